@@ -75,6 +75,7 @@ event subscription.
 */
 type Client interface {
 	ExternalTaskClient
+	ExternalScheduledTaskClient
 	ExternalDomainClient
 	ExternalActualLRPClient
 	ExternalDesiredLRPClient
@@ -85,6 +86,22 @@ type Client interface {
 
 	// Lists all Cells
 	Cells(logger lager.Logger, traceID string) ([]*models.CellPresence, error)
+
+	// Marks a Cell so that no new work is placed on it
+	CordonCell(logger lager.Logger, traceID string, cellID string) error
+
+	// Removes the cordon of a Cell and stops its drain
+	UncordonCell(logger lager.Logger, traceID string, cellID string) error
+
+	// Cordons a Cell and evacuates its ActualLRPs to other Cells
+	DrainCell(logger lager.Logger, traceID string, cellID string) error
+
+	// Returns the number of ActualLRP instances that remain on a Cell
+	CellDrainStatus(logger lager.Logger, traceID string, cellID string) (*models.CellDrainStatus, error)
+
+	// Returns the capacity of the Cells and the resources reserved on them,
+	// aggregated by zone and placement tag
+	CapacityReport(logger lager.Logger, traceID string) (*models.CapacityReport, error)
 }
 
 /*
@@ -112,6 +129,9 @@ type ExternalTaskClient interface {
 	// Returns the Task with the given guid
 	TaskByGuid(logger lager.Logger, traceID string, guid string) (*models.Task, error)
 
+	// Returns the result of the Task with the given guid
+	TaskResult(logger lager.Logger, traceID string, taskGuid string) (string, error)
+
 	// Cancels the Task with the given task guid
 	CancelTask(logger lager.Logger, traceID string, taskGuid string) error
 
@@ -120,6 +140,42 @@ type ExternalTaskClient interface {
 
 	// Deletes a completed task with the given guid
 	DeleteTask(logger lager.Logger, traceID string, taskGuid string) error
+
+	// Creates count Tasks from the given TaskDefinition, each with its index in the INDEX environment variable
+	DesireTaskArray(logger lager.Logger, traceID string, arrayGuid string, domain string, count int32, def *models.TaskDefinition) error
+
+	// Returns the number of Tasks of the task array in each state
+	TaskArrayStatus(logger lager.Logger, traceID string, arrayGuid string) (*models.TaskArrayStatus, error)
+
+	// Cancels every Task of the task array that has not yet completed
+	CancelTaskArray(logger lager.Logger, traceID string, arrayGuid string) error
+
+	// Lists a page of the archived Tasks that match filter, from the most recently archived, and returns the token of the next page
+	ArchivedTasks(logger lager.Logger, traceID string, filter models.TaskArchiveFilter) ([]*models.ArchivedTask, string, error)
+
+	// Returns the most recently archived Task with the given guid
+	ArchivedTaskByGuid(logger lager.Logger, traceID string, taskGuid string) (*models.ArchivedTask, error)
+}
+
+/*
+The ExternalScheduledTaskClient is used to manage ScheduledTasks, which desire
+a Task each time their cron schedule fires.
+*/
+type ExternalScheduledTaskClient interface {
+	// Lists all ScheduledTasks, or only those of the given domain if it is not empty
+	ScheduledTasks(logger lager.Logger, traceID string, domain string) ([]*models.ScheduledTask, error)
+
+	// Returns the ScheduledTask with the given guid
+	ScheduledTaskByGuid(logger lager.Logger, traceID string, guid string) (*models.ScheduledTask, error)
+
+	// Creates the given ScheduledTask
+	DesireScheduledTask(logger lager.Logger, traceID string, scheduledTask *models.ScheduledTask) error
+
+	// Replaces the schedule, policies and TaskDefinition of an existing ScheduledTask
+	UpdateScheduledTask(logger lager.Logger, traceID string, scheduledTask *models.ScheduledTask) error
+
+	// Deletes the ScheduledTask with the given guid. Tasks it already desired are not affected.
+	DeleteScheduledTask(logger lager.Logger, traceID string, guid string) error
 }
 
 /*
@@ -131,6 +187,27 @@ type ExternalDomainClient interface {
 
 	// Creates a domain or bumps the ttl on an existing domain
 	UpsertDomain(logger lager.Logger, traceID string, domain string, ttl time.Duration) error
+
+	// Lists all domains, including stale ones, with their expiry and last upsert
+	AllDomains(logger lager.Logger, traceID string) ([]*models.Domain, error)
+
+	// Makes a domain stale without waiting for its ttl to run out
+	ExpireDomain(logger lager.Logger, traceID string, domain string) error
+
+	// Removes a domain, making it stale
+	DeleteDomain(logger lager.Logger, traceID string, domain string) error
+
+	// Creates or replaces the resource quota of a domain
+	UpsertDomainQuota(logger lager.Logger, traceID string, quota *models.DomainQuota) error
+
+	// Removes the resource quota of a domain, leaving it unlimited
+	DeleteDomainQuota(logger lager.Logger, traceID string, domain string) error
+
+	// Lists the resource quotas of all domains that have one
+	DomainQuotas(logger lager.Logger, traceID string) ([]*models.DomainQuota, error)
+
+	// Returns the resources used by a domain against its quota
+	DomainUsage(logger lager.Logger, traceID string, domain string) (*models.DomainUsage, error)
 }
 
 /*
@@ -140,6 +217,13 @@ type ExternalActualLRPClient interface {
 	// Returns all ActualLRPs matching the given ActualLRPFilter
 	ActualLRPs(lager.Logger, string, models.ActualLRPFilter) ([]*models.ActualLRP, error)
 
+	// Returns all ActualLRPs matching the given ActualLRPFilter along with the
+	// crash history of their indices. The filter must have a process guid
+	ActualLRPsWithCrashHistory(lager.Logger, string, models.ActualLRPFilter) ([]*models.ActualLRP, []*models.ActualLRPCrash, error)
+
+	// Returns the latest crashes of the ActualLRP with the given process guid and instance index
+	ActualLRPCrashes(logger lager.Logger, traceID string, processGuid string, index int32) ([]*models.ActualLRPCrash, error)
+
 	// Returns all ActualLRPGroups matching the given ActualLRPFilter
 	//lint:ignore SA1019 - deprecated function returning deprecated data
 	// Deprecated: use ActualLRPs instead
@@ -157,6 +241,9 @@ type ExternalActualLRPClient interface {
 
 	// Shuts down the ActualLRP matching the given ActualLRPKey, but does not modify the desired state
 	RetireActualLRP(logger lager.Logger, traceID string, key *models.ActualLRPKey) error
+
+	// Stops the ActualLRP matching the given ActualLRPKey and auctions its replacement straight away
+	RestartActualLRP(logger lager.Logger, traceID string, key *models.ActualLRPKey) error
 }
 
 /*
@@ -178,6 +265,9 @@ type ExternalDesiredLRPClient interface {
 	// Returns all DesiredLRPRoutingInfos that match the given DesiredLRPFilter
 	DesiredLRPRoutingInfos(lager.Logger, string, models.DesiredLRPFilter) ([]*models.DesiredLRP, error)
 
+	// Returns the instance counts and health of the DesiredLRPs that match the given DesiredLRPFilter
+	DesiredLRPStatus(lager.Logger, string, models.DesiredLRPFilter) ([]*models.DesiredLRPStatus, error)
+
 	// Creates the given DesiredLRP and its corresponding ActualLRPs
 	DesireLRP(lager.Logger, string, *models.DesiredLRP) error
 
@@ -186,6 +276,19 @@ type ExternalDesiredLRPClient interface {
 
 	// Removes the DesiredLRP matching the given process guid
 	RemoveDesiredLRP(logger lager.Logger, traceID string, processGuid string) error
+
+	// Restarts all the instances of the DesiredLRP matching the given process guid,
+	// at most maxInFlight at a time if it is non-zero
+	RestartDesiredLRP(logger lager.Logger, traceID string, processGuid string, maxInFlight int32) error
+
+	// Returns the revision history of the DesiredLRP matching the given process guid, latest first
+	DesiredLRPRevisions(logger lager.Logger, traceID string, processGuid string) ([]*models.DesiredLRPRevision, error)
+
+	// Returns the fields of the DesiredLRP that changed between the two given revisions
+	DesiredLRPRevisionDiff(logger lager.Logger, traceID string, processGuid string, fromRevision, toRevision int32) ([]*models.DesiredLRPRevisionChange, error)
+
+	// Restores the run info and resources of the given revision of the DesiredLRP as a new revision
+	RollbackDesiredLRP(logger lager.Logger, traceID string, processGuid string, revision int32) error
 }
 
 /*
@@ -335,12 +438,18 @@ func (c *client) Ping(logger lager.Logger, traceID string) bool {
 }
 
 func (c *client) Domains(logger lager.Logger, traceID string) ([]string, error) {
-	response := models.DomainsResponse{}
-	err := c.doRequest(logger, traceID, DomainsRoute_r0, nil, nil, nil, &response)
+	allDomains, err := c.AllDomains(logger, traceID)
 	if err != nil {
 		return nil, err
 	}
-	return response.Domains, response.Error.ToError()
+
+	domains := []string{}
+	for _, domain := range allDomains {
+		if domain.Fresh {
+			domains = append(domains, domain.Domain)
+		}
+	}
+	return domains, nil
 }
 
 func (c *client) UpsertDomain(logger lager.Logger, traceID string, domain string, ttl time.Duration) error {
@@ -356,6 +465,84 @@ func (c *client) UpsertDomain(logger lager.Logger, traceID string, domain string
 	return response.Error.ToError()
 }
 
+func (c *client) AllDomains(logger lager.Logger, traceID string) ([]*models.Domain, error) {
+	response := models.AllDomainsResponse{}
+	err := c.doRequest(logger, traceID, DomainsRoute_r1, nil, nil, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.Domains, response.Error.ToError()
+}
+
+func (c *client) ExpireDomain(logger lager.Logger, traceID string, domain string) error {
+	request := models.ExpireDomainRequest{
+		Domain: domain,
+	}
+	response := models.ExpireDomainResponse{}
+	err := c.doRequest(logger, traceID, ExpireDomainRoute_r0, nil, nil, &request, &response)
+	if err != nil {
+		return err
+	}
+	return response.Error.ToError()
+}
+
+func (c *client) DeleteDomain(logger lager.Logger, traceID string, domain string) error {
+	request := models.DeleteDomainRequest{
+		Domain: domain,
+	}
+	response := models.DeleteDomainResponse{}
+	err := c.doRequest(logger, traceID, DeleteDomainRoute_r0, nil, nil, &request, &response)
+	if err != nil {
+		return err
+	}
+	return response.Error.ToError()
+}
+
+func (c *client) UpsertDomainQuota(logger lager.Logger, traceID string, quota *models.DomainQuota) error {
+	request := models.UpsertDomainQuotaRequest{
+		Quota: quota,
+	}
+	response := models.UpsertDomainQuotaResponse{}
+	err := c.doRequest(logger, traceID, UpsertDomainQuotaRoute_r0, nil, nil, &request, &response)
+	if err != nil {
+		return err
+	}
+	return response.Error.ToError()
+}
+
+func (c *client) DeleteDomainQuota(logger lager.Logger, traceID string, domain string) error {
+	request := models.DeleteDomainQuotaRequest{
+		Domain: domain,
+	}
+	response := models.DeleteDomainQuotaResponse{}
+	err := c.doRequest(logger, traceID, DeleteDomainQuotaRoute_r0, nil, nil, &request, &response)
+	if err != nil {
+		return err
+	}
+	return response.Error.ToError()
+}
+
+func (c *client) DomainQuotas(logger lager.Logger, traceID string) ([]*models.DomainQuota, error) {
+	response := models.DomainQuotasResponse{}
+	err := c.doRequest(logger, traceID, DomainQuotasRoute_r0, nil, nil, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.Quotas, response.Error.ToError()
+}
+
+func (c *client) DomainUsage(logger lager.Logger, traceID string, domain string) (*models.DomainUsage, error) {
+	request := models.DomainUsageRequest{
+		Domain: domain,
+	}
+	response := models.DomainUsageResponse{}
+	err := c.doRequest(logger, traceID, DomainUsageRoute_r0, nil, nil, &request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.Usage, response.Error.ToError()
+}
+
 func (c *client) ActualLRPs(logger lager.Logger, traceID string, filter models.ActualLRPFilter) ([]*models.ActualLRP, error) {
 	request := models.ActualLRPsRequest{
 		Domain:      filter.Domain,
@@ -374,6 +561,39 @@ func (c *client) ActualLRPs(logger lager.Logger, traceID string, filter models.A
 	return response.ActualLrps, response.Error.ToError()
 }
 
+func (c *client) ActualLRPsWithCrashHistory(logger lager.Logger, traceID string, filter models.ActualLRPFilter) ([]*models.ActualLRP, []*models.ActualLRPCrash, error) {
+	request := models.ActualLRPsRequest{
+		Domain:              filter.Domain,
+		CellId:              filter.CellID,
+		ProcessGuid:         filter.ProcessGuid,
+		IncludeCrashHistory: true,
+	}
+	if filter.Index != nil {
+		request.SetIndex(*filter.Index)
+	}
+	response := models.ActualLRPsResponse{}
+	err := c.doRequest(logger, traceID, ActualLRPsRoute_r0, nil, nil, &request, &response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.ActualLrps, response.CrashHistory, response.Error.ToError()
+}
+
+func (c *client) ActualLRPCrashes(logger lager.Logger, traceID string, processGuid string, index int32) ([]*models.ActualLRPCrash, error) {
+	request := models.ActualLRPCrashesRequest{
+		ProcessGuid: processGuid,
+		Index:       index,
+	}
+	response := models.ActualLRPCrashesResponse{}
+	err := c.doRequest(logger, traceID, ActualLRPCrashesRoute_r0, nil, nil, &request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Crashes, response.Error.ToError()
+}
+
 // Deprecated: use ActualLRPs instead
 func (c *client) ActualLRPGroups(logger lager.Logger, traceID string, filter models.ActualLRPFilter) ([]*models.ActualLRPGroup, error) {
 	request := models.ActualLRPGroupsRequest{
@@ -509,6 +729,18 @@ func (c *client) RetireActualLRP(logger lager.Logger, traceID string, key *model
 	return response.Error.ToError()
 }
 
+func (c *client) RestartActualLRP(logger lager.Logger, traceID string, key *models.ActualLRPKey) error {
+	request := models.RestartActualLRPRequest{
+		ActualLrpKey: key,
+	}
+	response := models.ActualLRPLifecycleResponse{}
+	err := c.doRequest(logger, traceID, RestartActualLRPRoute_r0, nil, nil, &request, &response)
+	if err != nil {
+		return err
+	}
+	return response.Error.ToError()
+}
+
 func (c *client) RemoveActualLRP(logger lager.Logger, traceID string, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey) error {
 	request := models.RemoveActualLRPRequest{
 		ProcessGuid:          key.ProcessGuid,
@@ -595,7 +827,7 @@ func (c *client) RemoveEvacuatingActualLRP(logger lager.Logger, traceID string,
 func (c *client) DesiredLRPs(logger lager.Logger, traceID string, filter models.DesiredLRPFilter) ([]*models.DesiredLRP, error) {
 	request := models.DesiredLRPsRequest(filter)
 	response := models.DesiredLRPsResponse{}
-	err := c.doRequest(logger, traceID, DesiredLRPsRoute_r3, nil, nil, &request, &response)
+	err := c.doRequest(logger, traceID, DesiredLRPsRoute_r4, nil, nil, &request, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -608,7 +840,7 @@ func (c *client) DesiredLRPByProcessGuid(logger lager.Logger, traceID string, pr
 		ProcessGuid: processGuid,
 	}
 	response := models.DesiredLRPResponse{}
-	err := c.doRequest(logger, traceID, DesiredLRPByProcessGuidRoute_r3, nil, nil, &request, &response)
+	err := c.doRequest(logger, traceID, DesiredLRPByProcessGuidRoute_r4, nil, nil, &request, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -651,6 +883,17 @@ func (c *client) DesiredLRPRoutingInfos(logger lager.Logger, traceID string, fil
 	return response.DesiredLrps, response.Error.ToError()
 }
 
+func (c *client) DesiredLRPStatus(logger lager.Logger, traceID string, filter models.DesiredLRPFilter) ([]*models.DesiredLRPStatus, error) {
+	request := models.DesiredLRPsRequest(filter)
+	response := models.DesiredLRPStatusResponse{}
+	err := c.doRequest(logger, traceID, DesiredLRPStatusRoute_r0, nil, nil, &request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Statuses, response.Error.ToError()
+}
+
 func (c *client) doDesiredLRPLifecycleRequest(logger lager.Logger, traceID string, route string, request proto.Message) error {
 	response := models.DesiredLRPLifecycleResponse{}
 	err := c.doRequest(logger, traceID, route, nil, nil, request, &response)
@@ -682,10 +925,54 @@ func (c *client) RemoveDesiredLRP(logger lager.Logger, traceID string, processGu
 	return c.doDesiredLRPLifecycleRequest(logger, traceID, RemoveDesiredLRPRoute_r0, &request)
 }
 
+func (c *client) RestartDesiredLRP(logger lager.Logger, traceID string, processGuid string, maxInFlight int32) error {
+	request := models.RestartDesiredLRPRequest{
+		ProcessGuid: processGuid,
+		MaxInFlight: maxInFlight,
+	}
+	return c.doDesiredLRPLifecycleRequest(logger, traceID, RestartDesiredLRPRoute_r0, &request)
+}
+
+func (c *client) DesiredLRPRevisions(logger lager.Logger, traceID string, processGuid string) ([]*models.DesiredLRPRevision, error) {
+	request := models.DesiredLRPRevisionsRequest{
+		ProcessGuid: processGuid,
+	}
+	response := models.DesiredLRPRevisionsResponse{}
+	err := c.doRequest(logger, traceID, DesiredLRPRevisionsRoute_r0, nil, nil, &request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Revisions, response.Error.ToError()
+}
+
+func (c *client) DesiredLRPRevisionDiff(logger lager.Logger, traceID string, processGuid string, fromRevision, toRevision int32) ([]*models.DesiredLRPRevisionChange, error) {
+	request := models.DesiredLRPRevisionDiffRequest{
+		ProcessGuid:  processGuid,
+		FromRevision: fromRevision,
+		ToRevision:   toRevision,
+	}
+	response := models.DesiredLRPRevisionDiffResponse{}
+	err := c.doRequest(logger, traceID, DesiredLRPRevisionDiffRoute_r0, nil, nil, &request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Changes, response.Error.ToError()
+}
+
+func (c *client) RollbackDesiredLRP(logger lager.Logger, traceID string, processGuid string, revision int32) error {
+	request := models.RollbackDesiredLRPRequest{
+		ProcessGuid: processGuid,
+		Revision:    revision,
+	}
+	return c.doDesiredLRPLifecycleRequest(logger, traceID, RollbackDesiredLRPRoute_r0, &request)
+}
+
 func (c *client) Tasks(logger lager.Logger, traceID string) ([]*models.Task, error) {
 	request := models.TasksRequest{}
 	response := models.TasksResponse{}
-	err := c.doRequest(logger, traceID, TasksRoute_r3, nil, nil, &request, &response)
+	err := c.doRequest(logger, traceID, TasksRoute_r4, nil, nil, &request, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -695,11 +982,12 @@ func (c *client) Tasks(logger lager.Logger, traceID string) ([]*models.Task, err
 
 func (c *client) TasksWithFilter(logger lager.Logger, traceID string, filter models.TaskFilter) ([]*models.Task, error) {
 	request := models.TasksRequest{
-		Domain: filter.Domain,
-		CellId: filter.CellID,
+		Domain:         filter.Domain,
+		CellId:         filter.CellID,
+		IncludeResults: filter.IncludeResults,
 	}
 	response := models.TasksResponse{}
-	err := c.doRequest(logger, traceID, TasksRoute_r3, nil, nil, &request, &response)
+	err := c.doRequest(logger, traceID, TasksRoute_r4, nil, nil, &request, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -711,7 +999,7 @@ func (c *client) TasksByDomain(logger lager.Logger, traceID string, domain strin
 		Domain: domain,
 	}
 	response := models.TasksResponse{}
-	err := c.doRequest(logger, traceID, TasksRoute_r3, nil, nil, &request, &response)
+	err := c.doRequest(logger, traceID, TasksRoute_r4, nil, nil, &request, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -724,7 +1012,7 @@ func (c *client) TasksByCellID(logger lager.Logger, traceID string, cellId strin
 		CellId: cellId,
 	}
 	response := models.TasksResponse{}
-	err := c.doRequest(logger, traceID, TasksRoute_r3, nil, nil, &request, &response)
+	err := c.doRequest(logger, traceID, TasksRoute_r4, nil, nil, &request, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -745,6 +1033,19 @@ func (c *client) TaskByGuid(logger lager.Logger, traceID string, taskGuid string
 	return response.Task, response.Error.ToError()
 }
 
+func (c *client) TaskResult(logger lager.Logger, traceID string, taskGuid string) (string, error) {
+	request := models.TaskResultRequest{
+		TaskGuid: taskGuid,
+	}
+	response := models.TaskResultResponse{}
+	err := c.doRequest(logger, traceID, TaskResultRoute_r0, nil, nil, &request, &response)
+	if err != nil {
+		return "", err
+	}
+
+	return response.Result, response.Error.ToError()
+}
+
 func (c *client) doTaskLifecycleRequest(logger lager.Logger, traceID string, route string, request proto.Message) error {
 	response := models.TaskLifecycleResponse{}
 	err := c.doRequest(logger, traceID, route, nil, nil, request, &response)
@@ -764,6 +1065,36 @@ func (c *client) DesireTask(logger lager.Logger, traceID string, taskGuid, domai
 	return c.doTaskLifecycleRequest(logger, traceID, route, &request)
 }
 
+func (c *client) DesireTaskArray(logger lager.Logger, traceID string, arrayGuid, domain string, count int32, taskDef *models.TaskDefinition) error {
+	request := models.DesireTaskArrayRequest{
+		ArrayGuid:      arrayGuid,
+		Domain:         domain,
+		Count:          count,
+		TaskDefinition: taskDef,
+	}
+	return c.doTaskLifecycleRequest(logger, traceID, DesireTaskArrayRoute_r0, &request)
+}
+
+func (c *client) TaskArrayStatus(logger lager.Logger, traceID string, arrayGuid string) (*models.TaskArrayStatus, error) {
+	request := models.TaskArrayGuidRequest{
+		ArrayGuid: arrayGuid,
+	}
+	response := models.TaskArrayStatusResponse{}
+	err := c.doRequest(logger, traceID, TaskArrayStatusRoute_r0, nil, nil, &request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Status, response.Error.ToError()
+}
+
+func (c *client) CancelTaskArray(logger lager.Logger, traceID string, arrayGuid string) error {
+	request := models.TaskArrayGuidRequest{
+		ArrayGuid: arrayGuid,
+	}
+	return c.doTaskLifecycleRequest(logger, traceID, CancelTaskArrayRoute_r0, &request)
+}
+
 func (c *client) StartTask(logger lager.Logger, traceID string, taskGuid string, cellId string) (bool, error) {
 	request := &models.StartTaskRequest{
 		TaskGuid: taskGuid,
@@ -832,6 +1163,94 @@ func (c *client) CompleteTask(logger lager.Logger, traceID string, taskGuid stri
 	return c.doTaskLifecycleRequest(logger, traceID, route, &request)
 }
 
+func (c *client) ArchivedTasks(logger lager.Logger, traceID string, filter models.TaskArchiveFilter) ([]*models.ArchivedTask, string, error) {
+	request := models.ArchivedTasksRequest{
+		Domain:          filter.Domain,
+		CellId:          filter.CellID,
+		FailedOnly:      filter.FailedOnly,
+		CompletedAfter:  filter.CompletedAfter,
+		CompletedBefore: filter.CompletedBefore,
+		PageSize:        int32(filter.PageSize),
+		PageToken:       filter.PageToken,
+	}
+	response := models.ArchivedTasksResponse{}
+	err := c.doRequest(logger, traceID, ArchivedTasksRoute_r0, nil, nil, &request, &response)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return response.ArchivedTasks, response.NextPageToken, response.Error.ToError()
+}
+
+func (c *client) ArchivedTaskByGuid(logger lager.Logger, traceID string, taskGuid string) (*models.ArchivedTask, error) {
+	request := models.ArchivedTaskByGuidRequest{
+		TaskGuid: taskGuid,
+	}
+	response := models.ArchivedTaskResponse{}
+	err := c.doRequest(logger, traceID, ArchivedTaskByGuidRoute_r0, nil, nil, &request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.ArchivedTask, response.Error.ToError()
+}
+
+func (c *client) ScheduledTasks(logger lager.Logger, traceID string, domain string) ([]*models.ScheduledTask, error) {
+	request := models.ScheduledTasksRequest{
+		Domain: domain,
+	}
+	response := models.ScheduledTasksResponse{}
+	err := c.doRequest(logger, traceID, ScheduledTasksRoute_r0, nil, nil, &request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.ScheduledTasks, response.Error.ToError()
+}
+
+func (c *client) ScheduledTaskByGuid(logger lager.Logger, traceID string, guid string) (*models.ScheduledTask, error) {
+	request := models.ScheduledTaskByGuidRequest{
+		Guid: guid,
+	}
+	response := models.ScheduledTaskResponse{}
+	err := c.doRequest(logger, traceID, ScheduledTaskByGuidRoute_r0, nil, nil, &request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.ScheduledTask, response.Error.ToError()
+}
+
+func (c *client) doScheduledTaskLifecycleRequest(logger lager.Logger, traceID string, route string, request proto.Message) error {
+	response := models.ScheduledTaskLifecycleResponse{}
+	err := c.doRequest(logger, traceID, route, nil, nil, request, &response)
+	if err != nil {
+		return err
+	}
+	return response.Error.ToError()
+}
+
+func (c *client) DesireScheduledTask(logger lager.Logger, traceID string, scheduledTask *models.ScheduledTask) error {
+	request := models.DesireScheduledTaskRequest{
+		ScheduledTask: scheduledTask,
+	}
+	return c.doScheduledTaskLifecycleRequest(logger, traceID, DesireScheduledTaskRoute_r0, &request)
+}
+
+func (c *client) UpdateScheduledTask(logger lager.Logger, traceID string, scheduledTask *models.ScheduledTask) error {
+	request := models.UpdateScheduledTaskRequest{
+		ScheduledTask: scheduledTask,
+	}
+	return c.doScheduledTaskLifecycleRequest(logger, traceID, UpdateScheduledTaskRoute_r0, &request)
+}
+
+func (c *client) DeleteScheduledTask(logger lager.Logger, traceID string, guid string) error {
+	request := models.DeleteScheduledTaskRequest{
+		Guid: guid,
+	}
+	return c.doScheduledTaskLifecycleRequest(logger, traceID, DeleteScheduledTaskRoute_r0, &request)
+}
+
 func (c *client) subscribeToEvents(route string, cellId string) (events.EventSource, error) {
 	request := models.EventsByCellId{
 		CellId: cellId,
@@ -871,7 +1290,7 @@ func (c *client) SubscribeToEvents(logger lager.Logger) (events.EventSource, err
 }
 
 func (c *client) SubscribeToInstanceEvents(logger lager.Logger) (events.EventSource, error) {
-	return c.subscribeToEvents(LRPInstanceEventStreamRoute_r1, "")
+	return c.subscribeToEvents(LRPInstanceEventStreamRoute_r2, "")
 }
 
 func (c *client) SubscribeToTaskEvents(logger lager.Logger) (events.EventSource, error) {
@@ -884,7 +1303,7 @@ func (c *client) SubscribeToEventsByCellID(logger lager.Logger, cellId string) (
 }
 
 func (c *client) SubscribeToInstanceEventsByCellID(logger lager.Logger, cellId string) (events.EventSource, error) {
-	return c.subscribeToEvents(LRPInstanceEventStreamRoute_r1, cellId)
+	return c.subscribeToEvents(LRPInstanceEventStreamRoute_r2, cellId)
 }
 
 func (c *client) Cells(logger lager.Logger, traceID string) ([]*models.CellPresence, error) {
@@ -896,6 +1315,63 @@ func (c *client) Cells(logger lager.Logger, traceID string) ([]*models.CellPrese
 	return response.Cells, response.Error.ToError()
 }
 
+func (c *client) CordonCell(logger lager.Logger, traceID string, cellID string) error {
+	request := models.CordonCellRequest{
+		CellId: cellID,
+	}
+	response := models.CordonCellResponse{}
+	err := c.doRequest(logger, traceID, CordonCellRoute_r0, nil, nil, &request, &response)
+	if err != nil {
+		return err
+	}
+	return response.Error.ToError()
+}
+
+func (c *client) UncordonCell(logger lager.Logger, traceID string, cellID string) error {
+	request := models.UncordonCellRequest{
+		CellId: cellID,
+	}
+	response := models.UncordonCellResponse{}
+	err := c.doRequest(logger, traceID, UncordonCellRoute_r0, nil, nil, &request, &response)
+	if err != nil {
+		return err
+	}
+	return response.Error.ToError()
+}
+
+func (c *client) DrainCell(logger lager.Logger, traceID string, cellID string) error {
+	request := models.DrainCellRequest{
+		CellId: cellID,
+	}
+	response := models.DrainCellResponse{}
+	err := c.doRequest(logger, traceID, DrainCellRoute_r0, nil, nil, &request, &response)
+	if err != nil {
+		return err
+	}
+	return response.Error.ToError()
+}
+
+func (c *client) CellDrainStatus(logger lager.Logger, traceID string, cellID string) (*models.CellDrainStatus, error) {
+	request := models.CellDrainStatusRequest{
+		CellId: cellID,
+	}
+	response := models.CellDrainStatusResponse{}
+	err := c.doRequest(logger, traceID, CellDrainStatusRoute_r0, nil, nil, &request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.Status, response.Error.ToError()
+}
+
+func (c *client) CapacityReport(logger lager.Logger, traceID string) (*models.CapacityReport, error) {
+	response := models.CapacityReportResponse{}
+	err := c.doRequest(logger, traceID, CapacityReportRoute_r0, nil, nil, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.Report, response.Error.ToError()
+}
+
 func (c *client) createRequest(traceID string, requestName string, params rata.Params, queryParams url.Values, message proto.Message) (*http.Request, error) {
 	var messageBody []byte
 	var err error
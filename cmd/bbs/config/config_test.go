@@ -6,6 +6,7 @@ import (
 
 	"code.cloudfoundry.org/bbs/cmd/bbs/config"
 	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/taskworkpool"
 	"code.cloudfoundry.org/bbs/test_helpers"
 	"code.cloudfoundry.org/debugserver"
 	loggingclient "code.cloudfoundry.org/diego-logging-client"
@@ -77,12 +78,27 @@ var _ = Describe("BBSConfig", func() {
 			"rep_require_tls": true,
 			"report_interval": "1m0s",
 			"require_ssl": true,
+			"rolling_restart_timeout": "10m",
+			"scheduled_task_interval": "15s",
 			"session_name": "bbs-session",
 			"sql_ca_cert_file": "/var/vcap/jobs/bbs/config/sql.ca",
 			"sql_enable_identity_verification": true,
+			"task_archive_retention_duration": "168h",
+			"task_callback_allowed_hosts": ["cc.service.cf.internal", "*.apps.internal"],
+			"task_callback_domain_credentials": {
+				"cf-apps": {
+					"bearer_token": "some-token",
+					"client_cert_file": "/var/vcap/jobs/bbs/config/cf-apps.crt",
+					"client_key_file": "/var/vcap/jobs/bbs/config/cf-apps.key"
+				}
+			},
+			"task_callback_signing_secret": "callback-secret",
 			"task_callback_workers": 1000,
+			"task_default_max_run_durations": {"cf-apps": "1h0m0s"},
 			"update_workers": 1000,
-			"max_task_retries": 3
+			"max_task_retries": 3,
+			"max_task_auctions_per_convergence": 500,
+			"max_inline_task_result_size": 65536
 		}`
 	})
 
@@ -169,12 +185,29 @@ var _ = Describe("BBSConfig", func() {
 			RepRequireTLS:                 true,
 			ReportInterval:                durationjson.Duration(1 * time.Minute),
 			RequireSSL:                    true,
+			RollingRestartTimeout:         durationjson.Duration(10 * time.Minute),
 			SQLCACertFile:                 "/var/vcap/jobs/bbs/config/sql.ca",
 			SQLEnableIdentityVerification: true,
+			ScheduledTaskInterval:         durationjson.Duration(15 * time.Second),
 			SessionName:                   "bbs-session",
-			TaskCallbackWorkers:           1000,
+			TaskArchiveRetentionDuration:  durationjson.Duration(168 * time.Hour),
+			TaskCallbackAllowedHosts:      []string{"cc.service.cf.internal", "*.apps.internal"},
+			TaskCallbackDomainCredentials: map[string]taskworkpool.DomainCredentials{
+				"cf-apps": {
+					BearerToken:    "some-token",
+					ClientCertFile: "/var/vcap/jobs/bbs/config/cf-apps.crt",
+					ClientKeyFile:  "/var/vcap/jobs/bbs/config/cf-apps.key",
+				},
+			},
+			TaskCallbackSigningSecret: "callback-secret",
+			TaskCallbackWorkers:       1000,
+			TaskDefaultMaxRunDurations: map[string]durationjson.Duration{
+				"cf-apps": durationjson.Duration(time.Hour),
+			},
 			UpdateWorkers:                 1000,
 			MaxTaskRetries:                3,
+			MaxTaskAuctionsPerConvergence: 500,
+			MaxInlineTaskResultSize:       65536,
 		}
 
 		Expect(bbsConfig).To(test_helpers.DeepEqual(config))
@@ -106,6 +106,7 @@ var _ = Describe("Convergence API", func() {
 						wrappedDB,
 						1,
 						1,
+						0,
 						cryptor,
 						guidprovider.DefaultGuidProvider,
 						clock.NewClock(),
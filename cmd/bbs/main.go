@@ -26,6 +26,7 @@ import (
 	"code.cloudfoundry.org/bbs/handlers"
 	"code.cloudfoundry.org/bbs/metrics"
 	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/bbs/scheduler"
 	"code.cloudfoundry.org/bbs/serviceclient"
 	"code.cloudfoundry.org/bbs/taskworkpool"
 	cfhttp "code.cloudfoundry.org/cfhttp/v2"
@@ -126,6 +127,7 @@ func main() {
 		monitoredDB,
 		bbsConfig.ConvergenceWorkers,
 		bbsConfig.UpdateWorkers,
+		bbsConfig.MaxInlineTaskResultSize,
 		cryptor,
 		guidprovider.DefaultGuidProvider,
 		clock,
@@ -201,11 +203,23 @@ func main() {
 		tlsConfig.RootCAs = tlsConfig.ClientCAs
 	}
 
-	cbWorkPool := taskworkpool.New(logger,
+	callbackConfig := taskworkpool.CallbackConfig{
+		SigningSecret:     bbsConfig.TaskCallbackSigningSecret,
+		AllowedHosts:      bbsConfig.TaskCallbackAllowedHosts,
+		DomainCredentials: bbsConfig.TaskCallbackDomainCredentials,
+	}
+
+	cbWorkPool, err := taskworkpool.New(logger,
 		bbsConfig.TaskCallbackWorkers,
 		taskworkpool.HandleCompletedTask,
 		tlsConfig,
-		time.Duration(bbsConfig.CommunicationTimeout))
+		time.Duration(bbsConfig.CommunicationTimeout),
+		callbackConfig,
+		clock,
+	)
+	if err != nil {
+		logger.Fatal("task-callback-configuration-failed", err)
+	}
 
 	locks := []grouper.Member{}
 
@@ -258,6 +272,12 @@ func main() {
 	lockHeldMetronNotifier := lockheldmetrics.NewLockHeldMetronNotifier(logger, locksHeldTicker, metronClient)
 	taskStatMetronNotifier := metrics.NewTaskStatMetronNotifier(logger, clock, metronClient)
 	dbStatMetronNotifier := metrics.NewDBStatMetronNotifier(logger, clock, monitoredDB, metronClient, queryMonitor)
+	capacityMetronNotifier := metrics.NewCapacityMetronNotifier(logger, clock, serviceClient, sqlDB, metronClient)
+
+	rollingRestartTimeout := time.Duration(bbsConfig.RollingRestartTimeout)
+	if rollingRestartTimeout == 0 {
+		rollingRestartTimeout = controllers.DefaultRollingRestartTimeout
+	}
 
 	handler := handlers.New(
 		logger,
@@ -265,6 +285,7 @@ func main() {
 		bbsConfig.UpdateWorkers,
 		bbsConfig.ConvergenceWorkers,
 		bbsConfig.MaxTaskRetries,
+		rollingRestartTimeout,
 		requestStatMetronNotifier,
 		sqlDB,
 		desiredHub,
@@ -276,6 +297,7 @@ func main() {
 		auctioneerClient,
 		repClientFactory,
 		taskStatMetronNotifier,
+		clock,
 		migrationsDone,
 		exitChan,
 		metronClient,
@@ -293,6 +315,18 @@ func main() {
 		repClientFactory,
 		actualHub,
 		actualLRPInstanceHub,
+		rollingRestartTimeout,
+	)
+
+	evacuationController := controllers.NewEvacuationController(
+		sqlDB,
+		sqlDB,
+		sqlDB,
+		sqlDB,
+		sqlDB,
+		auctioneerClient,
+		actualHub,
+		actualLRPInstanceHub,
 	)
 
 	lrpStatMetronNotifier := metrics.NewLRPStatMetronNotifier(logger, clock, metronClient)
@@ -303,16 +337,24 @@ func main() {
 		sqlDB,
 		sqlDB,
 		sqlDB,
+		desiredHub,
 		actualHub,
 		actualLRPInstanceHub,
 		auctioneerClient,
 		serviceClient,
 		repClientFactory,
 		actualLRPController,
+		evacuationController,
+		actualLRPController,
 		bbsConfig.ConvergenceWorkers,
 		lrpStatMetronNotifier,
 	)
 
+	defaultMaxRunDurations := make(map[string]time.Duration, len(bbsConfig.TaskDefaultMaxRunDurations))
+	for domain, maxRunDuration := range bbsConfig.TaskDefaultMaxRunDurations {
+		defaultMaxRunDurations[domain] = time.Duration(maxRunDuration)
+	}
+
 	taskController := controllers.NewTaskController(
 		sqlDB,
 		cbWorkPool,
@@ -322,6 +364,12 @@ func main() {
 		taskHub,
 		taskStatMetronNotifier,
 		bbsConfig.MaxTaskRetries,
+		controllers.TaskControllerConfig{
+			ConvergenceWorkers:     bbsConfig.ConvergenceWorkers,
+			DefaultMaxRunDurations: defaultMaxRunDurations,
+			MaxAuctionsPerCycle:    bbsConfig.MaxTaskAuctionsPerConvergence,
+			TaskArchiveRetention:   time.Duration(bbsConfig.TaskArchiveRetentionDuration),
+		},
 	)
 
 	convergerProcess := converger.New(
@@ -336,6 +384,14 @@ func main() {
 		time.Duration(bbsConfig.ExpireCompletedTaskDuration),
 	)
 
+	scheduledTaskController := controllers.NewScheduledTaskController(sqlDB, taskController, taskHub, clock)
+	schedulerProcess := scheduler.New(
+		logger,
+		clock,
+		scheduledTaskController,
+		time.Duration(bbsConfig.ScheduledTaskInterval),
+	)
+
 	var server ifrit.Runner
 	if tlsConfig != nil {
 		server = http_server.NewTLSServer(bbsConfig.ListenAddress, handler, tlsConfig)
@@ -359,9 +415,11 @@ func main() {
 		{Name: "bbs-election-metrics", Runner: bbsElectionMetronNotifier},
 		{Name: "periodic-metrics", Runner: requestStatMetronNotifier},
 		{Name: "converger", Runner: convergerProcess},
+		{Name: "scheduler", Runner: schedulerProcess},
 		{Name: "lrp-stat-metron-notifier", Runner: lrpStatMetronNotifier},
 		{Name: "task-stat-metron-notifier", Runner: taskStatMetronNotifier},
 		{Name: "db-stat-metron-notifier", Runner: dbStatMetronNotifier},
+		{Name: "capacity-metron-notifier", Runner: capacityMetronNotifier},
 	}
 
 	if bbsConfig.DebugAddress != "" {
@@ -2,6 +2,7 @@ package controllers
 
 import (
 	"context"
+	"time"
 
 	"code.cloudfoundry.org/auctioneer"
 	"code.cloudfoundry.org/bbs/db"
@@ -14,6 +15,11 @@ import (
 	"code.cloudfoundry.org/rep"
 )
 
+// DefaultRollingRestartTimeout is how long a rolling restart waits for the
+// instances it restarted to be running before it is abandoned, unless
+// configured otherwise.
+const DefaultRollingRestartTimeout = 5 * time.Minute
+
 type ActualLRPLifecycleController struct {
 	db                   db.ActualLRPDB
 	suspectDB            db.SuspectDB
@@ -24,6 +30,8 @@ type ActualLRPLifecycleController struct {
 	repClientFactory     rep.ClientFactory
 	actualHub            events.Hub
 	actualLRPInstanceHub events.Hub
+
+	rollingRestartTimeout time.Duration
 }
 
 func NewActualLRPLifecycleController(
@@ -36,6 +44,7 @@ func NewActualLRPLifecycleController(
 	repClientFactory rep.ClientFactory,
 	actualHub events.Hub,
 	actualLRPInstanceHub events.Hub,
+	rollingRestartTimeout time.Duration,
 ) *ActualLRPLifecycleController {
 	return &ActualLRPLifecycleController{
 		db:                   db,
@@ -47,6 +56,8 @@ func NewActualLRPLifecycleController(
 		repClientFactory:     repClientFactory,
 		actualHub:            actualHub,
 		actualLRPInstanceHub: actualLRPInstanceHub,
+
+		rollingRestartTimeout: rollingRestartTimeout,
 	}
 }
 
@@ -151,6 +162,10 @@ func (h *ActualLRPLifecycleController) StartActualLRP(ctx context.Context,
 		err = h.evacuationDB.RemoveEvacuatingActualLRP(ctx, logger, &evacuating.ActualLRPKey, &evacuating.ActualLRPInstanceKey)
 		if err != nil {
 			logger.Error("failed-to-remove-evacuating-actual-lrp", err, lager.Data{"instance-guid": evacuating.ActualLRPInstanceKey})
+		} else {
+			// instances evacuated to be replaced on cells that are not
+			// shutting down would keep running once their replacement does
+			h.stopActualLRPInstance(ctx, logger, evacuating)
 		}
 		newLRPs = eventCalculator.RecordChange(evacuating, nil, newLRPs)
 	}
@@ -166,6 +181,11 @@ func (h *ActualLRPLifecycleController) StartActualLRP(ctx context.Context,
 		}
 	}
 
+	err = h.AdvanceRollingRestart(ctx, logger, actualLRPKey.ProcessGuid)
+	if err != nil {
+		logger.Error("failed-advancing-rolling-restart", err)
+	}
+
 	return nil
 }
 
@@ -213,7 +233,7 @@ func (h *ActualLRPLifecycleController) CrashActualLRP(ctx context.Context, logge
 		return err
 	}
 
-	startRequest := auctioneer.NewLRPStartRequestFromSchedulingInfo(schedInfo, int(actualLRPKey.Index))
+	startRequest := auctioneer.NewLRPStartRequestFromSchedulingInfo(schedInfo.WithSidecarResources(), int(actualLRPKey.Index))
 	logger.Info("start-lrp-auction-request", lager.Data{"app_guid": schedInfo.ProcessGuid, "index": int(actualLRPKey.Index)})
 	err = h.auctioneerClient.RequestLRPAuctions(logger, trace.RequestIdFromContext(ctx), []*auctioneer.LRPStartRequest{&startRequest})
 	logger.Info("finished-lrp-auction-request", lager.Data{"app_guid": schedInfo.ProcessGuid, "index": int(actualLRPKey.Index)})
@@ -344,3 +364,191 @@ func (h *ActualLRPLifecycleController) RetireActualLRP(ctx context.Context, logg
 
 	return err
 }
+
+// RestartActualLRP replaces the instance at the key straight away instead of
+// waiting for convergence. The instance is unclaimed, stopped through its rep
+// if it is claimed or running, and its replacement is auctioned. Crashed
+// instances are restarted without waiting for their restart backoff, and
+// unclaimed instances are left alone.
+func (h *ActualLRPLifecycleController) RestartActualLRP(ctx context.Context, logger lager.Logger, key *models.ActualLRPKey) error {
+	logger = logger.Session("restart-actual-lrp", lager.Data{"process_guid": key.ProcessGuid, "index": key.Index})
+
+	restarted, err := h.restartActualLRP(ctx, logger, key)
+	if err != nil || !restarted {
+		return err
+	}
+
+	schedInfo, err := h.desiredLRPDB.DesiredLRPSchedulingInfoByProcessGuid(ctx, logger, key.ProcessGuid)
+	if err != nil {
+		logger.Error("failed-fetching-desired-lrp", err)
+		return err
+	}
+
+	h.requestAuctions(ctx, logger, schedInfo, int(key.Index))
+	return nil
+}
+
+// RestartDesiredLRP restarts every instance of the DesiredLRP the way
+// RestartActualLRP does, unless it is paused. With a maxInFlight of zero all the instances are
+// restarted straight away. Otherwise the first maxInFlight instances are
+// restarted before returning, and the remaining ones are restarted,
+// maxInFlight at a time, as the replacements of the previous ones start
+// running.
+func (h *ActualLRPLifecycleController) RestartDesiredLRP(ctx context.Context, logger lager.Logger, processGuid string, maxInFlight int32) error {
+	logger = logger.Session("restart-desired-lrp", lager.Data{"process_guid": processGuid, "max_in_flight": maxInFlight})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	schedInfo, err := h.desiredLRPDB.DesiredLRPSchedulingInfoByProcessGuid(ctx, logger, processGuid)
+	if err != nil {
+		logger.Error("failed-fetching-desired-lrp", err)
+		return err
+	}
+
+	if schedInfo.Paused {
+		logger.Info("desired-lrp-is-paused")
+		return models.NewError(models.Error_ResourceConflict, "cannot restart a paused desired LRP")
+	}
+
+	indices := make([]int32, 0, schedInfo.Instances)
+	for index := int32(0); index < schedInfo.Instances; index++ {
+		indices = append(indices, index)
+	}
+
+	if maxInFlight == 0 || int(maxInFlight) >= len(indices) {
+		return h.restartActualLRPs(ctx, logger, schedInfo, indices)
+	}
+
+	err = h.desiredLRPDB.StartRollingRestart(ctx, logger, processGuid, maxInFlight)
+	if err != nil {
+		logger.Error("failed-starting-rolling-restart", err)
+		return err
+	}
+
+	return h.restartActualLRPs(ctx, logger, schedInfo, indices[:maxInFlight])
+}
+
+// AdvanceRollingRestart restarts the next instances of the rolling restart of
+// the DesiredLRP, if one is in progress. It is called when an instance starts
+// running, and by LRP convergence in case that did not advance it.
+func (h *ActualLRPLifecycleController) AdvanceRollingRestart(ctx context.Context, logger lager.Logger, processGuid string) error {
+	keys, err := h.desiredLRPDB.AdvanceRollingRestart(ctx, logger, processGuid, h.rollingRestartTimeout)
+	if err != nil || len(keys) == 0 {
+		return err
+	}
+
+	schedInfo, err := h.desiredLRPDB.DesiredLRPSchedulingInfoByProcessGuid(ctx, logger, processGuid)
+	if err != nil {
+		logger.Error("failed-fetching-desired-lrp", err)
+		return err
+	}
+
+	indices := make([]int32, 0, len(keys))
+	for _, key := range keys {
+		indices = append(indices, key.Index)
+	}
+
+	return h.restartActualLRPs(ctx, logger, schedInfo, indices)
+}
+
+// restartActualLRPs restarts the ActualLRPs at the indices and requests the
+// auction of their replacements. It returns the first error it encounters
+// after attempting all the indices.
+func (h *ActualLRPLifecycleController) restartActualLRPs(ctx context.Context, logger lager.Logger, schedInfo *models.DesiredLRPSchedulingInfo, indices []int32) error {
+	var firstErr error
+	restartedIndices := []int{}
+	for _, index := range indices {
+		key := models.NewActualLRPKey(schedInfo.ProcessGuid, index, schedInfo.Domain)
+		restarted, err := h.restartActualLRP(ctx, logger, &key)
+		if err == models.ErrResourceNotFound {
+			continue
+		}
+		if err != nil {
+			logger.Error("failed-restarting-actual-lrp", err, lager.Data{"index": index})
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		if restarted {
+			restartedIndices = append(restartedIndices, int(index))
+		}
+	}
+
+	if len(restartedIndices) > 0 {
+		h.requestAuctions(ctx, logger, schedInfo, restartedIndices...)
+	}
+
+	return firstErr
+}
+
+// restartActualLRP unclaims the ActualLRP at the key and stops the instance it
+// replaces. It returns whether the ActualLRP was unclaimed and needs to be
+// auctioned.
+func (h *ActualLRPLifecycleController) restartActualLRP(ctx context.Context, logger lager.Logger, key *models.ActualLRPKey) (bool, error) {
+	lrps, err := h.db.ActualLRPs(ctx, logger, models.ActualLRPFilter{ProcessGuid: key.ProcessGuid, Index: &key.Index})
+	if err != nil {
+		return false, err
+	}
+
+	lrp := findWithPresence(lrps, models.ActualLRP_Ordinary)
+	if lrp == nil {
+		return false, models.ErrResourceNotFound
+	}
+
+	if lrp.State == models.ActualLRPStateUnclaimed {
+		logger.Info("actual-lrp-already-unclaimed", lager.Data{"index": key.Index})
+		return false, nil
+	}
+
+	before, after, err := h.db.UnclaimActualLRP(ctx, logger, key)
+	if err != nil {
+		return false, err
+	}
+
+	eventCalculator := calculator.ActualLRPEventCalculator{
+		ActualLRPGroupHub:    h.actualHub,
+		ActualLRPInstanceHub: h.actualLRPInstanceHub,
+	}
+
+	newLRPs := eventCalculator.RecordChange(lrp, after, lrps)
+	go eventCalculator.EmitEvents(trace.RequestIdFromContext(ctx), lrps, newLRPs)
+
+	if before.State == models.ActualLRPStateClaimed || before.State == models.ActualLRPStateRunning {
+		h.stopActualLRPInstance(ctx, logger, before)
+	}
+
+	return true, nil
+}
+
+// stopActualLRPInstance asks the rep of the instance to stop it. Failures are
+// only logged, as the rep also stops instances that no longer match their
+// ActualLRP.
+func (h *ActualLRPLifecycleController) stopActualLRPInstance(ctx context.Context, logger lager.Logger, lrp *models.ActualLRP) {
+	cell, err := h.serviceClient.CellById(logger, lrp.CellId)
+	if err != nil {
+		logger.Error("failed-fetching-cell-presence", err, lager.Data{"cell_id": lrp.CellId})
+		return
+	}
+
+	client, err := h.repClientFactory.CreateClient(cell.RepAddress, cell.RepUrl, trace.RequestIdFromContext(ctx))
+	if err != nil {
+		logger.Error("create-rep-client-failed", err)
+		return
+	}
+
+	err = client.StopLRPInstance(logger, lrp.ActualLRPKey, lrp.ActualLRPInstanceKey)
+	if err != nil {
+		logger.Error("failed-stopping-lrp-instance", err, lager.Data{"instance_key": lrp.ActualLRPInstanceKey})
+	}
+}
+
+func (h *ActualLRPLifecycleController) requestAuctions(ctx context.Context, logger lager.Logger, schedInfo *models.DesiredLRPSchedulingInfo, indices ...int) {
+	startRequest := auctioneer.NewLRPStartRequestFromSchedulingInfo(schedInfo.WithSidecarResources(), indices...)
+	logger.Info("start-lrp-auction-request", lager.Data{"app_guid": schedInfo.ProcessGuid, "indices": indices})
+	err := h.auctioneerClient.RequestLRPAuctions(logger, trace.RequestIdFromContext(ctx), []*auctioneer.LRPStartRequest{&startRequest})
+	logger.Info("finished-lrp-auction-request", lager.Data{"app_guid": schedInfo.ProcessGuid, "indices": indices})
+	if err != nil {
+		logger.Error("failed-requesting-auction", err)
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"code.cloudfoundry.org/bbs/models/test/model_helpers"
 	"code.cloudfoundry.org/bbs/serviceclient/serviceclientfakes"
 	"code.cloudfoundry.org/bbs/trace"
+	"code.cloudfoundry.org/lager/v3"
 	"code.cloudfoundry.org/lager/v3/lagertest"
 	"code.cloudfoundry.org/rep/repfakes"
 
@@ -81,6 +82,7 @@ var _ = Describe("ActualLRP Lifecycle Controller", func() {
 			fakeRepClientFactory,
 			actualHub,
 			actualLRPInstanceHub,
+			controllers.DefaultRollingRestartTimeout,
 		)
 
 		beforeInstanceKey = models.NewActualLRPInstanceKey(
@@ -453,6 +455,68 @@ var _ = Describe("ActualLRP Lifecycle Controller", func() {
 				})
 			})
 
+			It("advances the rolling restart of the desired lrp", func() {
+				err = controller.StartActualLRP(ctx, logger, &actualLRPKey, &afterInstanceKey, &netInfo, internalRoutes, metricTags, routable, availabilityZone)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeDesiredLRPDB.AdvanceRollingRestartCallCount()).To(Equal(1))
+				_, _, guid, timeout := fakeDesiredLRPDB.AdvanceRollingRestartArgsForCall(0)
+				Expect(guid).To(Equal(processGuid))
+				Expect(timeout).To(Equal(controllers.DefaultRollingRestartTimeout))
+			})
+
+			Context("when the rolling restart has more instances to restart", func() {
+				var nextKey models.ActualLRPKey
+
+				BeforeEach(func() {
+					nextKey = models.NewActualLRPKey(processGuid, 2, "domain-0")
+					fakeDesiredLRPDB.AdvanceRollingRestartReturns([]*models.ActualLRPKey{&nextKey}, nil)
+
+					desiredLRP := model_helpers.NewValidDesiredLRP(processGuid)
+					schedInfo := desiredLRP.DesiredLRPSchedulingInfo()
+					fakeDesiredLRPDB.DesiredLRPSchedulingInfoByProcessGuidReturns(&schedInfo, nil)
+				})
+
+				JustBeforeEach(func() {
+					fakeActualLRPDB.ActualLRPsStub = func(_ context.Context, _ lager.Logger, filter models.ActualLRPFilter) ([]*models.ActualLRP, error) {
+						if filter.Index != nil && *filter.Index == nextKey.Index {
+							next := model_helpers.NewValidActualLRP(processGuid, nextKey.Index)
+							next.ActualLRPKey = nextKey
+							return []*models.ActualLRP{next}, nil
+						}
+						return []*models.ActualLRP{actualLRP}, nil
+					}
+					fakeActualLRPDB.UnclaimActualLRPReturns(actualLRP, actualLRP, nil)
+				})
+
+				It("restarts them", func() {
+					err = controller.StartActualLRP(ctx, logger, &actualLRPKey, &afterInstanceKey, &netInfo, internalRoutes, metricTags, routable, availabilityZone)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeActualLRPDB.UnclaimActualLRPCallCount()).To(Equal(1))
+					_, _, unclaimedKey := fakeActualLRPDB.UnclaimActualLRPArgsForCall(0)
+					Expect(unclaimedKey.ProcessGuid).To(Equal(processGuid))
+					Expect(unclaimedKey.Index).To(Equal(nextKey.Index))
+
+					Expect(fakeAuctioneerClient.RequestLRPAuctionsCallCount()).To(Equal(1))
+					_, _, startRequests := fakeAuctioneerClient.RequestLRPAuctionsArgsForCall(0)
+					Expect(startRequests).To(HaveLen(1))
+					Expect(startRequests[0].Indices).To(Equal([]int{2}))
+				})
+			})
+
+			Context("when advancing the rolling restart fails", func() {
+				BeforeEach(func() {
+					fakeDesiredLRPDB.AdvanceRollingRestartReturns(nil, errors.New("boom"))
+				})
+
+				It("logs the error and still starts the lrp", func() {
+					err = controller.StartActualLRP(ctx, logger, &actualLRPKey, &afterInstanceKey, &netInfo, internalRoutes, metricTags, routable, availabilityZone)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(logger).To(gbytes.Say("failed-advancing-rolling-restart"))
+				})
+			})
+
 			Context("when the lrp is evacuating", func() {
 				var evacuating *models.ActualLRP
 
@@ -460,6 +524,9 @@ var _ = Describe("ActualLRP Lifecycle Controller", func() {
 					evacuating = model_helpers.NewValidEvacuatingActualLRP(processGuid, index)
 					evacuating.ActualLRPKey = actualLRPKey
 					evacuating.State = models.ActualLRPStateRunning
+
+					cellPresence := models.NewCellPresence(evacuating.CellId, "cell1.addr", "", "the-zone", models.NewCellCapacity(128, 1024, 6), nil, nil, nil, nil)
+					fakeServiceClient.CellByIdReturns(&cellPresence, nil)
 				})
 
 				JustBeforeEach(func() {
@@ -474,6 +541,31 @@ var _ = Describe("ActualLRP Lifecycle Controller", func() {
 					Expect(*lrpInstanceKey).To(Equal(evacuating.ActualLRPInstanceKey))
 				})
 
+				It("stops the evacuating instance on its cell", func() {
+					err = controller.StartActualLRP(ctx, logger, &actualLRPKey, &afterInstanceKey, &netInfo, internalRoutes, metricTags, routable, availabilityZone)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeServiceClient.CellByIdCallCount()).To(Equal(1))
+					_, cellID := fakeServiceClient.CellByIdArgsForCall(0)
+					Expect(cellID).To(Equal(evacuating.CellId))
+
+					Expect(fakeRepClient.StopLRPInstanceCallCount()).To(Equal(1))
+					_, stoppedKey, stoppedInstanceKey := fakeRepClient.StopLRPInstanceArgsForCall(0)
+					Expect(stoppedKey).To(Equal(evacuating.ActualLRPKey))
+					Expect(stoppedInstanceKey).To(Equal(evacuating.ActualLRPInstanceKey))
+				})
+
+				Context("when removing the evacuating lrp fails", func() {
+					BeforeEach(func() {
+						fakeEvacuationDB.RemoveEvacuatingActualLRPReturns(errors.New("boom"))
+					})
+
+					It("does not stop the evacuating instance", func() {
+						err = controller.StartActualLRP(ctx, logger, &actualLRPKey, &afterInstanceKey, &netInfo, internalRoutes, metricTags, routable, availabilityZone)
+						Expect(fakeRepClient.StopLRPInstanceCallCount()).To(BeZero())
+					})
+				})
+
 				It("should emit an ActualLRPChanged event and an ActualLRPRemoved event", func() {
 					err = controller.StartActualLRP(ctx, logger, &actualLRPKey, &afterInstanceKey, &netInfo, internalRoutes, metricTags, routable, availabilityZone)
 
@@ -502,6 +594,20 @@ var _ = Describe("ActualLRP Lifecycle Controller", func() {
 					Expect(actualLRPInstanceHub.EmitArgsForCall(0)).To(Equal(models.NewActualLRPInstanceChangedEvent(actualLRP, afterActualLRP, traceId)))
 					Expect(actualLRPInstanceHub.EmitArgsForCall(1)).To(Equal(models.NewActualLRPInstanceRemovedEvent(evacuating, traceId)))
 				})
+
+				Context("and the evacuated instance starts again on its drained cell", func() {
+					JustBeforeEach(func() {
+						fakeActualLRPDB.StartActualLRPReturns(nil, nil, models.ErrActualLRPCannotBeStarted)
+					})
+
+					It("keeps the evacuating lrp until its replacement starts", func() {
+						err = controller.StartActualLRP(ctx, logger, &actualLRPKey, &evacuating.ActualLRPInstanceKey, &netInfo, internalRoutes, metricTags, routable, availabilityZone)
+						Expect(err).To(MatchError(models.ErrActualLRPCannotBeStarted))
+						Expect(fakeEvacuationDB.RemoveEvacuatingActualLRPCallCount()).To(BeZero())
+						Consistently(actualLRPInstanceHub.EmitCallCount).Should(BeZero())
+						Expect(fakeRepClient.StopLRPInstanceCallCount()).To(BeZero())
+					})
+				})
 			})
 
 			Context("when the actual lrp was created", func() {
@@ -1696,4 +1802,310 @@ var _ = Describe("ActualLRP Lifecycle Controller", func() {
 			})
 		})
 	})
+
+	Describe("RestartActualLRP", func() {
+		var unclaimedLRP *models.ActualLRP
+
+		BeforeEach(func() {
+			actualLRPState = models.ActualLRPStateRunning
+			fakeServiceClient.CellByIdReturns(&models.CellPresence{RepAddress: "some-address", RepUrl: "http://some-address"}, nil)
+			desiredLRP := model_helpers.NewValidDesiredLRP(processGuid)
+			desiredLRP.Instances = 3
+			schedInfo := desiredLRP.DesiredLRPSchedulingInfo()
+			fakeDesiredLRPDB.DesiredLRPSchedulingInfoByProcessGuidReturns(&schedInfo, nil)
+		})
+
+		JustBeforeEach(func() {
+			unclaimedLRP = &models.ActualLRP{
+				ActualLRPKey: actualLRPKey,
+				State:        models.ActualLRPStateUnclaimed,
+				Since:        1140,
+			}
+			fakeActualLRPDB.ActualLRPsReturns([]*models.ActualLRP{actualLRP}, nil)
+			fakeActualLRPDB.UnclaimActualLRPReturns(actualLRP, unclaimedLRP, nil)
+		})
+
+		It("unclaims the actual lrp", func() {
+			err = controller.RestartActualLRP(ctx, logger, &actualLRPKey)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeActualLRPDB.UnclaimActualLRPCallCount()).To(Equal(1))
+			_, _, key := fakeActualLRPDB.UnclaimActualLRPArgsForCall(0)
+			Expect(key).To(Equal(&actualLRPKey))
+		})
+
+		It("stops the instance through its rep", func() {
+			err = controller.RestartActualLRP(ctx, logger, &actualLRPKey)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeServiceClient.CellByIdCallCount()).To(Equal(1))
+			_, cellID := fakeServiceClient.CellByIdArgsForCall(0)
+			Expect(cellID).To(Equal("cell-id-0"))
+
+			Expect(fakeRepClient.StopLRPInstanceCallCount()).To(Equal(1))
+			_, key, instanceKey := fakeRepClient.StopLRPInstanceArgsForCall(0)
+			Expect(key).To(Equal(actualLRPKey))
+			Expect(instanceKey).To(Equal(beforeInstanceKey))
+		})
+
+		It("requests an auction for the replacement", func() {
+			err = controller.RestartActualLRP(ctx, logger, &actualLRPKey)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeAuctioneerClient.RequestLRPAuctionsCallCount()).To(Equal(1))
+			_, _, startRequests := fakeAuctioneerClient.RequestLRPAuctionsArgsForCall(0)
+			Expect(startRequests).To(HaveLen(1))
+			Expect(startRequests[0].ProcessGuid).To(Equal(processGuid))
+			Expect(startRequests[0].Indices).To(ConsistOf(int(index)))
+		})
+
+		It("emits instance events for the replacement", func() {
+			err = controller.RestartActualLRP(ctx, logger, &actualLRPKey)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(actualLRPInstanceHub.EmitCallCount).Should(Equal(2))
+			events := []models.Event{actualLRPInstanceHub.EmitArgsForCall(0), actualLRPInstanceHub.EmitArgsForCall(1)}
+			Expect(events).To(ContainElement(models.NewActualLRPInstanceCreatedEvent(unclaimedLRP, "")))
+			Expect(events).To(ContainElement(models.NewActualLRPInstanceRemovedEvent(actualLRP, "")))
+		})
+
+		Context("when the rep cannot be reached", func() {
+			BeforeEach(func() {
+				fakeServiceClient.CellByIdReturns(nil, models.ErrResourceNotFound)
+			})
+
+			It("still requests an auction for the replacement", func() {
+				err = controller.RestartActualLRP(ctx, logger, &actualLRPKey)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeRepClient.StopLRPInstanceCallCount()).To(Equal(0))
+				Expect(fakeAuctioneerClient.RequestLRPAuctionsCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the actual lrp is crashed", func() {
+			BeforeEach(func() {
+				actualLRPState = models.ActualLRPStateCrashed
+			})
+
+			It("restarts it without stopping an instance", func() {
+				err = controller.RestartActualLRP(ctx, logger, &actualLRPKey)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeActualLRPDB.UnclaimActualLRPCallCount()).To(Equal(1))
+				Expect(fakeRepClient.StopLRPInstanceCallCount()).To(Equal(0))
+				Expect(fakeAuctioneerClient.RequestLRPAuctionsCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the actual lrp is unclaimed", func() {
+			BeforeEach(func() {
+				actualLRPState = models.ActualLRPStateUnclaimed
+			})
+
+			It("leaves it alone", func() {
+				err = controller.RestartActualLRP(ctx, logger, &actualLRPKey)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeActualLRPDB.UnclaimActualLRPCallCount()).To(Equal(0))
+				Expect(fakeAuctioneerClient.RequestLRPAuctionsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when there is no matching actual lrp", func() {
+			JustBeforeEach(func() {
+				fakeActualLRPDB.ActualLRPsReturns([]*models.ActualLRP{}, nil)
+			})
+
+			It("returns a not found error", func() {
+				err = controller.RestartActualLRP(ctx, logger, &actualLRPKey)
+				Expect(err).To(Equal(models.ErrResourceNotFound))
+				Expect(fakeActualLRPDB.UnclaimActualLRPCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when unclaiming the actual lrp fails", func() {
+			JustBeforeEach(func() {
+				fakeActualLRPDB.UnclaimActualLRPReturns(nil, nil, models.ErrUnknownError)
+			})
+
+			It("returns the error and does not stop the instance or request an auction", func() {
+				err = controller.RestartActualLRP(ctx, logger, &actualLRPKey)
+				Expect(err).To(Equal(models.ErrUnknownError))
+				Expect(fakeRepClient.StopLRPInstanceCallCount()).To(Equal(0))
+				Expect(fakeAuctioneerClient.RequestLRPAuctionsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("RestartDesiredLRP", func() {
+		var states map[int32]string
+
+		newLRP := func(index int32, state string) *models.ActualLRP {
+			lrp := &models.ActualLRP{
+				ActualLRPKey: models.NewActualLRPKey(processGuid, index, "domain-0"),
+				State:        state,
+			}
+			if state != models.ActualLRPStateUnclaimed {
+				lrp.ActualLRPInstanceKey = models.NewActualLRPInstanceKey("instance-guid", "cell-id")
+			}
+			return lrp
+		}
+
+		auctionedIndices := func(call int) []int {
+			_, _, startRequests := fakeAuctioneerClient.RequestLRPAuctionsArgsForCall(call)
+			Expect(startRequests).To(HaveLen(1))
+			return startRequests[0].Indices
+		}
+
+		BeforeEach(func() {
+			states = map[int32]string{
+				0: models.ActualLRPStateRunning,
+				1: models.ActualLRPStateRunning,
+				2: models.ActualLRPStateRunning,
+			}
+
+			fakeServiceClient.CellByIdReturns(&models.CellPresence{RepAddress: "some-address", RepUrl: "http://some-address"}, nil)
+			desiredLRP := model_helpers.NewValidDesiredLRP(processGuid)
+			desiredLRP.Instances = 3
+			schedInfo := desiredLRP.DesiredLRPSchedulingInfo()
+			fakeDesiredLRPDB.DesiredLRPSchedulingInfoByProcessGuidReturns(&schedInfo, nil)
+
+			fakeActualLRPDB.ActualLRPsStub = func(_ context.Context, _ lager.Logger, filter models.ActualLRPFilter) ([]*models.ActualLRP, error) {
+				lrps := []*models.ActualLRP{}
+				for index := int32(0); index < 3; index++ {
+					if filter.Index == nil || *filter.Index == index {
+						lrps = append(lrps, newLRP(index, states[index]))
+					}
+				}
+				return lrps, nil
+			}
+			fakeActualLRPDB.UnclaimActualLRPStub = func(_ context.Context, _ lager.Logger, key *models.ActualLRPKey) (*models.ActualLRP, *models.ActualLRP, error) {
+				before := newLRP(key.Index, states[key.Index])
+				states[key.Index] = models.ActualLRPStateUnclaimed
+				return before, newLRP(key.Index, models.ActualLRPStateUnclaimed), nil
+			}
+		})
+
+		Context("without a max in flight", func() {
+			It("restarts every instance straight away", func() {
+				err = controller.RestartDesiredLRP(ctx, logger, processGuid, 0)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeActualLRPDB.UnclaimActualLRPCallCount()).To(Equal(3))
+				Expect(fakeRepClient.StopLRPInstanceCallCount()).To(Equal(3))
+				Expect(fakeAuctioneerClient.RequestLRPAuctionsCallCount()).To(Equal(1))
+				Expect(auctionedIndices(0)).To(Equal([]int{0, 1, 2}))
+			})
+
+			Context("when an instance is already unclaimed", func() {
+				BeforeEach(func() {
+					states[1] = models.ActualLRPStateUnclaimed
+				})
+
+				It("does not auction it again", func() {
+					err = controller.RestartDesiredLRP(ctx, logger, processGuid, 0)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(auctionedIndices(0)).To(Equal([]int{0, 2}))
+				})
+			})
+		})
+
+		Context("with a max in flight", func() {
+			It("restarts the first instances before returning", func() {
+				err = controller.RestartDesiredLRP(ctx, logger, processGuid, 2)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeActualLRPDB.UnclaimActualLRPCallCount()).To(Equal(2))
+				Expect(fakeAuctioneerClient.RequestLRPAuctionsCallCount()).To(Equal(1))
+				Expect(auctionedIndices(0)).To(Equal([]int{0, 1}))
+			})
+
+			It("records the rolling restart for the starts of the restarted instances to continue", func() {
+				err = controller.RestartDesiredLRP(ctx, logger, processGuid, 2)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeDesiredLRPDB.StartRollingRestartCallCount()).To(Equal(1))
+				_, _, guid, maxInFlight := fakeDesiredLRPDB.StartRollingRestartArgsForCall(0)
+				Expect(guid).To(Equal(processGuid))
+				Expect(maxInFlight).To(BeEquivalentTo(2))
+			})
+
+			Context("when recording the rolling restart fails", func() {
+				BeforeEach(func() {
+					fakeDesiredLRPDB.StartRollingRestartReturns(errors.New("boom"))
+				})
+
+				It("returns the error without restarting any instance", func() {
+					err = controller.RestartDesiredLRP(ctx, logger, processGuid, 2)
+					Expect(err).To(MatchError("boom"))
+					Expect(fakeActualLRPDB.UnclaimActualLRPCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("without a max in flight below the number of instances", func() {
+			It("does not record a rolling restart", func() {
+				err = controller.RestartDesiredLRP(ctx, logger, processGuid, 3)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeDesiredLRPDB.StartRollingRestartCallCount()).To(Equal(0))
+				Expect(auctionedIndices(0)).To(Equal([]int{0, 1, 2}))
+			})
+		})
+
+		Context("when the desired lrp is paused", func() {
+			BeforeEach(func() {
+				desiredLRP := model_helpers.NewValidDesiredLRP(processGuid)
+				desiredLRP.Instances = 3
+				desiredLRP.Paused = true
+				schedInfo := desiredLRP.DesiredLRPSchedulingInfo()
+				fakeDesiredLRPDB.DesiredLRPSchedulingInfoByProcessGuidReturns(&schedInfo, nil)
+			})
+
+			It("refuses to restart it", func() {
+				err = controller.RestartDesiredLRP(ctx, logger, processGuid, 2)
+				Expect(err).To(HaveOccurred())
+				Expect(err.(*models.Error).Type).To(Equal(models.Error_ResourceConflict))
+				Expect(fakeDesiredLRPDB.StartRollingRestartCallCount()).To(BeZero())
+				Expect(fakeActualLRPDB.UnclaimActualLRPCallCount()).To(BeZero())
+				Expect(fakeAuctioneerClient.RequestLRPAuctionsCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the desired lrp does not exist", func() {
+			BeforeEach(func() {
+				fakeDesiredLRPDB.DesiredLRPSchedulingInfoByProcessGuidReturns(nil, models.ErrResourceNotFound)
+			})
+
+			It("returns the error", func() {
+				err = controller.RestartDesiredLRP(ctx, logger, processGuid, 0)
+				Expect(err).To(Equal(models.ErrResourceNotFound))
+				Expect(fakeActualLRPDB.UnclaimActualLRPCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("AdvanceRollingRestart", func() {
+		BeforeEach(func() {
+			desiredLRP := model_helpers.NewValidDesiredLRP(processGuid)
+			schedInfo := desiredLRP.DesiredLRPSchedulingInfo()
+			fakeDesiredLRPDB.DesiredLRPSchedulingInfoByProcessGuidReturns(&schedInfo, nil)
+		})
+
+		Context("when there are no instances to restart", func() {
+			It("does not restart any instance", func() {
+				err := controller.AdvanceRollingRestart(ctx, logger, processGuid)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeActualLRPDB.UnclaimActualLRPCallCount()).To(BeZero())
+				Expect(fakeAuctioneerClient.RequestLRPAuctionsCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when advancing the rolling restart fails", func() {
+			BeforeEach(func() {
+				fakeDesiredLRPDB.AdvanceRollingRestartReturns(nil, errors.New("boom"))
+			})
+
+			It("returns the error", func() {
+				err := controller.AdvanceRollingRestart(ctx, logger, processGuid)
+				Expect(err).To(MatchError("boom"))
+			})
+		})
+	})
 })
@@ -2,6 +2,8 @@ package controllers
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"code.cloudfoundry.org/auctioneer"
 	"code.cloudfoundry.org/bbs/db"
@@ -17,6 +19,7 @@ type EvacuationController struct {
 	actualLRPDB          db.ActualLRPDB
 	suspectLRPDB         db.SuspectDB
 	desiredLRPDB         db.DesiredLRPDB
+	cellCordonDB         db.CellCordonDB
 	auctioneerClient     auctioneer.Client
 	actualHub            events.Hub
 	actualLRPInstanceHub events.Hub
@@ -27,6 +30,7 @@ func NewEvacuationController(
 	actualLRPDB db.ActualLRPDB,
 	suspectLRPDB db.SuspectDB,
 	desiredLRPDB db.DesiredLRPDB,
+	cellCordonDB db.CellCordonDB,
 	auctioneerClient auctioneer.Client,
 	actualHub events.Hub,
 	actualLRPInstanceHub events.Hub,
@@ -36,6 +40,7 @@ func NewEvacuationController(
 		actualLRPDB:          actualLRPDB,
 		suspectLRPDB:         suspectLRPDB,
 		desiredLRPDB:         desiredLRPDB,
+		cellCordonDB:         cellCordonDB,
 		auctioneerClient:     auctioneerClient,
 		actualHub:            actualHub,
 		actualLRPInstanceHub: actualLRPInstanceHub,
@@ -383,6 +388,87 @@ func (h *EvacuationController) EvacuateStoppedActualLRP(ctx context.Context, log
 	return nil
 }
 
+// DrainCell cordons the cell and evacuates its ActualLRPs the same way the rep
+// does when the cell shuts down: running instances keep running as evacuating
+// instances until their replacements start on other cells, and claimed
+// instances are auctioned again. It returns the errors of the instances that
+// could not be evacuated.
+func (h *EvacuationController) DrainCell(ctx context.Context, logger lager.Logger, cellID string) error {
+	logger = logger.Session("drain-cell", lager.Data{"cell_id": cellID})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	err := h.cellCordonDB.StartDrainingCell(ctx, logger, cellID)
+	if err != nil {
+		logger.Error("failed-starting-drain", err)
+		return err
+	}
+
+	actualLRPs, err := h.actualLRPDB.ActualLRPs(ctx, logger, models.ActualLRPFilter{CellID: cellID})
+	if err != nil {
+		logger.Error("failed-fetching-actual-lrps", err)
+		return err
+	}
+
+	var evacuationErrs []error
+	for _, lrp := range actualLRPs {
+		if lrp.Presence != models.ActualLRP_Ordinary {
+			continue
+		}
+
+		var err error
+		switch lrp.State {
+		case models.ActualLRPStateRunning:
+			_, err = h.EvacuateRunningActualLRP(ctx, logger,
+				&lrp.ActualLRPKey,
+				&lrp.ActualLRPInstanceKey,
+				&lrp.ActualLRPNetInfo,
+				lrp.ActualLrpInternalRoutes,
+				lrp.MetricTags,
+				lrp.GetRoutable(),
+				lrp.AvailabilityZone,
+			)
+		case models.ActualLRPStateClaimed:
+			_, err = h.EvacuateClaimedActualLRP(ctx, logger, &lrp.ActualLRPKey, &lrp.ActualLRPInstanceKey)
+		default:
+			continue
+		}
+
+		// the remaining instances are still evacuated, and the drain can be
+		// started again to retry this one
+		if err != nil {
+			logger.Error("failed-evacuating-actual-lrp", err, lager.Data{"lrp_key": lrp.ActualLRPKey, "instance_key": lrp.ActualLRPInstanceKey})
+			evacuationErrs = append(evacuationErrs, fmt.Errorf("evacuating %s index %d: %w", lrp.ProcessGuid, lrp.Index, err))
+		}
+	}
+
+	return errors.Join(evacuationErrs...)
+}
+
+// ReplaceActualLRP replaces an instance with one that starts from the current
+// revision of its DesiredLRP. With surge, a running instance is evacuated and
+// keeps running until its replacement is running. Otherwise the instance is
+// unclaimed and its replacement is auctioned straight away.
+func (h *EvacuationController) ReplaceActualLRP(ctx context.Context, logger lager.Logger, lrp *models.ActualLRP, surge bool) error {
+	logger = logger.Session("replace-actual-lrp", lager.Data{"lrp_key": lrp.ActualLRPKey, "instance_key": lrp.ActualLRPInstanceKey, "surge": surge})
+
+	if surge && lrp.State == models.ActualLRPStateRunning {
+		_, err := h.EvacuateRunningActualLRP(ctx, logger,
+			&lrp.ActualLRPKey,
+			&lrp.ActualLRPInstanceKey,
+			&lrp.ActualLRPNetInfo,
+			lrp.ActualLrpInternalRoutes,
+			lrp.MetricTags,
+			lrp.GetRoutable(),
+			lrp.AvailabilityZone,
+		)
+		return err
+	}
+
+	_, err := h.EvacuateClaimedActualLRP(ctx, logger, &lrp.ActualLRPKey, &lrp.ActualLRPInstanceKey)
+	return err
+}
+
 func (h *EvacuationController) requestAuction(ctx context.Context, logger lager.Logger, lrpKey *models.ActualLRPKey) {
 	schedInfo, err := h.desiredLRPDB.DesiredLRPSchedulingInfoByProcessGuid(ctx, logger, lrpKey.ProcessGuid)
 	if err != nil {
@@ -390,7 +476,7 @@ func (h *EvacuationController) requestAuction(ctx context.Context, logger lager.
 		return
 	}
 
-	startRequest := auctioneer.NewLRPStartRequestFromSchedulingInfo(schedInfo, int(lrpKey.Index))
+	startRequest := auctioneer.NewLRPStartRequestFromSchedulingInfo(schedInfo.WithSidecarResources(), int(lrpKey.Index))
 	err = h.auctioneerClient.RequestLRPAuctions(logger, trace.RequestIdFromContext(ctx), []*auctioneer.LRPStartRequest{&startRequest})
 	if err != nil {
 		logger.Error("failed-requesting-auction", err)
@@ -13,6 +13,7 @@ import (
 	"code.cloudfoundry.org/bbs/models/test/model_helpers"
 	"code.cloudfoundry.org/bbs/serviceclient/serviceclientfakes"
 	"code.cloudfoundry.org/bbs/trace"
+	"code.cloudfoundry.org/lager/v3"
 	"code.cloudfoundry.org/lager/v3/lagertest"
 	"code.cloudfoundry.org/rep/repfakes"
 	. "github.com/onsi/ginkgo/v2"
@@ -29,6 +30,7 @@ var _ = Describe("Evacuation Controller", func() {
 		fakeDesiredLRPDB     *dbfakes.FakeDesiredLRPDB
 		fakeEvacuationDB     *dbfakes.FakeEvacuationDB
 		fakeSuspectDB        *dbfakes.FakeSuspectDB
+		fakeCellCordonDB     *dbfakes.FakeCellCordonDB
 		fakeAuctioneerClient *auctioneerfakes.FakeClient
 		actualHub            *eventfakes.FakeHub
 		actualLRPInstanceHub *eventfakes.FakeHub
@@ -43,6 +45,7 @@ var _ = Describe("Evacuation Controller", func() {
 		fakeSuspectDB = new(dbfakes.FakeSuspectDB)
 		fakeDesiredLRPDB = new(dbfakes.FakeDesiredLRPDB)
 		fakeEvacuationDB = new(dbfakes.FakeEvacuationDB)
+		fakeCellCordonDB = new(dbfakes.FakeCellCordonDB)
 		fakeAuctioneerClient = new(auctioneerfakes.FakeClient)
 		logger = lagertest.NewTestLogger("test")
 
@@ -58,6 +61,7 @@ var _ = Describe("Evacuation Controller", func() {
 			fakeActualLRPDB,
 			fakeSuspectDB,
 			fakeDesiredLRPDB,
+			fakeCellCordonDB,
 			fakeAuctioneerClient,
 			actualHub,
 			actualLRPInstanceHub,
@@ -1736,4 +1740,107 @@ var _ = Describe("Evacuation Controller", func() {
 			})
 		})
 	})
+
+	Describe("DrainCell", func() {
+		var (
+			runningLRP, claimedLRP, crashedLRP, otherCellLRP *models.ActualLRP
+		)
+
+		BeforeEach(func() {
+			runningLRP = model_helpers.NewValidActualLRP("running-guid", 0)
+			runningLRP.ActualLRPInstanceKey = models.NewActualLRPInstanceKey("running-instance", "cell-1")
+
+			claimedLRP = model_helpers.NewValidActualLRP("claimed-guid", 0)
+			claimedLRP.ActualLRPInstanceKey = models.NewActualLRPInstanceKey("claimed-instance", "cell-1")
+			claimedLRP.State = models.ActualLRPStateClaimed
+
+			crashedLRP = model_helpers.NewValidActualLRP("crashed-guid", 0)
+			crashedLRP.ActualLRPInstanceKey = models.NewActualLRPInstanceKey("crashed-instance", "cell-1")
+			crashedLRP.State = models.ActualLRPStateCrashed
+
+			otherCellLRP = model_helpers.NewValidActualLRP("other-guid", 0)
+			otherCellLRP.ActualLRPInstanceKey = models.NewActualLRPInstanceKey("other-instance", "cell-2")
+
+			allLRPs := []*models.ActualLRP{runningLRP, claimedLRP, crashedLRP, otherCellLRP}
+			fakeActualLRPDB.ActualLRPsStub = func(_ context.Context, _ lager.Logger, filter models.ActualLRPFilter) ([]*models.ActualLRP, error) {
+				lrps := []*models.ActualLRP{}
+				for _, lrp := range allLRPs {
+					if filter.CellID != "" && lrp.CellId != filter.CellID {
+						continue
+					}
+					if filter.ProcessGuid != "" && lrp.ProcessGuid != filter.ProcessGuid {
+						continue
+					}
+					lrps = append(lrps, lrp)
+				}
+				return lrps, nil
+			}
+
+			fakeEvacuationDB.EvacuateActualLRPStub = func(_ context.Context, _ lager.Logger, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey, _ *models.ActualLRPNetInfo, _ []*models.ActualLRPInternalRoute, _ map[string]string, _ bool, _ string) (*models.ActualLRP, error) {
+				evacuating := model_helpers.NewValidActualLRP(key.ProcessGuid, key.Index)
+				evacuating.ActualLRPInstanceKey = *instanceKey
+				evacuating.Presence = models.ActualLRP_Evacuating
+				return evacuating, nil
+			}
+			fakeActualLRPDB.UnclaimActualLRPStub = func(_ context.Context, _ lager.Logger, key *models.ActualLRPKey) (*models.ActualLRP, *models.ActualLRP, error) {
+				after := model_helpers.NewValidActualLRP(key.ProcessGuid, key.Index)
+				after.State = models.ActualLRPStateUnclaimed
+				after.ActualLRPInstanceKey = models.ActualLRPInstanceKey{}
+				return nil, after, nil
+			}
+			schedInfo := model_helpers.NewValidDesiredLRP("some-guid").DesiredLRPSchedulingInfo()
+			fakeDesiredLRPDB.DesiredLRPSchedulingInfoByProcessGuidReturns(&schedInfo, nil)
+		})
+
+		JustBeforeEach(func() {
+			err = controller.DrainCell(ctx, logger, "cell-1")
+		})
+
+		It("starts draining the cell", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeCellCordonDB.StartDrainingCellCallCount()).To(Equal(1))
+			_, _, cellID := fakeCellCordonDB.StartDrainingCellArgsForCall(0)
+			Expect(cellID).To(Equal("cell-1"))
+		})
+
+		It("evacuates the running instances on the cell", func() {
+			Expect(fakeEvacuationDB.EvacuateActualLRPCallCount()).To(Equal(1))
+			_, _, lrpKey, instanceKey, _, _, _, _, _ := fakeEvacuationDB.EvacuateActualLRPArgsForCall(0)
+			Expect(lrpKey).To(Equal(&runningLRP.ActualLRPKey))
+			Expect(instanceKey).To(Equal(&runningLRP.ActualLRPInstanceKey))
+		})
+
+		It("unclaims and auctions the running and claimed instances on the cell", func() {
+			Expect(fakeActualLRPDB.UnclaimActualLRPCallCount()).To(Equal(2))
+			_, _, firstKey := fakeActualLRPDB.UnclaimActualLRPArgsForCall(0)
+			_, _, secondKey := fakeActualLRPDB.UnclaimActualLRPArgsForCall(1)
+			Expect([]*models.ActualLRPKey{firstKey, secondKey}).To(ConsistOf(&runningLRP.ActualLRPKey, &claimedLRP.ActualLRPKey))
+			Expect(fakeAuctioneerClient.RequestLRPAuctionsCallCount()).To(Equal(2))
+		})
+
+		Context("when evacuating an instance fails", func() {
+			BeforeEach(func() {
+				fakeEvacuationDB.EvacuateActualLRPStub = nil
+				fakeEvacuationDB.EvacuateActualLRPReturns(nil, errors.New("boom"))
+			})
+
+			It("keeps evacuating the other instances and returns the error", func() {
+				Expect(err).To(MatchError(ContainSubstring("evacuating running-guid index 0: boom")))
+				Expect(fakeActualLRPDB.UnclaimActualLRPCallCount()).To(Equal(1))
+				_, _, key := fakeActualLRPDB.UnclaimActualLRPArgsForCall(0)
+				Expect(key).To(Equal(&claimedLRP.ActualLRPKey))
+			})
+		})
+
+		Context("when starting the drain fails", func() {
+			BeforeEach(func() {
+				fakeCellCordonDB.StartDrainingCellReturns(errors.New("boom"))
+			})
+
+			It("returns the error without evacuating anything", func() {
+				Expect(err).To(MatchError("boom"))
+				Expect(fakeActualLRPDB.ActualLRPsCallCount()).To(Equal(0))
+			})
+		})
+	})
 })
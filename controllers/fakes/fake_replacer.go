@@ -0,0 +1,120 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fakes
+
+import (
+	"context"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/controllers"
+	"code.cloudfoundry.org/bbs/models"
+	lager "code.cloudfoundry.org/lager/v3"
+)
+
+type FakeReplacer struct {
+	ReplaceActualLRPStub        func(context.Context, lager.Logger, *models.ActualLRP, bool) error
+	replaceActualLRPMutex       sync.RWMutex
+	replaceActualLRPArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ActualLRP
+		arg4 bool
+	}
+	replaceActualLRPReturns struct {
+		result1 error
+	}
+	replaceActualLRPReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeReplacer) ReplaceActualLRP(arg1 context.Context, arg2 lager.Logger, arg3 *models.ActualLRP, arg4 bool) error {
+	fake.replaceActualLRPMutex.Lock()
+	ret, specificReturn := fake.replaceActualLRPReturnsOnCall[len(fake.replaceActualLRPArgsForCall)]
+	fake.replaceActualLRPArgsForCall = append(fake.replaceActualLRPArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ActualLRP
+		arg4 bool
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ReplaceActualLRPStub
+	fakeReturns := fake.replaceActualLRPReturns
+	fake.recordInvocation("ReplaceActualLRP", []interface{}{arg1, arg2, arg3, arg4})
+	fake.replaceActualLRPMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeReplacer) ReplaceActualLRPCallCount() int {
+	fake.replaceActualLRPMutex.RLock()
+	defer fake.replaceActualLRPMutex.RUnlock()
+	return len(fake.replaceActualLRPArgsForCall)
+}
+
+func (fake *FakeReplacer) ReplaceActualLRPCalls(stub func(context.Context, lager.Logger, *models.ActualLRP, bool) error) {
+	fake.replaceActualLRPMutex.Lock()
+	defer fake.replaceActualLRPMutex.Unlock()
+	fake.ReplaceActualLRPStub = stub
+}
+
+func (fake *FakeReplacer) ReplaceActualLRPArgsForCall(i int) (context.Context, lager.Logger, *models.ActualLRP, bool) {
+	fake.replaceActualLRPMutex.RLock()
+	defer fake.replaceActualLRPMutex.RUnlock()
+	argsForCall := fake.replaceActualLRPArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeReplacer) ReplaceActualLRPReturns(result1 error) {
+	fake.replaceActualLRPMutex.Lock()
+	defer fake.replaceActualLRPMutex.Unlock()
+	fake.ReplaceActualLRPStub = nil
+	fake.replaceActualLRPReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeReplacer) ReplaceActualLRPReturnsOnCall(i int, result1 error) {
+	fake.replaceActualLRPMutex.Lock()
+	defer fake.replaceActualLRPMutex.Unlock()
+	fake.ReplaceActualLRPStub = nil
+	if fake.replaceActualLRPReturnsOnCall == nil {
+		fake.replaceActualLRPReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.replaceActualLRPReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeReplacer) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.replaceActualLRPMutex.RLock()
+	defer fake.replaceActualLRPMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeReplacer) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ controllers.Replacer = new(FakeReplacer)
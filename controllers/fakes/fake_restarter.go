@@ -0,0 +1,117 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fakes
+
+import (
+	"context"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/controllers"
+	lager "code.cloudfoundry.org/lager/v3"
+)
+
+type FakeRestarter struct {
+	AdvanceRollingRestartStub        func(context.Context, lager.Logger, string) error
+	advanceRollingRestartMutex       sync.RWMutex
+	advanceRollingRestartArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	advanceRollingRestartReturns struct {
+		result1 error
+	}
+	advanceRollingRestartReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRestarter) AdvanceRollingRestart(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
+	fake.advanceRollingRestartMutex.Lock()
+	ret, specificReturn := fake.advanceRollingRestartReturnsOnCall[len(fake.advanceRollingRestartArgsForCall)]
+	fake.advanceRollingRestartArgsForCall = append(fake.advanceRollingRestartArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.AdvanceRollingRestartStub
+	fakeReturns := fake.advanceRollingRestartReturns
+	fake.recordInvocation("AdvanceRollingRestart", []interface{}{arg1, arg2, arg3})
+	fake.advanceRollingRestartMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRestarter) AdvanceRollingRestartCallCount() int {
+	fake.advanceRollingRestartMutex.RLock()
+	defer fake.advanceRollingRestartMutex.RUnlock()
+	return len(fake.advanceRollingRestartArgsForCall)
+}
+
+func (fake *FakeRestarter) AdvanceRollingRestartCalls(stub func(context.Context, lager.Logger, string) error) {
+	fake.advanceRollingRestartMutex.Lock()
+	defer fake.advanceRollingRestartMutex.Unlock()
+	fake.AdvanceRollingRestartStub = stub
+}
+
+func (fake *FakeRestarter) AdvanceRollingRestartArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.advanceRollingRestartMutex.RLock()
+	defer fake.advanceRollingRestartMutex.RUnlock()
+	argsForCall := fake.advanceRollingRestartArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRestarter) AdvanceRollingRestartReturns(result1 error) {
+	fake.advanceRollingRestartMutex.Lock()
+	defer fake.advanceRollingRestartMutex.Unlock()
+	fake.AdvanceRollingRestartStub = nil
+	fake.advanceRollingRestartReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRestarter) AdvanceRollingRestartReturnsOnCall(i int, result1 error) {
+	fake.advanceRollingRestartMutex.Lock()
+	defer fake.advanceRollingRestartMutex.Unlock()
+	fake.AdvanceRollingRestartStub = nil
+	if fake.advanceRollingRestartReturnsOnCall == nil {
+		fake.advanceRollingRestartReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.advanceRollingRestartReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRestarter) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.advanceRollingRestartMutex.RLock()
+	defer fake.advanceRollingRestartMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeRestarter) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ controllers.Restarter = new(FakeRestarter)
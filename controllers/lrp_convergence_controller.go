@@ -25,18 +25,31 @@ type Retirer interface {
 	RetireActualLRP(ctx context.Context, logger lager.Logger, key *models.ActualLRPKey) error
 }
 
+//counterfeiter:generate -o fakes/fake_replacer.go . Replacer
+type Replacer interface {
+	ReplaceActualLRP(ctx context.Context, logger lager.Logger, lrp *models.ActualLRP, surge bool) error
+}
+
+//counterfeiter:generate -o fakes/fake_restarter.go . Restarter
+type Restarter interface {
+	AdvanceRollingRestart(ctx context.Context, logger lager.Logger, processGuid string) error
+}
+
 type LRPConvergenceController struct {
 	logger                 lager.Logger
 	clock                  clock.Clock
 	lrpDB                  db.LRPDB
 	suspectDB              db.SuspectDB
 	domainDB               db.DomainDB
+	desiredHub             events.Hub
 	actualHub              events.Hub
 	actualLRPInstanceHub   events.Hub
 	auctioneerClient       auctioneer.Client
 	serviceClient          serviceclient.ServiceClient
 	repClientFactory       rep.ClientFactory
 	retirer                Retirer
+	replacer               Replacer
+	restarter              Restarter
 	convergenceWorkersSize int
 	lrpStatMetronNotifier  metrics.LRPStatMetronNotifier
 }
@@ -47,12 +60,15 @@ func NewLRPConvergenceController(
 	db db.LRPDB,
 	suspectDB db.SuspectDB,
 	domainDB db.DomainDB,
+	desiredHub events.Hub,
 	actualHub events.Hub,
 	actualLRPInstanceHub events.Hub,
 	auctioneerClient auctioneer.Client,
 	serviceClient serviceclient.ServiceClient,
 	repClientFactory rep.ClientFactory,
 	retirer Retirer,
+	replacer Replacer,
+	restarter Restarter,
 	convergenceWorkersSize int,
 	lrpStatMetronNotifier metrics.LRPStatMetronNotifier,
 ) *LRPConvergenceController {
@@ -62,12 +78,15 @@ func NewLRPConvergenceController(
 		lrpDB:                  db,
 		suspectDB:              suspectDB,
 		domainDB:               domainDB,
+		desiredHub:             desiredHub,
 		actualHub:              actualHub,
 		actualLRPInstanceHub:   actualLRPInstanceHub,
 		auctioneerClient:       auctioneerClient,
 		serviceClient:          serviceClient,
 		repClientFactory:       repClientFactory,
 		retirer:                retirer,
+		replacer:               replacer,
+		restarter:              restarter,
 		convergenceWorkersSize: convergenceWorkersSize,
 		lrpStatMetronNotifier:  lrpStatMetronNotifier,
 	}
@@ -96,6 +115,11 @@ func (h *LRPConvergenceController) ConvergeLRPs(ctx context.Context) {
 
 	convergenceResult := h.lrpDB.ConvergeLRPs(ctx, logger, cellSet)
 
+	err = h.lrpDB.PruneActualLRPCrashes(ctx, logger)
+	if err != nil {
+		logger.Error("failed-pruning-actual-lrp-crashes", err)
+	}
+
 	events := convergenceResult.Events
 	for _, e := range events {
 		go h.actualHub.Emit(e)
@@ -106,6 +130,11 @@ func (h *LRPConvergenceController) ConvergeLRPs(ctx context.Context) {
 		go h.actualLRPInstanceHub.Emit(e)
 	}
 
+	domainEvents := convergenceResult.DomainEvents
+	for _, e := range domainEvents {
+		go h.desiredHub.Emit(e)
+	}
+
 	keysToRetire := convergenceResult.KeysToRetire
 	retireLogger := logger.WithData(lager.Data{"retiring_lrp_count": len(keysToRetire)})
 	works := []func(){}
@@ -169,7 +198,7 @@ func (h *LRPConvergenceController) ConvergeLRPs(ctx context.Context) {
 			go h.actualHub.Emit(models.NewActualLRPCreatedEvent(lrp.ToActualLRPGroup()))
 			go h.actualLRPInstanceHub.Emit(models.NewActualLRPInstanceCreatedEvent(lrp, traceId))
 
-			startRequest := auctioneer.NewLRPStartRequestFromSchedulingInfo(dereferencedKey.SchedulingInfo, int(dereferencedKey.Key.Index))
+			startRequest := auctioneer.NewLRPStartRequestFromSchedulingInfo(dereferencedKey.SchedulingInfo.WithSidecarResources(), int(dereferencedKey.Key.Index))
 			startRequestLock.Lock()
 			startRequests = append(startRequests, &startRequest)
 			startRequestLock.Unlock()
@@ -193,7 +222,7 @@ func (h *LRPConvergenceController) ConvergeLRPs(ctx context.Context) {
 				}()
 			}
 
-			startRequest := auctioneer.NewLRPStartRequestFromSchedulingInfo(dereferencedKey.SchedulingInfo, int(dereferencedKey.Key.Index))
+			startRequest := auctioneer.NewLRPStartRequestFromSchedulingInfo(dereferencedKey.SchedulingInfo.WithSidecarResources(), int(dereferencedKey.Key.Index))
 			startRequestLock.Lock()
 			startRequests = append(startRequests, &startRequest)
 			startRequestLock.Unlock()
@@ -247,7 +276,7 @@ func (h *LRPConvergenceController) ConvergeLRPs(ctx context.Context) {
 			}
 			go h.actualLRPInstanceHub.Emit(models.NewActualLRPInstanceCreatedEvent(unclaimed, traceId))
 
-			startRequest := auctioneer.NewLRPStartRequestFromSchedulingInfo(dereferencedKey.SchedulingInfo, int(dereferencedKey.Key.Index))
+			startRequest := auctioneer.NewLRPStartRequestFromSchedulingInfo(dereferencedKey.SchedulingInfo.WithSidecarResources(), int(dereferencedKey.Key.Index))
 			startRequestLock.Lock()
 			startRequests = append(startRequests, &startRequest)
 			startRequestLock.Unlock()
@@ -343,6 +372,36 @@ func (h *LRPConvergenceController) ConvergeLRPs(ctx context.Context) {
 		})
 	}
 
+	for _, lrp := range convergenceResult.LRPsToSurge {
+		lrp := lrp
+		works = append(works, func() {
+			err := h.replacer.ReplaceActualLRP(ctx, logger, lrp, true)
+			if err != nil {
+				logger.Error("failed-surging-lrp", err, lager.Data{"key": lrp.ActualLRPKey})
+			}
+		})
+	}
+
+	for _, lrp := range convergenceResult.LRPsToReplace {
+		lrp := lrp
+		works = append(works, func() {
+			err := h.replacer.ReplaceActualLRP(ctx, logger, lrp, false)
+			if err != nil {
+				logger.Error("failed-replacing-lrp", err, lager.Data{"key": lrp.ActualLRPKey})
+			}
+		})
+	}
+
+	for _, processGuid := range convergenceResult.RollingRestartProcessGuids {
+		processGuid := processGuid
+		works = append(works, func() {
+			err := h.restarter.AdvanceRollingRestart(ctx, logger, processGuid)
+			if err != nil {
+				logger.Error("failed-advancing-rolling-restart", err, lager.Data{"process_guid": processGuid})
+			}
+		})
+	}
+
 	var throttler *workpool.Throttler
 	throttler, err = workpool.NewThrottler(h.convergenceWorkersSize, works)
 	if err != nil {
@@ -36,9 +36,12 @@ var _ = Describe("LRP Convergence Controllers", func() {
 		fakeLRPDB                 *dbfakes.FakeLRPDB
 		fakeSuspectDB             *dbfakes.FakeSuspectDB
 		fakeDomainDB              *dbfakes.FakeDomainDB
+		desiredHub                *eventfakes.FakeHub
 		actualHub                 *eventfakes.FakeHub
 		actualLRPInstanceHub      *eventfakes.FakeHub
 		retirer                   *fakes.FakeRetirer
+		replacer                  *fakes.FakeReplacer
+		restarter                 *fakes.FakeRestarter
 		fakeAuctioneerClient      *auctioneerfakes.FakeClient
 		fakeLRPStatMetronNotifier *mfakes.FakeLRPStatMetronNotifier
 
@@ -74,9 +77,12 @@ var _ = Describe("LRP Convergence Controllers", func() {
 		cellSet = models.CellSet{"cell-id": &cellPresence}
 		fakeServiceClient.CellsReturns(cellSet, nil)
 
+		desiredHub = &eventfakes.FakeHub{}
 		actualHub = &eventfakes.FakeHub{}
 		actualLRPInstanceHub = &eventfakes.FakeHub{}
 		retirer = &fakes.FakeRetirer{}
+		replacer = &fakes.FakeReplacer{}
+		restarter = &fakes.FakeRestarter{}
 	})
 
 	JustBeforeEach(func() {
@@ -86,12 +92,15 @@ var _ = Describe("LRP Convergence Controllers", func() {
 			fakeLRPDB,
 			fakeSuspectDB,
 			fakeDomainDB,
+			desiredHub,
 			actualHub,
 			actualLRPInstanceHub,
 			fakeAuctioneerClient,
 			fakeServiceClient,
 			fakeRepClientFactory,
 			retirer,
+			replacer,
+			restarter,
 			2,
 			fakeLRPStatMetronNotifier,
 		)
@@ -104,6 +113,20 @@ var _ = Describe("LRP Convergence Controllers", func() {
 		Expect(actualCellSet).To(BeEquivalentTo(cellSet))
 	})
 
+	It("prunes the crash history of ActualLRPs that no longer exist", func() {
+		Expect(fakeLRPDB.PruneActualLRPCrashesCallCount()).To(Equal(1))
+	})
+
+	Context("when pruning the crash history fails", func() {
+		BeforeEach(func() {
+			fakeLRPDB.PruneActualLRPCrashesReturns(errors.New("boom"))
+		})
+
+		It("logs the error", func() {
+			Expect(logger).To(gbytes.Say("failed-pruning-actual-lrp-crashes"))
+		})
+	})
+
 	Describe("metrics", func() {
 		Context("when convergence occurs", func() {
 			BeforeEach(func() {
@@ -768,6 +791,85 @@ var _ = Describe("LRP Convergence Controllers", func() {
 		})
 	})
 
+	Context("when the db returns domain events", func() {
+		var expectedStaleEvent *models.DomainStaleEvent
+
+		BeforeEach(func() {
+			expectedStaleEvent = models.NewDomainStaleEvent("some-domain")
+			fakeLRPDB.ConvergeLRPsReturns(db.ConvergenceResult{
+				DomainEvents: []models.Event{expectedStaleEvent},
+			})
+		})
+
+		It("emits those events on the desired hub", func() {
+			Eventually(desiredHub.EmitCallCount).Should(Equal(1))
+			Expect(desiredHub.EmitArgsForCall(0)).To(Equal(expectedStaleEvent))
+		})
+	})
+
+	Context("when there are lrps to roll out", func() {
+		var surgeLRP, replaceLRP *models.ActualLRP
+
+		BeforeEach(func() {
+			surgeLRP = model_helpers.NewValidActualLRP("rolling-out", 0)
+			replaceLRP = model_helpers.NewValidActualLRP("rolling-out", 1)
+			fakeLRPDB.ConvergeLRPsReturns(db.ConvergenceResult{
+				LRPsToSurge:   []*models.ActualLRP{surgeLRP},
+				LRPsToReplace: []*models.ActualLRP{replaceLRP},
+			})
+		})
+
+		It("surges and replaces them", func() {
+			Expect(replacer.ReplaceActualLRPCallCount()).To(Equal(2))
+
+			replaced := map[int32]bool{}
+			for i := 0; i < replacer.ReplaceActualLRPCallCount(); i++ {
+				_, _, lrp, surge := replacer.ReplaceActualLRPArgsForCall(i)
+				replaced[lrp.Index] = surge
+			}
+			Expect(replaced).To(Equal(map[int32]bool{0: true, 1: false}))
+		})
+
+		Context("when replacing fails", func() {
+			BeforeEach(func() {
+				replacer.ReplaceActualLRPReturns(errors.New("boom"))
+			})
+
+			It("logs the error", func() {
+				Expect(logger).To(gbytes.Say("failed-(surging|replacing)-lrp"))
+			})
+		})
+	})
+
+	Context("when there are rolling restarts in progress", func() {
+		BeforeEach(func() {
+			fakeLRPDB.ConvergeLRPsReturns(db.ConvergenceResult{
+				RollingRestartProcessGuids: []string{"restarting-1", "restarting-2"},
+			})
+		})
+
+		It("advances them", func() {
+			Expect(restarter.AdvanceRollingRestartCallCount()).To(Equal(2))
+
+			advanced := map[string]bool{}
+			for i := 0; i < restarter.AdvanceRollingRestartCallCount(); i++ {
+				_, _, processGuid := restarter.AdvanceRollingRestartArgsForCall(i)
+				advanced[processGuid] = true
+			}
+			Expect(advanced).To(Equal(map[string]bool{"restarting-1": true, "restarting-2": true}))
+		})
+
+		Context("when advancing fails", func() {
+			BeforeEach(func() {
+				restarter.AdvanceRollingRestartReturns(errors.New("boom"))
+			})
+
+			It("logs the error", func() {
+				Expect(logger).To(gbytes.Say("failed-advancing-rolling-restart"))
+			})
+		})
+	})
+
 	Context("lrps with internal routes that needs updated", func() {
 		var (
 			actualLRPKeyWithInternalRoutes1, actualLRPKeyWithInternalRoutes2, actualLRPKeyWithInternalRoutes3 db.ActualLRPKeyWithInternalRoutes
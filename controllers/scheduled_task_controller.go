@@ -0,0 +1,180 @@
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/events"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+const ScheduledTaskPreviousRunActive = "previous run still active"
+
+//counterfeiter:generate -o fakes/fake_task_runner.go . TaskRunner
+type TaskRunner interface {
+	TaskByGuid(ctx context.Context, logger lager.Logger, taskGUID string) (*models.Task, error)
+	DesireTask(ctx context.Context, logger lager.Logger, taskDefinition *models.TaskDefinition, taskGUID, domain string) error
+	CancelTask(ctx context.Context, logger lager.Logger, taskGUID string) error
+}
+
+type ScheduledTaskController struct {
+	db         db.ScheduledTaskDB
+	taskRunner TaskRunner
+	taskHub    events.Hub
+	clock      clock.Clock
+}
+
+func NewScheduledTaskController(
+	db db.ScheduledTaskDB,
+	taskRunner TaskRunner,
+	taskHub events.Hub,
+	clock clock.Clock,
+) *ScheduledTaskController {
+	return &ScheduledTaskController{
+		db:         db,
+		taskRunner: taskRunner,
+		taskHub:    taskHub,
+		clock:      clock,
+	}
+}
+
+func (c *ScheduledTaskController) ScheduledTasks(ctx context.Context, logger lager.Logger, domain string) ([]*models.ScheduledTask, error) {
+	logger = logger.Session("scheduled-tasks")
+
+	return c.db.ScheduledTasks(ctx, logger, domain)
+}
+
+func (c *ScheduledTaskController) ScheduledTaskByGuid(ctx context.Context, logger lager.Logger, guid string) (*models.ScheduledTask, error) {
+	logger = logger.Session("scheduled-task-by-guid")
+
+	return c.db.ScheduledTaskByGuid(ctx, logger, guid)
+}
+
+func (c *ScheduledTaskController) DesireScheduledTask(ctx context.Context, logger lager.Logger, scheduledTask *models.ScheduledTask) error {
+	logger = logger.Session("desire-scheduled-task", scheduledTask.LagerData())
+
+	_, err := c.db.DesireScheduledTask(ctx, logger, scheduledTask)
+	return err
+}
+
+func (c *ScheduledTaskController) UpdateScheduledTask(ctx context.Context, logger lager.Logger, scheduledTask *models.ScheduledTask) error {
+	logger = logger.Session("update-scheduled-task", scheduledTask.LagerData())
+
+	_, err := c.db.UpdateScheduledTask(ctx, logger, scheduledTask)
+	return err
+}
+
+func (c *ScheduledTaskController) DeleteScheduledTask(ctx context.Context, logger lager.Logger, guid string) error {
+	logger = logger.Session("delete-scheduled-task", lager.Data{"guid": guid})
+
+	return c.db.DeleteScheduledTask(ctx, logger, guid)
+}
+
+// RunScheduledTasks desires a Task for every ScheduledTask whose schedule has
+// fired since its last run. Runs missed while no BBS held the lock are
+// coalesced into a single run at the most recent scheduled time.
+func (c *ScheduledTaskController) RunScheduledTasks(ctx context.Context, logger lager.Logger) error {
+	logger = logger.Session("run-scheduled-tasks")
+
+	scheduledTasks, err := c.db.ScheduledTasks(ctx, logger, "")
+	if err != nil {
+		logger.Error("failed-fetching-scheduled-tasks", err)
+		return err
+	}
+
+	now := c.clock.Now()
+	for _, scheduledTask := range scheduledTasks {
+		c.runScheduledTask(ctx, logger, scheduledTask, now)
+	}
+
+	return nil
+}
+
+func (c *ScheduledTaskController) runScheduledTask(ctx context.Context, logger lager.Logger, scheduledTask *models.ScheduledTask, now time.Time) {
+	logger = logger.WithData(lager.Data{"scheduled_task_guid": scheduledTask.Guid})
+
+	schedule, err := scheduledTask.CronSchedule()
+	if err != nil {
+		logger.Error("failed-parsing-schedule", err, lager.Data{"schedule": scheduledTask.Schedule, "time_zone": scheduledTask.TimeZone})
+		return
+	}
+
+	scheduledAt := latestScheduledTime(schedule, time.Unix(0, scheduledTask.LastScheduledAt), now)
+	if scheduledAt.IsZero() {
+		return
+	}
+
+	taskGuid := scheduledTask.RunTaskGuid(scheduledAt)
+	logger = logger.WithData(lager.Data{"task_guid": taskGuid, "scheduled_at": scheduledAt})
+
+	activeTaskGuids := c.activeRuns(ctx, logger, scheduledTask)
+	switch scheduledTask.ConcurrencyPolicy {
+	case models.ScheduledTask_Forbid:
+		if len(activeTaskGuids) > 0 {
+			logger.Info("skipping-run", lager.Data{"active_task_guids": activeTaskGuids})
+			err = c.db.RecordScheduledTaskRun(ctx, logger, scheduledTask.Guid, scheduledAt.UnixNano(), "")
+			if err != nil {
+				logger.Error("failed-recording-skipped-run", err)
+				return
+			}
+			go c.taskHub.Emit(models.NewScheduledTaskRunEvent(scheduledTask.Guid, "", scheduledAt.UnixNano(), ScheduledTaskPreviousRunActive))
+			return
+		}
+	case models.ScheduledTask_Replace:
+		for _, activeTaskGuid := range activeTaskGuids {
+			logger.Info("cancelling-previous-run", lager.Data{"active_task_guid": activeTaskGuid})
+			err = c.taskRunner.CancelTask(ctx, logger, activeTaskGuid)
+			if err != nil {
+				logger.Error("failed-cancelling-previous-run", err, lager.Data{"active_task_guid": activeTaskGuid})
+			}
+		}
+	}
+
+	logger.Info("desiring-run")
+	err = c.taskRunner.DesireTask(ctx, logger, scheduledTask.TaskDefinition, taskGuid, scheduledTask.Domain)
+	if err != nil && !models.ErrResourceExists.Equal(err) {
+		logger.Error("failed-desiring-run", err)
+		return
+	}
+
+	err = c.db.RecordScheduledTaskRun(ctx, logger, scheduledTask.Guid, scheduledAt.UnixNano(), taskGuid)
+	if err != nil {
+		logger.Error("failed-recording-run", err)
+		return
+	}
+
+	go c.taskHub.Emit(models.NewScheduledTaskRunEvent(scheduledTask.Guid, taskGuid, scheduledAt.UnixNano(), ""))
+}
+
+// activeRuns returns the guids of previous runs whose Tasks have not yet
+// completed.
+func (c *ScheduledTaskController) activeRuns(ctx context.Context, logger lager.Logger, scheduledTask *models.ScheduledTask) []string {
+	activeTaskGuids := []string{}
+	for _, run := range scheduledTask.Runs {
+		task, err := c.taskRunner.TaskByGuid(ctx, logger, run.TaskGuid)
+		if err != nil {
+			if !models.ErrResourceNotFound.Equal(err) {
+				logger.Error("failed-fetching-previous-run", err, lager.Data{"previous_task_guid": run.TaskGuid})
+			}
+			continue
+		}
+
+		if task.IsActive() {
+			activeTaskGuids = append(activeTaskGuids, run.TaskGuid)
+		}
+	}
+	return activeTaskGuids
+}
+
+// latestScheduledTime returns the most recent time in (after, now] at which
+// the schedule fires, or the zero time if it has not fired since after.
+func latestScheduledTime(schedule *models.CronSchedule, after, now time.Time) time.Time {
+	latest := time.Time{}
+	for next := schedule.Next(after); !next.IsZero() && !next.After(now); next = schedule.Next(next) {
+		latest = next
+	}
+	return latest
+}
@@ -0,0 +1,232 @@
+package controllers_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/bbs/controllers"
+	"code.cloudfoundry.org/bbs/controllers/fakes"
+	"code.cloudfoundry.org/bbs/db/dbfakes"
+	"code.cloudfoundry.org/bbs/events/eventfakes"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ScheduledTask Controller", func() {
+	var (
+		logger               *lagertest.TestLogger
+		fakeScheduledTaskDB  *dbfakes.FakeScheduledTaskDB
+		fakeTaskRunner       *fakes.FakeTaskRunner
+		taskHub              *eventfakes.FakeHub
+		fakeClock            *fakeclock.FakeClock
+		controller           *controllers.ScheduledTaskController
+		scheduledTask        *models.ScheduledTask
+		lastScheduledAt, now time.Time
+		err                  error
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		fakeScheduledTaskDB = new(dbfakes.FakeScheduledTaskDB)
+		fakeTaskRunner = new(fakes.FakeTaskRunner)
+		taskHub = &eventfakes.FakeHub{}
+
+		lastScheduledAt = time.Date(2024, time.March, 15, 10, 0, 0, 0, time.UTC)
+		now = lastScheduledAt.Add(90 * time.Second)
+		fakeClock = fakeclock.NewFakeClock(now)
+
+		scheduledTask = &models.ScheduledTask{
+			Guid:            "scheduled-guid",
+			Domain:          "some-domain",
+			Schedule:        "* * * * *",
+			TaskDefinition:  model_helpers.NewValidTaskDefinition(),
+			LastScheduledAt: lastScheduledAt.UnixNano(),
+		}
+		fakeScheduledTaskDB.ScheduledTasksReturns([]*models.ScheduledTask{scheduledTask}, nil)
+
+		controller = controllers.NewScheduledTaskController(fakeScheduledTaskDB, fakeTaskRunner, taskHub, fakeClock)
+	})
+
+	Describe("DesireScheduledTask", func() {
+		It("desires the scheduled task in the DB", func() {
+			err = controller.DesireScheduledTask(ctx, logger, scheduledTask)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeScheduledTaskDB.DesireScheduledTaskCallCount()).To(Equal(1))
+			_, _, actual := fakeScheduledTaskDB.DesireScheduledTaskArgsForCall(0)
+			Expect(actual).To(Equal(scheduledTask))
+		})
+
+		It("returns DB errors", func() {
+			fakeScheduledTaskDB.DesireScheduledTaskReturns(nil, models.ErrResourceExists)
+			err = controller.DesireScheduledTask(ctx, logger, scheduledTask)
+			Expect(err).To(Equal(models.ErrResourceExists))
+		})
+	})
+
+	Describe("RunScheduledTasks", func() {
+		JustBeforeEach(func() {
+			err = controller.RunScheduledTasks(ctx, logger)
+		})
+
+		Context("when fetching the scheduled tasks fails", func() {
+			BeforeEach(func() {
+				fakeScheduledTaskDB.ScheduledTasksReturns(nil, errors.New("boom"))
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(MatchError("boom"))
+			})
+		})
+
+		It("fetches scheduled tasks across all domains", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeScheduledTaskDB.ScheduledTasksCallCount()).To(Equal(1))
+			_, _, domain := fakeScheduledTaskDB.ScheduledTasksArgsForCall(0)
+			Expect(domain).To(BeEmpty())
+		})
+
+		Context("when the schedule has not fired since the last run", func() {
+			BeforeEach(func() {
+				scheduledTask.Schedule = "30 * * * *"
+			})
+
+			It("does not desire a task", func() {
+				Expect(fakeTaskRunner.DesireTaskCallCount()).To(Equal(0))
+				Expect(fakeScheduledTaskDB.RecordScheduledTaskRunCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the schedule has fired", func() {
+			var scheduledAt time.Time
+
+			BeforeEach(func() {
+				scheduledAt = lastScheduledAt.Add(time.Minute)
+			})
+
+			It("desires a task with a guid derived from the scheduled time", func() {
+				Expect(fakeTaskRunner.DesireTaskCallCount()).To(Equal(1))
+				_, _, taskDef, taskGuid, domain := fakeTaskRunner.DesireTaskArgsForCall(0)
+				Expect(taskDef).To(Equal(scheduledTask.TaskDefinition))
+				Expect(taskGuid).To(Equal(scheduledTask.RunTaskGuid(scheduledAt)))
+				Expect(domain).To(Equal("some-domain"))
+			})
+
+			It("records the run", func() {
+				Expect(fakeScheduledTaskDB.RecordScheduledTaskRunCallCount()).To(Equal(1))
+				_, _, guid, recordedAt, taskGuid := fakeScheduledTaskDB.RecordScheduledTaskRunArgsForCall(0)
+				Expect(guid).To(Equal("scheduled-guid"))
+				Expect(recordedAt).To(Equal(scheduledAt.UnixNano()))
+				Expect(taskGuid).To(Equal(scheduledTask.RunTaskGuid(scheduledAt)))
+			})
+
+			It("emits a scheduled task run event", func() {
+				Eventually(taskHub.EmitCallCount).Should(Equal(1))
+				event := taskHub.EmitArgsForCall(0)
+				Expect(event).To(Equal(models.NewScheduledTaskRunEvent("scheduled-guid", scheduledTask.RunTaskGuid(scheduledAt), scheduledAt.UnixNano(), "")))
+			})
+
+			Context("when several runs were missed", func() {
+				BeforeEach(func() {
+					fakeClock.Increment(5 * time.Minute)
+					scheduledAt = lastScheduledAt.Add(6 * time.Minute)
+				})
+
+				It("coalesces them into a single run at the latest scheduled time", func() {
+					Expect(fakeTaskRunner.DesireTaskCallCount()).To(Equal(1))
+					_, _, _, taskGuid, _ := fakeTaskRunner.DesireTaskArgsForCall(0)
+					Expect(taskGuid).To(Equal(scheduledTask.RunTaskGuid(scheduledAt)))
+				})
+			})
+
+			Context("when the task already exists", func() {
+				BeforeEach(func() {
+					fakeTaskRunner.DesireTaskReturns(models.ErrResourceExists)
+				})
+
+				It("still records the run", func() {
+					Expect(fakeScheduledTaskDB.RecordScheduledTaskRunCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when desiring the task fails", func() {
+				BeforeEach(func() {
+					fakeTaskRunner.DesireTaskReturns(errors.New("boom"))
+				})
+
+				It("does not record the run", func() {
+					Expect(fakeScheduledTaskDB.RecordScheduledTaskRunCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when a previous run is still active", func() {
+				BeforeEach(func() {
+					scheduledTask.Runs = []*models.ScheduledTaskRun{
+						{TaskGuid: "previous-task-guid", ScheduledAt: lastScheduledAt.UnixNano()},
+					}
+					fakeTaskRunner.TaskByGuidReturns(&models.Task{TaskGuid: "previous-task-guid", State: models.Task_Running}, nil)
+				})
+
+				Context("and the concurrency policy is Allow", func() {
+					It("desires the next run alongside it", func() {
+						Expect(fakeTaskRunner.CancelTaskCallCount()).To(Equal(0))
+						Expect(fakeTaskRunner.DesireTaskCallCount()).To(Equal(1))
+					})
+				})
+
+				Context("and the concurrency policy is Forbid", func() {
+					BeforeEach(func() {
+						scheduledTask.ConcurrencyPolicy = models.ScheduledTask_Forbid
+					})
+
+					It("skips the run", func() {
+						Expect(fakeTaskRunner.DesireTaskCallCount()).To(Equal(0))
+
+						Expect(fakeScheduledTaskDB.RecordScheduledTaskRunCallCount()).To(Equal(1))
+						_, _, _, recordedAt, taskGuid := fakeScheduledTaskDB.RecordScheduledTaskRunArgsForCall(0)
+						Expect(recordedAt).To(Equal(scheduledAt.UnixNano()))
+						Expect(taskGuid).To(BeEmpty())
+					})
+
+					It("emits an event with the skip reason", func() {
+						Eventually(taskHub.EmitCallCount).Should(Equal(1))
+						event := taskHub.EmitArgsForCall(0).(*models.ScheduledTaskRunEvent)
+						Expect(event.SkipReason).To(Equal(controllers.ScheduledTaskPreviousRunActive))
+					})
+				})
+
+				Context("and the concurrency policy is Replace", func() {
+					BeforeEach(func() {
+						scheduledTask.ConcurrencyPolicy = models.ScheduledTask_Replace
+					})
+
+					It("cancels the previous run and desires the next one", func() {
+						Expect(fakeTaskRunner.CancelTaskCallCount()).To(Equal(1))
+						_, _, taskGuid := fakeTaskRunner.CancelTaskArgsForCall(0)
+						Expect(taskGuid).To(Equal("previous-task-guid"))
+
+						Expect(fakeTaskRunner.DesireTaskCallCount()).To(Equal(1))
+					})
+				})
+			})
+
+			Context("when the previous run has completed", func() {
+				BeforeEach(func() {
+					scheduledTask.ConcurrencyPolicy = models.ScheduledTask_Forbid
+					scheduledTask.Runs = []*models.ScheduledTaskRun{
+						{TaskGuid: "previous-task-guid", ScheduledAt: lastScheduledAt.UnixNano()},
+					}
+					fakeTaskRunner.TaskByGuidReturns(&models.Task{TaskGuid: "previous-task-guid", State: models.Task_Completed}, nil)
+				})
+
+				It("desires the next run", func() {
+					Expect(fakeTaskRunner.DesireTaskCallCount()).To(Equal(1))
+				})
+			})
+		})
+	})
+})
@@ -14,6 +14,7 @@ import (
 	"code.cloudfoundry.org/bbs/trace"
 	"code.cloudfoundry.org/lager/v3"
 	"code.cloudfoundry.org/rep"
+	"code.cloudfoundry.org/workpool"
 )
 
 type TaskController struct {
@@ -25,6 +26,24 @@ type TaskController struct {
 	taskHub                events.Hub
 	taskStatMetronNotifier metrics.TaskStatMetronNotifier
 	maxRetries             int
+	config                 TaskControllerConfig
+}
+
+// TaskControllerConfig holds the settings of a TaskController used by task
+// convergence.
+type TaskControllerConfig struct {
+	// ConvergenceWorkers is the number of tasks cancelled on their cells at
+	// the same time.
+	ConvergenceWorkers int
+	// DefaultMaxRunDurations is the maximum run duration of the tasks of a
+	// domain that do not set their own.
+	DefaultMaxRunDurations map[string]time.Duration
+	// MaxAuctionsPerCycle caps the number of tasks auctioned by a single
+	// convergence. It is unlimited when 0.
+	MaxAuctionsPerCycle int
+	// TaskArchiveRetention is how long archived tasks are kept. They are kept
+	// forever when it is 0.
+	TaskArchiveRetention time.Duration
 }
 
 func NewTaskController(
@@ -36,6 +55,7 @@ func NewTaskController(
 	taskHub events.Hub,
 	taskStatMetronNotifier metrics.TaskStatMetronNotifier,
 	maxRetries int,
+	config TaskControllerConfig,
 ) *TaskController {
 	return &TaskController{
 		db:                     db,
@@ -46,13 +66,14 @@ func NewTaskController(
 		taskHub:                taskHub,
 		taskStatMetronNotifier: taskStatMetronNotifier,
 		maxRetries:             maxRetries,
+		config:                 config,
 	}
 }
 
-func (c *TaskController) Tasks(ctx context.Context, logger lager.Logger, domain, cellID string) ([]*models.Task, error) {
+func (c *TaskController) Tasks(ctx context.Context, logger lager.Logger, domain, cellID string, includeResults bool) ([]*models.Task, error) {
 	logger = logger.Session("tasks")
 
-	filter := models.TaskFilter{Domain: domain, CellID: cellID}
+	filter := models.TaskFilter{Domain: domain, CellID: cellID, IncludeResults: includeResults}
 	return c.db.Tasks(ctx, logger, filter)
 }
 
@@ -62,6 +83,12 @@ func (c *TaskController) TaskByGuid(ctx context.Context, logger lager.Logger, ta
 	return c.db.TaskByGuid(ctx, logger, taskGUID)
 }
 
+func (c *TaskController) TaskResult(ctx context.Context, logger lager.Logger, taskGUID string) (string, error) {
+	logger = logger.Session("task-result")
+
+	return c.db.TaskResult(ctx, logger, taskGUID)
+}
+
 func (c *TaskController) DesireTask(ctx context.Context, logger lager.Logger, taskDefinition *models.TaskDefinition, taskGUID, domain string) error {
 	var err error
 	var task *models.Task
@@ -69,12 +96,25 @@ func (c *TaskController) DesireTask(ctx context.Context, logger lager.Logger, ta
 
 	logger = logger.WithData(lager.Data{"task_guid": taskGUID})
 
+	err = c.taskCompletionClient.ValidateCallbackURL(taskDefinition.CompletionCallbackUrl)
+	if err != nil {
+		logger.Error("invalid-completion-callback-url", err, lager.Data{"callback_url": taskDefinition.CompletionCallbackUrl})
+		return models.NewError(models.Error_InvalidRequest, err.Error())
+	}
+
 	task, err = c.db.DesireTask(ctx, logger, taskDefinition, taskGUID, domain)
 	if err != nil {
 		return err
 	}
 	go c.taskHub.Emit(models.NewTaskCreatedEvent(task))
 
+	if task.State == models.Task_Blocked {
+		logger.Debug("task-blocked-on-dependencies", lager.Data{"depends_on": taskDefinition.DependsOn})
+		// the dependencies may already have finished
+		c.releaseBlockedTasks(ctx, logger, taskGUID)
+		return nil
+	}
+
 	logger.Debug("start-task-auction-request")
 	taskStartRequest := auctioneer.NewTaskStartRequestFromModel(taskGUID, domain, taskDefinition)
 	err = c.auctioneerClient.RequestTaskAuctions(logger, trace.RequestIdFromContext(ctx), []*auctioneer.TaskStartRequest{&taskStartRequest})
@@ -88,6 +128,107 @@ func (c *TaskController) DesireTask(ctx context.Context, logger lager.Logger, ta
 	return nil
 }
 
+func (c *TaskController) DesireTaskArray(ctx context.Context, logger lager.Logger, taskDefinition *models.TaskDefinition, arrayGUID, domain string, count int32) error {
+	logger = logger.Session("desire-task-array", lager.Data{"array_guid": arrayGUID, "count": count})
+
+	err := c.taskCompletionClient.ValidateCallbackURL(taskDefinition.CompletionCallbackUrl)
+	if err != nil {
+		logger.Error("invalid-completion-callback-url", err, lager.Data{"callback_url": taskDefinition.CompletionCallbackUrl})
+		return models.NewError(models.Error_InvalidRequest, err.Error())
+	}
+
+	tasks, err := c.db.DesireTaskArray(ctx, logger, taskDefinition, arrayGUID, domain, count)
+	if err != nil {
+		return err
+	}
+
+	taskStartRequests := []*auctioneer.TaskStartRequest{}
+	blockedTaskGUIDs := []string{}
+	for _, task := range tasks {
+		go c.taskHub.Emit(models.NewTaskCreatedEvent(task))
+
+		if task.State == models.Task_Pending {
+			taskStartRequest := auctioneer.NewTaskStartRequestFromModel(task.TaskGuid, task.Domain, task.TaskDefinition)
+			taskStartRequests = append(taskStartRequests, &taskStartRequest)
+		} else {
+			blockedTaskGUIDs = append(blockedTaskGUIDs, task.TaskGuid)
+		}
+	}
+
+	if len(blockedTaskGUIDs) > 0 {
+		logger.Debug("task-array-blocked-on-dependencies", lager.Data{"depends_on": taskDefinition.DependsOn})
+		// the dependencies may already have finished
+		c.releaseBlockedTasks(ctx, logger, blockedTaskGUIDs...)
+	}
+
+	if len(taskStartRequests) == 0 {
+		return nil
+	}
+
+	logger.Debug("start-task-auction-request", lager.Data{"num_tasks": len(taskStartRequests)})
+	err = c.auctioneerClient.RequestTaskAuctions(logger, trace.RequestIdFromContext(ctx), taskStartRequests)
+	if err != nil {
+		logger.Error("failed-requesting-task-auction", err)
+		// The creation succeeded, the auction request error can be dropped
+	} else {
+		logger.Debug("succeeded-requesting-task-auction")
+	}
+
+	return nil
+}
+
+func (c *TaskController) TaskArrayStatus(ctx context.Context, logger lager.Logger, arrayGUID string) (*models.TaskArrayStatus, error) {
+	logger = logger.Session("task-array-status", lager.Data{"array_guid": arrayGUID})
+
+	tasks, err := c.db.TasksByArrayGuid(ctx, logger, arrayGUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tasks) == 0 {
+		return nil, models.ErrResourceNotFound
+	}
+
+	return models.NewTaskArrayStatus(arrayGUID, tasks), nil
+}
+
+// CancelTaskArray cancels every Task of the array that has not yet completed.
+// A failure to cancel one Task does not prevent the others from being
+// cancelled; the first error encountered is returned.
+func (c *TaskController) CancelTaskArray(ctx context.Context, logger lager.Logger, arrayGUID string) error {
+	logger = logger.Session("cancel-task-array", lager.Data{"array_guid": arrayGUID})
+
+	tasks, err := c.db.TasksByArrayGuid(ctx, logger, arrayGUID)
+	if err != nil {
+		return err
+	}
+
+	if len(tasks) == 0 {
+		return models.ErrResourceNotFound
+	}
+
+	var cancelErr error
+	for _, task := range tasks {
+		if !task.IsActive() {
+			continue
+		}
+
+		err = c.CancelTask(ctx, logger, task.TaskGuid)
+		if err != nil {
+			if models.ConvertError(err).Type == models.Error_InvalidStateTransition {
+				// the task completed since it was listed
+				continue
+			}
+			logger.Error("failed-cancelling-task", err, lager.Data{"task_guid": task.TaskGuid})
+			if cancelErr == nil {
+				cancelErr = err
+			}
+		}
+	}
+
+	return cancelErr
+}
+
 func (c *TaskController) StartTask(ctx context.Context, logger lager.Logger, taskGUID, cellID string) (shouldStart bool, err error) {
 	logger = logger.Session("start-task", lager.Data{"task_guid": taskGUID, "cell_id": cellID})
 	before, after, shouldStart, err := c.db.StartTask(ctx, logger, taskGUID, cellID)
@@ -95,6 +236,19 @@ func (c *TaskController) StartTask(ctx context.Context, logger lager.Logger, tas
 		go c.taskHub.Emit(models.NewTaskChangedEvent(before, after))
 		c.taskStatMetronNotifier.RecordTaskStarted(cellID)
 	}
+
+	// the task is still pending when the cell it was placed on is cordoned,
+	// so it is auctioned again straight away rather than on the next kick
+	if err == nil && !shouldStart && after != nil && after.State == models.Task_Pending {
+		logger.Info("task-refused-by-cordoned-cell")
+		taskStartRequest := auctioneer.NewTaskStartRequestFromModel(after.TaskGuid, after.Domain, after.TaskDefinition)
+		err := c.auctioneerClient.RequestTaskAuctions(logger, trace.RequestIdFromContext(ctx), []*auctioneer.TaskStartRequest{&taskStartRequest})
+		if err != nil {
+			logger.Error("failed-requesting-task-auction", err)
+			// convergence will auction the task again
+		}
+	}
+
 	return shouldStart, err
 }
 
@@ -107,6 +261,8 @@ func (c *TaskController) CancelTask(ctx context.Context, logger lager.Logger, ta
 	}
 	go c.taskHub.Emit(models.NewTaskChangedEvent(before, after))
 
+	c.releaseBlockedTasks(ctx, logger, taskGUID)
+
 	if after.CompletionCallbackUrl != "" {
 		logger.Info("task-client-completing-task")
 		go c.taskCompletionClient.Submit(c.db, c.taskHub, after)
@@ -125,6 +281,10 @@ func (c *TaskController) CancelTask(ctx context.Context, logger lager.Logger, ta
 	}
 	logger.Info("finished-check-cell-presence", lager.Data{"cell_id": cellID})
 
+	return c.cancelTaskOnCell(ctx, logger, taskGUID, cellPresence)
+}
+
+func (c *TaskController) cancelTaskOnCell(ctx context.Context, logger lager.Logger, taskGUID string, cellPresence *models.CellPresence) error {
 	repClient, err := c.repClientFactory.CreateClient(cellPresence.RepAddress, cellPresence.RepUrl, trace.RequestIdFromContext(ctx))
 	if err != nil {
 		logger.Error("create-rep-client-failed", err)
@@ -151,6 +311,8 @@ func (c *TaskController) FailTask(ctx context.Context, logger lager.Logger, task
 
 	go c.taskHub.Emit(models.NewTaskChangedEvent(before, after))
 
+	c.releaseBlockedTasks(ctx, logger, taskGUID)
+
 	if after.CompletionCallbackUrl != "" {
 		logger.Info("task-client-completing-task")
 		go c.taskCompletionClient.Submit(c.db, c.taskHub, after)
@@ -203,12 +365,20 @@ func (c *TaskController) CompleteTask(
 	}
 	go c.taskHub.Emit(models.NewTaskChangedEvent(before, after))
 
+	// the task's retry policy moved it back to pending instead of completing it
+	if len(after.GetAttempts()) > len(before.GetAttempts()) {
+		c.retryTask(ctx, logger, after)
+		return nil
+	}
+
 	if failed {
 		c.taskStatMetronNotifier.RecordTaskFailed(cellID)
 	} else {
 		c.taskStatMetronNotifier.RecordTaskSucceeded(cellID)
 	}
 
+	c.releaseBlockedTasks(ctx, logger, taskGUID)
+
 	if after.CompletionCallbackUrl != "" {
 		logger.Info("task-client-completing-task")
 		go c.taskCompletionClient.Submit(c.db, c.taskHub, after)
@@ -217,6 +387,24 @@ func (c *TaskController) CompleteTask(
 	return nil
 }
 
+// retryTask requests an auction for a failed task that its retry policy has
+// moved back to pending. Tasks with a retry backoff are auctioned by
+// convergence once the backoff has elapsed.
+func (c *TaskController) retryTask(ctx context.Context, logger lager.Logger, task *models.Task) {
+	logger = logger.Session("retry-task", lager.Data{"task_guid": task.TaskGuid, "attempts": len(task.Attempts)})
+	if task.RetryAt > task.UpdatedAt {
+		logger.Info("waiting-for-retry-backoff", lager.Data{"retry_at": task.RetryAt})
+		return
+	}
+
+	taskStartRequest := auctioneer.NewTaskStartRequestFromModel(task.TaskGuid, task.Domain, task.TaskDefinition)
+	err := c.auctioneerClient.RequestTaskAuctions(logger, trace.RequestIdFromContext(ctx), []*auctioneer.TaskStartRequest{&taskStartRequest})
+	if err != nil {
+		logger.Error("failed-requesting-task-auction", err)
+		// convergence will auction the task again
+	}
+}
+
 func (c *TaskController) ResolvingTask(ctx context.Context, logger lager.Logger, taskGUID string) error {
 	logger = logger.Session("resolving-task")
 
@@ -270,6 +458,8 @@ func (c *TaskController) ConvergeTasks(
 		kickTaskDuration,
 		expirePendingTaskDuration,
 		expireCompletedTaskDuration,
+		c.config.DefaultMaxRunDurations,
+		c.config.TaskArchiveRetention,
 	)
 
 	c.taskStatMetronNotifier.RecordTaskCounts(
@@ -288,20 +478,31 @@ func (c *TaskController) ConvergeTasks(
 		go c.taskHub.Emit(event)
 	}
 
-	if len(taskConvergenceResult.TasksToAuction) > 0 {
-		logger.Debug("requesting-task-auctions", lager.Data{"num_tasks_to_auction": len(taskConvergenceResult.TasksToAuction)})
-		err = c.auctioneerClient.RequestTaskAuctions(logger, trace.RequestIdFromContext(ctx), taskConvergenceResult.TasksToAuction)
+	// tasks to auction are ordered by priority, so the tasks left over by the
+	// cap are the least urgent ones; they stay pending and are auctioned by a
+	// later convergence
+	tasksToAuction := taskConvergenceResult.TasksToAuction
+	if c.config.MaxAuctionsPerCycle > 0 && len(tasksToAuction) > c.config.MaxAuctionsPerCycle {
+		logger.Info("deferring-task-auctions", lager.Data{"num_tasks_deferred": len(tasksToAuction) - c.config.MaxAuctionsPerCycle})
+		tasksToAuction = tasksToAuction[:c.config.MaxAuctionsPerCycle]
+	}
+
+	if len(tasksToAuction) > 0 {
+		logger.Debug("requesting-task-auctions", lager.Data{"num_tasks_to_auction": len(tasksToAuction)})
+		err = c.auctioneerClient.RequestTaskAuctions(logger, trace.RequestIdFromContext(ctx), tasksToAuction)
 		if err != nil {
-			taskGuids := make([]string, len(taskConvergenceResult.TasksToAuction))
-			for i, task := range taskConvergenceResult.TasksToAuction {
+			taskGuids := make([]string, len(tasksToAuction))
+			for i, task := range tasksToAuction {
 				taskGuids[i] = task.TaskGuid
 			}
 			logger.Error("failed-to-request-auctions-for-pending-tasks", err,
 				lager.Data{"task_guids": taskGuids})
 		}
-		logger.Debug("done-requesting-task-auctions", lager.Data{"num_tasks_to_auction": len(taskConvergenceResult.TasksToAuction)})
+		logger.Debug("done-requesting-task-auctions", lager.Data{"num_tasks_to_auction": len(tasksToAuction)})
 	}
 
+	c.cancelTasksOnCells(ctx, logger, taskConvergenceResult.TasksToCancel, cellSet)
+
 	logger.Debug("submitting-tasks-to-be-completed", lager.Data{"num_tasks_to_complete": len(taskConvergenceResult.TasksToComplete)})
 	for _, task := range taskConvergenceResult.TasksToComplete {
 		c.taskCompletionClient.Submit(c.db, c.taskHub, task)
@@ -310,3 +511,74 @@ func (c *TaskController) ConvergeTasks(
 
 	return nil
 }
+
+// cancelTasksOnCells cancels the given tasks on the cells they are running on.
+func (c *TaskController) cancelTasksOnCells(ctx context.Context, logger lager.Logger, tasks []*models.Task, cellSet models.CellSet) {
+	works := []func(){}
+	for _, task := range tasks {
+		cellPresence, ok := cellSet[task.CellId]
+		if !ok {
+			// the cell has disappeared along with the task
+			continue
+		}
+
+		taskGUID := task.TaskGuid
+		works = append(works, func() {
+			// errors are logged, and the rep will converge later
+			_ = c.cancelTaskOnCell(ctx, logger, taskGUID, cellPresence)
+		})
+	}
+
+	if len(works) == 0 {
+		return
+	}
+
+	throttler, err := workpool.NewThrottler(c.config.ConvergenceWorkers, works)
+	if err != nil {
+		logger.Error("failed-constructing-throttler", err, lager.Data{"max_workers": c.config.ConvergenceWorkers, "num_works": len(works)})
+		return
+	}
+
+	logger.Debug("cancelling-tasks", lager.Data{"num_tasks_to_cancel": len(works)})
+	throttler.Work()
+	logger.Debug("done-cancelling-tasks", lager.Data{"num_tasks_to_cancel": len(works)})
+}
+
+// releaseBlockedTasks releases the given blocked tasks, and the blocked tasks
+// depending on them, once their dependencies have finished.
+func (c *TaskController) releaseBlockedTasks(ctx context.Context, logger lager.Logger, taskGUIDs ...string) {
+	released, failed, err := c.db.ReleaseBlockedTasks(ctx, logger, taskGUIDs...)
+	if err != nil {
+		logger.Error("failed-releasing-blocked-tasks", err)
+		// don't return an error, convergence will release the tasks later
+		return
+	}
+
+	for _, change := range failed {
+		go c.taskHub.Emit(models.NewTaskChangedEvent(change.Before, change.After))
+
+		if change.After.CompletionCallbackUrl != "" {
+			logger.Info("task-client-completing-task", lager.Data{"task_guid": change.After.TaskGuid})
+			go c.taskCompletionClient.Submit(c.db, c.taskHub, change.After)
+		}
+	}
+
+	if len(released) == 0 {
+		return
+	}
+
+	taskStartRequests := make([]*auctioneer.TaskStartRequest, 0, len(released))
+	for _, change := range released {
+		go c.taskHub.Emit(models.NewTaskChangedEvent(change.Before, change.After))
+
+		taskStartRequest := auctioneer.NewTaskStartRequestFromModel(change.After.TaskGuid, change.After.Domain, change.After.TaskDefinition)
+		taskStartRequests = append(taskStartRequests, &taskStartRequest)
+	}
+
+	logger.Debug("requesting-task-auctions-for-released-tasks", lager.Data{"num_tasks_to_auction": len(taskStartRequests)})
+	err = c.auctioneerClient.RequestTaskAuctions(logger, trace.RequestIdFromContext(ctx), taskStartRequests)
+	if err != nil {
+		logger.Error("failed-requesting-task-auctions-for-released-tasks", err)
+		// convergence will kick the pending tasks later
+	}
+}
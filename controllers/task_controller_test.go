@@ -14,6 +14,7 @@ import (
 	"code.cloudfoundry.org/bbs/metrics/fakes"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	"code.cloudfoundry.org/bbs/taskworkpool"
 	"code.cloudfoundry.org/bbs/taskworkpool/taskworkpoolfakes"
 	"code.cloudfoundry.org/bbs/trace"
 	"code.cloudfoundry.org/lager/v3/lagertest"
@@ -31,6 +32,7 @@ var _ = Describe("Task Controller", func() {
 		fakeTaskCompletionClient *taskworkpoolfakes.FakeTaskCompletionClient
 		taskHub                  *eventfakes.FakeHub
 		maxPlacementRetries      int
+		config                   controllers.TaskControllerConfig
 
 		controller           *controllers.TaskController
 		fakeTaskStatNotifier *fakes.FakeTaskStatMetronNotifier
@@ -48,6 +50,11 @@ var _ = Describe("Task Controller", func() {
 
 		taskHub = &eventfakes.FakeHub{}
 		maxPlacementRetries = 0
+		config = controllers.TaskControllerConfig{
+			ConvergenceWorkers:     10,
+			DefaultMaxRunDurations: map[string]time.Duration{"some-domain": time.Hour},
+			TaskArchiveRetention:   24 * time.Hour,
+		}
 	})
 
 	JustBeforeEach(func() {
@@ -60,12 +67,14 @@ var _ = Describe("Task Controller", func() {
 			taskHub,
 			fakeTaskStatNotifier,
 			maxPlacementRetries,
+			config,
 		)
 	})
 
 	Describe("Tasks", func() {
 		var (
 			domain, cellId string
+			includeResults bool
 			task1          models.Task
 			task2          models.Task
 			actualTasks    []*models.Task
@@ -76,10 +85,11 @@ var _ = Describe("Task Controller", func() {
 			task2 = models.Task{CellId: "cell-id"}
 			domain = ""
 			cellId = ""
+			includeResults = false
 		})
 
 		JustBeforeEach(func() {
-			actualTasks, err = controller.Tasks(ctx, logger, domain, cellId)
+			actualTasks, err = controller.Tasks(ctx, logger, domain, cellId, includeResults)
 		})
 
 		Context("when reading tasks from DB succeeds", func() {
@@ -124,6 +134,18 @@ var _ = Describe("Task Controller", func() {
 					Expect(filter.CellID).To(Equal(cellId))
 				})
 			})
+
+			Context("and including results", func() {
+				BeforeEach(func() {
+					includeResults = true
+				})
+
+				It("asks the DB for the task results", func() {
+					Expect(fakeTaskDB.TasksCallCount()).To(Equal(1))
+					_, _, filter := fakeTaskDB.TasksArgsForCall(0)
+					Expect(filter.IncludeResults).To(BeTrue())
+				})
+			})
 		})
 
 		Context("when the DB returns an error", func() {
@@ -178,6 +200,44 @@ var _ = Describe("Task Controller", func() {
 		})
 	})
 
+	Describe("TaskResult", func() {
+		var (
+			taskGuid     = "task-guid"
+			actualResult string
+		)
+
+		JustBeforeEach(func() {
+			actualResult, err = controller.TaskResult(ctx, logger, taskGuid)
+		})
+
+		Context("when reading the result from the DB succeeds", func() {
+			BeforeEach(func() {
+				fakeTaskDB.TaskResultReturns("some-result", nil)
+			})
+
+			It("fetches the result by task guid", func() {
+				Expect(fakeTaskDB.TaskResultCallCount()).To(Equal(1))
+				_, _, actualGuid := fakeTaskDB.TaskResultArgsForCall(0)
+				Expect(actualGuid).To(Equal(taskGuid))
+			})
+
+			It("returns the result", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(actualResult).To(Equal("some-result"))
+			})
+		})
+
+		Context("when the DB errors out", func() {
+			BeforeEach(func() {
+				fakeTaskDB.TaskResultReturns("", errors.New("kaboom"))
+			})
+
+			It("provides relevant error information", func() {
+				Expect(err).To(MatchError("kaboom"))
+			})
+		})
+	})
+
 	Describe("DesireTask", func() {
 		var (
 			taskGuid = "task-guid"
@@ -282,6 +342,56 @@ var _ = Describe("Task Controller", func() {
 			})
 		})
 
+		Context("when the task is blocked on its dependencies", func() {
+			BeforeEach(func() {
+				taskDef.DependsOn = []string{"other-task-guid"}
+				fakeTaskDB.DesireTaskReturns(&models.Task{TaskGuid: taskGuid, State: models.Task_Blocked}, nil)
+			})
+
+			It("does not request an auction for the task", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Consistently(fakeAuctioneerClient.RequestTaskAuctionsCallCount).Should(Equal(0))
+			})
+
+			It("attempts to release the task", func() {
+				Expect(fakeTaskDB.ReleaseBlockedTasksCallCount()).To(Equal(1))
+				_, _, taskGuids := fakeTaskDB.ReleaseBlockedTasksArgsForCall(0)
+				Expect(taskGuids).To(ConsistOf(taskGuid))
+			})
+
+			Context("when the dependencies have already finished", func() {
+				var before, after *models.Task
+
+				BeforeEach(func() {
+					before = &models.Task{TaskGuid: taskGuid, Domain: domain, State: models.Task_Blocked, TaskDefinition: taskDef}
+					after = &models.Task{TaskGuid: taskGuid, Domain: domain, State: models.Task_Pending, TaskDefinition: taskDef}
+					fakeTaskDB.ReleaseBlockedTasksReturns([]*models.TaskChange{{Before: before, After: after}}, nil, nil)
+				})
+
+				It("requests an auction for the released task", func() {
+					Expect(fakeAuctioneerClient.RequestTaskAuctionsCallCount()).To(Equal(1))
+					_, _, requestedTasks := fakeAuctioneerClient.RequestTaskAuctionsArgsForCall(0)
+					Expect(requestedTasks).To(HaveLen(1))
+					Expect(requestedTasks[0].TaskGuid).To(Equal(taskGuid))
+				})
+
+				It("emits a TaskChangedEvent for the released task", func() {
+					Eventually(taskHub.EmitCallCount).Should(Equal(2))
+					Expect([]models.Event{taskHub.EmitArgsForCall(0), taskHub.EmitArgsForCall(1)}).To(ContainElement(models.NewTaskChangedEvent(before, after)))
+				})
+			})
+
+			Context("when releasing blocked tasks fails", func() {
+				BeforeEach(func() {
+					fakeTaskDB.ReleaseBlockedTasksReturns(nil, nil, errors.New("kaboom"))
+				})
+
+				It("does not return an error", func() {
+					Expect(err).NotTo(HaveOccurred())
+				})
+			})
+		})
+
 		Context("when desiring the task fails", func() {
 			BeforeEach(func() {
 				fakeTaskDB.DesireTaskReturns(nil, errors.New("kaboom"))
@@ -295,6 +405,193 @@ var _ = Describe("Task Controller", func() {
 				Consistently(taskHub.EmitCallCount).Should(Equal(0))
 			})
 		})
+
+		Context("when the completion callback URL is not allowed", func() {
+			BeforeEach(func() {
+				taskDef.CompletionCallbackUrl = "http://not-allowed.example.com/callback"
+				fakeTaskCompletionClient.ValidateCallbackURLReturns(taskworkpool.ErrCallbackHostNotAllowed)
+			})
+
+			It("responds with an invalid request error", func() {
+				Expect(err).To(MatchError(models.NewError(models.Error_InvalidRequest, taskworkpool.ErrCallbackHostNotAllowed.Error())))
+				Expect(fakeTaskCompletionClient.ValidateCallbackURLArgsForCall(0)).To(Equal("http://not-allowed.example.com/callback"))
+			})
+
+			It("does not desire the task", func() {
+				Expect(fakeTaskDB.DesireTaskCallCount()).To(Equal(0))
+				Consistently(fakeAuctioneerClient.RequestTaskAuctionsCallCount).Should(Equal(0))
+			})
+		})
+	})
+
+	Describe("DesireTaskArray", func() {
+		var (
+			taskDef *models.TaskDefinition
+			tasks   []*models.Task
+		)
+
+		BeforeEach(func() {
+			taskDef = model_helpers.NewValidTaskDefinition()
+			tasks = []*models.Task{
+				{TaskGuid: "array-guid-0", Domain: "domain", State: models.Task_Pending, TaskDefinition: models.TaskArrayTaskDefinition(taskDef, 0)},
+				{TaskGuid: "array-guid-1", Domain: "domain", State: models.Task_Pending, TaskDefinition: models.TaskArrayTaskDefinition(taskDef, 1)},
+			}
+		})
+
+		JustBeforeEach(func() {
+			fakeTaskDB.DesireTaskArrayReturns(tasks, nil)
+			err = controller.DesireTaskArray(ctx, logger, taskDef, "array-guid", "domain", 2)
+		})
+
+		It("desires the task array in the DB", func() {
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeTaskDB.DesireTaskArrayCallCount()).To(Equal(1))
+			_, _, actualTaskDef, actualArrayGuid, actualDomain, actualCount := fakeTaskDB.DesireTaskArrayArgsForCall(0)
+			Expect(actualTaskDef).To(Equal(taskDef))
+			Expect(actualArrayGuid).To(Equal("array-guid"))
+			Expect(actualDomain).To(Equal("domain"))
+			Expect(actualCount).To(BeEquivalentTo(2))
+		})
+
+		It("emits a TaskCreatedEvent for every task", func() {
+			Eventually(taskHub.EmitCallCount).Should(Equal(2))
+		})
+
+		It("requests a single auction for all the tasks", func() {
+			Expect(fakeAuctioneerClient.RequestTaskAuctionsCallCount()).To(Equal(1))
+			_, _, requestedTasks := fakeAuctioneerClient.RequestTaskAuctionsArgsForCall(0)
+			Expect(requestedTasks).To(HaveLen(2))
+			Expect(requestedTasks[0].TaskGuid).To(Equal("array-guid-0"))
+			Expect(requestedTasks[1].TaskGuid).To(Equal("array-guid-1"))
+		})
+
+		Context("when the tasks are blocked on their dependencies", func() {
+			BeforeEach(func() {
+				for _, task := range tasks {
+					task.State = models.Task_Blocked
+				}
+			})
+
+			It("does not request an auction", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeAuctioneerClient.RequestTaskAuctionsCallCount()).To(Equal(0))
+			})
+
+			It("attempts to release the tasks", func() {
+				Expect(fakeTaskDB.ReleaseBlockedTasksCallCount()).To(Equal(1))
+				_, _, taskGuids := fakeTaskDB.ReleaseBlockedTasksArgsForCall(0)
+				Expect(taskGuids).To(ConsistOf("array-guid-0", "array-guid-1"))
+			})
+		})
+
+		Context("when desiring the task array fails", func() {
+			JustBeforeEach(func() {
+				fakeTaskDB.DesireTaskArrayReturns(nil, models.ErrResourceExists)
+				err = controller.DesireTaskArray(ctx, logger, taskDef, "array-guid", "domain", 2)
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(Equal(models.ErrResourceExists))
+			})
+		})
+
+		Context("when the completion callback url is invalid", func() {
+			BeforeEach(func() {
+				fakeTaskCompletionClient.ValidateCallbackURLReturns(errors.New("invalid url"))
+			})
+
+			It("returns an invalid request error without desiring any tasks", func() {
+				Expect(err).To(HaveOccurred())
+				Expect(models.ConvertError(err).Type).To(Equal(models.Error_InvalidRequest))
+				Expect(fakeTaskDB.DesireTaskArrayCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("TaskArrayStatus", func() {
+		var status *models.TaskArrayStatus
+
+		JustBeforeEach(func() {
+			status, err = controller.TaskArrayStatus(ctx, logger, "array-guid")
+		})
+
+		Context("when the array has tasks", func() {
+			BeforeEach(func() {
+				fakeTaskDB.TasksByArrayGuidReturns([]*models.Task{
+					{State: models.Task_Running},
+					{State: models.Task_Completed, Failed: true},
+				}, nil)
+			})
+
+			It("summarizes the states of its tasks", func() {
+				Expect(err).NotTo(HaveOccurred())
+				_, _, arrayGuid := fakeTaskDB.TasksByArrayGuidArgsForCall(0)
+				Expect(arrayGuid).To(Equal("array-guid"))
+				Expect(status).To(Equal(&models.TaskArrayStatus{ArrayGuid: "array-guid", Total: 2, Running: 1, Failed: 1}))
+			})
+		})
+
+		Context("when the array has no tasks", func() {
+			It("returns a resource not found error", func() {
+				Expect(err).To(Equal(models.ErrResourceNotFound))
+			})
+		})
+	})
+
+	Describe("CancelTaskArray", func() {
+		BeforeEach(func() {
+			fakeTaskDB.TasksByArrayGuidReturns([]*models.Task{
+				{TaskGuid: "array-guid-0", State: models.Task_Pending},
+				{TaskGuid: "array-guid-1", State: models.Task_Completed},
+				{TaskGuid: "array-guid-2", State: models.Task_Running},
+			}, nil)
+			fakeTaskDB.CancelTaskReturns(&models.Task{}, model_helpers.NewValidTask("array-guid-0"), "", nil)
+		})
+
+		JustBeforeEach(func() {
+			err = controller.CancelTaskArray(ctx, logger, "array-guid")
+		})
+
+		It("cancels the tasks that have not completed", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeTaskDB.CancelTaskCallCount()).To(Equal(2))
+			_, _, firstGuid := fakeTaskDB.CancelTaskArgsForCall(0)
+			_, _, secondGuid := fakeTaskDB.CancelTaskArgsForCall(1)
+			Expect([]string{firstGuid, secondGuid}).To(ConsistOf("array-guid-0", "array-guid-2"))
+		})
+
+		Context("when a task completes before it is cancelled", func() {
+			BeforeEach(func() {
+				fakeTaskDB.CancelTaskReturnsOnCall(0, nil, nil, "", models.NewTaskTransitionError(models.Task_Completed, models.Task_Completed))
+			})
+
+			It("ignores it and cancels the rest", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeTaskDB.CancelTaskCallCount()).To(Equal(2))
+			})
+		})
+
+		Context("when cancelling a task fails", func() {
+			BeforeEach(func() {
+				fakeTaskDB.CancelTaskReturnsOnCall(0, nil, nil, "", models.ErrUnknownError)
+			})
+
+			It("cancels the rest and returns the error", func() {
+				Expect(err).To(Equal(models.ErrUnknownError))
+				Expect(fakeTaskDB.CancelTaskCallCount()).To(Equal(2))
+			})
+		})
+
+		Context("when the array has no tasks", func() {
+			BeforeEach(func() {
+				fakeTaskDB.TasksByArrayGuidReturns(nil, nil)
+			})
+
+			It("returns a resource not found error", func() {
+				Expect(err).To(Equal(models.ErrResourceNotFound))
+			})
+		})
 	})
 
 	Describe("StartTask", func() {
@@ -369,6 +666,42 @@ var _ = Describe("Task Controller", func() {
 				It("does not emit a change to the hub", func() {
 					Consistently(taskHub.EmitCallCount).Should(Equal(0))
 				})
+
+				It("does not request an auction", func() {
+					Expect(fakeAuctioneerClient.RequestTaskAuctionsCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the cell is cordoned", func() {
+				var task *models.Task
+
+				BeforeEach(func() {
+					task = model_helpers.NewValidTask(taskGuid)
+					task.State = models.Task_Pending
+					fakeTaskDB.StartTaskReturns(task, task, false, nil)
+				})
+
+				It("responds with false", func() {
+					Expect(err).NotTo(HaveOccurred())
+					Expect(shouldStart).To(BeFalse())
+				})
+
+				It("auctions the task again", func() {
+					Expect(fakeAuctioneerClient.RequestTaskAuctionsCallCount()).To(Equal(1))
+					_, _, requestedTasks := fakeAuctioneerClient.RequestTaskAuctionsArgsForCall(0)
+					taskStartRequest := auctioneer.NewTaskStartRequestFromModel(task.TaskGuid, task.Domain, task.TaskDefinition)
+					Expect(requestedTasks).To(ConsistOf(&taskStartRequest))
+				})
+
+				Context("when requesting the auction fails", func() {
+					BeforeEach(func() {
+						fakeAuctioneerClient.RequestTaskAuctionsReturns(errors.New("boom"))
+					})
+
+					It("does not return the error", func() {
+						Expect(err).NotTo(HaveOccurred())
+					})
+				})
 			})
 
 			Context("when the DB fails", func() {
@@ -827,6 +1160,98 @@ var _ = Describe("Task Controller", func() {
 			})
 		})
 
+		Context("when tasks are blocked on the completed task", func() {
+			var releasedBefore, releasedAfter, failedBefore, failedAfter *models.Task
+
+			BeforeEach(func() {
+				releasedBefore = model_helpers.NewValidTask("released-task")
+				releasedBefore.State = models.Task_Blocked
+				releasedAfter = model_helpers.NewValidTask("released-task")
+				releasedAfter.State = models.Task_Pending
+
+				failedBefore = model_helpers.NewValidTask("failed-task")
+				failedBefore.State = models.Task_Blocked
+				failedAfter = model_helpers.NewValidTask("failed-task")
+				failedAfter.State = models.Task_Completed
+				failedAfter.Failed = true
+				failedAfter.CompletionCallbackUrl = "bogus"
+
+				fakeTaskDB.ReleaseBlockedTasksReturns(
+					[]*models.TaskChange{{Before: releasedBefore, After: releasedAfter}},
+					[]*models.TaskChange{{Before: failedBefore, After: failedAfter}},
+					nil,
+				)
+			})
+
+			It("releases the tasks depending on the completed task", func() {
+				Expect(fakeTaskDB.ReleaseBlockedTasksCallCount()).To(Equal(1))
+				_, _, taskGuids := fakeTaskDB.ReleaseBlockedTasksArgsForCall(0)
+				Expect(taskGuids).To(ConsistOf(taskGuid))
+			})
+
+			It("requests auctions for the released tasks", func() {
+				Expect(fakeTaskDB.ReleaseBlockedTasksCallCount()).To(Equal(1))
+				Expect(fakeAuctioneerClient.RequestTaskAuctionsCallCount()).To(Equal(1))
+				_, _, requestedTasks := fakeAuctioneerClient.RequestTaskAuctionsArgsForCall(0)
+				Expect(requestedTasks).To(HaveLen(1))
+				Expect(requestedTasks[0].TaskGuid).To(Equal("released-task"))
+			})
+
+			It("completes the callbacks of the failed tasks", func() {
+				Eventually(fakeTaskCompletionClient.SubmitCallCount).Should(Equal(1))
+				_, _, task := fakeTaskCompletionClient.SubmitArgsForCall(0)
+				Expect(task).To(Equal(failedAfter))
+			})
+
+			It("emits changes for the released and failed tasks", func() {
+				Eventually(taskHub.EmitCallCount).Should(Equal(3))
+				var events []models.Event
+				for i := 0; i < taskHub.EmitCallCount(); i++ {
+					events = append(events, taskHub.EmitArgsForCall(i))
+				}
+				Expect(events).To(ContainElement(models.NewTaskChangedEvent(releasedBefore, releasedAfter)))
+				Expect(events).To(ContainElement(models.NewTaskChangedEvent(failedBefore, failedAfter)))
+			})
+		})
+
+		Context("when the task is retried", func() {
+			BeforeEach(func() {
+				after.State = models.Task_Pending
+				after.UpdatedAt = 100
+				after.RetryAt = 100
+				after.CompletionCallbackUrl = "bogus"
+				after.Attempts = []*models.TaskAttempt{{CellId: cellId, FailureClass: models.RetryPolicy_NonZeroExit}}
+			})
+
+			It("emits a change to the hub", func() {
+				Eventually(taskHub.EmitCallCount).Should(Equal(1))
+				Expect(taskHub.EmitArgsForCall(0)).To(Equal(models.NewTaskChangedEvent(before, after)))
+			})
+
+			It("requests an auction for the task", func() {
+				Expect(fakeAuctioneerClient.RequestTaskAuctionsCallCount()).To(Equal(1))
+				_, _, requestedTasks := fakeAuctioneerClient.RequestTaskAuctionsArgsForCall(0)
+				Expect(requestedTasks).To(HaveLen(1))
+				Expect(requestedTasks[0].TaskGuid).To(Equal("hi-bob"))
+			})
+
+			It("does not complete the task", func() {
+				Expect(fakeTaskStatNotifier.RecordTaskFailedCallCount()).To(BeZero())
+				Expect(fakeTaskDB.ReleaseBlockedTasksCallCount()).To(BeZero())
+				Consistently(fakeTaskCompletionClient.SubmitCallCount).Should(BeZero())
+			})
+
+			Context("when the retry is backed off", func() {
+				BeforeEach(func() {
+					after.RetryAt = 200
+				})
+
+				It("leaves the auction to convergence", func() {
+					Expect(fakeAuctioneerClient.RequestTaskAuctionsCallCount()).To(BeZero())
+				})
+			})
+		})
+
 		Context("when completing the task fails", func() {
 			BeforeEach(func() {
 				fakeTaskDB.CompleteTaskReturns(nil, nil, errors.New("kaboom"))
@@ -984,13 +1409,15 @@ var _ = Describe("Task Controller", func() {
 			It("calls ConvergeTasks", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(fakeTaskDB.ConvergeTasksCallCount()).To(Equal(1))
-				taskContext, taskLogger, actualCellSet, actualKickDuration, actualPendingDuration, actualCompletedDuration := fakeTaskDB.ConvergeTasksArgsForCall(0)
+				taskContext, taskLogger, actualCellSet, actualKickDuration, actualPendingDuration, actualCompletedDuration, actualMaxRunDurations, actualArchiveRetention := fakeTaskDB.ConvergeTasksArgsForCall(0)
 				Expect(taskContext).To(Equal(ctx))
 				Expect(taskLogger.SessionName()).To(ContainSubstring("converge-tasks"))
 				Expect(actualCellSet).To(BeEquivalentTo(cellSet))
 				Expect(actualKickDuration).To(BeEquivalentTo(kickTaskDuration))
 				Expect(actualPendingDuration).To(BeEquivalentTo(expirePendingTaskDuration))
 				Expect(actualCompletedDuration).To(BeEquivalentTo(expireCompletedTaskDuration))
+				Expect(actualMaxRunDurations).To(Equal(config.DefaultMaxRunDurations))
+				Expect(actualArchiveRetention).To(Equal(config.TaskArchiveRetention))
 			})
 
 			It("records task count metrics", func() {
@@ -1029,7 +1456,7 @@ var _ = Describe("Task Controller", func() {
 				It("calls ConvergeTasks with an empty CellSet", func() {
 					Expect(err).NotTo(HaveOccurred())
 					Expect(fakeTaskDB.ConvergeTasksCallCount()).To(Equal(1))
-					_, _, actualCellSet, _, _, _ := fakeTaskDB.ConvergeTasksArgsForCall(0)
+					_, _, actualCellSet, _, _, _, _, _ := fakeTaskDB.ConvergeTasksArgsForCall(0)
 					Expect(actualCellSet).To(BeEquivalentTo(models.CellSet{}))
 				})
 			})
@@ -1107,6 +1534,52 @@ var _ = Describe("Task Controller", func() {
 						Expect(logger.TestSink.LogMessages()).To(ContainElement("test.converge-tasks.failed-to-request-auctions-for-pending-tasks"))
 					})
 				})
+
+				Context("when there are more tasks than can be auctioned in a single convergence", func() {
+					BeforeEach(func() {
+						config.MaxAuctionsPerCycle = 1
+					})
+
+					It("requests auctions for the first tasks only", func() {
+						Expect(fakeAuctioneerClient.RequestTaskAuctionsCallCount()).To(Equal(1))
+
+						_, _, requestedTasks := fakeAuctioneerClient.RequestTaskAuctionsArgsForCall(0)
+						Expect(requestedTasks).To(HaveLen(1))
+						Expect(requestedTasks[0].TaskGuid).To(Equal(taskGuid1))
+					})
+				})
+			})
+
+			Context("when there are tasks to cancel", func() {
+				BeforeEach(func() {
+					overdueTask := model_helpers.NewValidTask("overdue-task")
+					overdueTask.CellId = "cell-id"
+					lostTask := model_helpers.NewValidTask("lost-task")
+					lostTask.CellId = "missing-cell-id"
+					fakeTaskDB.ConvergeTasksReturns(db.TaskConvergenceResult{
+						TasksToCancel: []*models.Task{overdueTask, lostTask},
+					})
+				})
+
+				It("cancels the tasks on their cells", func() {
+					Expect(fakeRepClientFactory.CreateClientCallCount()).To(Equal(1))
+					repAddress, _, _ := fakeRepClientFactory.CreateClientArgsForCall(0)
+					Expect(repAddress).To(Equal("1.1.1.1"))
+
+					Expect(fakeRepClient.CancelTaskCallCount()).To(Equal(1))
+					_, guid := fakeRepClient.CancelTaskArgsForCall(0)
+					Expect(guid).To(Equal("overdue-task"))
+				})
+
+				Context("when cancelling the task on the cell fails", func() {
+					BeforeEach(func() {
+						fakeRepClient.CancelTaskReturns(errors.New("boom"))
+					})
+
+					It("does not fail convergence", func() {
+						Expect(err).NotTo(HaveOccurred())
+					})
+				})
 			})
 
 			Context("when there are events to emit", func() {
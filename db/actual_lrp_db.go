@@ -30,6 +30,9 @@ type ActualLRPDB interface {
 
 	ChangeActualLRPPresence(ctx context.Context, logger lager.Logger, key *models.ActualLRPKey, from, to models.ActualLRP_Presence) (before *models.ActualLRP, after *models.ActualLRP, err error)
 
+	ActualLRPCrashes(ctx context.Context, logger lager.Logger, processGuid string, index *int32) ([]*models.ActualLRPCrash, error)
+	PruneActualLRPCrashes(ctx context.Context, logger lager.Logger) error
+
 	CountActualLRPsByState(ctx context.Context, logger lager.Logger) (int, int, int, int, int)
 	CountDesiredInstances(ctx context.Context, logger lager.Logger) int
 }
@@ -0,0 +1,16 @@
+package db
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+//counterfeiter:generate . CapacityDB
+type CapacityDB interface {
+	// CellReservations returns the memory, disk and containers reserved on
+	// each cell by the claimed and running ActualLRPs and the running tasks,
+	// keyed by cell ID.
+	CellReservations(ctx context.Context, logger lager.Logger) (map[string]*models.CellReservation, error)
+}
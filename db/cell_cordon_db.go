@@ -0,0 +1,23 @@
+package db
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+//counterfeiter:generate . CellCordonDB
+type CellCordonDB interface {
+	// CordonCell records that no new work should be placed on the cell.
+	// Cordoning a cordoned cell has no effect.
+	CordonCell(ctx context.Context, logger lager.Logger, cellID string) error
+	// UncordonCell removes the cordon of the cell and stops its drain.
+	UncordonCell(ctx context.Context, logger lager.Logger, cellID string) error
+	CordonedCells(ctx context.Context, logger lager.Logger) ([]*models.CordonedCell, error)
+	// StartDrainingCell cordons the cell and records when its drain started.
+	StartDrainingCell(ctx context.Context, logger lager.Logger, cellID string) error
+	// CellDrainStatus returns the number of ActualLRP instances that are
+	// still on the cell.
+	CellDrainStatus(ctx context.Context, logger lager.Logger, cellID string) (*models.CellDrainStatus, error)
+}
@@ -5,10 +5,15 @@ package db
 //counterfeiter:generate . DB
 
 type DB interface {
+	CapacityDB
+	CellCordonDB
 	DomainDB
+	DomainQuotaDB
 	EncryptionDB
 	EvacuationDB
 	LRPDB
+	ScheduledTaskDB
+	TaskArchiveDB
 	TaskDB
 	VersionDB
 	SuspectDB
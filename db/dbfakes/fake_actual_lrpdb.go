@@ -11,6 +11,22 @@ import (
 )
 
 type FakeActualLRPDB struct {
+	ActualLRPCrashesStub        func(context.Context, lager.Logger, string, *int32) ([]*models.ActualLRPCrash, error)
+	actualLRPCrashesMutex       sync.RWMutex
+	actualLRPCrashesArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 *int32
+	}
+	actualLRPCrashesReturns struct {
+		result1 []*models.ActualLRPCrash
+		result2 error
+	}
+	actualLRPCrashesReturnsOnCall map[int]struct {
+		result1 []*models.ActualLRPCrash
+		result2 error
+	}
 	ActualLRPsStub        func(context.Context, lager.Logger, models.ActualLRPFilter) ([]*models.ActualLRP, error)
 	actualLRPsMutex       sync.RWMutex
 	actualLRPsArgsForCall []struct {
@@ -150,6 +166,18 @@ type FakeActualLRPDB struct {
 		result2 *models.ActualLRP
 		result3 error
 	}
+	PruneActualLRPCrashesStub        func(context.Context, lager.Logger) error
+	pruneActualLRPCrashesMutex       sync.RWMutex
+	pruneActualLRPCrashesArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	pruneActualLRPCrashesReturns struct {
+		result1 error
+	}
+	pruneActualLRPCrashesReturnsOnCall map[int]struct {
+		result1 error
+	}
 	RemoveActualLRPStub        func(context.Context, lager.Logger, string, int32, *models.ActualLRPInstanceKey) error
 	removeActualLRPMutex       sync.RWMutex
 	removeActualLRPArgsForCall []struct {
@@ -209,6 +237,73 @@ type FakeActualLRPDB struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeActualLRPDB) ActualLRPCrashes(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 *int32) ([]*models.ActualLRPCrash, error) {
+	fake.actualLRPCrashesMutex.Lock()
+	ret, specificReturn := fake.actualLRPCrashesReturnsOnCall[len(fake.actualLRPCrashesArgsForCall)]
+	fake.actualLRPCrashesArgsForCall = append(fake.actualLRPCrashesArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 *int32
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ActualLRPCrashesStub
+	fakeReturns := fake.actualLRPCrashesReturns
+	fake.recordInvocation("ActualLRPCrashes", []interface{}{arg1, arg2, arg3, arg4})
+	fake.actualLRPCrashesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActualLRPDB) ActualLRPCrashesCallCount() int {
+	fake.actualLRPCrashesMutex.RLock()
+	defer fake.actualLRPCrashesMutex.RUnlock()
+	return len(fake.actualLRPCrashesArgsForCall)
+}
+
+func (fake *FakeActualLRPDB) ActualLRPCrashesCalls(stub func(context.Context, lager.Logger, string, *int32) ([]*models.ActualLRPCrash, error)) {
+	fake.actualLRPCrashesMutex.Lock()
+	defer fake.actualLRPCrashesMutex.Unlock()
+	fake.ActualLRPCrashesStub = stub
+}
+
+func (fake *FakeActualLRPDB) ActualLRPCrashesArgsForCall(i int) (context.Context, lager.Logger, string, *int32) {
+	fake.actualLRPCrashesMutex.RLock()
+	defer fake.actualLRPCrashesMutex.RUnlock()
+	argsForCall := fake.actualLRPCrashesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeActualLRPDB) ActualLRPCrashesReturns(result1 []*models.ActualLRPCrash, result2 error) {
+	fake.actualLRPCrashesMutex.Lock()
+	defer fake.actualLRPCrashesMutex.Unlock()
+	fake.ActualLRPCrashesStub = nil
+	fake.actualLRPCrashesReturns = struct {
+		result1 []*models.ActualLRPCrash
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActualLRPDB) ActualLRPCrashesReturnsOnCall(i int, result1 []*models.ActualLRPCrash, result2 error) {
+	fake.actualLRPCrashesMutex.Lock()
+	defer fake.actualLRPCrashesMutex.Unlock()
+	fake.ActualLRPCrashesStub = nil
+	if fake.actualLRPCrashesReturnsOnCall == nil {
+		fake.actualLRPCrashesReturnsOnCall = make(map[int]struct {
+			result1 []*models.ActualLRPCrash
+			result2 error
+		})
+	}
+	fake.actualLRPCrashesReturnsOnCall[i] = struct {
+		result1 []*models.ActualLRPCrash
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActualLRPDB) ActualLRPs(arg1 context.Context, arg2 lager.Logger, arg3 models.ActualLRPFilter) ([]*models.ActualLRP, error) {
 	fake.actualLRPsMutex.Lock()
 	ret, specificReturn := fake.actualLRPsReturnsOnCall[len(fake.actualLRPsArgsForCall)]
@@ -763,6 +858,68 @@ func (fake *FakeActualLRPDB) FailActualLRPReturnsOnCall(i int, result1 *models.A
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActualLRPDB) PruneActualLRPCrashes(arg1 context.Context, arg2 lager.Logger) error {
+	fake.pruneActualLRPCrashesMutex.Lock()
+	ret, specificReturn := fake.pruneActualLRPCrashesReturnsOnCall[len(fake.pruneActualLRPCrashesArgsForCall)]
+	fake.pruneActualLRPCrashesArgsForCall = append(fake.pruneActualLRPCrashesArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.PruneActualLRPCrashesStub
+	fakeReturns := fake.pruneActualLRPCrashesReturns
+	fake.recordInvocation("PruneActualLRPCrashes", []interface{}{arg1, arg2})
+	fake.pruneActualLRPCrashesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeActualLRPDB) PruneActualLRPCrashesCallCount() int {
+	fake.pruneActualLRPCrashesMutex.RLock()
+	defer fake.pruneActualLRPCrashesMutex.RUnlock()
+	return len(fake.pruneActualLRPCrashesArgsForCall)
+}
+
+func (fake *FakeActualLRPDB) PruneActualLRPCrashesCalls(stub func(context.Context, lager.Logger) error) {
+	fake.pruneActualLRPCrashesMutex.Lock()
+	defer fake.pruneActualLRPCrashesMutex.Unlock()
+	fake.PruneActualLRPCrashesStub = stub
+}
+
+func (fake *FakeActualLRPDB) PruneActualLRPCrashesArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.pruneActualLRPCrashesMutex.RLock()
+	defer fake.pruneActualLRPCrashesMutex.RUnlock()
+	argsForCall := fake.pruneActualLRPCrashesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActualLRPDB) PruneActualLRPCrashesReturns(result1 error) {
+	fake.pruneActualLRPCrashesMutex.Lock()
+	defer fake.pruneActualLRPCrashesMutex.Unlock()
+	fake.PruneActualLRPCrashesStub = nil
+	fake.pruneActualLRPCrashesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeActualLRPDB) PruneActualLRPCrashesReturnsOnCall(i int, result1 error) {
+	fake.pruneActualLRPCrashesMutex.Lock()
+	defer fake.pruneActualLRPCrashesMutex.Unlock()
+	fake.PruneActualLRPCrashesStub = nil
+	if fake.pruneActualLRPCrashesReturnsOnCall == nil {
+		fake.pruneActualLRPCrashesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.pruneActualLRPCrashesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeActualLRPDB) RemoveActualLRP(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 int32, arg5 *models.ActualLRPInstanceKey) error {
 	fake.removeActualLRPMutex.Lock()
 	ret, specificReturn := fake.removeActualLRPReturnsOnCall[len(fake.removeActualLRPArgsForCall)]
@@ -980,6 +1137,8 @@ func (fake *FakeActualLRPDB) UnclaimActualLRPReturnsOnCall(i int, result1 *model
 func (fake *FakeActualLRPDB) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.actualLRPCrashesMutex.RLock()
+	defer fake.actualLRPCrashesMutex.RUnlock()
 	fake.actualLRPsMutex.RLock()
 	defer fake.actualLRPsMutex.RUnlock()
 	fake.changeActualLRPPresenceMutex.RLock()
@@ -996,6 +1155,8 @@ func (fake *FakeActualLRPDB) Invocations() map[string][][]interface{} {
 	defer fake.createUnclaimedActualLRPMutex.RUnlock()
 	fake.failActualLRPMutex.RLock()
 	defer fake.failActualLRPMutex.RUnlock()
+	fake.pruneActualLRPCrashesMutex.RLock()
+	defer fake.pruneActualLRPCrashesMutex.RUnlock()
 	fake.removeActualLRPMutex.RLock()
 	defer fake.removeActualLRPMutex.RUnlock()
 	fake.startActualLRPMutex.RLock()
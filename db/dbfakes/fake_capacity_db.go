@@ -0,0 +1,121 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"context"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	lager "code.cloudfoundry.org/lager/v3"
+)
+
+type FakeCapacityDB struct {
+	CellReservationsStub        func(context.Context, lager.Logger) (map[string]*models.CellReservation, error)
+	cellReservationsMutex       sync.RWMutex
+	cellReservationsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	cellReservationsReturns struct {
+		result1 map[string]*models.CellReservation
+		result2 error
+	}
+	cellReservationsReturnsOnCall map[int]struct {
+		result1 map[string]*models.CellReservation
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCapacityDB) CellReservations(arg1 context.Context, arg2 lager.Logger) (map[string]*models.CellReservation, error) {
+	fake.cellReservationsMutex.Lock()
+	ret, specificReturn := fake.cellReservationsReturnsOnCall[len(fake.cellReservationsArgsForCall)]
+	fake.cellReservationsArgsForCall = append(fake.cellReservationsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.CellReservationsStub
+	fakeReturns := fake.cellReservationsReturns
+	fake.recordInvocation("CellReservations", []interface{}{arg1, arg2})
+	fake.cellReservationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCapacityDB) CellReservationsCallCount() int {
+	fake.cellReservationsMutex.RLock()
+	defer fake.cellReservationsMutex.RUnlock()
+	return len(fake.cellReservationsArgsForCall)
+}
+
+func (fake *FakeCapacityDB) CellReservationsCalls(stub func(context.Context, lager.Logger) (map[string]*models.CellReservation, error)) {
+	fake.cellReservationsMutex.Lock()
+	defer fake.cellReservationsMutex.Unlock()
+	fake.CellReservationsStub = stub
+}
+
+func (fake *FakeCapacityDB) CellReservationsArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.cellReservationsMutex.RLock()
+	defer fake.cellReservationsMutex.RUnlock()
+	argsForCall := fake.cellReservationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCapacityDB) CellReservationsReturns(result1 map[string]*models.CellReservation, result2 error) {
+	fake.cellReservationsMutex.Lock()
+	defer fake.cellReservationsMutex.Unlock()
+	fake.CellReservationsStub = nil
+	fake.cellReservationsReturns = struct {
+		result1 map[string]*models.CellReservation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCapacityDB) CellReservationsReturnsOnCall(i int, result1 map[string]*models.CellReservation, result2 error) {
+	fake.cellReservationsMutex.Lock()
+	defer fake.cellReservationsMutex.Unlock()
+	fake.CellReservationsStub = nil
+	if fake.cellReservationsReturnsOnCall == nil {
+		fake.cellReservationsReturnsOnCall = make(map[int]struct {
+			result1 map[string]*models.CellReservation
+			result2 error
+		})
+	}
+	fake.cellReservationsReturnsOnCall[i] = struct {
+		result1 map[string]*models.CellReservation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCapacityDB) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.cellReservationsMutex.RLock()
+	defer fake.cellReservationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeCapacityDB) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.CapacityDB = new(FakeCapacityDB)
@@ -0,0 +1,438 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"context"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	lager "code.cloudfoundry.org/lager/v3"
+)
+
+type FakeCellCordonDB struct {
+	CellDrainStatusStub        func(context.Context, lager.Logger, string) (*models.CellDrainStatus, error)
+	cellDrainStatusMutex       sync.RWMutex
+	cellDrainStatusArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	cellDrainStatusReturns struct {
+		result1 *models.CellDrainStatus
+		result2 error
+	}
+	cellDrainStatusReturnsOnCall map[int]struct {
+		result1 *models.CellDrainStatus
+		result2 error
+	}
+	CordonCellStub        func(context.Context, lager.Logger, string) error
+	cordonCellMutex       sync.RWMutex
+	cordonCellArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	cordonCellReturns struct {
+		result1 error
+	}
+	cordonCellReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CordonedCellsStub        func(context.Context, lager.Logger) ([]*models.CordonedCell, error)
+	cordonedCellsMutex       sync.RWMutex
+	cordonedCellsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	cordonedCellsReturns struct {
+		result1 []*models.CordonedCell
+		result2 error
+	}
+	cordonedCellsReturnsOnCall map[int]struct {
+		result1 []*models.CordonedCell
+		result2 error
+	}
+	StartDrainingCellStub        func(context.Context, lager.Logger, string) error
+	startDrainingCellMutex       sync.RWMutex
+	startDrainingCellArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	startDrainingCellReturns struct {
+		result1 error
+	}
+	startDrainingCellReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UncordonCellStub        func(context.Context, lager.Logger, string) error
+	uncordonCellMutex       sync.RWMutex
+	uncordonCellArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	uncordonCellReturns struct {
+		result1 error
+	}
+	uncordonCellReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCellCordonDB) CellDrainStatus(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.CellDrainStatus, error) {
+	fake.cellDrainStatusMutex.Lock()
+	ret, specificReturn := fake.cellDrainStatusReturnsOnCall[len(fake.cellDrainStatusArgsForCall)]
+	fake.cellDrainStatusArgsForCall = append(fake.cellDrainStatusArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.CellDrainStatusStub
+	fakeReturns := fake.cellDrainStatusReturns
+	fake.recordInvocation("CellDrainStatus", []interface{}{arg1, arg2, arg3})
+	fake.cellDrainStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCellCordonDB) CellDrainStatusCallCount() int {
+	fake.cellDrainStatusMutex.RLock()
+	defer fake.cellDrainStatusMutex.RUnlock()
+	return len(fake.cellDrainStatusArgsForCall)
+}
+
+func (fake *FakeCellCordonDB) CellDrainStatusCalls(stub func(context.Context, lager.Logger, string) (*models.CellDrainStatus, error)) {
+	fake.cellDrainStatusMutex.Lock()
+	defer fake.cellDrainStatusMutex.Unlock()
+	fake.CellDrainStatusStub = stub
+}
+
+func (fake *FakeCellCordonDB) CellDrainStatusArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.cellDrainStatusMutex.RLock()
+	defer fake.cellDrainStatusMutex.RUnlock()
+	argsForCall := fake.cellDrainStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeCellCordonDB) CellDrainStatusReturns(result1 *models.CellDrainStatus, result2 error) {
+	fake.cellDrainStatusMutex.Lock()
+	defer fake.cellDrainStatusMutex.Unlock()
+	fake.CellDrainStatusStub = nil
+	fake.cellDrainStatusReturns = struct {
+		result1 *models.CellDrainStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCellCordonDB) CellDrainStatusReturnsOnCall(i int, result1 *models.CellDrainStatus, result2 error) {
+	fake.cellDrainStatusMutex.Lock()
+	defer fake.cellDrainStatusMutex.Unlock()
+	fake.CellDrainStatusStub = nil
+	if fake.cellDrainStatusReturnsOnCall == nil {
+		fake.cellDrainStatusReturnsOnCall = make(map[int]struct {
+			result1 *models.CellDrainStatus
+			result2 error
+		})
+	}
+	fake.cellDrainStatusReturnsOnCall[i] = struct {
+		result1 *models.CellDrainStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCellCordonDB) CordonCell(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
+	fake.cordonCellMutex.Lock()
+	ret, specificReturn := fake.cordonCellReturnsOnCall[len(fake.cordonCellArgsForCall)]
+	fake.cordonCellArgsForCall = append(fake.cordonCellArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.CordonCellStub
+	fakeReturns := fake.cordonCellReturns
+	fake.recordInvocation("CordonCell", []interface{}{arg1, arg2, arg3})
+	fake.cordonCellMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeCellCordonDB) CordonCellCallCount() int {
+	fake.cordonCellMutex.RLock()
+	defer fake.cordonCellMutex.RUnlock()
+	return len(fake.cordonCellArgsForCall)
+}
+
+func (fake *FakeCellCordonDB) CordonCellCalls(stub func(context.Context, lager.Logger, string) error) {
+	fake.cordonCellMutex.Lock()
+	defer fake.cordonCellMutex.Unlock()
+	fake.CordonCellStub = stub
+}
+
+func (fake *FakeCellCordonDB) CordonCellArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.cordonCellMutex.RLock()
+	defer fake.cordonCellMutex.RUnlock()
+	argsForCall := fake.cordonCellArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeCellCordonDB) CordonCellReturns(result1 error) {
+	fake.cordonCellMutex.Lock()
+	defer fake.cordonCellMutex.Unlock()
+	fake.CordonCellStub = nil
+	fake.cordonCellReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCellCordonDB) CordonCellReturnsOnCall(i int, result1 error) {
+	fake.cordonCellMutex.Lock()
+	defer fake.cordonCellMutex.Unlock()
+	fake.CordonCellStub = nil
+	if fake.cordonCellReturnsOnCall == nil {
+		fake.cordonCellReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.cordonCellReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCellCordonDB) CordonedCells(arg1 context.Context, arg2 lager.Logger) ([]*models.CordonedCell, error) {
+	fake.cordonedCellsMutex.Lock()
+	ret, specificReturn := fake.cordonedCellsReturnsOnCall[len(fake.cordonedCellsArgsForCall)]
+	fake.cordonedCellsArgsForCall = append(fake.cordonedCellsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.CordonedCellsStub
+	fakeReturns := fake.cordonedCellsReturns
+	fake.recordInvocation("CordonedCells", []interface{}{arg1, arg2})
+	fake.cordonedCellsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCellCordonDB) CordonedCellsCallCount() int {
+	fake.cordonedCellsMutex.RLock()
+	defer fake.cordonedCellsMutex.RUnlock()
+	return len(fake.cordonedCellsArgsForCall)
+}
+
+func (fake *FakeCellCordonDB) CordonedCellsCalls(stub func(context.Context, lager.Logger) ([]*models.CordonedCell, error)) {
+	fake.cordonedCellsMutex.Lock()
+	defer fake.cordonedCellsMutex.Unlock()
+	fake.CordonedCellsStub = stub
+}
+
+func (fake *FakeCellCordonDB) CordonedCellsArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.cordonedCellsMutex.RLock()
+	defer fake.cordonedCellsMutex.RUnlock()
+	argsForCall := fake.cordonedCellsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCellCordonDB) CordonedCellsReturns(result1 []*models.CordonedCell, result2 error) {
+	fake.cordonedCellsMutex.Lock()
+	defer fake.cordonedCellsMutex.Unlock()
+	fake.CordonedCellsStub = nil
+	fake.cordonedCellsReturns = struct {
+		result1 []*models.CordonedCell
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCellCordonDB) CordonedCellsReturnsOnCall(i int, result1 []*models.CordonedCell, result2 error) {
+	fake.cordonedCellsMutex.Lock()
+	defer fake.cordonedCellsMutex.Unlock()
+	fake.CordonedCellsStub = nil
+	if fake.cordonedCellsReturnsOnCall == nil {
+		fake.cordonedCellsReturnsOnCall = make(map[int]struct {
+			result1 []*models.CordonedCell
+			result2 error
+		})
+	}
+	fake.cordonedCellsReturnsOnCall[i] = struct {
+		result1 []*models.CordonedCell
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCellCordonDB) StartDrainingCell(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
+	fake.startDrainingCellMutex.Lock()
+	ret, specificReturn := fake.startDrainingCellReturnsOnCall[len(fake.startDrainingCellArgsForCall)]
+	fake.startDrainingCellArgsForCall = append(fake.startDrainingCellArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.StartDrainingCellStub
+	fakeReturns := fake.startDrainingCellReturns
+	fake.recordInvocation("StartDrainingCell", []interface{}{arg1, arg2, arg3})
+	fake.startDrainingCellMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeCellCordonDB) StartDrainingCellCallCount() int {
+	fake.startDrainingCellMutex.RLock()
+	defer fake.startDrainingCellMutex.RUnlock()
+	return len(fake.startDrainingCellArgsForCall)
+}
+
+func (fake *FakeCellCordonDB) StartDrainingCellCalls(stub func(context.Context, lager.Logger, string) error) {
+	fake.startDrainingCellMutex.Lock()
+	defer fake.startDrainingCellMutex.Unlock()
+	fake.StartDrainingCellStub = stub
+}
+
+func (fake *FakeCellCordonDB) StartDrainingCellArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.startDrainingCellMutex.RLock()
+	defer fake.startDrainingCellMutex.RUnlock()
+	argsForCall := fake.startDrainingCellArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeCellCordonDB) StartDrainingCellReturns(result1 error) {
+	fake.startDrainingCellMutex.Lock()
+	defer fake.startDrainingCellMutex.Unlock()
+	fake.StartDrainingCellStub = nil
+	fake.startDrainingCellReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCellCordonDB) StartDrainingCellReturnsOnCall(i int, result1 error) {
+	fake.startDrainingCellMutex.Lock()
+	defer fake.startDrainingCellMutex.Unlock()
+	fake.StartDrainingCellStub = nil
+	if fake.startDrainingCellReturnsOnCall == nil {
+		fake.startDrainingCellReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.startDrainingCellReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCellCordonDB) UncordonCell(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
+	fake.uncordonCellMutex.Lock()
+	ret, specificReturn := fake.uncordonCellReturnsOnCall[len(fake.uncordonCellArgsForCall)]
+	fake.uncordonCellArgsForCall = append(fake.uncordonCellArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.UncordonCellStub
+	fakeReturns := fake.uncordonCellReturns
+	fake.recordInvocation("UncordonCell", []interface{}{arg1, arg2, arg3})
+	fake.uncordonCellMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeCellCordonDB) UncordonCellCallCount() int {
+	fake.uncordonCellMutex.RLock()
+	defer fake.uncordonCellMutex.RUnlock()
+	return len(fake.uncordonCellArgsForCall)
+}
+
+func (fake *FakeCellCordonDB) UncordonCellCalls(stub func(context.Context, lager.Logger, string) error) {
+	fake.uncordonCellMutex.Lock()
+	defer fake.uncordonCellMutex.Unlock()
+	fake.UncordonCellStub = stub
+}
+
+func (fake *FakeCellCordonDB) UncordonCellArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.uncordonCellMutex.RLock()
+	defer fake.uncordonCellMutex.RUnlock()
+	argsForCall := fake.uncordonCellArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeCellCordonDB) UncordonCellReturns(result1 error) {
+	fake.uncordonCellMutex.Lock()
+	defer fake.uncordonCellMutex.Unlock()
+	fake.UncordonCellStub = nil
+	fake.uncordonCellReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCellCordonDB) UncordonCellReturnsOnCall(i int, result1 error) {
+	fake.uncordonCellMutex.Lock()
+	defer fake.uncordonCellMutex.Unlock()
+	fake.UncordonCellStub = nil
+	if fake.uncordonCellReturnsOnCall == nil {
+		fake.uncordonCellReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.uncordonCellReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCellCordonDB) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.cellDrainStatusMutex.RLock()
+	defer fake.cellDrainStatusMutex.RUnlock()
+	fake.cordonCellMutex.RLock()
+	defer fake.cordonCellMutex.RUnlock()
+	fake.cordonedCellsMutex.RLock()
+	defer fake.cordonedCellsMutex.RUnlock()
+	fake.startDrainingCellMutex.RLock()
+	defer fake.startDrainingCellMutex.RUnlock()
+	fake.uncordonCellMutex.RLock()
+	defer fake.uncordonCellMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeCellCordonDB) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.CellCordonDB = new(FakeCellCordonDB)
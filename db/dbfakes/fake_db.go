@@ -13,6 +13,22 @@ import (
 )
 
 type FakeDB struct {
+	ActualLRPCrashesStub        func(context.Context, lager.Logger, string, *int32) ([]*models.ActualLRPCrash, error)
+	actualLRPCrashesMutex       sync.RWMutex
+	actualLRPCrashesArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 *int32
+	}
+	actualLRPCrashesReturns struct {
+		result1 []*models.ActualLRPCrash
+		result2 error
+	}
+	actualLRPCrashesReturnsOnCall map[int]struct {
+		result1 []*models.ActualLRPCrash
+		result2 error
+	}
 	ActualLRPsStub        func(context.Context, lager.Logger, models.ActualLRPFilter) ([]*models.ActualLRP, error)
 	actualLRPsMutex       sync.RWMutex
 	actualLRPsArgsForCall []struct {
@@ -28,6 +44,54 @@ type FakeDB struct {
 		result1 []*models.ActualLRP
 		result2 error
 	}
+	AdvanceRollingRestartStub        func(context.Context, lager.Logger, string, time.Duration) ([]*models.ActualLRPKey, error)
+	advanceRollingRestartMutex       sync.RWMutex
+	advanceRollingRestartArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 time.Duration
+	}
+	advanceRollingRestartReturns struct {
+		result1 []*models.ActualLRPKey
+		result2 error
+	}
+	advanceRollingRestartReturnsOnCall map[int]struct {
+		result1 []*models.ActualLRPKey
+		result2 error
+	}
+	ArchivedTaskByGuidStub        func(context.Context, lager.Logger, string) (*models.ArchivedTask, error)
+	archivedTaskByGuidMutex       sync.RWMutex
+	archivedTaskByGuidArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	archivedTaskByGuidReturns struct {
+		result1 *models.ArchivedTask
+		result2 error
+	}
+	archivedTaskByGuidReturnsOnCall map[int]struct {
+		result1 *models.ArchivedTask
+		result2 error
+	}
+	ArchivedTasksStub        func(context.Context, lager.Logger, models.TaskArchiveFilter) ([]*models.ArchivedTask, string, error)
+	archivedTasksMutex       sync.RWMutex
+	archivedTasksArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.TaskArchiveFilter
+	}
+	archivedTasksReturns struct {
+		result1 []*models.ArchivedTask
+		result2 string
+		result3 error
+	}
+	archivedTasksReturnsOnCall map[int]struct {
+		result1 []*models.ArchivedTask
+		result2 string
+		result3 error
+	}
 	CancelTaskStub        func(context.Context, lager.Logger, string) (*models.Task, *models.Task, string, error)
 	cancelTaskMutex       sync.RWMutex
 	cancelTaskArgsForCall []struct {
@@ -47,6 +111,35 @@ type FakeDB struct {
 		result3 string
 		result4 error
 	}
+	CellDrainStatusStub        func(context.Context, lager.Logger, string) (*models.CellDrainStatus, error)
+	cellDrainStatusMutex       sync.RWMutex
+	cellDrainStatusArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	cellDrainStatusReturns struct {
+		result1 *models.CellDrainStatus
+		result2 error
+	}
+	cellDrainStatusReturnsOnCall map[int]struct {
+		result1 *models.CellDrainStatus
+		result2 error
+	}
+	CellReservationsStub        func(context.Context, lager.Logger) (map[string]*models.CellReservation, error)
+	cellReservationsMutex       sync.RWMutex
+	cellReservationsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	cellReservationsReturns struct {
+		result1 map[string]*models.CellReservation
+		result2 error
+	}
+	cellReservationsReturnsOnCall map[int]struct {
+		result1 map[string]*models.CellReservation
+		result2 error
+	}
 	ChangeActualLRPPresenceStub        func(context.Context, lager.Logger, *models.ActualLRPKey, models.ActualLRP_Presence, models.ActualLRP_Presence) (*models.ActualLRP, *models.ActualLRP, error)
 	changeActualLRPPresenceMutex       sync.RWMutex
 	changeActualLRPPresenceArgsForCall []struct {
@@ -119,7 +212,7 @@ type FakeDB struct {
 	convergeLRPsReturnsOnCall map[int]struct {
 		result1 db.ConvergenceResult
 	}
-	ConvergeTasksStub        func(context.Context, lager.Logger, models.CellSet, time.Duration, time.Duration, time.Duration) db.TaskConvergenceResult
+	ConvergeTasksStub        func(context.Context, lager.Logger, models.CellSet, time.Duration, time.Duration, time.Duration, map[string]time.Duration, time.Duration) db.TaskConvergenceResult
 	convergeTasksMutex       sync.RWMutex
 	convergeTasksArgsForCall []struct {
 		arg1 context.Context
@@ -128,6 +221,8 @@ type FakeDB struct {
 		arg4 time.Duration
 		arg5 time.Duration
 		arg6 time.Duration
+		arg7 map[string]time.Duration
+		arg8 time.Duration
 	}
 	convergeTasksReturns struct {
 		result1 db.TaskConvergenceResult
@@ -135,6 +230,33 @@ type FakeDB struct {
 	convergeTasksReturnsOnCall map[int]struct {
 		result1 db.TaskConvergenceResult
 	}
+	CordonCellStub        func(context.Context, lager.Logger, string) error
+	cordonCellMutex       sync.RWMutex
+	cordonCellArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	cordonCellReturns struct {
+		result1 error
+	}
+	cordonCellReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CordonedCellsStub        func(context.Context, lager.Logger) ([]*models.CordonedCell, error)
+	cordonedCellsMutex       sync.RWMutex
+	cordonedCellsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	cordonedCellsReturns struct {
+		result1 []*models.CordonedCell
+		result2 error
+	}
+	cordonedCellsReturnsOnCall map[int]struct {
+		result1 []*models.CordonedCell
+		result2 error
+	}
 	CountActualLRPsByStateStub        func(context.Context, lager.Logger) (int, int, int, int, int)
 	countActualLRPsByStateMutex       sync.RWMutex
 	countActualLRPsByStateArgsForCall []struct {
@@ -203,6 +325,47 @@ type FakeDB struct {
 		result1 *models.ActualLRP
 		result2 error
 	}
+	DeleteDomainStub        func(context.Context, lager.Logger, string) (bool, error)
+	deleteDomainMutex       sync.RWMutex
+	deleteDomainArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	deleteDomainReturns struct {
+		result1 bool
+		result2 error
+	}
+	deleteDomainReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	DeleteDomainQuotaStub        func(context.Context, lager.Logger, string) error
+	deleteDomainQuotaMutex       sync.RWMutex
+	deleteDomainQuotaArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	deleteDomainQuotaReturns struct {
+		result1 error
+	}
+	deleteDomainQuotaReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteScheduledTaskStub        func(context.Context, lager.Logger, string) error
+	deleteScheduledTaskMutex       sync.RWMutex
+	deleteScheduledTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	deleteScheduledTaskReturns struct {
+		result1 error
+	}
+	deleteScheduledTaskReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DeleteTaskStub        func(context.Context, lager.Logger, string) (*models.Task, error)
 	deleteTaskMutex       sync.RWMutex
 	deleteTaskArgsForCall []struct {
@@ -231,6 +394,21 @@ type FakeDB struct {
 	desireLRPReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DesireScheduledTaskStub        func(context.Context, lager.Logger, *models.ScheduledTask) (*models.ScheduledTask, error)
+	desireScheduledTaskMutex       sync.RWMutex
+	desireScheduledTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ScheduledTask
+	}
+	desireScheduledTaskReturns struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
+	desireScheduledTaskReturnsOnCall map[int]struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
 	DesireTaskStub        func(context.Context, lager.Logger, *models.TaskDefinition, string, string) (*models.Task, error)
 	desireTaskMutex       sync.RWMutex
 	desireTaskArgsForCall []struct {
@@ -248,6 +426,24 @@ type FakeDB struct {
 		result1 *models.Task
 		result2 error
 	}
+	DesireTaskArrayStub        func(context.Context, lager.Logger, *models.TaskDefinition, string, string, int32) ([]*models.Task, error)
+	desireTaskArrayMutex       sync.RWMutex
+	desireTaskArrayArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.TaskDefinition
+		arg4 string
+		arg5 string
+		arg6 int32
+	}
+	desireTaskArrayReturns struct {
+		result1 []*models.Task
+		result2 error
+	}
+	desireTaskArrayReturnsOnCall map[int]struct {
+		result1 []*models.Task
+		result2 error
+	}
 	DesiredLRPByProcessGuidStub        func(context.Context, lager.Logger, string) (*models.DesiredLRP, error)
 	desiredLRPByProcessGuidMutex       sync.RWMutex
 	desiredLRPByProcessGuidArgsForCall []struct {
@@ -263,6 +459,37 @@ type FakeDB struct {
 		result1 *models.DesiredLRP
 		result2 error
 	}
+	DesiredLRPRevisionStub        func(context.Context, lager.Logger, string, int32) (*models.DesiredLRPRevision, error)
+	desiredLRPRevisionMutex       sync.RWMutex
+	desiredLRPRevisionArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int32
+	}
+	desiredLRPRevisionReturns struct {
+		result1 *models.DesiredLRPRevision
+		result2 error
+	}
+	desiredLRPRevisionReturnsOnCall map[int]struct {
+		result1 *models.DesiredLRPRevision
+		result2 error
+	}
+	DesiredLRPRevisionsStub        func(context.Context, lager.Logger, string) ([]*models.DesiredLRPRevision, error)
+	desiredLRPRevisionsMutex       sync.RWMutex
+	desiredLRPRevisionsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	desiredLRPRevisionsReturns struct {
+		result1 []*models.DesiredLRPRevision
+		result2 error
+	}
+	desiredLRPRevisionsReturnsOnCall map[int]struct {
+		result1 []*models.DesiredLRPRevision
+		result2 error
+	}
 	DesiredLRPRoutingInfosStub        func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRP, error)
 	desiredLRPRoutingInfosMutex       sync.RWMutex
 	desiredLRPRoutingInfosArgsForCall []struct {
@@ -308,6 +535,21 @@ type FakeDB struct {
 		result1 []*models.DesiredLRPSchedulingInfo
 		result2 error
 	}
+	DesiredLRPStatusesStub        func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRPStatus, error)
+	desiredLRPStatusesMutex       sync.RWMutex
+	desiredLRPStatusesArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.DesiredLRPFilter
+	}
+	desiredLRPStatusesReturns struct {
+		result1 []*models.DesiredLRPStatus
+		result2 error
+	}
+	desiredLRPStatusesReturnsOnCall map[int]struct {
+		result1 []*models.DesiredLRPStatus
+		result2 error
+	}
 	DesiredLRPsStub        func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRP, error)
 	desiredLRPsMutex       sync.RWMutex
 	desiredLRPsArgsForCall []struct {
@@ -323,6 +565,49 @@ type FakeDB struct {
 		result1 []*models.DesiredLRP
 		result2 error
 	}
+	DomainQuotasStub        func(context.Context, lager.Logger) ([]*models.DomainQuota, error)
+	domainQuotasMutex       sync.RWMutex
+	domainQuotasArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	domainQuotasReturns struct {
+		result1 []*models.DomainQuota
+		result2 error
+	}
+	domainQuotasReturnsOnCall map[int]struct {
+		result1 []*models.DomainQuota
+		result2 error
+	}
+	DomainUsageStub        func(context.Context, lager.Logger, string) (*models.DomainUsage, error)
+	domainUsageMutex       sync.RWMutex
+	domainUsageArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	domainUsageReturns struct {
+		result1 *models.DomainUsage
+		result2 error
+	}
+	domainUsageReturnsOnCall map[int]struct {
+		result1 *models.DomainUsage
+		result2 error
+	}
+	DomainsStub        func(context.Context, lager.Logger) ([]*models.Domain, error)
+	domainsMutex       sync.RWMutex
+	domainsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	domainsReturns struct {
+		result1 []*models.Domain
+		result2 error
+	}
+	domainsReturnsOnCall map[int]struct {
+		result1 []*models.Domain
+		result2 error
+	}
 	EncryptionKeyLabelStub        func(context.Context, lager.Logger) (string, error)
 	encryptionKeyLabelMutex       sync.RWMutex
 	encryptionKeyLabelArgsForCall []struct {
@@ -358,6 +643,21 @@ type FakeDB struct {
 		result1 *models.ActualLRP
 		result2 error
 	}
+	ExpireDomainStub        func(context.Context, lager.Logger, string) (bool, error)
+	expireDomainMutex       sync.RWMutex
+	expireDomainArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	expireDomainReturns struct {
+		result1 bool
+		result2 error
+	}
+	expireDomainReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	FailActualLRPStub        func(context.Context, lager.Logger, *models.ActualLRPKey, string) (*models.ActualLRP, *models.ActualLRP, error)
 	failActualLRPMutex       sync.RWMutex
 	failActualLRPArgsForCall []struct {
@@ -440,6 +740,33 @@ type FakeDB struct {
 		result3 *models.ActualLRP
 		result4 error
 	}
+	PruneActualLRPCrashesStub        func(context.Context, lager.Logger) error
+	pruneActualLRPCrashesMutex       sync.RWMutex
+	pruneActualLRPCrashesArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	pruneActualLRPCrashesReturns struct {
+		result1 error
+	}
+	pruneActualLRPCrashesReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RecordScheduledTaskRunStub        func(context.Context, lager.Logger, string, int64, string) error
+	recordScheduledTaskRunMutex       sync.RWMutex
+	recordScheduledTaskRunArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int64
+		arg5 string
+	}
+	recordScheduledTaskRunReturns struct {
+		result1 error
+	}
+	recordScheduledTaskRunReturnsOnCall map[int]struct {
+		result1 error
+	}
 	RejectTaskStub        func(context.Context, lager.Logger, string, string) (*models.Task, *models.Task, error)
 	rejectTaskMutex       sync.RWMutex
 	rejectTaskArgsForCall []struct {
@@ -458,6 +785,23 @@ type FakeDB struct {
 		result2 *models.Task
 		result3 error
 	}
+	ReleaseBlockedTasksStub        func(context.Context, lager.Logger, ...string) ([]*models.TaskChange, []*models.TaskChange, error)
+	releaseBlockedTasksMutex       sync.RWMutex
+	releaseBlockedTasksArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 []string
+	}
+	releaseBlockedTasksReturns struct {
+		result1 []*models.TaskChange
+		result2 []*models.TaskChange
+		result3 error
+	}
+	releaseBlockedTasksReturnsOnCall map[int]struct {
+		result1 []*models.TaskChange
+		result2 []*models.TaskChange
+		result3 error
+	}
 	RemoveActualLRPStub        func(context.Context, lager.Logger, string, int32, *models.ActualLRPInstanceKey) error
 	removeActualLRPMutex       sync.RWMutex
 	removeActualLRPArgsForCall []struct {
@@ -532,6 +876,52 @@ type FakeDB struct {
 		result2 *models.Task
 		result3 error
 	}
+	RollbackDesiredLRPStub        func(context.Context, lager.Logger, string, int32) (*models.DesiredLRP, error)
+	rollbackDesiredLRPMutex       sync.RWMutex
+	rollbackDesiredLRPArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int32
+	}
+	rollbackDesiredLRPReturns struct {
+		result1 *models.DesiredLRP
+		result2 error
+	}
+	rollbackDesiredLRPReturnsOnCall map[int]struct {
+		result1 *models.DesiredLRP
+		result2 error
+	}
+	ScheduledTaskByGuidStub        func(context.Context, lager.Logger, string) (*models.ScheduledTask, error)
+	scheduledTaskByGuidMutex       sync.RWMutex
+	scheduledTaskByGuidArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	scheduledTaskByGuidReturns struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
+	scheduledTaskByGuidReturnsOnCall map[int]struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
+	ScheduledTasksStub        func(context.Context, lager.Logger, string) ([]*models.ScheduledTask, error)
+	scheduledTasksMutex       sync.RWMutex
+	scheduledTasksArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	scheduledTasksReturns struct {
+		result1 []*models.ScheduledTask
+		result2 error
+	}
+	scheduledTasksReturnsOnCall map[int]struct {
+		result1 []*models.ScheduledTask
+		result2 error
+	}
 	SetEncryptionKeyLabelStub        func(context.Context, lager.Logger, string) error
 	setEncryptionKeyLabelMutex       sync.RWMutex
 	setEncryptionKeyLabelArgsForCall []struct {
@@ -582,6 +972,33 @@ type FakeDB struct {
 		result2 *models.ActualLRP
 		result3 error
 	}
+	StartDrainingCellStub        func(context.Context, lager.Logger, string) error
+	startDrainingCellMutex       sync.RWMutex
+	startDrainingCellArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	startDrainingCellReturns struct {
+		result1 error
+	}
+	startDrainingCellReturnsOnCall map[int]struct {
+		result1 error
+	}
+	StartRollingRestartStub        func(context.Context, lager.Logger, string, int32) error
+	startRollingRestartMutex       sync.RWMutex
+	startRollingRestartArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int32
+	}
+	startRollingRestartReturns struct {
+		result1 error
+	}
+	startRollingRestartReturnsOnCall map[int]struct {
+		result1 error
+	}
 	StartTaskStub        func(context.Context, lager.Logger, string, string) (*models.Task, *models.Task, bool, error)
 	startTaskMutex       sync.RWMutex
 	startTaskArgsForCall []struct {
@@ -617,6 +1034,21 @@ type FakeDB struct {
 		result1 *models.Task
 		result2 error
 	}
+	TaskResultStub        func(context.Context, lager.Logger, string) (string, error)
+	taskResultMutex       sync.RWMutex
+	taskResultArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	taskResultReturns struct {
+		result1 string
+		result2 error
+	}
+	taskResultReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	TasksStub        func(context.Context, lager.Logger, models.TaskFilter) ([]*models.Task, error)
 	tasksMutex       sync.RWMutex
 	tasksArgsForCall []struct {
@@ -632,6 +1064,21 @@ type FakeDB struct {
 		result1 []*models.Task
 		result2 error
 	}
+	TasksByArrayGuidStub        func(context.Context, lager.Logger, string) ([]*models.Task, error)
+	tasksByArrayGuidMutex       sync.RWMutex
+	tasksByArrayGuidArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	tasksByArrayGuidReturns struct {
+		result1 []*models.Task
+		result2 error
+	}
+	tasksByArrayGuidReturnsOnCall map[int]struct {
+		result1 []*models.Task
+		result2 error
+	}
 	UnclaimActualLRPStub        func(context.Context, lager.Logger, *models.ActualLRPKey) (*models.ActualLRP, *models.ActualLRP, error)
 	unclaimActualLRPMutex       sync.RWMutex
 	unclaimActualLRPArgsForCall []struct {
@@ -649,6 +1096,19 @@ type FakeDB struct {
 		result2 *models.ActualLRP
 		result3 error
 	}
+	UncordonCellStub        func(context.Context, lager.Logger, string) error
+	uncordonCellMutex       sync.RWMutex
+	uncordonCellArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	uncordonCellReturns struct {
+		result1 error
+	}
+	uncordonCellReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UpdateDesiredLRPStub        func(context.Context, lager.Logger, string, *models.DesiredLRPUpdate) (*models.DesiredLRP, error)
 	updateDesiredLRPMutex       sync.RWMutex
 	updateDesiredLRPArgsForCall []struct {
@@ -665,18 +1125,49 @@ type FakeDB struct {
 		result1 *models.DesiredLRP
 		result2 error
 	}
-	UpsertDomainStub        func(context.Context, lager.Logger, string, uint32) error
-	upsertDomainMutex       sync.RWMutex
+	UpdateScheduledTaskStub        func(context.Context, lager.Logger, *models.ScheduledTask) (*models.ScheduledTask, error)
+	updateScheduledTaskMutex       sync.RWMutex
+	updateScheduledTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ScheduledTask
+	}
+	updateScheduledTaskReturns struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
+	updateScheduledTaskReturnsOnCall map[int]struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
+	UpsertDomainStub        func(context.Context, lager.Logger, string, uint32, string) (bool, error)
+	upsertDomainMutex       sync.RWMutex
 	upsertDomainArgsForCall []struct {
 		arg1 context.Context
 		arg2 lager.Logger
 		arg3 string
 		arg4 uint32
+		arg5 string
 	}
 	upsertDomainReturns struct {
-		result1 error
+		result1 bool
+		result2 error
 	}
 	upsertDomainReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	UpsertDomainQuotaStub        func(context.Context, lager.Logger, *models.DomainQuota) error
+	upsertDomainQuotaMutex       sync.RWMutex
+	upsertDomainQuotaArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.DomainQuota
+	}
+	upsertDomainQuotaReturns struct {
+		result1 error
+	}
+	upsertDomainQuotaReturnsOnCall map[int]struct {
 		result1 error
 	}
 	VersionStub        func(helpers.Tx, context.Context, lager.Logger) (*models.Version, error)
@@ -698,6 +1189,73 @@ type FakeDB struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeDB) ActualLRPCrashes(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 *int32) ([]*models.ActualLRPCrash, error) {
+	fake.actualLRPCrashesMutex.Lock()
+	ret, specificReturn := fake.actualLRPCrashesReturnsOnCall[len(fake.actualLRPCrashesArgsForCall)]
+	fake.actualLRPCrashesArgsForCall = append(fake.actualLRPCrashesArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 *int32
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ActualLRPCrashesStub
+	fakeReturns := fake.actualLRPCrashesReturns
+	fake.recordInvocation("ActualLRPCrashes", []interface{}{arg1, arg2, arg3, arg4})
+	fake.actualLRPCrashesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) ActualLRPCrashesCallCount() int {
+	fake.actualLRPCrashesMutex.RLock()
+	defer fake.actualLRPCrashesMutex.RUnlock()
+	return len(fake.actualLRPCrashesArgsForCall)
+}
+
+func (fake *FakeDB) ActualLRPCrashesCalls(stub func(context.Context, lager.Logger, string, *int32) ([]*models.ActualLRPCrash, error)) {
+	fake.actualLRPCrashesMutex.Lock()
+	defer fake.actualLRPCrashesMutex.Unlock()
+	fake.ActualLRPCrashesStub = stub
+}
+
+func (fake *FakeDB) ActualLRPCrashesArgsForCall(i int) (context.Context, lager.Logger, string, *int32) {
+	fake.actualLRPCrashesMutex.RLock()
+	defer fake.actualLRPCrashesMutex.RUnlock()
+	argsForCall := fake.actualLRPCrashesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeDB) ActualLRPCrashesReturns(result1 []*models.ActualLRPCrash, result2 error) {
+	fake.actualLRPCrashesMutex.Lock()
+	defer fake.actualLRPCrashesMutex.Unlock()
+	fake.ActualLRPCrashesStub = nil
+	fake.actualLRPCrashesReturns = struct {
+		result1 []*models.ActualLRPCrash
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) ActualLRPCrashesReturnsOnCall(i int, result1 []*models.ActualLRPCrash, result2 error) {
+	fake.actualLRPCrashesMutex.Lock()
+	defer fake.actualLRPCrashesMutex.Unlock()
+	fake.ActualLRPCrashesStub = nil
+	if fake.actualLRPCrashesReturnsOnCall == nil {
+		fake.actualLRPCrashesReturnsOnCall = make(map[int]struct {
+			result1 []*models.ActualLRPCrash
+			result2 error
+		})
+	}
+	fake.actualLRPCrashesReturnsOnCall[i] = struct {
+		result1 []*models.ActualLRPCrash
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDB) ActualLRPs(arg1 context.Context, arg2 lager.Logger, arg3 models.ActualLRPFilter) ([]*models.ActualLRP, error) {
 	fake.actualLRPsMutex.Lock()
 	ret, specificReturn := fake.actualLRPsReturnsOnCall[len(fake.actualLRPsArgsForCall)]
@@ -764,6 +1322,208 @@ func (fake *FakeDB) ActualLRPsReturnsOnCall(i int, result1 []*models.ActualLRP,
 	}{result1, result2}
 }
 
+func (fake *FakeDB) AdvanceRollingRestart(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 time.Duration) ([]*models.ActualLRPKey, error) {
+	fake.advanceRollingRestartMutex.Lock()
+	ret, specificReturn := fake.advanceRollingRestartReturnsOnCall[len(fake.advanceRollingRestartArgsForCall)]
+	fake.advanceRollingRestartArgsForCall = append(fake.advanceRollingRestartArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 time.Duration
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.AdvanceRollingRestartStub
+	fakeReturns := fake.advanceRollingRestartReturns
+	fake.recordInvocation("AdvanceRollingRestart", []interface{}{arg1, arg2, arg3, arg4})
+	fake.advanceRollingRestartMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) AdvanceRollingRestartCallCount() int {
+	fake.advanceRollingRestartMutex.RLock()
+	defer fake.advanceRollingRestartMutex.RUnlock()
+	return len(fake.advanceRollingRestartArgsForCall)
+}
+
+func (fake *FakeDB) AdvanceRollingRestartCalls(stub func(context.Context, lager.Logger, string, time.Duration) ([]*models.ActualLRPKey, error)) {
+	fake.advanceRollingRestartMutex.Lock()
+	defer fake.advanceRollingRestartMutex.Unlock()
+	fake.AdvanceRollingRestartStub = stub
+}
+
+func (fake *FakeDB) AdvanceRollingRestartArgsForCall(i int) (context.Context, lager.Logger, string, time.Duration) {
+	fake.advanceRollingRestartMutex.RLock()
+	defer fake.advanceRollingRestartMutex.RUnlock()
+	argsForCall := fake.advanceRollingRestartArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeDB) AdvanceRollingRestartReturns(result1 []*models.ActualLRPKey, result2 error) {
+	fake.advanceRollingRestartMutex.Lock()
+	defer fake.advanceRollingRestartMutex.Unlock()
+	fake.AdvanceRollingRestartStub = nil
+	fake.advanceRollingRestartReturns = struct {
+		result1 []*models.ActualLRPKey
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) AdvanceRollingRestartReturnsOnCall(i int, result1 []*models.ActualLRPKey, result2 error) {
+	fake.advanceRollingRestartMutex.Lock()
+	defer fake.advanceRollingRestartMutex.Unlock()
+	fake.AdvanceRollingRestartStub = nil
+	if fake.advanceRollingRestartReturnsOnCall == nil {
+		fake.advanceRollingRestartReturnsOnCall = make(map[int]struct {
+			result1 []*models.ActualLRPKey
+			result2 error
+		})
+	}
+	fake.advanceRollingRestartReturnsOnCall[i] = struct {
+		result1 []*models.ActualLRPKey
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) ArchivedTaskByGuid(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.ArchivedTask, error) {
+	fake.archivedTaskByGuidMutex.Lock()
+	ret, specificReturn := fake.archivedTaskByGuidReturnsOnCall[len(fake.archivedTaskByGuidArgsForCall)]
+	fake.archivedTaskByGuidArgsForCall = append(fake.archivedTaskByGuidArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ArchivedTaskByGuidStub
+	fakeReturns := fake.archivedTaskByGuidReturns
+	fake.recordInvocation("ArchivedTaskByGuid", []interface{}{arg1, arg2, arg3})
+	fake.archivedTaskByGuidMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) ArchivedTaskByGuidCallCount() int {
+	fake.archivedTaskByGuidMutex.RLock()
+	defer fake.archivedTaskByGuidMutex.RUnlock()
+	return len(fake.archivedTaskByGuidArgsForCall)
+}
+
+func (fake *FakeDB) ArchivedTaskByGuidCalls(stub func(context.Context, lager.Logger, string) (*models.ArchivedTask, error)) {
+	fake.archivedTaskByGuidMutex.Lock()
+	defer fake.archivedTaskByGuidMutex.Unlock()
+	fake.ArchivedTaskByGuidStub = stub
+}
+
+func (fake *FakeDB) ArchivedTaskByGuidArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.archivedTaskByGuidMutex.RLock()
+	defer fake.archivedTaskByGuidMutex.RUnlock()
+	argsForCall := fake.archivedTaskByGuidArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) ArchivedTaskByGuidReturns(result1 *models.ArchivedTask, result2 error) {
+	fake.archivedTaskByGuidMutex.Lock()
+	defer fake.archivedTaskByGuidMutex.Unlock()
+	fake.ArchivedTaskByGuidStub = nil
+	fake.archivedTaskByGuidReturns = struct {
+		result1 *models.ArchivedTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) ArchivedTaskByGuidReturnsOnCall(i int, result1 *models.ArchivedTask, result2 error) {
+	fake.archivedTaskByGuidMutex.Lock()
+	defer fake.archivedTaskByGuidMutex.Unlock()
+	fake.ArchivedTaskByGuidStub = nil
+	if fake.archivedTaskByGuidReturnsOnCall == nil {
+		fake.archivedTaskByGuidReturnsOnCall = make(map[int]struct {
+			result1 *models.ArchivedTask
+			result2 error
+		})
+	}
+	fake.archivedTaskByGuidReturnsOnCall[i] = struct {
+		result1 *models.ArchivedTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) ArchivedTasks(arg1 context.Context, arg2 lager.Logger, arg3 models.TaskArchiveFilter) ([]*models.ArchivedTask, string, error) {
+	fake.archivedTasksMutex.Lock()
+	ret, specificReturn := fake.archivedTasksReturnsOnCall[len(fake.archivedTasksArgsForCall)]
+	fake.archivedTasksArgsForCall = append(fake.archivedTasksArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.TaskArchiveFilter
+	}{arg1, arg2, arg3})
+	stub := fake.ArchivedTasksStub
+	fakeReturns := fake.archivedTasksReturns
+	fake.recordInvocation("ArchivedTasks", []interface{}{arg1, arg2, arg3})
+	fake.archivedTasksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeDB) ArchivedTasksCallCount() int {
+	fake.archivedTasksMutex.RLock()
+	defer fake.archivedTasksMutex.RUnlock()
+	return len(fake.archivedTasksArgsForCall)
+}
+
+func (fake *FakeDB) ArchivedTasksCalls(stub func(context.Context, lager.Logger, models.TaskArchiveFilter) ([]*models.ArchivedTask, string, error)) {
+	fake.archivedTasksMutex.Lock()
+	defer fake.archivedTasksMutex.Unlock()
+	fake.ArchivedTasksStub = stub
+}
+
+func (fake *FakeDB) ArchivedTasksArgsForCall(i int) (context.Context, lager.Logger, models.TaskArchiveFilter) {
+	fake.archivedTasksMutex.RLock()
+	defer fake.archivedTasksMutex.RUnlock()
+	argsForCall := fake.archivedTasksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) ArchivedTasksReturns(result1 []*models.ArchivedTask, result2 string, result3 error) {
+	fake.archivedTasksMutex.Lock()
+	defer fake.archivedTasksMutex.Unlock()
+	fake.ArchivedTasksStub = nil
+	fake.archivedTasksReturns = struct {
+		result1 []*models.ArchivedTask
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDB) ArchivedTasksReturnsOnCall(i int, result1 []*models.ArchivedTask, result2 string, result3 error) {
+	fake.archivedTasksMutex.Lock()
+	defer fake.archivedTasksMutex.Unlock()
+	fake.ArchivedTasksStub = nil
+	if fake.archivedTasksReturnsOnCall == nil {
+		fake.archivedTasksReturnsOnCall = make(map[int]struct {
+			result1 []*models.ArchivedTask
+			result2 string
+			result3 error
+		})
+	}
+	fake.archivedTasksReturnsOnCall[i] = struct {
+		result1 []*models.ArchivedTask
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeDB) CancelTask(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.Task, *models.Task, string, error) {
 	fake.cancelTaskMutex.Lock()
 	ret, specificReturn := fake.cancelTaskReturnsOnCall[len(fake.cancelTaskArgsForCall)]
@@ -836,6 +1596,137 @@ func (fake *FakeDB) CancelTaskReturnsOnCall(i int, result1 *models.Task, result2
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeDB) CellDrainStatus(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.CellDrainStatus, error) {
+	fake.cellDrainStatusMutex.Lock()
+	ret, specificReturn := fake.cellDrainStatusReturnsOnCall[len(fake.cellDrainStatusArgsForCall)]
+	fake.cellDrainStatusArgsForCall = append(fake.cellDrainStatusArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.CellDrainStatusStub
+	fakeReturns := fake.cellDrainStatusReturns
+	fake.recordInvocation("CellDrainStatus", []interface{}{arg1, arg2, arg3})
+	fake.cellDrainStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) CellDrainStatusCallCount() int {
+	fake.cellDrainStatusMutex.RLock()
+	defer fake.cellDrainStatusMutex.RUnlock()
+	return len(fake.cellDrainStatusArgsForCall)
+}
+
+func (fake *FakeDB) CellDrainStatusCalls(stub func(context.Context, lager.Logger, string) (*models.CellDrainStatus, error)) {
+	fake.cellDrainStatusMutex.Lock()
+	defer fake.cellDrainStatusMutex.Unlock()
+	fake.CellDrainStatusStub = stub
+}
+
+func (fake *FakeDB) CellDrainStatusArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.cellDrainStatusMutex.RLock()
+	defer fake.cellDrainStatusMutex.RUnlock()
+	argsForCall := fake.cellDrainStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) CellDrainStatusReturns(result1 *models.CellDrainStatus, result2 error) {
+	fake.cellDrainStatusMutex.Lock()
+	defer fake.cellDrainStatusMutex.Unlock()
+	fake.CellDrainStatusStub = nil
+	fake.cellDrainStatusReturns = struct {
+		result1 *models.CellDrainStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) CellDrainStatusReturnsOnCall(i int, result1 *models.CellDrainStatus, result2 error) {
+	fake.cellDrainStatusMutex.Lock()
+	defer fake.cellDrainStatusMutex.Unlock()
+	fake.CellDrainStatusStub = nil
+	if fake.cellDrainStatusReturnsOnCall == nil {
+		fake.cellDrainStatusReturnsOnCall = make(map[int]struct {
+			result1 *models.CellDrainStatus
+			result2 error
+		})
+	}
+	fake.cellDrainStatusReturnsOnCall[i] = struct {
+		result1 *models.CellDrainStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) CellReservations(arg1 context.Context, arg2 lager.Logger) (map[string]*models.CellReservation, error) {
+	fake.cellReservationsMutex.Lock()
+	ret, specificReturn := fake.cellReservationsReturnsOnCall[len(fake.cellReservationsArgsForCall)]
+	fake.cellReservationsArgsForCall = append(fake.cellReservationsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.CellReservationsStub
+	fakeReturns := fake.cellReservationsReturns
+	fake.recordInvocation("CellReservations", []interface{}{arg1, arg2})
+	fake.cellReservationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) CellReservationsCallCount() int {
+	fake.cellReservationsMutex.RLock()
+	defer fake.cellReservationsMutex.RUnlock()
+	return len(fake.cellReservationsArgsForCall)
+}
+
+func (fake *FakeDB) CellReservationsCalls(stub func(context.Context, lager.Logger) (map[string]*models.CellReservation, error)) {
+	fake.cellReservationsMutex.Lock()
+	defer fake.cellReservationsMutex.Unlock()
+	fake.CellReservationsStub = stub
+}
+
+func (fake *FakeDB) CellReservationsArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.cellReservationsMutex.RLock()
+	defer fake.cellReservationsMutex.RUnlock()
+	argsForCall := fake.cellReservationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDB) CellReservationsReturns(result1 map[string]*models.CellReservation, result2 error) {
+	fake.cellReservationsMutex.Lock()
+	defer fake.cellReservationsMutex.Unlock()
+	fake.CellReservationsStub = nil
+	fake.cellReservationsReturns = struct {
+		result1 map[string]*models.CellReservation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) CellReservationsReturnsOnCall(i int, result1 map[string]*models.CellReservation, result2 error) {
+	fake.cellReservationsMutex.Lock()
+	defer fake.cellReservationsMutex.Unlock()
+	fake.CellReservationsStub = nil
+	if fake.cellReservationsReturnsOnCall == nil {
+		fake.cellReservationsReturnsOnCall = make(map[int]struct {
+			result1 map[string]*models.CellReservation
+			result2 error
+		})
+	}
+	fake.cellReservationsReturnsOnCall[i] = struct {
+		result1 map[string]*models.CellReservation
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDB) ChangeActualLRPPresence(arg1 context.Context, arg2 lager.Logger, arg3 *models.ActualLRPKey, arg4 models.ActualLRP_Presence, arg5 models.ActualLRP_Presence) (*models.ActualLRP, *models.ActualLRP, error) {
 	fake.changeActualLRPPresenceMutex.Lock()
 	ret, specificReturn := fake.changeActualLRPPresenceReturnsOnCall[len(fake.changeActualLRPPresenceArgsForCall)]
@@ -1114,7 +2005,7 @@ func (fake *FakeDB) ConvergeLRPsReturnsOnCall(i int, result1 db.ConvergenceResul
 	}{result1}
 }
 
-func (fake *FakeDB) ConvergeTasks(arg1 context.Context, arg2 lager.Logger, arg3 models.CellSet, arg4 time.Duration, arg5 time.Duration, arg6 time.Duration) db.TaskConvergenceResult {
+func (fake *FakeDB) ConvergeTasks(arg1 context.Context, arg2 lager.Logger, arg3 models.CellSet, arg4 time.Duration, arg5 time.Duration, arg6 time.Duration, arg7 map[string]time.Duration, arg8 time.Duration) db.TaskConvergenceResult {
 	fake.convergeTasksMutex.Lock()
 	ret, specificReturn := fake.convergeTasksReturnsOnCall[len(fake.convergeTasksArgsForCall)]
 	fake.convergeTasksArgsForCall = append(fake.convergeTasksArgsForCall, struct {
@@ -1124,13 +2015,15 @@ func (fake *FakeDB) ConvergeTasks(arg1 context.Context, arg2 lager.Logger, arg3
 		arg4 time.Duration
 		arg5 time.Duration
 		arg6 time.Duration
-	}{arg1, arg2, arg3, arg4, arg5, arg6})
+		arg7 map[string]time.Duration
+		arg8 time.Duration
+	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8})
 	stub := fake.ConvergeTasksStub
 	fakeReturns := fake.convergeTasksReturns
-	fake.recordInvocation("ConvergeTasks", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.recordInvocation("ConvergeTasks", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8})
 	fake.convergeTasksMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8)
 	}
 	if specificReturn {
 		return ret.result1
@@ -1144,17 +2037,17 @@ func (fake *FakeDB) ConvergeTasksCallCount() int {
 	return len(fake.convergeTasksArgsForCall)
 }
 
-func (fake *FakeDB) ConvergeTasksCalls(stub func(context.Context, lager.Logger, models.CellSet, time.Duration, time.Duration, time.Duration) db.TaskConvergenceResult) {
+func (fake *FakeDB) ConvergeTasksCalls(stub func(context.Context, lager.Logger, models.CellSet, time.Duration, time.Duration, time.Duration, map[string]time.Duration, time.Duration) db.TaskConvergenceResult) {
 	fake.convergeTasksMutex.Lock()
 	defer fake.convergeTasksMutex.Unlock()
 	fake.ConvergeTasksStub = stub
 }
 
-func (fake *FakeDB) ConvergeTasksArgsForCall(i int) (context.Context, lager.Logger, models.CellSet, time.Duration, time.Duration, time.Duration) {
+func (fake *FakeDB) ConvergeTasksArgsForCall(i int) (context.Context, lager.Logger, models.CellSet, time.Duration, time.Duration, time.Duration, map[string]time.Duration, time.Duration) {
 	fake.convergeTasksMutex.RLock()
 	defer fake.convergeTasksMutex.RUnlock()
 	argsForCall := fake.convergeTasksArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7, argsForCall.arg8
 }
 
 func (fake *FakeDB) ConvergeTasksReturns(result1 db.TaskConvergenceResult) {
@@ -1180,64 +2073,192 @@ func (fake *FakeDB) ConvergeTasksReturnsOnCall(i int, result1 db.TaskConvergence
 	}{result1}
 }
 
-func (fake *FakeDB) CountActualLRPsByState(arg1 context.Context, arg2 lager.Logger) (int, int, int, int, int) {
-	fake.countActualLRPsByStateMutex.Lock()
-	ret, specificReturn := fake.countActualLRPsByStateReturnsOnCall[len(fake.countActualLRPsByStateArgsForCall)]
-	fake.countActualLRPsByStateArgsForCall = append(fake.countActualLRPsByStateArgsForCall, struct {
+func (fake *FakeDB) CordonCell(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
+	fake.cordonCellMutex.Lock()
+	ret, specificReturn := fake.cordonCellReturnsOnCall[len(fake.cordonCellArgsForCall)]
+	fake.cordonCellArgsForCall = append(fake.cordonCellArgsForCall, struct {
 		arg1 context.Context
 		arg2 lager.Logger
-	}{arg1, arg2})
-	stub := fake.CountActualLRPsByStateStub
-	fakeReturns := fake.countActualLRPsByStateReturns
-	fake.recordInvocation("CountActualLRPsByState", []interface{}{arg1, arg2})
-	fake.countActualLRPsByStateMutex.Unlock()
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.CordonCellStub
+	fakeReturns := fake.cordonCellReturns
+	fake.recordInvocation("CordonCell", []interface{}{arg1, arg2, arg3})
+	fake.cordonCellMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3, ret.result4, ret.result5
+		return ret.result1
 	}
-	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3, fakeReturns.result4, fakeReturns.result5
+	return fakeReturns.result1
 }
 
-func (fake *FakeDB) CountActualLRPsByStateCallCount() int {
-	fake.countActualLRPsByStateMutex.RLock()
-	defer fake.countActualLRPsByStateMutex.RUnlock()
-	return len(fake.countActualLRPsByStateArgsForCall)
+func (fake *FakeDB) CordonCellCallCount() int {
+	fake.cordonCellMutex.RLock()
+	defer fake.cordonCellMutex.RUnlock()
+	return len(fake.cordonCellArgsForCall)
 }
 
-func (fake *FakeDB) CountActualLRPsByStateCalls(stub func(context.Context, lager.Logger) (int, int, int, int, int)) {
-	fake.countActualLRPsByStateMutex.Lock()
-	defer fake.countActualLRPsByStateMutex.Unlock()
-	fake.CountActualLRPsByStateStub = stub
+func (fake *FakeDB) CordonCellCalls(stub func(context.Context, lager.Logger, string) error) {
+	fake.cordonCellMutex.Lock()
+	defer fake.cordonCellMutex.Unlock()
+	fake.CordonCellStub = stub
 }
 
-func (fake *FakeDB) CountActualLRPsByStateArgsForCall(i int) (context.Context, lager.Logger) {
-	fake.countActualLRPsByStateMutex.RLock()
-	defer fake.countActualLRPsByStateMutex.RUnlock()
-	argsForCall := fake.countActualLRPsByStateArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeDB) CordonCellArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.cordonCellMutex.RLock()
+	defer fake.cordonCellMutex.RUnlock()
+	argsForCall := fake.cordonCellArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeDB) CountActualLRPsByStateReturns(result1 int, result2 int, result3 int, result4 int, result5 int) {
-	fake.countActualLRPsByStateMutex.Lock()
-	defer fake.countActualLRPsByStateMutex.Unlock()
-	fake.CountActualLRPsByStateStub = nil
-	fake.countActualLRPsByStateReturns = struct {
-		result1 int
-		result2 int
-		result3 int
-		result4 int
-		result5 int
-	}{result1, result2, result3, result4, result5}
+func (fake *FakeDB) CordonCellReturns(result1 error) {
+	fake.cordonCellMutex.Lock()
+	defer fake.cordonCellMutex.Unlock()
+	fake.CordonCellStub = nil
+	fake.cordonCellReturns = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeDB) CountActualLRPsByStateReturnsOnCall(i int, result1 int, result2 int, result3 int, result4 int, result5 int) {
-	fake.countActualLRPsByStateMutex.Lock()
-	defer fake.countActualLRPsByStateMutex.Unlock()
-	fake.CountActualLRPsByStateStub = nil
-	if fake.countActualLRPsByStateReturnsOnCall == nil {
-		fake.countActualLRPsByStateReturnsOnCall = make(map[int]struct {
+func (fake *FakeDB) CordonCellReturnsOnCall(i int, result1 error) {
+	fake.cordonCellMutex.Lock()
+	defer fake.cordonCellMutex.Unlock()
+	fake.CordonCellStub = nil
+	if fake.cordonCellReturnsOnCall == nil {
+		fake.cordonCellReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.cordonCellReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) CordonedCells(arg1 context.Context, arg2 lager.Logger) ([]*models.CordonedCell, error) {
+	fake.cordonedCellsMutex.Lock()
+	ret, specificReturn := fake.cordonedCellsReturnsOnCall[len(fake.cordonedCellsArgsForCall)]
+	fake.cordonedCellsArgsForCall = append(fake.cordonedCellsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.CordonedCellsStub
+	fakeReturns := fake.cordonedCellsReturns
+	fake.recordInvocation("CordonedCells", []interface{}{arg1, arg2})
+	fake.cordonedCellsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) CordonedCellsCallCount() int {
+	fake.cordonedCellsMutex.RLock()
+	defer fake.cordonedCellsMutex.RUnlock()
+	return len(fake.cordonedCellsArgsForCall)
+}
+
+func (fake *FakeDB) CordonedCellsCalls(stub func(context.Context, lager.Logger) ([]*models.CordonedCell, error)) {
+	fake.cordonedCellsMutex.Lock()
+	defer fake.cordonedCellsMutex.Unlock()
+	fake.CordonedCellsStub = stub
+}
+
+func (fake *FakeDB) CordonedCellsArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.cordonedCellsMutex.RLock()
+	defer fake.cordonedCellsMutex.RUnlock()
+	argsForCall := fake.cordonedCellsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDB) CordonedCellsReturns(result1 []*models.CordonedCell, result2 error) {
+	fake.cordonedCellsMutex.Lock()
+	defer fake.cordonedCellsMutex.Unlock()
+	fake.CordonedCellsStub = nil
+	fake.cordonedCellsReturns = struct {
+		result1 []*models.CordonedCell
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) CordonedCellsReturnsOnCall(i int, result1 []*models.CordonedCell, result2 error) {
+	fake.cordonedCellsMutex.Lock()
+	defer fake.cordonedCellsMutex.Unlock()
+	fake.CordonedCellsStub = nil
+	if fake.cordonedCellsReturnsOnCall == nil {
+		fake.cordonedCellsReturnsOnCall = make(map[int]struct {
+			result1 []*models.CordonedCell
+			result2 error
+		})
+	}
+	fake.cordonedCellsReturnsOnCall[i] = struct {
+		result1 []*models.CordonedCell
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) CountActualLRPsByState(arg1 context.Context, arg2 lager.Logger) (int, int, int, int, int) {
+	fake.countActualLRPsByStateMutex.Lock()
+	ret, specificReturn := fake.countActualLRPsByStateReturnsOnCall[len(fake.countActualLRPsByStateArgsForCall)]
+	fake.countActualLRPsByStateArgsForCall = append(fake.countActualLRPsByStateArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.CountActualLRPsByStateStub
+	fakeReturns := fake.countActualLRPsByStateReturns
+	fake.recordInvocation("CountActualLRPsByState", []interface{}{arg1, arg2})
+	fake.countActualLRPsByStateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4, ret.result5
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3, fakeReturns.result4, fakeReturns.result5
+}
+
+func (fake *FakeDB) CountActualLRPsByStateCallCount() int {
+	fake.countActualLRPsByStateMutex.RLock()
+	defer fake.countActualLRPsByStateMutex.RUnlock()
+	return len(fake.countActualLRPsByStateArgsForCall)
+}
+
+func (fake *FakeDB) CountActualLRPsByStateCalls(stub func(context.Context, lager.Logger) (int, int, int, int, int)) {
+	fake.countActualLRPsByStateMutex.Lock()
+	defer fake.countActualLRPsByStateMutex.Unlock()
+	fake.CountActualLRPsByStateStub = stub
+}
+
+func (fake *FakeDB) CountActualLRPsByStateArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.countActualLRPsByStateMutex.RLock()
+	defer fake.countActualLRPsByStateMutex.RUnlock()
+	argsForCall := fake.countActualLRPsByStateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDB) CountActualLRPsByStateReturns(result1 int, result2 int, result3 int, result4 int, result5 int) {
+	fake.countActualLRPsByStateMutex.Lock()
+	defer fake.countActualLRPsByStateMutex.Unlock()
+	fake.CountActualLRPsByStateStub = nil
+	fake.countActualLRPsByStateReturns = struct {
+		result1 int
+		result2 int
+		result3 int
+		result4 int
+		result5 int
+	}{result1, result2, result3, result4, result5}
+}
+
+func (fake *FakeDB) CountActualLRPsByStateReturnsOnCall(i int, result1 int, result2 int, result3 int, result4 int, result5 int) {
+	fake.countActualLRPsByStateMutex.Lock()
+	defer fake.countActualLRPsByStateMutex.Unlock()
+	fake.CountActualLRPsByStateStub = nil
+	if fake.countActualLRPsByStateReturnsOnCall == nil {
+		fake.countActualLRPsByStateReturnsOnCall = make(map[int]struct {
 			result1 int
 			result2 int
 			result3 int
@@ -1456,6 +2477,198 @@ func (fake *FakeDB) CreateUnclaimedActualLRPReturnsOnCall(i int, result1 *models
 	}{result1, result2}
 }
 
+func (fake *FakeDB) DeleteDomain(arg1 context.Context, arg2 lager.Logger, arg3 string) (bool, error) {
+	fake.deleteDomainMutex.Lock()
+	ret, specificReturn := fake.deleteDomainReturnsOnCall[len(fake.deleteDomainArgsForCall)]
+	fake.deleteDomainArgsForCall = append(fake.deleteDomainArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteDomainStub
+	fakeReturns := fake.deleteDomainReturns
+	fake.recordInvocation("DeleteDomain", []interface{}{arg1, arg2, arg3})
+	fake.deleteDomainMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) DeleteDomainCallCount() int {
+	fake.deleteDomainMutex.RLock()
+	defer fake.deleteDomainMutex.RUnlock()
+	return len(fake.deleteDomainArgsForCall)
+}
+
+func (fake *FakeDB) DeleteDomainCalls(stub func(context.Context, lager.Logger, string) (bool, error)) {
+	fake.deleteDomainMutex.Lock()
+	defer fake.deleteDomainMutex.Unlock()
+	fake.DeleteDomainStub = stub
+}
+
+func (fake *FakeDB) DeleteDomainArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.deleteDomainMutex.RLock()
+	defer fake.deleteDomainMutex.RUnlock()
+	argsForCall := fake.deleteDomainArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) DeleteDomainReturns(result1 bool, result2 error) {
+	fake.deleteDomainMutex.Lock()
+	defer fake.deleteDomainMutex.Unlock()
+	fake.DeleteDomainStub = nil
+	fake.deleteDomainReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) DeleteDomainReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.deleteDomainMutex.Lock()
+	defer fake.deleteDomainMutex.Unlock()
+	fake.DeleteDomainStub = nil
+	if fake.deleteDomainReturnsOnCall == nil {
+		fake.deleteDomainReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.deleteDomainReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) DeleteDomainQuota(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
+	fake.deleteDomainQuotaMutex.Lock()
+	ret, specificReturn := fake.deleteDomainQuotaReturnsOnCall[len(fake.deleteDomainQuotaArgsForCall)]
+	fake.deleteDomainQuotaArgsForCall = append(fake.deleteDomainQuotaArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteDomainQuotaStub
+	fakeReturns := fake.deleteDomainQuotaReturns
+	fake.recordInvocation("DeleteDomainQuota", []interface{}{arg1, arg2, arg3})
+	fake.deleteDomainQuotaMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDB) DeleteDomainQuotaCallCount() int {
+	fake.deleteDomainQuotaMutex.RLock()
+	defer fake.deleteDomainQuotaMutex.RUnlock()
+	return len(fake.deleteDomainQuotaArgsForCall)
+}
+
+func (fake *FakeDB) DeleteDomainQuotaCalls(stub func(context.Context, lager.Logger, string) error) {
+	fake.deleteDomainQuotaMutex.Lock()
+	defer fake.deleteDomainQuotaMutex.Unlock()
+	fake.DeleteDomainQuotaStub = stub
+}
+
+func (fake *FakeDB) DeleteDomainQuotaArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.deleteDomainQuotaMutex.RLock()
+	defer fake.deleteDomainQuotaMutex.RUnlock()
+	argsForCall := fake.deleteDomainQuotaArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) DeleteDomainQuotaReturns(result1 error) {
+	fake.deleteDomainQuotaMutex.Lock()
+	defer fake.deleteDomainQuotaMutex.Unlock()
+	fake.DeleteDomainQuotaStub = nil
+	fake.deleteDomainQuotaReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) DeleteDomainQuotaReturnsOnCall(i int, result1 error) {
+	fake.deleteDomainQuotaMutex.Lock()
+	defer fake.deleteDomainQuotaMutex.Unlock()
+	fake.DeleteDomainQuotaStub = nil
+	if fake.deleteDomainQuotaReturnsOnCall == nil {
+		fake.deleteDomainQuotaReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteDomainQuotaReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) DeleteScheduledTask(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
+	fake.deleteScheduledTaskMutex.Lock()
+	ret, specificReturn := fake.deleteScheduledTaskReturnsOnCall[len(fake.deleteScheduledTaskArgsForCall)]
+	fake.deleteScheduledTaskArgsForCall = append(fake.deleteScheduledTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteScheduledTaskStub
+	fakeReturns := fake.deleteScheduledTaskReturns
+	fake.recordInvocation("DeleteScheduledTask", []interface{}{arg1, arg2, arg3})
+	fake.deleteScheduledTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDB) DeleteScheduledTaskCallCount() int {
+	fake.deleteScheduledTaskMutex.RLock()
+	defer fake.deleteScheduledTaskMutex.RUnlock()
+	return len(fake.deleteScheduledTaskArgsForCall)
+}
+
+func (fake *FakeDB) DeleteScheduledTaskCalls(stub func(context.Context, lager.Logger, string) error) {
+	fake.deleteScheduledTaskMutex.Lock()
+	defer fake.deleteScheduledTaskMutex.Unlock()
+	fake.DeleteScheduledTaskStub = stub
+}
+
+func (fake *FakeDB) DeleteScheduledTaskArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.deleteScheduledTaskMutex.RLock()
+	defer fake.deleteScheduledTaskMutex.RUnlock()
+	argsForCall := fake.deleteScheduledTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) DeleteScheduledTaskReturns(result1 error) {
+	fake.deleteScheduledTaskMutex.Lock()
+	defer fake.deleteScheduledTaskMutex.Unlock()
+	fake.DeleteScheduledTaskStub = nil
+	fake.deleteScheduledTaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) DeleteScheduledTaskReturnsOnCall(i int, result1 error) {
+	fake.deleteScheduledTaskMutex.Lock()
+	defer fake.deleteScheduledTaskMutex.Unlock()
+	fake.DeleteScheduledTaskStub = nil
+	if fake.deleteScheduledTaskReturnsOnCall == nil {
+		fake.deleteScheduledTaskReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteScheduledTaskReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeDB) DeleteTask(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.Task, error) {
 	fake.deleteTaskMutex.Lock()
 	ret, specificReturn := fake.deleteTaskReturnsOnCall[len(fake.deleteTaskArgsForCall)]
@@ -1585,6 +2798,72 @@ func (fake *FakeDB) DesireLRPReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeDB) DesireScheduledTask(arg1 context.Context, arg2 lager.Logger, arg3 *models.ScheduledTask) (*models.ScheduledTask, error) {
+	fake.desireScheduledTaskMutex.Lock()
+	ret, specificReturn := fake.desireScheduledTaskReturnsOnCall[len(fake.desireScheduledTaskArgsForCall)]
+	fake.desireScheduledTaskArgsForCall = append(fake.desireScheduledTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ScheduledTask
+	}{arg1, arg2, arg3})
+	stub := fake.DesireScheduledTaskStub
+	fakeReturns := fake.desireScheduledTaskReturns
+	fake.recordInvocation("DesireScheduledTask", []interface{}{arg1, arg2, arg3})
+	fake.desireScheduledTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) DesireScheduledTaskCallCount() int {
+	fake.desireScheduledTaskMutex.RLock()
+	defer fake.desireScheduledTaskMutex.RUnlock()
+	return len(fake.desireScheduledTaskArgsForCall)
+}
+
+func (fake *FakeDB) DesireScheduledTaskCalls(stub func(context.Context, lager.Logger, *models.ScheduledTask) (*models.ScheduledTask, error)) {
+	fake.desireScheduledTaskMutex.Lock()
+	defer fake.desireScheduledTaskMutex.Unlock()
+	fake.DesireScheduledTaskStub = stub
+}
+
+func (fake *FakeDB) DesireScheduledTaskArgsForCall(i int) (context.Context, lager.Logger, *models.ScheduledTask) {
+	fake.desireScheduledTaskMutex.RLock()
+	defer fake.desireScheduledTaskMutex.RUnlock()
+	argsForCall := fake.desireScheduledTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) DesireScheduledTaskReturns(result1 *models.ScheduledTask, result2 error) {
+	fake.desireScheduledTaskMutex.Lock()
+	defer fake.desireScheduledTaskMutex.Unlock()
+	fake.DesireScheduledTaskStub = nil
+	fake.desireScheduledTaskReturns = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) DesireScheduledTaskReturnsOnCall(i int, result1 *models.ScheduledTask, result2 error) {
+	fake.desireScheduledTaskMutex.Lock()
+	defer fake.desireScheduledTaskMutex.Unlock()
+	fake.DesireScheduledTaskStub = nil
+	if fake.desireScheduledTaskReturnsOnCall == nil {
+		fake.desireScheduledTaskReturnsOnCall = make(map[int]struct {
+			result1 *models.ScheduledTask
+			result2 error
+		})
+	}
+	fake.desireScheduledTaskReturnsOnCall[i] = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDB) DesireTask(arg1 context.Context, arg2 lager.Logger, arg3 *models.TaskDefinition, arg4 string, arg5 string) (*models.Task, error) {
 	fake.desireTaskMutex.Lock()
 	ret, specificReturn := fake.desireTaskReturnsOnCall[len(fake.desireTaskArgsForCall)]
@@ -1653,6 +2932,75 @@ func (fake *FakeDB) DesireTaskReturnsOnCall(i int, result1 *models.Task, result2
 	}{result1, result2}
 }
 
+func (fake *FakeDB) DesireTaskArray(arg1 context.Context, arg2 lager.Logger, arg3 *models.TaskDefinition, arg4 string, arg5 string, arg6 int32) ([]*models.Task, error) {
+	fake.desireTaskArrayMutex.Lock()
+	ret, specificReturn := fake.desireTaskArrayReturnsOnCall[len(fake.desireTaskArrayArgsForCall)]
+	fake.desireTaskArrayArgsForCall = append(fake.desireTaskArrayArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.TaskDefinition
+		arg4 string
+		arg5 string
+		arg6 int32
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	stub := fake.DesireTaskArrayStub
+	fakeReturns := fake.desireTaskArrayReturns
+	fake.recordInvocation("DesireTaskArray", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.desireTaskArrayMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) DesireTaskArrayCallCount() int {
+	fake.desireTaskArrayMutex.RLock()
+	defer fake.desireTaskArrayMutex.RUnlock()
+	return len(fake.desireTaskArrayArgsForCall)
+}
+
+func (fake *FakeDB) DesireTaskArrayCalls(stub func(context.Context, lager.Logger, *models.TaskDefinition, string, string, int32) ([]*models.Task, error)) {
+	fake.desireTaskArrayMutex.Lock()
+	defer fake.desireTaskArrayMutex.Unlock()
+	fake.DesireTaskArrayStub = stub
+}
+
+func (fake *FakeDB) DesireTaskArrayArgsForCall(i int) (context.Context, lager.Logger, *models.TaskDefinition, string, string, int32) {
+	fake.desireTaskArrayMutex.RLock()
+	defer fake.desireTaskArrayMutex.RUnlock()
+	argsForCall := fake.desireTaskArrayArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakeDB) DesireTaskArrayReturns(result1 []*models.Task, result2 error) {
+	fake.desireTaskArrayMutex.Lock()
+	defer fake.desireTaskArrayMutex.Unlock()
+	fake.DesireTaskArrayStub = nil
+	fake.desireTaskArrayReturns = struct {
+		result1 []*models.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) DesireTaskArrayReturnsOnCall(i int, result1 []*models.Task, result2 error) {
+	fake.desireTaskArrayMutex.Lock()
+	defer fake.desireTaskArrayMutex.Unlock()
+	fake.DesireTaskArrayStub = nil
+	if fake.desireTaskArrayReturnsOnCall == nil {
+		fake.desireTaskArrayReturnsOnCall = make(map[int]struct {
+			result1 []*models.Task
+			result2 error
+		})
+	}
+	fake.desireTaskArrayReturnsOnCall[i] = struct {
+		result1 []*models.Task
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDB) DesiredLRPByProcessGuid(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.DesiredLRP, error) {
 	fake.desiredLRPByProcessGuidMutex.Lock()
 	ret, specificReturn := fake.desiredLRPByProcessGuidReturnsOnCall[len(fake.desiredLRPByProcessGuidArgsForCall)]
@@ -1719,6 +3067,139 @@ func (fake *FakeDB) DesiredLRPByProcessGuidReturnsOnCall(i int, result1 *models.
 	}{result1, result2}
 }
 
+func (fake *FakeDB) DesiredLRPRevision(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 int32) (*models.DesiredLRPRevision, error) {
+	fake.desiredLRPRevisionMutex.Lock()
+	ret, specificReturn := fake.desiredLRPRevisionReturnsOnCall[len(fake.desiredLRPRevisionArgsForCall)]
+	fake.desiredLRPRevisionArgsForCall = append(fake.desiredLRPRevisionArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int32
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.DesiredLRPRevisionStub
+	fakeReturns := fake.desiredLRPRevisionReturns
+	fake.recordInvocation("DesiredLRPRevision", []interface{}{arg1, arg2, arg3, arg4})
+	fake.desiredLRPRevisionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) DesiredLRPRevisionCallCount() int {
+	fake.desiredLRPRevisionMutex.RLock()
+	defer fake.desiredLRPRevisionMutex.RUnlock()
+	return len(fake.desiredLRPRevisionArgsForCall)
+}
+
+func (fake *FakeDB) DesiredLRPRevisionCalls(stub func(context.Context, lager.Logger, string, int32) (*models.DesiredLRPRevision, error)) {
+	fake.desiredLRPRevisionMutex.Lock()
+	defer fake.desiredLRPRevisionMutex.Unlock()
+	fake.DesiredLRPRevisionStub = stub
+}
+
+func (fake *FakeDB) DesiredLRPRevisionArgsForCall(i int) (context.Context, lager.Logger, string, int32) {
+	fake.desiredLRPRevisionMutex.RLock()
+	defer fake.desiredLRPRevisionMutex.RUnlock()
+	argsForCall := fake.desiredLRPRevisionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeDB) DesiredLRPRevisionReturns(result1 *models.DesiredLRPRevision, result2 error) {
+	fake.desiredLRPRevisionMutex.Lock()
+	defer fake.desiredLRPRevisionMutex.Unlock()
+	fake.DesiredLRPRevisionStub = nil
+	fake.desiredLRPRevisionReturns = struct {
+		result1 *models.DesiredLRPRevision
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) DesiredLRPRevisionReturnsOnCall(i int, result1 *models.DesiredLRPRevision, result2 error) {
+	fake.desiredLRPRevisionMutex.Lock()
+	defer fake.desiredLRPRevisionMutex.Unlock()
+	fake.DesiredLRPRevisionStub = nil
+	if fake.desiredLRPRevisionReturnsOnCall == nil {
+		fake.desiredLRPRevisionReturnsOnCall = make(map[int]struct {
+			result1 *models.DesiredLRPRevision
+			result2 error
+		})
+	}
+	fake.desiredLRPRevisionReturnsOnCall[i] = struct {
+		result1 *models.DesiredLRPRevision
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) DesiredLRPRevisions(arg1 context.Context, arg2 lager.Logger, arg3 string) ([]*models.DesiredLRPRevision, error) {
+	fake.desiredLRPRevisionsMutex.Lock()
+	ret, specificReturn := fake.desiredLRPRevisionsReturnsOnCall[len(fake.desiredLRPRevisionsArgsForCall)]
+	fake.desiredLRPRevisionsArgsForCall = append(fake.desiredLRPRevisionsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DesiredLRPRevisionsStub
+	fakeReturns := fake.desiredLRPRevisionsReturns
+	fake.recordInvocation("DesiredLRPRevisions", []interface{}{arg1, arg2, arg3})
+	fake.desiredLRPRevisionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) DesiredLRPRevisionsCallCount() int {
+	fake.desiredLRPRevisionsMutex.RLock()
+	defer fake.desiredLRPRevisionsMutex.RUnlock()
+	return len(fake.desiredLRPRevisionsArgsForCall)
+}
+
+func (fake *FakeDB) DesiredLRPRevisionsCalls(stub func(context.Context, lager.Logger, string) ([]*models.DesiredLRPRevision, error)) {
+	fake.desiredLRPRevisionsMutex.Lock()
+	defer fake.desiredLRPRevisionsMutex.Unlock()
+	fake.DesiredLRPRevisionsStub = stub
+}
+
+func (fake *FakeDB) DesiredLRPRevisionsArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.desiredLRPRevisionsMutex.RLock()
+	defer fake.desiredLRPRevisionsMutex.RUnlock()
+	argsForCall := fake.desiredLRPRevisionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) DesiredLRPRevisionsReturns(result1 []*models.DesiredLRPRevision, result2 error) {
+	fake.desiredLRPRevisionsMutex.Lock()
+	defer fake.desiredLRPRevisionsMutex.Unlock()
+	fake.DesiredLRPRevisionsStub = nil
+	fake.desiredLRPRevisionsReturns = struct {
+		result1 []*models.DesiredLRPRevision
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) DesiredLRPRevisionsReturnsOnCall(i int, result1 []*models.DesiredLRPRevision, result2 error) {
+	fake.desiredLRPRevisionsMutex.Lock()
+	defer fake.desiredLRPRevisionsMutex.Unlock()
+	fake.DesiredLRPRevisionsStub = nil
+	if fake.desiredLRPRevisionsReturnsOnCall == nil {
+		fake.desiredLRPRevisionsReturnsOnCall = make(map[int]struct {
+			result1 []*models.DesiredLRPRevision
+			result2 error
+		})
+	}
+	fake.desiredLRPRevisionsReturnsOnCall[i] = struct {
+		result1 []*models.DesiredLRPRevision
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDB) DesiredLRPRoutingInfos(arg1 context.Context, arg2 lager.Logger, arg3 models.DesiredLRPFilter) ([]*models.DesiredLRP, error) {
 	fake.desiredLRPRoutingInfosMutex.Lock()
 	ret, specificReturn := fake.desiredLRPRoutingInfosReturnsOnCall[len(fake.desiredLRPRoutingInfosArgsForCall)]
@@ -1917,6 +3398,72 @@ func (fake *FakeDB) DesiredLRPSchedulingInfosReturnsOnCall(i int, result1 []*mod
 	}{result1, result2}
 }
 
+func (fake *FakeDB) DesiredLRPStatuses(arg1 context.Context, arg2 lager.Logger, arg3 models.DesiredLRPFilter) ([]*models.DesiredLRPStatus, error) {
+	fake.desiredLRPStatusesMutex.Lock()
+	ret, specificReturn := fake.desiredLRPStatusesReturnsOnCall[len(fake.desiredLRPStatusesArgsForCall)]
+	fake.desiredLRPStatusesArgsForCall = append(fake.desiredLRPStatusesArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.DesiredLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.DesiredLRPStatusesStub
+	fakeReturns := fake.desiredLRPStatusesReturns
+	fake.recordInvocation("DesiredLRPStatuses", []interface{}{arg1, arg2, arg3})
+	fake.desiredLRPStatusesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) DesiredLRPStatusesCallCount() int {
+	fake.desiredLRPStatusesMutex.RLock()
+	defer fake.desiredLRPStatusesMutex.RUnlock()
+	return len(fake.desiredLRPStatusesArgsForCall)
+}
+
+func (fake *FakeDB) DesiredLRPStatusesCalls(stub func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRPStatus, error)) {
+	fake.desiredLRPStatusesMutex.Lock()
+	defer fake.desiredLRPStatusesMutex.Unlock()
+	fake.DesiredLRPStatusesStub = stub
+}
+
+func (fake *FakeDB) DesiredLRPStatusesArgsForCall(i int) (context.Context, lager.Logger, models.DesiredLRPFilter) {
+	fake.desiredLRPStatusesMutex.RLock()
+	defer fake.desiredLRPStatusesMutex.RUnlock()
+	argsForCall := fake.desiredLRPStatusesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) DesiredLRPStatusesReturns(result1 []*models.DesiredLRPStatus, result2 error) {
+	fake.desiredLRPStatusesMutex.Lock()
+	defer fake.desiredLRPStatusesMutex.Unlock()
+	fake.DesiredLRPStatusesStub = nil
+	fake.desiredLRPStatusesReturns = struct {
+		result1 []*models.DesiredLRPStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) DesiredLRPStatusesReturnsOnCall(i int, result1 []*models.DesiredLRPStatus, result2 error) {
+	fake.desiredLRPStatusesMutex.Lock()
+	defer fake.desiredLRPStatusesMutex.Unlock()
+	fake.DesiredLRPStatusesStub = nil
+	if fake.desiredLRPStatusesReturnsOnCall == nil {
+		fake.desiredLRPStatusesReturnsOnCall = make(map[int]struct {
+			result1 []*models.DesiredLRPStatus
+			result2 error
+		})
+	}
+	fake.desiredLRPStatusesReturnsOnCall[i] = struct {
+		result1 []*models.DesiredLRPStatus
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDB) DesiredLRPs(arg1 context.Context, arg2 lager.Logger, arg3 models.DesiredLRPFilter) ([]*models.DesiredLRP, error) {
 	fake.desiredLRPsMutex.Lock()
 	ret, specificReturn := fake.desiredLRPsReturnsOnCall[len(fake.desiredLRPsArgsForCall)]
@@ -1944,41 +3491,237 @@ func (fake *FakeDB) DesiredLRPsCallCount() int {
 	return len(fake.desiredLRPsArgsForCall)
 }
 
-func (fake *FakeDB) DesiredLRPsCalls(stub func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRP, error)) {
-	fake.desiredLRPsMutex.Lock()
-	defer fake.desiredLRPsMutex.Unlock()
-	fake.DesiredLRPsStub = stub
+func (fake *FakeDB) DesiredLRPsCalls(stub func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRP, error)) {
+	fake.desiredLRPsMutex.Lock()
+	defer fake.desiredLRPsMutex.Unlock()
+	fake.DesiredLRPsStub = stub
+}
+
+func (fake *FakeDB) DesiredLRPsArgsForCall(i int) (context.Context, lager.Logger, models.DesiredLRPFilter) {
+	fake.desiredLRPsMutex.RLock()
+	defer fake.desiredLRPsMutex.RUnlock()
+	argsForCall := fake.desiredLRPsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) DesiredLRPsReturns(result1 []*models.DesiredLRP, result2 error) {
+	fake.desiredLRPsMutex.Lock()
+	defer fake.desiredLRPsMutex.Unlock()
+	fake.DesiredLRPsStub = nil
+	fake.desiredLRPsReturns = struct {
+		result1 []*models.DesiredLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) DesiredLRPsReturnsOnCall(i int, result1 []*models.DesiredLRP, result2 error) {
+	fake.desiredLRPsMutex.Lock()
+	defer fake.desiredLRPsMutex.Unlock()
+	fake.DesiredLRPsStub = nil
+	if fake.desiredLRPsReturnsOnCall == nil {
+		fake.desiredLRPsReturnsOnCall = make(map[int]struct {
+			result1 []*models.DesiredLRP
+			result2 error
+		})
+	}
+	fake.desiredLRPsReturnsOnCall[i] = struct {
+		result1 []*models.DesiredLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) DomainQuotas(arg1 context.Context, arg2 lager.Logger) ([]*models.DomainQuota, error) {
+	fake.domainQuotasMutex.Lock()
+	ret, specificReturn := fake.domainQuotasReturnsOnCall[len(fake.domainQuotasArgsForCall)]
+	fake.domainQuotasArgsForCall = append(fake.domainQuotasArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.DomainQuotasStub
+	fakeReturns := fake.domainQuotasReturns
+	fake.recordInvocation("DomainQuotas", []interface{}{arg1, arg2})
+	fake.domainQuotasMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) DomainQuotasCallCount() int {
+	fake.domainQuotasMutex.RLock()
+	defer fake.domainQuotasMutex.RUnlock()
+	return len(fake.domainQuotasArgsForCall)
+}
+
+func (fake *FakeDB) DomainQuotasCalls(stub func(context.Context, lager.Logger) ([]*models.DomainQuota, error)) {
+	fake.domainQuotasMutex.Lock()
+	defer fake.domainQuotasMutex.Unlock()
+	fake.DomainQuotasStub = stub
+}
+
+func (fake *FakeDB) DomainQuotasArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.domainQuotasMutex.RLock()
+	defer fake.domainQuotasMutex.RUnlock()
+	argsForCall := fake.domainQuotasArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDB) DomainQuotasReturns(result1 []*models.DomainQuota, result2 error) {
+	fake.domainQuotasMutex.Lock()
+	defer fake.domainQuotasMutex.Unlock()
+	fake.DomainQuotasStub = nil
+	fake.domainQuotasReturns = struct {
+		result1 []*models.DomainQuota
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) DomainQuotasReturnsOnCall(i int, result1 []*models.DomainQuota, result2 error) {
+	fake.domainQuotasMutex.Lock()
+	defer fake.domainQuotasMutex.Unlock()
+	fake.DomainQuotasStub = nil
+	if fake.domainQuotasReturnsOnCall == nil {
+		fake.domainQuotasReturnsOnCall = make(map[int]struct {
+			result1 []*models.DomainQuota
+			result2 error
+		})
+	}
+	fake.domainQuotasReturnsOnCall[i] = struct {
+		result1 []*models.DomainQuota
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) DomainUsage(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.DomainUsage, error) {
+	fake.domainUsageMutex.Lock()
+	ret, specificReturn := fake.domainUsageReturnsOnCall[len(fake.domainUsageArgsForCall)]
+	fake.domainUsageArgsForCall = append(fake.domainUsageArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DomainUsageStub
+	fakeReturns := fake.domainUsageReturns
+	fake.recordInvocation("DomainUsage", []interface{}{arg1, arg2, arg3})
+	fake.domainUsageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) DomainUsageCallCount() int {
+	fake.domainUsageMutex.RLock()
+	defer fake.domainUsageMutex.RUnlock()
+	return len(fake.domainUsageArgsForCall)
+}
+
+func (fake *FakeDB) DomainUsageCalls(stub func(context.Context, lager.Logger, string) (*models.DomainUsage, error)) {
+	fake.domainUsageMutex.Lock()
+	defer fake.domainUsageMutex.Unlock()
+	fake.DomainUsageStub = stub
+}
+
+func (fake *FakeDB) DomainUsageArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.domainUsageMutex.RLock()
+	defer fake.domainUsageMutex.RUnlock()
+	argsForCall := fake.domainUsageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) DomainUsageReturns(result1 *models.DomainUsage, result2 error) {
+	fake.domainUsageMutex.Lock()
+	defer fake.domainUsageMutex.Unlock()
+	fake.DomainUsageStub = nil
+	fake.domainUsageReturns = struct {
+		result1 *models.DomainUsage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) DomainUsageReturnsOnCall(i int, result1 *models.DomainUsage, result2 error) {
+	fake.domainUsageMutex.Lock()
+	defer fake.domainUsageMutex.Unlock()
+	fake.DomainUsageStub = nil
+	if fake.domainUsageReturnsOnCall == nil {
+		fake.domainUsageReturnsOnCall = make(map[int]struct {
+			result1 *models.DomainUsage
+			result2 error
+		})
+	}
+	fake.domainUsageReturnsOnCall[i] = struct {
+		result1 *models.DomainUsage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) Domains(arg1 context.Context, arg2 lager.Logger) ([]*models.Domain, error) {
+	fake.domainsMutex.Lock()
+	ret, specificReturn := fake.domainsReturnsOnCall[len(fake.domainsArgsForCall)]
+	fake.domainsArgsForCall = append(fake.domainsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.DomainsStub
+	fakeReturns := fake.domainsReturns
+	fake.recordInvocation("Domains", []interface{}{arg1, arg2})
+	fake.domainsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) DomainsCallCount() int {
+	fake.domainsMutex.RLock()
+	defer fake.domainsMutex.RUnlock()
+	return len(fake.domainsArgsForCall)
+}
+
+func (fake *FakeDB) DomainsCalls(stub func(context.Context, lager.Logger) ([]*models.Domain, error)) {
+	fake.domainsMutex.Lock()
+	defer fake.domainsMutex.Unlock()
+	fake.DomainsStub = stub
 }
 
-func (fake *FakeDB) DesiredLRPsArgsForCall(i int) (context.Context, lager.Logger, models.DesiredLRPFilter) {
-	fake.desiredLRPsMutex.RLock()
-	defer fake.desiredLRPsMutex.RUnlock()
-	argsForCall := fake.desiredLRPsArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+func (fake *FakeDB) DomainsArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.domainsMutex.RLock()
+	defer fake.domainsMutex.RUnlock()
+	argsForCall := fake.domainsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeDB) DesiredLRPsReturns(result1 []*models.DesiredLRP, result2 error) {
-	fake.desiredLRPsMutex.Lock()
-	defer fake.desiredLRPsMutex.Unlock()
-	fake.DesiredLRPsStub = nil
-	fake.desiredLRPsReturns = struct {
-		result1 []*models.DesiredLRP
+func (fake *FakeDB) DomainsReturns(result1 []*models.Domain, result2 error) {
+	fake.domainsMutex.Lock()
+	defer fake.domainsMutex.Unlock()
+	fake.DomainsStub = nil
+	fake.domainsReturns = struct {
+		result1 []*models.Domain
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeDB) DesiredLRPsReturnsOnCall(i int, result1 []*models.DesiredLRP, result2 error) {
-	fake.desiredLRPsMutex.Lock()
-	defer fake.desiredLRPsMutex.Unlock()
-	fake.DesiredLRPsStub = nil
-	if fake.desiredLRPsReturnsOnCall == nil {
-		fake.desiredLRPsReturnsOnCall = make(map[int]struct {
-			result1 []*models.DesiredLRP
+func (fake *FakeDB) DomainsReturnsOnCall(i int, result1 []*models.Domain, result2 error) {
+	fake.domainsMutex.Lock()
+	defer fake.domainsMutex.Unlock()
+	fake.DomainsStub = nil
+	if fake.domainsReturnsOnCall == nil {
+		fake.domainsReturnsOnCall = make(map[int]struct {
+			result1 []*models.Domain
 			result2 error
 		})
 	}
-	fake.desiredLRPsReturnsOnCall[i] = struct {
-		result1 []*models.DesiredLRP
+	fake.domainsReturnsOnCall[i] = struct {
+		result1 []*models.Domain
 		result2 error
 	}{result1, result2}
 }
@@ -2125,6 +3868,72 @@ func (fake *FakeDB) EvacuateActualLRPReturnsOnCall(i int, result1 *models.Actual
 	}{result1, result2}
 }
 
+func (fake *FakeDB) ExpireDomain(arg1 context.Context, arg2 lager.Logger, arg3 string) (bool, error) {
+	fake.expireDomainMutex.Lock()
+	ret, specificReturn := fake.expireDomainReturnsOnCall[len(fake.expireDomainArgsForCall)]
+	fake.expireDomainArgsForCall = append(fake.expireDomainArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ExpireDomainStub
+	fakeReturns := fake.expireDomainReturns
+	fake.recordInvocation("ExpireDomain", []interface{}{arg1, arg2, arg3})
+	fake.expireDomainMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) ExpireDomainCallCount() int {
+	fake.expireDomainMutex.RLock()
+	defer fake.expireDomainMutex.RUnlock()
+	return len(fake.expireDomainArgsForCall)
+}
+
+func (fake *FakeDB) ExpireDomainCalls(stub func(context.Context, lager.Logger, string) (bool, error)) {
+	fake.expireDomainMutex.Lock()
+	defer fake.expireDomainMutex.Unlock()
+	fake.ExpireDomainStub = stub
+}
+
+func (fake *FakeDB) ExpireDomainArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.expireDomainMutex.RLock()
+	defer fake.expireDomainMutex.RUnlock()
+	argsForCall := fake.expireDomainArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) ExpireDomainReturns(result1 bool, result2 error) {
+	fake.expireDomainMutex.Lock()
+	defer fake.expireDomainMutex.Unlock()
+	fake.ExpireDomainStub = nil
+	fake.expireDomainReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) ExpireDomainReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.expireDomainMutex.Lock()
+	defer fake.expireDomainMutex.Unlock()
+	fake.ExpireDomainStub = nil
+	if fake.expireDomainReturnsOnCall == nil {
+		fake.expireDomainReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.expireDomainReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDB) FailActualLRP(arg1 context.Context, arg2 lager.Logger, arg3 *models.ActualLRPKey, arg4 string) (*models.ActualLRP, *models.ActualLRP, error) {
 	fake.failActualLRPMutex.Lock()
 	ret, specificReturn := fake.failActualLRPReturnsOnCall[len(fake.failActualLRPArgsForCall)]
@@ -2465,6 +4274,133 @@ func (fake *FakeDB) PromoteSuspectActualLRPReturnsOnCall(i int, result1 *models.
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeDB) PruneActualLRPCrashes(arg1 context.Context, arg2 lager.Logger) error {
+	fake.pruneActualLRPCrashesMutex.Lock()
+	ret, specificReturn := fake.pruneActualLRPCrashesReturnsOnCall[len(fake.pruneActualLRPCrashesArgsForCall)]
+	fake.pruneActualLRPCrashesArgsForCall = append(fake.pruneActualLRPCrashesArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.PruneActualLRPCrashesStub
+	fakeReturns := fake.pruneActualLRPCrashesReturns
+	fake.recordInvocation("PruneActualLRPCrashes", []interface{}{arg1, arg2})
+	fake.pruneActualLRPCrashesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDB) PruneActualLRPCrashesCallCount() int {
+	fake.pruneActualLRPCrashesMutex.RLock()
+	defer fake.pruneActualLRPCrashesMutex.RUnlock()
+	return len(fake.pruneActualLRPCrashesArgsForCall)
+}
+
+func (fake *FakeDB) PruneActualLRPCrashesCalls(stub func(context.Context, lager.Logger) error) {
+	fake.pruneActualLRPCrashesMutex.Lock()
+	defer fake.pruneActualLRPCrashesMutex.Unlock()
+	fake.PruneActualLRPCrashesStub = stub
+}
+
+func (fake *FakeDB) PruneActualLRPCrashesArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.pruneActualLRPCrashesMutex.RLock()
+	defer fake.pruneActualLRPCrashesMutex.RUnlock()
+	argsForCall := fake.pruneActualLRPCrashesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDB) PruneActualLRPCrashesReturns(result1 error) {
+	fake.pruneActualLRPCrashesMutex.Lock()
+	defer fake.pruneActualLRPCrashesMutex.Unlock()
+	fake.PruneActualLRPCrashesStub = nil
+	fake.pruneActualLRPCrashesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) PruneActualLRPCrashesReturnsOnCall(i int, result1 error) {
+	fake.pruneActualLRPCrashesMutex.Lock()
+	defer fake.pruneActualLRPCrashesMutex.Unlock()
+	fake.PruneActualLRPCrashesStub = nil
+	if fake.pruneActualLRPCrashesReturnsOnCall == nil {
+		fake.pruneActualLRPCrashesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.pruneActualLRPCrashesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) RecordScheduledTaskRun(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 int64, arg5 string) error {
+	fake.recordScheduledTaskRunMutex.Lock()
+	ret, specificReturn := fake.recordScheduledTaskRunReturnsOnCall[len(fake.recordScheduledTaskRunArgsForCall)]
+	fake.recordScheduledTaskRunArgsForCall = append(fake.recordScheduledTaskRunArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int64
+		arg5 string
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.RecordScheduledTaskRunStub
+	fakeReturns := fake.recordScheduledTaskRunReturns
+	fake.recordInvocation("RecordScheduledTaskRun", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.recordScheduledTaskRunMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDB) RecordScheduledTaskRunCallCount() int {
+	fake.recordScheduledTaskRunMutex.RLock()
+	defer fake.recordScheduledTaskRunMutex.RUnlock()
+	return len(fake.recordScheduledTaskRunArgsForCall)
+}
+
+func (fake *FakeDB) RecordScheduledTaskRunCalls(stub func(context.Context, lager.Logger, string, int64, string) error) {
+	fake.recordScheduledTaskRunMutex.Lock()
+	defer fake.recordScheduledTaskRunMutex.Unlock()
+	fake.RecordScheduledTaskRunStub = stub
+}
+
+func (fake *FakeDB) RecordScheduledTaskRunArgsForCall(i int) (context.Context, lager.Logger, string, int64, string) {
+	fake.recordScheduledTaskRunMutex.RLock()
+	defer fake.recordScheduledTaskRunMutex.RUnlock()
+	argsForCall := fake.recordScheduledTaskRunArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeDB) RecordScheduledTaskRunReturns(result1 error) {
+	fake.recordScheduledTaskRunMutex.Lock()
+	defer fake.recordScheduledTaskRunMutex.Unlock()
+	fake.RecordScheduledTaskRunStub = nil
+	fake.recordScheduledTaskRunReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) RecordScheduledTaskRunReturnsOnCall(i int, result1 error) {
+	fake.recordScheduledTaskRunMutex.Lock()
+	defer fake.recordScheduledTaskRunMutex.Unlock()
+	fake.RecordScheduledTaskRunStub = nil
+	if fake.recordScheduledTaskRunReturnsOnCall == nil {
+		fake.recordScheduledTaskRunReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.recordScheduledTaskRunReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeDB) RejectTask(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 string) (*models.Task, *models.Task, error) {
 	fake.rejectTaskMutex.Lock()
 	ret, specificReturn := fake.rejectTaskReturnsOnCall[len(fake.rejectTaskArgsForCall)]
@@ -2535,6 +4471,75 @@ func (fake *FakeDB) RejectTaskReturnsOnCall(i int, result1 *models.Task, result2
 	}{result1, result2, result3}
 }
 
+func (fake *FakeDB) ReleaseBlockedTasks(arg1 context.Context, arg2 lager.Logger, arg3 ...string) ([]*models.TaskChange, []*models.TaskChange, error) {
+	fake.releaseBlockedTasksMutex.Lock()
+	ret, specificReturn := fake.releaseBlockedTasksReturnsOnCall[len(fake.releaseBlockedTasksArgsForCall)]
+	fake.releaseBlockedTasksArgsForCall = append(fake.releaseBlockedTasksArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 []string
+	}{arg1, arg2, arg3})
+	stub := fake.ReleaseBlockedTasksStub
+	fakeReturns := fake.releaseBlockedTasksReturns
+	fake.recordInvocation("ReleaseBlockedTasks", []interface{}{arg1, arg2, arg3})
+	fake.releaseBlockedTasksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeDB) ReleaseBlockedTasksCallCount() int {
+	fake.releaseBlockedTasksMutex.RLock()
+	defer fake.releaseBlockedTasksMutex.RUnlock()
+	return len(fake.releaseBlockedTasksArgsForCall)
+}
+
+func (fake *FakeDB) ReleaseBlockedTasksCalls(stub func(context.Context, lager.Logger, ...string) ([]*models.TaskChange, []*models.TaskChange, error)) {
+	fake.releaseBlockedTasksMutex.Lock()
+	defer fake.releaseBlockedTasksMutex.Unlock()
+	fake.ReleaseBlockedTasksStub = stub
+}
+
+func (fake *FakeDB) ReleaseBlockedTasksArgsForCall(i int) (context.Context, lager.Logger, []string) {
+	fake.releaseBlockedTasksMutex.RLock()
+	defer fake.releaseBlockedTasksMutex.RUnlock()
+	argsForCall := fake.releaseBlockedTasksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) ReleaseBlockedTasksReturns(result1 []*models.TaskChange, result2 []*models.TaskChange, result3 error) {
+	fake.releaseBlockedTasksMutex.Lock()
+	defer fake.releaseBlockedTasksMutex.Unlock()
+	fake.ReleaseBlockedTasksStub = nil
+	fake.releaseBlockedTasksReturns = struct {
+		result1 []*models.TaskChange
+		result2 []*models.TaskChange
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDB) ReleaseBlockedTasksReturnsOnCall(i int, result1 []*models.TaskChange, result2 []*models.TaskChange, result3 error) {
+	fake.releaseBlockedTasksMutex.Lock()
+	defer fake.releaseBlockedTasksMutex.Unlock()
+	fake.ReleaseBlockedTasksStub = nil
+	if fake.releaseBlockedTasksReturnsOnCall == nil {
+		fake.releaseBlockedTasksReturnsOnCall = make(map[int]struct {
+			result1 []*models.TaskChange
+			result2 []*models.TaskChange
+			result3 error
+		})
+	}
+	fake.releaseBlockedTasksReturnsOnCall[i] = struct {
+		result1 []*models.TaskChange
+		result2 []*models.TaskChange
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeDB) RemoveActualLRP(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 int32, arg5 *models.ActualLRPInstanceKey) error {
 	fake.removeActualLRPMutex.Lock()
 	ret, specificReturn := fake.removeActualLRPReturnsOnCall[len(fake.removeActualLRPArgsForCall)]
@@ -2844,22 +4849,221 @@ func (fake *FakeDB) ResolvingTaskReturns(result1 *models.Task, result2 *models.T
 	}{result1, result2, result3}
 }
 
-func (fake *FakeDB) ResolvingTaskReturnsOnCall(i int, result1 *models.Task, result2 *models.Task, result3 error) {
-	fake.resolvingTaskMutex.Lock()
-	defer fake.resolvingTaskMutex.Unlock()
-	fake.ResolvingTaskStub = nil
-	if fake.resolvingTaskReturnsOnCall == nil {
-		fake.resolvingTaskReturnsOnCall = make(map[int]struct {
-			result1 *models.Task
-			result2 *models.Task
-			result3 error
+func (fake *FakeDB) ResolvingTaskReturnsOnCall(i int, result1 *models.Task, result2 *models.Task, result3 error) {
+	fake.resolvingTaskMutex.Lock()
+	defer fake.resolvingTaskMutex.Unlock()
+	fake.ResolvingTaskStub = nil
+	if fake.resolvingTaskReturnsOnCall == nil {
+		fake.resolvingTaskReturnsOnCall = make(map[int]struct {
+			result1 *models.Task
+			result2 *models.Task
+			result3 error
+		})
+	}
+	fake.resolvingTaskReturnsOnCall[i] = struct {
+		result1 *models.Task
+		result2 *models.Task
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDB) RollbackDesiredLRP(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 int32) (*models.DesiredLRP, error) {
+	fake.rollbackDesiredLRPMutex.Lock()
+	ret, specificReturn := fake.rollbackDesiredLRPReturnsOnCall[len(fake.rollbackDesiredLRPArgsForCall)]
+	fake.rollbackDesiredLRPArgsForCall = append(fake.rollbackDesiredLRPArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int32
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RollbackDesiredLRPStub
+	fakeReturns := fake.rollbackDesiredLRPReturns
+	fake.recordInvocation("RollbackDesiredLRP", []interface{}{arg1, arg2, arg3, arg4})
+	fake.rollbackDesiredLRPMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) RollbackDesiredLRPCallCount() int {
+	fake.rollbackDesiredLRPMutex.RLock()
+	defer fake.rollbackDesiredLRPMutex.RUnlock()
+	return len(fake.rollbackDesiredLRPArgsForCall)
+}
+
+func (fake *FakeDB) RollbackDesiredLRPCalls(stub func(context.Context, lager.Logger, string, int32) (*models.DesiredLRP, error)) {
+	fake.rollbackDesiredLRPMutex.Lock()
+	defer fake.rollbackDesiredLRPMutex.Unlock()
+	fake.RollbackDesiredLRPStub = stub
+}
+
+func (fake *FakeDB) RollbackDesiredLRPArgsForCall(i int) (context.Context, lager.Logger, string, int32) {
+	fake.rollbackDesiredLRPMutex.RLock()
+	defer fake.rollbackDesiredLRPMutex.RUnlock()
+	argsForCall := fake.rollbackDesiredLRPArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeDB) RollbackDesiredLRPReturns(result1 *models.DesiredLRP, result2 error) {
+	fake.rollbackDesiredLRPMutex.Lock()
+	defer fake.rollbackDesiredLRPMutex.Unlock()
+	fake.RollbackDesiredLRPStub = nil
+	fake.rollbackDesiredLRPReturns = struct {
+		result1 *models.DesiredLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) RollbackDesiredLRPReturnsOnCall(i int, result1 *models.DesiredLRP, result2 error) {
+	fake.rollbackDesiredLRPMutex.Lock()
+	defer fake.rollbackDesiredLRPMutex.Unlock()
+	fake.RollbackDesiredLRPStub = nil
+	if fake.rollbackDesiredLRPReturnsOnCall == nil {
+		fake.rollbackDesiredLRPReturnsOnCall = make(map[int]struct {
+			result1 *models.DesiredLRP
+			result2 error
+		})
+	}
+	fake.rollbackDesiredLRPReturnsOnCall[i] = struct {
+		result1 *models.DesiredLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) ScheduledTaskByGuid(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.ScheduledTask, error) {
+	fake.scheduledTaskByGuidMutex.Lock()
+	ret, specificReturn := fake.scheduledTaskByGuidReturnsOnCall[len(fake.scheduledTaskByGuidArgsForCall)]
+	fake.scheduledTaskByGuidArgsForCall = append(fake.scheduledTaskByGuidArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ScheduledTaskByGuidStub
+	fakeReturns := fake.scheduledTaskByGuidReturns
+	fake.recordInvocation("ScheduledTaskByGuid", []interface{}{arg1, arg2, arg3})
+	fake.scheduledTaskByGuidMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) ScheduledTaskByGuidCallCount() int {
+	fake.scheduledTaskByGuidMutex.RLock()
+	defer fake.scheduledTaskByGuidMutex.RUnlock()
+	return len(fake.scheduledTaskByGuidArgsForCall)
+}
+
+func (fake *FakeDB) ScheduledTaskByGuidCalls(stub func(context.Context, lager.Logger, string) (*models.ScheduledTask, error)) {
+	fake.scheduledTaskByGuidMutex.Lock()
+	defer fake.scheduledTaskByGuidMutex.Unlock()
+	fake.ScheduledTaskByGuidStub = stub
+}
+
+func (fake *FakeDB) ScheduledTaskByGuidArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.scheduledTaskByGuidMutex.RLock()
+	defer fake.scheduledTaskByGuidMutex.RUnlock()
+	argsForCall := fake.scheduledTaskByGuidArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) ScheduledTaskByGuidReturns(result1 *models.ScheduledTask, result2 error) {
+	fake.scheduledTaskByGuidMutex.Lock()
+	defer fake.scheduledTaskByGuidMutex.Unlock()
+	fake.ScheduledTaskByGuidStub = nil
+	fake.scheduledTaskByGuidReturns = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) ScheduledTaskByGuidReturnsOnCall(i int, result1 *models.ScheduledTask, result2 error) {
+	fake.scheduledTaskByGuidMutex.Lock()
+	defer fake.scheduledTaskByGuidMutex.Unlock()
+	fake.ScheduledTaskByGuidStub = nil
+	if fake.scheduledTaskByGuidReturnsOnCall == nil {
+		fake.scheduledTaskByGuidReturnsOnCall = make(map[int]struct {
+			result1 *models.ScheduledTask
+			result2 error
+		})
+	}
+	fake.scheduledTaskByGuidReturnsOnCall[i] = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) ScheduledTasks(arg1 context.Context, arg2 lager.Logger, arg3 string) ([]*models.ScheduledTask, error) {
+	fake.scheduledTasksMutex.Lock()
+	ret, specificReturn := fake.scheduledTasksReturnsOnCall[len(fake.scheduledTasksArgsForCall)]
+	fake.scheduledTasksArgsForCall = append(fake.scheduledTasksArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ScheduledTasksStub
+	fakeReturns := fake.scheduledTasksReturns
+	fake.recordInvocation("ScheduledTasks", []interface{}{arg1, arg2, arg3})
+	fake.scheduledTasksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) ScheduledTasksCallCount() int {
+	fake.scheduledTasksMutex.RLock()
+	defer fake.scheduledTasksMutex.RUnlock()
+	return len(fake.scheduledTasksArgsForCall)
+}
+
+func (fake *FakeDB) ScheduledTasksCalls(stub func(context.Context, lager.Logger, string) ([]*models.ScheduledTask, error)) {
+	fake.scheduledTasksMutex.Lock()
+	defer fake.scheduledTasksMutex.Unlock()
+	fake.ScheduledTasksStub = stub
+}
+
+func (fake *FakeDB) ScheduledTasksArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.scheduledTasksMutex.RLock()
+	defer fake.scheduledTasksMutex.RUnlock()
+	argsForCall := fake.scheduledTasksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) ScheduledTasksReturns(result1 []*models.ScheduledTask, result2 error) {
+	fake.scheduledTasksMutex.Lock()
+	defer fake.scheduledTasksMutex.Unlock()
+	fake.ScheduledTasksStub = nil
+	fake.scheduledTasksReturns = struct {
+		result1 []*models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) ScheduledTasksReturnsOnCall(i int, result1 []*models.ScheduledTask, result2 error) {
+	fake.scheduledTasksMutex.Lock()
+	defer fake.scheduledTasksMutex.Unlock()
+	fake.ScheduledTasksStub = nil
+	if fake.scheduledTasksReturnsOnCall == nil {
+		fake.scheduledTasksReturnsOnCall = make(map[int]struct {
+			result1 []*models.ScheduledTask
+			result2 error
 		})
 	}
-	fake.resolvingTaskReturnsOnCall[i] = struct {
-		result1 *models.Task
-		result2 *models.Task
-		result3 error
-	}{result1, result2, result3}
+	fake.scheduledTasksReturnsOnCall[i] = struct {
+		result1 []*models.ScheduledTask
+		result2 error
+	}{result1, result2}
 }
 
 func (fake *FakeDB) SetEncryptionKeyLabel(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
@@ -3069,6 +5273,133 @@ func (fake *FakeDB) StartActualLRPReturnsOnCall(i int, result1 *models.ActualLRP
 	}{result1, result2, result3}
 }
 
+func (fake *FakeDB) StartDrainingCell(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
+	fake.startDrainingCellMutex.Lock()
+	ret, specificReturn := fake.startDrainingCellReturnsOnCall[len(fake.startDrainingCellArgsForCall)]
+	fake.startDrainingCellArgsForCall = append(fake.startDrainingCellArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.StartDrainingCellStub
+	fakeReturns := fake.startDrainingCellReturns
+	fake.recordInvocation("StartDrainingCell", []interface{}{arg1, arg2, arg3})
+	fake.startDrainingCellMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDB) StartDrainingCellCallCount() int {
+	fake.startDrainingCellMutex.RLock()
+	defer fake.startDrainingCellMutex.RUnlock()
+	return len(fake.startDrainingCellArgsForCall)
+}
+
+func (fake *FakeDB) StartDrainingCellCalls(stub func(context.Context, lager.Logger, string) error) {
+	fake.startDrainingCellMutex.Lock()
+	defer fake.startDrainingCellMutex.Unlock()
+	fake.StartDrainingCellStub = stub
+}
+
+func (fake *FakeDB) StartDrainingCellArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.startDrainingCellMutex.RLock()
+	defer fake.startDrainingCellMutex.RUnlock()
+	argsForCall := fake.startDrainingCellArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) StartDrainingCellReturns(result1 error) {
+	fake.startDrainingCellMutex.Lock()
+	defer fake.startDrainingCellMutex.Unlock()
+	fake.StartDrainingCellStub = nil
+	fake.startDrainingCellReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) StartDrainingCellReturnsOnCall(i int, result1 error) {
+	fake.startDrainingCellMutex.Lock()
+	defer fake.startDrainingCellMutex.Unlock()
+	fake.StartDrainingCellStub = nil
+	if fake.startDrainingCellReturnsOnCall == nil {
+		fake.startDrainingCellReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.startDrainingCellReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) StartRollingRestart(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 int32) error {
+	fake.startRollingRestartMutex.Lock()
+	ret, specificReturn := fake.startRollingRestartReturnsOnCall[len(fake.startRollingRestartArgsForCall)]
+	fake.startRollingRestartArgsForCall = append(fake.startRollingRestartArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int32
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.StartRollingRestartStub
+	fakeReturns := fake.startRollingRestartReturns
+	fake.recordInvocation("StartRollingRestart", []interface{}{arg1, arg2, arg3, arg4})
+	fake.startRollingRestartMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDB) StartRollingRestartCallCount() int {
+	fake.startRollingRestartMutex.RLock()
+	defer fake.startRollingRestartMutex.RUnlock()
+	return len(fake.startRollingRestartArgsForCall)
+}
+
+func (fake *FakeDB) StartRollingRestartCalls(stub func(context.Context, lager.Logger, string, int32) error) {
+	fake.startRollingRestartMutex.Lock()
+	defer fake.startRollingRestartMutex.Unlock()
+	fake.StartRollingRestartStub = stub
+}
+
+func (fake *FakeDB) StartRollingRestartArgsForCall(i int) (context.Context, lager.Logger, string, int32) {
+	fake.startRollingRestartMutex.RLock()
+	defer fake.startRollingRestartMutex.RUnlock()
+	argsForCall := fake.startRollingRestartArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeDB) StartRollingRestartReturns(result1 error) {
+	fake.startRollingRestartMutex.Lock()
+	defer fake.startRollingRestartMutex.Unlock()
+	fake.StartRollingRestartStub = nil
+	fake.startRollingRestartReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) StartRollingRestartReturnsOnCall(i int, result1 error) {
+	fake.startRollingRestartMutex.Lock()
+	defer fake.startRollingRestartMutex.Unlock()
+	fake.StartRollingRestartStub = nil
+	if fake.startRollingRestartReturnsOnCall == nil {
+		fake.startRollingRestartReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.startRollingRestartReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeDB) StartTask(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 string) (*models.Task, *models.Task, bool, error) {
 	fake.startTaskMutex.Lock()
 	ret, specificReturn := fake.startTaskReturnsOnCall[len(fake.startTaskArgsForCall)]
@@ -3208,6 +5539,72 @@ func (fake *FakeDB) TaskByGuidReturnsOnCall(i int, result1 *models.Task, result2
 	}{result1, result2}
 }
 
+func (fake *FakeDB) TaskResult(arg1 context.Context, arg2 lager.Logger, arg3 string) (string, error) {
+	fake.taskResultMutex.Lock()
+	ret, specificReturn := fake.taskResultReturnsOnCall[len(fake.taskResultArgsForCall)]
+	fake.taskResultArgsForCall = append(fake.taskResultArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.TaskResultStub
+	fakeReturns := fake.taskResultReturns
+	fake.recordInvocation("TaskResult", []interface{}{arg1, arg2, arg3})
+	fake.taskResultMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) TaskResultCallCount() int {
+	fake.taskResultMutex.RLock()
+	defer fake.taskResultMutex.RUnlock()
+	return len(fake.taskResultArgsForCall)
+}
+
+func (fake *FakeDB) TaskResultCalls(stub func(context.Context, lager.Logger, string) (string, error)) {
+	fake.taskResultMutex.Lock()
+	defer fake.taskResultMutex.Unlock()
+	fake.TaskResultStub = stub
+}
+
+func (fake *FakeDB) TaskResultArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.taskResultMutex.RLock()
+	defer fake.taskResultMutex.RUnlock()
+	argsForCall := fake.taskResultArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) TaskResultReturns(result1 string, result2 error) {
+	fake.taskResultMutex.Lock()
+	defer fake.taskResultMutex.Unlock()
+	fake.TaskResultStub = nil
+	fake.taskResultReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) TaskResultReturnsOnCall(i int, result1 string, result2 error) {
+	fake.taskResultMutex.Lock()
+	defer fake.taskResultMutex.Unlock()
+	fake.TaskResultStub = nil
+	if fake.taskResultReturnsOnCall == nil {
+		fake.taskResultReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.taskResultReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDB) Tasks(arg1 context.Context, arg2 lager.Logger, arg3 models.TaskFilter) ([]*models.Task, error) {
 	fake.tasksMutex.Lock()
 	ret, specificReturn := fake.tasksReturnsOnCall[len(fake.tasksArgsForCall)]
@@ -3274,6 +5671,72 @@ func (fake *FakeDB) TasksReturnsOnCall(i int, result1 []*models.Task, result2 er
 	}{result1, result2}
 }
 
+func (fake *FakeDB) TasksByArrayGuid(arg1 context.Context, arg2 lager.Logger, arg3 string) ([]*models.Task, error) {
+	fake.tasksByArrayGuidMutex.Lock()
+	ret, specificReturn := fake.tasksByArrayGuidReturnsOnCall[len(fake.tasksByArrayGuidArgsForCall)]
+	fake.tasksByArrayGuidArgsForCall = append(fake.tasksByArrayGuidArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.TasksByArrayGuidStub
+	fakeReturns := fake.tasksByArrayGuidReturns
+	fake.recordInvocation("TasksByArrayGuid", []interface{}{arg1, arg2, arg3})
+	fake.tasksByArrayGuidMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) TasksByArrayGuidCallCount() int {
+	fake.tasksByArrayGuidMutex.RLock()
+	defer fake.tasksByArrayGuidMutex.RUnlock()
+	return len(fake.tasksByArrayGuidArgsForCall)
+}
+
+func (fake *FakeDB) TasksByArrayGuidCalls(stub func(context.Context, lager.Logger, string) ([]*models.Task, error)) {
+	fake.tasksByArrayGuidMutex.Lock()
+	defer fake.tasksByArrayGuidMutex.Unlock()
+	fake.TasksByArrayGuidStub = stub
+}
+
+func (fake *FakeDB) TasksByArrayGuidArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.tasksByArrayGuidMutex.RLock()
+	defer fake.tasksByArrayGuidMutex.RUnlock()
+	argsForCall := fake.tasksByArrayGuidArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) TasksByArrayGuidReturns(result1 []*models.Task, result2 error) {
+	fake.tasksByArrayGuidMutex.Lock()
+	defer fake.tasksByArrayGuidMutex.Unlock()
+	fake.TasksByArrayGuidStub = nil
+	fake.tasksByArrayGuidReturns = struct {
+		result1 []*models.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) TasksByArrayGuidReturnsOnCall(i int, result1 []*models.Task, result2 error) {
+	fake.tasksByArrayGuidMutex.Lock()
+	defer fake.tasksByArrayGuidMutex.Unlock()
+	fake.TasksByArrayGuidStub = nil
+	if fake.tasksByArrayGuidReturnsOnCall == nil {
+		fake.tasksByArrayGuidReturnsOnCall = make(map[int]struct {
+			result1 []*models.Task
+			result2 error
+		})
+	}
+	fake.tasksByArrayGuidReturnsOnCall[i] = struct {
+		result1 []*models.Task
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDB) UnclaimActualLRP(arg1 context.Context, arg2 lager.Logger, arg3 *models.ActualLRPKey) (*models.ActualLRP, *models.ActualLRP, error) {
 	fake.unclaimActualLRPMutex.Lock()
 	ret, specificReturn := fake.unclaimActualLRPReturnsOnCall[len(fake.unclaimActualLRPArgsForCall)]
@@ -3343,6 +5806,69 @@ func (fake *FakeDB) UnclaimActualLRPReturnsOnCall(i int, result1 *models.ActualL
 	}{result1, result2, result3}
 }
 
+func (fake *FakeDB) UncordonCell(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
+	fake.uncordonCellMutex.Lock()
+	ret, specificReturn := fake.uncordonCellReturnsOnCall[len(fake.uncordonCellArgsForCall)]
+	fake.uncordonCellArgsForCall = append(fake.uncordonCellArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.UncordonCellStub
+	fakeReturns := fake.uncordonCellReturns
+	fake.recordInvocation("UncordonCell", []interface{}{arg1, arg2, arg3})
+	fake.uncordonCellMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDB) UncordonCellCallCount() int {
+	fake.uncordonCellMutex.RLock()
+	defer fake.uncordonCellMutex.RUnlock()
+	return len(fake.uncordonCellArgsForCall)
+}
+
+func (fake *FakeDB) UncordonCellCalls(stub func(context.Context, lager.Logger, string) error) {
+	fake.uncordonCellMutex.Lock()
+	defer fake.uncordonCellMutex.Unlock()
+	fake.UncordonCellStub = stub
+}
+
+func (fake *FakeDB) UncordonCellArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.uncordonCellMutex.RLock()
+	defer fake.uncordonCellMutex.RUnlock()
+	argsForCall := fake.uncordonCellArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) UncordonCellReturns(result1 error) {
+	fake.uncordonCellMutex.Lock()
+	defer fake.uncordonCellMutex.Unlock()
+	fake.UncordonCellStub = nil
+	fake.uncordonCellReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) UncordonCellReturnsOnCall(i int, result1 error) {
+	fake.uncordonCellMutex.Lock()
+	defer fake.uncordonCellMutex.Unlock()
+	fake.UncordonCellStub = nil
+	if fake.uncordonCellReturnsOnCall == nil {
+		fake.uncordonCellReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.uncordonCellReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeDB) UpdateDesiredLRP(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 *models.DesiredLRPUpdate) (*models.DesiredLRP, error) {
 	fake.updateDesiredLRPMutex.Lock()
 	ret, specificReturn := fake.updateDesiredLRPReturnsOnCall[len(fake.updateDesiredLRPArgsForCall)]
@@ -3410,7 +5936,73 @@ func (fake *FakeDB) UpdateDesiredLRPReturnsOnCall(i int, result1 *models.Desired
 	}{result1, result2}
 }
 
-func (fake *FakeDB) UpsertDomain(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 uint32) error {
+func (fake *FakeDB) UpdateScheduledTask(arg1 context.Context, arg2 lager.Logger, arg3 *models.ScheduledTask) (*models.ScheduledTask, error) {
+	fake.updateScheduledTaskMutex.Lock()
+	ret, specificReturn := fake.updateScheduledTaskReturnsOnCall[len(fake.updateScheduledTaskArgsForCall)]
+	fake.updateScheduledTaskArgsForCall = append(fake.updateScheduledTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ScheduledTask
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateScheduledTaskStub
+	fakeReturns := fake.updateScheduledTaskReturns
+	fake.recordInvocation("UpdateScheduledTask", []interface{}{arg1, arg2, arg3})
+	fake.updateScheduledTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) UpdateScheduledTaskCallCount() int {
+	fake.updateScheduledTaskMutex.RLock()
+	defer fake.updateScheduledTaskMutex.RUnlock()
+	return len(fake.updateScheduledTaskArgsForCall)
+}
+
+func (fake *FakeDB) UpdateScheduledTaskCalls(stub func(context.Context, lager.Logger, *models.ScheduledTask) (*models.ScheduledTask, error)) {
+	fake.updateScheduledTaskMutex.Lock()
+	defer fake.updateScheduledTaskMutex.Unlock()
+	fake.UpdateScheduledTaskStub = stub
+}
+
+func (fake *FakeDB) UpdateScheduledTaskArgsForCall(i int) (context.Context, lager.Logger, *models.ScheduledTask) {
+	fake.updateScheduledTaskMutex.RLock()
+	defer fake.updateScheduledTaskMutex.RUnlock()
+	argsForCall := fake.updateScheduledTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) UpdateScheduledTaskReturns(result1 *models.ScheduledTask, result2 error) {
+	fake.updateScheduledTaskMutex.Lock()
+	defer fake.updateScheduledTaskMutex.Unlock()
+	fake.UpdateScheduledTaskStub = nil
+	fake.updateScheduledTaskReturns = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) UpdateScheduledTaskReturnsOnCall(i int, result1 *models.ScheduledTask, result2 error) {
+	fake.updateScheduledTaskMutex.Lock()
+	defer fake.updateScheduledTaskMutex.Unlock()
+	fake.UpdateScheduledTaskStub = nil
+	if fake.updateScheduledTaskReturnsOnCall == nil {
+		fake.updateScheduledTaskReturnsOnCall = make(map[int]struct {
+			result1 *models.ScheduledTask
+			result2 error
+		})
+	}
+	fake.updateScheduledTaskReturnsOnCall[i] = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) UpsertDomain(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 uint32, arg5 string) (bool, error) {
 	fake.upsertDomainMutex.Lock()
 	ret, specificReturn := fake.upsertDomainReturnsOnCall[len(fake.upsertDomainArgsForCall)]
 	fake.upsertDomainArgsForCall = append(fake.upsertDomainArgsForCall, struct {
@@ -3418,18 +6010,19 @@ func (fake *FakeDB) UpsertDomain(arg1 context.Context, arg2 lager.Logger, arg3 s
 		arg2 lager.Logger
 		arg3 string
 		arg4 uint32
-	}{arg1, arg2, arg3, arg4})
+		arg5 string
+	}{arg1, arg2, arg3, arg4, arg5})
 	stub := fake.UpsertDomainStub
 	fakeReturns := fake.upsertDomainReturns
-	fake.recordInvocation("UpsertDomain", []interface{}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("UpsertDomain", []interface{}{arg1, arg2, arg3, arg4, arg5})
 	fake.upsertDomainMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4)
+		return stub(arg1, arg2, arg3, arg4, arg5)
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1
+	return fakeReturns.result1, fakeReturns.result2
 }
 
 func (fake *FakeDB) UpsertDomainCallCount() int {
@@ -3438,38 +6031,104 @@ func (fake *FakeDB) UpsertDomainCallCount() int {
 	return len(fake.upsertDomainArgsForCall)
 }
 
-func (fake *FakeDB) UpsertDomainCalls(stub func(context.Context, lager.Logger, string, uint32) error) {
+func (fake *FakeDB) UpsertDomainCalls(stub func(context.Context, lager.Logger, string, uint32, string) (bool, error)) {
 	fake.upsertDomainMutex.Lock()
 	defer fake.upsertDomainMutex.Unlock()
 	fake.UpsertDomainStub = stub
 }
 
-func (fake *FakeDB) UpsertDomainArgsForCall(i int) (context.Context, lager.Logger, string, uint32) {
+func (fake *FakeDB) UpsertDomainArgsForCall(i int) (context.Context, lager.Logger, string, uint32, string) {
 	fake.upsertDomainMutex.RLock()
 	defer fake.upsertDomainMutex.RUnlock()
 	argsForCall := fake.upsertDomainArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
 }
 
-func (fake *FakeDB) UpsertDomainReturns(result1 error) {
+func (fake *FakeDB) UpsertDomainReturns(result1 bool, result2 error) {
 	fake.upsertDomainMutex.Lock()
 	defer fake.upsertDomainMutex.Unlock()
 	fake.UpsertDomainStub = nil
 	fake.upsertDomainReturns = struct {
-		result1 error
-	}{result1}
+		result1 bool
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeDB) UpsertDomainReturnsOnCall(i int, result1 error) {
+func (fake *FakeDB) UpsertDomainReturnsOnCall(i int, result1 bool, result2 error) {
 	fake.upsertDomainMutex.Lock()
 	defer fake.upsertDomainMutex.Unlock()
 	fake.UpsertDomainStub = nil
 	if fake.upsertDomainReturnsOnCall == nil {
 		fake.upsertDomainReturnsOnCall = make(map[int]struct {
-			result1 error
+			result1 bool
+			result2 error
 		})
 	}
 	fake.upsertDomainReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) UpsertDomainQuota(arg1 context.Context, arg2 lager.Logger, arg3 *models.DomainQuota) error {
+	fake.upsertDomainQuotaMutex.Lock()
+	ret, specificReturn := fake.upsertDomainQuotaReturnsOnCall[len(fake.upsertDomainQuotaArgsForCall)]
+	fake.upsertDomainQuotaArgsForCall = append(fake.upsertDomainQuotaArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.DomainQuota
+	}{arg1, arg2, arg3})
+	stub := fake.UpsertDomainQuotaStub
+	fakeReturns := fake.upsertDomainQuotaReturns
+	fake.recordInvocation("UpsertDomainQuota", []interface{}{arg1, arg2, arg3})
+	fake.upsertDomainQuotaMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDB) UpsertDomainQuotaCallCount() int {
+	fake.upsertDomainQuotaMutex.RLock()
+	defer fake.upsertDomainQuotaMutex.RUnlock()
+	return len(fake.upsertDomainQuotaArgsForCall)
+}
+
+func (fake *FakeDB) UpsertDomainQuotaCalls(stub func(context.Context, lager.Logger, *models.DomainQuota) error) {
+	fake.upsertDomainQuotaMutex.Lock()
+	defer fake.upsertDomainQuotaMutex.Unlock()
+	fake.UpsertDomainQuotaStub = stub
+}
+
+func (fake *FakeDB) UpsertDomainQuotaArgsForCall(i int) (context.Context, lager.Logger, *models.DomainQuota) {
+	fake.upsertDomainQuotaMutex.RLock()
+	defer fake.upsertDomainQuotaMutex.RUnlock()
+	argsForCall := fake.upsertDomainQuotaArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) UpsertDomainQuotaReturns(result1 error) {
+	fake.upsertDomainQuotaMutex.Lock()
+	defer fake.upsertDomainQuotaMutex.Unlock()
+	fake.UpsertDomainQuotaStub = nil
+	fake.upsertDomainQuotaReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) UpsertDomainQuotaReturnsOnCall(i int, result1 error) {
+	fake.upsertDomainQuotaMutex.Lock()
+	defer fake.upsertDomainQuotaMutex.Unlock()
+	fake.UpsertDomainQuotaStub = nil
+	if fake.upsertDomainQuotaReturnsOnCall == nil {
+		fake.upsertDomainQuotaReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.upsertDomainQuotaReturnsOnCall[i] = struct {
 		result1 error
 	}{result1}
 }
@@ -3543,10 +6202,22 @@ func (fake *FakeDB) VersionReturnsOnCall(i int, result1 *models.Version, result2
 func (fake *FakeDB) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.actualLRPCrashesMutex.RLock()
+	defer fake.actualLRPCrashesMutex.RUnlock()
 	fake.actualLRPsMutex.RLock()
 	defer fake.actualLRPsMutex.RUnlock()
+	fake.advanceRollingRestartMutex.RLock()
+	defer fake.advanceRollingRestartMutex.RUnlock()
+	fake.archivedTaskByGuidMutex.RLock()
+	defer fake.archivedTaskByGuidMutex.RUnlock()
+	fake.archivedTasksMutex.RLock()
+	defer fake.archivedTasksMutex.RUnlock()
 	fake.cancelTaskMutex.RLock()
 	defer fake.cancelTaskMutex.RUnlock()
+	fake.cellDrainStatusMutex.RLock()
+	defer fake.cellDrainStatusMutex.RUnlock()
+	fake.cellReservationsMutex.RLock()
+	defer fake.cellReservationsMutex.RUnlock()
 	fake.changeActualLRPPresenceMutex.RLock()
 	defer fake.changeActualLRPPresenceMutex.RUnlock()
 	fake.claimActualLRPMutex.RLock()
@@ -3557,6 +6228,10 @@ func (fake *FakeDB) Invocations() map[string][][]interface{} {
 	defer fake.convergeLRPsMutex.RUnlock()
 	fake.convergeTasksMutex.RLock()
 	defer fake.convergeTasksMutex.RUnlock()
+	fake.cordonCellMutex.RLock()
+	defer fake.cordonCellMutex.RUnlock()
+	fake.cordonedCellsMutex.RLock()
+	defer fake.cordonedCellsMutex.RUnlock()
 	fake.countActualLRPsByStateMutex.RLock()
 	defer fake.countActualLRPsByStateMutex.RUnlock()
 	fake.countDesiredInstancesMutex.RLock()
@@ -3565,26 +6240,50 @@ func (fake *FakeDB) Invocations() map[string][][]interface{} {
 	defer fake.crashActualLRPMutex.RUnlock()
 	fake.createUnclaimedActualLRPMutex.RLock()
 	defer fake.createUnclaimedActualLRPMutex.RUnlock()
+	fake.deleteDomainMutex.RLock()
+	defer fake.deleteDomainMutex.RUnlock()
+	fake.deleteDomainQuotaMutex.RLock()
+	defer fake.deleteDomainQuotaMutex.RUnlock()
+	fake.deleteScheduledTaskMutex.RLock()
+	defer fake.deleteScheduledTaskMutex.RUnlock()
 	fake.deleteTaskMutex.RLock()
 	defer fake.deleteTaskMutex.RUnlock()
 	fake.desireLRPMutex.RLock()
 	defer fake.desireLRPMutex.RUnlock()
+	fake.desireScheduledTaskMutex.RLock()
+	defer fake.desireScheduledTaskMutex.RUnlock()
 	fake.desireTaskMutex.RLock()
 	defer fake.desireTaskMutex.RUnlock()
+	fake.desireTaskArrayMutex.RLock()
+	defer fake.desireTaskArrayMutex.RUnlock()
 	fake.desiredLRPByProcessGuidMutex.RLock()
 	defer fake.desiredLRPByProcessGuidMutex.RUnlock()
+	fake.desiredLRPRevisionMutex.RLock()
+	defer fake.desiredLRPRevisionMutex.RUnlock()
+	fake.desiredLRPRevisionsMutex.RLock()
+	defer fake.desiredLRPRevisionsMutex.RUnlock()
 	fake.desiredLRPRoutingInfosMutex.RLock()
 	defer fake.desiredLRPRoutingInfosMutex.RUnlock()
 	fake.desiredLRPSchedulingInfoByProcessGuidMutex.RLock()
 	defer fake.desiredLRPSchedulingInfoByProcessGuidMutex.RUnlock()
 	fake.desiredLRPSchedulingInfosMutex.RLock()
 	defer fake.desiredLRPSchedulingInfosMutex.RUnlock()
+	fake.desiredLRPStatusesMutex.RLock()
+	defer fake.desiredLRPStatusesMutex.RUnlock()
 	fake.desiredLRPsMutex.RLock()
 	defer fake.desiredLRPsMutex.RUnlock()
+	fake.domainQuotasMutex.RLock()
+	defer fake.domainQuotasMutex.RUnlock()
+	fake.domainUsageMutex.RLock()
+	defer fake.domainUsageMutex.RUnlock()
+	fake.domainsMutex.RLock()
+	defer fake.domainsMutex.RUnlock()
 	fake.encryptionKeyLabelMutex.RLock()
 	defer fake.encryptionKeyLabelMutex.RUnlock()
 	fake.evacuateActualLRPMutex.RLock()
 	defer fake.evacuateActualLRPMutex.RUnlock()
+	fake.expireDomainMutex.RLock()
+	defer fake.expireDomainMutex.RUnlock()
 	fake.failActualLRPMutex.RLock()
 	defer fake.failActualLRPMutex.RUnlock()
 	fake.failTaskMutex.RLock()
@@ -3595,8 +6294,14 @@ func (fake *FakeDB) Invocations() map[string][][]interface{} {
 	defer fake.performEncryptionMutex.RUnlock()
 	fake.promoteSuspectActualLRPMutex.RLock()
 	defer fake.promoteSuspectActualLRPMutex.RUnlock()
+	fake.pruneActualLRPCrashesMutex.RLock()
+	defer fake.pruneActualLRPCrashesMutex.RUnlock()
+	fake.recordScheduledTaskRunMutex.RLock()
+	defer fake.recordScheduledTaskRunMutex.RUnlock()
 	fake.rejectTaskMutex.RLock()
 	defer fake.rejectTaskMutex.RUnlock()
+	fake.releaseBlockedTasksMutex.RLock()
+	defer fake.releaseBlockedTasksMutex.RUnlock()
 	fake.removeActualLRPMutex.RLock()
 	defer fake.removeActualLRPMutex.RUnlock()
 	fake.removeDesiredLRPMutex.RLock()
@@ -3607,24 +6312,44 @@ func (fake *FakeDB) Invocations() map[string][][]interface{} {
 	defer fake.removeSuspectActualLRPMutex.RUnlock()
 	fake.resolvingTaskMutex.RLock()
 	defer fake.resolvingTaskMutex.RUnlock()
+	fake.rollbackDesiredLRPMutex.RLock()
+	defer fake.rollbackDesiredLRPMutex.RUnlock()
+	fake.scheduledTaskByGuidMutex.RLock()
+	defer fake.scheduledTaskByGuidMutex.RUnlock()
+	fake.scheduledTasksMutex.RLock()
+	defer fake.scheduledTasksMutex.RUnlock()
 	fake.setEncryptionKeyLabelMutex.RLock()
 	defer fake.setEncryptionKeyLabelMutex.RUnlock()
 	fake.setVersionMutex.RLock()
 	defer fake.setVersionMutex.RUnlock()
 	fake.startActualLRPMutex.RLock()
 	defer fake.startActualLRPMutex.RUnlock()
+	fake.startDrainingCellMutex.RLock()
+	defer fake.startDrainingCellMutex.RUnlock()
+	fake.startRollingRestartMutex.RLock()
+	defer fake.startRollingRestartMutex.RUnlock()
 	fake.startTaskMutex.RLock()
 	defer fake.startTaskMutex.RUnlock()
 	fake.taskByGuidMutex.RLock()
 	defer fake.taskByGuidMutex.RUnlock()
+	fake.taskResultMutex.RLock()
+	defer fake.taskResultMutex.RUnlock()
 	fake.tasksMutex.RLock()
 	defer fake.tasksMutex.RUnlock()
+	fake.tasksByArrayGuidMutex.RLock()
+	defer fake.tasksByArrayGuidMutex.RUnlock()
 	fake.unclaimActualLRPMutex.RLock()
 	defer fake.unclaimActualLRPMutex.RUnlock()
+	fake.uncordonCellMutex.RLock()
+	defer fake.uncordonCellMutex.RUnlock()
 	fake.updateDesiredLRPMutex.RLock()
 	defer fake.updateDesiredLRPMutex.RUnlock()
+	fake.updateScheduledTaskMutex.RLock()
+	defer fake.updateScheduledTaskMutex.RUnlock()
 	fake.upsertDomainMutex.RLock()
 	defer fake.upsertDomainMutex.RUnlock()
+	fake.upsertDomainQuotaMutex.RLock()
+	defer fake.upsertDomainQuotaMutex.RUnlock()
 	fake.versionMutex.RLock()
 	defer fake.versionMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -4,6 +4,7 @@ package dbfakes
 import (
 	"context"
 	"sync"
+	"time"
 
 	"code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/models"
@@ -11,6 +12,22 @@ import (
 )
 
 type FakeDesiredLRPDB struct {
+	AdvanceRollingRestartStub        func(context.Context, lager.Logger, string, time.Duration) ([]*models.ActualLRPKey, error)
+	advanceRollingRestartMutex       sync.RWMutex
+	advanceRollingRestartArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 time.Duration
+	}
+	advanceRollingRestartReturns struct {
+		result1 []*models.ActualLRPKey
+		result2 error
+	}
+	advanceRollingRestartReturnsOnCall map[int]struct {
+		result1 []*models.ActualLRPKey
+		result2 error
+	}
 	DesireLRPStub        func(context.Context, lager.Logger, *models.DesiredLRP) error
 	desireLRPMutex       sync.RWMutex
 	desireLRPArgsForCall []struct {
@@ -39,6 +56,37 @@ type FakeDesiredLRPDB struct {
 		result1 *models.DesiredLRP
 		result2 error
 	}
+	DesiredLRPRevisionStub        func(context.Context, lager.Logger, string, int32) (*models.DesiredLRPRevision, error)
+	desiredLRPRevisionMutex       sync.RWMutex
+	desiredLRPRevisionArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int32
+	}
+	desiredLRPRevisionReturns struct {
+		result1 *models.DesiredLRPRevision
+		result2 error
+	}
+	desiredLRPRevisionReturnsOnCall map[int]struct {
+		result1 *models.DesiredLRPRevision
+		result2 error
+	}
+	DesiredLRPRevisionsStub        func(context.Context, lager.Logger, string) ([]*models.DesiredLRPRevision, error)
+	desiredLRPRevisionsMutex       sync.RWMutex
+	desiredLRPRevisionsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	desiredLRPRevisionsReturns struct {
+		result1 []*models.DesiredLRPRevision
+		result2 error
+	}
+	desiredLRPRevisionsReturnsOnCall map[int]struct {
+		result1 []*models.DesiredLRPRevision
+		result2 error
+	}
 	DesiredLRPRoutingInfosStub        func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRP, error)
 	desiredLRPRoutingInfosMutex       sync.RWMutex
 	desiredLRPRoutingInfosArgsForCall []struct {
@@ -84,6 +132,21 @@ type FakeDesiredLRPDB struct {
 		result1 []*models.DesiredLRPSchedulingInfo
 		result2 error
 	}
+	DesiredLRPStatusesStub        func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRPStatus, error)
+	desiredLRPStatusesMutex       sync.RWMutex
+	desiredLRPStatusesArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.DesiredLRPFilter
+	}
+	desiredLRPStatusesReturns struct {
+		result1 []*models.DesiredLRPStatus
+		result2 error
+	}
+	desiredLRPStatusesReturnsOnCall map[int]struct {
+		result1 []*models.DesiredLRPStatus
+		result2 error
+	}
 	DesiredLRPsStub        func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRP, error)
 	desiredLRPsMutex       sync.RWMutex
 	desiredLRPsArgsForCall []struct {
@@ -112,6 +175,36 @@ type FakeDesiredLRPDB struct {
 	removeDesiredLRPReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RollbackDesiredLRPStub        func(context.Context, lager.Logger, string, int32) (*models.DesiredLRP, error)
+	rollbackDesiredLRPMutex       sync.RWMutex
+	rollbackDesiredLRPArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int32
+	}
+	rollbackDesiredLRPReturns struct {
+		result1 *models.DesiredLRP
+		result2 error
+	}
+	rollbackDesiredLRPReturnsOnCall map[int]struct {
+		result1 *models.DesiredLRP
+		result2 error
+	}
+	StartRollingRestartStub        func(context.Context, lager.Logger, string, int32) error
+	startRollingRestartMutex       sync.RWMutex
+	startRollingRestartArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int32
+	}
+	startRollingRestartReturns struct {
+		result1 error
+	}
+	startRollingRestartReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UpdateDesiredLRPStub        func(context.Context, lager.Logger, string, *models.DesiredLRPUpdate) (*models.DesiredLRP, error)
 	updateDesiredLRPMutex       sync.RWMutex
 	updateDesiredLRPArgsForCall []struct {
@@ -132,6 +225,73 @@ type FakeDesiredLRPDB struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeDesiredLRPDB) AdvanceRollingRestart(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 time.Duration) ([]*models.ActualLRPKey, error) {
+	fake.advanceRollingRestartMutex.Lock()
+	ret, specificReturn := fake.advanceRollingRestartReturnsOnCall[len(fake.advanceRollingRestartArgsForCall)]
+	fake.advanceRollingRestartArgsForCall = append(fake.advanceRollingRestartArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 time.Duration
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.AdvanceRollingRestartStub
+	fakeReturns := fake.advanceRollingRestartReturns
+	fake.recordInvocation("AdvanceRollingRestart", []interface{}{arg1, arg2, arg3, arg4})
+	fake.advanceRollingRestartMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDesiredLRPDB) AdvanceRollingRestartCallCount() int {
+	fake.advanceRollingRestartMutex.RLock()
+	defer fake.advanceRollingRestartMutex.RUnlock()
+	return len(fake.advanceRollingRestartArgsForCall)
+}
+
+func (fake *FakeDesiredLRPDB) AdvanceRollingRestartCalls(stub func(context.Context, lager.Logger, string, time.Duration) ([]*models.ActualLRPKey, error)) {
+	fake.advanceRollingRestartMutex.Lock()
+	defer fake.advanceRollingRestartMutex.Unlock()
+	fake.AdvanceRollingRestartStub = stub
+}
+
+func (fake *FakeDesiredLRPDB) AdvanceRollingRestartArgsForCall(i int) (context.Context, lager.Logger, string, time.Duration) {
+	fake.advanceRollingRestartMutex.RLock()
+	defer fake.advanceRollingRestartMutex.RUnlock()
+	argsForCall := fake.advanceRollingRestartArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeDesiredLRPDB) AdvanceRollingRestartReturns(result1 []*models.ActualLRPKey, result2 error) {
+	fake.advanceRollingRestartMutex.Lock()
+	defer fake.advanceRollingRestartMutex.Unlock()
+	fake.AdvanceRollingRestartStub = nil
+	fake.advanceRollingRestartReturns = struct {
+		result1 []*models.ActualLRPKey
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDesiredLRPDB) AdvanceRollingRestartReturnsOnCall(i int, result1 []*models.ActualLRPKey, result2 error) {
+	fake.advanceRollingRestartMutex.Lock()
+	defer fake.advanceRollingRestartMutex.Unlock()
+	fake.AdvanceRollingRestartStub = nil
+	if fake.advanceRollingRestartReturnsOnCall == nil {
+		fake.advanceRollingRestartReturnsOnCall = make(map[int]struct {
+			result1 []*models.ActualLRPKey
+			result2 error
+		})
+	}
+	fake.advanceRollingRestartReturnsOnCall[i] = struct {
+		result1 []*models.ActualLRPKey
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDesiredLRPDB) DesireLRP(arg1 context.Context, arg2 lager.Logger, arg3 *models.DesiredLRP) error {
 	fake.desireLRPMutex.Lock()
 	ret, specificReturn := fake.desireLRPReturnsOnCall[len(fake.desireLRPArgsForCall)]
@@ -261,6 +421,139 @@ func (fake *FakeDesiredLRPDB) DesiredLRPByProcessGuidReturnsOnCall(i int, result
 	}{result1, result2}
 }
 
+func (fake *FakeDesiredLRPDB) DesiredLRPRevision(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 int32) (*models.DesiredLRPRevision, error) {
+	fake.desiredLRPRevisionMutex.Lock()
+	ret, specificReturn := fake.desiredLRPRevisionReturnsOnCall[len(fake.desiredLRPRevisionArgsForCall)]
+	fake.desiredLRPRevisionArgsForCall = append(fake.desiredLRPRevisionArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int32
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.DesiredLRPRevisionStub
+	fakeReturns := fake.desiredLRPRevisionReturns
+	fake.recordInvocation("DesiredLRPRevision", []interface{}{arg1, arg2, arg3, arg4})
+	fake.desiredLRPRevisionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDesiredLRPDB) DesiredLRPRevisionCallCount() int {
+	fake.desiredLRPRevisionMutex.RLock()
+	defer fake.desiredLRPRevisionMutex.RUnlock()
+	return len(fake.desiredLRPRevisionArgsForCall)
+}
+
+func (fake *FakeDesiredLRPDB) DesiredLRPRevisionCalls(stub func(context.Context, lager.Logger, string, int32) (*models.DesiredLRPRevision, error)) {
+	fake.desiredLRPRevisionMutex.Lock()
+	defer fake.desiredLRPRevisionMutex.Unlock()
+	fake.DesiredLRPRevisionStub = stub
+}
+
+func (fake *FakeDesiredLRPDB) DesiredLRPRevisionArgsForCall(i int) (context.Context, lager.Logger, string, int32) {
+	fake.desiredLRPRevisionMutex.RLock()
+	defer fake.desiredLRPRevisionMutex.RUnlock()
+	argsForCall := fake.desiredLRPRevisionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeDesiredLRPDB) DesiredLRPRevisionReturns(result1 *models.DesiredLRPRevision, result2 error) {
+	fake.desiredLRPRevisionMutex.Lock()
+	defer fake.desiredLRPRevisionMutex.Unlock()
+	fake.DesiredLRPRevisionStub = nil
+	fake.desiredLRPRevisionReturns = struct {
+		result1 *models.DesiredLRPRevision
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDesiredLRPDB) DesiredLRPRevisionReturnsOnCall(i int, result1 *models.DesiredLRPRevision, result2 error) {
+	fake.desiredLRPRevisionMutex.Lock()
+	defer fake.desiredLRPRevisionMutex.Unlock()
+	fake.DesiredLRPRevisionStub = nil
+	if fake.desiredLRPRevisionReturnsOnCall == nil {
+		fake.desiredLRPRevisionReturnsOnCall = make(map[int]struct {
+			result1 *models.DesiredLRPRevision
+			result2 error
+		})
+	}
+	fake.desiredLRPRevisionReturnsOnCall[i] = struct {
+		result1 *models.DesiredLRPRevision
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDesiredLRPDB) DesiredLRPRevisions(arg1 context.Context, arg2 lager.Logger, arg3 string) ([]*models.DesiredLRPRevision, error) {
+	fake.desiredLRPRevisionsMutex.Lock()
+	ret, specificReturn := fake.desiredLRPRevisionsReturnsOnCall[len(fake.desiredLRPRevisionsArgsForCall)]
+	fake.desiredLRPRevisionsArgsForCall = append(fake.desiredLRPRevisionsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DesiredLRPRevisionsStub
+	fakeReturns := fake.desiredLRPRevisionsReturns
+	fake.recordInvocation("DesiredLRPRevisions", []interface{}{arg1, arg2, arg3})
+	fake.desiredLRPRevisionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDesiredLRPDB) DesiredLRPRevisionsCallCount() int {
+	fake.desiredLRPRevisionsMutex.RLock()
+	defer fake.desiredLRPRevisionsMutex.RUnlock()
+	return len(fake.desiredLRPRevisionsArgsForCall)
+}
+
+func (fake *FakeDesiredLRPDB) DesiredLRPRevisionsCalls(stub func(context.Context, lager.Logger, string) ([]*models.DesiredLRPRevision, error)) {
+	fake.desiredLRPRevisionsMutex.Lock()
+	defer fake.desiredLRPRevisionsMutex.Unlock()
+	fake.DesiredLRPRevisionsStub = stub
+}
+
+func (fake *FakeDesiredLRPDB) DesiredLRPRevisionsArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.desiredLRPRevisionsMutex.RLock()
+	defer fake.desiredLRPRevisionsMutex.RUnlock()
+	argsForCall := fake.desiredLRPRevisionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDesiredLRPDB) DesiredLRPRevisionsReturns(result1 []*models.DesiredLRPRevision, result2 error) {
+	fake.desiredLRPRevisionsMutex.Lock()
+	defer fake.desiredLRPRevisionsMutex.Unlock()
+	fake.DesiredLRPRevisionsStub = nil
+	fake.desiredLRPRevisionsReturns = struct {
+		result1 []*models.DesiredLRPRevision
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDesiredLRPDB) DesiredLRPRevisionsReturnsOnCall(i int, result1 []*models.DesiredLRPRevision, result2 error) {
+	fake.desiredLRPRevisionsMutex.Lock()
+	defer fake.desiredLRPRevisionsMutex.Unlock()
+	fake.DesiredLRPRevisionsStub = nil
+	if fake.desiredLRPRevisionsReturnsOnCall == nil {
+		fake.desiredLRPRevisionsReturnsOnCall = make(map[int]struct {
+			result1 []*models.DesiredLRPRevision
+			result2 error
+		})
+	}
+	fake.desiredLRPRevisionsReturnsOnCall[i] = struct {
+		result1 []*models.DesiredLRPRevision
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDesiredLRPDB) DesiredLRPRoutingInfos(arg1 context.Context, arg2 lager.Logger, arg3 models.DesiredLRPFilter) ([]*models.DesiredLRP, error) {
 	fake.desiredLRPRoutingInfosMutex.Lock()
 	ret, specificReturn := fake.desiredLRPRoutingInfosReturnsOnCall[len(fake.desiredLRPRoutingInfosArgsForCall)]
@@ -459,6 +752,72 @@ func (fake *FakeDesiredLRPDB) DesiredLRPSchedulingInfosReturnsOnCall(i int, resu
 	}{result1, result2}
 }
 
+func (fake *FakeDesiredLRPDB) DesiredLRPStatuses(arg1 context.Context, arg2 lager.Logger, arg3 models.DesiredLRPFilter) ([]*models.DesiredLRPStatus, error) {
+	fake.desiredLRPStatusesMutex.Lock()
+	ret, specificReturn := fake.desiredLRPStatusesReturnsOnCall[len(fake.desiredLRPStatusesArgsForCall)]
+	fake.desiredLRPStatusesArgsForCall = append(fake.desiredLRPStatusesArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.DesiredLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.DesiredLRPStatusesStub
+	fakeReturns := fake.desiredLRPStatusesReturns
+	fake.recordInvocation("DesiredLRPStatuses", []interface{}{arg1, arg2, arg3})
+	fake.desiredLRPStatusesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDesiredLRPDB) DesiredLRPStatusesCallCount() int {
+	fake.desiredLRPStatusesMutex.RLock()
+	defer fake.desiredLRPStatusesMutex.RUnlock()
+	return len(fake.desiredLRPStatusesArgsForCall)
+}
+
+func (fake *FakeDesiredLRPDB) DesiredLRPStatusesCalls(stub func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRPStatus, error)) {
+	fake.desiredLRPStatusesMutex.Lock()
+	defer fake.desiredLRPStatusesMutex.Unlock()
+	fake.DesiredLRPStatusesStub = stub
+}
+
+func (fake *FakeDesiredLRPDB) DesiredLRPStatusesArgsForCall(i int) (context.Context, lager.Logger, models.DesiredLRPFilter) {
+	fake.desiredLRPStatusesMutex.RLock()
+	defer fake.desiredLRPStatusesMutex.RUnlock()
+	argsForCall := fake.desiredLRPStatusesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDesiredLRPDB) DesiredLRPStatusesReturns(result1 []*models.DesiredLRPStatus, result2 error) {
+	fake.desiredLRPStatusesMutex.Lock()
+	defer fake.desiredLRPStatusesMutex.Unlock()
+	fake.DesiredLRPStatusesStub = nil
+	fake.desiredLRPStatusesReturns = struct {
+		result1 []*models.DesiredLRPStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDesiredLRPDB) DesiredLRPStatusesReturnsOnCall(i int, result1 []*models.DesiredLRPStatus, result2 error) {
+	fake.desiredLRPStatusesMutex.Lock()
+	defer fake.desiredLRPStatusesMutex.Unlock()
+	fake.DesiredLRPStatusesStub = nil
+	if fake.desiredLRPStatusesReturnsOnCall == nil {
+		fake.desiredLRPStatusesReturnsOnCall = make(map[int]struct {
+			result1 []*models.DesiredLRPStatus
+			result2 error
+		})
+	}
+	fake.desiredLRPStatusesReturnsOnCall[i] = struct {
+		result1 []*models.DesiredLRPStatus
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDesiredLRPDB) DesiredLRPs(arg1 context.Context, arg2 lager.Logger, arg3 models.DesiredLRPFilter) ([]*models.DesiredLRP, error) {
 	fake.desiredLRPsMutex.Lock()
 	ret, specificReturn := fake.desiredLRPsReturnsOnCall[len(fake.desiredLRPsArgsForCall)]
@@ -588,6 +947,137 @@ func (fake *FakeDesiredLRPDB) RemoveDesiredLRPReturnsOnCall(i int, result1 error
 	}{result1}
 }
 
+func (fake *FakeDesiredLRPDB) RollbackDesiredLRP(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 int32) (*models.DesiredLRP, error) {
+	fake.rollbackDesiredLRPMutex.Lock()
+	ret, specificReturn := fake.rollbackDesiredLRPReturnsOnCall[len(fake.rollbackDesiredLRPArgsForCall)]
+	fake.rollbackDesiredLRPArgsForCall = append(fake.rollbackDesiredLRPArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int32
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RollbackDesiredLRPStub
+	fakeReturns := fake.rollbackDesiredLRPReturns
+	fake.recordInvocation("RollbackDesiredLRP", []interface{}{arg1, arg2, arg3, arg4})
+	fake.rollbackDesiredLRPMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDesiredLRPDB) RollbackDesiredLRPCallCount() int {
+	fake.rollbackDesiredLRPMutex.RLock()
+	defer fake.rollbackDesiredLRPMutex.RUnlock()
+	return len(fake.rollbackDesiredLRPArgsForCall)
+}
+
+func (fake *FakeDesiredLRPDB) RollbackDesiredLRPCalls(stub func(context.Context, lager.Logger, string, int32) (*models.DesiredLRP, error)) {
+	fake.rollbackDesiredLRPMutex.Lock()
+	defer fake.rollbackDesiredLRPMutex.Unlock()
+	fake.RollbackDesiredLRPStub = stub
+}
+
+func (fake *FakeDesiredLRPDB) RollbackDesiredLRPArgsForCall(i int) (context.Context, lager.Logger, string, int32) {
+	fake.rollbackDesiredLRPMutex.RLock()
+	defer fake.rollbackDesiredLRPMutex.RUnlock()
+	argsForCall := fake.rollbackDesiredLRPArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeDesiredLRPDB) RollbackDesiredLRPReturns(result1 *models.DesiredLRP, result2 error) {
+	fake.rollbackDesiredLRPMutex.Lock()
+	defer fake.rollbackDesiredLRPMutex.Unlock()
+	fake.RollbackDesiredLRPStub = nil
+	fake.rollbackDesiredLRPReturns = struct {
+		result1 *models.DesiredLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDesiredLRPDB) RollbackDesiredLRPReturnsOnCall(i int, result1 *models.DesiredLRP, result2 error) {
+	fake.rollbackDesiredLRPMutex.Lock()
+	defer fake.rollbackDesiredLRPMutex.Unlock()
+	fake.RollbackDesiredLRPStub = nil
+	if fake.rollbackDesiredLRPReturnsOnCall == nil {
+		fake.rollbackDesiredLRPReturnsOnCall = make(map[int]struct {
+			result1 *models.DesiredLRP
+			result2 error
+		})
+	}
+	fake.rollbackDesiredLRPReturnsOnCall[i] = struct {
+		result1 *models.DesiredLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDesiredLRPDB) StartRollingRestart(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 int32) error {
+	fake.startRollingRestartMutex.Lock()
+	ret, specificReturn := fake.startRollingRestartReturnsOnCall[len(fake.startRollingRestartArgsForCall)]
+	fake.startRollingRestartArgsForCall = append(fake.startRollingRestartArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int32
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.StartRollingRestartStub
+	fakeReturns := fake.startRollingRestartReturns
+	fake.recordInvocation("StartRollingRestart", []interface{}{arg1, arg2, arg3, arg4})
+	fake.startRollingRestartMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDesiredLRPDB) StartRollingRestartCallCount() int {
+	fake.startRollingRestartMutex.RLock()
+	defer fake.startRollingRestartMutex.RUnlock()
+	return len(fake.startRollingRestartArgsForCall)
+}
+
+func (fake *FakeDesiredLRPDB) StartRollingRestartCalls(stub func(context.Context, lager.Logger, string, int32) error) {
+	fake.startRollingRestartMutex.Lock()
+	defer fake.startRollingRestartMutex.Unlock()
+	fake.StartRollingRestartStub = stub
+}
+
+func (fake *FakeDesiredLRPDB) StartRollingRestartArgsForCall(i int) (context.Context, lager.Logger, string, int32) {
+	fake.startRollingRestartMutex.RLock()
+	defer fake.startRollingRestartMutex.RUnlock()
+	argsForCall := fake.startRollingRestartArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeDesiredLRPDB) StartRollingRestartReturns(result1 error) {
+	fake.startRollingRestartMutex.Lock()
+	defer fake.startRollingRestartMutex.Unlock()
+	fake.StartRollingRestartStub = nil
+	fake.startRollingRestartReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDesiredLRPDB) StartRollingRestartReturnsOnCall(i int, result1 error) {
+	fake.startRollingRestartMutex.Lock()
+	defer fake.startRollingRestartMutex.Unlock()
+	fake.StartRollingRestartStub = nil
+	if fake.startRollingRestartReturnsOnCall == nil {
+		fake.startRollingRestartReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.startRollingRestartReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeDesiredLRPDB) UpdateDesiredLRP(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 *models.DesiredLRPUpdate) (*models.DesiredLRP, error) {
 	fake.updateDesiredLRPMutex.Lock()
 	ret, specificReturn := fake.updateDesiredLRPReturnsOnCall[len(fake.updateDesiredLRPArgsForCall)]
@@ -658,20 +1148,32 @@ func (fake *FakeDesiredLRPDB) UpdateDesiredLRPReturnsOnCall(i int, result1 *mode
 func (fake *FakeDesiredLRPDB) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.advanceRollingRestartMutex.RLock()
+	defer fake.advanceRollingRestartMutex.RUnlock()
 	fake.desireLRPMutex.RLock()
 	defer fake.desireLRPMutex.RUnlock()
 	fake.desiredLRPByProcessGuidMutex.RLock()
 	defer fake.desiredLRPByProcessGuidMutex.RUnlock()
+	fake.desiredLRPRevisionMutex.RLock()
+	defer fake.desiredLRPRevisionMutex.RUnlock()
+	fake.desiredLRPRevisionsMutex.RLock()
+	defer fake.desiredLRPRevisionsMutex.RUnlock()
 	fake.desiredLRPRoutingInfosMutex.RLock()
 	defer fake.desiredLRPRoutingInfosMutex.RUnlock()
 	fake.desiredLRPSchedulingInfoByProcessGuidMutex.RLock()
 	defer fake.desiredLRPSchedulingInfoByProcessGuidMutex.RUnlock()
 	fake.desiredLRPSchedulingInfosMutex.RLock()
 	defer fake.desiredLRPSchedulingInfosMutex.RUnlock()
+	fake.desiredLRPStatusesMutex.RLock()
+	defer fake.desiredLRPStatusesMutex.RUnlock()
 	fake.desiredLRPsMutex.RLock()
 	defer fake.desiredLRPsMutex.RUnlock()
 	fake.removeDesiredLRPMutex.RLock()
 	defer fake.removeDesiredLRPMutex.RUnlock()
+	fake.rollbackDesiredLRPMutex.RLock()
+	defer fake.rollbackDesiredLRPMutex.RUnlock()
+	fake.startRollingRestartMutex.RLock()
+	defer fake.startRollingRestartMutex.RUnlock()
 	fake.updateDesiredLRPMutex.RLock()
 	defer fake.updateDesiredLRPMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
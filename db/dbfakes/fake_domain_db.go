@@ -6,10 +6,55 @@ import (
 	"sync"
 
 	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
 	lager "code.cloudfoundry.org/lager/v3"
 )
 
 type FakeDomainDB struct {
+	DeleteDomainStub        func(context.Context, lager.Logger, string) (bool, error)
+	deleteDomainMutex       sync.RWMutex
+	deleteDomainArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	deleteDomainReturns struct {
+		result1 bool
+		result2 error
+	}
+	deleteDomainReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	DomainsStub        func(context.Context, lager.Logger) ([]*models.Domain, error)
+	domainsMutex       sync.RWMutex
+	domainsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	domainsReturns struct {
+		result1 []*models.Domain
+		result2 error
+	}
+	domainsReturnsOnCall map[int]struct {
+		result1 []*models.Domain
+		result2 error
+	}
+	ExpireDomainStub        func(context.Context, lager.Logger, string) (bool, error)
+	expireDomainMutex       sync.RWMutex
+	expireDomainArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	expireDomainReturns struct {
+		result1 bool
+		result2 error
+	}
+	expireDomainReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	FreshDomainsStub        func(context.Context, lager.Logger) ([]string, error)
 	freshDomainsMutex       sync.RWMutex
 	freshDomainsArgsForCall []struct {
@@ -24,24 +69,224 @@ type FakeDomainDB struct {
 		result1 []string
 		result2 error
 	}
-	UpsertDomainStub        func(context.Context, lager.Logger, string, uint32) error
+	UpsertDomainStub        func(context.Context, lager.Logger, string, uint32, string) (bool, error)
 	upsertDomainMutex       sync.RWMutex
 	upsertDomainArgsForCall []struct {
 		arg1 context.Context
 		arg2 lager.Logger
 		arg3 string
 		arg4 uint32
+		arg5 string
 	}
 	upsertDomainReturns struct {
-		result1 error
+		result1 bool
+		result2 error
 	}
 	upsertDomainReturnsOnCall map[int]struct {
-		result1 error
+		result1 bool
+		result2 error
 	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeDomainDB) DeleteDomain(arg1 context.Context, arg2 lager.Logger, arg3 string) (bool, error) {
+	fake.deleteDomainMutex.Lock()
+	ret, specificReturn := fake.deleteDomainReturnsOnCall[len(fake.deleteDomainArgsForCall)]
+	fake.deleteDomainArgsForCall = append(fake.deleteDomainArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteDomainStub
+	fakeReturns := fake.deleteDomainReturns
+	fake.recordInvocation("DeleteDomain", []interface{}{arg1, arg2, arg3})
+	fake.deleteDomainMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDomainDB) DeleteDomainCallCount() int {
+	fake.deleteDomainMutex.RLock()
+	defer fake.deleteDomainMutex.RUnlock()
+	return len(fake.deleteDomainArgsForCall)
+}
+
+func (fake *FakeDomainDB) DeleteDomainCalls(stub func(context.Context, lager.Logger, string) (bool, error)) {
+	fake.deleteDomainMutex.Lock()
+	defer fake.deleteDomainMutex.Unlock()
+	fake.DeleteDomainStub = stub
+}
+
+func (fake *FakeDomainDB) DeleteDomainArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.deleteDomainMutex.RLock()
+	defer fake.deleteDomainMutex.RUnlock()
+	argsForCall := fake.deleteDomainArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDomainDB) DeleteDomainReturns(result1 bool, result2 error) {
+	fake.deleteDomainMutex.Lock()
+	defer fake.deleteDomainMutex.Unlock()
+	fake.DeleteDomainStub = nil
+	fake.deleteDomainReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDomainDB) DeleteDomainReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.deleteDomainMutex.Lock()
+	defer fake.deleteDomainMutex.Unlock()
+	fake.DeleteDomainStub = nil
+	if fake.deleteDomainReturnsOnCall == nil {
+		fake.deleteDomainReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.deleteDomainReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDomainDB) Domains(arg1 context.Context, arg2 lager.Logger) ([]*models.Domain, error) {
+	fake.domainsMutex.Lock()
+	ret, specificReturn := fake.domainsReturnsOnCall[len(fake.domainsArgsForCall)]
+	fake.domainsArgsForCall = append(fake.domainsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.DomainsStub
+	fakeReturns := fake.domainsReturns
+	fake.recordInvocation("Domains", []interface{}{arg1, arg2})
+	fake.domainsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDomainDB) DomainsCallCount() int {
+	fake.domainsMutex.RLock()
+	defer fake.domainsMutex.RUnlock()
+	return len(fake.domainsArgsForCall)
+}
+
+func (fake *FakeDomainDB) DomainsCalls(stub func(context.Context, lager.Logger) ([]*models.Domain, error)) {
+	fake.domainsMutex.Lock()
+	defer fake.domainsMutex.Unlock()
+	fake.DomainsStub = stub
+}
+
+func (fake *FakeDomainDB) DomainsArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.domainsMutex.RLock()
+	defer fake.domainsMutex.RUnlock()
+	argsForCall := fake.domainsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDomainDB) DomainsReturns(result1 []*models.Domain, result2 error) {
+	fake.domainsMutex.Lock()
+	defer fake.domainsMutex.Unlock()
+	fake.DomainsStub = nil
+	fake.domainsReturns = struct {
+		result1 []*models.Domain
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDomainDB) DomainsReturnsOnCall(i int, result1 []*models.Domain, result2 error) {
+	fake.domainsMutex.Lock()
+	defer fake.domainsMutex.Unlock()
+	fake.DomainsStub = nil
+	if fake.domainsReturnsOnCall == nil {
+		fake.domainsReturnsOnCall = make(map[int]struct {
+			result1 []*models.Domain
+			result2 error
+		})
+	}
+	fake.domainsReturnsOnCall[i] = struct {
+		result1 []*models.Domain
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDomainDB) ExpireDomain(arg1 context.Context, arg2 lager.Logger, arg3 string) (bool, error) {
+	fake.expireDomainMutex.Lock()
+	ret, specificReturn := fake.expireDomainReturnsOnCall[len(fake.expireDomainArgsForCall)]
+	fake.expireDomainArgsForCall = append(fake.expireDomainArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ExpireDomainStub
+	fakeReturns := fake.expireDomainReturns
+	fake.recordInvocation("ExpireDomain", []interface{}{arg1, arg2, arg3})
+	fake.expireDomainMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDomainDB) ExpireDomainCallCount() int {
+	fake.expireDomainMutex.RLock()
+	defer fake.expireDomainMutex.RUnlock()
+	return len(fake.expireDomainArgsForCall)
+}
+
+func (fake *FakeDomainDB) ExpireDomainCalls(stub func(context.Context, lager.Logger, string) (bool, error)) {
+	fake.expireDomainMutex.Lock()
+	defer fake.expireDomainMutex.Unlock()
+	fake.ExpireDomainStub = stub
+}
+
+func (fake *FakeDomainDB) ExpireDomainArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.expireDomainMutex.RLock()
+	defer fake.expireDomainMutex.RUnlock()
+	argsForCall := fake.expireDomainArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDomainDB) ExpireDomainReturns(result1 bool, result2 error) {
+	fake.expireDomainMutex.Lock()
+	defer fake.expireDomainMutex.Unlock()
+	fake.ExpireDomainStub = nil
+	fake.expireDomainReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDomainDB) ExpireDomainReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.expireDomainMutex.Lock()
+	defer fake.expireDomainMutex.Unlock()
+	fake.ExpireDomainStub = nil
+	if fake.expireDomainReturnsOnCall == nil {
+		fake.expireDomainReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.expireDomainReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDomainDB) FreshDomains(arg1 context.Context, arg2 lager.Logger) ([]string, error) {
 	fake.freshDomainsMutex.Lock()
 	ret, specificReturn := fake.freshDomainsReturnsOnCall[len(fake.freshDomainsArgsForCall)]
@@ -107,7 +352,7 @@ func (fake *FakeDomainDB) FreshDomainsReturnsOnCall(i int, result1 []string, res
 	}{result1, result2}
 }
 
-func (fake *FakeDomainDB) UpsertDomain(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 uint32) error {
+func (fake *FakeDomainDB) UpsertDomain(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 uint32, arg5 string) (bool, error) {
 	fake.upsertDomainMutex.Lock()
 	ret, specificReturn := fake.upsertDomainReturnsOnCall[len(fake.upsertDomainArgsForCall)]
 	fake.upsertDomainArgsForCall = append(fake.upsertDomainArgsForCall, struct {
@@ -115,18 +360,19 @@ func (fake *FakeDomainDB) UpsertDomain(arg1 context.Context, arg2 lager.Logger,
 		arg2 lager.Logger
 		arg3 string
 		arg4 uint32
-	}{arg1, arg2, arg3, arg4})
+		arg5 string
+	}{arg1, arg2, arg3, arg4, arg5})
 	stub := fake.UpsertDomainStub
 	fakeReturns := fake.upsertDomainReturns
-	fake.recordInvocation("UpsertDomain", []interface{}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("UpsertDomain", []interface{}{arg1, arg2, arg3, arg4, arg5})
 	fake.upsertDomainMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4)
+		return stub(arg1, arg2, arg3, arg4, arg5)
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1
+	return fakeReturns.result1, fakeReturns.result2
 }
 
 func (fake *FakeDomainDB) UpsertDomainCallCount() int {
@@ -135,45 +381,54 @@ func (fake *FakeDomainDB) UpsertDomainCallCount() int {
 	return len(fake.upsertDomainArgsForCall)
 }
 
-func (fake *FakeDomainDB) UpsertDomainCalls(stub func(context.Context, lager.Logger, string, uint32) error) {
+func (fake *FakeDomainDB) UpsertDomainCalls(stub func(context.Context, lager.Logger, string, uint32, string) (bool, error)) {
 	fake.upsertDomainMutex.Lock()
 	defer fake.upsertDomainMutex.Unlock()
 	fake.UpsertDomainStub = stub
 }
 
-func (fake *FakeDomainDB) UpsertDomainArgsForCall(i int) (context.Context, lager.Logger, string, uint32) {
+func (fake *FakeDomainDB) UpsertDomainArgsForCall(i int) (context.Context, lager.Logger, string, uint32, string) {
 	fake.upsertDomainMutex.RLock()
 	defer fake.upsertDomainMutex.RUnlock()
 	argsForCall := fake.upsertDomainArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
 }
 
-func (fake *FakeDomainDB) UpsertDomainReturns(result1 error) {
+func (fake *FakeDomainDB) UpsertDomainReturns(result1 bool, result2 error) {
 	fake.upsertDomainMutex.Lock()
 	defer fake.upsertDomainMutex.Unlock()
 	fake.UpsertDomainStub = nil
 	fake.upsertDomainReturns = struct {
-		result1 error
-	}{result1}
+		result1 bool
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeDomainDB) UpsertDomainReturnsOnCall(i int, result1 error) {
+func (fake *FakeDomainDB) UpsertDomainReturnsOnCall(i int, result1 bool, result2 error) {
 	fake.upsertDomainMutex.Lock()
 	defer fake.upsertDomainMutex.Unlock()
 	fake.UpsertDomainStub = nil
 	if fake.upsertDomainReturnsOnCall == nil {
 		fake.upsertDomainReturnsOnCall = make(map[int]struct {
-			result1 error
+			result1 bool
+			result2 error
 		})
 	}
 	fake.upsertDomainReturnsOnCall[i] = struct {
-		result1 error
-	}{result1}
+		result1 bool
+		result2 error
+	}{result1, result2}
 }
 
 func (fake *FakeDomainDB) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.deleteDomainMutex.RLock()
+	defer fake.deleteDomainMutex.RUnlock()
+	fake.domainsMutex.RLock()
+	defer fake.domainsMutex.RUnlock()
+	fake.expireDomainMutex.RLock()
+	defer fake.expireDomainMutex.RUnlock()
 	fake.freshDomainsMutex.RLock()
 	defer fake.freshDomainsMutex.RUnlock()
 	fake.upsertDomainMutex.RLock()
@@ -0,0 +1,360 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"context"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	lager "code.cloudfoundry.org/lager/v3"
+)
+
+type FakeDomainQuotaDB struct {
+	DeleteDomainQuotaStub        func(context.Context, lager.Logger, string) error
+	deleteDomainQuotaMutex       sync.RWMutex
+	deleteDomainQuotaArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	deleteDomainQuotaReturns struct {
+		result1 error
+	}
+	deleteDomainQuotaReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DomainQuotasStub        func(context.Context, lager.Logger) ([]*models.DomainQuota, error)
+	domainQuotasMutex       sync.RWMutex
+	domainQuotasArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	domainQuotasReturns struct {
+		result1 []*models.DomainQuota
+		result2 error
+	}
+	domainQuotasReturnsOnCall map[int]struct {
+		result1 []*models.DomainQuota
+		result2 error
+	}
+	DomainUsageStub        func(context.Context, lager.Logger, string) (*models.DomainUsage, error)
+	domainUsageMutex       sync.RWMutex
+	domainUsageArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	domainUsageReturns struct {
+		result1 *models.DomainUsage
+		result2 error
+	}
+	domainUsageReturnsOnCall map[int]struct {
+		result1 *models.DomainUsage
+		result2 error
+	}
+	UpsertDomainQuotaStub        func(context.Context, lager.Logger, *models.DomainQuota) error
+	upsertDomainQuotaMutex       sync.RWMutex
+	upsertDomainQuotaArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.DomainQuota
+	}
+	upsertDomainQuotaReturns struct {
+		result1 error
+	}
+	upsertDomainQuotaReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDomainQuotaDB) DeleteDomainQuota(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
+	fake.deleteDomainQuotaMutex.Lock()
+	ret, specificReturn := fake.deleteDomainQuotaReturnsOnCall[len(fake.deleteDomainQuotaArgsForCall)]
+	fake.deleteDomainQuotaArgsForCall = append(fake.deleteDomainQuotaArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteDomainQuotaStub
+	fakeReturns := fake.deleteDomainQuotaReturns
+	fake.recordInvocation("DeleteDomainQuota", []interface{}{arg1, arg2, arg3})
+	fake.deleteDomainQuotaMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDomainQuotaDB) DeleteDomainQuotaCallCount() int {
+	fake.deleteDomainQuotaMutex.RLock()
+	defer fake.deleteDomainQuotaMutex.RUnlock()
+	return len(fake.deleteDomainQuotaArgsForCall)
+}
+
+func (fake *FakeDomainQuotaDB) DeleteDomainQuotaCalls(stub func(context.Context, lager.Logger, string) error) {
+	fake.deleteDomainQuotaMutex.Lock()
+	defer fake.deleteDomainQuotaMutex.Unlock()
+	fake.DeleteDomainQuotaStub = stub
+}
+
+func (fake *FakeDomainQuotaDB) DeleteDomainQuotaArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.deleteDomainQuotaMutex.RLock()
+	defer fake.deleteDomainQuotaMutex.RUnlock()
+	argsForCall := fake.deleteDomainQuotaArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDomainQuotaDB) DeleteDomainQuotaReturns(result1 error) {
+	fake.deleteDomainQuotaMutex.Lock()
+	defer fake.deleteDomainQuotaMutex.Unlock()
+	fake.DeleteDomainQuotaStub = nil
+	fake.deleteDomainQuotaReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDomainQuotaDB) DeleteDomainQuotaReturnsOnCall(i int, result1 error) {
+	fake.deleteDomainQuotaMutex.Lock()
+	defer fake.deleteDomainQuotaMutex.Unlock()
+	fake.DeleteDomainQuotaStub = nil
+	if fake.deleteDomainQuotaReturnsOnCall == nil {
+		fake.deleteDomainQuotaReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteDomainQuotaReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDomainQuotaDB) DomainQuotas(arg1 context.Context, arg2 lager.Logger) ([]*models.DomainQuota, error) {
+	fake.domainQuotasMutex.Lock()
+	ret, specificReturn := fake.domainQuotasReturnsOnCall[len(fake.domainQuotasArgsForCall)]
+	fake.domainQuotasArgsForCall = append(fake.domainQuotasArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.DomainQuotasStub
+	fakeReturns := fake.domainQuotasReturns
+	fake.recordInvocation("DomainQuotas", []interface{}{arg1, arg2})
+	fake.domainQuotasMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDomainQuotaDB) DomainQuotasCallCount() int {
+	fake.domainQuotasMutex.RLock()
+	defer fake.domainQuotasMutex.RUnlock()
+	return len(fake.domainQuotasArgsForCall)
+}
+
+func (fake *FakeDomainQuotaDB) DomainQuotasCalls(stub func(context.Context, lager.Logger) ([]*models.DomainQuota, error)) {
+	fake.domainQuotasMutex.Lock()
+	defer fake.domainQuotasMutex.Unlock()
+	fake.DomainQuotasStub = stub
+}
+
+func (fake *FakeDomainQuotaDB) DomainQuotasArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.domainQuotasMutex.RLock()
+	defer fake.domainQuotasMutex.RUnlock()
+	argsForCall := fake.domainQuotasArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDomainQuotaDB) DomainQuotasReturns(result1 []*models.DomainQuota, result2 error) {
+	fake.domainQuotasMutex.Lock()
+	defer fake.domainQuotasMutex.Unlock()
+	fake.DomainQuotasStub = nil
+	fake.domainQuotasReturns = struct {
+		result1 []*models.DomainQuota
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDomainQuotaDB) DomainQuotasReturnsOnCall(i int, result1 []*models.DomainQuota, result2 error) {
+	fake.domainQuotasMutex.Lock()
+	defer fake.domainQuotasMutex.Unlock()
+	fake.DomainQuotasStub = nil
+	if fake.domainQuotasReturnsOnCall == nil {
+		fake.domainQuotasReturnsOnCall = make(map[int]struct {
+			result1 []*models.DomainQuota
+			result2 error
+		})
+	}
+	fake.domainQuotasReturnsOnCall[i] = struct {
+		result1 []*models.DomainQuota
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDomainQuotaDB) DomainUsage(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.DomainUsage, error) {
+	fake.domainUsageMutex.Lock()
+	ret, specificReturn := fake.domainUsageReturnsOnCall[len(fake.domainUsageArgsForCall)]
+	fake.domainUsageArgsForCall = append(fake.domainUsageArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DomainUsageStub
+	fakeReturns := fake.domainUsageReturns
+	fake.recordInvocation("DomainUsage", []interface{}{arg1, arg2, arg3})
+	fake.domainUsageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDomainQuotaDB) DomainUsageCallCount() int {
+	fake.domainUsageMutex.RLock()
+	defer fake.domainUsageMutex.RUnlock()
+	return len(fake.domainUsageArgsForCall)
+}
+
+func (fake *FakeDomainQuotaDB) DomainUsageCalls(stub func(context.Context, lager.Logger, string) (*models.DomainUsage, error)) {
+	fake.domainUsageMutex.Lock()
+	defer fake.domainUsageMutex.Unlock()
+	fake.DomainUsageStub = stub
+}
+
+func (fake *FakeDomainQuotaDB) DomainUsageArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.domainUsageMutex.RLock()
+	defer fake.domainUsageMutex.RUnlock()
+	argsForCall := fake.domainUsageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDomainQuotaDB) DomainUsageReturns(result1 *models.DomainUsage, result2 error) {
+	fake.domainUsageMutex.Lock()
+	defer fake.domainUsageMutex.Unlock()
+	fake.DomainUsageStub = nil
+	fake.domainUsageReturns = struct {
+		result1 *models.DomainUsage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDomainQuotaDB) DomainUsageReturnsOnCall(i int, result1 *models.DomainUsage, result2 error) {
+	fake.domainUsageMutex.Lock()
+	defer fake.domainUsageMutex.Unlock()
+	fake.DomainUsageStub = nil
+	if fake.domainUsageReturnsOnCall == nil {
+		fake.domainUsageReturnsOnCall = make(map[int]struct {
+			result1 *models.DomainUsage
+			result2 error
+		})
+	}
+	fake.domainUsageReturnsOnCall[i] = struct {
+		result1 *models.DomainUsage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDomainQuotaDB) UpsertDomainQuota(arg1 context.Context, arg2 lager.Logger, arg3 *models.DomainQuota) error {
+	fake.upsertDomainQuotaMutex.Lock()
+	ret, specificReturn := fake.upsertDomainQuotaReturnsOnCall[len(fake.upsertDomainQuotaArgsForCall)]
+	fake.upsertDomainQuotaArgsForCall = append(fake.upsertDomainQuotaArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.DomainQuota
+	}{arg1, arg2, arg3})
+	stub := fake.UpsertDomainQuotaStub
+	fakeReturns := fake.upsertDomainQuotaReturns
+	fake.recordInvocation("UpsertDomainQuota", []interface{}{arg1, arg2, arg3})
+	fake.upsertDomainQuotaMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDomainQuotaDB) UpsertDomainQuotaCallCount() int {
+	fake.upsertDomainQuotaMutex.RLock()
+	defer fake.upsertDomainQuotaMutex.RUnlock()
+	return len(fake.upsertDomainQuotaArgsForCall)
+}
+
+func (fake *FakeDomainQuotaDB) UpsertDomainQuotaCalls(stub func(context.Context, lager.Logger, *models.DomainQuota) error) {
+	fake.upsertDomainQuotaMutex.Lock()
+	defer fake.upsertDomainQuotaMutex.Unlock()
+	fake.UpsertDomainQuotaStub = stub
+}
+
+func (fake *FakeDomainQuotaDB) UpsertDomainQuotaArgsForCall(i int) (context.Context, lager.Logger, *models.DomainQuota) {
+	fake.upsertDomainQuotaMutex.RLock()
+	defer fake.upsertDomainQuotaMutex.RUnlock()
+	argsForCall := fake.upsertDomainQuotaArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDomainQuotaDB) UpsertDomainQuotaReturns(result1 error) {
+	fake.upsertDomainQuotaMutex.Lock()
+	defer fake.upsertDomainQuotaMutex.Unlock()
+	fake.UpsertDomainQuotaStub = nil
+	fake.upsertDomainQuotaReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDomainQuotaDB) UpsertDomainQuotaReturnsOnCall(i int, result1 error) {
+	fake.upsertDomainQuotaMutex.Lock()
+	defer fake.upsertDomainQuotaMutex.Unlock()
+	fake.UpsertDomainQuotaStub = nil
+	if fake.upsertDomainQuotaReturnsOnCall == nil {
+		fake.upsertDomainQuotaReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.upsertDomainQuotaReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDomainQuotaDB) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.deleteDomainQuotaMutex.RLock()
+	defer fake.deleteDomainQuotaMutex.RUnlock()
+	fake.domainQuotasMutex.RLock()
+	defer fake.domainQuotasMutex.RUnlock()
+	fake.domainUsageMutex.RLock()
+	defer fake.domainUsageMutex.RUnlock()
+	fake.upsertDomainQuotaMutex.RLock()
+	defer fake.upsertDomainQuotaMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeDomainQuotaDB) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.DomainQuotaDB = new(FakeDomainQuotaDB)
@@ -4,6 +4,7 @@ package dbfakes
 import (
 	"context"
 	"sync"
+	"time"
 
 	"code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/models"
@@ -11,6 +12,22 @@ import (
 )
 
 type FakeLRPDB struct {
+	ActualLRPCrashesStub        func(context.Context, lager.Logger, string, *int32) ([]*models.ActualLRPCrash, error)
+	actualLRPCrashesMutex       sync.RWMutex
+	actualLRPCrashesArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 *int32
+	}
+	actualLRPCrashesReturns struct {
+		result1 []*models.ActualLRPCrash
+		result2 error
+	}
+	actualLRPCrashesReturnsOnCall map[int]struct {
+		result1 []*models.ActualLRPCrash
+		result2 error
+	}
 	ActualLRPsStub        func(context.Context, lager.Logger, models.ActualLRPFilter) ([]*models.ActualLRP, error)
 	actualLRPsMutex       sync.RWMutex
 	actualLRPsArgsForCall []struct {
@@ -26,6 +43,22 @@ type FakeLRPDB struct {
 		result1 []*models.ActualLRP
 		result2 error
 	}
+	AdvanceRollingRestartStub        func(context.Context, lager.Logger, string, time.Duration) ([]*models.ActualLRPKey, error)
+	advanceRollingRestartMutex       sync.RWMutex
+	advanceRollingRestartArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 time.Duration
+	}
+	advanceRollingRestartReturns struct {
+		result1 []*models.ActualLRPKey
+		result2 error
+	}
+	advanceRollingRestartReturnsOnCall map[int]struct {
+		result1 []*models.ActualLRPKey
+		result2 error
+	}
 	ChangeActualLRPPresenceStub        func(context.Context, lager.Logger, *models.ActualLRPKey, models.ActualLRP_Presence, models.ActualLRP_Presence) (*models.ActualLRP, *models.ActualLRP, error)
 	changeActualLRPPresenceMutex       sync.RWMutex
 	changeActualLRPPresenceArgsForCall []struct {
@@ -173,6 +206,37 @@ type FakeLRPDB struct {
 		result1 *models.DesiredLRP
 		result2 error
 	}
+	DesiredLRPRevisionStub        func(context.Context, lager.Logger, string, int32) (*models.DesiredLRPRevision, error)
+	desiredLRPRevisionMutex       sync.RWMutex
+	desiredLRPRevisionArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int32
+	}
+	desiredLRPRevisionReturns struct {
+		result1 *models.DesiredLRPRevision
+		result2 error
+	}
+	desiredLRPRevisionReturnsOnCall map[int]struct {
+		result1 *models.DesiredLRPRevision
+		result2 error
+	}
+	DesiredLRPRevisionsStub        func(context.Context, lager.Logger, string) ([]*models.DesiredLRPRevision, error)
+	desiredLRPRevisionsMutex       sync.RWMutex
+	desiredLRPRevisionsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	desiredLRPRevisionsReturns struct {
+		result1 []*models.DesiredLRPRevision
+		result2 error
+	}
+	desiredLRPRevisionsReturnsOnCall map[int]struct {
+		result1 []*models.DesiredLRPRevision
+		result2 error
+	}
 	DesiredLRPRoutingInfosStub        func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRP, error)
 	desiredLRPRoutingInfosMutex       sync.RWMutex
 	desiredLRPRoutingInfosArgsForCall []struct {
@@ -218,6 +282,21 @@ type FakeLRPDB struct {
 		result1 []*models.DesiredLRPSchedulingInfo
 		result2 error
 	}
+	DesiredLRPStatusesStub        func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRPStatus, error)
+	desiredLRPStatusesMutex       sync.RWMutex
+	desiredLRPStatusesArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.DesiredLRPFilter
+	}
+	desiredLRPStatusesReturns struct {
+		result1 []*models.DesiredLRPStatus
+		result2 error
+	}
+	desiredLRPStatusesReturnsOnCall map[int]struct {
+		result1 []*models.DesiredLRPStatus
+		result2 error
+	}
 	DesiredLRPsStub        func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRP, error)
 	desiredLRPsMutex       sync.RWMutex
 	desiredLRPsArgsForCall []struct {
@@ -251,6 +330,18 @@ type FakeLRPDB struct {
 		result2 *models.ActualLRP
 		result3 error
 	}
+	PruneActualLRPCrashesStub        func(context.Context, lager.Logger) error
+	pruneActualLRPCrashesMutex       sync.RWMutex
+	pruneActualLRPCrashesArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	pruneActualLRPCrashesReturns struct {
+		result1 error
+	}
+	pruneActualLRPCrashesReturnsOnCall map[int]struct {
+		result1 error
+	}
 	RemoveActualLRPStub        func(context.Context, lager.Logger, string, int32, *models.ActualLRPInstanceKey) error
 	removeActualLRPMutex       sync.RWMutex
 	removeActualLRPArgsForCall []struct {
@@ -279,6 +370,22 @@ type FakeLRPDB struct {
 	removeDesiredLRPReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RollbackDesiredLRPStub        func(context.Context, lager.Logger, string, int32) (*models.DesiredLRP, error)
+	rollbackDesiredLRPMutex       sync.RWMutex
+	rollbackDesiredLRPArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int32
+	}
+	rollbackDesiredLRPReturns struct {
+		result1 *models.DesiredLRP
+		result2 error
+	}
+	rollbackDesiredLRPReturnsOnCall map[int]struct {
+		result1 *models.DesiredLRP
+		result2 error
+	}
 	StartActualLRPStub        func(context.Context, lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey, *models.ActualLRPNetInfo, []*models.ActualLRPInternalRoute, map[string]string, bool, string) (*models.ActualLRP, *models.ActualLRP, error)
 	startActualLRPMutex       sync.RWMutex
 	startActualLRPArgsForCall []struct {
@@ -302,6 +409,20 @@ type FakeLRPDB struct {
 		result2 *models.ActualLRP
 		result3 error
 	}
+	StartRollingRestartStub        func(context.Context, lager.Logger, string, int32) error
+	startRollingRestartMutex       sync.RWMutex
+	startRollingRestartArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int32
+	}
+	startRollingRestartReturns struct {
+		result1 error
+	}
+	startRollingRestartReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UnclaimActualLRPStub        func(context.Context, lager.Logger, *models.ActualLRPKey) (*models.ActualLRP, *models.ActualLRP, error)
 	unclaimActualLRPMutex       sync.RWMutex
 	unclaimActualLRPArgsForCall []struct {
@@ -339,6 +460,73 @@ type FakeLRPDB struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeLRPDB) ActualLRPCrashes(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 *int32) ([]*models.ActualLRPCrash, error) {
+	fake.actualLRPCrashesMutex.Lock()
+	ret, specificReturn := fake.actualLRPCrashesReturnsOnCall[len(fake.actualLRPCrashesArgsForCall)]
+	fake.actualLRPCrashesArgsForCall = append(fake.actualLRPCrashesArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 *int32
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ActualLRPCrashesStub
+	fakeReturns := fake.actualLRPCrashesReturns
+	fake.recordInvocation("ActualLRPCrashes", []interface{}{arg1, arg2, arg3, arg4})
+	fake.actualLRPCrashesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeLRPDB) ActualLRPCrashesCallCount() int {
+	fake.actualLRPCrashesMutex.RLock()
+	defer fake.actualLRPCrashesMutex.RUnlock()
+	return len(fake.actualLRPCrashesArgsForCall)
+}
+
+func (fake *FakeLRPDB) ActualLRPCrashesCalls(stub func(context.Context, lager.Logger, string, *int32) ([]*models.ActualLRPCrash, error)) {
+	fake.actualLRPCrashesMutex.Lock()
+	defer fake.actualLRPCrashesMutex.Unlock()
+	fake.ActualLRPCrashesStub = stub
+}
+
+func (fake *FakeLRPDB) ActualLRPCrashesArgsForCall(i int) (context.Context, lager.Logger, string, *int32) {
+	fake.actualLRPCrashesMutex.RLock()
+	defer fake.actualLRPCrashesMutex.RUnlock()
+	argsForCall := fake.actualLRPCrashesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeLRPDB) ActualLRPCrashesReturns(result1 []*models.ActualLRPCrash, result2 error) {
+	fake.actualLRPCrashesMutex.Lock()
+	defer fake.actualLRPCrashesMutex.Unlock()
+	fake.ActualLRPCrashesStub = nil
+	fake.actualLRPCrashesReturns = struct {
+		result1 []*models.ActualLRPCrash
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLRPDB) ActualLRPCrashesReturnsOnCall(i int, result1 []*models.ActualLRPCrash, result2 error) {
+	fake.actualLRPCrashesMutex.Lock()
+	defer fake.actualLRPCrashesMutex.Unlock()
+	fake.ActualLRPCrashesStub = nil
+	if fake.actualLRPCrashesReturnsOnCall == nil {
+		fake.actualLRPCrashesReturnsOnCall = make(map[int]struct {
+			result1 []*models.ActualLRPCrash
+			result2 error
+		})
+	}
+	fake.actualLRPCrashesReturnsOnCall[i] = struct {
+		result1 []*models.ActualLRPCrash
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeLRPDB) ActualLRPs(arg1 context.Context, arg2 lager.Logger, arg3 models.ActualLRPFilter) ([]*models.ActualLRP, error) {
 	fake.actualLRPsMutex.Lock()
 	ret, specificReturn := fake.actualLRPsReturnsOnCall[len(fake.actualLRPsArgsForCall)]
@@ -405,6 +593,73 @@ func (fake *FakeLRPDB) ActualLRPsReturnsOnCall(i int, result1 []*models.ActualLR
 	}{result1, result2}
 }
 
+func (fake *FakeLRPDB) AdvanceRollingRestart(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 time.Duration) ([]*models.ActualLRPKey, error) {
+	fake.advanceRollingRestartMutex.Lock()
+	ret, specificReturn := fake.advanceRollingRestartReturnsOnCall[len(fake.advanceRollingRestartArgsForCall)]
+	fake.advanceRollingRestartArgsForCall = append(fake.advanceRollingRestartArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 time.Duration
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.AdvanceRollingRestartStub
+	fakeReturns := fake.advanceRollingRestartReturns
+	fake.recordInvocation("AdvanceRollingRestart", []interface{}{arg1, arg2, arg3, arg4})
+	fake.advanceRollingRestartMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeLRPDB) AdvanceRollingRestartCallCount() int {
+	fake.advanceRollingRestartMutex.RLock()
+	defer fake.advanceRollingRestartMutex.RUnlock()
+	return len(fake.advanceRollingRestartArgsForCall)
+}
+
+func (fake *FakeLRPDB) AdvanceRollingRestartCalls(stub func(context.Context, lager.Logger, string, time.Duration) ([]*models.ActualLRPKey, error)) {
+	fake.advanceRollingRestartMutex.Lock()
+	defer fake.advanceRollingRestartMutex.Unlock()
+	fake.AdvanceRollingRestartStub = stub
+}
+
+func (fake *FakeLRPDB) AdvanceRollingRestartArgsForCall(i int) (context.Context, lager.Logger, string, time.Duration) {
+	fake.advanceRollingRestartMutex.RLock()
+	defer fake.advanceRollingRestartMutex.RUnlock()
+	argsForCall := fake.advanceRollingRestartArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeLRPDB) AdvanceRollingRestartReturns(result1 []*models.ActualLRPKey, result2 error) {
+	fake.advanceRollingRestartMutex.Lock()
+	defer fake.advanceRollingRestartMutex.Unlock()
+	fake.AdvanceRollingRestartStub = nil
+	fake.advanceRollingRestartReturns = struct {
+		result1 []*models.ActualLRPKey
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLRPDB) AdvanceRollingRestartReturnsOnCall(i int, result1 []*models.ActualLRPKey, result2 error) {
+	fake.advanceRollingRestartMutex.Lock()
+	defer fake.advanceRollingRestartMutex.Unlock()
+	fake.AdvanceRollingRestartStub = nil
+	if fake.advanceRollingRestartReturnsOnCall == nil {
+		fake.advanceRollingRestartReturnsOnCall = make(map[int]struct {
+			result1 []*models.ActualLRPKey
+			result2 error
+		})
+	}
+	fake.advanceRollingRestartReturnsOnCall[i] = struct {
+		result1 []*models.ActualLRPKey
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeLRPDB) ChangeActualLRPPresence(arg1 context.Context, arg2 lager.Logger, arg3 *models.ActualLRPKey, arg4 models.ActualLRP_Presence, arg5 models.ActualLRP_Presence) (*models.ActualLRP, *models.ActualLRP, error) {
 	fake.changeActualLRPPresenceMutex.Lock()
 	ret, specificReturn := fake.changeActualLRPPresenceReturnsOnCall[len(fake.changeActualLRPPresenceArgsForCall)]
@@ -1015,6 +1270,139 @@ func (fake *FakeLRPDB) DesiredLRPByProcessGuidReturnsOnCall(i int, result1 *mode
 	}{result1, result2}
 }
 
+func (fake *FakeLRPDB) DesiredLRPRevision(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 int32) (*models.DesiredLRPRevision, error) {
+	fake.desiredLRPRevisionMutex.Lock()
+	ret, specificReturn := fake.desiredLRPRevisionReturnsOnCall[len(fake.desiredLRPRevisionArgsForCall)]
+	fake.desiredLRPRevisionArgsForCall = append(fake.desiredLRPRevisionArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int32
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.DesiredLRPRevisionStub
+	fakeReturns := fake.desiredLRPRevisionReturns
+	fake.recordInvocation("DesiredLRPRevision", []interface{}{arg1, arg2, arg3, arg4})
+	fake.desiredLRPRevisionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeLRPDB) DesiredLRPRevisionCallCount() int {
+	fake.desiredLRPRevisionMutex.RLock()
+	defer fake.desiredLRPRevisionMutex.RUnlock()
+	return len(fake.desiredLRPRevisionArgsForCall)
+}
+
+func (fake *FakeLRPDB) DesiredLRPRevisionCalls(stub func(context.Context, lager.Logger, string, int32) (*models.DesiredLRPRevision, error)) {
+	fake.desiredLRPRevisionMutex.Lock()
+	defer fake.desiredLRPRevisionMutex.Unlock()
+	fake.DesiredLRPRevisionStub = stub
+}
+
+func (fake *FakeLRPDB) DesiredLRPRevisionArgsForCall(i int) (context.Context, lager.Logger, string, int32) {
+	fake.desiredLRPRevisionMutex.RLock()
+	defer fake.desiredLRPRevisionMutex.RUnlock()
+	argsForCall := fake.desiredLRPRevisionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeLRPDB) DesiredLRPRevisionReturns(result1 *models.DesiredLRPRevision, result2 error) {
+	fake.desiredLRPRevisionMutex.Lock()
+	defer fake.desiredLRPRevisionMutex.Unlock()
+	fake.DesiredLRPRevisionStub = nil
+	fake.desiredLRPRevisionReturns = struct {
+		result1 *models.DesiredLRPRevision
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLRPDB) DesiredLRPRevisionReturnsOnCall(i int, result1 *models.DesiredLRPRevision, result2 error) {
+	fake.desiredLRPRevisionMutex.Lock()
+	defer fake.desiredLRPRevisionMutex.Unlock()
+	fake.DesiredLRPRevisionStub = nil
+	if fake.desiredLRPRevisionReturnsOnCall == nil {
+		fake.desiredLRPRevisionReturnsOnCall = make(map[int]struct {
+			result1 *models.DesiredLRPRevision
+			result2 error
+		})
+	}
+	fake.desiredLRPRevisionReturnsOnCall[i] = struct {
+		result1 *models.DesiredLRPRevision
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLRPDB) DesiredLRPRevisions(arg1 context.Context, arg2 lager.Logger, arg3 string) ([]*models.DesiredLRPRevision, error) {
+	fake.desiredLRPRevisionsMutex.Lock()
+	ret, specificReturn := fake.desiredLRPRevisionsReturnsOnCall[len(fake.desiredLRPRevisionsArgsForCall)]
+	fake.desiredLRPRevisionsArgsForCall = append(fake.desiredLRPRevisionsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DesiredLRPRevisionsStub
+	fakeReturns := fake.desiredLRPRevisionsReturns
+	fake.recordInvocation("DesiredLRPRevisions", []interface{}{arg1, arg2, arg3})
+	fake.desiredLRPRevisionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeLRPDB) DesiredLRPRevisionsCallCount() int {
+	fake.desiredLRPRevisionsMutex.RLock()
+	defer fake.desiredLRPRevisionsMutex.RUnlock()
+	return len(fake.desiredLRPRevisionsArgsForCall)
+}
+
+func (fake *FakeLRPDB) DesiredLRPRevisionsCalls(stub func(context.Context, lager.Logger, string) ([]*models.DesiredLRPRevision, error)) {
+	fake.desiredLRPRevisionsMutex.Lock()
+	defer fake.desiredLRPRevisionsMutex.Unlock()
+	fake.DesiredLRPRevisionsStub = stub
+}
+
+func (fake *FakeLRPDB) DesiredLRPRevisionsArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.desiredLRPRevisionsMutex.RLock()
+	defer fake.desiredLRPRevisionsMutex.RUnlock()
+	argsForCall := fake.desiredLRPRevisionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeLRPDB) DesiredLRPRevisionsReturns(result1 []*models.DesiredLRPRevision, result2 error) {
+	fake.desiredLRPRevisionsMutex.Lock()
+	defer fake.desiredLRPRevisionsMutex.Unlock()
+	fake.DesiredLRPRevisionsStub = nil
+	fake.desiredLRPRevisionsReturns = struct {
+		result1 []*models.DesiredLRPRevision
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLRPDB) DesiredLRPRevisionsReturnsOnCall(i int, result1 []*models.DesiredLRPRevision, result2 error) {
+	fake.desiredLRPRevisionsMutex.Lock()
+	defer fake.desiredLRPRevisionsMutex.Unlock()
+	fake.DesiredLRPRevisionsStub = nil
+	if fake.desiredLRPRevisionsReturnsOnCall == nil {
+		fake.desiredLRPRevisionsReturnsOnCall = make(map[int]struct {
+			result1 []*models.DesiredLRPRevision
+			result2 error
+		})
+	}
+	fake.desiredLRPRevisionsReturnsOnCall[i] = struct {
+		result1 []*models.DesiredLRPRevision
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeLRPDB) DesiredLRPRoutingInfos(arg1 context.Context, arg2 lager.Logger, arg3 models.DesiredLRPFilter) ([]*models.DesiredLRP, error) {
 	fake.desiredLRPRoutingInfosMutex.Lock()
 	ret, specificReturn := fake.desiredLRPRoutingInfosReturnsOnCall[len(fake.desiredLRPRoutingInfosArgsForCall)]
@@ -1213,6 +1601,72 @@ func (fake *FakeLRPDB) DesiredLRPSchedulingInfosReturnsOnCall(i int, result1 []*
 	}{result1, result2}
 }
 
+func (fake *FakeLRPDB) DesiredLRPStatuses(arg1 context.Context, arg2 lager.Logger, arg3 models.DesiredLRPFilter) ([]*models.DesiredLRPStatus, error) {
+	fake.desiredLRPStatusesMutex.Lock()
+	ret, specificReturn := fake.desiredLRPStatusesReturnsOnCall[len(fake.desiredLRPStatusesArgsForCall)]
+	fake.desiredLRPStatusesArgsForCall = append(fake.desiredLRPStatusesArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.DesiredLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.DesiredLRPStatusesStub
+	fakeReturns := fake.desiredLRPStatusesReturns
+	fake.recordInvocation("DesiredLRPStatuses", []interface{}{arg1, arg2, arg3})
+	fake.desiredLRPStatusesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeLRPDB) DesiredLRPStatusesCallCount() int {
+	fake.desiredLRPStatusesMutex.RLock()
+	defer fake.desiredLRPStatusesMutex.RUnlock()
+	return len(fake.desiredLRPStatusesArgsForCall)
+}
+
+func (fake *FakeLRPDB) DesiredLRPStatusesCalls(stub func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRPStatus, error)) {
+	fake.desiredLRPStatusesMutex.Lock()
+	defer fake.desiredLRPStatusesMutex.Unlock()
+	fake.DesiredLRPStatusesStub = stub
+}
+
+func (fake *FakeLRPDB) DesiredLRPStatusesArgsForCall(i int) (context.Context, lager.Logger, models.DesiredLRPFilter) {
+	fake.desiredLRPStatusesMutex.RLock()
+	defer fake.desiredLRPStatusesMutex.RUnlock()
+	argsForCall := fake.desiredLRPStatusesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeLRPDB) DesiredLRPStatusesReturns(result1 []*models.DesiredLRPStatus, result2 error) {
+	fake.desiredLRPStatusesMutex.Lock()
+	defer fake.desiredLRPStatusesMutex.Unlock()
+	fake.DesiredLRPStatusesStub = nil
+	fake.desiredLRPStatusesReturns = struct {
+		result1 []*models.DesiredLRPStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLRPDB) DesiredLRPStatusesReturnsOnCall(i int, result1 []*models.DesiredLRPStatus, result2 error) {
+	fake.desiredLRPStatusesMutex.Lock()
+	defer fake.desiredLRPStatusesMutex.Unlock()
+	fake.DesiredLRPStatusesStub = nil
+	if fake.desiredLRPStatusesReturnsOnCall == nil {
+		fake.desiredLRPStatusesReturnsOnCall = make(map[int]struct {
+			result1 []*models.DesiredLRPStatus
+			result2 error
+		})
+	}
+	fake.desiredLRPStatusesReturnsOnCall[i] = struct {
+		result1 []*models.DesiredLRPStatus
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeLRPDB) DesiredLRPs(arg1 context.Context, arg2 lager.Logger, arg3 models.DesiredLRPFilter) ([]*models.DesiredLRP, error) {
 	fake.desiredLRPsMutex.Lock()
 	ret, specificReturn := fake.desiredLRPsReturnsOnCall[len(fake.desiredLRPsArgsForCall)]
@@ -1349,6 +1803,68 @@ func (fake *FakeLRPDB) FailActualLRPReturnsOnCall(i int, result1 *models.ActualL
 	}{result1, result2, result3}
 }
 
+func (fake *FakeLRPDB) PruneActualLRPCrashes(arg1 context.Context, arg2 lager.Logger) error {
+	fake.pruneActualLRPCrashesMutex.Lock()
+	ret, specificReturn := fake.pruneActualLRPCrashesReturnsOnCall[len(fake.pruneActualLRPCrashesArgsForCall)]
+	fake.pruneActualLRPCrashesArgsForCall = append(fake.pruneActualLRPCrashesArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.PruneActualLRPCrashesStub
+	fakeReturns := fake.pruneActualLRPCrashesReturns
+	fake.recordInvocation("PruneActualLRPCrashes", []interface{}{arg1, arg2})
+	fake.pruneActualLRPCrashesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeLRPDB) PruneActualLRPCrashesCallCount() int {
+	fake.pruneActualLRPCrashesMutex.RLock()
+	defer fake.pruneActualLRPCrashesMutex.RUnlock()
+	return len(fake.pruneActualLRPCrashesArgsForCall)
+}
+
+func (fake *FakeLRPDB) PruneActualLRPCrashesCalls(stub func(context.Context, lager.Logger) error) {
+	fake.pruneActualLRPCrashesMutex.Lock()
+	defer fake.pruneActualLRPCrashesMutex.Unlock()
+	fake.PruneActualLRPCrashesStub = stub
+}
+
+func (fake *FakeLRPDB) PruneActualLRPCrashesArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.pruneActualLRPCrashesMutex.RLock()
+	defer fake.pruneActualLRPCrashesMutex.RUnlock()
+	argsForCall := fake.pruneActualLRPCrashesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeLRPDB) PruneActualLRPCrashesReturns(result1 error) {
+	fake.pruneActualLRPCrashesMutex.Lock()
+	defer fake.pruneActualLRPCrashesMutex.Unlock()
+	fake.PruneActualLRPCrashesStub = nil
+	fake.pruneActualLRPCrashesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeLRPDB) PruneActualLRPCrashesReturnsOnCall(i int, result1 error) {
+	fake.pruneActualLRPCrashesMutex.Lock()
+	defer fake.pruneActualLRPCrashesMutex.Unlock()
+	fake.PruneActualLRPCrashesStub = nil
+	if fake.pruneActualLRPCrashesReturnsOnCall == nil {
+		fake.pruneActualLRPCrashesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.pruneActualLRPCrashesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeLRPDB) RemoveActualLRP(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 int32, arg5 *models.ActualLRPInstanceKey) error {
 	fake.removeActualLRPMutex.Lock()
 	ret, specificReturn := fake.removeActualLRPReturnsOnCall[len(fake.removeActualLRPArgsForCall)]
@@ -1477,6 +1993,73 @@ func (fake *FakeLRPDB) RemoveDesiredLRPReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeLRPDB) RollbackDesiredLRP(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 int32) (*models.DesiredLRP, error) {
+	fake.rollbackDesiredLRPMutex.Lock()
+	ret, specificReturn := fake.rollbackDesiredLRPReturnsOnCall[len(fake.rollbackDesiredLRPArgsForCall)]
+	fake.rollbackDesiredLRPArgsForCall = append(fake.rollbackDesiredLRPArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int32
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RollbackDesiredLRPStub
+	fakeReturns := fake.rollbackDesiredLRPReturns
+	fake.recordInvocation("RollbackDesiredLRP", []interface{}{arg1, arg2, arg3, arg4})
+	fake.rollbackDesiredLRPMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeLRPDB) RollbackDesiredLRPCallCount() int {
+	fake.rollbackDesiredLRPMutex.RLock()
+	defer fake.rollbackDesiredLRPMutex.RUnlock()
+	return len(fake.rollbackDesiredLRPArgsForCall)
+}
+
+func (fake *FakeLRPDB) RollbackDesiredLRPCalls(stub func(context.Context, lager.Logger, string, int32) (*models.DesiredLRP, error)) {
+	fake.rollbackDesiredLRPMutex.Lock()
+	defer fake.rollbackDesiredLRPMutex.Unlock()
+	fake.RollbackDesiredLRPStub = stub
+}
+
+func (fake *FakeLRPDB) RollbackDesiredLRPArgsForCall(i int) (context.Context, lager.Logger, string, int32) {
+	fake.rollbackDesiredLRPMutex.RLock()
+	defer fake.rollbackDesiredLRPMutex.RUnlock()
+	argsForCall := fake.rollbackDesiredLRPArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeLRPDB) RollbackDesiredLRPReturns(result1 *models.DesiredLRP, result2 error) {
+	fake.rollbackDesiredLRPMutex.Lock()
+	defer fake.rollbackDesiredLRPMutex.Unlock()
+	fake.RollbackDesiredLRPStub = nil
+	fake.rollbackDesiredLRPReturns = struct {
+		result1 *models.DesiredLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLRPDB) RollbackDesiredLRPReturnsOnCall(i int, result1 *models.DesiredLRP, result2 error) {
+	fake.rollbackDesiredLRPMutex.Lock()
+	defer fake.rollbackDesiredLRPMutex.Unlock()
+	fake.RollbackDesiredLRPStub = nil
+	if fake.rollbackDesiredLRPReturnsOnCall == nil {
+		fake.rollbackDesiredLRPReturnsOnCall = make(map[int]struct {
+			result1 *models.DesiredLRP
+			result2 error
+		})
+	}
+	fake.rollbackDesiredLRPReturnsOnCall[i] = struct {
+		result1 *models.DesiredLRP
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeLRPDB) StartActualLRP(arg1 context.Context, arg2 lager.Logger, arg3 *models.ActualLRPKey, arg4 *models.ActualLRPInstanceKey, arg5 *models.ActualLRPNetInfo, arg6 []*models.ActualLRPInternalRoute, arg7 map[string]string, arg8 bool, arg9 string) (*models.ActualLRP, *models.ActualLRP, error) {
 	var arg6Copy []*models.ActualLRPInternalRoute
 	if arg6 != nil {
@@ -1557,6 +2140,70 @@ func (fake *FakeLRPDB) StartActualLRPReturnsOnCall(i int, result1 *models.Actual
 	}{result1, result2, result3}
 }
 
+func (fake *FakeLRPDB) StartRollingRestart(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 int32) error {
+	fake.startRollingRestartMutex.Lock()
+	ret, specificReturn := fake.startRollingRestartReturnsOnCall[len(fake.startRollingRestartArgsForCall)]
+	fake.startRollingRestartArgsForCall = append(fake.startRollingRestartArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int32
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.StartRollingRestartStub
+	fakeReturns := fake.startRollingRestartReturns
+	fake.recordInvocation("StartRollingRestart", []interface{}{arg1, arg2, arg3, arg4})
+	fake.startRollingRestartMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeLRPDB) StartRollingRestartCallCount() int {
+	fake.startRollingRestartMutex.RLock()
+	defer fake.startRollingRestartMutex.RUnlock()
+	return len(fake.startRollingRestartArgsForCall)
+}
+
+func (fake *FakeLRPDB) StartRollingRestartCalls(stub func(context.Context, lager.Logger, string, int32) error) {
+	fake.startRollingRestartMutex.Lock()
+	defer fake.startRollingRestartMutex.Unlock()
+	fake.StartRollingRestartStub = stub
+}
+
+func (fake *FakeLRPDB) StartRollingRestartArgsForCall(i int) (context.Context, lager.Logger, string, int32) {
+	fake.startRollingRestartMutex.RLock()
+	defer fake.startRollingRestartMutex.RUnlock()
+	argsForCall := fake.startRollingRestartArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeLRPDB) StartRollingRestartReturns(result1 error) {
+	fake.startRollingRestartMutex.Lock()
+	defer fake.startRollingRestartMutex.Unlock()
+	fake.StartRollingRestartStub = nil
+	fake.startRollingRestartReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeLRPDB) StartRollingRestartReturnsOnCall(i int, result1 error) {
+	fake.startRollingRestartMutex.Lock()
+	defer fake.startRollingRestartMutex.Unlock()
+	fake.StartRollingRestartStub = nil
+	if fake.startRollingRestartReturnsOnCall == nil {
+		fake.startRollingRestartReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.startRollingRestartReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeLRPDB) UnclaimActualLRP(arg1 context.Context, arg2 lager.Logger, arg3 *models.ActualLRPKey) (*models.ActualLRP, *models.ActualLRP, error) {
 	fake.unclaimActualLRPMutex.Lock()
 	ret, specificReturn := fake.unclaimActualLRPReturnsOnCall[len(fake.unclaimActualLRPArgsForCall)]
@@ -1696,8 +2343,12 @@ func (fake *FakeLRPDB) UpdateDesiredLRPReturnsOnCall(i int, result1 *models.Desi
 func (fake *FakeLRPDB) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.actualLRPCrashesMutex.RLock()
+	defer fake.actualLRPCrashesMutex.RUnlock()
 	fake.actualLRPsMutex.RLock()
 	defer fake.actualLRPsMutex.RUnlock()
+	fake.advanceRollingRestartMutex.RLock()
+	defer fake.advanceRollingRestartMutex.RUnlock()
 	fake.changeActualLRPPresenceMutex.RLock()
 	defer fake.changeActualLRPPresenceMutex.RUnlock()
 	fake.claimActualLRPMutex.RLock()
@@ -1716,22 +2367,34 @@ func (fake *FakeLRPDB) Invocations() map[string][][]interface{} {
 	defer fake.desireLRPMutex.RUnlock()
 	fake.desiredLRPByProcessGuidMutex.RLock()
 	defer fake.desiredLRPByProcessGuidMutex.RUnlock()
+	fake.desiredLRPRevisionMutex.RLock()
+	defer fake.desiredLRPRevisionMutex.RUnlock()
+	fake.desiredLRPRevisionsMutex.RLock()
+	defer fake.desiredLRPRevisionsMutex.RUnlock()
 	fake.desiredLRPRoutingInfosMutex.RLock()
 	defer fake.desiredLRPRoutingInfosMutex.RUnlock()
 	fake.desiredLRPSchedulingInfoByProcessGuidMutex.RLock()
 	defer fake.desiredLRPSchedulingInfoByProcessGuidMutex.RUnlock()
 	fake.desiredLRPSchedulingInfosMutex.RLock()
 	defer fake.desiredLRPSchedulingInfosMutex.RUnlock()
+	fake.desiredLRPStatusesMutex.RLock()
+	defer fake.desiredLRPStatusesMutex.RUnlock()
 	fake.desiredLRPsMutex.RLock()
 	defer fake.desiredLRPsMutex.RUnlock()
 	fake.failActualLRPMutex.RLock()
 	defer fake.failActualLRPMutex.RUnlock()
+	fake.pruneActualLRPCrashesMutex.RLock()
+	defer fake.pruneActualLRPCrashesMutex.RUnlock()
 	fake.removeActualLRPMutex.RLock()
 	defer fake.removeActualLRPMutex.RUnlock()
 	fake.removeDesiredLRPMutex.RLock()
 	defer fake.removeDesiredLRPMutex.RUnlock()
+	fake.rollbackDesiredLRPMutex.RLock()
+	defer fake.rollbackDesiredLRPMutex.RUnlock()
 	fake.startActualLRPMutex.RLock()
 	defer fake.startActualLRPMutex.RUnlock()
+	fake.startRollingRestartMutex.RLock()
+	defer fake.startRollingRestartMutex.RUnlock()
 	fake.unclaimActualLRPMutex.RLock()
 	defer fake.unclaimActualLRPMutex.RUnlock()
 	fake.updateDesiredLRPMutex.RLock()
@@ -0,0 +1,211 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"context"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	lager "code.cloudfoundry.org/lager/v3"
+)
+
+type FakeTaskArchiveDB struct {
+	ArchivedTaskByGuidStub        func(context.Context, lager.Logger, string) (*models.ArchivedTask, error)
+	archivedTaskByGuidMutex       sync.RWMutex
+	archivedTaskByGuidArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	archivedTaskByGuidReturns struct {
+		result1 *models.ArchivedTask
+		result2 error
+	}
+	archivedTaskByGuidReturnsOnCall map[int]struct {
+		result1 *models.ArchivedTask
+		result2 error
+	}
+	ArchivedTasksStub        func(context.Context, lager.Logger, models.TaskArchiveFilter) ([]*models.ArchivedTask, string, error)
+	archivedTasksMutex       sync.RWMutex
+	archivedTasksArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.TaskArchiveFilter
+	}
+	archivedTasksReturns struct {
+		result1 []*models.ArchivedTask
+		result2 string
+		result3 error
+	}
+	archivedTasksReturnsOnCall map[int]struct {
+		result1 []*models.ArchivedTask
+		result2 string
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeTaskArchiveDB) ArchivedTaskByGuid(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.ArchivedTask, error) {
+	fake.archivedTaskByGuidMutex.Lock()
+	ret, specificReturn := fake.archivedTaskByGuidReturnsOnCall[len(fake.archivedTaskByGuidArgsForCall)]
+	fake.archivedTaskByGuidArgsForCall = append(fake.archivedTaskByGuidArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ArchivedTaskByGuidStub
+	fakeReturns := fake.archivedTaskByGuidReturns
+	fake.recordInvocation("ArchivedTaskByGuid", []interface{}{arg1, arg2, arg3})
+	fake.archivedTaskByGuidMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTaskArchiveDB) ArchivedTaskByGuidCallCount() int {
+	fake.archivedTaskByGuidMutex.RLock()
+	defer fake.archivedTaskByGuidMutex.RUnlock()
+	return len(fake.archivedTaskByGuidArgsForCall)
+}
+
+func (fake *FakeTaskArchiveDB) ArchivedTaskByGuidCalls(stub func(context.Context, lager.Logger, string) (*models.ArchivedTask, error)) {
+	fake.archivedTaskByGuidMutex.Lock()
+	defer fake.archivedTaskByGuidMutex.Unlock()
+	fake.ArchivedTaskByGuidStub = stub
+}
+
+func (fake *FakeTaskArchiveDB) ArchivedTaskByGuidArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.archivedTaskByGuidMutex.RLock()
+	defer fake.archivedTaskByGuidMutex.RUnlock()
+	argsForCall := fake.archivedTaskByGuidArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTaskArchiveDB) ArchivedTaskByGuidReturns(result1 *models.ArchivedTask, result2 error) {
+	fake.archivedTaskByGuidMutex.Lock()
+	defer fake.archivedTaskByGuidMutex.Unlock()
+	fake.ArchivedTaskByGuidStub = nil
+	fake.archivedTaskByGuidReturns = struct {
+		result1 *models.ArchivedTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskArchiveDB) ArchivedTaskByGuidReturnsOnCall(i int, result1 *models.ArchivedTask, result2 error) {
+	fake.archivedTaskByGuidMutex.Lock()
+	defer fake.archivedTaskByGuidMutex.Unlock()
+	fake.ArchivedTaskByGuidStub = nil
+	if fake.archivedTaskByGuidReturnsOnCall == nil {
+		fake.archivedTaskByGuidReturnsOnCall = make(map[int]struct {
+			result1 *models.ArchivedTask
+			result2 error
+		})
+	}
+	fake.archivedTaskByGuidReturnsOnCall[i] = struct {
+		result1 *models.ArchivedTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskArchiveDB) ArchivedTasks(arg1 context.Context, arg2 lager.Logger, arg3 models.TaskArchiveFilter) ([]*models.ArchivedTask, string, error) {
+	fake.archivedTasksMutex.Lock()
+	ret, specificReturn := fake.archivedTasksReturnsOnCall[len(fake.archivedTasksArgsForCall)]
+	fake.archivedTasksArgsForCall = append(fake.archivedTasksArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.TaskArchiveFilter
+	}{arg1, arg2, arg3})
+	stub := fake.ArchivedTasksStub
+	fakeReturns := fake.archivedTasksReturns
+	fake.recordInvocation("ArchivedTasks", []interface{}{arg1, arg2, arg3})
+	fake.archivedTasksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTaskArchiveDB) ArchivedTasksCallCount() int {
+	fake.archivedTasksMutex.RLock()
+	defer fake.archivedTasksMutex.RUnlock()
+	return len(fake.archivedTasksArgsForCall)
+}
+
+func (fake *FakeTaskArchiveDB) ArchivedTasksCalls(stub func(context.Context, lager.Logger, models.TaskArchiveFilter) ([]*models.ArchivedTask, string, error)) {
+	fake.archivedTasksMutex.Lock()
+	defer fake.archivedTasksMutex.Unlock()
+	fake.ArchivedTasksStub = stub
+}
+
+func (fake *FakeTaskArchiveDB) ArchivedTasksArgsForCall(i int) (context.Context, lager.Logger, models.TaskArchiveFilter) {
+	fake.archivedTasksMutex.RLock()
+	defer fake.archivedTasksMutex.RUnlock()
+	argsForCall := fake.archivedTasksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTaskArchiveDB) ArchivedTasksReturns(result1 []*models.ArchivedTask, result2 string, result3 error) {
+	fake.archivedTasksMutex.Lock()
+	defer fake.archivedTasksMutex.Unlock()
+	fake.ArchivedTasksStub = nil
+	fake.archivedTasksReturns = struct {
+		result1 []*models.ArchivedTask
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTaskArchiveDB) ArchivedTasksReturnsOnCall(i int, result1 []*models.ArchivedTask, result2 string, result3 error) {
+	fake.archivedTasksMutex.Lock()
+	defer fake.archivedTasksMutex.Unlock()
+	fake.ArchivedTasksStub = nil
+	if fake.archivedTasksReturnsOnCall == nil {
+		fake.archivedTasksReturnsOnCall = make(map[int]struct {
+			result1 []*models.ArchivedTask
+			result2 string
+			result3 error
+		})
+	}
+	fake.archivedTasksReturnsOnCall[i] = struct {
+		result1 []*models.ArchivedTask
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTaskArchiveDB) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.archivedTaskByGuidMutex.RLock()
+	defer fake.archivedTaskByGuidMutex.RUnlock()
+	fake.archivedTasksMutex.RLock()
+	defer fake.archivedTasksMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeTaskArchiveDB) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.TaskArchiveDB = new(FakeTaskArchiveDB)
@@ -52,7 +52,7 @@ type FakeTaskDB struct {
 		result2 *models.Task
 		result3 error
 	}
-	ConvergeTasksStub        func(context.Context, lager.Logger, models.CellSet, time.Duration, time.Duration, time.Duration) db.TaskConvergenceResult
+	ConvergeTasksStub        func(context.Context, lager.Logger, models.CellSet, time.Duration, time.Duration, time.Duration, map[string]time.Duration, time.Duration) db.TaskConvergenceResult
 	convergeTasksMutex       sync.RWMutex
 	convergeTasksArgsForCall []struct {
 		arg1 context.Context
@@ -61,6 +61,8 @@ type FakeTaskDB struct {
 		arg4 time.Duration
 		arg5 time.Duration
 		arg6 time.Duration
+		arg7 map[string]time.Duration
+		arg8 time.Duration
 	}
 	convergeTasksReturns struct {
 		result1 db.TaskConvergenceResult
@@ -100,6 +102,24 @@ type FakeTaskDB struct {
 		result1 *models.Task
 		result2 error
 	}
+	DesireTaskArrayStub        func(context.Context, lager.Logger, *models.TaskDefinition, string, string, int32) ([]*models.Task, error)
+	desireTaskArrayMutex       sync.RWMutex
+	desireTaskArrayArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.TaskDefinition
+		arg4 string
+		arg5 string
+		arg6 int32
+	}
+	desireTaskArrayReturns struct {
+		result1 []*models.Task
+		result2 error
+	}
+	desireTaskArrayReturnsOnCall map[int]struct {
+		result1 []*models.Task
+		result2 error
+	}
 	FailTaskStub        func(context.Context, lager.Logger, string, string) (*models.Task, *models.Task, error)
 	failTaskMutex       sync.RWMutex
 	failTaskArgsForCall []struct {
@@ -136,6 +156,23 @@ type FakeTaskDB struct {
 		result2 *models.Task
 		result3 error
 	}
+	ReleaseBlockedTasksStub        func(context.Context, lager.Logger, ...string) ([]*models.TaskChange, []*models.TaskChange, error)
+	releaseBlockedTasksMutex       sync.RWMutex
+	releaseBlockedTasksArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 []string
+	}
+	releaseBlockedTasksReturns struct {
+		result1 []*models.TaskChange
+		result2 []*models.TaskChange
+		result3 error
+	}
+	releaseBlockedTasksReturnsOnCall map[int]struct {
+		result1 []*models.TaskChange
+		result2 []*models.TaskChange
+		result3 error
+	}
 	ResolvingTaskStub        func(context.Context, lager.Logger, string) (*models.Task, *models.Task, error)
 	resolvingTaskMutex       sync.RWMutex
 	resolvingTaskArgsForCall []struct {
@@ -188,6 +225,21 @@ type FakeTaskDB struct {
 		result1 *models.Task
 		result2 error
 	}
+	TaskResultStub        func(context.Context, lager.Logger, string) (string, error)
+	taskResultMutex       sync.RWMutex
+	taskResultArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	taskResultReturns struct {
+		result1 string
+		result2 error
+	}
+	taskResultReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	TasksStub        func(context.Context, lager.Logger, models.TaskFilter) ([]*models.Task, error)
 	tasksMutex       sync.RWMutex
 	tasksArgsForCall []struct {
@@ -203,6 +255,21 @@ type FakeTaskDB struct {
 		result1 []*models.Task
 		result2 error
 	}
+	TasksByArrayGuidStub        func(context.Context, lager.Logger, string) ([]*models.Task, error)
+	tasksByArrayGuidMutex       sync.RWMutex
+	tasksByArrayGuidArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	tasksByArrayGuidReturns struct {
+		result1 []*models.Task
+		result2 error
+	}
+	tasksByArrayGuidReturnsOnCall map[int]struct {
+		result1 []*models.Task
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -352,7 +419,7 @@ func (fake *FakeTaskDB) CompleteTaskReturnsOnCall(i int, result1 *models.Task, r
 	}{result1, result2, result3}
 }
 
-func (fake *FakeTaskDB) ConvergeTasks(arg1 context.Context, arg2 lager.Logger, arg3 models.CellSet, arg4 time.Duration, arg5 time.Duration, arg6 time.Duration) db.TaskConvergenceResult {
+func (fake *FakeTaskDB) ConvergeTasks(arg1 context.Context, arg2 lager.Logger, arg3 models.CellSet, arg4 time.Duration, arg5 time.Duration, arg6 time.Duration, arg7 map[string]time.Duration, arg8 time.Duration) db.TaskConvergenceResult {
 	fake.convergeTasksMutex.Lock()
 	ret, specificReturn := fake.convergeTasksReturnsOnCall[len(fake.convergeTasksArgsForCall)]
 	fake.convergeTasksArgsForCall = append(fake.convergeTasksArgsForCall, struct {
@@ -362,13 +429,15 @@ func (fake *FakeTaskDB) ConvergeTasks(arg1 context.Context, arg2 lager.Logger, a
 		arg4 time.Duration
 		arg5 time.Duration
 		arg6 time.Duration
-	}{arg1, arg2, arg3, arg4, arg5, arg6})
+		arg7 map[string]time.Duration
+		arg8 time.Duration
+	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8})
 	stub := fake.ConvergeTasksStub
 	fakeReturns := fake.convergeTasksReturns
-	fake.recordInvocation("ConvergeTasks", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.recordInvocation("ConvergeTasks", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8})
 	fake.convergeTasksMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8)
 	}
 	if specificReturn {
 		return ret.result1
@@ -382,17 +451,17 @@ func (fake *FakeTaskDB) ConvergeTasksCallCount() int {
 	return len(fake.convergeTasksArgsForCall)
 }
 
-func (fake *FakeTaskDB) ConvergeTasksCalls(stub func(context.Context, lager.Logger, models.CellSet, time.Duration, time.Duration, time.Duration) db.TaskConvergenceResult) {
+func (fake *FakeTaskDB) ConvergeTasksCalls(stub func(context.Context, lager.Logger, models.CellSet, time.Duration, time.Duration, time.Duration, map[string]time.Duration, time.Duration) db.TaskConvergenceResult) {
 	fake.convergeTasksMutex.Lock()
 	defer fake.convergeTasksMutex.Unlock()
 	fake.ConvergeTasksStub = stub
 }
 
-func (fake *FakeTaskDB) ConvergeTasksArgsForCall(i int) (context.Context, lager.Logger, models.CellSet, time.Duration, time.Duration, time.Duration) {
+func (fake *FakeTaskDB) ConvergeTasksArgsForCall(i int) (context.Context, lager.Logger, models.CellSet, time.Duration, time.Duration, time.Duration, map[string]time.Duration, time.Duration) {
 	fake.convergeTasksMutex.RLock()
 	defer fake.convergeTasksMutex.RUnlock()
 	argsForCall := fake.convergeTasksArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7, argsForCall.arg8
 }
 
 func (fake *FakeTaskDB) ConvergeTasksReturns(result1 db.TaskConvergenceResult) {
@@ -552,6 +621,75 @@ func (fake *FakeTaskDB) DesireTaskReturnsOnCall(i int, result1 *models.Task, res
 	}{result1, result2}
 }
 
+func (fake *FakeTaskDB) DesireTaskArray(arg1 context.Context, arg2 lager.Logger, arg3 *models.TaskDefinition, arg4 string, arg5 string, arg6 int32) ([]*models.Task, error) {
+	fake.desireTaskArrayMutex.Lock()
+	ret, specificReturn := fake.desireTaskArrayReturnsOnCall[len(fake.desireTaskArrayArgsForCall)]
+	fake.desireTaskArrayArgsForCall = append(fake.desireTaskArrayArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.TaskDefinition
+		arg4 string
+		arg5 string
+		arg6 int32
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	stub := fake.DesireTaskArrayStub
+	fakeReturns := fake.desireTaskArrayReturns
+	fake.recordInvocation("DesireTaskArray", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.desireTaskArrayMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTaskDB) DesireTaskArrayCallCount() int {
+	fake.desireTaskArrayMutex.RLock()
+	defer fake.desireTaskArrayMutex.RUnlock()
+	return len(fake.desireTaskArrayArgsForCall)
+}
+
+func (fake *FakeTaskDB) DesireTaskArrayCalls(stub func(context.Context, lager.Logger, *models.TaskDefinition, string, string, int32) ([]*models.Task, error)) {
+	fake.desireTaskArrayMutex.Lock()
+	defer fake.desireTaskArrayMutex.Unlock()
+	fake.DesireTaskArrayStub = stub
+}
+
+func (fake *FakeTaskDB) DesireTaskArrayArgsForCall(i int) (context.Context, lager.Logger, *models.TaskDefinition, string, string, int32) {
+	fake.desireTaskArrayMutex.RLock()
+	defer fake.desireTaskArrayMutex.RUnlock()
+	argsForCall := fake.desireTaskArrayArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakeTaskDB) DesireTaskArrayReturns(result1 []*models.Task, result2 error) {
+	fake.desireTaskArrayMutex.Lock()
+	defer fake.desireTaskArrayMutex.Unlock()
+	fake.DesireTaskArrayStub = nil
+	fake.desireTaskArrayReturns = struct {
+		result1 []*models.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskDB) DesireTaskArrayReturnsOnCall(i int, result1 []*models.Task, result2 error) {
+	fake.desireTaskArrayMutex.Lock()
+	defer fake.desireTaskArrayMutex.Unlock()
+	fake.DesireTaskArrayStub = nil
+	if fake.desireTaskArrayReturnsOnCall == nil {
+		fake.desireTaskArrayReturnsOnCall = make(map[int]struct {
+			result1 []*models.Task
+			result2 error
+		})
+	}
+	fake.desireTaskArrayReturnsOnCall[i] = struct {
+		result1 []*models.Task
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTaskDB) FailTask(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 string) (*models.Task, *models.Task, error) {
 	fake.failTaskMutex.Lock()
 	ret, specificReturn := fake.failTaskReturnsOnCall[len(fake.failTaskArgsForCall)]
@@ -692,6 +830,75 @@ func (fake *FakeTaskDB) RejectTaskReturnsOnCall(i int, result1 *models.Task, res
 	}{result1, result2, result3}
 }
 
+func (fake *FakeTaskDB) ReleaseBlockedTasks(arg1 context.Context, arg2 lager.Logger, arg3 ...string) ([]*models.TaskChange, []*models.TaskChange, error) {
+	fake.releaseBlockedTasksMutex.Lock()
+	ret, specificReturn := fake.releaseBlockedTasksReturnsOnCall[len(fake.releaseBlockedTasksArgsForCall)]
+	fake.releaseBlockedTasksArgsForCall = append(fake.releaseBlockedTasksArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 []string
+	}{arg1, arg2, arg3})
+	stub := fake.ReleaseBlockedTasksStub
+	fakeReturns := fake.releaseBlockedTasksReturns
+	fake.recordInvocation("ReleaseBlockedTasks", []interface{}{arg1, arg2, arg3})
+	fake.releaseBlockedTasksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTaskDB) ReleaseBlockedTasksCallCount() int {
+	fake.releaseBlockedTasksMutex.RLock()
+	defer fake.releaseBlockedTasksMutex.RUnlock()
+	return len(fake.releaseBlockedTasksArgsForCall)
+}
+
+func (fake *FakeTaskDB) ReleaseBlockedTasksCalls(stub func(context.Context, lager.Logger, ...string) ([]*models.TaskChange, []*models.TaskChange, error)) {
+	fake.releaseBlockedTasksMutex.Lock()
+	defer fake.releaseBlockedTasksMutex.Unlock()
+	fake.ReleaseBlockedTasksStub = stub
+}
+
+func (fake *FakeTaskDB) ReleaseBlockedTasksArgsForCall(i int) (context.Context, lager.Logger, []string) {
+	fake.releaseBlockedTasksMutex.RLock()
+	defer fake.releaseBlockedTasksMutex.RUnlock()
+	argsForCall := fake.releaseBlockedTasksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTaskDB) ReleaseBlockedTasksReturns(result1 []*models.TaskChange, result2 []*models.TaskChange, result3 error) {
+	fake.releaseBlockedTasksMutex.Lock()
+	defer fake.releaseBlockedTasksMutex.Unlock()
+	fake.ReleaseBlockedTasksStub = nil
+	fake.releaseBlockedTasksReturns = struct {
+		result1 []*models.TaskChange
+		result2 []*models.TaskChange
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTaskDB) ReleaseBlockedTasksReturnsOnCall(i int, result1 []*models.TaskChange, result2 []*models.TaskChange, result3 error) {
+	fake.releaseBlockedTasksMutex.Lock()
+	defer fake.releaseBlockedTasksMutex.Unlock()
+	fake.ReleaseBlockedTasksStub = nil
+	if fake.releaseBlockedTasksReturnsOnCall == nil {
+		fake.releaseBlockedTasksReturnsOnCall = make(map[int]struct {
+			result1 []*models.TaskChange
+			result2 []*models.TaskChange
+			result3 error
+		})
+	}
+	fake.releaseBlockedTasksReturnsOnCall[i] = struct {
+		result1 []*models.TaskChange
+		result2 []*models.TaskChange
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTaskDB) ResolvingTask(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.Task, *models.Task, error) {
 	fake.resolvingTaskMutex.Lock()
 	ret, specificReturn := fake.resolvingTaskReturnsOnCall[len(fake.resolvingTaskArgsForCall)]
@@ -900,6 +1107,72 @@ func (fake *FakeTaskDB) TaskByGuidReturnsOnCall(i int, result1 *models.Task, res
 	}{result1, result2}
 }
 
+func (fake *FakeTaskDB) TaskResult(arg1 context.Context, arg2 lager.Logger, arg3 string) (string, error) {
+	fake.taskResultMutex.Lock()
+	ret, specificReturn := fake.taskResultReturnsOnCall[len(fake.taskResultArgsForCall)]
+	fake.taskResultArgsForCall = append(fake.taskResultArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.TaskResultStub
+	fakeReturns := fake.taskResultReturns
+	fake.recordInvocation("TaskResult", []interface{}{arg1, arg2, arg3})
+	fake.taskResultMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTaskDB) TaskResultCallCount() int {
+	fake.taskResultMutex.RLock()
+	defer fake.taskResultMutex.RUnlock()
+	return len(fake.taskResultArgsForCall)
+}
+
+func (fake *FakeTaskDB) TaskResultCalls(stub func(context.Context, lager.Logger, string) (string, error)) {
+	fake.taskResultMutex.Lock()
+	defer fake.taskResultMutex.Unlock()
+	fake.TaskResultStub = stub
+}
+
+func (fake *FakeTaskDB) TaskResultArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.taskResultMutex.RLock()
+	defer fake.taskResultMutex.RUnlock()
+	argsForCall := fake.taskResultArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTaskDB) TaskResultReturns(result1 string, result2 error) {
+	fake.taskResultMutex.Lock()
+	defer fake.taskResultMutex.Unlock()
+	fake.TaskResultStub = nil
+	fake.taskResultReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskDB) TaskResultReturnsOnCall(i int, result1 string, result2 error) {
+	fake.taskResultMutex.Lock()
+	defer fake.taskResultMutex.Unlock()
+	fake.TaskResultStub = nil
+	if fake.taskResultReturnsOnCall == nil {
+		fake.taskResultReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.taskResultReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTaskDB) Tasks(arg1 context.Context, arg2 lager.Logger, arg3 models.TaskFilter) ([]*models.Task, error) {
 	fake.tasksMutex.Lock()
 	ret, specificReturn := fake.tasksReturnsOnCall[len(fake.tasksArgsForCall)]
@@ -966,6 +1239,72 @@ func (fake *FakeTaskDB) TasksReturnsOnCall(i int, result1 []*models.Task, result
 	}{result1, result2}
 }
 
+func (fake *FakeTaskDB) TasksByArrayGuid(arg1 context.Context, arg2 lager.Logger, arg3 string) ([]*models.Task, error) {
+	fake.tasksByArrayGuidMutex.Lock()
+	ret, specificReturn := fake.tasksByArrayGuidReturnsOnCall[len(fake.tasksByArrayGuidArgsForCall)]
+	fake.tasksByArrayGuidArgsForCall = append(fake.tasksByArrayGuidArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.TasksByArrayGuidStub
+	fakeReturns := fake.tasksByArrayGuidReturns
+	fake.recordInvocation("TasksByArrayGuid", []interface{}{arg1, arg2, arg3})
+	fake.tasksByArrayGuidMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTaskDB) TasksByArrayGuidCallCount() int {
+	fake.tasksByArrayGuidMutex.RLock()
+	defer fake.tasksByArrayGuidMutex.RUnlock()
+	return len(fake.tasksByArrayGuidArgsForCall)
+}
+
+func (fake *FakeTaskDB) TasksByArrayGuidCalls(stub func(context.Context, lager.Logger, string) ([]*models.Task, error)) {
+	fake.tasksByArrayGuidMutex.Lock()
+	defer fake.tasksByArrayGuidMutex.Unlock()
+	fake.TasksByArrayGuidStub = stub
+}
+
+func (fake *FakeTaskDB) TasksByArrayGuidArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.tasksByArrayGuidMutex.RLock()
+	defer fake.tasksByArrayGuidMutex.RUnlock()
+	argsForCall := fake.tasksByArrayGuidArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTaskDB) TasksByArrayGuidReturns(result1 []*models.Task, result2 error) {
+	fake.tasksByArrayGuidMutex.Lock()
+	defer fake.tasksByArrayGuidMutex.Unlock()
+	fake.TasksByArrayGuidStub = nil
+	fake.tasksByArrayGuidReturns = struct {
+		result1 []*models.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskDB) TasksByArrayGuidReturnsOnCall(i int, result1 []*models.Task, result2 error) {
+	fake.tasksByArrayGuidMutex.Lock()
+	defer fake.tasksByArrayGuidMutex.Unlock()
+	fake.TasksByArrayGuidStub = nil
+	if fake.tasksByArrayGuidReturnsOnCall == nil {
+		fake.tasksByArrayGuidReturnsOnCall = make(map[int]struct {
+			result1 []*models.Task
+			result2 error
+		})
+	}
+	fake.tasksByArrayGuidReturnsOnCall[i] = struct {
+		result1 []*models.Task
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTaskDB) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -979,18 +1318,26 @@ func (fake *FakeTaskDB) Invocations() map[string][][]interface{} {
 	defer fake.deleteTaskMutex.RUnlock()
 	fake.desireTaskMutex.RLock()
 	defer fake.desireTaskMutex.RUnlock()
+	fake.desireTaskArrayMutex.RLock()
+	defer fake.desireTaskArrayMutex.RUnlock()
 	fake.failTaskMutex.RLock()
 	defer fake.failTaskMutex.RUnlock()
 	fake.rejectTaskMutex.RLock()
 	defer fake.rejectTaskMutex.RUnlock()
+	fake.releaseBlockedTasksMutex.RLock()
+	defer fake.releaseBlockedTasksMutex.RUnlock()
 	fake.resolvingTaskMutex.RLock()
 	defer fake.resolvingTaskMutex.RUnlock()
 	fake.startTaskMutex.RLock()
 	defer fake.startTaskMutex.RUnlock()
 	fake.taskByGuidMutex.RLock()
 	defer fake.taskByGuidMutex.RUnlock()
+	fake.taskResultMutex.RLock()
+	defer fake.taskResultMutex.RUnlock()
 	fake.tasksMutex.RLock()
 	defer fake.tasksMutex.RUnlock()
+	fake.tasksByArrayGuidMutex.RLock()
+	defer fake.tasksByArrayGuidMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
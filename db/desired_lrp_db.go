@@ -2,6 +2,7 @@ package db
 
 import (
 	"context"
+	"time"
 
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/lager/v3"
@@ -18,7 +19,29 @@ type DesiredLRPDB interface {
 
 	DesiredLRPRoutingInfos(ctx context.Context, logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRP, error)
 
+	// DesiredLRPStatuses returns the instance counts, the latest crash
+	// reasons and placement errors, and the health of the DesiredLRPs that
+	// match the filter.
+	DesiredLRPStatuses(ctx context.Context, logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRPStatus, error)
+
 	DesireLRP(ctx context.Context, logger lager.Logger, desiredLRP *models.DesiredLRP) error
 	UpdateDesiredLRP(ctx context.Context, logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate) (beforeDesiredLRP *models.DesiredLRP, err error)
 	RemoveDesiredLRP(ctx context.Context, logger lager.Logger, processGuid string) error
+	// StartRollingRestart records that the instances of the DesiredLRP are
+	// restarted maxInFlight at a time, along with their current instance guids
+	// so that an instance counts as restarted once its instance guid changed.
+	StartRollingRestart(ctx context.Context, logger lager.Logger, processGuid string, maxInFlight int32) error
+	// AdvanceRollingRestart returns the keys of the next instances to restart
+	// for the rolling restart of the DesiredLRP, if any. It finishes the
+	// rolling restart once every instance has been restarted, and abandons it
+	// when it has not progressed for longer than timeout.
+	AdvanceRollingRestart(ctx context.Context, logger lager.Logger, processGuid string, timeout time.Duration) ([]*models.ActualLRPKey, error)
+
+	// DesiredLRPRevisions returns the revision history of the DesiredLRP,
+	// latest first.
+	DesiredLRPRevisions(ctx context.Context, logger lager.Logger, processGuid string) ([]*models.DesiredLRPRevision, error)
+	DesiredLRPRevision(ctx context.Context, logger lager.Logger, processGuid string, revision int32) (*models.DesiredLRPRevision, error)
+	// RollbackDesiredLRP restores the run info and resources of a prior
+	// revision as a new revision, and returns the DesiredLRP as it was before.
+	RollbackDesiredLRP(ctx context.Context, logger lager.Logger, processGuid string, revision int32) (beforeDesiredLRP *models.DesiredLRP, err error)
 }
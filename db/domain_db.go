@@ -3,11 +3,21 @@ package db
 import (
 	"context"
 
+	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/lager/v3"
 )
 
 //counterfeiter:generate . DomainDB
 type DomainDB interface {
 	FreshDomains(ctx context.Context, logger lager.Logger) ([]string, error)
-	UpsertDomain(ctx context.Context, lgger lager.Logger, domain string, ttl uint32) error
+	// Domains returns all the domains, including the stale ones that
+	// convergence has not pruned yet.
+	Domains(ctx context.Context, logger lager.Logger) ([]*models.Domain, error)
+	// UpsertDomain returns whether the domain was not fresh before.
+	UpsertDomain(ctx context.Context, lgger lager.Logger, domain string, ttl uint32, upsertedBy string) (bool, error)
+	// ExpireDomain makes the domain stale until convergence prunes it, and
+	// returns whether it was fresh before.
+	ExpireDomain(ctx context.Context, logger lager.Logger, domain string) (bool, error)
+	// DeleteDomain returns whether the domain was fresh before.
+	DeleteDomain(ctx context.Context, logger lager.Logger, domain string) (bool, error)
 }
@@ -0,0 +1,20 @@
+package db
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+//counterfeiter:generate . DomainQuotaDB
+type DomainQuotaDB interface {
+	// UpsertDomainQuota creates or replaces the quota of a domain. Domains
+	// without a quota are unlimited.
+	UpsertDomainQuota(ctx context.Context, logger lager.Logger, quota *models.DomainQuota) error
+	DeleteDomainQuota(ctx context.Context, logger lager.Logger, domain string) error
+	DomainQuotas(ctx context.Context, logger lager.Logger) ([]*models.DomainQuota, error)
+	// DomainUsage returns the resources the LRPs and the pending and running
+	// tasks of a domain use against its quota.
+	DomainUsage(ctx context.Context, logger lager.Logger, domain string) (*models.DomainUsage, error)
+}
@@ -33,9 +33,13 @@ type ConvergenceResult struct {
 	KeysWithMissingCells         []*models.ActualLRPKeyWithSchedulingInfo
 	KeysWithInternalRouteChanges []*ActualLRPKeyWithInternalRoutes
 	KeysWithMetricTagChanges     []*ActualLRPKeyWithMetricTags
+	LRPsToSurge                  []*models.ActualLRP
+	LRPsToReplace                []*models.ActualLRP
+	RollingRestartProcessGuids   []string
 	MissingCellIds               []string
 	Events                       []models.Event
 	InstanceEvents               []models.Event
+	DomainEvents                 []models.Event
 }
 
 type LRPDB interface {
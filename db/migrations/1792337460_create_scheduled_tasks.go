@@ -0,0 +1,81 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func init() {
+	appendMigration(NewCreateScheduledTasks())
+}
+
+type CreateScheduledTasks struct {
+	serializer format.Serializer
+	clock      clock.Clock
+	dbFlavor   string
+}
+
+func NewCreateScheduledTasks() migration.Migration {
+	return new(CreateScheduledTasks)
+}
+
+func (e *CreateScheduledTasks) String() string {
+	return migrationString(e)
+}
+
+func (e *CreateScheduledTasks) Version() int64 {
+	return 1792337460
+}
+
+func (e *CreateScheduledTasks) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *CreateScheduledTasks) SetClock(c clock.Clock)    { e.clock = c }
+func (e *CreateScheduledTasks) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *CreateScheduledTasks) Up(tx *sql.Tx, logger lager.Logger) error {
+	logger = logger.Session("create-scheduled-tasks")
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	for _, query := range []string{createScheduledTasksSQL, createScheduledTaskRunsSQL} {
+		query = helpers.RebindForFlavor(query, e.dbFlavor)
+		logger.Info("creating the table", lager.Data{"query": query})
+		_, err := tx.Exec(query)
+		if err != nil {
+			logger.Error("failed-creating-table", err)
+			return err
+		}
+		logger.Info("created the table", lager.Data{"query": query})
+	}
+
+	return nil
+}
+
+const createScheduledTasksSQL = `CREATE TABLE IF NOT EXISTS scheduled_tasks(
+	guid VARCHAR(255) PRIMARY KEY,
+	domain VARCHAR(255) NOT NULL,
+	schedule VARCHAR(255) NOT NULL,
+	time_zone VARCHAR(255) NOT NULL DEFAULT '',
+	concurrency_policy INT NOT NULL DEFAULT 0,
+	history_limit INT NOT NULL DEFAULT 0,
+	task_definition MEDIUMTEXT NOT NULL,
+	created_at BIGINT DEFAULT 0,
+	updated_at BIGINT DEFAULT 0,
+	last_scheduled_at BIGINT DEFAULT 0
+);`
+
+const createScheduledTaskRunsSQL = `CREATE TABLE IF NOT EXISTS scheduled_task_runs(
+	scheduled_task_guid VARCHAR(255) NOT NULL,
+	task_guid VARCHAR(255) NOT NULL,
+	scheduled_at BIGINT DEFAULT 0,
+
+	PRIMARY KEY(scheduled_task_guid, task_guid)
+);`
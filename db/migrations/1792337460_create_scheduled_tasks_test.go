@@ -0,0 +1,85 @@
+package migrations_test
+
+import (
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/migration"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CreateScheduledTasks", func() {
+	var (
+		migration migration.Migration
+	)
+
+	BeforeEach(func() {
+		rawSQLDB.Exec("DROP TABLE scheduled_task_runs;")
+		rawSQLDB.Exec("DROP TABLE scheduled_tasks;")
+
+		migration = migrations.NewCreateScheduledTasks()
+	})
+
+	It("appends itself to the migration list", func() {
+		Expect(migrations.AllMigrations()).To(ContainElement(migration))
+	})
+
+	Describe("Version", func() {
+		It("returns the timestamp from which it was created", func() {
+			Expect(migration.Version()).To(BeEquivalentTo(1792337460))
+		})
+	})
+
+	Describe("Up", func() {
+		BeforeEach(func() {
+			migration.SetDBFlavor(flavor)
+		})
+
+		It("creates the scheduled_tasks table", func() {
+			testUpInTransaction(rawSQLDB, migration, logger)
+
+			_, err := rawSQLDB.Exec(
+				helpers.RebindForFlavor(
+					`insert into scheduled_tasks
+						(guid, domain, schedule, time_zone, concurrency_policy, history_limit,
+						task_definition, created_at, updated_at, last_scheduled_at)
+					values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+					flavor,
+				),
+				"guid", "domain", "*/5 * * * *", "Europe/Berlin", 1, 3, "{}", 1, 2, 3,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			var schedule, timeZone string
+			query := helpers.RebindForFlavor("select schedule, time_zone from scheduled_tasks limit 1", flavor)
+			row := rawSQLDB.QueryRow(query)
+			Expect(row.Scan(&schedule, &timeZone)).To(Succeed())
+			Expect(schedule).To(Equal("*/5 * * * *"))
+			Expect(timeZone).To(Equal("Europe/Berlin"))
+		})
+
+		It("creates the scheduled_task_runs table", func() {
+			testUpInTransaction(rawSQLDB, migration, logger)
+
+			_, err := rawSQLDB.Exec(
+				helpers.RebindForFlavor(
+					`insert into scheduled_task_runs (scheduled_task_guid, task_guid, scheduled_at) values (?, ?, ?)`,
+					flavor,
+				),
+				"guid", "guid-60", 60,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			var taskGuid string
+			query := helpers.RebindForFlavor("select task_guid from scheduled_task_runs limit 1", flavor)
+			row := rawSQLDB.QueryRow(query)
+			Expect(row.Scan(&taskGuid)).To(Succeed())
+			Expect(taskGuid).To(Equal("guid-60"))
+		})
+
+		It("is idempotent", func() {
+			testIdempotency(rawSQLDB, migration, logger)
+		})
+	})
+})
@@ -0,0 +1,78 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func init() {
+	appendMigration(NewAddArrayGuidToTasks())
+}
+
+type AddArrayGuidToTasks struct {
+	serializer format.Serializer
+	clock      clock.Clock
+	dbFlavor   string
+}
+
+func NewAddArrayGuidToTasks() migration.Migration {
+	return new(AddArrayGuidToTasks)
+}
+
+func (e *AddArrayGuidToTasks) String() string {
+	return migrationString(e)
+}
+
+func (e *AddArrayGuidToTasks) Version() int64 {
+	return 1792423860
+}
+
+func (e *AddArrayGuidToTasks) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddArrayGuidToTasks) SetClock(c clock.Clock)    { e.clock = c }
+func (e *AddArrayGuidToTasks) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *AddArrayGuidToTasks) Up(tx *sql.Tx, logger lager.Logger) error {
+	var alterTasksSQL []string
+	var createIndexSQL string
+	if e.dbFlavor == "mysql" {
+		alterTasksSQL = []string{
+			`ALTER TABLE tasks ADD COLUMN array_guid VARCHAR(255) NOT NULL DEFAULT '';`,
+			`ALTER TABLE tasks ADD COLUMN array_index INT NOT NULL DEFAULT 0;`,
+		}
+		createIndexSQL = `CREATE INDEX tasks_array_guid_idx ON tasks (array_guid);`
+	} else {
+		alterTasksSQL = []string{
+			`ALTER TABLE tasks ADD COLUMN IF NOT EXISTS array_guid VARCHAR(255) NOT NULL DEFAULT '';`,
+			`ALTER TABLE tasks ADD COLUMN IF NOT EXISTS array_index INT NOT NULL DEFAULT 0;`,
+		}
+		createIndexSQL = `CREATE INDEX IF NOT EXISTS tasks_array_guid_idx ON tasks (array_guid);`
+	}
+
+	for _, query := range alterTasksSQL {
+		logger.Info("altering the table", lager.Data{"query": query})
+		_, err := tx.Exec(query)
+		if err != nil && !isDuplicateColumnError(err) {
+			logger.Error("failed-altering-tables", err)
+			return err
+		}
+		logger.Info("altered the table", lager.Data{"query": query})
+	}
+
+	logger.Info("creating the index", lager.Data{"query": createIndexSQL})
+	_, err := tx.Exec(createIndexSQL)
+	if err != nil && !isDuplicateIndexError(err) {
+		logger.Error("failed-creating-index", err)
+		return err
+	}
+	logger.Info("created the index", lager.Data{"query": createIndexSQL})
+
+	return nil
+}
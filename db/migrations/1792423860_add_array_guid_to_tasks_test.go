@@ -0,0 +1,70 @@
+package migrations_test
+
+import (
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/migration"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AddArrayGuidToTasks", func() {
+	var (
+		migration migration.Migration
+	)
+
+	BeforeEach(func() {
+		rawSQLDB.Exec("DROP TABLE tasks;")
+
+		migration = migrations.NewAddArrayGuidToTasks()
+	})
+
+	It("appends itself to the migration list", func() {
+		Expect(migrations.AllMigrations()).To(ContainElement(migration))
+	})
+
+	Describe("Version", func() {
+		It("returns the timestamp from which it was created", func() {
+			Expect(migration.Version()).To(BeEquivalentTo(1792423860))
+		})
+	})
+
+	Describe("Up", func() {
+		BeforeEach(func() {
+			initialMigration := migrations.NewInitSQL()
+			initialMigration.SetDBFlavor(flavor)
+			initialMigration.SetClock(fakeClock)
+			testUpInTransaction(rawSQLDB, initialMigration, logger)
+
+			migration.SetDBFlavor(flavor)
+		})
+
+		It("adds the array_guid and array_index columns to tasks", func() {
+			testUpInTransaction(rawSQLDB, migration, logger)
+
+			_, err := rawSQLDB.Exec(
+				helpers.RebindForFlavor(
+					`insert into tasks
+						(guid, domain, task_definition, array_guid, array_index)
+					values (?, ?, ?, ?, ?)`,
+					flavor,
+				),
+				"array-guid-3", "some-domain", "", "array-guid", 3,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			var arrayGuid string
+			var arrayIndex int32
+			query := helpers.RebindForFlavor("select array_guid, array_index from tasks limit 1", flavor)
+			row := rawSQLDB.QueryRow(query)
+			Expect(row.Scan(&arrayGuid, &arrayIndex)).To(Succeed())
+			Expect(arrayGuid).To(Equal("array-guid"))
+			Expect(arrayIndex).To(BeEquivalentTo(3))
+		})
+
+		It("is idempotent", func() {
+			testIdempotency(rawSQLDB, migration, logger)
+		})
+	})
+})
@@ -0,0 +1,68 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func init() {
+	appendMigration(NewAddRetryAttemptsToTasks())
+}
+
+type AddRetryAttemptsToTasks struct {
+	serializer format.Serializer
+	clock      clock.Clock
+	dbFlavor   string
+}
+
+func NewAddRetryAttemptsToTasks() migration.Migration {
+	return new(AddRetryAttemptsToTasks)
+}
+
+func (e *AddRetryAttemptsToTasks) String() string {
+	return migrationString(e)
+}
+
+func (e *AddRetryAttemptsToTasks) Version() int64 {
+	return 1792510260
+}
+
+func (e *AddRetryAttemptsToTasks) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddRetryAttemptsToTasks) SetClock(c clock.Clock)    { e.clock = c }
+func (e *AddRetryAttemptsToTasks) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *AddRetryAttemptsToTasks) Up(tx *sql.Tx, logger lager.Logger) error {
+	var alterTasksSQL []string
+	if e.dbFlavor == "mysql" {
+		alterTasksSQL = []string{
+			`ALTER TABLE tasks ADD COLUMN attempts MEDIUMTEXT;`,
+			`ALTER TABLE tasks ADD COLUMN retry_at BIGINT NOT NULL DEFAULT 0;`,
+		}
+	} else {
+		alterTasksSQL = []string{
+			`ALTER TABLE tasks ADD COLUMN IF NOT EXISTS attempts MEDIUMTEXT;`,
+			`ALTER TABLE tasks ADD COLUMN IF NOT EXISTS retry_at BIGINT NOT NULL DEFAULT 0;`,
+		}
+	}
+
+	for _, query := range alterTasksSQL {
+		logger.Info("altering the table", lager.Data{"query": query})
+		_, err := tx.Exec(helpers.RebindForFlavor(query, e.dbFlavor))
+		if err != nil && !isDuplicateColumnError(err) {
+			logger.Error("failed-altering-tables", err)
+			return err
+		}
+		logger.Info("altered the table", lager.Data{"query": query})
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package migrations_test
+
+import (
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/migration"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AddRetryAttemptsToTasks", func() {
+	var (
+		migration migration.Migration
+	)
+
+	BeforeEach(func() {
+		rawSQLDB.Exec("DROP TABLE tasks;")
+
+		migration = migrations.NewAddRetryAttemptsToTasks()
+	})
+
+	It("appends itself to the migration list", func() {
+		Expect(migrations.AllMigrations()).To(ContainElement(migration))
+	})
+
+	Describe("Version", func() {
+		It("returns the timestamp from which it was created", func() {
+			Expect(migration.Version()).To(BeEquivalentTo(1792510260))
+		})
+	})
+
+	Describe("Up", func() {
+		BeforeEach(func() {
+			initialMigration := migrations.NewInitSQL()
+			initialMigration.SetDBFlavor(flavor)
+			initialMigration.SetClock(fakeClock)
+			testUpInTransaction(rawSQLDB, initialMigration, logger)
+
+			migration.SetDBFlavor(flavor)
+		})
+
+		It("adds the attempts and retry_at columns to tasks", func() {
+			testUpInTransaction(rawSQLDB, migration, logger)
+
+			_, err := rawSQLDB.Exec(
+				helpers.RebindForFlavor(
+					`insert into tasks
+						(guid, domain, task_definition, attempts, retry_at)
+					values (?, ?, ?, ?, ?)`,
+					flavor,
+				),
+				"some-guid", "some-domain", "", "some-attempts", 42,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			var attempts string
+			var retryAt int64
+			query := helpers.RebindForFlavor("select attempts, retry_at from tasks limit 1", flavor)
+			row := rawSQLDB.QueryRow(query)
+			Expect(row.Scan(&attempts, &retryAt)).To(Succeed())
+			Expect(attempts).To(Equal("some-attempts"))
+			Expect(retryAt).To(BeEquivalentTo(42))
+		})
+
+		It("is idempotent", func() {
+			testIdempotency(rawSQLDB, migration, logger)
+		})
+	})
+})
@@ -0,0 +1,58 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func init() {
+	appendMigration(NewAddMaxRunDurationToTasks())
+}
+
+type AddMaxRunDurationToTasks struct {
+	serializer format.Serializer
+	clock      clock.Clock
+	dbFlavor   string
+}
+
+func NewAddMaxRunDurationToTasks() migration.Migration {
+	return new(AddMaxRunDurationToTasks)
+}
+
+func (e *AddMaxRunDurationToTasks) String() string {
+	return migrationString(e)
+}
+
+func (e *AddMaxRunDurationToTasks) Version() int64 {
+	return 1792596660
+}
+
+func (e *AddMaxRunDurationToTasks) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddMaxRunDurationToTasks) SetClock(c clock.Clock)    { e.clock = c }
+func (e *AddMaxRunDurationToTasks) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *AddMaxRunDurationToTasks) Up(tx *sql.Tx, logger lager.Logger) error {
+	var alterTableSQL string
+	if e.dbFlavor == "mysql" {
+		alterTableSQL = "ALTER TABLE tasks ADD COLUMN max_run_duration BIGINT NOT NULL DEFAULT 0;"
+	} else {
+		alterTableSQL = "ALTER TABLE tasks ADD COLUMN IF NOT EXISTS max_run_duration BIGINT NOT NULL DEFAULT 0;"
+	}
+	logger.Info("altering the table", lager.Data{"query": alterTableSQL})
+	_, err := tx.Exec(alterTableSQL)
+	if err != nil && !isDuplicateColumnError(err) {
+		logger.Error("failed-altering-table", err)
+		return err
+	}
+	logger.Info("altered the table", lager.Data{"query": alterTableSQL})
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package migrations_test
+
+import (
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/migration"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AddMaxRunDurationToTasks", func() {
+	var (
+		migration migration.Migration
+	)
+
+	BeforeEach(func() {
+		rawSQLDB.Exec("DROP TABLE tasks;")
+
+		migration = migrations.NewAddMaxRunDurationToTasks()
+	})
+
+	It("appends itself to the migration list", func() {
+		Expect(migrations.AllMigrations()).To(ContainElement(migration))
+	})
+
+	Describe("Version", func() {
+		It("returns the timestamp from which it was created", func() {
+			Expect(migration.Version()).To(BeEquivalentTo(1792596660))
+		})
+	})
+
+	Describe("Up", func() {
+		BeforeEach(func() {
+			initialMigration := migrations.NewInitSQL()
+			initialMigration.SetDBFlavor(flavor)
+			initialMigration.SetClock(fakeClock)
+			testUpInTransaction(rawSQLDB, initialMigration, logger)
+
+			migration.SetDBFlavor(flavor)
+		})
+
+		It("adds the max_run_duration column to tasks", func() {
+			testUpInTransaction(rawSQLDB, migration, logger)
+
+			_, err := rawSQLDB.Exec(
+				helpers.RebindForFlavor(
+					`insert into tasks
+						(guid, domain, task_definition, max_run_duration)
+					values (?, ?, ?, ?)`,
+					flavor,
+				),
+				"some-guid", "some-domain", "", 42,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			var maxRunDuration int64
+			query := helpers.RebindForFlavor("select max_run_duration from tasks limit 1", flavor)
+			row := rawSQLDB.QueryRow(query)
+			Expect(row.Scan(&maxRunDuration)).To(Succeed())
+			Expect(maxRunDuration).To(BeEquivalentTo(42))
+		})
+
+		It("is idempotent", func() {
+			testIdempotency(rawSQLDB, migration, logger)
+		})
+	})
+})
@@ -0,0 +1,69 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func init() {
+	appendMigration(NewAddPriorityToTasks())
+}
+
+type AddPriorityToTasks struct {
+	serializer format.Serializer
+	clock      clock.Clock
+	dbFlavor   string
+}
+
+func NewAddPriorityToTasks() migration.Migration {
+	return new(AddPriorityToTasks)
+}
+
+func (e *AddPriorityToTasks) String() string {
+	return migrationString(e)
+}
+
+func (e *AddPriorityToTasks) Version() int64 {
+	return 1792683060
+}
+
+func (e *AddPriorityToTasks) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddPriorityToTasks) SetClock(c clock.Clock)    { e.clock = c }
+func (e *AddPriorityToTasks) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *AddPriorityToTasks) Up(tx *sql.Tx, logger lager.Logger) error {
+	var alterTableSQL, createIndexSQL string
+	if e.dbFlavor == "mysql" {
+		alterTableSQL = `ALTER TABLE tasks ADD COLUMN priority INT NOT NULL DEFAULT 0;`
+		createIndexSQL = `CREATE INDEX tasks_priority_idx ON tasks (state, priority, created_at);`
+	} else {
+		alterTableSQL = `ALTER TABLE tasks ADD COLUMN IF NOT EXISTS priority INT NOT NULL DEFAULT 0;`
+		createIndexSQL = `CREATE INDEX IF NOT EXISTS tasks_priority_idx ON tasks (state, priority, created_at);`
+	}
+
+	logger.Info("altering the table", lager.Data{"query": alterTableSQL})
+	_, err := tx.Exec(alterTableSQL)
+	if err != nil && !isDuplicateColumnError(err) {
+		logger.Error("failed-altering-table", err)
+		return err
+	}
+	logger.Info("altered the table", lager.Data{"query": alterTableSQL})
+
+	logger.Info("creating the index", lager.Data{"query": createIndexSQL})
+	_, err = tx.Exec(createIndexSQL)
+	if err != nil && !isDuplicateIndexError(err) {
+		logger.Error("failed-creating-index", err)
+		return err
+	}
+	logger.Info("created the index", lager.Data{"query": createIndexSQL})
+
+	return nil
+}
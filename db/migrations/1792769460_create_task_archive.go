@@ -0,0 +1,108 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func init() {
+	appendMigration(NewCreateTaskArchive())
+}
+
+type CreateTaskArchive struct {
+	serializer format.Serializer
+	clock      clock.Clock
+	dbFlavor   string
+}
+
+func NewCreateTaskArchive() migration.Migration {
+	return new(CreateTaskArchive)
+}
+
+func (e *CreateTaskArchive) String() string {
+	return migrationString(e)
+}
+
+func (e *CreateTaskArchive) Version() int64 {
+	return 1792769460
+}
+
+func (e *CreateTaskArchive) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *CreateTaskArchive) SetClock(c clock.Clock)    { e.clock = c }
+func (e *CreateTaskArchive) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *CreateTaskArchive) Up(tx *sql.Tx, logger lager.Logger) error {
+	logger = logger.Session("create-task-archive")
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	query := helpers.RebindForFlavor(createTaskArchiveSQL, e.dbFlavor)
+	logger.Info("creating the table", lager.Data{"query": query})
+	_, err := tx.Exec(query)
+	if err != nil {
+		logger.Error("failed-creating-table", err)
+		return err
+	}
+	logger.Info("created the table", lager.Data{"query": query})
+
+	var createIndexSQL string
+	var alterTasksSQL []string
+	if e.dbFlavor == "mysql" {
+		createIndexSQL = `CREATE INDEX task_archive_archived_at_idx ON task_archive (archived_at);`
+		alterTasksSQL = []string{
+			`ALTER TABLE tasks ADD COLUMN started_at BIGINT NOT NULL DEFAULT 0;`,
+			`ALTER TABLE tasks ADD COLUMN started_cell_id VARCHAR(255) NOT NULL DEFAULT '';`,
+		}
+	} else {
+		createIndexSQL = `CREATE INDEX IF NOT EXISTS task_archive_archived_at_idx ON task_archive (archived_at);`
+		alterTasksSQL = []string{
+			`ALTER TABLE tasks ADD COLUMN IF NOT EXISTS started_at BIGINT NOT NULL DEFAULT 0;`,
+			`ALTER TABLE tasks ADD COLUMN IF NOT EXISTS started_cell_id VARCHAR(255) NOT NULL DEFAULT '';`,
+		}
+	}
+
+	logger.Info("creating the index", lager.Data{"query": createIndexSQL})
+	_, err = tx.Exec(createIndexSQL)
+	if err != nil && !isDuplicateIndexError(err) {
+		logger.Error("failed-creating-index", err)
+		return err
+	}
+	logger.Info("created the index", lager.Data{"query": createIndexSQL})
+
+	for _, query := range alterTasksSQL {
+		logger.Info("altering the table", lager.Data{"query": query})
+		_, err = tx.Exec(query)
+		if err != nil && !isDuplicateColumnError(err) {
+			logger.Error("failed-altering-table", err)
+			return err
+		}
+		logger.Info("altered the table", lager.Data{"query": query})
+	}
+
+	return nil
+}
+
+const createTaskArchiveSQL = `CREATE TABLE IF NOT EXISTS task_archive(
+	guid VARCHAR(255) NOT NULL,
+	domain VARCHAR(255) NOT NULL,
+	state INT,
+	cell_id VARCHAR(255) NOT NULL DEFAULT '',
+	failed BOOL DEFAULT false,
+	failure_reason VARCHAR(1024) NOT NULL DEFAULT '',
+	rejection_count INT NOT NULL DEFAULT 0,
+	created_at BIGINT DEFAULT 0,
+	started_at BIGINT DEFAULT 0,
+	completed_at BIGINT DEFAULT 0,
+	archived_at BIGINT DEFAULT 0,
+
+	PRIMARY KEY(guid, archived_at)
+);`
@@ -0,0 +1,96 @@
+package migrations_test
+
+import (
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/migration"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CreateTaskArchive", func() {
+	var (
+		migration migration.Migration
+	)
+
+	BeforeEach(func() {
+		rawSQLDB.Exec("DROP TABLE task_archive;")
+		rawSQLDB.Exec("DROP TABLE tasks;")
+
+		migration = migrations.NewCreateTaskArchive()
+	})
+
+	It("appends itself to the migration list", func() {
+		Expect(migrations.AllMigrations()).To(ContainElement(migration))
+	})
+
+	Describe("Version", func() {
+		It("returns the timestamp from which it was created", func() {
+			Expect(migration.Version()).To(BeEquivalentTo(1792769460))
+		})
+	})
+
+	Describe("Up", func() {
+		BeforeEach(func() {
+			initialMigration := migrations.NewInitSQL()
+			initialMigration.SetDBFlavor(flavor)
+			initialMigration.SetClock(fakeClock)
+			testUpInTransaction(rawSQLDB, initialMigration, logger)
+
+			migration.SetDBFlavor(flavor)
+		})
+
+		It("creates the task_archive table", func() {
+			testUpInTransaction(rawSQLDB, migration, logger)
+
+			_, err := rawSQLDB.Exec(
+				helpers.RebindForFlavor(
+					`insert into task_archive
+						(guid, domain, state, cell_id, failed, failure_reason, rejection_count,
+						created_at, started_at, completed_at, archived_at)
+					values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+					flavor,
+				),
+				"some-guid", "some-domain", 3, "some-cell", true, "boom", 2, 1, 2, 3, 4,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			var cellID, failureReason string
+			var archivedAt int64
+			query := helpers.RebindForFlavor("select cell_id, failure_reason, archived_at from task_archive limit 1", flavor)
+			row := rawSQLDB.QueryRow(query)
+			Expect(row.Scan(&cellID, &failureReason, &archivedAt)).To(Succeed())
+			Expect(cellID).To(Equal("some-cell"))
+			Expect(failureReason).To(Equal("boom"))
+			Expect(archivedAt).To(BeEquivalentTo(4))
+		})
+
+		It("adds the started_at and started_cell_id columns to tasks", func() {
+			testUpInTransaction(rawSQLDB, migration, logger)
+
+			_, err := rawSQLDB.Exec(
+				helpers.RebindForFlavor(
+					`insert into tasks
+						(guid, domain, task_definition, started_at, started_cell_id)
+					values (?, ?, ?, ?, ?)`,
+					flavor,
+				),
+				"some-guid", "some-domain", "", 42, "some-cell",
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			var startedAt int64
+			var startedCellID string
+			query := helpers.RebindForFlavor("select started_at, started_cell_id from tasks limit 1", flavor)
+			row := rawSQLDB.QueryRow(query)
+			Expect(row.Scan(&startedAt, &startedCellID)).To(Succeed())
+			Expect(startedAt).To(BeEquivalentTo(42))
+			Expect(startedCellID).To(Equal("some-cell"))
+		})
+
+		It("is idempotent", func() {
+			testIdempotency(rawSQLDB, migration, logger)
+		})
+	})
+})
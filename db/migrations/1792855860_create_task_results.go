@@ -0,0 +1,63 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func init() {
+	appendMigration(NewCreateTaskResults())
+}
+
+type CreateTaskResults struct {
+	serializer format.Serializer
+	clock      clock.Clock
+	dbFlavor   string
+}
+
+func NewCreateTaskResults() migration.Migration {
+	return new(CreateTaskResults)
+}
+
+func (e *CreateTaskResults) String() string {
+	return migrationString(e)
+}
+
+func (e *CreateTaskResults) Version() int64 {
+	return 1792855860
+}
+
+func (e *CreateTaskResults) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *CreateTaskResults) SetClock(c clock.Clock)    { e.clock = c }
+func (e *CreateTaskResults) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *CreateTaskResults) Up(tx *sql.Tx, logger lager.Logger) error {
+	logger = logger.Session("create-task-results")
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	query := helpers.RebindForFlavor(createTaskResultsSQL, e.dbFlavor)
+	logger.Info("creating the table", lager.Data{"query": query})
+	_, err := tx.Exec(query)
+	if err != nil {
+		logger.Error("failed-creating-table", err)
+		return err
+	}
+	logger.Info("created the table", lager.Data{"query": query})
+
+	return nil
+}
+
+const createTaskResultsSQL = `CREATE TABLE IF NOT EXISTS task_results(
+	guid VARCHAR(255) PRIMARY KEY,
+	result MEDIUMTEXT
+);`
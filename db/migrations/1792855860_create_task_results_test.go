@@ -0,0 +1,61 @@
+package migrations_test
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/migration"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CreateTaskResults", func() {
+	var (
+		migration migration.Migration
+	)
+
+	BeforeEach(func() {
+		rawSQLDB.Exec("DROP TABLE task_results;")
+
+		migration = migrations.NewCreateTaskResults()
+	})
+
+	It("appends itself to the migration list", func() {
+		Expect(migrations.AllMigrations()).To(ContainElement(migration))
+	})
+
+	Describe("Version", func() {
+		It("returns the timestamp from which it was created", func() {
+			Expect(migration.Version()).To(BeEquivalentTo(1792855860))
+		})
+	})
+
+	Describe("Up", func() {
+		BeforeEach(func() {
+			migration.SetDBFlavor(flavor)
+		})
+
+		It("creates the task_results table", func() {
+			testUpInTransaction(rawSQLDB, migration, logger)
+
+			largeResult := strings.Repeat("x", 100*1024)
+			_, err := rawSQLDB.Exec(
+				helpers.RebindForFlavor(`insert into task_results (guid, result) values (?, ?)`, flavor),
+				"some-guid", largeResult,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			var result string
+			query := helpers.RebindForFlavor("select result from task_results limit 1", flavor)
+			row := rawSQLDB.QueryRow(query)
+			Expect(row.Scan(&result)).To(Succeed())
+			Expect(result).To(Equal(largeResult))
+		})
+
+		It("is idempotent", func() {
+			testIdempotency(rawSQLDB, migration, logger)
+		})
+	})
+})
@@ -0,0 +1,198 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func init() {
+	appendMigration(NewEncryptTaskResults())
+}
+
+type EncryptTaskResults struct {
+	encoder  format.Encoder
+	clock    clock.Clock
+	dbFlavor string
+}
+
+func NewEncryptTaskResults() migration.Migration {
+	return &EncryptTaskResults{}
+}
+
+func (e *EncryptTaskResults) String() string {
+	return migrationString(e)
+}
+
+func (e *EncryptTaskResults) Version() int64 {
+	return 1792942260
+}
+
+func (e *EncryptTaskResults) SetCryptor(cryptor encryption.Cryptor) {
+	e.encoder = format.NewEncoder(cryptor)
+}
+
+func (e *EncryptTaskResults) SetClock(c clock.Clock)    { e.clock = c }
+func (e *EncryptTaskResults) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *EncryptTaskResults) Up(tx *sql.Tx, logger lager.Logger) error {
+	logger = logger.Session("encrypt-task-results")
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	err := e.alterTables(tx, logger)
+	if err != nil {
+		return err
+	}
+
+	err = e.encryptColumns(tx, logger, "tasks", []string{"guid"}, "result", "failure_reason")
+	if err != nil {
+		return err
+	}
+
+	err = e.encryptColumns(tx, logger, "task_results", []string{"guid"}, "result")
+	if err != nil {
+		return err
+	}
+
+	return e.encryptColumns(tx, logger, "task_archive", []string{"guid", "archived_at"}, "failure_reason")
+}
+
+// alterTables makes room for the encrypted failure reasons, which are larger
+// than the 1024 characters they are truncated to. The columns stay nullable, as
+// MySQL does not allow defaults on MEDIUMTEXT columns.
+func (e *EncryptTaskResults) alterTables(tx *sql.Tx, logger lager.Logger) error {
+	var alterTablesSQL []string
+	if e.dbFlavor == "mysql" {
+		alterTablesSQL = []string{
+			`ALTER TABLE tasks MODIFY failure_reason MEDIUMTEXT`,
+			`ALTER TABLE task_archive MODIFY failure_reason MEDIUMTEXT`,
+		}
+	} else {
+		alterTablesSQL = []string{
+			`ALTER TABLE tasks ALTER failure_reason TYPE TEXT`,
+			`ALTER TABLE task_archive ALTER failure_reason TYPE TEXT`,
+		}
+	}
+
+	for _, query := range alterTablesSQL {
+		logger.Info("altering the table", lager.Data{"query": query})
+		_, err := tx.Exec(query)
+		if err != nil {
+			logger.Error("failed-altering-table", err)
+			return err
+		}
+		logger.Info("altered the table", lager.Data{"query": query})
+	}
+
+	return nil
+}
+
+// encryptColumns encrypts the non-empty values of the given columns of every
+// row of the table. Values that are already encrypted are left alone, so that
+// running the migration again does not encrypt them twice.
+func (e *EncryptTaskResults) encryptColumns(tx *sql.Tx, logger lager.Logger, table string, keyColumns []string, columns ...string) error {
+	logger = logger.Session("encrypt-columns", lager.Data{"table": table, "columns": columns})
+
+	query := fmt.Sprintf("SELECT %s, %s FROM %s", strings.Join(keyColumns, ", "), strings.Join(columns, ", "), table)
+	rows, err := tx.Query(query)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return err
+	}
+
+	type row struct {
+		keys   []interface{}
+		values [][]byte
+	}
+
+	rowsToEncrypt := []row{}
+	for rows.Next() {
+		r := row{
+			keys:   make([]interface{}, len(keyColumns)),
+			values: make([][]byte, len(columns)),
+		}
+		dest := []interface{}{}
+		for i := range r.keys {
+			dest = append(dest, &r.keys[i])
+		}
+		for i := range r.values {
+			dest = append(dest, &r.values[i])
+		}
+
+		err := rows.Scan(dest...)
+		if err != nil {
+			logger.Error("failed-reading-row", err)
+			rows.Close()
+			return err
+		}
+		rowsToEncrypt = append(rowsToEncrypt, r)
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-fetching-row", rows.Err())
+		return rows.Err()
+	}
+
+	err = rows.Close()
+	if err != nil {
+		logger.Error("failed-to-close-row", err)
+	}
+
+	setClauses := []string{}
+	for _, column := range columns {
+		setClauses = append(setClauses, column+" = ?")
+	}
+	whereClauses := []string{}
+	for _, column := range keyColumns {
+		whereClauses = append(whereClauses, column+" = ?")
+	}
+	updateQuery := helpers.RebindForFlavor(
+		fmt.Sprintf("UPDATE %s SET %s WHERE %s", table, strings.Join(setClauses, ", "), strings.Join(whereClauses, " AND ")),
+		e.dbFlavor,
+	)
+
+	for _, r := range rowsToEncrypt {
+		bindings := make([]interface{}, 0, len(columns)+len(keyColumns))
+		changed := false
+		for _, value := range r.values {
+			if len(value) == 0 {
+				bindings = append(bindings, value)
+				continue
+			}
+
+			if _, err := e.encoder.Decode(value); err == nil {
+				bindings = append(bindings, value)
+				continue
+			}
+
+			changed = true
+			encodedData, err := e.encoder.Encode(value)
+			if err != nil {
+				logger.Error("failed-encrypting-column", err)
+				return models.ErrBadRequest
+			}
+			bindings = append(bindings, encodedData)
+		}
+		if !changed {
+			continue
+		}
+		bindings = append(bindings, r.keys...)
+
+		_, err = tx.Exec(updateQuery, bindings...)
+		if err != nil {
+			logger.Error("failed-updating-row", err)
+			return models.ErrBadRequest
+		}
+	}
+
+	return nil
+}
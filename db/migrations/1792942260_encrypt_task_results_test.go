@@ -0,0 +1,150 @@
+package migrations_test
+
+import (
+	"crypto/rand"
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EncryptTaskResults", func() {
+	var (
+		mig     migration.Migration
+		encoder format.Encoder
+	)
+
+	BeforeEach(func() {
+		rawSQLDB.Exec("DROP TABLE task_results;")
+		rawSQLDB.Exec("DROP TABLE task_archive;")
+		rawSQLDB.Exec("DROP TABLE tasks;")
+
+		mig = migrations.NewEncryptTaskResults()
+	})
+
+	It("appends itself to the migration list", func() {
+		Expect(migrations.AllMigrations()).To(ContainElement(mig))
+	})
+
+	Describe("Version", func() {
+		It("returns the timestamp from which it was created", func() {
+			Expect(mig.Version()).To(BeEquivalentTo(1792942260))
+		})
+	})
+
+	Describe("Up", func() {
+		BeforeEach(func() {
+			initialMigrations := []migration.Migration{
+				migrations.NewInitSQL(),
+				migrations.NewCreateTaskArchive(),
+				migrations.NewCreateTaskResults(),
+			}
+
+			for _, m := range initialMigrations {
+				m.SetDBFlavor(flavor)
+				m.SetClock(fakeClock)
+				testUpInTransaction(rawSQLDB, m, logger)
+			}
+
+			key, err := encryption.NewKey("a", "my key")
+			Expect(err).NotTo(HaveOccurred())
+			keyManager, err := encryption.NewKeyManager(key, nil)
+			Expect(err).NotTo(HaveOccurred())
+			cryptor := encryption.NewCryptor(keyManager, rand.Reader)
+			encoder = format.NewEncoder(cryptor)
+
+			mig.SetDBFlavor(flavor)
+			mig.SetCryptor(cryptor)
+
+			_, err = rawSQLDB.Exec(
+				helpers.RebindForFlavor(
+					`INSERT INTO tasks (guid, domain, task_definition, result, failure_reason) VALUES (?, ?, ?, ?, ?), (?, ?, ?, ?, ?)`,
+					flavor,
+				),
+				"failed-task", "some-domain", "", "", "some failure",
+				"succeeded-task", "some-domain", "", "some result", "",
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = rawSQLDB.Exec(
+				helpers.RebindForFlavor(`INSERT INTO task_results (guid, result) VALUES (?, ?)`, flavor),
+				"offloaded-task", "some offloaded result",
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = rawSQLDB.Exec(
+				helpers.RebindForFlavor(`INSERT INTO task_archive (guid, domain, failure_reason, archived_at) VALUES (?, ?, ?, ?)`, flavor),
+				"archived-task", "some-domain", "some archived failure", 42,
+			)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		decode := func(data []byte) string {
+			if len(data) == 0 {
+				return ""
+			}
+			decoded, err := encoder.Decode(data)
+			Expect(err).NotTo(HaveOccurred())
+			return string(decoded)
+		}
+
+		It("encrypts the results and failure reasons of the tasks", func() {
+			testUpInTransaction(rawSQLDB, mig, logger)
+
+			var result, failureReason []byte
+			query := helpers.RebindForFlavor("SELECT result, failure_reason FROM tasks WHERE guid = ?", flavor)
+			Expect(rawSQLDB.QueryRow(query, "failed-task").Scan(&result, &failureReason)).To(Succeed())
+			Expect(result).To(BeEmpty())
+			Expect(string(failureReason)).NotTo(Equal("some failure"))
+			Expect(decode(failureReason)).To(Equal("some failure"))
+
+			Expect(rawSQLDB.QueryRow(query, "succeeded-task").Scan(&result, &failureReason)).To(Succeed())
+			Expect(string(result)).NotTo(Equal("some result"))
+			Expect(decode(result)).To(Equal("some result"))
+			Expect(failureReason).To(BeEmpty())
+		})
+
+		It("encrypts the offloaded task results", func() {
+			testUpInTransaction(rawSQLDB, mig, logger)
+
+			var result []byte
+			query := helpers.RebindForFlavor("SELECT result FROM task_results WHERE guid = ?", flavor)
+			Expect(rawSQLDB.QueryRow(query, "offloaded-task").Scan(&result)).To(Succeed())
+			Expect(decode(result)).To(Equal("some offloaded result"))
+		})
+
+		It("encrypts the failure reasons of the archived tasks", func() {
+			testUpInTransaction(rawSQLDB, mig, logger)
+
+			var failureReason []byte
+			query := helpers.RebindForFlavor("SELECT failure_reason FROM task_archive WHERE guid = ?", flavor)
+			Expect(rawSQLDB.QueryRow(query, "archived-task").Scan(&failureReason)).To(Succeed())
+			Expect(decode(failureReason)).To(Equal("some archived failure"))
+		})
+
+		It("leaves NULL failure reasons NULL", func() {
+			_, err := rawSQLDB.Exec(
+				helpers.RebindForFlavor(`INSERT INTO tasks (guid, domain, task_definition, failure_reason) VALUES (?, ?, ?, NULL)`, flavor),
+				"null-task", "some-domain", "",
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			testUpInTransaction(rawSQLDB, mig, logger)
+
+			var failureReason sql.NullString
+			query := helpers.RebindForFlavor("SELECT failure_reason FROM tasks WHERE guid = ?", flavor)
+			Expect(rawSQLDB.QueryRow(query, "null-task").Scan(&failureReason)).To(Succeed())
+			Expect(failureReason.Valid).To(BeFalse())
+		})
+
+		It("is idempotent", func() {
+			testIdempotency(rawSQLDB, mig, logger)
+		})
+	})
+})
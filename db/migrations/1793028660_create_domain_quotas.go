@@ -0,0 +1,66 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func init() {
+	appendMigration(NewCreateDomainQuotas())
+}
+
+type CreateDomainQuotas struct {
+	serializer format.Serializer
+	clock      clock.Clock
+	dbFlavor   string
+}
+
+func NewCreateDomainQuotas() migration.Migration {
+	return new(CreateDomainQuotas)
+}
+
+func (e *CreateDomainQuotas) String() string {
+	return migrationString(e)
+}
+
+func (e *CreateDomainQuotas) Version() int64 {
+	return 1793028660
+}
+
+func (e *CreateDomainQuotas) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *CreateDomainQuotas) SetClock(c clock.Clock)    { e.clock = c }
+func (e *CreateDomainQuotas) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *CreateDomainQuotas) Up(tx *sql.Tx, logger lager.Logger) error {
+	logger = logger.Session("create-domain-quotas")
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	query := helpers.RebindForFlavor(createDomainQuotasSQL, e.dbFlavor)
+	logger.Info("creating the table", lager.Data{"query": query})
+	_, err := tx.Exec(query)
+	if err != nil {
+		logger.Error("failed-creating-table", err)
+		return err
+	}
+	logger.Info("created the table", lager.Data{"query": query})
+
+	return nil
+}
+
+const createDomainQuotasSQL = `CREATE TABLE IF NOT EXISTS domain_quotas(
+	domain VARCHAR(255) PRIMARY KEY,
+	max_instances INT NOT NULL DEFAULT 0,
+	max_memory_mb BIGINT NOT NULL DEFAULT 0,
+	max_disk_mb BIGINT NOT NULL DEFAULT 0,
+	max_tasks INT NOT NULL DEFAULT 0
+);`
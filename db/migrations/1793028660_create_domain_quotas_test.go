@@ -0,0 +1,62 @@
+package migrations_test
+
+import (
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/migration"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CreateDomainQuotas", func() {
+	var (
+		migration migration.Migration
+	)
+
+	BeforeEach(func() {
+		rawSQLDB.Exec("DROP TABLE domain_quotas;")
+
+		migration = migrations.NewCreateDomainQuotas()
+	})
+
+	It("appends itself to the migration list", func() {
+		Expect(migrations.AllMigrations()).To(ContainElement(migration))
+	})
+
+	Describe("Version", func() {
+		It("returns the timestamp from which it was created", func() {
+			Expect(migration.Version()).To(BeEquivalentTo(1793028660))
+		})
+	})
+
+	Describe("Up", func() {
+		BeforeEach(func() {
+			migration.SetDBFlavor(flavor)
+		})
+
+		It("creates the domain_quotas table", func() {
+			testUpInTransaction(rawSQLDB, migration, logger)
+
+			_, err := rawSQLDB.Exec(
+				helpers.RebindForFlavor(`insert into domain_quotas (domain, max_memory_mb) values (?, ?)`, flavor),
+				"some-domain", 1<<40,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			var maxInstances, maxTasks int32
+			var maxMemoryMB, maxDiskMB int64
+			query := helpers.RebindForFlavor("select max_instances, max_memory_mb, max_disk_mb, max_tasks from domain_quotas limit 1", flavor)
+			row := rawSQLDB.QueryRow(query)
+			Expect(row.Scan(&maxInstances, &maxMemoryMB, &maxDiskMB, &maxTasks)).To(Succeed())
+			Expect(maxInstances).To(BeZero())
+			Expect(maxMemoryMB).To(BeEquivalentTo(1 << 40))
+			Expect(maxDiskMB).To(BeZero())
+			Expect(maxTasks).To(BeZero())
+		})
+
+		It("is idempotent", func() {
+			testIdempotency(rawSQLDB, migration, logger)
+		})
+	})
+})
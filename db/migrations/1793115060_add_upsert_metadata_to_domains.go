@@ -0,0 +1,67 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func init() {
+	appendMigration(NewAddUpsertMetadataToDomains())
+}
+
+type AddUpsertMetadataToDomains struct {
+	serializer format.Serializer
+	clock      clock.Clock
+	dbFlavor   string
+}
+
+func NewAddUpsertMetadataToDomains() migration.Migration {
+	return new(AddUpsertMetadataToDomains)
+}
+
+func (e *AddUpsertMetadataToDomains) String() string {
+	return migrationString(e)
+}
+
+func (e *AddUpsertMetadataToDomains) Version() int64 {
+	return 1793115060
+}
+
+func (e *AddUpsertMetadataToDomains) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddUpsertMetadataToDomains) SetClock(c clock.Clock)    { e.clock = c }
+func (e *AddUpsertMetadataToDomains) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *AddUpsertMetadataToDomains) Up(tx *sql.Tx, logger lager.Logger) error {
+	var alterTablesSQL []string
+	if e.dbFlavor == "mysql" {
+		alterTablesSQL = []string{
+			`ALTER TABLE domains ADD COLUMN upserted_by VARCHAR(255) NOT NULL DEFAULT '';`,
+			`ALTER TABLE domains ADD COLUMN upserted_at BIGINT DEFAULT 0;`,
+		}
+	} else {
+		alterTablesSQL = []string{
+			`ALTER TABLE domains ADD COLUMN IF NOT EXISTS upserted_by VARCHAR(255) NOT NULL DEFAULT '';`,
+			`ALTER TABLE domains ADD COLUMN IF NOT EXISTS upserted_at BIGINT DEFAULT 0;`,
+		}
+	}
+
+	for _, alterTableSQL := range alterTablesSQL {
+		logger.Info("altering the table", lager.Data{"query": alterTableSQL})
+		_, err := tx.Exec(alterTableSQL)
+		if err != nil && !isDuplicateColumnError(err) {
+			logger.Error("failed-altering-table", err)
+			return err
+		}
+		logger.Info("altered the table", lager.Data{"query": alterTableSQL})
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package migrations_test
+
+import (
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/migration"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AddUpsertMetadataToDomains", func() {
+	var (
+		migration migration.Migration
+	)
+
+	BeforeEach(func() {
+		rawSQLDB.Exec("DROP TABLE domains;")
+
+		migration = migrations.NewAddUpsertMetadataToDomains()
+	})
+
+	It("appends itself to the migration list", func() {
+		Expect(migrations.AllMigrations()).To(ContainElement(migration))
+	})
+
+	Describe("Version", func() {
+		It("returns the timestamp from which it was created", func() {
+			Expect(migration.Version()).To(BeEquivalentTo(1793115060))
+		})
+	})
+
+	Describe("Up", func() {
+		BeforeEach(func() {
+			initialMigration := migrations.NewInitSQL()
+			initialMigration.SetDBFlavor(flavor)
+			initialMigration.SetClock(fakeClock)
+			testUpInTransaction(rawSQLDB, initialMigration, logger)
+
+			_, err := rawSQLDB.Exec(
+				helpers.RebindForFlavor(`insert into domains (domain, expire_time) values (?, ?)`, flavor),
+				"existing-domain", 1,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			migration.SetDBFlavor(flavor)
+		})
+
+		It("adds the upsert metadata columns to domains", func() {
+			testUpInTransaction(rawSQLDB, migration, logger)
+
+			_, err := rawSQLDB.Exec(
+				helpers.RebindForFlavor(
+					`insert into domains (domain, expire_time, upserted_by, upserted_at) values (?, ?, ?, ?)`,
+					flavor,
+				),
+				"some-domain", 2, "some-caller", 3,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			var upsertedBy string
+			var upsertedAt int64
+			query := helpers.RebindForFlavor("select upserted_by, upserted_at from domains where domain = ?", flavor)
+			Expect(rawSQLDB.QueryRow(query, "some-domain").Scan(&upsertedBy, &upsertedAt)).To(Succeed())
+			Expect(upsertedBy).To(Equal("some-caller"))
+			Expect(upsertedAt).To(BeEquivalentTo(3))
+
+			Expect(rawSQLDB.QueryRow(query, "existing-domain").Scan(&upsertedBy, &upsertedAt)).To(Succeed())
+			Expect(upsertedBy).To(BeEmpty())
+			Expect(upsertedAt).To(BeZero())
+		})
+
+		It("is idempotent", func() {
+			testIdempotency(rawSQLDB, migration, logger)
+		})
+	})
+})
@@ -0,0 +1,64 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func init() {
+	appendMigration(NewCreateCordonedCells())
+}
+
+type CreateCordonedCells struct {
+	serializer format.Serializer
+	clock      clock.Clock
+	dbFlavor   string
+}
+
+func NewCreateCordonedCells() migration.Migration {
+	return new(CreateCordonedCells)
+}
+
+func (e *CreateCordonedCells) String() string {
+	return migrationString(e)
+}
+
+func (e *CreateCordonedCells) Version() int64 {
+	return 1793201460
+}
+
+func (e *CreateCordonedCells) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *CreateCordonedCells) SetClock(c clock.Clock)    { e.clock = c }
+func (e *CreateCordonedCells) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *CreateCordonedCells) Up(tx *sql.Tx, logger lager.Logger) error {
+	logger = logger.Session("create-cordoned-cells")
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	query := helpers.RebindForFlavor(createCordonedCellsSQL, e.dbFlavor)
+	logger.Info("creating the table", lager.Data{"query": query})
+	_, err := tx.Exec(query)
+	if err != nil {
+		logger.Error("failed-creating-table", err)
+		return err
+	}
+	logger.Info("created the table", lager.Data{"query": query})
+
+	return nil
+}
+
+const createCordonedCellsSQL = `CREATE TABLE IF NOT EXISTS cordoned_cells(
+	cell_id VARCHAR(255) PRIMARY KEY,
+	cordoned_at BIGINT NOT NULL DEFAULT 0,
+	drain_started_at BIGINT NOT NULL DEFAULT 0
+);`
@@ -0,0 +1,59 @@
+package migrations_test
+
+import (
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/migration"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CreateCordonedCells", func() {
+	var (
+		migration migration.Migration
+	)
+
+	BeforeEach(func() {
+		rawSQLDB.Exec("DROP TABLE cordoned_cells;")
+
+		migration = migrations.NewCreateCordonedCells()
+	})
+
+	It("appends itself to the migration list", func() {
+		Expect(migrations.AllMigrations()).To(ContainElement(migration))
+	})
+
+	Describe("Version", func() {
+		It("returns the timestamp from which it was created", func() {
+			Expect(migration.Version()).To(BeEquivalentTo(1793201460))
+		})
+	})
+
+	Describe("Up", func() {
+		BeforeEach(func() {
+			migration.SetDBFlavor(flavor)
+		})
+
+		It("creates the cordoned_cells table", func() {
+			testUpInTransaction(rawSQLDB, migration, logger)
+
+			_, err := rawSQLDB.Exec(
+				helpers.RebindForFlavor(`insert into cordoned_cells (cell_id, cordoned_at) values (?, ?)`, flavor),
+				"some-cell", 1234,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			var cordonedAt, drainStartedAt int64
+			query := helpers.RebindForFlavor("select cordoned_at, drain_started_at from cordoned_cells limit 1", flavor)
+			row := rawSQLDB.QueryRow(query)
+			Expect(row.Scan(&cordonedAt, &drainStartedAt)).To(Succeed())
+			Expect(cordonedAt).To(BeEquivalentTo(1234))
+			Expect(drainStartedAt).To(BeZero())
+		})
+
+		It("is idempotent", func() {
+			testIdempotency(rawSQLDB, migration, logger)
+		})
+	})
+})
@@ -0,0 +1,71 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func init() {
+	appendMigration(NewAddRevisionToLRPs())
+}
+
+type AddRevisionToLRPs struct {
+	serializer format.Serializer
+	clock      clock.Clock
+	dbFlavor   string
+}
+
+func NewAddRevisionToLRPs() migration.Migration {
+	return new(AddRevisionToLRPs)
+}
+
+func (e *AddRevisionToLRPs) String() string {
+	return migrationString(e)
+}
+
+func (e *AddRevisionToLRPs) Version() int64 {
+	return 1793287860
+}
+
+func (e *AddRevisionToLRPs) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddRevisionToLRPs) SetClock(c clock.Clock)    { e.clock = c }
+func (e *AddRevisionToLRPs) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *AddRevisionToLRPs) Up(tx *sql.Tx, logger lager.Logger) error {
+	var alterTablesSQL []string
+	if e.dbFlavor == "mysql" {
+		alterTablesSQL = []string{
+			`ALTER TABLE desired_lrps ADD COLUMN revision INT NOT NULL DEFAULT 0;`,
+			`ALTER TABLE desired_lrps ADD COLUMN rollout_max_surge INT NOT NULL DEFAULT 0;`,
+			`ALTER TABLE desired_lrps ADD COLUMN rollout_max_unavailable INT NOT NULL DEFAULT 0;`,
+			`ALTER TABLE actual_lrps ADD COLUMN revision INT NOT NULL DEFAULT 0;`,
+		}
+	} else {
+		alterTablesSQL = []string{
+			`ALTER TABLE desired_lrps ADD COLUMN IF NOT EXISTS revision INT NOT NULL DEFAULT 0;`,
+			`ALTER TABLE desired_lrps ADD COLUMN IF NOT EXISTS rollout_max_surge INT NOT NULL DEFAULT 0;`,
+			`ALTER TABLE desired_lrps ADD COLUMN IF NOT EXISTS rollout_max_unavailable INT NOT NULL DEFAULT 0;`,
+			`ALTER TABLE actual_lrps ADD COLUMN IF NOT EXISTS revision INT NOT NULL DEFAULT 0;`,
+		}
+	}
+
+	for _, alterTableSQL := range alterTablesSQL {
+		logger.Info("altering the table", lager.Data{"query": alterTableSQL})
+		_, err := tx.Exec(alterTableSQL)
+		if err != nil && !isDuplicateColumnError(err) {
+			logger.Error("failed-altering-table", err)
+			return err
+		}
+		logger.Info("altered the table", lager.Data{"query": alterTableSQL})
+	}
+
+	return nil
+}
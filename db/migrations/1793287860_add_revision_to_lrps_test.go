@@ -0,0 +1,95 @@
+package migrations_test
+
+import (
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/migration"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AddRevisionToLRPs", func() {
+	var (
+		migration migration.Migration
+	)
+
+	BeforeEach(func() {
+		rawSQLDB.Exec("DROP TABLE desired_lrps;")
+		rawSQLDB.Exec("DROP TABLE actual_lrps;")
+
+		migration = migrations.NewAddRevisionToLRPs()
+	})
+
+	It("appends itself to the migration list", func() {
+		Expect(migrations.AllMigrations()).To(ContainElement(migration))
+	})
+
+	Describe("Version", func() {
+		It("returns the timestamp from which it was created", func() {
+			Expect(migration.Version()).To(BeEquivalentTo(1793287860))
+		})
+	})
+
+	Describe("Up", func() {
+		BeforeEach(func() {
+			initialMigration := migrations.NewInitSQL()
+			initialMigration.SetDBFlavor(flavor)
+			initialMigration.SetClock(fakeClock)
+			testUpInTransaction(rawSQLDB, initialMigration, logger)
+
+			migration.SetDBFlavor(flavor)
+		})
+
+		It("adds the revision and rollout columns to desired lrps", func() {
+			testUpInTransaction(rawSQLDB, migration, logger)
+
+			_, err := rawSQLDB.Exec(
+				helpers.RebindForFlavor(
+					`insert into desired_lrps
+						(process_guid, domain, log_guid, instances, memory_mb, disk_mb, rootfs, routes,
+						volume_placement, modification_tag_epoch, run_info,
+						revision, rollout_max_surge, rollout_max_unavailable)
+					values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+					flavor,
+				),
+				"guid", "cfapps", "log-guid", 2, 128, 256, "some-rootfs", "", "", "epoch", "", 3, 2, 1,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			var revision, maxSurge, maxUnavailable int32
+			query := helpers.RebindForFlavor("select revision, rollout_max_surge, rollout_max_unavailable from desired_lrps limit 1", flavor)
+			row := rawSQLDB.QueryRow(query)
+			Expect(row.Scan(&revision, &maxSurge, &maxUnavailable)).To(Succeed())
+			Expect(revision).To(BeEquivalentTo(3))
+			Expect(maxSurge).To(BeEquivalentTo(2))
+			Expect(maxUnavailable).To(BeEquivalentTo(1))
+		})
+
+		It("adds the revision column to actual lrps", func() {
+			testUpInTransaction(rawSQLDB, migration, logger)
+
+			_, err := rawSQLDB.Exec(
+				helpers.RebindForFlavor(
+					`insert into actual_lrps
+						(process_guid, instance_index, domain, state, net_info,
+						modification_tag_epoch, modification_tag_index, revision)
+					values (?, ?, ?, ?, ?, ?, ?, ?)`,
+					flavor,
+				),
+				"guid", 10, "cfapps", "running", "", "epoch", 0, 3,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			var revision int32
+			query := helpers.RebindForFlavor("select revision from actual_lrps limit 1", flavor)
+			row := rawSQLDB.QueryRow(query)
+			Expect(row.Scan(&revision)).To(Succeed())
+			Expect(revision).To(BeEquivalentTo(3))
+		})
+
+		It("is idempotent", func() {
+			testIdempotency(rawSQLDB, migration, logger)
+		})
+	})
+})
@@ -0,0 +1,71 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func init() {
+	appendMigration(NewCreateDesiredLRPRevisions())
+}
+
+type CreateDesiredLRPRevisions struct {
+	serializer format.Serializer
+	clock      clock.Clock
+	dbFlavor   string
+}
+
+func NewCreateDesiredLRPRevisions() migration.Migration {
+	return new(CreateDesiredLRPRevisions)
+}
+
+func (e *CreateDesiredLRPRevisions) String() string {
+	return migrationString(e)
+}
+
+func (e *CreateDesiredLRPRevisions) Version() int64 {
+	return 1793374260
+}
+
+func (e *CreateDesiredLRPRevisions) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *CreateDesiredLRPRevisions) SetClock(c clock.Clock)    { e.clock = c }
+func (e *CreateDesiredLRPRevisions) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *CreateDesiredLRPRevisions) Up(tx *sql.Tx, logger lager.Logger) error {
+	logger = logger.Session("create-desired-lrp-revisions")
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	query := helpers.RebindForFlavor(createDesiredLRPRevisionsSQL, e.dbFlavor)
+	logger.Info("creating the table", lager.Data{"query": query})
+	_, err := tx.Exec(query)
+	if err != nil {
+		logger.Error("failed-creating-table", err)
+		return err
+	}
+	logger.Info("created the table", lager.Data{"query": query})
+
+	return nil
+}
+
+const createDesiredLRPRevisionsSQL = `CREATE TABLE IF NOT EXISTS desired_lrp_revisions(
+	process_guid VARCHAR(255) NOT NULL,
+	revision INT NOT NULL,
+	run_info MEDIUMTEXT NOT NULL,
+	scheduling_info MEDIUMTEXT NOT NULL,
+	modification_tag_epoch VARCHAR(255) NOT NULL,
+	modification_tag_index INT NOT NULL DEFAULT 0,
+	created_at BIGINT NOT NULL DEFAULT 0,
+	source VARCHAR(255) NOT NULL DEFAULT '',
+
+	PRIMARY KEY(process_guid, revision)
+);`
@@ -0,0 +1,68 @@
+package migrations_test
+
+import (
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/migration"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CreateDesiredLRPRevisions", func() {
+	var (
+		migration migration.Migration
+	)
+
+	BeforeEach(func() {
+		rawSQLDB.Exec("DROP TABLE desired_lrp_revisions;")
+
+		migration = migrations.NewCreateDesiredLRPRevisions()
+	})
+
+	It("appends itself to the migration list", func() {
+		Expect(migrations.AllMigrations()).To(ContainElement(migration))
+	})
+
+	Describe("Version", func() {
+		It("returns the timestamp from which it was created", func() {
+			Expect(migration.Version()).To(BeEquivalentTo(1793374260))
+		})
+	})
+
+	Describe("Up", func() {
+		BeforeEach(func() {
+			migration.SetDBFlavor(flavor)
+		})
+
+		It("creates the desired_lrp_revisions table", func() {
+			testUpInTransaction(rawSQLDB, migration, logger)
+
+			_, err := rawSQLDB.Exec(
+				helpers.RebindForFlavor(
+					`insert into desired_lrp_revisions
+						(process_guid, revision, run_info, scheduling_info, modification_tag_epoch, created_at)
+					values (?, ?, ?, ?, ?, ?)`,
+					flavor,
+				),
+				"some-guid", 2, "run-info", "scheduling-info", "some-epoch", 1234,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			var revision, modificationTagIndex int32
+			var createdAt int64
+			var source string
+			query := helpers.RebindForFlavor("select revision, modification_tag_index, created_at, source from desired_lrp_revisions limit 1", flavor)
+			row := rawSQLDB.QueryRow(query)
+			Expect(row.Scan(&revision, &modificationTagIndex, &createdAt, &source)).To(Succeed())
+			Expect(revision).To(BeEquivalentTo(2))
+			Expect(modificationTagIndex).To(BeZero())
+			Expect(createdAt).To(BeEquivalentTo(1234))
+			Expect(source).To(BeEmpty())
+		})
+
+		It("is idempotent", func() {
+			testIdempotency(rawSQLDB, migration, logger)
+		})
+	})
+})
@@ -0,0 +1,69 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func init() {
+	appendMigration(NewAddRestartPolicyToDesiredLRPs())
+}
+
+type AddRestartPolicyToDesiredLRPs struct {
+	serializer format.Serializer
+	clock      clock.Clock
+	dbFlavor   string
+}
+
+func NewAddRestartPolicyToDesiredLRPs() migration.Migration {
+	return new(AddRestartPolicyToDesiredLRPs)
+}
+
+func (e *AddRestartPolicyToDesiredLRPs) String() string {
+	return migrationString(e)
+}
+
+func (e *AddRestartPolicyToDesiredLRPs) Version() int64 {
+	return 1793460660
+}
+
+func (e *AddRestartPolicyToDesiredLRPs) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddRestartPolicyToDesiredLRPs) SetClock(c clock.Clock)    { e.clock = c }
+func (e *AddRestartPolicyToDesiredLRPs) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *AddRestartPolicyToDesiredLRPs) Up(tx *sql.Tx, logger lager.Logger) error {
+	var alterTablesSQL []string
+	if e.dbFlavor == "mysql" {
+		alterTablesSQL = []string{
+			`ALTER TABLE desired_lrps ADD COLUMN restart_immediate_restarts INT NOT NULL DEFAULT 0;`,
+			`ALTER TABLE desired_lrps ADD COLUMN restart_max_backoff_duration_ms BIGINT NOT NULL DEFAULT 0;`,
+			`ALTER TABLE desired_lrps ADD COLUMN restart_max_restarts INT NOT NULL DEFAULT 0;`,
+		}
+	} else {
+		alterTablesSQL = []string{
+			`ALTER TABLE desired_lrps ADD COLUMN IF NOT EXISTS restart_immediate_restarts INT NOT NULL DEFAULT 0;`,
+			`ALTER TABLE desired_lrps ADD COLUMN IF NOT EXISTS restart_max_backoff_duration_ms BIGINT NOT NULL DEFAULT 0;`,
+			`ALTER TABLE desired_lrps ADD COLUMN IF NOT EXISTS restart_max_restarts INT NOT NULL DEFAULT 0;`,
+		}
+	}
+
+	for _, alterTableSQL := range alterTablesSQL {
+		logger.Info("altering the table", lager.Data{"query": alterTableSQL})
+		_, err := tx.Exec(alterTableSQL)
+		if err != nil && !isDuplicateColumnError(err) {
+			logger.Error("failed-altering-table", err)
+			return err
+		}
+		logger.Info("altered the table", lager.Data{"query": alterTableSQL})
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package migrations_test
+
+import (
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/migration"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AddRestartPolicyToDesiredLRPs", func() {
+	var (
+		migration migration.Migration
+	)
+
+	BeforeEach(func() {
+		rawSQLDB.Exec("DROP TABLE desired_lrps;")
+
+		migration = migrations.NewAddRestartPolicyToDesiredLRPs()
+	})
+
+	It("appends itself to the migration list", func() {
+		Expect(migrations.AllMigrations()).To(ContainElement(migration))
+	})
+
+	Describe("Version", func() {
+		It("returns the timestamp from which it was created", func() {
+			Expect(migration.Version()).To(BeEquivalentTo(1793460660))
+		})
+	})
+
+	Describe("Up", func() {
+		BeforeEach(func() {
+			initialMigration := migrations.NewInitSQL()
+			initialMigration.SetDBFlavor(flavor)
+			initialMigration.SetClock(fakeClock)
+			testUpInTransaction(rawSQLDB, initialMigration, logger)
+
+			migration.SetDBFlavor(flavor)
+		})
+
+		It("adds the restart policy columns to desired lrps", func() {
+			testUpInTransaction(rawSQLDB, migration, logger)
+
+			_, err := rawSQLDB.Exec(
+				helpers.RebindForFlavor(
+					`insert into desired_lrps
+						(process_guid, domain, log_guid, instances, memory_mb, disk_mb, rootfs, routes,
+						volume_placement, modification_tag_epoch, run_info,
+						restart_immediate_restarts, restart_max_backoff_duration_ms, restart_max_restarts)
+					values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+					flavor,
+				),
+				"guid", "cfapps", "log-guid", 2, 128, 256, "some-rootfs", "", "", "epoch", "", 1, 60000, 10,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			var immediateRestarts, maxRestarts int32
+			var maxBackoffDurationMs int64
+			query := helpers.RebindForFlavor("select restart_immediate_restarts, restart_max_backoff_duration_ms, restart_max_restarts from desired_lrps limit 1", flavor)
+			row := rawSQLDB.QueryRow(query)
+			Expect(row.Scan(&immediateRestarts, &maxBackoffDurationMs, &maxRestarts)).To(Succeed())
+			Expect(immediateRestarts).To(BeEquivalentTo(1))
+			Expect(maxBackoffDurationMs).To(BeEquivalentTo(60000))
+			Expect(maxRestarts).To(BeEquivalentTo(10))
+		})
+
+		It("is idempotent", func() {
+			testIdempotency(rawSQLDB, migration, logger)
+		})
+	})
+})
@@ -0,0 +1,67 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func init() {
+	appendMigration(NewAddRestartStateToActualLRPs())
+}
+
+type AddRestartStateToActualLRPs struct {
+	serializer format.Serializer
+	clock      clock.Clock
+	dbFlavor   string
+}
+
+func NewAddRestartStateToActualLRPs() migration.Migration {
+	return new(AddRestartStateToActualLRPs)
+}
+
+func (e *AddRestartStateToActualLRPs) String() string {
+	return migrationString(e)
+}
+
+func (e *AddRestartStateToActualLRPs) Version() int64 {
+	return 1793547060
+}
+
+func (e *AddRestartStateToActualLRPs) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddRestartStateToActualLRPs) SetClock(c clock.Clock)    { e.clock = c }
+func (e *AddRestartStateToActualLRPs) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *AddRestartStateToActualLRPs) Up(tx *sql.Tx, logger lager.Logger) error {
+	var alterTablesSQL []string
+	if e.dbFlavor == "mysql" {
+		alterTablesSQL = []string{
+			`ALTER TABLE actual_lrps ADD COLUMN next_restart_at BIGINT NOT NULL DEFAULT 0;`,
+			`ALTER TABLE actual_lrps ADD COLUMN restarts_exhausted BOOL NOT NULL DEFAULT false;`,
+		}
+	} else {
+		alterTablesSQL = []string{
+			`ALTER TABLE actual_lrps ADD COLUMN IF NOT EXISTS next_restart_at BIGINT NOT NULL DEFAULT 0;`,
+			`ALTER TABLE actual_lrps ADD COLUMN IF NOT EXISTS restarts_exhausted BOOL NOT NULL DEFAULT false;`,
+		}
+	}
+
+	for _, alterTableSQL := range alterTablesSQL {
+		logger.Info("altering the table", lager.Data{"query": alterTableSQL})
+		_, err := tx.Exec(alterTableSQL)
+		if err != nil && !isDuplicateColumnError(err) {
+			logger.Error("failed-altering-table", err)
+			return err
+		}
+		logger.Info("altered the table", lager.Data{"query": alterTableSQL})
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package migrations_test
+
+import (
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/migration"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AddRestartStateToActualLRPs", func() {
+	var (
+		migration migration.Migration
+	)
+
+	BeforeEach(func() {
+		rawSQLDB.Exec("DROP TABLE actual_lrps;")
+
+		migration = migrations.NewAddRestartStateToActualLRPs()
+	})
+
+	It("appends itself to the migration list", func() {
+		Expect(migrations.AllMigrations()).To(ContainElement(migration))
+	})
+
+	Describe("Version", func() {
+		It("returns the timestamp from which it was created", func() {
+			Expect(migration.Version()).To(BeEquivalentTo(1793547060))
+		})
+	})
+
+	Describe("Up", func() {
+		BeforeEach(func() {
+			initialMigration := migrations.NewInitSQL()
+			initialMigration.SetDBFlavor(flavor)
+			initialMigration.SetClock(fakeClock)
+			testUpInTransaction(rawSQLDB, initialMigration, logger)
+
+			migration.SetDBFlavor(flavor)
+		})
+
+		It("adds the restart state columns to actual lrps", func() {
+			testUpInTransaction(rawSQLDB, migration, logger)
+
+			_, err := rawSQLDB.Exec(
+				helpers.RebindForFlavor(
+					`insert into actual_lrps
+						(process_guid, instance_index, domain, state, net_info,
+						modification_tag_epoch, modification_tag_index, next_restart_at, restarts_exhausted)
+					values (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+					flavor,
+				),
+				"guid", 10, "cfapps", "CRASHED", "", "epoch", 0, 1234, true,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			var nextRestartAt int64
+			var restartsExhausted bool
+			query := helpers.RebindForFlavor("select next_restart_at, restarts_exhausted from actual_lrps limit 1", flavor)
+			row := rawSQLDB.QueryRow(query)
+			Expect(row.Scan(&nextRestartAt, &restartsExhausted)).To(Succeed())
+			Expect(nextRestartAt).To(BeEquivalentTo(1234))
+			Expect(restartsExhausted).To(BeTrue())
+		})
+
+		It("defaults to no restart state", func() {
+			testUpInTransaction(rawSQLDB, migration, logger)
+
+			_, err := rawSQLDB.Exec(
+				helpers.RebindForFlavor(
+					`insert into actual_lrps
+						(process_guid, instance_index, domain, state, net_info,
+						modification_tag_epoch, modification_tag_index)
+					values (?, ?, ?, ?, ?, ?, ?)`,
+					flavor,
+				),
+				"guid", 10, "cfapps", "RUNNING", "", "epoch", 0,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			var nextRestartAt int64
+			var restartsExhausted bool
+			query := helpers.RebindForFlavor("select next_restart_at, restarts_exhausted from actual_lrps limit 1", flavor)
+			row := rawSQLDB.QueryRow(query)
+			Expect(row.Scan(&nextRestartAt, &restartsExhausted)).To(Succeed())
+			Expect(nextRestartAt).To(BeEquivalentTo(0))
+			Expect(restartsExhausted).To(BeFalse())
+		})
+
+		It("is idempotent", func() {
+			testIdempotency(rawSQLDB, migration, logger)
+		})
+	})
+})
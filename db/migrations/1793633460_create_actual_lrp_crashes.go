@@ -0,0 +1,70 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func init() {
+	appendMigration(NewCreateActualLRPCrashes())
+}
+
+type CreateActualLRPCrashes struct {
+	serializer format.Serializer
+	clock      clock.Clock
+	dbFlavor   string
+}
+
+func NewCreateActualLRPCrashes() migration.Migration {
+	return new(CreateActualLRPCrashes)
+}
+
+func (e *CreateActualLRPCrashes) String() string {
+	return migrationString(e)
+}
+
+func (e *CreateActualLRPCrashes) Version() int64 {
+	return 1793633460
+}
+
+func (e *CreateActualLRPCrashes) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *CreateActualLRPCrashes) SetClock(c clock.Clock)    { e.clock = c }
+func (e *CreateActualLRPCrashes) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *CreateActualLRPCrashes) Up(tx *sql.Tx, logger lager.Logger) error {
+	logger = logger.Session("create-actual-lrp-crashes")
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	query := helpers.RebindForFlavor(createActualLRPCrashesSQL, e.dbFlavor)
+	logger.Info("creating the table", lager.Data{"query": query})
+	_, err := tx.Exec(query)
+	if err != nil {
+		logger.Error("failed-creating-table", err)
+		return err
+	}
+	logger.Info("created the table", lager.Data{"query": query})
+
+	return nil
+}
+
+const createActualLRPCrashesSQL = `CREATE TABLE IF NOT EXISTS actual_lrp_crashes(
+	process_guid VARCHAR(255) NOT NULL,
+	instance_index INT NOT NULL,
+	crashed_at BIGINT NOT NULL,
+	instance_guid VARCHAR(255) NOT NULL DEFAULT '',
+	cell_id VARCHAR(255) NOT NULL DEFAULT '',
+	crash_reason VARCHAR(1024) NOT NULL DEFAULT '',
+	crash_count INT NOT NULL DEFAULT 0,
+
+	PRIMARY KEY(process_guid, instance_index, crashed_at)
+);`
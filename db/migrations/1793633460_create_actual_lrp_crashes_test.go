@@ -0,0 +1,67 @@
+package migrations_test
+
+import (
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/migration"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CreateActualLRPCrashes", func() {
+	var (
+		migration migration.Migration
+	)
+
+	BeforeEach(func() {
+		rawSQLDB.Exec("DROP TABLE actual_lrp_crashes;")
+
+		migration = migrations.NewCreateActualLRPCrashes()
+	})
+
+	It("appends itself to the migration list", func() {
+		Expect(migrations.AllMigrations()).To(ContainElement(migration))
+	})
+
+	Describe("Version", func() {
+		It("returns the timestamp from which it was created", func() {
+			Expect(migration.Version()).To(BeEquivalentTo(1793633460))
+		})
+	})
+
+	Describe("Up", func() {
+		BeforeEach(func() {
+			migration.SetDBFlavor(flavor)
+		})
+
+		It("creates the actual_lrp_crashes table", func() {
+			testUpInTransaction(rawSQLDB, migration, logger)
+
+			_, err := rawSQLDB.Exec(
+				helpers.RebindForFlavor(
+					`insert into actual_lrp_crashes
+						(process_guid, instance_index, crashed_at, instance_guid, crash_reason)
+					values (?, ?, ?, ?, ?)`,
+					flavor,
+				),
+				"some-guid", 1, 1234, "some-instance-guid", "boom",
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			var crashCount int32
+			var crashedAt int64
+			var cellID string
+			query := helpers.RebindForFlavor("select crash_count, crashed_at, cell_id from actual_lrp_crashes limit 1", flavor)
+			row := rawSQLDB.QueryRow(query)
+			Expect(row.Scan(&crashCount, &crashedAt, &cellID)).To(Succeed())
+			Expect(crashCount).To(BeZero())
+			Expect(crashedAt).To(BeEquivalentTo(1234))
+			Expect(cellID).To(BeEmpty())
+		})
+
+		It("is idempotent", func() {
+			testIdempotency(rawSQLDB, migration, logger)
+		})
+	})
+})
@@ -0,0 +1,65 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func init() {
+	appendMigration(NewAddZoneSpreadConstraintToDesiredLRPs())
+}
+
+type AddZoneSpreadConstraintToDesiredLRPs struct {
+	serializer format.Serializer
+	clock      clock.Clock
+	dbFlavor   string
+}
+
+func NewAddZoneSpreadConstraintToDesiredLRPs() migration.Migration {
+	return new(AddZoneSpreadConstraintToDesiredLRPs)
+}
+
+func (e *AddZoneSpreadConstraintToDesiredLRPs) String() string {
+	return migrationString(e)
+}
+
+func (e *AddZoneSpreadConstraintToDesiredLRPs) Version() int64 {
+	return 1793719860
+}
+
+func (e *AddZoneSpreadConstraintToDesiredLRPs) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddZoneSpreadConstraintToDesiredLRPs) SetClock(c clock.Clock)    { e.clock = c }
+func (e *AddZoneSpreadConstraintToDesiredLRPs) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *AddZoneSpreadConstraintToDesiredLRPs) Up(tx *sql.Tx, logger lager.Logger) error {
+	var alterTableSQL []string
+	if e.dbFlavor == "mysql" {
+		alterTableSQL = []string{
+			`ALTER TABLE desired_lrps ADD COLUMN zone_spread_max_skew INT NOT NULL DEFAULT 0;`,
+		}
+	} else {
+		alterTableSQL = []string{
+			`ALTER TABLE desired_lrps ADD COLUMN IF NOT EXISTS zone_spread_max_skew INT NOT NULL DEFAULT 0;`,
+		}
+	}
+
+	for _, query := range alterTableSQL {
+		logger.Info("altering the table", lager.Data{"query": query})
+		_, err := tx.Exec(query)
+		if err != nil && !isDuplicateColumnError(err) {
+			logger.Error("failed-altering-table", err)
+			return err
+		}
+		logger.Info("altered the table", lager.Data{"query": query})
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func init() {
+	appendMigration(NewAddPausedToDesiredLRPs())
+}
+
+type AddPausedToDesiredLRPs struct {
+	serializer format.Serializer
+	clock      clock.Clock
+	dbFlavor   string
+}
+
+func NewAddPausedToDesiredLRPs() migration.Migration {
+	return new(AddPausedToDesiredLRPs)
+}
+
+func (e *AddPausedToDesiredLRPs) String() string {
+	return migrationString(e)
+}
+
+func (e *AddPausedToDesiredLRPs) Version() int64 {
+	return 1793806260
+}
+
+func (e *AddPausedToDesiredLRPs) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddPausedToDesiredLRPs) SetClock(c clock.Clock)    { e.clock = c }
+func (e *AddPausedToDesiredLRPs) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *AddPausedToDesiredLRPs) Up(tx *sql.Tx, logger lager.Logger) error {
+	var alterTableSQL string
+	if e.dbFlavor == "mysql" {
+		alterTableSQL = `ALTER TABLE desired_lrps ADD COLUMN paused BOOL NOT NULL DEFAULT false;`
+	} else {
+		alterTableSQL = `ALTER TABLE desired_lrps ADD COLUMN IF NOT EXISTS paused BOOL NOT NULL DEFAULT false;`
+	}
+
+	logger.Info("altering the table", lager.Data{"query": alterTableSQL})
+	_, err := tx.Exec(alterTableSQL)
+	if err != nil && !isDuplicateColumnError(err) {
+		logger.Error("failed-altering-table", err)
+		return err
+	}
+	logger.Info("altered the table", lager.Data{"query": alterTableSQL})
+
+	return nil
+}
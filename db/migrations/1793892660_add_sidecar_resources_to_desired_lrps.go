@@ -0,0 +1,131 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func init() {
+	appendMigration(NewAddSidecarResourcesToDesiredLRPs())
+}
+
+type AddSidecarResourcesToDesiredLRPs struct {
+	serializer format.Serializer
+	clock      clock.Clock
+	dbFlavor   string
+}
+
+func NewAddSidecarResourcesToDesiredLRPs() migration.Migration {
+	return new(AddSidecarResourcesToDesiredLRPs)
+}
+
+func (e *AddSidecarResourcesToDesiredLRPs) String() string {
+	return migrationString(e)
+}
+
+func (e *AddSidecarResourcesToDesiredLRPs) Version() int64 {
+	return 1793892660
+}
+
+func (e *AddSidecarResourcesToDesiredLRPs) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddSidecarResourcesToDesiredLRPs) SetClock(c clock.Clock)    { e.clock = c }
+func (e *AddSidecarResourcesToDesiredLRPs) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *AddSidecarResourcesToDesiredLRPs) Up(tx *sql.Tx, logger lager.Logger) error {
+	logger = logger.Session("add-sidecar-resources-to-desired-lrps")
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	var alterTablesSQL []string
+	if e.dbFlavor == "mysql" {
+		alterTablesSQL = []string{
+			`ALTER TABLE desired_lrps ADD COLUMN sidecar_memory_mb INT NOT NULL DEFAULT 0;`,
+			`ALTER TABLE desired_lrps ADD COLUMN sidecar_disk_mb INT NOT NULL DEFAULT 0;`,
+		}
+	} else {
+		alterTablesSQL = []string{
+			`ALTER TABLE desired_lrps ADD COLUMN IF NOT EXISTS sidecar_memory_mb INT NOT NULL DEFAULT 0;`,
+			`ALTER TABLE desired_lrps ADD COLUMN IF NOT EXISTS sidecar_disk_mb INT NOT NULL DEFAULT 0;`,
+		}
+	}
+
+	for _, alterTableSQL := range alterTablesSQL {
+		logger.Info("altering the table", lager.Data{"query": alterTableSQL})
+		_, err := tx.Exec(alterTableSQL)
+		if err != nil && !isDuplicateColumnError(err) {
+			logger.Error("failed-altering-table", err)
+			return err
+		}
+		logger.Info("altered the table", lager.Data{"query": alterTableSQL})
+	}
+
+	return e.backfillSidecarResources(tx, logger)
+}
+
+// backfillSidecarResources records the resources of the sidecars of the
+// existing DesiredLRPs, which are only found in their run info.
+func (e *AddSidecarResourcesToDesiredLRPs) backfillSidecarResources(tx *sql.Tx, logger lager.Logger) error {
+	rows, err := tx.Query("SELECT process_guid, run_info FROM desired_lrps")
+	if err != nil {
+		logger.Error("failed-query", err)
+		return err
+	}
+
+	type sidecarResources struct {
+		memoryMb, diskMb int32
+	}
+
+	resources := map[string]sidecarResources{}
+	for rows.Next() {
+		var processGuid string
+		var runInfoData []byte
+		err := rows.Scan(&processGuid, &runInfoData)
+		if err != nil {
+			logger.Error("failed-reading-row", err)
+			continue
+		}
+
+		var runInfo models.DesiredLRPRunInfo
+		err = e.serializer.Unmarshal(logger, runInfoData, &runInfo)
+		if err != nil {
+			logger.Error("failed-parsing-run-info", err, lager.Data{"process_guid": processGuid})
+			continue
+		}
+
+		memoryMb, diskMb := models.SidecarResources(runInfo.Sidecars)
+		if memoryMb > 0 || diskMb > 0 {
+			resources[processGuid] = sidecarResources{memoryMb: memoryMb, diskMb: diskMb}
+		}
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-fetching-row", rows.Err())
+		return rows.Err()
+	}
+
+	err = rows.Close()
+	if err != nil {
+		logger.Error("failed-to-close-row", err)
+	}
+
+	updateQuery := helpers.RebindForFlavor("UPDATE desired_lrps SET sidecar_memory_mb = ?, sidecar_disk_mb = ? WHERE process_guid = ?", e.dbFlavor)
+	for processGuid, r := range resources {
+		_, err := tx.Exec(updateQuery, r.memoryMb, r.diskMb, processGuid)
+		if err != nil {
+			logger.Error("failed-updating-desired-lrp-record", err, lager.Data{"process_guid": processGuid})
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package migrations_test
+
+import (
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/bbs/models"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AddSidecarResourcesToDesiredLRPs", func() {
+	var (
+		migration  migration.Migration
+		serializer format.Serializer
+	)
+
+	BeforeEach(func() {
+		rawSQLDB.Exec("DROP TABLE desired_lrps;")
+
+		serializer = format.NewSerializer(cryptor)
+
+		migration = migrations.NewAddSidecarResourcesToDesiredLRPs()
+	})
+
+	It("appends itself to the migration list", func() {
+		Expect(migrations.AllMigrations()).To(ContainElement(migration))
+	})
+
+	Describe("Version", func() {
+		It("returns the timestamp from which it was created", func() {
+			Expect(migration.Version()).To(BeEquivalentTo(1793892660))
+		})
+	})
+
+	Describe("Up", func() {
+		var runInfo *models.DesiredLRPRunInfo
+
+		BeforeEach(func() {
+			initialMigration := migrations.NewInitSQL()
+			initialMigration.SetDBFlavor(flavor)
+			initialMigration.SetClock(fakeClock)
+			testUpInTransaction(rawSQLDB, initialMigration, logger)
+
+			migration.SetCryptor(cryptor)
+			migration.SetDBFlavor(flavor)
+
+			runInfo = &models.DesiredLRPRunInfo{}
+		})
+
+		JustBeforeEach(func() {
+			runInfoData, err := serializer.Marshal(logger, runInfo)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = rawSQLDB.Exec(
+				helpers.RebindForFlavor(
+					`insert into desired_lrps
+						(process_guid, domain, log_guid, instances, memory_mb, disk_mb, rootfs, routes,
+						volume_placement, modification_tag_epoch, run_info)
+					values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+					flavor,
+				),
+				"guid", "cfapps", "log-guid", 2, 128, 256, "some-rootfs", "", "", "epoch", runInfoData,
+			)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		selectSidecarResources := func() (int32, int32) {
+			var memoryMb, diskMb int32
+			query := helpers.RebindForFlavor("select sidecar_memory_mb, sidecar_disk_mb from desired_lrps limit 1", flavor)
+			row := rawSQLDB.QueryRow(query)
+			ExpectWithOffset(1, row.Scan(&memoryMb, &diskMb)).To(Succeed())
+			return memoryMb, diskMb
+		}
+
+		Context("when the run info has sidecars", func() {
+			BeforeEach(func() {
+				runInfo.Sidecars = []*models.Sidecar{
+					{MemoryMb: 32, DiskMb: 64},
+					{MemoryMb: 16, DiskMb: 8},
+				}
+			})
+
+			It("records the sum of their resources", func() {
+				testUpInTransaction(rawSQLDB, migration, logger)
+
+				memoryMb, diskMb := selectSidecarResources()
+				Expect(memoryMb).To(BeEquivalentTo(48))
+				Expect(diskMb).To(BeEquivalentTo(72))
+			})
+		})
+
+		Context("when the run info has no sidecars", func() {
+			It("records no resources", func() {
+				testUpInTransaction(rawSQLDB, migration, logger)
+
+				memoryMb, diskMb := selectSidecarResources()
+				Expect(memoryMb).To(BeZero())
+				Expect(diskMb).To(BeZero())
+			})
+		})
+
+		It("is idempotent", func() {
+			testIdempotency(rawSQLDB, migration, logger)
+		})
+	})
+})
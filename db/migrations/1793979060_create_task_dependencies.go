@@ -0,0 +1,143 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func init() {
+	appendMigration(NewCreateTaskDependencies())
+}
+
+type CreateTaskDependencies struct {
+	serializer format.Serializer
+	clock      clock.Clock
+	dbFlavor   string
+}
+
+func NewCreateTaskDependencies() migration.Migration {
+	return new(CreateTaskDependencies)
+}
+
+func (e *CreateTaskDependencies) String() string {
+	return migrationString(e)
+}
+
+func (e *CreateTaskDependencies) Version() int64 {
+	return 1793979060
+}
+
+func (e *CreateTaskDependencies) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *CreateTaskDependencies) SetClock(c clock.Clock)    { e.clock = c }
+func (e *CreateTaskDependencies) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *CreateTaskDependencies) Up(tx *sql.Tx, logger lager.Logger) error {
+	logger = logger.Session("create-task-dependencies")
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	query := helpers.RebindForFlavor(createTaskDependenciesSQL, e.dbFlavor)
+	logger.Info("creating the table", lager.Data{"query": query})
+	_, err := tx.Exec(query)
+	if err != nil {
+		logger.Error("failed-creating-table", err)
+		return err
+	}
+	logger.Info("created the table", lager.Data{"query": query})
+
+	var createIndexSQL string
+	if e.dbFlavor == "mysql" {
+		createIndexSQL = `CREATE INDEX task_dependencies_dependency_guid_idx ON task_dependencies (dependency_guid);`
+	} else {
+		createIndexSQL = `CREATE INDEX IF NOT EXISTS task_dependencies_dependency_guid_idx ON task_dependencies (dependency_guid);`
+	}
+
+	logger.Info("creating the index", lager.Data{"query": createIndexSQL})
+	_, err = tx.Exec(createIndexSQL)
+	if err != nil && !isDuplicateIndexError(err) {
+		logger.Error("failed-creating-index", err)
+		return err
+	}
+	logger.Info("created the index", lager.Data{"query": createIndexSQL})
+
+	return e.backfillTaskDependencies(tx, logger)
+}
+
+// backfillTaskDependencies records the dependencies of the blocked tasks,
+// which are only found in their task definitions.
+func (e *CreateTaskDependencies) backfillTaskDependencies(tx *sql.Tx, logger lager.Logger) error {
+	rows, err := tx.Query(helpers.RebindForFlavor("SELECT guid, task_definition FROM tasks WHERE state = ?", e.dbFlavor), models.Task_Blocked)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return err
+	}
+
+	dependencies := map[string][]string{}
+	for rows.Next() {
+		var taskGuid string
+		var taskDefData []byte
+		err := rows.Scan(&taskGuid, &taskDefData)
+		if err != nil {
+			logger.Error("failed-reading-row", err)
+			continue
+		}
+
+		var taskDef models.TaskDefinition
+		err = e.serializer.Unmarshal(logger, taskDefData, &taskDef)
+		if err != nil {
+			logger.Error("failed-parsing-task-definition", err, lager.Data{"task_guid": taskGuid})
+			continue
+		}
+
+		dependencies[taskGuid] = taskDef.DependsOn
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-fetching-row", rows.Err())
+		return rows.Err()
+	}
+
+	err = rows.Close()
+	if err != nil {
+		logger.Error("failed-to-close-row", err)
+	}
+
+	deleteQuery := helpers.RebindForFlavor("DELETE FROM task_dependencies WHERE task_guid = ?", e.dbFlavor)
+	insertQuery := helpers.RebindForFlavor("INSERT INTO task_dependencies (task_guid, dependency_guid) VALUES (?, ?)", e.dbFlavor)
+	for taskGuid, dependsOn := range dependencies {
+		_, err := tx.Exec(deleteQuery, taskGuid)
+		if err != nil {
+			logger.Error("failed-deleting-task-dependencies", err, lager.Data{"task_guid": taskGuid})
+			return err
+		}
+
+		for _, dependencyGuid := range dependsOn {
+			_, err := tx.Exec(insertQuery, taskGuid, dependencyGuid)
+			if err != nil {
+				logger.Error("failed-inserting-task-dependency", err, lager.Data{"task_guid": taskGuid})
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+const createTaskDependenciesSQL = `CREATE TABLE IF NOT EXISTS task_dependencies(
+	task_guid VARCHAR(255) NOT NULL,
+	dependency_guid VARCHAR(255) NOT NULL,
+	dependency_completed BOOL NOT NULL DEFAULT false,
+	dependency_failed BOOL NOT NULL DEFAULT false,
+
+	PRIMARY KEY(task_guid, dependency_guid)
+);`
@@ -0,0 +1,91 @@
+package migrations_test
+
+import (
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CreateTaskDependencies", func() {
+	var (
+		migration  migration.Migration
+		serializer format.Serializer
+	)
+
+	BeforeEach(func() {
+		rawSQLDB.Exec("DROP TABLE tasks;")
+		rawSQLDB.Exec("DROP TABLE task_dependencies;")
+
+		serializer = format.NewSerializer(cryptor)
+
+		migration = migrations.NewCreateTaskDependencies()
+	})
+
+	It("appends itself to the migration list", func() {
+		Expect(migrations.AllMigrations()).To(ContainElement(migration))
+	})
+
+	Describe("Version", func() {
+		It("returns the timestamp from which it was created", func() {
+			Expect(migration.Version()).To(BeEquivalentTo(1793979060))
+		})
+	})
+
+	Describe("Up", func() {
+		BeforeEach(func() {
+			initialMigration := migrations.NewInitSQL()
+			initialMigration.SetDBFlavor(flavor)
+			initialMigration.SetClock(fakeClock)
+			testUpInTransaction(rawSQLDB, initialMigration, logger)
+
+			migration.SetCryptor(cryptor)
+			migration.SetDBFlavor(flavor)
+
+			insertTask := func(guid string, state models.Task_State, dependsOn ...string) {
+				taskDef := model_helpers.NewValidTaskDefinition()
+				taskDef.DependsOn = dependsOn
+				taskDefData, err := serializer.Marshal(logger, taskDef)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = rawSQLDB.Exec(
+					helpers.RebindForFlavor(`insert into tasks (guid, domain, state, task_definition) values (?, ?, ?, ?)`, flavor),
+					guid, "some-domain", state, taskDefData,
+				)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			insertTask("blocked-guid", models.Task_Blocked, "dependency-a", "dependency-b")
+			insertTask("pending-guid", models.Task_Pending, "dependency-a")
+		})
+
+		It("records the dependencies of the blocked tasks", func() {
+			testUpInTransaction(rawSQLDB, migration, logger)
+
+			rows, err := rawSQLDB.Query("select task_guid, dependency_guid, dependency_completed, dependency_failed from task_dependencies")
+			Expect(err).NotTo(HaveOccurred())
+			defer rows.Close()
+
+			dependencies := []string{}
+			for rows.Next() {
+				var taskGuid, dependencyGuid string
+				var completed, failed bool
+				Expect(rows.Scan(&taskGuid, &dependencyGuid, &completed, &failed)).To(Succeed())
+				Expect(completed).To(BeFalse())
+				Expect(failed).To(BeFalse())
+				dependencies = append(dependencies, taskGuid+":"+dependencyGuid)
+			}
+			Expect(rows.Err()).NotTo(HaveOccurred())
+			Expect(dependencies).To(ConsistOf("blocked-guid:dependency-a", "blocked-guid:dependency-b"))
+		})
+
+		It("is idempotent", func() {
+			testIdempotency(rawSQLDB, migration, logger)
+		})
+	})
+})
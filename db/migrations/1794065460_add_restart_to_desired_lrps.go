@@ -0,0 +1,69 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func init() {
+	appendMigration(NewAddRestartToDesiredLRPs())
+}
+
+type AddRestartToDesiredLRPs struct {
+	serializer format.Serializer
+	clock      clock.Clock
+	dbFlavor   string
+}
+
+func NewAddRestartToDesiredLRPs() migration.Migration {
+	return new(AddRestartToDesiredLRPs)
+}
+
+func (e *AddRestartToDesiredLRPs) String() string {
+	return migrationString(e)
+}
+
+func (e *AddRestartToDesiredLRPs) Version() int64 {
+	return 1794065460
+}
+
+func (e *AddRestartToDesiredLRPs) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddRestartToDesiredLRPs) SetClock(c clock.Clock)    { e.clock = c }
+func (e *AddRestartToDesiredLRPs) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *AddRestartToDesiredLRPs) Up(tx *sql.Tx, logger lager.Logger) error {
+	var alterTableSQL []string
+	if e.dbFlavor == "mysql" {
+		alterTableSQL = []string{
+			`ALTER TABLE desired_lrps ADD COLUMN restart_max_in_flight INT NOT NULL DEFAULT 0;`,
+			`ALTER TABLE desired_lrps ADD COLUMN restart_requested_at BIGINT NOT NULL DEFAULT 0;`,
+			`ALTER TABLE desired_lrps ADD COLUMN restart_progressed_at BIGINT NOT NULL DEFAULT 0;`,
+		}
+	} else {
+		alterTableSQL = []string{
+			`ALTER TABLE desired_lrps ADD COLUMN IF NOT EXISTS restart_max_in_flight INT NOT NULL DEFAULT 0;`,
+			`ALTER TABLE desired_lrps ADD COLUMN IF NOT EXISTS restart_requested_at BIGINT NOT NULL DEFAULT 0;`,
+			`ALTER TABLE desired_lrps ADD COLUMN IF NOT EXISTS restart_progressed_at BIGINT NOT NULL DEFAULT 0;`,
+		}
+	}
+
+	for _, query := range alterTableSQL {
+		logger.Info("altering the table", lager.Data{"query": query})
+		_, err := tx.Exec(query)
+		if err != nil && !isDuplicateColumnError(err) {
+			logger.Error("failed-altering-table", err)
+			return err
+		}
+		logger.Info("altered the table", lager.Data{"query": query})
+	}
+
+	return nil
+}
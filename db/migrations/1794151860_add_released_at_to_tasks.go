@@ -0,0 +1,59 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func init() {
+	appendMigration(NewAddReleasedAtToTasks())
+}
+
+type AddReleasedAtToTasks struct {
+	serializer format.Serializer
+	clock      clock.Clock
+	dbFlavor   string
+}
+
+func NewAddReleasedAtToTasks() migration.Migration {
+	return new(AddReleasedAtToTasks)
+}
+
+func (e *AddReleasedAtToTasks) String() string {
+	return migrationString(e)
+}
+
+func (e *AddReleasedAtToTasks) Version() int64 {
+	return 1794151860
+}
+
+func (e *AddReleasedAtToTasks) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddReleasedAtToTasks) SetClock(c clock.Clock)    { e.clock = c }
+func (e *AddReleasedAtToTasks) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *AddReleasedAtToTasks) Up(tx *sql.Tx, logger lager.Logger) error {
+	var alterTableSQL string
+	if e.dbFlavor == "mysql" {
+		alterTableSQL = `ALTER TABLE tasks ADD COLUMN released_at BIGINT NOT NULL DEFAULT 0;`
+	} else {
+		alterTableSQL = `ALTER TABLE tasks ADD COLUMN IF NOT EXISTS released_at BIGINT NOT NULL DEFAULT 0;`
+	}
+
+	logger.Info("altering the table", lager.Data{"query": alterTableSQL})
+	_, err := tx.Exec(alterTableSQL)
+	if err != nil && !isDuplicateColumnError(err) {
+		logger.Error("failed-altering-table", err)
+		return err
+	}
+	logger.Info("altered the table", lager.Data{"query": alterTableSQL})
+
+	return nil
+}
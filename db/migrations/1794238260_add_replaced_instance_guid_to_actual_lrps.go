@@ -0,0 +1,59 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func init() {
+	appendMigration(NewAddReplacedInstanceGuidToActualLRPs())
+}
+
+type AddReplacedInstanceGuidToActualLRPs struct {
+	serializer format.Serializer
+	clock      clock.Clock
+	dbFlavor   string
+}
+
+func NewAddReplacedInstanceGuidToActualLRPs() migration.Migration {
+	return new(AddReplacedInstanceGuidToActualLRPs)
+}
+
+func (e *AddReplacedInstanceGuidToActualLRPs) String() string {
+	return migrationString(e)
+}
+
+func (e *AddReplacedInstanceGuidToActualLRPs) Version() int64 {
+	return 1794238260
+}
+
+func (e *AddReplacedInstanceGuidToActualLRPs) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddReplacedInstanceGuidToActualLRPs) SetClock(c clock.Clock)    { e.clock = c }
+func (e *AddReplacedInstanceGuidToActualLRPs) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *AddReplacedInstanceGuidToActualLRPs) Up(tx *sql.Tx, logger lager.Logger) error {
+	var alterTableSQL string
+	if e.dbFlavor == "mysql" {
+		alterTableSQL = `ALTER TABLE actual_lrps ADD COLUMN replaced_instance_guid VARCHAR(255) NOT NULL DEFAULT '';`
+	} else {
+		alterTableSQL = `ALTER TABLE actual_lrps ADD COLUMN IF NOT EXISTS replaced_instance_guid VARCHAR(255) NOT NULL DEFAULT '';`
+	}
+
+	logger.Info("altering the table", lager.Data{"query": alterTableSQL})
+	_, err := tx.Exec(alterTableSQL)
+	if err != nil && !isDuplicateColumnError(err) {
+		logger.Error("failed-altering-table", err)
+		return err
+	}
+	logger.Info("altered the table", lager.Data{"query": alterTableSQL})
+
+	return nil
+}
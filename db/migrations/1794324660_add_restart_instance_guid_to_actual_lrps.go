@@ -0,0 +1,59 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func init() {
+	appendMigration(NewAddRestartInstanceGuidToActualLRPs())
+}
+
+type AddRestartInstanceGuidToActualLRPs struct {
+	serializer format.Serializer
+	clock      clock.Clock
+	dbFlavor   string
+}
+
+func NewAddRestartInstanceGuidToActualLRPs() migration.Migration {
+	return new(AddRestartInstanceGuidToActualLRPs)
+}
+
+func (e *AddRestartInstanceGuidToActualLRPs) String() string {
+	return migrationString(e)
+}
+
+func (e *AddRestartInstanceGuidToActualLRPs) Version() int64 {
+	return 1794324660
+}
+
+func (e *AddRestartInstanceGuidToActualLRPs) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddRestartInstanceGuidToActualLRPs) SetClock(c clock.Clock)    { e.clock = c }
+func (e *AddRestartInstanceGuidToActualLRPs) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *AddRestartInstanceGuidToActualLRPs) Up(tx *sql.Tx, logger lager.Logger) error {
+	var alterTableSQL string
+	if e.dbFlavor == "mysql" {
+		alterTableSQL = `ALTER TABLE actual_lrps ADD COLUMN restart_instance_guid VARCHAR(255) NOT NULL DEFAULT '';`
+	} else {
+		alterTableSQL = `ALTER TABLE actual_lrps ADD COLUMN IF NOT EXISTS restart_instance_guid VARCHAR(255) NOT NULL DEFAULT '';`
+	}
+
+	logger.Info("altering the table", lager.Data{"query": alterTableSQL})
+	_, err := tx.Exec(alterTableSQL)
+	if err != nil && !isDuplicateColumnError(err) {
+		logger.Error("failed-altering-table", err)
+		return err
+	}
+	logger.Info("altered the table", lager.Data{"query": alterTableSQL})
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package db
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+//counterfeiter:generate . ScheduledTaskDB
+type ScheduledTaskDB interface {
+	ScheduledTasks(ctx context.Context, logger lager.Logger, domain string) ([]*models.ScheduledTask, error)
+	ScheduledTaskByGuid(ctx context.Context, logger lager.Logger, guid string) (*models.ScheduledTask, error)
+
+	DesireScheduledTask(ctx context.Context, logger lager.Logger, scheduledTask *models.ScheduledTask) (*models.ScheduledTask, error)
+	UpdateScheduledTask(ctx context.Context, logger lager.Logger, scheduledTask *models.ScheduledTask) (*models.ScheduledTask, error)
+	DeleteScheduledTask(ctx context.Context, logger lager.Logger, guid string) error
+
+	// RecordScheduledTaskRun advances the last scheduled time of the
+	// ScheduledTask and, unless taskGuid is empty because the run was skipped,
+	// adds the run to its history, trimming the history to its limit.
+	RecordScheduledTaskRun(ctx context.Context, logger lager.Logger, guid string, scheduledAt int64, taskGuid string) error
+}
@@ -0,0 +1,131 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+// ActualLRPCrashes returns the crash history of the ActualLRPs of the process
+// guid, ordered by index and from the latest crash. If index is not nil only
+// the crashes of that index are returned.
+func (db *SQLDB) ActualLRPCrashes(ctx context.Context, logger lager.Logger, processGuid string, index *int32) ([]*models.ActualLRPCrash, error) {
+	logger = logger.Session("db-actual-lrp-crashes", lager.Data{"process_guid": processGuid, "index": index})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	wheres := []string{"process_guid = ?"}
+	values := []interface{}{processGuid}
+	if index != nil {
+		wheres = append(wheres, "instance_index = ?")
+		values = append(values, *index)
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM %s\nWHERE %s\nORDER BY instance_index ASC, crashed_at DESC",
+		strings.Join(actualLRPCrashColumns, ", "), actualLRPCrashesTable, strings.Join(wheres, " AND "))
+
+	rows, err := db.db.QueryContext(ctx, db.helper.Rebind(query), values...)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return nil, db.convertSQLError(err)
+	}
+	defer rows.Close()
+
+	crashes := []*models.ActualLRPCrash{}
+	for rows.Next() {
+		crash := &models.ActualLRPCrash{}
+		err := rows.Scan(
+			&crash.ProcessGuid,
+			&crash.Index,
+			&crash.InstanceGuid,
+			&crash.CellId,
+			&crash.CrashReason,
+			&crash.CrashCount,
+			&crash.CrashedAt,
+		)
+		if err != nil {
+			logger.Error("failed-scanning-row", err)
+			return nil, db.convertSQLError(err)
+		}
+		crashes = append(crashes, crash)
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-getting-next-row", rows.Err())
+		return nil, db.convertSQLError(rows.Err())
+	}
+
+	return crashes, nil
+}
+
+// recordActualLRPCrash adds the crash to the crash history of the ActualLRP and
+// prunes the crashes of its index beyond the latest models.MaxActualLRPCrashes.
+// It must be called in the same transaction that crashes the ActualLRP.
+func (db *SQLDB) recordActualLRPCrash(ctx context.Context, logger lager.Logger, tx helpers.Tx, crash *models.ActualLRPCrash) error {
+	_, err := db.upsert(ctx, logger, tx, actualLRPCrashesTable,
+		helpers.SQLAttributes{
+			"process_guid":   crash.ProcessGuid,
+			"instance_index": crash.Index,
+			"instance_guid":  crash.InstanceGuid,
+			"cell_id":        crash.CellId,
+			"crash_reason":   truncateString(crash.CrashReason, 1024),
+			"crash_count":    crash.CrashCount,
+			"crashed_at":     crash.CrashedAt,
+		},
+		"process_guid = ? AND instance_index = ? AND crashed_at = ?", crash.ProcessGuid, crash.Index, crash.CrashedAt,
+	)
+	if err != nil {
+		logger.Error("failed-recording-crash", err)
+		return err
+	}
+
+	var oldestKept int64
+	query := fmt.Sprintf(`SELECT crashed_at FROM %s
+		WHERE process_guid = ? AND instance_index = ?
+		ORDER BY crashed_at DESC
+		LIMIT 1 OFFSET %d`, actualLRPCrashesTable, models.MaxActualLRPCrashes-1)
+	err = tx.QueryRowContext(ctx, db.helper.Rebind(query), crash.ProcessGuid, crash.Index).Scan(&oldestKept)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		logger.Error("failed-scanning-row", err)
+		return err
+	}
+
+	_, err = db.delete(ctx, logger, tx, actualLRPCrashesTable,
+		"process_guid = ? AND instance_index = ? AND crashed_at < ?", crash.ProcessGuid, crash.Index, oldestKept,
+	)
+	if err != nil {
+		logger.Error("failed-pruning-crashes", err)
+		return err
+	}
+
+	return nil
+}
+
+// PruneActualLRPCrashes deletes the crash history of ActualLRPs that no longer
+// exist.
+func (db *SQLDB) PruneActualLRPCrashes(ctx context.Context, logger lager.Logger) error {
+	logger = logger.Session("db-prune-actual-lrp-crashes")
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	_, err := db.delete(ctx, logger, db.db, actualLRPCrashesTable,
+		fmt.Sprintf(`NOT EXISTS (
+			SELECT 1 FROM %[1]s
+			WHERE %[1]s.process_guid = %[2]s.process_guid AND %[1]s.instance_index = %[2]s.instance_index
+		)`, actualLRPsTable, actualLRPCrashesTable),
+	)
+	if err != nil {
+		logger.Error("failed-deleting-orphaned-crashes", err)
+		return db.convertSQLError(err)
+	}
+
+	return nil
+}
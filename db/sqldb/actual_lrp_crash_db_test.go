@@ -0,0 +1,140 @@
+package sqldb_test
+
+import (
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/bbs/models"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ActualLRPCrashDB", func() {
+	var processGuid string
+
+	crash := func(index int32, reason string) {
+		key := models.NewActualLRPKey(processGuid, index, "some-domain")
+		instanceKey := models.NewActualLRPInstanceKey("instance-guid", "cell-id")
+		_, _, err := sqlDB.ClaimActualLRP(ctx, logger, processGuid, index, &instanceKey)
+		Expect(err).NotTo(HaveOccurred())
+		_, after, _, err := sqlDB.CrashActualLRP(ctx, logger, &key, &instanceKey, reason)
+		Expect(err).NotTo(HaveOccurred())
+		if after.State == models.ActualLRPStateCrashed {
+			_, _, err = sqlDB.UnclaimActualLRP(ctx, logger, &key)
+			Expect(err).NotTo(HaveOccurred())
+		}
+		fakeClock.Increment(time.Second)
+	}
+
+	crashTimes := func(crashes []*models.ActualLRPCrash) []int64 {
+		times := []int64{}
+		for _, crash := range crashes {
+			times = append(times, crash.CrashedAt)
+		}
+		return times
+	}
+
+	BeforeEach(func() {
+		processGuid = "some-process-guid"
+		for _, index := range []int32{0, 1} {
+			key := models.NewActualLRPKey(processGuid, index, "some-domain")
+			_, err := sqlDB.CreateUnclaimedActualLRP(ctx, logger, &key)
+			Expect(err).NotTo(HaveOccurred())
+		}
+	})
+
+	Describe("ActualLRPCrashes", func() {
+		It("records each crash of the ActualLRP, latest first", func() {
+			firstCrashAt := fakeClock.Now().UnixNano()
+			crash(1, "first")
+			secondCrashAt := fakeClock.Now().UnixNano()
+			crash(1, "second")
+
+			index := int32(1)
+			crashes, err := sqlDB.ActualLRPCrashes(ctx, logger, processGuid, &index)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(crashes).To(Equal([]*models.ActualLRPCrash{
+				{
+					ProcessGuid:  processGuid,
+					Index:        1,
+					InstanceGuid: "instance-guid",
+					CellId:       "cell-id",
+					CrashReason:  "second",
+					CrashCount:   2,
+					CrashedAt:    secondCrashAt,
+				},
+				{
+					ProcessGuid:  processGuid,
+					Index:        1,
+					InstanceGuid: "instance-guid",
+					CellId:       "cell-id",
+					CrashReason:  "first",
+					CrashCount:   1,
+					CrashedAt:    firstCrashAt,
+				},
+			}))
+		})
+
+		It("returns the crashes of all indices when no index is given", func() {
+			crash(1, "crash-1")
+			crash(0, "crash-0")
+
+			crashes, err := sqlDB.ActualLRPCrashes(ctx, logger, processGuid, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(crashes).To(HaveLen(2))
+			Expect(crashes[0].Index).To(BeEquivalentTo(0))
+			Expect(crashes[1].Index).To(BeEquivalentTo(1))
+		})
+
+		It("truncates crash reasons larger than 1K", func() {
+			crash(0, strings.Repeat("x", 2*1024))
+
+			crashes, err := sqlDB.ActualLRPCrashes(ctx, logger, processGuid, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(crashes).To(HaveLen(1))
+			Expect(crashes[0].CrashReason).To(HaveLen(1024))
+		})
+
+		It("returns no crashes for an ActualLRP that did not crash", func() {
+			crashes, err := sqlDB.ActualLRPCrashes(ctx, logger, "other-process-guid", nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(crashes).To(BeEmpty())
+		})
+	})
+
+	Describe("recording crashes", func() {
+		It("keeps the latest MaxActualLRPCrashes crashes of each index", func() {
+			crashedAt := []int64{}
+			for i := 0; i < models.MaxActualLRPCrashes+2; i++ {
+				crashedAt = append([]int64{fakeClock.Now().UnixNano()}, crashedAt...)
+				crash(0, "boom")
+			}
+			crash(1, "boom")
+
+			index := int32(0)
+			crashes, err := sqlDB.ActualLRPCrashes(ctx, logger, processGuid, &index)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(crashTimes(crashes)).To(Equal(crashedAt[:models.MaxActualLRPCrashes]))
+
+			index = 1
+			crashes, err = sqlDB.ActualLRPCrashes(ctx, logger, processGuid, &index)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(crashes).To(HaveLen(1))
+		})
+	})
+
+	Describe("PruneActualLRPCrashes", func() {
+		It("deletes the crashes of ActualLRPs that no longer exist", func() {
+			crash(0, "boom")
+			crash(1, "boom")
+
+			Expect(sqlDB.RemoveActualLRP(ctx, logger, processGuid, 1, nil)).To(Succeed())
+			Expect(sqlDB.PruneActualLRPCrashes(ctx, logger)).To(Succeed())
+
+			crashes, err := sqlDB.ActualLRPCrashes(ctx, logger, processGuid, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(crashes).To(HaveLen(1))
+			Expect(crashes[0].Index).To(BeEquivalentTo(0))
+		})
+	})
+})
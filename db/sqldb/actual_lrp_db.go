@@ -199,6 +199,8 @@ func (db *SQLDB) UnclaimActualLRP(ctx context.Context, logger lager.Logger, key
 		actualLRP.ActualLRPInstanceKey.InstanceGuid = ""
 		actualLRP.Since = now
 		actualLRP.ActualLRPNetInfo = models.ActualLRPNetInfo{}
+		actualLRP.NextRestartAt = 0
+		actualLRP.RestartsExhausted = false
 		netInfoData, err := db.serializeModel(logger, &models.ActualLRPNetInfo{})
 		if err != nil {
 			logger.Error("failed-to-serialize-net-info", err)
@@ -213,6 +215,9 @@ func (db *SQLDB) UnclaimActualLRP(ctx context.Context, logger lager.Logger, key
 				"modification_tag_index": actualLRP.ModificationTag.Index,
 				"since":                  actualLRP.Since,
 				"net_info":               netInfoData,
+				"next_restart_at":        actualLRP.NextRestartAt,
+				"restarts_exhausted":     actualLRP.RestartsExhausted,
+				"replaced_instance_guid": beforeActualLRP.InstanceGuid,
 			},
 			"process_guid = ? AND instance_index = ? AND presence = ?",
 			processGuid, index, models.ActualLRP_Ordinary,
@@ -253,12 +258,28 @@ func (db *SQLDB) ClaimActualLRP(ctx context.Context, logger lager.Logger, proces
 			return nil
 		}
 
+		cordoned, err := db.cellCordoned(ctx, logger, tx, instanceKey.CellId)
+		if err != nil {
+			return err
+		}
+		if cordoned {
+			logger.Info("cell-is-cordoned")
+			return models.ErrActualLRPCannotBeClaimed
+		}
+
+		revision, err := db.desiredLRPRevision(ctx, logger, tx, processGuid)
+		if err != nil {
+			logger.Error("failed-fetching-desired-lrp-revision", err)
+			return err
+		}
+
 		actualLRP.ModificationTag.Increment()
 		actualLRP.State = models.ActualLRPStateClaimed
 		actualLRP.ActualLRPInstanceKey = *instanceKey
 		actualLRP.PlacementError = ""
 		actualLRP.ActualLRPNetInfo = models.ActualLRPNetInfo{}
 		actualLRP.Since = db.clock.Now().UnixNano()
+		actualLRP.Revision = revision
 		netInfoData, err := db.serializeModel(logger, &models.ActualLRPNetInfo{})
 		if err != nil {
 			logger.Error("failed-to-serialize-net-info", err)
@@ -274,6 +295,7 @@ func (db *SQLDB) ClaimActualLRP(ctx context.Context, logger lager.Logger, proces
 				"placement_error":        actualLRP.PlacementError,
 				"since":                  actualLRP.Since,
 				"net_info":               netInfoData,
+				"revision":               actualLRP.Revision,
 			},
 			"process_guid = ? AND instance_index = ? AND presence = ?",
 			processGuid, index, models.ActualLRP_Ordinary,
@@ -311,6 +333,10 @@ func (db *SQLDB) StartActualLRP(
 		var err error
 		actualLRP, err = db.fetchActualLRPForUpdate(ctx, logger, key.ProcessGuid, key.Index, models.ActualLRP_Ordinary, tx)
 		if err == models.ErrResourceNotFound {
+			err = db.checkCellAcceptsInstances(ctx, logger, tx, instanceKey.CellId)
+			if err != nil {
+				return err
+			}
 			actualLRP, err = db.createRunningActualLRP(ctx, logger, key, instanceKey, netInfo, internalRoutes, metricTags, routable, availabilityZone, tx)
 			return err
 		}
@@ -339,6 +365,30 @@ func (db *SQLDB) StartActualLRP(
 			return models.ErrActualLRPCannotBeStarted
 		}
 
+		// instances claimed before the cell was cordoned may still start, but
+		// instances that were not placed on the cell, such as the ones a drain
+		// evacuated, must not register on it again
+		if !actualLRP.ActualLRPInstanceKey.Equal(instanceKey) {
+			err = db.checkCellAcceptsInstances(ctx, logger, tx, instanceKey.CellId)
+			if err != nil {
+				return err
+			}
+
+			// the instance that was unclaimed to be replaced keeps running
+			// until its replacement does, and must not take its place back
+			replacedInstanceGuid, err := db.replacedInstanceGuid(ctx, logger, tx, key)
+			if err != nil {
+				logger.Error("failed-fetching-replaced-instance-guid", err)
+				return err
+			}
+			if replacedInstanceGuid == instanceKey.InstanceGuid {
+				logger.Info("instance-is-being-replaced")
+				return models.ErrActualLRPCannotBeStarted
+			}
+		}
+
+		// instances that were not claimed first keep the revision they had, as
+		// they may be an instance from an older revision
 		now := db.clock.Now().UnixNano()
 
 		actualLRP.ActualLRPInstanceKey = *instanceKey
@@ -383,6 +433,8 @@ func (db *SQLDB) StartActualLRP(
 				"metric_tags":            metricTagsData,
 				"routable":               actualLRP.GetRoutable(),
 				"availability_zone":      actualLRP.AvailabilityZone,
+				"revision":               actualLRP.Revision,
+				"replaced_instance_guid": "",
 			},
 			"process_guid = ? AND instance_index = ? AND presence = ?",
 			key.ProcessGuid, key.Index, models.ActualLRP_Ordinary,
@@ -452,13 +504,20 @@ func (db *SQLDB) CrashActualLRP(ctx context.Context, logger lager.Logger, key *m
 			return err
 		}
 
-		if actualLRP.ShouldRestartImmediately(models.NewDefaultRestartCalculator()) {
+		restartCalculator, err := db.desiredLRPRestartCalculator(ctx, logger, tx, key.ProcessGuid)
+		if err != nil {
+			logger.Error("failed-to-fetch-restart-policy", err)
+			return err
+		}
+
+		if actualLRP.ShouldRestartImmediately(restartCalculator) {
 			actualLRP.State = models.ActualLRPStateUnclaimed
 			immediateRestart = true
 		}
 
 		now := db.clock.Now().UnixNano()
 		actualLRP.Since = now
+		actualLRP.ScheduleRestart(restartCalculator)
 
 		_, err = db.update(ctx, logger, tx, actualLRPsTable,
 			helpers.SQLAttributes{
@@ -470,6 +529,8 @@ func (db *SQLDB) CrashActualLRP(ctx context.Context, logger lager.Logger, key *m
 				"crash_reason":           truncateString(actualLRP.CrashReason, 1024),
 				"since":                  actualLRP.Since,
 				"net_info":               netInfoData,
+				"next_restart_at":        actualLRP.NextRestartAt,
+				"restarts_exhausted":     actualLRP.RestartsExhausted,
 			},
 			"process_guid = ? AND instance_index = ? AND presence = ?",
 			key.ProcessGuid, key.Index, models.ActualLRP_Ordinary,
@@ -479,7 +540,7 @@ func (db *SQLDB) CrashActualLRP(ctx context.Context, logger lager.Logger, key *m
 			return err
 		}
 
-		return nil
+		return db.recordActualLRPCrash(ctx, logger, tx, models.NewActualLRPCrash(&beforeActualLRP, actualLRP))
 	})
 
 	return &beforeActualLRP, actualLRP, immediateRestart, err
@@ -577,6 +638,12 @@ func (db *SQLDB) createRunningActualLRP(ctx context.Context, logger lager.Logger
 		return nil, models.ErrGUIDGeneration
 	}
 
+	revision, err := db.desiredLRPRevision(ctx, logger, tx, key.ProcessGuid)
+	if err != nil {
+		logger.Error("failed-fetching-desired-lrp-revision", err)
+		return nil, err
+	}
+
 	actualLRP := &models.ActualLRP{}
 	actualLRP.ModificationTag = models.NewModificationTag(guid, 0)
 	actualLRP.ActualLRPKey = *key
@@ -588,6 +655,7 @@ func (db *SQLDB) createRunningActualLRP(ctx context.Context, logger lager.Logger
 	actualLRP.Since = now
 	actualLRP.SetRoutable(routable)
 	actualLRP.AvailabilityZone = availabilityZone
+	actualLRP.Revision = revision
 
 	netInfoData, err := db.serializeModel(logger, &actualLRP.ActualLRPNetInfo)
 	if err != nil {
@@ -622,6 +690,7 @@ func (db *SQLDB) createRunningActualLRP(ctx context.Context, logger lager.Logger
 			"since":                  actualLRP.Since,
 			"modification_tag_epoch": actualLRP.ModificationTag.Epoch,
 			"modification_tag_index": actualLRP.ModificationTag.Index,
+			"revision":               actualLRP.Revision,
 		},
 	)
 	if err != nil {
@@ -657,6 +726,9 @@ func (db *SQLDB) scanToActualLRP(logger lager.Logger, row helpers.RowScanner) (*
 		&actualLRP.ModificationTag.Index,
 		&actualLRP.CrashCount,
 		&actualLRP.CrashReason,
+		&actualLRP.Revision,
+		&actualLRP.NextRestartAt,
+		&actualLRP.RestartsExhausted,
 	)
 	if err != nil {
 		logger.Error("failed-scanning-actual-lrp", err)
@@ -705,6 +777,52 @@ func (db *SQLDB) scanToActualLRP(logger lager.Logger, row helpers.RowScanner) (*
 	return &actualLRP, nil
 }
 
+// replacedInstanceGuid returns the guid of the instance that was last
+// unclaimed from the ordinary ActualLRP.
+func (db *SQLDB) replacedInstanceGuid(ctx context.Context, logger lager.Logger, q helpers.Queryable, key *models.ActualLRPKey) (string, error) {
+	var instanceGuid string
+	row := db.one(ctx, logger, q, actualLRPsTable,
+		helpers.ColumnList{"replaced_instance_guid"}, helpers.NoLockRow,
+		"process_guid = ? AND instance_index = ? AND presence = ?",
+		key.ProcessGuid, key.Index, models.ActualLRP_Ordinary,
+	)
+	err := row.Scan(&instanceGuid)
+	return instanceGuid, err
+}
+
+// desiredLRPRevision returns the revision of the DesiredLRP that its
+// instances start with, or 0 if it does not exist anymore.
+func (db *SQLDB) desiredLRPRevision(ctx context.Context, logger lager.Logger, q helpers.Queryable, processGuid string) (int32, error) {
+	var revision int32
+	row := db.one(ctx, logger, q, desiredLRPsTable,
+		helpers.ColumnList{"revision"}, helpers.NoLockRow,
+		"process_guid = ?", processGuid,
+	)
+	err := row.Scan(&revision)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	return revision, err
+}
+
+// desiredLRPRestartCalculator returns the calculator for the restart policy of
+// the DesiredLRP, or the default one if it has none or does not exist.
+func (db *SQLDB) desiredLRPRestartCalculator(ctx context.Context, logger lager.Logger, q helpers.Queryable, processGuid string) (models.RestartCalculator, error) {
+	var restartPolicy models.RestartPolicy
+	row := db.one(ctx, logger, q, desiredLRPsTable,
+		helpers.ColumnList{"restart_immediate_restarts", "restart_max_backoff_duration_ms", "restart_max_restarts"}, helpers.NoLockRow,
+		"process_guid = ?", processGuid,
+	)
+	err := row.Scan(&restartPolicy.ImmediateRestarts, &restartPolicy.MaxBackoffDurationMs, &restartPolicy.MaxRestarts)
+	if err == sql.ErrNoRows || (err == nil && restartPolicy == models.RestartPolicy{}) {
+		return models.NewDefaultRestartCalculator(), nil
+	}
+	if err != nil {
+		return models.RestartCalculator{}, err
+	}
+	return restartPolicy.RestartCalculator(), nil
+}
+
 func (db *SQLDB) fetchActualLRPForUpdate(ctx context.Context, logger lager.Logger, processGuid string, index int32, presence models.ActualLRP_Presence, tx helpers.Tx) (*models.ActualLRP, error) {
 	wheres := "process_guid = ? AND instance_index = ? AND presence = ?"
 	bindings := []interface{}{processGuid, index, presence}
@@ -656,6 +656,47 @@ var _ = Describe("ActualLRPDB", func() {
 					Expect(actualLRPs).To(ConsistOf(afterActualLRP))
 				})
 
+				Context("and the cell is cordoned", func() {
+					BeforeEach(func() {
+						Expect(sqlDB.CordonCell(ctx, logger, instanceKey.CellId)).To(Succeed())
+					})
+
+					It("returns an error and leaves the actual lrp unclaimed", func() {
+						_, _, err := sqlDB.ClaimActualLRP(ctx, logger, expectedActualLRP.ProcessGuid, expectedActualLRP.Index, instanceKey)
+						Expect(err).To(Equal(models.ErrActualLRPCannotBeClaimed))
+
+						actualLRPs, err := sqlDB.ActualLRPs(ctx, logger, models.ActualLRPFilter{ProcessGuid: expectedActualLRP.ProcessGuid, Index: &expectedActualLRP.Index})
+						Expect(err).NotTo(HaveOccurred())
+						Expect(actualLRPs).To(HaveLen(1))
+						Expect(actualLRPs[0].State).To(Equal(models.ActualLRPStateUnclaimed))
+					})
+				})
+
+				Context("and the desired lrp has a revision", func() {
+					BeforeEach(func() {
+						desiredLRP := model_helpers.NewValidDesiredLRP(expectedActualLRP.ProcessGuid)
+						Expect(sqlDB.DesireLRP(ctx, logger, desiredLRP)).To(Succeed())
+
+						queryStr := `UPDATE desired_lrps SET revision = ? WHERE process_guid = ?`
+						if test_helpers.UsePostgres() {
+							queryStr = test_helpers.ReplaceQuestionMarks(queryStr)
+						}
+						_, err := db.ExecContext(ctx, queryStr, 3, expectedActualLRP.ProcessGuid)
+						Expect(err).NotTo(HaveOccurred())
+					})
+
+					It("claims the actual lrp at that revision", func() {
+						_, afterActualLRP, err := sqlDB.ClaimActualLRP(ctx, logger, expectedActualLRP.ProcessGuid, expectedActualLRP.Index, instanceKey)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(afterActualLRP.Revision).To(BeEquivalentTo(3))
+
+						actualLRPs, err := sqlDB.ActualLRPs(ctx, logger, models.ActualLRPFilter{ProcessGuid: expectedActualLRP.ProcessGuid, Index: &expectedActualLRP.Index})
+						Expect(err).NotTo(HaveOccurred())
+						Expect(actualLRPs).To(HaveLen(1))
+						Expect(actualLRPs[0].Revision).To(BeEquivalentTo(3))
+					})
+				})
+
 				Context("and there is a placement error", func() {
 					BeforeEach(func() {
 						queryStr := `
@@ -1007,6 +1048,66 @@ var _ = Describe("ActualLRPDB", func() {
 					Expect(err).NotTo(HaveOccurred())
 					Expect(actualLRPs).To(ConsistOf(afterActualLRP))
 				})
+
+				Context("and the cell has been drained", func() {
+					BeforeEach(func() {
+						Expect(sqlDB.StartDrainingCell(ctx, logger, instanceKey.CellId)).To(Succeed())
+					})
+
+					It("does not let the evacuated instance register again on the cell", func() {
+						_, _, err := sqlDB.StartActualLRP(ctx, logger, &actualLRP.ActualLRPKey, instanceKey, netInfo, internalRoutes, metricTags, true, availabilityZone)
+						Expect(err).To(Equal(models.ErrActualLRPCannotBeStarted))
+
+						actualLRPs, err := sqlDB.ActualLRPs(ctx, logger, models.ActualLRPFilter{ProcessGuid: actualLRP.ProcessGuid, Index: &actualLRP.Index})
+						Expect(err).NotTo(HaveOccurred())
+						Expect(actualLRPs).To(HaveLen(1))
+						Expect(actualLRPs[0].State).To(Equal(models.ActualLRPStateUnclaimed))
+					})
+				})
+
+				Context("and the instance was evacuated to roll it over to a newer revision", func() {
+					BeforeEach(func() {
+						desiredLRP := model_helpers.NewValidDesiredLRP(actualLRP.ProcessGuid)
+						Expect(sqlDB.DesireLRP(ctx, logger, desiredLRP)).To(Succeed())
+
+						_, _, err := sqlDB.StartActualLRP(ctx, logger, &actualLRP.ActualLRPKey, instanceKey, netInfo, internalRoutes, metricTags, true, availabilityZone)
+						Expect(err).NotTo(HaveOccurred())
+
+						queryStr := `UPDATE desired_lrps SET revision = ? WHERE process_guid = ?`
+						if test_helpers.UsePostgres() {
+							queryStr = test_helpers.ReplaceQuestionMarks(queryStr)
+						}
+						_, err = db.ExecContext(ctx, queryStr, 1, actualLRP.ProcessGuid)
+						Expect(err).NotTo(HaveOccurred())
+
+						_, err = sqlDB.EvacuateActualLRP(ctx, logger, &actualLRP.ActualLRPKey, instanceKey, netInfo, internalRoutes, metricTags, true, availabilityZone)
+						Expect(err).NotTo(HaveOccurred())
+						_, _, err = sqlDB.UnclaimActualLRP(ctx, logger, &actualLRP.ActualLRPKey)
+						Expect(err).NotTo(HaveOccurred())
+					})
+
+					It("does not let the evacuated instance take its place back", func() {
+						_, _, err := sqlDB.StartActualLRP(ctx, logger, &actualLRP.ActualLRPKey, instanceKey, netInfo, internalRoutes, metricTags, true, availabilityZone)
+						Expect(err).To(Equal(models.ErrActualLRPCannotBeStarted))
+
+						actualLRPs, err := sqlDB.ActualLRPs(ctx, logger, models.ActualLRPFilter{ProcessGuid: actualLRP.ProcessGuid, Index: &actualLRP.Index})
+						Expect(err).NotTo(HaveOccurred())
+						Expect(actualLRPs).To(HaveLen(2))
+						for _, lrp := range actualLRPs {
+							if lrp.Presence == models.ActualLRP_Ordinary {
+								Expect(lrp.State).To(Equal(models.ActualLRPStateUnclaimed))
+							}
+						}
+					})
+
+					It("keeps the older revision when another instance starts without being claimed", func() {
+						otherInstanceKey := &models.ActualLRPInstanceKey{InstanceGuid: "other-instance-guid", CellId: "other-cell-id"}
+						_, afterActualLRP, err := sqlDB.StartActualLRP(ctx, logger, &actualLRP.ActualLRPKey, otherInstanceKey, netInfo, internalRoutes, metricTags, true, availabilityZone)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(afterActualLRP.State).To(Equal(models.ActualLRPStateRunning))
+						Expect(afterActualLRP.Revision).To(BeEquivalentTo(0))
+					})
+				})
 			})
 
 			Context("and the actual lrp has been CLAIMED", func() {
@@ -1016,6 +1117,18 @@ var _ = Describe("ActualLRPDB", func() {
 					fakeClock.Increment(time.Hour)
 				})
 
+				Context("and the cell is cordoned since", func() {
+					BeforeEach(func() {
+						Expect(sqlDB.CordonCell(ctx, logger, instanceKey.CellId)).To(Succeed())
+					})
+
+					It("still starts the claimed instance", func() {
+						_, afterActualLRP, err := sqlDB.StartActualLRP(ctx, logger, &actualLRP.ActualLRPKey, instanceKey, netInfo, internalRoutes, metricTags, true, availabilityZone)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(afterActualLRP.State).To(Equal(models.ActualLRPStateRunning))
+					})
+				})
+
 				It("transitions the state to RUNNING", func() {
 					_, _, err := sqlDB.StartActualLRP(ctx, logger, &actualLRP.ActualLRPKey, instanceKey, netInfo, internalRoutes, metricTags, true, availabilityZone)
 					Expect(err).NotTo(HaveOccurred())
@@ -1376,6 +1489,21 @@ var _ = Describe("ActualLRPDB", func() {
 				}
 			})
 
+			Context("and the cell is cordoned", func() {
+				BeforeEach(func() {
+					Expect(sqlDB.CordonCell(ctx, logger, instanceKey.CellId)).To(Succeed())
+				})
+
+				It("does not create the actual lrp", func() {
+					_, _, err := sqlDB.StartActualLRP(ctx, logger, &actualLRP.ActualLRPKey, instanceKey, netInfo, internalRoutes, metricTags, false, availabilityZone)
+					Expect(err).To(Equal(models.ErrActualLRPCannotBeStarted))
+
+					fetchedActualLRPs, err := sqlDB.ActualLRPs(ctx, logger, models.ActualLRPFilter{ProcessGuid: actualLRP.ProcessGuid, Index: &actualLRP.Index})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(fetchedActualLRPs).To(BeEmpty())
+				})
+			})
+
 			It("creates the actual lrp", func() {
 				beforeActualLRP, afterActualLRP, err := sqlDB.StartActualLRP(ctx, logger, &actualLRP.ActualLRPKey, instanceKey, netInfo, internalRoutes, metricTags, false, availabilityZone)
 				Expect(err).NotTo(HaveOccurred())
@@ -1554,6 +1682,45 @@ var _ = Describe("ActualLRPDB", func() {
 					})
 				})
 
+				Context("and the desired lrp has a restart policy", func() {
+					BeforeEach(func() {
+						desiredLRP := model_helpers.NewValidDesiredLRP(actualLRP.ProcessGuid)
+						desiredLRP.RestartPolicy = &models.RestartPolicy{ImmediateRestarts: 1, MaxBackoffDurationMs: 60000, MaxRestarts: 10}
+						Expect(sqlDB.DesireLRP(ctx, logger, desiredLRP)).To(Succeed())
+					})
+
+					It("restarts the lrp according to the policy", func() {
+						_, afterActualLRP, shouldRestart, err := sqlDB.CrashActualLRP(ctx, logger, &actualLRP.ActualLRPKey, instanceKey, "because it didn't go well")
+						Expect(err).NotTo(HaveOccurred())
+						Expect(shouldRestart).To(BeFalse())
+						Expect(afterActualLRP.State).To(Equal(models.ActualLRPStateCrashed))
+						Expect(afterActualLRP.CrashCount).To(BeEquivalentTo(1))
+						Expect(afterActualLRP.NextRestartAt).To(Equal(fakeClock.Now().Add(30 * time.Second).UnixNano()))
+						Expect(afterActualLRP.RestartsExhausted).To(BeFalse())
+					})
+				})
+
+				Context("and it has exhausted its restarts", func() {
+					BeforeEach(func() {
+						desiredLRP := model_helpers.NewValidDesiredLRP(actualLRP.ProcessGuid)
+						desiredLRP.RestartPolicy = &models.RestartPolicy{ImmediateRestarts: 0, MaxBackoffDurationMs: 60000, MaxRestarts: 1}
+						Expect(sqlDB.DesireLRP(ctx, logger, desiredLRP)).To(Succeed())
+					})
+
+					It("records that it will not be restarted anymore", func() {
+						_, afterActualLRP, shouldRestart, err := sqlDB.CrashActualLRP(ctx, logger, &actualLRP.ActualLRPKey, instanceKey, "because it didn't go well")
+						Expect(err).NotTo(HaveOccurred())
+						Expect(shouldRestart).To(BeFalse())
+						Expect(afterActualLRP.State).To(Equal(models.ActualLRPStateCrashed))
+						Expect(afterActualLRP.NextRestartAt).To(BeZero())
+						Expect(afterActualLRP.RestartsExhausted).To(BeTrue())
+
+						actualLRPs, err := sqlDB.ActualLRPs(ctx, logger, models.ActualLRPFilter{ProcessGuid: actualLRP.ProcessGuid, Index: &actualLRP.Index})
+						Expect(err).NotTo(HaveOccurred())
+						Expect(actualLRPs).To(ConsistOf(afterActualLRP))
+					})
+				})
+
 				Context("and it should NOT be restarted", func() {
 					BeforeEach(func() {
 						queryStr := `
@@ -1584,6 +1751,7 @@ var _ = Describe("ActualLRPDB", func() {
 						expectedActualLRP.CrashReason = "because it didn't go well"
 						expectedActualLRP.ModificationTag.Increment()
 						expectedActualLRP.Since = fakeClock.Now().UnixNano()
+						expectedActualLRP.NextRestartAt = fakeClock.Now().Add(2 * time.Minute).UnixNano()
 						expectedActualLRP.ActualLrpInternalRoutes = internalRoutes
 						expectedActualLRP.MetricTags = metricTags
 						expectedActualLRP.SetRoutable(false)
@@ -1718,6 +1886,7 @@ var _ = Describe("ActualLRPDB", func() {
 						expectedActualLRP.CrashReason = "some other failure reason"
 						expectedActualLRP.ModificationTag.Increment()
 						expectedActualLRP.Since = fakeClock.Now().UnixNano()
+						expectedActualLRP.NextRestartAt = fakeClock.Now().Add(4 * time.Minute).UnixNano()
 						expectedActualLRP.ActualLrpInternalRoutes = []*models.ActualLRPInternalRoute{}
 						expectedActualLRP.MetricTags = map[string]string{}
 						expectedActualLRP.SetRoutable(false)
@@ -2158,6 +2327,43 @@ var _ = Describe("ActualLRPDB", func() {
 				})
 			})
 
+			Context("When the actual LRP is crashed", func() {
+				BeforeEach(func() {
+					actualLRP = &models.ActualLRP{
+						ActualLRPKey: *actualLRPKey,
+					}
+
+					_, err := sqlDB.CreateUnclaimedActualLRP(ctx, logger, &actualLRP.ActualLRPKey)
+					Expect(err).NotTo(HaveOccurred())
+
+					queryStr := `
+						UPDATE actual_lrps SET state = ?, next_restart_at = ?, restarts_exhausted = ?
+						WHERE process_guid = ? AND instance_index = ?`
+					if test_helpers.UsePostgres() {
+						queryStr = test_helpers.ReplaceQuestionMarks(queryStr)
+					}
+					_, err = db.ExecContext(ctx, queryStr,
+						models.ActualLRPStateCrashed,
+						fakeClock.Now().UnixNano(),
+						true,
+						guid,
+						index,
+					)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("clears its restart state", func() {
+					_, afterActualLRP, err := sqlDB.UnclaimActualLRP(ctx, logger, actualLRPKey)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(afterActualLRP.NextRestartAt).To(BeZero())
+					Expect(afterActualLRP.RestartsExhausted).To(BeFalse())
+
+					actualLRPs, err := sqlDB.ActualLRPs(ctx, logger, models.ActualLRPFilter{ProcessGuid: guid, Index: &index})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(actualLRPs).To(ConsistOf(afterActualLRP))
+				})
+			})
+
 			Context("When the actual LRP is unclaimed", func() {
 				BeforeEach(func() {
 					actualLRP = &models.ActualLRP{
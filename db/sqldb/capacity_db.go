@@ -0,0 +1,89 @@
+package sqldb
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func (db *SQLDB) CellReservations(ctx context.Context, logger lager.Logger) (map[string]*models.CellReservation, error) {
+	logger = logger.Session("db-cell-reservations")
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	reservations := map[string]*models.CellReservation{}
+	reservationFor := func(cellID string) *models.CellReservation {
+		reservation, ok := reservations[cellID]
+		if !ok {
+			reservation = &models.CellReservation{CellId: cellID}
+			reservations[cellID] = reservation
+		}
+		return reservation
+	}
+
+	rows, err := db.selectLRPCellReservations(ctx, logger, db.db)
+	if err != nil {
+		logger.Error("failed-query-lrps", err)
+		return nil, db.convertSQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var cellID string
+		var memoryMB, diskMB int64
+		var containers int32
+		err := rows.Scan(&cellID, &memoryMB, &diskMB, &containers)
+		if err != nil {
+			logger.Error("failed-scanning-row", err)
+			return nil, db.convertSQLError(err)
+		}
+
+		reservation := reservationFor(cellID)
+		reservation.MemoryMb += memoryMB
+		reservation.DiskMb += diskMB
+		reservation.Containers += containers
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-getting-next-row", rows.Err())
+		return nil, db.convertSQLError(rows.Err())
+	}
+
+	taskRows, err := db.all(ctx, logger, db.db, tasksTable,
+		helpers.ColumnList{"cell_id", "task_definition"}, helpers.NoLockRow,
+		"state = ? AND cell_id <> ''", models.Task_Running,
+	)
+	if err != nil {
+		logger.Error("failed-query-tasks", err)
+		return nil, db.convertSQLError(err)
+	}
+	defer taskRows.Close()
+
+	for taskRows.Next() {
+		var cellID string
+		var taskDefData []byte
+		err := taskRows.Scan(&cellID, &taskDefData)
+		if err != nil {
+			logger.Error("failed-scanning-row", err)
+			return nil, db.convertSQLError(err)
+		}
+
+		var taskDef models.TaskDefinition
+		err = db.deserializeModel(logger, taskDefData, &taskDef)
+		if err != nil {
+			logger.Error("failed-deserializing-task-definition", err, lager.Data{"cell_id": cellID})
+			continue
+		}
+
+		reservationFor(cellID).Add(int64(taskDef.MemoryMb), int64(taskDef.DiskMb))
+	}
+
+	if taskRows.Err() != nil {
+		logger.Error("failed-getting-next-row", taskRows.Err())
+		return nil, db.convertSQLError(taskRows.Err())
+	}
+
+	return reservations, nil
+}
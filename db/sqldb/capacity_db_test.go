@@ -0,0 +1,76 @@
+package sqldb_test
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CapacityDB", func() {
+	Describe("CellReservations", func() {
+		startLRP := func(guid string, index int32, cellID string) *models.ActualLRP {
+			actualLRP := model_helpers.NewValidActualLRP(guid, index)
+			actualLRP.ActualLRPInstanceKey = models.NewActualLRPInstanceKey("instance-"+guid, cellID)
+
+			_, err := sqlDB.CreateUnclaimedActualLRP(ctx, logger, &actualLRP.ActualLRPKey)
+			Expect(err).NotTo(HaveOccurred())
+			_, _, err = sqlDB.ClaimActualLRP(ctx, logger, guid, index, &actualLRP.ActualLRPInstanceKey)
+			Expect(err).NotTo(HaveOccurred())
+			_, _, err = sqlDB.StartActualLRP(ctx, logger, &actualLRP.ActualLRPKey, &actualLRP.ActualLRPInstanceKey, &actualLRP.ActualLRPNetInfo, model_helpers.NewActualLRPInternalRoutes(), model_helpers.NewActualLRPMetricTags(), true, actualLRP.AvailabilityZone)
+			Expect(err).NotTo(HaveOccurred())
+			return actualLRP
+		}
+
+		BeforeEach(func() {
+			desiredLRP := model_helpers.NewValidDesiredLRP("guid-1")
+			desiredLRP.MemoryMb = 1024
+			desiredLRP.DiskMb = 512
+			Expect(sqlDB.DesireLRP(ctx, logger, desiredLRP)).To(Succeed())
+
+			startLRP("guid-1", 0, "cell-1")
+			startLRP("guid-1", 1, "cell-1")
+			evacuatingLRP := startLRP("guid-1", 2, "cell-2")
+			_, err := sqlDB.EvacuateActualLRP(ctx, logger, &evacuatingLRP.ActualLRPKey, &evacuatingLRP.ActualLRPInstanceKey, &evacuatingLRP.ActualLRPNetInfo, model_helpers.NewActualLRPInternalRoutes(), model_helpers.NewActualLRPMetricTags(), true, evacuatingLRP.AvailabilityZone)
+			Expect(err).NotTo(HaveOccurred())
+			_, _, err = sqlDB.UnclaimActualLRP(ctx, logger, &evacuatingLRP.ActualLRPKey)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = sqlDB.CreateUnclaimedActualLRP(ctx, logger, &models.ActualLRPKey{ProcessGuid: "guid-1", Index: 3, Domain: desiredLRP.Domain})
+			Expect(err).NotTo(HaveOccurred())
+
+			taskDef := model_helpers.NewValidTaskDefinition()
+			taskDef.MemoryMb = 256
+			taskDef.DiskMb = 128
+			_, err = sqlDB.DesireTask(ctx, logger, taskDef, "task-1", "some-domain")
+			Expect(err).NotTo(HaveOccurred())
+			_, _, _, err = sqlDB.StartTask(ctx, logger, "task-1", "cell-2")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = sqlDB.DesireTask(ctx, logger, taskDef, "task-2", "some-domain")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("sums the resources of the claimed and running instances and the running tasks on each cell", func() {
+			reservations, err := sqlDB.CellReservations(ctx, logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reservations).To(Equal(map[string]*models.CellReservation{
+				"cell-1": {CellId: "cell-1", MemoryMb: 2048, DiskMb: 1024, Containers: 2},
+				"cell-2": {CellId: "cell-2", MemoryMb: 1280, DiskMb: 640, Containers: 2},
+			}))
+		})
+
+		It("includes the resources of the sidecars", func() {
+			desiredLRP := model_helpers.NewValidDesiredLRP("guid-2")
+			desiredLRP.MemoryMb = 128
+			desiredLRP.DiskMb = 256
+			desiredLRP.Sidecars = []*models.Sidecar{{Action: model_helpers.NewValidAction(), MemoryMb: 64, DiskMb: 32}}
+			Expect(sqlDB.DesireLRP(ctx, logger, desiredLRP)).To(Succeed())
+			startLRP("guid-2", 0, "cell-3")
+
+			reservations, err := sqlDB.CellReservations(ctx, logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reservations).To(HaveKeyWithValue("cell-3", &models.CellReservation{CellId: "cell-3", MemoryMb: 192, DiskMb: 288, Containers: 1}))
+		})
+	})
+})
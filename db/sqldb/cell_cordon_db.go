@@ -0,0 +1,232 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func (db *SQLDB) CordonCell(ctx context.Context, logger lager.Logger, cellID string) error {
+	logger = logger.Session("db-cordon-cell", lager.Data{"cell_id": cellID})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	return db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		_, err := db.lockCordonedCell(ctx, logger, tx, cellID)
+		switch err {
+		case nil:
+			return nil
+		case sql.ErrNoRows:
+		default:
+			return err
+		}
+
+		_, err = db.insert(ctx, logger, tx, cordonedCellsTable, helpers.SQLAttributes{
+			"cell_id":     cellID,
+			"cordoned_at": db.clock.Now().UnixNano(),
+		})
+		if err != nil {
+			logger.Error("failed-cordoning-cell", err)
+			return err
+		}
+
+		return nil
+	})
+}
+
+func (db *SQLDB) UncordonCell(ctx context.Context, logger lager.Logger, cellID string) error {
+	logger = logger.Session("db-uncordon-cell", lager.Data{"cell_id": cellID})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	result, err := db.delete(ctx, logger, db.db, cordonedCellsTable, "cell_id = ?", cellID)
+	if err != nil {
+		logger.Error("failed-uncordoning-cell", err)
+		return db.convertSQLError(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Error("failed-rows-affected", err)
+		return db.convertSQLError(err)
+	}
+
+	if rowsAffected == 0 {
+		return models.ErrResourceNotFound
+	}
+
+	return nil
+}
+
+func (db *SQLDB) CordonedCells(ctx context.Context, logger lager.Logger) ([]*models.CordonedCell, error) {
+	logger = logger.Session("db-cordoned-cells")
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	rows, err := db.all(ctx, logger, db.db, cordonedCellsTable,
+		cordonedCellColumns, helpers.NoLockRow,
+		"",
+	)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return nil, db.convertSQLError(err)
+	}
+	defer rows.Close()
+
+	cells := []*models.CordonedCell{}
+	for rows.Next() {
+		cell, err := db.fetchCordonedCell(logger, rows)
+		if err != nil {
+			return nil, db.convertSQLError(err)
+		}
+		cells = append(cells, cell)
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-getting-next-row", rows.Err())
+		return nil, db.convertSQLError(rows.Err())
+	}
+
+	return cells, nil
+}
+
+func (db *SQLDB) StartDrainingCell(ctx context.Context, logger lager.Logger, cellID string) error {
+	logger = logger.Session("db-start-draining-cell", lager.Data{"cell_id": cellID})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	return db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		now := db.clock.Now().UnixNano()
+
+		cell, err := db.lockCordonedCell(ctx, logger, tx, cellID)
+		switch err {
+		case nil:
+			if cell.DrainStartedAt != 0 {
+				return nil
+			}
+			_, err = db.update(ctx, logger, tx, cordonedCellsTable,
+				helpers.SQLAttributes{"drain_started_at": now},
+				"cell_id = ?", cellID,
+			)
+		case sql.ErrNoRows:
+			_, err = db.insert(ctx, logger, tx, cordonedCellsTable, helpers.SQLAttributes{
+				"cell_id":          cellID,
+				"cordoned_at":      now,
+				"drain_started_at": now,
+			})
+		}
+		if err != nil {
+			logger.Error("failed-starting-drain", err)
+			return err
+		}
+
+		return nil
+	})
+}
+
+func (db *SQLDB) CellDrainStatus(ctx context.Context, logger lager.Logger, cellID string) (*models.CellDrainStatus, error) {
+	logger = logger.Session("db-cell-drain-status", lager.Data{"cell_id": cellID})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	status := &models.CellDrainStatus{CellId: cellID}
+
+	row := db.one(ctx, logger, db.db, cordonedCellsTable,
+		cordonedCellColumns, helpers.NoLockRow,
+		"cell_id = ?", cellID,
+	)
+	cell, err := db.fetchCordonedCell(logger, row)
+	switch err {
+	case nil:
+		status.Cordoned = true
+		status.Draining = cell.DrainStartedAt != 0
+		status.DrainStartedAt = cell.DrainStartedAt
+	case sql.ErrNoRows:
+	default:
+		return nil, db.convertSQLError(err)
+	}
+
+	instances, err := db.helper.Count(ctx, logger, db.db, actualLRPsTable, "cell_id = ?", cellID)
+	if err != nil {
+		logger.Error("failed-counting-instances", err)
+		return nil, db.convertSQLError(err)
+	}
+	status.InstancesRemaining = int32(instances)
+
+	evacuating, err := db.helper.Count(ctx, logger, db.db, actualLRPsTable,
+		"cell_id = ? AND presence = ?", cellID, models.ActualLRP_Evacuating,
+	)
+	if err != nil {
+		logger.Error("failed-counting-evacuating-instances", err)
+		return nil, db.convertSQLError(err)
+	}
+	status.EvacuatingInstances = int32(evacuating)
+
+	return status, nil
+}
+
+func (db *SQLDB) lockCordonedCell(ctx context.Context, logger lager.Logger, tx helpers.Tx, cellID string) (*models.CordonedCell, error) {
+	row := db.one(ctx, logger, tx, cordonedCellsTable,
+		cordonedCellColumns, helpers.LockRow,
+		"cell_id = ?", cellID,
+	)
+	return db.fetchCordonedCell(logger, row)
+}
+
+// cellCordoned returns whether new work must not be placed on the cell. The
+// auctioneer does not know about cordons, so claims and starts on cordoned
+// cells are refused instead. Refused tasks are auctioned again straight away,
+// and refused ActualLRPs stay unclaimed until convergence auctions them.
+func (db *SQLDB) cellCordoned(ctx context.Context, logger lager.Logger, q helpers.Queryable, cellID string) (bool, error) {
+	row := db.one(ctx, logger, q, cordonedCellsTable,
+		cordonedCellColumns, helpers.NoLockRow,
+		"cell_id = ?", cellID,
+	)
+	_, err := db.fetchCordonedCell(logger, row)
+	switch err {
+	case nil:
+		return true, nil
+	case sql.ErrNoRows:
+		return false, nil
+	default:
+		logger.Error("failed-fetching-cordoned-cell", err)
+		return false, err
+	}
+}
+
+// checkCellAcceptsInstances returns ErrActualLRPCannotBeStarted if instances
+// must not start on the cell because it is cordoned.
+func (db *SQLDB) checkCellAcceptsInstances(ctx context.Context, logger lager.Logger, q helpers.Queryable, cellID string) error {
+	cordoned, err := db.cellCordoned(ctx, logger, q, cellID)
+	if err != nil {
+		return err
+	}
+	if cordoned {
+		logger.Info("cell-is-cordoned")
+		return models.ErrActualLRPCannotBeStarted
+	}
+	return nil
+}
+
+func (db *SQLDB) fetchCordonedCell(logger lager.Logger, scanner helpers.RowScanner) (*models.CordonedCell, error) {
+	cell := &models.CordonedCell{}
+
+	err := scanner.Scan(
+		&cell.CellId,
+		&cell.CordonedAt,
+		&cell.DrainStartedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, err
+	}
+
+	if err != nil {
+		logger.Error("failed-scanning-row", err)
+		return nil, err
+	}
+
+	return cell, nil
+}
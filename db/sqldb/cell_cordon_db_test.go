@@ -0,0 +1,146 @@
+package sqldb_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CellCordonDB", func() {
+	Describe("CordonCell", func() {
+		It("cordons the cell", func() {
+			Expect(sqlDB.CordonCell(ctx, logger, "cell-1")).To(Succeed())
+
+			cells, err := sqlDB.CordonedCells(ctx, logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cells).To(ConsistOf(&models.CordonedCell{
+				CellId:     "cell-1",
+				CordonedAt: fakeClock.Now().UnixNano(),
+			}))
+		})
+
+		It("keeps the original cordon time when the cell is cordoned again", func() {
+			cordonedAt := fakeClock.Now().UnixNano()
+			Expect(sqlDB.CordonCell(ctx, logger, "cell-1")).To(Succeed())
+			fakeClock.Increment(time.Minute)
+			Expect(sqlDB.CordonCell(ctx, logger, "cell-1")).To(Succeed())
+
+			cells, err := sqlDB.CordonedCells(ctx, logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cells).To(HaveLen(1))
+			Expect(cells[0].CordonedAt).To(Equal(cordonedAt))
+		})
+	})
+
+	Describe("UncordonCell", func() {
+		BeforeEach(func() {
+			Expect(sqlDB.StartDrainingCell(ctx, logger, "cell-1")).To(Succeed())
+		})
+
+		It("removes the cordon and the drain of the cell", func() {
+			Expect(sqlDB.UncordonCell(ctx, logger, "cell-1")).To(Succeed())
+
+			cells, err := sqlDB.CordonedCells(ctx, logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cells).To(BeEmpty())
+
+			status, err := sqlDB.CellDrainStatus(ctx, logger, "cell-1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.Cordoned).To(BeFalse())
+			Expect(status.Draining).To(BeFalse())
+		})
+
+		Context("when the cell is not cordoned", func() {
+			It("returns a resource not found error", func() {
+				err := sqlDB.UncordonCell(ctx, logger, "cell-2")
+				Expect(err).To(Equal(models.ErrResourceNotFound))
+			})
+		})
+	})
+
+	Describe("StartDrainingCell", func() {
+		It("cordons the cell and records when its drain started", func() {
+			Expect(sqlDB.StartDrainingCell(ctx, logger, "cell-1")).To(Succeed())
+
+			cells, err := sqlDB.CordonedCells(ctx, logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cells).To(ConsistOf(&models.CordonedCell{
+				CellId:         "cell-1",
+				CordonedAt:     fakeClock.Now().UnixNano(),
+				DrainStartedAt: fakeClock.Now().UnixNano(),
+			}))
+		})
+
+		It("keeps the cordon time of a cordoned cell and the start of a running drain", func() {
+			cordonedAt := fakeClock.Now().UnixNano()
+			Expect(sqlDB.CordonCell(ctx, logger, "cell-1")).To(Succeed())
+
+			fakeClock.Increment(time.Minute)
+			drainStartedAt := fakeClock.Now().UnixNano()
+			Expect(sqlDB.StartDrainingCell(ctx, logger, "cell-1")).To(Succeed())
+
+			fakeClock.Increment(time.Minute)
+			Expect(sqlDB.StartDrainingCell(ctx, logger, "cell-1")).To(Succeed())
+
+			cells, err := sqlDB.CordonedCells(ctx, logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cells).To(ConsistOf(&models.CordonedCell{
+				CellId:         "cell-1",
+				CordonedAt:     cordonedAt,
+				DrainStartedAt: drainStartedAt,
+			}))
+		})
+	})
+
+	Describe("CellDrainStatus", func() {
+		BeforeEach(func() {
+			for i, guid := range []string{"guid-1", "guid-2", "guid-3"} {
+				actualLRP := model_helpers.NewValidActualLRP(guid, 0)
+				cellID := "cell-1"
+				if i == 2 {
+					cellID = "cell-2"
+				}
+				actualLRP.ActualLRPInstanceKey = models.NewActualLRPInstanceKey("instance-"+guid, cellID)
+
+				_, err := sqlDB.CreateUnclaimedActualLRP(ctx, logger, &actualLRP.ActualLRPKey)
+				Expect(err).NotTo(HaveOccurred())
+				_, _, err = sqlDB.ClaimActualLRP(ctx, logger, guid, 0, &actualLRP.ActualLRPInstanceKey)
+				Expect(err).NotTo(HaveOccurred())
+				_, _, err = sqlDB.StartActualLRP(ctx, logger, &actualLRP.ActualLRPKey, &actualLRP.ActualLRPInstanceKey, &actualLRP.ActualLRPNetInfo, model_helpers.NewActualLRPInternalRoutes(), model_helpers.NewActualLRPMetricTags(), true, actualLRP.AvailabilityZone)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			actualLRP := model_helpers.NewValidActualLRP("guid-1", 0)
+			actualLRP.ActualLRPInstanceKey = models.NewActualLRPInstanceKey("instance-guid-1", "cell-1")
+			_, err := sqlDB.EvacuateActualLRP(ctx, logger, &actualLRP.ActualLRPKey, &actualLRP.ActualLRPInstanceKey, &actualLRP.ActualLRPNetInfo, model_helpers.NewActualLRPInternalRoutes(), model_helpers.NewActualLRPMetricTags(), true, actualLRP.AvailabilityZone)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns the instances that remain on a draining cell", func() {
+			Expect(sqlDB.StartDrainingCell(ctx, logger, "cell-1")).To(Succeed())
+
+			status, err := sqlDB.CellDrainStatus(ctx, logger, "cell-1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status).To(Equal(&models.CellDrainStatus{
+				CellId:              "cell-1",
+				Cordoned:            true,
+				Draining:            true,
+				DrainStartedAt:      fakeClock.Now().UnixNano(),
+				InstancesRemaining:  3,
+				EvacuatingInstances: 1,
+			}))
+		})
+
+		It("returns the instances on a cell that is not cordoned", func() {
+			status, err := sqlDB.CellDrainStatus(ctx, logger, "cell-2")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status).To(Equal(&models.CellDrainStatus{
+				CellId:             "cell-2",
+				InstancesRemaining: 1,
+			}))
+		})
+	})
+})
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
 	"code.cloudfoundry.org/bbs/models"
@@ -16,7 +17,16 @@ func (db *SQLDB) DesireLRP(ctx context.Context, logger lager.Logger, desiredLRP
 	logger.Info("starting")
 	defer logger.Info("complete")
 
+	sidecarMemoryMb, sidecarDiskMb := models.SidecarResources(desiredLRP.Sidecars)
+
 	return db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		err := db.checkDomainQuota(ctx, logger, tx, desiredLRP.Domain,
+			models.DesiredLRPUsage(desiredLRP.Instances, desiredLRP.MemoryMb+sidecarMemoryMb, desiredLRP.DiskMb+sidecarDiskMb),
+		)
+		if err != nil {
+			return err
+		}
+
 		routesData, err := db.encodeRouteData(logger, desiredLRP.Routes)
 		if err != nil {
 			logger.Error("failed-encoding-route-data", err)
@@ -37,13 +47,7 @@ func (db *SQLDB) DesireLRP(ctx context.Context, logger lager.Logger, desiredLRP
 			return err
 		}
 
-		volumePlacement := &models.VolumePlacement{}
-		volumePlacement.DriverNames = []string{}
-		for _, mount := range desiredLRP.VolumeMounts {
-			volumePlacement.DriverNames = append(volumePlacement.DriverNames, mount.Driver)
-		}
-
-		volumePlacementData, err := db.serializeModel(logger, volumePlacement)
+		volumePlacementData, err := db.serializeModel(logger, newVolumePlacement(desiredLRP.VolumeMounts))
 		if err != nil {
 			logger.Error("failed-to-serialize-model", err)
 			return err
@@ -62,32 +66,44 @@ func (db *SQLDB) DesireLRP(ctx context.Context, logger lager.Logger, desiredLRP
 		}
 
 		desiredLRP.ModificationTag = &models.ModificationTag{Epoch: guid, Index: 0}
+		desiredLRP.Revision = 0
 
 		_, err = db.insert(ctx, logger, tx, desiredLRPsTable,
 			helpers.SQLAttributes{
-				"process_guid":           desiredLRP.ProcessGuid,
-				"domain":                 desiredLRP.Domain,
-				"log_guid":               desiredLRP.LogGuid,
-				"annotation":             desiredLRP.Annotation,
-				"instances":              desiredLRP.Instances,
-				"memory_mb":              desiredLRP.MemoryMb,
-				"disk_mb":                desiredLRP.DiskMb,
-				"max_pids":               desiredLRP.MaxPids,
-				"rootfs":                 desiredLRP.RootFs,
-				"volume_placement":       volumePlacementData,
-				"modification_tag_epoch": desiredLRP.ModificationTag.Epoch,
-				"modification_tag_index": desiredLRP.ModificationTag.Index,
-				"routes":                 routesData,
-				"run_info":               runInfoData,
-				"placement_tags":         placementTagData,
-				"metric_tags":            metricTagsData,
+				"process_guid":                    desiredLRP.ProcessGuid,
+				"domain":                          desiredLRP.Domain,
+				"log_guid":                        desiredLRP.LogGuid,
+				"annotation":                      desiredLRP.Annotation,
+				"instances":                       desiredLRP.Instances,
+				"memory_mb":                       desiredLRP.MemoryMb,
+				"disk_mb":                         desiredLRP.DiskMb,
+				"max_pids":                        desiredLRP.MaxPids,
+				"rootfs":                          desiredLRP.RootFs,
+				"volume_placement":                volumePlacementData,
+				"modification_tag_epoch":          desiredLRP.ModificationTag.Epoch,
+				"modification_tag_index":          desiredLRP.ModificationTag.Index,
+				"routes":                          routesData,
+				"run_info":                        runInfoData,
+				"placement_tags":                  placementTagData,
+				"metric_tags":                     metricTagsData,
+				"revision":                        desiredLRP.Revision,
+				"rollout_max_surge":               desiredLRP.RolloutStrategy.GetMaxSurge(),
+				"rollout_max_unavailable":         desiredLRP.RolloutStrategy.GetMaxUnavailable(),
+				"restart_immediate_restarts":      desiredLRP.RestartPolicy.GetImmediateRestarts(),
+				"restart_max_backoff_duration_ms": desiredLRP.RestartPolicy.GetMaxBackoffDurationMs(),
+				"restart_max_restarts":            desiredLRP.RestartPolicy.GetMaxRestarts(),
+				"zone_spread_max_skew":            desiredLRP.ZoneSpreadConstraint.GetMaxSkew(),
+				"paused":                          desiredLRP.Paused,
+				"sidecar_memory_mb":               sidecarMemoryMb,
+				"sidecar_disk_mb":                 sidecarDiskMb,
 			},
 		)
 		if err != nil {
 			logger.Error("failed-inserting-desired", err)
 			return err
 		}
-		return nil
+
+		return db.recordDesiredLRPRevision(ctx, logger, tx, desiredLRP, models.DesiredLRPRevisionSourceDesire)
 	})
 }
 
@@ -281,6 +297,56 @@ func (db *SQLDB) DesiredLRPRoutingInfos(ctx context.Context, logger lager.Logger
 	return results, err
 }
 
+func (db *SQLDB) DesiredLRPStatuses(ctx context.Context, logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRPStatus, error) {
+	logger = logger.Session("db-desired-lrp-statuses", lager.Data{"filter": filter})
+	logger.Debug("start")
+	defer logger.Debug("complete")
+
+	rows, err := db.selectDesiredLRPStatuses(ctx, logger, db.db, filter)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return nil, db.convertSQLError(err)
+	}
+	defer rows.Close()
+
+	statuses := []*models.DesiredLRPStatus{}
+	for rows.Next() {
+		status := &models.DesiredLRPStatus{}
+		var crashReasons, placementErrors sql.NullString
+
+		err := rows.Scan(
+			&status.ProcessGuid,
+			&status.Domain,
+			&status.DesiredInstances,
+			&status.Paused,
+			&status.UnclaimedInstances,
+			&status.ClaimedInstances,
+			&status.RunningInstances,
+			&status.CrashedInstances,
+			&status.EvacuatingInstances,
+			&status.SuspectInstances,
+			&crashReasons,
+			&placementErrors,
+		)
+		if err != nil {
+			logger.Error("failed-scanning-row", err)
+			return nil, db.convertSQLError(err)
+		}
+
+		status.CrashReasons = models.LatestReasons(strings.Split(crashReasons.String, statusReasonSeparator))
+		status.PlacementErrors = models.LatestReasons(strings.Split(placementErrors.String, statusReasonSeparator))
+		status.Health = status.DeriveHealth()
+		statuses = append(statuses, status)
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-getting-next-row", rows.Err())
+		return nil, db.convertSQLError(rows.Err())
+	}
+
+	return statuses, nil
+}
+
 func (db *SQLDB) UpdateDesiredLRP(ctx context.Context, logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate) (*models.DesiredLRP, error) {
 	logger = logger.Session("db-update-desired-lrp", lager.Data{"process_guid": processGuid})
 	logger.Info("starting")
@@ -289,53 +355,154 @@ func (db *SQLDB) UpdateDesiredLRP(ctx context.Context, logger lager.Logger, proc
 	var beforeDesiredLRP *models.DesiredLRP
 	err := db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
 		var err error
-		row := db.one(ctx, logger, tx, desiredLRPsTable,
-			desiredLRPColumns, helpers.LockRow,
-			"process_guid = ?", processGuid,
-		)
-		beforeDesiredLRP, err = db.fetchDesiredLRP(ctx, logger, row, tx)
+		beforeDesiredLRP, err = db.updateDesiredLRP(ctx, logger, tx, processGuid, update, models.DesiredLRPRevisionSourceUpdate)
+		return err
+	})
+
+	return beforeDesiredLRP, err
+}
+
+// updateDesiredLRP applies the update to the DesiredLRP and records its new
+// revision, if any, with the given source. It returns the DesiredLRP as it was
+// before the update.
+func (db *SQLDB) updateDesiredLRP(ctx context.Context, logger lager.Logger, tx helpers.Tx, processGuid string, update *models.DesiredLRPUpdate, source string) (*models.DesiredLRP, error) {
+	row := db.one(ctx, logger, tx, desiredLRPsTable,
+		desiredLRPColumns, helpers.LockRow,
+		"process_guid = ?", processGuid,
+	)
+	beforeDesiredLRP, err := db.fetchDesiredLRP(ctx, logger, row, tx)
+	if err != nil {
+		logger.Error("failed-lock-desired", err)
+		return nil, err
+	}
+
+	updateAttributes := helpers.SQLAttributes{"modification_tag_index": beforeDesiredLRP.ModificationTag.Index + 1}
 
+	if update.AnnotationExists() {
+		updateAttributes["annotation"] = update.GetAnnotation()
+	}
+
+	instances := beforeDesiredLRP.Instances
+	if update.InstancesExists() {
+		instances = update.GetInstances()
+		updateAttributes["instances"] = instances
+	}
+
+	resource := beforeDesiredLRP.DesiredLRPResource()
+	if update.Resource != nil {
+		resource = *update.Resource
+		updateAttributes["memory_mb"] = resource.MemoryMb
+		updateAttributes["disk_mb"] = resource.DiskMb
+		updateAttributes["max_pids"] = resource.MaxPids
+		updateAttributes["rootfs"] = resource.RootFs
+	}
+
+	beforeSidecarMemoryMb, beforeSidecarDiskMb := models.SidecarResources(beforeDesiredLRP.Sidecars)
+	sidecarMemoryMb, sidecarDiskMb := beforeSidecarMemoryMb, beforeSidecarDiskMb
+	if update.RunInfo != nil {
+		sidecarMemoryMb, sidecarDiskMb = models.SidecarResources(update.RunInfo.Sidecars)
+		updateAttributes["sidecar_memory_mb"] = sidecarMemoryMb
+		updateAttributes["sidecar_disk_mb"] = sidecarDiskMb
+	}
+
+	requested := models.DesiredLRPUsage(instances, resource.MemoryMb+sidecarMemoryMb, resource.DiskMb+sidecarDiskMb).
+		Sub(models.DesiredLRPUsage(beforeDesiredLRP.Instances, beforeDesiredLRP.MemoryMb+beforeSidecarMemoryMb, beforeDesiredLRP.DiskMb+beforeSidecarDiskMb))
+	if requested.Instances > 0 || requested.MemoryMb > 0 || requested.DiskMb > 0 {
+		err = db.checkDomainQuota(ctx, logger, tx, beforeDesiredLRP.Domain, requested)
 		if err != nil {
-			logger.Error("failed-lock-desired", err)
-			return err
+			return nil, err
 		}
+	}
 
-		updateAttributes := helpers.SQLAttributes{"modification_tag_index": beforeDesiredLRP.ModificationTag.Index + 1}
+	if update.RunInfo != nil {
+		if !update.RunInfo.DesiredLRPKey.Equal(beforeDesiredLRP.DesiredLRPKey()) {
+			logger.Error("run-info-key-does-not-match", nil, lager.Data{"run_info_key": update.RunInfo.DesiredLRPKey})
+			return nil, models.ErrBadRequest
+		}
 
-		if update.AnnotationExists() {
-			updateAttributes["annotation"] = update.GetAnnotation()
+		runInfo := *update.RunInfo
+		runInfo.CreatedAt = db.clock.Now().UnixNano()
+		runInfoData, err := db.serializeModel(logger, &runInfo)
+		if err != nil {
+			logger.Error("failed-to-serialize-model", err)
+			return nil, err
 		}
+		updateAttributes["run_info"] = runInfoData
 
-		if update.InstancesExists() {
-			updateAttributes["instances"] = update.GetInstances()
+		volumePlacementData, err := db.serializeModel(logger, newVolumePlacement(runInfo.VolumeMounts))
+		if err != nil {
+			logger.Error("failed-to-serialize-model", err)
+			return nil, err
 		}
+		updateAttributes["volume_placement"] = volumePlacementData
+	}
 
-		if update.Routes != nil {
-			encodedData, err := db.encodeRouteData(logger, update.Routes)
-			if err != nil {
-				return err
-			}
-			updateAttributes["routes"] = encodedData
+	if update.PausedExists() {
+		updateAttributes["paused"] = update.GetPaused()
+	}
+
+	if update.RolloutStrategy != nil {
+		updateAttributes["rollout_max_surge"] = update.RolloutStrategy.MaxSurge
+		updateAttributes["rollout_max_unavailable"] = update.RolloutStrategy.MaxUnavailable
+	}
+
+	if update.IsNewRevision() {
+		err = db.recordMissingDesiredLRPRevision(ctx, logger, tx, beforeDesiredLRP)
+		if err != nil {
+			return nil, err
 		}
+		updateAttributes["revision"] = beforeDesiredLRP.Revision + 1
+	}
 
-		if update.MetricTags != nil {
-			encodedData, err := db.encodeDesiredMetricTagsData(logger, update.MetricTags)
-			if err != nil {
-				return err
-			}
-			updateAttributes["metric_tags"] = encodedData
+	if update.Routes != nil {
+		encodedData, err := db.encodeRouteData(logger, update.Routes)
+		if err != nil {
+			return nil, err
 		}
+		updateAttributes["routes"] = encodedData
+	}
 
-		_, err = db.update(ctx, logger, tx, desiredLRPsTable, updateAttributes, `process_guid = ?`, processGuid)
+	if update.MetricTags != nil {
+		encodedData, err := db.encodeDesiredMetricTagsData(logger, update.MetricTags)
 		if err != nil {
-			logger.Error("failed-executing-query", err)
-			return err
+			return nil, err
 		}
+		updateAttributes["metric_tags"] = encodedData
+	}
 
-		return nil
-	})
+	_, err = db.update(ctx, logger, tx, desiredLRPsTable, updateAttributes, `process_guid = ?`, processGuid)
+	if err != nil {
+		logger.Error("failed-executing-query", err)
+		return nil, err
+	}
 
-	return beforeDesiredLRP, err
+	if update.IsNewRevision() {
+		row := db.one(ctx, logger, tx, desiredLRPsTable,
+			desiredLRPColumns, helpers.NoLockRow,
+			"process_guid = ?", processGuid,
+		)
+		desiredLRP, err := db.fetchDesiredLRP(ctx, logger, row, tx)
+		if err != nil {
+			logger.Error("failed-fetching-desired", err)
+			return nil, err
+		}
+
+		err = db.recordDesiredLRPRevision(ctx, logger, tx, desiredLRP, source)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return beforeDesiredLRP, nil
+}
+
+func newVolumePlacement(mounts []*models.VolumeMount) *models.VolumePlacement {
+	volumePlacement := &models.VolumePlacement{}
+	volumePlacement.DriverNames = []string{}
+	for _, mount := range mounts {
+		volumePlacement.DriverNames = append(volumePlacement.DriverNames, mount.Driver)
+	}
+	return volumePlacement
 }
 
 func (db *SQLDB) encodeRouteData(logger lager.Logger, routes *models.Routes) ([]byte, error) {
@@ -384,14 +551,199 @@ func (db *SQLDB) RemoveDesiredLRP(ctx context.Context, logger lager.Logger, proc
 			return err
 		}
 
+		_, err = db.delete(ctx, logger, tx, desiredLRPRevisionsTable, "process_guid = ?", processGuid)
+		if err != nil {
+			logger.Error("failed-deleting-revisions-from-db", err)
+			return err
+		}
+
+		return nil
+	})
+}
+
+func (db *SQLDB) StartRollingRestart(ctx context.Context, logger lager.Logger, processGuid string, maxInFlight int32) error {
+	logger = logger.Session("db-start-rolling-restart", lager.Data{"process_guid": processGuid, "max_in_flight": maxInFlight})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	return db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		now := db.clock.Now().UnixNano()
+		result, err := db.update(ctx, logger, tx, desiredLRPsTable,
+			helpers.SQLAttributes{
+				"restart_max_in_flight": maxInFlight,
+				"restart_requested_at":  now,
+				"restart_progressed_at": now,
+			},
+			"process_guid = ?", processGuid,
+		)
+		if err != nil {
+			logger.Error("failed-starting-rolling-restart", err)
+			return err
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			logger.Error("failed-rows-affected", err)
+			return err
+		}
+
+		if rowsAffected == 0 {
+			return models.ErrResourceNotFound
+		}
+
+		query := `UPDATE actual_lrps SET restart_instance_guid = instance_guid WHERE process_guid = ? AND presence = ?`
+		_, err = tx.ExecContext(ctx, db.helper.Rebind(query), processGuid, models.ActualLRP_Ordinary)
+		if err != nil {
+			logger.Error("failed-recording-restart-instance-guids", err)
+			return err
+		}
+
+		return nil
+	})
+}
+
+func (db *SQLDB) AdvanceRollingRestart(ctx context.Context, logger lager.Logger, processGuid string, timeout time.Duration) ([]*models.ActualLRPKey, error) {
+	logger = logger.Session("db-advance-rolling-restart", lager.Data{"process_guid": processGuid})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	var keys []*models.ActualLRPKey
+	err := db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		keys = nil
+
+		var maxInFlight int32
+		var requestedAt, progressedAt int64
+		row := db.one(ctx, logger, tx, desiredLRPsTable,
+			append(append(helpers.ColumnList{}, schedulingInfoColumns...),
+				desiredLRPsTable+".restart_max_in_flight",
+				desiredLRPsTable+".restart_requested_at",
+				desiredLRPsTable+".restart_progressed_at",
+			), helpers.LockRow,
+			"process_guid = ?", processGuid,
+		)
+		schedulingInfo, err := db.fetchDesiredLRPSchedulingInfoAndMore(logger, row, &maxInFlight, &requestedAt, &progressedAt)
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if requestedAt == 0 {
+			return nil
+		}
+
+		rows, err := db.all(ctx, logger, tx, actualLRPsTable,
+			actualLRPColumns, helpers.NoLockRow,
+			"process_guid = ? AND presence = ?", processGuid, models.ActualLRP_Ordinary,
+		)
+		if err != nil {
+			logger.Error("failed-query", err)
+			return err
+		}
+		actualLRPs, err := db.scanAndCleanupActualLRPs(ctx, logger, tx, rows)
+		rows.Close()
+		if err != nil {
+			return err
+		}
+
+		restartInstanceGuids, err := db.restartInstanceGuids(ctx, logger, tx, processGuid)
+		if err != nil {
+			logger.Error("failed-fetching-restart-instance-guids", err)
+			return err
+		}
+
+		lrps, done := models.PlanRollingRestart(schedulingInfo, actualLRPs, restartInstanceGuids, maxInFlight)
+		now := db.clock.Now()
+		switch {
+		case done:
+			logger.Info("finished-rolling-restart")
+			return db.stopRollingRestart(ctx, logger, tx, processGuid)
+		case len(lrps) > 0:
+			_, err := db.update(ctx, logger, tx, desiredLRPsTable,
+				helpers.SQLAttributes{"restart_progressed_at": now.UnixNano()},
+				"process_guid = ?", processGuid,
+			)
+			if err != nil {
+				logger.Error("failed-recording-rolling-restart-progress", err)
+				return err
+			}
+			for _, lrp := range lrps {
+				keys = append(keys, &lrp.ActualLRPKey)
+			}
+			logger.Info("rolling-restart", lager.Data{"restarts": len(lrps)})
+		case now.Sub(time.Unix(0, progressedAt)) > timeout:
+			logger.Info("abandoning-rolling-restart")
+			return db.stopRollingRestart(ctx, logger, tx, processGuid)
+		}
+
 		return nil
 	})
+
+	return keys, err
+}
+
+// restartInstanceGuids returns the instance guids recorded by the rolling
+// restart of the DesiredLRP, by index.
+func (db *SQLDB) restartInstanceGuids(ctx context.Context, logger lager.Logger, q helpers.Queryable, processGuid string) (map[int32]string, error) {
+	rows, err := db.all(ctx, logger, q, actualLRPsTable,
+		helpers.ColumnList{"instance_index", "restart_instance_guid"}, helpers.NoLockRow,
+		"process_guid = ? AND presence = ?", processGuid, models.ActualLRP_Ordinary,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	guids := map[int32]string{}
+	for rows.Next() {
+		var index int32
+		var guid string
+		err := rows.Scan(&index, &guid)
+		if err != nil {
+			return nil, err
+		}
+		guids[index] = guid
+	}
+
+	return guids, rows.Err()
+}
+
+// stopRollingRestart clears the rolling restart of the DesiredLRP and the
+// instance guids it recorded.
+func (db *SQLDB) stopRollingRestart(ctx context.Context, logger lager.Logger, tx helpers.Tx, processGuid string) error {
+	_, err := db.update(ctx, logger, tx, desiredLRPsTable,
+		helpers.SQLAttributes{
+			"restart_max_in_flight": 0,
+			"restart_requested_at":  0,
+			"restart_progressed_at": 0,
+		},
+		"process_guid = ?", processGuid,
+	)
+	if err != nil {
+		logger.Error("failed-stopping-rolling-restart", err)
+		return err
+	}
+
+	_, err = db.update(ctx, logger, tx, actualLRPsTable,
+		helpers.SQLAttributes{"restart_instance_guid": ""},
+		"process_guid = ?", processGuid,
+	)
+	if err != nil {
+		logger.Error("failed-clearing-restart-instance-guids", err)
+		return err
+	}
+
+	return nil
 }
 
 // "rows" needs to have the columns defined in the schedulingInfoColumns constant
 func (db *SQLDB) fetchDesiredLRPSchedulingInfoAndMore(logger lager.Logger, scanner helpers.RowScanner, dest ...interface{}) (*models.DesiredLRPSchedulingInfo, error) {
 	schedulingInfo := &models.DesiredLRPSchedulingInfo{}
 	var routeData, volumePlacementData, placementTagData []byte
+	var rolloutStrategy models.RolloutStrategy
+	var restartPolicy models.RestartPolicy
+	var zoneSpreadConstraint models.ZoneSpreadConstraint
 	values := []interface{}{
 		&schedulingInfo.ProcessGuid,
 		&schedulingInfo.Domain,
@@ -407,6 +759,16 @@ func (db *SQLDB) fetchDesiredLRPSchedulingInfoAndMore(logger lager.Logger, scann
 		&schedulingInfo.ModificationTag.Epoch,
 		&schedulingInfo.ModificationTag.Index,
 		&placementTagData,
+		&schedulingInfo.Revision,
+		&rolloutStrategy.MaxSurge,
+		&rolloutStrategy.MaxUnavailable,
+		&restartPolicy.ImmediateRestarts,
+		&restartPolicy.MaxBackoffDurationMs,
+		&restartPolicy.MaxRestarts,
+		&zoneSpreadConstraint.MaxSkew,
+		&schedulingInfo.Paused,
+		&schedulingInfo.SidecarMemoryMb,
+		&schedulingInfo.SidecarDiskMb,
 	}
 	values = append(values, dest...)
 
@@ -448,6 +810,20 @@ func (db *SQLDB) fetchDesiredLRPSchedulingInfoAndMore(logger lager.Logger, scann
 		}
 	}
 
+	// a zero strategy is not valid, and is stored for LRPs using the default one
+	if rolloutStrategy != (models.RolloutStrategy{}) {
+		schedulingInfo.RolloutStrategy = &rolloutStrategy
+	}
+
+	// likewise, a zero restart policy is stored for LRPs using the default one
+	if restartPolicy != (models.RestartPolicy{}) {
+		schedulingInfo.RestartPolicy = &restartPolicy
+	}
+
+	if zoneSpreadConstraint.MaxSkew > 0 {
+		schedulingInfo.ZoneSpreadConstraint = &zoneSpreadConstraint
+	}
+
 	return schedulingInfo, nil
 }
 
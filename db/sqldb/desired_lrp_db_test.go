@@ -3,6 +3,7 @@ package sqldb_test
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/models/test/model_helpers"
@@ -28,6 +29,21 @@ var _ = Describe("DesiredLRPDB", func() {
 			Expect(desiredLRP).To(Equal(expectedDesiredLRP))
 		})
 
+		It("records the resources of the sidecars in the scheduling info", func() {
+			expectedDesiredLRP.Sidecars = []*models.Sidecar{
+				{Action: model_helpers.NewValidAction(), MemoryMb: 64, DiskMb: 32},
+				{Action: model_helpers.NewValidAction(), MemoryMb: 16, DiskMb: 8},
+			}
+			err := sqlDB.DesireLRP(ctx, logger, expectedDesiredLRP)
+			Expect(err).NotTo(HaveOccurred())
+
+			schedulingInfos, err := sqlDB.DesiredLRPSchedulingInfos(ctx, logger, models.DesiredLRPFilter{ProcessGuids: []string{"the-guid"}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(schedulingInfos).To(HaveLen(1))
+			Expect(schedulingInfos[0].SidecarMemoryMb).To(BeEquivalentTo(80))
+			Expect(schedulingInfos[0].SidecarDiskMb).To(BeEquivalentTo(40))
+		})
+
 		Context("when the process_guid is already taken", func() {
 			BeforeEach(func() {
 				err := sqlDB.DesireLRP(ctx, logger, expectedDesiredLRP)
@@ -443,6 +459,121 @@ var _ = Describe("DesiredLRPDB", func() {
 		})
 	})
 
+	Describe("DesiredLRPStatuses", func() {
+		BeforeEach(func() {
+			desiredLRP1 := model_helpers.NewValidDesiredLRP("d-1")
+			desiredLRP1.Domain = "domain-1"
+			desiredLRP1.Instances = 3
+			Expect(sqlDB.DesireLRP(ctx, logger, desiredLRP1)).To(Succeed())
+
+			desiredLRP2 := model_helpers.NewValidDesiredLRP("d-2")
+			desiredLRP2.Domain = "domain-2"
+			desiredLRP2.Instances = 0
+			Expect(sqlDB.DesireLRP(ctx, logger, desiredLRP2)).To(Succeed())
+
+			keys := []*models.ActualLRPKey{}
+			for i := int32(0); i < 3; i++ {
+				key := models.NewActualLRPKey("d-1", i, "domain-1")
+				_, err := sqlDB.CreateUnclaimedActualLRP(ctx, logger, &key)
+				Expect(err).NotTo(HaveOccurred())
+				keys = append(keys, &key)
+			}
+
+			instanceKey := models.NewActualLRPInstanceKey("instance-0", "cell-1")
+			_, _, err := sqlDB.ClaimActualLRP(ctx, logger, "d-1", 0, &instanceKey)
+			Expect(err).NotTo(HaveOccurred())
+			netInfo := models.NewActualLRPNetInfo("1.2.3.4", "2.2.2.2", models.ActualLRPNetInfo_PreferredAddressUnknown)
+			_, _, err = sqlDB.StartActualLRP(ctx, logger, keys[0], &instanceKey, &netInfo, model_helpers.NewActualLRPInternalRoutes(), model_helpers.NewActualLRPMetricTags(), true, "")
+			Expect(err).NotTo(HaveOccurred())
+
+			crashedInstanceKey := models.NewActualLRPInstanceKey("instance-1", "cell-1")
+			for i := 0; i < 3; i++ {
+				_, _, err = sqlDB.ClaimActualLRP(ctx, logger, "d-1", 1, &crashedInstanceKey)
+				Expect(err).NotTo(HaveOccurred())
+				_, _, _, err = sqlDB.CrashActualLRP(ctx, logger, keys[1], &crashedInstanceKey, "out of memory")
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			_, _, err = sqlDB.FailActualLRP(ctx, logger, keys[2], "insufficient resources")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns the instance counts, reasons and health of the desired lrps", func() {
+			statuses, err := sqlDB.DesiredLRPStatuses(ctx, logger, models.DesiredLRPFilter{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(statuses).To(ConsistOf(
+				&models.DesiredLRPStatus{
+					ProcessGuid:        "d-1",
+					Domain:             "domain-1",
+					DesiredInstances:   3,
+					UnclaimedInstances: 1,
+					RunningInstances:   1,
+					CrashedInstances:   1,
+					CrashReasons:       []string{"out of memory"},
+					PlacementErrors:    []string{"insufficient resources"},
+					Health:             models.DesiredLRPStatus_Unplaceable,
+				},
+				&models.DesiredLRPStatus{
+					ProcessGuid:     "d-2",
+					Domain:          "domain-2",
+					CrashReasons:    []string{},
+					PlacementErrors: []string{},
+					Health:          models.DesiredLRPStatus_Healthy,
+				},
+			))
+		})
+
+		It("counts evacuating instances separately", func() {
+			instanceKey := models.NewActualLRPInstanceKey("instance-0", "cell-1")
+			netInfo := models.NewActualLRPNetInfo("1.2.3.4", "2.2.2.2", models.ActualLRPNetInfo_PreferredAddressUnknown)
+			key := models.NewActualLRPKey("d-1", 0, "domain-1")
+			_, err := sqlDB.EvacuateActualLRP(ctx, logger, &key, &instanceKey, &netInfo, model_helpers.NewActualLRPInternalRoutes(), model_helpers.NewActualLRPMetricTags(), true, "")
+			Expect(err).NotTo(HaveOccurred())
+
+			statuses, err := sqlDB.DesiredLRPStatuses(ctx, logger, models.DesiredLRPFilter{ProcessGuids: []string{"d-1"}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(statuses).To(HaveLen(1))
+			Expect(statuses[0].RunningInstances).To(BeEquivalentTo(1))
+			Expect(statuses[0].EvacuatingInstances).To(BeEquivalentTo(1))
+		})
+
+		Context("when a desired lrp is paused", func() {
+			BeforeEach(func() {
+				update := &models.DesiredLRPUpdate{}
+				update.SetPaused(true)
+				_, err := sqlDB.UpdateDesiredLRP(ctx, logger, "d-1", update)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("reports it as paused", func() {
+				statuses, err := sqlDB.DesiredLRPStatuses(ctx, logger, models.DesiredLRPFilter{ProcessGuids: []string{"d-1"}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(statuses).To(HaveLen(1))
+				Expect(statuses[0].Paused).To(BeTrue())
+				Expect(statuses[0].DesiredInstances).To(BeEquivalentTo(3))
+				Expect(statuses[0].Health).To(Equal(models.DesiredLRPStatus_Paused))
+			})
+		})
+
+		Context("when filtering by domain", func() {
+			It("returns the statuses of the desired lrps in the domain", func() {
+				statuses, err := sqlDB.DesiredLRPStatuses(ctx, logger, models.DesiredLRPFilter{Domain: "domain-2"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(statuses).To(HaveLen(1))
+				Expect(statuses[0].ProcessGuid).To(Equal("d-2"))
+			})
+		})
+
+		Context("when filtering by process guids", func() {
+			It("returns the statuses of the desired lrps", func() {
+				statuses, err := sqlDB.DesiredLRPStatuses(ctx, logger, models.DesiredLRPFilter{ProcessGuids: []string{"d-1", "missing"}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(statuses).To(HaveLen(1))
+				Expect(statuses[0].ProcessGuid).To(Equal("d-1"))
+			})
+		})
+	})
+
 	Describe("UpdateDesiredLRP", func() {
 		var expectedDesiredLRP *models.DesiredLRP
 		var update *models.DesiredLRPUpdate
@@ -537,6 +668,100 @@ var _ = Describe("DesiredLRPDB", func() {
 			})
 		})
 
+		Context("when updating the run info and resources", func() {
+			var runInfo models.DesiredLRPRunInfo
+			var resource models.DesiredLRPResource
+
+			JustBeforeEach(func() {
+				newDesiredLRP := model_helpers.NewValidDesiredLRP(expectedDesiredLRP.ProcessGuid)
+				newDesiredLRP.Action = models.WrapAction(&models.RunAction{Path: "new-path", User: "me"})
+				newDesiredLRP.VolumeMounts = nil
+				runInfo = newDesiredLRP.DesiredLRPRunInfo(fakeClock.Now())
+				resource = models.NewDesiredLRPResource(1024, 2048, 100, "docker:///new-image")
+
+				update = &models.DesiredLRPUpdate{
+					RunInfo:         &runInfo,
+					Resource:        &resource,
+					RolloutStrategy: &models.RolloutStrategy{MaxSurge: 2, MaxUnavailable: 1},
+				}
+			})
+
+			It("stores them as a new revision", func() {
+				_, err := sqlDB.UpdateDesiredLRP(ctx, logger, expectedDesiredLRP.ProcessGuid, update)
+				Expect(err).NotTo(HaveOccurred())
+
+				desiredLRP, err := sqlDB.DesiredLRPByProcessGuid(ctx, logger, expectedDesiredLRP.ProcessGuid)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(desiredLRP.Revision).To(BeEquivalentTo(1))
+				Expect(desiredLRP.RolloutStrategy).To(Equal(&models.RolloutStrategy{MaxSurge: 2, MaxUnavailable: 1}))
+				Expect(desiredLRP.Action).To(Equal(runInfo.Action))
+				Expect(desiredLRP.VolumeMounts).To(BeEmpty())
+				Expect(desiredLRP.DesiredLRPResource()).To(Equal(resource))
+
+				schedulingInfo, err := sqlDB.DesiredLRPSchedulingInfoByProcessGuid(ctx, logger, expectedDesiredLRP.ProcessGuid)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(schedulingInfo.Revision).To(BeEquivalentTo(1))
+				Expect(schedulingInfo.VolumePlacement.DriverNames).To(BeEmpty())
+			})
+
+			It("does not change the revision for other updates", func() {
+				update = &models.DesiredLRPUpdate{}
+				update.SetInstances(3)
+				_, err := sqlDB.UpdateDesiredLRP(ctx, logger, expectedDesiredLRP.ProcessGuid, update)
+				Expect(err).NotTo(HaveOccurred())
+
+				desiredLRP, err := sqlDB.DesiredLRPByProcessGuid(ctx, logger, expectedDesiredLRP.ProcessGuid)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(desiredLRP.Revision).To(BeZero())
+			})
+
+			Context("when the lrp has no recorded revision", func() {
+				BeforeEach(func() {
+					queryStr := `DELETE FROM desired_lrp_revisions WHERE process_guid = ?`
+					if test_helpers.UsePostgres() {
+						queryStr = test_helpers.ReplaceQuestionMarks(queryStr)
+					}
+					_, err := db.ExecContext(ctx, queryStr, expectedDesiredLRP.ProcessGuid)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("records the revision from before the update", func() {
+					_, err := sqlDB.UpdateDesiredLRP(ctx, logger, expectedDesiredLRP.ProcessGuid, update)
+					Expect(err).NotTo(HaveOccurred())
+
+					revision, err := sqlDB.DesiredLRPRevision(ctx, logger, expectedDesiredLRP.ProcessGuid, 0)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(revision.Source).To(Equal(models.DesiredLRPRevisionSourceBackfill))
+					Expect(revision.RunInfo.Action).To(Equal(expectedDesiredLRP.Action))
+
+					revisions, err := sqlDB.DesiredLRPRevisions(ctx, logger, expectedDesiredLRP.ProcessGuid)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(revisions).To(HaveLen(2))
+				})
+			})
+
+			Context("when the run info is for another lrp", func() {
+				It("returns a bad request error", func() {
+					runInfo.ProcessGuid = "some-other-guid"
+					_, err := sqlDB.UpdateDesiredLRP(ctx, logger, expectedDesiredLRP.ProcessGuid, update)
+					Expect(err).To(Equal(models.ErrBadRequest))
+				})
+			})
+
+			Context("when the domain quota does not fit the new resources", func() {
+				It("returns a quota exceeded error", func() {
+					Expect(sqlDB.UpsertDomainQuota(ctx, logger, &models.DomainQuota{
+						Domain:      expectedDesiredLRP.Domain,
+						MaxMemoryMb: int64(expectedDesiredLRP.Instances) * int64(expectedDesiredLRP.MemoryMb),
+					})).To(Succeed())
+
+					_, err := sqlDB.UpdateDesiredLRP(ctx, logger, expectedDesiredLRP.ProcessGuid, update)
+					Expect(models.ConvertError(err).GetType()).To(Equal(models.Error_QuotaExceeded))
+				})
+			})
+		})
+
 		Context("when routes param is invalid", func() {
 			It("returns a bad request error", func() {
 				routeContent := []byte("bad json")
@@ -560,6 +785,201 @@ var _ = Describe("DesiredLRPDB", func() {
 		})
 	})
 
+	Describe("StartRollingRestart", func() {
+		var processGuid string
+
+		BeforeEach(func() {
+			processGuid = "desired-lrp-guid"
+			Expect(sqlDB.DesireLRP(ctx, logger, model_helpers.NewValidDesiredLRP(processGuid))).To(Succeed())
+		})
+
+		It("records the rolling restart on the desired lrp", func() {
+			Expect(sqlDB.StartRollingRestart(ctx, logger, processGuid, 2)).To(Succeed())
+
+			queryStr := `SELECT restart_max_in_flight, restart_requested_at, restart_progressed_at FROM desired_lrps WHERE process_guid = ?`
+			if test_helpers.UsePostgres() {
+				queryStr = test_helpers.ReplaceQuestionMarks(queryStr)
+			}
+			var maxInFlight int32
+			var requestedAt, progressedAt int64
+			Expect(db.QueryRowContext(ctx, queryStr, processGuid).Scan(&maxInFlight, &requestedAt, &progressedAt)).To(Succeed())
+			Expect(maxInFlight).To(BeEquivalentTo(2))
+			Expect(requestedAt).To(Equal(fakeClock.Now().UnixNano()))
+			Expect(progressedAt).To(Equal(fakeClock.Now().UnixNano()))
+		})
+
+		It("records the instance guids of the instances", func() {
+			desiredLRP := model_helpers.NewValidDesiredLRP(processGuid)
+			key := models.NewActualLRPKey(processGuid, 0, desiredLRP.Domain)
+			instanceKey := models.NewActualLRPInstanceKey("ig-0", "some-cell")
+			netInfo := models.NewActualLRPNetInfo("some-address", "container-address", models.ActualLRPNetInfo_PreferredAddressUnknown)
+			_, _, err := sqlDB.StartActualLRP(ctx, logger, &key, &instanceKey, &netInfo, model_helpers.NewActualLRPInternalRoutes(), model_helpers.NewActualLRPMetricTags(), true, "some-zone")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(sqlDB.StartRollingRestart(ctx, logger, processGuid, 2)).To(Succeed())
+
+			queryStr := `SELECT restart_instance_guid FROM actual_lrps WHERE process_guid = ? AND instance_index = ?`
+			if test_helpers.UsePostgres() {
+				queryStr = test_helpers.ReplaceQuestionMarks(queryStr)
+			}
+			var restartInstanceGuid string
+			Expect(db.QueryRowContext(ctx, queryStr, processGuid, 0).Scan(&restartInstanceGuid)).To(Succeed())
+			Expect(restartInstanceGuid).To(Equal("ig-0"))
+		})
+
+		Context("when the desired lrp does not exist", func() {
+			It("returns a ResourceNotFound error", func() {
+				err := sqlDB.StartRollingRestart(ctx, logger, "does-not-exist", 2)
+				Expect(err).To(Equal(models.ErrResourceNotFound))
+			})
+		})
+	})
+
+	Describe("AdvanceRollingRestart", func() {
+		const timeout = 5 * time.Minute
+
+		var (
+			processGuid string
+			domain      string
+		)
+
+		restartRequestedAt := func() int64 {
+			queryStr := `SELECT restart_requested_at FROM desired_lrps WHERE process_guid = ?`
+			if test_helpers.UsePostgres() {
+				queryStr = test_helpers.ReplaceQuestionMarks(queryStr)
+			}
+			var requestedAt int64
+			Expect(db.QueryRowContext(ctx, queryStr, processGuid).Scan(&requestedAt)).To(Succeed())
+			return requestedAt
+		}
+
+		startInstance := func(index int32, instanceGuid string) {
+			key := models.NewActualLRPKey(processGuid, index, domain)
+			instanceKey := models.NewActualLRPInstanceKey(instanceGuid, "some-cell")
+			netInfo := models.NewActualLRPNetInfo("some-address", "container-address", models.ActualLRPNetInfo_PreferredAddressUnknown)
+			_, _, err := sqlDB.StartActualLRP(ctx, logger, &key, &instanceKey, &netInfo, model_helpers.NewActualLRPInternalRoutes(), model_helpers.NewActualLRPMetricTags(), true, "some-zone")
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		restartInstance := func(index int32) {
+			key := models.NewActualLRPKey(processGuid, index, domain)
+			_, _, err := sqlDB.UnclaimActualLRP(ctx, logger, &key)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		BeforeEach(func() {
+			processGuid = "desired-lrp-guid"
+			desiredLRP := model_helpers.NewValidDesiredLRP(processGuid)
+			desiredLRP.Instances = 2
+			domain = desiredLRP.Domain
+			Expect(sqlDB.DesireLRP(ctx, logger, desiredLRP)).To(Succeed())
+
+			startInstance(0, "ig-0")
+			startInstance(1, "ig-1")
+		})
+
+		Context("when the desired lrp has no rolling restart in progress", func() {
+			It("returns no instances to restart", func() {
+				keys, err := sqlDB.AdvanceRollingRestart(ctx, logger, processGuid, timeout)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(keys).To(BeEmpty())
+			})
+		})
+
+		Context("when the desired lrp does not exist", func() {
+			It("returns no instances to restart", func() {
+				keys, err := sqlDB.AdvanceRollingRestart(ctx, logger, "does-not-exist", timeout)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(keys).To(BeEmpty())
+			})
+		})
+
+		Context("when the desired lrp has a rolling restart in progress", func() {
+			BeforeEach(func() {
+				Expect(sqlDB.StartRollingRestart(ctx, logger, processGuid, 1)).To(Succeed())
+				fakeClock.Increment(time.Second)
+			})
+
+			It("returns the next instances to restart up to the max in flight", func() {
+				keys, err := sqlDB.AdvanceRollingRestart(ctx, logger, processGuid, timeout)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(keys).To(ConsistOf(&models.ActualLRPKey{ProcessGuid: processGuid, Index: 0, Domain: domain}))
+				Expect(restartRequestedAt()).NotTo(BeZero())
+			})
+
+			Context("when an instance changed state but kept its instance guid", func() {
+				BeforeEach(func() {
+					queryStr := `UPDATE actual_lrps SET state = ?, since = ? WHERE process_guid = ? AND instance_index = ?`
+					if test_helpers.UsePostgres() {
+						queryStr = test_helpers.ReplaceQuestionMarks(queryStr)
+					}
+					_, err := db.ExecContext(ctx, queryStr, models.ActualLRPStateClaimed, fakeClock.Now().UnixNano(), processGuid, 0)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("still restarts it", func() {
+					keys, err := sqlDB.AdvanceRollingRestart(ctx, logger, processGuid, timeout)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(keys).To(ConsistOf(&models.ActualLRPKey{ProcessGuid: processGuid, Index: 0, Domain: domain}))
+				})
+			})
+
+			Context("when a restarted instance is not running yet", func() {
+				BeforeEach(func() {
+					restartInstance(0)
+				})
+
+				It("waits for it", func() {
+					keys, err := sqlDB.AdvanceRollingRestart(ctx, logger, processGuid, timeout)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(keys).To(BeEmpty())
+					Expect(restartRequestedAt()).NotTo(BeZero())
+				})
+
+				Context("and the restart has not progressed for longer than the timeout", func() {
+					BeforeEach(func() {
+						fakeClock.Increment(timeout + time.Second)
+					})
+
+					It("abandons the rolling restart", func() {
+						keys, err := sqlDB.AdvanceRollingRestart(ctx, logger, processGuid, timeout)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(keys).To(BeEmpty())
+						Expect(restartRequestedAt()).To(BeZero())
+					})
+				})
+
+				Context("and its replacement is running", func() {
+					BeforeEach(func() {
+						startInstance(0, "ig-0-new")
+					})
+
+					It("returns the next instances to restart", func() {
+						keys, err := sqlDB.AdvanceRollingRestart(ctx, logger, processGuid, timeout)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(keys).To(ConsistOf(&models.ActualLRPKey{ProcessGuid: processGuid, Index: 1, Domain: domain}))
+					})
+				})
+			})
+
+			Context("when every instance has been restarted", func() {
+				BeforeEach(func() {
+					restartInstance(0)
+					startInstance(0, "ig-0-new")
+					restartInstance(1)
+					startInstance(1, "ig-1-new")
+				})
+
+				It("finishes the rolling restart", func() {
+					keys, err := sqlDB.AdvanceRollingRestart(ctx, logger, processGuid, timeout)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(keys).To(BeEmpty())
+					Expect(restartRequestedAt()).To(BeZero())
+				})
+			})
+		})
+	})
+
 	Describe("RemoveDesiredLRP", func() {
 		var expectedDesiredLRP *models.DesiredLRP
 
@@ -0,0 +1,189 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func (db *SQLDB) DesiredLRPRevisions(ctx context.Context, logger lager.Logger, processGuid string) ([]*models.DesiredLRPRevision, error) {
+	logger = logger.Session("db-desired-lrp-revisions", lager.Data{"process_guid": processGuid})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	query := fmt.Sprintf("SELECT %s FROM %s\nWHERE process_guid = ?\nORDER BY revision DESC",
+		strings.Join(desiredLRPRevisionColumns, ", "), desiredLRPRevisionsTable)
+
+	rows, err := db.db.QueryContext(ctx, db.helper.Rebind(query), processGuid)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return nil, db.convertSQLError(err)
+	}
+	defer rows.Close()
+
+	revisions := []*models.DesiredLRPRevision{}
+	for rows.Next() {
+		revision, err := db.fetchDesiredLRPRevision(logger, rows)
+		if err != nil {
+			return nil, db.convertSQLError(err)
+		}
+		revisions = append(revisions, revision)
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-getting-next-row", rows.Err())
+		return nil, db.convertSQLError(rows.Err())
+	}
+
+	return revisions, nil
+}
+
+func (db *SQLDB) DesiredLRPRevision(ctx context.Context, logger lager.Logger, processGuid string, revision int32) (*models.DesiredLRPRevision, error) {
+	logger = logger.Session("db-desired-lrp-revision", lager.Data{"process_guid": processGuid, "revision": revision})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	row := db.one(ctx, logger, db.db, desiredLRPRevisionsTable,
+		desiredLRPRevisionColumns, helpers.NoLockRow,
+		"process_guid = ? AND revision = ?", processGuid, revision,
+	)
+	desiredLRPRevision, err := db.fetchDesiredLRPRevision(logger, row)
+	if err != nil {
+		return nil, db.convertSQLError(err)
+	}
+
+	return desiredLRPRevision, nil
+}
+
+func (db *SQLDB) RollbackDesiredLRP(ctx context.Context, logger lager.Logger, processGuid string, revision int32) (*models.DesiredLRP, error) {
+	logger = logger.Session("db-rollback-desired-lrp", lager.Data{"process_guid": processGuid, "revision": revision})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	var beforeDesiredLRP *models.DesiredLRP
+	err := db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		row := db.one(ctx, logger, tx, desiredLRPRevisionsTable,
+			desiredLRPRevisionColumns, helpers.NoLockRow,
+			"process_guid = ? AND revision = ?", processGuid, revision,
+		)
+		desiredLRPRevision, err := db.fetchDesiredLRPRevision(logger, row)
+		if err != nil {
+			logger.Error("failed-fetching-revision", err)
+			return err
+		}
+
+		beforeDesiredLRP, err = db.updateDesiredLRP(ctx, logger, tx, processGuid, desiredLRPRevision.RollbackUpdate(), models.DesiredLRPRevisionSourceRollback)
+		return err
+	})
+
+	return beforeDesiredLRP, err
+}
+
+// recordDesiredLRPRevision adds the current revision of the DesiredLRP to its
+// history and prunes the revisions beyond models.MaxDesiredLRPRevisions. It
+// must be called in the same transaction that changes the DesiredLRP.
+func (db *SQLDB) recordDesiredLRPRevision(ctx context.Context, logger lager.Logger, tx helpers.Tx, desiredLRP *models.DesiredLRP, source string) error {
+	desiredLRPRevision := models.NewDesiredLRPRevision(desiredLRP, db.clock.Now(), source)
+
+	runInfoData, err := db.serializeModel(logger, desiredLRPRevision.RunInfo)
+	if err != nil {
+		logger.Error("failed-to-serialize-model", err)
+		return err
+	}
+
+	schedulingInfoData, err := db.serializeModel(logger, desiredLRPRevision.SchedulingInfo)
+	if err != nil {
+		logger.Error("failed-to-serialize-model", err)
+		return err
+	}
+
+	_, err = db.upsert(ctx, logger, tx, desiredLRPRevisionsTable,
+		helpers.SQLAttributes{
+			"process_guid":           desiredLRPRevision.ProcessGuid,
+			"revision":               desiredLRPRevision.Revision,
+			"run_info":               runInfoData,
+			"scheduling_info":        schedulingInfoData,
+			"modification_tag_epoch": desiredLRPRevision.ModificationTag.Epoch,
+			"modification_tag_index": desiredLRPRevision.ModificationTag.Index,
+			"created_at":             desiredLRPRevision.CreatedAt,
+			"source":                 desiredLRPRevision.Source,
+		},
+		"process_guid = ? AND revision = ?", desiredLRPRevision.ProcessGuid, desiredLRPRevision.Revision,
+	)
+	if err != nil {
+		logger.Error("failed-recording-revision", err)
+		return err
+	}
+
+	_, err = db.delete(ctx, logger, tx, desiredLRPRevisionsTable,
+		"process_guid = ? AND revision <= ?", desiredLRPRevision.ProcessGuid, desiredLRPRevision.Revision-models.MaxDesiredLRPRevisions,
+	)
+	if err != nil {
+		logger.Error("failed-pruning-revisions", err)
+		return err
+	}
+
+	return nil
+}
+
+// recordMissingDesiredLRPRevision adds the current revision of the DesiredLRP
+// to its history if it is not there yet, as for DesiredLRPs desired before
+// revisions were recorded, so that they can be rolled back to it.
+func (db *SQLDB) recordMissingDesiredLRPRevision(ctx context.Context, logger lager.Logger, tx helpers.Tx, desiredLRP *models.DesiredLRP) error {
+	var revision int32
+	row := db.one(ctx, logger, tx, desiredLRPRevisionsTable,
+		helpers.ColumnList{"revision"}, helpers.NoLockRow,
+		"process_guid = ? AND revision = ?", desiredLRP.ProcessGuid, desiredLRP.Revision,
+	)
+	err := row.Scan(&revision)
+	if err == nil {
+		return nil
+	}
+	if err != sql.ErrNoRows {
+		logger.Error("failed-fetching-revision", err)
+		return err
+	}
+
+	return db.recordDesiredLRPRevision(ctx, logger, tx, desiredLRP, models.DesiredLRPRevisionSourceBackfill)
+}
+
+func (db *SQLDB) fetchDesiredLRPRevision(logger lager.Logger, scanner helpers.RowScanner) (*models.DesiredLRPRevision, error) {
+	var runInfoData, schedulingInfoData []byte
+	desiredLRPRevision := &models.DesiredLRPRevision{
+		RunInfo:         &models.DesiredLRPRunInfo{},
+		SchedulingInfo:  &models.DesiredLRPSchedulingInfo{},
+		ModificationTag: &models.ModificationTag{},
+	}
+
+	err := scanner.Scan(
+		&desiredLRPRevision.ProcessGuid,
+		&desiredLRPRevision.Revision,
+		&runInfoData,
+		&schedulingInfoData,
+		&desiredLRPRevision.ModificationTag.Epoch,
+		&desiredLRPRevision.ModificationTag.Index,
+		&desiredLRPRevision.CreatedAt,
+		&desiredLRPRevision.Source,
+	)
+	if err != nil {
+		logger.Error("failed-scanning-row", err)
+		return nil, err
+	}
+
+	err = db.deserializeModel(logger, runInfoData, desiredLRPRevision.RunInfo)
+	if err != nil {
+		return nil, models.ErrDeserialize
+	}
+
+	err = db.deserializeModel(logger, schedulingInfoData, desiredLRPRevision.SchedulingInfo)
+	if err != nil {
+		return nil, models.ErrDeserialize
+	}
+
+	return desiredLRPRevision, nil
+}
@@ -0,0 +1,140 @@
+package sqldb_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DesiredLRPRevisionDB", func() {
+	var (
+		processGuid string
+		desiredLRP  *models.DesiredLRP
+	)
+
+	updateMemory := func(memoryMB int32) {
+		resource := desiredLRP.DesiredLRPResource()
+		resource.MemoryMb = memoryMB
+		_, err := sqlDB.UpdateDesiredLRP(ctx, logger, processGuid, &models.DesiredLRPUpdate{Resource: &resource})
+		Expect(err).NotTo(HaveOccurred())
+		fakeClock.Increment(time.Second)
+	}
+
+	revisionNumbers := func(revisions []*models.DesiredLRPRevision) []int32 {
+		numbers := []int32{}
+		for _, revision := range revisions {
+			numbers = append(numbers, revision.Revision)
+		}
+		return numbers
+	}
+
+	BeforeEach(func() {
+		processGuid = "some-process-guid"
+		desiredLRP = model_helpers.NewValidDesiredLRP(processGuid)
+		Expect(sqlDB.DesireLRP(ctx, logger, desiredLRP)).To(Succeed())
+		fakeClock.Increment(time.Second)
+	})
+
+	Describe("DesiredLRPRevisions", func() {
+		It("records the initial revision when the lrp is desired", func() {
+			revisions, err := sqlDB.DesiredLRPRevisions(ctx, logger, processGuid)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(revisions).To(HaveLen(1))
+			Expect(revisions[0].Revision).To(BeEquivalentTo(0))
+			Expect(revisions[0].Source).To(Equal(models.DesiredLRPRevisionSourceDesire))
+			Expect(revisions[0].RunInfo.Action).To(Equal(desiredLRP.Action))
+			Expect(revisions[0].SchedulingInfo.MemoryMb).To(Equal(desiredLRP.MemoryMb))
+			Expect(revisions[0].ModificationTag).To(Equal(desiredLRP.ModificationTag))
+		})
+
+		It("records a revision for each update of the run info or resources, latest first", func() {
+			updateMemory(2048)
+			updateMemory(4096)
+
+			revisions, err := sqlDB.DesiredLRPRevisions(ctx, logger, processGuid)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(revisionNumbers(revisions)).To(Equal([]int32{2, 1, 0}))
+			Expect(revisions[0].SchedulingInfo.MemoryMb).To(BeEquivalentTo(4096))
+			Expect(revisions[0].Source).To(Equal(models.DesiredLRPRevisionSourceUpdate))
+			Expect(revisions[0].CreatedAt).To(BeNumerically(">", revisions[1].CreatedAt))
+		})
+
+		It("does not record a revision for other updates", func() {
+			update := &models.DesiredLRPUpdate{}
+			update.SetInstances(3)
+			_, err := sqlDB.UpdateDesiredLRP(ctx, logger, processGuid, update)
+			Expect(err).NotTo(HaveOccurred())
+
+			revisions, err := sqlDB.DesiredLRPRevisions(ctx, logger, processGuid)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(revisionNumbers(revisions)).To(Equal([]int32{0}))
+		})
+
+		It("keeps at most MaxDesiredLRPRevisions revisions", func() {
+			for i := 0; i < models.MaxDesiredLRPRevisions+2; i++ {
+				updateMemory(int32(2048 + i))
+			}
+
+			revisions, err := sqlDB.DesiredLRPRevisions(ctx, logger, processGuid)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(revisions).To(HaveLen(models.MaxDesiredLRPRevisions))
+			Expect(revisions[0].Revision).To(BeEquivalentTo(models.MaxDesiredLRPRevisions + 2))
+			Expect(revisions[len(revisions)-1].Revision).To(BeEquivalentTo(3))
+		})
+
+		It("removes the revisions when the lrp is removed", func() {
+			Expect(sqlDB.RemoveDesiredLRP(ctx, logger, processGuid)).To(Succeed())
+
+			revisions, err := sqlDB.DesiredLRPRevisions(ctx, logger, processGuid)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(revisions).To(BeEmpty())
+		})
+	})
+
+	Describe("DesiredLRPRevision", func() {
+		It("returns the revision", func() {
+			updateMemory(2048)
+
+			revision, err := sqlDB.DesiredLRPRevision(ctx, logger, processGuid, 1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(revision.SchedulingInfo.MemoryMb).To(BeEquivalentTo(2048))
+		})
+
+		It("returns a not found error when the revision does not exist", func() {
+			_, err := sqlDB.DesiredLRPRevision(ctx, logger, processGuid, 5)
+			Expect(err).To(Equal(models.ErrResourceNotFound))
+		})
+	})
+
+	Describe("RollbackDesiredLRP", func() {
+		BeforeEach(func() {
+			updateMemory(2048)
+		})
+
+		It("restores the revision as a new revision", func() {
+			before, err := sqlDB.RollbackDesiredLRP(ctx, logger, processGuid, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(before.MemoryMb).To(BeEquivalentTo(2048))
+			Expect(before.Revision).To(BeEquivalentTo(1))
+
+			after, err := sqlDB.DesiredLRPByProcessGuid(ctx, logger, processGuid)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(after.MemoryMb).To(Equal(desiredLRP.MemoryMb))
+			Expect(after.Revision).To(BeEquivalentTo(2))
+			Expect(after.ModificationTag.Index).To(Equal(before.ModificationTag.Index + 1))
+
+			revisions, err := sqlDB.DesiredLRPRevisions(ctx, logger, processGuid)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(revisionNumbers(revisions)).To(Equal([]int32{2, 1, 0}))
+			Expect(revisions[0].Source).To(Equal(models.DesiredLRPRevisionSourceRollback))
+		})
+
+		It("returns a not found error when the revision does not exist", func() {
+			_, err := sqlDB.RollbackDesiredLRP(ctx, logger, processGuid, 5)
+			Expect(err).To(Equal(models.ErrResourceNotFound))
+		})
+	})
+})
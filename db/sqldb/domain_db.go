@@ -2,13 +2,19 @@ package sqldb
 
 import (
 	"context"
+	"database/sql"
 	"math"
 	"time"
 
 	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/lager/v3"
 )
 
+// expiredDomainTime is the expire time of domains that were expired
+// explicitly, which tells them apart from domains whose TTL ran out.
+const expiredDomainTime = 0
+
 func (db *SQLDB) FreshDomains(ctx context.Context, logger lager.Logger) ([]string, error) {
 	logger = logger.Session("db-fresh-domains")
 	logger.Debug("starting")
@@ -33,9 +39,49 @@ func (db *SQLDB) FreshDomains(ctx context.Context, logger lager.Logger) ([]strin
 	return domainNames, err
 }
 
+func (db *SQLDB) Domains(ctx context.Context, logger lager.Logger) ([]*models.Domain, error) {
+	logger = logger.Session("db-domains")
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	domains, err := db.domains(ctx, logger, db.db, time.Time{})
+	if err != nil {
+		return nil, db.convertSQLError(err)
+	}
+
+	now := db.clock.Now()
+	result := make([]*models.Domain, 0, len(domains))
+	for _, d := range domains {
+		result = append(result, d.toModel(now))
+	}
+
+	return result, nil
+}
+
 type domain struct {
-	name      string
-	expiresAt time.Time
+	name       string
+	expiresAt  time.Time
+	upsertedBy string
+	upsertedAt int64
+}
+
+func (d domain) fresh(now time.Time) bool {
+	return d.expiresAt.After(now.Round(time.Second))
+}
+
+func (d domain) toModel(now time.Time) *models.Domain {
+	expiresAt := d.expiresAt.UnixNano()
+	if expiresAt == math.MaxInt64 {
+		expiresAt = 0
+	}
+
+	return &models.Domain{
+		Domain:     d.name,
+		ExpiresAt:  expiresAt,
+		Fresh:      d.fresh(now),
+		UpsertedBy: d.upsertedBy,
+		UpsertedAt: d.upsertedAt,
+	}
 }
 
 func (db *SQLDB) domains(ctx context.Context, logger lager.Logger, tx helpers.Queryable, expiresAfter time.Time) ([]domain, error) {
@@ -54,15 +100,12 @@ func (db *SQLDB) domains(ctx context.Context, logger lager.Logger, tx helpers.Qu
 	var results []domain
 
 	for rows.Next() {
-		var name string
-		var expiresAt int64
-		err = rows.Scan(&name, &expiresAt)
+		d, err := db.fetchDomain(logger, rows)
 		if err != nil {
-			logger.Error("failed-scan-row", err)
 			return nil, err
 		}
 
-		results = append(results, domain{name, time.Unix(0, int64(expiresAt))})
+		results = append(results, d)
 	}
 
 	if rows.Err() != nil {
@@ -73,31 +116,130 @@ func (db *SQLDB) domains(ctx context.Context, logger lager.Logger, tx helpers.Qu
 	return results, nil
 }
 
-func (db *SQLDB) UpsertDomain(ctx context.Context, logger lager.Logger, domain string, ttl uint32) error {
-	logger = logger.Session("db-upsert-domain", lager.Data{"domain": domain, "ttl": ttl})
+func (db *SQLDB) UpsertDomain(ctx context.Context, logger lager.Logger, domainName string, ttl uint32, upsertedBy string) (bool, error) {
+	logger = logger.Session("db-upsert-domain", lager.Data{"domain": domainName, "ttl": ttl, "upserted_by": upsertedBy})
 	logger.Debug("starting")
 	defer logger.Debug("complete")
 
-	return db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
-		expireTime := db.clock.Now().Add(time.Duration(ttl) * time.Second).UnixNano()
+	var becameFresh bool
+	err := db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		now := db.clock.Now()
+		expireTime := now.Add(time.Duration(ttl) * time.Second).UnixNano()
 		if ttl == 0 {
 			expireTime = math.MaxInt64
 		}
 
-		ok, err := db.upsert(ctx, logger, tx, domainsTable,
-			helpers.SQLAttributes{"domain": domain, "expire_time": expireTime},
-			"domain = ?", domain,
-		)
+		attributes := helpers.SQLAttributes{
+			"expire_time": expireTime,
+			"upserted_by": upsertedBy,
+			"upserted_at": now.UnixNano(),
+		}
+
+		existing, err := db.lockDomain(ctx, logger, tx, domainName)
+		switch err {
+		case nil:
+			becameFresh = !existing.fresh(now)
+			_, err = db.update(ctx, logger, tx, domainsTable, attributes, "domain = ?", domainName)
+		case sql.ErrNoRows:
+			becameFresh = true
+			attributes["domain"] = domainName
+			_, err = db.insert(ctx, logger, tx, domainsTable, attributes)
+		}
 
 		if err != nil {
 			logger.Error("failed-inserting-domain", err)
 			return err
 		}
 
-		if ok {
-			logger.Info("added-domain", lager.Data{"domain": domain})
+		if existing == nil {
+			logger.Info("added-domain", lager.Data{"domain": domainName})
 		}
 
 		return nil
 	})
+
+	return becameFresh, err
+}
+
+func (db *SQLDB) ExpireDomain(ctx context.Context, logger lager.Logger, domainName string) (bool, error) {
+	logger = logger.Session("db-expire-domain", lager.Data{"domain": domainName})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	var wasFresh bool
+	err := db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		existing, err := db.lockDomain(ctx, logger, tx, domainName)
+		if err != nil {
+			return err
+		}
+		wasFresh = existing.fresh(db.clock.Now())
+
+		_, err = db.update(ctx, logger, tx, domainsTable,
+			helpers.SQLAttributes{"expire_time": expiredDomainTime},
+			"domain = ?", domainName,
+		)
+		if err != nil {
+			logger.Error("failed-expiring-domain", err)
+			return err
+		}
+
+		return nil
+	})
+
+	return wasFresh, err
+}
+
+func (db *SQLDB) DeleteDomain(ctx context.Context, logger lager.Logger, domainName string) (bool, error) {
+	logger = logger.Session("db-delete-domain", lager.Data{"domain": domainName})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	var wasFresh bool
+	err := db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		existing, err := db.lockDomain(ctx, logger, tx, domainName)
+		if err != nil {
+			return err
+		}
+		wasFresh = existing.fresh(db.clock.Now())
+
+		_, err = db.delete(ctx, logger, tx, domainsTable, "domain = ?", domainName)
+		if err != nil {
+			logger.Error("failed-deleting-domain", err)
+			return err
+		}
+
+		return nil
+	})
+
+	return wasFresh, err
+}
+
+func (db *SQLDB) lockDomain(ctx context.Context, logger lager.Logger, tx helpers.Tx, domainName string) (*domain, error) {
+	row := db.one(ctx, logger, tx, domainsTable,
+		domainColumns, helpers.LockRow,
+		"domain = ?", domainName,
+	)
+	d, err := db.fetchDomain(logger, row)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+func (db *SQLDB) fetchDomain(logger lager.Logger, scanner helpers.RowScanner) (domain, error) {
+	var d domain
+	var expiresAt int64
+
+	err := scanner.Scan(&d.name, &expiresAt, &d.upsertedBy, &d.upsertedAt)
+	if err == sql.ErrNoRows {
+		return d, err
+	}
+
+	if err != nil {
+		logger.Error("failed-scan-row", err)
+		return d, err
+	}
+
+	d.expiresAt = time.Unix(0, expiresAt)
+	return d, nil
 }
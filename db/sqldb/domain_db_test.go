@@ -4,6 +4,7 @@ import (
 	"math"
 	"time"
 
+	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/test_helpers"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -16,7 +17,7 @@ var _ = Describe("DomainDB", func() {
 			BeforeEach(func() {
 				futureTime := fakeClock.Now().Add(5 * time.Second).UnixNano()
 
-				queryStr := "INSERT INTO domains VALUES (?, ?)"
+				queryStr := "INSERT INTO domains (domain, expire_time) VALUES (?, ?)"
 				if test_helpers.UsePostgres() {
 					queryStr = test_helpers.ReplaceQuestionMarks(queryStr)
 				}
@@ -56,27 +57,30 @@ var _ = Describe("DomainDB", func() {
 		Context("when the domain is not present in the DB", func() {
 			It("inserts a new domain with the requested TTL", func() {
 				domain := "my-awesome-domain"
-				bbsErr := sqlDB.UpsertDomain(ctx, logger, domain, 5432)
+				becameFresh, bbsErr := sqlDB.UpsertDomain(ctx, logger, domain, 5432, "cloud-controller")
 				Expect(bbsErr).NotTo(HaveOccurred())
+				Expect(becameFresh).To(BeTrue())
 
-				rows, err := db.QueryContext(ctx, "SELECT * FROM domains;")
+				rows, err := db.QueryContext(ctx, "SELECT domain, expire_time, upserted_by, upserted_at FROM domains;")
 				Expect(err).NotTo(HaveOccurred())
 				defer rows.Close()
 
-				var domainName string
-				var expireTime int64
+				var domainName, upsertedBy string
+				var expireTime, upsertedAt int64
 
 				Expect(rows.Next()).To(BeTrue())
-				err = rows.Scan(&domainName, &expireTime)
+				err = rows.Scan(&domainName, &expireTime, &upsertedBy, &upsertedAt)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(domainName).To(Equal(domain))
 				expectedExpireTime := fakeClock.Now().UTC().Add(time.Duration(5432) * time.Second).UnixNano()
 				Expect(expireTime).To(BeEquivalentTo(expectedExpireTime))
+				Expect(upsertedBy).To(Equal("cloud-controller"))
+				Expect(upsertedAt).To(Equal(fakeClock.Now().UnixNano()))
 			})
 
 			It("logs that a new domain was inserted", func() {
 				domain := "my-awesome-domain"
-				bbsErr := sqlDB.UpsertDomain(ctx, logger, domain, 5432)
+				_, bbsErr := sqlDB.UpsertDomain(ctx, logger, domain, 5432, "")
 				Expect(bbsErr).NotTo(HaveOccurred())
 
 				Eventually(logger).Should(gbytes.Say("added-domain.*my-awesome-domain"))
@@ -85,10 +89,10 @@ var _ = Describe("DomainDB", func() {
 			It("never expires when the ttl is Zero", func() {
 				domain := "my-awesome-domain"
 
-				bbsErr := sqlDB.UpsertDomain(ctx, logger, domain, 0)
+				_, bbsErr := sqlDB.UpsertDomain(ctx, logger, domain, 0, "")
 				Expect(bbsErr).NotTo(HaveOccurred())
 
-				rows, err := db.QueryContext(ctx, "SELECT * FROM domains")
+				rows, err := db.QueryContext(ctx, "SELECT domain, expire_time FROM domains")
 				Expect(err).NotTo(HaveOccurred())
 				defer rows.Close()
 
@@ -105,7 +109,7 @@ var _ = Describe("DomainDB", func() {
 			Context("when the domain is too long", func() {
 				It("returns an error", func() {
 					domain := randStr(256)
-					bbsErr := sqlDB.UpsertDomain(ctx, logger, domain, 5432)
+					_, bbsErr := sqlDB.UpsertDomain(ctx, logger, domain, 5432, "")
 					Expect(bbsErr).To(HaveOccurred())
 				})
 			})
@@ -115,15 +119,16 @@ var _ = Describe("DomainDB", func() {
 			var existingDomain = "the-domain-that-was-already-there"
 
 			BeforeEach(func() {
-				bbsErr := sqlDB.UpsertDomain(ctx, logger, existingDomain, 1)
+				_, bbsErr := sqlDB.UpsertDomain(ctx, logger, existingDomain, 1, "")
 				Expect(bbsErr).NotTo(HaveOccurred())
 			})
 
 			It("updates the TTL on the existing record", func() {
 				fakeClock.Increment(10 * time.Second)
 
-				bbsErr := sqlDB.UpsertDomain(ctx, logger, existingDomain, 1)
+				becameFresh, bbsErr := sqlDB.UpsertDomain(ctx, logger, existingDomain, 1, "")
 				Expect(bbsErr).NotTo(HaveOccurred())
+				Expect(becameFresh).To(BeTrue())
 
 				rowsCount, err := db.QueryContext(ctx, "SELECT COUNT(*) FROM domains;")
 				Expect(err).NotTo(HaveOccurred())
@@ -136,7 +141,7 @@ var _ = Describe("DomainDB", func() {
 				Expect(domainCount).To(Equal(1))
 				Expect(rowsCount.Close()).To(Succeed())
 
-				rows, err := db.QueryContext(ctx, "SELECT * FROM domains;")
+				rows, err := db.QueryContext(ctx, "SELECT domain, expire_time FROM domains;")
 				Expect(err).NotTo(HaveOccurred())
 				defer rows.Close()
 
@@ -150,6 +155,132 @@ var _ = Describe("DomainDB", func() {
 				expectedExpireTime := fakeClock.Now().UTC().Add(time.Duration(1) * time.Second).UnixNano()
 				Expect(expireTime).To(BeEquivalentTo(expectedExpireTime))
 			})
+
+			It("reports that the domain did not become fresh when it was still fresh", func() {
+				becameFresh, bbsErr := sqlDB.UpsertDomain(ctx, logger, existingDomain, 1, "")
+				Expect(bbsErr).NotTo(HaveOccurred())
+				Expect(becameFresh).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("Domains", func() {
+		BeforeEach(func() {
+			_, err := sqlDB.UpsertDomain(ctx, logger, "fresh-domain", 10, "cloud-controller")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = sqlDB.UpsertDomain(ctx, logger, "stale-domain", 1, "10.0.0.1:4242")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = sqlDB.UpsertDomain(ctx, logger, "eternal-domain", 0, "cloud-controller")
+			Expect(err).NotTo(HaveOccurred())
+
+			fakeClock.Increment(5 * time.Second)
+		})
+
+		It("returns all the domains with their metadata", func() {
+			domains, err := sqlDB.Domains(ctx, logger)
+			Expect(err).NotTo(HaveOccurred())
+
+			upsertedAt := fakeClock.Now().Add(-5 * time.Second).UnixNano()
+			Expect(domains).To(ConsistOf(
+				&models.Domain{
+					Domain:     "fresh-domain",
+					ExpiresAt:  fakeClock.Now().Add(5 * time.Second).UnixNano(),
+					Fresh:      true,
+					UpsertedBy: "cloud-controller",
+					UpsertedAt: upsertedAt,
+				},
+				&models.Domain{
+					Domain:     "stale-domain",
+					ExpiresAt:  fakeClock.Now().Add(-4 * time.Second).UnixNano(),
+					Fresh:      false,
+					UpsertedBy: "10.0.0.1:4242",
+					UpsertedAt: upsertedAt,
+				},
+				&models.Domain{
+					Domain:     "eternal-domain",
+					ExpiresAt:  0,
+					Fresh:      true,
+					UpsertedBy: "cloud-controller",
+					UpsertedAt: upsertedAt,
+				},
+			))
+		})
+
+		It("reports that a stale domain became fresh when it is upserted", func() {
+			becameFresh, err := sqlDB.UpsertDomain(ctx, logger, "stale-domain", 10, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(becameFresh).To(BeTrue())
+		})
+	})
+
+	Describe("ExpireDomain", func() {
+		BeforeEach(func() {
+			_, err := sqlDB.UpsertDomain(ctx, logger, "some-domain", 10, "")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("makes the domain stale", func() {
+			wasFresh, err := sqlDB.ExpireDomain(ctx, logger, "some-domain")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(wasFresh).To(BeTrue())
+
+			freshDomains, err := sqlDB.FreshDomains(ctx, logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(freshDomains).To(BeEmpty())
+
+			domains, err := sqlDB.Domains(ctx, logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(domains).To(HaveLen(1))
+			Expect(domains[0].Domain).To(Equal("some-domain"))
+			Expect(domains[0].Fresh).To(BeFalse())
+		})
+
+		It("reports that the domain was not fresh when it is expired again", func() {
+			_, err := sqlDB.ExpireDomain(ctx, logger, "some-domain")
+			Expect(err).NotTo(HaveOccurred())
+
+			wasFresh, err := sqlDB.ExpireDomain(ctx, logger, "some-domain")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(wasFresh).To(BeFalse())
+		})
+
+		Context("when the domain does not exist", func() {
+			It("returns a resource not found error", func() {
+				_, err := sqlDB.ExpireDomain(ctx, logger, "unknown-domain")
+				Expect(err).To(Equal(models.ErrResourceNotFound))
+			})
+		})
+	})
+
+	Describe("DeleteDomain", func() {
+		BeforeEach(func() {
+			_, err := sqlDB.UpsertDomain(ctx, logger, "some-domain", 10, "")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("removes the domain", func() {
+			wasFresh, err := sqlDB.DeleteDomain(ctx, logger, "some-domain")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(wasFresh).To(BeTrue())
+
+			domains, err := sqlDB.Domains(ctx, logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(domains).To(BeEmpty())
+		})
+
+		It("reports that the domain was not fresh when it had expired", func() {
+			fakeClock.Increment(time.Minute)
+
+			wasFresh, err := sqlDB.DeleteDomain(ctx, logger, "some-domain")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(wasFresh).To(BeFalse())
+		})
+
+		Context("when the domain does not exist", func() {
+			It("returns a resource not found error", func() {
+				_, err := sqlDB.DeleteDomain(ctx, logger, "unknown-domain")
+				Expect(err).To(Equal(models.ErrResourceNotFound))
+			})
 		})
 	})
 })
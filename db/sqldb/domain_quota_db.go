@@ -0,0 +1,196 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func (db *SQLDB) UpsertDomainQuota(ctx context.Context, logger lager.Logger, quota *models.DomainQuota) error {
+	logger = logger.Session("db-upsert-domain-quota", lager.Data{"quota": quota})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	return db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		attributes := helpers.SQLAttributes{
+			"max_instances": quota.MaxInstances,
+			"max_memory_mb": quota.MaxMemoryMb,
+			"max_disk_mb":   quota.MaxDiskMb,
+			"max_tasks":     quota.MaxTasks,
+		}
+
+		// the helper's upsert cannot be used because MySQL reports no affected
+		// rows when a quota is replaced with an identical one
+		row := db.one(ctx, logger, tx, domainQuotasTable,
+			domainQuotaColumns, helpers.LockRow,
+			"domain = ?", quota.Domain,
+		)
+		_, err := db.fetchDomainQuota(logger, row)
+		switch err {
+		case nil:
+			_, err = db.update(ctx, logger, tx, domainQuotasTable, attributes, "domain = ?", quota.Domain)
+		case sql.ErrNoRows:
+			attributes["domain"] = quota.Domain
+			_, err = db.insert(ctx, logger, tx, domainQuotasTable, attributes)
+		}
+		if err != nil {
+			logger.Error("failed-upserting-domain-quota", err)
+			return err
+		}
+
+		return nil
+	})
+}
+
+func (db *SQLDB) DeleteDomainQuota(ctx context.Context, logger lager.Logger, domain string) error {
+	logger = logger.Session("db-delete-domain-quota", lager.Data{"domain": domain})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	result, err := db.delete(ctx, logger, db.db, domainQuotasTable, "domain = ?", domain)
+	if err != nil {
+		logger.Error("failed-deleting-domain-quota", err)
+		return db.convertSQLError(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Error("failed-rows-affected", err)
+		return db.convertSQLError(err)
+	}
+
+	if rowsAffected == 0 {
+		return models.ErrResourceNotFound
+	}
+
+	return nil
+}
+
+func (db *SQLDB) DomainQuotas(ctx context.Context, logger lager.Logger) ([]*models.DomainQuota, error) {
+	logger = logger.Session("db-domain-quotas")
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	rows, err := db.all(ctx, logger, db.db, domainQuotasTable,
+		domainQuotaColumns, helpers.NoLockRow,
+		"",
+	)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return nil, db.convertSQLError(err)
+	}
+	defer rows.Close()
+
+	quotas := []*models.DomainQuota{}
+	for rows.Next() {
+		quota, err := db.fetchDomainQuota(logger, rows)
+		if err != nil {
+			return nil, db.convertSQLError(err)
+		}
+		quotas = append(quotas, quota)
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-getting-next-row", rows.Err())
+		return nil, db.convertSQLError(rows.Err())
+	}
+
+	return quotas, nil
+}
+
+func (db *SQLDB) DomainUsage(ctx context.Context, logger lager.Logger, domain string) (*models.DomainUsage, error) {
+	logger = logger.Session("db-domain-usage", lager.Data{"domain": domain})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	usage, err := db.domainUsage(ctx, logger, db.db, domain)
+	if err != nil {
+		return nil, db.convertSQLError(err)
+	}
+
+	return usage, nil
+}
+
+// checkDomainQuota returns a QuotaExceeded error if allocating the requested
+// resources in the domain would exceed its quota. The quota is locked until
+// the end of the transaction so that concurrent allocations in the domain
+// are checked one after the other.
+func (db *SQLDB) checkDomainQuota(ctx context.Context, logger lager.Logger, tx helpers.Tx, domain string, requested *models.DomainUsage) error {
+	row := db.one(ctx, logger, tx, domainQuotasTable,
+		domainQuotaColumns, helpers.LockRow,
+		"domain = ?", domain,
+	)
+	quota, err := db.fetchDomainQuota(logger, row)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	usage, err := db.domainUsage(ctx, logger, tx, domain)
+	if err != nil {
+		return err
+	}
+
+	if limit := quota.ExceededLimit(usage, requested); limit != "" {
+		logger.Info("domain-quota-exceeded", lager.Data{"domain": domain, "limit": limit, "usage": usage, "requested": requested})
+		return models.NewQuotaExceededError(domain, limit)
+	}
+
+	return nil
+}
+
+func (db *SQLDB) domainUsage(ctx context.Context, logger lager.Logger, q helpers.Queryable, domain string) (*models.DomainUsage, error) {
+	usage := &models.DomainUsage{Domain: domain}
+
+	query := `
+		SELECT COALESCE(SUM(instances), 0),
+			COALESCE(SUM(instances * (memory_mb + sidecar_memory_mb)), 0),
+			COALESCE(SUM(instances * (disk_mb + sidecar_disk_mb)), 0)
+			FROM desired_lrps
+			WHERE domain = ?
+	`
+	err := q.QueryRowContext(ctx, db.helper.Rebind(query), domain).Scan(&usage.Instances, &usage.MemoryMb, &usage.DiskMb)
+	if err != nil {
+		logger.Error("failed-summing-desired-lrps", err)
+		return nil, err
+	}
+
+	tasks, err := db.helper.Count(ctx, logger, q, tasksTable,
+		"domain = ? AND state IN (?, ?, ?)",
+		domain, models.Task_Pending, models.Task_Running, models.Task_Blocked,
+	)
+	if err != nil {
+		logger.Error("failed-counting-tasks", err)
+		return nil, err
+	}
+	usage.Tasks = int32(tasks)
+
+	return usage, nil
+}
+
+func (db *SQLDB) fetchDomainQuota(logger lager.Logger, scanner helpers.RowScanner) (*models.DomainQuota, error) {
+	quota := &models.DomainQuota{}
+
+	err := scanner.Scan(
+		&quota.Domain,
+		&quota.MaxInstances,
+		&quota.MaxMemoryMb,
+		&quota.MaxDiskMb,
+		&quota.MaxTasks,
+	)
+	if err == sql.ErrNoRows {
+		return nil, err
+	}
+
+	if err != nil {
+		logger.Error("failed-scanning-row", err)
+		return nil, err
+	}
+
+	return quota, nil
+}
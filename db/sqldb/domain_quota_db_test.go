@@ -0,0 +1,191 @@
+package sqldb_test
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DomainQuotaDB", func() {
+	desireLRP := func(processGuid, domain string, instances int32) error {
+		desiredLRP := model_helpers.NewValidDesiredLRP(processGuid)
+		desiredLRP.Domain = domain
+		desiredLRP.Instances = instances
+		desiredLRP.MemoryMb = 256
+		desiredLRP.DiskMb = 1024
+		return sqlDB.DesireLRP(ctx, logger, desiredLRP)
+	}
+
+	expectQuotaExceeded := func(err error, limit string) {
+		Expect(err).To(HaveOccurred())
+		modelErr := models.ConvertError(err)
+		Expect(modelErr.Type).To(Equal(models.Error_QuotaExceeded))
+		Expect(modelErr.Message).To(ContainSubstring(limit))
+	}
+
+	Describe("UpsertDomainQuota", func() {
+		It("creates and replaces the quota of a domain", func() {
+			quota := &models.DomainQuota{Domain: "some-domain", MaxInstances: 10}
+			Expect(sqlDB.UpsertDomainQuota(ctx, logger, quota)).To(Succeed())
+			Expect(sqlDB.UpsertDomainQuota(ctx, logger, quota)).To(Succeed())
+
+			quotas, err := sqlDB.DomainQuotas(ctx, logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(quotas).To(ConsistOf(quota))
+
+			quota = &models.DomainQuota{Domain: "some-domain", MaxMemoryMb: 4096, MaxTasks: 3}
+			Expect(sqlDB.UpsertDomainQuota(ctx, logger, quota)).To(Succeed())
+
+			quotas, err = sqlDB.DomainQuotas(ctx, logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(quotas).To(ConsistOf(quota))
+		})
+	})
+
+	Describe("DeleteDomainQuota", func() {
+		It("deletes the quota of the domain", func() {
+			Expect(sqlDB.UpsertDomainQuota(ctx, logger, &models.DomainQuota{Domain: "some-domain", MaxTasks: 1})).To(Succeed())
+			Expect(sqlDB.DeleteDomainQuota(ctx, logger, "some-domain")).To(Succeed())
+
+			quotas, err := sqlDB.DomainQuotas(ctx, logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(quotas).To(BeEmpty())
+		})
+
+		Context("when the domain has no quota", func() {
+			It("returns a resource not found error", func() {
+				err := sqlDB.DeleteDomainQuota(ctx, logger, "some-domain")
+				Expect(err).To(Equal(models.ErrResourceNotFound))
+			})
+		})
+	})
+
+	Describe("DomainUsage", func() {
+		It("sums the LRP instances and counts the unfinished tasks of the domain", func() {
+			Expect(desireLRP("lrp-1", "some-domain", 2)).To(Succeed())
+			Expect(desireLRP("lrp-2", "some-domain", 3)).To(Succeed())
+			Expect(desireLRP("lrp-3", "other-domain", 4)).To(Succeed())
+
+			_, err := sqlDB.DesireTask(ctx, logger, model_helpers.NewValidTaskDefinition(), "task-1", "some-domain")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = sqlDB.DesireTask(ctx, logger, model_helpers.NewValidTaskDefinition(), "task-2", "some-domain")
+			Expect(err).NotTo(HaveOccurred())
+			_, _, _, err = sqlDB.StartTask(ctx, logger, "task-2", "some-cell")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = sqlDB.DesireTask(ctx, logger, model_helpers.NewValidTaskDefinition(), "task-3", "some-domain")
+			Expect(err).NotTo(HaveOccurred())
+			_, _, err = sqlDB.FailTask(ctx, logger, "task-3", "boom")
+			Expect(err).NotTo(HaveOccurred())
+
+			usage, err := sqlDB.DomainUsage(ctx, logger, "some-domain")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(usage).To(Equal(&models.DomainUsage{
+				Domain:    "some-domain",
+				Instances: 5,
+				MemoryMb:  5 * 256,
+				DiskMb:    5 * 1024,
+				Tasks:     2,
+			}))
+		})
+
+		It("includes the resources of the sidecars of the LRPs", func() {
+			desiredLRP := model_helpers.NewValidDesiredLRP("lrp-1")
+			desiredLRP.Domain = "some-domain"
+			desiredLRP.Instances = 2
+			desiredLRP.MemoryMb = 256
+			desiredLRP.DiskMb = 1024
+			desiredLRP.Sidecars = []*models.Sidecar{{Action: model_helpers.NewValidAction(), MemoryMb: 64, DiskMb: 32}}
+			Expect(sqlDB.DesireLRP(ctx, logger, desiredLRP)).To(Succeed())
+
+			usage, err := sqlDB.DomainUsage(ctx, logger, "some-domain")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(usage.MemoryMb).To(BeEquivalentTo(2 * 320))
+			Expect(usage.DiskMb).To(BeEquivalentTo(2 * 1056))
+		})
+
+		It("returns an empty usage for an unused domain", func() {
+			usage, err := sqlDB.DomainUsage(ctx, logger, "unused-domain")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(usage).To(Equal(&models.DomainUsage{Domain: "unused-domain"}))
+		})
+	})
+
+	Describe("quota enforcement", func() {
+		BeforeEach(func() {
+			Expect(sqlDB.UpsertDomainQuota(ctx, logger, &models.DomainQuota{
+				Domain:       "some-domain",
+				MaxInstances: 5,
+				MaxMemoryMb:  1024,
+				MaxTasks:     2,
+			})).To(Succeed())
+		})
+
+		It("rejects desired LRPs that exceed the quota of their domain", func() {
+			Expect(desireLRP("lrp-1", "some-domain", 3)).To(Succeed())
+			expectQuotaExceeded(desireLRP("lrp-2", "some-domain", 2), models.QuotaLimitMemoryMB)
+			Expect(desireLRP("lrp-2", "some-domain", 1)).To(Succeed())
+
+			Expect(desireLRP("lrp-3", "other-domain", 10)).To(Succeed())
+		})
+
+		It("rejects updates that scale LRPs beyond the quota of their domain", func() {
+			Expect(desireLRP("lrp-1", "some-domain", 2)).To(Succeed())
+
+			update := &models.DesiredLRPUpdate{}
+			update.SetInstances(5)
+			_, err := sqlDB.UpdateDesiredLRP(ctx, logger, "lrp-1", update)
+			expectQuotaExceeded(err, models.QuotaLimitMemoryMB)
+
+			update.SetInstances(4)
+			_, err = sqlDB.UpdateDesiredLRP(ctx, logger, "lrp-1", update)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("rejects run info updates whose sidecars exceed the quota of their domain", func() {
+			Expect(desireLRP("lrp-1", "some-domain", 2)).To(Succeed())
+			desiredLRP, err := sqlDB.DesiredLRPByProcessGuid(ctx, logger, "lrp-1")
+			Expect(err).NotTo(HaveOccurred())
+
+			runInfo := desiredLRP.DesiredLRPRunInfo(fakeClock.Now())
+			runInfo.Sidecars = []*models.Sidecar{{Action: model_helpers.NewValidAction(), MemoryMb: 512}}
+			update := &models.DesiredLRPUpdate{RunInfo: &runInfo}
+			_, err = sqlDB.UpdateDesiredLRP(ctx, logger, "lrp-1", update)
+			expectQuotaExceeded(err, models.QuotaLimitMemoryMB)
+
+			runInfo.Sidecars[0].MemoryMb = 256
+			_, err = sqlDB.UpdateDesiredLRP(ctx, logger, "lrp-1", update)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("lets LRPs scale down in a domain that is over its quota", func() {
+			Expect(desireLRP("lrp-1", "some-domain", 4)).To(Succeed())
+			Expect(sqlDB.UpsertDomainQuota(ctx, logger, &models.DomainQuota{Domain: "some-domain", MaxInstances: 1})).To(Succeed())
+
+			update := &models.DesiredLRPUpdate{}
+			update.SetInstances(3)
+			_, err := sqlDB.UpdateDesiredLRP(ctx, logger, "lrp-1", update)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("rejects tasks beyond the quota of their domain", func() {
+			_, err := sqlDB.DesireTask(ctx, logger, model_helpers.NewValidTaskDefinition(), "task-1", "some-domain")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = sqlDB.DesireTaskArray(ctx, logger, model_helpers.NewValidTaskDefinition(), "array-guid", "some-domain", 2)
+			expectQuotaExceeded(err, models.QuotaLimitTasks)
+
+			_, err = sqlDB.DesireTask(ctx, logger, model_helpers.NewValidTaskDefinition(), "task-2", "some-domain")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = sqlDB.DesireTask(ctx, logger, model_helpers.NewValidTaskDefinition(), "task-3", "some-domain")
+			expectQuotaExceeded(err, models.QuotaLimitTasks)
+
+			_, _, err = sqlDB.FailTask(ctx, logger, "task-1", "boom")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = sqlDB.DesireTask(ctx, logger, model_helpers.NewValidTaskDefinition(), "task-3", "some-domain")
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+})
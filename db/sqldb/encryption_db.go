@@ -52,6 +52,51 @@ func (db *SQLDB) PerformEncryption(ctx context.Context, logger lager.Logger) err
 				PrimaryKeyFunc:  func() primaryKey { return &taskPrimaryKey{} },
 			})
 		},
+		func() {
+			errCh <- db.reEncrypt(ctx, logger, encryptable{
+				TableName:       tasksTable,
+				PrimaryKeyNames: []string{"guid"},
+				Columns:         []string{"attempts"},
+				EncryptIfEmpty:  false,
+				PrimaryKeyFunc:  func() primaryKey { return &taskPrimaryKey{} },
+			})
+		},
+		func() {
+			errCh <- db.reEncrypt(ctx, logger, encryptable{
+				TableName:       tasksTable,
+				PrimaryKeyNames: []string{"guid"},
+				Columns:         []string{"result"},
+				EncryptIfEmpty:  false,
+				PrimaryKeyFunc:  func() primaryKey { return &taskPrimaryKey{} },
+			})
+		},
+		func() {
+			errCh <- db.reEncrypt(ctx, logger, encryptable{
+				TableName:       tasksTable,
+				PrimaryKeyNames: []string{"guid"},
+				Columns:         []string{"failure_reason"},
+				EncryptIfEmpty:  false,
+				PrimaryKeyFunc:  func() primaryKey { return &taskPrimaryKey{} },
+			})
+		},
+		func() {
+			errCh <- db.reEncrypt(ctx, logger, encryptable{
+				TableName:       taskResultsTable,
+				PrimaryKeyNames: []string{"guid"},
+				Columns:         []string{"result"},
+				EncryptIfEmpty:  false,
+				PrimaryKeyFunc:  func() primaryKey { return &taskPrimaryKey{} },
+			})
+		},
+		func() {
+			errCh <- db.reEncrypt(ctx, logger, encryptable{
+				TableName:       taskArchiveTable,
+				PrimaryKeyNames: []string{"guid", "archived_at"},
+				Columns:         []string{"failure_reason"},
+				EncryptIfEmpty:  false,
+				PrimaryKeyFunc:  func() primaryKey { return &archivedTaskPrimaryKey{} },
+			})
+		},
 		func() {
 			errCh <- db.reEncrypt(ctx, logger, encryptable{
 				TableName:       desiredLRPsTable,
@@ -61,6 +106,15 @@ func (db *SQLDB) PerformEncryption(ctx context.Context, logger lager.Logger) err
 				PrimaryKeyFunc:  func() primaryKey { return &desiredLRPPrimaryKey{} },
 			})
 		},
+		func() {
+			errCh <- db.reEncrypt(ctx, logger, encryptable{
+				TableName:       desiredLRPRevisionsTable,
+				PrimaryKeyNames: []string{"process_guid", "revision"},
+				Columns:         []string{"run_info", "scheduling_info"},
+				EncryptIfEmpty:  true,
+				PrimaryKeyFunc:  func() primaryKey { return &desiredLRPRevisionPrimaryKey{} },
+			})
+		},
 		func() {
 			errCh <- db.reEncrypt(ctx, logger, encryptable{
 				TableName:       actualLRPsTable,
@@ -70,6 +124,15 @@ func (db *SQLDB) PerformEncryption(ctx context.Context, logger lager.Logger) err
 				PrimaryKeyFunc:  func() primaryKey { return &actualLRPPrimaryKey{} },
 			})
 		},
+		func() {
+			errCh <- db.reEncrypt(ctx, logger, encryptable{
+				TableName:       scheduledTasksTable,
+				PrimaryKeyNames: []string{"guid"},
+				Columns:         []string{"task_definition"},
+				EncryptIfEmpty:  true,
+				PrimaryKeyFunc:  func() primaryKey { return &taskPrimaryKey{} },
+			})
+		},
 	}
 
 	for _, f := range funcs {
@@ -214,6 +277,19 @@ func (pk *desiredLRPPrimaryKey) WhereBindings() []interface{} {
 	return []interface{}{pk.ProcessGuid}
 }
 
+type desiredLRPRevisionPrimaryKey struct {
+	ProcessGuid string
+	Revision    int32
+}
+
+func (pk *desiredLRPRevisionPrimaryKey) Scan(row helpers.RowScanner) error {
+	return row.Scan(&pk.ProcessGuid, &pk.Revision)
+}
+
+func (pk *desiredLRPRevisionPrimaryKey) WhereBindings() []interface{} {
+	return []interface{}{pk.ProcessGuid, pk.Revision}
+}
+
 type taskPrimaryKey struct {
 	Guid string
 }
@@ -225,3 +301,16 @@ func (pk *taskPrimaryKey) Scan(row helpers.RowScanner) error {
 func (pk *taskPrimaryKey) WhereBindings() []interface{} {
 	return []interface{}{pk.Guid}
 }
+
+type archivedTaskPrimaryKey struct {
+	Guid       string
+	ArchivedAt int64
+}
+
+func (pk *archivedTaskPrimaryKey) Scan(row helpers.RowScanner) error {
+	return row.Scan(&pk.Guid, &pk.ArchivedAt)
+}
+
+func (pk *archivedTaskPrimaryKey) WhereBindings() []interface{} {
+	return []interface{}{pk.Guid, pk.ArchivedAt}
+}
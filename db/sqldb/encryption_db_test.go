@@ -178,7 +178,7 @@ var _ = Describe("Encryption", func() {
 			Expect(err).NotTo(HaveOccurred())
 			cryptor = makeCryptor("new", "old")
 
-			sqlDB := sqldb.NewSQLDB(db, 5, 5, cryptor, fakeGUIDProvider, fakeClock, dbFlavor, fakeMetronClient)
+			sqlDB := sqldb.NewSQLDB(db, 5, 5, 0, cryptor, fakeGUIDProvider, fakeClock, dbFlavor, fakeMetronClient)
 			err = sqlDB.PerformEncryption(ctx, logger)
 			Expect(err).NotTo(HaveOccurred())
 
@@ -240,7 +240,7 @@ var _ = Describe("Encryption", func() {
 
 			JustBeforeEach(func() {
 				cryptor = makeCryptor("new", "old")
-				sqlDB := sqldb.NewSQLDB(db, 5, 5, cryptor, fakeGUIDProvider, fakeClock, dbFlavor, fakeMetronClient)
+				sqlDB := sqldb.NewSQLDB(db, 5, 5, 0, cryptor, fakeGUIDProvider, fakeClock, dbFlavor, fakeMetronClient)
 				err := sqlDB.PerformEncryption(ctx, logger)
 				Expect(err).NotTo(HaveOccurred())
 			})
@@ -423,7 +423,7 @@ var _ = Describe("Encryption", func() {
 
 			cryptor = makeCryptor("new", "old")
 
-			sqlDB := sqldb.NewSQLDB(db, 5, 5, cryptor, fakeGUIDProvider, fakeClock, dbFlavor, fakeMetronClient)
+			sqlDB := sqldb.NewSQLDB(db, 5, 5, 0, cryptor, fakeGUIDProvider, fakeClock, dbFlavor, fakeMetronClient)
 			err = sqlDB.PerformEncryption(ctx, logger)
 			Expect(err).NotTo(HaveOccurred())
 		})
@@ -451,12 +451,22 @@ var _ = Describe("Encryption", func() {
 					"state":                  "running",
 					"modification_tag_epoch": "10",
 				},
+				"scheduled_tasks": {
+					"guid":     "some-guid",
+					"domain":   "fake-domain",
+					"schedule": "* * * * *",
+				},
+				"task_results": {"guid": "some-guid"},
+				"task_archive": {"guid": "some-guid", "domain": "fake-domain", "archived_at": 1},
 			}
 			dataTypesToEncrypt := map[string]bool{"text": true, "mediumtext": true, "longtext": true}
 			whiteListedFields := map[string]map[string]bool{
-				"tasks":        {"result": true},
-				"desired_lrps": {"annotation": true, "placement_tags": true},
-				"actual_lrps":  {},
+				"tasks":           {},
+				"desired_lrps":    {"annotation": true, "placement_tags": true},
+				"actual_lrps":     {},
+				"scheduled_tasks": {},
+				"task_results":    {},
+				"task_archive":    {},
 			}
 			var columnName, dataType string
 			dataToStore, err := encoder.Encode([]byte("actual value"))
@@ -515,7 +525,7 @@ var _ = Describe("Encryption", func() {
 			}
 
 			cryptor = makeCryptor("new", "old")
-			sqlDB := sqldb.NewSQLDB(db, 5, 5, cryptor, fakeGUIDProvider, fakeClock, dbFlavor, fakeMetronClient)
+			sqlDB := sqldb.NewSQLDB(db, 5, 5, 0, cryptor, fakeGUIDProvider, fakeClock, dbFlavor, fakeMetronClient)
 			err = sqlDB.PerformEncryption(ctx, logger)
 			Expect(err).NotTo(HaveOccurred())
 
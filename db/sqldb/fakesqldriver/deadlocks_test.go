@@ -41,7 +41,7 @@ var _ = Describe("Deadlocks", func() {
 
 	Context("UpsertDomain", func() {
 		It("retries on deadlocks", func() {
-			err := sqlDB.UpsertDomain(ctx, logger, "", 0)
+			_, err := sqlDB.UpsertDomain(ctx, logger, "", 0, "")
 			Expect(err).To(HaveOccurred())
 			Expect(fakeConn.BeginCallCount()).To(Equal(3))
 		})
@@ -24,7 +24,7 @@ func (sqldb *SQLDB) ConvergeLRPs(ctx context.Context, logger lager.Logger, cellS
 	defer logger.Info("complete")
 
 	now := sqldb.clock.Now()
-	sqldb.pruneDomains(ctx, logger, now)
+	domainEvents := sqldb.pruneDomains(ctx, logger, now)
 	events, instanceEvents := sqldb.pruneEvacuatingActualLRPs(ctx, logger, cellSet)
 	domainSet, err := sqldb.domainSet(ctx, logger)
 	if err != nil {
@@ -44,7 +44,8 @@ func (sqldb *SQLDB) ConvergeLRPs(ctx context.Context, logger lager.Logger, cellS
 	converge.crashedActualLRPs(ctx, logger, now)
 	converge.lrpsWithInternalRouteChanges(ctx, logger)
 	converge.lrpsWithMetricTagChanges(ctx, logger)
-
+	converge.lrpsWithOutdatedInstances(ctx, logger)
+	converge.lrpsWithRollingRestarts(ctx, logger)
 	return db.ConvergenceResult{
 		MissingLRPKeys:               converge.missingLRPKeys,
 		UnstartedLRPKeys:             converge.unstartedLRPKeys,
@@ -54,11 +55,15 @@ func (sqldb *SQLDB) ConvergeLRPs(ctx context.Context, logger lager.Logger, cellS
 		MissingCellIds:               converge.missingCellIds,
 		Events:                       events,
 		InstanceEvents:               instanceEvents,
+		DomainEvents:                 domainEvents,
 		SuspectKeysWithExistingCells: converge.suspectKeysWithExistingCells,
 		SuspectRunningKeys:           converge.suspectRunningKeys,
 		SuspectClaimedKeys:           converge.suspectClaimedKeys,
 		KeysWithInternalRouteChanges: converge.keysWithInternalRouteChanges,
 		KeysWithMetricTagChanges:     converge.keysWithMetricTagChanges,
+		LRPsToSurge:                  converge.lrpsToSurge,
+		LRPsToReplace:                converge.lrpsToReplace,
+		RollingRestartProcessGuids:   converge.rollingRestartProcessGuids,
 	}
 }
 
@@ -82,6 +87,11 @@ type convergence struct {
 
 	keysWithInternalRouteChanges []*db.ActualLRPKeyWithInternalRoutes
 	keysWithMetricTagChanges     []*db.ActualLRPKeyWithMetricTags
+
+	lrpsToSurge   []*models.ActualLRP
+	lrpsToReplace []*models.ActualLRP
+
+	rollingRestartProcessGuids []string
 }
 
 func newConvergence(db *SQLDB) *convergence {
@@ -125,7 +135,6 @@ func (c *convergence) staleUnclaimedActualLRPs(ctx context.Context, logger lager
 // and transitions them to UNCLAIMED.
 func (c *convergence) crashedActualLRPs(ctx context.Context, logger lager.Logger, now time.Time) {
 	logger = logger.Session("crashed-actual-lrps")
-	restartCalculator := models.NewDefaultRestartCalculator()
 
 	rows, err := c.selectCrashedLRPs(ctx, logger, c.db)
 	if err != nil {
@@ -145,7 +154,7 @@ func (c *convergence) crashedActualLRPs(ctx context.Context, logger lager.Logger
 		actual.ActualLRPKey = models.NewActualLRPKey(schedulingInfo.ProcessGuid, int32(index), schedulingInfo.Domain)
 		actual.State = models.ActualLRPStateCrashed
 
-		if actual.ShouldRestartCrash(now, restartCalculator) {
+		if actual.ShouldRestartCrash(now, schedulingInfo.RestartPolicy.RestartCalculator()) {
 			c.unstartedLRPKeys = append(c.unstartedLRPKeys, &models.ActualLRPKeyWithSchedulingInfo{
 				Key:            &actual.ActualLRPKey,
 				SchedulingInfo: schedulingInfo,
@@ -325,6 +334,109 @@ func (c *convergence) lrpsWithMetricTagChanges(ctx context.Context, logger lager
 
 }
 
+// Plans the next step of rolling the LRPs that have instances from an older
+// revision over to their current revision.
+func (c *convergence) lrpsWithOutdatedInstances(ctx context.Context, logger lager.Logger) {
+	logger = logger.Session("lrps-with-outdated-instances")
+
+	rows, err := c.selectLRPsWithOutdatedInstances(ctx, logger, c.db)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return
+	}
+
+	schedulingInfos := []*models.DesiredLRPSchedulingInfo{}
+	for rows.Next() {
+		schedulingInfo, err := c.fetchDesiredLRPSchedulingInfo(logger, rows)
+		if err != nil {
+			continue
+		}
+		schedulingInfos = append(schedulingInfos, schedulingInfo)
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-getting-next-row", rows.Err())
+	}
+
+	actualLRPsByProcessGuid, err := c.actualLRPsByProcessGuid(ctx, logger, schedulingInfos)
+	if err != nil {
+		logger.Error("failed-fetching-actual-lrps", err)
+		return
+	}
+
+	for _, schedulingInfo := range schedulingInfos {
+		plan := models.PlanRollout(schedulingInfo, actualLRPsByProcessGuid[schedulingInfo.ProcessGuid])
+		if plan.Empty() {
+			continue
+		}
+
+		c.lrpsToSurge = append(c.lrpsToSurge, plan.Surge...)
+		c.lrpsToReplace = append(c.lrpsToReplace, plan.Replace...)
+		logger.Info("rolling-out-revision", lager.Data{
+			"process_guid": schedulingInfo.ProcessGuid,
+			"revision":     schedulingInfo.Revision,
+			"surge":        len(plan.Surge),
+			"replace":      len(plan.Replace),
+		})
+	}
+}
+
+// Adds the LRPs with rolling restarts in progress to the list of rolling
+// restarts to advance, in case the start of their restarted instances did not
+// advance them.
+func (c *convergence) lrpsWithRollingRestarts(ctx context.Context, logger lager.Logger) {
+	logger = logger.Session("lrps-with-rolling-restarts")
+
+	rows, err := c.selectLRPsWithRollingRestarts(ctx, logger, c.db)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var processGuid string
+		err := rows.Scan(&processGuid)
+		if err != nil {
+			logger.Error("failed-scanning", err)
+			continue
+		}
+		c.rollingRestartProcessGuids = append(c.rollingRestartProcessGuids, processGuid)
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-getting-next-row", rows.Err())
+	}
+}
+
+// actualLRPsByProcessGuid fetches the actual LRPs of the given LRPs in one
+// query.
+func (c *convergence) actualLRPsByProcessGuid(ctx context.Context, logger lager.Logger, schedulingInfos []*models.DesiredLRPSchedulingInfo) (map[string][]*models.ActualLRP, error) {
+	actualLRPsByProcessGuid := map[string][]*models.ActualLRP{}
+	if len(schedulingInfos) == 0 {
+		return actualLRPsByProcessGuid, nil
+	}
+
+	processGuids := make([]interface{}, 0, len(schedulingInfos))
+	for _, schedulingInfo := range schedulingInfos {
+		processGuids = append(processGuids, schedulingInfo.ProcessGuid)
+	}
+
+	actualLRPs, err := c.getActualLRPs(ctx, logger,
+		fmt.Sprintf("process_guid IN (%s)", helpers.QuestionMarks(len(processGuids))),
+		processGuids...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, actualLRP := range actualLRPs {
+		actualLRPsByProcessGuid[actualLRP.ProcessGuid] = append(actualLRPsByProcessGuid[actualLRP.ProcessGuid], actualLRP)
+	}
+
+	return actualLRPsByProcessGuid, nil
+}
+
 func scanActualLRPs(logger lager.Logger, rows *sql.Rows) []*models.ActualLRPKey {
 	var actualLRPKeys []*models.ActualLRPKey
 	for rows.Next() {
@@ -446,7 +558,7 @@ func (c *convergence) lrpInstanceCounts(ctx context.Context, logger lager.Logger
 			}
 		}
 
-		for i := 0; i < int(schedulingInfo.Instances); i++ {
+		for i := 0; i < int(schedulingInfo.ScheduledInstances()); i++ {
 			_, found := existingIndices[i]
 			if found {
 				continue
@@ -466,7 +578,7 @@ func (c *convergence) lrpInstanceCounts(ctx context.Context, logger lager.Logger
 		}
 
 		for index := range existingIndices {
-			if index < int(schedulingInfo.Instances) {
+			if index < int(schedulingInfo.ScheduledInstances()) {
 				continue
 			}
 
@@ -551,10 +663,15 @@ func (c *convergence) actualLRPsWithMissingCells(ctx context.Context, logger lag
 	c.ordinaryKeysWithMissingCells = ordinaryKeysWithMissingCells
 }
 
-func (db *SQLDB) pruneDomains(ctx context.Context, logger lager.Logger, now time.Time) {
+// pruneDomains deletes the expired domains and returns a stale event for each
+// of them whose TTL ran out. Domains that were expired explicitly already had
+// their stale event emitted.
+func (db *SQLDB) pruneDomains(ctx context.Context, logger lager.Logger, now time.Time) []models.Event {
 	logger = logger.Session("prune-domains")
 
+	var events []models.Event
 	err := db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		events = nil
 		domains, err := db.domains(ctx, logger, tx, time.Time{})
 		if err != nil {
 			return err
@@ -570,6 +687,10 @@ func (db *SQLDB) pruneDomains(ctx context.Context, logger lager.Logger, now time
 			if err != nil {
 				return err
 			}
+
+			if d.expiresAt.UnixNano() != expiredDomainTime {
+				events = append(events, models.NewDomainStaleEvent(d.name))
+			}
 		}
 
 		return nil
@@ -577,7 +698,10 @@ func (db *SQLDB) pruneDomains(ctx context.Context, logger lager.Logger, now time
 
 	if err != nil {
 		logger.Error("cannot-prune-domains", err)
+		return nil
 	}
+
+	return events
 }
 
 func (db *SQLDB) pruneEvacuatingActualLRPs(ctx context.Context, logger lager.Logger, cellSet models.CellSet) ([]models.Event, []models.Event) {
@@ -108,7 +108,7 @@ var _ = Describe("LRPConvergence", func() {
 
 		BeforeEach(func() {
 			fakeClock.Increment(-10 * time.Second)
-			sqlDB.UpsertDomain(ctx, logger, expiredDomain, 5)
+			sqlDB.UpsertDomain(ctx, logger, expiredDomain, 5, "")
 			fakeClock.Increment(10 * time.Second)
 		})
 
@@ -139,6 +139,23 @@ var _ = Describe("LRPConvergence", func() {
 			sqlDB.ConvergeLRPs(ctx, logger, cellSet)
 			Eventually(logger).Should(gbytes.Say("pruning-domain.*expired-domain"))
 		})
+
+		It("returns a stale event for the expired domains", func() {
+			result := sqlDB.ConvergeLRPs(ctx, logger, cellSet)
+			Expect(result.DomainEvents).To(ConsistOf(models.NewDomainStaleEvent(expiredDomain)))
+		})
+
+		Context("when the domain was expired explicitly", func() {
+			BeforeEach(func() {
+				_, err := sqlDB.ExpireDomain(ctx, logger, expiredDomain)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("does not return a stale event for it again", func() {
+				result := sqlDB.ConvergeLRPs(ctx, logger, cellSet)
+				Expect(result.DomainEvents).To(BeEmpty())
+			})
+		})
 	})
 
 	Context("when there are unclaimed LRPs", func() {
@@ -161,7 +178,7 @@ var _ = Describe("LRPConvergence", func() {
 
 		Context("when the domain is fresh", func() {
 			BeforeEach(func() {
-				Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5)).To(Succeed())
+				Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5, "")).Error().NotTo(HaveOccurred())
 			})
 
 			It("does not touch the ActualLRPs in the database", func() {
@@ -184,7 +201,7 @@ var _ = Describe("LRPConvergence", func() {
 
 		Context("when the ActualLRP's presence is set to evacuating", func() {
 			BeforeEach(func() {
-				Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5)).To(Succeed())
+				Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5, "")).Error().NotTo(HaveOccurred())
 
 				queryStr := `UPDATE actual_lrps SET presence = ? WHERE process_guid = ?`
 				if test_helpers.UsePostgres() {
@@ -404,7 +421,7 @@ var _ = Describe("LRPConvergence", func() {
 			})
 
 			domain := "some-domain"
-			Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5)).To(Succeed())
+			Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5, "")).Error().NotTo(HaveOccurred())
 
 			var err error
 
@@ -463,7 +480,7 @@ var _ = Describe("LRPConvergence", func() {
 
 		Context("when the domain is fresh", func() {
 			BeforeEach(func() {
-				Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5)).To(Succeed())
+				Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5, "")).Error().NotTo(HaveOccurred())
 			})
 
 			It("does not retire the extra lrps", func() {
@@ -520,7 +537,7 @@ var _ = Describe("LRPConvergence", func() {
 
 		Context("when the domain is fresh", func() {
 			BeforeEach(func() {
-				Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5)).To(Succeed())
+				Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5, "")).Error().NotTo(HaveOccurred())
 			})
 
 			It("returns start requests", func() {
@@ -558,7 +575,7 @@ var _ = Describe("LRPConvergence", func() {
 		Context("when the domain is expired", func() {
 			BeforeEach(func() {
 				fakeClock.Increment(-10 * time.Second)
-				Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5)).To(Succeed())
+				Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5, "")).Error().NotTo(HaveOccurred())
 				fakeClock.Increment(10 * time.Second)
 			})
 
@@ -596,7 +613,7 @@ var _ = Describe("LRPConvergence", func() {
 
 		Context("when the ActualLRPs presence is set to evacuating", func() {
 			BeforeEach(func() {
-				Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5)).To(Succeed())
+				Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5, "")).Error().NotTo(HaveOccurred())
 
 				queryStr := `UPDATE actual_lrps SET presence = ?`
 				if test_helpers.UsePostgres() {
@@ -686,7 +703,7 @@ var _ = Describe("LRPConvergence", func() {
 
 		Context("when the domain is fresh", func() {
 			BeforeEach(func() {
-				Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5)).To(Succeed())
+				Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5, "")).Error().NotTo(HaveOccurred())
 			})
 
 			It("returns the start requests, actual lrp keys for actuals with missing cells and missing cell ids", func() {
@@ -725,7 +742,7 @@ var _ = Describe("LRPConvergence", func() {
 		Context("when the domain is expired", func() {
 			BeforeEach(func() {
 				fakeClock.Increment(-10 * time.Second)
-				Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5)).To(Succeed())
+				Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5, "")).Error().NotTo(HaveOccurred())
 				fakeClock.Increment(10 * time.Second)
 			})
 
@@ -762,7 +779,7 @@ var _ = Describe("LRPConvergence", func() {
 
 		Context("when the lrp is evacuating", func() {
 			BeforeEach(func() {
-				Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5)).To(Succeed())
+				Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5, "")).Error().NotTo(HaveOccurred())
 
 				queryStr := `UPDATE actual_lrps SET presence = ?`
 				if test_helpers.UsePostgres() {
@@ -852,7 +869,7 @@ var _ = Describe("LRPConvergence", func() {
 
 		Context("when the domain is fresh", func() {
 			BeforeEach(func() {
-				Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5)).To(Succeed())
+				Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5, "")).Error().NotTo(HaveOccurred())
 			})
 
 			It("returns extra ActualLRPs to be retired", func() {
@@ -884,7 +901,7 @@ var _ = Describe("LRPConvergence", func() {
 		Context("when the domain is expired", func() {
 			BeforeEach(func() {
 				fakeClock.Increment(-10 * time.Second)
-				Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5)).To(Succeed())
+				Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5, "")).Error().NotTo(HaveOccurred())
 				fakeClock.Increment(10 * time.Second)
 			})
 
@@ -913,7 +930,7 @@ var _ = Describe("LRPConvergence", func() {
 
 		Context("when the ActualLRP's presence is set to evacuating", func() {
 			BeforeEach(func() {
-				Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5)).To(Succeed())
+				Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5, "")).Error().NotTo(HaveOccurred())
 
 				queryStr := `UPDATE actual_lrps SET presence = ? WHERE process_guid = ?`
 				if test_helpers.UsePostgres() {
@@ -955,7 +972,7 @@ var _ = Describe("LRPConvergence", func() {
 
 		Context("and the domain is fresh", func() {
 			BeforeEach(func() {
-				Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5)).To(Succeed())
+				Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5, "")).Error().NotTo(HaveOccurred())
 			})
 
 			It("should have the correct number of missing LRP instances", func() {
@@ -988,7 +1005,7 @@ var _ = Describe("LRPConvergence", func() {
 		Context("and the domain is expired", func() {
 			BeforeEach(func() {
 				fakeClock.Increment(-10 * time.Second)
-				Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5)).To(Succeed())
+				Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5, "")).Error().NotTo(HaveOccurred())
 				fakeClock.Increment(10 * time.Second)
 			})
 
@@ -1020,6 +1037,38 @@ var _ = Describe("LRPConvergence", func() {
 		})
 	})
 
+	Context("when the ActualLRPs are crashed and their restart policy allows no more restarts", func() {
+		var processGuid string
+
+		BeforeEach(func() {
+			processGuid = "desired-with-restart-policy"
+			desiredLRP := model_helpers.NewValidDesiredLRP(processGuid)
+			desiredLRP.Domain = "some-domain"
+			desiredLRP.Instances = 1
+			desiredLRP.RestartPolicy = &models.RestartPolicy{ImmediateRestarts: 0, MaxBackoffDurationMs: 60000, MaxRestarts: 1}
+			err := sqlDB.DesireLRP(ctx, logger, desiredLRP)
+			Expect(err).NotTo(HaveOccurred())
+
+			key := models.NewActualLRPKey(processGuid, 0, "some-domain")
+			instanceKey := models.ActualLRPInstanceKey{InstanceGuid: "crashed-instance", CellId: "existing-cell"}
+			actualLRPNetInfo := models.NewActualLRPNetInfo("some-address", "container-address", models.ActualLRPNetInfo_PreferredAddressUnknown, models.NewPortMapping(2222, 4444))
+			_, _, err = sqlDB.StartActualLRP(ctx, logger, &key, &instanceKey, &actualLRPNetInfo, model_helpers.NewActualLRPInternalRoutes(), model_helpers.NewActualLRPMetricTags(), false, "some-zone")
+			Expect(err).NotTo(HaveOccurred())
+			_, _, shouldRestart, err := sqlDB.CrashActualLRP(ctx, logger, &key, &instanceKey, "whatever")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(shouldRestart).To(BeFalse())
+
+			fakeClock.Increment(time.Hour)
+		})
+
+		It("does not restart them", func() {
+			result := sqlDB.ConvergeLRPs(ctx, logger, cellSet)
+			for _, lrpKey := range result.UnstartedLRPKeys {
+				Expect(lrpKey.Key.ProcessGuid).NotTo(Equal(processGuid))
+			}
+		})
+	})
+
 	Context("when the ActualLRPs are crashed and restartable", func() {
 		var (
 			domain      string
@@ -1064,7 +1113,7 @@ var _ = Describe("LRPConvergence", func() {
 
 		Context("when the domain is fresh", func() {
 			BeforeEach(func() {
-				Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5)).To(Succeed())
+				Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5, "")).Error().NotTo(HaveOccurred())
 			})
 
 			It("should have the correct number of crashed LRP instances", func() {
@@ -1090,7 +1139,7 @@ var _ = Describe("LRPConvergence", func() {
 		Context("when the domain is expired", func() {
 			BeforeEach(func() {
 				fakeClock.Increment(-10 * time.Second)
-				Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5)).To(Succeed())
+				Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5, "")).Error().NotTo(HaveOccurred())
 				fakeClock.Increment(10 * time.Second)
 			})
 
@@ -1116,7 +1165,7 @@ var _ = Describe("LRPConvergence", func() {
 
 		Context("when the the lrps are evacuating", func() {
 			BeforeEach(func() {
-				Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5)).To(Succeed())
+				Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5, "")).Error().NotTo(HaveOccurred())
 
 				queryStr := `UPDATE actual_lrps SET presence = ?`
 				if test_helpers.UsePostgres() {
@@ -1229,7 +1278,7 @@ var _ = Describe("LRPConvergence", func() {
 
 		Context("when the domain is fresh", func() {
 			BeforeEach(func() {
-				Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5)).To(Succeed())
+				Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5, "")).Error().NotTo(HaveOccurred())
 			})
 
 			It("should have the correct number of crashed LRP instances", func() {
@@ -1247,7 +1296,7 @@ var _ = Describe("LRPConvergence", func() {
 		Context("when the domain is expired", func() {
 			BeforeEach(func() {
 				fakeClock.Increment(-10 * time.Second)
-				Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5)).To(Succeed())
+				Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5, "")).Error().NotTo(HaveOccurred())
 				fakeClock.Increment(10 * time.Second)
 			})
 
@@ -1279,7 +1328,7 @@ var _ = Describe("LRPConvergence", func() {
 
 		Context("when the domain is fresh", func() {
 			BeforeEach(func() {
-				Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5)).To(Succeed())
+				Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5, "")).Error().NotTo(HaveOccurred())
 			})
 
 			It("returns extra ActualLRPs to be retired", func() {
@@ -1317,7 +1366,7 @@ var _ = Describe("LRPConvergence", func() {
 		Context("when the domain is expired", func() {
 			BeforeEach(func() {
 				fakeClock.Increment(-10 * time.Second)
-				Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5)).To(Succeed())
+				Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5, "")).Error().NotTo(HaveOccurred())
 				fakeClock.Increment(10 * time.Second)
 			})
 
@@ -1352,7 +1401,7 @@ var _ = Describe("LRPConvergence", func() {
 
 		Context("when the the lrps are evacuating", func() {
 			BeforeEach(func() {
-				Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5)).To(Succeed())
+				Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5, "")).Error().NotTo(HaveOccurred())
 
 				queryStr := `UPDATE actual_lrps SET presence = ?`
 				if test_helpers.UsePostgres() {
@@ -1535,4 +1584,180 @@ var _ = Describe("LRPConvergence", func() {
 			Expect(result.KeysWithMetricTagChanges).To(ConsistOf(&lrpKeyWithMetricTags1, &lrpKeyWithMetricTags2))
 		})
 	})
+
+	Context("when there are actual LRPs at an older revision than the desired LRP", func() {
+		var (
+			processGuid string
+			lrpKey      models.ActualLRPKey
+		)
+
+		BeforeEach(func() {
+			processGuid = "desired-with-outdated-actuals"
+			desiredLRP := model_helpers.NewValidDesiredLRP(processGuid)
+			desiredLRP.Domain = "some-domain"
+			desiredLRP.Instances = 2
+			err := sqlDB.DesireLRP(ctx, logger, desiredLRP)
+			Expect(err).NotTo(HaveOccurred())
+
+			actualLRPNetInfo := models.NewActualLRPNetInfo("some-address", "container-address", models.ActualLRPNetInfo_PreferredAddressUnknown, models.NewPortMapping(2222, 4444))
+			for i := int32(0); i < 2; i++ {
+				key := models.NewActualLRPKey(processGuid, i, "some-domain")
+				instanceKey := models.ActualLRPInstanceKey{InstanceGuid: fmt.Sprintf("ig-%d", i), CellId: "existing-cell"}
+				_, _, err = sqlDB.StartActualLRP(ctx, logger, &key, &instanceKey, &actualLRPNetInfo, model_helpers.NewActualLRPInternalRoutes(), model_helpers.NewActualLRPMetricTags(), true, "some-zone")
+				Expect(err).NotTo(HaveOccurred())
+			}
+			lrpKey = models.NewActualLRPKey(processGuid, 0, "some-domain")
+
+			queryStr := `UPDATE desired_lrps SET revision = ? WHERE process_guid = ?`
+			if test_helpers.UsePostgres() {
+				queryStr = test_helpers.ReplaceQuestionMarks(queryStr)
+			}
+			_, err = db.ExecContext(ctx, queryStr, 1, processGuid)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns the instances to surge according to the default rollout strategy", func() {
+			result := sqlDB.ConvergeLRPs(ctx, logger, cellSet)
+			Expect(result.LRPsToSurge).To(HaveLen(1))
+			Expect(result.LRPsToSurge[0].ActualLRPKey).To(Equal(lrpKey))
+			Expect(result.LRPsToReplace).To(BeEmpty())
+		})
+
+		Context("and the surged instance registers again before its replacement runs", func() {
+			BeforeEach(func() {
+				actualLRPNetInfo := models.NewActualLRPNetInfo("some-address", "container-address", models.ActualLRPNetInfo_PreferredAddressUnknown, models.NewPortMapping(2222, 4444))
+				instanceKey := models.ActualLRPInstanceKey{InstanceGuid: "ig-0", CellId: "existing-cell"}
+
+				_, err := sqlDB.EvacuateActualLRP(ctx, logger, &lrpKey, &instanceKey, &actualLRPNetInfo, model_helpers.NewActualLRPInternalRoutes(), model_helpers.NewActualLRPMetricTags(), true, "some-zone")
+				Expect(err).NotTo(HaveOccurred())
+				_, _, err = sqlDB.UnclaimActualLRP(ctx, logger, &lrpKey)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, _, err = sqlDB.StartActualLRP(ctx, logger, &lrpKey, &instanceKey, &actualLRPNetInfo, model_helpers.NewActualLRPInternalRoutes(), model_helpers.NewActualLRPMetricTags(), true, "some-zone")
+				Expect(err).To(Equal(models.ErrActualLRPCannotBeStarted))
+			})
+
+			It("does not surge it again", func() {
+				result := sqlDB.ConvergeLRPs(ctx, logger, cellSet)
+				Expect(result.LRPsToSurge).To(BeEmpty())
+				Expect(result.LRPsToReplace).To(BeEmpty())
+			})
+		})
+	})
+
+	Context("when the desired LRP is paused", func() {
+		var processGuid string
+
+		BeforeEach(func() {
+			processGuid = "paused-desired"
+			desiredLRP := model_helpers.NewValidDesiredLRP(processGuid)
+			desiredLRP.Domain = "some-domain"
+			desiredLRP.Instances = 2
+			desiredLRP.Paused = true
+			err := sqlDB.DesireLRP(ctx, logger, desiredLRP)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sqlDB.UpsertDomain(ctx, logger, "some-domain", 5, "")).Error().NotTo(HaveOccurred())
+
+			_, err = sqlDB.CreateUnclaimedActualLRP(ctx, logger, &models.ActualLRPKey{ProcessGuid: processGuid, Index: 0, Domain: "some-domain"})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("retires its ActualLRPs and does not create missing ones", func() {
+			result := sqlDB.ConvergeLRPs(ctx, logger, cellSet)
+			Expect(result.KeysToRetire).To(ContainElement(&models.ActualLRPKey{ProcessGuid: processGuid, Index: 0, Domain: "some-domain"}))
+			for _, lrpKey := range result.MissingLRPKeys {
+				Expect(lrpKey.Key.ProcessGuid).NotTo(Equal(processGuid))
+			}
+		})
+
+		It("does not count its instances as desired", func() {
+			Expect(sqlDB.CountDesiredInstances(ctx, logger)).To(BeZero())
+		})
+
+		Context("and its instances are at an older revision", func() {
+			BeforeEach(func() {
+				actualLRPNetInfo := models.NewActualLRPNetInfo("some-address", "container-address", models.ActualLRPNetInfo_PreferredAddressUnknown, models.NewPortMapping(2222, 4444))
+				key := models.NewActualLRPKey(processGuid, 1, "some-domain")
+				instanceKey := models.ActualLRPInstanceKey{InstanceGuid: "ig-1", CellId: "existing-cell"}
+				_, _, err := sqlDB.StartActualLRP(ctx, logger, &key, &instanceKey, &actualLRPNetInfo, model_helpers.NewActualLRPInternalRoutes(), model_helpers.NewActualLRPMetricTags(), true, "some-zone")
+				Expect(err).NotTo(HaveOccurred())
+
+				queryStr := `UPDATE desired_lrps SET revision = ? WHERE process_guid = ?`
+				if test_helpers.UsePostgres() {
+					queryStr = test_helpers.ReplaceQuestionMarks(queryStr)
+				}
+				_, err = db.ExecContext(ctx, queryStr, 1, processGuid)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("does not roll them out", func() {
+				result := sqlDB.ConvergeLRPs(ctx, logger, cellSet)
+				for _, lrp := range append(result.LRPsToSurge, result.LRPsToReplace...) {
+					Expect(lrp.ProcessGuid).NotTo(Equal(processGuid))
+				}
+			})
+		})
+
+		Context("and it has a rolling restart in progress", func() {
+			BeforeEach(func() {
+				Expect(sqlDB.StartRollingRestart(ctx, logger, processGuid, 1)).To(Succeed())
+			})
+
+			It("does not advance it", func() {
+				result := sqlDB.ConvergeLRPs(ctx, logger, cellSet)
+				Expect(result.RollingRestartProcessGuids).NotTo(ContainElement(processGuid))
+			})
+		})
+	})
+
+	Context("when the desired LRP has a rolling restart in progress", func() {
+		var processGuid string
+
+		BeforeEach(func() {
+			processGuid = "desired-with-rolling-restart"
+			desiredLRP := model_helpers.NewValidDesiredLRP(processGuid)
+			desiredLRP.Domain = "some-domain"
+			err := sqlDB.DesireLRP(ctx, logger, desiredLRP)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sqlDB.StartRollingRestart(ctx, logger, processGuid, 1)).To(Succeed())
+		})
+
+		It("returns it to advance its rolling restart", func() {
+			result := sqlDB.ConvergeLRPs(ctx, logger, cellSet)
+			Expect(result.RollingRestartProcessGuids).To(ConsistOf(processGuid))
+		})
+	})
+
+	Context("when the actual LRPs are skewed across zones beyond their zone spread constraint", func() {
+		var processGuid string
+
+		BeforeEach(func() {
+			processGuid = "desired-with-zone-skew"
+			desiredLRP := model_helpers.NewValidDesiredLRP(processGuid)
+			desiredLRP.Domain = "some-domain"
+			desiredLRP.Instances = 3
+			desiredLRP.PlacementTags = nil
+			desiredLRP.ZoneSpreadConstraint = &models.ZoneSpreadConstraint{MaxSkew: 1}
+			err := sqlDB.DesireLRP(ctx, logger, desiredLRP)
+			Expect(err).NotTo(HaveOccurred())
+
+			actualLRPNetInfo := models.NewActualLRPNetInfo("some-address", "container-address", models.ActualLRPNetInfo_PreferredAddressUnknown, models.NewPortMapping(2222, 4444))
+			for i := int32(0); i < 3; i++ {
+				key := models.NewActualLRPKey(processGuid, i, "some-domain")
+				instanceKey := models.ActualLRPInstanceKey{InstanceGuid: fmt.Sprintf("ig-%d", i), CellId: "existing-cell"}
+				_, _, err = sqlDB.StartActualLRP(ctx, logger, &key, &instanceKey, &actualLRPNetInfo, model_helpers.NewActualLRPInternalRoutes(), model_helpers.NewActualLRPMetricTags(), false, "some-zone")
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			cellSet = models.NewCellSetFromList([]*models.CellPresence{
+				{CellId: "existing-cell", Zone: "some-zone"},
+				{CellId: "other-cell", Zone: "other-zone"},
+			})
+		})
+
+		It("does not move any instance, as the auctioneer cannot place them by the constraint", func() {
+			result := sqlDB.ConvergeLRPs(ctx, logger, cellSet)
+			Expect(result.LRPsToSurge).To(BeEmpty())
+		})
+	})
 })
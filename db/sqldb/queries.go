@@ -17,6 +17,21 @@ const (
 	desiredLRPsTable = "desired_lrps"
 	actualLRPsTable  = "actual_lrps"
 	domainsTable     = "domains"
+
+	desiredLRPRevisionsTable = "desired_lrp_revisions"
+
+	domainQuotasTable = "domain_quotas"
+
+	cordonedCellsTable = "cordoned_cells"
+
+	scheduledTasksTable    = "scheduled_tasks"
+	scheduledTaskRunsTable = "scheduled_task_runs"
+
+	taskArchiveTable      = "task_archive"
+	taskResultsTable      = "task_results"
+	taskDependenciesTable = "task_dependencies"
+
+	actualLRPCrashesTable = "actual_lrp_crashes"
 )
 
 var (
@@ -46,6 +61,16 @@ var (
 		desiredLRPsTable + ".modification_tag_epoch",
 		desiredLRPsTable + ".modification_tag_index",
 		desiredLRPsTable + ".placement_tags",
+		desiredLRPsTable + ".revision",
+		desiredLRPsTable + ".rollout_max_surge",
+		desiredLRPsTable + ".rollout_max_unavailable",
+		desiredLRPsTable + ".restart_immediate_restarts",
+		desiredLRPsTable + ".restart_max_backoff_duration_ms",
+		desiredLRPsTable + ".restart_max_restarts",
+		desiredLRPsTable + ".zone_spread_max_skew",
+		desiredLRPsTable + ".paused",
+		desiredLRPsTable + ".sidecar_memory_mb",
+		desiredLRPsTable + ".sidecar_disk_mb",
 	}
 
 	desiredLRPColumns = append(schedulingInfoColumns,
@@ -67,8 +92,16 @@ var (
 		tasksTable + ".task_definition",
 		tasksTable + ".rejection_count",
 		tasksTable + ".rejection_reason",
+		tasksTable + ".array_guid",
+		tasksTable + ".array_index",
+		tasksTable + ".attempts",
+		tasksTable + ".retry_at",
 	}
 
+	// taskColumnsWithoutResult are the taskColumns with an empty result in
+	// place of the result, for the task lists that leave results out.
+	taskColumnsWithoutResult = replaceColumn(taskColumns, tasksTable+".result", "'' AS result")
+
 	actualLRPColumns = helpers.ColumnList{
 		actualLRPsTable + ".process_guid",
 		actualLRPsTable + ".instance_index",
@@ -88,6 +121,9 @@ var (
 		actualLRPsTable + ".modification_tag_index",
 		actualLRPsTable + ".crash_count",
 		actualLRPsTable + ".crash_reason",
+		actualLRPsTable + ".revision",
+		actualLRPsTable + ".next_restart_at",
+		actualLRPsTable + ".restarts_exhausted",
 	}
 
 	actualLRPIDColumns = helpers.ColumnList{
@@ -101,9 +137,90 @@ var (
 	domainColumns = helpers.ColumnList{
 		domainsTable + ".domain",
 		domainsTable + ".expire_time",
+		domainsTable + ".upserted_by",
+		domainsTable + ".upserted_at",
+	}
+
+	domainQuotaColumns = helpers.ColumnList{
+		domainQuotasTable + ".domain",
+		domainQuotasTable + ".max_instances",
+		domainQuotasTable + ".max_memory_mb",
+		domainQuotasTable + ".max_disk_mb",
+		domainQuotasTable + ".max_tasks",
+	}
+
+	cordonedCellColumns = helpers.ColumnList{
+		cordonedCellsTable + ".cell_id",
+		cordonedCellsTable + ".cordoned_at",
+		cordonedCellsTable + ".drain_started_at",
+	}
+
+	scheduledTaskColumns = helpers.ColumnList{
+		scheduledTasksTable + ".guid",
+		scheduledTasksTable + ".domain",
+		scheduledTasksTable + ".schedule",
+		scheduledTasksTable + ".time_zone",
+		scheduledTasksTable + ".concurrency_policy",
+		scheduledTasksTable + ".history_limit",
+		scheduledTasksTable + ".task_definition",
+		scheduledTasksTable + ".created_at",
+		scheduledTasksTable + ".updated_at",
+		scheduledTasksTable + ".last_scheduled_at",
+	}
+
+	scheduledTaskRunColumns = helpers.ColumnList{
+		scheduledTaskRunsTable + ".scheduled_task_guid",
+		scheduledTaskRunsTable + ".task_guid",
+		scheduledTaskRunsTable + ".scheduled_at",
+	}
+
+	archivedTaskColumns = helpers.ColumnList{
+		taskArchiveTable + ".guid",
+		taskArchiveTable + ".domain",
+		taskArchiveTable + ".state",
+		taskArchiveTable + ".cell_id",
+		taskArchiveTable + ".failed",
+		taskArchiveTable + ".failure_reason",
+		taskArchiveTable + ".rejection_count",
+		taskArchiveTable + ".created_at",
+		taskArchiveTable + ".started_at",
+		taskArchiveTable + ".completed_at",
+		taskArchiveTable + ".archived_at",
+	}
+
+	desiredLRPRevisionColumns = helpers.ColumnList{
+		desiredLRPRevisionsTable + ".process_guid",
+		desiredLRPRevisionsTable + ".revision",
+		desiredLRPRevisionsTable + ".run_info",
+		desiredLRPRevisionsTable + ".scheduling_info",
+		desiredLRPRevisionsTable + ".modification_tag_epoch",
+		desiredLRPRevisionsTable + ".modification_tag_index",
+		desiredLRPRevisionsTable + ".created_at",
+		desiredLRPRevisionsTable + ".source",
+	}
+
+	actualLRPCrashColumns = helpers.ColumnList{
+		actualLRPCrashesTable + ".process_guid",
+		actualLRPCrashesTable + ".instance_index",
+		actualLRPCrashesTable + ".instance_guid",
+		actualLRPCrashesTable + ".cell_id",
+		actualLRPCrashesTable + ".crash_reason",
+		actualLRPCrashesTable + ".crash_count",
+		actualLRPCrashesTable + ".crashed_at",
 	}
 )
 
+func replaceColumn(columns helpers.ColumnList, column, replacement string) helpers.ColumnList {
+	replaced := make(helpers.ColumnList, len(columns))
+	for i, c := range columns {
+		if c == column {
+			c = replacement
+		}
+		replaced[i] = c
+	}
+	return replaced
+}
+
 func (db *SQLDB) CreateConfigurationsTable(ctx context.Context, logger lager.Logger) error {
 	_, err := db.db.ExecContext(
 		ctx,
@@ -139,7 +256,7 @@ func (db *SQLDB) selectLRPInstanceCounts(ctx context.Context, logger lager.Logge
 			FROM desired_lrps
 			LEFT OUTER JOIN actual_lrps ON desired_lrps.process_guid = actual_lrps.process_guid AND actual_lrps.presence = %d
 			GROUP BY desired_lrps.process_guid
-			HAVING COUNT(actual_lrps.instance_index) <> desired_lrps.instances
+			HAVING COUNT(actual_lrps.instance_index) <> CASE WHEN desired_lrps.paused THEN 0 ELSE desired_lrps.instances END
 		`,
 		strings.Join(columns, ", "), models.ActualLRP_Ordinary,
 	)
@@ -253,7 +370,7 @@ func (db *SQLDB) selectCrashedLRPs(ctx context.Context, logger lager.Logger, q h
 		SELECT %s
 			FROM desired_lrps
 			JOIN actual_lrps ON desired_lrps.process_guid = actual_lrps.process_guid
-			WHERE actual_lrps.state = ? AND actual_lrps.presence = ?
+			WHERE actual_lrps.state = ? AND actual_lrps.presence = ? AND desired_lrps.paused = ?
 		`,
 		strings.Join(
 			append(schedulingInfoColumns, "actual_lrps.instance_index", "actual_lrps.since", "actual_lrps.crash_count"),
@@ -261,7 +378,7 @@ func (db *SQLDB) selectCrashedLRPs(ctx context.Context, logger lager.Logger, q h
 		),
 	)
 
-	return q.QueryContext(ctx, db.helper.Rebind(query), models.ActualLRPStateCrashed, models.ActualLRP_Ordinary)
+	return q.QueryContext(ctx, db.helper.Rebind(query), models.ActualLRPStateCrashed, models.ActualLRP_Ordinary, false)
 }
 
 func (db *SQLDB) selectStaleUnclaimedLRPs(ctx context.Context, logger lager.Logger, q helpers.Queryable, now time.Time) (*sql.Rows, error) {
@@ -269,7 +386,7 @@ func (db *SQLDB) selectStaleUnclaimedLRPs(ctx context.Context, logger lager.Logg
 		SELECT %s
 			FROM desired_lrps
 			JOIN actual_lrps ON desired_lrps.process_guid = actual_lrps.process_guid
-			WHERE actual_lrps.state = ? AND actual_lrps.since < ? AND actual_lrps.presence = ?
+			WHERE actual_lrps.state = ? AND actual_lrps.since < ? AND actual_lrps.presence = ? AND desired_lrps.paused = ?
 		`,
 		strings.Join(append(schedulingInfoColumns, "actual_lrps.instance_index"), ", "),
 	)
@@ -279,6 +396,7 @@ func (db *SQLDB) selectStaleUnclaimedLRPs(ctx context.Context, logger lager.Logg
 		models.ActualLRPStateUnclaimed,
 		now.Add(-models.StaleUnclaimedActualLRPDuration).UnixNano(),
 		models.ActualLRP_Ordinary,
+		false,
 	)
 }
 
@@ -314,9 +432,125 @@ func (db *SQLDB) selectLRPsWithMetricTags(ctx context.Context, logger lager.Logg
 	return q.QueryContext(ctx, db.helper.Rebind(query), models.ActualLRPStateRunning, models.ActualLRP_Ordinary)
 }
 
+func (db *SQLDB) selectLRPsWithOutdatedInstances(ctx context.Context, logger lager.Logger, q helpers.Queryable) (*sql.Rows, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+			FROM desired_lrps
+			WHERE desired_lrps.paused = ? AND EXISTS (
+				SELECT 1 FROM actual_lrps
+					WHERE actual_lrps.process_guid = desired_lrps.process_guid
+					AND actual_lrps.presence = ? AND actual_lrps.state IN (?, ?)
+					AND actual_lrps.revision < desired_lrps.revision
+			)
+		`,
+		strings.Join(schedulingInfoColumns, ", "),
+	)
+
+	return q.QueryContext(ctx, db.helper.Rebind(query),
+		false, models.ActualLRP_Ordinary, models.ActualLRPStateClaimed, models.ActualLRPStateRunning,
+	)
+}
+
+func (db *SQLDB) selectLRPsWithRollingRestarts(ctx context.Context, logger lager.Logger, q helpers.Queryable) (*sql.Rows, error) {
+	query := `
+		SELECT desired_lrps.process_guid
+			FROM desired_lrps
+			WHERE desired_lrps.restart_requested_at > 0 AND desired_lrps.paused = ?
+		`
+
+	return q.QueryContext(ctx, db.helper.Rebind(query), false)
+}
+
+func (db *SQLDB) selectLRPCellReservations(ctx context.Context, logger lager.Logger, q helpers.Queryable) (*sql.Rows, error) {
+	query := `
+		SELECT actual_lrps.cell_id,
+			COALESCE(SUM(desired_lrps.memory_mb + desired_lrps.sidecar_memory_mb), 0),
+			COALESCE(SUM(desired_lrps.disk_mb + desired_lrps.sidecar_disk_mb), 0),
+			COUNT(*)
+			FROM actual_lrps
+			JOIN desired_lrps ON actual_lrps.process_guid = desired_lrps.process_guid
+			WHERE actual_lrps.state IN (?, ?) AND actual_lrps.cell_id <> ''
+			GROUP BY actual_lrps.cell_id
+		`
+
+	return q.QueryContext(ctx, db.helper.Rebind(query), models.ActualLRPStateClaimed, models.ActualLRPStateRunning)
+}
+
+// statusReasonSeparator separates the reasons aggregated by
+// selectDesiredLRPStatuses. Crash reasons and placement errors are not
+// expected to contain it.
+const statusReasonSeparator = "\x1f"
+
+func (db *SQLDB) selectDesiredLRPStatuses(ctx context.Context, logger lager.Logger, q helpers.Queryable, filter models.DesiredLRPFilter) (*sql.Rows, error) {
+	var wheres []string
+	bindings := []interface{}{
+		models.ActualLRP_Ordinary, models.ActualLRPStateUnclaimed,
+		models.ActualLRP_Ordinary, models.ActualLRPStateClaimed,
+		models.ActualLRP_Ordinary, models.ActualLRPStateRunning,
+		models.ActualLRP_Ordinary, models.ActualLRPStateCrashed,
+		models.ActualLRP_Evacuating,
+		models.ActualLRP_Suspect,
+		models.ActualLRPStateUnclaimed,
+	}
+
+	if filter.Domain != "" {
+		wheres = append(wheres, "desired_lrps.domain = ?")
+		bindings = append(bindings, filter.Domain)
+	}
+
+	if len(filter.ProcessGuids) > 0 {
+		wheres = append(wheres, fmt.Sprintf("desired_lrps.process_guid IN (%s)", helpers.QuestionMarks(len(filter.ProcessGuids))))
+		for _, guid := range filter.ProcessGuids {
+			bindings = append(bindings, guid)
+		}
+	}
+
+	where := ""
+	if len(wheres) > 0 {
+		where = "WHERE " + strings.Join(wheres, " AND ")
+	}
+
+	// The reasons are aggregated from the latest, so that when MySQL truncates
+	// the aggregate to group_concat_max_len the oldest reasons are dropped.
+	var crashReasons, placementErrors string
+	crashReason := "NULLIF(actual_lrps.crash_reason, '')"
+	placementError := "CASE WHEN actual_lrps.state = ? THEN NULLIF(actual_lrps.placement_error, '') END"
+	switch db.flavor {
+	case helpers.Postgres:
+		crashReasons = fmt.Sprintf("STRING_AGG(%s, '%s' ORDER BY actual_lrps.since DESC)", crashReason, statusReasonSeparator)
+		placementErrors = fmt.Sprintf("STRING_AGG(%s, '%s' ORDER BY actual_lrps.since DESC)", placementError, statusReasonSeparator)
+	case helpers.MySQL:
+		crashReasons = fmt.Sprintf("GROUP_CONCAT(%s ORDER BY actual_lrps.since DESC SEPARATOR '%s')", crashReason, statusReasonSeparator)
+		placementErrors = fmt.Sprintf("GROUP_CONCAT(%s ORDER BY actual_lrps.since DESC SEPARATOR '%s')", placementError, statusReasonSeparator)
+	default:
+		// totally shouldn't happen
+		panic("database flavor not implemented: " + db.flavor)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT desired_lrps.process_guid, desired_lrps.domain, desired_lrps.instances, desired_lrps.paused,
+			SUM(CASE WHEN actual_lrps.presence = ? AND actual_lrps.state = ? THEN 1 ELSE 0 END),
+			SUM(CASE WHEN actual_lrps.presence = ? AND actual_lrps.state = ? THEN 1 ELSE 0 END),
+			SUM(CASE WHEN actual_lrps.presence = ? AND actual_lrps.state = ? THEN 1 ELSE 0 END),
+			SUM(CASE WHEN actual_lrps.presence = ? AND actual_lrps.state = ? THEN 1 ELSE 0 END),
+			SUM(CASE WHEN actual_lrps.presence = ? THEN 1 ELSE 0 END),
+			SUM(CASE WHEN actual_lrps.presence = ? THEN 1 ELSE 0 END),
+			%s,
+			%s
+			FROM desired_lrps
+			LEFT OUTER JOIN actual_lrps ON desired_lrps.process_guid = actual_lrps.process_guid
+			%s
+			GROUP BY desired_lrps.process_guid, desired_lrps.domain, desired_lrps.instances, desired_lrps.paused
+		`,
+		crashReasons, placementErrors, where,
+	)
+
+	return q.QueryContext(ctx, db.helper.Rebind(query), bindings...)
+}
+
 func (db *SQLDB) CountDesiredInstances(ctx context.Context, logger lager.Logger) int {
 	query := `
-		SELECT COALESCE(SUM(desired_lrps.instances), 0) AS desired_instances
+		SELECT COALESCE(SUM(CASE WHEN desired_lrps.paused THEN 0 ELSE desired_lrps.instances END), 0) AS desired_instances
 			FROM desired_lrps
 	`
 
@@ -0,0 +1,411 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func (db *SQLDB) ScheduledTasks(ctx context.Context, logger lager.Logger, domain string) ([]*models.ScheduledTask, error) {
+	logger = logger.Session("db-scheduled-tasks", lager.Data{"domain": domain})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	wheres := ""
+	values := []interface{}{}
+
+	if domain != "" {
+		wheres = "domain = ?"
+		values = append(values, domain)
+	}
+
+	results := []*models.ScheduledTask{}
+
+	err := db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		rows, err := db.all(ctx, logger, tx, scheduledTasksTable,
+			scheduledTaskColumns, helpers.NoLockRow,
+			wheres, values...,
+		)
+		if err != nil {
+			logger.Error("failed-query", err)
+			return err
+		}
+		defer rows.Close()
+
+		results = []*models.ScheduledTask{}
+		for rows.Next() {
+			scheduledTask, err := db.fetchScheduledTask(logger, rows)
+			if err != nil {
+				logger.Error("failed-fetch", err)
+				return err
+			}
+			results = append(results, scheduledTask)
+		}
+		if err := rows.Err(); err != nil {
+			logger.Error("failed-fetching-row", err)
+			return err
+		}
+		rows.Close()
+
+		return db.fetchScheduledTaskRuns(ctx, logger, tx, results...)
+	})
+
+	return results, err
+}
+
+func (db *SQLDB) ScheduledTaskByGuid(ctx context.Context, logger lager.Logger, guid string) (*models.ScheduledTask, error) {
+	logger = logger.Session("db-scheduled-task-by-guid", lager.Data{"guid": guid})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	var scheduledTask *models.ScheduledTask
+
+	err := db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		var err error
+		row := db.one(ctx, logger, tx, scheduledTasksTable,
+			scheduledTaskColumns, helpers.NoLockRow,
+			"guid = ?", guid,
+		)
+
+		scheduledTask, err = db.fetchScheduledTask(logger, row)
+		if err != nil {
+			return err
+		}
+
+		return db.fetchScheduledTaskRuns(ctx, logger, tx, scheduledTask)
+	})
+
+	return scheduledTask, err
+}
+
+func (db *SQLDB) DesireScheduledTask(ctx context.Context, logger lager.Logger, scheduledTask *models.ScheduledTask) (*models.ScheduledTask, error) {
+	logger = logger.Session("db-desire-scheduled-task", lager.Data{"guid": scheduledTask.Guid})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	taskDefData, err := db.serializeModel(logger, scheduledTask.TaskDefinition)
+	if err != nil {
+		logger.Error("failed-serializing-task-definition", err)
+		return nil, err
+	}
+
+	now := db.clock.Now().UnixNano()
+	err = db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		_, err := db.insert(ctx, logger, tx, scheduledTasksTable,
+			helpers.SQLAttributes{
+				"guid":               scheduledTask.Guid,
+				"domain":             scheduledTask.Domain,
+				"schedule":           scheduledTask.Schedule,
+				"time_zone":          scheduledTask.TimeZone,
+				"concurrency_policy": scheduledTask.ConcurrencyPolicy,
+				"history_limit":      scheduledTask.HistoryLimit,
+				"task_definition":    taskDefData,
+				"created_at":         now,
+				"updated_at":         now,
+				"last_scheduled_at":  now,
+			},
+		)
+		return err
+	})
+	if err != nil {
+		logger.Error("failed-inserting-scheduled-task", err)
+		return nil, err
+	}
+
+	return &models.ScheduledTask{
+		Guid:              scheduledTask.Guid,
+		Domain:            scheduledTask.Domain,
+		Schedule:          scheduledTask.Schedule,
+		TimeZone:          scheduledTask.TimeZone,
+		ConcurrencyPolicy: scheduledTask.ConcurrencyPolicy,
+		HistoryLimit:      scheduledTask.HistoryLimit,
+		TaskDefinition:    scheduledTask.TaskDefinition,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+		LastScheduledAt:   now,
+	}, nil
+}
+
+func (db *SQLDB) UpdateScheduledTask(ctx context.Context, logger lager.Logger, scheduledTask *models.ScheduledTask) (*models.ScheduledTask, error) {
+	logger = logger.Session("db-update-scheduled-task", lager.Data{"guid": scheduledTask.Guid})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	taskDefData, err := db.serializeModel(logger, scheduledTask.TaskDefinition)
+	if err != nil {
+		logger.Error("failed-serializing-task-definition", err)
+		return nil, err
+	}
+
+	var after *models.ScheduledTask
+	err = db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		row := db.one(ctx, logger, tx, scheduledTasksTable,
+			scheduledTaskColumns, helpers.LockRow,
+			"guid = ?", scheduledTask.Guid,
+		)
+		before, err := db.fetchScheduledTask(logger, row)
+		if err != nil {
+			logger.Error("failed-locking-scheduled-task", err)
+			return err
+		}
+
+		now := db.clock.Now().UnixNano()
+		_, err = db.update(ctx, logger, tx, scheduledTasksTable,
+			helpers.SQLAttributes{
+				"domain":             scheduledTask.Domain,
+				"schedule":           scheduledTask.Schedule,
+				"time_zone":          scheduledTask.TimeZone,
+				"concurrency_policy": scheduledTask.ConcurrencyPolicy,
+				"history_limit":      scheduledTask.HistoryLimit,
+				"task_definition":    taskDefData,
+				"updated_at":         now,
+			},
+			"guid = ?", scheduledTask.Guid,
+		)
+		if err != nil {
+			logger.Error("failed-updating-scheduled-task", err)
+			return err
+		}
+
+		after = &models.ScheduledTask{
+			Guid:              before.Guid,
+			Domain:            scheduledTask.Domain,
+			Schedule:          scheduledTask.Schedule,
+			TimeZone:          scheduledTask.TimeZone,
+			ConcurrencyPolicy: scheduledTask.ConcurrencyPolicy,
+			HistoryLimit:      scheduledTask.HistoryLimit,
+			TaskDefinition:    scheduledTask.TaskDefinition,
+			CreatedAt:         before.CreatedAt,
+			UpdatedAt:         now,
+			LastScheduledAt:   before.LastScheduledAt,
+		}
+
+		err = db.trimScheduledTaskRuns(ctx, logger, tx, after)
+		if err != nil {
+			return err
+		}
+
+		return db.fetchScheduledTaskRuns(ctx, logger, tx, after)
+	})
+
+	return after, err
+}
+
+func (db *SQLDB) DeleteScheduledTask(ctx context.Context, logger lager.Logger, guid string) error {
+	logger = logger.Session("db-delete-scheduled-task", lager.Data{"guid": guid})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	return db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		row := db.one(ctx, logger, tx, scheduledTasksTable,
+			scheduledTaskColumns, helpers.LockRow,
+			"guid = ?", guid,
+		)
+		_, err := db.fetchScheduledTask(logger, row)
+		if err != nil {
+			logger.Error("failed-locking-scheduled-task", err)
+			return err
+		}
+
+		_, err = db.delete(ctx, logger, tx, scheduledTaskRunsTable, "scheduled_task_guid = ?", guid)
+		if err != nil {
+			logger.Error("failed-deleting-scheduled-task-runs", err)
+			return err
+		}
+
+		_, err = db.delete(ctx, logger, tx, scheduledTasksTable, "guid = ?", guid)
+		if err != nil {
+			logger.Error("failed-deleting-scheduled-task", err)
+			return err
+		}
+
+		return nil
+	})
+}
+
+func (db *SQLDB) RecordScheduledTaskRun(ctx context.Context, logger lager.Logger, guid string, scheduledAt int64, taskGuid string) error {
+	logger = logger.Session("db-record-scheduled-task-run", lager.Data{"guid": guid, "scheduled_at": scheduledAt, "task_guid": taskGuid})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	return db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		row := db.one(ctx, logger, tx, scheduledTasksTable,
+			scheduledTaskColumns, helpers.LockRow,
+			"guid = ?", guid,
+		)
+		scheduledTask, err := db.fetchScheduledTask(logger, row)
+		if err != nil {
+			logger.Error("failed-locking-scheduled-task", err)
+			return err
+		}
+
+		if scheduledAt > scheduledTask.LastScheduledAt {
+			_, err = db.update(ctx, logger, tx, scheduledTasksTable,
+				helpers.SQLAttributes{"last_scheduled_at": scheduledAt},
+				"guid = ?", guid,
+			)
+			if err != nil {
+				logger.Error("failed-updating-last-scheduled-at", err)
+				return err
+			}
+		}
+
+		if taskGuid == "" {
+			return nil
+		}
+
+		_, err = db.upsert(ctx, logger, tx, scheduledTaskRunsTable,
+			helpers.SQLAttributes{
+				"scheduled_task_guid": guid,
+				"task_guid":           taskGuid,
+				"scheduled_at":        scheduledAt,
+			},
+			"scheduled_task_guid = ? AND task_guid = ?", guid, taskGuid,
+		)
+		if err != nil {
+			logger.Error("failed-recording-run", err)
+			return err
+		}
+
+		return db.trimScheduledTaskRuns(ctx, logger, tx, scheduledTask)
+	})
+}
+
+// trimScheduledTaskRuns deletes the oldest runs of the ScheduledTask beyond
+// its history limit.
+func (db *SQLDB) trimScheduledTaskRuns(ctx context.Context, logger lager.Logger, tx helpers.Tx, scheduledTask *models.ScheduledTask) error {
+	allRuns, err := db.scheduledTaskRuns(ctx, logger, tx, scheduledTask.Guid)
+	if err != nil {
+		return err
+	}
+
+	runs := allRuns[scheduledTask.Guid]
+	limit := scheduledTask.EffectiveHistoryLimit()
+	if len(runs) <= limit {
+		return nil
+	}
+
+	expired := runs[:len(runs)-limit]
+	bindings := []interface{}{scheduledTask.Guid}
+	for _, run := range expired {
+		bindings = append(bindings, run.TaskGuid)
+	}
+
+	_, err = db.delete(ctx, logger, tx, scheduledTaskRunsTable,
+		fmt.Sprintf("scheduled_task_guid = ? AND task_guid IN (%s)", helpers.QuestionMarks(len(expired))),
+		bindings...,
+	)
+	if err != nil {
+		logger.Error("failed-trimming-runs", err)
+		return err
+	}
+
+	return nil
+}
+
+func (db *SQLDB) fetchScheduledTaskRuns(ctx context.Context, logger lager.Logger, tx helpers.Tx, scheduledTasks ...*models.ScheduledTask) error {
+	if len(scheduledTasks) == 0 {
+		return nil
+	}
+
+	guids := make([]string, 0, len(scheduledTasks))
+	for _, scheduledTask := range scheduledTasks {
+		guids = append(guids, scheduledTask.Guid)
+	}
+
+	runs, err := db.scheduledTaskRuns(ctx, logger, tx, guids...)
+	if err != nil {
+		return err
+	}
+
+	for _, scheduledTask := range scheduledTasks {
+		scheduledTask.Runs = runs[scheduledTask.Guid]
+	}
+	return nil
+}
+
+// scheduledTaskRuns returns the runs of the given ScheduledTasks keyed by
+// ScheduledTask guid, oldest first.
+func (db *SQLDB) scheduledTaskRuns(ctx context.Context, logger lager.Logger, tx helpers.Tx, guids ...string) (map[string][]*models.ScheduledTaskRun, error) {
+	bindings := make([]interface{}, 0, len(guids))
+	for _, guid := range guids {
+		bindings = append(bindings, guid)
+	}
+
+	rows, err := db.all(ctx, logger, tx, scheduledTaskRunsTable,
+		scheduledTaskRunColumns, helpers.NoLockRow,
+		fmt.Sprintf("scheduled_task_guid IN (%s)", helpers.QuestionMarks(len(guids))),
+		bindings...,
+	)
+	if err != nil {
+		logger.Error("failed-query-runs", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	runs := map[string][]*models.ScheduledTaskRun{}
+	for rows.Next() {
+		var guid string
+		run := &models.ScheduledTaskRun{}
+		err := rows.Scan(&guid, &run.TaskGuid, &run.ScheduledAt)
+		if err != nil {
+			logger.Error("failed-scanning-run", err)
+			return nil, err
+		}
+		runs[guid] = append(runs[guid], run)
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error("failed-fetching-run", err)
+		return nil, err
+	}
+
+	for _, guidRuns := range runs {
+		sort.Slice(guidRuns, func(i, j int) bool {
+			return guidRuns[i].ScheduledAt < guidRuns[j].ScheduledAt
+		})
+	}
+
+	return runs, nil
+}
+
+func (db *SQLDB) fetchScheduledTask(logger lager.Logger, scanner helpers.RowScanner) (*models.ScheduledTask, error) {
+	var taskDefData []byte
+	var concurrencyPolicy int32
+	scheduledTask := &models.ScheduledTask{}
+
+	err := scanner.Scan(
+		&scheduledTask.Guid,
+		&scheduledTask.Domain,
+		&scheduledTask.Schedule,
+		&scheduledTask.TimeZone,
+		&concurrencyPolicy,
+		&scheduledTask.HistoryLimit,
+		&taskDefData,
+		&scheduledTask.CreatedAt,
+		&scheduledTask.UpdatedAt,
+		&scheduledTask.LastScheduledAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, err
+	}
+
+	if err != nil {
+		logger.Error("failed-scanning-row", err)
+		return nil, err
+	}
+
+	var taskDef models.TaskDefinition
+	err = db.deserializeModel(logger, taskDefData, &taskDef)
+	if err != nil {
+		return nil, models.ErrDeserialize
+	}
+
+	scheduledTask.ConcurrencyPolicy = models.ScheduledTask_ConcurrencyPolicy(concurrencyPolicy)
+	scheduledTask.TaskDefinition = &taskDef
+	return scheduledTask, nil
+}
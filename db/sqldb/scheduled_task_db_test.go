@@ -0,0 +1,184 @@
+package sqldb_test
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ScheduledTaskDB", func() {
+	var scheduledTask *models.ScheduledTask
+
+	BeforeEach(func() {
+		scheduledTask = &models.ScheduledTask{
+			Guid:              "scheduled-guid",
+			Domain:            "some-domain",
+			Schedule:          "@hourly",
+			TimeZone:          "Europe/London",
+			ConcurrencyPolicy: models.ScheduledTask_Forbid,
+			HistoryLimit:      2,
+			TaskDefinition:    model_helpers.NewValidTaskDefinition(),
+		}
+	})
+
+	Describe("DesireScheduledTask", func() {
+		It("persists the scheduled task", func() {
+			desired, err := sqlDB.DesireScheduledTask(ctx, logger, scheduledTask)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(desired.CreatedAt).To(Equal(fakeClock.Now().UnixNano()))
+			Expect(desired.LastScheduledAt).To(Equal(fakeClock.Now().UnixNano()))
+
+			actual, err := sqlDB.ScheduledTaskByGuid(ctx, logger, "scheduled-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actual.Domain).To(Equal("some-domain"))
+			Expect(actual.Schedule).To(Equal("@hourly"))
+			Expect(actual.TimeZone).To(Equal("Europe/London"))
+			Expect(actual.ConcurrencyPolicy).To(Equal(models.ScheduledTask_Forbid))
+			Expect(actual.HistoryLimit).To(BeEquivalentTo(2))
+			Expect(actual.TaskDefinition).To(Equal(scheduledTask.TaskDefinition))
+			Expect(actual.LastScheduledAt).To(Equal(desired.LastScheduledAt))
+		})
+
+		Context("when the scheduled task already exists", func() {
+			It("returns a resource exists error", func() {
+				_, err := sqlDB.DesireScheduledTask(ctx, logger, scheduledTask)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = sqlDB.DesireScheduledTask(ctx, logger, scheduledTask)
+				Expect(err).To(Equal(models.ErrResourceExists))
+			})
+		})
+	})
+
+	Describe("ScheduledTasks", func() {
+		BeforeEach(func() {
+			_, err := sqlDB.DesireScheduledTask(ctx, logger, scheduledTask)
+			Expect(err).NotTo(HaveOccurred())
+
+			other := *scheduledTask
+			other.Guid = "other-guid"
+			other.Domain = "other-domain"
+			_, err = sqlDB.DesireScheduledTask(ctx, logger, &other)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns all scheduled tasks", func() {
+			scheduledTasks, err := sqlDB.ScheduledTasks(ctx, logger, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(scheduledTasks).To(HaveLen(2))
+		})
+
+		It("filters by domain", func() {
+			scheduledTasks, err := sqlDB.ScheduledTasks(ctx, logger, "other-domain")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(scheduledTasks).To(HaveLen(1))
+			Expect(scheduledTasks[0].Guid).To(Equal("other-guid"))
+		})
+	})
+
+	Describe("ScheduledTaskByGuid", func() {
+		Context("when the scheduled task does not exist", func() {
+			It("returns a resource not found error", func() {
+				_, err := sqlDB.ScheduledTaskByGuid(ctx, logger, "missing-guid")
+				Expect(err).To(Equal(models.ErrResourceNotFound))
+			})
+		})
+	})
+
+	Describe("UpdateScheduledTask", func() {
+		BeforeEach(func() {
+			_, err := sqlDB.DesireScheduledTask(ctx, logger, scheduledTask)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("updates the schedule and policies", func() {
+			scheduledTask.Schedule = "@daily"
+			scheduledTask.ConcurrencyPolicy = models.ScheduledTask_Replace
+
+			updated, err := sqlDB.UpdateScheduledTask(ctx, logger, scheduledTask)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated.Schedule).To(Equal("@daily"))
+
+			actual, err := sqlDB.ScheduledTaskByGuid(ctx, logger, "scheduled-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actual.Schedule).To(Equal("@daily"))
+			Expect(actual.ConcurrencyPolicy).To(Equal(models.ScheduledTask_Replace))
+		})
+
+		Context("when the scheduled task does not exist", func() {
+			It("returns a resource not found error", func() {
+				scheduledTask.Guid = "missing-guid"
+				_, err := sqlDB.UpdateScheduledTask(ctx, logger, scheduledTask)
+				Expect(err).To(Equal(models.ErrResourceNotFound))
+			})
+		})
+	})
+
+	Describe("RecordScheduledTaskRun", func() {
+		var lastScheduledAt int64
+
+		BeforeEach(func() {
+			desired, err := sqlDB.DesireScheduledTask(ctx, logger, scheduledTask)
+			Expect(err).NotTo(HaveOccurred())
+			lastScheduledAt = desired.LastScheduledAt
+		})
+
+		It("records the run and advances the last scheduled time", func() {
+			err := sqlDB.RecordScheduledTaskRun(ctx, logger, "scheduled-guid", lastScheduledAt+1, "task-1")
+			Expect(err).NotTo(HaveOccurred())
+
+			actual, err := sqlDB.ScheduledTaskByGuid(ctx, logger, "scheduled-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actual.LastScheduledAt).To(Equal(lastScheduledAt + 1))
+			Expect(actual.Runs).To(Equal([]*models.ScheduledTaskRun{{TaskGuid: "task-1", ScheduledAt: lastScheduledAt + 1}}))
+		})
+
+		It("advances the last scheduled time without recording skipped runs", func() {
+			err := sqlDB.RecordScheduledTaskRun(ctx, logger, "scheduled-guid", lastScheduledAt+1, "")
+			Expect(err).NotTo(HaveOccurred())
+
+			actual, err := sqlDB.ScheduledTaskByGuid(ctx, logger, "scheduled-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actual.LastScheduledAt).To(Equal(lastScheduledAt + 1))
+			Expect(actual.Runs).To(BeEmpty())
+		})
+
+		It("keeps only the most recent runs up to the history limit", func() {
+			for i, taskGuid := range []string{"task-1", "task-2", "task-3"} {
+				err := sqlDB.RecordScheduledTaskRun(ctx, logger, "scheduled-guid", lastScheduledAt+int64(i+1), taskGuid)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			actual, err := sqlDB.ScheduledTaskByGuid(ctx, logger, "scheduled-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actual.Runs).To(HaveLen(2))
+			Expect(actual.Runs[0].TaskGuid).To(Equal("task-2"))
+			Expect(actual.Runs[1].TaskGuid).To(Equal("task-3"))
+		})
+	})
+
+	Describe("DeleteScheduledTask", func() {
+		BeforeEach(func() {
+			_, err := sqlDB.DesireScheduledTask(ctx, logger, scheduledTask)
+			Expect(err).NotTo(HaveOccurred())
+			err = sqlDB.RecordScheduledTaskRun(ctx, logger, "scheduled-guid", fakeClock.Now().UnixNano()+1, "task-1")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("removes the scheduled task and its runs", func() {
+			err := sqlDB.DeleteScheduledTask(ctx, logger, "scheduled-guid")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = sqlDB.ScheduledTaskByGuid(ctx, logger, "scheduled-guid")
+			Expect(err).To(Equal(models.ErrResourceNotFound))
+		})
+
+		Context("when the scheduled task does not exist", func() {
+			It("returns a resource not found error", func() {
+				err := sqlDB.DeleteScheduledTask(ctx, logger, "missing-guid")
+				Expect(err).To(Equal(models.ErrResourceNotFound))
+			})
+		})
+	})
+})
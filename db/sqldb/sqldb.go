@@ -14,23 +14,25 @@ import (
 )
 
 type SQLDB struct {
-	db                     helpers.QueryableDB
-	convergenceWorkersSize int
-	updateWorkersSize      int
-	clock                  clock.Clock
-	guidProvider           guidprovider.GUIDProvider
-	serializer             format.Serializer
-	cryptor                encryption.Cryptor
-	encoder                format.Encoder
-	flavor                 string
-	helper                 helpers.SQLHelper
-	metronClient           loggingclient.IngressClient
+	db                      helpers.QueryableDB
+	convergenceWorkersSize  int
+	updateWorkersSize       int
+	maxInlineTaskResultSize int
+	clock                   clock.Clock
+	guidProvider            guidprovider.GUIDProvider
+	serializer              format.Serializer
+	cryptor                 encryption.Cryptor
+	encoder                 format.Encoder
+	flavor                  string
+	helper                  helpers.SQLHelper
+	metronClient            loggingclient.IngressClient
 }
 
 func NewSQLDB(
 	db helpers.QueryableDB,
 	convergenceWorkersSize int,
 	updateWorkersSize int,
+	maxInlineTaskResultSize int,
 	cryptor encryption.Cryptor,
 	guidProvider guidprovider.GUIDProvider,
 	clock clock.Clock,
@@ -39,17 +41,18 @@ func NewSQLDB(
 ) *SQLDB {
 	helper := helpers.NewSQLHelper(flavor)
 	return &SQLDB{
-		db:                     db,
-		convergenceWorkersSize: convergenceWorkersSize,
-		updateWorkersSize:      updateWorkersSize,
-		clock:                  clock,
-		guidProvider:           guidProvider,
-		serializer:             format.NewSerializer(cryptor),
-		cryptor:                cryptor,
-		encoder:                format.NewEncoder(cryptor),
-		flavor:                 flavor,
-		helper:                 helper,
-		metronClient:           metronClient,
+		db:                      db,
+		convergenceWorkersSize:  convergenceWorkersSize,
+		updateWorkersSize:       updateWorkersSize,
+		maxInlineTaskResultSize: maxInlineTaskResultSize,
+		clock:                   clock,
+		guidProvider:            guidProvider,
+		serializer:              format.NewSerializer(cryptor),
+		cryptor:                 cryptor,
+		encoder:                 format.NewEncoder(cryptor),
+		flavor:                  flavor,
+		helper:                  helper,
+		metronClient:            metronClient,
 	}
 }
 
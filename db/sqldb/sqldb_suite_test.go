@@ -116,7 +116,7 @@ var _ = BeforeSuite(func() {
 	db = helpers.NewMonitoredDB(rawDB, monitor.New())
 	ctx = context.Background()
 
-	sqlDB = sqldb.NewSQLDB(db, 5, 5, cryptor, fakeGUIDProvider, fakeClock, dbFlavor, fakeMetronClient)
+	sqlDB = sqldb.NewSQLDB(db, 5, 5, 0, cryptor, fakeGUIDProvider, fakeClock, dbFlavor, fakeMetronClient)
 	err = sqlDB.CreateConfigurationsTable(ctx, logger)
 	if err != nil {
 		logger.Fatal("sql-failed-create-configurations-table", err)
@@ -131,7 +131,7 @@ var _ = BeforeEach(func() {
 
 	fakeMetronClient = new(mfakes.FakeIngressClient)
 	migrationMetronClient := new(mfakes.FakeIngressClient)
-	sqlDB = sqldb.NewSQLDB(db, 5, 5, cryptor, fakeGUIDProvider, fakeClock, dbFlavor, fakeMetronClient)
+	sqlDB = sqldb.NewSQLDB(db, 5, 5, 0, cryptor, fakeGUIDProvider, fakeClock, dbFlavor, fakeMetronClient)
 
 	migrationsDone := make(chan struct{})
 
@@ -190,6 +190,15 @@ var truncateTablesSQL = []string{
 	"TRUNCATE TABLE desired_lrps",
 	"TRUNCATE TABLE actual_lrps",
 	"TRUNCATE TABLE configurations",
+	"TRUNCATE TABLE scheduled_tasks",
+	"TRUNCATE TABLE scheduled_task_runs",
+	"TRUNCATE TABLE task_archive",
+	"TRUNCATE TABLE task_results",
+	"TRUNCATE TABLE task_dependencies",
+	"TRUNCATE TABLE domain_quotas",
+	"TRUNCATE TABLE cordoned_cells",
+	"TRUNCATE TABLE desired_lrp_revisions",
+	"TRUNCATE TABLE actual_lrp_crashes",
 }
 
 func randStr(strSize int) string {
@@ -0,0 +1,201 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func (db *SQLDB) ArchivedTasks(ctx context.Context, logger lager.Logger, filter models.TaskArchiveFilter) ([]*models.ArchivedTask, string, error) {
+	logger = logger.Session("db-archived-tasks", lager.Data{"filter": filter})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	wheres := []string{}
+	values := []interface{}{}
+
+	if filter.Domain != "" {
+		wheres = append(wheres, "domain = ?")
+		values = append(values, filter.Domain)
+	}
+
+	if filter.CellID != "" {
+		wheres = append(wheres, "cell_id = ?")
+		values = append(values, filter.CellID)
+	}
+
+	if filter.FailedOnly {
+		wheres = append(wheres, "failed = ?")
+		values = append(values, true)
+	}
+
+	if filter.CompletedAfter > 0 {
+		wheres = append(wheres, "completed_at >= ?")
+		values = append(values, filter.CompletedAfter)
+	}
+
+	if filter.CompletedBefore > 0 {
+		wheres = append(wheres, "completed_at < ?")
+		values = append(values, filter.CompletedBefore)
+	}
+
+	if filter.PageToken != "" {
+		archivedAt, taskGuid, err := models.ParseArchivedTaskPageToken(filter.PageToken)
+		if err != nil {
+			logger.Error("failed-parsing-page-token", err)
+			return nil, "", models.ErrBadRequest
+		}
+		wheres = append(wheres, "(archived_at < ? OR (archived_at = ? AND guid < ?))")
+		values = append(values, archivedAt, archivedAt, taskGuid)
+	}
+
+	pageSize := filter.PageSize
+	if pageSize <= 0 {
+		pageSize = models.DefaultArchivedTasksPageSize
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM %s\n", strings.Join(archivedTaskColumns, ", "), taskArchiveTable)
+	if len(wheres) > 0 {
+		query += "WHERE " + strings.Join(wheres, " AND ") + "\n"
+	}
+	// fetch one more row than requested to know whether there is a next page
+	query += fmt.Sprintf("ORDER BY archived_at DESC, guid DESC\nLIMIT %d", pageSize+1)
+
+	rows, err := db.db.QueryContext(ctx, db.helper.Rebind(query), values...)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return nil, "", db.convertSQLError(err)
+	}
+	defer rows.Close()
+
+	archivedTasks := []*models.ArchivedTask{}
+	for rows.Next() {
+		archivedTask, err := db.fetchArchivedTask(logger, rows)
+		if err != nil {
+			return nil, "", db.convertSQLError(err)
+		}
+		archivedTasks = append(archivedTasks, archivedTask)
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-getting-next-row", rows.Err())
+		return nil, "", db.convertSQLError(rows.Err())
+	}
+
+	nextPageToken := ""
+	if len(archivedTasks) > pageSize {
+		archivedTasks = archivedTasks[:pageSize]
+		nextPageToken = models.ArchivedTaskPageToken(archivedTasks[pageSize-1])
+	}
+
+	return archivedTasks, nextPageToken, nil
+}
+
+func (db *SQLDB) ArchivedTaskByGuid(ctx context.Context, logger lager.Logger, taskGuid string) (*models.ArchivedTask, error) {
+	logger = logger.Session("db-archived-task-by-guid", lager.Data{"task_guid": taskGuid})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	query := fmt.Sprintf("SELECT %s FROM %s\nWHERE guid = ?\nORDER BY archived_at DESC\nLIMIT 1",
+		strings.Join(archivedTaskColumns, ", "), taskArchiveTable)
+
+	row := db.db.QueryRowContext(ctx, db.helper.Rebind(query), taskGuid)
+	archivedTask, err := db.fetchArchivedTask(logger, row)
+	if err != nil {
+		return nil, db.convertSQLError(err)
+	}
+
+	return archivedTask, nil
+}
+
+// archiveTasks records the final state of the tasks matching the given where
+// clause in the task archive. It must be called in the same transaction that
+// deletes the tasks. The cell is taken from started_cell_id because completing
+// a task clears its cell_id.
+func (db *SQLDB) archiveTasks(ctx context.Context, logger lager.Logger, q helpers.Queryable, wheres string, whereBindings ...interface{}) error {
+	// the archive time is formatted into the query because some databases
+	// cannot infer the type of a bound parameter in the select list
+	query := fmt.Sprintf(`INSERT INTO %s (guid, domain, state, cell_id, failed, failure_reason, rejection_count, created_at, started_at, completed_at, archived_at)
+		SELECT guid, domain, state, started_cell_id, failed, failure_reason, rejection_count, created_at, started_at, first_completed_at, %d
+		FROM %s
+		WHERE %s`,
+		taskArchiveTable, db.clock.Now().UnixNano(), tasksTable, wheres,
+	)
+
+	_, err := q.ExecContext(ctx, db.helper.Rebind(query), whereBindings...)
+	if err != nil {
+		logger.Error("failed-archiving-tasks", err)
+		return err
+	}
+
+	return nil
+}
+
+// pruneTaskArchive deletes the archived tasks that have been archived for
+// longer than the retention period. A retention period of 0 keeps archived
+// tasks forever.
+func (db *SQLDB) pruneTaskArchive(ctx context.Context, logger lager.Logger, taskArchiveRetention time.Duration) {
+	logger = logger.Session("prune-task-archive")
+
+	if taskArchiveRetention <= 0 {
+		return
+	}
+
+	result, err := db.delete(ctx, logger, db.db, taskArchiveTable,
+		"archived_at < ?", db.clock.Now().Add(-taskArchiveRetention).UnixNano(),
+	)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Error("failed-rows-affected", err)
+		return
+	}
+
+	logger.Debug("pruned-archived-tasks", lager.Data{"count": rowsAffected})
+}
+
+func (db *SQLDB) fetchArchivedTask(logger lager.Logger, scanner helpers.RowScanner) (*models.ArchivedTask, error) {
+	var state int32
+	var failureReasonData []byte
+	archivedTask := &models.ArchivedTask{}
+
+	err := scanner.Scan(
+		&archivedTask.TaskGuid,
+		&archivedTask.Domain,
+		&state,
+		&archivedTask.CellId,
+		&archivedTask.Failed,
+		&failureReasonData,
+		&archivedTask.RejectionCount,
+		&archivedTask.CreatedAt,
+		&archivedTask.StartedAt,
+		&archivedTask.CompletedAt,
+		&archivedTask.ArchivedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, err
+	}
+
+	if err != nil {
+		logger.Error("failed-scanning-row", err)
+		return nil, err
+	}
+
+	archivedTask.FailureReason, err = db.decryptTaskField(logger, failureReasonData)
+	if err != nil {
+		return nil, err
+	}
+
+	archivedTask.State = models.Task_State(state)
+	return archivedTask, nil
+}
@@ -0,0 +1,116 @@
+package sqldb_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TaskArchiveDB", func() {
+	archiveTask := func(taskGuid, domain, cellID string, failed bool) {
+		_, err := sqlDB.DesireTask(ctx, logger, model_helpers.NewValidTaskDefinition(), taskGuid, domain)
+		Expect(err).NotTo(HaveOccurred())
+		_, _, _, err = sqlDB.StartTask(ctx, logger, taskGuid, cellID)
+		Expect(err).NotTo(HaveOccurred())
+		_, _, err = sqlDB.CompleteTask(ctx, logger, taskGuid, cellID, failed, "boom", "")
+		Expect(err).NotTo(HaveOccurred())
+		_, _, err = sqlDB.ResolvingTask(ctx, logger, taskGuid)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = sqlDB.DeleteTask(ctx, logger, taskGuid)
+		Expect(err).NotTo(HaveOccurred())
+		fakeClock.Increment(time.Second)
+	}
+
+	archivedTaskGuids := func(archivedTasks []*models.ArchivedTask) []string {
+		guids := []string{}
+		for _, archivedTask := range archivedTasks {
+			guids = append(guids, archivedTask.TaskGuid)
+		}
+		return guids
+	}
+
+	BeforeEach(func() {
+		archiveTask("task-1", "domain-1", "cell-1", false)
+		archiveTask("task-2", "domain-1", "cell-2", true)
+		archiveTask("task-3", "domain-2", "cell-1", true)
+		archiveTask("task-4", "domain-2", "cell-2", false)
+	})
+
+	Describe("ArchivedTasks", func() {
+		It("returns the archived tasks from the most recently archived", func() {
+			archivedTasks, nextPageToken, err := sqlDB.ArchivedTasks(ctx, logger, models.TaskArchiveFilter{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(archivedTaskGuids(archivedTasks)).To(Equal([]string{"task-4", "task-3", "task-2", "task-1"}))
+			Expect(nextPageToken).To(BeEmpty())
+		})
+
+		It("filters by domain, cell and failure", func() {
+			archivedTasks, _, err := sqlDB.ArchivedTasks(ctx, logger, models.TaskArchiveFilter{Domain: "domain-1"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(archivedTaskGuids(archivedTasks)).To(Equal([]string{"task-2", "task-1"}))
+
+			archivedTasks, _, err = sqlDB.ArchivedTasks(ctx, logger, models.TaskArchiveFilter{CellID: "cell-1"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(archivedTaskGuids(archivedTasks)).To(Equal([]string{"task-3", "task-1"}))
+
+			archivedTasks, _, err = sqlDB.ArchivedTasks(ctx, logger, models.TaskArchiveFilter{FailedOnly: true})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(archivedTaskGuids(archivedTasks)).To(Equal([]string{"task-3", "task-2"}))
+			Expect(archivedTasks[0].FailureReason).To(Equal("boom"))
+		})
+
+		It("filters by completion time", func() {
+			task2, err := sqlDB.ArchivedTaskByGuid(ctx, logger, "task-2")
+			Expect(err).NotTo(HaveOccurred())
+			task4, err := sqlDB.ArchivedTaskByGuid(ctx, logger, "task-4")
+			Expect(err).NotTo(HaveOccurred())
+
+			archivedTasks, _, err := sqlDB.ArchivedTasks(ctx, logger, models.TaskArchiveFilter{
+				CompletedAfter:  task2.CompletedAt,
+				CompletedBefore: task4.CompletedAt,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(archivedTaskGuids(archivedTasks)).To(Equal([]string{"task-3", "task-2"}))
+		})
+
+		It("paginates the archived tasks", func() {
+			archivedTasks, nextPageToken, err := sqlDB.ArchivedTasks(ctx, logger, models.TaskArchiveFilter{PageSize: 3})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(archivedTaskGuids(archivedTasks)).To(Equal([]string{"task-4", "task-3", "task-2"}))
+			Expect(nextPageToken).NotTo(BeEmpty())
+
+			archivedTasks, nextPageToken, err = sqlDB.ArchivedTasks(ctx, logger, models.TaskArchiveFilter{PageSize: 3, PageToken: nextPageToken})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(archivedTaskGuids(archivedTasks)).To(Equal([]string{"task-1"}))
+			Expect(nextPageToken).To(BeEmpty())
+		})
+
+		Context("when the page token is invalid", func() {
+			It("returns a bad request error", func() {
+				_, _, err := sqlDB.ArchivedTasks(ctx, logger, models.TaskArchiveFilter{PageToken: "garbage"})
+				Expect(err).To(Equal(models.ErrBadRequest))
+			})
+		})
+	})
+
+	Describe("ArchivedTaskByGuid", func() {
+		It("returns the most recently archived task with the guid", func() {
+			archiveTask("task-1", "domain-3", "cell-3", false)
+
+			archivedTask, err := sqlDB.ArchivedTaskByGuid(ctx, logger, "task-1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(archivedTask.Domain).To(Equal("domain-3"))
+			Expect(archivedTask.CellId).To(Equal("cell-3"))
+		})
+
+		Context("when the task has not been archived", func() {
+			It("returns a resource not found error", func() {
+				_, err := sqlDB.ArchivedTaskByGuid(ctx, logger, "unknown-task")
+				Expect(err).To(Equal(models.ErrResourceNotFound))
+			})
+		})
+	})
+})
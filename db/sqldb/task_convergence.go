@@ -2,8 +2,10 @@ package sqldb
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"math"
+	"sort"
 	"strings"
 	"time"
 
@@ -17,9 +19,10 @@ import (
 const (
 	expiredFailureReason         = "not started within time limit"
 	cellDisappearedFailureReason = "cell disappeared before completion"
+	overdueFailureReason         = "exceeded maximum run duration"
 )
 
-func (sqldb *SQLDB) ConvergeTasks(ctx context.Context, logger lager.Logger, cellSet models.CellSet, kickTasksDuration, expirePendingTaskDuration, expireCompletedTaskDuration time.Duration) db.TaskConvergenceResult {
+func (sqldb *SQLDB) ConvergeTasks(ctx context.Context, logger lager.Logger, cellSet models.CellSet, kickTasksDuration, expirePendingTaskDuration, expireCompletedTaskDuration time.Duration, defaultMaxRunDurations map[string]time.Duration, taskArchiveRetention time.Duration) db.TaskConvergenceResult {
 	logger = logger.Session("db-converge-tasks")
 	logger.Info("starting")
 	defer logger.Info("complete")
@@ -35,15 +38,28 @@ func (sqldb *SQLDB) ConvergeTasks(ctx context.Context, logger lager.Logger, cell
 	convergenceResult.Metrics.TasksKicked += uint64(rowsAffected)
 
 	// tasksToAuction is a list of tasks in the pending state that have not expired and are being auctioned
-	tasksToAuction, failedFetches := sqldb.getTaskStartRequestsForKickablePendingTasks(ctx, logger, expirePendingTaskDuration)
-	convergenceResult.TasksToAuction = tasksToAuction
+	tasksToAuction, failedFetches := sqldb.getKickablePendingTasks(ctx, logger, expirePendingTaskDuration)
 	convergenceResult.Metrics.TasksPruned += failedFetches
 	convergenceResult.Metrics.TasksKicked += uint64(len(tasksToAuction))
 
-	// failedEvents is a list of tasks that have transitioned from the running to completed state (but cell dissappeared and failed)
+	// failedEvents is a list of tasks that have transitioned from the running to completed state (but cell dissappeared and failed),
+	// or back to the pending state when they are retried
 	// rowsAffected is the number of running tasks that have lost their cells
-	failedEvents, failedFetches, rowsAffected = sqldb.failTasksWithDisappearedCells(ctx, logger, cellSet)
+	// retriedTasks are tasks that lost their cells but are being retried according to their retry policy
+	failedEvents, retriedTasks, failedFetches, rowsAffected := sqldb.failTasksWithDisappearedCells(ctx, logger, cellSet)
 	convergenceResult.Events = append(convergenceResult.Events, failedEvents...)
+	tasksToAuction = append(tasksToAuction, retriedTasks...)
+	convergenceResult.Metrics.TasksPruned += failedFetches
+	convergenceResult.Metrics.TasksKicked += uint64(rowsAffected)
+
+	// overdueTasks is a list of running tasks that have exceeded their maximum run duration and have been failed,
+	// or moved back to the pending state when they are retried
+	// they still have to be cancelled on the cells they were running on, so retried tasks are auctioned by a later convergence
+	overdueTasks, failedFetches, rowsAffected := sqldb.failOverdueRunningTasks(ctx, logger, defaultMaxRunDurations)
+	for _, change := range overdueTasks {
+		convergenceResult.TasksToCancel = append(convergenceResult.TasksToCancel, change.Before)
+		convergenceResult.Events = append(convergenceResult.Events, models.NewTaskChangedEvent(change.Before, change.After))
+	}
 	convergenceResult.Metrics.TasksPruned += failedFetches
 	convergenceResult.Metrics.TasksKicked += uint64(rowsAffected)
 
@@ -54,14 +70,55 @@ func (sqldb *SQLDB) ConvergeTasks(ctx context.Context, logger lager.Logger, cell
 	convergenceResult.Events = append(convergenceResult.Events, demotedEvents...)
 	convergenceResult.Metrics.TasksPruned += failedFetches
 
+	// releasedTasks are blocked tasks whose dependencies have finished and are now pending
+	// failedTasks are blocked tasks that have been failed because a dependency failed or disappeared
+	// do this before deleting expired completed tasks so that their dependents can still see them
+	releasedTasks, failedTasks, err := sqldb.ReleaseBlockedTasks(ctx, logger)
+	if err != nil {
+		logger.Error("failed-releasing-blocked-tasks", err)
+	}
+	for _, change := range releasedTasks {
+		tasksToAuction = append(tasksToAuction, change.After)
+		convergenceResult.Events = append(convergenceResult.Events, models.NewTaskChangedEvent(change.Before, change.After))
+	}
+	for _, change := range failedTasks {
+		convergenceResult.Events = append(convergenceResult.Events, models.NewTaskChangedEvent(change.Before, change.After))
+	}
+
+	// the kickable pending tasks are already in priority order, but retried
+	// and released tasks have to be merged into it
+	sort.SliceStable(tasksToAuction, func(i, j int) bool {
+		if tasksToAuction[i].Priority != tasksToAuction[j].Priority {
+			return tasksToAuction[i].Priority > tasksToAuction[j].Priority
+		}
+		return tasksToAuction[i].CreatedAt < tasksToAuction[j].CreatedAt
+	})
+	for _, task := range tasksToAuction {
+		taskStartRequest := auctioneer.NewTaskStartRequestFromModel(task.TaskGuid, task.Domain, task.TaskDefinition)
+		convergenceResult.TasksToAuction = append(convergenceResult.TasksToAuction, &taskStartRequest)
+	}
+
 	// removedEvents is a list of tasks in the completed stated that have been deleted bc the time since they initially changed to completed exceeded expireCompleteTaskDuration
 	removedEvents, rowsAffected := sqldb.deleteExpiredCompletedTasks(ctx, logger, expireCompletedTaskDuration)
 	convergenceResult.Events = append(convergenceResult.Events, removedEvents...)
 	convergenceResult.Metrics.TasksPruned += uint64(rowsAffected)
 
+	// archived tasks are pruned once they have been kept for the retention period
+	sqldb.pruneTaskArchive(ctx, logger, taskArchiveRetention)
+
 	// tasksToComplete is a list of tasks in the complete state that have exceeded kickTasksDuration
 	tasksToComplete, failedFetches := sqldb.getKickableCompleteTasksForCompletion(ctx, logger, kickTasksDuration)
 	convergenceResult.TasksToComplete = tasksToComplete
+	for _, change := range failedTasks {
+		if change.After.CompletionCallbackUrl != "" {
+			convergenceResult.TasksToComplete = append(convergenceResult.TasksToComplete, change.After)
+		}
+	}
+	for _, change := range overdueTasks {
+		if change.After.State == models.Task_Completed && change.After.CompletionCallbackUrl != "" {
+			convergenceResult.TasksToComplete = append(convergenceResult.TasksToComplete, change.After)
+		}
+	}
 	convergenceResult.Metrics.TasksPruned += failedFetches
 	convergenceResult.Metrics.TasksKicked += uint64(len(tasksToComplete))
 
@@ -75,9 +132,12 @@ func (db *SQLDB) failExpiredPendingTasks(ctx context.Context, logger lager.Logge
 
 	now := db.clock.Now()
 
+	// retried and released tasks expire relative to the time they become
+	// eligible to be placed rather than their creation time
+	expiredBefore := now.Add(-expirePendingTaskDuration).UnixNano()
 	rows, err := db.all(ctx, logger, db.db, tasksTable,
 		taskColumns, helpers.NoLockRow,
-		"state = ? AND created_at < ?", models.Task_Pending, now.Add(-expirePendingTaskDuration).UnixNano())
+		"state = ? AND created_at < ? AND retry_at < ? AND released_at < ?", models.Task_Pending, expiredBefore, expiredBefore, expiredBefore)
 	if err != nil {
 		logger.Error("failed-query", err)
 		return nil, 0, 0
@@ -89,8 +149,8 @@ func (db *SQLDB) failExpiredPendingTasks(ctx context.Context, logger lager.Logge
 		logger.Error("failed-fetching-some-tasks", err)
 	}
 
-	wheres := []string{"state = ?", "created_at < ?"}
-	bindings := []interface{}{models.Task_Pending, now.Add(-expirePendingTaskDuration).UnixNano()}
+	wheres := []string{"state = ?", "created_at < ?", "retry_at < ?", "released_at < ?"}
+	bindings := []interface{}{models.Task_Pending, expiredBefore, expiredBefore, expiredBefore}
 
 	if len(validTaskGuids) == 0 {
 		return nil, uint64(invalidTasksCount), 0
@@ -101,10 +161,15 @@ func (db *SQLDB) failExpiredPendingTasks(ctx context.Context, logger lager.Logge
 		bindings = append(bindings, guid)
 	}
 
+	failureReason, err := db.encryptTaskField(logger, expiredFailureReason)
+	if err != nil {
+		return nil, uint64(invalidTasksCount), 0
+	}
+
 	result, err := db.update(ctx, logger, db.db, tasksTable,
 		helpers.SQLAttributes{
 			"failed":             true,
-			"failure_reason":     expiredFailureReason,
+			"failure_reason":     failureReason,
 			"result":             "",
 			"state":              models.Task_Completed,
 			"first_completed_at": now.UnixNano(),
@@ -137,37 +202,39 @@ func (db *SQLDB) failExpiredPendingTasks(ctx context.Context, logger lager.Logge
 	return events, uint64(invalidTasksCount), rowsAffected
 }
 
-func (db *SQLDB) getTaskStartRequestsForKickablePendingTasks(ctx context.Context, logger lager.Logger, expirePendingTaskDuration time.Duration) ([]*auctioneer.TaskStartRequest, uint64) {
-	logger = logger.Session("get-task-start-requests-for-kickable-pending-tasks")
+func (db *SQLDB) getKickablePendingTasks(ctx context.Context, logger lager.Logger, expirePendingTaskDuration time.Duration) ([]*models.Task, uint64) {
+	logger = logger.Session("get-kickable-pending-tasks")
 
-	rows, err := db.all(ctx, logger, db.db, tasksTable,
-		taskColumns, helpers.NoLockRow,
-		"state = ? AND created_at > ?",
-		models.Task_Pending, db.clock.Now().Add(-expirePendingTaskDuration).UnixNano(),
+	now := db.clock.Now()
+	expiredBefore := now.Add(-expirePendingTaskDuration).UnixNano()
+
+	// higher priority tasks are auctioned first, and the oldest first among
+	// tasks of the same priority
+	query := fmt.Sprintf(`SELECT %s FROM %s
+		WHERE state = ? AND (created_at > ? OR retry_at > ? OR released_at > ?) AND retry_at <= ?
+		ORDER BY priority DESC, created_at ASC`,
+		strings.Join(taskColumns, ", "), tasksTable,
+	)
+	rows, err := db.db.QueryContext(ctx, db.helper.Rebind(query),
+		models.Task_Pending, expiredBefore, expiredBefore, expiredBefore, now.UnixNano(),
 	)
 
 	if err != nil {
 		logger.Error("failed-query", err)
-		return []*auctioneer.TaskStartRequest{}, math.MaxUint64
+		return []*models.Task{}, math.MaxUint64
 	}
 
 	defer rows.Close()
 
-	tasksToAuction := []*auctioneer.TaskStartRequest{}
 	tasks, _, invalidTasksCount, err := db.fetchTasks(ctx, logger, rows, db.db, false)
 	if err != nil {
 		logger.Error("failed-fetching-some-tasks", err)
 	}
 
-	for _, task := range tasks {
-		taskStartRequest := auctioneer.NewTaskStartRequestFromModel(task.TaskGuid, task.Domain, task.TaskDefinition)
-		tasksToAuction = append(tasksToAuction, &taskStartRequest)
-	}
-
-	return tasksToAuction, uint64(invalidTasksCount)
+	return tasks, uint64(invalidTasksCount)
 }
 
-func (db *SQLDB) failTasksWithDisappearedCells(ctx context.Context, logger lager.Logger, cellSet models.CellSet) ([]models.Event, uint64, int64) {
+func (db *SQLDB) failTasksWithDisappearedCells(ctx context.Context, logger lager.Logger, cellSet models.CellSet) ([]models.Event, []*models.Task, uint64, int64) {
 	logger = logger.Session("fail-tasks-with-disappeared-cells")
 
 	values := make([]interface{}, 0, 1+len(cellSet))
@@ -186,29 +253,71 @@ func (db *SQLDB) failTasksWithDisappearedCells(ctx context.Context, logger lager
 	rows, err := db.all(ctx, logger, db.db, tasksTable, taskColumns, helpers.NoLockRow, wheres, values...)
 	if err != nil {
 		logger.Error("failed-query", err)
-		return nil, 0, 0
+		return nil, nil, 0, 0
 	}
 	defer rows.Close()
 
-	tasks, validTaskGuids, invalidTasksCount, err := db.fetchTasks(ctx, logger, rows, db.db, false)
+	tasks, _, invalidTasksCount, err := db.fetchTasks(ctx, logger, rows, db.db, false)
 	if err != nil {
 		logger.Error("failed-fetching-tasks", err)
 	}
 
-	if len(validTaskGuids) == 0 {
-		return nil, uint64(invalidTasksCount), 0
+	var events []models.Event
+	var tasksToAuction []*models.Task
+	var retriedCount int64
+	tasksToFail := []*models.Task{}
+	for _, task := range tasks {
+		if !task.ShouldRetry(models.RetryPolicy_CellLost) {
+			tasksToFail = append(tasksToFail, task)
+			continue
+		}
+
+		var afterTask *models.Task
+		err = db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+			afterTask, err = db.fetchTaskForUpdate(ctx, logger, task.TaskGuid, tx)
+			if err != nil {
+				logger.Error("failed-locking-task", err)
+				return err
+			}
+
+			// the task may have completed since it was fetched
+			if afterTask.State != models.Task_Running || afterTask.CellId != task.CellId {
+				afterTask = nil
+				return nil
+			}
+
+			return db.retryTask(ctx, logger, afterTask, models.RetryPolicy_CellLost, cellDisappearedFailureReason, tx)
+		})
+		if err != nil || afterTask == nil {
+			continue
+		}
+
+		retriedCount++
+		events = append(events, models.NewTaskChangedEvent(task, afterTask))
+		if afterTask.RetryAt <= now {
+			tasksToAuction = append(tasksToAuction, afterTask)
+		}
 	}
 
-	wheres += fmt.Sprintf(" AND guid IN (%s)", helpers.QuestionMarks(len(validTaskGuids)))
+	if len(tasksToFail) == 0 {
+		return events, tasksToAuction, uint64(invalidTasksCount), retriedCount
+	}
 
-	for _, guid := range validTaskGuids {
-		values = append(values, guid)
+	wheres += fmt.Sprintf(" AND guid IN (%s)", helpers.QuestionMarks(len(tasksToFail)))
+
+	for _, task := range tasksToFail {
+		values = append(values, task.TaskGuid)
+	}
+
+	failureReason, err := db.encryptTaskField(logger, cellDisappearedFailureReason)
+	if err != nil {
+		return events, tasksToAuction, uint64(invalidTasksCount), retriedCount
 	}
 
 	result, err := db.update(ctx, logger, db.db, tasksTable,
 		helpers.SQLAttributes{
 			"failed":             true,
-			"failure_reason":     cellDisappearedFailureReason,
+			"failure_reason":     failureReason,
 			"result":             "",
 			"state":              models.Task_Completed,
 			"first_completed_at": now,
@@ -218,11 +327,10 @@ func (db *SQLDB) failTasksWithDisappearedCells(ctx context.Context, logger lager
 	)
 	if err != nil {
 		logger.Error("failed-updating-tasks", err)
-		return nil, uint64(invalidTasksCount), 0
+		return events, tasksToAuction, uint64(invalidTasksCount), retriedCount
 	}
 
-	var events []models.Event
-	for _, task := range tasks {
+	for _, task := range tasksToFail {
 		afterTask := *task
 		afterTask.Failed = true
 		afterTask.FailureReason = cellDisappearedFailureReason
@@ -237,10 +345,133 @@ func (db *SQLDB) failTasksWithDisappearedCells(ctx context.Context, logger lager
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
 		logger.Error("failed-rows-affected", err)
-		return events, uint64(invalidTasksCount), 0
+		return events, tasksToAuction, uint64(invalidTasksCount), retriedCount
 	}
 
-	return events, uint64(invalidTasksCount), rowsAffected
+	return events, tasksToAuction, uint64(invalidTasksCount), rowsAffected + retriedCount
+}
+
+// failOverdueRunningTasks fails running tasks that have run for longer than
+// their maximum run duration, or the default for their domain if they do not
+// set one. Tasks whose retry policy retries overdue tasks are moved back to
+// the pending state instead.
+func (db *SQLDB) failOverdueRunningTasks(ctx context.Context, logger lager.Logger, defaultMaxRunDurations map[string]time.Duration) ([]*models.TaskChange, uint64, int64) {
+	logger = logger.Session("fail-overdue-running-tasks")
+
+	now := db.clock.Now().UnixNano()
+
+	// a running task is only updated when it starts, so updated_at is the
+	// time at which it started running
+	deadlines := []string{"(max_run_duration > 0 AND updated_at < ? - max_run_duration)"}
+	values := []interface{}{models.Task_Running, now}
+	for domain, maxRunDuration := range defaultMaxRunDurations {
+		if maxRunDuration <= 0 {
+			continue
+		}
+		deadlines = append(deadlines, "(max_run_duration = 0 AND domain = ? AND updated_at < ?)")
+		values = append(values, domain, now-int64(maxRunDuration))
+	}
+	wheres := fmt.Sprintf("state = ? AND (%s)", strings.Join(deadlines, " OR "))
+
+	rows, err := db.all(ctx, logger, db.db, tasksTable, taskColumns, helpers.NoLockRow, wheres, values...)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return nil, 0, 0
+	}
+	defer rows.Close()
+
+	tasks, _, invalidTasksCount, err := db.fetchTasks(ctx, logger, rows, db.db, false)
+	if err != nil {
+		logger.Error("failed-fetching-tasks", err)
+	}
+
+	var changes []*models.TaskChange
+	var retriedCount int64
+	tasksToFail := []*models.Task{}
+	for _, task := range tasks {
+		if !task.ShouldRetry(models.RetryPolicy_Overdue) {
+			tasksToFail = append(tasksToFail, task)
+			continue
+		}
+
+		var afterTask *models.Task
+		err = db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+			afterTask, err = db.fetchTaskForUpdate(ctx, logger, task.TaskGuid, tx)
+			if err != nil {
+				logger.Error("failed-locking-task", err)
+				return err
+			}
+
+			// the task may have completed since it was fetched
+			if afterTask.State != models.Task_Running || afterTask.CellId != task.CellId {
+				afterTask = nil
+				return nil
+			}
+
+			return db.retryTask(ctx, logger, afterTask, models.RetryPolicy_Overdue, overdueFailureReason, tx)
+		})
+		if err != nil || afterTask == nil {
+			continue
+		}
+
+		retriedCount++
+		logger.Info("retried-overdue-task", lager.Data{"task_guid": task.TaskGuid, "cell_id": task.CellId})
+		changes = append(changes, &models.TaskChange{Before: task, After: afterTask})
+	}
+
+	if len(tasksToFail) == 0 {
+		return changes, uint64(invalidTasksCount), retriedCount
+	}
+
+	wheres += fmt.Sprintf(" AND guid IN (%s)", helpers.QuestionMarks(len(tasksToFail)))
+
+	for _, task := range tasksToFail {
+		values = append(values, task.TaskGuid)
+	}
+
+	failureReason, err := db.encryptTaskField(logger, overdueFailureReason)
+	if err != nil {
+		return changes, uint64(invalidTasksCount), retriedCount
+	}
+
+	result, err := db.update(ctx, logger, db.db, tasksTable,
+		helpers.SQLAttributes{
+			"failed":             true,
+			"failure_reason":     failureReason,
+			"result":             "",
+			"state":              models.Task_Completed,
+			"first_completed_at": now,
+			"updated_at":         now,
+			"cell_id":            "",
+		},
+		wheres, values...,
+	)
+	if err != nil {
+		logger.Error("failed-updating-tasks", err)
+		return changes, uint64(invalidTasksCount), retriedCount
+	}
+
+	for _, task := range tasksToFail {
+		afterTask := *task
+		afterTask.Failed = true
+		afterTask.FailureReason = overdueFailureReason
+		afterTask.Result = ""
+		afterTask.State = models.Task_Completed
+		afterTask.FirstCompletedAt = now
+		afterTask.UpdatedAt = now
+		afterTask.CellId = ""
+
+		logger.Info("failed-overdue-task", lager.Data{"task_guid": task.TaskGuid, "cell_id": task.CellId})
+		changes = append(changes, &models.TaskChange{Before: task, After: &afterTask})
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Error("failed-rows-affected", err)
+		return changes, uint64(invalidTasksCount), retriedCount
+	}
+
+	return changes, uint64(invalidTasksCount), rowsAffected + retriedCount
 }
 
 func (db *SQLDB) demoteKickableResolvingTasks(ctx context.Context, logger lager.Logger, kickTasksDuration time.Duration) ([]models.Event, uint64) {
@@ -322,7 +553,26 @@ func (db *SQLDB) deleteExpiredCompletedTasks(ctx context.Context, logger lager.L
 		values = append(values, guid)
 	}
 
-	result, err := db.delete(ctx, logger, db.db, tasksTable, wheres, values...)
+	var result sql.Result
+	err = db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		err := db.archiveTasks(ctx, logger, tx, wheres, values...)
+		if err != nil {
+			return err
+		}
+
+		err = db.recordTaskDependencyOutcomes(ctx, logger, tx, wheres, values...)
+		if err != nil {
+			return err
+		}
+
+		err = db.deleteTaskResults(ctx, logger, tx, validTaskGuids...)
+		if err != nil {
+			return err
+		}
+
+		result, err = db.delete(ctx, logger, tx, tasksTable, wheres, values...)
+		return err
+	})
 	if err != nil {
 		logger.Error("failed-query", err)
 		return nil, int64(invalidTasksCount)
@@ -365,6 +615,11 @@ func (db *SQLDB) getKickableCompleteTasksForCompletion(ctx context.Context, logg
 		logger.Error("failed-fetching-some-tasks", err)
 	}
 
+	err = db.loadTaskResults(ctx, logger, db.db, tasksToComplete...)
+	if err != nil {
+		logger.Error("failed-loading-task-results", err)
+	}
+
 	return tasksToComplete, uint64(failedFetches)
 }
 
@@ -34,7 +34,9 @@ var _ = Describe("Convergence of Tasks", func() {
 			cellSet        models.CellSet
 			taskDef        *models.TaskDefinition
 
-			convergenceResult dbpkg.TaskConvergenceResult
+			convergenceResult      dbpkg.TaskConvergenceResult
+			defaultMaxRunDurations map[string]time.Duration
+			taskArchiveRetention   time.Duration
 		)
 
 		BeforeEach(func() {
@@ -44,10 +46,12 @@ var _ = Describe("Convergence of Tasks", func() {
 				{CellId: existingCellID},
 			})
 			taskDef = model_helpers.NewValidTaskDefinition()
+			defaultMaxRunDurations = nil
+			taskArchiveRetention = 0
 		})
 
 		JustBeforeEach(func() {
-			convergenceResult = sqlDB.ConvergeTasks(ctx, logger, cellSet, kickTasksDuration, expirePendingTaskDuration, expireCompletedTaskDuration)
+			convergenceResult = sqlDB.ConvergeTasks(ctx, logger, cellSet, kickTasksDuration, expirePendingTaskDuration, expireCompletedTaskDuration, defaultMaxRunDurations, taskArchiveRetention)
 		})
 
 		Context("pending tasks", func() {
@@ -139,6 +143,36 @@ var _ = Describe("Convergence of Tasks", func() {
 			})
 		})
 
+		Context("pending tasks with a priority", func() {
+			var lowPriorityTaskDef, highPriorityTaskDef *models.TaskDefinition
+
+			BeforeEach(func() {
+				lowPriorityTaskDef = model_helpers.NewValidTaskDefinition()
+				highPriorityTaskDef = model_helpers.NewValidTaskDefinition()
+				highPriorityTaskDef.Priority = 10
+
+				fakeClock.IncrementBySeconds(-kickTasksDurationInSeconds)
+				_, err := sqlDB.DesireTask(ctx, logger, lowPriorityTaskDef, "old-low-priority-task", domain)
+				Expect(err).NotTo(HaveOccurred())
+				fakeClock.IncrementBySeconds(1)
+				_, err = sqlDB.DesireTask(ctx, logger, highPriorityTaskDef, "high-priority-task", domain)
+				Expect(err).NotTo(HaveOccurred())
+				fakeClock.IncrementBySeconds(1)
+				_, err = sqlDB.DesireTask(ctx, logger, lowPriorityTaskDef, "new-low-priority-task", domain)
+				Expect(err).NotTo(HaveOccurred())
+				fakeClock.IncrementBySeconds(kickTasksDurationInSeconds)
+			})
+
+			It("returns the tasks to auction ordered by priority and then by age", func() {
+				highPriorityRequest := auctioneer.NewTaskStartRequestFromModel("high-priority-task", domain, highPriorityTaskDef)
+				oldLowPriorityRequest := auctioneer.NewTaskStartRequestFromModel("old-low-priority-task", domain, lowPriorityTaskDef)
+				newLowPriorityRequest := auctioneer.NewTaskStartRequestFromModel("new-low-priority-task", domain, lowPriorityTaskDef)
+				Expect(convergenceResult.TasksToAuction).To(Equal([]*auctioneer.TaskStartRequest{
+					&highPriorityRequest, &oldLowPriorityRequest, &newLowPriorityRequest,
+				}))
+			})
+		})
+
 		Context("running tasks", func() {
 			var runningTaskNoCell *models.Task
 
@@ -156,6 +190,13 @@ var _ = Describe("Convergence of Tasks", func() {
 				_, err = db.ExecContext(ctx, "UPDATE tasks SET task_definition = 'garbage' WHERE guid = 'invalid-running-task-no-cell'")
 				Expect(err).NotTo(HaveOccurred())
 
+				_, err = sqlDB.DesireTask(ctx, logger, taskDef, "failed-task", domain)
+				Expect(err).NotTo(HaveOccurred())
+				_, _, _, err = sqlDB.StartTask(ctx, logger, "failed-task", existingCellID)
+				Expect(err).NotTo(HaveOccurred())
+				_, _, err = sqlDB.CompleteTask(ctx, logger, "failed-task", existingCellID, true, "boom", "")
+				Expect(err).NotTo(HaveOccurred())
+
 				_, err = sqlDB.DesireTask(ctx, logger, taskDef, "running-task", domain)
 				Expect(err).NotTo(HaveOccurred())
 				_, _, _, err = sqlDB.StartTask(ctx, logger, "running-task", existingCellID)
@@ -207,6 +248,273 @@ var _ = Describe("Convergence of Tasks", func() {
 			})
 		})
 
+		Context("running tasks with a maximum run duration", func() {
+			var overdueTask, retriedOverdueTask *models.Task
+
+			BeforeEach(func() {
+				limitedTaskDef := model_helpers.NewValidTaskDefinition()
+				limitedTaskDef.MaxRunDurationMs = 60000
+				limitedTaskDef.CompletionCallbackUrl = "http://example.com/callback"
+
+				defaultMaxRunDurations = map[string]time.Duration{"default-domain": 2 * time.Minute}
+
+				var err error
+				_, err = sqlDB.DesireTask(ctx, logger, limitedTaskDef, "overdue-task", domain)
+				Expect(err).NotTo(HaveOccurred())
+				_, overdueTask, _, err = sqlDB.StartTask(ctx, logger, "overdue-task", existingCellID)
+				Expect(err).NotTo(HaveOccurred())
+
+				retryingTaskDef := model_helpers.NewValidTaskDefinition()
+				retryingTaskDef.MaxRunDurationMs = 60000
+				retryingTaskDef.CompletionCallbackUrl = "http://example.com/callback"
+				retryingTaskDef.RetryPolicy = &models.RetryPolicy{
+					MaxAttempts: 2,
+					RetryOn:     []models.RetryPolicy_FailureClass{models.RetryPolicy_Overdue},
+				}
+				_, err = sqlDB.DesireTask(ctx, logger, retryingTaskDef, "retried-overdue-task", domain)
+				Expect(err).NotTo(HaveOccurred())
+				_, retriedOverdueTask, _, err = sqlDB.StartTask(ctx, logger, "retried-overdue-task", existingCellID)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = sqlDB.DesireTask(ctx, logger, taskDef, "overdue-default-task", "default-domain")
+				Expect(err).NotTo(HaveOccurred())
+				_, _, _, err = sqlDB.StartTask(ctx, logger, "overdue-default-task", existingCellID)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = sqlDB.DesireTask(ctx, logger, taskDef, "unlimited-task", domain)
+				Expect(err).NotTo(HaveOccurred())
+				_, _, _, err = sqlDB.StartTask(ctx, logger, "unlimited-task", existingCellID)
+				Expect(err).NotTo(HaveOccurred())
+
+				fakeClock.Increment(90 * time.Second)
+
+				_, err = sqlDB.DesireTask(ctx, logger, limitedTaskDef, "recent-task", domain)
+				Expect(err).NotTo(HaveOccurred())
+				_, _, _, err = sqlDB.StartTask(ctx, logger, "recent-task", existingCellID)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("fails tasks that have run for longer than their maximum run duration", func() {
+				task, err := sqlDB.TaskByGuid(ctx, logger, "overdue-task")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(task.State).To(Equal(models.Task_Completed))
+				Expect(task.Failed).To(BeTrue())
+				Expect(task.FailureReason).To(Equal("exceeded maximum run duration"))
+				Expect(task.CellId).To(BeEmpty())
+				Expect(task.FirstCompletedAt).To(Equal(fakeClock.Now().UnixNano()))
+			})
+
+			It("retries overdue tasks whose retry policy retries overdue failures", func() {
+				task, err := sqlDB.TaskByGuid(ctx, logger, "retried-overdue-task")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(task.State).To(Equal(models.Task_Pending))
+				Expect(task.CellId).To(BeEmpty())
+				Expect(task.Attempts).To(HaveLen(1))
+				Expect(task.Attempts[0].FailureClass).To(Equal(models.RetryPolicy_Overdue))
+				Expect(task.Attempts[0].FailureReason).To(Equal("exceeded maximum run duration"))
+				Expect(task.Attempts[0].CellId).To(Equal(existingCellID))
+			})
+
+			It("does not auction the retried tasks before they are cancelled on their cells", func() {
+				for _, request := range convergenceResult.TasksToAuction {
+					Expect(request.TaskGuid).NotTo(Equal("retried-overdue-task"))
+				}
+			})
+
+			It("returns the failed and retried tasks to be cancelled on their cells", func() {
+				Expect(convergenceResult.TasksToCancel).To(ConsistOf(overdueTask, retriedOverdueTask))
+			})
+
+			It("returns the failed tasks with callbacks to be completed", func() {
+				Expect(convergenceResult.TasksToComplete).To(HaveLen(1))
+				Expect(convergenceResult.TasksToComplete[0].TaskGuid).To(Equal("overdue-task"))
+			})
+
+			It("returns TaskChangedEvents for the failed and retried tasks", func() {
+				afterTask, err := sqlDB.TaskByGuid(ctx, logger, "overdue-task")
+				Expect(err).NotTo(HaveOccurred())
+				retriedTask, err := sqlDB.TaskByGuid(ctx, logger, "retried-overdue-task")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(convergenceResult.Events).To(ConsistOf(
+					models.NewTaskChangedEvent(overdueTask, afterTask),
+					models.NewTaskChangedEvent(retriedOverdueTask, retriedTask),
+				))
+			})
+
+			It("leaves tasks within their maximum run duration, or without one, running", func() {
+				for _, guid := range []string{"overdue-default-task", "unlimited-task", "recent-task"} {
+					task, err := sqlDB.TaskByGuid(ctx, logger, guid)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(task.State).To(Equal(models.Task_Running))
+				}
+			})
+
+			Context("when the default maximum run duration for the domain has passed", func() {
+				BeforeEach(func() {
+					fakeClock.Increment(time.Minute)
+				})
+
+				It("fails tasks without a maximum run duration of their own", func() {
+					task, err := sqlDB.TaskByGuid(ctx, logger, "overdue-default-task")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(task.State).To(Equal(models.Task_Completed))
+					Expect(task.FailureReason).To(Equal("exceeded maximum run duration"))
+
+					task, err = sqlDB.TaskByGuid(ctx, logger, "unlimited-task")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(task.State).To(Equal(models.Task_Running))
+				})
+			})
+		})
+
+		Context("running tasks with a retry policy", func() {
+			var runningTaskNoCell *models.Task
+
+			BeforeEach(func() {
+				taskDef.RetryPolicy = &models.RetryPolicy{
+					MaxAttempts: 2,
+					RetryOn:     []models.RetryPolicy_FailureClass{models.RetryPolicy_CellLost},
+				}
+
+				var err error
+				_, err = sqlDB.DesireTask(ctx, logger, taskDef, "running-task-no-cell", domain)
+				Expect(err).NotTo(HaveOccurred())
+				_, runningTaskNoCell, _, err = sqlDB.StartTask(ctx, logger, "running-task-no-cell", "non-existant-cell")
+				Expect(err).NotTo(HaveOccurred())
+
+				fakeClock.IncrementBySeconds(1)
+			})
+
+			It("moves them back to pending when their cells are not present", func() {
+				task, err := sqlDB.TaskByGuid(ctx, logger, "running-task-no-cell")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(task.State).To(Equal(models.Task_Pending))
+				Expect(task.Failed).To(BeFalse())
+				Expect(task.CellId).To(BeEmpty())
+				Expect(task.Attempts).To(Equal([]*models.TaskAttempt{{
+					CellId:        "non-existant-cell",
+					FailureClass:  models.RetryPolicy_CellLost,
+					FailureReason: "cell disappeared before completion",
+					FailedAt:      fakeClock.Now().UnixNano(),
+				}}))
+			})
+
+			It("returns them for auctioning", func() {
+				taskRequest := auctioneer.NewTaskStartRequestFromModel("running-task-no-cell", domain, taskDef)
+				Expect(convergenceResult.TasksToAuction).To(ConsistOf(&taskRequest))
+			})
+
+			It("returns TaskChangedEvents for the retried tasks", func() {
+				afterRunning, err := sqlDB.TaskByGuid(ctx, logger, "running-task-no-cell")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(convergenceResult.Events).To(ConsistOf(models.NewTaskChangedEvent(runningTaskNoCell, afterRunning)))
+			})
+		})
+
+		Context("pending tasks waiting to be retried", func() {
+			BeforeEach(func() {
+				taskDef.RetryPolicy = &models.RetryPolicy{
+					MaxAttempts: 2,
+					BackoffMs:   expirePendingTaskDuration.Milliseconds(),
+				}
+
+				// created long enough ago that it would have expired had it not been retried
+				fakeClock.Increment(-2 * expirePendingTaskDuration)
+				_, err := sqlDB.DesireTask(ctx, logger, taskDef, "retried-task", domain)
+				Expect(err).NotTo(HaveOccurred())
+				fakeClock.Increment(2 * expirePendingTaskDuration)
+
+				_, _, _, err = sqlDB.StartTask(ctx, logger, "retried-task", existingCellID)
+				Expect(err).NotTo(HaveOccurred())
+				_, _, err = sqlDB.CompleteTask(ctx, logger, "retried-task", existingCellID, true, "it blew up", "")
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("neither expires nor auctions them before the backoff elapses", func() {
+				Expect(convergenceResult.TasksToAuction).To(BeEmpty())
+
+				task, err := sqlDB.TaskByGuid(ctx, logger, "retried-task")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(task.State).To(Equal(models.Task_Pending))
+			})
+
+			Context("when the backoff has elapsed", func() {
+				BeforeEach(func() {
+					fakeClock.IncrementBySeconds(expirePendingTaskDurationInSeconds)
+				})
+
+				It("returns them for auctioning", func() {
+					taskRequest := auctioneer.NewTaskStartRequestFromModel("retried-task", domain, taskDef)
+					Expect(convergenceResult.TasksToAuction).To(ConsistOf(&taskRequest))
+				})
+			})
+
+			Context("when the task has not been placed within the expirePendingTaskDuration after the backoff", func() {
+				BeforeEach(func() {
+					fakeClock.IncrementBySeconds(2*expirePendingTaskDurationInSeconds + 1)
+				})
+
+				It("fails it", func() {
+					task, err := sqlDB.TaskByGuid(ctx, logger, "retried-task")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(task.State).To(Equal(models.Task_Completed))
+					Expect(task.FailureReason).To(Equal("not started within time limit"))
+				})
+			})
+		})
+
+		Context("pending tasks released from being blocked", func() {
+			BeforeEach(func() {
+				_, err := sqlDB.DesireTask(ctx, logger, taskDef, "dependency-task", domain)
+				Expect(err).NotTo(HaveOccurred())
+				_, _, _, err = sqlDB.StartTask(ctx, logger, "dependency-task", existingCellID)
+				Expect(err).NotTo(HaveOccurred())
+
+				// created long enough ago that it would have expired had it not been blocked
+				releasedTaskDef := model_helpers.NewValidTaskDefinition()
+				releasedTaskDef.DependsOn = []string{"dependency-task"}
+				fakeClock.Increment(-2 * expirePendingTaskDuration)
+				_, err = sqlDB.DesireTask(ctx, logger, releasedTaskDef, "released-task", domain)
+				Expect(err).NotTo(HaveOccurred())
+				fakeClock.Increment(2 * expirePendingTaskDuration)
+
+				_, _, err = sqlDB.CompleteTask(ctx, logger, "dependency-task", existingCellID, false, "", "")
+				Expect(err).NotTo(HaveOccurred())
+				released, _, err := sqlDB.ReleaseBlockedTasks(ctx, logger, "dependency-task")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(released).To(HaveLen(1))
+			})
+
+			It("neither expires them nor changes their creation time", func() {
+				task, err := sqlDB.TaskByGuid(ctx, logger, "released-task")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(task.State).To(Equal(models.Task_Pending))
+				Expect(task.CreatedAt).To(Equal(fakeClock.Now().Add(-2 * expirePendingTaskDuration).UnixNano()))
+			})
+
+			It("returns them for auctioning", func() {
+				task, err := sqlDB.TaskByGuid(ctx, logger, "released-task")
+				Expect(err).NotTo(HaveOccurred())
+
+				taskRequest := auctioneer.NewTaskStartRequestFromModel("released-task", domain, task.TaskDefinition)
+				Expect(convergenceResult.TasksToAuction).To(ContainElement(&taskRequest))
+			})
+
+			Context("when the task has not been placed within the expirePendingTaskDuration after its release", func() {
+				BeforeEach(func() {
+					fakeClock.IncrementBySeconds(expirePendingTaskDurationInSeconds + 1)
+				})
+
+				It("fails it", func() {
+					task, err := sqlDB.TaskByGuid(ctx, logger, "released-task")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(task.State).To(Equal(models.Task_Completed))
+					Expect(task.FailureReason).To(Equal("not started within time limit"))
+				})
+			})
+		})
+
 		Context("completed tasks", func() {
 			var expiredCompletedTask *models.Task
 
@@ -263,6 +571,21 @@ var _ = Describe("Convergence of Tasks", func() {
 				Expect(err).To(Equal(models.ErrResourceNotFound))
 			})
 
+			It("archives tasks that have exceeded expireCompleteTaskDuration", func() {
+				archivedTask, err := sqlDB.ArchivedTaskByGuid(ctx, logger, "completed-expired-task")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(archivedTask).To(Equal(&models.ArchivedTask{
+					TaskGuid:    "completed-expired-task",
+					Domain:      domain,
+					State:       models.Task_Completed,
+					CellId:      existingCellID,
+					CreatedAt:   expiredCompletedTask.CreatedAt,
+					StartedAt:   expiredCompletedTask.UpdatedAt,
+					CompletedAt: expiredCompletedTask.FirstCompletedAt,
+					ArchivedAt:  fakeClock.Now().UnixNano(),
+				}))
+			})
+
 			Context("when there are invalid tasks", func() {
 				BeforeEach(func() {
 					fakeClock.Increment(-expireCompletedTaskDuration)
@@ -310,6 +633,50 @@ var _ = Describe("Convergence of Tasks", func() {
 			})
 		})
 
+		Context("archived tasks", func() {
+			archiveTask := func(taskGuid string) {
+				_, err := sqlDB.DesireTask(ctx, logger, taskDef, taskGuid, domain)
+				Expect(err).NotTo(HaveOccurred())
+				_, _, _, err = sqlDB.StartTask(ctx, logger, taskGuid, existingCellID)
+				Expect(err).NotTo(HaveOccurred())
+				_, _, err = sqlDB.CompleteTask(ctx, logger, taskGuid, existingCellID, false, "", "")
+				Expect(err).NotTo(HaveOccurred())
+				_, _, err = sqlDB.ResolvingTask(ctx, logger, taskGuid)
+				Expect(err).NotTo(HaveOccurred())
+				_, err = sqlDB.DeleteTask(ctx, logger, taskGuid)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			BeforeEach(func() {
+				taskArchiveRetention = time.Hour
+
+				fakeClock.Increment(-2 * taskArchiveRetention)
+				archiveTask("old-archived-task")
+				fakeClock.Increment(2 * taskArchiveRetention)
+
+				archiveTask("archived-task")
+			})
+
+			It("prunes archived tasks that have exceeded the retention period", func() {
+				_, err := sqlDB.ArchivedTaskByGuid(ctx, logger, "old-archived-task")
+				Expect(err).To(Equal(models.ErrResourceNotFound))
+
+				_, err = sqlDB.ArchivedTaskByGuid(ctx, logger, "archived-task")
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			Context("when the retention period is 0", func() {
+				BeforeEach(func() {
+					taskArchiveRetention = 0
+				})
+
+				It("keeps every archived task", func() {
+					_, err := sqlDB.ArchivedTaskByGuid(ctx, logger, "old-archived-task")
+					Expect(err).NotTo(HaveOccurred())
+				})
+			})
+		})
+
 		Context("resolving tasks", func() {
 			var resolvingExpiredTask, resolvingKickableTask *models.Task
 
@@ -415,5 +782,76 @@ var _ = Describe("Convergence of Tasks", func() {
 				Expect(convergenceResult.Events).To(ConsistOf(event1, event2, event3))
 			})
 		})
+
+		Context("blocked tasks", func() {
+			var releasableTask, failableTask *models.Task
+
+			BeforeEach(func() {
+				var err error
+				_, err = sqlDB.DesireTask(ctx, logger, taskDef, "succeeded-task", domain)
+				Expect(err).NotTo(HaveOccurred())
+				_, _, _, err = sqlDB.StartTask(ctx, logger, "succeeded-task", existingCellID)
+				Expect(err).NotTo(HaveOccurred())
+				_, _, err = sqlDB.CompleteTask(ctx, logger, "succeeded-task", existingCellID, false, "", "")
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = sqlDB.DesireTask(ctx, logger, taskDef, "failed-task", domain)
+				Expect(err).NotTo(HaveOccurred())
+				_, _, _, err = sqlDB.StartTask(ctx, logger, "failed-task", existingCellID)
+				Expect(err).NotTo(HaveOccurred())
+				_, _, err = sqlDB.CompleteTask(ctx, logger, "failed-task", existingCellID, true, "boom", "")
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = sqlDB.DesireTask(ctx, logger, taskDef, "running-task", domain)
+				Expect(err).NotTo(HaveOccurred())
+				_, _, _, err = sqlDB.StartTask(ctx, logger, "running-task", existingCellID)
+				Expect(err).NotTo(HaveOccurred())
+
+				releasableTaskDef := model_helpers.NewValidTaskDefinition()
+				releasableTaskDef.DependsOn = []string{"succeeded-task"}
+				releasableTask, err = sqlDB.DesireTask(ctx, logger, releasableTaskDef, "releasable-task", domain)
+				Expect(err).NotTo(HaveOccurred())
+
+				failableTaskDef := model_helpers.NewValidTaskDefinition()
+				failableTaskDef.DependsOn = []string{"running-task", "failed-task"}
+				failableTaskDef.CompletionCallbackUrl = "http://example.com/callback"
+				failableTask, err = sqlDB.DesireTask(ctx, logger, failableTaskDef, "failable-task", domain)
+				Expect(err).NotTo(HaveOccurred())
+
+				blockedTaskDef := model_helpers.NewValidTaskDefinition()
+				blockedTaskDef.DependsOn = []string{"running-task"}
+				_, err = sqlDB.DesireTask(ctx, logger, blockedTaskDef, "blocked-task", domain)
+				Expect(err).NotTo(HaveOccurred())
+
+				fakeClock.IncrementBySeconds(1)
+			})
+
+			It("releases blocked tasks whose dependencies have finished and auctions them", func() {
+				task, err := sqlDB.TaskByGuid(ctx, logger, "releasable-task")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(task.State).To(Equal(models.Task_Pending))
+
+				taskRequest := auctioneer.NewTaskStartRequestFromModel("releasable-task", domain, task.TaskDefinition)
+				Expect(convergenceResult.TasksToAuction).To(ContainElement(&taskRequest))
+				Expect(convergenceResult.Events).To(ContainElement(models.NewTaskChangedEvent(releasableTask, task)))
+			})
+
+			It("fails blocked tasks whose dependencies cannot be satisfied and completes them", func() {
+				task, err := sqlDB.TaskByGuid(ctx, logger, "failable-task")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(task.State).To(Equal(models.Task_Completed))
+				Expect(task.Failed).To(BeTrue())
+				Expect(task.FailureReason).To(Equal("dependency failed-task failed"))
+
+				Expect(convergenceResult.TasksToComplete).To(ContainElement(task))
+				Expect(convergenceResult.Events).To(ContainElement(models.NewTaskChangedEvent(failableTask, task)))
+			})
+
+			It("leaves tasks with unfinished dependencies blocked", func() {
+				task, err := sqlDB.TaskByGuid(ctx, logger, "blocked-task")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(task.State).To(Equal(models.Task_Blocked))
+			})
+		})
 	})
 })
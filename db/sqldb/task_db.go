@@ -3,6 +3,8 @@ package sqldb
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"fmt"
 	"strings"
 
 	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
@@ -21,8 +23,23 @@ func (db *SQLDB) DesireTask(ctx context.Context, logger lager.Logger, taskDef *m
 		return nil, err
 	}
 
+	state := models.Task_Pending
+	if len(taskDef.DependsOn) > 0 {
+		state = models.Task_Blocked
+	}
+
 	now := db.clock.Now().UnixNano()
 	err = db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		err = db.checkDomainQuota(ctx, logger, tx, domain, &models.DomainUsage{Tasks: 1})
+		if err != nil {
+			return err
+		}
+
+		dependencies, err := db.checkTaskDependenciesExist(ctx, logger, tx, taskDef.DependsOn)
+		if err != nil {
+			return err
+		}
+
 		_, err = db.insert(ctx, logger, tx, tasksTable,
 			helpers.SQLAttributes{
 				"guid":               taskGuid,
@@ -30,12 +47,17 @@ func (db *SQLDB) DesireTask(ctx context.Context, logger lager.Logger, taskDef *m
 				"created_at":         now,
 				"updated_at":         now,
 				"first_completed_at": 0,
-				"state":              models.Task_Pending,
+				"state":              state,
 				"task_definition":    taskDefData,
+				"max_run_duration":   int64(taskDef.MaxRunDuration()),
+				"priority":           taskDef.Priority,
 			},
 		)
+		if err != nil {
+			return err
+		}
 
-		return err
+		return db.insertTaskDependencies(ctx, logger, tx, taskGuid, taskDef.DependsOn, dependencies)
 	})
 
 	if err != nil {
@@ -50,10 +72,92 @@ func (db *SQLDB) DesireTask(ctx context.Context, logger lager.Logger, taskDef *m
 		CreatedAt:        now,
 		UpdatedAt:        now,
 		FirstCompletedAt: 0,
-		State:            models.Task_Pending,
+		State:            state,
 	}, nil
 }
 
+// DesireTaskArray creates count Tasks from the given TaskDefinition in a
+// single transaction. Each Task is given its index in the array through the
+// INDEX environment variable.
+func (db *SQLDB) DesireTaskArray(ctx context.Context, logger lager.Logger, taskDef *models.TaskDefinition, arrayGuid, domain string, count int32) ([]*models.Task, error) {
+	logger = logger.Session("db-desire-task-array", lager.Data{"array_guid": arrayGuid, "count": count})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	state := models.Task_Pending
+	if len(taskDef.DependsOn) > 0 {
+		state = models.Task_Blocked
+	}
+
+	now := db.clock.Now().UnixNano()
+	tasks := make([]*models.Task, 0, count)
+	err := db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		tasks = tasks[:0]
+		err := db.checkDomainQuota(ctx, logger, tx, domain, &models.DomainUsage{Tasks: count})
+		if err != nil {
+			return err
+		}
+
+		dependencies, err := db.checkTaskDependenciesExist(ctx, logger, tx, taskDef.DependsOn)
+		if err != nil {
+			return err
+		}
+
+		for index := int32(0); index < count; index++ {
+			indexedTaskDef := models.TaskArrayTaskDefinition(taskDef, index)
+			taskDefData, err := db.serializeModel(logger, indexedTaskDef)
+			if err != nil {
+				logger.Error("failed-serializing-task-definition", err)
+				return err
+			}
+
+			taskGuid := models.TaskArrayTaskGuid(arrayGuid, index)
+			_, err = db.insert(ctx, logger, tx, tasksTable,
+				helpers.SQLAttributes{
+					"guid":               taskGuid,
+					"domain":             domain,
+					"created_at":         now,
+					"updated_at":         now,
+					"first_completed_at": 0,
+					"state":              state,
+					"task_definition":    taskDefData,
+					"array_guid":         arrayGuid,
+					"array_index":        index,
+					"max_run_duration":   int64(taskDef.MaxRunDuration()),
+					"priority":           taskDef.Priority,
+				},
+			)
+			if err != nil {
+				logger.Error("failed-inserting-task", err, lager.Data{"task_guid": taskGuid})
+				return err
+			}
+
+			err = db.insertTaskDependencies(ctx, logger, tx, taskGuid, taskDef.DependsOn, dependencies)
+			if err != nil {
+				return err
+			}
+
+			tasks = append(tasks, &models.Task{
+				TaskDefinition:   indexedTaskDef,
+				TaskGuid:         taskGuid,
+				Domain:           domain,
+				CreatedAt:        now,
+				UpdatedAt:        now,
+				FirstCompletedAt: 0,
+				State:            state,
+				ArrayGuid:        arrayGuid,
+				ArrayIndex:       index,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
 func (db *SQLDB) Tasks(ctx context.Context, logger lager.Logger, filter models.TaskFilter) ([]*models.Task, error) {
 	logger = logger.Session("db-tasks", lager.Data{"filter": filter})
 	logger.Debug("starting")
@@ -72,11 +176,16 @@ func (db *SQLDB) Tasks(ctx context.Context, logger lager.Logger, filter models.T
 		values = append(values, filter.CellID)
 	}
 
+	columns := taskColumnsWithoutResult
+	if filter.IncludeResults {
+		columns = taskColumns
+	}
+
 	results := []*models.Task{}
 
 	err := db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
 		rows, err := db.all(ctx, logger, tx, tasksTable,
-			taskColumns, helpers.NoLockRow,
+			columns, helpers.NoLockRow,
 			strings.Join(wheres, " AND "), values...,
 		)
 		if err != nil {
@@ -91,6 +200,40 @@ func (db *SQLDB) Tasks(ctx context.Context, logger lager.Logger, filter models.T
 			return err
 		}
 
+		if filter.IncludeResults {
+			return db.loadTaskResults(ctx, logger, tx, results...)
+		}
+
+		return nil
+	})
+
+	return results, err
+}
+
+func (db *SQLDB) TasksByArrayGuid(ctx context.Context, logger lager.Logger, arrayGuid string) ([]*models.Task, error) {
+	logger = logger.Session("db-tasks-by-array-guid", lager.Data{"array_guid": arrayGuid})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	results := []*models.Task{}
+
+	err := db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		rows, err := db.all(ctx, logger, tx, tasksTable,
+			taskColumns, helpers.NoLockRow,
+			"array_guid = ?", arrayGuid,
+		)
+		if err != nil {
+			logger.Error("failed-query", err)
+			return err
+		}
+		defer rows.Close()
+
+		results, _, _, err = db.fetchTasks(ctx, logger, rows, tx, true)
+		if err != nil {
+			logger.Error("failed-fetch", err)
+			return err
+		}
+
 		return nil
 	})
 
@@ -112,7 +255,11 @@ func (db *SQLDB) TaskByGuid(ctx context.Context, logger lager.Logger, taskGuid s
 		)
 
 		task, err = db.fetchTask(ctx, logger, row, tx)
-		return err
+		if err != nil {
+			return err
+		}
+
+		return db.loadTaskResults(ctx, logger, tx, task)
 	})
 
 	return task, err
@@ -146,12 +293,23 @@ func (db *SQLDB) StartTask(ctx context.Context, logger lager.Logger, taskGuid, c
 			return err
 		}
 
+		cordoned, err := db.cellCordoned(ctx, logger, tx, cellId)
+		if err != nil {
+			return err
+		}
+		if cordoned {
+			logger.Info("cell-is-cordoned")
+			return nil
+		}
+
 		now := db.clock.Now().UnixNano()
 		_, err = db.update(ctx, logger, tx, tasksTable,
 			helpers.SQLAttributes{
-				"state":      models.Task_Running,
-				"updated_at": now,
-				"cell_id":    cellId,
+				"state":           models.Task_Running,
+				"updated_at":      now,
+				"started_at":      now,
+				"started_cell_id": cellId,
+				"cell_id":         cellId,
 			},
 			"guid = ?", taskGuid,
 		)
@@ -191,7 +349,7 @@ func (db *SQLDB) CancelTask(ctx context.Context, logger lager.Logger, taskGuid s
 		cellID = afterTask.CellId
 
 		if err = afterTask.ValidateTransitionTo(models.Task_Completed); err != nil {
-			if afterTask.State != models.Task_Pending {
+			if afterTask.State != models.Task_Pending && afterTask.State != models.Task_Blocked {
 				logger.Error("failed-to-transition-task-to-completed", err)
 				return err
 			}
@@ -234,6 +392,10 @@ func (db *SQLDB) CompleteTask(ctx context.Context, logger lager.Logger, taskGuid
 			return err
 		}
 
+		if failed && afterTask.ShouldRetry(models.RetryPolicy_NonZeroExit) {
+			return db.retryTask(ctx, logger, afterTask, models.RetryPolicy_NonZeroExit, failureReason, tx)
+		}
+
 		err = db.completeTask(ctx, logger, afterTask, failed, failureReason, taskResult, tx)
 		if err != nil {
 			return err
@@ -264,7 +426,7 @@ func (db *SQLDB) FailTask(ctx context.Context, logger lager.Logger, taskGuid, fa
 		beforeTask = *afterTask
 
 		if err = afterTask.ValidateTransitionTo(models.Task_Completed); err != nil {
-			if afterTask.State != models.Task_Pending {
+			if afterTask.State != models.Task_Pending && afterTask.State != models.Task_Blocked {
 				logger.Error("failed-to-transition-task-to-completed", err)
 				return err
 			}
@@ -398,6 +560,21 @@ func (db *SQLDB) DeleteTask(ctx context.Context, logger lager.Logger, taskGuid s
 			return err
 		}
 
+		err = db.archiveTasks(ctx, logger, tx, "guid = ?", taskGuid)
+		if err != nil {
+			return err
+		}
+
+		err = db.recordTaskDependencyOutcomes(ctx, logger, tx, "guid = ?", taskGuid)
+		if err != nil {
+			return err
+		}
+
+		err = db.deleteTaskResults(ctx, logger, tx, taskGuid)
+		if err != nil {
+			return err
+		}
+
 		_, err = db.delete(ctx, logger, tx, tasksTable, "guid = ?", taskGuid)
 		if err != nil {
 			logger.Error("failed-deleting-task", err)
@@ -409,9 +586,356 @@ func (db *SQLDB) DeleteTask(ctx context.Context, logger lager.Logger, taskGuid s
 	return task, err
 }
 
+// ReleaseBlockedTasks moves blocked tasks whose dependencies have all finished
+// into the pending state so that they can be auctioned. Blocked tasks whose
+// dependencies can never be satisfied are failed instead. Only the given tasks
+// and the tasks that depend on them are considered, or every blocked task when
+// no task guids are given.
+func (db *SQLDB) ReleaseBlockedTasks(ctx context.Context, logger lager.Logger, taskGuids ...string) ([]*models.TaskChange, []*models.TaskChange, error) {
+	logger = logger.Session("db-release-blocked-tasks", lager.Data{"task_guids": taskGuids})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	var released, failed []*models.TaskChange
+
+	err := db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		var err error
+		released, failed, err = db.releaseBlockedTasks(ctx, logger, tx, taskGuids)
+		return err
+	})
+
+	return released, failed, err
+}
+
+func (db *SQLDB) releaseBlockedTasks(ctx context.Context, logger lager.Logger, tx helpers.Tx, taskGuids []string) ([]*models.TaskChange, []*models.TaskChange, error) {
+	var released, failed []*models.TaskChange
+
+	for {
+		blockedTasks, err := db.fetchBlockedTasks(ctx, logger, tx, taskGuids)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if len(blockedTasks) == 0 {
+			return released, failed, nil
+		}
+
+		dependencies, err := db.fetchTaskDependencies(ctx, logger, tx, blockedTasks)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		failedGuids := []string{}
+
+		// failing a task may in turn fail the tasks that depend on it, so keep
+		// going until a pass leaves every remaining task blocked
+		for len(blockedTasks) > 0 {
+			stillBlocked := []*models.Task{}
+
+			for _, task := range blockedTasks {
+				ready, failureReason := dependencyStatus(task, dependencies)
+				switch {
+				case failureReason != "":
+					afterTask := task.Copy()
+					err = db.completeTask(ctx, logger, afterTask, true, failureReason, "", tx)
+					if err != nil {
+						return nil, nil, err
+					}
+
+					dependencies[task.TaskGuid] = afterTask
+					failed = append(failed, &models.TaskChange{Before: task, After: afterTask})
+					failedGuids = append(failedGuids, task.TaskGuid)
+				case ready:
+					afterTask, err := db.releaseBlockedTask(ctx, logger, task, tx)
+					if err != nil {
+						return nil, nil, err
+					}
+
+					dependencies[task.TaskGuid] = afterTask
+					released = append(released, &models.TaskChange{Before: task, After: afterTask})
+				default:
+					stillBlocked = append(stillBlocked, task)
+				}
+			}
+
+			if len(stillBlocked) == len(blockedTasks) {
+				break
+			}
+			blockedTasks = stillBlocked
+		}
+
+		// every blocked task has been considered, or no failure can affect the
+		// tasks that were not fetched
+		if len(taskGuids) == 0 || len(failedGuids) == 0 {
+			return released, failed, nil
+		}
+		taskGuids = failedGuids
+	}
+}
+
+// fetchBlockedTasks locks and returns the given blocked tasks and the blocked
+// tasks depending on them, or every blocked task when no task guids are
+// given.
+func (db *SQLDB) fetchBlockedTasks(ctx context.Context, logger lager.Logger, tx helpers.Tx, taskGuids []string) ([]*models.Task, error) {
+	wheres := "state = ?"
+	values := []interface{}{models.Task_Blocked}
+
+	if len(taskGuids) > 0 {
+		guids := make([]interface{}, 0, len(taskGuids))
+		for _, guid := range taskGuids {
+			guids = append(guids, guid)
+		}
+
+		wheres += fmt.Sprintf(" AND (guid IN (%[1]s) OR guid IN (SELECT task_guid FROM %[2]s WHERE dependency_guid IN (%[1]s)))",
+			helpers.QuestionMarks(len(guids)), taskDependenciesTable)
+		values = append(values, guids...)
+		values = append(values, guids...)
+	}
+
+	rows, err := db.all(ctx, logger, tx, tasksTable,
+		taskColumns, helpers.LockRow,
+		wheres, values...,
+	)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return nil, err
+	}
+
+	blockedTasks, _, _, err := db.fetchTasks(ctx, logger, rows, tx, false)
+	if err != nil {
+		logger.Error("failed-fetching-some-tasks", err)
+	}
+
+	return blockedTasks, nil
+}
+
+func (db *SQLDB) releaseBlockedTask(ctx context.Context, logger lager.Logger, task *models.Task, tx helpers.Tx) (*models.Task, error) {
+	afterTask := task.Copy()
+	if err := afterTask.ValidateTransitionTo(models.Task_Pending); err != nil {
+		logger.Error("failed-to-transition-task-to-pending", err)
+		return nil, err
+	}
+
+	// released tasks expire and are kicked relative to the time they become
+	// eligible to be placed rather than their creation time
+	now := db.clock.Now().UnixNano()
+	afterTask.State = models.Task_Pending
+	afterTask.UpdatedAt = now
+
+	_, err := db.update(ctx, logger, tx, tasksTable,
+		helpers.SQLAttributes{
+			"state":       afterTask.State,
+			"released_at": now,
+			"updated_at":  afterTask.UpdatedAt,
+		},
+		"guid = ?", task.TaskGuid,
+	)
+	if err != nil {
+		logger.Error("failed-updating-tasks", err)
+		return nil, err
+	}
+
+	err = db.deleteTaskDependencies(ctx, logger, tx, task.TaskGuid)
+	if err != nil {
+		return nil, err
+	}
+
+	return afterTask, nil
+}
+
+// insertTaskDependencies records the tasks the given task depends on, so that
+// it can be found when one of them finishes, along with the outcome of the
+// ones that have already finished.
+func (db *SQLDB) insertTaskDependencies(ctx context.Context, logger lager.Logger, tx helpers.Tx, taskGuid string, dependsOn []string, dependencies map[string]*models.Task) error {
+	for _, dependencyGuid := range dependsOn {
+		attributes := helpers.SQLAttributes{
+			"task_guid":       taskGuid,
+			"dependency_guid": dependencyGuid,
+		}
+		if dependency, ok := dependencies[dependencyGuid]; ok && taskFinished(dependency) {
+			attributes["dependency_completed"] = true
+			attributes["dependency_failed"] = dependency.Failed
+		}
+
+		_, err := db.insert(ctx, logger, tx, taskDependenciesTable, attributes)
+		if err != nil {
+			logger.Error("failed-inserting-task-dependency", err, lager.Data{"dependency_guid": dependencyGuid})
+			return err
+		}
+	}
+	return nil
+}
+
+// recordTaskDependencyOutcomes records the outcome of the tasks matching the
+// given where clause for the tasks that depend on them. It must be called in
+// the same transaction that deletes the tasks, so that their dependents can
+// still be released once neither the task nor its archive is around.
+func (db *SQLDB) recordTaskDependencyOutcomes(ctx context.Context, logger lager.Logger, q helpers.Queryable, wheres string, whereBindings ...interface{}) error {
+	query := fmt.Sprintf(`UPDATE %[1]s SET dependency_completed = true,
+		dependency_failed = (SELECT COALESCE(%[2]s.failed, false) FROM %[2]s WHERE %[2]s.guid = %[1]s.dependency_guid)
+		WHERE dependency_guid IN (SELECT guid FROM %[2]s WHERE %[3]s)`,
+		taskDependenciesTable, tasksTable, wheres,
+	)
+
+	_, err := q.ExecContext(ctx, db.helper.Rebind(query), whereBindings...)
+	if err != nil {
+		logger.Error("failed-recording-task-dependency-outcomes", err)
+		return err
+	}
+
+	return nil
+}
+
+func (db *SQLDB) deleteTaskDependencies(ctx context.Context, logger lager.Logger, tx helpers.Tx, taskGuid string) error {
+	_, err := db.delete(ctx, logger, tx, taskDependenciesTable, "task_guid = ?", taskGuid)
+	if err != nil {
+		logger.Error("failed-deleting-task-dependencies", err)
+		return err
+	}
+	return nil
+}
+
+// checkTaskDependenciesExist returns the state of the given dependencies, or
+// an InvalidRequest error if one of them is unknown.
+func (db *SQLDB) checkTaskDependenciesExist(ctx context.Context, logger lager.Logger, tx helpers.Tx, dependsOn []string) (map[string]*models.Task, error) {
+	dependencies, err := db.fetchTaskStates(ctx, logger, tx, dependsOn)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, guid := range dependsOn {
+		if _, ok := dependencies[guid]; !ok {
+			return nil, models.NewError(models.Error_InvalidRequest, fmt.Sprintf("dependency %s not found", guid))
+		}
+	}
+	return dependencies, nil
+}
+
+// fetchTaskDependencies returns the blocked tasks along with the state of
+// every task they depend on, keyed by task guid.
+func (db *SQLDB) fetchTaskDependencies(ctx context.Context, logger lager.Logger, tx helpers.Tx, blockedTasks []*models.Task) (map[string]*models.Task, error) {
+	guids := []string{}
+	for _, task := range blockedTasks {
+		guids = append(guids, task.DependsOn...)
+	}
+
+	dependencies, err := db.fetchTaskStates(ctx, logger, tx, guids)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, task := range blockedTasks {
+		dependencies[task.TaskGuid] = task
+	}
+	return dependencies, nil
+}
+
+// fetchTaskStates returns the state of the given tasks, keyed by task guid.
+// Tasks that have been deleted are looked up in the task archive, and then in
+// the outcomes recorded for the tasks depending on them. Unknown tasks are
+// left out.
+func (db *SQLDB) fetchTaskStates(ctx context.Context, logger lager.Logger, tx helpers.Tx, guids []string) (map[string]*models.Task, error) {
+	queries := []string{
+		fmt.Sprintf("SELECT guid, state, failed FROM %s WHERE guid IN (%%s)", tasksTable),
+		// a task can be archived more than once, so the latest archive wins
+		fmt.Sprintf("SELECT guid, state, failed FROM %s WHERE guid IN (%%s) ORDER BY archived_at DESC", taskArchiveTable),
+		fmt.Sprintf("SELECT dependency_guid, %d, dependency_failed FROM %s WHERE dependency_completed = true AND dependency_guid IN (%%s)",
+			models.Task_Completed, taskDependenciesTable),
+	}
+
+	states := map[string]*models.Task{}
+	for _, query := range queries {
+		remaining := []interface{}{}
+		requested := map[string]bool{}
+		for _, guid := range guids {
+			if _, ok := states[guid]; ok || requested[guid] {
+				continue
+			}
+			requested[guid] = true
+			remaining = append(remaining, guid)
+		}
+
+		if len(remaining) == 0 {
+			return states, nil
+		}
+
+		rows, err := tx.QueryContext(ctx, db.helper.Rebind(fmt.Sprintf(query, helpers.QuestionMarks(len(remaining)))), remaining...)
+		if err != nil {
+			logger.Error("failed-query", err)
+			return nil, err
+		}
+
+		for rows.Next() {
+			var guid string
+			var state int32
+			var failed bool
+
+			err = rows.Scan(&guid, &state, &failed)
+			if err != nil {
+				rows.Close()
+				logger.Error("failed-scanning-row", err)
+				return nil, err
+			}
+
+			if _, ok := states[guid]; !ok {
+				states[guid] = &models.Task{TaskGuid: guid, State: models.Task_State(state), Failed: failed}
+			}
+		}
+
+		rows.Close()
+		if rows.Err() != nil {
+			logger.Error("failed-getting-next-row", rows.Err())
+			return nil, rows.Err()
+		}
+	}
+
+	return states, nil
+}
+
+// taskFinished reports whether the task has completed, whether or not it has
+// been resolved since.
+func taskFinished(task *models.Task) bool {
+	return task.State == models.Task_Completed || task.State == models.Task_Resolving
+}
+
+// dependencyStatus reports whether every dependency of the task has finished
+// in a way that satisfies its dependency condition. A non-empty failure
+// reason is returned when a dependency can never be satisfied.
+func dependencyStatus(task *models.Task, dependencies map[string]*models.Task) (bool, string) {
+	ready := true
+	for _, guid := range task.DependsOn {
+		dependency, ok := dependencies[guid]
+		if !ok {
+			// dependencies are checked when the task is desired, so this one
+			// was deleted before its outcome was recorded and cannot be known
+			// to have succeeded
+			if task.DependencyCondition == models.TaskDefinition_OnSuccess {
+				return false, fmt.Sprintf("outcome of dependency %s is unknown", guid)
+			}
+			continue
+		}
+
+		switch {
+		case !taskFinished(dependency):
+			ready = false
+		case dependency.Failed && task.DependencyCondition == models.TaskDefinition_OnSuccess:
+			return false, fmt.Sprintf("dependency %s failed", guid)
+		}
+	}
+
+	return ready, ""
+}
+
 func (db *SQLDB) completeTask(ctx context.Context, logger lager.Logger, task *models.Task, failed bool, failureReason, result string, tx helpers.Tx) error {
 	now := db.clock.Now().UnixNano()
 
+	if task.State == models.Task_Blocked {
+		err := db.deleteTaskDependencies(ctx, logger, tx, task.TaskGuid)
+		if err != nil {
+			return err
+		}
+	}
+
 	task.State = models.Task_Completed
 	task.UpdatedAt = now
 	task.FirstCompletedAt = now
@@ -420,11 +944,30 @@ func (db *SQLDB) completeTask(ctx context.Context, logger lager.Logger, task *mo
 	task.Result = result
 	task.CellId = ""
 
-	_, err := db.update(ctx, logger, tx, tasksTable,
+	failureReasonData, err := db.encryptTaskField(logger, task.FailureReason)
+	if err != nil {
+		return err
+	}
+
+	resultData, err := db.encryptTaskField(logger, task.Result)
+	if err != nil {
+		return err
+	}
+
+	if db.shouldOffloadTaskResult(task.Result) {
+		logger.Info("offloading-task-result", lager.Data{"task_guid": task.TaskGuid, "result_size": len(task.Result)})
+		err = db.storeTaskResult(ctx, logger, tx, task.TaskGuid, resultData)
+		if err != nil {
+			return err
+		}
+		resultData = []byte{}
+	}
+
+	_, err = db.update(ctx, logger, tx, tasksTable,
 		helpers.SQLAttributes{
 			"failed":             task.Failed,
-			"failure_reason":     task.FailureReason,
-			"result":             task.Result,
+			"failure_reason":     failureReasonData,
+			"result":             resultData,
 			"state":              task.State,
 			"first_completed_at": task.FirstCompletedAt,
 			"updated_at":         task.UpdatedAt,
@@ -440,6 +983,96 @@ func (db *SQLDB) completeTask(ctx context.Context, logger lager.Logger, task *mo
 	return nil
 }
 
+// retryTask records the failed attempt on the task and moves it back to the
+// pending state so that it is auctioned again once its retry backoff elapses.
+func (db *SQLDB) retryTask(ctx context.Context, logger lager.Logger, task *models.Task, failureClass models.RetryPolicy_FailureClass, failureReason string, queryable helpers.Queryable) error {
+	now := db.clock.Now()
+
+	task.Attempts = append(task.Attempts, &models.TaskAttempt{
+		CellId:        task.CellId,
+		FailureClass:  failureClass,
+		FailureReason: truncateString(failureReason, 1024),
+		FailedAt:      now.UnixNano(),
+	})
+
+	attemptsData, err := db.encodeTaskAttempts(logger, task.Attempts)
+	if err != nil {
+		return err
+	}
+
+	task.State = models.Task_Pending
+	task.UpdatedAt = now.UnixNano()
+	task.RetryAt = now.Add(task.RetryPolicy.Backoff(len(task.Attempts))).UnixNano()
+	task.Result = ""
+	task.CellId = ""
+
+	err = db.deleteTaskResults(ctx, logger, queryable, task.TaskGuid)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("retrying-task", lager.Data{"task_guid": task.TaskGuid, "attempts": len(task.Attempts), "retry_at": task.RetryAt})
+	_, err = db.update(ctx, logger, queryable, tasksTable,
+		helpers.SQLAttributes{
+			"attempts":   attemptsData,
+			"retry_at":   task.RetryAt,
+			"result":     "",
+			"state":      task.State,
+			"updated_at": task.UpdatedAt,
+			"cell_id":    "",
+		},
+		"guid = ?", task.TaskGuid,
+	)
+	if err != nil {
+		logger.Error("failed-updating-tasks", err)
+		return err
+	}
+
+	return nil
+}
+
+func (db *SQLDB) encodeTaskAttempts(logger lager.Logger, attempts []*models.TaskAttempt) ([]byte, error) {
+	attemptsData, err := json.Marshal(attempts)
+	if err != nil {
+		logger.Error("failed-marshalling-attempts", err)
+		return nil, models.ErrBadRequest
+	}
+	encodedData, err := db.encoder.Encode(attemptsData)
+	if err != nil {
+		logger.Error("failed-encrypting-attempts", err)
+		return nil, models.ErrBadRequest
+	}
+	return encodedData, nil
+}
+
+// encryptTaskField encrypts a task field that may hold sensitive data, such as
+// a result or a failure reason. Empty fields are stored as they are.
+func (db *SQLDB) encryptTaskField(logger lager.Logger, value string) ([]byte, error) {
+	if value == "" {
+		return []byte{}, nil
+	}
+
+	encodedData, err := db.encoder.Encode([]byte(value))
+	if err != nil {
+		logger.Error("failed-encrypting-task-field", err)
+		return nil, models.ErrBadRequest
+	}
+	return encodedData, nil
+}
+
+func (db *SQLDB) decryptTaskField(logger lager.Logger, data []byte) (string, error) {
+	if len(data) == 0 {
+		return "", nil
+	}
+
+	decodedData, err := db.encoder.Decode(data)
+	if err != nil {
+		logger.Error("failed-decrypting-task-field", err)
+		return "", models.ErrDeserialize
+	}
+	return string(decodedData), nil
+}
+
 func (db *SQLDB) fetchTaskForUpdate(ctx context.Context, logger lager.Logger, taskGuid string, queryable helpers.Queryable) (*models.Task, error) {
 	row := db.one(ctx, logger, queryable, tasksTable,
 		taskColumns, helpers.LockRow,
@@ -505,12 +1138,12 @@ func (db *SQLDB) fetchTask(ctx context.Context, logger lager.Logger, scanner hel
 }
 
 func (db *SQLDB) fetchTaskInternal(logger lager.Logger, scanner helpers.RowScanner) (*models.Task, string, error) {
-	var guid, domain, cellID, failureReason, rejectionReason string
-	var result sql.NullString
-	var createdAt, updatedAt, firstCompletedAt int64
-	var state, rejectionCount int32
+	var guid, domain, cellID, rejectionReason, arrayGuid string
+	var resultData, failureReasonData []byte
+	var createdAt, updatedAt, firstCompletedAt, retryAt int64
+	var state, rejectionCount, arrayIndex int32
 	var failed bool
-	var taskDefData []byte
+	var taskDefData, attemptsData []byte
 
 	err := scanner.Scan(
 		&guid,
@@ -520,12 +1153,16 @@ func (db *SQLDB) fetchTaskInternal(logger lager.Logger, scanner helpers.RowScann
 		&firstCompletedAt,
 		&state,
 		&cellID,
-		&result,
+		&resultData,
 		&failed,
-		&failureReason,
+		&failureReasonData,
 		&taskDefData,
 		&rejectionCount,
 		&rejectionReason,
+		&arrayGuid,
+		&arrayIndex,
+		&attemptsData,
+		&retryAt,
 	)
 
 	if err == sql.ErrNoRows {
@@ -543,6 +1180,30 @@ func (db *SQLDB) fetchTaskInternal(logger lager.Logger, scanner helpers.RowScann
 		return nil, guid, models.ErrDeserialize
 	}
 
+	result, err := db.decryptTaskField(logger, resultData)
+	if err != nil {
+		return nil, guid, err
+	}
+
+	failureReason, err := db.decryptTaskField(logger, failureReasonData)
+	if err != nil {
+		return nil, guid, err
+	}
+
+	var attempts []*models.TaskAttempt
+	if len(attemptsData) > 0 {
+		decodedData, err := db.encoder.Decode(attemptsData)
+		if err != nil {
+			logger.Error("failed-decrypting-attempts", err)
+			return nil, guid, models.ErrDeserialize
+		}
+		err = json.Unmarshal(decodedData, &attempts)
+		if err != nil {
+			logger.Error("failed-parsing-attempts", err)
+			return nil, guid, models.ErrDeserialize
+		}
+	}
+
 	task := &models.Task{
 		TaskGuid:         guid,
 		Domain:           domain,
@@ -551,12 +1212,16 @@ func (db *SQLDB) fetchTaskInternal(logger lager.Logger, scanner helpers.RowScann
 		FirstCompletedAt: firstCompletedAt,
 		State:            models.Task_State(state),
 		CellId:           cellID,
-		Result:           result.String,
+		Result:           result,
 		Failed:           failed,
 		FailureReason:    failureReason,
 		TaskDefinition:   &taskDef,
 		RejectionCount:   rejectionCount,
 		RejectionReason:  rejectionReason,
+		ArrayGuid:        arrayGuid,
+		ArrayIndex:       arrayIndex,
+		Attempts:         attempts,
+		RetryAt:          retryAt,
 	}
 	return task, guid, nil
 }
@@ -568,6 +1233,7 @@ func (db *SQLDB) deleteInvalidTasks(ctx context.Context, logger lager.Logger, qu
 		if err != nil {
 			logger.Error("failed-deleting-task", err)
 		}
+		_ = db.deleteTaskResults(ctx, logger, queryable, guid)
 	}
 	return nil
 }
@@ -34,6 +34,7 @@ var _ = Describe("TaskDB", func() {
 			task = model_helpers.NewValidTask(taskGuid)
 			taskDomain = task.Domain
 			taskDef = task.TaskDefinition
+			taskDef.Priority = 5
 		})
 
 		Context("when a task is not already present at the desired key", func() {
@@ -49,12 +50,12 @@ var _ = Describe("TaskDB", func() {
 				defer rows.Close()
 				Expect(rows.Next()).To(BeTrue())
 
-				var guid, domain, cellID, failureReason, rejectionReason string
-				var result sql.NullString
-				var createdAt, updatedAt, firstCompletedAt int64
-				var state, rejectionCount int32
+				var guid, domain, cellID, rejectionReason, arrayGuid string
+				var result, failureReason sql.NullString
+				var createdAt, updatedAt, firstCompletedAt, retryAt, maxRunDuration, startedAt int64
+				var state, rejectionCount, arrayIndex, priority int32
 				var failed bool
-				var taskDefData []byte
+				var taskDefData, attemptsData []byte
 
 				err = rows.Scan(
 					&guid,
@@ -70,6 +71,13 @@ var _ = Describe("TaskDB", func() {
 					&taskDefData,
 					&rejectionCount,
 					&rejectionReason,
+					&arrayGuid,
+					&arrayIndex,
+					&attemptsData,
+					&retryAt,
+					&maxRunDuration,
+					&priority,
+					&startedAt,
 				)
 				Expect(err).NotTo(HaveOccurred())
 
@@ -80,11 +88,14 @@ var _ = Describe("TaskDB", func() {
 				Expect(firstCompletedAt).To(BeEquivalentTo(0))
 				Expect(state).To(BeEquivalentTo(models.Task_Pending))
 				Expect(result.String).To(Equal(""))
-				Expect(failureReason).To(Equal(""))
+				Expect(failureReason.String).To(Equal(""))
 				Expect(cellID).To(Equal(""))
 				Expect(failed).To(BeFalse())
 				Expect(rejectionCount).To(BeEquivalentTo(0))
 				Expect(rejectionReason).To(Equal(""))
+				Expect(maxRunDuration).To(Equal(int64(taskDef.MaxRunDuration())))
+				Expect(priority).To(Equal(taskDef.Priority))
+				Expect(startedAt).To(BeEquivalentTo(0))
 
 				var actualTaskDef models.TaskDefinition
 				err = serializer.Unmarshal(logger, taskDefData, &actualTaskDef)
@@ -105,6 +116,45 @@ var _ = Describe("TaskDB", func() {
 			})
 		})
 
+		Context("when the task depends on other tasks", func() {
+			BeforeEach(func() {
+				_, err := sqlDB.DesireTask(ctx, logger, model_helpers.NewValidTaskDefinition(), "some-other-guid", taskDomain)
+				Expect(err).NotTo(HaveOccurred())
+
+				taskDef.DependsOn = []string{"some-other-guid"}
+			})
+
+			It("persists the task in the blocked state", func() {
+				Expect(errDesire).NotTo(HaveOccurred())
+				Expect(desiredTask.State).To(Equal(models.Task_Blocked))
+
+				task, err := sqlDB.TaskByGuid(ctx, logger, taskGuid)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(task.State).To(Equal(models.Task_Blocked))
+			})
+
+			It("records its dependencies", func() {
+				Expect(errDesire).NotTo(HaveOccurred())
+				Expect(countTaskDependencies(ctx, db, taskGuid)).To(Equal(1))
+			})
+
+			Context("when a dependency does not exist", func() {
+				BeforeEach(func() {
+					taskDef.DependsOn = []string{"some-other-guid", "missing-guid"}
+				})
+
+				It("returns an invalid request error and does not persist the task", func() {
+					Expect(errDesire).To(HaveOccurred())
+					modelErr := models.ConvertError(errDesire)
+					Expect(modelErr.Type).To(Equal(models.Error_InvalidRequest))
+					Expect(modelErr.Message).To(Equal("dependency missing-guid not found"))
+
+					_, err := sqlDB.TaskByGuid(ctx, logger, taskGuid)
+					Expect(err).To(Equal(models.ErrResourceNotFound))
+				})
+			})
+		})
+
 		Context("when a task is already present with the desired task guid", func() {
 			BeforeEach(func() {
 				otherDomain := "my-other-domain"
@@ -128,6 +178,70 @@ var _ = Describe("TaskDB", func() {
 		})
 	})
 
+	Describe("DesireTaskArray", func() {
+		var (
+			taskDef *models.TaskDefinition
+			tasks   []*models.Task
+			err     error
+		)
+
+		BeforeEach(func() {
+			taskDef = model_helpers.NewValidTaskDefinition()
+		})
+
+		JustBeforeEach(func() {
+			tasks, err = sqlDB.DesireTaskArray(ctx, logger, taskDef, "array-guid", "domain", 3)
+		})
+
+		It("persists a task for every index of the array", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tasks).To(HaveLen(3))
+
+			arrayTasks, err := sqlDB.TasksByArrayGuid(ctx, logger, "array-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(arrayTasks).To(ConsistOf(tasks))
+
+			for i, task := range tasks {
+				Expect(task.TaskGuid).To(Equal(models.TaskArrayTaskGuid("array-guid", int32(i))))
+				Expect(task.ArrayGuid).To(Equal("array-guid"))
+				Expect(task.ArrayIndex).To(BeEquivalentTo(i))
+				Expect(task.State).To(Equal(models.Task_Pending))
+				Expect(task.TaskDefinition).To(Equal(models.TaskArrayTaskDefinition(taskDef, int32(i))))
+			}
+		})
+
+		Context("when the task definition has dependencies", func() {
+			BeforeEach(func() {
+				_, err := sqlDB.DesireTask(ctx, logger, model_helpers.NewValidTaskDefinition(), "other-task-guid", "domain")
+				Expect(err).NotTo(HaveOccurred())
+
+				taskDef.DependsOn = []string{"other-task-guid"}
+			})
+
+			It("creates the tasks in the blocked state", func() {
+				Expect(err).NotTo(HaveOccurred())
+				for _, task := range tasks {
+					Expect(task.State).To(Equal(models.Task_Blocked))
+				}
+			})
+		})
+
+		Context("when one of the tasks already exists", func() {
+			BeforeEach(func() {
+				_, err := sqlDB.DesireTask(ctx, logger, model_helpers.NewValidTaskDefinition(), "array-guid-1", "domain")
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns a resource exists error and creates none of the tasks", func() {
+				Expect(err).To(Equal(models.ErrResourceExists))
+
+				arrayTasks, err := sqlDB.TasksByArrayGuid(ctx, logger, "array-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(arrayTasks).To(BeEmpty())
+			})
+		})
+	})
+
 	Describe("Tasks", func() {
 		Context("when there are tasks", func() {
 			var expectedTasks []*models.Task
@@ -149,28 +263,37 @@ var _ = Describe("TaskDB", func() {
 				}
 			})
 
-			It("returns all the tasks", func() {
+			It("returns all the tasks without their results", func() {
 				tasks, err := sqlDB.Tasks(ctx, logger, models.TaskFilter{})
 				Expect(err).NotTo(HaveOccurred())
+				Expect(tasks).To(HaveLen(3))
+				for _, task := range tasks {
+					Expect(task.Result).To(BeEmpty())
+				}
+			})
+
+			It("returns all the tasks with their results when asked to include them", func() {
+				tasks, err := sqlDB.Tasks(ctx, logger, models.TaskFilter{IncludeResults: true})
+				Expect(err).NotTo(HaveOccurred())
 				Expect(tasks).To(ConsistOf(expectedTasks))
 			})
 
 			It("can filter by domain", func() {
-				tasks, err := sqlDB.Tasks(ctx, logger, models.TaskFilter{Domain: "domain-1"})
+				tasks, err := sqlDB.Tasks(ctx, logger, models.TaskFilter{Domain: "domain-1", IncludeResults: true})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(tasks).To(HaveLen(1))
 				Expect(tasks[0]).To(Equal(expectedTasks[0]))
 			})
 
 			It("can filter by cell id", func() {
-				tasks, err := sqlDB.Tasks(ctx, logger, models.TaskFilter{CellID: "cell-2"})
+				tasks, err := sqlDB.Tasks(ctx, logger, models.TaskFilter{CellID: "cell-2", IncludeResults: true})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(tasks).To(HaveLen(1))
 				Expect(tasks[0]).To(Equal(expectedTasks[1]))
 			})
 
 			It("can filter by domain and cell id", func() {
-				tasks, err := sqlDB.Tasks(ctx, logger, models.TaskFilter{CellID: "cell-1", Domain: "domain-2"})
+				tasks, err := sqlDB.Tasks(ctx, logger, models.TaskFilter{CellID: "cell-1", Domain: "domain-2", IncludeResults: true})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(tasks).To(HaveLen(1))
 				Expect(tasks[0]).To(Equal(expectedTasks[2]))
@@ -215,6 +338,29 @@ var _ = Describe("TaskDB", func() {
 			})
 		})
 
+		Context("when the failure reason of the task is NULL", func() {
+			var expectedTask *models.Task
+
+			BeforeEach(func() {
+				expectedTask = model_helpers.NewValidTask("task-guid")
+				expectedTask.FailureReason = ""
+				insertTask(ctx, db, serializer, expectedTask, false)
+
+				queryStr := "UPDATE tasks SET failure_reason = NULL WHERE guid = ?"
+				if test_helpers.UsePostgres() {
+					queryStr = test_helpers.ReplaceQuestionMarks(queryStr)
+				}
+				_, err := db.ExecContext(ctx, queryStr, "task-guid")
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns the task with an empty failure reason", func() {
+				task, err := sqlDB.TaskByGuid(ctx, logger, "task-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(task).To(Equal(expectedTask))
+			})
+		})
+
 		Context("when there is no task", func() {
 			It("returns a ResourceNotFound", func() {
 				_, err := sqlDB.TaskByGuid(ctx, logger, "nota-guid")
@@ -273,6 +419,23 @@ var _ = Describe("TaskDB", func() {
 			Expect(task.UpdatedAt).To(Equal(fakeClock.Now().UnixNano()))
 		})
 
+		Context("when the cell is cordoned", func() {
+			BeforeEach(func() {
+				Expect(sqlDB.CordonCell(ctx, logger, "cordoned-cell")).To(Succeed())
+			})
+
+			It("does not start the task", func() {
+				_, after, started, err := sqlDB.StartTask(ctx, logger, expectedTask.TaskGuid, "cordoned-cell")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(started).To(BeFalse())
+				Expect(after.State).To(Equal(models.Task_Pending))
+
+				task, err := sqlDB.TaskByGuid(ctx, logger, expectedTask.TaskGuid)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(task.State).To(Equal(models.Task_Pending))
+			})
+		})
+
 		Context("when the cell id is toooooo long", func() {
 			It("returns a BadRequest error", func() {
 				_, _, started, err := sqlDB.StartTask(ctx, logger, expectedTask.TaskGuid, randStr(256))
@@ -614,6 +777,62 @@ var _ = Describe("TaskDB", func() {
 						})
 					})
 
+					Context("when the task has a retry policy", func() {
+						BeforeEach(func() {
+							taskDefinition.RetryPolicy = &models.RetryPolicy{
+								MaxAttempts: 2,
+								BackoffMs:   1000,
+								RetryOn:     []models.RetryPolicy_FailureClass{models.RetryPolicy_NonZeroExit},
+							}
+						})
+
+						It("moves the failed task back to pending and records the attempt", func() {
+							fakeClock.Increment(time.Second)
+							now := fakeClock.Now()
+
+							_, after, err := sqlDB.CompleteTask(ctx, logger, taskGuid, cellID, true, "it blew up", "i am the result")
+							Expect(err).NotTo(HaveOccurred())
+
+							expectedAttempts := []*models.TaskAttempt{{
+								CellId:        cellID,
+								FailureClass:  models.RetryPolicy_NonZeroExit,
+								FailureReason: "it blew up",
+								FailedAt:      now.UnixNano(),
+							}}
+							Expect(after.State).To(Equal(models.Task_Pending))
+							Expect(after.Failed).To(BeFalse())
+							Expect(after.CellId).To(BeEmpty())
+							Expect(after.Attempts).To(Equal(expectedAttempts))
+							Expect(after.RetryAt).To(Equal(now.Add(time.Second).UnixNano()))
+
+							task, err := sqlDB.TaskByGuid(ctx, logger, taskGuid)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(task).To(Equal(after))
+						})
+
+						It("completes the task once the attempts are exhausted", func() {
+							_, _, err := sqlDB.CompleteTask(ctx, logger, taskGuid, cellID, true, "it blew up", "")
+							Expect(err).NotTo(HaveOccurred())
+
+							_, _, started, err := sqlDB.StartTask(ctx, logger, taskGuid, cellID)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(started).To(BeTrue())
+
+							_, after, err := sqlDB.CompleteTask(ctx, logger, taskGuid, cellID, true, "it blew up again", "")
+							Expect(err).NotTo(HaveOccurred())
+							Expect(after.State).To(Equal(models.Task_Completed))
+							Expect(after.Failed).To(BeTrue())
+							Expect(after.Attempts).To(HaveLen(1))
+						})
+
+						It("completes tasks that succeed", func() {
+							_, after, err := sqlDB.CompleteTask(ctx, logger, taskGuid, cellID, false, "", "i am the result")
+							Expect(err).NotTo(HaveOccurred())
+							Expect(after.State).To(Equal(models.Task_Completed))
+							Expect(after.Attempts).To(BeEmpty())
+						})
+					})
+
 					Context("with multiple tasks", func() {
 						var anotherTask *models.Task
 
@@ -1064,6 +1283,22 @@ var _ = Describe("TaskDB", func() {
 					Expect(err).To(Equal(models.ErrResourceNotFound))
 				})
 
+				It("archives the final state of the task", func() {
+					_, err := sqlDB.DeleteTask(ctx, logger, taskGuid)
+					Expect(err).NotTo(HaveOccurred())
+
+					archivedTask, err := sqlDB.ArchivedTaskByGuid(ctx, logger, taskGuid)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(archivedTask.TaskGuid).To(Equal(taskGuid))
+					Expect(archivedTask.Domain).To(Equal(taskDomain))
+					Expect(archivedTask.State).To(Equal(models.Task_Resolving))
+					Expect(archivedTask.CellId).To(Equal(cellID))
+					Expect(archivedTask.CreatedAt).To(Equal(beforeTask.CreatedAt))
+					Expect(archivedTask.StartedAt).To(Equal(fakeClock.Now().UnixNano()))
+					Expect(archivedTask.CompletedAt).To(Equal(beforeTask.FirstCompletedAt))
+					Expect(archivedTask.ArchivedAt).To(Equal(fakeClock.Now().UnixNano()))
+				})
+
 				Context("with multiple resolving tasks", func() {
 					var anotherTask *models.Task
 
@@ -1247,8 +1482,330 @@ var _ = Describe("TaskDB", func() {
 			})
 		})
 	})
+
+	Describe("ReleaseBlockedTasks", func() {
+		var (
+			taskDomain       string
+			dependentTaskDef *models.TaskDefinition
+		)
+
+		BeforeEach(func() {
+			taskDomain = "the-task-domain"
+
+			_, err := sqlDB.DesireTask(ctx, logger, model_helpers.NewValidTaskDefinition(), "dependency-guid", taskDomain)
+			Expect(err).NotTo(HaveOccurred())
+			_, _, _, err = sqlDB.StartTask(ctx, logger, "dependency-guid", "the-cell")
+			Expect(err).NotTo(HaveOccurred())
+
+			dependentTaskDef = model_helpers.NewValidTaskDefinition()
+			dependentTaskDef.DependsOn = []string{"dependency-guid"}
+		})
+
+		JustBeforeEach(func() {
+			task, err := sqlDB.DesireTask(ctx, logger, dependentTaskDef, "dependent-guid", taskDomain)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(task.State).To(Equal(models.Task_Blocked))
+		})
+
+		Context("when the dependency is still running", func() {
+			It("keeps the dependent task blocked", func() {
+				released, failed, err := sqlDB.ReleaseBlockedTasks(ctx, logger)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(released).To(BeEmpty())
+				Expect(failed).To(BeEmpty())
+
+				task, err := sqlDB.TaskByGuid(ctx, logger, "dependent-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(task.State).To(Equal(models.Task_Blocked))
+			})
+		})
+
+		Context("when the dependency succeeds", func() {
+			JustBeforeEach(func() {
+				_, _, err := sqlDB.CompleteTask(ctx, logger, "dependency-guid", "the-cell", false, "", "result")
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("releases the dependent task", func() {
+				createdAt := fakeClock.Now().UnixNano()
+				fakeClock.Increment(time.Second)
+				now := fakeClock.Now().UnixNano()
+
+				released, failed, err := sqlDB.ReleaseBlockedTasks(ctx, logger)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(failed).To(BeEmpty())
+				Expect(released).To(HaveLen(1))
+				Expect(released[0].Before.State).To(Equal(models.Task_Blocked))
+				Expect(released[0].After.State).To(Equal(models.Task_Pending))
+				Expect(released[0].After.CreatedAt).To(Equal(createdAt))
+
+				task, err := sqlDB.TaskByGuid(ctx, logger, "dependent-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(task.State).To(Equal(models.Task_Pending))
+				Expect(task.CreatedAt).To(Equal(createdAt))
+				Expect(task.UpdatedAt).To(Equal(now))
+
+				var releasedAt int64
+				row := db.QueryRowContext(ctx, helpers.RebindForFlavor("SELECT released_at FROM tasks WHERE guid = ?", dbFlavor), "dependent-guid")
+				Expect(row.Scan(&releasedAt)).To(Succeed())
+				Expect(releasedAt).To(Equal(now))
+			})
+		})
+
+		Context("when the dependency fails", func() {
+			JustBeforeEach(func() {
+				_, _, err := sqlDB.CompleteTask(ctx, logger, "dependency-guid", "the-cell", true, "boom", "")
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("fails the dependent task", func() {
+				released, failed, err := sqlDB.ReleaseBlockedTasks(ctx, logger)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(released).To(BeEmpty())
+				Expect(failed).To(HaveLen(1))
+				Expect(failed[0].After.State).To(Equal(models.Task_Completed))
+				Expect(failed[0].After.Failed).To(BeTrue())
+				Expect(failed[0].After.FailureReason).To(Equal("dependency dependency-guid failed"))
+
+				task, err := sqlDB.TaskByGuid(ctx, logger, "dependent-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(task.State).To(Equal(models.Task_Completed))
+				Expect(task.Failed).To(BeTrue())
+				Expect(task.FailureReason).To(Equal("dependency dependency-guid failed"))
+			})
+
+			Context("and other tasks depend on the dependent task", func() {
+				JustBeforeEach(func() {
+					taskDef := model_helpers.NewValidTaskDefinition()
+					taskDef.DependsOn = []string{"dependent-guid"}
+					_, err := sqlDB.DesireTask(ctx, logger, taskDef, "transitive-guid", taskDomain)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("fails them too", func() {
+					_, failed, err := sqlDB.ReleaseBlockedTasks(ctx, logger)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(failed).To(HaveLen(2))
+
+					task, err := sqlDB.TaskByGuid(ctx, logger, "transitive-guid")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(task.State).To(Equal(models.Task_Completed))
+					Expect(task.FailureReason).To(Equal("dependency dependent-guid failed"))
+				})
+			})
+
+			Context("and the dependent task only requires completion", func() {
+				BeforeEach(func() {
+					dependentTaskDef.DependencyCondition = models.TaskDefinition_OnCompletion
+				})
+
+				It("releases the dependent task", func() {
+					released, failed, err := sqlDB.ReleaseBlockedTasks(ctx, logger)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(failed).To(BeEmpty())
+					Expect(released).To(HaveLen(1))
+					Expect(released[0].After.State).To(Equal(models.Task_Pending))
+				})
+			})
+		})
+
+		Context("when given task guids", func() {
+			JustBeforeEach(func() {
+				_, _, err := sqlDB.CompleteTask(ctx, logger, "dependency-guid", "the-cell", false, "", "result")
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("releases the tasks depending on them", func() {
+				released, _, err := sqlDB.ReleaseBlockedTasks(ctx, logger, "dependency-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(released).To(HaveLen(1))
+				Expect(released[0].After.TaskGuid).To(Equal("dependent-guid"))
+				Expect(countTaskDependencies(ctx, db, "dependent-guid")).To(BeZero())
+			})
+
+			It("releases the given tasks", func() {
+				released, _, err := sqlDB.ReleaseBlockedTasks(ctx, logger, "dependent-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(released).To(HaveLen(1))
+				Expect(released[0].After.TaskGuid).To(Equal("dependent-guid"))
+			})
+
+			It("ignores unrelated blocked tasks", func() {
+				released, failed, err := sqlDB.ReleaseBlockedTasks(ctx, logger, "unrelated-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(released).To(BeEmpty())
+				Expect(failed).To(BeEmpty())
+
+				task, err := sqlDB.TaskByGuid(ctx, logger, "dependent-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(task.State).To(Equal(models.Task_Blocked))
+			})
+
+			Context("and a failure cascades to tasks depending on the dependent task", func() {
+				JustBeforeEach(func() {
+					taskDef := model_helpers.NewValidTaskDefinition()
+					taskDef.DependsOn = []string{"dependent-guid"}
+					_, err := sqlDB.DesireTask(ctx, logger, taskDef, "transitive-guid", taskDomain)
+					Expect(err).NotTo(HaveOccurred())
+
+					_, _, _, err = sqlDB.CancelTask(ctx, logger, "dependent-guid")
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("fails them too", func() {
+					_, failed, err := sqlDB.ReleaseBlockedTasks(ctx, logger, "dependent-guid")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(failed).To(HaveLen(1))
+					Expect(failed[0].After.TaskGuid).To(Equal("transitive-guid"))
+					Expect(failed[0].After.FailureReason).To(Equal("dependency dependent-guid failed"))
+				})
+			})
+		})
+
+		Context("when the dependency has been resolved and archived", func() {
+			var dependencyFailed bool
+
+			BeforeEach(func() {
+				dependencyFailed = false
+			})
+
+			JustBeforeEach(func() {
+				_, _, err := sqlDB.CompleteTask(ctx, logger, "dependency-guid", "the-cell", dependencyFailed, "", "")
+				Expect(err).NotTo(HaveOccurred())
+				_, _, err = sqlDB.ResolvingTask(ctx, logger, "dependency-guid")
+				Expect(err).NotTo(HaveOccurred())
+				_, err = sqlDB.DeleteTask(ctx, logger, "dependency-guid")
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("releases the dependent task", func() {
+				released, failed, err := sqlDB.ReleaseBlockedTasks(ctx, logger, "dependency-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(failed).To(BeEmpty())
+				Expect(released).To(HaveLen(1))
+			})
+
+			Context("and it had failed", func() {
+				BeforeEach(func() {
+					dependencyFailed = true
+				})
+
+				It("fails the dependent task", func() {
+					released, failed, err := sqlDB.ReleaseBlockedTasks(ctx, logger, "dependency-guid")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(released).To(BeEmpty())
+					Expect(failed).To(HaveLen(1))
+					Expect(failed[0].After.FailureReason).To(Equal("dependency dependency-guid failed"))
+				})
+			})
+
+			Context("and a new task depends on it", func() {
+				It("can be desired", func() {
+					taskDef := model_helpers.NewValidTaskDefinition()
+					taskDef.DependsOn = []string{"dependency-guid"}
+					_, err := sqlDB.DesireTask(ctx, logger, taskDef, "late-dependent-guid", taskDomain)
+					Expect(err).NotTo(HaveOccurred())
+				})
+			})
+
+			Context("and it has been archived again since", func() {
+				JustBeforeEach(func() {
+					taskDef := model_helpers.NewValidTaskDefinition()
+					_, err := sqlDB.DesireTask(ctx, logger, taskDef, "dependency-guid", taskDomain)
+					Expect(err).NotTo(HaveOccurred())
+					_, _, _, err = sqlDB.StartTask(ctx, logger, "dependency-guid", "the-cell")
+					Expect(err).NotTo(HaveOccurred())
+					_, _, err = sqlDB.CompleteTask(ctx, logger, "dependency-guid", "the-cell", !dependencyFailed, "", "")
+					Expect(err).NotTo(HaveOccurred())
+					_, _, err = sqlDB.ResolvingTask(ctx, logger, "dependency-guid")
+					Expect(err).NotTo(HaveOccurred())
+
+					fakeClock.Increment(time.Second)
+					_, err = sqlDB.DeleteTask(ctx, logger, "dependency-guid")
+					Expect(err).NotTo(HaveOccurred())
+
+					_, err = db.ExecContext(ctx, helpers.RebindForFlavor("DELETE FROM task_dependencies WHERE dependency_guid = ?", dbFlavor), "dependency-guid")
+					Expect(err).NotTo(HaveOccurred())
+					_, err = db.ExecContext(ctx, helpers.RebindForFlavor("INSERT INTO task_dependencies (task_guid, dependency_guid) VALUES (?, ?)", dbFlavor), "dependent-guid", "dependency-guid")
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("uses the latest archive", func() {
+					released, failed, err := sqlDB.ReleaseBlockedTasks(ctx, logger, "dependency-guid")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(released).To(BeEmpty())
+					Expect(failed).To(HaveLen(1))
+					Expect(failed[0].After.FailureReason).To(Equal("dependency dependency-guid failed"))
+				})
+			})
+
+			Context("and its archive has been pruned", func() {
+				JustBeforeEach(func() {
+					_, err := db.ExecContext(ctx, helpers.RebindForFlavor("DELETE FROM task_archive WHERE guid = ?", dbFlavor), "dependency-guid")
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("releases the dependent task from the recorded outcome", func() {
+					released, failed, err := sqlDB.ReleaseBlockedTasks(ctx, logger, "dependency-guid")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(failed).To(BeEmpty())
+					Expect(released).To(HaveLen(1))
+				})
+
+				Context("and it had failed", func() {
+					BeforeEach(func() {
+						dependencyFailed = true
+					})
+
+					It("fails the dependent task", func() {
+						released, failed, err := sqlDB.ReleaseBlockedTasks(ctx, logger, "dependency-guid")
+						Expect(err).NotTo(HaveOccurred())
+						Expect(released).To(BeEmpty())
+						Expect(failed).To(HaveLen(1))
+						Expect(failed[0].After.FailureReason).To(Equal("dependency dependency-guid failed"))
+					})
+				})
+			})
+		})
+
+		Context("when the dependency has been deleted without being archived", func() {
+			JustBeforeEach(func() {
+				_, err := db.ExecContext(ctx, helpers.RebindForFlavor("DELETE FROM tasks WHERE guid = ?", dbFlavor), "dependency-guid")
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("fails the dependent task", func() {
+				released, failed, err := sqlDB.ReleaseBlockedTasks(ctx, logger)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(released).To(BeEmpty())
+				Expect(failed).To(HaveLen(1))
+				Expect(failed[0].After.State).To(Equal(models.Task_Completed))
+				Expect(failed[0].After.FailureReason).To(Equal("outcome of dependency dependency-guid is unknown"))
+			})
+
+			Context("and the dependent task only requires completion", func() {
+				BeforeEach(func() {
+					dependentTaskDef.DependencyCondition = models.TaskDefinition_OnCompletion
+				})
+
+				It("releases the dependent task", func() {
+					released, failed, err := sqlDB.ReleaseBlockedTasks(ctx, logger)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(failed).To(BeEmpty())
+					Expect(released).To(HaveLen(1))
+				})
+			})
+		})
+	})
 })
 
+func countTaskDependencies(ctx context.Context, db helpers.QueryableDB, taskGuid string) int {
+	var count int
+	row := db.QueryRowContext(ctx, helpers.RebindForFlavor("SELECT COUNT(*) FROM task_dependencies WHERE task_guid = ?", dbFlavor), taskGuid)
+	Expect(row.Scan(&count)).To(Succeed())
+	return count
+}
+
 func insertTask(ctx context.Context, db helpers.QueryableDB, serializer format.Serializer, task *models.Task, malformedTaskDefinition bool) {
 	taskDefData, err := serializer.Marshal(logger, task.TaskDefinition)
 	Expect(err).NotTo(HaveOccurred())
@@ -1257,6 +1814,17 @@ func insertTask(ctx context.Context, db helpers.QueryableDB, serializer format.S
 		taskDefData = []byte("{{{{{{{{{{")
 	}
 
+	encoder := format.NewEncoder(cryptor)
+	resultData, failureReasonData := []byte{}, []byte{}
+	if task.Result != "" {
+		resultData, err = encoder.Encode([]byte(task.Result))
+		Expect(err).NotTo(HaveOccurred())
+	}
+	if task.FailureReason != "" {
+		failureReasonData, err = encoder.Encode([]byte(task.FailureReason))
+		Expect(err).NotTo(HaveOccurred())
+	}
+
 	queryStr := `INSERT INTO tasks
 						  (guid, domain, created_at, updated_at, first_completed_at, state,
 							cell_id, result, failed, failure_reason, task_definition)
@@ -1274,9 +1842,9 @@ func insertTask(ctx context.Context, db helpers.QueryableDB, serializer format.S
 		task.FirstCompletedAt,
 		task.State,
 		task.CellId,
-		task.Result,
+		resultData,
 		task.Failed,
-		task.FailureReason,
+		failureReasonData,
 		taskDefData,
 	)
 	Expect(err).NotTo(HaveOccurred())
@@ -0,0 +1,151 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func (db *SQLDB) TaskResult(ctx context.Context, logger lager.Logger, taskGuid string) (string, error) {
+	logger = logger.Session("db-task-result", lager.Data{"task_guid": taskGuid})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	var result string
+
+	err := db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		var resultData []byte
+		row := db.one(ctx, logger, tx, tasksTable,
+			helpers.ColumnList{"result"}, helpers.NoLockRow,
+			"guid = ?", taskGuid,
+		)
+		err := row.Scan(&resultData)
+		if err != nil {
+			if err != sql.ErrNoRows {
+				logger.Error("failed-scanning-row", err)
+			}
+			return err
+		}
+
+		if len(resultData) == 0 {
+			row = db.one(ctx, logger, tx, taskResultsTable,
+				helpers.ColumnList{"result"}, helpers.NoLockRow,
+				"guid = ?", taskGuid,
+			)
+			err = row.Scan(&resultData)
+			if err == sql.ErrNoRows {
+				return nil
+			}
+			if err != nil {
+				logger.Error("failed-scanning-row", err)
+				return err
+			}
+		}
+
+		result, err = db.decryptTaskField(logger, resultData)
+		return err
+	})
+
+	return result, err
+}
+
+// shouldOffloadTaskResult returns whether the result is too large to be stored
+// in the tasks table.
+func (db *SQLDB) shouldOffloadTaskResult(result string) bool {
+	return db.maxInlineTaskResultSize > 0 && len(result) > db.maxInlineTaskResultSize
+}
+
+func (db *SQLDB) storeTaskResult(ctx context.Context, logger lager.Logger, q helpers.Queryable, taskGuid string, resultData []byte) error {
+	err := db.deleteTaskResults(ctx, logger, q, taskGuid)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.insert(ctx, logger, q, taskResultsTable,
+		helpers.SQLAttributes{
+			"guid":   taskGuid,
+			"result": resultData,
+		},
+	)
+	if err != nil {
+		logger.Error("failed-inserting-task-result", err)
+		return err
+	}
+
+	return nil
+}
+
+func (db *SQLDB) deleteTaskResults(ctx context.Context, logger lager.Logger, q helpers.Queryable, taskGuids ...string) error {
+	if len(taskGuids) == 0 {
+		return nil
+	}
+
+	values := make([]interface{}, 0, len(taskGuids))
+	for _, guid := range taskGuids {
+		values = append(values, guid)
+	}
+
+	_, err := db.delete(ctx, logger, q, taskResultsTable,
+		fmt.Sprintf("guid IN (%s)", helpers.QuestionMarks(len(taskGuids))), values...,
+	)
+	if err != nil {
+		logger.Error("failed-deleting-task-results", err)
+		return err
+	}
+
+	return nil
+}
+
+// loadTaskResults fills in the results of the completed tasks whose results
+// were offloaded to the task_results table.
+func (db *SQLDB) loadTaskResults(ctx context.Context, logger lager.Logger, q helpers.Queryable, tasks ...*models.Task) error {
+	tasksByGuid := map[string]*models.Task{}
+	values := []interface{}{}
+	for _, task := range tasks {
+		if task.Result != "" || (task.State != models.Task_Completed && task.State != models.Task_Resolving) {
+			continue
+		}
+		tasksByGuid[task.TaskGuid] = task
+		values = append(values, task.TaskGuid)
+	}
+
+	if len(values) == 0 {
+		return nil
+	}
+
+	rows, err := db.all(ctx, logger, q, taskResultsTable,
+		helpers.ColumnList{"guid", "result"}, helpers.NoLockRow,
+		fmt.Sprintf("guid IN (%s)", helpers.QuestionMarks(len(values))), values...,
+	)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var guid string
+		var resultData []byte
+		err = rows.Scan(&guid, &resultData)
+		if err != nil {
+			logger.Error("failed-scanning-row", err)
+			return err
+		}
+
+		tasksByGuid[guid].Result, err = db.decryptTaskField(logger, resultData)
+		if err != nil {
+			return err
+		}
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-getting-next-row", rows.Err())
+		return rows.Err()
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package sqldb_test
+
+import (
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/bbs/db/sqldb"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TaskResultDB", func() {
+	const (
+		taskGuid = "the-task-guid"
+		cellID   = "the-cell"
+	)
+
+	var (
+		offloadingDB *sqldb.SQLDB
+		smallResult  string
+		largeResult  string
+	)
+
+	inlineResult := func(taskGuid string) string {
+		var resultData []byte
+		query := helpers.RebindForFlavor("SELECT result FROM tasks WHERE guid = ?", dbFlavor)
+		Expect(db.QueryRowContext(ctx, query, taskGuid).Scan(&resultData)).To(Succeed())
+		if len(resultData) == 0 {
+			return ""
+		}
+		result, err := format.NewEncoder(cryptor).Decode(resultData)
+		Expect(err).NotTo(HaveOccurred())
+		return string(result)
+	}
+
+	offloadedResultCount := func(taskGuid string) int {
+		var count int
+		query := helpers.RebindForFlavor("SELECT COUNT(*) FROM task_results WHERE guid = ?", dbFlavor)
+		Expect(db.QueryRowContext(ctx, query, taskGuid).Scan(&count)).To(Succeed())
+		return count
+	}
+
+	completeTask := func(result string) {
+		_, err := offloadingDB.DesireTask(ctx, logger, model_helpers.NewValidTaskDefinition(), taskGuid, "the-domain")
+		Expect(err).NotTo(HaveOccurred())
+		_, _, _, err = offloadingDB.StartTask(ctx, logger, taskGuid, cellID)
+		Expect(err).NotTo(HaveOccurred())
+		_, _, err = offloadingDB.CompleteTask(ctx, logger, taskGuid, cellID, false, "", result)
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		offloadingDB = sqldb.NewSQLDB(db, 5, 5, 16, cryptor, fakeGUIDProvider, fakeClock, dbFlavor, fakeMetronClient)
+		smallResult = "small-result"
+		largeResult = strings.Repeat("large-result", 10)
+	})
+
+	Context("when the result is no larger than the maximum inline result size", func() {
+		BeforeEach(func() {
+			completeTask(smallResult)
+		})
+
+		It("stores the result in the tasks table", func() {
+			Expect(inlineResult(taskGuid)).To(Equal(smallResult))
+			Expect(offloadedResultCount(taskGuid)).To(BeZero())
+		})
+
+		It("returns the result", func() {
+			result, err := offloadingDB.TaskResult(ctx, logger, taskGuid)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(smallResult))
+		})
+	})
+
+	Context("when the result is larger than the maximum inline result size", func() {
+		BeforeEach(func() {
+			completeTask(largeResult)
+		})
+
+		It("stores the result in the task_results table", func() {
+			Expect(inlineResult(taskGuid)).To(BeEmpty())
+			Expect(offloadedResultCount(taskGuid)).To(Equal(1))
+		})
+
+		It("returns the result", func() {
+			result, err := offloadingDB.TaskResult(ctx, logger, taskGuid)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(largeResult))
+		})
+
+		It("includes the result when fetching the task", func() {
+			task, err := offloadingDB.TaskByGuid(ctx, logger, taskGuid)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(task.Result).To(Equal(largeResult))
+		})
+
+		It("includes the result when listing tasks with their results", func() {
+			tasks, err := offloadingDB.Tasks(ctx, logger, models.TaskFilter{IncludeResults: true})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tasks).To(HaveLen(1))
+			Expect(tasks[0].Result).To(Equal(largeResult))
+		})
+
+		It("includes the result in the tasks to complete during convergence", func() {
+			fakeClock.Increment(time.Hour)
+			result := offloadingDB.ConvergeTasks(ctx, logger, models.CellSet{}, time.Minute, time.Hour, 2*time.Hour, nil, 0)
+			Expect(result.TasksToComplete).To(HaveLen(1))
+			Expect(result.TasksToComplete[0].Result).To(Equal(largeResult))
+		})
+
+		It("deletes the result with the task", func() {
+			_, _, err := offloadingDB.ResolvingTask(ctx, logger, taskGuid)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = offloadingDB.DeleteTask(ctx, logger, taskGuid)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(offloadedResultCount(taskGuid)).To(BeZero())
+		})
+
+		It("deletes the result when the completed task expires", func() {
+			fakeClock.Increment(time.Hour)
+			offloadingDB.ConvergeTasks(ctx, logger, models.CellSet{}, 2*time.Hour, 2*time.Hour, time.Minute, nil, 0)
+
+			Expect(offloadedResultCount(taskGuid)).To(BeZero())
+		})
+	})
+
+	Context("when the task does not exist", func() {
+		It("returns a resource not found error", func() {
+			_, err := offloadingDB.TaskResult(ctx, logger, "unknown-task")
+			Expect(err).To(Equal(models.ErrResourceNotFound))
+		})
+	})
+})
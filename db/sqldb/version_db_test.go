@@ -127,7 +127,7 @@ var _ = Describe("Version", func() {
 				db, err = helpers.Connect(logger, dbDriverName, dbBaseConnectionString+"invalid-db", "", false)
 				Expect(err).NotTo(HaveOccurred())
 				helperDB = helpers.NewMonitoredDB(db, monitor.New())
-				sqlDB = sqldb.NewSQLDB(helperDB, 5, 5, cryptor, fakeGUIDProvider, fakeClock, dbFlavor, fakeMetronClient)
+				sqlDB = sqldb.NewSQLDB(helperDB, 5, 5, 0, cryptor, fakeGUIDProvider, fakeClock, dbFlavor, fakeMetronClient)
 			})
 
 			AfterEach(func() {
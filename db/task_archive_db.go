@@ -0,0 +1,19 @@
+package db
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+//counterfeiter:generate . TaskArchiveDB
+type TaskArchiveDB interface {
+	// ArchivedTasks returns a page of archived tasks matching the filter, from
+	// the most recently archived, along with the token of the next page. The
+	// token is empty on the last page.
+	ArchivedTasks(ctx context.Context, logger lager.Logger, filter models.TaskArchiveFilter) ([]*models.ArchivedTask, string, error)
+	// ArchivedTaskByGuid returns the most recently archived task with the
+	// given guid.
+	ArchivedTaskByGuid(ctx context.Context, logger lager.Logger, taskGuid string) (*models.ArchivedTask, error)
+}
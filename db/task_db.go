@@ -14,7 +14,10 @@ type CompleteTaskWork func(logger lager.Logger, taskDB TaskDB, task *models.Task
 type TaskConvergenceResult struct {
 	TasksToAuction  []*auctioneer.TaskStartRequest
 	TasksToComplete []*models.Task
-	Events          []models.Event
+	// TasksToCancel are running tasks that convergence has completed and that
+	// must still be cancelled on their cells
+	TasksToCancel []*models.Task
+	Events        []models.Event
 
 	Metrics TaskMetrics
 }
@@ -31,9 +34,12 @@ type TaskMetrics struct {
 //counterfeiter:generate . TaskDB
 type TaskDB interface {
 	Tasks(ctx context.Context, logger lager.Logger, filter models.TaskFilter) ([]*models.Task, error)
+	TasksByArrayGuid(ctx context.Context, logger lager.Logger, arrayGuid string) ([]*models.Task, error)
 	TaskByGuid(ctx context.Context, logger lager.Logger, taskGuid string) (*models.Task, error)
+	TaskResult(ctx context.Context, logger lager.Logger, taskGuid string) (string, error)
 
 	DesireTask(ctx context.Context, logger lager.Logger, taskDefinition *models.TaskDefinition, taskGuid, domain string) (*models.Task, error)
+	DesireTaskArray(ctx context.Context, logger lager.Logger, taskDefinition *models.TaskDefinition, arrayGuid, domain string, count int32) ([]*models.Task, error)
 	StartTask(ctx context.Context, logger lager.Logger, taskGuid, cellId string) (before *models.Task, after *models.Task, shouldStart bool, rr error)
 	CancelTask(ctx context.Context, logger lager.Logger, taskGuid string) (before *models.Task, after *models.Task, cellID string, err error)
 	FailTask(ctx context.Context, logger lager.Logger, taskGuid, failureReason string) (before *models.Task, after *models.Task, err error)
@@ -41,6 +47,7 @@ type TaskDB interface {
 	CompleteTask(ctx context.Context, logger lager.Logger, taskGuid, cellId string, failed bool, failureReason, result string) (before *models.Task, after *models.Task, err error)
 	ResolvingTask(ctx context.Context, logger lager.Logger, taskGuid string) (before *models.Task, after *models.Task, err error)
 	DeleteTask(ctx context.Context, logger lager.Logger, taskGuid string) (task *models.Task, err error)
+	ReleaseBlockedTasks(ctx context.Context, logger lager.Logger, taskGuids ...string) (released []*models.TaskChange, failed []*models.TaskChange, err error)
 
-	ConvergeTasks(ctx context.Context, logger lager.Logger, cellSet models.CellSet, kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration time.Duration) TaskConvergenceResult
+	ConvergeTasks(ctx context.Context, logger lager.Logger, cellSet models.CellSet, kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration time.Duration, defaultMaxRunDurations map[string]time.Duration, taskArchiveRetention time.Duration) TaskConvergenceResult
 }
@@ -84,6 +84,7 @@ type ActualLRPEventCalculator struct {
 //   - A ChangedEvent for the Group Hub
 //   - Either a ChangedEvent or a Removed and Created Event for the Instance Hub, depending on the state
 //     of the ActualLRP
+//   - A RestartsExhaustedEvent for the Instance Hub, if the ActualLRP will not be restarted anymore
 //
 // This function was added to work around a bug in the existing logic for EmitEvents, where CrashedEvents
 // were not being emitted due to the CrashResetTimeout
@@ -154,6 +155,11 @@ func (e ActualLRPEventCalculator) EmitCrashEvents(traceId string, beforeSet, aft
 				models.NewActualLRPCrashedEvent(before, after),
 				models.NewActualLRPInstanceChangedEvent(before, after, traceId),
 			)
+			if after.RestartsExhausted && !before.RestartsExhausted {
+				instanceEvents = append(instanceEvents,
+					models.NewActualLRPRestartsExhaustedEvent(before, after),
+				)
+			}
 		} else {
 			instanceEvents = append(instanceEvents,
 				models.NewActualLRPCrashedEvent(before, after),
@@ -163,7 +169,7 @@ func (e ActualLRPEventCalculator) EmitCrashEvents(traceId string, beforeSet, aft
 		}
 	}
 
-	sort.Slice(instanceEvents, func(i, j int) bool {
+	sort.SliceStable(instanceEvents, func(i, j int) bool {
 		return EventScore(instanceEvents[i]) > EventScore(instanceEvents[j])
 	})
 
@@ -377,6 +383,8 @@ func getEventLRP(e models.Event) (*models.ActualLRP, bool) {
 		return x.After.ToActualLRP(x.ActualLRPKey, x.ActualLRPInstanceKey), false
 	case *models.ActualLRPCrashedEvent:
 		return nil, true
+	case *models.ActualLRPRestartsExhaustedEvent:
+		return nil, true
 	}
 
 	return nil, false
@@ -630,6 +630,28 @@ var _ = Describe("ActualLrpEventCalculator", func() {
 				instanceChangedEvent := actualInstanceHub.EmitArgsForCall(1)
 				Expect(instanceChangedEvent).To(Equal(models.NewActualLRPInstanceChangedEvent(originalLRP, updatedLRP, "some-trace-id")))
 			})
+
+			Context("and it has exhausted its restarts", func() {
+				BeforeEach(func() {
+					updatedLRP.RestartsExhausted = true
+				})
+
+				It("also emits a restarts exhausted event to the instance hub", func() {
+					eventCalculator.EmitCrashEvents("some-trace-id", beforeSet, afterSet)
+					Expect(actualHub.EmitCallCount()).To(Equal(2))
+					Expect(actualInstanceHub.EmitCallCount()).To(Equal(3))
+
+					instanceCrashedEvent := actualInstanceHub.EmitArgsForCall(0)
+					Expect(instanceCrashedEvent).To(Equal(models.NewActualLRPCrashedEvent(originalLRP, updatedLRP)))
+
+					restartsExhaustedEvent := actualInstanceHub.EmitArgsForCall(1)
+					Expect(restartsExhaustedEvent).To(Equal(models.NewActualLRPRestartsExhaustedEvent(originalLRP, updatedLRP)))
+
+					instanceChangedEvent := actualInstanceHub.EmitArgsForCall(2)
+					Expect(instanceChangedEvent).To(Equal(models.NewActualLRPInstanceChangedEvent(originalLRP, updatedLRP, "some-trace-id")))
+					Expect(instanceChangedEvent.(*models.ActualLRPInstanceChangedEvent).After.RestartsExhausted).To(BeTrue())
+				})
+			})
 		})
 
 		Context("when instance is evacuating", func() {
@@ -210,6 +210,15 @@ func parseRawEvent(rawEvent sse.Event) (models.Event, error) {
 
 		return event, nil
 
+	case models.EventTypeActualLRPRestartsExhausted:
+		event := new(models.ActualLRPRestartsExhaustedEvent)
+		err := proto.Unmarshal(data, event)
+		if err != nil {
+			return nil, NewInvalidPayloadError(rawEvent.Name, err)
+		}
+
+		return event, nil
+
 	case models.EventTypeTaskCreated:
 		event := new(models.TaskCreatedEvent)
 		err := proto.Unmarshal(data, event)
@@ -237,6 +246,33 @@ func parseRawEvent(rawEvent sse.Event) (models.Event, error) {
 
 		return event, nil
 
+	case models.EventTypeScheduledTaskRun:
+		event := new(models.ScheduledTaskRunEvent)
+		err := proto.Unmarshal(data, event)
+		if err != nil {
+			return nil, NewInvalidPayloadError(rawEvent.Name, err)
+		}
+
+		return event, nil
+
+	case models.EventTypeDomainFresh:
+		event := new(models.DomainFreshEvent)
+		err := proto.Unmarshal(data, event)
+		if err != nil {
+			return nil, NewInvalidPayloadError(rawEvent.Name, err)
+		}
+
+		return event, nil
+
+	case models.EventTypeDomainStale:
+		event := new(models.DomainStaleEvent)
+		err := proto.Unmarshal(data, event)
+		if err != nil {
+			return nil, NewInvalidPayloadError(rawEvent.Name, err)
+		}
+
+		return event, nil
+
 	case models.EventTypeActualLRPInstanceCreated:
 		event := new(models.ActualLRPInstanceCreatedEvent)
 		err := proto.Unmarshal(data, event)
@@ -266,6 +266,35 @@ var _ = Describe("EventSource", func() {
 					Expect(actualLRPCrashedEvent).To(Equal(expectedEvent))
 				})
 			})
+
+			Context("when receiving a ActualLRPRestartsExhaustedEvent", func() {
+				var expectedEvent *models.ActualLRPRestartsExhaustedEvent
+
+				BeforeEach(func() {
+					expectedEvent = models.NewActualLRPRestartsExhaustedEvent(actualLRP, actualLRP)
+					payload, err := proto.Marshal(expectedEvent)
+					Expect(err).NotTo(HaveOccurred())
+					payload = []byte(base64.StdEncoding.EncodeToString(payload))
+
+					fakeRawEventSource.NextReturns(
+						sse.Event{
+							ID:   "sup",
+							Name: string(expectedEvent.EventType()),
+							Data: payload,
+						},
+						nil,
+					)
+				})
+
+				It("returns the event", func() {
+					event, err := eventSource.Next()
+					Expect(err).NotTo(HaveOccurred())
+
+					actualLRPRestartsExhaustedEvent, ok := event.(*models.ActualLRPRestartsExhaustedEvent)
+					Expect(ok).To(BeTrue())
+					Expect(actualLRPRestartsExhaustedEvent).To(Equal(expectedEvent))
+				})
+			})
 		})
 
 		Describe("Task events", func() {
@@ -361,6 +390,93 @@ var _ = Describe("EventSource", func() {
 					Expect(taskRemovedEvent).To(Equal(expectedEvent))
 				})
 			})
+
+			Context("when receiving a ScheduledTaskRunEvent", func() {
+				var expectedEvent *models.ScheduledTaskRunEvent
+
+				BeforeEach(func() {
+					expectedEvent = models.NewScheduledTaskRunEvent("scheduled-guid", "scheduled-guid-60", 60, "")
+					payload, err := proto.Marshal(expectedEvent)
+					Expect(err).NotTo(HaveOccurred())
+					payload = []byte(base64.StdEncoding.EncodeToString(payload))
+
+					fakeRawEventSource.NextReturns(
+						sse.Event{
+							ID:   "sup",
+							Name: string(expectedEvent.EventType()),
+							Data: payload,
+						},
+						nil,
+					)
+				})
+
+				It("returns the event", func() {
+					event, err := eventSource.Next()
+					Expect(err).NotTo(HaveOccurred())
+
+					scheduledTaskRunEvent, ok := event.(*models.ScheduledTaskRunEvent)
+					Expect(ok).To(BeTrue())
+					Expect(scheduledTaskRunEvent).To(Equal(expectedEvent))
+				})
+			})
+
+			Context("when receiving a DomainFreshEvent", func() {
+				var expectedEvent *models.DomainFreshEvent
+
+				BeforeEach(func() {
+					expectedEvent = models.NewDomainFreshEvent("some-domain")
+					payload, err := proto.Marshal(expectedEvent)
+					Expect(err).NotTo(HaveOccurred())
+					payload = []byte(base64.StdEncoding.EncodeToString(payload))
+
+					fakeRawEventSource.NextReturns(
+						sse.Event{
+							ID:   "sup",
+							Name: string(expectedEvent.EventType()),
+							Data: payload,
+						},
+						nil,
+					)
+				})
+
+				It("returns the event", func() {
+					event, err := eventSource.Next()
+					Expect(err).NotTo(HaveOccurred())
+
+					domainFreshEvent, ok := event.(*models.DomainFreshEvent)
+					Expect(ok).To(BeTrue())
+					Expect(domainFreshEvent).To(Equal(expectedEvent))
+				})
+			})
+
+			Context("when receiving a DomainStaleEvent", func() {
+				var expectedEvent *models.DomainStaleEvent
+
+				BeforeEach(func() {
+					expectedEvent = models.NewDomainStaleEvent("some-domain")
+					payload, err := proto.Marshal(expectedEvent)
+					Expect(err).NotTo(HaveOccurred())
+					payload = []byte(base64.StdEncoding.EncodeToString(payload))
+
+					fakeRawEventSource.NextReturns(
+						sse.Event{
+							ID:   "sup",
+							Name: string(expectedEvent.EventType()),
+							Data: payload,
+						},
+						nil,
+					)
+				})
+
+				It("returns the event", func() {
+					event, err := eventSource.Next()
+					Expect(err).NotTo(HaveOccurred())
+
+					domainStaleEvent, ok := event.(*models.DomainStaleEvent)
+					Expect(ok).To(BeTrue())
+					Expect(domainStaleEvent).To(Equal(expectedEvent))
+				})
+			})
 		})
 
 		Context("when receiving an unrecognized event", func() {
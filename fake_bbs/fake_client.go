@@ -12,6 +12,22 @@ import (
 )
 
 type FakeClient struct {
+	ActualLRPCrashesStub        func(lager.Logger, string, string, int32) ([]*models.ActualLRPCrash, error)
+	actualLRPCrashesMutex       sync.RWMutex
+	actualLRPCrashesArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+		arg4 int32
+	}
+	actualLRPCrashesReturns struct {
+		result1 []*models.ActualLRPCrash
+		result2 error
+	}
+	actualLRPCrashesReturnsOnCall map[int]struct {
+		result1 []*models.ActualLRPCrash
+		result2 error
+	}
 	ActualLRPGroupByProcessGuidAndIndexStub        func(lager.Logger, string, string, int) (*models.ActualLRPGroup, error)
 	actualLRPGroupByProcessGuidAndIndexMutex       sync.RWMutex
 	actualLRPGroupByProcessGuidAndIndexArgsForCall []struct {
@@ -73,6 +89,69 @@ type FakeClient struct {
 		result1 []*models.ActualLRP
 		result2 error
 	}
+	ActualLRPsWithCrashHistoryStub        func(lager.Logger, string, models.ActualLRPFilter) ([]*models.ActualLRP, []*models.ActualLRPCrash, error)
+	actualLRPsWithCrashHistoryMutex       sync.RWMutex
+	actualLRPsWithCrashHistoryArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 models.ActualLRPFilter
+	}
+	actualLRPsWithCrashHistoryReturns struct {
+		result1 []*models.ActualLRP
+		result2 []*models.ActualLRPCrash
+		result3 error
+	}
+	actualLRPsWithCrashHistoryReturnsOnCall map[int]struct {
+		result1 []*models.ActualLRP
+		result2 []*models.ActualLRPCrash
+		result3 error
+	}
+	AllDomainsStub        func(lager.Logger, string) ([]*models.Domain, error)
+	allDomainsMutex       sync.RWMutex
+	allDomainsArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+	}
+	allDomainsReturns struct {
+		result1 []*models.Domain
+		result2 error
+	}
+	allDomainsReturnsOnCall map[int]struct {
+		result1 []*models.Domain
+		result2 error
+	}
+	ArchivedTaskByGuidStub        func(lager.Logger, string, string) (*models.ArchivedTask, error)
+	archivedTaskByGuidMutex       sync.RWMutex
+	archivedTaskByGuidArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	archivedTaskByGuidReturns struct {
+		result1 *models.ArchivedTask
+		result2 error
+	}
+	archivedTaskByGuidReturnsOnCall map[int]struct {
+		result1 *models.ArchivedTask
+		result2 error
+	}
+	ArchivedTasksStub        func(lager.Logger, string, models.TaskArchiveFilter) ([]*models.ArchivedTask, string, error)
+	archivedTasksMutex       sync.RWMutex
+	archivedTasksArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 models.TaskArchiveFilter
+	}
+	archivedTasksReturns struct {
+		result1 []*models.ArchivedTask
+		result2 string
+		result3 error
+	}
+	archivedTasksReturnsOnCall map[int]struct {
+		result1 []*models.ArchivedTask
+		result2 string
+		result3 error
+	}
 	CancelTaskStub        func(lager.Logger, string, string) error
 	cancelTaskMutex       sync.RWMutex
 	cancelTaskArgsForCall []struct {
@@ -86,6 +165,48 @@ type FakeClient struct {
 	cancelTaskReturnsOnCall map[int]struct {
 		result1 error
 	}
+	CancelTaskArrayStub        func(lager.Logger, string, string) error
+	cancelTaskArrayMutex       sync.RWMutex
+	cancelTaskArrayArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	cancelTaskArrayReturns struct {
+		result1 error
+	}
+	cancelTaskArrayReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CapacityReportStub        func(lager.Logger, string) (*models.CapacityReport, error)
+	capacityReportMutex       sync.RWMutex
+	capacityReportArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+	}
+	capacityReportReturns struct {
+		result1 *models.CapacityReport
+		result2 error
+	}
+	capacityReportReturnsOnCall map[int]struct {
+		result1 *models.CapacityReport
+		result2 error
+	}
+	CellDrainStatusStub        func(lager.Logger, string, string) (*models.CellDrainStatus, error)
+	cellDrainStatusMutex       sync.RWMutex
+	cellDrainStatusArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	cellDrainStatusReturns struct {
+		result1 *models.CellDrainStatus
+		result2 error
+	}
+	cellDrainStatusReturnsOnCall map[int]struct {
+		result1 *models.CellDrainStatus
+		result2 error
+	}
 	CellsStub        func(lager.Logger, string) ([]*models.CellPresence, error)
 	cellsMutex       sync.RWMutex
 	cellsArgsForCall []struct {
@@ -100,6 +221,58 @@ type FakeClient struct {
 		result1 []*models.CellPresence
 		result2 error
 	}
+	CordonCellStub        func(lager.Logger, string, string) error
+	cordonCellMutex       sync.RWMutex
+	cordonCellArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	cordonCellReturns struct {
+		result1 error
+	}
+	cordonCellReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteDomainStub        func(lager.Logger, string, string) error
+	deleteDomainMutex       sync.RWMutex
+	deleteDomainArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	deleteDomainReturns struct {
+		result1 error
+	}
+	deleteDomainReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteDomainQuotaStub        func(lager.Logger, string, string) error
+	deleteDomainQuotaMutex       sync.RWMutex
+	deleteDomainQuotaArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	deleteDomainQuotaReturns struct {
+		result1 error
+	}
+	deleteDomainQuotaReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteScheduledTaskStub        func(lager.Logger, string, string) error
+	deleteScheduledTaskMutex       sync.RWMutex
+	deleteScheduledTaskArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	deleteScheduledTaskReturns struct {
+		result1 error
+	}
+	deleteScheduledTaskReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DeleteTaskStub        func(lager.Logger, string, string) error
 	deleteTaskMutex       sync.RWMutex
 	deleteTaskArgsForCall []struct {
@@ -126,6 +299,19 @@ type FakeClient struct {
 	desireLRPReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DesireScheduledTaskStub        func(lager.Logger, string, *models.ScheduledTask) error
+	desireScheduledTaskMutex       sync.RWMutex
+	desireScheduledTaskArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 *models.ScheduledTask
+	}
+	desireScheduledTaskReturns struct {
+		result1 error
+	}
+	desireScheduledTaskReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DesireTaskStub        func(lager.Logger, string, string, string, *models.TaskDefinition) error
 	desireTaskMutex       sync.RWMutex
 	desireTaskArgsForCall []struct {
@@ -141,6 +327,22 @@ type FakeClient struct {
 	desireTaskReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DesireTaskArrayStub        func(lager.Logger, string, string, string, int32, *models.TaskDefinition) error
+	desireTaskArrayMutex       sync.RWMutex
+	desireTaskArrayArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 int32
+		arg6 *models.TaskDefinition
+	}
+	desireTaskArrayReturns struct {
+		result1 error
+	}
+	desireTaskArrayReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DesiredLRPByProcessGuidStub        func(lager.Logger, string, string) (*models.DesiredLRP, error)
 	desiredLRPByProcessGuidMutex       sync.RWMutex
 	desiredLRPByProcessGuidArgsForCall []struct {
@@ -156,6 +358,38 @@ type FakeClient struct {
 		result1 *models.DesiredLRP
 		result2 error
 	}
+	DesiredLRPRevisionDiffStub        func(lager.Logger, string, string, int32, int32) ([]*models.DesiredLRPRevisionChange, error)
+	desiredLRPRevisionDiffMutex       sync.RWMutex
+	desiredLRPRevisionDiffArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+		arg4 int32
+		arg5 int32
+	}
+	desiredLRPRevisionDiffReturns struct {
+		result1 []*models.DesiredLRPRevisionChange
+		result2 error
+	}
+	desiredLRPRevisionDiffReturnsOnCall map[int]struct {
+		result1 []*models.DesiredLRPRevisionChange
+		result2 error
+	}
+	DesiredLRPRevisionsStub        func(lager.Logger, string, string) ([]*models.DesiredLRPRevision, error)
+	desiredLRPRevisionsMutex       sync.RWMutex
+	desiredLRPRevisionsArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	desiredLRPRevisionsReturns struct {
+		result1 []*models.DesiredLRPRevision
+		result2 error
+	}
+	desiredLRPRevisionsReturnsOnCall map[int]struct {
+		result1 []*models.DesiredLRPRevision
+		result2 error
+	}
 	DesiredLRPRoutingInfosStub        func(lager.Logger, string, models.DesiredLRPFilter) ([]*models.DesiredLRP, error)
 	desiredLRPRoutingInfosMutex       sync.RWMutex
 	desiredLRPRoutingInfosArgsForCall []struct {
@@ -201,6 +435,21 @@ type FakeClient struct {
 		result1 []*models.DesiredLRPSchedulingInfo
 		result2 error
 	}
+	DesiredLRPStatusStub        func(lager.Logger, string, models.DesiredLRPFilter) ([]*models.DesiredLRPStatus, error)
+	desiredLRPStatusMutex       sync.RWMutex
+	desiredLRPStatusArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 models.DesiredLRPFilter
+	}
+	desiredLRPStatusReturns struct {
+		result1 []*models.DesiredLRPStatus
+		result2 error
+	}
+	desiredLRPStatusReturnsOnCall map[int]struct {
+		result1 []*models.DesiredLRPStatus
+		result2 error
+	}
 	DesiredLRPsStub        func(lager.Logger, string, models.DesiredLRPFilter) ([]*models.DesiredLRP, error)
 	desiredLRPsMutex       sync.RWMutex
 	desiredLRPsArgsForCall []struct {
@@ -216,6 +465,35 @@ type FakeClient struct {
 		result1 []*models.DesiredLRP
 		result2 error
 	}
+	DomainQuotasStub        func(lager.Logger, string) ([]*models.DomainQuota, error)
+	domainQuotasMutex       sync.RWMutex
+	domainQuotasArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+	}
+	domainQuotasReturns struct {
+		result1 []*models.DomainQuota
+		result2 error
+	}
+	domainQuotasReturnsOnCall map[int]struct {
+		result1 []*models.DomainQuota
+		result2 error
+	}
+	DomainUsageStub        func(lager.Logger, string, string) (*models.DomainUsage, error)
+	domainUsageMutex       sync.RWMutex
+	domainUsageArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	domainUsageReturns struct {
+		result1 *models.DomainUsage
+		result2 error
+	}
+	domainUsageReturnsOnCall map[int]struct {
+		result1 *models.DomainUsage
+		result2 error
+	}
 	DomainsStub        func(lager.Logger, string) ([]string, error)
 	domainsMutex       sync.RWMutex
 	domainsArgsForCall []struct {
@@ -230,6 +508,32 @@ type FakeClient struct {
 		result1 []string
 		result2 error
 	}
+	DrainCellStub        func(lager.Logger, string, string) error
+	drainCellMutex       sync.RWMutex
+	drainCellArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	drainCellReturns struct {
+		result1 error
+	}
+	drainCellReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ExpireDomainStub        func(lager.Logger, string, string) error
+	expireDomainMutex       sync.RWMutex
+	expireDomainArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	expireDomainReturns struct {
+		result1 error
+	}
+	expireDomainReturnsOnCall map[int]struct {
+		result1 error
+	}
 	PingStub        func(lager.Logger, string) bool
 	pingMutex       sync.RWMutex
 	pingArgsForCall []struct {
@@ -268,6 +572,33 @@ type FakeClient struct {
 	resolvingTaskReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RestartActualLRPStub        func(lager.Logger, string, *models.ActualLRPKey) error
+	restartActualLRPMutex       sync.RWMutex
+	restartActualLRPArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 *models.ActualLRPKey
+	}
+	restartActualLRPReturns struct {
+		result1 error
+	}
+	restartActualLRPReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RestartDesiredLRPStub        func(lager.Logger, string, string, int32) error
+	restartDesiredLRPMutex       sync.RWMutex
+	restartDesiredLRPArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+		arg4 int32
+	}
+	restartDesiredLRPReturns struct {
+		result1 error
+	}
+	restartDesiredLRPReturnsOnCall map[int]struct {
+		result1 error
+	}
 	RetireActualLRPStub        func(lager.Logger, string, *models.ActualLRPKey) error
 	retireActualLRPMutex       sync.RWMutex
 	retireActualLRPArgsForCall []struct {
@@ -281,6 +612,50 @@ type FakeClient struct {
 	retireActualLRPReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RollbackDesiredLRPStub        func(lager.Logger, string, string, int32) error
+	rollbackDesiredLRPMutex       sync.RWMutex
+	rollbackDesiredLRPArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+		arg4 int32
+	}
+	rollbackDesiredLRPReturns struct {
+		result1 error
+	}
+	rollbackDesiredLRPReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ScheduledTaskByGuidStub        func(lager.Logger, string, string) (*models.ScheduledTask, error)
+	scheduledTaskByGuidMutex       sync.RWMutex
+	scheduledTaskByGuidArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	scheduledTaskByGuidReturns struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
+	scheduledTaskByGuidReturnsOnCall map[int]struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
+	ScheduledTasksStub        func(lager.Logger, string, string) ([]*models.ScheduledTask, error)
+	scheduledTasksMutex       sync.RWMutex
+	scheduledTasksArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	scheduledTasksReturns struct {
+		result1 []*models.ScheduledTask
+		result2 error
+	}
+	scheduledTasksReturnsOnCall map[int]struct {
+		result1 []*models.ScheduledTask
+		result2 error
+	}
 	SubscribeToEventsStub        func(lager.Logger) (events.EventSource, error)
 	subscribeToEventsMutex       sync.RWMutex
 	subscribeToEventsArgsForCall []struct {
@@ -348,6 +723,21 @@ type FakeClient struct {
 		result1 events.EventSource
 		result2 error
 	}
+	TaskArrayStatusStub        func(lager.Logger, string, string) (*models.TaskArrayStatus, error)
+	taskArrayStatusMutex       sync.RWMutex
+	taskArrayStatusArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	taskArrayStatusReturns struct {
+		result1 *models.TaskArrayStatus
+		result2 error
+	}
+	taskArrayStatusReturnsOnCall map[int]struct {
+		result1 *models.TaskArrayStatus
+		result2 error
+	}
 	TaskByGuidStub        func(lager.Logger, string, string) (*models.Task, error)
 	taskByGuidMutex       sync.RWMutex
 	taskByGuidArgsForCall []struct {
@@ -363,6 +753,21 @@ type FakeClient struct {
 		result1 *models.Task
 		result2 error
 	}
+	TaskResultStub        func(lager.Logger, string, string) (string, error)
+	taskResultMutex       sync.RWMutex
+	taskResultArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	taskResultReturns struct {
+		result1 string
+		result2 error
+	}
+	taskResultReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	TasksStub        func(lager.Logger, string) ([]*models.Task, error)
 	tasksMutex       sync.RWMutex
 	tasksArgsForCall []struct {
@@ -422,6 +827,19 @@ type FakeClient struct {
 		result1 []*models.Task
 		result2 error
 	}
+	UncordonCellStub        func(lager.Logger, string, string) error
+	uncordonCellMutex       sync.RWMutex
+	uncordonCellArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	uncordonCellReturns struct {
+		result1 error
+	}
+	uncordonCellReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UpdateDesiredLRPStub        func(lager.Logger, string, string, *models.DesiredLRPUpdate) error
 	updateDesiredLRPMutex       sync.RWMutex
 	updateDesiredLRPArgsForCall []struct {
@@ -436,6 +854,19 @@ type FakeClient struct {
 	updateDesiredLRPReturnsOnCall map[int]struct {
 		result1 error
 	}
+	UpdateScheduledTaskStub        func(lager.Logger, string, *models.ScheduledTask) error
+	updateScheduledTaskMutex       sync.RWMutex
+	updateScheduledTaskArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 *models.ScheduledTask
+	}
+	updateScheduledTaskReturns struct {
+		result1 error
+	}
+	updateScheduledTaskReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UpsertDomainStub        func(lager.Logger, string, string, time.Duration) error
 	upsertDomainMutex       sync.RWMutex
 	upsertDomainArgsForCall []struct {
@@ -450,23 +881,36 @@ type FakeClient struct {
 	upsertDomainReturnsOnCall map[int]struct {
 		result1 error
 	}
+	UpsertDomainQuotaStub        func(lager.Logger, string, *models.DomainQuota) error
+	upsertDomainQuotaMutex       sync.RWMutex
+	upsertDomainQuotaArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 *models.DomainQuota
+	}
+	upsertDomainQuotaReturns struct {
+		result1 error
+	}
+	upsertDomainQuotaReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeClient) ActualLRPGroupByProcessGuidAndIndex(arg1 lager.Logger, arg2 string, arg3 string, arg4 int) (*models.ActualLRPGroup, error) {
-	fake.actualLRPGroupByProcessGuidAndIndexMutex.Lock()
-	ret, specificReturn := fake.actualLRPGroupByProcessGuidAndIndexReturnsOnCall[len(fake.actualLRPGroupByProcessGuidAndIndexArgsForCall)]
-	fake.actualLRPGroupByProcessGuidAndIndexArgsForCall = append(fake.actualLRPGroupByProcessGuidAndIndexArgsForCall, struct {
+func (fake *FakeClient) ActualLRPCrashes(arg1 lager.Logger, arg2 string, arg3 string, arg4 int32) ([]*models.ActualLRPCrash, error) {
+	fake.actualLRPCrashesMutex.Lock()
+	ret, specificReturn := fake.actualLRPCrashesReturnsOnCall[len(fake.actualLRPCrashesArgsForCall)]
+	fake.actualLRPCrashesArgsForCall = append(fake.actualLRPCrashesArgsForCall, struct {
 		arg1 lager.Logger
 		arg2 string
 		arg3 string
-		arg4 int
+		arg4 int32
 	}{arg1, arg2, arg3, arg4})
-	stub := fake.ActualLRPGroupByProcessGuidAndIndexStub
-	fakeReturns := fake.actualLRPGroupByProcessGuidAndIndexReturns
-	fake.recordInvocation("ActualLRPGroupByProcessGuidAndIndex", []interface{}{arg1, arg2, arg3, arg4})
-	fake.actualLRPGroupByProcessGuidAndIndexMutex.Unlock()
+	stub := fake.ActualLRPCrashesStub
+	fakeReturns := fake.actualLRPCrashesReturns
+	fake.recordInvocation("ActualLRPCrashes", []interface{}{arg1, arg2, arg3, arg4})
+	fake.actualLRPCrashesMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3, arg4)
 	}
@@ -476,15 +920,82 @@ func (fake *FakeClient) ActualLRPGroupByProcessGuidAndIndex(arg1 lager.Logger, a
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeClient) ActualLRPGroupByProcessGuidAndIndexCallCount() int {
-	fake.actualLRPGroupByProcessGuidAndIndexMutex.RLock()
-	defer fake.actualLRPGroupByProcessGuidAndIndexMutex.RUnlock()
-	return len(fake.actualLRPGroupByProcessGuidAndIndexArgsForCall)
+func (fake *FakeClient) ActualLRPCrashesCallCount() int {
+	fake.actualLRPCrashesMutex.RLock()
+	defer fake.actualLRPCrashesMutex.RUnlock()
+	return len(fake.actualLRPCrashesArgsForCall)
 }
 
-func (fake *FakeClient) ActualLRPGroupByProcessGuidAndIndexCalls(stub func(lager.Logger, string, string, int) (*models.ActualLRPGroup, error)) {
-	fake.actualLRPGroupByProcessGuidAndIndexMutex.Lock()
-	defer fake.actualLRPGroupByProcessGuidAndIndexMutex.Unlock()
+func (fake *FakeClient) ActualLRPCrashesCalls(stub func(lager.Logger, string, string, int32) ([]*models.ActualLRPCrash, error)) {
+	fake.actualLRPCrashesMutex.Lock()
+	defer fake.actualLRPCrashesMutex.Unlock()
+	fake.ActualLRPCrashesStub = stub
+}
+
+func (fake *FakeClient) ActualLRPCrashesArgsForCall(i int) (lager.Logger, string, string, int32) {
+	fake.actualLRPCrashesMutex.RLock()
+	defer fake.actualLRPCrashesMutex.RUnlock()
+	argsForCall := fake.actualLRPCrashesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeClient) ActualLRPCrashesReturns(result1 []*models.ActualLRPCrash, result2 error) {
+	fake.actualLRPCrashesMutex.Lock()
+	defer fake.actualLRPCrashesMutex.Unlock()
+	fake.ActualLRPCrashesStub = nil
+	fake.actualLRPCrashesReturns = struct {
+		result1 []*models.ActualLRPCrash
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ActualLRPCrashesReturnsOnCall(i int, result1 []*models.ActualLRPCrash, result2 error) {
+	fake.actualLRPCrashesMutex.Lock()
+	defer fake.actualLRPCrashesMutex.Unlock()
+	fake.ActualLRPCrashesStub = nil
+	if fake.actualLRPCrashesReturnsOnCall == nil {
+		fake.actualLRPCrashesReturnsOnCall = make(map[int]struct {
+			result1 []*models.ActualLRPCrash
+			result2 error
+		})
+	}
+	fake.actualLRPCrashesReturnsOnCall[i] = struct {
+		result1 []*models.ActualLRPCrash
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ActualLRPGroupByProcessGuidAndIndex(arg1 lager.Logger, arg2 string, arg3 string, arg4 int) (*models.ActualLRPGroup, error) {
+	fake.actualLRPGroupByProcessGuidAndIndexMutex.Lock()
+	ret, specificReturn := fake.actualLRPGroupByProcessGuidAndIndexReturnsOnCall[len(fake.actualLRPGroupByProcessGuidAndIndexArgsForCall)]
+	fake.actualLRPGroupByProcessGuidAndIndexArgsForCall = append(fake.actualLRPGroupByProcessGuidAndIndexArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ActualLRPGroupByProcessGuidAndIndexStub
+	fakeReturns := fake.actualLRPGroupByProcessGuidAndIndexReturns
+	fake.recordInvocation("ActualLRPGroupByProcessGuidAndIndex", []interface{}{arg1, arg2, arg3, arg4})
+	fake.actualLRPGroupByProcessGuidAndIndexMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ActualLRPGroupByProcessGuidAndIndexCallCount() int {
+	fake.actualLRPGroupByProcessGuidAndIndexMutex.RLock()
+	defer fake.actualLRPGroupByProcessGuidAndIndexMutex.RUnlock()
+	return len(fake.actualLRPGroupByProcessGuidAndIndexArgsForCall)
+}
+
+func (fake *FakeClient) ActualLRPGroupByProcessGuidAndIndexCalls(stub func(lager.Logger, string, string, int) (*models.ActualLRPGroup, error)) {
+	fake.actualLRPGroupByProcessGuidAndIndexMutex.Lock()
+	defer fake.actualLRPGroupByProcessGuidAndIndexMutex.Unlock()
 	fake.ActualLRPGroupByProcessGuidAndIndexStub = stub
 }
 
@@ -719,6 +1230,275 @@ func (fake *FakeClient) ActualLRPsReturnsOnCall(i int, result1 []*models.ActualL
 	}{result1, result2}
 }
 
+func (fake *FakeClient) ActualLRPsWithCrashHistory(arg1 lager.Logger, arg2 string, arg3 models.ActualLRPFilter) ([]*models.ActualLRP, []*models.ActualLRPCrash, error) {
+	fake.actualLRPsWithCrashHistoryMutex.Lock()
+	ret, specificReturn := fake.actualLRPsWithCrashHistoryReturnsOnCall[len(fake.actualLRPsWithCrashHistoryArgsForCall)]
+	fake.actualLRPsWithCrashHistoryArgsForCall = append(fake.actualLRPsWithCrashHistoryArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 models.ActualLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.ActualLRPsWithCrashHistoryStub
+	fakeReturns := fake.actualLRPsWithCrashHistoryReturns
+	fake.recordInvocation("ActualLRPsWithCrashHistory", []interface{}{arg1, arg2, arg3})
+	fake.actualLRPsWithCrashHistoryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClient) ActualLRPsWithCrashHistoryCallCount() int {
+	fake.actualLRPsWithCrashHistoryMutex.RLock()
+	defer fake.actualLRPsWithCrashHistoryMutex.RUnlock()
+	return len(fake.actualLRPsWithCrashHistoryArgsForCall)
+}
+
+func (fake *FakeClient) ActualLRPsWithCrashHistoryCalls(stub func(lager.Logger, string, models.ActualLRPFilter) ([]*models.ActualLRP, []*models.ActualLRPCrash, error)) {
+	fake.actualLRPsWithCrashHistoryMutex.Lock()
+	defer fake.actualLRPsWithCrashHistoryMutex.Unlock()
+	fake.ActualLRPsWithCrashHistoryStub = stub
+}
+
+func (fake *FakeClient) ActualLRPsWithCrashHistoryArgsForCall(i int) (lager.Logger, string, models.ActualLRPFilter) {
+	fake.actualLRPsWithCrashHistoryMutex.RLock()
+	defer fake.actualLRPsWithCrashHistoryMutex.RUnlock()
+	argsForCall := fake.actualLRPsWithCrashHistoryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) ActualLRPsWithCrashHistoryReturns(result1 []*models.ActualLRP, result2 []*models.ActualLRPCrash, result3 error) {
+	fake.actualLRPsWithCrashHistoryMutex.Lock()
+	defer fake.actualLRPsWithCrashHistoryMutex.Unlock()
+	fake.ActualLRPsWithCrashHistoryStub = nil
+	fake.actualLRPsWithCrashHistoryReturns = struct {
+		result1 []*models.ActualLRP
+		result2 []*models.ActualLRPCrash
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) ActualLRPsWithCrashHistoryReturnsOnCall(i int, result1 []*models.ActualLRP, result2 []*models.ActualLRPCrash, result3 error) {
+	fake.actualLRPsWithCrashHistoryMutex.Lock()
+	defer fake.actualLRPsWithCrashHistoryMutex.Unlock()
+	fake.ActualLRPsWithCrashHistoryStub = nil
+	if fake.actualLRPsWithCrashHistoryReturnsOnCall == nil {
+		fake.actualLRPsWithCrashHistoryReturnsOnCall = make(map[int]struct {
+			result1 []*models.ActualLRP
+			result2 []*models.ActualLRPCrash
+			result3 error
+		})
+	}
+	fake.actualLRPsWithCrashHistoryReturnsOnCall[i] = struct {
+		result1 []*models.ActualLRP
+		result2 []*models.ActualLRPCrash
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) AllDomains(arg1 lager.Logger, arg2 string) ([]*models.Domain, error) {
+	fake.allDomainsMutex.Lock()
+	ret, specificReturn := fake.allDomainsReturnsOnCall[len(fake.allDomainsArgsForCall)]
+	fake.allDomainsArgsForCall = append(fake.allDomainsArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.AllDomainsStub
+	fakeReturns := fake.allDomainsReturns
+	fake.recordInvocation("AllDomains", []interface{}{arg1, arg2})
+	fake.allDomainsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) AllDomainsCallCount() int {
+	fake.allDomainsMutex.RLock()
+	defer fake.allDomainsMutex.RUnlock()
+	return len(fake.allDomainsArgsForCall)
+}
+
+func (fake *FakeClient) AllDomainsCalls(stub func(lager.Logger, string) ([]*models.Domain, error)) {
+	fake.allDomainsMutex.Lock()
+	defer fake.allDomainsMutex.Unlock()
+	fake.AllDomainsStub = stub
+}
+
+func (fake *FakeClient) AllDomainsArgsForCall(i int) (lager.Logger, string) {
+	fake.allDomainsMutex.RLock()
+	defer fake.allDomainsMutex.RUnlock()
+	argsForCall := fake.allDomainsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) AllDomainsReturns(result1 []*models.Domain, result2 error) {
+	fake.allDomainsMutex.Lock()
+	defer fake.allDomainsMutex.Unlock()
+	fake.AllDomainsStub = nil
+	fake.allDomainsReturns = struct {
+		result1 []*models.Domain
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) AllDomainsReturnsOnCall(i int, result1 []*models.Domain, result2 error) {
+	fake.allDomainsMutex.Lock()
+	defer fake.allDomainsMutex.Unlock()
+	fake.AllDomainsStub = nil
+	if fake.allDomainsReturnsOnCall == nil {
+		fake.allDomainsReturnsOnCall = make(map[int]struct {
+			result1 []*models.Domain
+			result2 error
+		})
+	}
+	fake.allDomainsReturnsOnCall[i] = struct {
+		result1 []*models.Domain
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ArchivedTaskByGuid(arg1 lager.Logger, arg2 string, arg3 string) (*models.ArchivedTask, error) {
+	fake.archivedTaskByGuidMutex.Lock()
+	ret, specificReturn := fake.archivedTaskByGuidReturnsOnCall[len(fake.archivedTaskByGuidArgsForCall)]
+	fake.archivedTaskByGuidArgsForCall = append(fake.archivedTaskByGuidArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ArchivedTaskByGuidStub
+	fakeReturns := fake.archivedTaskByGuidReturns
+	fake.recordInvocation("ArchivedTaskByGuid", []interface{}{arg1, arg2, arg3})
+	fake.archivedTaskByGuidMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ArchivedTaskByGuidCallCount() int {
+	fake.archivedTaskByGuidMutex.RLock()
+	defer fake.archivedTaskByGuidMutex.RUnlock()
+	return len(fake.archivedTaskByGuidArgsForCall)
+}
+
+func (fake *FakeClient) ArchivedTaskByGuidCalls(stub func(lager.Logger, string, string) (*models.ArchivedTask, error)) {
+	fake.archivedTaskByGuidMutex.Lock()
+	defer fake.archivedTaskByGuidMutex.Unlock()
+	fake.ArchivedTaskByGuidStub = stub
+}
+
+func (fake *FakeClient) ArchivedTaskByGuidArgsForCall(i int) (lager.Logger, string, string) {
+	fake.archivedTaskByGuidMutex.RLock()
+	defer fake.archivedTaskByGuidMutex.RUnlock()
+	argsForCall := fake.archivedTaskByGuidArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) ArchivedTaskByGuidReturns(result1 *models.ArchivedTask, result2 error) {
+	fake.archivedTaskByGuidMutex.Lock()
+	defer fake.archivedTaskByGuidMutex.Unlock()
+	fake.ArchivedTaskByGuidStub = nil
+	fake.archivedTaskByGuidReturns = struct {
+		result1 *models.ArchivedTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ArchivedTaskByGuidReturnsOnCall(i int, result1 *models.ArchivedTask, result2 error) {
+	fake.archivedTaskByGuidMutex.Lock()
+	defer fake.archivedTaskByGuidMutex.Unlock()
+	fake.ArchivedTaskByGuidStub = nil
+	if fake.archivedTaskByGuidReturnsOnCall == nil {
+		fake.archivedTaskByGuidReturnsOnCall = make(map[int]struct {
+			result1 *models.ArchivedTask
+			result2 error
+		})
+	}
+	fake.archivedTaskByGuidReturnsOnCall[i] = struct {
+		result1 *models.ArchivedTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ArchivedTasks(arg1 lager.Logger, arg2 string, arg3 models.TaskArchiveFilter) ([]*models.ArchivedTask, string, error) {
+	fake.archivedTasksMutex.Lock()
+	ret, specificReturn := fake.archivedTasksReturnsOnCall[len(fake.archivedTasksArgsForCall)]
+	fake.archivedTasksArgsForCall = append(fake.archivedTasksArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 models.TaskArchiveFilter
+	}{arg1, arg2, arg3})
+	stub := fake.ArchivedTasksStub
+	fakeReturns := fake.archivedTasksReturns
+	fake.recordInvocation("ArchivedTasks", []interface{}{arg1, arg2, arg3})
+	fake.archivedTasksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClient) ArchivedTasksCallCount() int {
+	fake.archivedTasksMutex.RLock()
+	defer fake.archivedTasksMutex.RUnlock()
+	return len(fake.archivedTasksArgsForCall)
+}
+
+func (fake *FakeClient) ArchivedTasksCalls(stub func(lager.Logger, string, models.TaskArchiveFilter) ([]*models.ArchivedTask, string, error)) {
+	fake.archivedTasksMutex.Lock()
+	defer fake.archivedTasksMutex.Unlock()
+	fake.ArchivedTasksStub = stub
+}
+
+func (fake *FakeClient) ArchivedTasksArgsForCall(i int) (lager.Logger, string, models.TaskArchiveFilter) {
+	fake.archivedTasksMutex.RLock()
+	defer fake.archivedTasksMutex.RUnlock()
+	argsForCall := fake.archivedTasksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) ArchivedTasksReturns(result1 []*models.ArchivedTask, result2 string, result3 error) {
+	fake.archivedTasksMutex.Lock()
+	defer fake.archivedTasksMutex.Unlock()
+	fake.ArchivedTasksStub = nil
+	fake.archivedTasksReturns = struct {
+		result1 []*models.ArchivedTask
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) ArchivedTasksReturnsOnCall(i int, result1 []*models.ArchivedTask, result2 string, result3 error) {
+	fake.archivedTasksMutex.Lock()
+	defer fake.archivedTasksMutex.Unlock()
+	fake.ArchivedTasksStub = nil
+	if fake.archivedTasksReturnsOnCall == nil {
+		fake.archivedTasksReturnsOnCall = make(map[int]struct {
+			result1 []*models.ArchivedTask
+			result2 string
+			result3 error
+		})
+	}
+	fake.archivedTasksReturnsOnCall[i] = struct {
+		result1 []*models.ArchivedTask
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeClient) CancelTask(arg1 lager.Logger, arg2 string, arg3 string) error {
 	fake.cancelTaskMutex.Lock()
 	ret, specificReturn := fake.cancelTaskReturnsOnCall[len(fake.cancelTaskArgsForCall)]
@@ -782,6 +1562,200 @@ func (fake *FakeClient) CancelTaskReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeClient) CancelTaskArray(arg1 lager.Logger, arg2 string, arg3 string) error {
+	fake.cancelTaskArrayMutex.Lock()
+	ret, specificReturn := fake.cancelTaskArrayReturnsOnCall[len(fake.cancelTaskArrayArgsForCall)]
+	fake.cancelTaskArrayArgsForCall = append(fake.cancelTaskArrayArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.CancelTaskArrayStub
+	fakeReturns := fake.cancelTaskArrayReturns
+	fake.recordInvocation("CancelTaskArray", []interface{}{arg1, arg2, arg3})
+	fake.cancelTaskArrayMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) CancelTaskArrayCallCount() int {
+	fake.cancelTaskArrayMutex.RLock()
+	defer fake.cancelTaskArrayMutex.RUnlock()
+	return len(fake.cancelTaskArrayArgsForCall)
+}
+
+func (fake *FakeClient) CancelTaskArrayCalls(stub func(lager.Logger, string, string) error) {
+	fake.cancelTaskArrayMutex.Lock()
+	defer fake.cancelTaskArrayMutex.Unlock()
+	fake.CancelTaskArrayStub = stub
+}
+
+func (fake *FakeClient) CancelTaskArrayArgsForCall(i int) (lager.Logger, string, string) {
+	fake.cancelTaskArrayMutex.RLock()
+	defer fake.cancelTaskArrayMutex.RUnlock()
+	argsForCall := fake.cancelTaskArrayArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) CancelTaskArrayReturns(result1 error) {
+	fake.cancelTaskArrayMutex.Lock()
+	defer fake.cancelTaskArrayMutex.Unlock()
+	fake.CancelTaskArrayStub = nil
+	fake.cancelTaskArrayReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) CancelTaskArrayReturnsOnCall(i int, result1 error) {
+	fake.cancelTaskArrayMutex.Lock()
+	defer fake.cancelTaskArrayMutex.Unlock()
+	fake.CancelTaskArrayStub = nil
+	if fake.cancelTaskArrayReturnsOnCall == nil {
+		fake.cancelTaskArrayReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.cancelTaskArrayReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) CapacityReport(arg1 lager.Logger, arg2 string) (*models.CapacityReport, error) {
+	fake.capacityReportMutex.Lock()
+	ret, specificReturn := fake.capacityReportReturnsOnCall[len(fake.capacityReportArgsForCall)]
+	fake.capacityReportArgsForCall = append(fake.capacityReportArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.CapacityReportStub
+	fakeReturns := fake.capacityReportReturns
+	fake.recordInvocation("CapacityReport", []interface{}{arg1, arg2})
+	fake.capacityReportMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) CapacityReportCallCount() int {
+	fake.capacityReportMutex.RLock()
+	defer fake.capacityReportMutex.RUnlock()
+	return len(fake.capacityReportArgsForCall)
+}
+
+func (fake *FakeClient) CapacityReportCalls(stub func(lager.Logger, string) (*models.CapacityReport, error)) {
+	fake.capacityReportMutex.Lock()
+	defer fake.capacityReportMutex.Unlock()
+	fake.CapacityReportStub = stub
+}
+
+func (fake *FakeClient) CapacityReportArgsForCall(i int) (lager.Logger, string) {
+	fake.capacityReportMutex.RLock()
+	defer fake.capacityReportMutex.RUnlock()
+	argsForCall := fake.capacityReportArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) CapacityReportReturns(result1 *models.CapacityReport, result2 error) {
+	fake.capacityReportMutex.Lock()
+	defer fake.capacityReportMutex.Unlock()
+	fake.CapacityReportStub = nil
+	fake.capacityReportReturns = struct {
+		result1 *models.CapacityReport
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CapacityReportReturnsOnCall(i int, result1 *models.CapacityReport, result2 error) {
+	fake.capacityReportMutex.Lock()
+	defer fake.capacityReportMutex.Unlock()
+	fake.CapacityReportStub = nil
+	if fake.capacityReportReturnsOnCall == nil {
+		fake.capacityReportReturnsOnCall = make(map[int]struct {
+			result1 *models.CapacityReport
+			result2 error
+		})
+	}
+	fake.capacityReportReturnsOnCall[i] = struct {
+		result1 *models.CapacityReport
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CellDrainStatus(arg1 lager.Logger, arg2 string, arg3 string) (*models.CellDrainStatus, error) {
+	fake.cellDrainStatusMutex.Lock()
+	ret, specificReturn := fake.cellDrainStatusReturnsOnCall[len(fake.cellDrainStatusArgsForCall)]
+	fake.cellDrainStatusArgsForCall = append(fake.cellDrainStatusArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.CellDrainStatusStub
+	fakeReturns := fake.cellDrainStatusReturns
+	fake.recordInvocation("CellDrainStatus", []interface{}{arg1, arg2, arg3})
+	fake.cellDrainStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) CellDrainStatusCallCount() int {
+	fake.cellDrainStatusMutex.RLock()
+	defer fake.cellDrainStatusMutex.RUnlock()
+	return len(fake.cellDrainStatusArgsForCall)
+}
+
+func (fake *FakeClient) CellDrainStatusCalls(stub func(lager.Logger, string, string) (*models.CellDrainStatus, error)) {
+	fake.cellDrainStatusMutex.Lock()
+	defer fake.cellDrainStatusMutex.Unlock()
+	fake.CellDrainStatusStub = stub
+}
+
+func (fake *FakeClient) CellDrainStatusArgsForCall(i int) (lager.Logger, string, string) {
+	fake.cellDrainStatusMutex.RLock()
+	defer fake.cellDrainStatusMutex.RUnlock()
+	argsForCall := fake.cellDrainStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) CellDrainStatusReturns(result1 *models.CellDrainStatus, result2 error) {
+	fake.cellDrainStatusMutex.Lock()
+	defer fake.cellDrainStatusMutex.Unlock()
+	fake.CellDrainStatusStub = nil
+	fake.cellDrainStatusReturns = struct {
+		result1 *models.CellDrainStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CellDrainStatusReturnsOnCall(i int, result1 *models.CellDrainStatus, result2 error) {
+	fake.cellDrainStatusMutex.Lock()
+	defer fake.cellDrainStatusMutex.Unlock()
+	fake.CellDrainStatusStub = nil
+	if fake.cellDrainStatusReturnsOnCall == nil {
+		fake.cellDrainStatusReturnsOnCall = make(map[int]struct {
+			result1 *models.CellDrainStatus
+			result2 error
+		})
+	}
+	fake.cellDrainStatusReturnsOnCall[i] = struct {
+		result1 *models.CellDrainStatus
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) Cells(arg1 lager.Logger, arg2 string) ([]*models.CellPresence, error) {
 	fake.cellsMutex.Lock()
 	ret, specificReturn := fake.cellsReturnsOnCall[len(fake.cellsArgsForCall)]
@@ -847,18 +1821,270 @@ func (fake *FakeClient) CellsReturnsOnCall(i int, result1 []*models.CellPresence
 	}{result1, result2}
 }
 
-func (fake *FakeClient) DeleteTask(arg1 lager.Logger, arg2 string, arg3 string) error {
-	fake.deleteTaskMutex.Lock()
-	ret, specificReturn := fake.deleteTaskReturnsOnCall[len(fake.deleteTaskArgsForCall)]
-	fake.deleteTaskArgsForCall = append(fake.deleteTaskArgsForCall, struct {
+func (fake *FakeClient) CordonCell(arg1 lager.Logger, arg2 string, arg3 string) error {
+	fake.cordonCellMutex.Lock()
+	ret, specificReturn := fake.cordonCellReturnsOnCall[len(fake.cordonCellArgsForCall)]
+	fake.cordonCellArgsForCall = append(fake.cordonCellArgsForCall, struct {
 		arg1 lager.Logger
 		arg2 string
 		arg3 string
 	}{arg1, arg2, arg3})
-	stub := fake.DeleteTaskStub
-	fakeReturns := fake.deleteTaskReturns
-	fake.recordInvocation("DeleteTask", []interface{}{arg1, arg2, arg3})
-	fake.deleteTaskMutex.Unlock()
+	stub := fake.CordonCellStub
+	fakeReturns := fake.cordonCellReturns
+	fake.recordInvocation("CordonCell", []interface{}{arg1, arg2, arg3})
+	fake.cordonCellMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) CordonCellCallCount() int {
+	fake.cordonCellMutex.RLock()
+	defer fake.cordonCellMutex.RUnlock()
+	return len(fake.cordonCellArgsForCall)
+}
+
+func (fake *FakeClient) CordonCellCalls(stub func(lager.Logger, string, string) error) {
+	fake.cordonCellMutex.Lock()
+	defer fake.cordonCellMutex.Unlock()
+	fake.CordonCellStub = stub
+}
+
+func (fake *FakeClient) CordonCellArgsForCall(i int) (lager.Logger, string, string) {
+	fake.cordonCellMutex.RLock()
+	defer fake.cordonCellMutex.RUnlock()
+	argsForCall := fake.cordonCellArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) CordonCellReturns(result1 error) {
+	fake.cordonCellMutex.Lock()
+	defer fake.cordonCellMutex.Unlock()
+	fake.CordonCellStub = nil
+	fake.cordonCellReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) CordonCellReturnsOnCall(i int, result1 error) {
+	fake.cordonCellMutex.Lock()
+	defer fake.cordonCellMutex.Unlock()
+	fake.CordonCellStub = nil
+	if fake.cordonCellReturnsOnCall == nil {
+		fake.cordonCellReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.cordonCellReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) DeleteDomain(arg1 lager.Logger, arg2 string, arg3 string) error {
+	fake.deleteDomainMutex.Lock()
+	ret, specificReturn := fake.deleteDomainReturnsOnCall[len(fake.deleteDomainArgsForCall)]
+	fake.deleteDomainArgsForCall = append(fake.deleteDomainArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteDomainStub
+	fakeReturns := fake.deleteDomainReturns
+	fake.recordInvocation("DeleteDomain", []interface{}{arg1, arg2, arg3})
+	fake.deleteDomainMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) DeleteDomainCallCount() int {
+	fake.deleteDomainMutex.RLock()
+	defer fake.deleteDomainMutex.RUnlock()
+	return len(fake.deleteDomainArgsForCall)
+}
+
+func (fake *FakeClient) DeleteDomainCalls(stub func(lager.Logger, string, string) error) {
+	fake.deleteDomainMutex.Lock()
+	defer fake.deleteDomainMutex.Unlock()
+	fake.DeleteDomainStub = stub
+}
+
+func (fake *FakeClient) DeleteDomainArgsForCall(i int) (lager.Logger, string, string) {
+	fake.deleteDomainMutex.RLock()
+	defer fake.deleteDomainMutex.RUnlock()
+	argsForCall := fake.deleteDomainArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) DeleteDomainReturns(result1 error) {
+	fake.deleteDomainMutex.Lock()
+	defer fake.deleteDomainMutex.Unlock()
+	fake.DeleteDomainStub = nil
+	fake.deleteDomainReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) DeleteDomainReturnsOnCall(i int, result1 error) {
+	fake.deleteDomainMutex.Lock()
+	defer fake.deleteDomainMutex.Unlock()
+	fake.DeleteDomainStub = nil
+	if fake.deleteDomainReturnsOnCall == nil {
+		fake.deleteDomainReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteDomainReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) DeleteDomainQuota(arg1 lager.Logger, arg2 string, arg3 string) error {
+	fake.deleteDomainQuotaMutex.Lock()
+	ret, specificReturn := fake.deleteDomainQuotaReturnsOnCall[len(fake.deleteDomainQuotaArgsForCall)]
+	fake.deleteDomainQuotaArgsForCall = append(fake.deleteDomainQuotaArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteDomainQuotaStub
+	fakeReturns := fake.deleteDomainQuotaReturns
+	fake.recordInvocation("DeleteDomainQuota", []interface{}{arg1, arg2, arg3})
+	fake.deleteDomainQuotaMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) DeleteDomainQuotaCallCount() int {
+	fake.deleteDomainQuotaMutex.RLock()
+	defer fake.deleteDomainQuotaMutex.RUnlock()
+	return len(fake.deleteDomainQuotaArgsForCall)
+}
+
+func (fake *FakeClient) DeleteDomainQuotaCalls(stub func(lager.Logger, string, string) error) {
+	fake.deleteDomainQuotaMutex.Lock()
+	defer fake.deleteDomainQuotaMutex.Unlock()
+	fake.DeleteDomainQuotaStub = stub
+}
+
+func (fake *FakeClient) DeleteDomainQuotaArgsForCall(i int) (lager.Logger, string, string) {
+	fake.deleteDomainQuotaMutex.RLock()
+	defer fake.deleteDomainQuotaMutex.RUnlock()
+	argsForCall := fake.deleteDomainQuotaArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) DeleteDomainQuotaReturns(result1 error) {
+	fake.deleteDomainQuotaMutex.Lock()
+	defer fake.deleteDomainQuotaMutex.Unlock()
+	fake.DeleteDomainQuotaStub = nil
+	fake.deleteDomainQuotaReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) DeleteDomainQuotaReturnsOnCall(i int, result1 error) {
+	fake.deleteDomainQuotaMutex.Lock()
+	defer fake.deleteDomainQuotaMutex.Unlock()
+	fake.DeleteDomainQuotaStub = nil
+	if fake.deleteDomainQuotaReturnsOnCall == nil {
+		fake.deleteDomainQuotaReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteDomainQuotaReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) DeleteScheduledTask(arg1 lager.Logger, arg2 string, arg3 string) error {
+	fake.deleteScheduledTaskMutex.Lock()
+	ret, specificReturn := fake.deleteScheduledTaskReturnsOnCall[len(fake.deleteScheduledTaskArgsForCall)]
+	fake.deleteScheduledTaskArgsForCall = append(fake.deleteScheduledTaskArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteScheduledTaskStub
+	fakeReturns := fake.deleteScheduledTaskReturns
+	fake.recordInvocation("DeleteScheduledTask", []interface{}{arg1, arg2, arg3})
+	fake.deleteScheduledTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) DeleteScheduledTaskCallCount() int {
+	fake.deleteScheduledTaskMutex.RLock()
+	defer fake.deleteScheduledTaskMutex.RUnlock()
+	return len(fake.deleteScheduledTaskArgsForCall)
+}
+
+func (fake *FakeClient) DeleteScheduledTaskCalls(stub func(lager.Logger, string, string) error) {
+	fake.deleteScheduledTaskMutex.Lock()
+	defer fake.deleteScheduledTaskMutex.Unlock()
+	fake.DeleteScheduledTaskStub = stub
+}
+
+func (fake *FakeClient) DeleteScheduledTaskArgsForCall(i int) (lager.Logger, string, string) {
+	fake.deleteScheduledTaskMutex.RLock()
+	defer fake.deleteScheduledTaskMutex.RUnlock()
+	argsForCall := fake.deleteScheduledTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) DeleteScheduledTaskReturns(result1 error) {
+	fake.deleteScheduledTaskMutex.Lock()
+	defer fake.deleteScheduledTaskMutex.Unlock()
+	fake.DeleteScheduledTaskStub = nil
+	fake.deleteScheduledTaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) DeleteScheduledTaskReturnsOnCall(i int, result1 error) {
+	fake.deleteScheduledTaskMutex.Lock()
+	defer fake.deleteScheduledTaskMutex.Unlock()
+	fake.DeleteScheduledTaskStub = nil
+	if fake.deleteScheduledTaskReturnsOnCall == nil {
+		fake.deleteScheduledTaskReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteScheduledTaskReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) DeleteTask(arg1 lager.Logger, arg2 string, arg3 string) error {
+	fake.deleteTaskMutex.Lock()
+	ret, specificReturn := fake.deleteTaskReturnsOnCall[len(fake.deleteTaskArgsForCall)]
+	fake.deleteTaskArgsForCall = append(fake.deleteTaskArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteTaskStub
+	fakeReturns := fake.deleteTaskReturns
+	fake.recordInvocation("DeleteTask", []interface{}{arg1, arg2, arg3})
+	fake.deleteTaskMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3)
 	}
@@ -973,6 +2199,69 @@ func (fake *FakeClient) DesireLRPReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeClient) DesireScheduledTask(arg1 lager.Logger, arg2 string, arg3 *models.ScheduledTask) error {
+	fake.desireScheduledTaskMutex.Lock()
+	ret, specificReturn := fake.desireScheduledTaskReturnsOnCall[len(fake.desireScheduledTaskArgsForCall)]
+	fake.desireScheduledTaskArgsForCall = append(fake.desireScheduledTaskArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 *models.ScheduledTask
+	}{arg1, arg2, arg3})
+	stub := fake.DesireScheduledTaskStub
+	fakeReturns := fake.desireScheduledTaskReturns
+	fake.recordInvocation("DesireScheduledTask", []interface{}{arg1, arg2, arg3})
+	fake.desireScheduledTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) DesireScheduledTaskCallCount() int {
+	fake.desireScheduledTaskMutex.RLock()
+	defer fake.desireScheduledTaskMutex.RUnlock()
+	return len(fake.desireScheduledTaskArgsForCall)
+}
+
+func (fake *FakeClient) DesireScheduledTaskCalls(stub func(lager.Logger, string, *models.ScheduledTask) error) {
+	fake.desireScheduledTaskMutex.Lock()
+	defer fake.desireScheduledTaskMutex.Unlock()
+	fake.DesireScheduledTaskStub = stub
+}
+
+func (fake *FakeClient) DesireScheduledTaskArgsForCall(i int) (lager.Logger, string, *models.ScheduledTask) {
+	fake.desireScheduledTaskMutex.RLock()
+	defer fake.desireScheduledTaskMutex.RUnlock()
+	argsForCall := fake.desireScheduledTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) DesireScheduledTaskReturns(result1 error) {
+	fake.desireScheduledTaskMutex.Lock()
+	defer fake.desireScheduledTaskMutex.Unlock()
+	fake.DesireScheduledTaskStub = nil
+	fake.desireScheduledTaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) DesireScheduledTaskReturnsOnCall(i int, result1 error) {
+	fake.desireScheduledTaskMutex.Lock()
+	defer fake.desireScheduledTaskMutex.Unlock()
+	fake.DesireScheduledTaskStub = nil
+	if fake.desireScheduledTaskReturnsOnCall == nil {
+		fake.desireScheduledTaskReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.desireScheduledTaskReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeClient) DesireTask(arg1 lager.Logger, arg2 string, arg3 string, arg4 string, arg5 *models.TaskDefinition) error {
 	fake.desireTaskMutex.Lock()
 	ret, specificReturn := fake.desireTaskReturnsOnCall[len(fake.desireTaskArgsForCall)]
@@ -1038,18 +2327,218 @@ func (fake *FakeClient) DesireTaskReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeClient) DesiredLRPByProcessGuid(arg1 lager.Logger, arg2 string, arg3 string) (*models.DesiredLRP, error) {
-	fake.desiredLRPByProcessGuidMutex.Lock()
-	ret, specificReturn := fake.desiredLRPByProcessGuidReturnsOnCall[len(fake.desiredLRPByProcessGuidArgsForCall)]
-	fake.desiredLRPByProcessGuidArgsForCall = append(fake.desiredLRPByProcessGuidArgsForCall, struct {
+func (fake *FakeClient) DesireTaskArray(arg1 lager.Logger, arg2 string, arg3 string, arg4 string, arg5 int32, arg6 *models.TaskDefinition) error {
+	fake.desireTaskArrayMutex.Lock()
+	ret, specificReturn := fake.desireTaskArrayReturnsOnCall[len(fake.desireTaskArrayArgsForCall)]
+	fake.desireTaskArrayArgsForCall = append(fake.desireTaskArrayArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 int32
+		arg6 *models.TaskDefinition
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	stub := fake.DesireTaskArrayStub
+	fakeReturns := fake.desireTaskArrayReturns
+	fake.recordInvocation("DesireTaskArray", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.desireTaskArrayMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) DesireTaskArrayCallCount() int {
+	fake.desireTaskArrayMutex.RLock()
+	defer fake.desireTaskArrayMutex.RUnlock()
+	return len(fake.desireTaskArrayArgsForCall)
+}
+
+func (fake *FakeClient) DesireTaskArrayCalls(stub func(lager.Logger, string, string, string, int32, *models.TaskDefinition) error) {
+	fake.desireTaskArrayMutex.Lock()
+	defer fake.desireTaskArrayMutex.Unlock()
+	fake.DesireTaskArrayStub = stub
+}
+
+func (fake *FakeClient) DesireTaskArrayArgsForCall(i int) (lager.Logger, string, string, string, int32, *models.TaskDefinition) {
+	fake.desireTaskArrayMutex.RLock()
+	defer fake.desireTaskArrayMutex.RUnlock()
+	argsForCall := fake.desireTaskArrayArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakeClient) DesireTaskArrayReturns(result1 error) {
+	fake.desireTaskArrayMutex.Lock()
+	defer fake.desireTaskArrayMutex.Unlock()
+	fake.DesireTaskArrayStub = nil
+	fake.desireTaskArrayReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) DesireTaskArrayReturnsOnCall(i int, result1 error) {
+	fake.desireTaskArrayMutex.Lock()
+	defer fake.desireTaskArrayMutex.Unlock()
+	fake.DesireTaskArrayStub = nil
+	if fake.desireTaskArrayReturnsOnCall == nil {
+		fake.desireTaskArrayReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.desireTaskArrayReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) DesiredLRPByProcessGuid(arg1 lager.Logger, arg2 string, arg3 string) (*models.DesiredLRP, error) {
+	fake.desiredLRPByProcessGuidMutex.Lock()
+	ret, specificReturn := fake.desiredLRPByProcessGuidReturnsOnCall[len(fake.desiredLRPByProcessGuidArgsForCall)]
+	fake.desiredLRPByProcessGuidArgsForCall = append(fake.desiredLRPByProcessGuidArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DesiredLRPByProcessGuidStub
+	fakeReturns := fake.desiredLRPByProcessGuidReturns
+	fake.recordInvocation("DesiredLRPByProcessGuid", []interface{}{arg1, arg2, arg3})
+	fake.desiredLRPByProcessGuidMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) DesiredLRPByProcessGuidCallCount() int {
+	fake.desiredLRPByProcessGuidMutex.RLock()
+	defer fake.desiredLRPByProcessGuidMutex.RUnlock()
+	return len(fake.desiredLRPByProcessGuidArgsForCall)
+}
+
+func (fake *FakeClient) DesiredLRPByProcessGuidCalls(stub func(lager.Logger, string, string) (*models.DesiredLRP, error)) {
+	fake.desiredLRPByProcessGuidMutex.Lock()
+	defer fake.desiredLRPByProcessGuidMutex.Unlock()
+	fake.DesiredLRPByProcessGuidStub = stub
+}
+
+func (fake *FakeClient) DesiredLRPByProcessGuidArgsForCall(i int) (lager.Logger, string, string) {
+	fake.desiredLRPByProcessGuidMutex.RLock()
+	defer fake.desiredLRPByProcessGuidMutex.RUnlock()
+	argsForCall := fake.desiredLRPByProcessGuidArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) DesiredLRPByProcessGuidReturns(result1 *models.DesiredLRP, result2 error) {
+	fake.desiredLRPByProcessGuidMutex.Lock()
+	defer fake.desiredLRPByProcessGuidMutex.Unlock()
+	fake.DesiredLRPByProcessGuidStub = nil
+	fake.desiredLRPByProcessGuidReturns = struct {
+		result1 *models.DesiredLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) DesiredLRPByProcessGuidReturnsOnCall(i int, result1 *models.DesiredLRP, result2 error) {
+	fake.desiredLRPByProcessGuidMutex.Lock()
+	defer fake.desiredLRPByProcessGuidMutex.Unlock()
+	fake.DesiredLRPByProcessGuidStub = nil
+	if fake.desiredLRPByProcessGuidReturnsOnCall == nil {
+		fake.desiredLRPByProcessGuidReturnsOnCall = make(map[int]struct {
+			result1 *models.DesiredLRP
+			result2 error
+		})
+	}
+	fake.desiredLRPByProcessGuidReturnsOnCall[i] = struct {
+		result1 *models.DesiredLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) DesiredLRPRevisionDiff(arg1 lager.Logger, arg2 string, arg3 string, arg4 int32, arg5 int32) ([]*models.DesiredLRPRevisionChange, error) {
+	fake.desiredLRPRevisionDiffMutex.Lock()
+	ret, specificReturn := fake.desiredLRPRevisionDiffReturnsOnCall[len(fake.desiredLRPRevisionDiffArgsForCall)]
+	fake.desiredLRPRevisionDiffArgsForCall = append(fake.desiredLRPRevisionDiffArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+		arg4 int32
+		arg5 int32
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.DesiredLRPRevisionDiffStub
+	fakeReturns := fake.desiredLRPRevisionDiffReturns
+	fake.recordInvocation("DesiredLRPRevisionDiff", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.desiredLRPRevisionDiffMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) DesiredLRPRevisionDiffCallCount() int {
+	fake.desiredLRPRevisionDiffMutex.RLock()
+	defer fake.desiredLRPRevisionDiffMutex.RUnlock()
+	return len(fake.desiredLRPRevisionDiffArgsForCall)
+}
+
+func (fake *FakeClient) DesiredLRPRevisionDiffCalls(stub func(lager.Logger, string, string, int32, int32) ([]*models.DesiredLRPRevisionChange, error)) {
+	fake.desiredLRPRevisionDiffMutex.Lock()
+	defer fake.desiredLRPRevisionDiffMutex.Unlock()
+	fake.DesiredLRPRevisionDiffStub = stub
+}
+
+func (fake *FakeClient) DesiredLRPRevisionDiffArgsForCall(i int) (lager.Logger, string, string, int32, int32) {
+	fake.desiredLRPRevisionDiffMutex.RLock()
+	defer fake.desiredLRPRevisionDiffMutex.RUnlock()
+	argsForCall := fake.desiredLRPRevisionDiffArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeClient) DesiredLRPRevisionDiffReturns(result1 []*models.DesiredLRPRevisionChange, result2 error) {
+	fake.desiredLRPRevisionDiffMutex.Lock()
+	defer fake.desiredLRPRevisionDiffMutex.Unlock()
+	fake.DesiredLRPRevisionDiffStub = nil
+	fake.desiredLRPRevisionDiffReturns = struct {
+		result1 []*models.DesiredLRPRevisionChange
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) DesiredLRPRevisionDiffReturnsOnCall(i int, result1 []*models.DesiredLRPRevisionChange, result2 error) {
+	fake.desiredLRPRevisionDiffMutex.Lock()
+	defer fake.desiredLRPRevisionDiffMutex.Unlock()
+	fake.DesiredLRPRevisionDiffStub = nil
+	if fake.desiredLRPRevisionDiffReturnsOnCall == nil {
+		fake.desiredLRPRevisionDiffReturnsOnCall = make(map[int]struct {
+			result1 []*models.DesiredLRPRevisionChange
+			result2 error
+		})
+	}
+	fake.desiredLRPRevisionDiffReturnsOnCall[i] = struct {
+		result1 []*models.DesiredLRPRevisionChange
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) DesiredLRPRevisions(arg1 lager.Logger, arg2 string, arg3 string) ([]*models.DesiredLRPRevision, error) {
+	fake.desiredLRPRevisionsMutex.Lock()
+	ret, specificReturn := fake.desiredLRPRevisionsReturnsOnCall[len(fake.desiredLRPRevisionsArgsForCall)]
+	fake.desiredLRPRevisionsArgsForCall = append(fake.desiredLRPRevisionsArgsForCall, struct {
 		arg1 lager.Logger
 		arg2 string
 		arg3 string
 	}{arg1, arg2, arg3})
-	stub := fake.DesiredLRPByProcessGuidStub
-	fakeReturns := fake.desiredLRPByProcessGuidReturns
-	fake.recordInvocation("DesiredLRPByProcessGuid", []interface{}{arg1, arg2, arg3})
-	fake.desiredLRPByProcessGuidMutex.Unlock()
+	stub := fake.DesiredLRPRevisionsStub
+	fakeReturns := fake.desiredLRPRevisionsReturns
+	fake.recordInvocation("DesiredLRPRevisions", []interface{}{arg1, arg2, arg3})
+	fake.desiredLRPRevisionsMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3)
 	}
@@ -1059,47 +2548,47 @@ func (fake *FakeClient) DesiredLRPByProcessGuid(arg1 lager.Logger, arg2 string,
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeClient) DesiredLRPByProcessGuidCallCount() int {
-	fake.desiredLRPByProcessGuidMutex.RLock()
-	defer fake.desiredLRPByProcessGuidMutex.RUnlock()
-	return len(fake.desiredLRPByProcessGuidArgsForCall)
+func (fake *FakeClient) DesiredLRPRevisionsCallCount() int {
+	fake.desiredLRPRevisionsMutex.RLock()
+	defer fake.desiredLRPRevisionsMutex.RUnlock()
+	return len(fake.desiredLRPRevisionsArgsForCall)
 }
 
-func (fake *FakeClient) DesiredLRPByProcessGuidCalls(stub func(lager.Logger, string, string) (*models.DesiredLRP, error)) {
-	fake.desiredLRPByProcessGuidMutex.Lock()
-	defer fake.desiredLRPByProcessGuidMutex.Unlock()
-	fake.DesiredLRPByProcessGuidStub = stub
+func (fake *FakeClient) DesiredLRPRevisionsCalls(stub func(lager.Logger, string, string) ([]*models.DesiredLRPRevision, error)) {
+	fake.desiredLRPRevisionsMutex.Lock()
+	defer fake.desiredLRPRevisionsMutex.Unlock()
+	fake.DesiredLRPRevisionsStub = stub
 }
 
-func (fake *FakeClient) DesiredLRPByProcessGuidArgsForCall(i int) (lager.Logger, string, string) {
-	fake.desiredLRPByProcessGuidMutex.RLock()
-	defer fake.desiredLRPByProcessGuidMutex.RUnlock()
-	argsForCall := fake.desiredLRPByProcessGuidArgsForCall[i]
+func (fake *FakeClient) DesiredLRPRevisionsArgsForCall(i int) (lager.Logger, string, string) {
+	fake.desiredLRPRevisionsMutex.RLock()
+	defer fake.desiredLRPRevisionsMutex.RUnlock()
+	argsForCall := fake.desiredLRPRevisionsArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeClient) DesiredLRPByProcessGuidReturns(result1 *models.DesiredLRP, result2 error) {
-	fake.desiredLRPByProcessGuidMutex.Lock()
-	defer fake.desiredLRPByProcessGuidMutex.Unlock()
-	fake.DesiredLRPByProcessGuidStub = nil
-	fake.desiredLRPByProcessGuidReturns = struct {
-		result1 *models.DesiredLRP
+func (fake *FakeClient) DesiredLRPRevisionsReturns(result1 []*models.DesiredLRPRevision, result2 error) {
+	fake.desiredLRPRevisionsMutex.Lock()
+	defer fake.desiredLRPRevisionsMutex.Unlock()
+	fake.DesiredLRPRevisionsStub = nil
+	fake.desiredLRPRevisionsReturns = struct {
+		result1 []*models.DesiredLRPRevision
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeClient) DesiredLRPByProcessGuidReturnsOnCall(i int, result1 *models.DesiredLRP, result2 error) {
-	fake.desiredLRPByProcessGuidMutex.Lock()
-	defer fake.desiredLRPByProcessGuidMutex.Unlock()
-	fake.DesiredLRPByProcessGuidStub = nil
-	if fake.desiredLRPByProcessGuidReturnsOnCall == nil {
-		fake.desiredLRPByProcessGuidReturnsOnCall = make(map[int]struct {
-			result1 *models.DesiredLRP
+func (fake *FakeClient) DesiredLRPRevisionsReturnsOnCall(i int, result1 []*models.DesiredLRPRevision, result2 error) {
+	fake.desiredLRPRevisionsMutex.Lock()
+	defer fake.desiredLRPRevisionsMutex.Unlock()
+	fake.DesiredLRPRevisionsStub = nil
+	if fake.desiredLRPRevisionsReturnsOnCall == nil {
+		fake.desiredLRPRevisionsReturnsOnCall = make(map[int]struct {
+			result1 []*models.DesiredLRPRevision
 			result2 error
 		})
 	}
-	fake.desiredLRPByProcessGuidReturnsOnCall[i] = struct {
-		result1 *models.DesiredLRP
+	fake.desiredLRPRevisionsReturnsOnCall[i] = struct {
+		result1 []*models.DesiredLRPRevision
 		result2 error
 	}{result1, result2}
 }
@@ -1302,6 +2791,72 @@ func (fake *FakeClient) DesiredLRPSchedulingInfosReturnsOnCall(i int, result1 []
 	}{result1, result2}
 }
 
+func (fake *FakeClient) DesiredLRPStatus(arg1 lager.Logger, arg2 string, arg3 models.DesiredLRPFilter) ([]*models.DesiredLRPStatus, error) {
+	fake.desiredLRPStatusMutex.Lock()
+	ret, specificReturn := fake.desiredLRPStatusReturnsOnCall[len(fake.desiredLRPStatusArgsForCall)]
+	fake.desiredLRPStatusArgsForCall = append(fake.desiredLRPStatusArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 models.DesiredLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.DesiredLRPStatusStub
+	fakeReturns := fake.desiredLRPStatusReturns
+	fake.recordInvocation("DesiredLRPStatus", []interface{}{arg1, arg2, arg3})
+	fake.desiredLRPStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) DesiredLRPStatusCallCount() int {
+	fake.desiredLRPStatusMutex.RLock()
+	defer fake.desiredLRPStatusMutex.RUnlock()
+	return len(fake.desiredLRPStatusArgsForCall)
+}
+
+func (fake *FakeClient) DesiredLRPStatusCalls(stub func(lager.Logger, string, models.DesiredLRPFilter) ([]*models.DesiredLRPStatus, error)) {
+	fake.desiredLRPStatusMutex.Lock()
+	defer fake.desiredLRPStatusMutex.Unlock()
+	fake.DesiredLRPStatusStub = stub
+}
+
+func (fake *FakeClient) DesiredLRPStatusArgsForCall(i int) (lager.Logger, string, models.DesiredLRPFilter) {
+	fake.desiredLRPStatusMutex.RLock()
+	defer fake.desiredLRPStatusMutex.RUnlock()
+	argsForCall := fake.desiredLRPStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) DesiredLRPStatusReturns(result1 []*models.DesiredLRPStatus, result2 error) {
+	fake.desiredLRPStatusMutex.Lock()
+	defer fake.desiredLRPStatusMutex.Unlock()
+	fake.DesiredLRPStatusStub = nil
+	fake.desiredLRPStatusReturns = struct {
+		result1 []*models.DesiredLRPStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) DesiredLRPStatusReturnsOnCall(i int, result1 []*models.DesiredLRPStatus, result2 error) {
+	fake.desiredLRPStatusMutex.Lock()
+	defer fake.desiredLRPStatusMutex.Unlock()
+	fake.DesiredLRPStatusStub = nil
+	if fake.desiredLRPStatusReturnsOnCall == nil {
+		fake.desiredLRPStatusReturnsOnCall = make(map[int]struct {
+			result1 []*models.DesiredLRPStatus
+			result2 error
+		})
+	}
+	fake.desiredLRPStatusReturnsOnCall[i] = struct {
+		result1 []*models.DesiredLRPStatus
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) DesiredLRPs(arg1 lager.Logger, arg2 string, arg3 models.DesiredLRPFilter) ([]*models.DesiredLRP, error) {
 	fake.desiredLRPsMutex.Lock()
 	ret, specificReturn := fake.desiredLRPsReturnsOnCall[len(fake.desiredLRPsArgsForCall)]
@@ -1368,6 +2923,137 @@ func (fake *FakeClient) DesiredLRPsReturnsOnCall(i int, result1 []*models.Desire
 	}{result1, result2}
 }
 
+func (fake *FakeClient) DomainQuotas(arg1 lager.Logger, arg2 string) ([]*models.DomainQuota, error) {
+	fake.domainQuotasMutex.Lock()
+	ret, specificReturn := fake.domainQuotasReturnsOnCall[len(fake.domainQuotasArgsForCall)]
+	fake.domainQuotasArgsForCall = append(fake.domainQuotasArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DomainQuotasStub
+	fakeReturns := fake.domainQuotasReturns
+	fake.recordInvocation("DomainQuotas", []interface{}{arg1, arg2})
+	fake.domainQuotasMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) DomainQuotasCallCount() int {
+	fake.domainQuotasMutex.RLock()
+	defer fake.domainQuotasMutex.RUnlock()
+	return len(fake.domainQuotasArgsForCall)
+}
+
+func (fake *FakeClient) DomainQuotasCalls(stub func(lager.Logger, string) ([]*models.DomainQuota, error)) {
+	fake.domainQuotasMutex.Lock()
+	defer fake.domainQuotasMutex.Unlock()
+	fake.DomainQuotasStub = stub
+}
+
+func (fake *FakeClient) DomainQuotasArgsForCall(i int) (lager.Logger, string) {
+	fake.domainQuotasMutex.RLock()
+	defer fake.domainQuotasMutex.RUnlock()
+	argsForCall := fake.domainQuotasArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) DomainQuotasReturns(result1 []*models.DomainQuota, result2 error) {
+	fake.domainQuotasMutex.Lock()
+	defer fake.domainQuotasMutex.Unlock()
+	fake.DomainQuotasStub = nil
+	fake.domainQuotasReturns = struct {
+		result1 []*models.DomainQuota
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) DomainQuotasReturnsOnCall(i int, result1 []*models.DomainQuota, result2 error) {
+	fake.domainQuotasMutex.Lock()
+	defer fake.domainQuotasMutex.Unlock()
+	fake.DomainQuotasStub = nil
+	if fake.domainQuotasReturnsOnCall == nil {
+		fake.domainQuotasReturnsOnCall = make(map[int]struct {
+			result1 []*models.DomainQuota
+			result2 error
+		})
+	}
+	fake.domainQuotasReturnsOnCall[i] = struct {
+		result1 []*models.DomainQuota
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) DomainUsage(arg1 lager.Logger, arg2 string, arg3 string) (*models.DomainUsage, error) {
+	fake.domainUsageMutex.Lock()
+	ret, specificReturn := fake.domainUsageReturnsOnCall[len(fake.domainUsageArgsForCall)]
+	fake.domainUsageArgsForCall = append(fake.domainUsageArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DomainUsageStub
+	fakeReturns := fake.domainUsageReturns
+	fake.recordInvocation("DomainUsage", []interface{}{arg1, arg2, arg3})
+	fake.domainUsageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) DomainUsageCallCount() int {
+	fake.domainUsageMutex.RLock()
+	defer fake.domainUsageMutex.RUnlock()
+	return len(fake.domainUsageArgsForCall)
+}
+
+func (fake *FakeClient) DomainUsageCalls(stub func(lager.Logger, string, string) (*models.DomainUsage, error)) {
+	fake.domainUsageMutex.Lock()
+	defer fake.domainUsageMutex.Unlock()
+	fake.DomainUsageStub = stub
+}
+
+func (fake *FakeClient) DomainUsageArgsForCall(i int) (lager.Logger, string, string) {
+	fake.domainUsageMutex.RLock()
+	defer fake.domainUsageMutex.RUnlock()
+	argsForCall := fake.domainUsageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) DomainUsageReturns(result1 *models.DomainUsage, result2 error) {
+	fake.domainUsageMutex.Lock()
+	defer fake.domainUsageMutex.Unlock()
+	fake.DomainUsageStub = nil
+	fake.domainUsageReturns = struct {
+		result1 *models.DomainUsage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) DomainUsageReturnsOnCall(i int, result1 *models.DomainUsage, result2 error) {
+	fake.domainUsageMutex.Lock()
+	defer fake.domainUsageMutex.Unlock()
+	fake.DomainUsageStub = nil
+	if fake.domainUsageReturnsOnCall == nil {
+		fake.domainUsageReturnsOnCall = make(map[int]struct {
+			result1 *models.DomainUsage
+			result2 error
+		})
+	}
+	fake.domainUsageReturnsOnCall[i] = struct {
+		result1 *models.DomainUsage
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) Domains(arg1 lager.Logger, arg2 string) ([]string, error) {
 	fake.domainsMutex.Lock()
 	ret, specificReturn := fake.domainsReturnsOnCall[len(fake.domainsArgsForCall)]
@@ -1433,6 +3119,132 @@ func (fake *FakeClient) DomainsReturnsOnCall(i int, result1 []string, result2 er
 	}{result1, result2}
 }
 
+func (fake *FakeClient) DrainCell(arg1 lager.Logger, arg2 string, arg3 string) error {
+	fake.drainCellMutex.Lock()
+	ret, specificReturn := fake.drainCellReturnsOnCall[len(fake.drainCellArgsForCall)]
+	fake.drainCellArgsForCall = append(fake.drainCellArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DrainCellStub
+	fakeReturns := fake.drainCellReturns
+	fake.recordInvocation("DrainCell", []interface{}{arg1, arg2, arg3})
+	fake.drainCellMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) DrainCellCallCount() int {
+	fake.drainCellMutex.RLock()
+	defer fake.drainCellMutex.RUnlock()
+	return len(fake.drainCellArgsForCall)
+}
+
+func (fake *FakeClient) DrainCellCalls(stub func(lager.Logger, string, string) error) {
+	fake.drainCellMutex.Lock()
+	defer fake.drainCellMutex.Unlock()
+	fake.DrainCellStub = stub
+}
+
+func (fake *FakeClient) DrainCellArgsForCall(i int) (lager.Logger, string, string) {
+	fake.drainCellMutex.RLock()
+	defer fake.drainCellMutex.RUnlock()
+	argsForCall := fake.drainCellArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) DrainCellReturns(result1 error) {
+	fake.drainCellMutex.Lock()
+	defer fake.drainCellMutex.Unlock()
+	fake.DrainCellStub = nil
+	fake.drainCellReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) DrainCellReturnsOnCall(i int, result1 error) {
+	fake.drainCellMutex.Lock()
+	defer fake.drainCellMutex.Unlock()
+	fake.DrainCellStub = nil
+	if fake.drainCellReturnsOnCall == nil {
+		fake.drainCellReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.drainCellReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) ExpireDomain(arg1 lager.Logger, arg2 string, arg3 string) error {
+	fake.expireDomainMutex.Lock()
+	ret, specificReturn := fake.expireDomainReturnsOnCall[len(fake.expireDomainArgsForCall)]
+	fake.expireDomainArgsForCall = append(fake.expireDomainArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ExpireDomainStub
+	fakeReturns := fake.expireDomainReturns
+	fake.recordInvocation("ExpireDomain", []interface{}{arg1, arg2, arg3})
+	fake.expireDomainMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) ExpireDomainCallCount() int {
+	fake.expireDomainMutex.RLock()
+	defer fake.expireDomainMutex.RUnlock()
+	return len(fake.expireDomainArgsForCall)
+}
+
+func (fake *FakeClient) ExpireDomainCalls(stub func(lager.Logger, string, string) error) {
+	fake.expireDomainMutex.Lock()
+	defer fake.expireDomainMutex.Unlock()
+	fake.ExpireDomainStub = stub
+}
+
+func (fake *FakeClient) ExpireDomainArgsForCall(i int) (lager.Logger, string, string) {
+	fake.expireDomainMutex.RLock()
+	defer fake.expireDomainMutex.RUnlock()
+	argsForCall := fake.expireDomainArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) ExpireDomainReturns(result1 error) {
+	fake.expireDomainMutex.Lock()
+	defer fake.expireDomainMutex.Unlock()
+	fake.ExpireDomainStub = nil
+	fake.expireDomainReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) ExpireDomainReturnsOnCall(i int, result1 error) {
+	fake.expireDomainMutex.Lock()
+	defer fake.expireDomainMutex.Unlock()
+	fake.ExpireDomainStub = nil
+	if fake.expireDomainReturnsOnCall == nil {
+		fake.expireDomainReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.expireDomainReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeClient) Ping(arg1 lager.Logger, arg2 string) bool {
 	fake.pingMutex.Lock()
 	ret, specificReturn := fake.pingReturnsOnCall[len(fake.pingArgsForCall)]
@@ -1607,16 +3419,143 @@ func (fake *FakeClient) ResolvingTaskReturns(result1 error) {
 	}{result1}
 }
 
-func (fake *FakeClient) ResolvingTaskReturnsOnCall(i int, result1 error) {
-	fake.resolvingTaskMutex.Lock()
-	defer fake.resolvingTaskMutex.Unlock()
-	fake.ResolvingTaskStub = nil
-	if fake.resolvingTaskReturnsOnCall == nil {
-		fake.resolvingTaskReturnsOnCall = make(map[int]struct {
+func (fake *FakeClient) ResolvingTaskReturnsOnCall(i int, result1 error) {
+	fake.resolvingTaskMutex.Lock()
+	defer fake.resolvingTaskMutex.Unlock()
+	fake.ResolvingTaskStub = nil
+	if fake.resolvingTaskReturnsOnCall == nil {
+		fake.resolvingTaskReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.resolvingTaskReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) RestartActualLRP(arg1 lager.Logger, arg2 string, arg3 *models.ActualLRPKey) error {
+	fake.restartActualLRPMutex.Lock()
+	ret, specificReturn := fake.restartActualLRPReturnsOnCall[len(fake.restartActualLRPArgsForCall)]
+	fake.restartActualLRPArgsForCall = append(fake.restartActualLRPArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 *models.ActualLRPKey
+	}{arg1, arg2, arg3})
+	stub := fake.RestartActualLRPStub
+	fakeReturns := fake.restartActualLRPReturns
+	fake.recordInvocation("RestartActualLRP", []interface{}{arg1, arg2, arg3})
+	fake.restartActualLRPMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) RestartActualLRPCallCount() int {
+	fake.restartActualLRPMutex.RLock()
+	defer fake.restartActualLRPMutex.RUnlock()
+	return len(fake.restartActualLRPArgsForCall)
+}
+
+func (fake *FakeClient) RestartActualLRPCalls(stub func(lager.Logger, string, *models.ActualLRPKey) error) {
+	fake.restartActualLRPMutex.Lock()
+	defer fake.restartActualLRPMutex.Unlock()
+	fake.RestartActualLRPStub = stub
+}
+
+func (fake *FakeClient) RestartActualLRPArgsForCall(i int) (lager.Logger, string, *models.ActualLRPKey) {
+	fake.restartActualLRPMutex.RLock()
+	defer fake.restartActualLRPMutex.RUnlock()
+	argsForCall := fake.restartActualLRPArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) RestartActualLRPReturns(result1 error) {
+	fake.restartActualLRPMutex.Lock()
+	defer fake.restartActualLRPMutex.Unlock()
+	fake.RestartActualLRPStub = nil
+	fake.restartActualLRPReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) RestartActualLRPReturnsOnCall(i int, result1 error) {
+	fake.restartActualLRPMutex.Lock()
+	defer fake.restartActualLRPMutex.Unlock()
+	fake.RestartActualLRPStub = nil
+	if fake.restartActualLRPReturnsOnCall == nil {
+		fake.restartActualLRPReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.restartActualLRPReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) RestartDesiredLRP(arg1 lager.Logger, arg2 string, arg3 string, arg4 int32) error {
+	fake.restartDesiredLRPMutex.Lock()
+	ret, specificReturn := fake.restartDesiredLRPReturnsOnCall[len(fake.restartDesiredLRPArgsForCall)]
+	fake.restartDesiredLRPArgsForCall = append(fake.restartDesiredLRPArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+		arg4 int32
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RestartDesiredLRPStub
+	fakeReturns := fake.restartDesiredLRPReturns
+	fake.recordInvocation("RestartDesiredLRP", []interface{}{arg1, arg2, arg3, arg4})
+	fake.restartDesiredLRPMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) RestartDesiredLRPCallCount() int {
+	fake.restartDesiredLRPMutex.RLock()
+	defer fake.restartDesiredLRPMutex.RUnlock()
+	return len(fake.restartDesiredLRPArgsForCall)
+}
+
+func (fake *FakeClient) RestartDesiredLRPCalls(stub func(lager.Logger, string, string, int32) error) {
+	fake.restartDesiredLRPMutex.Lock()
+	defer fake.restartDesiredLRPMutex.Unlock()
+	fake.RestartDesiredLRPStub = stub
+}
+
+func (fake *FakeClient) RestartDesiredLRPArgsForCall(i int) (lager.Logger, string, string, int32) {
+	fake.restartDesiredLRPMutex.RLock()
+	defer fake.restartDesiredLRPMutex.RUnlock()
+	argsForCall := fake.restartDesiredLRPArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeClient) RestartDesiredLRPReturns(result1 error) {
+	fake.restartDesiredLRPMutex.Lock()
+	defer fake.restartDesiredLRPMutex.Unlock()
+	fake.RestartDesiredLRPStub = nil
+	fake.restartDesiredLRPReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) RestartDesiredLRPReturnsOnCall(i int, result1 error) {
+	fake.restartDesiredLRPMutex.Lock()
+	defer fake.restartDesiredLRPMutex.Unlock()
+	fake.RestartDesiredLRPStub = nil
+	if fake.restartDesiredLRPReturnsOnCall == nil {
+		fake.restartDesiredLRPReturnsOnCall = make(map[int]struct {
 			result1 error
 		})
 	}
-	fake.resolvingTaskReturnsOnCall[i] = struct {
+	fake.restartDesiredLRPReturnsOnCall[i] = struct {
 		result1 error
 	}{result1}
 }
@@ -1684,6 +3623,202 @@ func (fake *FakeClient) RetireActualLRPReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeClient) RollbackDesiredLRP(arg1 lager.Logger, arg2 string, arg3 string, arg4 int32) error {
+	fake.rollbackDesiredLRPMutex.Lock()
+	ret, specificReturn := fake.rollbackDesiredLRPReturnsOnCall[len(fake.rollbackDesiredLRPArgsForCall)]
+	fake.rollbackDesiredLRPArgsForCall = append(fake.rollbackDesiredLRPArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+		arg4 int32
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RollbackDesiredLRPStub
+	fakeReturns := fake.rollbackDesiredLRPReturns
+	fake.recordInvocation("RollbackDesiredLRP", []interface{}{arg1, arg2, arg3, arg4})
+	fake.rollbackDesiredLRPMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) RollbackDesiredLRPCallCount() int {
+	fake.rollbackDesiredLRPMutex.RLock()
+	defer fake.rollbackDesiredLRPMutex.RUnlock()
+	return len(fake.rollbackDesiredLRPArgsForCall)
+}
+
+func (fake *FakeClient) RollbackDesiredLRPCalls(stub func(lager.Logger, string, string, int32) error) {
+	fake.rollbackDesiredLRPMutex.Lock()
+	defer fake.rollbackDesiredLRPMutex.Unlock()
+	fake.RollbackDesiredLRPStub = stub
+}
+
+func (fake *FakeClient) RollbackDesiredLRPArgsForCall(i int) (lager.Logger, string, string, int32) {
+	fake.rollbackDesiredLRPMutex.RLock()
+	defer fake.rollbackDesiredLRPMutex.RUnlock()
+	argsForCall := fake.rollbackDesiredLRPArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeClient) RollbackDesiredLRPReturns(result1 error) {
+	fake.rollbackDesiredLRPMutex.Lock()
+	defer fake.rollbackDesiredLRPMutex.Unlock()
+	fake.RollbackDesiredLRPStub = nil
+	fake.rollbackDesiredLRPReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) RollbackDesiredLRPReturnsOnCall(i int, result1 error) {
+	fake.rollbackDesiredLRPMutex.Lock()
+	defer fake.rollbackDesiredLRPMutex.Unlock()
+	fake.RollbackDesiredLRPStub = nil
+	if fake.rollbackDesiredLRPReturnsOnCall == nil {
+		fake.rollbackDesiredLRPReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.rollbackDesiredLRPReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) ScheduledTaskByGuid(arg1 lager.Logger, arg2 string, arg3 string) (*models.ScheduledTask, error) {
+	fake.scheduledTaskByGuidMutex.Lock()
+	ret, specificReturn := fake.scheduledTaskByGuidReturnsOnCall[len(fake.scheduledTaskByGuidArgsForCall)]
+	fake.scheduledTaskByGuidArgsForCall = append(fake.scheduledTaskByGuidArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ScheduledTaskByGuidStub
+	fakeReturns := fake.scheduledTaskByGuidReturns
+	fake.recordInvocation("ScheduledTaskByGuid", []interface{}{arg1, arg2, arg3})
+	fake.scheduledTaskByGuidMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ScheduledTaskByGuidCallCount() int {
+	fake.scheduledTaskByGuidMutex.RLock()
+	defer fake.scheduledTaskByGuidMutex.RUnlock()
+	return len(fake.scheduledTaskByGuidArgsForCall)
+}
+
+func (fake *FakeClient) ScheduledTaskByGuidCalls(stub func(lager.Logger, string, string) (*models.ScheduledTask, error)) {
+	fake.scheduledTaskByGuidMutex.Lock()
+	defer fake.scheduledTaskByGuidMutex.Unlock()
+	fake.ScheduledTaskByGuidStub = stub
+}
+
+func (fake *FakeClient) ScheduledTaskByGuidArgsForCall(i int) (lager.Logger, string, string) {
+	fake.scheduledTaskByGuidMutex.RLock()
+	defer fake.scheduledTaskByGuidMutex.RUnlock()
+	argsForCall := fake.scheduledTaskByGuidArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) ScheduledTaskByGuidReturns(result1 *models.ScheduledTask, result2 error) {
+	fake.scheduledTaskByGuidMutex.Lock()
+	defer fake.scheduledTaskByGuidMutex.Unlock()
+	fake.ScheduledTaskByGuidStub = nil
+	fake.scheduledTaskByGuidReturns = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ScheduledTaskByGuidReturnsOnCall(i int, result1 *models.ScheduledTask, result2 error) {
+	fake.scheduledTaskByGuidMutex.Lock()
+	defer fake.scheduledTaskByGuidMutex.Unlock()
+	fake.ScheduledTaskByGuidStub = nil
+	if fake.scheduledTaskByGuidReturnsOnCall == nil {
+		fake.scheduledTaskByGuidReturnsOnCall = make(map[int]struct {
+			result1 *models.ScheduledTask
+			result2 error
+		})
+	}
+	fake.scheduledTaskByGuidReturnsOnCall[i] = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ScheduledTasks(arg1 lager.Logger, arg2 string, arg3 string) ([]*models.ScheduledTask, error) {
+	fake.scheduledTasksMutex.Lock()
+	ret, specificReturn := fake.scheduledTasksReturnsOnCall[len(fake.scheduledTasksArgsForCall)]
+	fake.scheduledTasksArgsForCall = append(fake.scheduledTasksArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ScheduledTasksStub
+	fakeReturns := fake.scheduledTasksReturns
+	fake.recordInvocation("ScheduledTasks", []interface{}{arg1, arg2, arg3})
+	fake.scheduledTasksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ScheduledTasksCallCount() int {
+	fake.scheduledTasksMutex.RLock()
+	defer fake.scheduledTasksMutex.RUnlock()
+	return len(fake.scheduledTasksArgsForCall)
+}
+
+func (fake *FakeClient) ScheduledTasksCalls(stub func(lager.Logger, string, string) ([]*models.ScheduledTask, error)) {
+	fake.scheduledTasksMutex.Lock()
+	defer fake.scheduledTasksMutex.Unlock()
+	fake.ScheduledTasksStub = stub
+}
+
+func (fake *FakeClient) ScheduledTasksArgsForCall(i int) (lager.Logger, string, string) {
+	fake.scheduledTasksMutex.RLock()
+	defer fake.scheduledTasksMutex.RUnlock()
+	argsForCall := fake.scheduledTasksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) ScheduledTasksReturns(result1 []*models.ScheduledTask, result2 error) {
+	fake.scheduledTasksMutex.Lock()
+	defer fake.scheduledTasksMutex.Unlock()
+	fake.ScheduledTasksStub = nil
+	fake.scheduledTasksReturns = struct {
+		result1 []*models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ScheduledTasksReturnsOnCall(i int, result1 []*models.ScheduledTask, result2 error) {
+	fake.scheduledTasksMutex.Lock()
+	defer fake.scheduledTasksMutex.Unlock()
+	fake.ScheduledTasksStub = nil
+	if fake.scheduledTasksReturnsOnCall == nil {
+		fake.scheduledTasksReturnsOnCall = make(map[int]struct {
+			result1 []*models.ScheduledTask
+			result2 error
+		})
+	}
+	fake.scheduledTasksReturnsOnCall[i] = struct {
+		result1 []*models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) SubscribeToEvents(arg1 lager.Logger) (events.EventSource, error) {
 	fake.subscribeToEventsMutex.Lock()
 	ret, specificReturn := fake.subscribeToEventsReturnsOnCall[len(fake.subscribeToEventsArgsForCall)]
@@ -2006,6 +4141,72 @@ func (fake *FakeClient) SubscribeToTaskEventsReturnsOnCall(i int, result1 events
 	}{result1, result2}
 }
 
+func (fake *FakeClient) TaskArrayStatus(arg1 lager.Logger, arg2 string, arg3 string) (*models.TaskArrayStatus, error) {
+	fake.taskArrayStatusMutex.Lock()
+	ret, specificReturn := fake.taskArrayStatusReturnsOnCall[len(fake.taskArrayStatusArgsForCall)]
+	fake.taskArrayStatusArgsForCall = append(fake.taskArrayStatusArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.TaskArrayStatusStub
+	fakeReturns := fake.taskArrayStatusReturns
+	fake.recordInvocation("TaskArrayStatus", []interface{}{arg1, arg2, arg3})
+	fake.taskArrayStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) TaskArrayStatusCallCount() int {
+	fake.taskArrayStatusMutex.RLock()
+	defer fake.taskArrayStatusMutex.RUnlock()
+	return len(fake.taskArrayStatusArgsForCall)
+}
+
+func (fake *FakeClient) TaskArrayStatusCalls(stub func(lager.Logger, string, string) (*models.TaskArrayStatus, error)) {
+	fake.taskArrayStatusMutex.Lock()
+	defer fake.taskArrayStatusMutex.Unlock()
+	fake.TaskArrayStatusStub = stub
+}
+
+func (fake *FakeClient) TaskArrayStatusArgsForCall(i int) (lager.Logger, string, string) {
+	fake.taskArrayStatusMutex.RLock()
+	defer fake.taskArrayStatusMutex.RUnlock()
+	argsForCall := fake.taskArrayStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) TaskArrayStatusReturns(result1 *models.TaskArrayStatus, result2 error) {
+	fake.taskArrayStatusMutex.Lock()
+	defer fake.taskArrayStatusMutex.Unlock()
+	fake.TaskArrayStatusStub = nil
+	fake.taskArrayStatusReturns = struct {
+		result1 *models.TaskArrayStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) TaskArrayStatusReturnsOnCall(i int, result1 *models.TaskArrayStatus, result2 error) {
+	fake.taskArrayStatusMutex.Lock()
+	defer fake.taskArrayStatusMutex.Unlock()
+	fake.TaskArrayStatusStub = nil
+	if fake.taskArrayStatusReturnsOnCall == nil {
+		fake.taskArrayStatusReturnsOnCall = make(map[int]struct {
+			result1 *models.TaskArrayStatus
+			result2 error
+		})
+	}
+	fake.taskArrayStatusReturnsOnCall[i] = struct {
+		result1 *models.TaskArrayStatus
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) TaskByGuid(arg1 lager.Logger, arg2 string, arg3 string) (*models.Task, error) {
 	fake.taskByGuidMutex.Lock()
 	ret, specificReturn := fake.taskByGuidReturnsOnCall[len(fake.taskByGuidArgsForCall)]
@@ -2072,6 +4273,72 @@ func (fake *FakeClient) TaskByGuidReturnsOnCall(i int, result1 *models.Task, res
 	}{result1, result2}
 }
 
+func (fake *FakeClient) TaskResult(arg1 lager.Logger, arg2 string, arg3 string) (string, error) {
+	fake.taskResultMutex.Lock()
+	ret, specificReturn := fake.taskResultReturnsOnCall[len(fake.taskResultArgsForCall)]
+	fake.taskResultArgsForCall = append(fake.taskResultArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.TaskResultStub
+	fakeReturns := fake.taskResultReturns
+	fake.recordInvocation("TaskResult", []interface{}{arg1, arg2, arg3})
+	fake.taskResultMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) TaskResultCallCount() int {
+	fake.taskResultMutex.RLock()
+	defer fake.taskResultMutex.RUnlock()
+	return len(fake.taskResultArgsForCall)
+}
+
+func (fake *FakeClient) TaskResultCalls(stub func(lager.Logger, string, string) (string, error)) {
+	fake.taskResultMutex.Lock()
+	defer fake.taskResultMutex.Unlock()
+	fake.TaskResultStub = stub
+}
+
+func (fake *FakeClient) TaskResultArgsForCall(i int) (lager.Logger, string, string) {
+	fake.taskResultMutex.RLock()
+	defer fake.taskResultMutex.RUnlock()
+	argsForCall := fake.taskResultArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) TaskResultReturns(result1 string, result2 error) {
+	fake.taskResultMutex.Lock()
+	defer fake.taskResultMutex.Unlock()
+	fake.TaskResultStub = nil
+	fake.taskResultReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) TaskResultReturnsOnCall(i int, result1 string, result2 error) {
+	fake.taskResultMutex.Lock()
+	defer fake.taskResultMutex.Unlock()
+	fake.TaskResultStub = nil
+	if fake.taskResultReturnsOnCall == nil {
+		fake.taskResultReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.taskResultReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) Tasks(arg1 lager.Logger, arg2 string) ([]*models.Task, error) {
 	fake.tasksMutex.Lock()
 	ret, specificReturn := fake.tasksReturnsOnCall[len(fake.tasksArgsForCall)]
@@ -2335,6 +4602,69 @@ func (fake *FakeClient) TasksWithFilterReturnsOnCall(i int, result1 []*models.Ta
 	}{result1, result2}
 }
 
+func (fake *FakeClient) UncordonCell(arg1 lager.Logger, arg2 string, arg3 string) error {
+	fake.uncordonCellMutex.Lock()
+	ret, specificReturn := fake.uncordonCellReturnsOnCall[len(fake.uncordonCellArgsForCall)]
+	fake.uncordonCellArgsForCall = append(fake.uncordonCellArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.UncordonCellStub
+	fakeReturns := fake.uncordonCellReturns
+	fake.recordInvocation("UncordonCell", []interface{}{arg1, arg2, arg3})
+	fake.uncordonCellMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) UncordonCellCallCount() int {
+	fake.uncordonCellMutex.RLock()
+	defer fake.uncordonCellMutex.RUnlock()
+	return len(fake.uncordonCellArgsForCall)
+}
+
+func (fake *FakeClient) UncordonCellCalls(stub func(lager.Logger, string, string) error) {
+	fake.uncordonCellMutex.Lock()
+	defer fake.uncordonCellMutex.Unlock()
+	fake.UncordonCellStub = stub
+}
+
+func (fake *FakeClient) UncordonCellArgsForCall(i int) (lager.Logger, string, string) {
+	fake.uncordonCellMutex.RLock()
+	defer fake.uncordonCellMutex.RUnlock()
+	argsForCall := fake.uncordonCellArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) UncordonCellReturns(result1 error) {
+	fake.uncordonCellMutex.Lock()
+	defer fake.uncordonCellMutex.Unlock()
+	fake.UncordonCellStub = nil
+	fake.uncordonCellReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) UncordonCellReturnsOnCall(i int, result1 error) {
+	fake.uncordonCellMutex.Lock()
+	defer fake.uncordonCellMutex.Unlock()
+	fake.UncordonCellStub = nil
+	if fake.uncordonCellReturnsOnCall == nil {
+		fake.uncordonCellReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.uncordonCellReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeClient) UpdateDesiredLRP(arg1 lager.Logger, arg2 string, arg3 string, arg4 *models.DesiredLRPUpdate) error {
 	fake.updateDesiredLRPMutex.Lock()
 	ret, specificReturn := fake.updateDesiredLRPReturnsOnCall[len(fake.updateDesiredLRPArgsForCall)]
@@ -2399,6 +4729,69 @@ func (fake *FakeClient) UpdateDesiredLRPReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeClient) UpdateScheduledTask(arg1 lager.Logger, arg2 string, arg3 *models.ScheduledTask) error {
+	fake.updateScheduledTaskMutex.Lock()
+	ret, specificReturn := fake.updateScheduledTaskReturnsOnCall[len(fake.updateScheduledTaskArgsForCall)]
+	fake.updateScheduledTaskArgsForCall = append(fake.updateScheduledTaskArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 *models.ScheduledTask
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateScheduledTaskStub
+	fakeReturns := fake.updateScheduledTaskReturns
+	fake.recordInvocation("UpdateScheduledTask", []interface{}{arg1, arg2, arg3})
+	fake.updateScheduledTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) UpdateScheduledTaskCallCount() int {
+	fake.updateScheduledTaskMutex.RLock()
+	defer fake.updateScheduledTaskMutex.RUnlock()
+	return len(fake.updateScheduledTaskArgsForCall)
+}
+
+func (fake *FakeClient) UpdateScheduledTaskCalls(stub func(lager.Logger, string, *models.ScheduledTask) error) {
+	fake.updateScheduledTaskMutex.Lock()
+	defer fake.updateScheduledTaskMutex.Unlock()
+	fake.UpdateScheduledTaskStub = stub
+}
+
+func (fake *FakeClient) UpdateScheduledTaskArgsForCall(i int) (lager.Logger, string, *models.ScheduledTask) {
+	fake.updateScheduledTaskMutex.RLock()
+	defer fake.updateScheduledTaskMutex.RUnlock()
+	argsForCall := fake.updateScheduledTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) UpdateScheduledTaskReturns(result1 error) {
+	fake.updateScheduledTaskMutex.Lock()
+	defer fake.updateScheduledTaskMutex.Unlock()
+	fake.UpdateScheduledTaskStub = nil
+	fake.updateScheduledTaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) UpdateScheduledTaskReturnsOnCall(i int, result1 error) {
+	fake.updateScheduledTaskMutex.Lock()
+	defer fake.updateScheduledTaskMutex.Unlock()
+	fake.UpdateScheduledTaskStub = nil
+	if fake.updateScheduledTaskReturnsOnCall == nil {
+		fake.updateScheduledTaskReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateScheduledTaskReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeClient) UpsertDomain(arg1 lager.Logger, arg2 string, arg3 string, arg4 time.Duration) error {
 	fake.upsertDomainMutex.Lock()
 	ret, specificReturn := fake.upsertDomainReturnsOnCall[len(fake.upsertDomainArgsForCall)]
@@ -2463,9 +4856,74 @@ func (fake *FakeClient) UpsertDomainReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeClient) UpsertDomainQuota(arg1 lager.Logger, arg2 string, arg3 *models.DomainQuota) error {
+	fake.upsertDomainQuotaMutex.Lock()
+	ret, specificReturn := fake.upsertDomainQuotaReturnsOnCall[len(fake.upsertDomainQuotaArgsForCall)]
+	fake.upsertDomainQuotaArgsForCall = append(fake.upsertDomainQuotaArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 *models.DomainQuota
+	}{arg1, arg2, arg3})
+	stub := fake.UpsertDomainQuotaStub
+	fakeReturns := fake.upsertDomainQuotaReturns
+	fake.recordInvocation("UpsertDomainQuota", []interface{}{arg1, arg2, arg3})
+	fake.upsertDomainQuotaMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) UpsertDomainQuotaCallCount() int {
+	fake.upsertDomainQuotaMutex.RLock()
+	defer fake.upsertDomainQuotaMutex.RUnlock()
+	return len(fake.upsertDomainQuotaArgsForCall)
+}
+
+func (fake *FakeClient) UpsertDomainQuotaCalls(stub func(lager.Logger, string, *models.DomainQuota) error) {
+	fake.upsertDomainQuotaMutex.Lock()
+	defer fake.upsertDomainQuotaMutex.Unlock()
+	fake.UpsertDomainQuotaStub = stub
+}
+
+func (fake *FakeClient) UpsertDomainQuotaArgsForCall(i int) (lager.Logger, string, *models.DomainQuota) {
+	fake.upsertDomainQuotaMutex.RLock()
+	defer fake.upsertDomainQuotaMutex.RUnlock()
+	argsForCall := fake.upsertDomainQuotaArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) UpsertDomainQuotaReturns(result1 error) {
+	fake.upsertDomainQuotaMutex.Lock()
+	defer fake.upsertDomainQuotaMutex.Unlock()
+	fake.UpsertDomainQuotaStub = nil
+	fake.upsertDomainQuotaReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) UpsertDomainQuotaReturnsOnCall(i int, result1 error) {
+	fake.upsertDomainQuotaMutex.Lock()
+	defer fake.upsertDomainQuotaMutex.Unlock()
+	fake.UpsertDomainQuotaStub = nil
+	if fake.upsertDomainQuotaReturnsOnCall == nil {
+		fake.upsertDomainQuotaReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.upsertDomainQuotaReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.actualLRPCrashesMutex.RLock()
+	defer fake.actualLRPCrashesMutex.RUnlock()
 	fake.actualLRPGroupByProcessGuidAndIndexMutex.RLock()
 	defer fake.actualLRPGroupByProcessGuidAndIndexMutex.RUnlock()
 	fake.actualLRPGroupsMutex.RLock()
@@ -2474,36 +4932,86 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.actualLRPGroupsByProcessGuidMutex.RUnlock()
 	fake.actualLRPsMutex.RLock()
 	defer fake.actualLRPsMutex.RUnlock()
+	fake.actualLRPsWithCrashHistoryMutex.RLock()
+	defer fake.actualLRPsWithCrashHistoryMutex.RUnlock()
+	fake.allDomainsMutex.RLock()
+	defer fake.allDomainsMutex.RUnlock()
+	fake.archivedTaskByGuidMutex.RLock()
+	defer fake.archivedTaskByGuidMutex.RUnlock()
+	fake.archivedTasksMutex.RLock()
+	defer fake.archivedTasksMutex.RUnlock()
 	fake.cancelTaskMutex.RLock()
 	defer fake.cancelTaskMutex.RUnlock()
+	fake.cancelTaskArrayMutex.RLock()
+	defer fake.cancelTaskArrayMutex.RUnlock()
+	fake.capacityReportMutex.RLock()
+	defer fake.capacityReportMutex.RUnlock()
+	fake.cellDrainStatusMutex.RLock()
+	defer fake.cellDrainStatusMutex.RUnlock()
 	fake.cellsMutex.RLock()
 	defer fake.cellsMutex.RUnlock()
+	fake.cordonCellMutex.RLock()
+	defer fake.cordonCellMutex.RUnlock()
+	fake.deleteDomainMutex.RLock()
+	defer fake.deleteDomainMutex.RUnlock()
+	fake.deleteDomainQuotaMutex.RLock()
+	defer fake.deleteDomainQuotaMutex.RUnlock()
+	fake.deleteScheduledTaskMutex.RLock()
+	defer fake.deleteScheduledTaskMutex.RUnlock()
 	fake.deleteTaskMutex.RLock()
 	defer fake.deleteTaskMutex.RUnlock()
 	fake.desireLRPMutex.RLock()
 	defer fake.desireLRPMutex.RUnlock()
+	fake.desireScheduledTaskMutex.RLock()
+	defer fake.desireScheduledTaskMutex.RUnlock()
 	fake.desireTaskMutex.RLock()
 	defer fake.desireTaskMutex.RUnlock()
+	fake.desireTaskArrayMutex.RLock()
+	defer fake.desireTaskArrayMutex.RUnlock()
 	fake.desiredLRPByProcessGuidMutex.RLock()
 	defer fake.desiredLRPByProcessGuidMutex.RUnlock()
+	fake.desiredLRPRevisionDiffMutex.RLock()
+	defer fake.desiredLRPRevisionDiffMutex.RUnlock()
+	fake.desiredLRPRevisionsMutex.RLock()
+	defer fake.desiredLRPRevisionsMutex.RUnlock()
 	fake.desiredLRPRoutingInfosMutex.RLock()
 	defer fake.desiredLRPRoutingInfosMutex.RUnlock()
 	fake.desiredLRPSchedulingInfoByProcessGuidMutex.RLock()
 	defer fake.desiredLRPSchedulingInfoByProcessGuidMutex.RUnlock()
 	fake.desiredLRPSchedulingInfosMutex.RLock()
 	defer fake.desiredLRPSchedulingInfosMutex.RUnlock()
+	fake.desiredLRPStatusMutex.RLock()
+	defer fake.desiredLRPStatusMutex.RUnlock()
 	fake.desiredLRPsMutex.RLock()
 	defer fake.desiredLRPsMutex.RUnlock()
+	fake.domainQuotasMutex.RLock()
+	defer fake.domainQuotasMutex.RUnlock()
+	fake.domainUsageMutex.RLock()
+	defer fake.domainUsageMutex.RUnlock()
 	fake.domainsMutex.RLock()
 	defer fake.domainsMutex.RUnlock()
+	fake.drainCellMutex.RLock()
+	defer fake.drainCellMutex.RUnlock()
+	fake.expireDomainMutex.RLock()
+	defer fake.expireDomainMutex.RUnlock()
 	fake.pingMutex.RLock()
 	defer fake.pingMutex.RUnlock()
 	fake.removeDesiredLRPMutex.RLock()
 	defer fake.removeDesiredLRPMutex.RUnlock()
 	fake.resolvingTaskMutex.RLock()
 	defer fake.resolvingTaskMutex.RUnlock()
+	fake.restartActualLRPMutex.RLock()
+	defer fake.restartActualLRPMutex.RUnlock()
+	fake.restartDesiredLRPMutex.RLock()
+	defer fake.restartDesiredLRPMutex.RUnlock()
 	fake.retireActualLRPMutex.RLock()
 	defer fake.retireActualLRPMutex.RUnlock()
+	fake.rollbackDesiredLRPMutex.RLock()
+	defer fake.rollbackDesiredLRPMutex.RUnlock()
+	fake.scheduledTaskByGuidMutex.RLock()
+	defer fake.scheduledTaskByGuidMutex.RUnlock()
+	fake.scheduledTasksMutex.RLock()
+	defer fake.scheduledTasksMutex.RUnlock()
 	fake.subscribeToEventsMutex.RLock()
 	defer fake.subscribeToEventsMutex.RUnlock()
 	fake.subscribeToEventsByCellIDMutex.RLock()
@@ -2514,8 +5022,12 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.subscribeToInstanceEventsByCellIDMutex.RUnlock()
 	fake.subscribeToTaskEventsMutex.RLock()
 	defer fake.subscribeToTaskEventsMutex.RUnlock()
+	fake.taskArrayStatusMutex.RLock()
+	defer fake.taskArrayStatusMutex.RUnlock()
 	fake.taskByGuidMutex.RLock()
 	defer fake.taskByGuidMutex.RUnlock()
+	fake.taskResultMutex.RLock()
+	defer fake.taskResultMutex.RUnlock()
 	fake.tasksMutex.RLock()
 	defer fake.tasksMutex.RUnlock()
 	fake.tasksByCellIDMutex.RLock()
@@ -2524,10 +5036,16 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.tasksByDomainMutex.RUnlock()
 	fake.tasksWithFilterMutex.RLock()
 	defer fake.tasksWithFilterMutex.RUnlock()
+	fake.uncordonCellMutex.RLock()
+	defer fake.uncordonCellMutex.RUnlock()
 	fake.updateDesiredLRPMutex.RLock()
 	defer fake.updateDesiredLRPMutex.RUnlock()
+	fake.updateScheduledTaskMutex.RLock()
+	defer fake.updateScheduledTaskMutex.RUnlock()
 	fake.upsertDomainMutex.RLock()
 	defer fake.upsertDomainMutex.RUnlock()
+	fake.upsertDomainQuotaMutex.RLock()
+	defer fake.upsertDomainQuotaMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -12,6 +12,22 @@ import (
 )
 
 type FakeInternalClient struct {
+	ActualLRPCrashesStub        func(lager.Logger, string, string, int32) ([]*models.ActualLRPCrash, error)
+	actualLRPCrashesMutex       sync.RWMutex
+	actualLRPCrashesArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+		arg4 int32
+	}
+	actualLRPCrashesReturns struct {
+		result1 []*models.ActualLRPCrash
+		result2 error
+	}
+	actualLRPCrashesReturnsOnCall map[int]struct {
+		result1 []*models.ActualLRPCrash
+		result2 error
+	}
 	ActualLRPGroupByProcessGuidAndIndexStub        func(lager.Logger, string, string, int) (*models.ActualLRPGroup, error)
 	actualLRPGroupByProcessGuidAndIndexMutex       sync.RWMutex
 	actualLRPGroupByProcessGuidAndIndexArgsForCall []struct {
@@ -73,6 +89,69 @@ type FakeInternalClient struct {
 		result1 []*models.ActualLRP
 		result2 error
 	}
+	ActualLRPsWithCrashHistoryStub        func(lager.Logger, string, models.ActualLRPFilter) ([]*models.ActualLRP, []*models.ActualLRPCrash, error)
+	actualLRPsWithCrashHistoryMutex       sync.RWMutex
+	actualLRPsWithCrashHistoryArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 models.ActualLRPFilter
+	}
+	actualLRPsWithCrashHistoryReturns struct {
+		result1 []*models.ActualLRP
+		result2 []*models.ActualLRPCrash
+		result3 error
+	}
+	actualLRPsWithCrashHistoryReturnsOnCall map[int]struct {
+		result1 []*models.ActualLRP
+		result2 []*models.ActualLRPCrash
+		result3 error
+	}
+	AllDomainsStub        func(lager.Logger, string) ([]*models.Domain, error)
+	allDomainsMutex       sync.RWMutex
+	allDomainsArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+	}
+	allDomainsReturns struct {
+		result1 []*models.Domain
+		result2 error
+	}
+	allDomainsReturnsOnCall map[int]struct {
+		result1 []*models.Domain
+		result2 error
+	}
+	ArchivedTaskByGuidStub        func(lager.Logger, string, string) (*models.ArchivedTask, error)
+	archivedTaskByGuidMutex       sync.RWMutex
+	archivedTaskByGuidArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	archivedTaskByGuidReturns struct {
+		result1 *models.ArchivedTask
+		result2 error
+	}
+	archivedTaskByGuidReturnsOnCall map[int]struct {
+		result1 *models.ArchivedTask
+		result2 error
+	}
+	ArchivedTasksStub        func(lager.Logger, string, models.TaskArchiveFilter) ([]*models.ArchivedTask, string, error)
+	archivedTasksMutex       sync.RWMutex
+	archivedTasksArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 models.TaskArchiveFilter
+	}
+	archivedTasksReturns struct {
+		result1 []*models.ArchivedTask
+		result2 string
+		result3 error
+	}
+	archivedTasksReturnsOnCall map[int]struct {
+		result1 []*models.ArchivedTask
+		result2 string
+		result3 error
+	}
 	CancelTaskStub        func(lager.Logger, string, string) error
 	cancelTaskMutex       sync.RWMutex
 	cancelTaskArgsForCall []struct {
@@ -86,6 +165,48 @@ type FakeInternalClient struct {
 	cancelTaskReturnsOnCall map[int]struct {
 		result1 error
 	}
+	CancelTaskArrayStub        func(lager.Logger, string, string) error
+	cancelTaskArrayMutex       sync.RWMutex
+	cancelTaskArrayArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	cancelTaskArrayReturns struct {
+		result1 error
+	}
+	cancelTaskArrayReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CapacityReportStub        func(lager.Logger, string) (*models.CapacityReport, error)
+	capacityReportMutex       sync.RWMutex
+	capacityReportArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+	}
+	capacityReportReturns struct {
+		result1 *models.CapacityReport
+		result2 error
+	}
+	capacityReportReturnsOnCall map[int]struct {
+		result1 *models.CapacityReport
+		result2 error
+	}
+	CellDrainStatusStub        func(lager.Logger, string, string) (*models.CellDrainStatus, error)
+	cellDrainStatusMutex       sync.RWMutex
+	cellDrainStatusArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	cellDrainStatusReturns struct {
+		result1 *models.CellDrainStatus
+		result2 error
+	}
+	cellDrainStatusReturnsOnCall map[int]struct {
+		result1 *models.CellDrainStatus
+		result2 error
+	}
 	CellsStub        func(lager.Logger, string) ([]*models.CellPresence, error)
 	cellsMutex       sync.RWMutex
 	cellsArgsForCall []struct {
@@ -131,6 +252,19 @@ type FakeInternalClient struct {
 	completeTaskReturnsOnCall map[int]struct {
 		result1 error
 	}
+	CordonCellStub        func(lager.Logger, string, string) error
+	cordonCellMutex       sync.RWMutex
+	cordonCellArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	cordonCellReturns struct {
+		result1 error
+	}
+	cordonCellReturnsOnCall map[int]struct {
+		result1 error
+	}
 	CrashActualLRPStub        func(lager.Logger, string, *models.ActualLRPKey, *models.ActualLRPInstanceKey, string) error
 	crashActualLRPMutex       sync.RWMutex
 	crashActualLRPArgsForCall []struct {
@@ -146,6 +280,45 @@ type FakeInternalClient struct {
 	crashActualLRPReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DeleteDomainStub        func(lager.Logger, string, string) error
+	deleteDomainMutex       sync.RWMutex
+	deleteDomainArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	deleteDomainReturns struct {
+		result1 error
+	}
+	deleteDomainReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteDomainQuotaStub        func(lager.Logger, string, string) error
+	deleteDomainQuotaMutex       sync.RWMutex
+	deleteDomainQuotaArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	deleteDomainQuotaReturns struct {
+		result1 error
+	}
+	deleteDomainQuotaReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteScheduledTaskStub        func(lager.Logger, string, string) error
+	deleteScheduledTaskMutex       sync.RWMutex
+	deleteScheduledTaskArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	deleteScheduledTaskReturns struct {
+		result1 error
+	}
+	deleteScheduledTaskReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DeleteTaskStub        func(lager.Logger, string, string) error
 	deleteTaskMutex       sync.RWMutex
 	deleteTaskArgsForCall []struct {
@@ -172,6 +345,19 @@ type FakeInternalClient struct {
 	desireLRPReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DesireScheduledTaskStub        func(lager.Logger, string, *models.ScheduledTask) error
+	desireScheduledTaskMutex       sync.RWMutex
+	desireScheduledTaskArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 *models.ScheduledTask
+	}
+	desireScheduledTaskReturns struct {
+		result1 error
+	}
+	desireScheduledTaskReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DesireTaskStub        func(lager.Logger, string, string, string, *models.TaskDefinition) error
 	desireTaskMutex       sync.RWMutex
 	desireTaskArgsForCall []struct {
@@ -187,6 +373,22 @@ type FakeInternalClient struct {
 	desireTaskReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DesireTaskArrayStub        func(lager.Logger, string, string, string, int32, *models.TaskDefinition) error
+	desireTaskArrayMutex       sync.RWMutex
+	desireTaskArrayArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 int32
+		arg6 *models.TaskDefinition
+	}
+	desireTaskArrayReturns struct {
+		result1 error
+	}
+	desireTaskArrayReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DesiredLRPByProcessGuidStub        func(lager.Logger, string, string) (*models.DesiredLRP, error)
 	desiredLRPByProcessGuidMutex       sync.RWMutex
 	desiredLRPByProcessGuidArgsForCall []struct {
@@ -202,6 +404,38 @@ type FakeInternalClient struct {
 		result1 *models.DesiredLRP
 		result2 error
 	}
+	DesiredLRPRevisionDiffStub        func(lager.Logger, string, string, int32, int32) ([]*models.DesiredLRPRevisionChange, error)
+	desiredLRPRevisionDiffMutex       sync.RWMutex
+	desiredLRPRevisionDiffArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+		arg4 int32
+		arg5 int32
+	}
+	desiredLRPRevisionDiffReturns struct {
+		result1 []*models.DesiredLRPRevisionChange
+		result2 error
+	}
+	desiredLRPRevisionDiffReturnsOnCall map[int]struct {
+		result1 []*models.DesiredLRPRevisionChange
+		result2 error
+	}
+	DesiredLRPRevisionsStub        func(lager.Logger, string, string) ([]*models.DesiredLRPRevision, error)
+	desiredLRPRevisionsMutex       sync.RWMutex
+	desiredLRPRevisionsArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	desiredLRPRevisionsReturns struct {
+		result1 []*models.DesiredLRPRevision
+		result2 error
+	}
+	desiredLRPRevisionsReturnsOnCall map[int]struct {
+		result1 []*models.DesiredLRPRevision
+		result2 error
+	}
 	DesiredLRPRoutingInfosStub        func(lager.Logger, string, models.DesiredLRPFilter) ([]*models.DesiredLRP, error)
 	desiredLRPRoutingInfosMutex       sync.RWMutex
 	desiredLRPRoutingInfosArgsForCall []struct {
@@ -247,6 +481,21 @@ type FakeInternalClient struct {
 		result1 []*models.DesiredLRPSchedulingInfo
 		result2 error
 	}
+	DesiredLRPStatusStub        func(lager.Logger, string, models.DesiredLRPFilter) ([]*models.DesiredLRPStatus, error)
+	desiredLRPStatusMutex       sync.RWMutex
+	desiredLRPStatusArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 models.DesiredLRPFilter
+	}
+	desiredLRPStatusReturns struct {
+		result1 []*models.DesiredLRPStatus
+		result2 error
+	}
+	desiredLRPStatusReturnsOnCall map[int]struct {
+		result1 []*models.DesiredLRPStatus
+		result2 error
+	}
 	DesiredLRPsStub        func(lager.Logger, string, models.DesiredLRPFilter) ([]*models.DesiredLRP, error)
 	desiredLRPsMutex       sync.RWMutex
 	desiredLRPsArgsForCall []struct {
@@ -262,6 +511,35 @@ type FakeInternalClient struct {
 		result1 []*models.DesiredLRP
 		result2 error
 	}
+	DomainQuotasStub        func(lager.Logger, string) ([]*models.DomainQuota, error)
+	domainQuotasMutex       sync.RWMutex
+	domainQuotasArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+	}
+	domainQuotasReturns struct {
+		result1 []*models.DomainQuota
+		result2 error
+	}
+	domainQuotasReturnsOnCall map[int]struct {
+		result1 []*models.DomainQuota
+		result2 error
+	}
+	DomainUsageStub        func(lager.Logger, string, string) (*models.DomainUsage, error)
+	domainUsageMutex       sync.RWMutex
+	domainUsageArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	domainUsageReturns struct {
+		result1 *models.DomainUsage
+		result2 error
+	}
+	domainUsageReturnsOnCall map[int]struct {
+		result1 *models.DomainUsage
+		result2 error
+	}
 	DomainsStub        func(lager.Logger, string) ([]string, error)
 	domainsMutex       sync.RWMutex
 	domainsArgsForCall []struct {
@@ -276,6 +554,19 @@ type FakeInternalClient struct {
 		result1 []string
 		result2 error
 	}
+	DrainCellStub        func(lager.Logger, string, string) error
+	drainCellMutex       sync.RWMutex
+	drainCellArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	drainCellReturns struct {
+		result1 error
+	}
+	drainCellReturnsOnCall map[int]struct {
+		result1 error
+	}
 	EvacuateClaimedActualLRPStub        func(lager.Logger, string, *models.ActualLRPKey, *models.ActualLRPInstanceKey) (bool, error)
 	evacuateClaimedActualLRPMutex       sync.RWMutex
 	evacuateClaimedActualLRPArgsForCall []struct {
@@ -346,6 +637,19 @@ type FakeInternalClient struct {
 		result1 bool
 		result2 error
 	}
+	ExpireDomainStub        func(lager.Logger, string, string) error
+	expireDomainMutex       sync.RWMutex
+	expireDomainArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	expireDomainReturns struct {
+		result1 error
+	}
+	expireDomainReturnsOnCall map[int]struct {
+		result1 error
+	}
 	FailActualLRPStub        func(lager.Logger, string, *models.ActualLRPKey, string) error
 	failActualLRPMutex       sync.RWMutex
 	failActualLRPArgsForCall []struct {
@@ -454,6 +758,33 @@ type FakeInternalClient struct {
 	resolvingTaskReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RestartActualLRPStub        func(lager.Logger, string, *models.ActualLRPKey) error
+	restartActualLRPMutex       sync.RWMutex
+	restartActualLRPArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 *models.ActualLRPKey
+	}
+	restartActualLRPReturns struct {
+		result1 error
+	}
+	restartActualLRPReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RestartDesiredLRPStub        func(lager.Logger, string, string, int32) error
+	restartDesiredLRPMutex       sync.RWMutex
+	restartDesiredLRPArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+		arg4 int32
+	}
+	restartDesiredLRPReturns struct {
+		result1 error
+	}
+	restartDesiredLRPReturnsOnCall map[int]struct {
+		result1 error
+	}
 	RetireActualLRPStub        func(lager.Logger, string, *models.ActualLRPKey) error
 	retireActualLRPMutex       sync.RWMutex
 	retireActualLRPArgsForCall []struct {
@@ -467,6 +798,50 @@ type FakeInternalClient struct {
 	retireActualLRPReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RollbackDesiredLRPStub        func(lager.Logger, string, string, int32) error
+	rollbackDesiredLRPMutex       sync.RWMutex
+	rollbackDesiredLRPArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+		arg4 int32
+	}
+	rollbackDesiredLRPReturns struct {
+		result1 error
+	}
+	rollbackDesiredLRPReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ScheduledTaskByGuidStub        func(lager.Logger, string, string) (*models.ScheduledTask, error)
+	scheduledTaskByGuidMutex       sync.RWMutex
+	scheduledTaskByGuidArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	scheduledTaskByGuidReturns struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
+	scheduledTaskByGuidReturnsOnCall map[int]struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
+	ScheduledTasksStub        func(lager.Logger, string, string) ([]*models.ScheduledTask, error)
+	scheduledTasksMutex       sync.RWMutex
+	scheduledTasksArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	scheduledTasksReturns struct {
+		result1 []*models.ScheduledTask
+		result2 error
+	}
+	scheduledTasksReturnsOnCall map[int]struct {
+		result1 []*models.ScheduledTask
+		result2 error
+	}
 	StartActualLRPStub        func(lager.Logger, string, *models.ActualLRPKey, *models.ActualLRPInstanceKey, *models.ActualLRPNetInfo, []*models.ActualLRPInternalRoute, map[string]string, bool, string) error
 	startActualLRPMutex       sync.RWMutex
 	startActualLRPArgsForCall []struct {
@@ -569,6 +944,21 @@ type FakeInternalClient struct {
 		result1 events.EventSource
 		result2 error
 	}
+	TaskArrayStatusStub        func(lager.Logger, string, string) (*models.TaskArrayStatus, error)
+	taskArrayStatusMutex       sync.RWMutex
+	taskArrayStatusArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	taskArrayStatusReturns struct {
+		result1 *models.TaskArrayStatus
+		result2 error
+	}
+	taskArrayStatusReturnsOnCall map[int]struct {
+		result1 *models.TaskArrayStatus
+		result2 error
+	}
 	TaskByGuidStub        func(lager.Logger, string, string) (*models.Task, error)
 	taskByGuidMutex       sync.RWMutex
 	taskByGuidArgsForCall []struct {
@@ -584,6 +974,21 @@ type FakeInternalClient struct {
 		result1 *models.Task
 		result2 error
 	}
+	TaskResultStub        func(lager.Logger, string, string) (string, error)
+	taskResultMutex       sync.RWMutex
+	taskResultArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	taskResultReturns struct {
+		result1 string
+		result2 error
+	}
+	taskResultReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	TasksStub        func(lager.Logger, string) ([]*models.Task, error)
 	tasksMutex       sync.RWMutex
 	tasksArgsForCall []struct {
@@ -643,6 +1048,19 @@ type FakeInternalClient struct {
 		result1 []*models.Task
 		result2 error
 	}
+	UncordonCellStub        func(lager.Logger, string, string) error
+	uncordonCellMutex       sync.RWMutex
+	uncordonCellArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	uncordonCellReturns struct {
+		result1 error
+	}
+	uncordonCellReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UpdateDesiredLRPStub        func(lager.Logger, string, string, *models.DesiredLRPUpdate) error
 	updateDesiredLRPMutex       sync.RWMutex
 	updateDesiredLRPArgsForCall []struct {
@@ -657,6 +1075,19 @@ type FakeInternalClient struct {
 	updateDesiredLRPReturnsOnCall map[int]struct {
 		result1 error
 	}
+	UpdateScheduledTaskStub        func(lager.Logger, string, *models.ScheduledTask) error
+	updateScheduledTaskMutex       sync.RWMutex
+	updateScheduledTaskArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 *models.ScheduledTask
+	}
+	updateScheduledTaskReturns struct {
+		result1 error
+	}
+	updateScheduledTaskReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UpsertDomainStub        func(lager.Logger, string, string, time.Duration) error
 	upsertDomainMutex       sync.RWMutex
 	upsertDomainArgsForCall []struct {
@@ -671,23 +1102,36 @@ type FakeInternalClient struct {
 	upsertDomainReturnsOnCall map[int]struct {
 		result1 error
 	}
+	UpsertDomainQuotaStub        func(lager.Logger, string, *models.DomainQuota) error
+	upsertDomainQuotaMutex       sync.RWMutex
+	upsertDomainQuotaArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 *models.DomainQuota
+	}
+	upsertDomainQuotaReturns struct {
+		result1 error
+	}
+	upsertDomainQuotaReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeInternalClient) ActualLRPGroupByProcessGuidAndIndex(arg1 lager.Logger, arg2 string, arg3 string, arg4 int) (*models.ActualLRPGroup, error) {
-	fake.actualLRPGroupByProcessGuidAndIndexMutex.Lock()
-	ret, specificReturn := fake.actualLRPGroupByProcessGuidAndIndexReturnsOnCall[len(fake.actualLRPGroupByProcessGuidAndIndexArgsForCall)]
-	fake.actualLRPGroupByProcessGuidAndIndexArgsForCall = append(fake.actualLRPGroupByProcessGuidAndIndexArgsForCall, struct {
+func (fake *FakeInternalClient) ActualLRPCrashes(arg1 lager.Logger, arg2 string, arg3 string, arg4 int32) ([]*models.ActualLRPCrash, error) {
+	fake.actualLRPCrashesMutex.Lock()
+	ret, specificReturn := fake.actualLRPCrashesReturnsOnCall[len(fake.actualLRPCrashesArgsForCall)]
+	fake.actualLRPCrashesArgsForCall = append(fake.actualLRPCrashesArgsForCall, struct {
 		arg1 lager.Logger
 		arg2 string
 		arg3 string
-		arg4 int
+		arg4 int32
 	}{arg1, arg2, arg3, arg4})
-	stub := fake.ActualLRPGroupByProcessGuidAndIndexStub
-	fakeReturns := fake.actualLRPGroupByProcessGuidAndIndexReturns
-	fake.recordInvocation("ActualLRPGroupByProcessGuidAndIndex", []interface{}{arg1, arg2, arg3, arg4})
-	fake.actualLRPGroupByProcessGuidAndIndexMutex.Unlock()
+	stub := fake.ActualLRPCrashesStub
+	fakeReturns := fake.actualLRPCrashesReturns
+	fake.recordInvocation("ActualLRPCrashes", []interface{}{arg1, arg2, arg3, arg4})
+	fake.actualLRPCrashesMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3, arg4)
 	}
@@ -697,15 +1141,82 @@ func (fake *FakeInternalClient) ActualLRPGroupByProcessGuidAndIndex(arg1 lager.L
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeInternalClient) ActualLRPGroupByProcessGuidAndIndexCallCount() int {
-	fake.actualLRPGroupByProcessGuidAndIndexMutex.RLock()
-	defer fake.actualLRPGroupByProcessGuidAndIndexMutex.RUnlock()
-	return len(fake.actualLRPGroupByProcessGuidAndIndexArgsForCall)
+func (fake *FakeInternalClient) ActualLRPCrashesCallCount() int {
+	fake.actualLRPCrashesMutex.RLock()
+	defer fake.actualLRPCrashesMutex.RUnlock()
+	return len(fake.actualLRPCrashesArgsForCall)
 }
 
-func (fake *FakeInternalClient) ActualLRPGroupByProcessGuidAndIndexCalls(stub func(lager.Logger, string, string, int) (*models.ActualLRPGroup, error)) {
-	fake.actualLRPGroupByProcessGuidAndIndexMutex.Lock()
-	defer fake.actualLRPGroupByProcessGuidAndIndexMutex.Unlock()
+func (fake *FakeInternalClient) ActualLRPCrashesCalls(stub func(lager.Logger, string, string, int32) ([]*models.ActualLRPCrash, error)) {
+	fake.actualLRPCrashesMutex.Lock()
+	defer fake.actualLRPCrashesMutex.Unlock()
+	fake.ActualLRPCrashesStub = stub
+}
+
+func (fake *FakeInternalClient) ActualLRPCrashesArgsForCall(i int) (lager.Logger, string, string, int32) {
+	fake.actualLRPCrashesMutex.RLock()
+	defer fake.actualLRPCrashesMutex.RUnlock()
+	argsForCall := fake.actualLRPCrashesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeInternalClient) ActualLRPCrashesReturns(result1 []*models.ActualLRPCrash, result2 error) {
+	fake.actualLRPCrashesMutex.Lock()
+	defer fake.actualLRPCrashesMutex.Unlock()
+	fake.ActualLRPCrashesStub = nil
+	fake.actualLRPCrashesReturns = struct {
+		result1 []*models.ActualLRPCrash
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) ActualLRPCrashesReturnsOnCall(i int, result1 []*models.ActualLRPCrash, result2 error) {
+	fake.actualLRPCrashesMutex.Lock()
+	defer fake.actualLRPCrashesMutex.Unlock()
+	fake.ActualLRPCrashesStub = nil
+	if fake.actualLRPCrashesReturnsOnCall == nil {
+		fake.actualLRPCrashesReturnsOnCall = make(map[int]struct {
+			result1 []*models.ActualLRPCrash
+			result2 error
+		})
+	}
+	fake.actualLRPCrashesReturnsOnCall[i] = struct {
+		result1 []*models.ActualLRPCrash
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) ActualLRPGroupByProcessGuidAndIndex(arg1 lager.Logger, arg2 string, arg3 string, arg4 int) (*models.ActualLRPGroup, error) {
+	fake.actualLRPGroupByProcessGuidAndIndexMutex.Lock()
+	ret, specificReturn := fake.actualLRPGroupByProcessGuidAndIndexReturnsOnCall[len(fake.actualLRPGroupByProcessGuidAndIndexArgsForCall)]
+	fake.actualLRPGroupByProcessGuidAndIndexArgsForCall = append(fake.actualLRPGroupByProcessGuidAndIndexArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ActualLRPGroupByProcessGuidAndIndexStub
+	fakeReturns := fake.actualLRPGroupByProcessGuidAndIndexReturns
+	fake.recordInvocation("ActualLRPGroupByProcessGuidAndIndex", []interface{}{arg1, arg2, arg3, arg4})
+	fake.actualLRPGroupByProcessGuidAndIndexMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) ActualLRPGroupByProcessGuidAndIndexCallCount() int {
+	fake.actualLRPGroupByProcessGuidAndIndexMutex.RLock()
+	defer fake.actualLRPGroupByProcessGuidAndIndexMutex.RUnlock()
+	return len(fake.actualLRPGroupByProcessGuidAndIndexArgsForCall)
+}
+
+func (fake *FakeInternalClient) ActualLRPGroupByProcessGuidAndIndexCalls(stub func(lager.Logger, string, string, int) (*models.ActualLRPGroup, error)) {
+	fake.actualLRPGroupByProcessGuidAndIndexMutex.Lock()
+	defer fake.actualLRPGroupByProcessGuidAndIndexMutex.Unlock()
 	fake.ActualLRPGroupByProcessGuidAndIndexStub = stub
 }
 
@@ -940,6 +1451,275 @@ func (fake *FakeInternalClient) ActualLRPsReturnsOnCall(i int, result1 []*models
 	}{result1, result2}
 }
 
+func (fake *FakeInternalClient) ActualLRPsWithCrashHistory(arg1 lager.Logger, arg2 string, arg3 models.ActualLRPFilter) ([]*models.ActualLRP, []*models.ActualLRPCrash, error) {
+	fake.actualLRPsWithCrashHistoryMutex.Lock()
+	ret, specificReturn := fake.actualLRPsWithCrashHistoryReturnsOnCall[len(fake.actualLRPsWithCrashHistoryArgsForCall)]
+	fake.actualLRPsWithCrashHistoryArgsForCall = append(fake.actualLRPsWithCrashHistoryArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 models.ActualLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.ActualLRPsWithCrashHistoryStub
+	fakeReturns := fake.actualLRPsWithCrashHistoryReturns
+	fake.recordInvocation("ActualLRPsWithCrashHistory", []interface{}{arg1, arg2, arg3})
+	fake.actualLRPsWithCrashHistoryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeInternalClient) ActualLRPsWithCrashHistoryCallCount() int {
+	fake.actualLRPsWithCrashHistoryMutex.RLock()
+	defer fake.actualLRPsWithCrashHistoryMutex.RUnlock()
+	return len(fake.actualLRPsWithCrashHistoryArgsForCall)
+}
+
+func (fake *FakeInternalClient) ActualLRPsWithCrashHistoryCalls(stub func(lager.Logger, string, models.ActualLRPFilter) ([]*models.ActualLRP, []*models.ActualLRPCrash, error)) {
+	fake.actualLRPsWithCrashHistoryMutex.Lock()
+	defer fake.actualLRPsWithCrashHistoryMutex.Unlock()
+	fake.ActualLRPsWithCrashHistoryStub = stub
+}
+
+func (fake *FakeInternalClient) ActualLRPsWithCrashHistoryArgsForCall(i int) (lager.Logger, string, models.ActualLRPFilter) {
+	fake.actualLRPsWithCrashHistoryMutex.RLock()
+	defer fake.actualLRPsWithCrashHistoryMutex.RUnlock()
+	argsForCall := fake.actualLRPsWithCrashHistoryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) ActualLRPsWithCrashHistoryReturns(result1 []*models.ActualLRP, result2 []*models.ActualLRPCrash, result3 error) {
+	fake.actualLRPsWithCrashHistoryMutex.Lock()
+	defer fake.actualLRPsWithCrashHistoryMutex.Unlock()
+	fake.ActualLRPsWithCrashHistoryStub = nil
+	fake.actualLRPsWithCrashHistoryReturns = struct {
+		result1 []*models.ActualLRP
+		result2 []*models.ActualLRPCrash
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInternalClient) ActualLRPsWithCrashHistoryReturnsOnCall(i int, result1 []*models.ActualLRP, result2 []*models.ActualLRPCrash, result3 error) {
+	fake.actualLRPsWithCrashHistoryMutex.Lock()
+	defer fake.actualLRPsWithCrashHistoryMutex.Unlock()
+	fake.ActualLRPsWithCrashHistoryStub = nil
+	if fake.actualLRPsWithCrashHistoryReturnsOnCall == nil {
+		fake.actualLRPsWithCrashHistoryReturnsOnCall = make(map[int]struct {
+			result1 []*models.ActualLRP
+			result2 []*models.ActualLRPCrash
+			result3 error
+		})
+	}
+	fake.actualLRPsWithCrashHistoryReturnsOnCall[i] = struct {
+		result1 []*models.ActualLRP
+		result2 []*models.ActualLRPCrash
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInternalClient) AllDomains(arg1 lager.Logger, arg2 string) ([]*models.Domain, error) {
+	fake.allDomainsMutex.Lock()
+	ret, specificReturn := fake.allDomainsReturnsOnCall[len(fake.allDomainsArgsForCall)]
+	fake.allDomainsArgsForCall = append(fake.allDomainsArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.AllDomainsStub
+	fakeReturns := fake.allDomainsReturns
+	fake.recordInvocation("AllDomains", []interface{}{arg1, arg2})
+	fake.allDomainsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) AllDomainsCallCount() int {
+	fake.allDomainsMutex.RLock()
+	defer fake.allDomainsMutex.RUnlock()
+	return len(fake.allDomainsArgsForCall)
+}
+
+func (fake *FakeInternalClient) AllDomainsCalls(stub func(lager.Logger, string) ([]*models.Domain, error)) {
+	fake.allDomainsMutex.Lock()
+	defer fake.allDomainsMutex.Unlock()
+	fake.AllDomainsStub = stub
+}
+
+func (fake *FakeInternalClient) AllDomainsArgsForCall(i int) (lager.Logger, string) {
+	fake.allDomainsMutex.RLock()
+	defer fake.allDomainsMutex.RUnlock()
+	argsForCall := fake.allDomainsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInternalClient) AllDomainsReturns(result1 []*models.Domain, result2 error) {
+	fake.allDomainsMutex.Lock()
+	defer fake.allDomainsMutex.Unlock()
+	fake.AllDomainsStub = nil
+	fake.allDomainsReturns = struct {
+		result1 []*models.Domain
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) AllDomainsReturnsOnCall(i int, result1 []*models.Domain, result2 error) {
+	fake.allDomainsMutex.Lock()
+	defer fake.allDomainsMutex.Unlock()
+	fake.AllDomainsStub = nil
+	if fake.allDomainsReturnsOnCall == nil {
+		fake.allDomainsReturnsOnCall = make(map[int]struct {
+			result1 []*models.Domain
+			result2 error
+		})
+	}
+	fake.allDomainsReturnsOnCall[i] = struct {
+		result1 []*models.Domain
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) ArchivedTaskByGuid(arg1 lager.Logger, arg2 string, arg3 string) (*models.ArchivedTask, error) {
+	fake.archivedTaskByGuidMutex.Lock()
+	ret, specificReturn := fake.archivedTaskByGuidReturnsOnCall[len(fake.archivedTaskByGuidArgsForCall)]
+	fake.archivedTaskByGuidArgsForCall = append(fake.archivedTaskByGuidArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ArchivedTaskByGuidStub
+	fakeReturns := fake.archivedTaskByGuidReturns
+	fake.recordInvocation("ArchivedTaskByGuid", []interface{}{arg1, arg2, arg3})
+	fake.archivedTaskByGuidMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) ArchivedTaskByGuidCallCount() int {
+	fake.archivedTaskByGuidMutex.RLock()
+	defer fake.archivedTaskByGuidMutex.RUnlock()
+	return len(fake.archivedTaskByGuidArgsForCall)
+}
+
+func (fake *FakeInternalClient) ArchivedTaskByGuidCalls(stub func(lager.Logger, string, string) (*models.ArchivedTask, error)) {
+	fake.archivedTaskByGuidMutex.Lock()
+	defer fake.archivedTaskByGuidMutex.Unlock()
+	fake.ArchivedTaskByGuidStub = stub
+}
+
+func (fake *FakeInternalClient) ArchivedTaskByGuidArgsForCall(i int) (lager.Logger, string, string) {
+	fake.archivedTaskByGuidMutex.RLock()
+	defer fake.archivedTaskByGuidMutex.RUnlock()
+	argsForCall := fake.archivedTaskByGuidArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) ArchivedTaskByGuidReturns(result1 *models.ArchivedTask, result2 error) {
+	fake.archivedTaskByGuidMutex.Lock()
+	defer fake.archivedTaskByGuidMutex.Unlock()
+	fake.ArchivedTaskByGuidStub = nil
+	fake.archivedTaskByGuidReturns = struct {
+		result1 *models.ArchivedTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) ArchivedTaskByGuidReturnsOnCall(i int, result1 *models.ArchivedTask, result2 error) {
+	fake.archivedTaskByGuidMutex.Lock()
+	defer fake.archivedTaskByGuidMutex.Unlock()
+	fake.ArchivedTaskByGuidStub = nil
+	if fake.archivedTaskByGuidReturnsOnCall == nil {
+		fake.archivedTaskByGuidReturnsOnCall = make(map[int]struct {
+			result1 *models.ArchivedTask
+			result2 error
+		})
+	}
+	fake.archivedTaskByGuidReturnsOnCall[i] = struct {
+		result1 *models.ArchivedTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) ArchivedTasks(arg1 lager.Logger, arg2 string, arg3 models.TaskArchiveFilter) ([]*models.ArchivedTask, string, error) {
+	fake.archivedTasksMutex.Lock()
+	ret, specificReturn := fake.archivedTasksReturnsOnCall[len(fake.archivedTasksArgsForCall)]
+	fake.archivedTasksArgsForCall = append(fake.archivedTasksArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 models.TaskArchiveFilter
+	}{arg1, arg2, arg3})
+	stub := fake.ArchivedTasksStub
+	fakeReturns := fake.archivedTasksReturns
+	fake.recordInvocation("ArchivedTasks", []interface{}{arg1, arg2, arg3})
+	fake.archivedTasksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeInternalClient) ArchivedTasksCallCount() int {
+	fake.archivedTasksMutex.RLock()
+	defer fake.archivedTasksMutex.RUnlock()
+	return len(fake.archivedTasksArgsForCall)
+}
+
+func (fake *FakeInternalClient) ArchivedTasksCalls(stub func(lager.Logger, string, models.TaskArchiveFilter) ([]*models.ArchivedTask, string, error)) {
+	fake.archivedTasksMutex.Lock()
+	defer fake.archivedTasksMutex.Unlock()
+	fake.ArchivedTasksStub = stub
+}
+
+func (fake *FakeInternalClient) ArchivedTasksArgsForCall(i int) (lager.Logger, string, models.TaskArchiveFilter) {
+	fake.archivedTasksMutex.RLock()
+	defer fake.archivedTasksMutex.RUnlock()
+	argsForCall := fake.archivedTasksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) ArchivedTasksReturns(result1 []*models.ArchivedTask, result2 string, result3 error) {
+	fake.archivedTasksMutex.Lock()
+	defer fake.archivedTasksMutex.Unlock()
+	fake.ArchivedTasksStub = nil
+	fake.archivedTasksReturns = struct {
+		result1 []*models.ArchivedTask
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInternalClient) ArchivedTasksReturnsOnCall(i int, result1 []*models.ArchivedTask, result2 string, result3 error) {
+	fake.archivedTasksMutex.Lock()
+	defer fake.archivedTasksMutex.Unlock()
+	fake.ArchivedTasksStub = nil
+	if fake.archivedTasksReturnsOnCall == nil {
+		fake.archivedTasksReturnsOnCall = make(map[int]struct {
+			result1 []*models.ArchivedTask
+			result2 string
+			result3 error
+		})
+	}
+	fake.archivedTasksReturnsOnCall[i] = struct {
+		result1 []*models.ArchivedTask
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeInternalClient) CancelTask(arg1 lager.Logger, arg2 string, arg3 string) error {
 	fake.cancelTaskMutex.Lock()
 	ret, specificReturn := fake.cancelTaskReturnsOnCall[len(fake.cancelTaskArgsForCall)]
@@ -1003,6 +1783,200 @@ func (fake *FakeInternalClient) CancelTaskReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeInternalClient) CancelTaskArray(arg1 lager.Logger, arg2 string, arg3 string) error {
+	fake.cancelTaskArrayMutex.Lock()
+	ret, specificReturn := fake.cancelTaskArrayReturnsOnCall[len(fake.cancelTaskArrayArgsForCall)]
+	fake.cancelTaskArrayArgsForCall = append(fake.cancelTaskArrayArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.CancelTaskArrayStub
+	fakeReturns := fake.cancelTaskArrayReturns
+	fake.recordInvocation("CancelTaskArray", []interface{}{arg1, arg2, arg3})
+	fake.cancelTaskArrayMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInternalClient) CancelTaskArrayCallCount() int {
+	fake.cancelTaskArrayMutex.RLock()
+	defer fake.cancelTaskArrayMutex.RUnlock()
+	return len(fake.cancelTaskArrayArgsForCall)
+}
+
+func (fake *FakeInternalClient) CancelTaskArrayCalls(stub func(lager.Logger, string, string) error) {
+	fake.cancelTaskArrayMutex.Lock()
+	defer fake.cancelTaskArrayMutex.Unlock()
+	fake.CancelTaskArrayStub = stub
+}
+
+func (fake *FakeInternalClient) CancelTaskArrayArgsForCall(i int) (lager.Logger, string, string) {
+	fake.cancelTaskArrayMutex.RLock()
+	defer fake.cancelTaskArrayMutex.RUnlock()
+	argsForCall := fake.cancelTaskArrayArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) CancelTaskArrayReturns(result1 error) {
+	fake.cancelTaskArrayMutex.Lock()
+	defer fake.cancelTaskArrayMutex.Unlock()
+	fake.CancelTaskArrayStub = nil
+	fake.cancelTaskArrayReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalClient) CancelTaskArrayReturnsOnCall(i int, result1 error) {
+	fake.cancelTaskArrayMutex.Lock()
+	defer fake.cancelTaskArrayMutex.Unlock()
+	fake.CancelTaskArrayStub = nil
+	if fake.cancelTaskArrayReturnsOnCall == nil {
+		fake.cancelTaskArrayReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.cancelTaskArrayReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalClient) CapacityReport(arg1 lager.Logger, arg2 string) (*models.CapacityReport, error) {
+	fake.capacityReportMutex.Lock()
+	ret, specificReturn := fake.capacityReportReturnsOnCall[len(fake.capacityReportArgsForCall)]
+	fake.capacityReportArgsForCall = append(fake.capacityReportArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.CapacityReportStub
+	fakeReturns := fake.capacityReportReturns
+	fake.recordInvocation("CapacityReport", []interface{}{arg1, arg2})
+	fake.capacityReportMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) CapacityReportCallCount() int {
+	fake.capacityReportMutex.RLock()
+	defer fake.capacityReportMutex.RUnlock()
+	return len(fake.capacityReportArgsForCall)
+}
+
+func (fake *FakeInternalClient) CapacityReportCalls(stub func(lager.Logger, string) (*models.CapacityReport, error)) {
+	fake.capacityReportMutex.Lock()
+	defer fake.capacityReportMutex.Unlock()
+	fake.CapacityReportStub = stub
+}
+
+func (fake *FakeInternalClient) CapacityReportArgsForCall(i int) (lager.Logger, string) {
+	fake.capacityReportMutex.RLock()
+	defer fake.capacityReportMutex.RUnlock()
+	argsForCall := fake.capacityReportArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInternalClient) CapacityReportReturns(result1 *models.CapacityReport, result2 error) {
+	fake.capacityReportMutex.Lock()
+	defer fake.capacityReportMutex.Unlock()
+	fake.CapacityReportStub = nil
+	fake.capacityReportReturns = struct {
+		result1 *models.CapacityReport
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) CapacityReportReturnsOnCall(i int, result1 *models.CapacityReport, result2 error) {
+	fake.capacityReportMutex.Lock()
+	defer fake.capacityReportMutex.Unlock()
+	fake.CapacityReportStub = nil
+	if fake.capacityReportReturnsOnCall == nil {
+		fake.capacityReportReturnsOnCall = make(map[int]struct {
+			result1 *models.CapacityReport
+			result2 error
+		})
+	}
+	fake.capacityReportReturnsOnCall[i] = struct {
+		result1 *models.CapacityReport
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) CellDrainStatus(arg1 lager.Logger, arg2 string, arg3 string) (*models.CellDrainStatus, error) {
+	fake.cellDrainStatusMutex.Lock()
+	ret, specificReturn := fake.cellDrainStatusReturnsOnCall[len(fake.cellDrainStatusArgsForCall)]
+	fake.cellDrainStatusArgsForCall = append(fake.cellDrainStatusArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.CellDrainStatusStub
+	fakeReturns := fake.cellDrainStatusReturns
+	fake.recordInvocation("CellDrainStatus", []interface{}{arg1, arg2, arg3})
+	fake.cellDrainStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) CellDrainStatusCallCount() int {
+	fake.cellDrainStatusMutex.RLock()
+	defer fake.cellDrainStatusMutex.RUnlock()
+	return len(fake.cellDrainStatusArgsForCall)
+}
+
+func (fake *FakeInternalClient) CellDrainStatusCalls(stub func(lager.Logger, string, string) (*models.CellDrainStatus, error)) {
+	fake.cellDrainStatusMutex.Lock()
+	defer fake.cellDrainStatusMutex.Unlock()
+	fake.CellDrainStatusStub = stub
+}
+
+func (fake *FakeInternalClient) CellDrainStatusArgsForCall(i int) (lager.Logger, string, string) {
+	fake.cellDrainStatusMutex.RLock()
+	defer fake.cellDrainStatusMutex.RUnlock()
+	argsForCall := fake.cellDrainStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) CellDrainStatusReturns(result1 *models.CellDrainStatus, result2 error) {
+	fake.cellDrainStatusMutex.Lock()
+	defer fake.cellDrainStatusMutex.Unlock()
+	fake.CellDrainStatusStub = nil
+	fake.cellDrainStatusReturns = struct {
+		result1 *models.CellDrainStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) CellDrainStatusReturnsOnCall(i int, result1 *models.CellDrainStatus, result2 error) {
+	fake.cellDrainStatusMutex.Lock()
+	defer fake.cellDrainStatusMutex.Unlock()
+	fake.CellDrainStatusStub = nil
+	if fake.cellDrainStatusReturnsOnCall == nil {
+		fake.cellDrainStatusReturnsOnCall = make(map[int]struct {
+			result1 *models.CellDrainStatus
+			result2 error
+		})
+	}
+	fake.cellDrainStatusReturnsOnCall[i] = struct {
+		result1 *models.CellDrainStatus
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInternalClient) Cells(arg1 lager.Logger, arg2 string) ([]*models.CellPresence, error) {
 	fake.cellsMutex.Lock()
 	ret, specificReturn := fake.cellsReturnsOnCall[len(fake.cellsArgsForCall)]
@@ -1199,16 +2173,79 @@ func (fake *FakeInternalClient) CompleteTaskReturnsOnCall(i int, result1 error)
 	}{result1}
 }
 
-func (fake *FakeInternalClient) CrashActualLRP(arg1 lager.Logger, arg2 string, arg3 *models.ActualLRPKey, arg4 *models.ActualLRPInstanceKey, arg5 string) error {
-	fake.crashActualLRPMutex.Lock()
-	ret, specificReturn := fake.crashActualLRPReturnsOnCall[len(fake.crashActualLRPArgsForCall)]
-	fake.crashActualLRPArgsForCall = append(fake.crashActualLRPArgsForCall, struct {
+func (fake *FakeInternalClient) CordonCell(arg1 lager.Logger, arg2 string, arg3 string) error {
+	fake.cordonCellMutex.Lock()
+	ret, specificReturn := fake.cordonCellReturnsOnCall[len(fake.cordonCellArgsForCall)]
+	fake.cordonCellArgsForCall = append(fake.cordonCellArgsForCall, struct {
 		arg1 lager.Logger
 		arg2 string
-		arg3 *models.ActualLRPKey
-		arg4 *models.ActualLRPInstanceKey
-		arg5 string
-	}{arg1, arg2, arg3, arg4, arg5})
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.CordonCellStub
+	fakeReturns := fake.cordonCellReturns
+	fake.recordInvocation("CordonCell", []interface{}{arg1, arg2, arg3})
+	fake.cordonCellMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInternalClient) CordonCellCallCount() int {
+	fake.cordonCellMutex.RLock()
+	defer fake.cordonCellMutex.RUnlock()
+	return len(fake.cordonCellArgsForCall)
+}
+
+func (fake *FakeInternalClient) CordonCellCalls(stub func(lager.Logger, string, string) error) {
+	fake.cordonCellMutex.Lock()
+	defer fake.cordonCellMutex.Unlock()
+	fake.CordonCellStub = stub
+}
+
+func (fake *FakeInternalClient) CordonCellArgsForCall(i int) (lager.Logger, string, string) {
+	fake.cordonCellMutex.RLock()
+	defer fake.cordonCellMutex.RUnlock()
+	argsForCall := fake.cordonCellArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) CordonCellReturns(result1 error) {
+	fake.cordonCellMutex.Lock()
+	defer fake.cordonCellMutex.Unlock()
+	fake.CordonCellStub = nil
+	fake.cordonCellReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalClient) CordonCellReturnsOnCall(i int, result1 error) {
+	fake.cordonCellMutex.Lock()
+	defer fake.cordonCellMutex.Unlock()
+	fake.CordonCellStub = nil
+	if fake.cordonCellReturnsOnCall == nil {
+		fake.cordonCellReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.cordonCellReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalClient) CrashActualLRP(arg1 lager.Logger, arg2 string, arg3 *models.ActualLRPKey, arg4 *models.ActualLRPInstanceKey, arg5 string) error {
+	fake.crashActualLRPMutex.Lock()
+	ret, specificReturn := fake.crashActualLRPReturnsOnCall[len(fake.crashActualLRPArgsForCall)]
+	fake.crashActualLRPArgsForCall = append(fake.crashActualLRPArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 *models.ActualLRPKey
+		arg4 *models.ActualLRPInstanceKey
+		arg5 string
+	}{arg1, arg2, arg3, arg4, arg5})
 	stub := fake.CrashActualLRPStub
 	fakeReturns := fake.crashActualLRPReturns
 	fake.recordInvocation("CrashActualLRP", []interface{}{arg1, arg2, arg3, arg4, arg5})
@@ -1264,6 +2301,195 @@ func (fake *FakeInternalClient) CrashActualLRPReturnsOnCall(i int, result1 error
 	}{result1}
 }
 
+func (fake *FakeInternalClient) DeleteDomain(arg1 lager.Logger, arg2 string, arg3 string) error {
+	fake.deleteDomainMutex.Lock()
+	ret, specificReturn := fake.deleteDomainReturnsOnCall[len(fake.deleteDomainArgsForCall)]
+	fake.deleteDomainArgsForCall = append(fake.deleteDomainArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteDomainStub
+	fakeReturns := fake.deleteDomainReturns
+	fake.recordInvocation("DeleteDomain", []interface{}{arg1, arg2, arg3})
+	fake.deleteDomainMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInternalClient) DeleteDomainCallCount() int {
+	fake.deleteDomainMutex.RLock()
+	defer fake.deleteDomainMutex.RUnlock()
+	return len(fake.deleteDomainArgsForCall)
+}
+
+func (fake *FakeInternalClient) DeleteDomainCalls(stub func(lager.Logger, string, string) error) {
+	fake.deleteDomainMutex.Lock()
+	defer fake.deleteDomainMutex.Unlock()
+	fake.DeleteDomainStub = stub
+}
+
+func (fake *FakeInternalClient) DeleteDomainArgsForCall(i int) (lager.Logger, string, string) {
+	fake.deleteDomainMutex.RLock()
+	defer fake.deleteDomainMutex.RUnlock()
+	argsForCall := fake.deleteDomainArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) DeleteDomainReturns(result1 error) {
+	fake.deleteDomainMutex.Lock()
+	defer fake.deleteDomainMutex.Unlock()
+	fake.DeleteDomainStub = nil
+	fake.deleteDomainReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalClient) DeleteDomainReturnsOnCall(i int, result1 error) {
+	fake.deleteDomainMutex.Lock()
+	defer fake.deleteDomainMutex.Unlock()
+	fake.DeleteDomainStub = nil
+	if fake.deleteDomainReturnsOnCall == nil {
+		fake.deleteDomainReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteDomainReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalClient) DeleteDomainQuota(arg1 lager.Logger, arg2 string, arg3 string) error {
+	fake.deleteDomainQuotaMutex.Lock()
+	ret, specificReturn := fake.deleteDomainQuotaReturnsOnCall[len(fake.deleteDomainQuotaArgsForCall)]
+	fake.deleteDomainQuotaArgsForCall = append(fake.deleteDomainQuotaArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteDomainQuotaStub
+	fakeReturns := fake.deleteDomainQuotaReturns
+	fake.recordInvocation("DeleteDomainQuota", []interface{}{arg1, arg2, arg3})
+	fake.deleteDomainQuotaMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInternalClient) DeleteDomainQuotaCallCount() int {
+	fake.deleteDomainQuotaMutex.RLock()
+	defer fake.deleteDomainQuotaMutex.RUnlock()
+	return len(fake.deleteDomainQuotaArgsForCall)
+}
+
+func (fake *FakeInternalClient) DeleteDomainQuotaCalls(stub func(lager.Logger, string, string) error) {
+	fake.deleteDomainQuotaMutex.Lock()
+	defer fake.deleteDomainQuotaMutex.Unlock()
+	fake.DeleteDomainQuotaStub = stub
+}
+
+func (fake *FakeInternalClient) DeleteDomainQuotaArgsForCall(i int) (lager.Logger, string, string) {
+	fake.deleteDomainQuotaMutex.RLock()
+	defer fake.deleteDomainQuotaMutex.RUnlock()
+	argsForCall := fake.deleteDomainQuotaArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) DeleteDomainQuotaReturns(result1 error) {
+	fake.deleteDomainQuotaMutex.Lock()
+	defer fake.deleteDomainQuotaMutex.Unlock()
+	fake.DeleteDomainQuotaStub = nil
+	fake.deleteDomainQuotaReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalClient) DeleteDomainQuotaReturnsOnCall(i int, result1 error) {
+	fake.deleteDomainQuotaMutex.Lock()
+	defer fake.deleteDomainQuotaMutex.Unlock()
+	fake.DeleteDomainQuotaStub = nil
+	if fake.deleteDomainQuotaReturnsOnCall == nil {
+		fake.deleteDomainQuotaReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteDomainQuotaReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalClient) DeleteScheduledTask(arg1 lager.Logger, arg2 string, arg3 string) error {
+	fake.deleteScheduledTaskMutex.Lock()
+	ret, specificReturn := fake.deleteScheduledTaskReturnsOnCall[len(fake.deleteScheduledTaskArgsForCall)]
+	fake.deleteScheduledTaskArgsForCall = append(fake.deleteScheduledTaskArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteScheduledTaskStub
+	fakeReturns := fake.deleteScheduledTaskReturns
+	fake.recordInvocation("DeleteScheduledTask", []interface{}{arg1, arg2, arg3})
+	fake.deleteScheduledTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInternalClient) DeleteScheduledTaskCallCount() int {
+	fake.deleteScheduledTaskMutex.RLock()
+	defer fake.deleteScheduledTaskMutex.RUnlock()
+	return len(fake.deleteScheduledTaskArgsForCall)
+}
+
+func (fake *FakeInternalClient) DeleteScheduledTaskCalls(stub func(lager.Logger, string, string) error) {
+	fake.deleteScheduledTaskMutex.Lock()
+	defer fake.deleteScheduledTaskMutex.Unlock()
+	fake.DeleteScheduledTaskStub = stub
+}
+
+func (fake *FakeInternalClient) DeleteScheduledTaskArgsForCall(i int) (lager.Logger, string, string) {
+	fake.deleteScheduledTaskMutex.RLock()
+	defer fake.deleteScheduledTaskMutex.RUnlock()
+	argsForCall := fake.deleteScheduledTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) DeleteScheduledTaskReturns(result1 error) {
+	fake.deleteScheduledTaskMutex.Lock()
+	defer fake.deleteScheduledTaskMutex.Unlock()
+	fake.DeleteScheduledTaskStub = nil
+	fake.deleteScheduledTaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalClient) DeleteScheduledTaskReturnsOnCall(i int, result1 error) {
+	fake.deleteScheduledTaskMutex.Lock()
+	defer fake.deleteScheduledTaskMutex.Unlock()
+	fake.DeleteScheduledTaskStub = nil
+	if fake.deleteScheduledTaskReturnsOnCall == nil {
+		fake.deleteScheduledTaskReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteScheduledTaskReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeInternalClient) DeleteTask(arg1 lager.Logger, arg2 string, arg3 string) error {
 	fake.deleteTaskMutex.Lock()
 	ret, specificReturn := fake.deleteTaskReturnsOnCall[len(fake.deleteTaskArgsForCall)]
@@ -1390,6 +2616,69 @@ func (fake *FakeInternalClient) DesireLRPReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeInternalClient) DesireScheduledTask(arg1 lager.Logger, arg2 string, arg3 *models.ScheduledTask) error {
+	fake.desireScheduledTaskMutex.Lock()
+	ret, specificReturn := fake.desireScheduledTaskReturnsOnCall[len(fake.desireScheduledTaskArgsForCall)]
+	fake.desireScheduledTaskArgsForCall = append(fake.desireScheduledTaskArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 *models.ScheduledTask
+	}{arg1, arg2, arg3})
+	stub := fake.DesireScheduledTaskStub
+	fakeReturns := fake.desireScheduledTaskReturns
+	fake.recordInvocation("DesireScheduledTask", []interface{}{arg1, arg2, arg3})
+	fake.desireScheduledTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInternalClient) DesireScheduledTaskCallCount() int {
+	fake.desireScheduledTaskMutex.RLock()
+	defer fake.desireScheduledTaskMutex.RUnlock()
+	return len(fake.desireScheduledTaskArgsForCall)
+}
+
+func (fake *FakeInternalClient) DesireScheduledTaskCalls(stub func(lager.Logger, string, *models.ScheduledTask) error) {
+	fake.desireScheduledTaskMutex.Lock()
+	defer fake.desireScheduledTaskMutex.Unlock()
+	fake.DesireScheduledTaskStub = stub
+}
+
+func (fake *FakeInternalClient) DesireScheduledTaskArgsForCall(i int) (lager.Logger, string, *models.ScheduledTask) {
+	fake.desireScheduledTaskMutex.RLock()
+	defer fake.desireScheduledTaskMutex.RUnlock()
+	argsForCall := fake.desireScheduledTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) DesireScheduledTaskReturns(result1 error) {
+	fake.desireScheduledTaskMutex.Lock()
+	defer fake.desireScheduledTaskMutex.Unlock()
+	fake.DesireScheduledTaskStub = nil
+	fake.desireScheduledTaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalClient) DesireScheduledTaskReturnsOnCall(i int, result1 error) {
+	fake.desireScheduledTaskMutex.Lock()
+	defer fake.desireScheduledTaskMutex.Unlock()
+	fake.DesireScheduledTaskStub = nil
+	if fake.desireScheduledTaskReturnsOnCall == nil {
+		fake.desireScheduledTaskReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.desireScheduledTaskReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeInternalClient) DesireTask(arg1 lager.Logger, arg2 string, arg3 string, arg4 string, arg5 *models.TaskDefinition) error {
 	fake.desireTaskMutex.Lock()
 	ret, specificReturn := fake.desireTaskReturnsOnCall[len(fake.desireTaskArgsForCall)]
@@ -1455,18 +2744,218 @@ func (fake *FakeInternalClient) DesireTaskReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeInternalClient) DesiredLRPByProcessGuid(arg1 lager.Logger, arg2 string, arg3 string) (*models.DesiredLRP, error) {
-	fake.desiredLRPByProcessGuidMutex.Lock()
-	ret, specificReturn := fake.desiredLRPByProcessGuidReturnsOnCall[len(fake.desiredLRPByProcessGuidArgsForCall)]
-	fake.desiredLRPByProcessGuidArgsForCall = append(fake.desiredLRPByProcessGuidArgsForCall, struct {
+func (fake *FakeInternalClient) DesireTaskArray(arg1 lager.Logger, arg2 string, arg3 string, arg4 string, arg5 int32, arg6 *models.TaskDefinition) error {
+	fake.desireTaskArrayMutex.Lock()
+	ret, specificReturn := fake.desireTaskArrayReturnsOnCall[len(fake.desireTaskArrayArgsForCall)]
+	fake.desireTaskArrayArgsForCall = append(fake.desireTaskArrayArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 int32
+		arg6 *models.TaskDefinition
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	stub := fake.DesireTaskArrayStub
+	fakeReturns := fake.desireTaskArrayReturns
+	fake.recordInvocation("DesireTaskArray", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.desireTaskArrayMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInternalClient) DesireTaskArrayCallCount() int {
+	fake.desireTaskArrayMutex.RLock()
+	defer fake.desireTaskArrayMutex.RUnlock()
+	return len(fake.desireTaskArrayArgsForCall)
+}
+
+func (fake *FakeInternalClient) DesireTaskArrayCalls(stub func(lager.Logger, string, string, string, int32, *models.TaskDefinition) error) {
+	fake.desireTaskArrayMutex.Lock()
+	defer fake.desireTaskArrayMutex.Unlock()
+	fake.DesireTaskArrayStub = stub
+}
+
+func (fake *FakeInternalClient) DesireTaskArrayArgsForCall(i int) (lager.Logger, string, string, string, int32, *models.TaskDefinition) {
+	fake.desireTaskArrayMutex.RLock()
+	defer fake.desireTaskArrayMutex.RUnlock()
+	argsForCall := fake.desireTaskArrayArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakeInternalClient) DesireTaskArrayReturns(result1 error) {
+	fake.desireTaskArrayMutex.Lock()
+	defer fake.desireTaskArrayMutex.Unlock()
+	fake.DesireTaskArrayStub = nil
+	fake.desireTaskArrayReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalClient) DesireTaskArrayReturnsOnCall(i int, result1 error) {
+	fake.desireTaskArrayMutex.Lock()
+	defer fake.desireTaskArrayMutex.Unlock()
+	fake.DesireTaskArrayStub = nil
+	if fake.desireTaskArrayReturnsOnCall == nil {
+		fake.desireTaskArrayReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.desireTaskArrayReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalClient) DesiredLRPByProcessGuid(arg1 lager.Logger, arg2 string, arg3 string) (*models.DesiredLRP, error) {
+	fake.desiredLRPByProcessGuidMutex.Lock()
+	ret, specificReturn := fake.desiredLRPByProcessGuidReturnsOnCall[len(fake.desiredLRPByProcessGuidArgsForCall)]
+	fake.desiredLRPByProcessGuidArgsForCall = append(fake.desiredLRPByProcessGuidArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DesiredLRPByProcessGuidStub
+	fakeReturns := fake.desiredLRPByProcessGuidReturns
+	fake.recordInvocation("DesiredLRPByProcessGuid", []interface{}{arg1, arg2, arg3})
+	fake.desiredLRPByProcessGuidMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) DesiredLRPByProcessGuidCallCount() int {
+	fake.desiredLRPByProcessGuidMutex.RLock()
+	defer fake.desiredLRPByProcessGuidMutex.RUnlock()
+	return len(fake.desiredLRPByProcessGuidArgsForCall)
+}
+
+func (fake *FakeInternalClient) DesiredLRPByProcessGuidCalls(stub func(lager.Logger, string, string) (*models.DesiredLRP, error)) {
+	fake.desiredLRPByProcessGuidMutex.Lock()
+	defer fake.desiredLRPByProcessGuidMutex.Unlock()
+	fake.DesiredLRPByProcessGuidStub = stub
+}
+
+func (fake *FakeInternalClient) DesiredLRPByProcessGuidArgsForCall(i int) (lager.Logger, string, string) {
+	fake.desiredLRPByProcessGuidMutex.RLock()
+	defer fake.desiredLRPByProcessGuidMutex.RUnlock()
+	argsForCall := fake.desiredLRPByProcessGuidArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) DesiredLRPByProcessGuidReturns(result1 *models.DesiredLRP, result2 error) {
+	fake.desiredLRPByProcessGuidMutex.Lock()
+	defer fake.desiredLRPByProcessGuidMutex.Unlock()
+	fake.DesiredLRPByProcessGuidStub = nil
+	fake.desiredLRPByProcessGuidReturns = struct {
+		result1 *models.DesiredLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) DesiredLRPByProcessGuidReturnsOnCall(i int, result1 *models.DesiredLRP, result2 error) {
+	fake.desiredLRPByProcessGuidMutex.Lock()
+	defer fake.desiredLRPByProcessGuidMutex.Unlock()
+	fake.DesiredLRPByProcessGuidStub = nil
+	if fake.desiredLRPByProcessGuidReturnsOnCall == nil {
+		fake.desiredLRPByProcessGuidReturnsOnCall = make(map[int]struct {
+			result1 *models.DesiredLRP
+			result2 error
+		})
+	}
+	fake.desiredLRPByProcessGuidReturnsOnCall[i] = struct {
+		result1 *models.DesiredLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) DesiredLRPRevisionDiff(arg1 lager.Logger, arg2 string, arg3 string, arg4 int32, arg5 int32) ([]*models.DesiredLRPRevisionChange, error) {
+	fake.desiredLRPRevisionDiffMutex.Lock()
+	ret, specificReturn := fake.desiredLRPRevisionDiffReturnsOnCall[len(fake.desiredLRPRevisionDiffArgsForCall)]
+	fake.desiredLRPRevisionDiffArgsForCall = append(fake.desiredLRPRevisionDiffArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+		arg4 int32
+		arg5 int32
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.DesiredLRPRevisionDiffStub
+	fakeReturns := fake.desiredLRPRevisionDiffReturns
+	fake.recordInvocation("DesiredLRPRevisionDiff", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.desiredLRPRevisionDiffMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) DesiredLRPRevisionDiffCallCount() int {
+	fake.desiredLRPRevisionDiffMutex.RLock()
+	defer fake.desiredLRPRevisionDiffMutex.RUnlock()
+	return len(fake.desiredLRPRevisionDiffArgsForCall)
+}
+
+func (fake *FakeInternalClient) DesiredLRPRevisionDiffCalls(stub func(lager.Logger, string, string, int32, int32) ([]*models.DesiredLRPRevisionChange, error)) {
+	fake.desiredLRPRevisionDiffMutex.Lock()
+	defer fake.desiredLRPRevisionDiffMutex.Unlock()
+	fake.DesiredLRPRevisionDiffStub = stub
+}
+
+func (fake *FakeInternalClient) DesiredLRPRevisionDiffArgsForCall(i int) (lager.Logger, string, string, int32, int32) {
+	fake.desiredLRPRevisionDiffMutex.RLock()
+	defer fake.desiredLRPRevisionDiffMutex.RUnlock()
+	argsForCall := fake.desiredLRPRevisionDiffArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeInternalClient) DesiredLRPRevisionDiffReturns(result1 []*models.DesiredLRPRevisionChange, result2 error) {
+	fake.desiredLRPRevisionDiffMutex.Lock()
+	defer fake.desiredLRPRevisionDiffMutex.Unlock()
+	fake.DesiredLRPRevisionDiffStub = nil
+	fake.desiredLRPRevisionDiffReturns = struct {
+		result1 []*models.DesiredLRPRevisionChange
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) DesiredLRPRevisionDiffReturnsOnCall(i int, result1 []*models.DesiredLRPRevisionChange, result2 error) {
+	fake.desiredLRPRevisionDiffMutex.Lock()
+	defer fake.desiredLRPRevisionDiffMutex.Unlock()
+	fake.DesiredLRPRevisionDiffStub = nil
+	if fake.desiredLRPRevisionDiffReturnsOnCall == nil {
+		fake.desiredLRPRevisionDiffReturnsOnCall = make(map[int]struct {
+			result1 []*models.DesiredLRPRevisionChange
+			result2 error
+		})
+	}
+	fake.desiredLRPRevisionDiffReturnsOnCall[i] = struct {
+		result1 []*models.DesiredLRPRevisionChange
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) DesiredLRPRevisions(arg1 lager.Logger, arg2 string, arg3 string) ([]*models.DesiredLRPRevision, error) {
+	fake.desiredLRPRevisionsMutex.Lock()
+	ret, specificReturn := fake.desiredLRPRevisionsReturnsOnCall[len(fake.desiredLRPRevisionsArgsForCall)]
+	fake.desiredLRPRevisionsArgsForCall = append(fake.desiredLRPRevisionsArgsForCall, struct {
 		arg1 lager.Logger
 		arg2 string
 		arg3 string
 	}{arg1, arg2, arg3})
-	stub := fake.DesiredLRPByProcessGuidStub
-	fakeReturns := fake.desiredLRPByProcessGuidReturns
-	fake.recordInvocation("DesiredLRPByProcessGuid", []interface{}{arg1, arg2, arg3})
-	fake.desiredLRPByProcessGuidMutex.Unlock()
+	stub := fake.DesiredLRPRevisionsStub
+	fakeReturns := fake.desiredLRPRevisionsReturns
+	fake.recordInvocation("DesiredLRPRevisions", []interface{}{arg1, arg2, arg3})
+	fake.desiredLRPRevisionsMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3)
 	}
@@ -1476,47 +2965,47 @@ func (fake *FakeInternalClient) DesiredLRPByProcessGuid(arg1 lager.Logger, arg2
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeInternalClient) DesiredLRPByProcessGuidCallCount() int {
-	fake.desiredLRPByProcessGuidMutex.RLock()
-	defer fake.desiredLRPByProcessGuidMutex.RUnlock()
-	return len(fake.desiredLRPByProcessGuidArgsForCall)
+func (fake *FakeInternalClient) DesiredLRPRevisionsCallCount() int {
+	fake.desiredLRPRevisionsMutex.RLock()
+	defer fake.desiredLRPRevisionsMutex.RUnlock()
+	return len(fake.desiredLRPRevisionsArgsForCall)
 }
 
-func (fake *FakeInternalClient) DesiredLRPByProcessGuidCalls(stub func(lager.Logger, string, string) (*models.DesiredLRP, error)) {
-	fake.desiredLRPByProcessGuidMutex.Lock()
-	defer fake.desiredLRPByProcessGuidMutex.Unlock()
-	fake.DesiredLRPByProcessGuidStub = stub
+func (fake *FakeInternalClient) DesiredLRPRevisionsCalls(stub func(lager.Logger, string, string) ([]*models.DesiredLRPRevision, error)) {
+	fake.desiredLRPRevisionsMutex.Lock()
+	defer fake.desiredLRPRevisionsMutex.Unlock()
+	fake.DesiredLRPRevisionsStub = stub
 }
 
-func (fake *FakeInternalClient) DesiredLRPByProcessGuidArgsForCall(i int) (lager.Logger, string, string) {
-	fake.desiredLRPByProcessGuidMutex.RLock()
-	defer fake.desiredLRPByProcessGuidMutex.RUnlock()
-	argsForCall := fake.desiredLRPByProcessGuidArgsForCall[i]
+func (fake *FakeInternalClient) DesiredLRPRevisionsArgsForCall(i int) (lager.Logger, string, string) {
+	fake.desiredLRPRevisionsMutex.RLock()
+	defer fake.desiredLRPRevisionsMutex.RUnlock()
+	argsForCall := fake.desiredLRPRevisionsArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeInternalClient) DesiredLRPByProcessGuidReturns(result1 *models.DesiredLRP, result2 error) {
-	fake.desiredLRPByProcessGuidMutex.Lock()
-	defer fake.desiredLRPByProcessGuidMutex.Unlock()
-	fake.DesiredLRPByProcessGuidStub = nil
-	fake.desiredLRPByProcessGuidReturns = struct {
-		result1 *models.DesiredLRP
+func (fake *FakeInternalClient) DesiredLRPRevisionsReturns(result1 []*models.DesiredLRPRevision, result2 error) {
+	fake.desiredLRPRevisionsMutex.Lock()
+	defer fake.desiredLRPRevisionsMutex.Unlock()
+	fake.DesiredLRPRevisionsStub = nil
+	fake.desiredLRPRevisionsReturns = struct {
+		result1 []*models.DesiredLRPRevision
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeInternalClient) DesiredLRPByProcessGuidReturnsOnCall(i int, result1 *models.DesiredLRP, result2 error) {
-	fake.desiredLRPByProcessGuidMutex.Lock()
-	defer fake.desiredLRPByProcessGuidMutex.Unlock()
-	fake.DesiredLRPByProcessGuidStub = nil
-	if fake.desiredLRPByProcessGuidReturnsOnCall == nil {
-		fake.desiredLRPByProcessGuidReturnsOnCall = make(map[int]struct {
-			result1 *models.DesiredLRP
+func (fake *FakeInternalClient) DesiredLRPRevisionsReturnsOnCall(i int, result1 []*models.DesiredLRPRevision, result2 error) {
+	fake.desiredLRPRevisionsMutex.Lock()
+	defer fake.desiredLRPRevisionsMutex.Unlock()
+	fake.DesiredLRPRevisionsStub = nil
+	if fake.desiredLRPRevisionsReturnsOnCall == nil {
+		fake.desiredLRPRevisionsReturnsOnCall = make(map[int]struct {
+			result1 []*models.DesiredLRPRevision
 			result2 error
 		})
 	}
-	fake.desiredLRPByProcessGuidReturnsOnCall[i] = struct {
-		result1 *models.DesiredLRP
+	fake.desiredLRPRevisionsReturnsOnCall[i] = struct {
+		result1 []*models.DesiredLRPRevision
 		result2 error
 	}{result1, result2}
 }
@@ -1719,6 +3208,72 @@ func (fake *FakeInternalClient) DesiredLRPSchedulingInfosReturnsOnCall(i int, re
 	}{result1, result2}
 }
 
+func (fake *FakeInternalClient) DesiredLRPStatus(arg1 lager.Logger, arg2 string, arg3 models.DesiredLRPFilter) ([]*models.DesiredLRPStatus, error) {
+	fake.desiredLRPStatusMutex.Lock()
+	ret, specificReturn := fake.desiredLRPStatusReturnsOnCall[len(fake.desiredLRPStatusArgsForCall)]
+	fake.desiredLRPStatusArgsForCall = append(fake.desiredLRPStatusArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 models.DesiredLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.DesiredLRPStatusStub
+	fakeReturns := fake.desiredLRPStatusReturns
+	fake.recordInvocation("DesiredLRPStatus", []interface{}{arg1, arg2, arg3})
+	fake.desiredLRPStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) DesiredLRPStatusCallCount() int {
+	fake.desiredLRPStatusMutex.RLock()
+	defer fake.desiredLRPStatusMutex.RUnlock()
+	return len(fake.desiredLRPStatusArgsForCall)
+}
+
+func (fake *FakeInternalClient) DesiredLRPStatusCalls(stub func(lager.Logger, string, models.DesiredLRPFilter) ([]*models.DesiredLRPStatus, error)) {
+	fake.desiredLRPStatusMutex.Lock()
+	defer fake.desiredLRPStatusMutex.Unlock()
+	fake.DesiredLRPStatusStub = stub
+}
+
+func (fake *FakeInternalClient) DesiredLRPStatusArgsForCall(i int) (lager.Logger, string, models.DesiredLRPFilter) {
+	fake.desiredLRPStatusMutex.RLock()
+	defer fake.desiredLRPStatusMutex.RUnlock()
+	argsForCall := fake.desiredLRPStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) DesiredLRPStatusReturns(result1 []*models.DesiredLRPStatus, result2 error) {
+	fake.desiredLRPStatusMutex.Lock()
+	defer fake.desiredLRPStatusMutex.Unlock()
+	fake.DesiredLRPStatusStub = nil
+	fake.desiredLRPStatusReturns = struct {
+		result1 []*models.DesiredLRPStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) DesiredLRPStatusReturnsOnCall(i int, result1 []*models.DesiredLRPStatus, result2 error) {
+	fake.desiredLRPStatusMutex.Lock()
+	defer fake.desiredLRPStatusMutex.Unlock()
+	fake.DesiredLRPStatusStub = nil
+	if fake.desiredLRPStatusReturnsOnCall == nil {
+		fake.desiredLRPStatusReturnsOnCall = make(map[int]struct {
+			result1 []*models.DesiredLRPStatus
+			result2 error
+		})
+	}
+	fake.desiredLRPStatusReturnsOnCall[i] = struct {
+		result1 []*models.DesiredLRPStatus
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInternalClient) DesiredLRPs(arg1 lager.Logger, arg2 string, arg3 models.DesiredLRPFilter) ([]*models.DesiredLRP, error) {
 	fake.desiredLRPsMutex.Lock()
 	ret, specificReturn := fake.desiredLRPsReturnsOnCall[len(fake.desiredLRPsArgsForCall)]
@@ -1785,6 +3340,137 @@ func (fake *FakeInternalClient) DesiredLRPsReturnsOnCall(i int, result1 []*model
 	}{result1, result2}
 }
 
+func (fake *FakeInternalClient) DomainQuotas(arg1 lager.Logger, arg2 string) ([]*models.DomainQuota, error) {
+	fake.domainQuotasMutex.Lock()
+	ret, specificReturn := fake.domainQuotasReturnsOnCall[len(fake.domainQuotasArgsForCall)]
+	fake.domainQuotasArgsForCall = append(fake.domainQuotasArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DomainQuotasStub
+	fakeReturns := fake.domainQuotasReturns
+	fake.recordInvocation("DomainQuotas", []interface{}{arg1, arg2})
+	fake.domainQuotasMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) DomainQuotasCallCount() int {
+	fake.domainQuotasMutex.RLock()
+	defer fake.domainQuotasMutex.RUnlock()
+	return len(fake.domainQuotasArgsForCall)
+}
+
+func (fake *FakeInternalClient) DomainQuotasCalls(stub func(lager.Logger, string) ([]*models.DomainQuota, error)) {
+	fake.domainQuotasMutex.Lock()
+	defer fake.domainQuotasMutex.Unlock()
+	fake.DomainQuotasStub = stub
+}
+
+func (fake *FakeInternalClient) DomainQuotasArgsForCall(i int) (lager.Logger, string) {
+	fake.domainQuotasMutex.RLock()
+	defer fake.domainQuotasMutex.RUnlock()
+	argsForCall := fake.domainQuotasArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInternalClient) DomainQuotasReturns(result1 []*models.DomainQuota, result2 error) {
+	fake.domainQuotasMutex.Lock()
+	defer fake.domainQuotasMutex.Unlock()
+	fake.DomainQuotasStub = nil
+	fake.domainQuotasReturns = struct {
+		result1 []*models.DomainQuota
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) DomainQuotasReturnsOnCall(i int, result1 []*models.DomainQuota, result2 error) {
+	fake.domainQuotasMutex.Lock()
+	defer fake.domainQuotasMutex.Unlock()
+	fake.DomainQuotasStub = nil
+	if fake.domainQuotasReturnsOnCall == nil {
+		fake.domainQuotasReturnsOnCall = make(map[int]struct {
+			result1 []*models.DomainQuota
+			result2 error
+		})
+	}
+	fake.domainQuotasReturnsOnCall[i] = struct {
+		result1 []*models.DomainQuota
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) DomainUsage(arg1 lager.Logger, arg2 string, arg3 string) (*models.DomainUsage, error) {
+	fake.domainUsageMutex.Lock()
+	ret, specificReturn := fake.domainUsageReturnsOnCall[len(fake.domainUsageArgsForCall)]
+	fake.domainUsageArgsForCall = append(fake.domainUsageArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DomainUsageStub
+	fakeReturns := fake.domainUsageReturns
+	fake.recordInvocation("DomainUsage", []interface{}{arg1, arg2, arg3})
+	fake.domainUsageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) DomainUsageCallCount() int {
+	fake.domainUsageMutex.RLock()
+	defer fake.domainUsageMutex.RUnlock()
+	return len(fake.domainUsageArgsForCall)
+}
+
+func (fake *FakeInternalClient) DomainUsageCalls(stub func(lager.Logger, string, string) (*models.DomainUsage, error)) {
+	fake.domainUsageMutex.Lock()
+	defer fake.domainUsageMutex.Unlock()
+	fake.DomainUsageStub = stub
+}
+
+func (fake *FakeInternalClient) DomainUsageArgsForCall(i int) (lager.Logger, string, string) {
+	fake.domainUsageMutex.RLock()
+	defer fake.domainUsageMutex.RUnlock()
+	argsForCall := fake.domainUsageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) DomainUsageReturns(result1 *models.DomainUsage, result2 error) {
+	fake.domainUsageMutex.Lock()
+	defer fake.domainUsageMutex.Unlock()
+	fake.DomainUsageStub = nil
+	fake.domainUsageReturns = struct {
+		result1 *models.DomainUsage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) DomainUsageReturnsOnCall(i int, result1 *models.DomainUsage, result2 error) {
+	fake.domainUsageMutex.Lock()
+	defer fake.domainUsageMutex.Unlock()
+	fake.DomainUsageStub = nil
+	if fake.domainUsageReturnsOnCall == nil {
+		fake.domainUsageReturnsOnCall = make(map[int]struct {
+			result1 *models.DomainUsage
+			result2 error
+		})
+	}
+	fake.domainUsageReturnsOnCall[i] = struct {
+		result1 *models.DomainUsage
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInternalClient) Domains(arg1 lager.Logger, arg2 string) ([]string, error) {
 	fake.domainsMutex.Lock()
 	ret, specificReturn := fake.domainsReturnsOnCall[len(fake.domainsArgsForCall)]
@@ -1850,6 +3536,69 @@ func (fake *FakeInternalClient) DomainsReturnsOnCall(i int, result1 []string, re
 	}{result1, result2}
 }
 
+func (fake *FakeInternalClient) DrainCell(arg1 lager.Logger, arg2 string, arg3 string) error {
+	fake.drainCellMutex.Lock()
+	ret, specificReturn := fake.drainCellReturnsOnCall[len(fake.drainCellArgsForCall)]
+	fake.drainCellArgsForCall = append(fake.drainCellArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DrainCellStub
+	fakeReturns := fake.drainCellReturns
+	fake.recordInvocation("DrainCell", []interface{}{arg1, arg2, arg3})
+	fake.drainCellMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInternalClient) DrainCellCallCount() int {
+	fake.drainCellMutex.RLock()
+	defer fake.drainCellMutex.RUnlock()
+	return len(fake.drainCellArgsForCall)
+}
+
+func (fake *FakeInternalClient) DrainCellCalls(stub func(lager.Logger, string, string) error) {
+	fake.drainCellMutex.Lock()
+	defer fake.drainCellMutex.Unlock()
+	fake.DrainCellStub = stub
+}
+
+func (fake *FakeInternalClient) DrainCellArgsForCall(i int) (lager.Logger, string, string) {
+	fake.drainCellMutex.RLock()
+	defer fake.drainCellMutex.RUnlock()
+	argsForCall := fake.drainCellArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) DrainCellReturns(result1 error) {
+	fake.drainCellMutex.Lock()
+	defer fake.drainCellMutex.Unlock()
+	fake.DrainCellStub = nil
+	fake.drainCellReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalClient) DrainCellReturnsOnCall(i int, result1 error) {
+	fake.drainCellMutex.Lock()
+	defer fake.drainCellMutex.Unlock()
+	fake.DrainCellStub = nil
+	if fake.drainCellReturnsOnCall == nil {
+		fake.drainCellReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.drainCellReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeInternalClient) EvacuateClaimedActualLRP(arg1 lager.Logger, arg2 string, arg3 *models.ActualLRPKey, arg4 *models.ActualLRPInstanceKey) (bool, error) {
 	fake.evacuateClaimedActualLRPMutex.Lock()
 	ret, specificReturn := fake.evacuateClaimedActualLRPReturnsOnCall[len(fake.evacuateClaimedActualLRPArgsForCall)]
@@ -2129,6 +3878,69 @@ func (fake *FakeInternalClient) EvacuateStoppedActualLRPReturnsOnCall(i int, res
 	}{result1, result2}
 }
 
+func (fake *FakeInternalClient) ExpireDomain(arg1 lager.Logger, arg2 string, arg3 string) error {
+	fake.expireDomainMutex.Lock()
+	ret, specificReturn := fake.expireDomainReturnsOnCall[len(fake.expireDomainArgsForCall)]
+	fake.expireDomainArgsForCall = append(fake.expireDomainArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ExpireDomainStub
+	fakeReturns := fake.expireDomainReturns
+	fake.recordInvocation("ExpireDomain", []interface{}{arg1, arg2, arg3})
+	fake.expireDomainMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInternalClient) ExpireDomainCallCount() int {
+	fake.expireDomainMutex.RLock()
+	defer fake.expireDomainMutex.RUnlock()
+	return len(fake.expireDomainArgsForCall)
+}
+
+func (fake *FakeInternalClient) ExpireDomainCalls(stub func(lager.Logger, string, string) error) {
+	fake.expireDomainMutex.Lock()
+	defer fake.expireDomainMutex.Unlock()
+	fake.ExpireDomainStub = stub
+}
+
+func (fake *FakeInternalClient) ExpireDomainArgsForCall(i int) (lager.Logger, string, string) {
+	fake.expireDomainMutex.RLock()
+	defer fake.expireDomainMutex.RUnlock()
+	argsForCall := fake.expireDomainArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) ExpireDomainReturns(result1 error) {
+	fake.expireDomainMutex.Lock()
+	defer fake.expireDomainMutex.Unlock()
+	fake.ExpireDomainStub = nil
+	fake.expireDomainReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalClient) ExpireDomainReturnsOnCall(i int, result1 error) {
+	fake.expireDomainMutex.Lock()
+	defer fake.expireDomainMutex.Unlock()
+	fake.ExpireDomainStub = nil
+	if fake.expireDomainReturnsOnCall == nil {
+		fake.expireDomainReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.expireDomainReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeInternalClient) FailActualLRP(arg1 lager.Logger, arg2 string, arg3 *models.ActualLRPKey, arg4 string) error {
 	fake.failActualLRPMutex.Lock()
 	ret, specificReturn := fake.failActualLRPReturnsOnCall[len(fake.failActualLRPArgsForCall)]
@@ -2623,16 +4435,143 @@ func (fake *FakeInternalClient) ResolvingTaskReturns(result1 error) {
 	}{result1}
 }
 
-func (fake *FakeInternalClient) ResolvingTaskReturnsOnCall(i int, result1 error) {
-	fake.resolvingTaskMutex.Lock()
-	defer fake.resolvingTaskMutex.Unlock()
-	fake.ResolvingTaskStub = nil
-	if fake.resolvingTaskReturnsOnCall == nil {
-		fake.resolvingTaskReturnsOnCall = make(map[int]struct {
+func (fake *FakeInternalClient) ResolvingTaskReturnsOnCall(i int, result1 error) {
+	fake.resolvingTaskMutex.Lock()
+	defer fake.resolvingTaskMutex.Unlock()
+	fake.ResolvingTaskStub = nil
+	if fake.resolvingTaskReturnsOnCall == nil {
+		fake.resolvingTaskReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.resolvingTaskReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalClient) RestartActualLRP(arg1 lager.Logger, arg2 string, arg3 *models.ActualLRPKey) error {
+	fake.restartActualLRPMutex.Lock()
+	ret, specificReturn := fake.restartActualLRPReturnsOnCall[len(fake.restartActualLRPArgsForCall)]
+	fake.restartActualLRPArgsForCall = append(fake.restartActualLRPArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 *models.ActualLRPKey
+	}{arg1, arg2, arg3})
+	stub := fake.RestartActualLRPStub
+	fakeReturns := fake.restartActualLRPReturns
+	fake.recordInvocation("RestartActualLRP", []interface{}{arg1, arg2, arg3})
+	fake.restartActualLRPMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInternalClient) RestartActualLRPCallCount() int {
+	fake.restartActualLRPMutex.RLock()
+	defer fake.restartActualLRPMutex.RUnlock()
+	return len(fake.restartActualLRPArgsForCall)
+}
+
+func (fake *FakeInternalClient) RestartActualLRPCalls(stub func(lager.Logger, string, *models.ActualLRPKey) error) {
+	fake.restartActualLRPMutex.Lock()
+	defer fake.restartActualLRPMutex.Unlock()
+	fake.RestartActualLRPStub = stub
+}
+
+func (fake *FakeInternalClient) RestartActualLRPArgsForCall(i int) (lager.Logger, string, *models.ActualLRPKey) {
+	fake.restartActualLRPMutex.RLock()
+	defer fake.restartActualLRPMutex.RUnlock()
+	argsForCall := fake.restartActualLRPArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) RestartActualLRPReturns(result1 error) {
+	fake.restartActualLRPMutex.Lock()
+	defer fake.restartActualLRPMutex.Unlock()
+	fake.RestartActualLRPStub = nil
+	fake.restartActualLRPReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalClient) RestartActualLRPReturnsOnCall(i int, result1 error) {
+	fake.restartActualLRPMutex.Lock()
+	defer fake.restartActualLRPMutex.Unlock()
+	fake.RestartActualLRPStub = nil
+	if fake.restartActualLRPReturnsOnCall == nil {
+		fake.restartActualLRPReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.restartActualLRPReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalClient) RestartDesiredLRP(arg1 lager.Logger, arg2 string, arg3 string, arg4 int32) error {
+	fake.restartDesiredLRPMutex.Lock()
+	ret, specificReturn := fake.restartDesiredLRPReturnsOnCall[len(fake.restartDesiredLRPArgsForCall)]
+	fake.restartDesiredLRPArgsForCall = append(fake.restartDesiredLRPArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+		arg4 int32
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RestartDesiredLRPStub
+	fakeReturns := fake.restartDesiredLRPReturns
+	fake.recordInvocation("RestartDesiredLRP", []interface{}{arg1, arg2, arg3, arg4})
+	fake.restartDesiredLRPMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInternalClient) RestartDesiredLRPCallCount() int {
+	fake.restartDesiredLRPMutex.RLock()
+	defer fake.restartDesiredLRPMutex.RUnlock()
+	return len(fake.restartDesiredLRPArgsForCall)
+}
+
+func (fake *FakeInternalClient) RestartDesiredLRPCalls(stub func(lager.Logger, string, string, int32) error) {
+	fake.restartDesiredLRPMutex.Lock()
+	defer fake.restartDesiredLRPMutex.Unlock()
+	fake.RestartDesiredLRPStub = stub
+}
+
+func (fake *FakeInternalClient) RestartDesiredLRPArgsForCall(i int) (lager.Logger, string, string, int32) {
+	fake.restartDesiredLRPMutex.RLock()
+	defer fake.restartDesiredLRPMutex.RUnlock()
+	argsForCall := fake.restartDesiredLRPArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeInternalClient) RestartDesiredLRPReturns(result1 error) {
+	fake.restartDesiredLRPMutex.Lock()
+	defer fake.restartDesiredLRPMutex.Unlock()
+	fake.RestartDesiredLRPStub = nil
+	fake.restartDesiredLRPReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalClient) RestartDesiredLRPReturnsOnCall(i int, result1 error) {
+	fake.restartDesiredLRPMutex.Lock()
+	defer fake.restartDesiredLRPMutex.Unlock()
+	fake.RestartDesiredLRPStub = nil
+	if fake.restartDesiredLRPReturnsOnCall == nil {
+		fake.restartDesiredLRPReturnsOnCall = make(map[int]struct {
 			result1 error
 		})
 	}
-	fake.resolvingTaskReturnsOnCall[i] = struct {
+	fake.restartDesiredLRPReturnsOnCall[i] = struct {
 		result1 error
 	}{result1}
 }
@@ -2700,6 +4639,202 @@ func (fake *FakeInternalClient) RetireActualLRPReturnsOnCall(i int, result1 erro
 	}{result1}
 }
 
+func (fake *FakeInternalClient) RollbackDesiredLRP(arg1 lager.Logger, arg2 string, arg3 string, arg4 int32) error {
+	fake.rollbackDesiredLRPMutex.Lock()
+	ret, specificReturn := fake.rollbackDesiredLRPReturnsOnCall[len(fake.rollbackDesiredLRPArgsForCall)]
+	fake.rollbackDesiredLRPArgsForCall = append(fake.rollbackDesiredLRPArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+		arg4 int32
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RollbackDesiredLRPStub
+	fakeReturns := fake.rollbackDesiredLRPReturns
+	fake.recordInvocation("RollbackDesiredLRP", []interface{}{arg1, arg2, arg3, arg4})
+	fake.rollbackDesiredLRPMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInternalClient) RollbackDesiredLRPCallCount() int {
+	fake.rollbackDesiredLRPMutex.RLock()
+	defer fake.rollbackDesiredLRPMutex.RUnlock()
+	return len(fake.rollbackDesiredLRPArgsForCall)
+}
+
+func (fake *FakeInternalClient) RollbackDesiredLRPCalls(stub func(lager.Logger, string, string, int32) error) {
+	fake.rollbackDesiredLRPMutex.Lock()
+	defer fake.rollbackDesiredLRPMutex.Unlock()
+	fake.RollbackDesiredLRPStub = stub
+}
+
+func (fake *FakeInternalClient) RollbackDesiredLRPArgsForCall(i int) (lager.Logger, string, string, int32) {
+	fake.rollbackDesiredLRPMutex.RLock()
+	defer fake.rollbackDesiredLRPMutex.RUnlock()
+	argsForCall := fake.rollbackDesiredLRPArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeInternalClient) RollbackDesiredLRPReturns(result1 error) {
+	fake.rollbackDesiredLRPMutex.Lock()
+	defer fake.rollbackDesiredLRPMutex.Unlock()
+	fake.RollbackDesiredLRPStub = nil
+	fake.rollbackDesiredLRPReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalClient) RollbackDesiredLRPReturnsOnCall(i int, result1 error) {
+	fake.rollbackDesiredLRPMutex.Lock()
+	defer fake.rollbackDesiredLRPMutex.Unlock()
+	fake.RollbackDesiredLRPStub = nil
+	if fake.rollbackDesiredLRPReturnsOnCall == nil {
+		fake.rollbackDesiredLRPReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.rollbackDesiredLRPReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalClient) ScheduledTaskByGuid(arg1 lager.Logger, arg2 string, arg3 string) (*models.ScheduledTask, error) {
+	fake.scheduledTaskByGuidMutex.Lock()
+	ret, specificReturn := fake.scheduledTaskByGuidReturnsOnCall[len(fake.scheduledTaskByGuidArgsForCall)]
+	fake.scheduledTaskByGuidArgsForCall = append(fake.scheduledTaskByGuidArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ScheduledTaskByGuidStub
+	fakeReturns := fake.scheduledTaskByGuidReturns
+	fake.recordInvocation("ScheduledTaskByGuid", []interface{}{arg1, arg2, arg3})
+	fake.scheduledTaskByGuidMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) ScheduledTaskByGuidCallCount() int {
+	fake.scheduledTaskByGuidMutex.RLock()
+	defer fake.scheduledTaskByGuidMutex.RUnlock()
+	return len(fake.scheduledTaskByGuidArgsForCall)
+}
+
+func (fake *FakeInternalClient) ScheduledTaskByGuidCalls(stub func(lager.Logger, string, string) (*models.ScheduledTask, error)) {
+	fake.scheduledTaskByGuidMutex.Lock()
+	defer fake.scheduledTaskByGuidMutex.Unlock()
+	fake.ScheduledTaskByGuidStub = stub
+}
+
+func (fake *FakeInternalClient) ScheduledTaskByGuidArgsForCall(i int) (lager.Logger, string, string) {
+	fake.scheduledTaskByGuidMutex.RLock()
+	defer fake.scheduledTaskByGuidMutex.RUnlock()
+	argsForCall := fake.scheduledTaskByGuidArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) ScheduledTaskByGuidReturns(result1 *models.ScheduledTask, result2 error) {
+	fake.scheduledTaskByGuidMutex.Lock()
+	defer fake.scheduledTaskByGuidMutex.Unlock()
+	fake.ScheduledTaskByGuidStub = nil
+	fake.scheduledTaskByGuidReturns = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) ScheduledTaskByGuidReturnsOnCall(i int, result1 *models.ScheduledTask, result2 error) {
+	fake.scheduledTaskByGuidMutex.Lock()
+	defer fake.scheduledTaskByGuidMutex.Unlock()
+	fake.ScheduledTaskByGuidStub = nil
+	if fake.scheduledTaskByGuidReturnsOnCall == nil {
+		fake.scheduledTaskByGuidReturnsOnCall = make(map[int]struct {
+			result1 *models.ScheduledTask
+			result2 error
+		})
+	}
+	fake.scheduledTaskByGuidReturnsOnCall[i] = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) ScheduledTasks(arg1 lager.Logger, arg2 string, arg3 string) ([]*models.ScheduledTask, error) {
+	fake.scheduledTasksMutex.Lock()
+	ret, specificReturn := fake.scheduledTasksReturnsOnCall[len(fake.scheduledTasksArgsForCall)]
+	fake.scheduledTasksArgsForCall = append(fake.scheduledTasksArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ScheduledTasksStub
+	fakeReturns := fake.scheduledTasksReturns
+	fake.recordInvocation("ScheduledTasks", []interface{}{arg1, arg2, arg3})
+	fake.scheduledTasksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) ScheduledTasksCallCount() int {
+	fake.scheduledTasksMutex.RLock()
+	defer fake.scheduledTasksMutex.RUnlock()
+	return len(fake.scheduledTasksArgsForCall)
+}
+
+func (fake *FakeInternalClient) ScheduledTasksCalls(stub func(lager.Logger, string, string) ([]*models.ScheduledTask, error)) {
+	fake.scheduledTasksMutex.Lock()
+	defer fake.scheduledTasksMutex.Unlock()
+	fake.ScheduledTasksStub = stub
+}
+
+func (fake *FakeInternalClient) ScheduledTasksArgsForCall(i int) (lager.Logger, string, string) {
+	fake.scheduledTasksMutex.RLock()
+	defer fake.scheduledTasksMutex.RUnlock()
+	argsForCall := fake.scheduledTasksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) ScheduledTasksReturns(result1 []*models.ScheduledTask, result2 error) {
+	fake.scheduledTasksMutex.Lock()
+	defer fake.scheduledTasksMutex.Unlock()
+	fake.ScheduledTasksStub = nil
+	fake.scheduledTasksReturns = struct {
+		result1 []*models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) ScheduledTasksReturnsOnCall(i int, result1 []*models.ScheduledTask, result2 error) {
+	fake.scheduledTasksMutex.Lock()
+	defer fake.scheduledTasksMutex.Unlock()
+	fake.ScheduledTasksStub = nil
+	if fake.scheduledTasksReturnsOnCall == nil {
+		fake.scheduledTasksReturnsOnCall = make(map[int]struct {
+			result1 []*models.ScheduledTask
+			result2 error
+		})
+	}
+	fake.scheduledTasksReturnsOnCall[i] = struct {
+		result1 []*models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInternalClient) StartActualLRP(arg1 lager.Logger, arg2 string, arg3 *models.ActualLRPKey, arg4 *models.ActualLRPInstanceKey, arg5 *models.ActualLRPNetInfo, arg6 []*models.ActualLRPInternalRoute, arg7 map[string]string, arg8 bool, arg9 string) error {
 	var arg6Copy []*models.ActualLRPInternalRoute
 	if arg6 != nil {
@@ -3163,6 +5298,72 @@ func (fake *FakeInternalClient) SubscribeToTaskEventsReturnsOnCall(i int, result
 	}{result1, result2}
 }
 
+func (fake *FakeInternalClient) TaskArrayStatus(arg1 lager.Logger, arg2 string, arg3 string) (*models.TaskArrayStatus, error) {
+	fake.taskArrayStatusMutex.Lock()
+	ret, specificReturn := fake.taskArrayStatusReturnsOnCall[len(fake.taskArrayStatusArgsForCall)]
+	fake.taskArrayStatusArgsForCall = append(fake.taskArrayStatusArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.TaskArrayStatusStub
+	fakeReturns := fake.taskArrayStatusReturns
+	fake.recordInvocation("TaskArrayStatus", []interface{}{arg1, arg2, arg3})
+	fake.taskArrayStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) TaskArrayStatusCallCount() int {
+	fake.taskArrayStatusMutex.RLock()
+	defer fake.taskArrayStatusMutex.RUnlock()
+	return len(fake.taskArrayStatusArgsForCall)
+}
+
+func (fake *FakeInternalClient) TaskArrayStatusCalls(stub func(lager.Logger, string, string) (*models.TaskArrayStatus, error)) {
+	fake.taskArrayStatusMutex.Lock()
+	defer fake.taskArrayStatusMutex.Unlock()
+	fake.TaskArrayStatusStub = stub
+}
+
+func (fake *FakeInternalClient) TaskArrayStatusArgsForCall(i int) (lager.Logger, string, string) {
+	fake.taskArrayStatusMutex.RLock()
+	defer fake.taskArrayStatusMutex.RUnlock()
+	argsForCall := fake.taskArrayStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) TaskArrayStatusReturns(result1 *models.TaskArrayStatus, result2 error) {
+	fake.taskArrayStatusMutex.Lock()
+	defer fake.taskArrayStatusMutex.Unlock()
+	fake.TaskArrayStatusStub = nil
+	fake.taskArrayStatusReturns = struct {
+		result1 *models.TaskArrayStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) TaskArrayStatusReturnsOnCall(i int, result1 *models.TaskArrayStatus, result2 error) {
+	fake.taskArrayStatusMutex.Lock()
+	defer fake.taskArrayStatusMutex.Unlock()
+	fake.TaskArrayStatusStub = nil
+	if fake.taskArrayStatusReturnsOnCall == nil {
+		fake.taskArrayStatusReturnsOnCall = make(map[int]struct {
+			result1 *models.TaskArrayStatus
+			result2 error
+		})
+	}
+	fake.taskArrayStatusReturnsOnCall[i] = struct {
+		result1 *models.TaskArrayStatus
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInternalClient) TaskByGuid(arg1 lager.Logger, arg2 string, arg3 string) (*models.Task, error) {
 	fake.taskByGuidMutex.Lock()
 	ret, specificReturn := fake.taskByGuidReturnsOnCall[len(fake.taskByGuidArgsForCall)]
@@ -3229,6 +5430,72 @@ func (fake *FakeInternalClient) TaskByGuidReturnsOnCall(i int, result1 *models.T
 	}{result1, result2}
 }
 
+func (fake *FakeInternalClient) TaskResult(arg1 lager.Logger, arg2 string, arg3 string) (string, error) {
+	fake.taskResultMutex.Lock()
+	ret, specificReturn := fake.taskResultReturnsOnCall[len(fake.taskResultArgsForCall)]
+	fake.taskResultArgsForCall = append(fake.taskResultArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.TaskResultStub
+	fakeReturns := fake.taskResultReturns
+	fake.recordInvocation("TaskResult", []interface{}{arg1, arg2, arg3})
+	fake.taskResultMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) TaskResultCallCount() int {
+	fake.taskResultMutex.RLock()
+	defer fake.taskResultMutex.RUnlock()
+	return len(fake.taskResultArgsForCall)
+}
+
+func (fake *FakeInternalClient) TaskResultCalls(stub func(lager.Logger, string, string) (string, error)) {
+	fake.taskResultMutex.Lock()
+	defer fake.taskResultMutex.Unlock()
+	fake.TaskResultStub = stub
+}
+
+func (fake *FakeInternalClient) TaskResultArgsForCall(i int) (lager.Logger, string, string) {
+	fake.taskResultMutex.RLock()
+	defer fake.taskResultMutex.RUnlock()
+	argsForCall := fake.taskResultArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) TaskResultReturns(result1 string, result2 error) {
+	fake.taskResultMutex.Lock()
+	defer fake.taskResultMutex.Unlock()
+	fake.TaskResultStub = nil
+	fake.taskResultReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) TaskResultReturnsOnCall(i int, result1 string, result2 error) {
+	fake.taskResultMutex.Lock()
+	defer fake.taskResultMutex.Unlock()
+	fake.TaskResultStub = nil
+	if fake.taskResultReturnsOnCall == nil {
+		fake.taskResultReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.taskResultReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInternalClient) Tasks(arg1 lager.Logger, arg2 string) ([]*models.Task, error) {
 	fake.tasksMutex.Lock()
 	ret, specificReturn := fake.tasksReturnsOnCall[len(fake.tasksArgsForCall)]
@@ -3492,6 +5759,69 @@ func (fake *FakeInternalClient) TasksWithFilterReturnsOnCall(i int, result1 []*m
 	}{result1, result2}
 }
 
+func (fake *FakeInternalClient) UncordonCell(arg1 lager.Logger, arg2 string, arg3 string) error {
+	fake.uncordonCellMutex.Lock()
+	ret, specificReturn := fake.uncordonCellReturnsOnCall[len(fake.uncordonCellArgsForCall)]
+	fake.uncordonCellArgsForCall = append(fake.uncordonCellArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.UncordonCellStub
+	fakeReturns := fake.uncordonCellReturns
+	fake.recordInvocation("UncordonCell", []interface{}{arg1, arg2, arg3})
+	fake.uncordonCellMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInternalClient) UncordonCellCallCount() int {
+	fake.uncordonCellMutex.RLock()
+	defer fake.uncordonCellMutex.RUnlock()
+	return len(fake.uncordonCellArgsForCall)
+}
+
+func (fake *FakeInternalClient) UncordonCellCalls(stub func(lager.Logger, string, string) error) {
+	fake.uncordonCellMutex.Lock()
+	defer fake.uncordonCellMutex.Unlock()
+	fake.UncordonCellStub = stub
+}
+
+func (fake *FakeInternalClient) UncordonCellArgsForCall(i int) (lager.Logger, string, string) {
+	fake.uncordonCellMutex.RLock()
+	defer fake.uncordonCellMutex.RUnlock()
+	argsForCall := fake.uncordonCellArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) UncordonCellReturns(result1 error) {
+	fake.uncordonCellMutex.Lock()
+	defer fake.uncordonCellMutex.Unlock()
+	fake.UncordonCellStub = nil
+	fake.uncordonCellReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalClient) UncordonCellReturnsOnCall(i int, result1 error) {
+	fake.uncordonCellMutex.Lock()
+	defer fake.uncordonCellMutex.Unlock()
+	fake.UncordonCellStub = nil
+	if fake.uncordonCellReturnsOnCall == nil {
+		fake.uncordonCellReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.uncordonCellReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeInternalClient) UpdateDesiredLRP(arg1 lager.Logger, arg2 string, arg3 string, arg4 *models.DesiredLRPUpdate) error {
 	fake.updateDesiredLRPMutex.Lock()
 	ret, specificReturn := fake.updateDesiredLRPReturnsOnCall[len(fake.updateDesiredLRPArgsForCall)]
@@ -3556,6 +5886,69 @@ func (fake *FakeInternalClient) UpdateDesiredLRPReturnsOnCall(i int, result1 err
 	}{result1}
 }
 
+func (fake *FakeInternalClient) UpdateScheduledTask(arg1 lager.Logger, arg2 string, arg3 *models.ScheduledTask) error {
+	fake.updateScheduledTaskMutex.Lock()
+	ret, specificReturn := fake.updateScheduledTaskReturnsOnCall[len(fake.updateScheduledTaskArgsForCall)]
+	fake.updateScheduledTaskArgsForCall = append(fake.updateScheduledTaskArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 *models.ScheduledTask
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateScheduledTaskStub
+	fakeReturns := fake.updateScheduledTaskReturns
+	fake.recordInvocation("UpdateScheduledTask", []interface{}{arg1, arg2, arg3})
+	fake.updateScheduledTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInternalClient) UpdateScheduledTaskCallCount() int {
+	fake.updateScheduledTaskMutex.RLock()
+	defer fake.updateScheduledTaskMutex.RUnlock()
+	return len(fake.updateScheduledTaskArgsForCall)
+}
+
+func (fake *FakeInternalClient) UpdateScheduledTaskCalls(stub func(lager.Logger, string, *models.ScheduledTask) error) {
+	fake.updateScheduledTaskMutex.Lock()
+	defer fake.updateScheduledTaskMutex.Unlock()
+	fake.UpdateScheduledTaskStub = stub
+}
+
+func (fake *FakeInternalClient) UpdateScheduledTaskArgsForCall(i int) (lager.Logger, string, *models.ScheduledTask) {
+	fake.updateScheduledTaskMutex.RLock()
+	defer fake.updateScheduledTaskMutex.RUnlock()
+	argsForCall := fake.updateScheduledTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) UpdateScheduledTaskReturns(result1 error) {
+	fake.updateScheduledTaskMutex.Lock()
+	defer fake.updateScheduledTaskMutex.Unlock()
+	fake.UpdateScheduledTaskStub = nil
+	fake.updateScheduledTaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalClient) UpdateScheduledTaskReturnsOnCall(i int, result1 error) {
+	fake.updateScheduledTaskMutex.Lock()
+	defer fake.updateScheduledTaskMutex.Unlock()
+	fake.UpdateScheduledTaskStub = nil
+	if fake.updateScheduledTaskReturnsOnCall == nil {
+		fake.updateScheduledTaskReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateScheduledTaskReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeInternalClient) UpsertDomain(arg1 lager.Logger, arg2 string, arg3 string, arg4 time.Duration) error {
 	fake.upsertDomainMutex.Lock()
 	ret, specificReturn := fake.upsertDomainReturnsOnCall[len(fake.upsertDomainArgsForCall)]
@@ -3620,9 +6013,74 @@ func (fake *FakeInternalClient) UpsertDomainReturnsOnCall(i int, result1 error)
 	}{result1}
 }
 
+func (fake *FakeInternalClient) UpsertDomainQuota(arg1 lager.Logger, arg2 string, arg3 *models.DomainQuota) error {
+	fake.upsertDomainQuotaMutex.Lock()
+	ret, specificReturn := fake.upsertDomainQuotaReturnsOnCall[len(fake.upsertDomainQuotaArgsForCall)]
+	fake.upsertDomainQuotaArgsForCall = append(fake.upsertDomainQuotaArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 *models.DomainQuota
+	}{arg1, arg2, arg3})
+	stub := fake.UpsertDomainQuotaStub
+	fakeReturns := fake.upsertDomainQuotaReturns
+	fake.recordInvocation("UpsertDomainQuota", []interface{}{arg1, arg2, arg3})
+	fake.upsertDomainQuotaMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInternalClient) UpsertDomainQuotaCallCount() int {
+	fake.upsertDomainQuotaMutex.RLock()
+	defer fake.upsertDomainQuotaMutex.RUnlock()
+	return len(fake.upsertDomainQuotaArgsForCall)
+}
+
+func (fake *FakeInternalClient) UpsertDomainQuotaCalls(stub func(lager.Logger, string, *models.DomainQuota) error) {
+	fake.upsertDomainQuotaMutex.Lock()
+	defer fake.upsertDomainQuotaMutex.Unlock()
+	fake.UpsertDomainQuotaStub = stub
+}
+
+func (fake *FakeInternalClient) UpsertDomainQuotaArgsForCall(i int) (lager.Logger, string, *models.DomainQuota) {
+	fake.upsertDomainQuotaMutex.RLock()
+	defer fake.upsertDomainQuotaMutex.RUnlock()
+	argsForCall := fake.upsertDomainQuotaArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) UpsertDomainQuotaReturns(result1 error) {
+	fake.upsertDomainQuotaMutex.Lock()
+	defer fake.upsertDomainQuotaMutex.Unlock()
+	fake.UpsertDomainQuotaStub = nil
+	fake.upsertDomainQuotaReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalClient) UpsertDomainQuotaReturnsOnCall(i int, result1 error) {
+	fake.upsertDomainQuotaMutex.Lock()
+	defer fake.upsertDomainQuotaMutex.Unlock()
+	fake.UpsertDomainQuotaStub = nil
+	if fake.upsertDomainQuotaReturnsOnCall == nil {
+		fake.upsertDomainQuotaReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.upsertDomainQuotaReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeInternalClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.actualLRPCrashesMutex.RLock()
+	defer fake.actualLRPCrashesMutex.RUnlock()
 	fake.actualLRPGroupByProcessGuidAndIndexMutex.RLock()
 	defer fake.actualLRPGroupByProcessGuidAndIndexMutex.RUnlock()
 	fake.actualLRPGroupsMutex.RLock()
@@ -3631,34 +6089,72 @@ func (fake *FakeInternalClient) Invocations() map[string][][]interface{} {
 	defer fake.actualLRPGroupsByProcessGuidMutex.RUnlock()
 	fake.actualLRPsMutex.RLock()
 	defer fake.actualLRPsMutex.RUnlock()
+	fake.actualLRPsWithCrashHistoryMutex.RLock()
+	defer fake.actualLRPsWithCrashHistoryMutex.RUnlock()
+	fake.allDomainsMutex.RLock()
+	defer fake.allDomainsMutex.RUnlock()
+	fake.archivedTaskByGuidMutex.RLock()
+	defer fake.archivedTaskByGuidMutex.RUnlock()
+	fake.archivedTasksMutex.RLock()
+	defer fake.archivedTasksMutex.RUnlock()
 	fake.cancelTaskMutex.RLock()
 	defer fake.cancelTaskMutex.RUnlock()
+	fake.cancelTaskArrayMutex.RLock()
+	defer fake.cancelTaskArrayMutex.RUnlock()
+	fake.capacityReportMutex.RLock()
+	defer fake.capacityReportMutex.RUnlock()
+	fake.cellDrainStatusMutex.RLock()
+	defer fake.cellDrainStatusMutex.RUnlock()
 	fake.cellsMutex.RLock()
 	defer fake.cellsMutex.RUnlock()
 	fake.claimActualLRPMutex.RLock()
 	defer fake.claimActualLRPMutex.RUnlock()
 	fake.completeTaskMutex.RLock()
 	defer fake.completeTaskMutex.RUnlock()
+	fake.cordonCellMutex.RLock()
+	defer fake.cordonCellMutex.RUnlock()
 	fake.crashActualLRPMutex.RLock()
 	defer fake.crashActualLRPMutex.RUnlock()
+	fake.deleteDomainMutex.RLock()
+	defer fake.deleteDomainMutex.RUnlock()
+	fake.deleteDomainQuotaMutex.RLock()
+	defer fake.deleteDomainQuotaMutex.RUnlock()
+	fake.deleteScheduledTaskMutex.RLock()
+	defer fake.deleteScheduledTaskMutex.RUnlock()
 	fake.deleteTaskMutex.RLock()
 	defer fake.deleteTaskMutex.RUnlock()
 	fake.desireLRPMutex.RLock()
 	defer fake.desireLRPMutex.RUnlock()
+	fake.desireScheduledTaskMutex.RLock()
+	defer fake.desireScheduledTaskMutex.RUnlock()
 	fake.desireTaskMutex.RLock()
 	defer fake.desireTaskMutex.RUnlock()
+	fake.desireTaskArrayMutex.RLock()
+	defer fake.desireTaskArrayMutex.RUnlock()
 	fake.desiredLRPByProcessGuidMutex.RLock()
 	defer fake.desiredLRPByProcessGuidMutex.RUnlock()
+	fake.desiredLRPRevisionDiffMutex.RLock()
+	defer fake.desiredLRPRevisionDiffMutex.RUnlock()
+	fake.desiredLRPRevisionsMutex.RLock()
+	defer fake.desiredLRPRevisionsMutex.RUnlock()
 	fake.desiredLRPRoutingInfosMutex.RLock()
 	defer fake.desiredLRPRoutingInfosMutex.RUnlock()
 	fake.desiredLRPSchedulingInfoByProcessGuidMutex.RLock()
 	defer fake.desiredLRPSchedulingInfoByProcessGuidMutex.RUnlock()
 	fake.desiredLRPSchedulingInfosMutex.RLock()
 	defer fake.desiredLRPSchedulingInfosMutex.RUnlock()
+	fake.desiredLRPStatusMutex.RLock()
+	defer fake.desiredLRPStatusMutex.RUnlock()
 	fake.desiredLRPsMutex.RLock()
 	defer fake.desiredLRPsMutex.RUnlock()
+	fake.domainQuotasMutex.RLock()
+	defer fake.domainQuotasMutex.RUnlock()
+	fake.domainUsageMutex.RLock()
+	defer fake.domainUsageMutex.RUnlock()
 	fake.domainsMutex.RLock()
 	defer fake.domainsMutex.RUnlock()
+	fake.drainCellMutex.RLock()
+	defer fake.drainCellMutex.RUnlock()
 	fake.evacuateClaimedActualLRPMutex.RLock()
 	defer fake.evacuateClaimedActualLRPMutex.RUnlock()
 	fake.evacuateCrashedActualLRPMutex.RLock()
@@ -3667,6 +6163,8 @@ func (fake *FakeInternalClient) Invocations() map[string][][]interface{} {
 	defer fake.evacuateRunningActualLRPMutex.RUnlock()
 	fake.evacuateStoppedActualLRPMutex.RLock()
 	defer fake.evacuateStoppedActualLRPMutex.RUnlock()
+	fake.expireDomainMutex.RLock()
+	defer fake.expireDomainMutex.RUnlock()
 	fake.failActualLRPMutex.RLock()
 	defer fake.failActualLRPMutex.RUnlock()
 	fake.failTaskMutex.RLock()
@@ -3683,8 +6181,18 @@ func (fake *FakeInternalClient) Invocations() map[string][][]interface{} {
 	defer fake.removeEvacuatingActualLRPMutex.RUnlock()
 	fake.resolvingTaskMutex.RLock()
 	defer fake.resolvingTaskMutex.RUnlock()
+	fake.restartActualLRPMutex.RLock()
+	defer fake.restartActualLRPMutex.RUnlock()
+	fake.restartDesiredLRPMutex.RLock()
+	defer fake.restartDesiredLRPMutex.RUnlock()
 	fake.retireActualLRPMutex.RLock()
 	defer fake.retireActualLRPMutex.RUnlock()
+	fake.rollbackDesiredLRPMutex.RLock()
+	defer fake.rollbackDesiredLRPMutex.RUnlock()
+	fake.scheduledTaskByGuidMutex.RLock()
+	defer fake.scheduledTaskByGuidMutex.RUnlock()
+	fake.scheduledTasksMutex.RLock()
+	defer fake.scheduledTasksMutex.RUnlock()
 	fake.startActualLRPMutex.RLock()
 	defer fake.startActualLRPMutex.RUnlock()
 	fake.startTaskMutex.RLock()
@@ -3699,8 +6207,12 @@ func (fake *FakeInternalClient) Invocations() map[string][][]interface{} {
 	defer fake.subscribeToInstanceEventsByCellIDMutex.RUnlock()
 	fake.subscribeToTaskEventsMutex.RLock()
 	defer fake.subscribeToTaskEventsMutex.RUnlock()
+	fake.taskArrayStatusMutex.RLock()
+	defer fake.taskArrayStatusMutex.RUnlock()
 	fake.taskByGuidMutex.RLock()
 	defer fake.taskByGuidMutex.RUnlock()
+	fake.taskResultMutex.RLock()
+	defer fake.taskResultMutex.RUnlock()
 	fake.tasksMutex.RLock()
 	defer fake.tasksMutex.RUnlock()
 	fake.tasksByCellIDMutex.RLock()
@@ -3709,10 +6221,16 @@ func (fake *FakeInternalClient) Invocations() map[string][][]interface{} {
 	defer fake.tasksByDomainMutex.RUnlock()
 	fake.tasksWithFilterMutex.RLock()
 	defer fake.tasksWithFilterMutex.RUnlock()
+	fake.uncordonCellMutex.RLock()
+	defer fake.uncordonCellMutex.RUnlock()
 	fake.updateDesiredLRPMutex.RLock()
 	defer fake.updateDesiredLRPMutex.RUnlock()
+	fake.updateScheduledTaskMutex.RLock()
+	defer fake.updateScheduledTaskMutex.RUnlock()
 	fake.upsertDomainMutex.RLock()
 	defer fake.upsertDomainMutex.RUnlock()
+	fake.upsertDomainQuotaMutex.RLock()
+	defer fake.upsertDomainQuotaMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
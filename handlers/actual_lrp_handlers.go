@@ -38,6 +38,29 @@ func (h *ActualLRPHandler) ActualLRPs(logger lager.Logger, w http.ResponseWriter
 		}
 		filter := models.ActualLRPFilter{Domain: request.Domain, CellID: request.CellId, Index: index, ProcessGuid: request.ProcessGuid}
 		response.ActualLrps, err = h.db.ActualLRPs(req.Context(), logger, filter)
+		if err == nil && request.IncludeCrashHistory {
+			response.CrashHistory, err = h.db.ActualLRPCrashes(req.Context(), logger, request.ProcessGuid, index)
+		}
+	}
+
+	response.Error = models.ConvertError(err)
+
+	writeResponse(w, response)
+	exitIfUnrecoverable(logger, h.exitChan, response.Error)
+}
+
+func (h *ActualLRPHandler) ActualLRPCrashes(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("actual-lrp-crashes").WithTraceInfo(req)
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	request := &models.ActualLRPCrashesRequest{}
+	response := &models.ActualLRPCrashesResponse{}
+
+	err = parseRequest(logger, req, request)
+	if err == nil {
+		response.Crashes, err = h.db.ActualLRPCrashes(req.Context(), logger, request.ProcessGuid, &request.Index)
 	}
 
 	response.Error = models.ConvertError(err)
@@ -212,6 +212,71 @@ var _ = Describe("ActualLRP Handlers", func() {
 					Expect(*filter.Index).To(Equal(int32(2)))
 				})
 			})
+
+			It("does not read the crash history", func() {
+				Expect(fakeActualLRPDB.ActualLRPCrashesCallCount()).To(BeZero())
+			})
+
+			Context("and the crash history is requested", func() {
+				var crashes []*models.ActualLRPCrash
+
+				BeforeEach(func() {
+					req := &models.ActualLRPsRequest{ProcessGuid: "process-guid-0", IncludeCrashHistory: true}
+					req.SetIndex(1)
+					requestBody = req
+
+					crashes = []*models.ActualLRPCrash{
+						{ProcessGuid: "process-guid-0", Index: 1, InstanceGuid: "instance-guid-0", CrashReason: "boom", CrashCount: 1, CrashedAt: 1138},
+					}
+					fakeActualLRPDB.ActualLRPCrashesReturns(crashes, nil)
+				})
+
+				It("returns the crash history of the matching indices", func() {
+					Expect(fakeActualLRPDB.ActualLRPCrashesCallCount()).To(Equal(1))
+					_, _, processGuid, index := fakeActualLRPDB.ActualLRPCrashesArgsForCall(0)
+					Expect(processGuid).To(Equal("process-guid-0"))
+					Expect(index).NotTo(BeNil())
+					Expect(*index).To(Equal(int32(1)))
+
+					response := models.ActualLRPsResponse{}
+					err := response.Unmarshal(responseRecorder.Body.Bytes())
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(response.Error).To(BeNil())
+					Expect(response.ActualLrps).To(Equal(actualLRPs))
+					Expect(response.CrashHistory).To(Equal(crashes))
+				})
+
+				Context("when reading the crash history fails", func() {
+					BeforeEach(func() {
+						fakeActualLRPDB.ActualLRPCrashesReturns(nil, models.ErrUnknownError)
+					})
+
+					It("provides relevant error information", func() {
+						response := models.ActualLRPsResponse{}
+						err := response.Unmarshal(responseRecorder.Body.Bytes())
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(response.Error).To(Equal(models.ErrUnknownError))
+					})
+				})
+			})
+
+			Context("and the crash history is requested without a process guid", func() {
+				BeforeEach(func() {
+					requestBody = &models.ActualLRPsRequest{IncludeCrashHistory: true}
+				})
+
+				It("responds with a bad request error", func() {
+					Expect(fakeActualLRPDB.ActualLRPsCallCount()).To(BeZero())
+
+					response := models.ActualLRPsResponse{}
+					err := response.Unmarshal(responseRecorder.Body.Bytes())
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+				})
+			})
 		})
 
 		Context("when the DB returns no actual lrps", func() {
@@ -258,6 +323,90 @@ var _ = Describe("ActualLRP Handlers", func() {
 		})
 	})
 
+	Describe("ActualLRPCrashes", func() {
+		var requestBody interface{}
+
+		BeforeEach(func() {
+			requestBody = &models.ActualLRPCrashesRequest{ProcessGuid: "process-guid-0", Index: 1}
+		})
+
+		JustBeforeEach(func() {
+			request := newTestRequest(requestBody)
+			request.Header.Set(lager.RequestIdHeader, requestIdHeader)
+			handler.ActualLRPCrashes(logger, responseRecorder, request)
+		})
+
+		Context("when reading the crashes from the DB succeeds", func() {
+			var crashes []*models.ActualLRPCrash
+
+			BeforeEach(func() {
+				crashes = []*models.ActualLRPCrash{
+					{ProcessGuid: "process-guid-0", Index: 1, InstanceGuid: "instance-guid-1", CrashReason: "boom", CrashCount: 2, CrashedAt: 2000},
+					{ProcessGuid: "process-guid-0", Index: 1, InstanceGuid: "instance-guid-0", CrashReason: "boom", CrashCount: 1, CrashedAt: 1000},
+				}
+				fakeActualLRPDB.ActualLRPCrashesReturns(crashes, nil)
+			})
+
+			It("returns the crashes of the ActualLRP", func() {
+				Expect(fakeActualLRPDB.ActualLRPCrashesCallCount()).To(Equal(1))
+				_, _, processGuid, index := fakeActualLRPDB.ActualLRPCrashesArgsForCall(0)
+				Expect(processGuid).To(Equal("process-guid-0"))
+				Expect(index).NotTo(BeNil())
+				Expect(*index).To(Equal(int32(1)))
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				response := models.ActualLRPCrashesResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(BeNil())
+				Expect(response.Crashes).To(Equal(crashes))
+			})
+		})
+
+		Context("when the request is invalid", func() {
+			BeforeEach(func() {
+				requestBody = &models.ActualLRPCrashesRequest{Index: 1}
+			})
+
+			It("responds with a bad request error", func() {
+				Expect(fakeActualLRPDB.ActualLRPCrashesCallCount()).To(BeZero())
+
+				response := models.ActualLRPCrashesResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+			})
+		})
+
+		Context("when the DB returns an unrecoverable error", func() {
+			BeforeEach(func() {
+				fakeActualLRPDB.ActualLRPCrashesReturns(nil, models.NewUnrecoverableError(nil))
+			})
+
+			It("logs and writes to the exit channel", func() {
+				Eventually(logger).Should(gbytes.Say("unrecoverable-error"))
+				Eventually(logger).Should(gbytes.Say(b3RequestIdHeader))
+				Eventually(exitCh).Should(Receive())
+			})
+		})
+
+		Context("when the DB errors out", func() {
+			BeforeEach(func() {
+				fakeActualLRPDB.ActualLRPCrashesReturns(nil, models.ErrUnknownError)
+			})
+
+			It("provides relevant error information", func() {
+				response := models.ActualLRPCrashesResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(Equal(models.ErrUnknownError))
+			})
+		})
+	})
+
 	Describe("ActualLRPGroups", func() {
 		var requestBody interface{}
 
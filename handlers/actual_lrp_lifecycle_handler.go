@@ -28,6 +28,8 @@ type ActualLRPLifecycleController interface {
 	FailActualLRP(ctx context.Context, logger lager.Logger, key *models.ActualLRPKey, errorMessage string) error
 	RemoveActualLRP(ctx context.Context, logger lager.Logger, processGuid string, index int32, instanceKey *models.ActualLRPInstanceKey) error
 	RetireActualLRP(ctx context.Context, logger lager.Logger, key *models.ActualLRPKey) error
+	RestartActualLRP(ctx context.Context, logger lager.Logger, key *models.ActualLRPKey) error
+	RestartDesiredLRP(ctx context.Context, logger lager.Logger, processGuid string, maxInFlight int32) error
 }
 
 type ActualLRPLifecycleHandler struct {
@@ -205,3 +207,45 @@ func (h *ActualLRPLifecycleHandler) RetireActualLRP(logger lager.Logger, w http.
 	err = h.controller.RetireActualLRP(trace.ContextWithRequestId(req), logger, request.ActualLrpKey)
 	response.Error = models.ConvertError(err)
 }
+
+func (h *ActualLRPLifecycleHandler) RestartActualLRP(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("restart-actual-lrp").WithTraceInfo(req)
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+	request := &models.RestartActualLRPRequest{}
+	response := &models.ActualLRPLifecycleResponse{}
+
+	var err error
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer writeResponse(w, response)
+
+	err = parseRequest(logger, req, request)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	err = h.controller.RestartActualLRP(trace.ContextWithRequestId(req), logger, request.ActualLrpKey)
+	response.Error = models.ConvertError(err)
+}
+
+func (h *ActualLRPLifecycleHandler) RestartDesiredLRP(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("restart-desired-lrp").WithTraceInfo(req)
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+	request := &models.RestartDesiredLRPRequest{}
+	response := &models.DesiredLRPLifecycleResponse{}
+
+	var err error
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer writeResponse(w, response)
+
+	err = parseRequest(logger, req, request)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	err = h.controller.RestartDesiredLRP(trace.ContextWithRequestId(req), logger, request.ProcessGuid, request.MaxInFlight)
+	response.Error = models.ConvertError(err)
+}
@@ -546,6 +546,147 @@ var _ = Describe("ActualLRP Lifecycle Handlers", func() {
 		})
 	})
 
+	Describe("RestartActualLRP", func() {
+		var (
+			request     *http.Request
+			response    *models.ActualLRPLifecycleResponse
+			processGuid = "process-guid"
+			index       = int32(1)
+
+			key models.ActualLRPKey
+
+			requestBody interface{}
+		)
+
+		BeforeEach(func() {
+			key = models.NewActualLRPKey(
+				processGuid,
+				index,
+				"domain-0",
+			)
+
+			requestBody = &models.RestartActualLRPRequest{
+				ActualLrpKey: &key,
+			}
+		})
+
+		JustBeforeEach(func() {
+			request = newTestRequest(requestBody)
+			request.Header.Set(lager.RequestIdHeader, requestIdHeader)
+			handler.RestartActualLRP(logger, responseRecorder, request)
+
+			response = &models.ActualLRPLifecycleResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("calls the controller", func() {
+			Expect(fakeController.RestartActualLRPCallCount()).To(Equal(1))
+			_, _, actualKey := fakeController.RestartActualLRPArgsForCall(0)
+			Expect(actualKey).To(Equal(&key))
+			Expect(response.Error).To(BeNil())
+		})
+
+		Context("when the request is invalid", func() {
+			BeforeEach(func() {
+				requestBody = &models.RestartActualLRPRequest{}
+			})
+
+			It("responds with an invalid request error", func() {
+				Expect(fakeController.RestartActualLRPCallCount()).To(Equal(0))
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+			})
+		})
+
+		Context("when an unrecoverable error is returned", func() {
+			BeforeEach(func() {
+				fakeController.RestartActualLRPReturns(models.NewUnrecoverableError(nil))
+			})
+
+			It("logs and writes to the exit channel", func() {
+				Eventually(logger).Should(gbytes.Say("unrecoverable-error"))
+				Eventually(logger).Should(gbytes.Say(b3RequestIdHeader))
+				Eventually(exitCh).Should(Receive())
+			})
+		})
+
+		Context("when a recoverable error is returned", func() {
+			BeforeEach(func() {
+				fakeController.RestartActualLRPReturns(models.ErrResourceNotFound)
+			})
+
+			It("returns the error", func() {
+				Expect(response.Error).To(Equal(models.ErrResourceNotFound))
+			})
+		})
+	})
+
+	Describe("RestartDesiredLRP", func() {
+		var (
+			request     *http.Request
+			response    *models.DesiredLRPLifecycleResponse
+			requestBody interface{}
+		)
+
+		BeforeEach(func() {
+			requestBody = &models.RestartDesiredLRPRequest{
+				ProcessGuid: "process-guid",
+				MaxInFlight: 2,
+			}
+		})
+
+		JustBeforeEach(func() {
+			request = newTestRequest(requestBody)
+			request.Header.Set(lager.RequestIdHeader, requestIdHeader)
+			handler.RestartDesiredLRP(logger, responseRecorder, request)
+
+			response = &models.DesiredLRPLifecycleResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("calls the controller", func() {
+			Expect(fakeController.RestartDesiredLRPCallCount()).To(Equal(1))
+			_, _, processGuid, maxInFlight := fakeController.RestartDesiredLRPArgsForCall(0)
+			Expect(processGuid).To(Equal("process-guid"))
+			Expect(maxInFlight).To(Equal(int32(2)))
+			Expect(response.Error).To(BeNil())
+		})
+
+		Context("when the request is invalid", func() {
+			BeforeEach(func() {
+				requestBody = &models.RestartDesiredLRPRequest{MaxInFlight: -1}
+			})
+
+			It("responds with an invalid request error", func() {
+				Expect(fakeController.RestartDesiredLRPCallCount()).To(Equal(0))
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+			})
+		})
+
+		Context("when an unrecoverable error is returned", func() {
+			BeforeEach(func() {
+				fakeController.RestartDesiredLRPReturns(models.NewUnrecoverableError(nil))
+			})
+
+			It("logs and writes to the exit channel", func() {
+				Eventually(logger).Should(gbytes.Say("unrecoverable-error"))
+				Eventually(logger).Should(gbytes.Say(b3RequestIdHeader))
+				Eventually(exitCh).Should(Receive())
+			})
+		})
+
+		Context("when a recoverable error is returned", func() {
+			BeforeEach(func() {
+				fakeController.RestartDesiredLRPReturns(models.ErrResourceNotFound)
+			})
+
+			It("returns the error", func() {
+				Expect(response.Error).To(Equal(models.ErrResourceNotFound))
+			})
+		})
+	})
+
 	Describe("FailActualLRP", func() {
 		var (
 			request     *http.Request
@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/serviceclient"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+type CapacityHandler struct {
+	serviceClient serviceclient.ServiceClient
+	db            db.CapacityDB
+	exitChan      chan<- struct{}
+}
+
+func NewCapacityHandler(serviceClient serviceclient.ServiceClient, db db.CapacityDB, exitChan chan<- struct{}) *CapacityHandler {
+	return &CapacityHandler{
+		serviceClient: serviceClient,
+		db:            db,
+		exitChan:      exitChan,
+	}
+}
+
+func (h *CapacityHandler) CapacityReport(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("capacity-report").WithTraceInfo(req)
+	response := &models.CapacityReportResponse{}
+
+	cellSet, err := h.serviceClient.Cells(logger)
+	if err == nil {
+		var reservations map[string]*models.CellReservation
+		reservations, err = h.db.CellReservations(req.Context(), logger)
+		if err == nil {
+			response.Report = models.NewCapacityReport(cellSet, reservations)
+		}
+	}
+
+	response.Error = models.ConvertError(err)
+	writeResponse(w, response)
+	exitIfUnrecoverable(logger, h.exitChan, response.Error)
+}
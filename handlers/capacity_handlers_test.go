@@ -0,0 +1,112 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"code.cloudfoundry.org/bbs/db/dbfakes"
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/serviceclient/serviceclientfakes"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Capacity Handlers", func() {
+	var (
+		logger            *lagertest.TestLogger
+		responseRecorder  *httptest.ResponseRecorder
+		handler           *handlers.CapacityHandler
+		fakeServiceClient *serviceclientfakes.FakeServiceClient
+		fakeCapacityDB    *dbfakes.FakeCapacityDB
+		exitCh            chan struct{}
+		cellSet           models.CellSet
+		reservations      map[string]*models.CellReservation
+	)
+
+	BeforeEach(func() {
+		fakeServiceClient = new(serviceclientfakes.FakeServiceClient)
+		fakeCapacityDB = new(dbfakes.FakeCapacityDB)
+		logger = lagertest.NewTestLogger("test")
+		responseRecorder = httptest.NewRecorder()
+		exitCh = make(chan struct{}, 1)
+		handler = handlers.NewCapacityHandler(fakeServiceClient, fakeCapacityDB, exitCh)
+
+		cell1 := models.NewCellPresence("cell-1", "1.1.1.1", "", "z1", models.NewCellCapacity(1000, 2000, 50), nil, nil, []string{"tag"}, nil)
+		cell2 := models.NewCellPresence("cell-2", "2.2.2.2", "", "z2", models.NewCellCapacity(3000, 4000, 20), nil, nil, nil, nil)
+		cellSet = models.NewCellSetFromList([]*models.CellPresence{&cell1, &cell2})
+		reservations = map[string]*models.CellReservation{
+			"cell-1": {CellId: "cell-1", MemoryMb: 100, DiskMb: 200, Containers: 1},
+		}
+	})
+
+	Describe("CapacityReport", func() {
+		JustBeforeEach(func() {
+			handler.CapacityReport(logger, responseRecorder, newTestRequest(""))
+		})
+
+		Context("when fetching the cells and reservations succeeds", func() {
+			BeforeEach(func() {
+				fakeServiceClient.CellsReturns(cellSet, nil)
+				fakeCapacityDB.CellReservationsReturns(reservations, nil)
+			})
+
+			It("returns the capacity report", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+
+				response := &models.CapacityReportResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(BeNil())
+				Expect(response.Report).To(Equal(models.NewCapacityReport(cellSet, reservations)))
+			})
+		})
+
+		Context("when fetching the cells fails", func() {
+			BeforeEach(func() {
+				fakeServiceClient.CellsReturns(nil, models.ErrUnknownError)
+			})
+
+			It("provides relevant error information", func() {
+				response := &models.CapacityReportResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(Equal(models.ErrUnknownError))
+				Expect(response.Report).To(BeNil())
+				Expect(fakeCapacityDB.CellReservationsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when fetching the reservations fails", func() {
+			BeforeEach(func() {
+				fakeServiceClient.CellsReturns(cellSet, nil)
+				fakeCapacityDB.CellReservationsReturns(nil, models.ErrUnknownError)
+			})
+
+			It("provides relevant error information", func() {
+				response := &models.CapacityReportResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(Equal(models.ErrUnknownError))
+				Expect(response.Report).To(BeNil())
+			})
+		})
+
+		Context("when the DB returns an unrecoverable error", func() {
+			BeforeEach(func() {
+				fakeServiceClient.CellsReturns(cellSet, nil)
+				fakeCapacityDB.CellReservationsReturns(nil, models.NewUnrecoverableError(nil))
+			})
+
+			It("logs and writes to the exit channel", func() {
+				Eventually(logger).Should(gbytes.Say("unrecoverable-error"))
+				Eventually(exitCh).Should(Receive())
+			})
+		})
+	})
+})
@@ -3,6 +3,7 @@ package handlers
 import (
 	"net/http"
 
+	"code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/serviceclient"
 	"code.cloudfoundry.org/lager/v3"
@@ -10,12 +11,16 @@ import (
 
 type CellHandler struct {
 	serviceClient serviceclient.ServiceClient
+	db            db.CellCordonDB
+	controller    EvacuationController
 	exitChan      chan<- struct{}
 }
 
-func NewCellHandler(serviceClient serviceclient.ServiceClient, exitChan chan<- struct{}) *CellHandler {
+func NewCellHandler(serviceClient serviceclient.ServiceClient, db db.CellCordonDB, controller EvacuationController, exitChan chan<- struct{}) *CellHandler {
 	return &CellHandler{
 		serviceClient: serviceClient,
+		db:            db,
+		controller:    controller,
 		exitChan:      exitChan,
 	}
 }
@@ -25,12 +30,101 @@ func (h *CellHandler) Cells(logger lager.Logger, w http.ResponseWriter, req *htt
 	logger = logger.Session("cells").WithTraceInfo(req)
 	response := &models.CellsResponse{}
 	cellSet, err := h.serviceClient.Cells(logger)
+	if err == nil {
+		h.markCordonedCells(req, logger, cellSet)
+	}
 	cells := []*models.CellPresence{}
-	for _, cp := range cellSet {
-		cells = append(cells, cp)
+	if err == nil {
+		for _, cp := range cellSet {
+			cells = append(cells, cp)
+		}
 	}
 	response.Cells = cells
 	response.Error = models.ConvertError(err)
 	writeResponse(w, response)
 	exitIfUnrecoverable(logger, h.exitChan, response.Error)
 }
+
+func (h *CellHandler) CordonCell(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("cordon-cell").WithTraceInfo(req)
+
+	request := &models.CordonCellRequest{}
+	response := &models.CordonCellResponse{}
+
+	err = parseRequest(logger, req, request)
+	if err == nil {
+		err = h.db.CordonCell(req.Context(), logger, request.CellId)
+	}
+
+	response.Error = models.ConvertError(err)
+	writeResponse(w, response)
+	exitIfUnrecoverable(logger, h.exitChan, response.Error)
+}
+
+func (h *CellHandler) UncordonCell(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("uncordon-cell").WithTraceInfo(req)
+
+	request := &models.UncordonCellRequest{}
+	response := &models.UncordonCellResponse{}
+
+	err = parseRequest(logger, req, request)
+	if err == nil {
+		err = h.db.UncordonCell(req.Context(), logger, request.CellId)
+	}
+
+	response.Error = models.ConvertError(err)
+	writeResponse(w, response)
+	exitIfUnrecoverable(logger, h.exitChan, response.Error)
+}
+
+func (h *CellHandler) DrainCell(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("drain-cell").WithTraceInfo(req)
+
+	request := &models.DrainCellRequest{}
+	response := &models.DrainCellResponse{}
+
+	err = parseRequest(logger, req, request)
+	if err == nil {
+		err = h.controller.DrainCell(req.Context(), logger, request.CellId)
+	}
+
+	response.Error = models.ConvertError(err)
+	writeResponse(w, response)
+	exitIfUnrecoverable(logger, h.exitChan, response.Error)
+}
+
+func (h *CellHandler) CellDrainStatus(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("cell-drain-status").WithTraceInfo(req)
+
+	request := &models.CellDrainStatusRequest{}
+	response := &models.CellDrainStatusResponse{}
+
+	err = parseRequest(logger, req, request)
+	if err == nil {
+		response.Status, err = h.db.CellDrainStatus(req.Context(), logger, request.CellId)
+	}
+
+	response.Error = models.ConvertError(err)
+	writeResponse(w, response)
+	exitIfUnrecoverable(logger, h.exitChan, response.Error)
+}
+
+// markCordonedCells sets the cordoned flag of the cells. The cells are still
+// listed without the flag if the cordons cannot be fetched.
+func (h *CellHandler) markCordonedCells(req *http.Request, logger lager.Logger, cellSet models.CellSet) {
+	cordonedCells, err := h.db.CordonedCells(req.Context(), logger)
+	if err != nil {
+		logger.Error("failed-fetching-cordoned-cells", err)
+		return
+	}
+
+	for _, cordoned := range cordonedCells {
+		if cell, ok := cellSet[cordoned.CellId]; ok {
+			cell.Cordoned = true
+		}
+	}
+}
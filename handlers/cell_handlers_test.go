@@ -6,7 +6,9 @@ import (
 	"net/http/httptest"
 	"strings"
 
+	"code.cloudfoundry.org/bbs/db/dbfakes"
 	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/handlers/fake_controllers"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/serviceclient/serviceclientfakes"
 	"code.cloudfoundry.org/lager/v3"
@@ -22,6 +24,8 @@ var _ = Describe("Cell Handlers", func() {
 		responseRecorder  *httptest.ResponseRecorder
 		handler           *handlers.CellHandler
 		fakeServiceClient *serviceclientfakes.FakeServiceClient
+		fakeCellCordonDB  *dbfakes.FakeCellCordonDB
+		fakeController    *fake_controllers.FakeEvacuationController
 		exitCh            chan struct{}
 		cells             []*models.CellPresence
 		cellSet           models.CellSet
@@ -37,7 +41,9 @@ var _ = Describe("Cell Handlers", func() {
 		exitCh = make(chan struct{}, 1)
 		requestIdHeader = "0bc29108-c522-4360-93dd-30ca38cce13d"
 		b3RequestIdHeader = fmt.Sprintf(`"trace-id":"%s"`, strings.Replace(requestIdHeader, "-", "", -1))
-		handler = handlers.NewCellHandler(fakeServiceClient, exitCh)
+		fakeCellCordonDB = new(dbfakes.FakeCellCordonDB)
+		fakeController = new(fake_controllers.FakeEvacuationController)
+		handler = handlers.NewCellHandler(fakeServiceClient, fakeCellCordonDB, fakeController, exitCh)
 		cells = []*models.CellPresence{
 			{
 				CellId:     "cell-1",
@@ -96,6 +102,46 @@ var _ = Describe("Cell Handlers", func() {
 				Expect(response.Error).To(BeNil())
 				Expect(response.Cells).To(ConsistOf(cells))
 			})
+
+			Context("when a cell is cordoned", func() {
+				BeforeEach(func() {
+					fakeCellCordonDB.CordonedCellsReturns([]*models.CordonedCell{
+						{CellId: "cell-2", CordonedAt: 10},
+						{CellId: "missing-cell", CordonedAt: 20},
+					}, nil)
+				})
+
+				It("marks the cell as cordoned", func() {
+					response := &models.CellsResponse{}
+					err := response.Unmarshal(responseRecorder.Body.Bytes())
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(response.Error).To(BeNil())
+					Expect(response.Cells).To(HaveLen(2))
+					for _, cell := range response.Cells {
+						Expect(cell.Cordoned).To(Equal(cell.CellId == "cell-2"))
+					}
+				})
+			})
+
+			Context("when fetching the cordoned cells fails", func() {
+				BeforeEach(func() {
+					fakeCellCordonDB.CordonedCellsReturns(nil, models.ErrUnknownError)
+				})
+
+				It("returns the cells without the cordoned flag", func() {
+					response := &models.CellsResponse{}
+					err := response.Unmarshal(responseRecorder.Body.Bytes())
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(response.Error).To(BeNil())
+					Expect(response.Cells).To(ConsistOf(cells))
+				})
+
+				It("logs the error", func() {
+					Expect(logger).To(gbytes.Say("failed-fetching-cordoned-cells"))
+				})
+			})
 		})
 
 		Context("when the serviceClient returns no cells", func() {
@@ -144,4 +190,161 @@ var _ = Describe("Cell Handlers", func() {
 			})
 		})
 	})
+
+	Describe("CordonCell", func() {
+		var requestBody interface{}
+
+		BeforeEach(func() {
+			requestBody = &models.CordonCellRequest{CellId: "cell-1"}
+		})
+
+		JustBeforeEach(func() {
+			request := newTestRequest(requestBody)
+			request.Header.Set(lager.RequestIdHeader, requestIdHeader)
+			handler.CordonCell(logger, responseRecorder, request)
+		})
+
+		It("cordons the cell", func() {
+			Expect(fakeCellCordonDB.CordonCellCallCount()).To(Equal(1))
+			_, _, cellID := fakeCellCordonDB.CordonCellArgsForCall(0)
+			Expect(cellID).To(Equal("cell-1"))
+
+			response := &models.CordonCellResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Error).To(BeNil())
+		})
+
+		Context("when the request is invalid", func() {
+			BeforeEach(func() {
+				requestBody = &models.CordonCellRequest{}
+			})
+
+			It("responds with an invalid request error", func() {
+				response := &models.CordonCellResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+				Expect(fakeCellCordonDB.CordonCellCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the DB returns an unrecoverable error", func() {
+			BeforeEach(func() {
+				fakeCellCordonDB.CordonCellReturns(models.NewUnrecoverableError(nil))
+			})
+
+			It("logs and writes to the exit channel", func() {
+				Eventually(logger).Should(gbytes.Say("unrecoverable-error"))
+				Eventually(exitCh).Should(Receive())
+			})
+		})
+	})
+
+	Describe("UncordonCell", func() {
+		JustBeforeEach(func() {
+			request := newTestRequest(&models.UncordonCellRequest{CellId: "cell-1"})
+			request.Header.Set(lager.RequestIdHeader, requestIdHeader)
+			handler.UncordonCell(logger, responseRecorder, request)
+		})
+
+		It("uncordons the cell", func() {
+			Expect(fakeCellCordonDB.UncordonCellCallCount()).To(Equal(1))
+			_, _, cellID := fakeCellCordonDB.UncordonCellArgsForCall(0)
+			Expect(cellID).To(Equal("cell-1"))
+		})
+
+		Context("when the cell is not cordoned", func() {
+			BeforeEach(func() {
+				fakeCellCordonDB.UncordonCellReturns(models.ErrResourceNotFound)
+			})
+
+			It("responds with a resource not found error", func() {
+				response := &models.UncordonCellResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error).To(Equal(models.ErrResourceNotFound))
+			})
+		})
+	})
+
+	Describe("DrainCell", func() {
+		JustBeforeEach(func() {
+			request := newTestRequest(&models.DrainCellRequest{CellId: "cell-1"})
+			request.Header.Set(lager.RequestIdHeader, requestIdHeader)
+			handler.DrainCell(logger, responseRecorder, request)
+		})
+
+		It("drains the cell", func() {
+			Expect(fakeController.DrainCellCallCount()).To(Equal(1))
+			_, _, cellID := fakeController.DrainCellArgsForCall(0)
+			Expect(cellID).To(Equal("cell-1"))
+
+			response := &models.DrainCellResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Error).To(BeNil())
+		})
+
+		Context("when draining the cell fails", func() {
+			BeforeEach(func() {
+				fakeController.DrainCellReturns(models.ErrUnknownError)
+			})
+
+			It("provides relevant error information", func() {
+				response := &models.DrainCellResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error).To(Equal(models.ErrUnknownError))
+			})
+		})
+	})
+
+	Describe("CellDrainStatus", func() {
+		var status *models.CellDrainStatus
+
+		BeforeEach(func() {
+			status = &models.CellDrainStatus{
+				CellId:              "cell-1",
+				Cordoned:            true,
+				Draining:            true,
+				DrainStartedAt:      10,
+				InstancesRemaining:  3,
+				EvacuatingInstances: 1,
+			}
+			fakeCellCordonDB.CellDrainStatusReturns(status, nil)
+		})
+
+		JustBeforeEach(func() {
+			request := newTestRequest(&models.CellDrainStatusRequest{CellId: "cell-1"})
+			request.Header.Set(lager.RequestIdHeader, requestIdHeader)
+			handler.CellDrainStatus(logger, responseRecorder, request)
+		})
+
+		It("returns the drain status of the cell", func() {
+			Expect(fakeCellCordonDB.CellDrainStatusCallCount()).To(Equal(1))
+			_, _, cellID := fakeCellCordonDB.CellDrainStatusArgsForCall(0)
+			Expect(cellID).To(Equal("cell-1"))
+
+			response := &models.CellDrainStatusResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Error).To(BeNil())
+			Expect(response.Status).To(Equal(status))
+		})
+
+		Context("when the DB errors out", func() {
+			BeforeEach(func() {
+				fakeCellCordonDB.CellDrainStatusReturns(nil, models.ErrUnknownError)
+			})
+
+			It("provides relevant error information", func() {
+				response := &models.CellDrainStatusResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error).To(Equal(models.ErrUnknownError))
+				Expect(response.Status).To(BeNil())
+			})
+		})
+	})
 })
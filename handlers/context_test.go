@@ -66,6 +66,7 @@ var _ = Describe("Context", func() {
 			monitoredDB,
 			convergenceWorkers,
 			updateWorkers,
+			0,
 			fakeCryptor,
 			guidprovider.DefaultGuidProvider,
 			fakeClock,
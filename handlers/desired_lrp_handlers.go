@@ -96,6 +96,10 @@ func (h *DesiredLRPHandler) commonDesiredLRPs(logger lager.Logger, targetVersion
 }
 
 func (h *DesiredLRPHandler) DesiredLRPs(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	h.commonDesiredLRPs(logger, format.V4, w, req)
+}
+
+func (h *DesiredLRPHandler) DesiredLRPs_r3(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
 	h.commonDesiredLRPs(logger, format.V3, w, req)
 }
 
@@ -127,6 +131,10 @@ func (h *DesiredLRPHandler) commonDesiredLRPByProcessGuid(logger lager.Logger, t
 }
 
 func (h *DesiredLRPHandler) DesiredLRPByProcessGuid(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	h.commonDesiredLRPByProcessGuid(logger, format.V4, w, req)
+}
+
+func (h *DesiredLRPHandler) DesiredLRPByProcessGuid_r3(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
 	h.commonDesiredLRPByProcessGuid(logger, format.V3, w, req)
 }
 
@@ -199,6 +207,29 @@ func (h *DesiredLRPHandler) DesiredLRPRoutingInfos(logger lager.Logger, w http.R
 	exitIfUnrecoverable(logger, h.exitChan, response.Error)
 }
 
+func (h *DesiredLRPHandler) DesiredLRPStatus(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("desired-lrp-status").WithTraceInfo(req)
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	request := &models.DesiredLRPsRequest{}
+	response := &models.DesiredLRPStatusResponse{}
+
+	err = parseRequest(logger, req, request)
+	if err == nil {
+		filter := models.DesiredLRPFilter{
+			Domain:       request.Domain,
+			ProcessGuids: request.ProcessGuids,
+		}
+		response.Statuses, err = h.desiredLRPDB.DesiredLRPStatuses(req.Context(), logger, filter)
+	}
+
+	response.Error = models.ConvertError(err)
+	writeResponse(w, response)
+	exitIfUnrecoverable(logger, h.exitChan, response.Error)
+}
+
 func (h *DesiredLRPHandler) DesireDesiredLRP(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
 	logger = logger.Session("desire-lrp").WithTraceInfo(req)
 
@@ -232,8 +263,8 @@ func (h *DesiredLRPHandler) DesireDesiredLRP(logger lager.Logger, w http.Respons
 	go h.desiredHub.Emit(models.NewDesiredLRPCreatedEvent(desiredLRP, trace.RequestIdFromRequest(req)))
 
 	schedulingInfo := request.DesiredLrp.DesiredLRPSchedulingInfo()
-	if schedulingInfo.Instances > 0 {
-		h.startInstanceRange(trace.ContextWithRequestId(req), logger, 0, schedulingInfo.Instances, &schedulingInfo)
+	if schedulingInfo.ScheduledInstances() > 0 {
+		h.startInstanceRange(trace.ContextWithRequestId(req), logger, 0, schedulingInfo.ScheduledInstances(), &schedulingInfo)
 	}
 }
 
@@ -272,17 +303,24 @@ func (h *DesiredLRPHandler) UpdateDesiredLRP(logger lager.Logger, w http.Respons
 		return
 	}
 
-	if request.Update.InstancesExists() {
+	if request.Update.InstancesExists() || request.Update.PausedExists() {
 		logger.Debug("updating-lrp-instances")
-		previousInstanceCount := beforeDesiredLRP.Instances
+		beforeSchedulingInfo := beforeDesiredLRP.DesiredLRPSchedulingInfo()
+		previousInstanceCount := beforeSchedulingInfo.ScheduledInstances()
 
-		requestedInstances := request.Update.GetInstances() - previousInstanceCount
+		// a paused LRP keeps its instance count but runs no instances, so
+		// pausing and resuming stops and starts them like scaling does
+		afterSchedulingInfo := beforeDesiredLRP.DesiredLRPSchedulingInfo()
+		afterSchedulingInfo.ApplyUpdate(request.Update)
+		instanceCount := afterSchedulingInfo.ScheduledInstances()
+
+		requestedInstances := instanceCount - previousInstanceCount
 
 		logger = logger.WithData(lager.Data{"instances_delta": requestedInstances})
 		if requestedInstances > 0 {
 			logger.Debug("increasing-the-instances")
 			schedulingInfo := desiredLRP.DesiredLRPSchedulingInfo()
-			h.startInstanceRange(trace.ContextWithRequestId(req), logger, previousInstanceCount, request.Update.GetInstances(), &schedulingInfo)
+			h.startInstanceRange(trace.ContextWithRequestId(req), logger, previousInstanceCount, instanceCount, &schedulingInfo)
 		}
 
 		if requestedInstances < 0 {
@@ -334,6 +372,91 @@ func (h *DesiredLRPHandler) RemoveDesiredLRP(logger lager.Logger, w http.Respons
 	h.stopInstancesFrom(trace.ContextWithRequestId(req), logger, request.ProcessGuid, 0)
 }
 
+func (h *DesiredLRPHandler) DesiredLRPRevisions(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("desired-lrp-revisions").WithTraceInfo(req)
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	request := &models.DesiredLRPRevisionsRequest{}
+	response := &models.DesiredLRPRevisionsResponse{}
+
+	err = parseRequest(logger, req, request)
+	if err == nil {
+		response.Revisions, err = h.desiredLRPDB.DesiredLRPRevisions(req.Context(), logger, request.ProcessGuid)
+	}
+
+	response.Error = models.ConvertError(err)
+	writeResponse(w, response)
+	exitIfUnrecoverable(logger, h.exitChan, response.Error)
+}
+
+func (h *DesiredLRPHandler) DesiredLRPRevisionDiff(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("desired-lrp-revision-diff").WithTraceInfo(req)
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	request := &models.DesiredLRPRevisionDiffRequest{}
+	response := &models.DesiredLRPRevisionDiffResponse{}
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer writeResponse(w, response)
+
+	err := parseRequest(logger, req, request)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	from, err := h.desiredLRPDB.DesiredLRPRevision(req.Context(), logger, request.ProcessGuid, request.FromRevision)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	to, err := h.desiredLRPDB.DesiredLRPRevision(req.Context(), logger, request.ProcessGuid, request.ToRevision)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	response.Changes, err = models.DiffDesiredLRPRevisions(from, to)
+	if err != nil {
+		logger.Error("failed-diffing-revisions", err)
+		response.Error = models.ConvertError(err)
+		return
+	}
+}
+
+func (h *DesiredLRPHandler) RollbackDesiredLRP(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("rollback-desired-lrp").WithTraceInfo(req)
+
+	request := &models.RollbackDesiredLRPRequest{}
+	response := &models.DesiredLRPLifecycleResponse{}
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer writeResponse(w, response)
+
+	err := parseRequest(logger, req, request)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+	logger = logger.WithData(lager.Data{"process_guid": request.ProcessGuid, "revision": request.Revision})
+
+	beforeDesiredLRP, err := h.desiredLRPDB.RollbackDesiredLRP(req.Context(), logger, request.ProcessGuid, request.Revision)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	desiredLRP, err := h.desiredLRPDB.DesiredLRPByProcessGuid(req.Context(), logger, request.ProcessGuid)
+	if err != nil {
+		logger.Error("failed-fetching-desired-lrp", err)
+		return
+	}
+
+	go h.desiredHub.Emit(models.NewDesiredLRPChangedEvent(beforeDesiredLRP, desiredLRP, trace.RequestIdFromRequest(req)))
+}
+
 func (h *DesiredLRPHandler) startInstanceRange(ctx context.Context, logger lager.Logger, lower, upper int32, schedulingInfo *models.DesiredLRPSchedulingInfo) {
 	logger = logger.Session("start-instance-range", lager.Data{"lower": lower, "upper": upper})
 	logger.Info("starting")
@@ -346,7 +469,7 @@ func (h *DesiredLRPHandler) startInstanceRange(ctx context.Context, logger lager
 	}
 
 	createdIndices := h.createUnclaimedActualLRPs(ctx, logger, keys)
-	start := auctioneer.NewLRPStartRequestFromSchedulingInfo(schedulingInfo, createdIndices...)
+	start := auctioneer.NewLRPStartRequestFromSchedulingInfo(schedulingInfo.WithSidecarResources(), createdIndices...)
 
 	logger.Info("start-lrp-auction-request", lager.Data{"app_guid": schedulingInfo.ProcessGuid, "indices": createdIndices})
 	err := h.auctioneerClient.RequestLRPAuctions(logger, trace.RequestIdFromContext(ctx), []*auctioneer.LRPStartRequest{&start})
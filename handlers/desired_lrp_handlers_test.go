@@ -251,6 +251,41 @@ var _ = Describe("DesiredLRP Handlers", func() {
 		})
 	})
 
+	Describe("DesiredLRPs_r3", func() {
+		var desiredLRPWithExecCheck *models.DesiredLRP
+
+		BeforeEach(func() {
+			desiredLRPWithExecCheck = &models.DesiredLRP{
+				ImageLayers: []*models.ImageLayer{{LayerType: models.LayerTypeExclusive}},
+				CheckDefinition: &models.CheckDefinition{
+					Checks: []*models.Check{
+						{TcpCheck: &models.TCPCheck{Port: 8080}},
+						{ExecCheck: &models.ExecCheck{Path: "/bin/healthcheck", User: "vcap"}},
+					},
+				},
+			}
+			fakeDesiredLRPDB.DesiredLRPsReturns([]*models.DesiredLRP{desiredLRPWithExecCheck.Copy()}, nil)
+		})
+
+		JustBeforeEach(func() {
+			request := newTestRequest(&models.DesiredLRPsRequest{})
+			request.Header.Set(lager.RequestIdHeader, requestIdHeader)
+			handler.DesiredLRPs_r3(logger, responseRecorder, request)
+		})
+
+		It("returns the desired lrps downgraded to remove the checks older clients cannot run", func() {
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			response := models.DesiredLRPsResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(response.Error).To(BeNil())
+			Expect(response.DesiredLrps).To(DeepEqual([]*models.DesiredLRP{desiredLRPWithExecCheck.VersionDownTo(format.V3)}))
+			Expect(response.DesiredLrps[0].ImageLayers).To(HaveLen(1))
+			Expect(response.DesiredLrps[0].CheckDefinition.Checks).To(HaveLen(1))
+		})
+	})
+
 	Describe("DesiredLRPs", func() {
 		var requestBody interface{}
 
@@ -521,6 +556,38 @@ var _ = Describe("DesiredLRP Handlers", func() {
 		})
 	})
 
+	Describe("DesiredLRPByProcessGuid_r3", func() {
+		var desiredLRPWithGRPCCheck *models.DesiredLRP
+
+		BeforeEach(func() {
+			desiredLRPWithGRPCCheck = &models.DesiredLRP{
+				ProcessGuid: "process-guid",
+				CheckDefinition: &models.CheckDefinition{
+					Checks:          []*models.Check{{TcpCheck: &models.TCPCheck{Port: 8080}}},
+					ReadinessChecks: []*models.Check{{GrpcCheck: &models.GRPCCheck{Port: 8080}}},
+				},
+			}
+			fakeDesiredLRPDB.DesiredLRPByProcessGuidReturns(desiredLRPWithGRPCCheck.Copy(), nil)
+		})
+
+		JustBeforeEach(func() {
+			request := newTestRequest(&models.DesiredLRPByProcessGuidRequest{ProcessGuid: "process-guid"})
+			request.Header.Set(lager.RequestIdHeader, requestIdHeader)
+			handler.DesiredLRPByProcessGuid_r3(logger, responseRecorder, request)
+		})
+
+		It("returns the desired lrp downgraded to remove the checks older clients cannot run", func() {
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			response := models.DesiredLRPResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(response.Error).To(BeNil())
+			Expect(response.DesiredLrp).To(DeepEqual(desiredLRPWithGRPCCheck.VersionDownTo(format.V3)))
+			Expect(response.DesiredLrp.CheckDefinition.ReadinessChecks).To(BeEmpty())
+		})
+	})
+
 	Describe("DesiredLRPByProcessGuid", func() {
 		var (
 			processGuid = "process-guid"
@@ -952,6 +1019,89 @@ var _ = Describe("DesiredLRP Handlers", func() {
 		})
 	})
 
+	Describe("DesiredLRPStatus", func() {
+		var requestBody interface{}
+
+		BeforeEach(func() {
+			requestBody = &models.DesiredLRPsRequest{}
+		})
+
+		JustBeforeEach(func() {
+			request := newTestRequest(requestBody)
+			handler.DesiredLRPStatus(logger, responseRecorder, request)
+		})
+
+		Context("when reading the statuses from the DB succeeds", func() {
+			var statuses []*models.DesiredLRPStatus
+
+			BeforeEach(func() {
+				statuses = []*models.DesiredLRPStatus{
+					{ProcessGuid: "guid-1", DesiredInstances: 2, RunningInstances: 2, CrashReasons: []string{}, PlacementErrors: []string{}},
+					{
+						ProcessGuid:      "guid-2",
+						DesiredInstances: 1,
+						CrashedInstances: 1,
+						CrashReasons:     []string{"out of memory"},
+						PlacementErrors:  []string{},
+						Health:           models.DesiredLRPStatus_Crashing,
+					},
+				}
+				fakeDesiredLRPDB.DesiredLRPStatusesReturns(statuses, nil)
+			})
+
+			It("returns the statuses", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				response := models.DesiredLRPStatusResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(BeNil())
+				Expect(response.Statuses).To(HaveLen(2))
+				Expect(response.Statuses[1].ProcessGuid).To(Equal("guid-2"))
+				Expect(response.Statuses[1].CrashReasons).To(Equal([]string{"out of memory"}))
+				Expect(response.Statuses[1].Health).To(Equal(models.DesiredLRPStatus_Crashing))
+			})
+
+			Context("and filtering by domain and process guids", func() {
+				BeforeEach(func() {
+					requestBody = &models.DesiredLRPsRequest{Domain: "domain-1", ProcessGuids: []string{"guid-1", "guid-2"}}
+				})
+
+				It("calls the DB with the filter", func() {
+					Expect(fakeDesiredLRPDB.DesiredLRPStatusesCallCount()).To(Equal(1))
+					_, _, filter := fakeDesiredLRPDB.DesiredLRPStatusesArgsForCall(0)
+					Expect(filter).To(Equal(models.DesiredLRPFilter{Domain: "domain-1", ProcessGuids: []string{"guid-1", "guid-2"}}))
+				})
+			})
+		})
+
+		Context("when the DB returns an unrecoverable error", func() {
+			BeforeEach(func() {
+				fakeDesiredLRPDB.DesiredLRPStatusesReturns(nil, models.NewUnrecoverableError(nil))
+			})
+
+			It("logs and writes to the exit channel", func() {
+				Eventually(logger).Should(gbytes.Say("unrecoverable-error"))
+				Eventually(exitCh).Should(Receive())
+			})
+		})
+
+		Context("when the DB errors out", func() {
+			BeforeEach(func() {
+				fakeDesiredLRPDB.DesiredLRPStatusesReturns(nil, models.ErrUnknownError)
+			})
+
+			It("provides relevant error information", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				response := models.DesiredLRPStatusResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(Equal(models.ErrUnknownError))
+			})
+		})
+	})
+
 	Describe("DesireDesiredLRP", func() {
 		var (
 			desiredLRP *models.DesiredLRP
@@ -1099,6 +1249,17 @@ var _ = Describe("DesiredLRP Handlers", func() {
 				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
 			})
 
+			Context("when the desired lrp is paused", func() {
+				BeforeEach(func() {
+					desiredLRP.Paused = true
+				})
+
+				It("does not create any actual lrp", func() {
+					Expect(fakeActualLRPDB.CreateUnclaimedActualLRPCallCount()).To(Equal(0))
+					Expect(fakeAuctioneerClient.RequestLRPAuctionsCallCount()).To(Equal(0))
+				})
+			})
+
 			Context("when an auctioneer is present", func() {
 				It("emits start auction requests", func() {
 					Expect(fakeAuctioneerClient.RequestLRPAuctionsCallCount()).To(Equal(1))
@@ -1133,6 +1294,22 @@ var _ = Describe("DesiredLRP Handlers", func() {
 					Expect(startAuctions[0].Resource).To(Equal(expectedStartRequest.Resource))
 					Expect(traceID).To(Equal(requestIdHeader))
 				})
+
+				Context("when the LRP has sidecars", func() {
+					BeforeEach(func() {
+						desiredLRP.Sidecars = []*models.Sidecar{
+							{Action: model_helpers.NewValidAction(), MemoryMb: 64, DiskMb: 32},
+						}
+					})
+
+					It("requests room for the sidecars", func() {
+						Expect(fakeAuctioneerClient.RequestLRPAuctionsCallCount()).To(Equal(1))
+						_, _, startAuctions := fakeAuctioneerClient.RequestLRPAuctionsArgsForCall(0)
+						Expect(startAuctions).To(HaveLen(1))
+						Expect(startAuctions[0].Resource.MemoryMB).To(Equal(desiredLRP.MemoryMb + 64))
+						Expect(startAuctions[0].Resource.DiskMB).To(Equal(desiredLRP.DiskMb + 32))
+					})
+				})
 			})
 
 			Context("when number of desired instances is 0", func() {
@@ -1516,6 +1693,71 @@ var _ = Describe("DesiredLRP Handlers", func() {
 				})
 			})
 
+			Context("when the LRP is paused", func() {
+				var actualLRPs []*models.ActualLRP
+
+				BeforeEach(func() {
+					update = &models.DesiredLRPUpdate{}
+					update.SetPaused(true)
+
+					actualLRPs = []*models.ActualLRP{}
+					for i := 3; i >= 0; i-- {
+						actualLRPs = append(actualLRPs, model_helpers.NewValidActualLRP("some-guid", int32(i)))
+					}
+					fakeActualLRPDB.ActualLRPsReturns(actualLRPs, nil)
+					fakeServiceClient.CellByIdReturns(&models.CellPresence{
+						RepAddress: "some-address",
+						RepUrl:     "http://some-address",
+					}, nil)
+				})
+
+				It("stops all the actual lrps", func() {
+					Expect(fakeRepClient.StopLRPInstanceCallCount()).To(Equal(4))
+					Expect(fakeAuctioneerClient.RequestLRPAuctionsCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the LRP is resumed", func() {
+				BeforeEach(func() {
+					beforeDesiredLRP.Instances = 2
+					beforeDesiredLRP.Paused = true
+					update = &models.DesiredLRPUpdate{}
+					update.SetPaused(false)
+				})
+
+				It("starts its configured instances", func() {
+					Expect(fakeActualLRPDB.CreateUnclaimedActualLRPCallCount()).To(Equal(2))
+
+					Expect(fakeAuctioneerClient.RequestLRPAuctionsCallCount()).To(Equal(1))
+					_, _, startRequests := fakeAuctioneerClient.RequestLRPAuctionsArgsForCall(0)
+					Expect(startRequests).To(HaveLen(1))
+					Expect(startRequests[0].Indices).To(ConsistOf(0, 1))
+				})
+
+				Context("when the instances are scaled at the same time", func() {
+					BeforeEach(func() {
+						update.SetInstances(3)
+					})
+
+					It("starts the new instance count", func() {
+						Expect(fakeActualLRPDB.CreateUnclaimedActualLRPCallCount()).To(Equal(3))
+					})
+				})
+			})
+
+			Context("when the instances of a paused LRP are scaled", func() {
+				BeforeEach(func() {
+					beforeDesiredLRP.Paused = true
+					update = &models.DesiredLRPUpdate{}
+					update.SetInstances(6)
+				})
+
+				It("does not start any instance", func() {
+					Expect(fakeActualLRPDB.CreateUnclaimedActualLRPCallCount()).To(Equal(0))
+					Expect(fakeAuctioneerClient.RequestLRPAuctionsCallCount()).To(Equal(0))
+				})
+			})
+
 			Context("when the routes were changed", func() {
 				var (
 					cfRouterContent, internalRouterContent []byte
@@ -2073,4 +2315,180 @@ var _ = Describe("DesiredLRP Handlers", func() {
 			})
 		})
 	})
+
+	Describe("DesiredLRPRevisions", func() {
+		var requestBody interface{}
+
+		BeforeEach(func() {
+			requestBody = &models.DesiredLRPRevisionsRequest{ProcessGuid: "some-guid"}
+		})
+
+		JustBeforeEach(func() {
+			request := newTestRequest(requestBody)
+			handler.DesiredLRPRevisions(logger, responseRecorder, request)
+		})
+
+		Context("when reading the revisions from the DB succeeds", func() {
+			BeforeEach(func() {
+				desiredLRP := model_helpers.NewValidDesiredLRP("some-guid")
+				fakeDesiredLRPDB.DesiredLRPRevisionsReturns([]*models.DesiredLRPRevision{
+					models.NewDesiredLRPRevision(desiredLRP, time.Unix(0, 1234), models.DesiredLRPRevisionSourceDesire),
+				}, nil)
+			})
+
+			It("returns the revisions", func() {
+				Expect(fakeDesiredLRPDB.DesiredLRPRevisionsCallCount()).To(Equal(1))
+				_, _, processGuid := fakeDesiredLRPDB.DesiredLRPRevisionsArgsForCall(0)
+				Expect(processGuid).To(Equal("some-guid"))
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				response := models.DesiredLRPRevisionsResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(BeNil())
+				Expect(response.Revisions).To(HaveLen(1))
+				Expect(response.Revisions[0].Source).To(Equal(models.DesiredLRPRevisionSourceDesire))
+				Expect(response.Revisions[0].CreatedAt).To(BeEquivalentTo(1234))
+			})
+		})
+
+		Context("when the request is invalid", func() {
+			BeforeEach(func() {
+				requestBody = &models.DesiredLRPRevisionsRequest{}
+			})
+
+			It("returns a bad request error", func() {
+				response := models.DesiredLRPRevisionsResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+				Expect(fakeDesiredLRPDB.DesiredLRPRevisionsCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the DB errors out", func() {
+			BeforeEach(func() {
+				fakeDesiredLRPDB.DesiredLRPRevisionsReturns(nil, models.ErrUnknownError)
+			})
+
+			It("provides relevant error information", func() {
+				response := models.DesiredLRPRevisionsResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(Equal(models.ErrUnknownError))
+			})
+		})
+	})
+
+	Describe("DesiredLRPRevisionDiff", func() {
+		var (
+			from, to *models.DesiredLRPRevision
+		)
+
+		BeforeEach(func() {
+			desiredLRP := model_helpers.NewValidDesiredLRP("some-guid")
+			from = models.NewDesiredLRPRevision(desiredLRP, time.Unix(0, 1234), models.DesiredLRPRevisionSourceDesire)
+			desiredLRP.MemoryMb = 2048
+			desiredLRP.Revision = 1
+			to = models.NewDesiredLRPRevision(desiredLRP, time.Unix(0, 5678), models.DesiredLRPRevisionSourceUpdate)
+
+			fakeDesiredLRPDB.DesiredLRPRevisionStub = func(_ context.Context, _ lager.Logger, _ string, revision int32) (*models.DesiredLRPRevision, error) {
+				if revision == 0 {
+					return from, nil
+				}
+				return to, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			request := newTestRequest(&models.DesiredLRPRevisionDiffRequest{ProcessGuid: "some-guid", FromRevision: 0, ToRevision: 1})
+			handler.DesiredLRPRevisionDiff(logger, responseRecorder, request)
+		})
+
+		It("returns the changes between the revisions", func() {
+			Expect(fakeDesiredLRPDB.DesiredLRPRevisionCallCount()).To(Equal(2))
+
+			response := models.DesiredLRPRevisionDiffResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(response.Error).To(BeNil())
+			Expect(response.Changes).To(ConsistOf(&models.DesiredLRPRevisionChange{Field: "memory_mb", From: "1024", To: "2048"}))
+		})
+
+		Context("when a revision is not found", func() {
+			BeforeEach(func() {
+				fakeDesiredLRPDB.DesiredLRPRevisionStub = nil
+				fakeDesiredLRPDB.DesiredLRPRevisionReturns(nil, models.ErrResourceNotFound)
+			})
+
+			It("returns the error", func() {
+				response := models.DesiredLRPRevisionDiffResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(Equal(models.ErrResourceNotFound))
+			})
+		})
+	})
+
+	Describe("RollbackDesiredLRP", func() {
+		var (
+			beforeDesiredLRP, afterDesiredLRP *models.DesiredLRP
+		)
+
+		BeforeEach(func() {
+			beforeDesiredLRP = model_helpers.NewValidDesiredLRP("some-guid")
+			beforeDesiredLRP.Revision = 2
+			afterDesiredLRP = model_helpers.NewValidDesiredLRP("some-guid")
+			afterDesiredLRP.Revision = 3
+
+			fakeDesiredLRPDB.RollbackDesiredLRPReturns(beforeDesiredLRP, nil)
+			fakeDesiredLRPDB.DesiredLRPByProcessGuidReturns(afterDesiredLRP, nil)
+		})
+
+		JustBeforeEach(func() {
+			request := newTestRequest(&models.RollbackDesiredLRPRequest{ProcessGuid: "some-guid", Revision: 1})
+			handler.RollbackDesiredLRP(logger, responseRecorder, request)
+		})
+
+		It("rolls the desired lrp back to the revision", func() {
+			Expect(fakeDesiredLRPDB.RollbackDesiredLRPCallCount()).To(Equal(1))
+			_, _, processGuid, revision := fakeDesiredLRPDB.RollbackDesiredLRPArgsForCall(0)
+			Expect(processGuid).To(Equal("some-guid"))
+			Expect(revision).To(BeEquivalentTo(1))
+
+			response := models.DesiredLRPLifecycleResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Error).To(BeNil())
+		})
+
+		It("emits a change event to the hub", func() {
+			Eventually(desiredHub.EmitCallCount).Should(Equal(1))
+			event := desiredHub.EmitArgsForCall(0)
+			changeEvent, ok := event.(*models.DesiredLRPChangedEvent)
+			Expect(ok).To(BeTrue())
+			Expect(changeEvent.Before).To(Equal(beforeDesiredLRP))
+			Expect(changeEvent.After).To(Equal(afterDesiredLRP))
+		})
+
+		Context("when the revision is not found", func() {
+			BeforeEach(func() {
+				fakeDesiredLRPDB.RollbackDesiredLRPReturns(nil, models.ErrResourceNotFound)
+			})
+
+			It("returns the error and does not emit an event", func() {
+				response := models.DesiredLRPLifecycleResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(Equal(models.ErrResourceNotFound))
+				Consistently(desiredHub.EmitCallCount).Should(BeZero())
+			})
+		})
+	})
 })
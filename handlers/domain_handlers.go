@@ -5,13 +5,15 @@ import (
 	"net/http"
 
 	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/events"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/lager/v3"
 )
 
 type DomainHandler struct {
-	db       db.DomainDB
-	exitChan chan<- struct{}
+	db         db.DomainDB
+	desiredHub events.Hub
+	exitChan   chan<- struct{}
 }
 
 var (
@@ -19,18 +21,19 @@ var (
 	ErrMaxAgeMissing = errors.New("max-age directive missing from request")
 )
 
-func NewDomainHandler(db db.DomainDB, exitChan chan<- struct{}) *DomainHandler {
+func NewDomainHandler(db db.DomainDB, desiredHub events.Hub, exitChan chan<- struct{}) *DomainHandler {
 	return &DomainHandler{
-		db:       db,
-		exitChan: exitChan,
+		db:         db,
+		desiredHub: desiredHub,
+		exitChan:   exitChan,
 	}
 }
 
 func (h *DomainHandler) Domains(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
 	var err error
 	logger = logger.Session("domains").WithTraceInfo(req)
-	response := &models.DomainsResponse{}
-	response.Domains, err = h.db.FreshDomains(req.Context(), logger)
+	response := &models.AllDomainsResponse{}
+	response.Domains, err = h.db.Domains(req.Context(), logger)
 	response.Error = models.ConvertError(err)
 	writeResponse(w, response)
 	exitIfUnrecoverable(logger, h.exitChan, response.Error)
@@ -45,10 +48,75 @@ func (h *DomainHandler) Upsert(logger lager.Logger, w http.ResponseWriter, req *
 
 	err = parseRequest(logger, req, request)
 	if err == nil {
-		err = h.db.UpsertDomain(req.Context(), logger, request.Domain, request.Ttl)
+		var becameFresh bool
+		becameFresh, err = h.db.UpsertDomain(req.Context(), logger, request.Domain, request.Ttl, upsertCaller(req))
+		if err == nil && becameFresh {
+			go h.desiredHub.Emit(models.NewDomainFreshEvent(request.Domain))
+		}
+	}
+
+	response.Error = models.ConvertError(err)
+	writeResponse(w, response)
+	exitIfUnrecoverable(logger, h.exitChan, response.Error)
+}
+
+func (h *DomainHandler) Domains_r0(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("domains").WithTraceInfo(req)
+	response := &models.DomainsResponse{}
+	response.Domains, err = h.db.FreshDomains(req.Context(), logger)
+	response.Error = models.ConvertError(err)
+	writeResponse(w, response)
+	exitIfUnrecoverable(logger, h.exitChan, response.Error)
+}
+
+func (h *DomainHandler) ExpireDomain(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("expire-domain").WithTraceInfo(req)
+
+	request := &models.ExpireDomainRequest{}
+	response := &models.ExpireDomainResponse{}
+
+	err = parseRequest(logger, req, request)
+	if err == nil {
+		var wasFresh bool
+		wasFresh, err = h.db.ExpireDomain(req.Context(), logger, request.Domain)
+		if err == nil && wasFresh {
+			go h.desiredHub.Emit(models.NewDomainStaleEvent(request.Domain))
+		}
 	}
 
 	response.Error = models.ConvertError(err)
 	writeResponse(w, response)
 	exitIfUnrecoverable(logger, h.exitChan, response.Error)
 }
+
+func (h *DomainHandler) DeleteDomain(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("delete-domain").WithTraceInfo(req)
+
+	request := &models.DeleteDomainRequest{}
+	response := &models.DeleteDomainResponse{}
+
+	err = parseRequest(logger, req, request)
+	if err == nil {
+		var wasFresh bool
+		wasFresh, err = h.db.DeleteDomain(req.Context(), logger, request.Domain)
+		if err == nil && wasFresh {
+			go h.desiredHub.Emit(models.NewDomainStaleEvent(request.Domain))
+		}
+	}
+
+	response.Error = models.ConvertError(err)
+	writeResponse(w, response)
+	exitIfUnrecoverable(logger, h.exitChan, response.Error)
+}
+
+// upsertCaller identifies the client that upserted a domain by the common
+// name of its certificate, or by its address when it did not present one.
+func upsertCaller(req *http.Request) string {
+	if req.TLS != nil && len(req.TLS.PeerCertificates) > 0 {
+		return req.TLS.PeerCertificates[0].Subject.CommonName
+	}
+	return req.RemoteAddr
+}
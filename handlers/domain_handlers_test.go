@@ -1,12 +1,16 @@
 package handlers_test
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 
 	"code.cloudfoundry.org/bbs/db/dbfakes"
+	"code.cloudfoundry.org/bbs/events/eventfakes"
 	"code.cloudfoundry.org/bbs/handlers"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/lager/v3"
@@ -20,6 +24,7 @@ var _ = Describe("Domain Handlers", func() {
 	var (
 		logger           *lagertest.TestLogger
 		fakeDomainDB     *dbfakes.FakeDomainDB
+		desiredHub       *eventfakes.FakeHub
 		responseRecorder *httptest.ResponseRecorder
 		handler          *handlers.DomainHandler
 		requestBody      interface{}
@@ -36,13 +41,16 @@ var _ = Describe("Domain Handlers", func() {
 		requestIdHeader = "93f2374a-c0ad-455a-98bc-aafd4e4a1dc4"
 		b3RequestIdHeader = fmt.Sprintf(`"trace-id":"%s"`, strings.Replace(requestIdHeader, "-", "", -1))
 		exitCh = make(chan struct{}, 1)
-		handler = handlers.NewDomainHandler(fakeDomainDB, exitCh)
+		desiredHub = new(eventfakes.FakeHub)
+		handler = handlers.NewDomainHandler(fakeDomainDB, desiredHub, exitCh)
 	})
 
 	Describe("Upsert", func() {
 		var (
-			domain string
-			ttl    uint32
+			domain     string
+			ttl        uint32
+			tlsState   *tls.ConnectionState
+			remoteAddr string
 		)
 
 		BeforeEach(func() {
@@ -53,24 +61,59 @@ var _ = Describe("Domain Handlers", func() {
 				Domain: domain,
 				Ttl:    ttl,
 			}
+			tlsState = nil
+			remoteAddr = "10.0.0.1:4242"
 		})
 
 		JustBeforeEach(func() {
 			request := newTestRequest(requestBody)
 			request.Header.Set(lager.RequestIdHeader, requestIdHeader)
+			request.TLS = tlsState
+			request.RemoteAddr = remoteAddr
 			handler.Upsert(logger, responseRecorder, request)
 		})
 
 		Context("when upserting domain to DB succeeds", func() {
 			BeforeEach(func() {
-				fakeDomainDB.UpsertDomainReturns(nil)
+				fakeDomainDB.UpsertDomainReturns(false, nil)
 			})
 
 			It("call the DB to upsert the domain", func() {
 				Expect(fakeDomainDB.UpsertDomainCallCount()).To(Equal(1))
-				_, _, domainUpserted, ttlUpserted := fakeDomainDB.UpsertDomainArgsForCall(0)
+				_, _, domainUpserted, ttlUpserted, upsertedBy := fakeDomainDB.UpsertDomainArgsForCall(0)
 				Expect(domainUpserted).To(Equal(domain))
 				Expect(ttlUpserted).To(Equal(ttl))
+				Expect(upsertedBy).To(Equal("10.0.0.1:4242"))
+			})
+
+			It("does not emit a domain fresh event", func() {
+				Consistently(desiredHub.EmitCallCount).Should(Equal(0))
+			})
+
+			Context("when the client presents a certificate", func() {
+				BeforeEach(func() {
+					tlsState = &tls.ConnectionState{
+						PeerCertificates: []*x509.Certificate{
+							{Subject: pkix.Name{CommonName: "cloud-controller"}},
+						},
+					}
+				})
+
+				It("records the common name of the certificate as the caller", func() {
+					_, _, _, _, upsertedBy := fakeDomainDB.UpsertDomainArgsForCall(0)
+					Expect(upsertedBy).To(Equal("cloud-controller"))
+				})
+			})
+
+			Context("when the domain becomes fresh", func() {
+				BeforeEach(func() {
+					fakeDomainDB.UpsertDomainReturns(true, nil)
+				})
+
+				It("emits a domain fresh event", func() {
+					Eventually(desiredHub.EmitCallCount).Should(Equal(1))
+					Expect(desiredHub.EmitArgsForCall(0)).To(Equal(models.NewDomainFreshEvent(domain)))
+				})
 			})
 
 			It("responds with 200 OK", func() {
@@ -122,7 +165,7 @@ var _ = Describe("Domain Handlers", func() {
 
 		Context("when the DB returns an unrecoverable error", func() {
 			BeforeEach(func() {
-				fakeDomainDB.UpsertDomainReturns(models.NewUnrecoverableError(nil))
+				fakeDomainDB.UpsertDomainReturns(false, models.NewUnrecoverableError(nil))
 			})
 
 			It("logs and writes to the exit channel", func() {
@@ -134,7 +177,7 @@ var _ = Describe("Domain Handlers", func() {
 
 		Context("when the DB errors out", func() {
 			BeforeEach(func() {
-				fakeDomainDB.UpsertDomainReturns(models.ErrUnknownError)
+				fakeDomainDB.UpsertDomainReturns(false, models.ErrUnknownError)
 			})
 
 			It("provides relevant error information", func() {
@@ -150,7 +193,7 @@ var _ = Describe("Domain Handlers", func() {
 		})
 	})
 
-	Describe("Domains", func() {
+	Describe("Domains_r0", func() {
 		var domains []string
 
 		BeforeEach(func() {
@@ -160,7 +203,7 @@ var _ = Describe("Domain Handlers", func() {
 		JustBeforeEach(func() {
 			request := newTestRequest("")
 			request.Header.Set(lager.RequestIdHeader, requestIdHeader)
-			handler.Domains(logger, responseRecorder, request)
+			handler.Domains_r0(logger, responseRecorder, request)
 		})
 
 		Context("when reading domains from DB succeeds", func() {
@@ -230,4 +273,220 @@ var _ = Describe("Domain Handlers", func() {
 			})
 		})
 	})
+
+	Describe("Domains", func() {
+		var domains []*models.Domain
+
+		BeforeEach(func() {
+			domains = []*models.Domain{
+				{Domain: "domain-a", ExpiresAt: 1000, Fresh: true, UpsertedBy: "cloud-controller", UpsertedAt: 10},
+				{Domain: "domain-b", ExpiresAt: 500, Fresh: false, UpsertedBy: "10.0.0.1:4242", UpsertedAt: 20},
+			}
+		})
+
+		JustBeforeEach(func() {
+			request := newTestRequest("")
+			request.Header.Set(lager.RequestIdHeader, requestIdHeader)
+			handler.Domains(logger, responseRecorder, request)
+		})
+
+		Context("when reading domains from DB succeeds", func() {
+			BeforeEach(func() {
+				fakeDomainDB.DomainsReturns(domains, nil)
+			})
+
+			It("returns the domains with their metadata", func() {
+				Expect(fakeDomainDB.DomainsCallCount()).To(Equal(1))
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+
+				response := &models.AllDomainsResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(BeNil())
+				Expect(response.Domains).To(Equal(domains))
+			})
+		})
+
+		Context("when the DB returns an unrecoverable error", func() {
+			BeforeEach(func() {
+				fakeDomainDB.DomainsReturns(nil, models.NewUnrecoverableError(nil))
+			})
+
+			It("logs and writes to the exit channel", func() {
+				Eventually(logger).Should(gbytes.Say("unrecoverable-error"))
+				Eventually(logger).Should(gbytes.Say(b3RequestIdHeader))
+				Eventually(exitCh).Should(Receive())
+			})
+		})
+
+		Context("when the DB errors out", func() {
+			BeforeEach(func() {
+				fakeDomainDB.DomainsReturns(nil, models.ErrUnknownError)
+			})
+
+			It("provides relevant error information", func() {
+				response := &models.AllDomainsResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(Equal(models.ErrUnknownError))
+				Expect(response.Domains).To(BeNil())
+			})
+		})
+	})
+
+	Describe("ExpireDomain", func() {
+		BeforeEach(func() {
+			requestBody = &models.ExpireDomainRequest{Domain: "domain-to-expire"}
+		})
+
+		JustBeforeEach(func() {
+			request := newTestRequest(requestBody)
+			request.Header.Set(lager.RequestIdHeader, requestIdHeader)
+			handler.ExpireDomain(logger, responseRecorder, request)
+		})
+
+		Context("when the domain was fresh", func() {
+			BeforeEach(func() {
+				fakeDomainDB.ExpireDomainReturns(true, nil)
+			})
+
+			It("expires the domain", func() {
+				Expect(fakeDomainDB.ExpireDomainCallCount()).To(Equal(1))
+				_, _, domain := fakeDomainDB.ExpireDomainArgsForCall(0)
+				Expect(domain).To(Equal("domain-to-expire"))
+
+				response := &models.ExpireDomainResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error).To(BeNil())
+			})
+
+			It("emits a domain stale event", func() {
+				Eventually(desiredHub.EmitCallCount).Should(Equal(1))
+				Expect(desiredHub.EmitArgsForCall(0)).To(Equal(models.NewDomainStaleEvent("domain-to-expire")))
+			})
+		})
+
+		Context("when the domain was already stale", func() {
+			BeforeEach(func() {
+				fakeDomainDB.ExpireDomainReturns(false, nil)
+			})
+
+			It("does not emit a domain stale event", func() {
+				Consistently(desiredHub.EmitCallCount).Should(Equal(0))
+			})
+		})
+
+		Context("when the request is invalid", func() {
+			BeforeEach(func() {
+				requestBody = &models.ExpireDomainRequest{}
+			})
+
+			It("responds with an invalid request error", func() {
+				response := &models.ExpireDomainResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+				Expect(fakeDomainDB.ExpireDomainCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the domain does not exist", func() {
+			BeforeEach(func() {
+				fakeDomainDB.ExpireDomainReturns(false, models.ErrResourceNotFound)
+			})
+
+			It("responds with a resource not found error", func() {
+				response := &models.ExpireDomainResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error).To(Equal(models.ErrResourceNotFound))
+			})
+		})
+
+		Context("when the DB returns an unrecoverable error", func() {
+			BeforeEach(func() {
+				fakeDomainDB.ExpireDomainReturns(false, models.NewUnrecoverableError(nil))
+			})
+
+			It("logs and writes to the exit channel", func() {
+				Eventually(logger).Should(gbytes.Say("unrecoverable-error"))
+				Eventually(exitCh).Should(Receive())
+			})
+		})
+	})
+
+	Describe("DeleteDomain", func() {
+		BeforeEach(func() {
+			requestBody = &models.DeleteDomainRequest{Domain: "domain-to-delete"}
+		})
+
+		JustBeforeEach(func() {
+			request := newTestRequest(requestBody)
+			request.Header.Set(lager.RequestIdHeader, requestIdHeader)
+			handler.DeleteDomain(logger, responseRecorder, request)
+		})
+
+		Context("when the domain was fresh", func() {
+			BeforeEach(func() {
+				fakeDomainDB.DeleteDomainReturns(true, nil)
+			})
+
+			It("deletes the domain", func() {
+				Expect(fakeDomainDB.DeleteDomainCallCount()).To(Equal(1))
+				_, _, domain := fakeDomainDB.DeleteDomainArgsForCall(0)
+				Expect(domain).To(Equal("domain-to-delete"))
+
+				response := &models.DeleteDomainResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error).To(BeNil())
+			})
+
+			It("emits a domain stale event", func() {
+				Eventually(desiredHub.EmitCallCount).Should(Equal(1))
+				Expect(desiredHub.EmitArgsForCall(0)).To(Equal(models.NewDomainStaleEvent("domain-to-delete")))
+			})
+		})
+
+		Context("when the domain was already stale", func() {
+			BeforeEach(func() {
+				fakeDomainDB.DeleteDomainReturns(false, nil)
+			})
+
+			It("does not emit a domain stale event", func() {
+				Consistently(desiredHub.EmitCallCount).Should(Equal(0))
+			})
+		})
+
+		Context("when the request is invalid", func() {
+			BeforeEach(func() {
+				requestBody = &models.DeleteDomainRequest{}
+			})
+
+			It("responds with an invalid request error", func() {
+				response := &models.DeleteDomainResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+				Expect(fakeDomainDB.DeleteDomainCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the DB errors out", func() {
+			BeforeEach(func() {
+				fakeDomainDB.DeleteDomainReturns(false, models.ErrUnknownError)
+			})
+
+			It("provides relevant error information", func() {
+				response := &models.DeleteDomainResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error).To(Equal(models.ErrUnknownError))
+				Expect(desiredHub.EmitCallCount()).To(Equal(0))
+			})
+		})
+	})
 })
@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+type DomainQuotaHandler struct {
+	db       db.DomainQuotaDB
+	exitChan chan<- struct{}
+}
+
+func NewDomainQuotaHandler(db db.DomainQuotaDB, exitChan chan<- struct{}) *DomainQuotaHandler {
+	return &DomainQuotaHandler{
+		db:       db,
+		exitChan: exitChan,
+	}
+}
+
+func (h *DomainQuotaHandler) UpsertDomainQuota(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("upsert-domain-quota").WithTraceInfo(req)
+
+	request := &models.UpsertDomainQuotaRequest{}
+	response := &models.UpsertDomainQuotaResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, response) }()
+
+	err = parseRequest(logger, req, request)
+	if err != nil {
+		logger.Error("failed-parsing-request", err)
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	err = h.db.UpsertDomainQuota(req.Context(), logger, request.Quota)
+	response.Error = models.ConvertError(err)
+}
+
+func (h *DomainQuotaHandler) DeleteDomainQuota(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("delete-domain-quota").WithTraceInfo(req)
+
+	request := &models.DeleteDomainQuotaRequest{}
+	response := &models.DeleteDomainQuotaResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, response) }()
+
+	err = parseRequest(logger, req, request)
+	if err != nil {
+		logger.Error("failed-parsing-request", err)
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	err = h.db.DeleteDomainQuota(req.Context(), logger, request.Domain)
+	response.Error = models.ConvertError(err)
+}
+
+func (h *DomainQuotaHandler) DomainQuotas(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("domain-quotas").WithTraceInfo(req)
+
+	response := &models.DomainQuotasResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, response) }()
+
+	response.Quotas, err = h.db.DomainQuotas(req.Context(), logger)
+	response.Error = models.ConvertError(err)
+}
+
+func (h *DomainQuotaHandler) DomainUsage(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("domain-usage").WithTraceInfo(req)
+
+	request := &models.DomainUsageRequest{}
+	response := &models.DomainUsageResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, response) }()
+
+	err = parseRequest(logger, req, request)
+	if err != nil {
+		logger.Error("failed-parsing-request", err)
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	response.Usage, err = h.db.DomainUsage(req.Context(), logger, request.Domain)
+	response.Error = models.ConvertError(err)
+}
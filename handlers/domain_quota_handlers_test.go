@@ -0,0 +1,182 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"code.cloudfoundry.org/bbs/db/dbfakes"
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("DomainQuota Handlers", func() {
+	var (
+		logger            *lagertest.TestLogger
+		fakeDomainQuotaDB *dbfakes.FakeDomainQuotaDB
+		responseRecorder  *httptest.ResponseRecorder
+		handler           *handlers.DomainQuotaHandler
+		exitCh            chan struct{}
+		requestBody       interface{}
+		quota             *models.DomainQuota
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		fakeDomainQuotaDB = new(dbfakes.FakeDomainQuotaDB)
+		responseRecorder = httptest.NewRecorder()
+		exitCh = make(chan struct{}, 1)
+		handler = handlers.NewDomainQuotaHandler(fakeDomainQuotaDB, exitCh)
+
+		quota = &models.DomainQuota{
+			Domain:       "some-domain",
+			MaxInstances: 10,
+			MaxMemoryMb:  1024,
+			MaxDiskMb:    2048,
+			MaxTasks:     5,
+		}
+	})
+
+	Describe("UpsertDomainQuota", func() {
+		BeforeEach(func() {
+			requestBody = &models.UpsertDomainQuotaRequest{Quota: quota}
+		})
+
+		JustBeforeEach(func() {
+			handler.UpsertDomainQuota(logger, responseRecorder, newTestRequest(requestBody))
+		})
+
+		It("upserts the quota", func() {
+			Expect(fakeDomainQuotaDB.UpsertDomainQuotaCallCount()).To(Equal(1))
+			_, _, actualQuota := fakeDomainQuotaDB.UpsertDomainQuotaArgsForCall(0)
+			Expect(actualQuota).To(Equal(quota))
+
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			response := models.UpsertDomainQuotaResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Error).To(BeNil())
+		})
+
+		Context("when the request is invalid", func() {
+			BeforeEach(func() {
+				requestBody = &models.UpsertDomainQuotaRequest{Quota: &models.DomainQuota{MaxTasks: -1}}
+			})
+
+			It("responds with a bad request error", func() {
+				Expect(fakeDomainQuotaDB.UpsertDomainQuotaCallCount()).To(Equal(0))
+
+				response := models.UpsertDomainQuotaResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+			})
+		})
+
+		Context("when the DB returns an unrecoverable error", func() {
+			BeforeEach(func() {
+				fakeDomainQuotaDB.UpsertDomainQuotaReturns(models.NewUnrecoverableError(nil))
+			})
+
+			It("logs and writes to the exit channel", func() {
+				Eventually(logger).Should(gbytes.Say("unrecoverable-error"))
+				Eventually(exitCh).Should(Receive())
+			})
+		})
+	})
+
+	Describe("DeleteDomainQuota", func() {
+		BeforeEach(func() {
+			requestBody = &models.DeleteDomainQuotaRequest{Domain: "some-domain"}
+		})
+
+		JustBeforeEach(func() {
+			handler.DeleteDomainQuota(logger, responseRecorder, newTestRequest(requestBody))
+		})
+
+		It("deletes the quota of the domain", func() {
+			Expect(fakeDomainQuotaDB.DeleteDomainQuotaCallCount()).To(Equal(1))
+			_, _, domain := fakeDomainQuotaDB.DeleteDomainQuotaArgsForCall(0)
+			Expect(domain).To(Equal("some-domain"))
+
+			response := models.DeleteDomainQuotaResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Error).To(BeNil())
+		})
+
+		Context("when the domain has no quota", func() {
+			BeforeEach(func() {
+				fakeDomainQuotaDB.DeleteDomainQuotaReturns(models.ErrResourceNotFound)
+			})
+
+			It("responds with a resource not found error", func() {
+				response := models.DeleteDomainQuotaResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error).To(Equal(models.ErrResourceNotFound))
+			})
+		})
+	})
+
+	Describe("DomainQuotas", func() {
+		JustBeforeEach(func() {
+			handler.DomainQuotas(logger, responseRecorder, newTestRequest(""))
+		})
+
+		BeforeEach(func() {
+			fakeDomainQuotaDB.DomainQuotasReturns([]*models.DomainQuota{quota}, nil)
+		})
+
+		It("returns the quotas", func() {
+			response := models.DomainQuotasResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Error).To(BeNil())
+			Expect(response.Quotas).To(Equal([]*models.DomainQuota{quota}))
+		})
+	})
+
+	Describe("DomainUsage", func() {
+		var usage *models.DomainUsage
+
+		BeforeEach(func() {
+			requestBody = &models.DomainUsageRequest{Domain: "some-domain"}
+			usage = &models.DomainUsage{Domain: "some-domain", Instances: 3, MemoryMb: 768, DiskMb: 3072, Tasks: 2}
+			fakeDomainQuotaDB.DomainUsageReturns(usage, nil)
+		})
+
+		JustBeforeEach(func() {
+			handler.DomainUsage(logger, responseRecorder, newTestRequest(requestBody))
+		})
+
+		It("returns the usage of the domain", func() {
+			_, _, domain := fakeDomainQuotaDB.DomainUsageArgsForCall(0)
+			Expect(domain).To(Equal("some-domain"))
+
+			response := models.DomainUsageResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Error).To(BeNil())
+			Expect(response.Usage).To(Equal(usage))
+		})
+
+		Context("when the request is invalid", func() {
+			BeforeEach(func() {
+				requestBody = &models.DomainUsageRequest{}
+			})
+
+			It("responds with a bad request error", func() {
+				Expect(fakeDomainQuotaDB.DomainUsageCallCount()).To(Equal(0))
+
+				response := models.DomainUsageResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+			})
+		})
+	})
+})
@@ -156,6 +156,10 @@ func (h *LRPInstanceEventHandler) Subscribe_r1(logger lager.Logger, w http.Respo
 	h.commonSubscribe(logger, w, req, format.V3)
 }
 
+func (h *LRPInstanceEventHandler) Subscribe_r2(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	h.commonSubscribe(logger, w, req, format.V4)
+}
+
 func (h *TaskEventHandler) commonSubscribe(logger lager.Logger, w http.ResponseWriter, req *http.Request, target format.Version) {
 	logger = logger.Session("tasks-subscribe-r0").WithTraceInfo(req)
 	logger.Info("subscribed-to-tasks-event-stream")
@@ -268,6 +272,11 @@ func filterInstanceEventByCellID(cellID string, bbsEvent models.Event, err error
 		if x.ActualLRPInstanceKey.CellId != cellID {
 			return false
 		}
+
+	case *models.ActualLRPRestartsExhaustedEvent:
+		if x.ActualLRPInstanceKey.CellId != cellID {
+			return false
+		}
 	}
 
 	return true
@@ -769,6 +769,53 @@ var _ = Describe("Event Handlers", func() {
 		})
 	})
 
+	Describe("Instance Events Subscribe_r2", func() {
+		var (
+			desiredHub      events.Hub
+			lrpInstanceHub  events.Hub
+			instanceHandler *handlers.LRPInstanceEventHandler
+		)
+
+		BeforeEach(func() {
+			desiredHub = events.NewHub(logger)
+			lrpInstanceHub = events.NewHub(logger)
+			instanceHandler = handlers.NewLRPInstanceEventHandler(desiredHub, lrpInstanceHub)
+		})
+
+		AfterEach(func() {
+			desiredHub.Close()
+			lrpInstanceHub.Close()
+		})
+
+		Describe("Subscribe to Desired Events", func() {
+			It("keeps the exec and gRPC checks of desired lrps", func() {
+				server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					instanceHandler.Subscribe_r2(logger, w, r)
+				}))
+
+				response, err := http.Get(server.URL)
+				Expect(err).NotTo(HaveOccurred())
+				reader := sse.NewReadCloser(response.Body)
+
+				desiredLRP := model_helpers.NewValidDesiredLRP("guid")
+				desiredLRP.CheckDefinition.Checks = append(desiredLRP.CheckDefinition.Checks,
+					&models.Check{ExecCheck: &models.ExecCheck{Path: "/bin/healthcheck", User: "vcap"}},
+				)
+				desiredLRP.CheckDefinition.ReadinessChecks = []*models.Check{{GrpcCheck: &models.GRPCCheck{Port: 8080}}}
+				event := models.NewDesiredLRPCreatedEvent(desiredLRP, "some-trace-id")
+
+				desiredHub.Emit(event)
+
+				events := events.NewEventSource(reader)
+				actualEvent, err := events.Next()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(actualEvent).To(Equal(event))
+
+				server.Close()
+			})
+		})
+	})
+
 	Describe("Tasks Subscribe_r0", func() {
 		var (
 			taskHub events.Hub
@@ -70,6 +70,33 @@ type FakeActualLRPLifecycleController struct {
 	removeActualLRPReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RestartActualLRPStub        func(context.Context, lager.Logger, *models.ActualLRPKey) error
+	restartActualLRPMutex       sync.RWMutex
+	restartActualLRPArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ActualLRPKey
+	}
+	restartActualLRPReturns struct {
+		result1 error
+	}
+	restartActualLRPReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RestartDesiredLRPStub        func(context.Context, lager.Logger, string, int32) error
+	restartDesiredLRPMutex       sync.RWMutex
+	restartDesiredLRPArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int32
+	}
+	restartDesiredLRPReturns struct {
+		result1 error
+	}
+	restartDesiredLRPReturnsOnCall map[int]struct {
+		result1 error
+	}
 	RetireActualLRPStub        func(context.Context, lager.Logger, *models.ActualLRPKey) error
 	retireActualLRPMutex       sync.RWMutex
 	retireActualLRPArgsForCall []struct {
@@ -365,6 +392,133 @@ func (fake *FakeActualLRPLifecycleController) RemoveActualLRPReturnsOnCall(i int
 	}{result1}
 }
 
+func (fake *FakeActualLRPLifecycleController) RestartActualLRP(arg1 context.Context, arg2 lager.Logger, arg3 *models.ActualLRPKey) error {
+	fake.restartActualLRPMutex.Lock()
+	ret, specificReturn := fake.restartActualLRPReturnsOnCall[len(fake.restartActualLRPArgsForCall)]
+	fake.restartActualLRPArgsForCall = append(fake.restartActualLRPArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ActualLRPKey
+	}{arg1, arg2, arg3})
+	stub := fake.RestartActualLRPStub
+	fakeReturns := fake.restartActualLRPReturns
+	fake.recordInvocation("RestartActualLRP", []interface{}{arg1, arg2, arg3})
+	fake.restartActualLRPMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeActualLRPLifecycleController) RestartActualLRPCallCount() int {
+	fake.restartActualLRPMutex.RLock()
+	defer fake.restartActualLRPMutex.RUnlock()
+	return len(fake.restartActualLRPArgsForCall)
+}
+
+func (fake *FakeActualLRPLifecycleController) RestartActualLRPCalls(stub func(context.Context, lager.Logger, *models.ActualLRPKey) error) {
+	fake.restartActualLRPMutex.Lock()
+	defer fake.restartActualLRPMutex.Unlock()
+	fake.RestartActualLRPStub = stub
+}
+
+func (fake *FakeActualLRPLifecycleController) RestartActualLRPArgsForCall(i int) (context.Context, lager.Logger, *models.ActualLRPKey) {
+	fake.restartActualLRPMutex.RLock()
+	defer fake.restartActualLRPMutex.RUnlock()
+	argsForCall := fake.restartActualLRPArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActualLRPLifecycleController) RestartActualLRPReturns(result1 error) {
+	fake.restartActualLRPMutex.Lock()
+	defer fake.restartActualLRPMutex.Unlock()
+	fake.RestartActualLRPStub = nil
+	fake.restartActualLRPReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeActualLRPLifecycleController) RestartActualLRPReturnsOnCall(i int, result1 error) {
+	fake.restartActualLRPMutex.Lock()
+	defer fake.restartActualLRPMutex.Unlock()
+	fake.RestartActualLRPStub = nil
+	if fake.restartActualLRPReturnsOnCall == nil {
+		fake.restartActualLRPReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.restartActualLRPReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeActualLRPLifecycleController) RestartDesiredLRP(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 int32) error {
+	fake.restartDesiredLRPMutex.Lock()
+	ret, specificReturn := fake.restartDesiredLRPReturnsOnCall[len(fake.restartDesiredLRPArgsForCall)]
+	fake.restartDesiredLRPArgsForCall = append(fake.restartDesiredLRPArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int32
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RestartDesiredLRPStub
+	fakeReturns := fake.restartDesiredLRPReturns
+	fake.recordInvocation("RestartDesiredLRP", []interface{}{arg1, arg2, arg3, arg4})
+	fake.restartDesiredLRPMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeActualLRPLifecycleController) RestartDesiredLRPCallCount() int {
+	fake.restartDesiredLRPMutex.RLock()
+	defer fake.restartDesiredLRPMutex.RUnlock()
+	return len(fake.restartDesiredLRPArgsForCall)
+}
+
+func (fake *FakeActualLRPLifecycleController) RestartDesiredLRPCalls(stub func(context.Context, lager.Logger, string, int32) error) {
+	fake.restartDesiredLRPMutex.Lock()
+	defer fake.restartDesiredLRPMutex.Unlock()
+	fake.RestartDesiredLRPStub = stub
+}
+
+func (fake *FakeActualLRPLifecycleController) RestartDesiredLRPArgsForCall(i int) (context.Context, lager.Logger, string, int32) {
+	fake.restartDesiredLRPMutex.RLock()
+	defer fake.restartDesiredLRPMutex.RUnlock()
+	argsForCall := fake.restartDesiredLRPArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeActualLRPLifecycleController) RestartDesiredLRPReturns(result1 error) {
+	fake.restartDesiredLRPMutex.Lock()
+	defer fake.restartDesiredLRPMutex.Unlock()
+	fake.RestartDesiredLRPStub = nil
+	fake.restartDesiredLRPReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeActualLRPLifecycleController) RestartDesiredLRPReturnsOnCall(i int, result1 error) {
+	fake.restartDesiredLRPMutex.Lock()
+	defer fake.restartDesiredLRPMutex.Unlock()
+	fake.RestartDesiredLRPStub = nil
+	if fake.restartDesiredLRPReturnsOnCall == nil {
+		fake.restartDesiredLRPReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.restartDesiredLRPReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeActualLRPLifecycleController) RetireActualLRP(arg1 context.Context, arg2 lager.Logger, arg3 *models.ActualLRPKey) error {
 	fake.retireActualLRPMutex.Lock()
 	ret, specificReturn := fake.retireActualLRPReturnsOnCall[len(fake.retireActualLRPArgsForCall)]
@@ -513,6 +667,10 @@ func (fake *FakeActualLRPLifecycleController) Invocations() map[string][][]inter
 	defer fake.failActualLRPMutex.RUnlock()
 	fake.removeActualLRPMutex.RLock()
 	defer fake.removeActualLRPMutex.RUnlock()
+	fake.restartActualLRPMutex.RLock()
+	defer fake.restartActualLRPMutex.RUnlock()
+	fake.restartDesiredLRPMutex.RLock()
+	defer fake.restartDesiredLRPMutex.RUnlock()
 	fake.retireActualLRPMutex.RLock()
 	defer fake.retireActualLRPMutex.RUnlock()
 	fake.startActualLRPMutex.RLock()
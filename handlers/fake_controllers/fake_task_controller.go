@@ -25,6 +25,19 @@ type FakeTaskController struct {
 	cancelTaskReturnsOnCall map[int]struct {
 		result1 error
 	}
+	CancelTaskArrayStub        func(context.Context, lager.Logger, string) error
+	cancelTaskArrayMutex       sync.RWMutex
+	cancelTaskArrayArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	cancelTaskArrayReturns struct {
+		result1 error
+	}
+	cancelTaskArrayReturnsOnCall map[int]struct {
+		result1 error
+	}
 	CompleteTaskStub        func(context.Context, lager.Logger, string, string, bool, string, string) error
 	completeTaskMutex       sync.RWMutex
 	completeTaskArgsForCall []struct {
@@ -85,6 +98,22 @@ type FakeTaskController struct {
 	desireTaskReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DesireTaskArrayStub        func(context.Context, lager.Logger, *models.TaskDefinition, string, string, int32) error
+	desireTaskArrayMutex       sync.RWMutex
+	desireTaskArrayArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.TaskDefinition
+		arg4 string
+		arg5 string
+		arg6 int32
+	}
+	desireTaskArrayReturns struct {
+		result1 error
+	}
+	desireTaskArrayReturnsOnCall map[int]struct {
+		result1 error
+	}
 	FailTaskStub        func(context.Context, lager.Logger, string, string) error
 	failTaskMutex       sync.RWMutex
 	failTaskArgsForCall []struct {
@@ -142,6 +171,21 @@ type FakeTaskController struct {
 		result1 bool
 		result2 error
 	}
+	TaskArrayStatusStub        func(context.Context, lager.Logger, string) (*models.TaskArrayStatus, error)
+	taskArrayStatusMutex       sync.RWMutex
+	taskArrayStatusArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	taskArrayStatusReturns struct {
+		result1 *models.TaskArrayStatus
+		result2 error
+	}
+	taskArrayStatusReturnsOnCall map[int]struct {
+		result1 *models.TaskArrayStatus
+		result2 error
+	}
 	TaskByGuidStub        func(context.Context, lager.Logger, string) (*models.Task, error)
 	taskByGuidMutex       sync.RWMutex
 	taskByGuidArgsForCall []struct {
@@ -157,13 +201,29 @@ type FakeTaskController struct {
 		result1 *models.Task
 		result2 error
 	}
-	TasksStub        func(context.Context, lager.Logger, string, string) ([]*models.Task, error)
+	TaskResultStub        func(context.Context, lager.Logger, string) (string, error)
+	taskResultMutex       sync.RWMutex
+	taskResultArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	taskResultReturns struct {
+		result1 string
+		result2 error
+	}
+	taskResultReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	TasksStub        func(context.Context, lager.Logger, string, string, bool) ([]*models.Task, error)
 	tasksMutex       sync.RWMutex
 	tasksArgsForCall []struct {
 		arg1 context.Context
 		arg2 lager.Logger
 		arg3 string
 		arg4 string
+		arg5 bool
 	}
 	tasksReturns struct {
 		result1 []*models.Task
@@ -240,6 +300,69 @@ func (fake *FakeTaskController) CancelTaskReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeTaskController) CancelTaskArray(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
+	fake.cancelTaskArrayMutex.Lock()
+	ret, specificReturn := fake.cancelTaskArrayReturnsOnCall[len(fake.cancelTaskArrayArgsForCall)]
+	fake.cancelTaskArrayArgsForCall = append(fake.cancelTaskArrayArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.CancelTaskArrayStub
+	fakeReturns := fake.cancelTaskArrayReturns
+	fake.recordInvocation("CancelTaskArray", []interface{}{arg1, arg2, arg3})
+	fake.cancelTaskArrayMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeTaskController) CancelTaskArrayCallCount() int {
+	fake.cancelTaskArrayMutex.RLock()
+	defer fake.cancelTaskArrayMutex.RUnlock()
+	return len(fake.cancelTaskArrayArgsForCall)
+}
+
+func (fake *FakeTaskController) CancelTaskArrayCalls(stub func(context.Context, lager.Logger, string) error) {
+	fake.cancelTaskArrayMutex.Lock()
+	defer fake.cancelTaskArrayMutex.Unlock()
+	fake.CancelTaskArrayStub = stub
+}
+
+func (fake *FakeTaskController) CancelTaskArrayArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.cancelTaskArrayMutex.RLock()
+	defer fake.cancelTaskArrayMutex.RUnlock()
+	argsForCall := fake.cancelTaskArrayArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTaskController) CancelTaskArrayReturns(result1 error) {
+	fake.cancelTaskArrayMutex.Lock()
+	defer fake.cancelTaskArrayMutex.Unlock()
+	fake.CancelTaskArrayStub = nil
+	fake.cancelTaskArrayReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTaskController) CancelTaskArrayReturnsOnCall(i int, result1 error) {
+	fake.cancelTaskArrayMutex.Lock()
+	defer fake.cancelTaskArrayMutex.Unlock()
+	fake.CancelTaskArrayStub = nil
+	if fake.cancelTaskArrayReturnsOnCall == nil {
+		fake.cancelTaskArrayReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.cancelTaskArrayReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTaskController) CompleteTask(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 string, arg5 bool, arg6 string, arg7 string) error {
 	fake.completeTaskMutex.Lock()
 	ret, specificReturn := fake.completeTaskReturnsOnCall[len(fake.completeTaskArgsForCall)]
@@ -500,6 +623,72 @@ func (fake *FakeTaskController) DesireTaskReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeTaskController) DesireTaskArray(arg1 context.Context, arg2 lager.Logger, arg3 *models.TaskDefinition, arg4 string, arg5 string, arg6 int32) error {
+	fake.desireTaskArrayMutex.Lock()
+	ret, specificReturn := fake.desireTaskArrayReturnsOnCall[len(fake.desireTaskArrayArgsForCall)]
+	fake.desireTaskArrayArgsForCall = append(fake.desireTaskArrayArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.TaskDefinition
+		arg4 string
+		arg5 string
+		arg6 int32
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	stub := fake.DesireTaskArrayStub
+	fakeReturns := fake.desireTaskArrayReturns
+	fake.recordInvocation("DesireTaskArray", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.desireTaskArrayMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeTaskController) DesireTaskArrayCallCount() int {
+	fake.desireTaskArrayMutex.RLock()
+	defer fake.desireTaskArrayMutex.RUnlock()
+	return len(fake.desireTaskArrayArgsForCall)
+}
+
+func (fake *FakeTaskController) DesireTaskArrayCalls(stub func(context.Context, lager.Logger, *models.TaskDefinition, string, string, int32) error) {
+	fake.desireTaskArrayMutex.Lock()
+	defer fake.desireTaskArrayMutex.Unlock()
+	fake.DesireTaskArrayStub = stub
+}
+
+func (fake *FakeTaskController) DesireTaskArrayArgsForCall(i int) (context.Context, lager.Logger, *models.TaskDefinition, string, string, int32) {
+	fake.desireTaskArrayMutex.RLock()
+	defer fake.desireTaskArrayMutex.RUnlock()
+	argsForCall := fake.desireTaskArrayArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakeTaskController) DesireTaskArrayReturns(result1 error) {
+	fake.desireTaskArrayMutex.Lock()
+	defer fake.desireTaskArrayMutex.Unlock()
+	fake.DesireTaskArrayStub = nil
+	fake.desireTaskArrayReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTaskController) DesireTaskArrayReturnsOnCall(i int, result1 error) {
+	fake.desireTaskArrayMutex.Lock()
+	defer fake.desireTaskArrayMutex.Unlock()
+	fake.DesireTaskArrayStub = nil
+	if fake.desireTaskArrayReturnsOnCall == nil {
+		fake.desireTaskArrayReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.desireTaskArrayReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTaskController) FailTask(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 string) error {
 	fake.failTaskMutex.Lock()
 	ret, specificReturn := fake.failTaskReturnsOnCall[len(fake.failTaskArgsForCall)]
@@ -758,6 +947,72 @@ func (fake *FakeTaskController) StartTaskReturnsOnCall(i int, result1 bool, resu
 	}{result1, result2}
 }
 
+func (fake *FakeTaskController) TaskArrayStatus(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.TaskArrayStatus, error) {
+	fake.taskArrayStatusMutex.Lock()
+	ret, specificReturn := fake.taskArrayStatusReturnsOnCall[len(fake.taskArrayStatusArgsForCall)]
+	fake.taskArrayStatusArgsForCall = append(fake.taskArrayStatusArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.TaskArrayStatusStub
+	fakeReturns := fake.taskArrayStatusReturns
+	fake.recordInvocation("TaskArrayStatus", []interface{}{arg1, arg2, arg3})
+	fake.taskArrayStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTaskController) TaskArrayStatusCallCount() int {
+	fake.taskArrayStatusMutex.RLock()
+	defer fake.taskArrayStatusMutex.RUnlock()
+	return len(fake.taskArrayStatusArgsForCall)
+}
+
+func (fake *FakeTaskController) TaskArrayStatusCalls(stub func(context.Context, lager.Logger, string) (*models.TaskArrayStatus, error)) {
+	fake.taskArrayStatusMutex.Lock()
+	defer fake.taskArrayStatusMutex.Unlock()
+	fake.TaskArrayStatusStub = stub
+}
+
+func (fake *FakeTaskController) TaskArrayStatusArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.taskArrayStatusMutex.RLock()
+	defer fake.taskArrayStatusMutex.RUnlock()
+	argsForCall := fake.taskArrayStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTaskController) TaskArrayStatusReturns(result1 *models.TaskArrayStatus, result2 error) {
+	fake.taskArrayStatusMutex.Lock()
+	defer fake.taskArrayStatusMutex.Unlock()
+	fake.TaskArrayStatusStub = nil
+	fake.taskArrayStatusReturns = struct {
+		result1 *models.TaskArrayStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskController) TaskArrayStatusReturnsOnCall(i int, result1 *models.TaskArrayStatus, result2 error) {
+	fake.taskArrayStatusMutex.Lock()
+	defer fake.taskArrayStatusMutex.Unlock()
+	fake.TaskArrayStatusStub = nil
+	if fake.taskArrayStatusReturnsOnCall == nil {
+		fake.taskArrayStatusReturnsOnCall = make(map[int]struct {
+			result1 *models.TaskArrayStatus
+			result2 error
+		})
+	}
+	fake.taskArrayStatusReturnsOnCall[i] = struct {
+		result1 *models.TaskArrayStatus
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTaskController) TaskByGuid(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.Task, error) {
 	fake.taskByGuidMutex.Lock()
 	ret, specificReturn := fake.taskByGuidReturnsOnCall[len(fake.taskByGuidArgsForCall)]
@@ -824,7 +1079,73 @@ func (fake *FakeTaskController) TaskByGuidReturnsOnCall(i int, result1 *models.T
 	}{result1, result2}
 }
 
-func (fake *FakeTaskController) Tasks(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 string) ([]*models.Task, error) {
+func (fake *FakeTaskController) TaskResult(arg1 context.Context, arg2 lager.Logger, arg3 string) (string, error) {
+	fake.taskResultMutex.Lock()
+	ret, specificReturn := fake.taskResultReturnsOnCall[len(fake.taskResultArgsForCall)]
+	fake.taskResultArgsForCall = append(fake.taskResultArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.TaskResultStub
+	fakeReturns := fake.taskResultReturns
+	fake.recordInvocation("TaskResult", []interface{}{arg1, arg2, arg3})
+	fake.taskResultMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTaskController) TaskResultCallCount() int {
+	fake.taskResultMutex.RLock()
+	defer fake.taskResultMutex.RUnlock()
+	return len(fake.taskResultArgsForCall)
+}
+
+func (fake *FakeTaskController) TaskResultCalls(stub func(context.Context, lager.Logger, string) (string, error)) {
+	fake.taskResultMutex.Lock()
+	defer fake.taskResultMutex.Unlock()
+	fake.TaskResultStub = stub
+}
+
+func (fake *FakeTaskController) TaskResultArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.taskResultMutex.RLock()
+	defer fake.taskResultMutex.RUnlock()
+	argsForCall := fake.taskResultArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTaskController) TaskResultReturns(result1 string, result2 error) {
+	fake.taskResultMutex.Lock()
+	defer fake.taskResultMutex.Unlock()
+	fake.TaskResultStub = nil
+	fake.taskResultReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskController) TaskResultReturnsOnCall(i int, result1 string, result2 error) {
+	fake.taskResultMutex.Lock()
+	defer fake.taskResultMutex.Unlock()
+	fake.TaskResultStub = nil
+	if fake.taskResultReturnsOnCall == nil {
+		fake.taskResultReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.taskResultReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskController) Tasks(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 string, arg5 bool) ([]*models.Task, error) {
 	fake.tasksMutex.Lock()
 	ret, specificReturn := fake.tasksReturnsOnCall[len(fake.tasksArgsForCall)]
 	fake.tasksArgsForCall = append(fake.tasksArgsForCall, struct {
@@ -832,13 +1153,14 @@ func (fake *FakeTaskController) Tasks(arg1 context.Context, arg2 lager.Logger, a
 		arg2 lager.Logger
 		arg3 string
 		arg4 string
-	}{arg1, arg2, arg3, arg4})
+		arg5 bool
+	}{arg1, arg2, arg3, arg4, arg5})
 	stub := fake.TasksStub
 	fakeReturns := fake.tasksReturns
-	fake.recordInvocation("Tasks", []interface{}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("Tasks", []interface{}{arg1, arg2, arg3, arg4, arg5})
 	fake.tasksMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4)
+		return stub(arg1, arg2, arg3, arg4, arg5)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -852,17 +1174,17 @@ func (fake *FakeTaskController) TasksCallCount() int {
 	return len(fake.tasksArgsForCall)
 }
 
-func (fake *FakeTaskController) TasksCalls(stub func(context.Context, lager.Logger, string, string) ([]*models.Task, error)) {
+func (fake *FakeTaskController) TasksCalls(stub func(context.Context, lager.Logger, string, string, bool) ([]*models.Task, error)) {
 	fake.tasksMutex.Lock()
 	defer fake.tasksMutex.Unlock()
 	fake.TasksStub = stub
 }
 
-func (fake *FakeTaskController) TasksArgsForCall(i int) (context.Context, lager.Logger, string, string) {
+func (fake *FakeTaskController) TasksArgsForCall(i int) (context.Context, lager.Logger, string, string, bool) {
 	fake.tasksMutex.RLock()
 	defer fake.tasksMutex.RUnlock()
 	argsForCall := fake.tasksArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
 }
 
 func (fake *FakeTaskController) TasksReturns(result1 []*models.Task, result2 error) {
@@ -896,6 +1218,8 @@ func (fake *FakeTaskController) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.cancelTaskMutex.RLock()
 	defer fake.cancelTaskMutex.RUnlock()
+	fake.cancelTaskArrayMutex.RLock()
+	defer fake.cancelTaskArrayMutex.RUnlock()
 	fake.completeTaskMutex.RLock()
 	defer fake.completeTaskMutex.RUnlock()
 	fake.convergeTasksMutex.RLock()
@@ -904,6 +1228,8 @@ func (fake *FakeTaskController) Invocations() map[string][][]interface{} {
 	defer fake.deleteTaskMutex.RUnlock()
 	fake.desireTaskMutex.RLock()
 	defer fake.desireTaskMutex.RUnlock()
+	fake.desireTaskArrayMutex.RLock()
+	defer fake.desireTaskArrayMutex.RUnlock()
 	fake.failTaskMutex.RLock()
 	defer fake.failTaskMutex.RUnlock()
 	fake.rejectTaskMutex.RLock()
@@ -912,8 +1238,12 @@ func (fake *FakeTaskController) Invocations() map[string][][]interface{} {
 	defer fake.resolvingTaskMutex.RUnlock()
 	fake.startTaskMutex.RLock()
 	defer fake.startTaskMutex.RUnlock()
+	fake.taskArrayStatusMutex.RLock()
+	defer fake.taskArrayStatusMutex.RUnlock()
 	fake.taskByGuidMutex.RLock()
 	defer fake.taskByGuidMutex.RUnlock()
+	fake.taskResultMutex.RLock()
+	defer fake.taskResultMutex.RUnlock()
 	fake.tasksMutex.RLock()
 	defer fake.tasksMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
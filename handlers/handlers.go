@@ -4,6 +4,7 @@ import (
 	"io"
 	"net/http"
 	"strconv"
+	"time"
 
 	"code.cloudfoundry.org/auctioneer"
 	"code.cloudfoundry.org/bbs"
@@ -15,6 +16,7 @@ import (
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/serviceclient"
 	"code.cloudfoundry.org/bbs/taskworkpool"
+	"code.cloudfoundry.org/clock"
 	loggingclient "code.cloudfoundry.org/diego-logging-client"
 	"code.cloudfoundry.org/lager/v3"
 	"code.cloudfoundry.org/rep"
@@ -28,6 +30,7 @@ func New(
 	updateWorkers int,
 	convergenceWorkersSize int,
 	maxTaskPlacementRetries int,
+	rollingRestartTimeout time.Duration,
 	emitter middleware.Emitter,
 	db db.DB,
 	desiredHub, actualHub, actualLRPInstanceHub, taskHub events.Hub,
@@ -36,12 +39,14 @@ func New(
 	auctioneerClient auctioneer.Client,
 	repClientFactory rep.ClientFactory,
 	taskStatMetronNotifier metrics.TaskStatMetronNotifier,
+	clock clock.Clock,
 	migrationsDone <-chan struct{},
 	exitChan chan struct{},
 	metronClient loggingclient.IngressClient,
 ) http.Handler {
 	pingHandler := NewPingHandler()
-	domainHandler := NewDomainHandler(db, exitChan)
+	domainHandler := NewDomainHandler(db, desiredHub, exitChan)
+	domainQuotaHandler := NewDomainQuotaHandler(db, exitChan)
 	actualLRPHandler := NewActualLRPHandler(db, exitChan)
 	actualLRPController := controllers.NewActualLRPLifecycleController(
 		db, db, db, db,
@@ -50,9 +55,10 @@ func New(
 		repClientFactory,
 		actualHub,
 		actualLRPInstanceHub,
+		rollingRestartTimeout,
 	)
 	evacuationController := controllers.NewEvacuationController(
-		db, db, db, db,
+		db, db, db, db, db,
 		auctioneerClient,
 		actualHub,
 		actualLRPInstanceHub,
@@ -60,23 +66,37 @@ func New(
 	actualLRPLifecycleHandler := NewActualLRPLifecycleHandler(actualLRPController, exitChan)
 	evacuationHandler := NewEvacuationHandler(evacuationController, exitChan)
 	desiredLRPHandler := NewDesiredLRPHandler(updateWorkers, db, db, desiredHub, actualHub, actualLRPInstanceHub, auctioneerClient, repClientFactory, serviceClient, exitChan, metronClient)
-	taskController := controllers.NewTaskController(db, taskCompletionClient, auctioneerClient, serviceClient, repClientFactory, taskHub, taskStatMetronNotifier, maxTaskPlacementRetries)
+	taskController := controllers.NewTaskController(db, taskCompletionClient, auctioneerClient, serviceClient, repClientFactory, taskHub, taskStatMetronNotifier, maxTaskPlacementRetries, controllers.TaskControllerConfig{ConvergenceWorkers: convergenceWorkersSize})
 	taskHandler := NewTaskHandler(taskController, exitChan)
+	scheduledTaskController := controllers.NewScheduledTaskController(db, taskController, taskHub, clock)
+	scheduledTaskHandler := NewScheduledTaskHandler(scheduledTaskController, exitChan)
 	lrpGroupEventsHandler := NewLRPGroupEventsHandler(desiredHub, actualHub)
 	taskEventsHandler := NewTaskEventHandler(taskHub)
 	lrpInstanceEventsHandler := NewLRPInstanceEventHandler(desiredHub, actualLRPInstanceHub)
-	cellsHandler := NewCellHandler(serviceClient, exitChan)
+	cellsHandler := NewCellHandler(serviceClient, db, evacuationController, exitChan)
+	capacityHandler := NewCapacityHandler(serviceClient, db, exitChan)
+	taskArchiveHandler := NewTaskArchiveHandler(db, exitChan)
 
 	actions := rata.Handlers{
 		// Ping
 		bbs.PingRoute_r0: middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, pingHandler.Ping), emitter),
 
 		// Domains
-		bbs.DomainsRoute_r0:      route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, domainHandler.Domains), emitter)),
+		bbs.DomainsRoute_r1:      route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, domainHandler.Domains), emitter)),
 		bbs.UpsertDomainRoute_r0: route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, domainHandler.Upsert), emitter)),
+		bbs.ExpireDomainRoute_r0: route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, domainHandler.ExpireDomain), emitter)),
+		bbs.DeleteDomainRoute_r0: route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, domainHandler.DeleteDomain), emitter)),
+		bbs.DomainsRoute_r0:      route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, domainHandler.Domains_r0), emitter)), // DEPRECATED
+
+		// Domain Quotas
+		bbs.UpsertDomainQuotaRoute_r0: route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, domainQuotaHandler.UpsertDomainQuota), emitter)),
+		bbs.DeleteDomainQuotaRoute_r0: route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, domainQuotaHandler.DeleteDomainQuota), emitter)),
+		bbs.DomainQuotasRoute_r0:      route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, domainQuotaHandler.DomainQuotas), emitter)),
+		bbs.DomainUsageRoute_r0:       route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, domainQuotaHandler.DomainUsage), emitter)),
 
 		// Actual LRPs
-		bbs.ActualLRPsRoute_r0: route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, actualLRPHandler.ActualLRPs), emitter)),
+		bbs.ActualLRPsRoute_r0:       route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, actualLRPHandler.ActualLRPs), emitter)),
+		bbs.ActualLRPCrashesRoute_r0: route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, actualLRPHandler.ActualLRPCrashes), emitter)),
 		//lint:ignore SA1019 - implementing deprecated logic until it is removed
 		bbs.ActualLRPGroupsRoute_r0: route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, actualLRPHandler.ActualLRPGroups), emitter)),
 		//lint:ignore SA1019 - implementing deprecated logic until it is removed
@@ -87,12 +107,13 @@ func New(
 		// Actual LRP Lifecycle
 		bbs.ClaimActualLRPRoute_r0: route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, actualLRPLifecycleHandler.ClaimActualLRP), emitter)),
 		//lint:ignore SA1019 - implementing deprecated logic until it is removed
-		bbs.StartActualLRPRoute_r0:  route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, actualLRPLifecycleHandler.StartActualLRP_r0), emitter)), // DEPRECATED
-		bbs.StartActualLRPRoute_r1:  route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, actualLRPLifecycleHandler.StartActualLRP), emitter)),
-		bbs.CrashActualLRPRoute_r0:  route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, actualLRPLifecycleHandler.CrashActualLRP), emitter)),
-		bbs.RetireActualLRPRoute_r0: route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, actualLRPLifecycleHandler.RetireActualLRP), emitter)),
-		bbs.FailActualLRPRoute_r0:   route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, actualLRPLifecycleHandler.FailActualLRP), emitter)),
-		bbs.RemoveActualLRPRoute_r0: route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, actualLRPLifecycleHandler.RemoveActualLRP), emitter)),
+		bbs.StartActualLRPRoute_r0:   route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, actualLRPLifecycleHandler.StartActualLRP_r0), emitter)), // DEPRECATED
+		bbs.StartActualLRPRoute_r1:   route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, actualLRPLifecycleHandler.StartActualLRP), emitter)),
+		bbs.CrashActualLRPRoute_r0:   route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, actualLRPLifecycleHandler.CrashActualLRP), emitter)),
+		bbs.RetireActualLRPRoute_r0:  route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, actualLRPLifecycleHandler.RetireActualLRP), emitter)),
+		bbs.FailActualLRPRoute_r0:    route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, actualLRPLifecycleHandler.FailActualLRP), emitter)),
+		bbs.RemoveActualLRPRoute_r0:  route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, actualLRPLifecycleHandler.RemoveActualLRP), emitter)),
+		bbs.RestartActualLRPRoute_r0: route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, actualLRPLifecycleHandler.RestartActualLRP), emitter)),
 
 		// Evacuation
 		bbs.RemoveEvacuatingActualLRPRoute_r0: route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, evacuationHandler.RemoveEvacuatingActualLRP), emitter)),
@@ -104,8 +125,12 @@ func New(
 		bbs.EvacuateRunningActualLRPRoute_r1: route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, evacuationHandler.EvacuateRunningActualLRP), emitter)),
 
 		// Desired LRPs
-		bbs.DesiredLRPsRoute_r3:             route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.DesiredLRPs), emitter)),
-		bbs.DesiredLRPByProcessGuidRoute_r3: route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.DesiredLRPByProcessGuid), emitter)),
+		bbs.DesiredLRPsRoute_r4:             route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.DesiredLRPs), emitter)),
+		bbs.DesiredLRPByProcessGuidRoute_r4: route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.DesiredLRPByProcessGuid), emitter)),
+		//lint:ignore SA1019 - implementing deprecated logic until it is removed
+		bbs.DesiredLRPsRoute_r3: route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.DesiredLRPs_r3), emitter)), // DEPRECATED
+		//lint:ignore SA1019 - implementing deprecated logic until it is removed
+		bbs.DesiredLRPByProcessGuidRoute_r3: route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.DesiredLRPByProcessGuid_r3), emitter)), // DEPRECATED
 		//lint:ignore SA1019 - implementing deprecated logic until it is removed
 		bbs.DesiredLRPsRoute_r2: route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.DesiredLRPs_r2), emitter)), // DEPRECATED
 		//lint:ignore SA1019 - implementing deprecated logic until it is removed
@@ -113,17 +138,25 @@ func New(
 		bbs.DesiredLRPSchedulingInfosRoute_r0:        route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.DesiredLRPSchedulingInfos), emitter)),
 		bbs.DesiredLRPSchedulingInfoByProcessGuid_r0: route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.DesiredLRPSchedulingInfoByProcessGuid), emitter)),
 		bbs.DesiredLRPRoutingInfosRoute_r0:           route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.DesiredLRPRoutingInfos), emitter)),
+		bbs.DesiredLRPStatusRoute_r0:                 route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.DesiredLRPStatus), emitter)),
 		bbs.DesireDesiredLRPRoute_r2:                 route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.DesireDesiredLRP), emitter)),
 		bbs.UpdateDesiredLRPRoute_r0:                 route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.UpdateDesiredLRP), emitter)),
 		bbs.RemoveDesiredLRPRoute_r0:                 route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.RemoveDesiredLRP), emitter)),
+		bbs.RestartDesiredLRPRoute_r0:                route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, actualLRPLifecycleHandler.RestartDesiredLRP), emitter)),
+		bbs.DesiredLRPRevisionsRoute_r0:              route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.DesiredLRPRevisions), emitter)),
+		bbs.DesiredLRPRevisionDiffRoute_r0:           route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.DesiredLRPRevisionDiff), emitter)),
+		bbs.RollbackDesiredLRPRoute_r0:               route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.RollbackDesiredLRP), emitter)),
 
 		// Tasks
 		//lint:ignore SA1019 - implementing deprecated logic until it is removed
 		bbs.TasksRoute_r2: route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, taskHandler.Tasks_r2), emitter)), // DEPRECATED
 		//lint:ignore SA1019 - implementing deprecated logic until it is removed
 		bbs.TaskByGuidRoute_r2: route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, taskHandler.TaskByGuid_r2), emitter)), // DEPRECATED
-		bbs.TasksRoute_r3:      route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, taskHandler.Tasks), emitter)),
+		//lint:ignore SA1019 - implementing deprecated logic until it is removed
+		bbs.TasksRoute_r3:      route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, taskHandler.Tasks_r3), emitter)), // DEPRECATED
+		bbs.TasksRoute_r4:      route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, taskHandler.Tasks), emitter)),
 		bbs.TaskByGuidRoute_r3: route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, taskHandler.TaskByGuid), emitter)),
+		bbs.TaskResultRoute_r0: route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, taskHandler.TaskResult), emitter)),
 		bbs.DesireTaskRoute_r2: route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, taskHandler.DesireTask), emitter)),
 		bbs.StartTaskRoute_r0:  route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, taskHandler.StartTask), emitter)),
 		bbs.CancelTaskRoute_r0: route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, taskHandler.CancelTask), emitter)),
@@ -134,6 +167,22 @@ func New(
 		bbs.ResolvingTaskRoute_r0: route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, taskHandler.ResolvingTask), emitter)),
 		bbs.DeleteTaskRoute_r0:    route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, taskHandler.DeleteTask), emitter)),
 
+		// Task Arrays
+		bbs.DesireTaskArrayRoute_r0: route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, taskHandler.DesireTaskArray), emitter)),
+		bbs.TaskArrayStatusRoute_r0: route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, taskHandler.TaskArrayStatus), emitter)),
+		bbs.CancelTaskArrayRoute_r0: route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, taskHandler.CancelTaskArray), emitter)),
+
+		// Task Archive
+		bbs.ArchivedTasksRoute_r0:      route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, taskArchiveHandler.ArchivedTasks), emitter)),
+		bbs.ArchivedTaskByGuidRoute_r0: route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, taskArchiveHandler.ArchivedTaskByGuid), emitter)),
+
+		// Scheduled Tasks
+		bbs.ScheduledTasksRoute_r0:      route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, scheduledTaskHandler.ScheduledTasks), emitter)),
+		bbs.ScheduledTaskByGuidRoute_r0: route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, scheduledTaskHandler.ScheduledTaskByGuid), emitter)),
+		bbs.DesireScheduledTaskRoute_r0: route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, scheduledTaskHandler.DesireScheduledTask), emitter)),
+		bbs.UpdateScheduledTaskRoute_r0: route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, scheduledTaskHandler.UpdateScheduledTask), emitter)),
+		bbs.DeleteScheduledTaskRoute_r0: route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, scheduledTaskHandler.DeleteScheduledTask), emitter)),
+
 		// Events
 		//lint:ignore SA1019 - implementing deprecated logic until it is removed
 		bbs.EventStreamRoute_r0: route(middleware.LogWrap(logger, accessLogger, lrpGroupEventsHandler.Subscribe_r0)), // DEPRECATED
@@ -143,10 +192,17 @@ func New(
 		bbs.LrpInstanceEventStreamRoute_r0: route(middleware.LogWrap(logger, accessLogger, lrpInstanceEventsHandler.Subscribe_r0)), // DEPRECATED
 		bbs.LRPGroupEventStreamRoute_r1:    route(middleware.LogWrap(logger, accessLogger, lrpGroupEventsHandler.Subscribe_r1)),
 		bbs.TaskEventStreamRoute_r1:        route(middleware.LogWrap(logger, accessLogger, taskEventsHandler.Subscribe_r1)),
-		bbs.LRPInstanceEventStreamRoute_r1: route(middleware.LogWrap(logger, accessLogger, lrpInstanceEventsHandler.Subscribe_r1)),
+		bbs.LRPInstanceEventStreamRoute_r2: route(middleware.LogWrap(logger, accessLogger, lrpInstanceEventsHandler.Subscribe_r2)),
+		//lint:ignore SA1019 - implementing deprecated logic until it is removed
+		bbs.LRPInstanceEventStreamRoute_r1: route(middleware.LogWrap(logger, accessLogger, lrpInstanceEventsHandler.Subscribe_r1)), // DEPRECATED
 
 		// Cells
-		bbs.CellsRoute_r0: route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, cellsHandler.Cells), emitter)),
+		bbs.CellsRoute_r0:           route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, cellsHandler.Cells), emitter)),
+		bbs.CordonCellRoute_r0:      route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, cellsHandler.CordonCell), emitter)),
+		bbs.UncordonCellRoute_r0:    route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, cellsHandler.UncordonCell), emitter)),
+		bbs.DrainCellRoute_r0:       route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, cellsHandler.DrainCell), emitter)),
+		bbs.CellDrainStatusRoute_r0: route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, cellsHandler.CellDrainStatus), emitter)),
+		bbs.CapacityReportRoute_r0:  route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, capacityHandler.CapacityReport), emitter)),
 	}
 
 	handler, err := rata.NewRouter(bbs.Routes, actions)
@@ -0,0 +1,139 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+//counterfeiter:generate -o fake_controllers/fake_scheduled_task_controller.go . ScheduledTaskController
+
+type ScheduledTaskController interface {
+	ScheduledTasks(ctx context.Context, logger lager.Logger, domain string) ([]*models.ScheduledTask, error)
+	ScheduledTaskByGuid(ctx context.Context, logger lager.Logger, guid string) (*models.ScheduledTask, error)
+	DesireScheduledTask(ctx context.Context, logger lager.Logger, scheduledTask *models.ScheduledTask) error
+	UpdateScheduledTask(ctx context.Context, logger lager.Logger, scheduledTask *models.ScheduledTask) error
+	DeleteScheduledTask(ctx context.Context, logger lager.Logger, guid string) error
+}
+
+type ScheduledTaskHandler struct {
+	controller ScheduledTaskController
+	exitChan   chan<- struct{}
+}
+
+func NewScheduledTaskHandler(
+	controller ScheduledTaskController,
+	exitChan chan<- struct{},
+) *ScheduledTaskHandler {
+	return &ScheduledTaskHandler{
+		controller: controller,
+		exitChan:   exitChan,
+	}
+}
+
+func (h *ScheduledTaskHandler) ScheduledTasks(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("scheduled-tasks").WithTraceInfo(req)
+
+	request := &models.ScheduledTasksRequest{}
+	response := &models.ScheduledTasksResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, response) }()
+
+	err = parseRequest(logger, req, request)
+	if err != nil {
+		logger.Error("failed-parsing-request", err)
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	response.ScheduledTasks, err = h.controller.ScheduledTasks(req.Context(), logger, request.Domain)
+	response.Error = models.ConvertError(err)
+}
+
+func (h *ScheduledTaskHandler) ScheduledTaskByGuid(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("scheduled-task-by-guid").WithTraceInfo(req)
+
+	request := &models.ScheduledTaskByGuidRequest{}
+	response := &models.ScheduledTaskResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, response) }()
+
+	err = parseRequest(logger, req, request)
+	if err != nil {
+		logger.Error("failed-parsing-request", err)
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	response.ScheduledTask, err = h.controller.ScheduledTaskByGuid(req.Context(), logger, request.Guid)
+	response.Error = models.ConvertError(err)
+}
+
+func (h *ScheduledTaskHandler) DesireScheduledTask(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("desire-scheduled-task").WithTraceInfo(req)
+
+	request := &models.DesireScheduledTaskRequest{}
+	response := &models.ScheduledTaskLifecycleResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, response) }()
+
+	err = parseRequest(logger, req, request)
+	if err != nil {
+		logger.Error("failed-parsing-request", err)
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	err = h.controller.DesireScheduledTask(req.Context(), logger, request.ScheduledTask)
+	response.Error = models.ConvertError(err)
+}
+
+func (h *ScheduledTaskHandler) UpdateScheduledTask(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("update-scheduled-task").WithTraceInfo(req)
+
+	request := &models.UpdateScheduledTaskRequest{}
+	response := &models.ScheduledTaskLifecycleResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, response) }()
+
+	err = parseRequest(logger, req, request)
+	if err != nil {
+		logger.Error("failed-parsing-request", err)
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	err = h.controller.UpdateScheduledTask(req.Context(), logger, request.ScheduledTask)
+	response.Error = models.ConvertError(err)
+}
+
+func (h *ScheduledTaskHandler) DeleteScheduledTask(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("delete-scheduled-task").WithTraceInfo(req)
+
+	request := &models.DeleteScheduledTaskRequest{}
+	response := &models.ScheduledTaskLifecycleResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, response) }()
+
+	err = parseRequest(logger, req, request)
+	if err != nil {
+		logger.Error("failed-parsing-request", err)
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	err = h.controller.DeleteScheduledTask(req.Context(), logger, request.Guid)
+	response.Error = models.ConvertError(err)
+}
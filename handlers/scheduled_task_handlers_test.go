@@ -0,0 +1,247 @@
+package handlers_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/handlers/fake_controllers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("ScheduledTask Handlers", func() {
+	var (
+		logger           *lagertest.TestLogger
+		controller       *fake_controllers.FakeScheduledTaskController
+		responseRecorder *httptest.ResponseRecorder
+		handler          *handlers.ScheduledTaskHandler
+		exitCh           chan struct{}
+		requestBody      interface{}
+		scheduledTask    *models.ScheduledTask
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		controller = &fake_controllers.FakeScheduledTaskController{}
+		responseRecorder = httptest.NewRecorder()
+		exitCh = make(chan struct{}, 1)
+		handler = handlers.NewScheduledTaskHandler(controller, exitCh)
+
+		scheduledTask = &models.ScheduledTask{
+			Guid:           "scheduled-guid",
+			Domain:         "some-domain",
+			Schedule:       "@hourly",
+			TaskDefinition: model_helpers.NewValidTaskDefinition(),
+		}
+	})
+
+	Describe("ScheduledTasks", func() {
+		BeforeEach(func() {
+			requestBody = &models.ScheduledTasksRequest{Domain: "some-domain"}
+		})
+
+		JustBeforeEach(func() {
+			handler.ScheduledTasks(logger, responseRecorder, newTestRequest(requestBody))
+		})
+
+		Context("when the controller succeeds", func() {
+			BeforeEach(func() {
+				controller.ScheduledTasksReturns([]*models.ScheduledTask{scheduledTask}, nil)
+			})
+
+			It("returns the scheduled tasks for the domain", func() {
+				Expect(controller.ScheduledTasksCallCount()).To(Equal(1))
+				_, _, domain := controller.ScheduledTasksArgsForCall(0)
+				Expect(domain).To(Equal("some-domain"))
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				response := models.ScheduledTasksResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(BeNil())
+				Expect(response.ScheduledTasks).To(Equal([]*models.ScheduledTask{scheduledTask}))
+			})
+		})
+
+		Context("when the controller fails", func() {
+			BeforeEach(func() {
+				controller.ScheduledTasksReturns(nil, models.ErrUnknownError)
+			})
+
+			It("provides relevant error information", func() {
+				response := models.ScheduledTasksResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(Equal(models.ErrUnknownError))
+			})
+		})
+
+		Context("when the controller returns an unrecoverable error", func() {
+			BeforeEach(func() {
+				controller.ScheduledTasksReturns(nil, models.NewUnrecoverableError(nil))
+			})
+
+			It("logs and writes to the exit channel", func() {
+				Eventually(logger).Should(gbytes.Say("unrecoverable-error"))
+				Eventually(exitCh).Should(Receive())
+			})
+		})
+	})
+
+	Describe("ScheduledTaskByGuid", func() {
+		BeforeEach(func() {
+			requestBody = &models.ScheduledTaskByGuidRequest{Guid: "scheduled-guid"}
+		})
+
+		JustBeforeEach(func() {
+			handler.ScheduledTaskByGuid(logger, responseRecorder, newTestRequest(requestBody))
+		})
+
+		Context("when the controller succeeds", func() {
+			BeforeEach(func() {
+				controller.ScheduledTaskByGuidReturns(scheduledTask, nil)
+			})
+
+			It("returns the scheduled task", func() {
+				_, _, guid := controller.ScheduledTaskByGuidArgsForCall(0)
+				Expect(guid).To(Equal("scheduled-guid"))
+
+				response := models.ScheduledTaskResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(BeNil())
+				Expect(response.ScheduledTask).To(Equal(scheduledTask))
+			})
+		})
+
+		Context("when the scheduled task is not found", func() {
+			BeforeEach(func() {
+				controller.ScheduledTaskByGuidReturns(nil, models.ErrResourceNotFound)
+			})
+
+			It("returns a resource not found error", func() {
+				response := models.ScheduledTaskResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(Equal(models.ErrResourceNotFound))
+			})
+		})
+	})
+
+	Describe("DesireScheduledTask", func() {
+		BeforeEach(func() {
+			requestBody = &models.DesireScheduledTaskRequest{ScheduledTask: scheduledTask}
+		})
+
+		JustBeforeEach(func() {
+			handler.DesireScheduledTask(logger, responseRecorder, newTestRequest(requestBody))
+		})
+
+		It("desires the scheduled task", func() {
+			Expect(controller.DesireScheduledTaskCallCount()).To(Equal(1))
+			_, _, actual := controller.DesireScheduledTaskArgsForCall(0)
+			Expect(actual).To(Equal(scheduledTask))
+
+			response := models.ScheduledTaskLifecycleResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Error).To(BeNil())
+		})
+
+		Context("when the request is invalid", func() {
+			BeforeEach(func() {
+				scheduledTask.Schedule = "not a schedule"
+			})
+
+			It("responds with an invalid request error", func() {
+				Expect(controller.DesireScheduledTaskCallCount()).To(Equal(0))
+
+				response := models.ScheduledTaskLifecycleResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+			})
+		})
+
+		Context("when the controller fails", func() {
+			BeforeEach(func() {
+				controller.DesireScheduledTaskReturns(models.ErrResourceExists)
+			})
+
+			It("provides relevant error information", func() {
+				response := models.ScheduledTaskLifecycleResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error).To(Equal(models.ErrResourceExists))
+			})
+		})
+	})
+
+	Describe("UpdateScheduledTask", func() {
+		BeforeEach(func() {
+			requestBody = &models.UpdateScheduledTaskRequest{ScheduledTask: scheduledTask}
+		})
+
+		JustBeforeEach(func() {
+			handler.UpdateScheduledTask(logger, responseRecorder, newTestRequest(requestBody))
+		})
+
+		It("updates the scheduled task", func() {
+			Expect(controller.UpdateScheduledTaskCallCount()).To(Equal(1))
+			_, _, actual := controller.UpdateScheduledTaskArgsForCall(0)
+			Expect(actual).To(Equal(scheduledTask))
+		})
+
+		Context("when the controller fails", func() {
+			BeforeEach(func() {
+				controller.UpdateScheduledTaskReturns(errors.New("boom"))
+			})
+
+			It("provides relevant error information", func() {
+				response := models.ScheduledTaskLifecycleResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error.Type).To(Equal(models.Error_UnknownError))
+			})
+		})
+	})
+
+	Describe("DeleteScheduledTask", func() {
+		BeforeEach(func() {
+			requestBody = &models.DeleteScheduledTaskRequest{Guid: "scheduled-guid"}
+		})
+
+		JustBeforeEach(func() {
+			handler.DeleteScheduledTask(logger, responseRecorder, newTestRequest(requestBody))
+		})
+
+		It("deletes the scheduled task", func() {
+			Expect(controller.DeleteScheduledTaskCallCount()).To(Equal(1))
+			_, _, guid := controller.DeleteScheduledTaskArgsForCall(0)
+			Expect(guid).To(Equal("scheduled-guid"))
+		})
+
+		Context("when the controller fails", func() {
+			BeforeEach(func() {
+				controller.DeleteScheduledTaskReturns(models.ErrResourceNotFound)
+			})
+
+			It("provides relevant error information", func() {
+				response := models.ScheduledTaskLifecycleResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error).To(Equal(models.ErrResourceNotFound))
+			})
+		})
+	})
+})
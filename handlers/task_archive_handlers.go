@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+type TaskArchiveHandler struct {
+	db       db.TaskArchiveDB
+	exitChan chan<- struct{}
+}
+
+func NewTaskArchiveHandler(db db.TaskArchiveDB, exitChan chan<- struct{}) *TaskArchiveHandler {
+	return &TaskArchiveHandler{
+		db:       db,
+		exitChan: exitChan,
+	}
+}
+
+func (h *TaskArchiveHandler) ArchivedTasks(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("archived-tasks").WithTraceInfo(req)
+
+	request := &models.ArchivedTasksRequest{}
+	response := &models.ArchivedTasksResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, response) }()
+
+	err = parseRequest(logger, req, request)
+	if err != nil {
+		logger.Error("failed-parsing-request", err)
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	filter := models.TaskArchiveFilter{
+		Domain:          request.Domain,
+		CellID:          request.CellId,
+		FailedOnly:      request.FailedOnly,
+		CompletedAfter:  request.CompletedAfter,
+		CompletedBefore: request.CompletedBefore,
+		PageSize:        int(request.PageSize),
+		PageToken:       request.PageToken,
+	}
+	response.ArchivedTasks, response.NextPageToken, err = h.db.ArchivedTasks(req.Context(), logger, filter)
+	response.Error = models.ConvertError(err)
+}
+
+func (h *TaskArchiveHandler) ArchivedTaskByGuid(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("archived-task-by-guid").WithTraceInfo(req)
+
+	request := &models.ArchivedTaskByGuidRequest{}
+	response := &models.ArchivedTaskResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, response) }()
+
+	err = parseRequest(logger, req, request)
+	if err != nil {
+		logger.Error("failed-parsing-request", err)
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	response.ArchivedTask, err = h.db.ArchivedTaskByGuid(req.Context(), logger, request.TaskGuid)
+	response.Error = models.ConvertError(err)
+}
@@ -0,0 +1,161 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"code.cloudfoundry.org/bbs/db/dbfakes"
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("TaskArchive Handlers", func() {
+	var (
+		logger            *lagertest.TestLogger
+		fakeTaskArchiveDB *dbfakes.FakeTaskArchiveDB
+		responseRecorder  *httptest.ResponseRecorder
+		handler           *handlers.TaskArchiveHandler
+		exitCh            chan struct{}
+		requestBody       interface{}
+		archivedTask      *models.ArchivedTask
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		fakeTaskArchiveDB = new(dbfakes.FakeTaskArchiveDB)
+		responseRecorder = httptest.NewRecorder()
+		exitCh = make(chan struct{}, 1)
+		handler = handlers.NewTaskArchiveHandler(fakeTaskArchiveDB, exitCh)
+
+		archivedTask = &models.ArchivedTask{
+			TaskGuid:      "some-guid",
+			Domain:        "some-domain",
+			State:         models.Task_Resolving,
+			CellId:        "some-cell",
+			Failed:        true,
+			FailureReason: "boom",
+			CreatedAt:     1,
+			StartedAt:     2,
+			CompletedAt:   3,
+			ArchivedAt:    4,
+		}
+	})
+
+	Describe("ArchivedTasks", func() {
+		BeforeEach(func() {
+			requestBody = &models.ArchivedTasksRequest{
+				Domain:          "some-domain",
+				CellId:          "some-cell",
+				FailedOnly:      true,
+				CompletedAfter:  1,
+				CompletedBefore: 5,
+				PageSize:        10,
+			}
+		})
+
+		JustBeforeEach(func() {
+			handler.ArchivedTasks(logger, responseRecorder, newTestRequest(requestBody))
+		})
+
+		Context("when reading archived tasks from the DB succeeds", func() {
+			BeforeEach(func() {
+				fakeTaskArchiveDB.ArchivedTasksReturns([]*models.ArchivedTask{archivedTask}, "next-page", nil)
+			})
+
+			It("returns the page of archived tasks matching the filter", func() {
+				Expect(fakeTaskArchiveDB.ArchivedTasksCallCount()).To(Equal(1))
+				_, _, filter := fakeTaskArchiveDB.ArchivedTasksArgsForCall(0)
+				Expect(filter).To(Equal(models.TaskArchiveFilter{
+					Domain:          "some-domain",
+					CellID:          "some-cell",
+					FailedOnly:      true,
+					CompletedAfter:  1,
+					CompletedBefore: 5,
+					PageSize:        10,
+				}))
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				response := models.ArchivedTasksResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(BeNil())
+				Expect(response.ArchivedTasks).To(Equal([]*models.ArchivedTask{archivedTask}))
+				Expect(response.NextPageToken).To(Equal("next-page"))
+			})
+		})
+
+		Context("when the request is invalid", func() {
+			BeforeEach(func() {
+				requestBody = &models.ArchivedTasksRequest{PageSize: -1}
+			})
+
+			It("responds with a bad request error", func() {
+				Expect(fakeTaskArchiveDB.ArchivedTasksCallCount()).To(Equal(0))
+
+				response := models.ArchivedTasksResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+			})
+		})
+
+		Context("when the DB returns an unrecoverable error", func() {
+			BeforeEach(func() {
+				fakeTaskArchiveDB.ArchivedTasksReturns(nil, "", models.NewUnrecoverableError(nil))
+			})
+
+			It("logs and writes to the exit channel", func() {
+				Eventually(logger).Should(gbytes.Say("unrecoverable-error"))
+				Eventually(exitCh).Should(Receive())
+			})
+		})
+	})
+
+	Describe("ArchivedTaskByGuid", func() {
+		BeforeEach(func() {
+			requestBody = &models.ArchivedTaskByGuidRequest{TaskGuid: "some-guid"}
+		})
+
+		JustBeforeEach(func() {
+			handler.ArchivedTaskByGuid(logger, responseRecorder, newTestRequest(requestBody))
+		})
+
+		Context("when reading the archived task from the DB succeeds", func() {
+			BeforeEach(func() {
+				fakeTaskArchiveDB.ArchivedTaskByGuidReturns(archivedTask, nil)
+			})
+
+			It("returns the archived task", func() {
+				_, _, taskGuid := fakeTaskArchiveDB.ArchivedTaskByGuidArgsForCall(0)
+				Expect(taskGuid).To(Equal("some-guid"))
+
+				response := models.ArchivedTaskResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(BeNil())
+				Expect(response.ArchivedTask).To(Equal(archivedTask))
+			})
+		})
+
+		Context("when the task has not been archived", func() {
+			BeforeEach(func() {
+				fakeTaskArchiveDB.ArchivedTaskByGuidReturns(nil, models.ErrResourceNotFound)
+			})
+
+			It("responds with a resource not found error", func() {
+				response := models.ArchivedTaskResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(Equal(models.ErrResourceNotFound))
+			})
+		})
+	})
+})
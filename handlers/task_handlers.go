@@ -14,9 +14,13 @@ import (
 //counterfeiter:generate -o fake_controllers/fake_task_controller.go . TaskController
 
 type TaskController interface {
-	Tasks(ctx context.Context, logger lager.Logger, domain, cellId string) ([]*models.Task, error)
+	Tasks(ctx context.Context, logger lager.Logger, domain, cellId string, includeResults bool) ([]*models.Task, error)
 	TaskByGuid(ctx context.Context, logger lager.Logger, taskGuid string) (*models.Task, error)
+	TaskResult(ctx context.Context, logger lager.Logger, taskGuid string) (string, error)
 	DesireTask(ctx context.Context, logger lager.Logger, taskDefinition *models.TaskDefinition, taskGuid, domain string) error
+	DesireTaskArray(ctx context.Context, logger lager.Logger, taskDefinition *models.TaskDefinition, arrayGuid, domain string, count int32) error
+	TaskArrayStatus(ctx context.Context, logger lager.Logger, arrayGuid string) (*models.TaskArrayStatus, error)
+	CancelTaskArray(ctx context.Context, logger lager.Logger, arrayGuid string) error
 	StartTask(ctx context.Context, logger lager.Logger, taskGuid, cellId string) (shouldStart bool, err error)
 	CancelTask(ctx context.Context, logger lager.Logger, taskGuid string) error
 	FailTask(ctx context.Context, logger lager.Logger, taskGuid, failureReason string) error
@@ -42,7 +46,7 @@ func NewTaskHandler(
 	}
 }
 
-func (h *TaskHandler) commonTasks(logger lager.Logger, targetVersion format.Version, w http.ResponseWriter, req *http.Request) {
+func (h *TaskHandler) commonTasks(logger lager.Logger, targetVersion format.Version, includeResults bool, w http.ResponseWriter, req *http.Request) {
 	var err error
 	logger = logger.Session("tasks").WithTraceInfo(req)
 
@@ -59,7 +63,8 @@ func (h *TaskHandler) commonTasks(logger lager.Logger, targetVersion format.Vers
 		return
 	}
 
-	tasks, err := h.controller.Tasks(req.Context(), logger, request.Domain, request.CellId)
+	includeResults = includeResults || request.IncludeResults
+	tasks, err := h.controller.Tasks(req.Context(), logger, request.Domain, request.CellId, includeResults)
 
 	downgradedTasks := []*models.Task{}
 	for _, t := range tasks {
@@ -69,12 +74,18 @@ func (h *TaskHandler) commonTasks(logger lager.Logger, targetVersion format.Vers
 	response.Error = models.ConvertError(err)
 }
 
+// the deprecated routes predate results being left out of task lists, so they
+// always include them
 func (h *TaskHandler) Tasks_r2(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
-	h.commonTasks(logger, format.V2, w, req)
+	h.commonTasks(logger, format.V2, true, w, req)
+}
+
+func (h *TaskHandler) Tasks_r3(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	h.commonTasks(logger, format.V3, true, w, req)
 }
 
 func (h *TaskHandler) Tasks(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
-	h.commonTasks(logger, format.V3, w, req)
+	h.commonTasks(logger, format.V3, false, w, req)
 }
 
 func (h *TaskHandler) commonTaskByGuid(logger lager.Logger, targetVersion format.Version, w http.ResponseWriter, req *http.Request) {
@@ -112,6 +123,27 @@ func (h *TaskHandler) TaskByGuid(logger lager.Logger, w http.ResponseWriter, req
 	h.commonTaskByGuid(logger, format.V3, w, req)
 }
 
+func (h *TaskHandler) TaskResult(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("task-result").WithTraceInfo(req)
+
+	request := &models.TaskResultRequest{}
+	response := &models.TaskResultResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, response) }()
+
+	err = parseRequest(logger, req, request)
+	if err != nil {
+		logger.Error("failed-parsing-request", err)
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	response.Result, err = h.controller.TaskResult(req.Context(), logger, request.TaskGuid)
+	response.Error = models.ConvertError(err)
+}
+
 func (h *TaskHandler) DesireTask(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
 	var err error
 	logger = logger.Session("desire-task").WithTraceInfo(req)
@@ -133,6 +165,68 @@ func (h *TaskHandler) DesireTask(logger lager.Logger, w http.ResponseWriter, req
 	response.Error = models.ConvertError(err)
 }
 
+func (h *TaskHandler) DesireTaskArray(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("desire-task-array").WithTraceInfo(req)
+
+	request := &models.DesireTaskArrayRequest{}
+	response := &models.TaskLifecycleResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, response) }()
+
+	err = parseRequest(logger, req, request)
+	if err != nil {
+		logger.Error("failed-parsing-request", err)
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	err = h.controller.DesireTaskArray(trace.ContextWithRequestId(req), logger, request.TaskDefinition, request.ArrayGuid, request.Domain, request.Count)
+	response.Error = models.ConvertError(err)
+}
+
+func (h *TaskHandler) TaskArrayStatus(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("task-array-status").WithTraceInfo(req)
+
+	request := &models.TaskArrayGuidRequest{}
+	response := &models.TaskArrayStatusResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, response) }()
+
+	err = parseRequest(logger, req, request)
+	if err != nil {
+		logger.Error("failed-parsing-request", err)
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	response.Status, err = h.controller.TaskArrayStatus(req.Context(), logger, request.ArrayGuid)
+	response.Error = models.ConvertError(err)
+}
+
+func (h *TaskHandler) CancelTaskArray(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("cancel-task-array").WithTraceInfo(req)
+
+	request := &models.TaskArrayGuidRequest{}
+	response := &models.TaskLifecycleResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, response) }()
+
+	err := parseRequest(logger, req, request)
+	if err != nil {
+		logger.Error("failed-parsing-request", err)
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	err = h.controller.CancelTaskArray(trace.ContextWithRequestId(req), logger, request.ArrayGuid)
+	response.Error = models.ConvertError(err)
+}
+
 func (h *TaskHandler) StartTask(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
 	var err error
 	logger = logger.Session("start-task").WithTraceInfo(req)
@@ -234,7 +328,7 @@ func (h *TaskHandler) CompleteTask(logger lager.Logger, w http.ResponseWriter, r
 		return
 	}
 
-	err = h.controller.CompleteTask(req.Context(), logger, request.TaskGuid, request.CellId, request.Failed, request.FailureReason, request.Result)
+	err = h.controller.CompleteTask(trace.ContextWithRequestId(req), logger, request.TaskGuid, request.CellId, request.Failed, request.FailureReason, request.Result)
 	response.Error = models.ConvertError(err)
 }
 
@@ -99,11 +99,17 @@ var _ = Describe("Task Handlers", func() {
 
 			It("calls the controller with no filter", func() {
 				Expect(controller.TasksCallCount()).To(Equal(1))
-				_, _, actualDomain, actualCellId := controller.TasksArgsForCall(0)
+				_, _, actualDomain, actualCellId, _ := controller.TasksArgsForCall(0)
 				Expect(actualDomain).To(Equal(domain))
 				Expect(actualCellId).To(Equal(cellId))
 			})
 
+			It("includes the task results", func() {
+				Expect(controller.TasksCallCount()).To(Equal(1))
+				_, _, _, _, includeResults := controller.TasksArgsForCall(0)
+				Expect(includeResults).To(BeTrue())
+			})
+
 			Context("when the tasks include image layers", func() {
 				var downgradedTasks []*models.Task
 
@@ -138,7 +144,7 @@ var _ = Describe("Task Handlers", func() {
 
 				It("calls the controller with a domain filter", func() {
 					Expect(controller.TasksCallCount()).To(Equal(1))
-					_, _, actualDomain, actualCellId := controller.TasksArgsForCall(0)
+					_, _, actualDomain, actualCellId, _ := controller.TasksArgsForCall(0)
 					Expect(actualDomain).To(Equal(domain))
 					Expect(actualCellId).To(Equal(cellId))
 				})
@@ -151,7 +157,7 @@ var _ = Describe("Task Handlers", func() {
 
 				It("calls the controller with a cell filter", func() {
 					Expect(controller.TasksCallCount()).To(Equal(1))
-					_, _, actualDomain, actualCellId := controller.TasksArgsForCall(0)
+					_, _, actualDomain, actualCellId, _ := controller.TasksArgsForCall(0)
 					Expect(actualDomain).To(Equal(domain))
 					Expect(actualCellId).To(Equal(cellId))
 				})
@@ -186,24 +192,64 @@ var _ = Describe("Task Handlers", func() {
 		})
 	})
 
+	Describe("Tasks_r3", func() {
+		var task models.Task
+
+		BeforeEach(func() {
+			task = models.Task{
+				Domain:         "domain-1",
+				Result:         "some-result",
+				TaskDefinition: &models.TaskDefinition{},
+			}
+			controller.TasksReturns([]*models.Task{&task}, nil)
+		})
+
+		JustBeforeEach(func() {
+			requestBody = &models.TasksRequest{Domain: "domain-1"}
+			request = newTestRequest(requestBody)
+			request.Header.Set(lager.RequestIdHeader, requestIdHeader)
+			handler.Tasks_r3(logger, responseRecorder, request)
+		})
+
+		It("returns the tasks with their results", func() {
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			response := models.TasksResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(response.Error).To(BeNil())
+			Expect(response.Tasks).To(DeepEqual([]*models.Task{&task}))
+		})
+
+		It("includes the task results", func() {
+			Expect(controller.TasksCallCount()).To(Equal(1))
+			_, _, actualDomain, _, includeResults := controller.TasksArgsForCall(0)
+			Expect(actualDomain).To(Equal("domain-1"))
+			Expect(includeResults).To(BeTrue())
+		})
+	})
+
 	Describe("Tasks", func() {
 		var (
 			task1          models.Task
 			task2          models.Task
 			cellId, domain string
+			includeResults bool
 		)
 
 		BeforeEach(func() {
 			task1 = models.Task{TaskDefinition: &models.TaskDefinition{ImageLayers: []*models.ImageLayer{{LayerType: models.LayerTypeExclusive}, {LayerType: models.LayerTypeShared}}}}
 			task2 = models.Task{TaskDefinition: &models.TaskDefinition{ImageLayers: []*models.ImageLayer{{LayerType: models.LayerTypeExclusive}, {LayerType: models.LayerTypeShared}}}}
 
+			includeResults = false
 			requestBody = &models.TasksRequest{}
 		})
 
 		JustBeforeEach(func() {
 			requestBody = &models.TasksRequest{
-				Domain: domain,
-				CellId: cellId,
+				Domain:         domain,
+				CellId:         cellId,
+				IncludeResults: includeResults,
 			}
 			request = newTestRequest(requestBody)
 			request.Header.Set(lager.RequestIdHeader, requestIdHeader)
@@ -232,11 +278,29 @@ var _ = Describe("Task Handlers", func() {
 
 			It("calls the controller with no filter", func() {
 				Expect(controller.TasksCallCount()).To(Equal(1))
-				_, _, actualDomain, actualCellId := controller.TasksArgsForCall(0)
+				_, _, actualDomain, actualCellId, _ := controller.TasksArgsForCall(0)
 				Expect(actualDomain).To(Equal(domain))
 				Expect(actualCellId).To(Equal(cellId))
 			})
 
+			It("excludes the task results", func() {
+				Expect(controller.TasksCallCount()).To(Equal(1))
+				_, _, _, _, actualIncludeResults := controller.TasksArgsForCall(0)
+				Expect(actualIncludeResults).To(BeFalse())
+			})
+
+			Context("and including results", func() {
+				BeforeEach(func() {
+					includeResults = true
+				})
+
+				It("calls the controller to include the task results", func() {
+					Expect(controller.TasksCallCount()).To(Equal(1))
+					_, _, _, _, actualIncludeResults := controller.TasksArgsForCall(0)
+					Expect(actualIncludeResults).To(BeTrue())
+				})
+			})
+
 			Context("and filtering by domain", func() {
 				BeforeEach(func() {
 					domain = "domain-1"
@@ -244,7 +308,7 @@ var _ = Describe("Task Handlers", func() {
 
 				It("calls the controller with a domain filter", func() {
 					Expect(controller.TasksCallCount()).To(Equal(1))
-					_, _, actualDomain, actualCellId := controller.TasksArgsForCall(0)
+					_, _, actualDomain, actualCellId, _ := controller.TasksArgsForCall(0)
 					Expect(actualDomain).To(Equal(domain))
 					Expect(actualCellId).To(Equal(cellId))
 				})
@@ -257,7 +321,7 @@ var _ = Describe("Task Handlers", func() {
 
 				It("calls the controller with a cell filter", func() {
 					Expect(controller.TasksCallCount()).To(Equal(1))
-					_, _, actualDomain, actualCellId := controller.TasksArgsForCall(0)
+					_, _, actualDomain, actualCellId, _ := controller.TasksArgsForCall(0)
 					Expect(actualDomain).To(Equal(domain))
 					Expect(actualCellId).To(Equal(cellId))
 				})
@@ -499,6 +563,88 @@ var _ = Describe("Task Handlers", func() {
 		})
 	})
 
+	Describe("TaskResult", func() {
+		var taskGuid = "task-guid"
+
+		BeforeEach(func() {
+			requestBody = &models.TaskResultRequest{
+				TaskGuid: taskGuid,
+			}
+		})
+
+		JustBeforeEach(func() {
+			request := newTestRequest(requestBody)
+			request.Header.Set(lager.RequestIdHeader, requestIdHeader)
+			handler.TaskResult(logger, responseRecorder, request)
+		})
+
+		Context("when reading the result from the controller succeeds", func() {
+			BeforeEach(func() {
+				controller.TaskResultReturns("some-result", nil)
+			})
+
+			It("fetches the result by task guid", func() {
+				Expect(controller.TaskResultCallCount()).To(Equal(1))
+				_, _, actualGuid := controller.TaskResultArgsForCall(0)
+				Expect(actualGuid).To(Equal(taskGuid))
+			})
+
+			It("returns the result", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				response := models.TaskResultResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(BeNil())
+				Expect(response.Result).To(Equal("some-result"))
+			})
+		})
+
+		Context("when the request is invalid", func() {
+			BeforeEach(func() {
+				requestBody = &models.TaskResultRequest{}
+			})
+
+			It("returns a bad request error", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				response := models.TaskResultResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).NotTo(BeNil())
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+				Expect(controller.TaskResultCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the controller returns an unrecoverable error", func() {
+			BeforeEach(func() {
+				controller.TaskResultReturns("", models.NewUnrecoverableError(nil))
+			})
+
+			It("logs and writes to the exit channel", func() {
+				Eventually(logger).Should(gbytes.Say("unrecoverable-error"))
+				Eventually(logger).Should(gbytes.Say(b3RequestIdHeader))
+				Eventually(exitCh).Should(Receive())
+			})
+		})
+
+		Context("when the controller errors out", func() {
+			BeforeEach(func() {
+				controller.TaskResultReturns("", models.ErrResourceNotFound)
+			})
+
+			It("provides relevant error information", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				response := models.TaskResultResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(Equal(models.ErrResourceNotFound))
+			})
+		})
+	})
+
 	Describe("DesireTask", func() {
 		var (
 			taskGuid = "task-guid"
@@ -566,6 +712,161 @@ var _ = Describe("Task Handlers", func() {
 		})
 	})
 
+	Describe("DesireTaskArray", func() {
+		var taskDef *models.TaskDefinition
+
+		BeforeEach(func() {
+			taskDef = model_helpers.NewValidTaskDefinition()
+			requestBody = &models.DesireTaskArrayRequest{
+				ArrayGuid:      "array-guid",
+				Domain:         "domain",
+				Count:          3,
+				TaskDefinition: taskDef,
+			}
+		})
+
+		JustBeforeEach(func() {
+			request := newTestRequest(requestBody)
+			request.Header.Set(lager.RequestIdHeader, requestIdHeader)
+			handler.DesireTaskArray(logger, responseRecorder, request)
+		})
+
+		Context("when the desire is successful", func() {
+			It("desires the task array with the requested definition", func() {
+				Expect(controller.DesireTaskArrayCallCount()).To(Equal(1))
+				_, _, actualTaskDef, actualArrayGuid, actualDomain, actualCount := controller.DesireTaskArrayArgsForCall(0)
+				Expect(actualTaskDef).To(Equal(taskDef))
+				Expect(actualArrayGuid).To(Equal("array-guid"))
+				Expect(actualDomain).To(Equal("domain"))
+				Expect(actualCount).To(BeEquivalentTo(3))
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				response := &models.TaskLifecycleResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(BeNil())
+			})
+		})
+
+		Context("when the count is invalid", func() {
+			BeforeEach(func() {
+				requestBody.(*models.DesireTaskArrayRequest).Count = 0
+			})
+
+			It("responds with an error and does not desire the task array", func() {
+				Expect(controller.DesireTaskArrayCallCount()).To(Equal(0))
+
+				response := &models.TaskLifecycleResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+			})
+		})
+
+		Context("when desiring the task array fails", func() {
+			BeforeEach(func() {
+				controller.DesireTaskArrayReturns(models.ErrResourceExists)
+			})
+
+			It("responds with an error", func() {
+				response := &models.TaskLifecycleResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(Equal(models.ErrResourceExists))
+			})
+		})
+	})
+
+	Describe("TaskArrayStatus", func() {
+		BeforeEach(func() {
+			requestBody = &models.TaskArrayGuidRequest{ArrayGuid: "array-guid"}
+		})
+
+		JustBeforeEach(func() {
+			handler.TaskArrayStatus(logger, responseRecorder, newTestRequest(requestBody))
+		})
+
+		Context("when the controller succeeds", func() {
+			var status *models.TaskArrayStatus
+
+			BeforeEach(func() {
+				status = &models.TaskArrayStatus{ArrayGuid: "array-guid", Total: 3, Running: 2, Failed: 1}
+				controller.TaskArrayStatusReturns(status, nil)
+			})
+
+			It("returns the status of the task array", func() {
+				Expect(controller.TaskArrayStatusCallCount()).To(Equal(1))
+				_, _, arrayGuid := controller.TaskArrayStatusArgsForCall(0)
+				Expect(arrayGuid).To(Equal("array-guid"))
+
+				response := &models.TaskArrayStatusResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(BeNil())
+				Expect(response.Status).To(Equal(status))
+			})
+		})
+
+		Context("when the task array does not exist", func() {
+			BeforeEach(func() {
+				controller.TaskArrayStatusReturns(nil, models.ErrResourceNotFound)
+			})
+
+			It("responds with a resource not found error", func() {
+				response := &models.TaskArrayStatusResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(Equal(models.ErrResourceNotFound))
+			})
+		})
+	})
+
+	Describe("CancelTaskArray", func() {
+		var request *http.Request
+
+		BeforeEach(func() {
+			requestBody = &models.TaskArrayGuidRequest{ArrayGuid: "array-guid"}
+			request = newTestRequest(requestBody)
+		})
+
+		JustBeforeEach(func() {
+			request.Header.Set(lager.RequestIdHeader, requestIdHeader)
+			handler.CancelTaskArray(logger, responseRecorder, request)
+		})
+
+		It("cancels the task array", func() {
+			Expect(controller.CancelTaskArrayCallCount()).To(Equal(1))
+			taskContext, _, arrayGuid := controller.CancelTaskArrayArgsForCall(0)
+			Expect(taskContext).To(Equal(context.WithValue(request.Context(), trace.RequestIdHeaderCtxKey, requestIdHeader)))
+			Expect(arrayGuid).To(Equal("array-guid"))
+
+			response := &models.TaskLifecycleResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(response.Error).To(BeNil())
+		})
+
+		Context("when cancelling the task array fails", func() {
+			BeforeEach(func() {
+				controller.CancelTaskArrayReturns(models.ErrUnknownError)
+			})
+
+			It("responds with an error", func() {
+				response := &models.TaskLifecycleResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(Equal(models.ErrUnknownError))
+			})
+		})
+	})
+
 	Describe("StartTask", func() {
 		Context("when the start is successful", func() {
 			var ctx context.Context
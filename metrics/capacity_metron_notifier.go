@@ -0,0 +1,117 @@
+package metrics
+
+import (
+	"context"
+	"os"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/serviceclient"
+	"code.cloudfoundry.org/clock"
+	logging "code.cloudfoundry.org/diego-logging-client"
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/tedsuo/ifrit"
+)
+
+const (
+	zoneMetricPrefix         = "Zone."
+	placementTagMetricPrefix = "PlacementTag."
+
+	CapacityCellsMetric              = "CapacityCells"
+	CapacityTotalMemoryMetric        = "CapacityTotalMemory"
+	CapacityTotalDiskMetric          = "CapacityTotalDisk"
+	CapacityTotalContainersMetric    = "CapacityTotalContainers"
+	CapacityReservedMemoryMetric     = "CapacityReservedMemory"
+	CapacityReservedDiskMetric       = "CapacityReservedDisk"
+	CapacityReservedContainersMetric = "CapacityReservedContainers"
+)
+
+type capacityMetronNotifier struct {
+	logger        lager.Logger
+	clock         clock.Clock
+	serviceClient serviceclient.ServiceClient
+	db            db.CapacityDB
+	metronClient  logging.IngressClient
+}
+
+// NewCapacityMetronNotifier periodically emits the capacity of the cells and
+// the resources reserved on them, in total and for each zone and placement
+// tag. The metrics of a zone or placement tag are prefixed with
+// "Zone.<zone>." or "PlacementTag.<tag>." respectively.
+func NewCapacityMetronNotifier(logger lager.Logger, clock clock.Clock, serviceClient serviceclient.ServiceClient, db db.CapacityDB, metronClient logging.IngressClient) ifrit.Runner {
+	return &capacityMetronNotifier{
+		logger:        logger,
+		clock:         clock,
+		serviceClient: serviceClient,
+		db:            db,
+		metronClient:  metronClient,
+	}
+}
+
+func (notifier *capacityMetronNotifier) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	logger := notifier.logger.Session("capacity-metron-notifier")
+	logger.Info("starting", lager.Data{"interval": DefaultEmitFrequency})
+	defer logger.Info("completed")
+
+	ticker := notifier.clock.NewTicker(DefaultEmitFrequency)
+	close(ready)
+
+	for {
+		select {
+		case <-signals:
+			return nil
+		case <-ticker.C():
+			notifier.emitMetrics(logger)
+		}
+	}
+}
+
+func (notifier *capacityMetronNotifier) emitMetrics(logger lager.Logger) {
+	logger.Debug("emitting-metrics")
+
+	cellSet, err := notifier.serviceClient.Cells(logger)
+	if err != nil {
+		logger.Error("failed-fetching-cells", err)
+		return
+	}
+
+	reservations, err := notifier.db.CellReservations(context.Background(), logger)
+	if err != nil {
+		logger.Error("failed-fetching-cell-reservations", err)
+		return
+	}
+
+	report := models.NewCapacityReport(cellSet, reservations)
+
+	notifier.sendUsage(logger, "", report.Total)
+	for _, zone := range report.Zones {
+		notifier.sendUsage(logger, zoneMetricPrefix+zone.Zone+".", zone.Usage)
+	}
+	for _, tag := range report.PlacementTags {
+		notifier.sendUsage(logger, placementTagMetricPrefix+tag.PlacementTag+".", tag.Usage)
+	}
+
+	logger.Debug("done-emitting-metrics")
+}
+
+func (notifier *capacityMetronNotifier) sendUsage(logger lager.Logger, prefix string, usage *models.CapacityUsage) {
+	metrics := []struct {
+		name  string
+		value int
+	}{
+		{CapacityCellsMetric, int(usage.Cells)},
+		{CapacityTotalMemoryMetric, int(usage.TotalMemoryMb)},
+		{CapacityTotalDiskMetric, int(usage.TotalDiskMb)},
+		{CapacityTotalContainersMetric, int(usage.TotalContainers)},
+		{CapacityReservedMemoryMetric, int(usage.ReservedMemoryMb)},
+		{CapacityReservedDiskMetric, int(usage.ReservedDiskMb)},
+		{CapacityReservedContainersMetric, int(usage.ReservedContainers)},
+	}
+
+	for _, metric := range metrics {
+		err := notifier.metronClient.SendMetric(prefix+metric.name, metric.value)
+		if err != nil {
+			logger.Error("failed-sending-metric", err, lager.Data{"metric": prefix + metric.name})
+		}
+	}
+}
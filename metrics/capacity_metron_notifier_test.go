@@ -0,0 +1,125 @@
+package metrics_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/bbs/db/dbfakes"
+	"code.cloudfoundry.org/bbs/metrics"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/serviceclient/serviceclientfakes"
+	"code.cloudfoundry.org/clock/fakeclock"
+	mfakes "code.cloudfoundry.org/diego-logging-client/testhelpers"
+	loggregator "code.cloudfoundry.org/go-loggregator/v9"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/tedsuo/ifrit"
+	ginkgomon "github.com/tedsuo/ifrit/ginkgomon_v2"
+)
+
+var _ = Describe("capacityMetronNotifier", func() {
+	var (
+		logger            *lagertest.TestLogger
+		fakeClock         *fakeclock.FakeClock
+		fakeServiceClient *serviceclientfakes.FakeServiceClient
+		fakeCapacityDB    *dbfakes.FakeCapacityDB
+		fakeMetronClient  *mfakes.FakeIngressClient
+
+		metricsChan chan FakeGauge
+
+		process ifrit.Process
+	)
+
+	BeforeEach(func() {
+		metricsChan = make(chan FakeGauge, 100)
+
+		logger = lagertest.NewTestLogger("metrics")
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+
+		fakeMetronClient = new(mfakes.FakeIngressClient)
+		fakeMetronClient.SendMetricStub = func(name string, value int, opts ...loggregator.EmitGaugeOption) error {
+			defer GinkgoRecover()
+			Eventually(metricsChan).Should(BeSent(FakeGauge{name, value}))
+			return nil
+		}
+
+		cell1 := models.NewCellPresence("cell-1", "1.1.1.1", "", "z1", models.NewCellCapacity(1024, 2048, 10), nil, nil, []string{"gpu"}, nil)
+		cell2 := models.NewCellPresence("cell-2", "2.2.2.2", "", "z2", models.NewCellCapacity(4096, 8192, 40), nil, nil, nil, nil)
+		fakeServiceClient = new(serviceclientfakes.FakeServiceClient)
+		fakeServiceClient.CellsReturns(models.NewCellSetFromList([]*models.CellPresence{&cell1, &cell2}), nil)
+
+		fakeCapacityDB = new(dbfakes.FakeCapacityDB)
+		fakeCapacityDB.CellReservationsReturns(map[string]*models.CellReservation{
+			"cell-1": {CellId: "cell-1", MemoryMb: 512, DiskMb: 256, Containers: 2},
+		}, nil)
+	})
+
+	JustBeforeEach(func() {
+		runner := metrics.NewCapacityMetronNotifier(logger, fakeClock, fakeServiceClient, fakeCapacityDB, fakeMetronClient)
+		process = ifrit.Background(runner)
+		Eventually(process.Ready()).Should(BeClosed())
+
+		fakeClock.Increment(metrics.DefaultEmitFrequency)
+	})
+
+	AfterEach(func() {
+		ginkgomon.Interrupt(process)
+	})
+
+	receivedMetrics := func() []FakeGauge {
+		gauges := []FakeGauge{}
+		Eventually(func() int {
+			for {
+				select {
+				case gauge := <-metricsChan:
+					gauges = append(gauges, gauge)
+				default:
+					return len(gauges)
+				}
+			}
+		}).Should(Equal(28))
+		return gauges
+	}
+
+	It("emits the total capacity and reservations", func() {
+		Expect(receivedMetrics()).To(ContainElements(
+			FakeGauge{"CapacityCells", 2},
+			FakeGauge{"CapacityTotalMemory", 5120},
+			FakeGauge{"CapacityTotalDisk", 10240},
+			FakeGauge{"CapacityTotalContainers", 50},
+			FakeGauge{"CapacityReservedMemory", 512},
+			FakeGauge{"CapacityReservedDisk", 256},
+			FakeGauge{"CapacityReservedContainers", 2},
+		))
+	})
+
+	It("emits the capacity and reservations of each zone", func() {
+		Expect(receivedMetrics()).To(ContainElements(
+			FakeGauge{"Zone.z1.CapacityCells", 1},
+			FakeGauge{"Zone.z1.CapacityTotalMemory", 1024},
+			FakeGauge{"Zone.z1.CapacityReservedMemory", 512},
+			FakeGauge{"Zone.z2.CapacityCells", 1},
+			FakeGauge{"Zone.z2.CapacityTotalMemory", 4096},
+			FakeGauge{"Zone.z2.CapacityReservedMemory", 0},
+		))
+	})
+
+	It("emits the capacity and reservations of each placement tag", func() {
+		Expect(receivedMetrics()).To(ContainElements(
+			FakeGauge{"PlacementTag.gpu.CapacityCells", 1},
+			FakeGauge{"PlacementTag.gpu.CapacityTotalContainers", 10},
+			FakeGauge{"PlacementTag.gpu.CapacityReservedContainers", 2},
+		))
+	})
+
+	Context("when fetching the cell reservations fails", func() {
+		BeforeEach(func() {
+			fakeCapacityDB.CellReservationsReturns(nil, models.ErrUnknownError)
+		})
+
+		It("does not emit metrics", func() {
+			Eventually(fakeCapacityDB.CellReservationsCallCount).Should(Equal(1))
+			Consistently(metricsChan).ShouldNot(Receive())
+		})
+	})
+})
@@ -144,6 +144,23 @@ func (actual ActualLRP) ShouldRestartCrash(now time.Time, calc RestartCalculator
 	return calc.ShouldRestart(now.UnixNano(), actual.Since, actual.CrashCount)
 }
 
+// ScheduleRestart records when a crashed instance will be restarted, or that
+// it has exhausted its restarts. It clears both for other states.
+func (actual *ActualLRP) ScheduleRestart(calc RestartCalculator) {
+	actual.NextRestartAt = 0
+	actual.RestartsExhausted = false
+	if actual.State != ActualLRPStateCrashed {
+		return
+	}
+
+	nextRestartAt, ok := calc.NextRestartTime(actual.Since, actual.CrashCount)
+	if ok {
+		actual.NextRestartAt = nextRestartAt
+	} else {
+		actual.RestartsExhausted = true
+	}
+}
+
 func (actual *ActualLRP) SetRoutable(routable bool) {
 	actual.OptionalRoutable = &ActualLRP_Routable{
 		Routable: routable,
@@ -283,6 +300,8 @@ func (actualLRPInfo *ActualLRPInfo) ToActualLRP(lrpKey ActualLRPKey, lrpInstance
 		Since:                actualLRPInfo.Since,
 		ModificationTag:      actualLRPInfo.ModificationTag,
 		Presence:             actualLRPInfo.Presence,
+		NextRestartAt:        actualLRPInfo.NextRestartAt,
+		RestartsExhausted:    actualLRPInfo.RestartsExhausted,
 	}
 
 	if actualLRPInfo.RoutableExists() {
@@ -297,15 +316,17 @@ func (actual *ActualLRP) ToActualLRPInfo() *ActualLRPInfo {
 		return nil
 	}
 	info := ActualLRPInfo{
-		ActualLRPNetInfo: actual.ActualLRPNetInfo,
-		AvailabilityZone: actual.AvailabilityZone,
-		CrashCount:       actual.CrashCount,
-		CrashReason:      actual.CrashReason,
-		State:            actual.State,
-		PlacementError:   actual.PlacementError,
-		Since:            actual.Since,
-		ModificationTag:  actual.ModificationTag,
-		Presence:         actual.Presence,
+		ActualLRPNetInfo:  actual.ActualLRPNetInfo,
+		AvailabilityZone:  actual.AvailabilityZone,
+		CrashCount:        actual.CrashCount,
+		CrashReason:       actual.CrashReason,
+		State:             actual.State,
+		PlacementError:    actual.PlacementError,
+		Since:             actual.Since,
+		ModificationTag:   actual.ModificationTag,
+		Presence:          actual.Presence,
+		NextRestartAt:     actual.NextRestartAt,
+		RestartsExhausted: actual.RestartsExhausted,
 	}
 
 	if actual.RoutableExists() {
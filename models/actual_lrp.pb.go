@@ -429,8 +429,11 @@ type ActualLRP struct {
 	MetricTags              map[string]string         `protobuf:"bytes,12,rep,name=metric_tags,json=metricTags,proto3" json:"metric_tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// Types that are valid to be assigned to OptionalRoutable:
 	//	*ActualLRP_Routable
-	OptionalRoutable isActualLRP_OptionalRoutable `protobuf_oneof:"optional_routable"`
-	AvailabilityZone string                       `protobuf:"bytes,14,opt,name=availability_zone,json=availabilityZone,proto3" json:"availability_zone"`
+	OptionalRoutable  isActualLRP_OptionalRoutable `protobuf_oneof:"optional_routable"`
+	AvailabilityZone  string                       `protobuf:"bytes,14,opt,name=availability_zone,json=availabilityZone,proto3" json:"availability_zone"`
+	Revision          int32                        `protobuf:"varint,15,opt,name=revision,proto3" json:"revision"`
+	NextRestartAt     int64                        `protobuf:"varint,16,opt,name=next_restart_at,json=nextRestartAt,proto3" json:"next_restart_at"`
+	RestartsExhausted bool                         `protobuf:"varint,17,opt,name=restarts_exhausted,json=restartsExhausted,proto3" json:"restarts_exhausted"`
 }
 
 func (m *ActualLRP) Reset()      { *m = ActualLRP{} }
@@ -562,6 +565,27 @@ func (m *ActualLRP) GetAvailabilityZone() string {
 	return ""
 }
 
+func (m *ActualLRP) GetRevision() int32 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *ActualLRP) GetNextRestartAt() int64 {
+	if m != nil {
+		return m.NextRestartAt
+	}
+	return 0
+}
+
+func (m *ActualLRP) GetRestartsExhausted() bool {
+	if m != nil {
+		return m.RestartsExhausted
+	}
+	return false
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ActualLRP) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -585,82 +609,86 @@ func init() {
 func init() { proto.RegisterFile("actual_lrp.proto", fileDescriptor_25e5e77bfca46c1a) }
 
 var fileDescriptor_25e5e77bfca46c1a = []byte{
-	// 1187 bytes of a gzipped FileDescriptorProto
+	// 1254 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x56, 0x4d, 0x6f, 0xdb, 0x46,
-	0x13, 0x16, 0xe5, 0xd8, 0x96, 0x46, 0xb2, 0x4c, 0xaf, 0x9d, 0x98, 0xd0, 0x1b, 0x90, 0x8a, 0xf0,
-	0x16, 0x75, 0x02, 0xc4, 0x69, 0x9d, 0xa0, 0x68, 0x03, 0xf4, 0x60, 0x3a, 0x6e, 0x6c, 0x24, 0x91,
-	0x8d, 0xb5, 0xdd, 0xa2, 0x1f, 0x80, 0xba, 0xa6, 0xd6, 0x0a, 0x11, 0x8a, 0x4b, 0x2c, 0x57, 0x6e,
-	0xd4, 0x53, 0x8f, 0x85, 0xd1, 0x43, 0x81, 0x5e, 0x7a, 0xf1, 0xbd, 0xbf, 0xa1, 0xbf, 0x20, 0x47,
-	0x1f, 0x73, 0x22, 0x1a, 0xe5, 0x52, 0xf0, 0x94, 0x9f, 0x50, 0xec, 0xf2, 0x23, 0xb4, 0x14, 0x9f,
-	0x76, 0xf7, 0xd9, 0x99, 0x67, 0x86, 0x33, 0xcf, 0x8e, 0x04, 0x3a, 0x71, 0xc4, 0x90, 0x78, 0x5d,
-	0x8f, 0x07, 0xeb, 0x01, 0x67, 0x82, 0xa1, 0xb9, 0x01, 0xeb, 0x51, 0x2f, 0x6c, 0xde, 0xed, 0xbb,
-	0xe2, 0xf9, 0xf0, 0x78, 0xdd, 0x61, 0x83, 0x7b, 0x7d, 0xd6, 0x67, 0xf7, 0xd4, 0xf5, 0xf1, 0xf0,
-	0x44, 0x9d, 0xd4, 0x41, 0xed, 0x12, 0xb7, 0xe6, 0x8d, 0x01, 0xeb, 0xb9, 0x27, 0xae, 0x43, 0x84,
-	0xcb, 0xfc, 0xae, 0x20, 0xfd, 0x04, 0x6f, 0x9f, 0x42, 0x63, 0x53, 0x85, 0x78, 0x8a, 0xf7, 0x1f,
-	0x73, 0x36, 0x0c, 0xd0, 0x5d, 0xa8, 0xb8, 0x7e, 0x28, 0x88, 0xef, 0x50, 0x43, 0x6b, 0x69, 0x6b,
-	0xb5, 0x8d, 0xa5, 0xf5, 0x24, 0xe6, 0x7a, 0x6e, 0x89, 0x73, 0x13, 0xf4, 0x29, 0x00, 0x3d, 0x25,
-	0xce, 0x90, 0x08, 0xd7, 0xef, 0x1b, 0xe5, 0xab, 0x1c, 0x0a, 0x46, 0x0f, 0xcb, 0x86, 0xd6, 0xfe,
-	0xa3, 0x0c, 0xb5, 0x7d, 0xc6, 0xc5, 0x33, 0x12, 0x04, 0xae, 0xdf, 0x47, 0x5f, 0x40, 0xc3, 0x61,
-	0xbe, 0x20, 0xae, 0x4f, 0x79, 0x37, 0x60, 0x5c, 0xa8, 0xd8, 0x0b, 0x36, 0x8a, 0x23, 0x6b, 0xe2,
-	0x06, 0x2f, 0xe4, 0x67, 0xc9, 0x80, 0xee, 0x40, 0xf5, 0x39, 0x0b, 0x45, 0xe2, 0x55, 0x56, 0x5e,
-	0x0b, 0x71, 0x64, 0xbd, 0x07, 0x71, 0x45, 0x6e, 0x95, 0xed, 0x11, 0x18, 0xef, 0xc9, 0x84, 0x17,
-	0x76, 0x03, 0xce, 0x5e, 0x8e, 0x12, 0xd7, 0x19, 0xe5, 0x7a, 0x33, 0x8e, 0xac, 0x2b, 0x6d, 0xf0,
-	0xf5, 0xfc, 0xe6, 0xd0, 0x0b, 0xf7, 0x25, 0xae, 0x68, 0xbf, 0x82, 0x65, 0x15, 0x6d, 0x82, 0xf1,
-	0x9a, 0x62, 0x5c, 0x8d, 0x23, 0xeb, 0x43, 0xd7, 0x58, 0x97, 0x60, 0x91, 0xa7, 0xfd, 0xab, 0x06,
-	0xf5, 0xbc, 0x66, 0x4f, 0xe8, 0x08, 0xdd, 0x87, 0x7a, 0xc0, 0x99, 0x43, 0xc3, 0xb0, 0xdb, 0x1f,
-	0xba, 0x3d, 0x55, 0x94, 0xaa, 0xad, 0xc7, 0x91, 0x75, 0x09, 0xc7, 0xb5, 0xf4, 0xf4, 0x78, 0xe8,
-	0xf6, 0x90, 0x05, 0xb3, 0xae, 0xdf, 0xa3, 0x2f, 0x55, 0x31, 0x66, 0xed, 0x6a, 0x1c, 0x59, 0x09,
-	0x80, 0x93, 0x05, 0xb5, 0x61, 0xae, 0xc7, 0x06, 0xc4, 0xf5, 0xd5, 0x37, 0x57, 0x6d, 0x88, 0x23,
-	0x2b, 0x45, 0x70, 0xba, 0xb6, 0x05, 0xac, 0xe4, 0x99, 0xec, 0xa6, 0xcd, 0x96, 0x19, 0x7d, 0x06,
-	0x0b, 0x59, 0xef, 0x8b, 0x29, 0x2d, 0xc5, 0x91, 0x75, 0xf9, 0x02, 0xd7, 0xb3, 0xa3, 0x4a, 0xea,
-	0xff, 0x30, 0xef, 0x50, 0xcf, 0xeb, 0xba, 0x3d, 0x95, 0x56, 0xd5, 0xae, 0xc5, 0x91, 0x95, 0x41,
-	0x78, 0x4e, 0x6e, 0x76, 0x7b, 0xed, 0x3f, 0x67, 0x40, 0xcf, 0xc3, 0x76, 0xa8, 0xd8, 0xf5, 0x4f,
-	0x18, 0xfa, 0x08, 0xe6, 0x49, 0xaf, 0xc7, 0x69, 0x18, 0xa6, 0xc1, 0x94, 0x6b, 0x0a, 0xe1, 0x6c,
-	0x83, 0x1e, 0xc0, 0xac, 0x2c, 0x6b, 0x68, 0x94, 0x5b, 0x33, 0x6b, 0xb5, 0x8d, 0xe5, 0x4c, 0x84,
-	0x05, 0x99, 0x25, 0xb5, 0x50, 0x56, 0x38, 0x59, 0xd0, 0x6d, 0xd0, 0xf3, 0xb4, 0xb3, 0x28, 0xaa,
-	0x2a, 0x78, 0x31, 0xc3, 0x37, 0xd3, 0x00, 0x03, 0x58, 0x0a, 0x38, 0x3d, 0xa1, 0x9c, 0xd3, 0x5e,
-	0x6e, 0x2b, 0x7b, 0xdc, 0xd8, 0xb8, 0x3d, 0xa5, 0xf8, 0x34, 0xf9, 0xf5, 0xfd, 0xcc, 0x23, 0x65,
-	0xb1, 0xaf, 0xc7, 0x91, 0x35, 0xcd, 0x83, 0xf5, 0x60, 0xc2, 0xb0, 0xfd, 0x9b, 0x06, 0xfa, 0xa4,
-	0x37, 0x5a, 0x83, 0xf9, 0xa3, 0xce, 0x93, 0xce, 0xde, 0x37, 0x1d, 0xbd, 0xd4, 0xfc, 0xdf, 0xd9,
-	0x79, 0x6b, 0x75, 0xd2, 0xe4, 0xc8, 0x7f, 0xe1, 0xb3, 0x9f, 0x7c, 0x74, 0x07, 0x2a, 0xbb, 0x9d,
-	0x83, 0xc3, 0xcd, 0xce, 0xd6, 0xb6, 0xae, 0x35, 0x6f, 0x9e, 0x9d, 0xb7, 0x8c, 0x49, 0xd3, 0xac,
-	0xaf, 0xa8, 0x0d, 0xd7, 0x76, 0xf6, 0x0e, 0x0e, 0xf5, 0x72, 0xd3, 0x38, 0x3b, 0x6f, 0xad, 0x4c,
-	0xda, 0xed, 0xb0, 0x50, 0xb4, 0x6d, 0xb8, 0x51, 0x10, 0x84, 0xa0, 0xdc, 0x27, 0x1e, 0x66, 0x43,
-	0x41, 0xd1, 0x1a, 0xa8, 0x07, 0xe6, 0x93, 0x01, 0x4d, 0x1b, 0x54, 0x8f, 0x23, 0x2b, 0xc7, 0x70,
-	0xbe, 0x6b, 0xff, 0x5d, 0x81, 0x6a, 0x4e, 0x82, 0x76, 0xa0, 0xf1, 0x7e, 0xbc, 0x75, 0x5f, 0xd0,
-	0x51, 0x3a, 0x6f, 0x56, 0xa6, 0x8a, 0xf9, 0x84, 0x8e, 0xec, 0xfa, 0xab, 0xc8, 0x2a, 0x5d, 0x44,
-	0x96, 0x16, 0x47, 0x56, 0x09, 0xd7, 0x13, 0xcf, 0xa7, 0x3c, 0x90, 0xa2, 0x24, 0xb0, 0x5a, 0x60,
-	0xca, 0xfb, 0x29, 0x29, 0x93, 0x89, 0x74, 0x73, 0x8a, 0xb2, 0xa0, 0xe9, 0x09, 0xea, 0x95, 0x9c,
-	0xba, 0xa8, 0xfb, 0x23, 0x58, 0x2e, 0x84, 0xf0, 0xa9, 0xe8, 0xba, 0xfe, 0x09, 0x53, 0x52, 0xa9,
-	0x6d, 0x18, 0x57, 0xb5, 0x7f, 0x82, 0x5a, 0xcf, 0xa9, 0x33, 0x6d, 0x7f, 0x02, 0x35, 0x87, 0x93,
-	0xf0, 0x79, 0xd7, 0x61, 0x43, 0x3f, 0x99, 0x18, 0xb3, 0xf6, 0x62, 0x1c, 0x59, 0x45, 0x18, 0x83,
-	0x3a, 0x6c, 0xc9, 0x3d, 0xba, 0x05, 0xf5, 0xe4, 0x8a, 0x53, 0x12, 0x32, 0xdf, 0x98, 0x55, 0x62,
-	0x4d, 0xcc, 0xb1, 0x82, 0xe4, 0x00, 0x08, 0x05, 0x11, 0xd4, 0x98, 0x53, 0xdd, 0x50, 0xa2, 0x57,
-	0x00, 0x4e, 0x16, 0xf4, 0x31, 0x2c, 0x06, 0x1e, 0x71, 0xe8, 0x80, 0xfa, 0xa2, 0x4b, 0x39, 0x67,
-	0xdc, 0x98, 0x57, 0x34, 0x8d, 0x1c, 0xde, 0x96, 0xa8, 0x62, 0x72, 0xe5, 0x2f, 0x41, 0xa5, 0xa5,
-	0xad, 0xcd, 0xa4, 0x4c, 0x12, 0xc0, 0xc9, 0x82, 0x7e, 0x00, 0x7d, 0xf2, 0x97, 0xc5, 0xa8, 0xaa,
-	0x9a, 0xac, 0x66, 0x35, 0x79, 0x56, 0xb8, 0x3f, 0x24, 0x7d, 0xdb, 0x90, 0x25, 0x89, 0x23, 0x6b,
-	0xca, 0x11, 0x2f, 0x0e, 0x2e, 0x9b, 0xa2, 0x47, 0x50, 0x09, 0x38, 0x0d, 0xa9, 0xcc, 0x00, 0xd4,
-	0x43, 0x6b, 0x4e, 0x55, 0x5a, 0xbe, 0x30, 0x65, 0x91, 0xa8, 0x2e, 0xb3, 0xc7, 0xf9, 0x0e, 0x7d,
-	0x0f, 0xcd, 0x4b, 0xea, 0x48, 0xb4, 0xdb, 0xe5, 0x52, 0xbc, 0xa1, 0x51, 0x53, 0xd3, 0xc2, 0xfc,
-	0x80, 0x40, 0x0a, 0x1a, 0xc7, 0xab, 0x05, 0x51, 0x14, 0xf0, 0x10, 0xd9, 0x50, 0x1b, 0x50, 0xc1,
-	0x5d, 0x47, 0x7e, 0x41, 0x68, 0xd4, 0x15, 0xdb, 0xad, 0xe9, 0x2c, 0x9f, 0x29, 0xa3, 0x43, 0xd2,
-	0x0f, 0xb7, 0x7d, 0xc1, 0x47, 0x18, 0x06, 0x39, 0x20, 0x9f, 0xaa, 0x4c, 0x86, 0x1c, 0x7b, 0xd4,
-	0x58, 0x68, 0x69, 0x6b, 0x95, 0xe4, 0x53, 0x32, 0x6c, 0xa7, 0x84, 0xf3, 0x3d, 0xb2, 0x61, 0x89,
-	0x9c, 0x12, 0xd7, 0x23, 0xc7, 0xae, 0xe7, 0x8a, 0x51, 0xf7, 0x67, 0xe6, 0x53, 0xa3, 0xa1, 0xfa,
-	0xac, 0x26, 0xcb, 0xd4, 0x25, 0xd6, 0x8b, 0xd0, 0x77, 0xcc, 0xa7, 0xcd, 0x2f, 0x61, 0x71, 0x22,
-	0x1d, 0xa4, 0xc3, 0x4c, 0xf6, 0x00, 0xab, 0x58, 0x6e, 0xd1, 0x0a, 0xcc, 0x9e, 0x12, 0x6f, 0x48,
-	0x93, 0x71, 0x8d, 0x93, 0xc3, 0xc3, 0xf2, 0xe7, 0x5a, 0xfb, 0x47, 0xa8, 0x64, 0x35, 0x47, 0x4d,
-	0xa8, 0xec, 0xe1, 0x47, 0xbb, 0x9d, 0x4d, 0xfc, 0xad, 0x5e, 0x6a, 0xd6, 0xcf, 0xce, 0x5b, 0x95,
-	0x3d, 0xde, 0x73, 0x7d, 0xc2, 0x47, 0xc8, 0x04, 0xd8, 0xfe, 0x7a, 0x73, 0xeb, 0x68, 0xf3, 0x70,
-	0xb7, 0xf3, 0x58, 0xd7, 0x9a, 0x8d, 0xb3, 0xf3, 0x16, 0x6c, 0xe7, 0xff, 0x03, 0x90, 0x01, 0xf3,
-	0x07, 0x47, 0x07, 0xfb, 0xdb, 0x5b, 0x72, 0xf0, 0xd4, 0xce, 0xce, 0x5b, 0xf3, 0x07, 0xc3, 0x30,
-	0xa0, 0x8e, 0xb0, 0x97, 0x61, 0x89, 0x05, 0x52, 0x04, 0x69, 0x9b, 0xe4, 0x97, 0xdb, 0x0f, 0x2e,
-	0xde, 0x98, 0xda, 0xeb, 0x37, 0x66, 0xe9, 0xdd, 0x1b, 0x53, 0xfb, 0x65, 0x6c, 0x6a, 0x7f, 0x8d,
-	0x4d, 0xed, 0xd5, 0xd8, 0xd4, 0x2e, 0xc6, 0xa6, 0xf6, 0xcf, 0xd8, 0xd4, 0xfe, 0x1d, 0x9b, 0xa5,
-	0x77, 0x63, 0x53, 0xfb, 0xfd, 0xad, 0x59, 0xba, 0x78, 0x6b, 0x96, 0x5e, 0xbf, 0x35, 0x4b, 0xc7,
-	0x73, 0xea, 0x7f, 0xce, 0xfd, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xa8, 0xf8, 0x7a, 0x0a, 0x4a,
-	0x09, 0x00, 0x00,
+	0x10, 0x15, 0xe5, 0xd8, 0x96, 0x46, 0xb2, 0x44, 0xad, 0x9d, 0x98, 0x50, 0x03, 0x52, 0x11, 0x5a,
+	0xd4, 0x09, 0x10, 0xa7, 0x75, 0x82, 0xa2, 0x4d, 0xd1, 0x83, 0xe8, 0xa8, 0xb1, 0x91, 0x44, 0x36,
+	0xd6, 0x76, 0x8b, 0x7e, 0x00, 0xec, 0x5a, 0x5a, 0xcb, 0x44, 0x24, 0x2e, 0xb1, 0x5c, 0xb9, 0x56,
+	0x4f, 0x3d, 0x16, 0x46, 0x0f, 0x05, 0x7a, 0xe9, 0xc5, 0xf7, 0xfe, 0x94, 0x1c, 0x7d, 0xcc, 0x89,
+	0x68, 0x94, 0x4b, 0xc0, 0x53, 0x7e, 0x42, 0xc1, 0xe5, 0x87, 0x69, 0x29, 0x3e, 0xed, 0xec, 0x9b,
+	0x99, 0xb7, 0xc3, 0x99, 0xc7, 0x25, 0x41, 0x25, 0x5d, 0x31, 0x22, 0x03, 0x6b, 0xc0, 0xdd, 0x75,
+	0x97, 0x33, 0xc1, 0xd0, 0xc2, 0x90, 0xf5, 0xe8, 0xc0, 0xab, 0xdf, 0xef, 0xdb, 0xe2, 0x78, 0x74,
+	0xb8, 0xde, 0x65, 0xc3, 0x07, 0x7d, 0xd6, 0x67, 0x0f, 0xa4, 0xfb, 0x70, 0x74, 0x24, 0x77, 0x72,
+	0x23, 0xad, 0x28, 0xad, 0x7e, 0x6b, 0xc8, 0x7a, 0xf6, 0x91, 0xdd, 0x25, 0xc2, 0x66, 0x8e, 0x25,
+	0x48, 0x3f, 0xc2, 0x9b, 0x27, 0x50, 0x69, 0xc9, 0x23, 0x9e, 0xe3, 0xdd, 0xa7, 0x9c, 0x8d, 0x5c,
+	0x74, 0x1f, 0x0a, 0xb6, 0xe3, 0x09, 0xe2, 0x74, 0xa9, 0xa6, 0x34, 0x94, 0xb5, 0xd2, 0x46, 0x6d,
+	0x3d, 0x3a, 0x73, 0x3d, 0x8d, 0xc4, 0x69, 0x08, 0xfa, 0x1c, 0x80, 0x9e, 0x90, 0xee, 0x88, 0x08,
+	0xdb, 0xe9, 0x6b, 0xf9, 0xeb, 0x12, 0x32, 0x41, 0x8f, 0xf3, 0x9a, 0xd2, 0xfc, 0x3b, 0x0f, 0xa5,
+	0x5d, 0xc6, 0xc5, 0x0b, 0xe2, 0xba, 0xb6, 0xd3, 0x47, 0x5f, 0x41, 0xa5, 0xcb, 0x1c, 0x41, 0x6c,
+	0x87, 0x72, 0xcb, 0x65, 0x5c, 0xc8, 0xb3, 0x97, 0x4c, 0x14, 0xf8, 0xc6, 0x94, 0x07, 0x2f, 0xa5,
+	0xfb, 0x90, 0x01, 0xdd, 0x83, 0xe2, 0x31, 0xf3, 0x44, 0x94, 0x95, 0x97, 0x59, 0x4b, 0x81, 0x6f,
+	0x5c, 0x82, 0xb8, 0x10, 0x9a, 0x32, 0xf6, 0x00, 0xb4, 0x4b, 0x32, 0x31, 0xf0, 0x2c, 0x97, 0xb3,
+	0xd3, 0x71, 0x94, 0x3a, 0x27, 0x53, 0x6f, 0x07, 0xbe, 0x71, 0x6d, 0x0c, 0xbe, 0x99, 0x7a, 0xf6,
+	0x07, 0xde, 0x6e, 0x88, 0x4b, 0xda, 0x6f, 0x61, 0x59, 0x9e, 0x36, 0xc5, 0x78, 0x43, 0x32, 0xae,
+	0x06, 0xbe, 0xf1, 0x21, 0x37, 0x56, 0x43, 0x30, 0xcb, 0xd3, 0xfc, 0x43, 0x81, 0x72, 0xda, 0xb3,
+	0x67, 0x74, 0x8c, 0x1e, 0x42, 0xd9, 0xe5, 0xac, 0x4b, 0x3d, 0xcf, 0xea, 0x8f, 0xec, 0x9e, 0x6c,
+	0x4a, 0xd1, 0x54, 0x03, 0xdf, 0xb8, 0x82, 0xe3, 0x52, 0xbc, 0x7b, 0x3a, 0xb2, 0x7b, 0xc8, 0x80,
+	0x79, 0xdb, 0xe9, 0xd1, 0x53, 0xd9, 0x8c, 0x79, 0xb3, 0x18, 0xf8, 0x46, 0x04, 0xe0, 0x68, 0x41,
+	0x4d, 0x58, 0xe8, 0xb1, 0x21, 0xb1, 0x1d, 0xf9, 0xcc, 0x45, 0x13, 0x02, 0xdf, 0x88, 0x11, 0x1c,
+	0xaf, 0x4d, 0x01, 0x2b, 0x69, 0x25, 0xdb, 0xf1, 0xb0, 0xc3, 0x8a, 0xbe, 0x80, 0xa5, 0x64, 0xf6,
+	0xd9, 0x92, 0x6a, 0x81, 0x6f, 0x5c, 0x75, 0xe0, 0x72, 0xb2, 0x95, 0x45, 0x7d, 0x0c, 0x8b, 0x5d,
+	0x3a, 0x18, 0x58, 0x76, 0x4f, 0x96, 0x55, 0x34, 0x4b, 0x81, 0x6f, 0x24, 0x10, 0x5e, 0x08, 0x8d,
+	0xed, 0x5e, 0xf3, 0x9f, 0x39, 0x50, 0xd3, 0x63, 0x3b, 0x54, 0x6c, 0x3b, 0x47, 0x0c, 0x7d, 0x02,
+	0x8b, 0xa4, 0xd7, 0xe3, 0xd4, 0xf3, 0x34, 0xe5, 0x32, 0x35, 0x86, 0x70, 0x62, 0xa0, 0x47, 0x30,
+	0x1f, 0xb6, 0xd5, 0xd3, 0xf2, 0x8d, 0xb9, 0xb5, 0xd2, 0xc6, 0x72, 0x22, 0xc2, 0x8c, 0xcc, 0xa2,
+	0x5e, 0xc8, 0x28, 0x1c, 0x2d, 0xe8, 0x2e, 0xa8, 0x69, 0xd9, 0xc9, 0x29, 0xb2, 0x2b, 0xb8, 0x9a,
+	0xe0, 0xad, 0xf8, 0x80, 0x21, 0xd4, 0x5c, 0x4e, 0x8f, 0x28, 0xe7, 0xb4, 0x97, 0xc6, 0x86, 0x33,
+	0xae, 0x6c, 0xdc, 0x9d, 0x51, 0x7c, 0x5c, 0xfc, 0xfa, 0x6e, 0x92, 0x11, 0xb3, 0x98, 0x37, 0x03,
+	0xdf, 0x98, 0xe5, 0xc1, 0xaa, 0x3b, 0x15, 0xd8, 0xfc, 0x53, 0x01, 0x75, 0x3a, 0x1b, 0xad, 0xc1,
+	0xe2, 0x41, 0xe7, 0x59, 0x67, 0xe7, 0xfb, 0x8e, 0x9a, 0xab, 0x7f, 0x74, 0x76, 0xde, 0x58, 0x9d,
+	0x0e, 0x39, 0x70, 0x5e, 0x3a, 0xec, 0x57, 0x07, 0xdd, 0x83, 0xc2, 0x76, 0x67, 0x6f, 0xbf, 0xd5,
+	0xd9, 0x6c, 0xab, 0x4a, 0xfd, 0xf6, 0xd9, 0x79, 0x43, 0x9b, 0x0e, 0x4d, 0xe6, 0x8a, 0x9a, 0x70,
+	0x63, 0x6b, 0x67, 0x6f, 0x5f, 0xcd, 0xd7, 0xb5, 0xb3, 0xf3, 0xc6, 0xca, 0x74, 0xdc, 0x16, 0xf3,
+	0x44, 0xd3, 0x84, 0x5b, 0x19, 0x41, 0x08, 0xca, 0x1d, 0x32, 0xc0, 0x6c, 0x24, 0x28, 0x5a, 0x03,
+	0xf9, 0x82, 0x39, 0x64, 0x48, 0xe3, 0x01, 0x95, 0x03, 0xdf, 0x48, 0x31, 0x9c, 0x5a, 0xcd, 0x77,
+	0x45, 0x28, 0xa6, 0x24, 0x68, 0x0b, 0x2a, 0x97, 0xd7, 0x9b, 0xf5, 0x92, 0x8e, 0xe3, 0xfb, 0x66,
+	0x65, 0xa6, 0x99, 0xcf, 0xe8, 0xd8, 0x2c, 0xbf, 0xf2, 0x8d, 0xdc, 0x85, 0x6f, 0x28, 0x81, 0x6f,
+	0xe4, 0x70, 0x39, 0xca, 0x7c, 0xce, 0xdd, 0x50, 0x94, 0x04, 0x56, 0x33, 0x4c, 0xe9, 0x3c, 0x43,
+	0xca, 0xe8, 0x46, 0xba, 0x3d, 0x43, 0x99, 0xd1, 0xf4, 0x14, 0xf5, 0x4a, 0x4a, 0x9d, 0xd5, 0xfd,
+	0x01, 0x2c, 0x67, 0x8e, 0x70, 0xa8, 0xb0, 0x6c, 0xe7, 0x88, 0x49, 0xa9, 0x94, 0x36, 0xb4, 0xeb,
+	0xc6, 0x3f, 0x45, 0xad, 0xa6, 0xd4, 0x89, 0xb6, 0x3f, 0x83, 0x52, 0x97, 0x13, 0xef, 0xd8, 0xea,
+	0xb2, 0x91, 0x13, 0xdd, 0x18, 0xf3, 0x66, 0x35, 0xf0, 0x8d, 0x2c, 0x8c, 0x41, 0x6e, 0x36, 0x43,
+	0x1b, 0xdd, 0x81, 0x72, 0xe4, 0xe2, 0x94, 0x78, 0xcc, 0xd1, 0xe6, 0xa5, 0x58, 0xa3, 0x70, 0x2c,
+	0xa1, 0xf0, 0x02, 0xf0, 0x04, 0x11, 0x54, 0x5b, 0x90, 0xd3, 0x90, 0xa2, 0x97, 0x00, 0x8e, 0x16,
+	0xf4, 0x29, 0x54, 0xdd, 0x01, 0xe9, 0xd2, 0x21, 0x75, 0x84, 0x45, 0x39, 0x67, 0x5c, 0x5b, 0x94,
+	0x34, 0x95, 0x14, 0x6e, 0x87, 0xa8, 0x64, 0xb2, 0xc3, 0x2f, 0x41, 0xa1, 0xa1, 0xac, 0xcd, 0xc5,
+	0x4c, 0x21, 0x80, 0xa3, 0x05, 0xfd, 0x0c, 0xea, 0xf4, 0x97, 0x45, 0x2b, 0xca, 0x9e, 0xac, 0x26,
+	0x3d, 0x79, 0x91, 0xf1, 0xef, 0x93, 0xbe, 0xa9, 0x85, 0x2d, 0x09, 0x7c, 0x63, 0x26, 0x11, 0x57,
+	0x87, 0x57, 0x43, 0xd1, 0x13, 0x28, 0xb8, 0x9c, 0x7a, 0x34, 0xac, 0x00, 0xe4, 0x8b, 0x56, 0x9f,
+	0xe9, 0xf4, 0xfa, 0x6e, 0x1c, 0x11, 0xa9, 0x2e, 0x89, 0xc7, 0xa9, 0x85, 0x7e, 0x82, 0xfa, 0x15,
+	0x75, 0x44, 0xda, 0xb5, 0x78, 0x28, 0x5e, 0x4f, 0x2b, 0xc9, 0xdb, 0x42, 0xff, 0x80, 0x40, 0x32,
+	0x1a, 0xc7, 0xab, 0x19, 0x51, 0x64, 0x70, 0x0f, 0x99, 0x50, 0x1a, 0x52, 0xc1, 0xed, 0x6e, 0xf8,
+	0x04, 0x9e, 0x56, 0x96, 0x6c, 0x77, 0x66, 0xab, 0x7c, 0x21, 0x83, 0xf6, 0x49, 0xdf, 0x6b, 0x3b,
+	0x82, 0x8f, 0x31, 0x0c, 0x53, 0x20, 0x7c, 0x55, 0xc3, 0x62, 0xc8, 0xe1, 0x80, 0x6a, 0x4b, 0x0d,
+	0x65, 0xad, 0x10, 0x3d, 0x4a, 0x82, 0x6d, 0xe5, 0x70, 0x6a, 0x23, 0x13, 0x6a, 0xe4, 0x84, 0xd8,
+	0x03, 0x72, 0x68, 0x0f, 0x6c, 0x31, 0xb6, 0x7e, 0x63, 0x0e, 0xd5, 0x2a, 0x72, 0xce, 0xf2, 0x66,
+	0x99, 0x71, 0x62, 0x35, 0x0b, 0xfd, 0xc8, 0x1c, 0xf9, 0xc2, 0x72, 0x7a, 0x62, 0x7b, 0x36, 0x73,
+	0xb4, 0xaa, 0x54, 0x5c, 0x74, 0x5e, 0x8c, 0xe1, 0xd4, 0x42, 0x5f, 0x43, 0xd5, 0xa1, 0xa7, 0xc2,
+	0xe2, 0xd4, 0x13, 0x84, 0x0b, 0x8b, 0x08, 0x4d, 0x95, 0x4a, 0x58, 0x0e, 0x7c, 0x63, 0xda, 0x85,
+	0x97, 0x42, 0x00, 0x47, 0xfb, 0x96, 0x40, 0x6d, 0x40, 0xb1, 0xd3, 0xb3, 0xe8, 0xe9, 0x31, 0x19,
+	0x79, 0x82, 0xf6, 0xb4, 0x9a, 0x7c, 0xc0, 0x5b, 0x81, 0x6f, 0x7c, 0xc0, 0x8b, 0x6b, 0x09, 0xd6,
+	0x4e, 0xa0, 0xfa, 0x37, 0x50, 0x9d, 0x6a, 0x1e, 0x52, 0x61, 0x2e, 0xb9, 0x2e, 0x8a, 0x38, 0x34,
+	0xd1, 0x0a, 0xcc, 0x9f, 0x90, 0xc1, 0x88, 0x46, 0x1f, 0x17, 0x1c, 0x6d, 0x1e, 0xe7, 0xbf, 0x54,
+	0x9a, 0xbf, 0x40, 0x21, 0x51, 0x08, 0xaa, 0x43, 0x61, 0x07, 0x3f, 0xd9, 0xee, 0xb4, 0xf0, 0x0f,
+	0x6a, 0xae, 0x5e, 0x3e, 0x3b, 0x6f, 0x14, 0x76, 0x78, 0xcf, 0x76, 0x08, 0x1f, 0x23, 0x1d, 0xa0,
+	0xfd, 0x5d, 0x6b, 0xf3, 0xa0, 0xb5, 0xbf, 0xdd, 0x79, 0xaa, 0x2a, 0xf5, 0xca, 0xd9, 0x79, 0x03,
+	0xda, 0xe9, 0x5f, 0x0b, 0xd2, 0x60, 0x71, 0xef, 0x60, 0x6f, 0xb7, 0xbd, 0x19, 0x5e, 0x93, 0xa5,
+	0xb3, 0xf3, 0xc6, 0xe2, 0xde, 0xc8, 0x73, 0x69, 0x57, 0x98, 0xcb, 0x50, 0x63, 0x6e, 0x28, 0xd9,
+	0x58, 0x54, 0xe1, 0x9c, 0xcc, 0x47, 0x17, 0x6f, 0x74, 0xe5, 0xf5, 0x1b, 0x3d, 0xf7, 0xfe, 0x8d,
+	0xae, 0xfc, 0x3e, 0xd1, 0x95, 0x7f, 0x27, 0xba, 0xf2, 0x6a, 0xa2, 0x2b, 0x17, 0x13, 0x5d, 0xf9,
+	0x6f, 0xa2, 0x2b, 0xef, 0x26, 0x7a, 0xee, 0xfd, 0x44, 0x57, 0xfe, 0x7a, 0xab, 0xe7, 0x2e, 0xde,
+	0xea, 0xb9, 0xd7, 0x6f, 0xf5, 0xdc, 0xe1, 0x82, 0xfc, 0x2b, 0x7b, 0xf8, 0xff, 0x00, 0xc4, 0x76,
+	0x90, 0xde, 0xf8, 0x09, 0x00, 0x00,
 }
 
 func (x ActualLRPNetInfo_PreferredAddress) String() string {
@@ -933,6 +961,15 @@ func (this *ActualLRP) Equal(that interface{}) bool {
 	if this.AvailabilityZone != that1.AvailabilityZone {
 		return false
 	}
+	if this.Revision != that1.Revision {
+		return false
+	}
+	if this.NextRestartAt != that1.NextRestartAt {
+		return false
+	}
+	if this.RestartsExhausted != that1.RestartsExhausted {
+		return false
+	}
 	return true
 }
 func (this *ActualLRP_Routable) Equal(that interface{}) bool {
@@ -1039,7 +1076,7 @@ func (this *ActualLRP) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 18)
+	s := make([]string, 0, 21)
 	s = append(s, "&models.ActualLRP{")
 	s = append(s, "ActualLRPKey: "+strings.Replace(this.ActualLRPKey.GoString(), `&`, ``, 1)+",\n")
 	s = append(s, "ActualLRPInstanceKey: "+strings.Replace(this.ActualLRPInstanceKey.GoString(), `&`, ``, 1)+",\n")
@@ -1071,6 +1108,9 @@ func (this *ActualLRP) GoString() string {
 		s = append(s, "OptionalRoutable: "+fmt.Sprintf("%#v", this.OptionalRoutable)+",\n")
 	}
 	s = append(s, "AvailabilityZone: "+fmt.Sprintf("%#v", this.AvailabilityZone)+",\n")
+	s = append(s, "Revision: "+fmt.Sprintf("%#v", this.Revision)+",\n")
+	s = append(s, "NextRestartAt: "+fmt.Sprintf("%#v", this.NextRestartAt)+",\n")
+	s = append(s, "RestartsExhausted: "+fmt.Sprintf("%#v", this.RestartsExhausted)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1365,6 +1405,30 @@ func (m *ActualLRP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RestartsExhausted {
+		i--
+		if m.RestartsExhausted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.NextRestartAt != 0 {
+		i = encodeVarintActualLrp(dAtA, i, uint64(m.NextRestartAt))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.Revision != 0 {
+		i = encodeVarintActualLrp(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x78
+	}
 	if len(m.AvailabilityZone) > 0 {
 		i -= len(m.AvailabilityZone)
 		copy(dAtA[i:], m.AvailabilityZone)
@@ -1691,6 +1755,15 @@ func (m *ActualLRP) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovActualLrp(uint64(l))
 	}
+	if m.Revision != 0 {
+		n += 1 + sovActualLrp(uint64(m.Revision))
+	}
+	if m.NextRestartAt != 0 {
+		n += 2 + sovActualLrp(uint64(m.NextRestartAt))
+	}
+	if m.RestartsExhausted {
+		n += 3
+	}
 	return n
 }
 
@@ -1819,6 +1892,9 @@ func (this *ActualLRP) String() string {
 		`MetricTags:` + mapStringForMetricTags + `,`,
 		`OptionalRoutable:` + fmt.Sprintf("%v", this.OptionalRoutable) + `,`,
 		`AvailabilityZone:` + fmt.Sprintf("%v", this.AvailabilityZone) + `,`,
+		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
+		`NextRestartAt:` + fmt.Sprintf("%v", this.NextRestartAt) + `,`,
+		`RestartsExhausted:` + fmt.Sprintf("%v", this.RestartsExhausted) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3113,6 +3189,64 @@ func (m *ActualLRP) Unmarshal(dAtA []byte) error {
 			}
 			m.AvailabilityZone = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRestartAt", wireType)
+			}
+			m.NextRestartAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRestartAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartsExhausted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RestartsExhausted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipActualLrp(dAtA[iNdEx:])
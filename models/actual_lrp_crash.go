@@ -0,0 +1,19 @@
+package models
+
+// MaxActualLRPCrashes is the number of crashes kept in the crash history of
+// each ActualLRP index.
+const MaxActualLRPCrashes = 10
+
+// NewActualLRPCrash returns the crash history entry of an ActualLRP that
+// crashed, from its state before and after the crash.
+func NewActualLRPCrash(before, after *ActualLRP) *ActualLRPCrash {
+	return &ActualLRPCrash{
+		ProcessGuid:  after.ProcessGuid,
+		Index:        after.Index,
+		InstanceGuid: before.InstanceGuid,
+		CellId:       before.CellId,
+		CrashReason:  after.CrashReason,
+		CrashCount:   after.CrashCount,
+		CrashedAt:    after.Since,
+	}
+}
@@ -0,0 +1,676 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: actual_lrp_crash.proto
+
+package models
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ActualLRPCrash struct {
+	ProcessGuid  string `protobuf:"bytes,1,opt,name=process_guid,json=processGuid,proto3" json:"process_guid"`
+	Index        int32  `protobuf:"varint,2,opt,name=index,proto3" json:"index"`
+	InstanceGuid string `protobuf:"bytes,3,opt,name=instance_guid,json=instanceGuid,proto3" json:"instance_guid"`
+	CellId       string `protobuf:"bytes,4,opt,name=cell_id,json=cellId,proto3" json:"cell_id"`
+	CrashReason  string `protobuf:"bytes,5,opt,name=crash_reason,json=crashReason,proto3" json:"crash_reason,omitempty"`
+	CrashCount   int32  `protobuf:"varint,6,opt,name=crash_count,json=crashCount,proto3" json:"crash_count"`
+	CrashedAt    int64  `protobuf:"varint,7,opt,name=crashed_at,json=crashedAt,proto3" json:"crashed_at"`
+}
+
+func (m *ActualLRPCrash) Reset()      { *m = ActualLRPCrash{} }
+func (*ActualLRPCrash) ProtoMessage() {}
+func (*ActualLRPCrash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff31765dfc508092, []int{0}
+}
+func (m *ActualLRPCrash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActualLRPCrash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActualLRPCrash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActualLRPCrash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActualLRPCrash.Merge(m, src)
+}
+func (m *ActualLRPCrash) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActualLRPCrash) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActualLRPCrash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActualLRPCrash proto.InternalMessageInfo
+
+func (m *ActualLRPCrash) GetProcessGuid() string {
+	if m != nil {
+		return m.ProcessGuid
+	}
+	return ""
+}
+
+func (m *ActualLRPCrash) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ActualLRPCrash) GetInstanceGuid() string {
+	if m != nil {
+		return m.InstanceGuid
+	}
+	return ""
+}
+
+func (m *ActualLRPCrash) GetCellId() string {
+	if m != nil {
+		return m.CellId
+	}
+	return ""
+}
+
+func (m *ActualLRPCrash) GetCrashReason() string {
+	if m != nil {
+		return m.CrashReason
+	}
+	return ""
+}
+
+func (m *ActualLRPCrash) GetCrashCount() int32 {
+	if m != nil {
+		return m.CrashCount
+	}
+	return 0
+}
+
+func (m *ActualLRPCrash) GetCrashedAt() int64 {
+	if m != nil {
+		return m.CrashedAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ActualLRPCrash)(nil), "models.ActualLRPCrash")
+}
+
+func init() { proto.RegisterFile("actual_lrp_crash.proto", fileDescriptor_ff31765dfc508092) }
+
+var fileDescriptor_ff31765dfc508092 = []byte{
+	// 344 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0x31, 0x6e, 0xf2, 0x30,
+	0x18, 0x86, 0x63, 0xf8, 0x09, 0xc2, 0xf0, 0xd3, 0x36, 0x43, 0x15, 0x75, 0x70, 0x68, 0xd5, 0x81,
+	0x05, 0xa8, 0x44, 0xd5, 0x9d, 0x30, 0x54, 0x95, 0x3a, 0x54, 0xbe, 0x40, 0x14, 0x6c, 0x37, 0x44,
+	0x0a, 0x31, 0x4a, 0x1c, 0xa9, 0x63, 0x8f, 0xd0, 0x63, 0xf4, 0x28, 0x8c, 0x8c, 0x4c, 0x51, 0x31,
+	0x4b, 0x95, 0x89, 0x23, 0x54, 0xf9, 0x02, 0x12, 0x4c, 0x79, 0x9f, 0xe7, 0xcb, 0xf7, 0xca, 0xb2,
+	0xf1, 0xb5, 0xcf, 0x54, 0xe6, 0x47, 0x5e, 0x94, 0x2c, 0x3d, 0x96, 0xf8, 0xe9, 0x7c, 0xb8, 0x4c,
+	0xa4, 0x92, 0x96, 0xb9, 0x90, 0x5c, 0x44, 0xe9, 0xcd, 0x20, 0x08, 0xd5, 0x3c, 0x9b, 0x0d, 0x99,
+	0x5c, 0x8c, 0x02, 0x19, 0xc8, 0x11, 0x8c, 0x67, 0xd9, 0x3b, 0x10, 0x00, 0xa4, 0x6a, 0xed, 0x6e,
+	0x55, 0xc3, 0xdd, 0x09, 0x34, 0xbe, 0xd2, 0xb7, 0x69, 0xd9, 0x67, 0x8d, 0x71, 0x67, 0x99, 0x48,
+	0x26, 0xd2, 0xd4, 0x0b, 0xb2, 0x90, 0xdb, 0xa8, 0x87, 0xfa, 0x2d, 0xf7, 0xb2, 0xc8, 0x9d, 0x33,
+	0x4f, 0xdb, 0x07, 0x7a, 0xce, 0x42, 0x6e, 0x39, 0xb8, 0x11, 0xc6, 0x5c, 0x7c, 0xd8, 0xb5, 0x1e,
+	0xea, 0x37, 0xdc, 0x56, 0x91, 0x3b, 0x95, 0xa0, 0xd5, 0xc7, 0x7a, 0xc2, 0xff, 0xc3, 0x38, 0x55,
+	0x7e, 0xcc, 0x44, 0x55, 0x5b, 0x87, 0xda, 0xab, 0x22, 0x77, 0xce, 0x07, 0xb4, 0x73, 0x44, 0x28,
+	0xbe, 0xc7, 0x4d, 0x26, 0xa2, 0xc8, 0x0b, 0xb9, 0xfd, 0x0f, 0x36, 0xda, 0x45, 0xee, 0x1c, 0x15,
+	0x35, 0xcb, 0xf0, 0xc2, 0xad, 0x5b, 0xdc, 0x81, 0xcb, 0xf0, 0x12, 0xe1, 0xa7, 0x32, 0xb6, 0x1b,
+	0xe5, 0xaf, 0xb4, 0x0d, 0x8e, 0x82, 0xb2, 0x1e, 0x70, 0x85, 0x1e, 0x93, 0x59, 0xac, 0x6c, 0x13,
+	0xce, 0x79, 0x51, 0xe4, 0xce, 0xa9, 0xa6, 0x18, 0x60, 0x5a, 0x66, 0x6b, 0x80, 0x2b, 0x12, 0xdc,
+	0xf3, 0x95, 0xdd, 0xec, 0xa1, 0x7e, 0xdd, 0xed, 0x16, 0xb9, 0x73, 0x62, 0x69, 0xeb, 0x90, 0x27,
+	0xca, 0x7d, 0x5c, 0x6f, 0x89, 0xb1, 0xd9, 0x12, 0x63, 0xbf, 0x25, 0xe8, 0x53, 0x13, 0xf4, 0xad,
+	0x09, 0x5a, 0x69, 0x82, 0xd6, 0x9a, 0xa0, 0x1f, 0x4d, 0xd0, 0xaf, 0x26, 0xc6, 0x5e, 0x13, 0xf4,
+	0xb5, 0x23, 0xc6, 0x7a, 0x47, 0x8c, 0xcd, 0x8e, 0x18, 0x33, 0x13, 0xde, 0x61, 0xfc, 0x37, 0x00,
+	0xd9, 0x8c, 0x81, 0x53, 0xd8, 0x01, 0x00, 0x00,
+}
+
+func (this *ActualLRPCrash) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ActualLRPCrash)
+	if !ok {
+		that2, ok := that.(ActualLRPCrash)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProcessGuid != that1.ProcessGuid {
+		return false
+	}
+	if this.Index != that1.Index {
+		return false
+	}
+	if this.InstanceGuid != that1.InstanceGuid {
+		return false
+	}
+	if this.CellId != that1.CellId {
+		return false
+	}
+	if this.CrashReason != that1.CrashReason {
+		return false
+	}
+	if this.CrashCount != that1.CrashCount {
+		return false
+	}
+	if this.CrashedAt != that1.CrashedAt {
+		return false
+	}
+	return true
+}
+func (this *ActualLRPCrash) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&models.ActualLRPCrash{")
+	s = append(s, "ProcessGuid: "+fmt.Sprintf("%#v", this.ProcessGuid)+",\n")
+	s = append(s, "Index: "+fmt.Sprintf("%#v", this.Index)+",\n")
+	s = append(s, "InstanceGuid: "+fmt.Sprintf("%#v", this.InstanceGuid)+",\n")
+	s = append(s, "CellId: "+fmt.Sprintf("%#v", this.CellId)+",\n")
+	s = append(s, "CrashReason: "+fmt.Sprintf("%#v", this.CrashReason)+",\n")
+	s = append(s, "CrashCount: "+fmt.Sprintf("%#v", this.CrashCount)+",\n")
+	s = append(s, "CrashedAt: "+fmt.Sprintf("%#v", this.CrashedAt)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringActualLrpCrash(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *ActualLRPCrash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActualLRPCrash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActualLRPCrash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CrashedAt != 0 {
+		i = encodeVarintActualLrpCrash(dAtA, i, uint64(m.CrashedAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.CrashCount != 0 {
+		i = encodeVarintActualLrpCrash(dAtA, i, uint64(m.CrashCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.CrashReason) > 0 {
+		i -= len(m.CrashReason)
+		copy(dAtA[i:], m.CrashReason)
+		i = encodeVarintActualLrpCrash(dAtA, i, uint64(len(m.CrashReason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CellId) > 0 {
+		i -= len(m.CellId)
+		copy(dAtA[i:], m.CellId)
+		i = encodeVarintActualLrpCrash(dAtA, i, uint64(len(m.CellId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.InstanceGuid) > 0 {
+		i -= len(m.InstanceGuid)
+		copy(dAtA[i:], m.InstanceGuid)
+		i = encodeVarintActualLrpCrash(dAtA, i, uint64(len(m.InstanceGuid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Index != 0 {
+		i = encodeVarintActualLrpCrash(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ProcessGuid) > 0 {
+		i -= len(m.ProcessGuid)
+		copy(dAtA[i:], m.ProcessGuid)
+		i = encodeVarintActualLrpCrash(dAtA, i, uint64(len(m.ProcessGuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintActualLrpCrash(dAtA []byte, offset int, v uint64) int {
+	offset -= sovActualLrpCrash(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ActualLRPCrash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProcessGuid)
+	if l > 0 {
+		n += 1 + l + sovActualLrpCrash(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovActualLrpCrash(uint64(m.Index))
+	}
+	l = len(m.InstanceGuid)
+	if l > 0 {
+		n += 1 + l + sovActualLrpCrash(uint64(l))
+	}
+	l = len(m.CellId)
+	if l > 0 {
+		n += 1 + l + sovActualLrpCrash(uint64(l))
+	}
+	l = len(m.CrashReason)
+	if l > 0 {
+		n += 1 + l + sovActualLrpCrash(uint64(l))
+	}
+	if m.CrashCount != 0 {
+		n += 1 + sovActualLrpCrash(uint64(m.CrashCount))
+	}
+	if m.CrashedAt != 0 {
+		n += 1 + sovActualLrpCrash(uint64(m.CrashedAt))
+	}
+	return n
+}
+
+func sovActualLrpCrash(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozActualLrpCrash(x uint64) (n int) {
+	return sovActualLrpCrash(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ActualLRPCrash) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ActualLRPCrash{`,
+		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`InstanceGuid:` + fmt.Sprintf("%v", this.InstanceGuid) + `,`,
+		`CellId:` + fmt.Sprintf("%v", this.CellId) + `,`,
+		`CrashReason:` + fmt.Sprintf("%v", this.CrashReason) + `,`,
+		`CrashCount:` + fmt.Sprintf("%v", this.CrashCount) + `,`,
+		`CrashedAt:` + fmt.Sprintf("%v", this.CrashedAt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringActualLrpCrash(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ActualLRPCrash) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowActualLrpCrash
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActualLRPCrash: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActualLRPCrash: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpCrash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthActualLrpCrash
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthActualLrpCrash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpCrash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstanceGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpCrash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthActualLrpCrash
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthActualLrpCrash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InstanceGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpCrash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthActualLrpCrash
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthActualLrpCrash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrashReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpCrash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthActualLrpCrash
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthActualLrpCrash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CrashReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrashCount", wireType)
+			}
+			m.CrashCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpCrash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CrashCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrashedAt", wireType)
+			}
+			m.CrashedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpCrash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CrashedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipActualLrpCrash(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthActualLrpCrash
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipActualLrpCrash(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowActualLrpCrash
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowActualLrpCrash
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowActualLrpCrash
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthActualLrpCrash
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupActualLrpCrash
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthActualLrpCrash
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthActualLrpCrash        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowActualLrpCrash          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupActualLrpCrash = fmt.Errorf("proto: unexpected end of group")
+)
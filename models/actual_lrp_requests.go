@@ -3,6 +3,16 @@ package models
 import "encoding/json"
 
 func (request *ActualLRPsRequest) Validate() error {
+	var validationError ValidationError
+
+	if request.IncludeCrashHistory && request.ProcessGuid == "" {
+		validationError = validationError.Append(ErrInvalidField{"process_guid"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
 	return nil
 }
 
@@ -16,10 +26,11 @@ func (request ActualLRPsRequest) IndexExists() bool {
 }
 
 type internalActualLRPsRequest struct {
-	Domain      string `json:"domain"`
-	CellId      string `json:"cell_id"`
-	ProcessGuid string `json:"process_guid"`
-	Index       *int32 `json:"index,omitempty"`
+	Domain              string `json:"domain"`
+	CellId              string `json:"cell_id"`
+	ProcessGuid         string `json:"process_guid"`
+	Index               *int32 `json:"index,omitempty"`
+	IncludeCrashHistory bool   `json:"include_crash_history,omitempty"`
 }
 
 func (request *ActualLRPsRequest) UnmarshalJSON(data []byte) error {
@@ -31,6 +42,7 @@ func (request *ActualLRPsRequest) UnmarshalJSON(data []byte) error {
 	request.Domain = internalRequest.Domain
 	request.CellId = internalRequest.CellId
 	request.ProcessGuid = internalRequest.ProcessGuid
+	request.IncludeCrashHistory = internalRequest.IncludeCrashHistory
 	if internalRequest.Index != nil {
 		request.SetIndex(*internalRequest.Index)
 	}
@@ -40,9 +52,10 @@ func (request *ActualLRPsRequest) UnmarshalJSON(data []byte) error {
 
 func (request ActualLRPsRequest) MarshalJSON() ([]byte, error) {
 	internalRequest := internalActualLRPsRequest{
-		Domain:      request.Domain,
-		CellId:      request.CellId,
-		ProcessGuid: request.ProcessGuid,
+		Domain:              request.Domain,
+		CellId:              request.CellId,
+		ProcessGuid:         request.ProcessGuid,
+		IncludeCrashHistory: request.IncludeCrashHistory,
 	}
 
 	if request.IndexExists() {
@@ -226,6 +239,22 @@ func (request *RetireActualLRPRequest) Validate() error {
 	return nil
 }
 
+func (request *RestartActualLRPRequest) Validate() error {
+	var validationError ValidationError
+
+	if request.ActualLrpKey == nil {
+		validationError = validationError.Append(ErrInvalidField{"actual_lrp_key"})
+	} else if err := request.ActualLrpKey.Validate(); err != nil {
+		validationError = validationError.Append(err)
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
 func (request *RemoveEvacuatingActualLRPRequest) Validate() error {
 	var validationError ValidationError
 
@@ -344,3 +373,21 @@ func (request *EvacuateRunningActualLRPRequest) Validate() error {
 
 	return nil
 }
+
+func (request *ActualLRPCrashesRequest) Validate() error {
+	var validationError ValidationError
+
+	if request.ProcessGuid == "" {
+		validationError = validationError.Append(ErrInvalidField{"process_guid"})
+	}
+
+	if request.Index < 0 {
+		validationError = validationError.Append(ErrInvalidField{"index"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
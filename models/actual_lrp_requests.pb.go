@@ -655,6 +655,49 @@ func (m *RetireActualLRPRequest) GetActualLrpKey() *ActualLRPKey {
 	return nil
 }
 
+type RestartActualLRPRequest struct {
+	ActualLrpKey *ActualLRPKey `protobuf:"bytes,1,opt,name=actual_lrp_key,json=actualLrpKey,proto3" json:"actual_lrp_key,omitempty"`
+}
+
+func (m *RestartActualLRPRequest) Reset()      { *m = RestartActualLRPRequest{} }
+func (*RestartActualLRPRequest) ProtoMessage() {}
+func (*RestartActualLRPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7753fd8557db809, []int{11}
+}
+func (m *RestartActualLRPRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestartActualLRPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestartActualLRPRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RestartActualLRPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestartActualLRPRequest.Merge(m, src)
+}
+func (m *RestartActualLRPRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestartActualLRPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestartActualLRPRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestartActualLRPRequest proto.InternalMessageInfo
+
+func (m *RestartActualLRPRequest) GetActualLrpKey() *ActualLRPKey {
+	if m != nil {
+		return m.ActualLrpKey
+	}
+	return nil
+}
+
 type RemoveActualLRPRequest struct {
 	ProcessGuid          string                `protobuf:"bytes,1,opt,name=process_guid,json=processGuid,proto3" json:"process_guid"`
 	Index                int32                 `protobuf:"varint,2,opt,name=index,proto3" json:"index"`
@@ -664,7 +707,7 @@ type RemoveActualLRPRequest struct {
 func (m *RemoveActualLRPRequest) Reset()      { *m = RemoveActualLRPRequest{} }
 func (*RemoveActualLRPRequest) ProtoMessage() {}
 func (*RemoveActualLRPRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a7753fd8557db809, []int{11}
+	return fileDescriptor_a7753fd8557db809, []int{12}
 }
 func (m *RemoveActualLRPRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -715,14 +758,15 @@ func (m *RemoveActualLRPRequest) GetActualLrpInstanceKey() *ActualLRPInstanceKey
 }
 
 type ActualLRPsResponse struct {
-	Error      *Error       `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
-	ActualLrps []*ActualLRP `protobuf:"bytes,2,rep,name=actual_lrps,json=actualLrps,proto3" json:"actual_lrps,omitempty"`
+	Error        *Error            `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	ActualLrps   []*ActualLRP      `protobuf:"bytes,2,rep,name=actual_lrps,json=actualLrps,proto3" json:"actual_lrps,omitempty"`
+	CrashHistory []*ActualLRPCrash `protobuf:"bytes,3,rep,name=crash_history,json=crashHistory,proto3" json:"crash_history,omitempty"`
 }
 
 func (m *ActualLRPsResponse) Reset()      { *m = ActualLRPsResponse{} }
 func (*ActualLRPsResponse) ProtoMessage() {}
 func (*ActualLRPsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a7753fd8557db809, []int{12}
+	return fileDescriptor_a7753fd8557db809, []int{13}
 }
 func (m *ActualLRPsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -765,19 +809,27 @@ func (m *ActualLRPsResponse) GetActualLrps() []*ActualLRP {
 	return nil
 }
 
+func (m *ActualLRPsResponse) GetCrashHistory() []*ActualLRPCrash {
+	if m != nil {
+		return m.CrashHistory
+	}
+	return nil
+}
+
 type ActualLRPsRequest struct {
 	Domain      string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain"`
 	CellId      string `protobuf:"bytes,2,opt,name=cell_id,json=cellId,proto3" json:"cell_id"`
 	ProcessGuid string `protobuf:"bytes,3,opt,name=process_guid,json=processGuid,proto3" json:"process_guid"`
 	// Types that are valid to be assigned to OptionalIndex:
 	//	*ActualLRPsRequest_Index
-	OptionalIndex isActualLRPsRequest_OptionalIndex `protobuf_oneof:"optional_index"`
+	OptionalIndex       isActualLRPsRequest_OptionalIndex `protobuf_oneof:"optional_index"`
+	IncludeCrashHistory bool                              `protobuf:"varint,5,opt,name=include_crash_history,json=includeCrashHistory,proto3" json:"include_crash_history"`
 }
 
 func (m *ActualLRPsRequest) Reset()      { *m = ActualLRPsRequest{} }
 func (*ActualLRPsRequest) ProtoMessage() {}
 func (*ActualLRPsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a7753fd8557db809, []int{13}
+	return fileDescriptor_a7753fd8557db809, []int{14}
 }
 func (m *ActualLRPsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -854,6 +906,13 @@ func (m *ActualLRPsRequest) GetIndex() int32 {
 	return 0
 }
 
+func (m *ActualLRPsRequest) GetIncludeCrashHistory() bool {
+	if m != nil {
+		return m.IncludeCrashHistory
+	}
+	return false
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ActualLRPsRequest) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -861,6 +920,108 @@ func (*ActualLRPsRequest) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+type ActualLRPCrashesRequest struct {
+	ProcessGuid string `protobuf:"bytes,1,opt,name=process_guid,json=processGuid,proto3" json:"process_guid"`
+	Index       int32  `protobuf:"varint,2,opt,name=index,proto3" json:"index"`
+}
+
+func (m *ActualLRPCrashesRequest) Reset()      { *m = ActualLRPCrashesRequest{} }
+func (*ActualLRPCrashesRequest) ProtoMessage() {}
+func (*ActualLRPCrashesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7753fd8557db809, []int{15}
+}
+func (m *ActualLRPCrashesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActualLRPCrashesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActualLRPCrashesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActualLRPCrashesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActualLRPCrashesRequest.Merge(m, src)
+}
+func (m *ActualLRPCrashesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActualLRPCrashesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActualLRPCrashesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActualLRPCrashesRequest proto.InternalMessageInfo
+
+func (m *ActualLRPCrashesRequest) GetProcessGuid() string {
+	if m != nil {
+		return m.ProcessGuid
+	}
+	return ""
+}
+
+func (m *ActualLRPCrashesRequest) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+type ActualLRPCrashesResponse struct {
+	Error   *Error            `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Crashes []*ActualLRPCrash `protobuf:"bytes,2,rep,name=crashes,proto3" json:"crashes,omitempty"`
+}
+
+func (m *ActualLRPCrashesResponse) Reset()      { *m = ActualLRPCrashesResponse{} }
+func (*ActualLRPCrashesResponse) ProtoMessage() {}
+func (*ActualLRPCrashesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7753fd8557db809, []int{16}
+}
+func (m *ActualLRPCrashesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActualLRPCrashesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActualLRPCrashesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActualLRPCrashesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActualLRPCrashesResponse.Merge(m, src)
+}
+func (m *ActualLRPCrashesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActualLRPCrashesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActualLRPCrashesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActualLRPCrashesResponse proto.InternalMessageInfo
+
+func (m *ActualLRPCrashesResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *ActualLRPCrashesResponse) GetCrashes() []*ActualLRPCrash {
+	if m != nil {
+		return m.Crashes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ActualLRPLifecycleResponse)(nil), "models.ActualLRPLifecycleResponse")
 	proto.RegisterType((*ActualLRPGroupsResponse)(nil), "models.ActualLRPGroupsResponse")
@@ -874,69 +1035,78 @@ func init() {
 	proto.RegisterType((*CrashActualLRPRequest)(nil), "models.CrashActualLRPRequest")
 	proto.RegisterType((*FailActualLRPRequest)(nil), "models.FailActualLRPRequest")
 	proto.RegisterType((*RetireActualLRPRequest)(nil), "models.RetireActualLRPRequest")
+	proto.RegisterType((*RestartActualLRPRequest)(nil), "models.RestartActualLRPRequest")
 	proto.RegisterType((*RemoveActualLRPRequest)(nil), "models.RemoveActualLRPRequest")
 	proto.RegisterType((*ActualLRPsResponse)(nil), "models.ActualLRPsResponse")
 	proto.RegisterType((*ActualLRPsRequest)(nil), "models.ActualLRPsRequest")
+	proto.RegisterType((*ActualLRPCrashesRequest)(nil), "models.ActualLRPCrashesRequest")
+	proto.RegisterType((*ActualLRPCrashesResponse)(nil), "models.ActualLRPCrashesResponse")
 }
 
 func init() { proto.RegisterFile("actual_lrp_requests.proto", fileDescriptor_a7753fd8557db809) }
 
 var fileDescriptor_a7753fd8557db809 = []byte{
-	// 851 bytes of a gzipped FileDescriptorProto
+	// 951 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x38, 0xb1, 0x5b, 0x3f, 0xa7, 0xa9, 0xbd, 0xcd, 0x8f, 0xc5, 0xaa, 0xd6, 0x61, 0xcb,
-	0x21, 0x42, 0xaa, 0x2b, 0xa5, 0x08, 0xa1, 0x48, 0x48, 0xc4, 0xa8, 0xa4, 0x56, 0xd3, 0xaa, 0x9a,
-	0xf6, 0x04, 0x12, 0xab, 0xb1, 0x3d, 0x76, 0x47, 0xec, 0xee, 0x98, 0x99, 0xd9, 0x08, 0x73, 0x42,
-	0x42, 0xea, 0x81, 0x13, 0x7f, 0x06, 0x7f, 0x07, 0x1c, 0xe0, 0x98, 0x03, 0x87, 0x9e, 0xac, 0xc6,
-	0xb9, 0x20, 0x9f, 0xfa, 0x27, 0xa0, 0x9d, 0xf1, 0x6e, 0xd6, 0xde, 0x82, 0x1a, 0x08, 0x12, 0x3d,
-	0xed, 0xbc, 0x6f, 0xde, 0x7c, 0xdf, 0x37, 0x6f, 0xdf, 0xcc, 0x2e, 0xbc, 0x43, 0x7a, 0x2a, 0x22,
-	0xbe, 0xe7, 0x8b, 0x91, 0x27, 0xe8, 0xd7, 0x11, 0x95, 0x4a, 0xb6, 0x46, 0x82, 0x2b, 0x6e, 0x95,
-	0x03, 0xde, 0xa7, 0xbe, 0x6c, 0xdc, 0x1e, 0x32, 0xf5, 0x2c, 0xea, 0xb6, 0x7a, 0x3c, 0xb8, 0x33,
-	0xe4, 0x43, 0x7e, 0x47, 0x4f, 0x77, 0xa3, 0x81, 0x8e, 0x74, 0xa0, 0x47, 0x66, 0x59, 0xa3, 0x76,
-	0xce, 0x38, 0x47, 0xaa, 0x54, 0x08, 0x2e, 0x4c, 0xe0, 0x1e, 0x40, 0xe3, 0x40, 0x27, 0x1c, 0xe1,
-	0xc7, 0x47, 0x6c, 0x40, 0x7b, 0xe3, 0x9e, 0x4f, 0x31, 0x95, 0x23, 0x1e, 0x4a, 0x6a, 0xdd, 0x82,
-	0x92, 0x4e, 0xb6, 0xd1, 0x0e, 0xda, 0xad, 0xee, 0x5d, 0x6b, 0x19, 0x0f, 0xad, 0x7b, 0x31, 0x88,
-	0xcd, 0x9c, 0xfb, 0x1c, 0xc1, 0x76, 0xca, 0x71, 0x28, 0x78, 0x34, 0x92, 0x17, 0x22, 0xb0, 0xda,
-	0x50, 0xcf, 0x6c, 0x7b, 0xa8, 0x19, 0xec, 0xe2, 0xce, 0xca, 0x6e, 0x75, 0x6f, 0x2b, 0x59, 0xb0,
-	0x28, 0x80, 0xaf, 0x9b, 0x05, 0x47, 0x62, 0x64, 0x04, 0xf7, 0x8b, 0x36, 0x72, 0xbf, 0x47, 0xb0,
-	0xb5, 0x94, 0x77, 0x21, 0x1f, 0x9f, 0x40, 0x6d, 0xd9, 0x87, 0x5d, 0xd4, 0xf9, 0x7f, 0x65, 0x63,
-	0x7d, 0xd1, 0x86, 0x76, 0x31, 0x58, 0x36, 0x21, 0xb1, 0x79, 0x91, 0x96, 0x0b, 0xe5, 0x3e, 0x0f,
-	0x08, 0x0b, 0xb5, 0x8b, 0x4a, 0x1b, 0x66, 0x93, 0xe6, 0x1c, 0xc1, 0xf3, 0xa7, 0xf5, 0x1e, 0x5c,
-	0xe9, 0x51, 0xdf, 0xf7, 0x58, 0x5f, 0x4b, 0x57, 0xda, 0xd5, 0xd9, 0xa4, 0x99, 0x40, 0xb8, 0x1c,
-	0x0f, 0x3a, 0x7d, 0xad, 0xf3, 0x25, 0xdc, 0x5a, 0xd2, 0x69, 0x8f, 0x1f, 0x0b, 0xde, 0xa3, 0x52,
-	0x1e, 0x46, 0xac, 0x9f, 0x88, 0xde, 0x85, 0xb5, 0x91, 0x41, 0xbd, 0x61, 0xc4, 0xfa, 0x73, 0xe9,
-	0xda, 0x6c, 0xd2, 0x5c, 0xc0, 0x71, 0x75, 0x74, 0xbe, 0x56, 0xf3, 0x3f, 0x47, 0xf0, 0xfe, 0xa2,
-	0xc0, 0x02, 0xff, 0x41, 0xd8, 0xef, 0x84, 0x7d, 0xfa, 0xcd, 0xbf, 0xd1, 0xb1, 0x9a, 0x50, 0x62,
-	0x31, 0x89, 0xde, 0x6b, 0xa9, 0x5d, 0x99, 0x4d, 0x9a, 0x06, 0xc0, 0xe6, 0xa1, 0x8d, 0xfc, 0x8c,
-	0x60, 0xf3, 0x53, 0x9f, 0xb0, 0x20, 0x75, 0xf3, 0x9f, 0x6a, 0x5a, 0x4f, 0x60, 0x3b, 0xd3, 0x06,
-	0x2c, 0x94, 0x8a, 0x84, 0x3d, 0xea, 0x7d, 0x45, 0xc7, 0xf6, 0x8a, 0xee, 0x86, 0x9b, 0xb9, 0x6e,
-	0xe8, 0xcc, 0x93, 0x1e, 0xd0, 0x31, 0xde, 0x48, 0x7b, 0x22, 0x83, 0xba, 0xbf, 0xaf, 0xc2, 0xe6,
-	0x13, 0x45, 0x84, 0xca, 0x6d, 0x62, 0x1f, 0xd6, 0x33, 0x72, 0xb1, 0x8a, 0xe9, 0xd1, 0x8d, 0x9c,
-	0x4a, 0xcc, 0xbe, 0x96, 0xb2, 0x3f, 0xa0, 0xe3, 0xbf, 0xb3, 0x5a, 0xfc, 0xa7, 0x56, 0xad, 0x43,
-	0xb8, 0x91, 0x21, 0x0d, 0xa9, 0xf2, 0x58, 0x38, 0xe0, 0xf3, 0xbd, 0xdb, 0x39, 0xc2, 0x47, 0x54,
-	0x75, 0xc2, 0x01, 0xc7, 0xb5, 0x94, 0x6c, 0x8e, 0x58, 0x5f, 0x40, 0x63, 0xc1, 0x9d, 0xa2, 0x22,
-	0x24, 0xbe, 0x27, 0x78, 0xa4, 0xa8, 0xb4, 0x57, 0xf5, 0x01, 0x77, 0x5e, 0x63, 0xd0, 0xe4, 0xe1,
-	0x38, 0x0d, 0x6f, 0x67, 0x2c, 0x66, 0x70, 0x69, 0x3d, 0x82, 0x6a, 0x40, 0x95, 0x60, 0x3d, 0x4f,
-	0x91, 0xa1, 0xb4, 0x4b, 0x9a, 0xed, 0x76, 0xc2, 0xf6, 0xda, 0x52, 0xb7, 0x1e, 0xea, 0x05, 0x4f,
-	0xc9, 0x50, 0xde, 0x0b, 0x95, 0x18, 0x63, 0x08, 0x52, 0xc0, 0xba, 0x09, 0x57, 0x63, 0x66, 0xd2,
-	0xf5, 0xa9, 0x5d, 0xde, 0x41, 0xbb, 0x57, 0xef, 0x17, 0x70, 0x8a, 0xe8, 0x2b, 0xea, 0x98, 0x30,
-	0x9f, 0x74, 0x99, 0xcf, 0xd4, 0xd8, 0xfb, 0x96, 0x87, 0xd4, 0xbe, 0xa2, 0xdb, 0x6d, 0x73, 0x36,
-	0x69, 0xe6, 0x27, 0x71, 0x2d, 0x0b, 0x7d, 0xce, 0x43, 0xda, 0xf8, 0x18, 0xae, 0x2f, 0x19, 0xb0,
-	0x6a, 0xb0, 0x92, 0xbc, 0xf0, 0x0a, 0x8e, 0x87, 0xd6, 0x06, 0x94, 0x8e, 0x89, 0x1f, 0x51, 0x73,
-	0xfa, 0xb1, 0x09, 0xf6, 0x8b, 0x1f, 0xa1, 0xf6, 0x0d, 0xa8, 0xf3, 0x91, 0x62, 0x3c, 0x29, 0x61,
-	0xec, 0xcb, 0x7d, 0x19, 0x9f, 0x0d, 0x41, 0xe4, 0xb3, 0xff, 0x7f, 0x5b, 0x7d, 0x08, 0xd7, 0xf4,
-	0x35, 0xeb, 0x05, 0x54, 0x4a, 0x32, 0xa4, 0xba, 0xa1, 0x2a, 0xed, 0xfa, 0x6c, 0xd2, 0x5c, 0x9c,
-	0xc0, 0x6b, 0x3a, 0x7c, 0x68, 0x22, 0xf7, 0x07, 0x04, 0x1b, 0x9f, 0x11, 0xe6, 0x5f, 0xea, 0x0e,
-	0x73, 0x66, 0x8a, 0x6f, 0x66, 0xe6, 0x29, 0x6c, 0x61, 0xaa, 0x98, 0xa0, 0x97, 0xe9, 0xc6, 0xfd,
-	0x05, 0xc5, 0xb4, 0x01, 0x3f, 0xa6, 0x6f, 0xf3, 0x15, 0x17, 0x80, 0x95, 0x66, 0x5f, 0xf0, 0x0f,
-	0x60, 0x0f, 0xaa, 0xe7, 0x7e, 0x92, 0x6f, 0x7f, 0x3d, 0xe7, 0x01, 0x43, 0x2a, 0x2c, 0xdd, 0x5f,
-	0x11, 0xd4, 0xb3, 0x7a, 0x97, 0xfc, 0x8d, 0xcd, 0x55, 0x7e, 0xe5, 0x4d, 0x2a, 0xff, 0x6e, 0x52,
-	0xf9, 0xd5, 0xa5, 0xca, 0xdf, 0x2f, 0xcc, 0x6b, 0xdf, 0xae, 0xc1, 0x7a, 0x7a, 0x8e, 0x0d, 0xf2,
-	0xc1, 0xc9, 0xa9, 0x53, 0x78, 0x71, 0xea, 0x14, 0x5e, 0x9d, 0x3a, 0xe8, 0xbb, 0xa9, 0x83, 0x7e,
-	0x9a, 0x3a, 0xe8, 0xb7, 0xa9, 0x83, 0x4e, 0xa6, 0x0e, 0x7a, 0x39, 0x75, 0xd0, 0x1f, 0x53, 0xa7,
-	0xf0, 0x6a, 0xea, 0xa0, 0x1f, 0xcf, 0x9c, 0xc2, 0xc9, 0x99, 0x53, 0x78, 0x71, 0xe6, 0x14, 0xba,
-	0x65, 0xfd, 0x03, 0x77, 0xf7, 0xcf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xee, 0x85, 0x3a, 0xee, 0x33,
-	0x0a, 0x00, 0x00,
+	0x14, 0xf6, 0x38, 0xb5, 0x53, 0x3f, 0x27, 0xa9, 0xbd, 0xf9, 0xb5, 0xb5, 0xaa, 0x75, 0xd8, 0x72,
+	0x88, 0x90, 0xea, 0xa2, 0x14, 0x21, 0x14, 0x84, 0x44, 0xb6, 0x2a, 0x49, 0xd4, 0xa4, 0xaa, 0xa6,
+	0xe5, 0x02, 0x12, 0xab, 0xf5, 0x7a, 0xec, 0x8c, 0x58, 0xef, 0xb8, 0x3b, 0xb3, 0x11, 0xe6, 0x84,
+	0x84, 0xd4, 0x03, 0x27, 0x4e, 0xfc, 0x0d, 0x88, 0x3f, 0x03, 0x2e, 0x1c, 0x73, 0xe0, 0xd0, 0x93,
+	0xd5, 0x38, 0x17, 0xe4, 0x53, 0xff, 0x04, 0xb4, 0x33, 0xbb, 0x9b, 0xb5, 0x37, 0x54, 0x0d, 0xa4,
+	0x12, 0x9c, 0xbc, 0xf3, 0xed, 0x9b, 0xef, 0xfb, 0x66, 0xdf, 0x9b, 0xe7, 0x07, 0x37, 0x1d, 0x57,
+	0x84, 0x8e, 0x67, 0x7b, 0xc1, 0xc0, 0x0e, 0xc8, 0xb3, 0x90, 0x70, 0xc1, 0x5b, 0x83, 0x80, 0x09,
+	0xa6, 0x95, 0xfb, 0xac, 0x43, 0x3c, 0xde, 0xb8, 0xd3, 0xa3, 0xe2, 0x28, 0x6c, 0xb7, 0x5c, 0xd6,
+	0xbf, 0xdb, 0x63, 0x3d, 0x76, 0x57, 0xbe, 0x6e, 0x87, 0x5d, 0xb9, 0x92, 0x0b, 0xf9, 0xa4, 0xb6,
+	0x35, 0x6a, 0xe7, 0x8c, 0x31, 0xb2, 0x96, 0xd1, 0x70, 0x03, 0x87, 0x1f, 0xc5, 0x78, 0x95, 0x04,
+	0x01, 0x0b, 0xd4, 0xc2, 0xdc, 0x81, 0xc6, 0x8e, 0x0c, 0x3b, 0xc0, 0x8f, 0x0f, 0x68, 0x97, 0xb8,
+	0x43, 0xd7, 0x23, 0x98, 0xf0, 0x01, 0xf3, 0x39, 0xd1, 0x6e, 0x43, 0x49, 0x06, 0xeb, 0x68, 0x03,
+	0x6d, 0x56, 0xb7, 0x16, 0x5b, 0xca, 0x5b, 0xeb, 0x41, 0x04, 0x62, 0xf5, 0xce, 0x7c, 0x8e, 0x60,
+	0x3d, 0xe5, 0xd8, 0x0d, 0x58, 0x38, 0xe0, 0x97, 0x22, 0xd0, 0x2c, 0xa8, 0x67, 0xac, 0xf6, 0x24,
+	0x83, 0x5e, 0xdc, 0x98, 0xdb, 0xac, 0x6e, 0xad, 0x25, 0x1b, 0xa6, 0x05, 0xf0, 0x0d, 0xb5, 0xe1,
+	0x20, 0x18, 0x28, 0xc1, 0xed, 0xa2, 0x8e, 0xcc, 0xef, 0x11, 0xac, 0xcd, 0xc4, 0x5d, 0xca, 0xc7,
+	0xa7, 0x50, 0x9b, 0xf5, 0xa1, 0x17, 0x37, 0xd0, 0x6b, 0x6c, 0x2c, 0x4d, 0xdb, 0x90, 0x2e, 0xba,
+	0xb3, 0x26, 0x38, 0x56, 0x09, 0xd6, 0x4c, 0x28, 0x77, 0x58, 0xdf, 0xa1, 0xbe, 0x74, 0x51, 0xb1,
+	0x60, 0x32, 0x6a, 0xc6, 0x08, 0x8e, 0x7f, 0xb5, 0x77, 0x61, 0xde, 0x25, 0x9e, 0x67, 0xd3, 0x8e,
+	0x94, 0xae, 0x58, 0xd5, 0xc9, 0xa8, 0x99, 0x40, 0xb8, 0x1c, 0x3d, 0xec, 0x77, 0xa4, 0xce, 0x57,
+	0x70, 0x7b, 0x46, 0xc7, 0x1a, 0x3e, 0x0e, 0x98, 0x4b, 0x38, 0xdf, 0x0d, 0x69, 0x27, 0x11, 0xbd,
+	0x07, 0x0b, 0x03, 0x85, 0xda, 0xbd, 0x90, 0x76, 0x62, 0xe9, 0xda, 0x64, 0xd4, 0x9c, 0xc2, 0x71,
+	0x75, 0x70, 0xbe, 0x57, 0xf2, 0x3f, 0x47, 0xf0, 0xde, 0xb4, 0xc0, 0x14, 0xff, 0x8e, 0xdf, 0xd9,
+	0xf7, 0x3b, 0xe4, 0x9b, 0x7f, 0xa3, 0xa3, 0x35, 0xa1, 0x44, 0x23, 0x12, 0x79, 0xd6, 0x92, 0x55,
+	0x99, 0x8c, 0x9a, 0x0a, 0xc0, 0xea, 0x47, 0x1a, 0xf9, 0x15, 0xc1, 0xea, 0x7d, 0xcf, 0xa1, 0xfd,
+	0xd4, 0xcd, 0x5b, 0xd5, 0xd4, 0x9e, 0xc0, 0x7a, 0xa6, 0x0c, 0xa8, 0xcf, 0x85, 0xe3, 0xbb, 0xc4,
+	0xfe, 0x9a, 0x0c, 0xf5, 0x39, 0x59, 0x0d, 0xb7, 0x72, 0xd5, 0xb0, 0x1f, 0x07, 0x3d, 0x24, 0x43,
+	0xbc, 0x92, 0xd6, 0x44, 0x06, 0x35, 0xff, 0xb8, 0x06, 0xab, 0x4f, 0x84, 0x13, 0x88, 0xdc, 0x21,
+	0xb6, 0x61, 0x29, 0x23, 0x17, 0xa9, 0xa8, 0x1a, 0x5d, 0xc9, 0xa9, 0x44, 0xec, 0x0b, 0x29, 0xfb,
+	0x43, 0x32, 0x7c, 0x9d, 0xd5, 0xe2, 0x3f, 0xb5, 0xaa, 0xed, 0xc2, 0x72, 0x86, 0xd4, 0x27, 0xc2,
+	0xa6, 0x7e, 0x97, 0xc5, 0x67, 0xd7, 0x73, 0x84, 0x8f, 0x88, 0xd8, 0xf7, 0xbb, 0x0c, 0xd7, 0x52,
+	0xb2, 0x18, 0xd1, 0xbe, 0x84, 0xc6, 0x94, 0x3b, 0x41, 0x02, 0xdf, 0xf1, 0xec, 0x80, 0x85, 0x82,
+	0x70, 0xfd, 0x9a, 0xbc, 0xe0, 0xc6, 0x05, 0x06, 0x55, 0x1c, 0x8e, 0xc2, 0xf0, 0x7a, 0xc6, 0x62,
+	0x06, 0xe7, 0xda, 0x23, 0xa8, 0xf6, 0x89, 0x08, 0xa8, 0x6b, 0x0b, 0xa7, 0xc7, 0xf5, 0x92, 0x64,
+	0xbb, 0x93, 0xb0, 0x5d, 0xf8, 0xa9, 0x5b, 0x87, 0x72, 0xc3, 0x53, 0xa7, 0xc7, 0x1f, 0xf8, 0x22,
+	0x18, 0x62, 0xe8, 0xa7, 0x80, 0x76, 0x0b, 0xae, 0x47, 0xcc, 0x4e, 0xdb, 0x23, 0x7a, 0x79, 0x03,
+	0x6d, 0x5e, 0xdf, 0x2b, 0xe0, 0x14, 0x91, 0x2d, 0xea, 0xd8, 0xa1, 0x9e, 0xd3, 0xa6, 0x1e, 0x15,
+	0x43, 0xfb, 0x5b, 0xe6, 0x13, 0x7d, 0x5e, 0x96, 0xdb, 0xea, 0x64, 0xd4, 0xcc, 0xbf, 0xc4, 0xb5,
+	0x2c, 0xf4, 0x05, 0xf3, 0x49, 0xe3, 0x13, 0xb8, 0x31, 0x63, 0x40, 0xab, 0xc1, 0x5c, 0x92, 0xf0,
+	0x0a, 0x8e, 0x1e, 0xb5, 0x15, 0x28, 0x1d, 0x3b, 0x5e, 0x48, 0xd4, 0xed, 0xc7, 0x6a, 0xb1, 0x5d,
+	0xfc, 0x08, 0x59, 0xcb, 0x50, 0x67, 0x03, 0x41, 0x59, 0xf2, 0x09, 0x23, 0x5f, 0xe6, 0xcb, 0xe8,
+	0x6e, 0x44, 0xbd, 0xfd, 0xbf, 0x5f, 0x56, 0x1f, 0xc2, 0xa2, 0x6c, 0xb3, 0x76, 0x9f, 0x70, 0xee,
+	0xf4, 0x88, 0x2c, 0xa8, 0x8a, 0x55, 0x9f, 0x8c, 0x9a, 0xd3, 0x2f, 0xf0, 0x82, 0x5c, 0x1e, 0xaa,
+	0x95, 0xf9, 0x03, 0x82, 0x95, 0xcf, 0x1c, 0xea, 0x5d, 0xe9, 0x09, 0x73, 0x66, 0x8a, 0x6f, 0x66,
+	0xe6, 0x29, 0xac, 0x61, 0x22, 0x68, 0x40, 0xae, 0xd2, 0x8d, 0xf9, 0x39, 0xac, 0x63, 0xc2, 0xaf,
+	0xba, 0x3b, 0x98, 0xbf, 0xa1, 0xc8, 0x6d, 0x9f, 0x1d, 0x93, 0xff, 0x73, 0xe7, 0xfc, 0x05, 0x81,
+	0x96, 0x86, 0x5f, 0x72, 0xb2, 0xd8, 0x82, 0xea, 0xb9, 0xa1, 0x64, 0xa6, 0xa8, 0xe7, 0x4c, 0x60,
+	0x48, 0x95, 0xb9, 0xf6, 0x31, 0x2c, 0xca, 0x69, 0xc9, 0x3e, 0xa2, 0x5c, 0xb0, 0x20, 0xb2, 0x7e,
+	0xf1, 0x24, 0x22, 0xef, 0x1d, 0x5e, 0x90, 0xc1, 0x7b, 0x2a, 0xd6, 0xfc, 0xa9, 0x08, 0xf5, 0xac,
+	0xd9, 0x2b, 0xfe, 0xe3, 0xcf, 0xe5, 0x6d, 0xee, 0x4d, 0xf2, 0xf6, 0x4e, 0x92, 0xb7, 0x6b, 0x33,
+	0x79, 0xdb, 0x2b, 0x24, 0x99, 0x3b, 0x84, 0x55, 0xea, 0xbb, 0x5e, 0xd8, 0x21, 0xf6, 0xf4, 0xe1,
+	0x4b, 0x51, 0x2b, 0xb4, 0x6e, 0x4e, 0x46, 0xcd, 0x8b, 0x03, 0xf0, 0x72, 0x0c, 0xdf, 0xcf, 0x7c,
+	0x06, 0xab, 0x06, 0x4b, 0x69, 0xaf, 0x92, 0x02, 0x26, 0xcb, 0xcc, 0x88, 0x32, 0x94, 0xf0, 0xb7,
+	0x5a, 0x8b, 0xe6, 0x33, 0xd0, 0xf3, 0x82, 0x97, 0xa9, 0x9d, 0xf7, 0x61, 0xde, 0x55, 0xfb, 0xfe,
+	0x76, 0x16, 0x55, 0x15, 0x90, 0x84, 0x59, 0x1f, 0x9c, 0x9c, 0x1a, 0x85, 0x17, 0xa7, 0x46, 0xe1,
+	0xd5, 0xa9, 0x81, 0xbe, 0x1b, 0x1b, 0xe8, 0xe7, 0xb1, 0x81, 0x7e, 0x1f, 0x1b, 0xe8, 0x64, 0x6c,
+	0xa0, 0x97, 0x63, 0x03, 0xfd, 0x39, 0x36, 0x0a, 0xaf, 0xc6, 0x06, 0xfa, 0xf1, 0xcc, 0x28, 0x9c,
+	0x9c, 0x19, 0x85, 0x17, 0x67, 0x46, 0xa1, 0x5d, 0x96, 0x83, 0xf8, 0xbd, 0xbf, 0x06, 0x00, 0xb9,
+	0x7b, 0x24, 0x13, 0x13, 0x0c, 0x00, 0x00,
 }
 
 func (this *ActualLRPLifecycleResponse) Equal(that interface{}) bool {
@@ -1293,6 +1463,30 @@ func (this *RetireActualLRPRequest) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *RestartActualLRPRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RestartActualLRPRequest)
+	if !ok {
+		that2, ok := that.(RestartActualLRPRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ActualLrpKey.Equal(that1.ActualLrpKey) {
+		return false
+	}
+	return true
+}
 func (this *RemoveActualLRPRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1353,6 +1547,14 @@ func (this *ActualLRPsResponse) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.CrashHistory) != len(that1.CrashHistory) {
+		return false
+	}
+	for i := range this.CrashHistory {
+		if !this.CrashHistory[i].Equal(that1.CrashHistory[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *ActualLRPsRequest) Equal(that interface{}) bool {
@@ -1392,6 +1594,9 @@ func (this *ActualLRPsRequest) Equal(that interface{}) bool {
 	} else if !this.OptionalIndex.Equal(that1.OptionalIndex) {
 		return false
 	}
+	if this.IncludeCrashHistory != that1.IncludeCrashHistory {
+		return false
+	}
 	return true
 }
 func (this *ActualLRPsRequest_Index) Equal(that interface{}) bool {
@@ -1418,6 +1623,65 @@ func (this *ActualLRPsRequest_Index) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ActualLRPCrashesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ActualLRPCrashesRequest)
+	if !ok {
+		that2, ok := that.(ActualLRPCrashesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProcessGuid != that1.ProcessGuid {
+		return false
+	}
+	if this.Index != that1.Index {
+		return false
+	}
+	return true
+}
+func (this *ActualLRPCrashesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ActualLRPCrashesResponse)
+	if !ok {
+		that2, ok := that.(ActualLRPCrashesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if len(this.Crashes) != len(that1.Crashes) {
+		return false
+	}
+	for i := range this.Crashes {
+		if !this.Crashes[i].Equal(that1.Crashes[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *ActualLRPLifecycleResponse) GoString() string {
 	if this == nil {
 		return "nil"
@@ -1593,6 +1857,18 @@ func (this *RetireActualLRPRequest) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *RestartActualLRPRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.RestartActualLRPRequest{")
+	if this.ActualLrpKey != nil {
+		s = append(s, "ActualLrpKey: "+fmt.Sprintf("%#v", this.ActualLrpKey)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *RemoveActualLRPRequest) GoString() string {
 	if this == nil {
 		return "nil"
@@ -1611,7 +1887,7 @@ func (this *ActualLRPsResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 7)
 	s = append(s, "&models.ActualLRPsResponse{")
 	if this.Error != nil {
 		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
@@ -1619,6 +1895,9 @@ func (this *ActualLRPsResponse) GoString() string {
 	if this.ActualLrps != nil {
 		s = append(s, "ActualLrps: "+fmt.Sprintf("%#v", this.ActualLrps)+",\n")
 	}
+	if this.CrashHistory != nil {
+		s = append(s, "CrashHistory: "+fmt.Sprintf("%#v", this.CrashHistory)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1626,7 +1905,7 @@ func (this *ActualLRPsRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
+	s := make([]string, 0, 9)
 	s = append(s, "&models.ActualLRPsRequest{")
 	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
 	s = append(s, "CellId: "+fmt.Sprintf("%#v", this.CellId)+",\n")
@@ -1634,6 +1913,7 @@ func (this *ActualLRPsRequest) GoString() string {
 	if this.OptionalIndex != nil {
 		s = append(s, "OptionalIndex: "+fmt.Sprintf("%#v", this.OptionalIndex)+",\n")
 	}
+	s = append(s, "IncludeCrashHistory: "+fmt.Sprintf("%#v", this.IncludeCrashHistory)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1645,6 +1925,32 @@ func (this *ActualLRPsRequest_Index) GoString() string {
 		`Index:` + fmt.Sprintf("%#v", this.Index) + `}`}, ", ")
 	return s
 }
+func (this *ActualLRPCrashesRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.ActualLRPCrashesRequest{")
+	s = append(s, "ProcessGuid: "+fmt.Sprintf("%#v", this.ProcessGuid)+",\n")
+	s = append(s, "Index: "+fmt.Sprintf("%#v", this.Index)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ActualLRPCrashesResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.ActualLRPCrashesResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.Crashes != nil {
+		s = append(s, "Crashes: "+fmt.Sprintf("%#v", this.Crashes)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringActualLrpRequests(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -2189,6 +2495,41 @@ func (m *RetireActualLRPRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *RestartActualLRPRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestartActualLRPRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestartActualLRPRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActualLrpKey != nil {
+		{
+			size, err := m.ActualLrpKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintActualLrpRequests(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RemoveActualLRPRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2256,8 +2597,22 @@ func (m *ActualLRPsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.ActualLrps) > 0 {
-		for iNdEx := len(m.ActualLrps) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.CrashHistory) > 0 {
+		for iNdEx := len(m.CrashHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CrashHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintActualLrpRequests(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ActualLrps) > 0 {
+		for iNdEx := len(m.ActualLrps) - 1; iNdEx >= 0; iNdEx-- {
 			{
 				size, err := m.ActualLrps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
@@ -2305,6 +2660,16 @@ func (m *ActualLRPsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IncludeCrashHistory {
+		i--
+		if m.IncludeCrashHistory {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.OptionalIndex != nil {
 		{
 			size := m.OptionalIndex.Size()
@@ -2350,6 +2715,90 @@ func (m *ActualLRPsRequest_Index) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	dAtA[i] = 0x20
 	return len(dAtA) - i, nil
 }
+func (m *ActualLRPCrashesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActualLRPCrashesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActualLRPCrashesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		i = encodeVarintActualLrpRequests(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ProcessGuid) > 0 {
+		i -= len(m.ProcessGuid)
+		copy(dAtA[i:], m.ProcessGuid)
+		i = encodeVarintActualLrpRequests(dAtA, i, uint64(len(m.ProcessGuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActualLRPCrashesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActualLRPCrashesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActualLRPCrashesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Crashes) > 0 {
+		for iNdEx := len(m.Crashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Crashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintActualLrpRequests(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintActualLrpRequests(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintActualLrpRequests(dAtA []byte, offset int, v uint64) int {
 	offset -= sovActualLrpRequests(v)
 	base := offset
@@ -2578,6 +3027,19 @@ func (m *RetireActualLRPRequest) Size() (n int) {
 	return n
 }
 
+func (m *RestartActualLRPRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActualLrpKey != nil {
+		l = m.ActualLrpKey.Size()
+		n += 1 + l + sovActualLrpRequests(uint64(l))
+	}
+	return n
+}
+
 func (m *RemoveActualLRPRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2614,6 +3076,12 @@ func (m *ActualLRPsResponse) Size() (n int) {
 			n += 1 + l + sovActualLrpRequests(uint64(l))
 		}
 	}
+	if len(m.CrashHistory) > 0 {
+		for _, e := range m.CrashHistory {
+			l = e.Size()
+			n += 1 + l + sovActualLrpRequests(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2638,6 +3106,9 @@ func (m *ActualLRPsRequest) Size() (n int) {
 	if m.OptionalIndex != nil {
 		n += m.OptionalIndex.Size()
 	}
+	if m.IncludeCrashHistory {
+		n += 2
+	}
 	return n
 }
 
@@ -2650,6 +3121,40 @@ func (m *ActualLRPsRequest_Index) Size() (n int) {
 	n += 1 + sovActualLrpRequests(uint64(m.Index))
 	return n
 }
+func (m *ActualLRPCrashesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProcessGuid)
+	if l > 0 {
+		n += 1 + l + sovActualLrpRequests(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovActualLrpRequests(uint64(m.Index))
+	}
+	return n
+}
+
+func (m *ActualLRPCrashesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovActualLrpRequests(uint64(l))
+	}
+	if len(m.Crashes) > 0 {
+		for _, e := range m.Crashes {
+			l = e.Size()
+			n += 1 + l + sovActualLrpRequests(uint64(l))
+		}
+	}
+	return n
+}
 
 func sovActualLrpRequests(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
@@ -2812,6 +3317,16 @@ func (this *RetireActualLRPRequest) String() string {
 	}, "")
 	return s
 }
+func (this *RestartActualLRPRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RestartActualLRPRequest{`,
+		`ActualLrpKey:` + strings.Replace(fmt.Sprintf("%v", this.ActualLrpKey), "ActualLRPKey", "ActualLRPKey", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *RemoveActualLRPRequest) String() string {
 	if this == nil {
 		return "nil"
@@ -2833,9 +3348,15 @@ func (this *ActualLRPsResponse) String() string {
 		repeatedStringForActualLrps += strings.Replace(fmt.Sprintf("%v", f), "ActualLRP", "ActualLRP", 1) + ","
 	}
 	repeatedStringForActualLrps += "}"
+	repeatedStringForCrashHistory := "[]*ActualLRPCrash{"
+	for _, f := range this.CrashHistory {
+		repeatedStringForCrashHistory += strings.Replace(fmt.Sprintf("%v", f), "ActualLRPCrash", "ActualLRPCrash", 1) + ","
+	}
+	repeatedStringForCrashHistory += "}"
 	s := strings.Join([]string{`&ActualLRPsResponse{`,
 		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
 		`ActualLrps:` + repeatedStringForActualLrps + `,`,
+		`CrashHistory:` + repeatedStringForCrashHistory + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2849,6 +3370,7 @@ func (this *ActualLRPsRequest) String() string {
 		`CellId:` + fmt.Sprintf("%v", this.CellId) + `,`,
 		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
 		`OptionalIndex:` + fmt.Sprintf("%v", this.OptionalIndex) + `,`,
+		`IncludeCrashHistory:` + fmt.Sprintf("%v", this.IncludeCrashHistory) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2863,6 +3385,33 @@ func (this *ActualLRPsRequest_Index) String() string {
 	}, "")
 	return s
 }
+func (this *ActualLRPCrashesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ActualLRPCrashesRequest{`,
+		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ActualLRPCrashesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForCrashes := "[]*ActualLRPCrash{"
+	for _, f := range this.Crashes {
+		repeatedStringForCrashes += strings.Replace(fmt.Sprintf("%v", f), "ActualLRPCrash", "ActualLRPCrash", 1) + ","
+	}
+	repeatedStringForCrashes += "}"
+	s := strings.Join([]string{`&ActualLRPCrashesResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`Crashes:` + repeatedStringForCrashes + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringActualLrpRequests(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -4363,6 +4912,92 @@ func (m *RetireActualLRPRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RestartActualLRPRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowActualLrpRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestartActualLRPRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestartActualLRPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualLrpKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActualLrpKey == nil {
+				m.ActualLrpKey = &ActualLRPKey{}
+			}
+			if err := m.ActualLrpKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipActualLrpRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *RemoveActualLRPRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4599,6 +5234,40 @@ func (m *ActualLRPsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrashHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CrashHistory = append(m.CrashHistory, &ActualLRPCrash{})
+			if err := m.CrashHistory[len(m.CrashHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipActualLrpRequests(dAtA[iNdEx:])
@@ -4765,6 +5434,247 @@ func (m *ActualLRPsRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.OptionalIndex = &ActualLRPsRequest_Index{v}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeCrashHistory", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeCrashHistory = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipActualLrpRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActualLRPCrashesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowActualLrpRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActualLRPCrashesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActualLRPCrashesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipActualLrpRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActualLRPCrashesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowActualLrpRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActualLRPCrashesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActualLRPCrashesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Crashes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Crashes = append(m.Crashes, &ActualLRPCrash{})
+			if err := m.Crashes[len(m.Crashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipActualLrpRequests(dAtA[iNdEx:])
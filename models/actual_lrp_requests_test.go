@@ -23,6 +23,16 @@ var _ = Describe("ActualLRP Requests", func() {
 					Expect(request.Validate()).To(BeNil())
 				})
 			})
+
+			Context("when the crash history is included without a ProcessGuid", func() {
+				BeforeEach(func() {
+					request.IncludeCrashHistory = true
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"process_guid"}))
+				})
+			})
 		})
 
 		Describe("serialization", func() {
@@ -53,6 +63,67 @@ var _ = Describe("ActualLRP Requests", func() {
 				Expect(json.Unmarshal([]byte(expectedJSON), &testV)).To(Succeed())
 				Expect(testV).To(Equal(request))
 			})
+
+			Context("when the crash history is included", func() {
+				BeforeEach(func() {
+					request.IncludeCrashHistory = true
+
+					expectedJSON = `{
+						"domain": "cfapps",
+						"cell_id": "abc123",
+						"process_guid": "def456",
+						"index": 3,
+						"include_crash_history": true
+					}`
+				})
+
+				It("can marshal to JSON and back", func() {
+					Expect(json.Marshal(request)).To(MatchJSON(expectedJSON))
+
+					var testV models.ActualLRPsRequest
+					Expect(json.Unmarshal([]byte(expectedJSON), &testV)).To(Succeed())
+					Expect(testV).To(Equal(request))
+				})
+			})
+		})
+	})
+
+	Describe("ActualLRPCrashesRequest", func() {
+		Describe("Validate", func() {
+			var request models.ActualLRPCrashesRequest
+
+			BeforeEach(func() {
+				request = models.ActualLRPCrashesRequest{
+					ProcessGuid: "something",
+					Index:       1,
+				}
+			})
+
+			Context("when valid", func() {
+				It("returns nil", func() {
+					Expect(request.Validate()).To(BeNil())
+				})
+			})
+
+			Context("when the ProcessGuid is blank", func() {
+				BeforeEach(func() {
+					request.ProcessGuid = ""
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"process_guid"}))
+				})
+			})
+
+			Context("when the Index is negative", func() {
+				BeforeEach(func() {
+					request.Index = -1
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"index"}))
+				})
+			})
 		})
 	})
 
@@ -406,6 +477,44 @@ var _ = Describe("ActualLRP Requests", func() {
 		})
 	})
 
+	Describe("RestartActualLRPRequest", func() {
+		Describe("Validate", func() {
+			var request models.RestartActualLRPRequest
+
+			BeforeEach(func() {
+				request = models.RestartActualLRPRequest{
+					ActualLrpKey: &models.ActualLRPKey{ProcessGuid: "p-guid", Index: 2, Domain: "domain"},
+				}
+			})
+
+			Context("when valid", func() {
+				It("returns nil", func() {
+					Expect(request.Validate()).To(BeNil())
+				})
+			})
+
+			Context("when the ActualLrpKey is blank", func() {
+				BeforeEach(func() {
+					request.ActualLrpKey = nil
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"actual_lrp_key"}))
+				})
+			})
+
+			Context("when the ActualLrpKey is invalid", func() {
+				BeforeEach(func() {
+					request.ActualLrpKey.ProcessGuid = ""
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"process_guid"}))
+				})
+			})
+		})
+	})
+
 	Describe("FailActualLRPRequest", func() {
 		Describe("Validate", func() {
 			var request models.FailActualLRPRequest
@@ -142,6 +142,35 @@ var _ = Describe("RestartCalculator", func() {
 		})
 	})
 
+	Describe("NextRestartTime", func() {
+		var calc models.RestartCalculator
+
+		BeforeEach(func() {
+			calc = models.NewRestartCalculator(2, 2*time.Minute, 5)
+		})
+
+		It("restarts immediately for the first crashes", func() {
+			next, ok := calc.NextRestartTime(100, 1)
+			Expect(ok).To(BeTrue())
+			Expect(next).To(BeEquivalentTo(100))
+		})
+
+		It("backs off exponentially up to the max backoff duration", func() {
+			next, ok := calc.NextRestartTime(100, 2)
+			Expect(ok).To(BeTrue())
+			Expect(next).To(Equal(100 + (30 * time.Second).Nanoseconds()))
+
+			next, ok = calc.NextRestartTime(100, 4)
+			Expect(ok).To(BeTrue())
+			Expect(next).To(Equal(100 + (2 * time.Minute).Nanoseconds()))
+		})
+
+		It("gives up once the max restarts are reached", func() {
+			_, ok := calc.NextRestartTime(100, 5)
+			Expect(ok).To(BeFalse())
+		})
+	})
+
 	Describe("Validate", func() {
 		It("the default values are valid", func() {
 			calc := models.NewDefaultRestartCalculator()
@@ -152,6 +181,42 @@ var _ = Describe("RestartCalculator", func() {
 			calc := models.NewRestartCalculator(models.DefaultImmediateRestarts, models.CrashBackoffMinDuration-time.Second, models.DefaultMaxRestarts)
 			Expect(calc.Validate()).To(HaveOccurred())
 		})
+
+		It("invalid when ImmediateRestarts is negative", func() {
+			calc := models.NewRestartCalculator(-1, models.DefaultMaxBackoffDuration, models.DefaultMaxRestarts)
+			Expect(calc.Validate()).To(HaveOccurred())
+		})
+
+		It("invalid when MaxRestartAttempts is negative", func() {
+			calc := models.NewRestartCalculator(models.DefaultImmediateRestarts, models.DefaultMaxBackoffDuration, -1)
+			Expect(calc.Validate()).To(HaveOccurred())
+		})
+	})
+})
+
+var _ = Describe("RestartPolicy", func() {
+	Describe("RestartCalculator", func() {
+		It("returns the default calculator when there is no policy", func() {
+			var policy *models.RestartPolicy
+			Expect(policy.RestartCalculator()).To(Equal(models.NewDefaultRestartCalculator()))
+		})
+
+		It("returns the calculator for the policy", func() {
+			policy := &models.RestartPolicy{ImmediateRestarts: 1, MaxBackoffDurationMs: 120000, MaxRestarts: 10}
+			Expect(policy.RestartCalculator()).To(Equal(models.NewRestartCalculator(1, 2*time.Minute, 10)))
+		})
+	})
+
+	Describe("Validate", func() {
+		It("is valid when its calculator is valid", func() {
+			policy := models.RestartPolicy{ImmediateRestarts: 0, MaxBackoffDurationMs: 30000, MaxRestarts: 0}
+			Expect(policy.Validate()).NotTo(HaveOccurred())
+		})
+
+		It("is invalid when its calculator is invalid", func() {
+			policy := models.RestartPolicy{ImmediateRestarts: 1, MaxBackoffDurationMs: 1000, MaxRestarts: 10}
+			Expect(policy.Validate()).To(HaveOccurred())
+		})
 	})
 })
 
@@ -169,6 +234,15 @@ var _ = Describe("ActualLRP", func() {
 			Expect(actualLRP.AvailabilityZone).To(Equal("some-zone-1"))
 		})
 
+		It("updates the restart state", func() {
+			actualLRPInfo.NextRestartAt = 1234
+			actualLRPInfo.RestartsExhausted = true
+			actualLRP := actualLRPInfo.ToActualLRP(models.NewActualLRPKey("p-guid", 0, "domain"), models.NewActualLRPInstanceKey("i-1", "cell-1"))
+			Expect(actualLRP.NextRestartAt).To(BeEquivalentTo(1234))
+			Expect(actualLRP.RestartsExhausted).To(BeTrue())
+			Expect(actualLRP.ToActualLRPInfo()).To(Equal(&actualLRPInfo))
+		})
+
 		Context("when Routable is not provided", func() {
 			It("does not set routable", func() {
 				actualLRP := actualLRPInfo.ToActualLRP(models.NewActualLRPKey("p-guid", 0, "domain"), models.NewActualLRPInstanceKey("i-1", "cell-1"))
@@ -201,6 +275,40 @@ var _ = Describe("ActualLRP", func() {
 		})
 	})
 
+	Describe("ScheduleRestart", func() {
+		var (
+			actualLRP models.ActualLRP
+			calc      models.RestartCalculator
+		)
+
+		BeforeEach(func() {
+			actualLRP = models.ActualLRP{State: models.ActualLRPStateCrashed, Since: 100, CrashCount: 3}
+			calc = models.NewDefaultRestartCalculator()
+		})
+
+		It("records when a crashed lrp will be restarted", func() {
+			actualLRP.ScheduleRestart(calc)
+			Expect(actualLRP.NextRestartAt).To(Equal(100 + (30 * time.Second).Nanoseconds()))
+			Expect(actualLRP.RestartsExhausted).To(BeFalse())
+		})
+
+		It("records that a crashed lrp has exhausted its restarts", func() {
+			actualLRP.CrashCount = models.DefaultMaxRestarts
+			actualLRP.ScheduleRestart(calc)
+			Expect(actualLRP.NextRestartAt).To(BeZero())
+			Expect(actualLRP.RestartsExhausted).To(BeTrue())
+		})
+
+		It("clears the restart state of lrps that are not crashed", func() {
+			actualLRP.State = models.ActualLRPStateUnclaimed
+			actualLRP.NextRestartAt = 1234
+			actualLRP.RestartsExhausted = true
+			actualLRP.ScheduleRestart(calc)
+			Expect(actualLRP.NextRestartAt).To(BeZero())
+			Expect(actualLRP.RestartsExhausted).To(BeFalse())
+		})
+	})
+
 	Describe("ShouldRestartCrash", func() {
 		Context("when the lpr is CRASHED", func() {
 			const maxWaitTime = 16 * time.Minute
@@ -0,0 +1,81 @@
+package models
+
+import "sort"
+
+func (r *CellReservation) Add(memoryMB, diskMB int64) {
+	r.MemoryMb += memoryMB
+	r.DiskMb += diskMB
+	r.Containers++
+}
+
+func (u *CapacityUsage) add(cell *CellPresence, reservation *CellReservation) {
+	u.Cells++
+	if capacity := cell.GetCapacity(); capacity != nil {
+		u.TotalMemoryMb += int64(capacity.MemoryMb)
+		u.TotalDiskMb += int64(capacity.DiskMb)
+		u.TotalContainers += capacity.Containers
+	}
+	if reservation != nil {
+		u.ReservedMemoryMb += reservation.MemoryMb
+		u.ReservedDiskMb += reservation.DiskMb
+		u.ReservedContainers += reservation.Containers
+	}
+}
+
+// NewCapacityReport aggregates the capacity of the cells and the resources
+// reserved on them by zone and by placement tag. A cell counts towards each of
+// its required and optional placement tags, and reservations on cells that are
+// not in the set are ignored.
+func NewCapacityReport(cells CellSet, reservations map[string]*CellReservation) *CapacityReport {
+	total := &CapacityUsage{}
+	zones := map[string]*CapacityUsage{}
+	tags := map[string]*CapacityUsage{}
+
+	usageFor := func(usages map[string]*CapacityUsage, key string) *CapacityUsage {
+		usage, ok := usages[key]
+		if !ok {
+			usage = &CapacityUsage{}
+			usages[key] = usage
+		}
+		return usage
+	}
+
+	cells.Each(func(cell *CellPresence) {
+		reservation := reservations[cell.CellId]
+
+		total.add(cell, reservation)
+		usageFor(zones, cell.Zone).add(cell, reservation)
+
+		seen := map[string]struct{}{}
+		for _, tag := range append(append([]string{}, cell.PlacementTags...), cell.OptionalPlacementTags...) {
+			if _, ok := seen[tag]; ok {
+				continue
+			}
+			seen[tag] = struct{}{}
+			usageFor(tags, tag).add(cell, reservation)
+		}
+	})
+
+	report := &CapacityReport{
+		Total:         total,
+		Zones:         []*ZoneCapacity{},
+		PlacementTags: []*PlacementTagCapacity{},
+	}
+	for _, zone := range sortedUsageKeys(zones) {
+		report.Zones = append(report.Zones, &ZoneCapacity{Zone: zone, Usage: zones[zone]})
+	}
+	for _, tag := range sortedUsageKeys(tags) {
+		report.PlacementTags = append(report.PlacementTags, &PlacementTagCapacity{PlacementTag: tag, Usage: tags[tag]})
+	}
+
+	return report
+}
+
+func sortedUsageKeys(usages map[string]*CapacityUsage) []string {
+	keys := make([]string, 0, len(usages))
+	for key := range usages {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,1901 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: capacity.proto
+
+package models
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type CellReservation struct {
+	CellId     string `protobuf:"bytes,1,opt,name=cell_id,json=cellId,proto3" json:"cell_id"`
+	MemoryMb   int64  `protobuf:"varint,2,opt,name=memory_mb,json=memoryMb,proto3" json:"memory_mb"`
+	DiskMb     int64  `protobuf:"varint,3,opt,name=disk_mb,json=diskMb,proto3" json:"disk_mb"`
+	Containers int32  `protobuf:"varint,4,opt,name=containers,proto3" json:"containers"`
+}
+
+func (m *CellReservation) Reset()      { *m = CellReservation{} }
+func (*CellReservation) ProtoMessage() {}
+func (*CellReservation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b1740d9273701e7, []int{0}
+}
+func (m *CellReservation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CellReservation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CellReservation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CellReservation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CellReservation.Merge(m, src)
+}
+func (m *CellReservation) XXX_Size() int {
+	return m.Size()
+}
+func (m *CellReservation) XXX_DiscardUnknown() {
+	xxx_messageInfo_CellReservation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CellReservation proto.InternalMessageInfo
+
+func (m *CellReservation) GetCellId() string {
+	if m != nil {
+		return m.CellId
+	}
+	return ""
+}
+
+func (m *CellReservation) GetMemoryMb() int64 {
+	if m != nil {
+		return m.MemoryMb
+	}
+	return 0
+}
+
+func (m *CellReservation) GetDiskMb() int64 {
+	if m != nil {
+		return m.DiskMb
+	}
+	return 0
+}
+
+func (m *CellReservation) GetContainers() int32 {
+	if m != nil {
+		return m.Containers
+	}
+	return 0
+}
+
+type CapacityUsage struct {
+	Cells              int32 `protobuf:"varint,1,opt,name=cells,proto3" json:"cells"`
+	TotalMemoryMb      int64 `protobuf:"varint,2,opt,name=total_memory_mb,json=totalMemoryMb,proto3" json:"total_memory_mb"`
+	TotalDiskMb        int64 `protobuf:"varint,3,opt,name=total_disk_mb,json=totalDiskMb,proto3" json:"total_disk_mb"`
+	TotalContainers    int32 `protobuf:"varint,4,opt,name=total_containers,json=totalContainers,proto3" json:"total_containers"`
+	ReservedMemoryMb   int64 `protobuf:"varint,5,opt,name=reserved_memory_mb,json=reservedMemoryMb,proto3" json:"reserved_memory_mb"`
+	ReservedDiskMb     int64 `protobuf:"varint,6,opt,name=reserved_disk_mb,json=reservedDiskMb,proto3" json:"reserved_disk_mb"`
+	ReservedContainers int32 `protobuf:"varint,7,opt,name=reserved_containers,json=reservedContainers,proto3" json:"reserved_containers"`
+}
+
+func (m *CapacityUsage) Reset()      { *m = CapacityUsage{} }
+func (*CapacityUsage) ProtoMessage() {}
+func (*CapacityUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b1740d9273701e7, []int{1}
+}
+func (m *CapacityUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CapacityUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CapacityUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CapacityUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CapacityUsage.Merge(m, src)
+}
+func (m *CapacityUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *CapacityUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_CapacityUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CapacityUsage proto.InternalMessageInfo
+
+func (m *CapacityUsage) GetCells() int32 {
+	if m != nil {
+		return m.Cells
+	}
+	return 0
+}
+
+func (m *CapacityUsage) GetTotalMemoryMb() int64 {
+	if m != nil {
+		return m.TotalMemoryMb
+	}
+	return 0
+}
+
+func (m *CapacityUsage) GetTotalDiskMb() int64 {
+	if m != nil {
+		return m.TotalDiskMb
+	}
+	return 0
+}
+
+func (m *CapacityUsage) GetTotalContainers() int32 {
+	if m != nil {
+		return m.TotalContainers
+	}
+	return 0
+}
+
+func (m *CapacityUsage) GetReservedMemoryMb() int64 {
+	if m != nil {
+		return m.ReservedMemoryMb
+	}
+	return 0
+}
+
+func (m *CapacityUsage) GetReservedDiskMb() int64 {
+	if m != nil {
+		return m.ReservedDiskMb
+	}
+	return 0
+}
+
+func (m *CapacityUsage) GetReservedContainers() int32 {
+	if m != nil {
+		return m.ReservedContainers
+	}
+	return 0
+}
+
+type ZoneCapacity struct {
+	Zone  string         `protobuf:"bytes,1,opt,name=zone,proto3" json:"zone"`
+	Usage *CapacityUsage `protobuf:"bytes,2,opt,name=usage,proto3" json:"usage,omitempty"`
+}
+
+func (m *ZoneCapacity) Reset()      { *m = ZoneCapacity{} }
+func (*ZoneCapacity) ProtoMessage() {}
+func (*ZoneCapacity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b1740d9273701e7, []int{2}
+}
+func (m *ZoneCapacity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ZoneCapacity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ZoneCapacity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ZoneCapacity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZoneCapacity.Merge(m, src)
+}
+func (m *ZoneCapacity) XXX_Size() int {
+	return m.Size()
+}
+func (m *ZoneCapacity) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZoneCapacity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZoneCapacity proto.InternalMessageInfo
+
+func (m *ZoneCapacity) GetZone() string {
+	if m != nil {
+		return m.Zone
+	}
+	return ""
+}
+
+func (m *ZoneCapacity) GetUsage() *CapacityUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
+type PlacementTagCapacity struct {
+	PlacementTag string         `protobuf:"bytes,1,opt,name=placement_tag,json=placementTag,proto3" json:"placement_tag"`
+	Usage        *CapacityUsage `protobuf:"bytes,2,opt,name=usage,proto3" json:"usage,omitempty"`
+}
+
+func (m *PlacementTagCapacity) Reset()      { *m = PlacementTagCapacity{} }
+func (*PlacementTagCapacity) ProtoMessage() {}
+func (*PlacementTagCapacity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b1740d9273701e7, []int{3}
+}
+func (m *PlacementTagCapacity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PlacementTagCapacity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PlacementTagCapacity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PlacementTagCapacity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlacementTagCapacity.Merge(m, src)
+}
+func (m *PlacementTagCapacity) XXX_Size() int {
+	return m.Size()
+}
+func (m *PlacementTagCapacity) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlacementTagCapacity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlacementTagCapacity proto.InternalMessageInfo
+
+func (m *PlacementTagCapacity) GetPlacementTag() string {
+	if m != nil {
+		return m.PlacementTag
+	}
+	return ""
+}
+
+func (m *PlacementTagCapacity) GetUsage() *CapacityUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
+type CapacityReport struct {
+	Total         *CapacityUsage          `protobuf:"bytes,1,opt,name=total,proto3" json:"total,omitempty"`
+	Zones         []*ZoneCapacity         `protobuf:"bytes,2,rep,name=zones,proto3" json:"zones,omitempty"`
+	PlacementTags []*PlacementTagCapacity `protobuf:"bytes,3,rep,name=placement_tags,json=placementTags,proto3" json:"placement_tags,omitempty"`
+}
+
+func (m *CapacityReport) Reset()      { *m = CapacityReport{} }
+func (*CapacityReport) ProtoMessage() {}
+func (*CapacityReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b1740d9273701e7, []int{4}
+}
+func (m *CapacityReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CapacityReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CapacityReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CapacityReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CapacityReport.Merge(m, src)
+}
+func (m *CapacityReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *CapacityReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_CapacityReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CapacityReport proto.InternalMessageInfo
+
+func (m *CapacityReport) GetTotal() *CapacityUsage {
+	if m != nil {
+		return m.Total
+	}
+	return nil
+}
+
+func (m *CapacityReport) GetZones() []*ZoneCapacity {
+	if m != nil {
+		return m.Zones
+	}
+	return nil
+}
+
+func (m *CapacityReport) GetPlacementTags() []*PlacementTagCapacity {
+	if m != nil {
+		return m.PlacementTags
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*CellReservation)(nil), "models.CellReservation")
+	proto.RegisterType((*CapacityUsage)(nil), "models.CapacityUsage")
+	proto.RegisterType((*ZoneCapacity)(nil), "models.ZoneCapacity")
+	proto.RegisterType((*PlacementTagCapacity)(nil), "models.PlacementTagCapacity")
+	proto.RegisterType((*CapacityReport)(nil), "models.CapacityReport")
+}
+
+func init() { proto.RegisterFile("capacity.proto", fileDescriptor_9b1740d9273701e7) }
+
+var fileDescriptor_9b1740d9273701e7 = []byte{
+	// 575 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0xf7, 0x35, 0x75, 0xda, 0x5c, 0x9a, 0xb4, 0x5c, 0x03, 0x58, 0xa8, 0x3a, 0x47, 0x11, 0x43,
+	0x54, 0x44, 0x2a, 0x95, 0x3f, 0x0b, 0x12, 0x48, 0x49, 0x07, 0x18, 0x22, 0xa1, 0x13, 0x0c, 0xb0,
+	0x44, 0xb6, 0x73, 0x18, 0x0b, 0xdb, 0x17, 0xd9, 0x0e, 0x52, 0x61, 0xe1, 0x23, 0xf0, 0x31, 0x98,
+	0xd8, 0x18, 0xf8, 0x06, 0x8c, 0x19, 0x3b, 0x59, 0xc4, 0x59, 0x90, 0xa7, 0x7e, 0x04, 0xe4, 0x77,
+	0xb1, 0xeb, 0xa4, 0x11, 0x12, 0x4b, 0xf2, 0xde, 0xef, 0xf7, 0xde, 0xbd, 0xdf, 0xef, 0xde, 0xc9,
+	0xb8, 0x69, 0x19, 0x13, 0xc3, 0x72, 0xa2, 0xf3, 0xde, 0x24, 0x10, 0x91, 0x20, 0x55, 0x4f, 0x8c,
+	0xb9, 0x1b, 0xde, 0xb9, 0x6f, 0x3b, 0xd1, 0xfb, 0xa9, 0xd9, 0xb3, 0x84, 0x77, 0x62, 0x0b, 0x5b,
+	0x9c, 0x00, 0x6d, 0x4e, 0xdf, 0x41, 0x06, 0x09, 0x44, 0xb2, 0xad, 0xf3, 0x13, 0xe1, 0xfd, 0x01,
+	0x77, 0x5d, 0xc6, 0x43, 0x1e, 0x7c, 0x34, 0x22, 0x47, 0xf8, 0xe4, 0x2e, 0xde, 0xb1, 0xb8, 0xeb,
+	0x8e, 0x9c, 0xb1, 0x86, 0xda, 0xa8, 0x5b, 0xeb, 0xd7, 0xd3, 0x58, 0xcf, 0x21, 0x56, 0xcd, 0x82,
+	0x17, 0x63, 0x72, 0x8c, 0x6b, 0x1e, 0xf7, 0x44, 0x70, 0x3e, 0xf2, 0x4c, 0x6d, 0xab, 0x8d, 0xba,
+	0x95, 0x7e, 0x23, 0x8d, 0xf5, 0x2b, 0x90, 0xed, 0xca, 0x70, 0x68, 0x66, 0x27, 0x8e, 0x9d, 0xf0,
+	0x43, 0x56, 0x59, 0x81, 0x4a, 0x38, 0x71, 0x09, 0xb1, 0x6a, 0x16, 0x0c, 0x4d, 0xd2, 0xc3, 0xd8,
+	0x12, 0x7e, 0x64, 0x38, 0x3e, 0x0f, 0x42, 0x6d, 0xbb, 0x8d, 0xba, 0x6a, 0xbf, 0x99, 0xc6, 0x7a,
+	0x09, 0x65, 0xa5, 0xb8, 0xf3, 0xa3, 0x82, 0x1b, 0x83, 0xe5, 0x2d, 0xbc, 0x0e, 0x0d, 0x9b, 0x13,
+	0x1d, 0xab, 0x99, 0xba, 0x10, 0x74, 0xab, 0xfd, 0x5a, 0x1a, 0xeb, 0x12, 0x60, 0xf2, 0x8f, 0x3c,
+	0xc1, 0xfb, 0x91, 0x88, 0x0c, 0x77, 0xb4, 0x2e, 0xfd, 0x30, 0x8d, 0xf5, 0x75, 0x8a, 0x35, 0x00,
+	0x18, 0xe6, 0x2e, 0x1e, 0x61, 0x09, 0x8c, 0x56, 0xbd, 0xdc, 0x48, 0x63, 0x7d, 0x95, 0x60, 0x75,
+	0x48, 0xcf, 0xa4, 0xad, 0x67, 0xf8, 0x40, 0xb2, 0xd7, 0xcc, 0xb5, 0xd2, 0x58, 0xbf, 0xc6, 0x31,
+	0x29, 0x63, 0x50, 0x00, 0xe4, 0x0c, 0x93, 0x00, 0xd6, 0xc3, 0xc7, 0x25, 0xdd, 0x2a, 0x0c, 0xbf,
+	0x95, 0xc6, 0xfa, 0x06, 0x96, 0x1d, 0xe4, 0x58, 0xa1, 0xfe, 0x29, 0x2e, 0xb0, 0xc2, 0x40, 0x15,
+	0xce, 0x00, 0x19, 0xeb, 0x1c, 0x6b, 0xe6, 0xc8, 0xd2, 0xc6, 0x73, 0x7c, 0x58, 0xd4, 0x94, 0x9c,
+	0xec, 0x80, 0x93, 0xdb, 0x69, 0xac, 0x6f, 0xa2, 0x59, 0xa1, 0xed, 0xca, 0x4f, 0xe7, 0x0d, 0xde,
+	0x7b, 0x2b, 0x7c, 0x9e, 0xaf, 0x8e, 0x1c, 0xe1, 0xed, 0x4f, 0xc2, 0xe7, 0xcb, 0xc7, 0xb6, 0x9b,
+	0xc6, 0x3a, 0xe4, 0x0c, 0x7e, 0xc9, 0x3d, 0xac, 0x4e, 0xb3, 0xe5, 0xc2, 0xa2, 0xea, 0xa7, 0x37,
+	0x7b, 0xf2, 0xa1, 0xf7, 0x56, 0x36, 0xcf, 0x64, 0x4d, 0xe7, 0x33, 0x6e, 0xbd, 0x74, 0x0d, 0x8b,
+	0x7b, 0xdc, 0x8f, 0x5e, 0x19, 0x76, 0x31, 0xe2, 0x31, 0x6e, 0x4c, 0x72, 0x7c, 0x14, 0x19, 0xf6,
+	0x72, 0x16, 0xac, 0x6e, 0x85, 0x60, 0x7b, 0x93, 0x52, 0xff, 0xff, 0x0d, 0xff, 0x8e, 0x70, 0x33,
+	0x27, 0x18, 0x9f, 0x88, 0x20, 0xca, 0xfa, 0x61, 0x9b, 0x1a, 0xfa, 0x67, 0x3f, 0xd4, 0x90, 0x63,
+	0xac, 0x66, 0x8e, 0x43, 0x6d, 0xab, 0x5d, 0xe9, 0xd6, 0x4f, 0x5b, 0x79, 0x71, 0xf9, 0xb2, 0x98,
+	0x2c, 0x21, 0x03, 0xdc, 0x5c, 0xd1, 0x1d, 0x6a, 0x15, 0x68, 0x3a, 0xca, 0x9b, 0x36, 0x5d, 0x03,
+	0x6b, 0x94, 0xcd, 0x85, 0xfd, 0x87, 0xb3, 0x39, 0x55, 0x2e, 0xe6, 0x54, 0xb9, 0x9c, 0x53, 0xf4,
+	0x25, 0xa1, 0xe8, 0x5b, 0x42, 0xd1, 0xaf, 0x84, 0xa2, 0x59, 0x42, 0xd1, 0xef, 0x84, 0xa2, 0x3f,
+	0x09, 0x55, 0x2e, 0x13, 0x8a, 0xbe, 0x2e, 0xa8, 0x32, 0x5b, 0x50, 0xe5, 0x62, 0x41, 0x15, 0xb3,
+	0x0a, 0x5f, 0x8e, 0x07, 0x7f, 0x07, 0x00, 0xb8, 0xd2, 0x86, 0x42, 0x82, 0x04, 0x00, 0x00,
+}
+
+func (this *CellReservation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CellReservation)
+	if !ok {
+		that2, ok := that.(CellReservation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CellId != that1.CellId {
+		return false
+	}
+	if this.MemoryMb != that1.MemoryMb {
+		return false
+	}
+	if this.DiskMb != that1.DiskMb {
+		return false
+	}
+	if this.Containers != that1.Containers {
+		return false
+	}
+	return true
+}
+func (this *CapacityUsage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CapacityUsage)
+	if !ok {
+		that2, ok := that.(CapacityUsage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Cells != that1.Cells {
+		return false
+	}
+	if this.TotalMemoryMb != that1.TotalMemoryMb {
+		return false
+	}
+	if this.TotalDiskMb != that1.TotalDiskMb {
+		return false
+	}
+	if this.TotalContainers != that1.TotalContainers {
+		return false
+	}
+	if this.ReservedMemoryMb != that1.ReservedMemoryMb {
+		return false
+	}
+	if this.ReservedDiskMb != that1.ReservedDiskMb {
+		return false
+	}
+	if this.ReservedContainers != that1.ReservedContainers {
+		return false
+	}
+	return true
+}
+func (this *ZoneCapacity) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ZoneCapacity)
+	if !ok {
+		that2, ok := that.(ZoneCapacity)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Zone != that1.Zone {
+		return false
+	}
+	if !this.Usage.Equal(that1.Usage) {
+		return false
+	}
+	return true
+}
+func (this *PlacementTagCapacity) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PlacementTagCapacity)
+	if !ok {
+		that2, ok := that.(PlacementTagCapacity)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PlacementTag != that1.PlacementTag {
+		return false
+	}
+	if !this.Usage.Equal(that1.Usage) {
+		return false
+	}
+	return true
+}
+func (this *CapacityReport) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CapacityReport)
+	if !ok {
+		that2, ok := that.(CapacityReport)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Total.Equal(that1.Total) {
+		return false
+	}
+	if len(this.Zones) != len(that1.Zones) {
+		return false
+	}
+	for i := range this.Zones {
+		if !this.Zones[i].Equal(that1.Zones[i]) {
+			return false
+		}
+	}
+	if len(this.PlacementTags) != len(that1.PlacementTags) {
+		return false
+	}
+	for i := range this.PlacementTags {
+		if !this.PlacementTags[i].Equal(that1.PlacementTags[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *CellReservation) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&models.CellReservation{")
+	s = append(s, "CellId: "+fmt.Sprintf("%#v", this.CellId)+",\n")
+	s = append(s, "MemoryMb: "+fmt.Sprintf("%#v", this.MemoryMb)+",\n")
+	s = append(s, "DiskMb: "+fmt.Sprintf("%#v", this.DiskMb)+",\n")
+	s = append(s, "Containers: "+fmt.Sprintf("%#v", this.Containers)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CapacityUsage) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&models.CapacityUsage{")
+	s = append(s, "Cells: "+fmt.Sprintf("%#v", this.Cells)+",\n")
+	s = append(s, "TotalMemoryMb: "+fmt.Sprintf("%#v", this.TotalMemoryMb)+",\n")
+	s = append(s, "TotalDiskMb: "+fmt.Sprintf("%#v", this.TotalDiskMb)+",\n")
+	s = append(s, "TotalContainers: "+fmt.Sprintf("%#v", this.TotalContainers)+",\n")
+	s = append(s, "ReservedMemoryMb: "+fmt.Sprintf("%#v", this.ReservedMemoryMb)+",\n")
+	s = append(s, "ReservedDiskMb: "+fmt.Sprintf("%#v", this.ReservedDiskMb)+",\n")
+	s = append(s, "ReservedContainers: "+fmt.Sprintf("%#v", this.ReservedContainers)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ZoneCapacity) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.ZoneCapacity{")
+	s = append(s, "Zone: "+fmt.Sprintf("%#v", this.Zone)+",\n")
+	if this.Usage != nil {
+		s = append(s, "Usage: "+fmt.Sprintf("%#v", this.Usage)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PlacementTagCapacity) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.PlacementTagCapacity{")
+	s = append(s, "PlacementTag: "+fmt.Sprintf("%#v", this.PlacementTag)+",\n")
+	if this.Usage != nil {
+		s = append(s, "Usage: "+fmt.Sprintf("%#v", this.Usage)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CapacityReport) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&models.CapacityReport{")
+	if this.Total != nil {
+		s = append(s, "Total: "+fmt.Sprintf("%#v", this.Total)+",\n")
+	}
+	if this.Zones != nil {
+		s = append(s, "Zones: "+fmt.Sprintf("%#v", this.Zones)+",\n")
+	}
+	if this.PlacementTags != nil {
+		s = append(s, "PlacementTags: "+fmt.Sprintf("%#v", this.PlacementTags)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringCapacity(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *CellReservation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CellReservation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CellReservation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Containers != 0 {
+		i = encodeVarintCapacity(dAtA, i, uint64(m.Containers))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DiskMb != 0 {
+		i = encodeVarintCapacity(dAtA, i, uint64(m.DiskMb))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MemoryMb != 0 {
+		i = encodeVarintCapacity(dAtA, i, uint64(m.MemoryMb))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CellId) > 0 {
+		i -= len(m.CellId)
+		copy(dAtA[i:], m.CellId)
+		i = encodeVarintCapacity(dAtA, i, uint64(len(m.CellId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CapacityUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CapacityUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CapacityUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReservedContainers != 0 {
+		i = encodeVarintCapacity(dAtA, i, uint64(m.ReservedContainers))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ReservedDiskMb != 0 {
+		i = encodeVarintCapacity(dAtA, i, uint64(m.ReservedDiskMb))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ReservedMemoryMb != 0 {
+		i = encodeVarintCapacity(dAtA, i, uint64(m.ReservedMemoryMb))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TotalContainers != 0 {
+		i = encodeVarintCapacity(dAtA, i, uint64(m.TotalContainers))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TotalDiskMb != 0 {
+		i = encodeVarintCapacity(dAtA, i, uint64(m.TotalDiskMb))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalMemoryMb != 0 {
+		i = encodeVarintCapacity(dAtA, i, uint64(m.TotalMemoryMb))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Cells != 0 {
+		i = encodeVarintCapacity(dAtA, i, uint64(m.Cells))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ZoneCapacity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ZoneCapacity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ZoneCapacity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Usage != nil {
+		{
+			size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCapacity(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Zone) > 0 {
+		i -= len(m.Zone)
+		copy(dAtA[i:], m.Zone)
+		i = encodeVarintCapacity(dAtA, i, uint64(len(m.Zone)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PlacementTagCapacity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlacementTagCapacity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlacementTagCapacity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Usage != nil {
+		{
+			size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCapacity(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PlacementTag) > 0 {
+		i -= len(m.PlacementTag)
+		copy(dAtA[i:], m.PlacementTag)
+		i = encodeVarintCapacity(dAtA, i, uint64(len(m.PlacementTag)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CapacityReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CapacityReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CapacityReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PlacementTags) > 0 {
+		for iNdEx := len(m.PlacementTags) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PlacementTags[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCapacity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Zones) > 0 {
+		for iNdEx := len(m.Zones) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Zones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCapacity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Total != nil {
+		{
+			size, err := m.Total.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCapacity(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCapacity(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCapacity(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CellReservation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CellId)
+	if l > 0 {
+		n += 1 + l + sovCapacity(uint64(l))
+	}
+	if m.MemoryMb != 0 {
+		n += 1 + sovCapacity(uint64(m.MemoryMb))
+	}
+	if m.DiskMb != 0 {
+		n += 1 + sovCapacity(uint64(m.DiskMb))
+	}
+	if m.Containers != 0 {
+		n += 1 + sovCapacity(uint64(m.Containers))
+	}
+	return n
+}
+
+func (m *CapacityUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Cells != 0 {
+		n += 1 + sovCapacity(uint64(m.Cells))
+	}
+	if m.TotalMemoryMb != 0 {
+		n += 1 + sovCapacity(uint64(m.TotalMemoryMb))
+	}
+	if m.TotalDiskMb != 0 {
+		n += 1 + sovCapacity(uint64(m.TotalDiskMb))
+	}
+	if m.TotalContainers != 0 {
+		n += 1 + sovCapacity(uint64(m.TotalContainers))
+	}
+	if m.ReservedMemoryMb != 0 {
+		n += 1 + sovCapacity(uint64(m.ReservedMemoryMb))
+	}
+	if m.ReservedDiskMb != 0 {
+		n += 1 + sovCapacity(uint64(m.ReservedDiskMb))
+	}
+	if m.ReservedContainers != 0 {
+		n += 1 + sovCapacity(uint64(m.ReservedContainers))
+	}
+	return n
+}
+
+func (m *ZoneCapacity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Zone)
+	if l > 0 {
+		n += 1 + l + sovCapacity(uint64(l))
+	}
+	if m.Usage != nil {
+		l = m.Usage.Size()
+		n += 1 + l + sovCapacity(uint64(l))
+	}
+	return n
+}
+
+func (m *PlacementTagCapacity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlacementTag)
+	if l > 0 {
+		n += 1 + l + sovCapacity(uint64(l))
+	}
+	if m.Usage != nil {
+		l = m.Usage.Size()
+		n += 1 + l + sovCapacity(uint64(l))
+	}
+	return n
+}
+
+func (m *CapacityReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Total != nil {
+		l = m.Total.Size()
+		n += 1 + l + sovCapacity(uint64(l))
+	}
+	if len(m.Zones) > 0 {
+		for _, e := range m.Zones {
+			l = e.Size()
+			n += 1 + l + sovCapacity(uint64(l))
+		}
+	}
+	if len(m.PlacementTags) > 0 {
+		for _, e := range m.PlacementTags {
+			l = e.Size()
+			n += 1 + l + sovCapacity(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovCapacity(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCapacity(x uint64) (n int) {
+	return sovCapacity(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *CellReservation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CellReservation{`,
+		`CellId:` + fmt.Sprintf("%v", this.CellId) + `,`,
+		`MemoryMb:` + fmt.Sprintf("%v", this.MemoryMb) + `,`,
+		`DiskMb:` + fmt.Sprintf("%v", this.DiskMb) + `,`,
+		`Containers:` + fmt.Sprintf("%v", this.Containers) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CapacityUsage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CapacityUsage{`,
+		`Cells:` + fmt.Sprintf("%v", this.Cells) + `,`,
+		`TotalMemoryMb:` + fmt.Sprintf("%v", this.TotalMemoryMb) + `,`,
+		`TotalDiskMb:` + fmt.Sprintf("%v", this.TotalDiskMb) + `,`,
+		`TotalContainers:` + fmt.Sprintf("%v", this.TotalContainers) + `,`,
+		`ReservedMemoryMb:` + fmt.Sprintf("%v", this.ReservedMemoryMb) + `,`,
+		`ReservedDiskMb:` + fmt.Sprintf("%v", this.ReservedDiskMb) + `,`,
+		`ReservedContainers:` + fmt.Sprintf("%v", this.ReservedContainers) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ZoneCapacity) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ZoneCapacity{`,
+		`Zone:` + fmt.Sprintf("%v", this.Zone) + `,`,
+		`Usage:` + strings.Replace(this.Usage.String(), "CapacityUsage", "CapacityUsage", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PlacementTagCapacity) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PlacementTagCapacity{`,
+		`PlacementTag:` + fmt.Sprintf("%v", this.PlacementTag) + `,`,
+		`Usage:` + strings.Replace(this.Usage.String(), "CapacityUsage", "CapacityUsage", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CapacityReport) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForZones := "[]*ZoneCapacity{"
+	for _, f := range this.Zones {
+		repeatedStringForZones += strings.Replace(f.String(), "ZoneCapacity", "ZoneCapacity", 1) + ","
+	}
+	repeatedStringForZones += "}"
+	repeatedStringForPlacementTags := "[]*PlacementTagCapacity{"
+	for _, f := range this.PlacementTags {
+		repeatedStringForPlacementTags += strings.Replace(f.String(), "PlacementTagCapacity", "PlacementTagCapacity", 1) + ","
+	}
+	repeatedStringForPlacementTags += "}"
+	s := strings.Join([]string{`&CapacityReport{`,
+		`Total:` + strings.Replace(this.Total.String(), "CapacityUsage", "CapacityUsage", 1) + `,`,
+		`Zones:` + repeatedStringForZones + `,`,
+		`PlacementTags:` + repeatedStringForPlacementTags + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringCapacity(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *CellReservation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCapacity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CellReservation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CellReservation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCapacity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCapacity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCapacity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryMb", wireType)
+			}
+			m.MemoryMb = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCapacity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemoryMb |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskMb", wireType)
+			}
+			m.DiskMb = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCapacity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiskMb |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Containers", wireType)
+			}
+			m.Containers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCapacity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Containers |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCapacity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCapacity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CapacityUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCapacity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CapacityUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CapacityUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cells", wireType)
+			}
+			m.Cells = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCapacity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cells |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMemoryMb", wireType)
+			}
+			m.TotalMemoryMb = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCapacity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalMemoryMb |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDiskMb", wireType)
+			}
+			m.TotalDiskMb = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCapacity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalDiskMb |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalContainers", wireType)
+			}
+			m.TotalContainers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCapacity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalContainers |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedMemoryMb", wireType)
+			}
+			m.ReservedMemoryMb = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCapacity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReservedMemoryMb |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedDiskMb", wireType)
+			}
+			m.ReservedDiskMb = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCapacity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReservedDiskMb |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedContainers", wireType)
+			}
+			m.ReservedContainers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCapacity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReservedContainers |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCapacity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCapacity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ZoneCapacity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCapacity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ZoneCapacity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ZoneCapacity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCapacity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCapacity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCapacity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCapacity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCapacity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCapacity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Usage == nil {
+				m.Usage = &CapacityUsage{}
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCapacity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCapacity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PlacementTagCapacity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCapacity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PlacementTagCapacity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PlacementTagCapacity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlacementTag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCapacity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCapacity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCapacity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlacementTag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCapacity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCapacity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCapacity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Usage == nil {
+				m.Usage = &CapacityUsage{}
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCapacity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCapacity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CapacityReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCapacity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CapacityReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CapacityReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCapacity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCapacity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCapacity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Total == nil {
+				m.Total = &CapacityUsage{}
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zones", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCapacity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCapacity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCapacity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zones = append(m.Zones, &ZoneCapacity{})
+			if err := m.Zones[len(m.Zones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlacementTags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCapacity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCapacity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCapacity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlacementTags = append(m.PlacementTags, &PlacementTagCapacity{})
+			if err := m.PlacementTags[len(m.PlacementTags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCapacity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCapacity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCapacity(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCapacity
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCapacity
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCapacity
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCapacity
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCapacity
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCapacity
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCapacity        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCapacity          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCapacity = fmt.Errorf("proto: unexpected end of group")
+)
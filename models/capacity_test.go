@@ -0,0 +1,87 @@
+package models_test
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CapacityReport", func() {
+	Describe("NewCapacityReport", func() {
+		var (
+			cells        models.CellSet
+			reservations map[string]*models.CellReservation
+		)
+
+		BeforeEach(func() {
+			cell1 := models.NewCellPresence("cell-1", "1.1.1.1", "", "z1", models.NewCellCapacity(1024, 2048, 10), nil, nil, []string{"gpu"}, nil)
+			cell2 := models.NewCellPresence("cell-2", "2.2.2.2", "", "z1", models.NewCellCapacity(2048, 4096, 20), nil, nil, nil, []string{"gpu", "ssd"})
+			cell3 := models.NewCellPresence("cell-3", "3.3.3.3", "", "z2", models.NewCellCapacity(4096, 8192, 40), nil, nil, nil, nil)
+			cells = models.NewCellSetFromList([]*models.CellPresence{&cell1, &cell2, &cell3})
+
+			reservations = map[string]*models.CellReservation{
+				"cell-1":       {CellId: "cell-1", MemoryMb: 512, DiskMb: 1024, Containers: 2},
+				"cell-3":       {CellId: "cell-3", MemoryMb: 128, DiskMb: 256, Containers: 1},
+				"missing-cell": {CellId: "missing-cell", MemoryMb: 64, DiskMb: 64, Containers: 1},
+			}
+		})
+
+		It("totals the capacity and reservations of the cells", func() {
+			report := models.NewCapacityReport(cells, reservations)
+			Expect(report.Total).To(Equal(&models.CapacityUsage{
+				Cells:              3,
+				TotalMemoryMb:      7168,
+				TotalDiskMb:        14336,
+				TotalContainers:    70,
+				ReservedMemoryMb:   640,
+				ReservedDiskMb:     1280,
+				ReservedContainers: 3,
+			}))
+		})
+
+		It("aggregates by zone", func() {
+			report := models.NewCapacityReport(cells, reservations)
+			Expect(report.Zones).To(Equal([]*models.ZoneCapacity{
+				{Zone: "z1", Usage: &models.CapacityUsage{
+					Cells: 2, TotalMemoryMb: 3072, TotalDiskMb: 6144, TotalContainers: 30,
+					ReservedMemoryMb: 512, ReservedDiskMb: 1024, ReservedContainers: 2,
+				}},
+				{Zone: "z2", Usage: &models.CapacityUsage{
+					Cells: 1, TotalMemoryMb: 4096, TotalDiskMb: 8192, TotalContainers: 40,
+					ReservedMemoryMb: 128, ReservedDiskMb: 256, ReservedContainers: 1,
+				}},
+			}))
+		})
+
+		It("aggregates by required and optional placement tags", func() {
+			report := models.NewCapacityReport(cells, reservations)
+			Expect(report.PlacementTags).To(Equal([]*models.PlacementTagCapacity{
+				{PlacementTag: "gpu", Usage: &models.CapacityUsage{
+					Cells: 2, TotalMemoryMb: 3072, TotalDiskMb: 6144, TotalContainers: 30,
+					ReservedMemoryMb: 512, ReservedDiskMb: 1024, ReservedContainers: 2,
+				}},
+				{PlacementTag: "ssd", Usage: &models.CapacityUsage{
+					Cells: 1, TotalMemoryMb: 2048, TotalDiskMb: 4096, TotalContainers: 20,
+				}},
+			}))
+		})
+
+		Context("when there are no cells", func() {
+			It("returns an empty report", func() {
+				report := models.NewCapacityReport(models.NewCellSet(), reservations)
+				Expect(report.Total).To(Equal(&models.CapacityUsage{}))
+				Expect(report.Zones).To(BeEmpty())
+				Expect(report.PlacementTags).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("CellReservation", func() {
+		It("adds a container with its resources", func() {
+			reservation := &models.CellReservation{CellId: "cell-1"}
+			reservation.Add(128, 256)
+			reservation.Add(64, 32)
+			Expect(reservation).To(Equal(&models.CellReservation{CellId: "cell-1", MemoryMb: 192, DiskMb: 288, Containers: 2}))
+		})
+	})
+})
@@ -0,0 +1,894 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cell_cordon.proto
+
+package models
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type CordonedCell struct {
+	CellId         string `protobuf:"bytes,1,opt,name=cell_id,json=cellId,proto3" json:"cell_id"`
+	CordonedAt     int64  `protobuf:"varint,2,opt,name=cordoned_at,json=cordonedAt,proto3" json:"cordoned_at"`
+	DrainStartedAt int64  `protobuf:"varint,3,opt,name=drain_started_at,json=drainStartedAt,proto3" json:"drain_started_at,omitempty"`
+}
+
+func (m *CordonedCell) Reset()      { *m = CordonedCell{} }
+func (*CordonedCell) ProtoMessage() {}
+func (*CordonedCell) Descriptor() ([]byte, []int) {
+	return fileDescriptor_01c93698e50d29f3, []int{0}
+}
+func (m *CordonedCell) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CordonedCell) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CordonedCell.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CordonedCell) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CordonedCell.Merge(m, src)
+}
+func (m *CordonedCell) XXX_Size() int {
+	return m.Size()
+}
+func (m *CordonedCell) XXX_DiscardUnknown() {
+	xxx_messageInfo_CordonedCell.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CordonedCell proto.InternalMessageInfo
+
+func (m *CordonedCell) GetCellId() string {
+	if m != nil {
+		return m.CellId
+	}
+	return ""
+}
+
+func (m *CordonedCell) GetCordonedAt() int64 {
+	if m != nil {
+		return m.CordonedAt
+	}
+	return 0
+}
+
+func (m *CordonedCell) GetDrainStartedAt() int64 {
+	if m != nil {
+		return m.DrainStartedAt
+	}
+	return 0
+}
+
+type CellDrainStatus struct {
+	CellId              string `protobuf:"bytes,1,opt,name=cell_id,json=cellId,proto3" json:"cell_id"`
+	Cordoned            bool   `protobuf:"varint,2,opt,name=cordoned,proto3" json:"cordoned"`
+	Draining            bool   `protobuf:"varint,3,opt,name=draining,proto3" json:"draining"`
+	DrainStartedAt      int64  `protobuf:"varint,4,opt,name=drain_started_at,json=drainStartedAt,proto3" json:"drain_started_at,omitempty"`
+	InstancesRemaining  int32  `protobuf:"varint,5,opt,name=instances_remaining,json=instancesRemaining,proto3" json:"instances_remaining"`
+	EvacuatingInstances int32  `protobuf:"varint,6,opt,name=evacuating_instances,json=evacuatingInstances,proto3" json:"evacuating_instances"`
+}
+
+func (m *CellDrainStatus) Reset()      { *m = CellDrainStatus{} }
+func (*CellDrainStatus) ProtoMessage() {}
+func (*CellDrainStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_01c93698e50d29f3, []int{1}
+}
+func (m *CellDrainStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CellDrainStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CellDrainStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CellDrainStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CellDrainStatus.Merge(m, src)
+}
+func (m *CellDrainStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *CellDrainStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_CellDrainStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CellDrainStatus proto.InternalMessageInfo
+
+func (m *CellDrainStatus) GetCellId() string {
+	if m != nil {
+		return m.CellId
+	}
+	return ""
+}
+
+func (m *CellDrainStatus) GetCordoned() bool {
+	if m != nil {
+		return m.Cordoned
+	}
+	return false
+}
+
+func (m *CellDrainStatus) GetDraining() bool {
+	if m != nil {
+		return m.Draining
+	}
+	return false
+}
+
+func (m *CellDrainStatus) GetDrainStartedAt() int64 {
+	if m != nil {
+		return m.DrainStartedAt
+	}
+	return 0
+}
+
+func (m *CellDrainStatus) GetInstancesRemaining() int32 {
+	if m != nil {
+		return m.InstancesRemaining
+	}
+	return 0
+}
+
+func (m *CellDrainStatus) GetEvacuatingInstances() int32 {
+	if m != nil {
+		return m.EvacuatingInstances
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*CordonedCell)(nil), "models.CordonedCell")
+	proto.RegisterType((*CellDrainStatus)(nil), "models.CellDrainStatus")
+}
+
+func init() { proto.RegisterFile("cell_cordon.proto", fileDescriptor_01c93698e50d29f3) }
+
+var fileDescriptor_01c93698e50d29f3 = []byte{
+	// 358 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x31, 0x4e, 0xfb, 0x30,
+	0x18, 0xc5, 0xe3, 0xf6, 0xdf, 0xfc, 0x8b, 0x5b, 0x51, 0x70, 0x91, 0x88, 0x18, 0x9c, 0xaa, 0x62,
+	0xc8, 0x42, 0x8b, 0x04, 0x17, 0x68, 0xca, 0x40, 0xc5, 0x66, 0x0e, 0x10, 0xa5, 0x89, 0x09, 0x91,
+	0xd2, 0x18, 0x25, 0x0e, 0x33, 0x47, 0xe8, 0x31, 0x38, 0x0a, 0x03, 0x43, 0xc7, 0x4e, 0x11, 0x75,
+	0x17, 0x94, 0xa9, 0x47, 0x40, 0x71, 0x93, 0x94, 0x21, 0x03, 0xdb, 0xfb, 0x7e, 0xdf, 0xf7, 0x9e,
+	0xdf, 0x60, 0x78, 0xea, 0xd0, 0x20, 0xb0, 0x1c, 0x16, 0xb9, 0x2c, 0x1c, 0xbd, 0x44, 0x8c, 0x33,
+	0xa4, 0x2e, 0x98, 0x4b, 0x83, 0xf8, 0xe2, 0xca, 0xf3, 0xf9, 0x73, 0x32, 0x1f, 0x39, 0x6c, 0x31,
+	0xf6, 0x98, 0xc7, 0xc6, 0x72, 0x3d, 0x4f, 0x9e, 0xe4, 0x24, 0x07, 0xa9, 0xf6, 0xb6, 0xe1, 0x12,
+	0xc0, 0xee, 0x54, 0xe6, 0x50, 0x77, 0x4a, 0x83, 0x00, 0x5d, 0xc2, 0xff, 0x32, 0xdc, 0x77, 0x35,
+	0x30, 0x00, 0xc6, 0x91, 0xd9, 0xc9, 0x52, 0xbd, 0x44, 0x44, 0xcd, 0xc5, 0xcc, 0x45, 0xd7, 0xb0,
+	0xe3, 0x14, 0x2e, 0xcb, 0xe6, 0x5a, 0x63, 0x00, 0x8c, 0xa6, 0xd9, 0xcb, 0x52, 0xfd, 0x37, 0x26,
+	0xb0, 0x1c, 0x26, 0x1c, 0x19, 0xf0, 0xc4, 0x8d, 0x6c, 0x3f, 0xb4, 0x62, 0x6e, 0x47, 0x7c, 0x6f,
+	0x6b, 0xe6, 0x36, 0x72, 0x2c, 0xf9, 0xe3, 0x1e, 0x4f, 0xf8, 0xf0, 0xb3, 0x01, 0x7b, 0x79, 0x95,
+	0xbb, 0x02, 0xf3, 0x24, 0xfe, 0x63, 0x2b, 0x03, 0xb6, 0xcb, 0x17, 0x65, 0xa5, 0xb6, 0xd9, 0xcd,
+	0x52, 0xbd, 0x62, 0xa4, 0x52, 0xf9, 0xa5, 0x7c, 0xd5, 0x0f, 0x3d, 0xad, 0x79, 0xb8, 0x2c, 0x19,
+	0xa9, 0x54, 0x6d, 0xef, 0x7f, 0x75, 0xbd, 0xd1, 0x3d, 0xec, 0xfb, 0x61, 0xcc, 0xed, 0xd0, 0xa1,
+	0xb1, 0x15, 0xd1, 0x45, 0x11, 0xdf, 0x1a, 0x00, 0xa3, 0x65, 0x9e, 0x67, 0xa9, 0x5e, 0xb7, 0x26,
+	0xa8, 0x82, 0xa4, 0x64, 0xe8, 0x01, 0x9e, 0xd1, 0x57, 0xdb, 0x49, 0x6c, 0xee, 0x87, 0x9e, 0x55,
+	0x1d, 0x68, 0xaa, 0x8c, 0xd2, 0xb2, 0x54, 0xaf, 0xdd, 0x93, 0xfe, 0x81, 0xce, 0x4a, 0x68, 0xde,
+	0xae, 0x36, 0x58, 0x59, 0x6f, 0xb0, 0xb2, 0xdb, 0x60, 0xf0, 0x26, 0x30, 0x78, 0x17, 0x18, 0x7c,
+	0x08, 0x0c, 0x56, 0x02, 0x83, 0x2f, 0x81, 0xc1, 0xb7, 0xc0, 0xca, 0x4e, 0x60, 0xb0, 0xdc, 0x62,
+	0x65, 0xb5, 0xc5, 0xca, 0x7a, 0x8b, 0x95, 0xb9, 0x2a, 0xbf, 0xc7, 0xcd, 0xcf, 0x00, 0x23, 0x70,
+	0x27, 0x5c, 0x6a, 0x02, 0x00, 0x00,
+}
+
+func (this *CordonedCell) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CordonedCell)
+	if !ok {
+		that2, ok := that.(CordonedCell)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CellId != that1.CellId {
+		return false
+	}
+	if this.CordonedAt != that1.CordonedAt {
+		return false
+	}
+	if this.DrainStartedAt != that1.DrainStartedAt {
+		return false
+	}
+	return true
+}
+func (this *CellDrainStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CellDrainStatus)
+	if !ok {
+		that2, ok := that.(CellDrainStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CellId != that1.CellId {
+		return false
+	}
+	if this.Cordoned != that1.Cordoned {
+		return false
+	}
+	if this.Draining != that1.Draining {
+		return false
+	}
+	if this.DrainStartedAt != that1.DrainStartedAt {
+		return false
+	}
+	if this.InstancesRemaining != that1.InstancesRemaining {
+		return false
+	}
+	if this.EvacuatingInstances != that1.EvacuatingInstances {
+		return false
+	}
+	return true
+}
+func (this *CordonedCell) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&models.CordonedCell{")
+	s = append(s, "CellId: "+fmt.Sprintf("%#v", this.CellId)+",\n")
+	s = append(s, "CordonedAt: "+fmt.Sprintf("%#v", this.CordonedAt)+",\n")
+	s = append(s, "DrainStartedAt: "+fmt.Sprintf("%#v", this.DrainStartedAt)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CellDrainStatus) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&models.CellDrainStatus{")
+	s = append(s, "CellId: "+fmt.Sprintf("%#v", this.CellId)+",\n")
+	s = append(s, "Cordoned: "+fmt.Sprintf("%#v", this.Cordoned)+",\n")
+	s = append(s, "Draining: "+fmt.Sprintf("%#v", this.Draining)+",\n")
+	s = append(s, "DrainStartedAt: "+fmt.Sprintf("%#v", this.DrainStartedAt)+",\n")
+	s = append(s, "InstancesRemaining: "+fmt.Sprintf("%#v", this.InstancesRemaining)+",\n")
+	s = append(s, "EvacuatingInstances: "+fmt.Sprintf("%#v", this.EvacuatingInstances)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringCellCordon(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *CordonedCell) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CordonedCell) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CordonedCell) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DrainStartedAt != 0 {
+		i = encodeVarintCellCordon(dAtA, i, uint64(m.DrainStartedAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CordonedAt != 0 {
+		i = encodeVarintCellCordon(dAtA, i, uint64(m.CordonedAt))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CellId) > 0 {
+		i -= len(m.CellId)
+		copy(dAtA[i:], m.CellId)
+		i = encodeVarintCellCordon(dAtA, i, uint64(len(m.CellId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CellDrainStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CellDrainStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CellDrainStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EvacuatingInstances != 0 {
+		i = encodeVarintCellCordon(dAtA, i, uint64(m.EvacuatingInstances))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.InstancesRemaining != 0 {
+		i = encodeVarintCellCordon(dAtA, i, uint64(m.InstancesRemaining))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DrainStartedAt != 0 {
+		i = encodeVarintCellCordon(dAtA, i, uint64(m.DrainStartedAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Draining {
+		i--
+		if m.Draining {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Cordoned {
+		i--
+		if m.Cordoned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CellId) > 0 {
+		i -= len(m.CellId)
+		copy(dAtA[i:], m.CellId)
+		i = encodeVarintCellCordon(dAtA, i, uint64(len(m.CellId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCellCordon(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCellCordon(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CordonedCell) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CellId)
+	if l > 0 {
+		n += 1 + l + sovCellCordon(uint64(l))
+	}
+	if m.CordonedAt != 0 {
+		n += 1 + sovCellCordon(uint64(m.CordonedAt))
+	}
+	if m.DrainStartedAt != 0 {
+		n += 1 + sovCellCordon(uint64(m.DrainStartedAt))
+	}
+	return n
+}
+
+func (m *CellDrainStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CellId)
+	if l > 0 {
+		n += 1 + l + sovCellCordon(uint64(l))
+	}
+	if m.Cordoned {
+		n += 2
+	}
+	if m.Draining {
+		n += 2
+	}
+	if m.DrainStartedAt != 0 {
+		n += 1 + sovCellCordon(uint64(m.DrainStartedAt))
+	}
+	if m.InstancesRemaining != 0 {
+		n += 1 + sovCellCordon(uint64(m.InstancesRemaining))
+	}
+	if m.EvacuatingInstances != 0 {
+		n += 1 + sovCellCordon(uint64(m.EvacuatingInstances))
+	}
+	return n
+}
+
+func sovCellCordon(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCellCordon(x uint64) (n int) {
+	return sovCellCordon(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *CordonedCell) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CordonedCell{`,
+		`CellId:` + fmt.Sprintf("%v", this.CellId) + `,`,
+		`CordonedAt:` + fmt.Sprintf("%v", this.CordonedAt) + `,`,
+		`DrainStartedAt:` + fmt.Sprintf("%v", this.DrainStartedAt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CellDrainStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CellDrainStatus{`,
+		`CellId:` + fmt.Sprintf("%v", this.CellId) + `,`,
+		`Cordoned:` + fmt.Sprintf("%v", this.Cordoned) + `,`,
+		`Draining:` + fmt.Sprintf("%v", this.Draining) + `,`,
+		`DrainStartedAt:` + fmt.Sprintf("%v", this.DrainStartedAt) + `,`,
+		`InstancesRemaining:` + fmt.Sprintf("%v", this.InstancesRemaining) + `,`,
+		`EvacuatingInstances:` + fmt.Sprintf("%v", this.EvacuatingInstances) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringCellCordon(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *CordonedCell) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCellCordon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CordonedCell: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CordonedCell: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellCordon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCellCordon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCellCordon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CordonedAt", wireType)
+			}
+			m.CordonedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellCordon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CordonedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrainStartedAt", wireType)
+			}
+			m.DrainStartedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellCordon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DrainStartedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCellCordon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCellCordon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CellDrainStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCellCordon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CellDrainStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CellDrainStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellCordon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCellCordon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCellCordon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cordoned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellCordon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cordoned = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Draining", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellCordon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Draining = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrainStartedAt", wireType)
+			}
+			m.DrainStartedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellCordon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DrainStartedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstancesRemaining", wireType)
+			}
+			m.InstancesRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellCordon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InstancesRemaining |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvacuatingInstances", wireType)
+			}
+			m.EvacuatingInstances = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellCordon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvacuatingInstances |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCellCordon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCellCordon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCellCordon(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCellCordon
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCellCordon
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCellCordon
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCellCordon
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCellCordon
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCellCordon
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCellCordon        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCellCordon          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCellCordon = fmt.Errorf("proto: unexpected end of group")
+)
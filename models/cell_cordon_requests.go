@@ -0,0 +1,31 @@
+package models
+
+func (req *CordonCellRequest) Validate() error {
+	return validateCellID(req.CellId)
+}
+
+func (req *UncordonCellRequest) Validate() error {
+	return validateCellID(req.CellId)
+}
+
+func (req *DrainCellRequest) Validate() error {
+	return validateCellID(req.CellId)
+}
+
+func (req *CellDrainStatusRequest) Validate() error {
+	return validateCellID(req.CellId)
+}
+
+func validateCellID(cellID string) error {
+	var validationError ValidationError
+
+	if cellID == "" {
+		validationError = validationError.Append(ErrInvalidField{"cell_id"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
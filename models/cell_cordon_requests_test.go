@@ -0,0 +1,40 @@
+package models_test
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cell cordon requests", func() {
+	Describe("CordonCellRequest", func() {
+		It("requires a cell id", func() {
+			request := models.CordonCellRequest{}
+			Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"cell_id"}))
+
+			request.CellId = "cell-1"
+			Expect(request.Validate()).To(Succeed())
+		})
+	})
+
+	Describe("UncordonCellRequest", func() {
+		It("requires a cell id", func() {
+			request := models.UncordonCellRequest{}
+			Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"cell_id"}))
+		})
+	})
+
+	Describe("DrainCellRequest", func() {
+		It("requires a cell id", func() {
+			request := models.DrainCellRequest{}
+			Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"cell_id"}))
+		})
+	})
+
+	Describe("CellDrainStatusRequest", func() {
+		It("requires a cell id", func() {
+			request := models.CellDrainStatusRequest{}
+			Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"cell_id"}))
+		})
+	})
+})
@@ -93,6 +93,7 @@ type CellPresence struct {
 	PlacementTags         []string      `protobuf:"bytes,6,rep,name=placement_tags,json=placementTags,proto3" json:"placement_tags,omitempty"`
 	OptionalPlacementTags []string      `protobuf:"bytes,7,rep,name=optional_placement_tags,json=optionalPlacementTags,proto3" json:"optional_placement_tags,omitempty"`
 	RepUrl                string        `protobuf:"bytes,8,opt,name=rep_url,json=repUrl,proto3" json:"rep_url"`
+	Cordoned              bool          `protobuf:"varint,9,opt,name=cordoned,proto3" json:"cordoned,omitempty"`
 }
 
 func (m *CellPresence) Reset()      { *m = CellPresence{} }
@@ -183,6 +184,13 @@ func (m *CellPresence) GetRepUrl() string {
 	return ""
 }
 
+func (m *CellPresence) GetCordoned() bool {
+	if m != nil {
+		return m.Cordoned
+	}
+	return false
+}
+
 type Provider struct {
 	Name       string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
 	Properties []string `protobuf:"bytes,2,rep,name=properties,proto3" json:"properties,omitempty"`
@@ -295,42 +303,42 @@ func init() {
 func init() { proto.RegisterFile("cells.proto", fileDescriptor_842e821272d22ff7) }
 
 var fileDescriptor_842e821272d22ff7 = []byte{
-	// 548 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x53, 0x4f, 0x6f, 0xd3, 0x30,
-	0x14, 0x4f, 0xe8, 0x9a, 0xb5, 0x0e, 0xdd, 0x26, 0x0b, 0x44, 0x35, 0x21, 0xa7, 0x2a, 0x43, 0xaa,
-	0x26, 0xe8, 0xa6, 0x81, 0xb8, 0xd3, 0x09, 0x09, 0x0e, 0x93, 0x26, 0x0b, 0xce, 0x21, 0x7f, 0xde,
-	0x4a, 0x44, 0x12, 0x5b, 0xb6, 0x8b, 0x54, 0x4e, 0x7c, 0x84, 0x7d, 0x00, 0x3e, 0x00, 0x1f, 0x85,
-	0x63, 0x8f, 0x3b, 0x45, 0x34, 0xbd, 0xa0, 0x9c, 0xf6, 0x11, 0x90, 0x9d, 0x66, 0x2b, 0xbd, 0x58,
-	0xbf, 0xf7, 0x7b, 0xbf, 0x67, 0x3f, 0xff, 0x9e, 0x8d, 0xdc, 0x08, 0xd2, 0x54, 0x8e, 0xb9, 0x60,
-	0x8a, 0x61, 0x27, 0x63, 0x31, 0xa4, 0xf2, 0xf0, 0xe5, 0x34, 0x51, 0x5f, 0x66, 0xe1, 0x38, 0x62,
-	0xd9, 0xc9, 0x94, 0x4d, 0xd9, 0x89, 0x49, 0x87, 0xb3, 0x2b, 0x13, 0x99, 0xc0, 0xa0, 0xba, 0xec,
-	0xd0, 0x05, 0x21, 0x98, 0xa8, 0x83, 0xe1, 0xb5, 0x8d, 0x1e, 0x9e, 0x43, 0x9a, 0x9e, 0x07, 0x3c,
-	0x88, 0x12, 0x35, 0xc7, 0xc7, 0xa8, 0x9b, 0x41, 0xc6, 0xc4, 0xdc, 0xcf, 0xc2, 0xbe, 0x3d, 0xb0,
-	0x47, 0xed, 0x49, 0xaf, 0x2a, 0xbc, 0x7b, 0x92, 0x76, 0x6a, 0x78, 0x11, 0xe2, 0x23, 0xb4, 0x1b,
-	0x27, 0xf2, 0xab, 0x56, 0x3e, 0x30, 0x4a, 0xb7, 0x2a, 0xbc, 0x86, 0xa2, 0x8e, 0x06, 0x17, 0x21,
-	0x1e, 0x23, 0x14, 0xb1, 0x5c, 0x05, 0x49, 0x0e, 0x42, 0xf6, 0x5b, 0x46, 0xb8, 0x57, 0x15, 0xde,
-	0x06, 0x4b, 0x37, 0xf0, 0xf0, 0x67, 0xab, 0x6e, 0xe9, 0x52, 0x80, 0x84, 0x3c, 0x02, 0x7d, 0x8c,
-	0xbe, 0xb6, 0x9f, 0xc4, 0xa6, 0xa1, 0x6e, 0x7d, 0xcc, 0x9a, 0xa2, 0x8e, 0x06, 0x1f, 0x62, 0x7c,
-	0x8a, 0x5c, 0x01, 0xdc, 0x0f, 0xe2, 0x58, 0x80, 0x94, 0xa6, 0xa1, 0xee, 0x64, 0xbf, 0x2a, 0xbc,
-	0x4d, 0x9a, 0x22, 0x01, 0xfc, 0x6d, 0x8d, 0xf1, 0x53, 0xb4, 0xf3, 0x9d, 0xe5, 0x60, 0x5a, 0xea,
-	0x4e, 0x3a, 0x55, 0xe1, 0x99, 0x98, 0x9a, 0x15, 0x9f, 0xa2, 0x4e, 0xb4, 0x36, 0xa5, 0xbf, 0x33,
-	0xb0, 0x47, 0xee, 0xd9, 0xa3, 0x71, 0x6d, 0xf8, 0x78, 0xd3, 0x30, 0x7a, 0xa7, 0xc2, 0x3e, 0x3a,
-	0x10, 0x8c, 0xa9, 0x2b, 0xe9, 0x73, 0xc1, 0xbe, 0x25, 0xb1, 0xbe, 0x6e, 0x7b, 0xd0, 0x1a, 0xb9,
-	0x67, 0x07, 0x4d, 0xe5, 0xe5, 0x3a, 0x31, 0x19, 0x56, 0x85, 0x47, 0xb6, 0xd4, 0x7e, 0x9a, 0x48,
-	0xf5, 0x82, 0x65, 0x89, 0x82, 0x8c, 0xab, 0x39, 0xdd, 0xaf, 0xf3, 0x4d, 0x8d, 0xc4, 0xcf, 0xd1,
-	0x1e, 0x4f, 0x83, 0x08, 0x32, 0xc8, 0x95, 0xaf, 0x82, 0xa9, 0xec, 0x3b, 0x83, 0xd6, 0xa8, 0x4b,
-	0x7b, 0x77, 0xec, 0xc7, 0x60, 0x2a, 0xf1, 0x1b, 0xf4, 0x84, 0x71, 0x95, 0xb0, 0x3c, 0x48, 0xfd,
-	0x2d, 0xfd, 0xae, 0xd1, 0x3f, 0x6e, 0xd2, 0x97, 0xff, 0xd5, 0x1d, 0xa1, 0x5d, 0x6d, 0xd5, 0x4c,
-	0xa4, 0xfd, 0xce, 0xbd, 0xcf, 0x6b, 0x8a, 0x3a, 0x02, 0xf8, 0x27, 0x91, 0x0e, 0xdf, 0xa3, 0x4e,
-	0xd3, 0x91, 0x76, 0x30, 0x0f, 0x32, 0x58, 0x8f, 0xc5, 0x38, 0xa8, 0x63, 0x6a, 0x56, 0x4c, 0x10,
-	0xe2, 0x82, 0x71, 0x10, 0x2a, 0x01, 0x3d, 0x10, 0x7d, 0xf4, 0x06, 0x33, 0xfc, 0x8c, 0x7a, 0xda,
-	0x49, 0x49, 0x41, 0x72, 0x96, 0x4b, 0xc0, 0xcf, 0x50, 0xdb, 0xbc, 0x4d, 0xb3, 0x9f, 0x7b, 0xd6,
-	0x6b, 0x5c, 0x7b, 0xa7, 0x49, 0x5a, 0xe7, 0xf0, 0x31, 0x6a, 0x9b, 0x4f, 0x60, 0x36, 0xdc, 0x1a,
-	0x4a, 0xf3, 0x64, 0x68, 0x2d, 0x99, 0xbc, 0x5e, 0x2c, 0x89, 0x75, 0xb3, 0x24, 0xd6, 0xed, 0x92,
-	0xd8, 0x3f, 0x4a, 0x62, 0xff, 0x2a, 0x89, 0xfd, 0xbb, 0x24, 0xf6, 0xa2, 0x24, 0xf6, 0x9f, 0x92,
-	0xd8, 0x7f, 0x4b, 0x62, 0xdd, 0x96, 0xc4, 0xbe, 0x5e, 0x11, 0x6b, 0xb1, 0x22, 0xd6, 0xcd, 0x8a,
-	0x58, 0xa1, 0x63, 0xbe, 0xc6, 0xab, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x12, 0x8c, 0x77, 0x8d,
-	0x6d, 0x03, 0x00, 0x00,
+	// 559 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x53, 0xcf, 0x6e, 0xd3, 0x30,
+	0x1c, 0x8e, 0xe9, 0x9a, 0x25, 0x0e, 0xdd, 0x26, 0x0b, 0x44, 0x34, 0x21, 0xa7, 0x2a, 0x43, 0xaa,
+	0x26, 0xe8, 0xa6, 0x81, 0xb8, 0xd3, 0x09, 0x09, 0x0e, 0x93, 0x26, 0x0b, 0xce, 0x21, 0x7f, 0xbc,
+	0x12, 0x91, 0xc4, 0x96, 0xed, 0x21, 0x8d, 0x13, 0x8f, 0xb0, 0xc7, 0xe0, 0x11, 0x78, 0x04, 0x8e,
+	0x3b, 0xee, 0x14, 0xb1, 0xf4, 0x82, 0x72, 0xda, 0x23, 0x20, 0x3b, 0x4d, 0x5b, 0x7a, 0x89, 0x7e,
+	0xdf, 0xf7, 0xfb, 0x7e, 0xf1, 0xe7, 0xcf, 0x36, 0xf4, 0x12, 0x9a, 0xe7, 0x72, 0xc2, 0x05, 0x53,
+	0x0c, 0xd9, 0x05, 0x4b, 0x69, 0x2e, 0xf7, 0x5f, 0xce, 0x32, 0xf5, 0xe5, 0x32, 0x9e, 0x24, 0xac,
+	0x38, 0x9a, 0xb1, 0x19, 0x3b, 0x32, 0xed, 0xf8, 0xf2, 0xc2, 0x20, 0x03, 0x4c, 0xd5, 0x8e, 0xed,
+	0x7b, 0x54, 0x08, 0x26, 0x5a, 0x30, 0xba, 0x06, 0xf0, 0xe1, 0x29, 0xcd, 0xf3, 0xd3, 0x88, 0x47,
+	0x49, 0xa6, 0xae, 0xd0, 0x21, 0x74, 0x0b, 0x5a, 0x30, 0x71, 0x15, 0x16, 0xb1, 0x0f, 0x86, 0x60,
+	0xdc, 0x9f, 0x0e, 0x9a, 0x2a, 0x58, 0x91, 0xc4, 0x69, 0xcb, 0xb3, 0x18, 0x1d, 0xc0, 0xed, 0x34,
+	0x93, 0x5f, 0xb5, 0xf2, 0x81, 0x51, 0x7a, 0x4d, 0x15, 0x74, 0x14, 0xb1, 0x75, 0x71, 0x16, 0xa3,
+	0x09, 0x84, 0x09, 0x2b, 0x55, 0x94, 0x95, 0x54, 0x48, 0xbf, 0x67, 0x84, 0x3b, 0x4d, 0x15, 0xac,
+	0xb1, 0x64, 0xad, 0x1e, 0xfd, 0xea, 0xb5, 0x96, 0xce, 0x05, 0x95, 0xb4, 0x4c, 0xa8, 0x5e, 0x46,
+	0x6f, 0x3b, 0xcc, 0x52, 0x63, 0xc8, 0x6d, 0x97, 0x59, 0x50, 0xc4, 0xd6, 0xc5, 0x87, 0x14, 0x1d,
+	0x43, 0x4f, 0x50, 0x1e, 0x46, 0x69, 0x2a, 0xa8, 0x94, 0xc6, 0x90, 0x3b, 0xdd, 0x6d, 0xaa, 0x60,
+	0x9d, 0x26, 0x50, 0x50, 0xfe, 0xb6, 0xad, 0xd1, 0x53, 0xb8, 0xf5, 0x9d, 0x95, 0xd4, 0x58, 0x72,
+	0xa7, 0x4e, 0x53, 0x05, 0x06, 0x13, 0xf3, 0x45, 0xc7, 0xd0, 0x49, 0x16, 0xa1, 0xf8, 0x5b, 0x43,
+	0x30, 0xf6, 0x4e, 0x1e, 0x4d, 0xda, 0xc0, 0x27, 0xeb, 0x81, 0x91, 0xa5, 0x0a, 0x85, 0x70, 0x4f,
+	0x30, 0xa6, 0x2e, 0x64, 0xc8, 0x05, 0xfb, 0x96, 0xa5, 0x7a, 0xbb, 0xfd, 0x61, 0x6f, 0xec, 0x9d,
+	0xec, 0x75, 0x93, 0xe7, 0x8b, 0xc6, 0x74, 0xd4, 0x54, 0x01, 0xde, 0x50, 0x87, 0x79, 0x26, 0xd5,
+	0x0b, 0x56, 0x64, 0x8a, 0x16, 0x5c, 0x5d, 0x91, 0xdd, 0xb6, 0xdf, 0xcd, 0x48, 0xf4, 0x1c, 0xee,
+	0xf0, 0x3c, 0x4a, 0x68, 0x41, 0x4b, 0x15, 0xaa, 0x68, 0x26, 0x7d, 0x7b, 0xd8, 0x1b, 0xbb, 0x64,
+	0xb0, 0x64, 0x3f, 0x46, 0x33, 0x89, 0xde, 0xc0, 0x27, 0x8c, 0xab, 0x8c, 0x95, 0x51, 0x1e, 0x6e,
+	0xe8, 0xb7, 0x8d, 0xfe, 0x71, 0xd7, 0x3e, 0xff, 0x6f, 0xee, 0x00, 0x6e, 0xeb, 0xa8, 0x2e, 0x45,
+	0xee, 0x3b, 0xab, 0x9c, 0x17, 0x14, 0xb1, 0x05, 0xe5, 0x9f, 0x44, 0x8e, 0xf6, 0xa1, 0x93, 0x30,
+	0x91, 0xb2, 0x92, 0xa6, 0xbe, 0x3b, 0x04, 0x63, 0x87, 0x2c, 0xf1, 0xe8, 0x3d, 0x74, 0x3a, 0xb7,
+	0x3a, 0xdd, 0x32, 0x2a, 0xa8, 0x0f, 0x56, 0xe9, 0x6a, 0x4c, 0xcc, 0x17, 0x61, 0x08, 0xb9, 0x60,
+	0x9c, 0x0a, 0x95, 0x51, 0x7d, 0x58, 0xda, 0xd6, 0x1a, 0x33, 0xfa, 0x0c, 0x07, 0x3a, 0x65, 0x49,
+	0xa8, 0xe4, 0xac, 0x94, 0x14, 0x3d, 0x83, 0x7d, 0x73, 0x6f, 0xcd, 0xff, 0xbc, 0x93, 0x41, 0x97,
+	0xe8, 0x3b, 0x4d, 0x92, 0xb6, 0x87, 0x0e, 0x61, 0xdf, 0x3c, 0x10, 0xf3, 0xc3, 0x8d, 0x03, 0xeb,
+	0xae, 0x13, 0x69, 0x25, 0xd3, 0xd7, 0x37, 0x77, 0xd8, 0xba, 0xbd, 0xc3, 0xd6, 0xfd, 0x1d, 0x06,
+	0x3f, 0x6a, 0x0c, 0x7e, 0xd6, 0x18, 0xfc, 0xae, 0x31, 0xb8, 0xa9, 0x31, 0xf8, 0x53, 0x63, 0xf0,
+	0xb7, 0xc6, 0xd6, 0x7d, 0x8d, 0xc1, 0xf5, 0x1c, 0x5b, 0x37, 0x73, 0x6c, 0xdd, 0xce, 0xb1, 0x15,
+	0xdb, 0xe6, 0xd9, 0xbc, 0xfa, 0x37, 0x00, 0x0d, 0xdf, 0x9c, 0x08, 0x89, 0x03, 0x00, 0x00,
 }
 
 func (this *CellCapacity) Equal(that interface{}) bool {
@@ -421,6 +429,9 @@ func (this *CellPresence) Equal(that interface{}) bool {
 	if this.RepUrl != that1.RepUrl {
 		return false
 	}
+	if this.Cordoned != that1.Cordoned {
+		return false
+	}
 	return true
 }
 func (this *Provider) Equal(that interface{}) bool {
@@ -503,7 +514,7 @@ func (this *CellPresence) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 12)
+	s := make([]string, 0, 13)
 	s = append(s, "&models.CellPresence{")
 	s = append(s, "CellId: "+fmt.Sprintf("%#v", this.CellId)+",\n")
 	s = append(s, "RepAddress: "+fmt.Sprintf("%#v", this.RepAddress)+",\n")
@@ -517,6 +528,7 @@ func (this *CellPresence) GoString() string {
 	s = append(s, "PlacementTags: "+fmt.Sprintf("%#v", this.PlacementTags)+",\n")
 	s = append(s, "OptionalPlacementTags: "+fmt.Sprintf("%#v", this.OptionalPlacementTags)+",\n")
 	s = append(s, "RepUrl: "+fmt.Sprintf("%#v", this.RepUrl)+",\n")
+	s = append(s, "Cordoned: "+fmt.Sprintf("%#v", this.Cordoned)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -612,6 +624,16 @@ func (m *CellPresence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Cordoned {
+		i--
+		if m.Cordoned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
 	if len(m.RepUrl) > 0 {
 		i -= len(m.RepUrl)
 		copy(dAtA[i:], m.RepUrl)
@@ -848,6 +870,9 @@ func (m *CellPresence) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCells(uint64(l))
 	}
+	if m.Cordoned {
+		n += 2
+	}
 	return n
 }
 
@@ -925,6 +950,7 @@ func (this *CellPresence) String() string {
 		`PlacementTags:` + fmt.Sprintf("%v", this.PlacementTags) + `,`,
 		`OptionalPlacementTags:` + fmt.Sprintf("%v", this.OptionalPlacementTags) + `,`,
 		`RepUrl:` + fmt.Sprintf("%v", this.RepUrl) + `,`,
+		`Cordoned:` + fmt.Sprintf("%v", this.Cordoned) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1362,6 +1388,26 @@ func (m *CellPresence) Unmarshal(dAtA []byte) error {
 			}
 			m.RepUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cordoned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCells
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cordoned = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCells(dAtA[iNdEx:])
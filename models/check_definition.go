@@ -1,5 +1,7 @@
 package models
 
+import "time"
+
 type PortChecker interface {
 	GetPort() uint32
 }
@@ -29,6 +31,62 @@ func (check CheckDefinition) Validate() error {
 
 }
 
+// hasPortChecksOnly returns whether every check can be run by clients that
+// only know about TCP and HTTP checks.
+func (check *CheckDefinition) hasPortChecksOnly() bool {
+	for _, c := range append(append([]*Check{}, check.GetChecks()...), check.GetReadinessChecks()...) {
+		if c.ExecCheck != nil || c.GrpcCheck != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// portChecksOnly returns a copy of the check definition without its exec and
+// gRPC checks, or nil if no checks are left.
+func (check *CheckDefinition) portChecksOnly() *CheckDefinition {
+	if check.hasPortChecksOnly() {
+		return check
+	}
+
+	downgraded := &CheckDefinition{LogSource: check.LogSource}
+	for _, c := range check.Checks {
+		if c.ExecCheck == nil && c.GrpcCheck == nil {
+			downgraded.Checks = append(downgraded.Checks, c)
+		}
+	}
+	for _, c := range check.ReadinessChecks {
+		if c.ExecCheck == nil && c.GrpcCheck == nil {
+			downgraded.ReadinessChecks = append(downgraded.ReadinessChecks, c)
+		}
+	}
+
+	if len(downgraded.Checks) == 0 && len(downgraded.ReadinessChecks) == 0 {
+		return nil
+	}
+	return downgraded
+}
+
+// execMonitor returns a monitor action running the first exec liveness
+// check, if any.
+func (check *CheckDefinition) execMonitor() *Action {
+	for _, c := range check.GetChecks() {
+		if exec := c.ExecCheck; exec != nil {
+			var run ActionInterface = &RunAction{
+				Path:      exec.Path,
+				Args:      exec.Args,
+				User:      exec.User,
+				LogSource: check.LogSource,
+			}
+			if exec.TimeoutMs > 0 {
+				run = Timeout(run, time.Duration(exec.TimeoutMs)*time.Millisecond)
+			}
+			return WrapAction(run)
+		}
+	}
+	return nil
+}
+
 func (check Check) GetPortChecker() PortChecker {
 	httpCheck := check.GetHttpCheck()
 	tcpCheck := check.GetTcpCheck()
@@ -44,12 +102,58 @@ func (check Check) GetPortChecker() PortChecker {
 
 func (check Check) Validate() error {
 	var validationError ValidationError
-	c := check.GetPortChecker()
 
-	if c == nil {
+	checkers := []Validator{}
+	if check.TcpCheck != nil {
+		checkers = append(checkers, check.TcpCheck)
+	}
+	if check.HttpCheck != nil {
+		checkers = append(checkers, check.HttpCheck)
+	}
+	if check.ExecCheck != nil {
+		checkers = append(checkers, check.ExecCheck)
+	}
+	if check.GrpcCheck != nil {
+		checkers = append(checkers, check.GrpcCheck)
+	}
+
+	if len(checkers) != 1 {
 		validationError = validationError.Append(ErrInvalidField{"check"})
-	} else if !(c.GetPort() > 0 && c.GetPort() <= 65535) {
-		validationError = validationError.Append(ErrInvalidField{"port"})
+	} else {
+		validationError = validationError.Check(checkers...)
+	}
+	return validationError.ToError()
+}
+
+func (check TCPCheck) Validate() error {
+	return validatePort(check.Port)
+}
+
+func (check HTTPCheck) Validate() error {
+	return validatePort(check.Port)
+}
+
+func (check GRPCCheck) Validate() error {
+	return validatePort(check.Port)
+}
+
+func (check ExecCheck) Validate() error {
+	var validationError ValidationError
+
+	if check.Path == "" {
+		validationError = validationError.Append(ErrInvalidField{"path"})
 	}
+
+	if check.User == "" {
+		validationError = validationError.Append(ErrInvalidField{"user"})
+	}
+
 	return validationError.ToError()
 }
+
+func validatePort(port uint32) error {
+	if !(port > 0 && port <= 65535) {
+		return ErrInvalidField{"port"}
+	}
+	return nil
+}
@@ -89,6 +89,8 @@ type Check struct {
 	// oneof check {
 	TcpCheck  *TCPCheck  `protobuf:"bytes,1,opt,name=tcp_check,json=tcpCheck,proto3" json:"tcp_check,omitempty"`
 	HttpCheck *HTTPCheck `protobuf:"bytes,2,opt,name=http_check,json=httpCheck,proto3" json:"http_check,omitempty"`
+	ExecCheck *ExecCheck `protobuf:"bytes,3,opt,name=exec_check,json=execCheck,proto3" json:"exec_check,omitempty"`
+	GrpcCheck *GRPCCheck `protobuf:"bytes,4,opt,name=grpc_check,json=grpcCheck,proto3" json:"grpc_check,omitempty"`
 }
 
 func (m *Check) Reset()      { *m = Check{} }
@@ -137,6 +139,20 @@ func (m *Check) GetHttpCheck() *HTTPCheck {
 	return nil
 }
 
+func (m *Check) GetExecCheck() *ExecCheck {
+	if m != nil {
+		return m.ExecCheck
+	}
+	return nil
+}
+
+func (m *Check) GetGrpcCheck() *GRPCCheck {
+	if m != nil {
+		return m.GrpcCheck
+	}
+	return nil
+}
+
 type TCPCheck struct {
 	Port             uint32 `protobuf:"varint,1,opt,name=port,proto3" json:"port"`
 	ConnectTimeoutMs uint64 `protobuf:"varint,2,opt,name=connect_timeout_ms,json=connectTimeoutMs,proto3" json:"connect_timeout_ms,omitempty"`
@@ -263,43 +279,194 @@ func (m *HTTPCheck) GetIntervalMs() uint64 {
 	return 0
 }
 
+type ExecCheck struct {
+	Path       string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path"`
+	Args       []string `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
+	User       string   `protobuf:"bytes,3,opt,name=user,proto3" json:"user"`
+	TimeoutMs  uint64   `protobuf:"varint,4,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	IntervalMs uint64   `protobuf:"varint,5,opt,name=interval_ms,json=intervalMs,proto3" json:"interval_ms,omitempty"`
+}
+
+func (m *ExecCheck) Reset()      { *m = ExecCheck{} }
+func (*ExecCheck) ProtoMessage() {}
+func (*ExecCheck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_048a62b88ce7913d, []int{4}
+}
+func (m *ExecCheck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecCheck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecCheck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecCheck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecCheck.Merge(m, src)
+}
+func (m *ExecCheck) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecCheck) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecCheck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecCheck proto.InternalMessageInfo
+
+func (m *ExecCheck) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *ExecCheck) GetArgs() []string {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *ExecCheck) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *ExecCheck) GetTimeoutMs() uint64 {
+	if m != nil {
+		return m.TimeoutMs
+	}
+	return 0
+}
+
+func (m *ExecCheck) GetIntervalMs() uint64 {
+	if m != nil {
+		return m.IntervalMs
+	}
+	return 0
+}
+
+type GRPCCheck struct {
+	Port             uint32 `protobuf:"varint,1,opt,name=port,proto3" json:"port"`
+	Service          string `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	RequestTimeoutMs uint64 `protobuf:"varint,3,opt,name=request_timeout_ms,json=requestTimeoutMs,proto3" json:"request_timeout_ms,omitempty"`
+	IntervalMs       uint64 `protobuf:"varint,4,opt,name=interval_ms,json=intervalMs,proto3" json:"interval_ms,omitempty"`
+}
+
+func (m *GRPCCheck) Reset()      { *m = GRPCCheck{} }
+func (*GRPCCheck) ProtoMessage() {}
+func (*GRPCCheck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_048a62b88ce7913d, []int{5}
+}
+func (m *GRPCCheck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GRPCCheck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GRPCCheck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GRPCCheck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GRPCCheck.Merge(m, src)
+}
+func (m *GRPCCheck) XXX_Size() int {
+	return m.Size()
+}
+func (m *GRPCCheck) XXX_DiscardUnknown() {
+	xxx_messageInfo_GRPCCheck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GRPCCheck proto.InternalMessageInfo
+
+func (m *GRPCCheck) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func (m *GRPCCheck) GetService() string {
+	if m != nil {
+		return m.Service
+	}
+	return ""
+}
+
+func (m *GRPCCheck) GetRequestTimeoutMs() uint64 {
+	if m != nil {
+		return m.RequestTimeoutMs
+	}
+	return 0
+}
+
+func (m *GRPCCheck) GetIntervalMs() uint64 {
+	if m != nil {
+		return m.IntervalMs
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*CheckDefinition)(nil), "models.CheckDefinition")
 	proto.RegisterType((*Check)(nil), "models.Check")
 	proto.RegisterType((*TCPCheck)(nil), "models.TCPCheck")
 	proto.RegisterType((*HTTPCheck)(nil), "models.HTTPCheck")
+	proto.RegisterType((*ExecCheck)(nil), "models.ExecCheck")
+	proto.RegisterType((*GRPCCheck)(nil), "models.GRPCCheck")
 }
 
 func init() { proto.RegisterFile("check_definition.proto", fileDescriptor_048a62b88ce7913d) }
 
 var fileDescriptor_048a62b88ce7913d = []byte{
-	// 414 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x31, 0x8f, 0xd3, 0x40,
-	0x10, 0x85, 0xbd, 0x97, 0x10, 0xc5, 0x13, 0x1d, 0x17, 0xb6, 0x40, 0x11, 0x42, 0x9b, 0xc8, 0x12,
-	0x52, 0x0a, 0xe2, 0x43, 0x07, 0x05, 0x75, 0x8e, 0x82, 0xe6, 0x24, 0x64, 0xdc, 0x5b, 0xce, 0x66,
-	0xcf, 0xb6, 0xb0, 0xbd, 0xc6, 0xbb, 0x86, 0x96, 0x9f, 0x40, 0x45, 0x4f, 0xc7, 0x4f, 0xa1, 0x4c,
-	0x99, 0x2a, 0x22, 0x4e, 0x83, 0x52, 0xe5, 0x27, 0x20, 0x8f, 0xed, 0x80, 0xa2, 0x48, 0x34, 0xd6,
-	0xcc, 0xbe, 0xef, 0xbd, 0x37, 0x85, 0xe1, 0x31, 0x0f, 0x05, 0xff, 0xe0, 0x2d, 0xc5, 0x7d, 0x94,
-	0x46, 0x3a, 0x92, 0xa9, 0x9d, 0xe5, 0x52, 0x4b, 0xda, 0x4b, 0xe4, 0x52, 0xc4, 0xea, 0xc9, 0x2c,
-	0x88, 0x74, 0x58, 0x2c, 0x6c, 0x2e, 0x93, 0xeb, 0x40, 0x06, 0xf2, 0x1a, 0xe5, 0x45, 0x71, 0x8f,
-	0x1b, 0x2e, 0x38, 0xd5, 0x36, 0xeb, 0x3b, 0x81, 0xab, 0xdb, 0x2a, 0xf1, 0xcd, 0x31, 0x90, 0x3e,
-	0x83, 0x1e, 0x96, 0xa8, 0x11, 0x99, 0x74, 0xa6, 0x83, 0x9b, 0x4b, 0xbb, 0xce, 0xb6, 0x11, 0x74,
-	0x1a, 0x91, 0xce, 0x00, 0x62, 0x19, 0x78, 0x4a, 0x16, 0x39, 0x17, 0xa3, 0x8b, 0x09, 0x99, 0x9a,
-	0xf3, 0x87, 0xfb, 0xcd, 0xf8, 0x9f, 0x57, 0xc7, 0x8c, 0x65, 0xf0, 0x1e, 0x47, 0xfa, 0x1a, 0x86,
-	0xb9, 0xf0, 0x97, 0x51, 0x2a, 0x94, 0xf2, 0x9a, 0xfc, 0xce, 0xb9, 0xfc, 0xab, 0x23, 0x86, 0xbb,
-	0xb2, 0x42, 0x78, 0x80, 0x13, 0x9d, 0x81, 0xa9, 0x79, 0x56, 0x9b, 0x47, 0x64, 0x42, 0xa6, 0x83,
-	0x9b, 0x61, 0xeb, 0x75, 0x6f, 0xdf, 0xd5, 0xf6, 0xbe, 0xe6, 0x59, 0x8d, 0xbf, 0x00, 0x08, 0xb5,
-	0x6e, 0xf9, 0x0b, 0xe4, 0x1f, 0xb5, 0xfc, 0x5b, 0xd7, 0x6d, 0x0c, 0x66, 0x05, 0xe1, 0x68, 0x7d,
-	0x86, 0x7e, 0x9b, 0x43, 0x9f, 0x42, 0x37, 0x93, 0xb9, 0xc6, 0x9e, 0xcb, 0x79, 0x7f, 0xbf, 0x19,
-	0xe3, 0xee, 0xe0, 0x97, 0x3e, 0x07, 0xca, 0x65, 0x9a, 0x0a, 0xae, 0x3d, 0x1d, 0x25, 0x42, 0x16,
-	0xda, 0x4b, 0x14, 0x76, 0x74, 0x9d, 0x61, 0xa3, 0xb8, 0xb5, 0x70, 0xa7, 0xe8, 0x18, 0x06, 0x51,
-	0xaa, 0x45, 0xfe, 0xc9, 0x8f, 0x2b, 0xac, 0x83, 0x18, 0xb4, 0x4f, 0x77, 0xca, 0xfa, 0x46, 0xc0,
-	0x3c, 0x5e, 0xf4, 0xff, 0xea, 0x5c, 0x7c, 0x2c, 0x84, 0x3a, 0x57, 0xdd, 0x28, 0x7f, 0xab, 0xab,
-	0x2c, 0x5f, 0x87, 0xd8, 0x69, 0x36, 0x59, 0xbe, 0x0e, 0x1d, 0xfc, 0x9e, 0x1e, 0xd6, 0x3d, 0x3d,
-	0x6c, 0xfe, 0x6a, 0xb5, 0x65, 0xc6, 0x7a, 0xcb, 0x8c, 0xc3, 0x96, 0x91, 0x2f, 0x25, 0x23, 0x3f,
-	0x4a, 0x46, 0x7e, 0x96, 0x8c, 0xac, 0x4a, 0x46, 0x7e, 0x95, 0x8c, 0xfc, 0x2e, 0x99, 0x71, 0x28,
-	0x19, 0xf9, 0xba, 0x63, 0xc6, 0x6a, 0xc7, 0x8c, 0xf5, 0x8e, 0x19, 0x8b, 0x1e, 0xfe, 0x5c, 0x2f,
-	0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xdc, 0xc4, 0x3b, 0x86, 0xad, 0x02, 0x00, 0x00,
+	// 526 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x3f, 0x6f, 0x13, 0x4d,
+	0x10, 0xc6, 0x6f, 0x73, 0x8e, 0x5f, 0xdf, 0x58, 0x79, 0x63, 0xb6, 0x40, 0x16, 0x82, 0xb5, 0x65,
+	0x09, 0xc9, 0x05, 0x76, 0x50, 0xa0, 0xa0, 0xb6, 0x41, 0xd0, 0x44, 0x8a, 0x0e, 0xf7, 0x96, 0xbd,
+	0xde, 0x9c, 0x4f, 0xd8, 0xb7, 0xc7, 0xee, 0x5e, 0x48, 0xc9, 0x47, 0x40, 0x14, 0x34, 0x54, 0x74,
+	0x7c, 0x14, 0x0a, 0x0a, 0x97, 0xa9, 0x22, 0x7c, 0x6e, 0x50, 0xaa, 0x7c, 0x04, 0x74, 0x73, 0x7f,
+	0x70, 0x88, 0x2d, 0x37, 0xab, 0x99, 0x9b, 0xe7, 0xf7, 0xcc, 0xec, 0x9c, 0x16, 0xee, 0xf3, 0xa9,
+	0xe0, 0xef, 0x86, 0x13, 0x71, 0xe6, 0x07, 0xbe, 0xf1, 0x65, 0xd0, 0x0d, 0x95, 0x34, 0x92, 0x96,
+	0xe7, 0x72, 0x22, 0x66, 0xfa, 0x41, 0xc7, 0xf3, 0xcd, 0x34, 0x1a, 0x77, 0xb9, 0x9c, 0x1f, 0x79,
+	0xd2, 0x93, 0x47, 0x58, 0x1e, 0x47, 0x67, 0x98, 0x61, 0x82, 0x51, 0x8a, 0xb5, 0xbe, 0x11, 0x38,
+	0xec, 0x27, 0x8e, 0x2f, 0x0b, 0x43, 0xfa, 0x18, 0xca, 0xd8, 0x44, 0xd7, 0x49, 0xd3, 0x6e, 0x57,
+	0x8f, 0x0f, 0xba, 0xa9, 0x77, 0x17, 0x85, 0x6e, 0x56, 0xa4, 0x1d, 0x80, 0x99, 0xf4, 0x86, 0x5a,
+	0x46, 0x8a, 0x8b, 0xfa, 0x5e, 0x93, 0xb4, 0x9d, 0xde, 0xff, 0xd7, 0x57, 0x8d, 0xb5, 0xaf, 0xae,
+	0x33, 0x93, 0xde, 0x5b, 0x0c, 0xe9, 0x0b, 0xa8, 0x29, 0x31, 0x9a, 0xf8, 0x81, 0xd0, 0x7a, 0x98,
+	0xf9, 0xdb, 0x9b, 0xfc, 0x0f, 0x0b, 0x19, 0xe6, 0xba, 0xf5, 0x93, 0xc0, 0x3e, 0x86, 0xb4, 0x03,
+	0x8e, 0xe1, 0x61, 0x4a, 0xd7, 0x49, 0x93, 0xb4, 0xab, 0xc7, 0xb5, 0x1c, 0x1e, 0xf4, 0x4f, 0x53,
+	0xbe, 0x62, 0x78, 0x98, 0xca, 0x9f, 0x02, 0x4c, 0x8d, 0xc9, 0xf5, 0x7b, 0xa8, 0xbf, 0x97, 0xeb,
+	0xdf, 0x0c, 0x06, 0x19, 0xe0, 0x24, 0xa2, 0x82, 0x10, 0x17, 0x82, 0x67, 0x84, 0x7d, 0x9b, 0x78,
+	0x75, 0x21, 0x78, 0x46, 0x88, 0x3c, 0x4c, 0x08, 0x4f, 0x85, 0x39, 0x51, 0xba, 0x4d, 0xbc, 0x76,
+	0x4f, 0xfb, 0x19, 0x91, 0x88, 0x30, 0x6c, 0x7d, 0x80, 0x4a, 0x3e, 0x2b, 0x7d, 0x08, 0xa5, 0x50,
+	0x2a, 0x83, 0x77, 0x39, 0xe8, 0x55, 0xae, 0xaf, 0x1a, 0x98, 0xbb, 0x78, 0xd2, 0x27, 0x40, 0xb9,
+	0x0c, 0x02, 0xc1, 0xcd, 0xd0, 0xf8, 0x73, 0x21, 0x23, 0x33, 0x9c, 0x6b, 0xbc, 0x47, 0xc9, 0xad,
+	0x65, 0x95, 0x41, 0x5a, 0x38, 0xd1, 0xb4, 0x01, 0x55, 0x3f, 0x30, 0x42, 0x9d, 0x8f, 0x66, 0x89,
+	0xcc, 0x46, 0x19, 0xe4, 0x9f, 0x4e, 0x74, 0xeb, 0x0b, 0x01, 0xa7, 0xb8, 0xf5, 0xee, 0xd6, 0x4a,
+	0xbc, 0x8f, 0x84, 0xde, 0xd4, 0x3a, 0xab, 0xfc, 0x6d, 0x9d, 0x78, 0x8d, 0xcc, 0x14, 0x7b, 0x3a,
+	0x99, 0xd7, 0xc8, 0x4c, 0x5d, 0x3c, 0xff, 0x1d, 0xac, 0x74, 0x67, 0xb0, 0xaf, 0x04, 0x9c, 0x62,
+	0xb9, 0x85, 0x19, 0xd9, 0x68, 0x46, 0xa1, 0x34, 0x52, 0x5e, 0x32, 0x8a, 0xdd, 0x76, 0x5c, 0x8c,
+	0x13, 0x22, 0xd2, 0x42, 0xad, 0xb7, 0x4f, 0x72, 0x17, 0x4f, 0xfa, 0x08, 0x60, 0xed, 0x0a, 0x69,
+	0x77, 0xc7, 0x6c, 0x5b, 0xdb, 0xfe, 0x9d, 0xe9, 0x3e, 0x13, 0x70, 0x8a, 0x1f, 0xb9, 0x63, 0x6d,
+	0x75, 0xf8, 0x4f, 0x0b, 0x75, 0xee, 0xe7, 0x0f, 0xc2, 0xcd, 0xd3, 0x2d, 0x0b, 0xb5, 0xb7, 0x2c,
+	0x74, 0xd7, 0xca, 0x7a, 0xcf, 0x17, 0x4b, 0x66, 0x5d, 0x2e, 0x99, 0x75, 0xb3, 0x64, 0xe4, 0x63,
+	0xcc, 0xc8, 0xf7, 0x98, 0x91, 0x1f, 0x31, 0x23, 0x8b, 0x98, 0x91, 0x5f, 0x31, 0x23, 0xbf, 0x63,
+	0x66, 0xdd, 0xc4, 0x8c, 0x7c, 0x5a, 0x31, 0x6b, 0xb1, 0x62, 0xd6, 0xe5, 0x8a, 0x59, 0xe3, 0x32,
+	0x3e, 0xfa, 0x67, 0x7f, 0x06, 0x00, 0x84, 0x08, 0x9a, 0x90, 0x45, 0x04, 0x00, 0x00,
 }
 
 func (this *CheckDefinition) Equal(that interface{}) bool {
@@ -367,6 +534,12 @@ func (this *Check) Equal(that interface{}) bool {
 	if !this.HttpCheck.Equal(that1.HttpCheck) {
 		return false
 	}
+	if !this.ExecCheck.Equal(that1.ExecCheck) {
+		return false
+	}
+	if !this.GrpcCheck.Equal(that1.GrpcCheck) {
+		return false
+	}
 	return true
 }
 func (this *TCPCheck) Equal(that interface{}) bool {
@@ -432,6 +605,80 @@ func (this *HTTPCheck) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ExecCheck) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ExecCheck)
+	if !ok {
+		that2, ok := that.(ExecCheck)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Path != that1.Path {
+		return false
+	}
+	if len(this.Args) != len(that1.Args) {
+		return false
+	}
+	for i := range this.Args {
+		if this.Args[i] != that1.Args[i] {
+			return false
+		}
+	}
+	if this.User != that1.User {
+		return false
+	}
+	if this.TimeoutMs != that1.TimeoutMs {
+		return false
+	}
+	if this.IntervalMs != that1.IntervalMs {
+		return false
+	}
+	return true
+}
+func (this *GRPCCheck) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GRPCCheck)
+	if !ok {
+		that2, ok := that.(GRPCCheck)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Port != that1.Port {
+		return false
+	}
+	if this.Service != that1.Service {
+		return false
+	}
+	if this.RequestTimeoutMs != that1.RequestTimeoutMs {
+		return false
+	}
+	if this.IntervalMs != that1.IntervalMs {
+		return false
+	}
+	return true
+}
 func (this *CheckDefinition) GoString() string {
 	if this == nil {
 		return "nil"
@@ -452,7 +699,7 @@ func (this *Check) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 8)
 	s = append(s, "&models.Check{")
 	if this.TcpCheck != nil {
 		s = append(s, "TcpCheck: "+fmt.Sprintf("%#v", this.TcpCheck)+",\n")
@@ -460,6 +707,12 @@ func (this *Check) GoString() string {
 	if this.HttpCheck != nil {
 		s = append(s, "HttpCheck: "+fmt.Sprintf("%#v", this.HttpCheck)+",\n")
 	}
+	if this.ExecCheck != nil {
+		s = append(s, "ExecCheck: "+fmt.Sprintf("%#v", this.ExecCheck)+",\n")
+	}
+	if this.GrpcCheck != nil {
+		s = append(s, "GrpcCheck: "+fmt.Sprintf("%#v", this.GrpcCheck)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -488,6 +741,33 @@ func (this *HTTPCheck) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *ExecCheck) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&models.ExecCheck{")
+	s = append(s, "Path: "+fmt.Sprintf("%#v", this.Path)+",\n")
+	s = append(s, "Args: "+fmt.Sprintf("%#v", this.Args)+",\n")
+	s = append(s, "User: "+fmt.Sprintf("%#v", this.User)+",\n")
+	s = append(s, "TimeoutMs: "+fmt.Sprintf("%#v", this.TimeoutMs)+",\n")
+	s = append(s, "IntervalMs: "+fmt.Sprintf("%#v", this.IntervalMs)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GRPCCheck) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&models.GRPCCheck{")
+	s = append(s, "Port: "+fmt.Sprintf("%#v", this.Port)+",\n")
+	s = append(s, "Service: "+fmt.Sprintf("%#v", this.Service)+",\n")
+	s = append(s, "RequestTimeoutMs: "+fmt.Sprintf("%#v", this.RequestTimeoutMs)+",\n")
+	s = append(s, "IntervalMs: "+fmt.Sprintf("%#v", this.IntervalMs)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringCheckDefinition(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -574,6 +854,30 @@ func (m *Check) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.GrpcCheck != nil {
+		{
+			size, err := m.GrpcCheck.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCheckDefinition(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ExecCheck != nil {
+		{
+			size, err := m.ExecCheck.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCheckDefinition(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.HttpCheck != nil {
 		{
 			size, err := m.HttpCheck.MarshalToSizedBuffer(dAtA[:i])
@@ -684,38 +988,139 @@ func (m *HTTPCheck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintCheckDefinition(dAtA []byte, offset int, v uint64) int {
-	offset -= sovCheckDefinition(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ExecCheck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *CheckDefinition) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ExecCheck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecCheck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Checks) > 0 {
-		for _, e := range m.Checks {
-			l = e.Size()
-			n += 1 + l + sovCheckDefinition(uint64(l))
-		}
+	if m.IntervalMs != 0 {
+		i = encodeVarintCheckDefinition(dAtA, i, uint64(m.IntervalMs))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.LogSource)
-	if l > 0 {
-		n += 1 + l + sovCheckDefinition(uint64(l))
+	if m.TimeoutMs != 0 {
+		i = encodeVarintCheckDefinition(dAtA, i, uint64(m.TimeoutMs))
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.ReadinessChecks) > 0 {
-		for _, e := range m.ReadinessChecks {
-			l = e.Size()
-			n += 1 + l + sovCheckDefinition(uint64(l))
-		}
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintCheckDefinition(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Args[iNdEx])
+			copy(dAtA[i:], m.Args[iNdEx])
+			i = encodeVarintCheckDefinition(dAtA, i, uint64(len(m.Args[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintCheckDefinition(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GRPCCheck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GRPCCheck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GRPCCheck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IntervalMs != 0 {
+		i = encodeVarintCheckDefinition(dAtA, i, uint64(m.IntervalMs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RequestTimeoutMs != 0 {
+		i = encodeVarintCheckDefinition(dAtA, i, uint64(m.RequestTimeoutMs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintCheckDefinition(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Port != 0 {
+		i = encodeVarintCheckDefinition(dAtA, i, uint64(m.Port))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCheckDefinition(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCheckDefinition(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CheckDefinition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Checks) > 0 {
+		for _, e := range m.Checks {
+			l = e.Size()
+			n += 1 + l + sovCheckDefinition(uint64(l))
+		}
+	}
+	l = len(m.LogSource)
+	if l > 0 {
+		n += 1 + l + sovCheckDefinition(uint64(l))
+	}
+	if len(m.ReadinessChecks) > 0 {
+		for _, e := range m.ReadinessChecks {
+			l = e.Size()
+			n += 1 + l + sovCheckDefinition(uint64(l))
+		}
 	}
 	return n
 }
@@ -734,6 +1139,14 @@ func (m *Check) Size() (n int) {
 		l = m.HttpCheck.Size()
 		n += 1 + l + sovCheckDefinition(uint64(l))
 	}
+	if m.ExecCheck != nil {
+		l = m.ExecCheck.Size()
+		n += 1 + l + sovCheckDefinition(uint64(l))
+	}
+	if m.GrpcCheck != nil {
+		l = m.GrpcCheck.Size()
+		n += 1 + l + sovCheckDefinition(uint64(l))
+	}
 	return n
 }
 
@@ -777,6 +1190,57 @@ func (m *HTTPCheck) Size() (n int) {
 	return n
 }
 
+func (m *ExecCheck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovCheckDefinition(uint64(l))
+	}
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			l = len(s)
+			n += 1 + l + sovCheckDefinition(uint64(l))
+		}
+	}
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovCheckDefinition(uint64(l))
+	}
+	if m.TimeoutMs != 0 {
+		n += 1 + sovCheckDefinition(uint64(m.TimeoutMs))
+	}
+	if m.IntervalMs != 0 {
+		n += 1 + sovCheckDefinition(uint64(m.IntervalMs))
+	}
+	return n
+}
+
+func (m *GRPCCheck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Port != 0 {
+		n += 1 + sovCheckDefinition(uint64(m.Port))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovCheckDefinition(uint64(l))
+	}
+	if m.RequestTimeoutMs != 0 {
+		n += 1 + sovCheckDefinition(uint64(m.RequestTimeoutMs))
+	}
+	if m.IntervalMs != 0 {
+		n += 1 + sovCheckDefinition(uint64(m.IntervalMs))
+	}
+	return n
+}
+
 func sovCheckDefinition(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -812,6 +1276,8 @@ func (this *Check) String() string {
 	s := strings.Join([]string{`&Check{`,
 		`TcpCheck:` + strings.Replace(this.TcpCheck.String(), "TCPCheck", "TCPCheck", 1) + `,`,
 		`HttpCheck:` + strings.Replace(this.HttpCheck.String(), "HTTPCheck", "HTTPCheck", 1) + `,`,
+		`ExecCheck:` + strings.Replace(this.ExecCheck.String(), "ExecCheck", "ExecCheck", 1) + `,`,
+		`GrpcCheck:` + strings.Replace(this.GrpcCheck.String(), "GRPCCheck", "GRPCCheck", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -841,6 +1307,33 @@ func (this *HTTPCheck) String() string {
 	}, "")
 	return s
 }
+func (this *ExecCheck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ExecCheck{`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`Args:` + fmt.Sprintf("%v", this.Args) + `,`,
+		`User:` + fmt.Sprintf("%v", this.User) + `,`,
+		`TimeoutMs:` + fmt.Sprintf("%v", this.TimeoutMs) + `,`,
+		`IntervalMs:` + fmt.Sprintf("%v", this.IntervalMs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GRPCCheck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GRPCCheck{`,
+		`Port:` + fmt.Sprintf("%v", this.Port) + `,`,
+		`Service:` + fmt.Sprintf("%v", this.Service) + `,`,
+		`RequestTimeoutMs:` + fmt.Sprintf("%v", this.RequestTimeoutMs) + `,`,
+		`IntervalMs:` + fmt.Sprintf("%v", this.IntervalMs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringCheckDefinition(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -1100,6 +1593,78 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecCheck", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckDefinition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCheckDefinition
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheckDefinition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecCheck == nil {
+				m.ExecCheck = &ExecCheck{}
+			}
+			if err := m.ExecCheck.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrpcCheck", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckDefinition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCheckDefinition
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheckDefinition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GrpcCheck == nil {
+				m.GrpcCheck = &GRPCCheck{}
+			}
+			if err := m.GrpcCheck.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCheckDefinition(dAtA[iNdEx:])
@@ -1367,6 +1932,329 @@ func (m *HTTPCheck) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ExecCheck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCheckDefinition
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecCheck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecCheck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckDefinition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheckDefinition
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheckDefinition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckDefinition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheckDefinition
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheckDefinition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckDefinition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheckDefinition
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheckDefinition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutMs", wireType)
+			}
+			m.TimeoutMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckDefinition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutMs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalMs", wireType)
+			}
+			m.IntervalMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckDefinition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntervalMs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCheckDefinition(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCheckDefinition
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GRPCCheck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCheckDefinition
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GRPCCheck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GRPCCheck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			m.Port = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckDefinition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Port |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckDefinition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheckDefinition
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheckDefinition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestTimeoutMs", wireType)
+			}
+			m.RequestTimeoutMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckDefinition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestTimeoutMs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalMs", wireType)
+			}
+			m.IntervalMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckDefinition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntervalMs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCheckDefinition(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCheckDefinition
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCheckDefinition(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,92 @@
+package models_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/bbs/models"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CronSchedule", func() {
+	Describe("ParseCronSchedule", func() {
+		DescribeTable("accepts valid schedules",
+			func(spec string) {
+				_, err := models.ParseCronSchedule(spec, time.UTC)
+				Expect(err).NotTo(HaveOccurred())
+			},
+			Entry("every minute", "* * * * *"),
+			Entry("lists, ranges and steps", "0,30 9-17/2 1-31 * 1-5"),
+			Entry("month and day names", "0 0 * jan-mar mon,FRI"),
+			Entry("sunday as 7", "0 0 * * 7"),
+			Entry("a macro", "@daily"),
+		)
+
+		DescribeTable("rejects invalid schedules",
+			func(spec string) {
+				_, err := models.ParseCronSchedule(spec, time.UTC)
+				Expect(err).To(HaveOccurred())
+			},
+			Entry("empty", ""),
+			Entry("too few fields", "* * * *"),
+			Entry("too many fields", "* * * * * *"),
+			Entry("out of range minute", "60 * * * *"),
+			Entry("inverted range", "0 5-1 * * *"),
+			Entry("zero step", "*/0 * * * *"),
+			Entry("unknown macro", "@fortnightly"),
+			Entry("garbage", "a b c d e"),
+		)
+
+		It("returns ErrEmptyCronSchedule for a blank schedule", func() {
+			_, err := models.ParseCronSchedule("  ", time.UTC)
+			Expect(err).To(Equal(models.ErrEmptyCronSchedule))
+		})
+	})
+
+	Describe("Next", func() {
+		var start time.Time
+
+		BeforeEach(func() {
+			start = time.Date(2024, time.March, 15, 10, 7, 30, 0, time.UTC)
+		})
+
+		next := func(spec string, after time.Time) time.Time {
+			schedule, err := models.ParseCronSchedule(spec, time.UTC)
+			Expect(err).NotTo(HaveOccurred())
+			return schedule.Next(after)
+		}
+
+		It("returns the next matching minute", func() {
+			Expect(next("* * * * *", start)).To(Equal(time.Date(2024, time.March, 15, 10, 8, 0, 0, time.UTC)))
+		})
+
+		It("is strictly after the given time", func() {
+			exact := time.Date(2024, time.March, 15, 10, 30, 0, 0, time.UTC)
+			Expect(next("30 * * * *", exact)).To(Equal(time.Date(2024, time.March, 15, 11, 30, 0, 0, time.UTC)))
+		})
+
+		It("rolls over days, months and years", func() {
+			Expect(next("@yearly", start)).To(Equal(time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)))
+		})
+
+		It("matches either the day of month or the day of week when both are restricted", func() {
+			// 2024-03-15 is a Friday; the next Monday is the 18th, before the 20th.
+			Expect(next("0 0 20 * mon", start)).To(Equal(time.Date(2024, time.March, 18, 0, 0, 0, 0, time.UTC)))
+		})
+
+		It("evaluates the schedule in its location", func() {
+			location, err := time.LoadLocation("America/New_York")
+			Expect(err).NotTo(HaveOccurred())
+
+			schedule, err := models.ParseCronSchedule("0 9 * * *", location)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(schedule.Next(start).Equal(time.Date(2024, time.March, 15, 13, 0, 0, 0, time.UTC))).To(BeTrue())
+		})
+
+		It("returns the zero time when the schedule never fires", func() {
+			Expect(next("0 0 31 feb *", start).IsZero()).To(BeTrue())
+		})
+	})
+})
@@ -80,6 +80,11 @@ func NewDesiredLRP(schedInfo DesiredLRPSchedulingInfo, runInfo DesiredLRPRunInfo
 		MetricTags:                    metricTags,
 		Sidecars:                      runInfo.Sidecars,
 		LogRateLimit:                  runInfo.LogRateLimit,
+		Revision:                      schedInfo.Revision,
+		RolloutStrategy:               schedInfo.RolloutStrategy,
+		RestartPolicy:                 schedInfo.RestartPolicy,
+		ZoneSpreadConstraint:          schedInfo.ZoneSpreadConstraint,
+		Paused:                        schedInfo.Paused,
 	}
 }
 
@@ -114,7 +119,7 @@ func (desiredLRP *DesiredLRP) AddRunInfo(runInfo DesiredLRPRunInfo) {
 }
 
 func (*DesiredLRP) Version() format.Version {
-	return format.V3
+	return format.V4
 }
 
 func (d *DesiredLRP) actionsFromCachedDependencies() []ActionInterface {
@@ -172,10 +177,33 @@ func downgradeDesiredLRPV3ToV2(d *DesiredLRP) *DesiredLRP {
 	return d
 }
 
+// downgradeDesiredLRPV4ToV3 removes the exec and gRPC checks, which older
+// clients cannot run. The first exec liveness check becomes the Monitor
+// action when there is none.
+func downgradeDesiredLRPV4ToV3(d *DesiredLRP) *DesiredLRP {
+	if d.Monitor == nil {
+		d.Monitor = d.CheckDefinition.execMonitor()
+	}
+	d.CheckDefinition = d.CheckDefinition.portChecksOnly()
+
+	sidecars := make([]*Sidecar, len(d.Sidecars))
+	for i, sidecar := range d.Sidecars {
+		downgraded := *sidecar
+		downgraded.CheckDefinition = sidecar.CheckDefinition.portChecksOnly()
+		sidecars[i] = &downgraded
+	}
+	if d.Sidecars != nil {
+		d.Sidecars = sidecars
+	}
+
+	return d
+}
+
 var downgrades = []func(*DesiredLRP) *DesiredLRP{
 	downgradeDesiredLRPV1ToV0,
 	downgradeDesiredLRPV2ToV1,
 	downgradeDesiredLRPV3ToV2,
+	downgradeDesiredLRPV4ToV3,
 }
 
 func (d *DesiredLRP) VersionDownTo(v format.Version) *DesiredLRP {
@@ -228,7 +256,7 @@ func (d *DesiredLRP) DesiredLRPSchedulingInfo() DesiredLRPSchedulingInfo {
 		volumePlacement.DriverNames = append(volumePlacement.DriverNames, mount.Driver)
 	}
 
-	return NewDesiredLRPSchedulingInfo(
+	schedulingInfo := NewDesiredLRPSchedulingInfo(
 		d.DesiredLRPKey(),
 		d.Annotation,
 		d.Instances,
@@ -238,6 +266,14 @@ func (d *DesiredLRP) DesiredLRPSchedulingInfo() DesiredLRPSchedulingInfo {
 		&volumePlacement,
 		d.PlacementTags,
 	)
+	schedulingInfo.Revision = d.Revision
+	schedulingInfo.RolloutStrategy = d.RolloutStrategy
+	schedulingInfo.RestartPolicy = d.RestartPolicy
+	schedulingInfo.ZoneSpreadConstraint = d.ZoneSpreadConstraint
+	schedulingInfo.Paused = d.Paused
+	schedulingInfo.SidecarMemoryMb, schedulingInfo.SidecarDiskMb = SidecarResources(d.Sidecars)
+
+	return schedulingInfo
 }
 
 func (d *DesiredLRP) DesiredLRPRoutingInfo() DesiredLRP {
@@ -358,6 +394,18 @@ func (desired DesiredLRP) Validate() error {
 		}
 	}
 
+	if desired.RolloutStrategy != nil {
+		validationError = validationError.Check(desired.RolloutStrategy)
+	}
+
+	if desired.RestartPolicy != nil {
+		validationError = validationError.Check(desired.RestartPolicy)
+	}
+
+	if desired.ZoneSpreadConstraint != nil {
+		validationError = validationError.Check(desired.ZoneSpreadConstraint)
+	}
+
 	if desired.MetricTags == nil {
 		validationError = validationError.Append(ErrInvalidField{"metric_tags"})
 	} else {
@@ -404,6 +452,24 @@ func (desired *DesiredLRPUpdate) Validate() error {
 		validationError = validationError.Append(err)
 	}
 
+	if desired.RunInfo != nil {
+		if err := desired.RunInfo.Validate(); err != nil {
+			validationError = validationError.Append(ErrInvalidField{"run_info"})
+			validationError = validationError.Append(err)
+		}
+	}
+
+	if desired.Resource != nil {
+		if err := desired.Resource.Validate(); err != nil {
+			validationError = validationError.Append(ErrInvalidField{"resource"})
+			validationError = validationError.Append(err)
+		}
+	}
+
+	if desired.RolloutStrategy != nil {
+		validationError = validationError.Check(desired.RolloutStrategy)
+	}
+
 	return validationError.ToError()
 }
 
@@ -429,6 +495,17 @@ func (desired DesiredLRPUpdate) AnnotationExists() bool {
 	return ok
 }
 
+func (desired *DesiredLRPUpdate) SetPaused(paused bool) {
+	desired.OptionalPaused = &DesiredLRPUpdate_Paused{
+		Paused: paused,
+	}
+}
+
+func (desired DesiredLRPUpdate) PausedExists() bool {
+	_, ok := desired.GetOptionalPaused().(*DesiredLRPUpdate_Paused)
+	return ok
+}
+
 func (desired DesiredLRPUpdate) IsRoutesGroupUpdated(routes *Routes, routerGroup string) bool {
 	if desired.Routes == nil {
 		return false
@@ -470,11 +547,21 @@ func (desired DesiredLRPUpdate) IsMetricTagsUpdated(existingTags map[string]*Met
 	return false
 }
 
+// IsNewRevision returns true if the update changes the run info or the
+// resources of the LRP, which has its instances rolled over to a new revision.
+func (desired DesiredLRPUpdate) IsNewRevision() bool {
+	return desired.RunInfo != nil || desired.Resource != nil
+}
+
 type internalDesiredLRPUpdate struct {
-	Instances  *int32                     `json:"instances,omitempty"`
-	Routes     *Routes                    `json:"routes,omitempty"`
-	Annotation *string                    `json:"annotation,omitempty"`
-	MetricTags map[string]*MetricTagValue `json:"metric_tags,omitempty"`
+	Instances       *int32                     `json:"instances,omitempty"`
+	Routes          *Routes                    `json:"routes,omitempty"`
+	Annotation      *string                    `json:"annotation,omitempty"`
+	MetricTags      map[string]*MetricTagValue `json:"metric_tags,omitempty"`
+	RunInfo         *DesiredLRPRunInfo         `json:"run_info,omitempty"`
+	Resource        *DesiredLRPResource        `json:"resource,omitempty"`
+	RolloutStrategy *RolloutStrategy           `json:"rollout_strategy,omitempty"`
+	Paused          *bool                      `json:"paused,omitempty"`
 }
 
 func (desired *DesiredLRPUpdate) UnmarshalJSON(data []byte) error {
@@ -491,6 +578,12 @@ func (desired *DesiredLRPUpdate) UnmarshalJSON(data []byte) error {
 		desired.SetAnnotation(*update.Annotation)
 	}
 	desired.MetricTags = update.MetricTags
+	desired.RunInfo = update.RunInfo
+	desired.Resource = update.Resource
+	desired.RolloutStrategy = update.RolloutStrategy
+	if update.Paused != nil {
+		desired.SetPaused(*update.Paused)
+	}
 
 	return nil
 }
@@ -507,6 +600,13 @@ func (desired DesiredLRPUpdate) MarshalJSON() ([]byte, error) {
 		update.Annotation = &a
 	}
 	update.MetricTags = desired.MetricTags
+	update.RunInfo = desired.RunInfo
+	update.Resource = desired.Resource
+	update.RolloutStrategy = desired.RolloutStrategy
+	if desired.PausedExists() {
+		p := desired.GetPaused()
+		update.Paused = &p
+	}
 	return json.Marshal(update)
 }
 
@@ -581,9 +681,43 @@ func (s *DesiredLRPSchedulingInfo) ApplyUpdate(update *DesiredLRPUpdate) {
 	if update.AnnotationExists() {
 		s.Annotation = update.GetAnnotation()
 	}
+	if update.Resource != nil {
+		s.DesiredLRPResource = *update.Resource
+	}
+	if update.RolloutStrategy != nil {
+		s.RolloutStrategy = update.RolloutStrategy
+	}
+	if update.PausedExists() {
+		s.Paused = update.GetPaused()
+	}
+	if update.RunInfo != nil {
+		s.SidecarMemoryMb, s.SidecarDiskMb = SidecarResources(update.RunInfo.Sidecars)
+	}
+	if update.IsNewRevision() {
+		s.Revision++
+	}
 	s.ModificationTag.Increment()
 }
 
+// ScheduledInstances returns the number of instances to run, which is none
+// while the LRP is paused.
+func (s *DesiredLRPSchedulingInfo) ScheduledInstances() int32 {
+	if s.Paused {
+		return 0
+	}
+	return s.Instances
+}
+
+// WithSidecarResources returns a copy of the scheduling info whose memory and
+// disk include those of the sidecars, so that each instance is placed on a
+// cell with room for all of its processes.
+func (s *DesiredLRPSchedulingInfo) WithSidecarResources() *DesiredLRPSchedulingInfo {
+	total := *s
+	total.MemoryMb += s.SidecarMemoryMb
+	total.DiskMb += s.SidecarDiskMb
+	return &total
+}
+
 func (*DesiredLRPSchedulingInfo) Version() format.Version {
 	return format.V0
 }
@@ -601,6 +735,18 @@ func (s DesiredLRPSchedulingInfo) Validate() error {
 		validationError = validationError.Append(ErrInvalidField{"annotation"})
 	}
 
+	if s.RolloutStrategy != nil {
+		validationError = validationError.Check(s.RolloutStrategy)
+	}
+
+	if s.RestartPolicy != nil {
+		validationError = validationError.Check(s.RestartPolicy)
+	}
+
+	if s.ZoneSpreadConstraint != nil {
+		validationError = validationError.Check(s.ZoneSpreadConstraint)
+	}
+
 	return validationError.ToError()
 }
 
@@ -28,14 +28,21 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type DesiredLRPSchedulingInfo struct {
-	DesiredLRPKey      `protobuf:"bytes,1,opt,name=desired_lrp_key,json=desiredLrpKey,proto3,embedded=desired_lrp_key" json:""`
-	Annotation         string `protobuf:"bytes,2,opt,name=annotation,proto3" json:"annotation"`
-	Instances          int32  `protobuf:"varint,3,opt,name=instances,proto3" json:"instances"`
-	DesiredLRPResource `protobuf:"bytes,4,opt,name=desired_lrp_resource,json=desiredLrpResource,proto3,embedded=desired_lrp_resource" json:""`
-	Routes             Routes `protobuf:"bytes,5,opt,name=routes,proto3,customtype=Routes" json:"routes"`
-	ModificationTag    `protobuf:"bytes,6,opt,name=modification_tag,json=modificationTag,proto3,embedded=modification_tag" json:""`
-	VolumePlacement    *VolumePlacement `protobuf:"bytes,7,opt,name=volume_placement,json=volumePlacement,proto3" json:"volume_placement,omitempty"`
-	PlacementTags      []string         `protobuf:"bytes,8,rep,name=PlacementTags,proto3" json:"placement_tags,omitempty"`
+	DesiredLRPKey        `protobuf:"bytes,1,opt,name=desired_lrp_key,json=desiredLrpKey,proto3,embedded=desired_lrp_key" json:""`
+	Annotation           string `protobuf:"bytes,2,opt,name=annotation,proto3" json:"annotation"`
+	Instances            int32  `protobuf:"varint,3,opt,name=instances,proto3" json:"instances"`
+	DesiredLRPResource   `protobuf:"bytes,4,opt,name=desired_lrp_resource,json=desiredLrpResource,proto3,embedded=desired_lrp_resource" json:""`
+	Routes               Routes `protobuf:"bytes,5,opt,name=routes,proto3,customtype=Routes" json:"routes"`
+	ModificationTag      `protobuf:"bytes,6,opt,name=modification_tag,json=modificationTag,proto3,embedded=modification_tag" json:""`
+	VolumePlacement      *VolumePlacement      `protobuf:"bytes,7,opt,name=volume_placement,json=volumePlacement,proto3" json:"volume_placement,omitempty"`
+	PlacementTags        []string              `protobuf:"bytes,8,rep,name=PlacementTags,proto3" json:"placement_tags,omitempty"`
+	Revision             int32                 `protobuf:"varint,9,opt,name=revision,proto3" json:"revision"`
+	RolloutStrategy      *RolloutStrategy      `protobuf:"bytes,10,opt,name=rollout_strategy,json=rolloutStrategy,proto3" json:"rollout_strategy,omitempty"`
+	RestartPolicy        *RestartPolicy        `protobuf:"bytes,11,opt,name=restart_policy,json=restartPolicy,proto3" json:"restart_policy,omitempty"`
+	ZoneSpreadConstraint *ZoneSpreadConstraint `protobuf:"bytes,12,opt,name=zone_spread_constraint,json=zoneSpreadConstraint,proto3" json:"zone_spread_constraint,omitempty"`
+	Paused               bool                  `protobuf:"varint,13,opt,name=paused,proto3" json:"paused,omitempty"`
+	SidecarMemoryMb      int32                 `protobuf:"varint,14,opt,name=sidecar_memory_mb,json=sidecarMemoryMb,proto3" json:"sidecar_memory_mb,omitempty"`
+	SidecarDiskMb        int32                 `protobuf:"varint,15,opt,name=sidecar_disk_mb,json=sidecarDiskMb,proto3" json:"sidecar_disk_mb,omitempty"`
 }
 
 func (m *DesiredLRPSchedulingInfo) Reset()      { *m = DesiredLRPSchedulingInfo{} }
@@ -98,6 +105,55 @@ func (m *DesiredLRPSchedulingInfo) GetPlacementTags() []string {
 	return nil
 }
 
+func (m *DesiredLRPSchedulingInfo) GetRevision() int32 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *DesiredLRPSchedulingInfo) GetRolloutStrategy() *RolloutStrategy {
+	if m != nil {
+		return m.RolloutStrategy
+	}
+	return nil
+}
+
+func (m *DesiredLRPSchedulingInfo) GetRestartPolicy() *RestartPolicy {
+	if m != nil {
+		return m.RestartPolicy
+	}
+	return nil
+}
+
+func (m *DesiredLRPSchedulingInfo) GetZoneSpreadConstraint() *ZoneSpreadConstraint {
+	if m != nil {
+		return m.ZoneSpreadConstraint
+	}
+	return nil
+}
+
+func (m *DesiredLRPSchedulingInfo) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *DesiredLRPSchedulingInfo) GetSidecarMemoryMb() int32 {
+	if m != nil {
+		return m.SidecarMemoryMb
+	}
+	return 0
+}
+
+func (m *DesiredLRPSchedulingInfo) GetSidecarDiskMb() int32 {
+	if m != nil {
+		return m.SidecarDiskMb
+	}
+	return 0
+}
+
 type DesiredLRPRunInfo struct {
 	DesiredLRPKey                 `protobuf:"bytes,1,opt,name=desired_lrp_key,json=desiredLrpKey,proto3,embedded=desired_lrp_key" json:""`
 	EnvironmentVariables          []EnvironmentVariable      `protobuf:"bytes,2,rep,name=environment_variables,json=environmentVariables,proto3" json:"env"`
@@ -392,15 +448,19 @@ func (m *ProtoRoutes) GetRoutes() map[string][]byte {
 
 type DesiredLRPUpdate struct {
 	// Types that are valid to be assigned to OptionalInstances:
-	//
 	//	*DesiredLRPUpdate_Instances
 	OptionalInstances isDesiredLRPUpdate_OptionalInstances `protobuf_oneof:"optional_instances"`
 	Routes            *Routes                              `protobuf:"bytes,2,opt,name=routes,proto3,customtype=Routes" json:"routes,omitempty"`
 	// Types that are valid to be assigned to OptionalAnnotation:
-	//
 	//	*DesiredLRPUpdate_Annotation
 	OptionalAnnotation isDesiredLRPUpdate_OptionalAnnotation `protobuf_oneof:"optional_annotation"`
 	MetricTags         map[string]*MetricTagValue            `protobuf:"bytes,4,rep,name=metric_tags,json=metricTags,proto3" json:"metric_tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	RunInfo            *DesiredLRPRunInfo                    `protobuf:"bytes,5,opt,name=run_info,json=runInfo,proto3" json:"run_info,omitempty"`
+	Resource           *DesiredLRPResource                   `protobuf:"bytes,6,opt,name=resource,proto3" json:"resource,omitempty"`
+	RolloutStrategy    *RolloutStrategy                      `protobuf:"bytes,7,opt,name=rollout_strategy,json=rolloutStrategy,proto3" json:"rollout_strategy,omitempty"`
+	// Types that are valid to be assigned to OptionalPaused:
+	//	*DesiredLRPUpdate_Paused
+	OptionalPaused isDesiredLRPUpdate_OptionalPaused `protobuf_oneof:"optional_paused"`
 }
 
 func (m *DesiredLRPUpdate) Reset()      { *m = DesiredLRPUpdate{} }
@@ -447,6 +507,12 @@ type isDesiredLRPUpdate_OptionalAnnotation interface {
 	MarshalTo([]byte) (int, error)
 	Size() int
 }
+type isDesiredLRPUpdate_OptionalPaused interface {
+	isDesiredLRPUpdate_OptionalPaused()
+	Equal(interface{}) bool
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
 
 type DesiredLRPUpdate_Instances struct {
 	Instances int32 `protobuf:"varint,1,opt,name=instances,proto3,oneof" json:"instances,omitempty"`
@@ -454,9 +520,13 @@ type DesiredLRPUpdate_Instances struct {
 type DesiredLRPUpdate_Annotation struct {
 	Annotation string `protobuf:"bytes,3,opt,name=annotation,proto3,oneof" json:"annotation,omitempty"`
 }
+type DesiredLRPUpdate_Paused struct {
+	Paused bool `protobuf:"varint,8,opt,name=paused,proto3,oneof" json:"paused,omitempty"`
+}
 
 func (*DesiredLRPUpdate_Instances) isDesiredLRPUpdate_OptionalInstances()   {}
 func (*DesiredLRPUpdate_Annotation) isDesiredLRPUpdate_OptionalAnnotation() {}
+func (*DesiredLRPUpdate_Paused) isDesiredLRPUpdate_OptionalPaused()         {}
 
 func (m *DesiredLRPUpdate) GetOptionalInstances() isDesiredLRPUpdate_OptionalInstances {
 	if m != nil {
@@ -470,6 +540,12 @@ func (m *DesiredLRPUpdate) GetOptionalAnnotation() isDesiredLRPUpdate_OptionalAn
 	}
 	return nil
 }
+func (m *DesiredLRPUpdate) GetOptionalPaused() isDesiredLRPUpdate_OptionalPaused {
+	if m != nil {
+		return m.OptionalPaused
+	}
+	return nil
+}
 
 func (m *DesiredLRPUpdate) GetInstances() int32 {
 	if x, ok := m.GetOptionalInstances().(*DesiredLRPUpdate_Instances); ok {
@@ -492,11 +568,40 @@ func (m *DesiredLRPUpdate) GetMetricTags() map[string]*MetricTagValue {
 	return nil
 }
 
+func (m *DesiredLRPUpdate) GetRunInfo() *DesiredLRPRunInfo {
+	if m != nil {
+		return m.RunInfo
+	}
+	return nil
+}
+
+func (m *DesiredLRPUpdate) GetResource() *DesiredLRPResource {
+	if m != nil {
+		return m.Resource
+	}
+	return nil
+}
+
+func (m *DesiredLRPUpdate) GetRolloutStrategy() *RolloutStrategy {
+	if m != nil {
+		return m.RolloutStrategy
+	}
+	return nil
+}
+
+func (m *DesiredLRPUpdate) GetPaused() bool {
+	if x, ok := m.GetOptionalPaused().(*DesiredLRPUpdate_Paused); ok {
+		return x.Paused
+	}
+	return false
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*DesiredLRPUpdate) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*DesiredLRPUpdate_Instances)(nil),
 		(*DesiredLRPUpdate_Annotation)(nil),
+		(*DesiredLRPUpdate_Paused)(nil),
 	}
 }
 
@@ -664,6 +769,11 @@ type DesiredLRP struct {
 	MetricTags                    map[string]*MetricTagValue `protobuf:"bytes,35,rep,name=metric_tags,json=metricTags,proto3" json:"metric_tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Sidecars                      []*Sidecar                 `protobuf:"bytes,36,rep,name=sidecars,proto3" json:"sidecars,omitempty"`
 	LogRateLimit                  *LogRateLimit              `protobuf:"bytes,37,opt,name=log_rate_limit,json=logRateLimit,proto3" json:"log_rate_limit,omitempty"`
+	Revision                      int32                      `protobuf:"varint,38,opt,name=revision,proto3" json:"revision"`
+	RolloutStrategy               *RolloutStrategy           `protobuf:"bytes,39,opt,name=rollout_strategy,json=rolloutStrategy,proto3" json:"rollout_strategy,omitempty"`
+	RestartPolicy                 *RestartPolicy             `protobuf:"bytes,40,opt,name=restart_policy,json=restartPolicy,proto3" json:"restart_policy,omitempty"`
+	ZoneSpreadConstraint          *ZoneSpreadConstraint      `protobuf:"bytes,41,opt,name=zone_spread_constraint,json=zoneSpreadConstraint,proto3" json:"zone_spread_constraint,omitempty"`
+	Paused                        bool                       `protobuf:"varint,42,opt,name=paused,proto3" json:"paused,omitempty"`
 }
 
 func (m *DesiredLRP) Reset()      { *m = DesiredLRP{} }
@@ -953,6 +1063,41 @@ func (m *DesiredLRP) GetLogRateLimit() *LogRateLimit {
 	return nil
 }
 
+func (m *DesiredLRP) GetRevision() int32 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *DesiredLRP) GetRolloutStrategy() *RolloutStrategy {
+	if m != nil {
+		return m.RolloutStrategy
+	}
+	return nil
+}
+
+func (m *DesiredLRP) GetRestartPolicy() *RestartPolicy {
+	if m != nil {
+		return m.RestartPolicy
+	}
+	return nil
+}
+
+func (m *DesiredLRP) GetZoneSpreadConstraint() *ZoneSpreadConstraint {
+	if m != nil {
+		return m.ZoneSpreadConstraint
+	}
+	return nil
+}
+
+func (m *DesiredLRP) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*DesiredLRPSchedulingInfo)(nil), "models.DesiredLRPSchedulingInfo")
 	proto.RegisterType((*DesiredLRPRunInfo)(nil), "models.DesiredLRPRunInfo")
@@ -970,120 +1115,137 @@ func init() {
 func init() { proto.RegisterFile("desired_lrp.proto", fileDescriptor_f592e9299b63d68c) }
 
 var fileDescriptor_f592e9299b63d68c = []byte{
-	// 1797 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4f, 0x6f, 0x1b, 0xc7,
-	0x15, 0xe7, 0xea, 0x0f, 0x29, 0x0e, 0x49, 0x89, 0x1a, 0x51, 0xd2, 0x98, 0xb6, 0xb9, 0x2c, 0x63,
-	0xb7, 0x4c, 0x93, 0x28, 0x80, 0x93, 0xa2, 0x69, 0x5a, 0x14, 0xc8, 0xda, 0xa9, 0x63, 0x58, 0x0a,
-	0x84, 0x91, 0xed, 0xa2, 0x01, 0x8a, 0xc5, 0x6a, 0x77, 0xb4, 0x5a, 0x78, 0x77, 0x67, 0xb1, 0x33,
-	0x2b, 0x87, 0xb7, 0xf6, 0xd8, 0x5b, 0xfb, 0x2d, 0xfa, 0x01, 0x0a, 0xf4, 0xd4, 0x7b, 0x8e, 0x3e,
-	0x06, 0x3d, 0x10, 0xb1, 0x7c, 0x29, 0x78, 0xca, 0x47, 0x28, 0x66, 0xf6, 0x3f, 0x49, 0x53, 0x74,
-	0x62, 0x23, 0x27, 0xce, 0xfc, 0xde, 0x9f, 0x7d, 0xf3, 0xe6, 0xed, 0x7b, 0xbf, 0x25, 0xd8, 0xb6,
-	0x08, 0x73, 0x42, 0x62, 0xe9, 0x6e, 0x18, 0x1c, 0x04, 0x21, 0xe5, 0x14, 0x56, 0x3d, 0x6a, 0x11,
-	0x97, 0x75, 0x3f, 0xb0, 0x1d, 0x7e, 0x1e, 0x9d, 0x1e, 0x98, 0xd4, 0xfb, 0xd0, 0xa6, 0x36, 0xfd,
-	0x50, 0x8a, 0x4f, 0xa3, 0x33, 0xb9, 0x93, 0x1b, 0xb9, 0x8a, 0xcd, 0xba, 0x2d, 0xc3, 0xe4, 0x0e,
-	0xf5, 0x59, 0xb2, 0xdd, 0x37, 0x0d, 0xf3, 0x9c, 0x58, 0xba, 0x45, 0x02, 0xe2, 0x5b, 0xc4, 0x37,
-	0x47, 0x89, 0xe0, 0x86, 0x49, 0x42, 0xee, 0x9c, 0x39, 0xa6, 0xc1, 0x89, 0x1e, 0x84, 0x34, 0x10,
-	0x5b, 0x92, 0x9a, 0x5d, 0x27, 0xfe, 0x85, 0x13, 0x52, 0xdf, 0x23, 0x3e, 0xd7, 0x2f, 0x8c, 0xd0,
-	0x31, 0x4e, 0xdd, 0x4c, 0xb8, 0xe7, 0x51, 0x2b, 0xb6, 0x74, 0xa8, 0xaf, 0x73, 0xc3, 0x4e, 0x1f,
-	0xed, 0x13, 0xfe, 0x8c, 0x86, 0x4f, 0x93, 0x6d, 0x87, 0x11, 0x33, 0x0a, 0x1d, 0x3e, 0xd2, 0xed,
-	0x90, 0x46, 0xc9, 0xb1, 0xba, 0xf0, 0x82, 0xba, 0x91, 0x47, 0x74, 0x8f, 0x46, 0x3e, 0x4f, 0x1d,
-	0x9a, 0xe7, 0xc4, 0x7c, 0xaa, 0x5b, 0xe4, 0xcc, 0xf1, 0x1d, 0xe1, 0x34, 0xc1, 0xb7, 0x1d, 0xcf,
-	0xb0, 0x89, 0xee, 0x1a, 0x23, 0x12, 0xa6, 0x90, 0x47, 0x78, 0xe8, 0x98, 0xe2, 0xa9, 0x69, 0x38,
-	0x2d, 0xe6, 0x58, 0xc4, 0x34, 0x52, 0x8d, 0x8e, 0x4b, 0x6d, 0x3d, 0x14, 0xa7, 0x72, 0x1d, 0xcf,
-	0x49, 0x1e, 0x31, 0xf8, 0xf7, 0x1a, 0x40, 0xf7, 0xe2, 0x1c, 0x1f, 0xe2, 0xe3, 0x13, 0x91, 0x93,
-	0xc8, 0x75, 0x7c, 0xfb, 0x81, 0x7f, 0x46, 0xe1, 0x43, 0xb0, 0x55, 0xc8, 0xbf, 0xfe, 0x94, 0x8c,
-	0x90, 0xd2, 0x57, 0x86, 0x8d, 0x3b, 0xbb, 0x07, 0xf1, 0x25, 0x1c, 0xe4, 0xa6, 0x0f, 0xc9, 0x48,
-	0x6b, 0x7e, 0x33, 0x56, 0x2b, 0xcf, 0xc7, 0xaa, 0x32, 0x19, 0xab, 0x15, 0xdc, 0x4a, 0x6c, 0x0f,
-	0xc3, 0xe0, 0x21, 0x19, 0xc1, 0x03, 0x00, 0x0c, 0xdf, 0xa7, 0x5c, 0x66, 0x07, 0xad, 0xf4, 0x95,
-	0x61, 0x5d, 0xdb, 0x9c, 0x8c, 0xd5, 0x02, 0x8a, 0x0b, 0x6b, 0xf8, 0x1e, 0xa8, 0x3b, 0x3e, 0xe3,
-	0x86, 0x6f, 0x12, 0x86, 0x56, 0xfb, 0xca, 0x70, 0x5d, 0x6b, 0x4d, 0xc6, 0x6a, 0x0e, 0xe2, 0x7c,
-	0x09, 0xbf, 0x02, 0x9d, 0x62, 0xa4, 0x21, 0x61, 0x34, 0x0a, 0x4d, 0x82, 0xd6, 0x64, 0xb8, 0xdd,
-	0xd9, 0x70, 0x71, 0xa2, 0x31, 0x15, 0x33, 0xcc, 0x63, 0x4e, 0x35, 0xe0, 0x6f, 0x41, 0x35, 0xa4,
-	0x11, 0x27, 0x0c, 0xad, 0x4b, 0x6f, 0x3b, 0xa9, 0xb7, 0x63, 0x91, 0x41, 0x2c, 0x45, 0xda, 0xa6,
-	0x70, 0xf3, 0xdf, 0xb1, 0x5a, 0x8d, 0xf7, 0x38, 0x31, 0x81, 0xc7, 0xa0, 0x3d, 0x5d, 0x15, 0xa8,
-	0x2a, 0xdd, 0xec, 0xa7, 0x6e, 0x8e, 0x0a, 0xf2, 0x47, 0x86, 0x3d, 0x15, 0xd1, 0x96, 0x57, 0x16,
-	0x43, 0x0d, 0xb4, 0x93, 0x52, 0x09, 0x5c, 0xc3, 0x24, 0xa2, 0x12, 0x51, 0xad, 0xec, 0xf1, 0x89,
-	0x94, 0x1f, 0xa7, 0x62, 0xbc, 0x75, 0x51, 0x06, 0xa0, 0x06, 0x5a, 0xd9, 0xe6, 0x91, 0x61, 0x33,
-	0xb4, 0xd1, 0x5f, 0x1d, 0xd6, 0xb5, 0x1b, 0x93, 0xb1, 0x8a, 0x32, 0xaf, 0xb2, 0x96, 0xde, 0xa7,
-	0x9e, 0xc3, 0x89, 0x17, 0xf0, 0x11, 0x2e, 0x9b, 0x0c, 0xfe, 0xd6, 0x02, 0xdb, 0x85, 0x7c, 0x46,
-	0xfe, 0x9b, 0x2f, 0x99, 0x3f, 0x83, 0xdd, 0xb9, 0xef, 0x1b, 0x5a, 0xe9, 0xaf, 0x0e, 0x1b, 0x77,
-	0xae, 0xa7, 0x2e, 0x3f, 0xcf, 0x95, 0x9e, 0x24, 0x3a, 0x5a, 0x43, 0x38, 0x9e, 0x8c, 0xd5, 0x55,
-	0xe2, 0x5f, 0xe0, 0x0e, 0x99, 0xd5, 0x60, 0xf0, 0x16, 0x58, 0x67, 0x84, 0x47, 0x81, 0xac, 0xae,
-	0xc6, 0x9d, 0xcd, 0xd4, 0xdd, 0x67, 0xb2, 0x53, 0xe0, 0x58, 0x08, 0x7f, 0x0e, 0xaa, 0x71, 0xeb,
-	0x48, 0x8a, 0x69, 0x5a, 0x2d, 0x91, 0xc2, 0x21, 0xa8, 0x79, 0xd4, 0x77, 0x38, 0x0d, 0x93, 0x3a,
-	0x99, 0x56, 0x4c, 0xc5, 0xf0, 0x2b, 0xd0, 0xb5, 0x48, 0x10, 0x12, 0xd1, 0x62, 0x2c, 0x9d, 0x71,
-	0x23, 0xe4, 0x3a, 0x77, 0x3c, 0x42, 0x23, 0xae, 0x33, 0x59, 0x1d, 0x2d, 0xed, 0xe6, 0x64, 0xac,
-	0xee, 0x97, 0x44, 0xf9, 0x4d, 0x20, 0x05, 0xef, 0xe7, 0x0e, 0x4e, 0x84, 0xd2, 0xa3, 0x58, 0xe7,
-	0x44, 0xbc, 0x65, 0x41, 0xe8, 0x5c, 0x38, 0x2e, 0xb1, 0x89, 0x25, 0xeb, 0x62, 0x23, 0x7e, 0xcb,
-	0x72, 0x14, 0x17, 0xd6, 0xf0, 0x03, 0x00, 0xcc, 0x20, 0xd2, 0x9f, 0x11, 0xc7, 0x3e, 0xe7, 0x68,
-	0x43, 0x3e, 0x5b, 0xea, 0xe7, 0x28, 0xae, 0x9b, 0x41, 0xf4, 0x47, 0xb9, 0x84, 0x08, 0xac, 0x07,
-	0x34, 0xe4, 0x0c, 0xd5, 0xfb, 0xab, 0xc3, 0x96, 0xb6, 0xd2, 0xae, 0xe0, 0x18, 0x80, 0x1a, 0x68,
-	0x12, 0x3b, 0x24, 0x8c, 0xe9, 0x61, 0x24, 0xae, 0x08, 0xc8, 0x2b, 0xba, 0x96, 0xe6, 0xe0, 0x24,
-	0xe9, 0x79, 0xf7, 0x45, 0xcb, 0xc3, 0x91, 0x4b, 0xb4, 0x35, 0x71, 0x41, 0xb8, 0x11, 0x1b, 0x09,
-	0x84, 0x89, 0x60, 0x44, 0x93, 0x4a, 0xde, 0xdd, 0x46, 0xde, 0x22, 0x72, 0x14, 0xd7, 0x5d, 0x6a,
-	0x9f, 0xc4, 0x2f, 0xe6, 0xaf, 0x40, 0x33, 0xee, 0x7a, 0x4c, 0xb7, 0x23, 0xc7, 0x42, 0x4d, 0x69,
-	0x00, 0x27, 0x63, 0xb5, 0x8c, 0x2b, 0xb8, 0x91, 0xec, 0xef, 0x47, 0x4e, 0x7c, 0xe4, 0x90, 0xc8,
-	0xdc, 0x1b, 0x1c, 0xb5, 0xfa, 0xca, 0x70, 0x35, 0x39, 0x72, 0x86, 0xe2, 0x7a, 0xb2, 0xfe, 0x8c,
-	0xc3, 0x07, 0x60, 0x67, 0x7a, 0x56, 0x38, 0x84, 0xa1, 0x4d, 0x79, 0x3e, 0x94, 0x9e, 0xef, 0xae,
-	0x54, 0xb9, 0x97, 0x4d, 0x13, 0x0c, 0xcd, 0x32, 0xe2, 0x10, 0x06, 0x3f, 0x06, 0x1d, 0x97, 0xd8,
-	0x86, 0x39, 0xd2, 0x2d, 0xfa, 0xcc, 0x77, 0xa9, 0x61, 0xe9, 0x11, 0x23, 0x21, 0xda, 0x92, 0x81,
-	0xaf, 0x20, 0x05, 0xc3, 0x58, 0x7e, 0x2f, 0x11, 0x3f, 0x66, 0x24, 0x84, 0xf7, 0x41, 0x9f, 0x87,
-	0x11, 0x93, 0xb5, 0x32, 0x62, 0x9c, 0x78, 0x7a, 0x61, 0x44, 0x31, 0x3d, 0x30, 0xf8, 0x39, 0x6a,
-	0x0b, 0x0f, 0xf8, 0x66, 0xa2, 0x77, 0x22, 0xd5, 0xee, 0x16, 0xb4, 0x8e, 0x0d, 0x7e, 0x0e, 0x3f,
-	0x01, 0xad, 0xe2, 0x90, 0x61, 0x68, 0x5b, 0x9e, 0x61, 0xa7, 0xdc, 0x36, 0x8e, 0x84, 0x0c, 0x37,
-	0x2f, 0xf2, 0x0d, 0x83, 0xef, 0x82, 0x5a, 0x32, 0xc3, 0x10, 0x94, 0xb5, 0xbd, 0x95, 0xda, 0x7c,
-	0x19, 0xc3, 0x38, 0x95, 0xc3, 0xdf, 0x83, 0x76, 0xb9, 0xa2, 0x3d, 0x86, 0x76, 0x64, 0x8e, 0x3b,
-	0x93, 0xb1, 0x3a, 0x23, 0xc3, 0x9b, 0xac, 0x50, 0xbf, 0x47, 0xa2, 0x93, 0xef, 0xcd, 0x9f, 0xc0,
-	0xa8, 0x23, 0x9f, 0x7c, 0x33, 0xcb, 0x78, 0xae, 0x75, 0x9c, 0x29, 0xc9, 0xaa, 0x52, 0xf0, 0xae,
-	0x39, 0x4f, 0x08, 0x6f, 0x83, 0xcd, 0x78, 0x72, 0x8a, 0xac, 0xfb, 0x86, 0x47, 0xd0, 0xae, 0xcc,
-	0x5b, 0x4b, 0xa2, 0x8f, 0x13, 0x30, 0x57, 0x0b, 0x0c, 0xc6, 0x9e, 0xd1, 0xd0, 0x42, 0x7b, 0x05,
-	0xb5, 0xe3, 0x04, 0x14, 0x8d, 0x78, 0x7a, 0x3e, 0xa3, 0xfd, 0x72, 0x23, 0xbe, 0x2b, 0xe4, 0xf7,
-	0x32, 0x31, 0xde, 0x32, 0xcb, 0x80, 0x28, 0xe1, 0xc2, 0x2c, 0x67, 0x08, 0xc9, 0x1b, 0x81, 0xa9,
-	0xfd, 0x03, 0x21, 0x3b, 0x14, 0x22, 0xdc, 0x70, 0xb2, 0x35, 0x83, 0x5f, 0x82, 0x46, 0x61, 0xde,
-	0xa3, 0x6b, 0xd2, 0xea, 0xdd, 0x39, 0x53, 0x2e, 0xee, 0xca, 0x07, 0x47, 0x52, 0x59, 0xb4, 0xed,
-	0xcf, 0x7d, 0x1e, 0x8e, 0x64, 0xa9, 0x01, 0x2f, 0x03, 0xe1, 0x7b, 0x60, 0x23, 0x21, 0x0b, 0x0c,
-	0x75, 0xa5, 0xb3, 0xec, 0x82, 0x4f, 0x62, 0x1c, 0x67, 0x0a, 0xf0, 0x53, 0xb0, 0x59, 0xa6, 0x12,
-	0xe8, 0xba, 0x3c, 0x75, 0x27, 0x35, 0x39, 0xa4, 0x36, 0x36, 0x38, 0x39, 0x14, 0x32, 0xdc, 0x74,
-	0x0b, 0xbb, 0xee, 0x63, 0xb0, 0x35, 0x15, 0x0b, 0x6c, 0x83, 0xd5, 0x74, 0x4a, 0xd4, 0xb1, 0x58,
-	0xc2, 0xf7, 0xc1, 0xfa, 0x85, 0xe1, 0x46, 0x44, 0x92, 0x84, 0xc6, 0x9d, 0xbd, 0x6c, 0x50, 0xa6,
-	0x96, 0x4f, 0x84, 0x14, 0xc7, 0x4a, 0x9f, 0xae, 0x7c, 0xa2, 0x0c, 0xfe, 0xaa, 0x80, 0x46, 0x61,
-	0x1a, 0xc3, 0x5f, 0x67, 0x23, 0x5b, 0x91, 0xa7, 0x51, 0xe7, 0x8c, 0xec, 0x83, 0xf8, 0x47, 0x06,
-	0x91, 0x8e, 0xeb, 0xee, 0x6f, 0x40, 0xa3, 0x00, 0xcf, 0x89, 0xad, 0x53, 0x8c, 0xad, 0x59, 0x8c,
-	0xe1, 0xbb, 0x15, 0xd0, 0xce, 0x33, 0xff, 0x38, 0xb0, 0x0c, 0x4e, 0x60, 0xaf, 0x48, 0x62, 0x84,
-	0x9b, 0xf5, 0x2f, 0x2a, 0x45, 0xde, 0x92, 0x73, 0x8b, 0x95, 0xc5, 0xdc, 0x42, 0x99, 0xc3, 0x2d,
-	0xfa, 0x25, 0x46, 0x25, 0x86, 0x58, 0xfd, 0x0b, 0xa5, 0xc4, 0xa1, 0x1e, 0x94, 0xeb, 0x64, 0x4d,
-	0x26, 0x63, 0x38, 0x5b, 0x27, 0x71, 0xb4, 0xd3, 0x65, 0x52, 0x2c, 0x91, 0xb7, 0x74, 0x73, 0x5a,
-	0x07, 0x40, 0x1a, 0x88, 0x58, 0x0d, 0x57, 0xcf, 0xd2, 0xa2, 0xed, 0x82, 0x9d, 0x0c, 0xcd, 0x8f,
-	0x33, 0xf8, 0x87, 0x02, 0x5a, 0x25, 0xfa, 0x00, 0x3f, 0x02, 0xcd, 0x20, 0xa4, 0xa6, 0x18, 0x3b,
-	0x71, 0xab, 0x97, 0x9d, 0xb4, 0x2d, 0x46, 0x40, 0x11, 0xc7, 0x8d, 0x64, 0x27, 0x07, 0xc0, 0x00,
-	0x54, 0x2d, 0xea, 0x19, 0x4e, 0xca, 0x42, 0xc1, 0x64, 0xac, 0x26, 0x08, 0x4e, 0x7e, 0xe1, 0x2f,
-	0xc0, 0x86, 0x28, 0x72, 0xe9, 0x54, 0x66, 0x56, 0x6b, 0x4e, 0xc6, 0x6a, 0x86, 0xe1, 0x9a, 0x4b,
-	0x6d, 0xe1, 0x6c, 0xf0, 0x2f, 0x05, 0xc0, 0x59, 0x5a, 0x09, 0x7f, 0x09, 0xea, 0x1e, 0xf1, 0x68,
-	0x38, 0xd2, 0xbd, 0xd3, 0xf8, 0xe2, 0x63, 0xf6, 0x9a, 0x81, 0x78, 0x23, 0x5e, 0x1e, 0x9d, 0xc2,
-	0x5b, 0xa0, 0x66, 0x39, 0xec, 0xa9, 0xd0, 0x5c, 0x91, 0x9a, 0x8d, 0xc9, 0x58, 0x4d, 0x21, 0x5c,
-	0x15, 0x8b, 0xa3, 0x53, 0xf8, 0x0e, 0xa8, 0x85, 0x94, 0x72, 0xfd, 0x8c, 0x25, 0x01, 0xc9, 0xb0,
-	0x05, 0x74, 0x26, 0x4b, 0x82, 0xf2, 0x3f, 0x30, 0x11, 0xb6, 0x67, 0x7c, 0xad, 0x07, 0x8e, 0xc5,
-	0x24, 0x5d, 0x59, 0x8f, 0xc3, 0x4e, 0x31, 0x5c, 0xf3, 0x8c, 0xaf, 0x8f, 0x1d, 0x8b, 0x0d, 0xfe,
-	0xd3, 0x06, 0x20, 0x0f, 0xfb, 0xed, 0xe5, 0x71, 0xa9, 0xa8, 0x4b, 0x54, 0x7f, 0xed, 0x0a, 0xaa,
-	0xff, 0xa7, 0x57, 0x91, 0xc2, 0xf5, 0xab, 0x49, 0x61, 0x6d, 0x49, 0x42, 0x58, 0x5d, 0x8e, 0x10,
-	0xd6, 0x16, 0x12, 0xc2, 0x79, 0x93, 0xf0, 0xfa, 0x6b, 0x4c, 0xc2, 0xd3, 0x85, 0x34, 0x31, 0xa6,
-	0x6a, 0xb7, 0x27, 0x63, 0x55, 0x2d, 0x68, 0xa5, 0x72, 0x9f, 0x2d, 0x47, 0x17, 0x0b, 0xa4, 0xb5,
-	0xbe, 0x98, 0xb4, 0x16, 0x8a, 0x14, 0xbc, 0xba, 0x48, 0x4b, 0x65, 0xdf, 0x58, 0x5c, 0xf6, 0x65,
-	0xea, 0xd9, 0xbc, 0x8a, 0x7a, 0x96, 0x99, 0x6d, 0xeb, 0x4a, 0x66, 0x9b, 0x51, 0xd5, 0xcd, 0x69,
-	0xaa, 0x9a, 0x37, 0xdd, 0xad, 0xd7, 0x6f, 0xba, 0x65, 0x8e, 0xda, 0xbe, 0x8a, 0xa3, 0x16, 0xfb,
-	0xc8, 0xf6, 0x82, 0x3e, 0x32, 0x43, 0x66, 0xe1, 0x72, 0x64, 0xb6, 0xfc, 0x55, 0xbd, 0x73, 0xe5,
-	0x57, 0xf5, 0xef, 0xa6, 0x68, 0x7a, 0xe7, 0x0a, 0x9a, 0x5e, 0x26, 0xe8, 0xda, 0x9c, 0xaf, 0xd9,
-	0xdd, 0x85, 0x5f, 0xb3, 0xb3, 0xdf, 0xaf, 0xaf, 0xe0, 0xd3, 0x7b, 0x6f, 0x90, 0x4f, 0xef, 0xff,
-	0x68, 0x3e, 0x8d, 0x7e, 0x10, 0x9f, 0xbe, 0xf6, 0x03, 0xf8, 0x74, 0xf7, 0x0a, 0x3e, 0x3d, 0xf3,
-	0xa9, 0x7e, 0xe3, 0xb5, 0x3f, 0xd5, 0x4b, 0x53, 0xe1, 0xe6, 0x82, 0xa9, 0xb0, 0x80, 0x7c, 0xf7,
-	0xde, 0x02, 0xf9, 0x56, 0x97, 0x23, 0xdf, 0xfd, 0x65, 0xc9, 0xf7, 0xcf, 0x7e, 0x24, 0xf9, 0x1e,
-	0x2c, 0x47, 0xbe, 0xef, 0x96, 0x49, 0xd5, 0x3b, 0xd2, 0x6a, 0x30, 0x4b, 0xaa, 0x16, 0xd1, 0xa9,
-	0x12, 0xe3, 0xbe, 0xf5, 0xfa, 0x8c, 0xfb, 0xf6, 0x4f, 0xcc, 0xb8, 0xb5, 0x8f, 0x9f, 0xbf, 0xe8,
-	0x55, 0xbe, 0x7d, 0xd1, 0xab, 0x7c, 0xff, 0xa2, 0xa7, 0xfc, 0xe5, 0xb2, 0xa7, 0xfc, 0xf3, 0xb2,
-	0xa7, 0x7c, 0x73, 0xd9, 0x53, 0x9e, 0x5f, 0xf6, 0x94, 0xef, 0x2e, 0x7b, 0xca, 0xff, 0x2e, 0x7b,
-	0x95, 0xef, 0x2f, 0x7b, 0xca, 0xdf, 0x5f, 0xf6, 0x2a, 0xcf, 0x5f, 0xf6, 0x2a, 0xdf, 0xbe, 0xec,
-	0x55, 0x4e, 0xab, 0xf2, 0x4f, 0xc7, 0x8f, 0xfe, 0x1f, 0x00, 0x00, 0xff, 0xff, 0x51, 0xbe, 0xb3,
-	0x10, 0xd7, 0x15, 0x00, 0x00,
+	// 2069 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xe7, 0x8a, 0x12, 0x3f, 0x86, 0x9f, 0x1a, 0x53, 0xd2, 0x58, 0xb6, 0xb9, 0xac, 0x62, 0x27,
+	0x74, 0xe2, 0x28, 0x80, 0xe3, 0xb6, 0x69, 0x1a, 0x14, 0x08, 0x6d, 0xd7, 0x36, 0x2c, 0x05, 0xc2,
+	0xc8, 0x76, 0x51, 0x03, 0xc5, 0x62, 0xb5, 0x3b, 0xa2, 0x16, 0xda, 0xdd, 0x59, 0xcc, 0xec, 0xca,
+	0x61, 0x4e, 0xed, 0xb1, 0xb7, 0xf6, 0xbf, 0xe8, 0x1f, 0xd0, 0x7b, 0xaf, 0x39, 0xfa, 0x18, 0xf4,
+	0x40, 0xd4, 0xf2, 0xa5, 0x60, 0x2f, 0xb9, 0xf6, 0x16, 0xcc, 0xec, 0x37, 0x45, 0x93, 0x52, 0x6c,
+	0x9f, 0x34, 0xf3, 0x7b, 0x6f, 0x1e, 0xdf, 0xce, 0x7b, 0x33, 0xef, 0xf7, 0x46, 0x60, 0xd5, 0x24,
+	0xdc, 0x62, 0xc4, 0xd4, 0x6c, 0xe6, 0x6d, 0x7b, 0x8c, 0xfa, 0x14, 0x96, 0x1c, 0x6a, 0x12, 0x9b,
+	0x6f, 0x7e, 0x3a, 0xb4, 0xfc, 0xa3, 0xe0, 0x60, 0xdb, 0xa0, 0xce, 0x67, 0x43, 0x3a, 0xa4, 0x9f,
+	0x49, 0xf1, 0x41, 0x70, 0x28, 0x67, 0x72, 0x22, 0x47, 0xe1, 0xb2, 0xcd, 0x86, 0x6e, 0xf8, 0x16,
+	0x75, 0x79, 0x34, 0xdd, 0x30, 0x74, 0xe3, 0x88, 0x98, 0x9a, 0x49, 0x3c, 0xe2, 0x9a, 0xc4, 0x35,
+	0x46, 0x91, 0xe0, 0xaa, 0x41, 0x98, 0x6f, 0x1d, 0x5a, 0x86, 0xee, 0x13, 0xcd, 0x63, 0xd4, 0x13,
+	0x53, 0x12, 0x2f, 0xbb, 0x42, 0xdc, 0x13, 0x8b, 0x51, 0xd7, 0x21, 0xae, 0xaf, 0x9d, 0xe8, 0xcc,
+	0xd2, 0x0f, 0xec, 0x44, 0xb8, 0xee, 0x50, 0x33, 0x5c, 0x69, 0x51, 0x57, 0xf3, 0xf5, 0x61, 0xfc,
+	0xd3, 0x2e, 0xf1, 0x5f, 0x50, 0x76, 0x1c, 0x4d, 0x3b, 0x9c, 0x18, 0x01, 0xb3, 0xfc, 0x91, 0x36,
+	0x64, 0x34, 0x88, 0x3e, 0x6b, 0x13, 0x9e, 0x50, 0x3b, 0x70, 0x88, 0xe6, 0xd0, 0xc0, 0xf5, 0x63,
+	0x83, 0xc6, 0x11, 0x31, 0x8e, 0x35, 0x93, 0x1c, 0x5a, 0xae, 0x25, 0x8c, 0x46, 0xf8, 0xaa, 0xe5,
+	0xe8, 0x43, 0xa2, 0xd9, 0xfa, 0x88, 0xb0, 0x18, 0x72, 0x88, 0xcf, 0x2c, 0x43, 0xfc, 0x6a, 0xec,
+	0x4e, 0x83, 0x5b, 0x26, 0x31, 0xf4, 0x58, 0xa3, 0x63, 0xd3, 0xa1, 0xc6, 0xc4, 0x57, 0xd9, 0x96,
+	0x63, 0xc5, 0x3f, 0xd1, 0x60, 0xd4, 0xb6, 0x69, 0x10, 0x4f, 0x3b, 0x8c, 0x70, 0x5f, 0x67, 0xbe,
+	0xe6, 0x51, 0xdb, 0x4a, 0xf6, 0x64, 0xf5, 0x3b, 0xea, 0x12, 0x8d, 0x7b, 0x8c, 0xe8, 0x66, 0x08,
+	0x6d, 0xfd, 0xbf, 0x0c, 0xd0, 0xbd, 0x30, 0x36, 0x3b, 0x78, 0x6f, 0x5f, 0xec, 0x65, 0x60, 0x5b,
+	0xee, 0xf0, 0x91, 0x7b, 0x48, 0xe1, 0x63, 0xd0, 0xca, 0xc4, 0x4d, 0x3b, 0x26, 0x23, 0xa4, 0xf4,
+	0x94, 0x7e, 0xed, 0xf6, 0xda, 0x76, 0x18, 0xbc, 0xed, 0x74, 0xe9, 0x63, 0x32, 0x1a, 0xd4, 0xbf,
+	0x1f, 0xab, 0x85, 0x97, 0x63, 0x55, 0x99, 0x8c, 0xd5, 0x02, 0x6e, 0x44, 0x6b, 0x77, 0x98, 0xf7,
+	0x98, 0x8c, 0xe0, 0x36, 0x00, 0xba, 0xeb, 0x52, 0x5f, 0xee, 0x2a, 0x5a, 0xea, 0x29, 0xfd, 0xea,
+	0xa0, 0x39, 0x19, 0xab, 0x19, 0x14, 0x67, 0xc6, 0xf0, 0x13, 0x50, 0xb5, 0x5c, 0xee, 0xeb, 0xae,
+	0x41, 0x38, 0x2a, 0xf6, 0x94, 0xfe, 0xca, 0xa0, 0x31, 0x19, 0xab, 0x29, 0x88, 0xd3, 0x21, 0x7c,
+	0x0e, 0x3a, 0x59, 0x4f, 0x19, 0xe1, 0x34, 0x60, 0x06, 0x41, 0xcb, 0xd2, 0xdd, 0xcd, 0xb3, 0xee,
+	0xe2, 0x48, 0x63, 0xca, 0x67, 0x98, 0xfa, 0x1c, 0x6b, 0xc0, 0xdf, 0x82, 0x12, 0xa3, 0x81, 0x4f,
+	0x38, 0x5a, 0x91, 0xd6, 0x2e, 0xc5, 0xd6, 0xf6, 0xc4, 0x0e, 0x62, 0x29, 0x1a, 0x34, 0x85, 0x99,
+	0x7f, 0x8f, 0xd5, 0x52, 0x38, 0xc7, 0xd1, 0x12, 0xb8, 0x07, 0xda, 0xd3, 0xd9, 0x84, 0x4a, 0xd2,
+	0xcc, 0x46, 0x6c, 0x66, 0x37, 0x23, 0x7f, 0xa2, 0x0f, 0xa7, 0x3c, 0x6a, 0x39, 0x79, 0x31, 0x1c,
+	0x80, 0x76, 0x94, 0x62, 0x9e, 0xad, 0x1b, 0x44, 0x64, 0x30, 0x2a, 0xe7, 0x2d, 0x3e, 0x93, 0xf2,
+	0xbd, 0x58, 0x8c, 0x5b, 0x27, 0x79, 0x00, 0x0e, 0x40, 0x23, 0x99, 0x3c, 0xd1, 0x87, 0x1c, 0x55,
+	0x7a, 0xc5, 0x7e, 0x75, 0x70, 0x75, 0x32, 0x56, 0x51, 0x62, 0x55, 0xe6, 0xe0, 0x2d, 0xea, 0x58,
+	0x3e, 0x71, 0x3c, 0x7f, 0x84, 0xf3, 0x4b, 0x60, 0x1f, 0x54, 0x18, 0x39, 0xb1, 0xb8, 0x88, 0x66,
+	0x55, 0x86, 0xa7, 0x3e, 0x19, 0xab, 0x09, 0x86, 0x93, 0x91, 0xf0, 0x38, 0xca, 0x4e, 0x8d, 0xfb,
+	0x22, 0x73, 0x87, 0x23, 0x04, 0xf2, 0x1e, 0xe3, 0x50, 0xbe, 0x1f, 0x89, 0x71, 0x8b, 0xe5, 0x01,
+	0xf8, 0x15, 0x68, 0xe6, 0x53, 0x1a, 0xd5, 0xf2, 0x99, 0x88, 0x43, 0xe9, 0x9e, 0x14, 0xe2, 0x06,
+	0xcb, 0x4e, 0x21, 0x06, 0xeb, 0x99, 0xd4, 0xd7, 0x0c, 0xea, 0x0a, 0x47, 0x2c, 0xd7, 0x47, 0x75,
+	0x69, 0xe5, 0x6a, 0x6c, 0xe5, 0x39, 0x75, 0xc9, 0xbe, 0x54, 0xba, 0x9b, 0xe8, 0xe0, 0xce, 0x77,
+	0x33, 0x50, 0x78, 0x0b, 0x94, 0x3c, 0x3d, 0xe0, 0xc4, 0x44, 0x8d, 0x9e, 0xd2, 0xaf, 0x0c, 0x3a,
+	0x93, 0xb1, 0xda, 0x0e, 0x91, 0xcc, 0xa6, 0x45, 0x3a, 0xf0, 0x31, 0x58, 0x8d, 0x8e, 0xb1, 0xe6,
+	0x10, 0x87, 0xb2, 0x91, 0xe6, 0x1c, 0xa0, 0xa6, 0xdc, 0x36, 0x75, 0x32, 0x56, 0xaf, 0x9c, 0x11,
+	0x66, 0x6c, 0xb4, 0x22, 0xe1, 0xae, 0x94, 0xed, 0x1e, 0xc0, 0xfb, 0x20, 0x86, 0x34, 0xd3, 0xe2,
+	0xc7, 0xc2, 0x54, 0x4b, 0x9a, 0xba, 0x36, 0x19, 0xab, 0x97, 0xa7, 0x44, 0xd9, 0x08, 0x46, 0xa2,
+	0x7b, 0x16, 0x3f, 0xde, 0x3d, 0xd8, 0xfa, 0x6b, 0x03, 0xac, 0x66, 0x4e, 0x44, 0xe0, 0xbe, 0xfb,
+	0x43, 0xff, 0x27, 0xb0, 0x36, 0xf3, 0xa6, 0x45, 0x4b, 0xbd, 0x62, 0xbf, 0x76, 0xfb, 0x4a, 0x6c,
+	0xf2, 0x7e, 0xaa, 0xf4, 0x2c, 0xd2, 0x19, 0xd4, 0x84, 0xe1, 0xc9, 0x58, 0x2d, 0x12, 0xf7, 0x04,
+	0x77, 0xc8, 0x59, 0x0d, 0x0e, 0xaf, 0x83, 0x15, 0x4e, 0xfc, 0xc0, 0x93, 0xf7, 0x43, 0xed, 0x76,
+	0x33, 0x36, 0xf7, 0xb5, 0xac, 0x11, 0x38, 0x14, 0xc2, 0x0f, 0x41, 0x29, 0x2c, 0x1a, 0x68, 0x79,
+	0xa6, 0x5a, 0x24, 0x85, 0x7d, 0x50, 0x76, 0xa8, 0x6b, 0xf9, 0x94, 0xa1, 0x95, 0x99, 0x8a, 0xb1,
+	0x18, 0x3e, 0x07, 0x9b, 0x26, 0xf1, 0x18, 0x11, 0xc5, 0xc5, 0xd4, 0xc2, 0xb4, 0xf4, 0x2d, 0x87,
+	0xc8, 0x14, 0x97, 0xe7, 0xbb, 0x21, 0x63, 0xb1, 0x91, 0x13, 0xa5, 0x91, 0x40, 0x0a, 0xde, 0x48,
+	0x0d, 0xec, 0x0b, 0xa5, 0x27, 0xa1, 0xce, 0xbe, 0xb8, 0x27, 0x3d, 0x66, 0x9d, 0x58, 0x36, 0x19,
+	0x12, 0x53, 0x9e, 0xec, 0x4a, 0x78, 0x4f, 0xa6, 0x28, 0xce, 0x8c, 0xe1, 0xa7, 0x00, 0x18, 0x5e,
+	0xa0, 0xbd, 0x20, 0xd6, 0xf0, 0xc8, 0x47, 0x15, 0xf9, 0xdb, 0x52, 0x3f, 0x45, 0x71, 0xd5, 0xf0,
+	0x82, 0x3f, 0xc8, 0x21, 0x44, 0x60, 0xc5, 0xa3, 0xcc, 0xe7, 0xa8, 0xda, 0x2b, 0xf6, 0x1b, 0x83,
+	0xa5, 0x76, 0x01, 0x87, 0x00, 0x1c, 0x80, 0x3a, 0x19, 0x32, 0xc2, 0xb9, 0xc6, 0x02, 0x11, 0x22,
+	0x20, 0x43, 0x74, 0x39, 0xde, 0x83, 0xfd, 0xa8, 0xda, 0x3d, 0x10, 0xc5, 0x0e, 0x07, 0x36, 0x19,
+	0x2c, 0x8b, 0x00, 0xe1, 0x5a, 0xb8, 0x48, 0x20, 0x5c, 0x38, 0x23, 0xca, 0x53, 0x74, 0xfb, 0xd6,
+	0xd2, 0x4b, 0x3e, 0x45, 0x71, 0xd5, 0xa6, 0xc3, 0x7d, 0x39, 0x84, 0xbf, 0x04, 0xf5, 0xb0, 0xde,
+	0x71, 0x6d, 0x18, 0x58, 0xa6, 0x3c, 0x8d, 0xd5, 0x01, 0x9c, 0x8c, 0xd5, 0x3c, 0xae, 0xe0, 0x5a,
+	0x34, 0x7f, 0x10, 0x58, 0xe1, 0x27, 0x33, 0x22, 0xf7, 0x5e, 0xf7, 0xe5, 0xf1, 0x2b, 0x46, 0x9f,
+	0x9c, 0xa0, 0xb8, 0x1a, 0x8d, 0xbf, 0xf6, 0xe1, 0x23, 0x70, 0x69, 0x9a, 0x25, 0x58, 0x84, 0xa3,
+	0xa6, 0xfc, 0x3e, 0x14, 0x7f, 0xdf, 0x5d, 0xa9, 0x72, 0x2f, 0xe1, 0x11, 0x18, 0x1a, 0x79, 0xc4,
+	0x22, 0x1c, 0xde, 0x01, 0x1d, 0x9b, 0x0c, 0x75, 0x63, 0xa4, 0x99, 0xf4, 0x85, 0x6b, 0x53, 0xdd,
+	0xd4, 0x02, 0x4e, 0x98, 0x3c, 0x7e, 0xd5, 0xc1, 0x12, 0x52, 0x30, 0x0c, 0xe5, 0xf7, 0x22, 0xf1,
+	0x53, 0x4e, 0x18, 0x7c, 0x00, 0x7a, 0x3e, 0x0b, 0xb8, 0xcc, 0x95, 0x11, 0xf7, 0x89, 0xa3, 0x65,
+	0xc8, 0x09, 0xd7, 0x3c, 0xdd, 0x3f, 0x42, 0x6d, 0x61, 0x01, 0x5f, 0x8b, 0xf4, 0xf6, 0xa5, 0xda,
+	0xdd, 0x8c, 0xd6, 0x9e, 0xee, 0x1f, 0xc1, 0x2f, 0x40, 0x23, 0x4b, 0x2f, 0x38, 0x5a, 0xed, 0x15,
+	0xb3, 0x15, 0x29, 0xbc, 0xf8, 0x77, 0x85, 0x0c, 0xd7, 0x4f, 0xd2, 0x09, 0x87, 0x37, 0x41, 0x39,
+	0x62, 0x2f, 0x08, 0xca, 0xdc, 0x6e, 0xc5, 0x6b, 0xbe, 0x09, 0x61, 0x1c, 0xcb, 0xe1, 0xef, 0x40,
+	0x3b, 0x9f, 0xd1, 0x0e, 0x47, 0x97, 0xe4, 0x1e, 0xcb, 0x2b, 0x6e, 0x5a, 0x86, 0x9b, 0x3c, 0x93,
+	0xbf, 0xbb, 0xa2, 0x16, 0xaf, 0xcf, 0xe6, 0x5e, 0xa8, 0x23, 0x7f, 0xf9, 0x5a, 0xb2, 0xe3, 0xa9,
+	0xd6, 0x5e, 0xa2, 0x24, 0xb3, 0x4a, 0xc1, 0x6b, 0xc6, 0x2c, 0x21, 0xbc, 0x01, 0x9a, 0x21, 0x67,
+	0x12, 0xbb, 0xee, 0xea, 0x0e, 0x41, 0x6b, 0x72, 0xdf, 0x1a, 0x12, 0x7d, 0x1a, 0x81, 0xa9, 0x9a,
+	0xa7, 0x73, 0xfe, 0x82, 0x32, 0x13, 0xad, 0x67, 0xd4, 0xf6, 0x22, 0x50, 0x14, 0xa6, 0x69, 0x66,
+	0x86, 0x36, 0xf2, 0x85, 0xe9, 0xae, 0x90, 0xdf, 0x4b, 0xc4, 0xb8, 0x65, 0xe4, 0x01, 0x91, 0xc2,
+	0x19, 0x16, 0xc7, 0x11, 0x92, 0x11, 0x81, 0xf1, 0xfa, 0x47, 0x42, 0xb6, 0x23, 0x44, 0xb8, 0x66,
+	0x25, 0x63, 0x0e, 0xbf, 0x01, 0xb5, 0x0c, 0xd3, 0x43, 0x97, 0xe5, 0xaa, 0x9b, 0x33, 0x78, 0x4a,
+	0x78, 0x2b, 0x6f, 0xef, 0x4a, 0x65, 0x51, 0x78, 0xef, 0xbb, 0x3e, 0x1b, 0xc9, 0x54, 0x03, 0x4e,
+	0x02, 0xc2, 0x4f, 0x40, 0x25, 0xba, 0xdc, 0x39, 0xda, 0xec, 0x15, 0xb3, 0x01, 0xde, 0x0f, 0x71,
+	0x9c, 0x28, 0xc0, 0x2f, 0x41, 0x33, 0x4f, 0x22, 0xd1, 0x15, 0xf9, 0xd5, 0x9d, 0x78, 0xc9, 0x0e,
+	0x1d, 0x62, 0xdd, 0x27, 0x3b, 0x42, 0x86, 0xeb, 0x76, 0x66, 0xb6, 0xf9, 0x14, 0xb4, 0xa6, 0x7c,
+	0x81, 0x6d, 0x50, 0x8c, 0xab, 0x44, 0x15, 0x8b, 0x21, 0xbc, 0x05, 0x56, 0x4e, 0x74, 0x3b, 0x20,
+	0x92, 0xe6, 0xd5, 0x6e, 0xaf, 0x27, 0x54, 0x27, 0x5e, 0xf9, 0x4c, 0x48, 0x71, 0xa8, 0xf4, 0xe5,
+	0xd2, 0x17, 0xca, 0xd6, 0x5f, 0x14, 0x50, 0xcb, 0xf0, 0x29, 0xf8, 0xeb, 0x84, 0x74, 0x29, 0xf2,
+	0x6b, 0xd4, 0x19, 0xa4, 0x6b, 0x3b, 0xfc, 0x23, 0x9d, 0x88, 0x09, 0xd7, 0xe6, 0x6f, 0x40, 0x2d,
+	0x03, 0xcf, 0xf0, 0xad, 0x93, 0xf5, 0xad, 0x9e, 0xf5, 0xe1, 0x5f, 0xcb, 0xa0, 0x9d, 0xee, 0xfc,
+	0x53, 0xcf, 0xd4, 0x7d, 0x02, 0xbb, 0x59, 0x1a, 0x2a, 0xcc, 0xac, 0x3c, 0x2c, 0x64, 0x99, 0x67,
+	0xca, 0x0e, 0x97, 0xe6, 0xb3, 0x43, 0x65, 0x06, 0x3b, 0xec, 0xe5, 0x38, 0xb1, 0x28, 0x62, 0xd5,
+	0x87, 0x4a, 0x8e, 0x05, 0x3f, 0xca, 0xe7, 0xc9, 0xb2, 0xdc, 0x8c, 0xfe, 0xd9, 0x3c, 0x09, 0xbd,
+	0x9d, 0x4e, 0x93, 0x5c, 0x8a, 0xdc, 0x01, 0x15, 0x16, 0xb8, 0x9a, 0xe5, 0x1e, 0xd2, 0xa8, 0xbe,
+	0x5d, 0x7e, 0x63, 0xbe, 0xe1, 0x32, 0x0b, 0x07, 0xf0, 0x57, 0x82, 0xe6, 0x45, 0xf7, 0x79, 0x69,
+	0x11, 0x9b, 0xc6, 0x89, 0xee, 0x4c, 0xd2, 0x57, 0xbe, 0x20, 0xe9, 0x43, 0x09, 0xc5, 0x12, 0x65,
+	0xad, 0xf2, 0x70, 0x29, 0xa6, 0x53, 0xef, 0x29, 0x0b, 0x07, 0x1d, 0x00, 0xa9, 0x27, 0xf6, 0x5d,
+	0xb7, 0xb5, 0x24, 0xc4, 0x83, 0x35, 0x70, 0x29, 0x41, 0xd3, 0xd0, 0x0c, 0x56, 0x41, 0x2b, 0x81,
+	0x43, 0xb7, 0xb6, 0xfe, 0xae, 0x80, 0x46, 0x8e, 0x1d, 0xc1, 0xcf, 0x41, 0xdd, 0x63, 0xd4, 0x20,
+	0x3c, 0xae, 0x64, 0xb2, 0x50, 0xb4, 0x45, 0x85, 0xcb, 0xe2, 0xb8, 0x16, 0xcd, 0x64, 0x7d, 0xdb,
+	0x02, 0x25, 0x93, 0x3a, 0xba, 0x15, 0xb7, 0x49, 0x60, 0x32, 0x56, 0x23, 0x04, 0x47, 0x7f, 0xe1,
+	0x47, 0xa0, 0x22, 0xce, 0xb0, 0x34, 0x2a, 0x13, 0x27, 0xa4, 0xdf, 0x31, 0x86, 0xcb, 0x36, 0x1d,
+	0x0a, 0x63, 0x5b, 0xff, 0x54, 0x00, 0x3c, 0x1b, 0x29, 0xf8, 0x31, 0xa8, 0xa6, 0x44, 0x54, 0x49,
+	0xdb, 0xab, 0x04, 0xc4, 0x15, 0x27, 0xe6, 0x9b, 0xd7, 0x41, 0x39, 0xe6, 0x99, 0x4b, 0x52, 0xb3,
+	0x36, 0x19, 0xab, 0x31, 0x84, 0x4b, 0xa6, 0xa4, 0x93, 0xf0, 0x03, 0x50, 0x66, 0x94, 0xfa, 0xda,
+	0x21, 0x47, 0xc5, 0xd4, 0x6d, 0x01, 0x1d, 0xca, 0x8c, 0xa7, 0xfe, 0xef, 0xb9, 0x70, 0xdb, 0xd1,
+	0xbf, 0xd5, 0x3c, 0xcb, 0xe4, 0x68, 0x39, 0xed, 0x1a, 0x62, 0x0c, 0x97, 0x1d, 0xfd, 0xdb, 0x3d,
+	0xcb, 0xe4, 0x5b, 0xff, 0x83, 0x00, 0xa4, 0x6e, 0xbf, 0xbf, 0x7d, 0x3c, 0x97, 0xd7, 0xb9, 0x5e,
+	0x74, 0x79, 0x41, 0x2f, 0xfa, 0xc7, 0x37, 0x71, 0xde, 0x95, 0xc5, 0x9c, 0xb7, 0x7c, 0x4e, 0xbe,
+	0x5b, 0x3a, 0x1f, 0xdf, 0x2d, 0xcf, 0xe5, 0xbb, 0xb3, 0x0a, 0xfd, 0x95, 0x0b, 0x14, 0xfa, 0x83,
+	0xb9, 0x2c, 0x38, 0x64, 0xa2, 0x37, 0x26, 0x63, 0x55, 0xcd, 0x68, 0xc5, 0x72, 0x97, 0x9f, 0x8f,
+	0x0d, 0x67, 0x38, 0x79, 0x75, 0x3e, 0x27, 0xcf, 0x24, 0x29, 0x78, 0x73, 0x92, 0xe6, 0xd2, 0xbe,
+	0x36, 0x3f, 0xed, 0xf3, 0xcc, 0xba, 0xbe, 0x88, 0x59, 0xe7, 0x89, 0x7b, 0x63, 0x21, 0x71, 0x4f,
+	0x98, 0x78, 0x73, 0x9a, 0x89, 0xa7, 0x35, 0xa5, 0x75, 0xf1, 0x9a, 0x92, 0xa7, 0xe0, 0xed, 0x45,
+	0x14, 0x3c, 0x7b, 0x8f, 0xac, 0xce, 0xb9, 0x47, 0xce, 0x70, 0x75, 0x78, 0x3e, 0xae, 0x9e, 0x7f,
+	0xf6, 0xb9, 0xb4, 0xf0, 0xd9, 0xe7, 0xab, 0xa9, 0x2e, 0xa4, 0xb3, 0xa0, 0x0b, 0xc9, 0xf7, 0x1f,
+	0x83, 0x19, 0xcf, 0x2d, 0x6b, 0x73, 0x9f, 0x5b, 0xce, 0x3e, 0xb0, 0xbc, 0xa1, 0x5d, 0x58, 0x7f,
+	0x87, 0xed, 0xc2, 0xc6, 0x5b, 0xb7, 0x0b, 0xe8, 0x67, 0xb5, 0x0b, 0x97, 0x7f, 0x46, 0xbb, 0xb0,
+	0xb9, 0xa0, 0x5d, 0x38, 0xf3, 0x96, 0x74, 0xf5, 0xe2, 0x6f, 0x49, 0xd9, 0xaa, 0x70, 0x6d, 0x4e,
+	0x55, 0x98, 0xd3, 0x5b, 0x74, 0xdf, 0x43, 0x6f, 0xa1, 0x9e, 0xaf, 0xb7, 0xe8, 0x9d, 0xb7, 0xb7,
+	0xf8, 0xc5, 0x5b, 0xf6, 0x16, 0x5b, 0xe7, 0xeb, 0x2d, 0xee, 0xe6, 0x39, 0xe3, 0x07, 0x72, 0xd5,
+	0xd6, 0x59, 0xd6, 0x36, 0x97, 0x2d, 0x66, 0x1b, 0x8a, 0xeb, 0x17, 0x6f, 0x28, 0x6e, 0x9c, 0xb7,
+	0xa1, 0xc8, 0xbd, 0x23, 0x7e, 0x78, 0xe1, 0x77, 0xc4, 0x8f, 0xde, 0xfa, 0x1d, 0xb1, 0xff, 0x4e,
+	0xde, 0x11, 0x6f, 0xbe, 0x83, 0x77, 0xc4, 0x8f, 0x17, 0xbf, 0x23, 0xbe, 0x2f, 0xe2, 0x7b, 0xe7,
+	0xe5, 0xab, 0x6e, 0xe1, 0x87, 0x57, 0xdd, 0xc2, 0x8f, 0xaf, 0xba, 0xca, 0x9f, 0x4f, 0xbb, 0xca,
+	0x3f, 0x4e, 0xbb, 0xca, 0xf7, 0xa7, 0x5d, 0xe5, 0xe5, 0x69, 0x57, 0xf9, 0xcf, 0x69, 0x57, 0xf9,
+	0xef, 0x69, 0xb7, 0xf0, 0xe3, 0x69, 0x57, 0xf9, 0xdb, 0xeb, 0x6e, 0xe1, 0xe5, 0xeb, 0x6e, 0xe1,
+	0x87, 0xd7, 0xdd, 0xc2, 0x41, 0x49, 0xfe, 0x0f, 0xe1, 0xf3, 0x9f, 0x06, 0x00, 0xcd, 0x8a, 0x0e,
+	0xca, 0xde, 0x19, 0x00, 0x00,
 }
 
 func (this *DesiredLRPSchedulingInfo) Equal(that interface{}) bool {
@@ -1134,6 +1296,27 @@ func (this *DesiredLRPSchedulingInfo) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.Revision != that1.Revision {
+		return false
+	}
+	if !this.RolloutStrategy.Equal(that1.RolloutStrategy) {
+		return false
+	}
+	if !this.RestartPolicy.Equal(that1.RestartPolicy) {
+		return false
+	}
+	if !this.ZoneSpreadConstraint.Equal(that1.ZoneSpreadConstraint) {
+		return false
+	}
+	if this.Paused != that1.Paused {
+		return false
+	}
+	if this.SidecarMemoryMb != that1.SidecarMemoryMb {
+		return false
+	}
+	if this.SidecarDiskMb != that1.SidecarDiskMb {
+		return false
+	}
 	return true
 }
 func (this *DesiredLRPRunInfo) Equal(that interface{}) bool {
@@ -1359,6 +1542,24 @@ func (this *DesiredLRPUpdate) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !this.RunInfo.Equal(that1.RunInfo) {
+		return false
+	}
+	if !this.Resource.Equal(that1.Resource) {
+		return false
+	}
+	if !this.RolloutStrategy.Equal(that1.RolloutStrategy) {
+		return false
+	}
+	if that1.OptionalPaused == nil {
+		if this.OptionalPaused != nil {
+			return false
+		}
+	} else if this.OptionalPaused == nil {
+		return false
+	} else if !this.OptionalPaused.Equal(that1.OptionalPaused) {
+		return false
+	}
 	return true
 }
 func (this *DesiredLRPUpdate_Instances) Equal(that interface{}) bool {
@@ -1409,6 +1610,30 @@ func (this *DesiredLRPUpdate_Annotation) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *DesiredLRPUpdate_Paused) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DesiredLRPUpdate_Paused)
+	if !ok {
+		that2, ok := that.(DesiredLRPUpdate_Paused)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Paused != that1.Paused {
+		return false
+	}
+	return true
+}
 func (this *DesiredLRPKey) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1651,13 +1876,28 @@ func (this *DesiredLRP) Equal(that interface{}) bool {
 	if !this.LogRateLimit.Equal(that1.LogRateLimit) {
 		return false
 	}
+	if this.Revision != that1.Revision {
+		return false
+	}
+	if !this.RolloutStrategy.Equal(that1.RolloutStrategy) {
+		return false
+	}
+	if !this.RestartPolicy.Equal(that1.RestartPolicy) {
+		return false
+	}
+	if !this.ZoneSpreadConstraint.Equal(that1.ZoneSpreadConstraint) {
+		return false
+	}
+	if this.Paused != that1.Paused {
+		return false
+	}
 	return true
 }
 func (this *DesiredLRPSchedulingInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 12)
+	s := make([]string, 0, 19)
 	s = append(s, "&models.DesiredLRPSchedulingInfo{")
 	s = append(s, "DesiredLRPKey: "+strings.Replace(this.DesiredLRPKey.GoString(), `&`, ``, 1)+",\n")
 	s = append(s, "Annotation: "+fmt.Sprintf("%#v", this.Annotation)+",\n")
@@ -1669,6 +1909,19 @@ func (this *DesiredLRPSchedulingInfo) GoString() string {
 		s = append(s, "VolumePlacement: "+fmt.Sprintf("%#v", this.VolumePlacement)+",\n")
 	}
 	s = append(s, "PlacementTags: "+fmt.Sprintf("%#v", this.PlacementTags)+",\n")
+	s = append(s, "Revision: "+fmt.Sprintf("%#v", this.Revision)+",\n")
+	if this.RolloutStrategy != nil {
+		s = append(s, "RolloutStrategy: "+fmt.Sprintf("%#v", this.RolloutStrategy)+",\n")
+	}
+	if this.RestartPolicy != nil {
+		s = append(s, "RestartPolicy: "+fmt.Sprintf("%#v", this.RestartPolicy)+",\n")
+	}
+	if this.ZoneSpreadConstraint != nil {
+		s = append(s, "ZoneSpreadConstraint: "+fmt.Sprintf("%#v", this.ZoneSpreadConstraint)+",\n")
+	}
+	s = append(s, "Paused: "+fmt.Sprintf("%#v", this.Paused)+",\n")
+	s = append(s, "SidecarMemoryMb: "+fmt.Sprintf("%#v", this.SidecarMemoryMb)+",\n")
+	s = append(s, "SidecarDiskMb: "+fmt.Sprintf("%#v", this.SidecarDiskMb)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1780,7 +2033,7 @@ func (this *DesiredLRPUpdate) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
+	s := make([]string, 0, 12)
 	s = append(s, "&models.DesiredLRPUpdate{")
 	if this.OptionalInstances != nil {
 		s = append(s, "OptionalInstances: "+fmt.Sprintf("%#v", this.OptionalInstances)+",\n")
@@ -1802,6 +2055,18 @@ func (this *DesiredLRPUpdate) GoString() string {
 	if this.MetricTags != nil {
 		s = append(s, "MetricTags: "+mapStringForMetricTags+",\n")
 	}
+	if this.RunInfo != nil {
+		s = append(s, "RunInfo: "+fmt.Sprintf("%#v", this.RunInfo)+",\n")
+	}
+	if this.Resource != nil {
+		s = append(s, "Resource: "+fmt.Sprintf("%#v", this.Resource)+",\n")
+	}
+	if this.RolloutStrategy != nil {
+		s = append(s, "RolloutStrategy: "+fmt.Sprintf("%#v", this.RolloutStrategy)+",\n")
+	}
+	if this.OptionalPaused != nil {
+		s = append(s, "OptionalPaused: "+fmt.Sprintf("%#v", this.OptionalPaused)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1821,6 +2086,14 @@ func (this *DesiredLRPUpdate_Annotation) GoString() string {
 		`Annotation:` + fmt.Sprintf("%#v", this.Annotation) + `}`}, ", ")
 	return s
 }
+func (this *DesiredLRPUpdate_Paused) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&models.DesiredLRPUpdate_Paused{` +
+		`Paused:` + fmt.Sprintf("%#v", this.Paused) + `}`}, ", ")
+	return s
+}
 func (this *DesiredLRPKey) GoString() string {
 	if this == nil {
 		return "nil"
@@ -1850,7 +2123,7 @@ func (this *DesiredLRP) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 41)
+	s := make([]string, 0, 46)
 	s = append(s, "&models.DesiredLRP{")
 	s = append(s, "ProcessGuid: "+fmt.Sprintf("%#v", this.ProcessGuid)+",\n")
 	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
@@ -1929,6 +2202,17 @@ func (this *DesiredLRP) GoString() string {
 	if this.LogRateLimit != nil {
 		s = append(s, "LogRateLimit: "+fmt.Sprintf("%#v", this.LogRateLimit)+",\n")
 	}
+	s = append(s, "Revision: "+fmt.Sprintf("%#v", this.Revision)+",\n")
+	if this.RolloutStrategy != nil {
+		s = append(s, "RolloutStrategy: "+fmt.Sprintf("%#v", this.RolloutStrategy)+",\n")
+	}
+	if this.RestartPolicy != nil {
+		s = append(s, "RestartPolicy: "+fmt.Sprintf("%#v", this.RestartPolicy)+",\n")
+	}
+	if this.ZoneSpreadConstraint != nil {
+		s = append(s, "ZoneSpreadConstraint: "+fmt.Sprintf("%#v", this.ZoneSpreadConstraint)+",\n")
+	}
+	s = append(s, "Paused: "+fmt.Sprintf("%#v", this.Paused)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1960,6 +2244,67 @@ func (m *DesiredLRPSchedulingInfo) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.SidecarDiskMb != 0 {
+		i = encodeVarintDesiredLrp(dAtA, i, uint64(m.SidecarDiskMb))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.SidecarMemoryMb != 0 {
+		i = encodeVarintDesiredLrp(dAtA, i, uint64(m.SidecarMemoryMb))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.ZoneSpreadConstraint != nil {
+		{
+			size, err := m.ZoneSpreadConstraint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDesiredLrp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.RestartPolicy != nil {
+		{
+			size, err := m.RestartPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDesiredLrp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.RolloutStrategy != nil {
+		{
+			size, err := m.RolloutStrategy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDesiredLrp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Revision != 0 {
+		i = encodeVarintDesiredLrp(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x48
+	}
 	if len(m.PlacementTags) > 0 {
 		for iNdEx := len(m.PlacementTags) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.PlacementTags[iNdEx])
@@ -2430,6 +2775,51 @@ func (m *DesiredLRPUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.OptionalPaused != nil {
+		{
+			size := m.OptionalPaused.Size()
+			i -= size
+			if _, err := m.OptionalPaused.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.RolloutStrategy != nil {
+		{
+			size, err := m.RolloutStrategy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDesiredLrp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Resource != nil {
+		{
+			size, err := m.Resource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDesiredLrp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.RunInfo != nil {
+		{
+			size, err := m.RunInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDesiredLrp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.MetricTags) > 0 {
 		for k := range m.MetricTags {
 			v := m.MetricTags[k]
@@ -2515,6 +2905,23 @@ func (m *DesiredLRPUpdate_Annotation) MarshalToSizedBuffer(dAtA []byte) (int, er
 	dAtA[i] = 0x1a
 	return len(dAtA) - i, nil
 }
+func (m *DesiredLRPUpdate_Paused) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DesiredLRPUpdate_Paused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i--
+	if m.Paused {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x40
+	return len(dAtA) - i, nil
+}
 func (m *DesiredLRPKey) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2624,11 +3031,72 @@ func (m *DesiredLRP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.LogRateLimit != nil {
-		{
-			size, err := m.LogRateLimit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.ZoneSpreadConstraint != nil {
+		{
+			size, err := m.ZoneSpreadConstraint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDesiredLrp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xca
+	}
+	if m.RestartPolicy != nil {
+		{
+			size, err := m.RestartPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDesiredLrp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.RolloutStrategy != nil {
+		{
+			size, err := m.RolloutStrategy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDesiredLrp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.Revision != 0 {
+		i = encodeVarintDesiredLrp(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.LogRateLimit != nil {
+		{
+			size, err := m.LogRateLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
 			i -= size
 			i = encodeVarintDesiredLrp(dAtA, i, uint64(size))
@@ -3069,6 +3537,30 @@ func (m *DesiredLRPSchedulingInfo) Size() (n int) {
 			n += 1 + l + sovDesiredLrp(uint64(l))
 		}
 	}
+	if m.Revision != 0 {
+		n += 1 + sovDesiredLrp(uint64(m.Revision))
+	}
+	if m.RolloutStrategy != nil {
+		l = m.RolloutStrategy.Size()
+		n += 1 + l + sovDesiredLrp(uint64(l))
+	}
+	if m.RestartPolicy != nil {
+		l = m.RestartPolicy.Size()
+		n += 1 + l + sovDesiredLrp(uint64(l))
+	}
+	if m.ZoneSpreadConstraint != nil {
+		l = m.ZoneSpreadConstraint.Size()
+		n += 1 + l + sovDesiredLrp(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	if m.SidecarMemoryMb != 0 {
+		n += 1 + sovDesiredLrp(uint64(m.SidecarMemoryMb))
+	}
+	if m.SidecarDiskMb != 0 {
+		n += 1 + sovDesiredLrp(uint64(m.SidecarDiskMb))
+	}
 	return n
 }
 
@@ -3254,6 +3746,21 @@ func (m *DesiredLRPUpdate) Size() (n int) {
 			n += mapEntrySize + 1 + sovDesiredLrp(uint64(mapEntrySize))
 		}
 	}
+	if m.RunInfo != nil {
+		l = m.RunInfo.Size()
+		n += 1 + l + sovDesiredLrp(uint64(l))
+	}
+	if m.Resource != nil {
+		l = m.Resource.Size()
+		n += 1 + l + sovDesiredLrp(uint64(l))
+	}
+	if m.RolloutStrategy != nil {
+		l = m.RolloutStrategy.Size()
+		n += 1 + l + sovDesiredLrp(uint64(l))
+	}
+	if m.OptionalPaused != nil {
+		n += m.OptionalPaused.Size()
+	}
 	return n
 }
 
@@ -3276,6 +3783,15 @@ func (m *DesiredLRPUpdate_Annotation) Size() (n int) {
 	n += 1 + l + sovDesiredLrp(uint64(l))
 	return n
 }
+func (m *DesiredLRPUpdate_Paused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2
+	return n
+}
 func (m *DesiredLRPKey) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3489,6 +4005,24 @@ func (m *DesiredLRP) Size() (n int) {
 		l = m.LogRateLimit.Size()
 		n += 2 + l + sovDesiredLrp(uint64(l))
 	}
+	if m.Revision != 0 {
+		n += 2 + sovDesiredLrp(uint64(m.Revision))
+	}
+	if m.RolloutStrategy != nil {
+		l = m.RolloutStrategy.Size()
+		n += 2 + l + sovDesiredLrp(uint64(l))
+	}
+	if m.RestartPolicy != nil {
+		l = m.RestartPolicy.Size()
+		n += 2 + l + sovDesiredLrp(uint64(l))
+	}
+	if m.ZoneSpreadConstraint != nil {
+		l = m.ZoneSpreadConstraint.Size()
+		n += 2 + l + sovDesiredLrp(uint64(l))
+	}
+	if m.Paused {
+		n += 3
+	}
 	return n
 }
 
@@ -3511,6 +4045,13 @@ func (this *DesiredLRPSchedulingInfo) String() string {
 		`ModificationTag:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ModificationTag), "ModificationTag", "ModificationTag", 1), `&`, ``, 1) + `,`,
 		`VolumePlacement:` + strings.Replace(fmt.Sprintf("%v", this.VolumePlacement), "VolumePlacement", "VolumePlacement", 1) + `,`,
 		`PlacementTags:` + fmt.Sprintf("%v", this.PlacementTags) + `,`,
+		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
+		`RolloutStrategy:` + strings.Replace(fmt.Sprintf("%v", this.RolloutStrategy), "RolloutStrategy", "RolloutStrategy", 1) + `,`,
+		`RestartPolicy:` + strings.Replace(fmt.Sprintf("%v", this.RestartPolicy), "RestartPolicy", "RestartPolicy", 1) + `,`,
+		`ZoneSpreadConstraint:` + strings.Replace(fmt.Sprintf("%v", this.ZoneSpreadConstraint), "ZoneSpreadConstraint", "ZoneSpreadConstraint", 1) + `,`,
+		`Paused:` + fmt.Sprintf("%v", this.Paused) + `,`,
+		`SidecarMemoryMb:` + fmt.Sprintf("%v", this.SidecarMemoryMb) + `,`,
+		`SidecarDiskMb:` + fmt.Sprintf("%v", this.SidecarDiskMb) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3630,6 +4171,10 @@ func (this *DesiredLRPUpdate) String() string {
 		`Routes:` + fmt.Sprintf("%v", this.Routes) + `,`,
 		`OptionalAnnotation:` + fmt.Sprintf("%v", this.OptionalAnnotation) + `,`,
 		`MetricTags:` + mapStringForMetricTags + `,`,
+		`RunInfo:` + strings.Replace(this.RunInfo.String(), "DesiredLRPRunInfo", "DesiredLRPRunInfo", 1) + `,`,
+		`Resource:` + strings.Replace(this.Resource.String(), "DesiredLRPResource", "DesiredLRPResource", 1) + `,`,
+		`RolloutStrategy:` + strings.Replace(fmt.Sprintf("%v", this.RolloutStrategy), "RolloutStrategy", "RolloutStrategy", 1) + `,`,
+		`OptionalPaused:` + fmt.Sprintf("%v", this.OptionalPaused) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3654,6 +4199,16 @@ func (this *DesiredLRPUpdate_Annotation) String() string {
 	}, "")
 	return s
 }
+func (this *DesiredLRPUpdate_Paused) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DesiredLRPUpdate_Paused{`,
+		`Paused:` + fmt.Sprintf("%v", this.Paused) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *DesiredLRPKey) String() string {
 	if this == nil {
 		return "nil"
@@ -3761,6 +4316,11 @@ func (this *DesiredLRP) String() string {
 		`MetricTags:` + mapStringForMetricTags + `,`,
 		`Sidecars:` + repeatedStringForSidecars + `,`,
 		`LogRateLimit:` + strings.Replace(fmt.Sprintf("%v", this.LogRateLimit), "LogRateLimit", "LogRateLimit", 1) + `,`,
+		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
+		`RolloutStrategy:` + strings.Replace(fmt.Sprintf("%v", this.RolloutStrategy), "RolloutStrategy", "RolloutStrategy", 1) + `,`,
+		`RestartPolicy:` + strings.Replace(fmt.Sprintf("%v", this.RestartPolicy), "RestartPolicy", "RestartPolicy", 1) + `,`,
+		`ZoneSpreadConstraint:` + strings.Replace(fmt.Sprintf("%v", this.ZoneSpreadConstraint), "ZoneSpreadConstraint", "ZoneSpreadConstraint", 1) + `,`,
+		`Paused:` + fmt.Sprintf("%v", this.Paused) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4025,7 +4585,205 @@ func (m *DesiredLRPSchedulingInfo) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PlacementTags", wireType)
 			}
-			var stringLen uint64
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDesiredLrp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDesiredLrp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlacementTags = append(m.PlacementTags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RolloutStrategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDesiredLrp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RolloutStrategy == nil {
+				m.RolloutStrategy = &RolloutStrategy{}
+			}
+			if err := m.RolloutStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDesiredLrp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RestartPolicy == nil {
+				m.RestartPolicy = &RestartPolicy{}
+			}
+			if err := m.RestartPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZoneSpreadConstraint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDesiredLrp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ZoneSpreadConstraint == nil {
+				m.ZoneSpreadConstraint = &ZoneSpreadConstraint{}
+			}
+			if err := m.ZoneSpreadConstraint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SidecarMemoryMb", wireType)
+			}
+			m.SidecarMemoryMb = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SidecarMemoryMb |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SidecarDiskMb", wireType)
+			}
+			m.SidecarDiskMb = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDesiredLrp
@@ -4035,24 +4793,11 @@ func (m *DesiredLRPSchedulingInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SidecarDiskMb |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDesiredLrp
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDesiredLrp
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PlacementTags = append(m.PlacementTags, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDesiredLrp(dAtA[iNdEx:])
@@ -5530,6 +6275,135 @@ func (m *DesiredLRPUpdate) Unmarshal(dAtA []byte) error {
 			}
 			m.MetricTags[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDesiredLrp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RunInfo == nil {
+				m.RunInfo = &DesiredLRPRunInfo{}
+			}
+			if err := m.RunInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDesiredLrp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resource == nil {
+				m.Resource = &DesiredLRPResource{}
+			}
+			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RolloutStrategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDesiredLrp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RolloutStrategy == nil {
+				m.RolloutStrategy = &RolloutStrategy{}
+			}
+			if err := m.RolloutStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.OptionalPaused = &DesiredLRPUpdate_Paused{b}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDesiredLrp(dAtA[iNdEx:])
@@ -7135,6 +8009,153 @@ func (m *DesiredLRP) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 38:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 39:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RolloutStrategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDesiredLrp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RolloutStrategy == nil {
+				m.RolloutStrategy = &RolloutStrategy{}
+			}
+			if err := m.RolloutStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 40:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDesiredLrp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RestartPolicy == nil {
+				m.RestartPolicy = &RestartPolicy{}
+			}
+			if err := m.RestartPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZoneSpreadConstraint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDesiredLrp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ZoneSpreadConstraint == nil {
+				m.ZoneSpreadConstraint = &ZoneSpreadConstraint{}
+			}
+			if err := m.ZoneSpreadConstraint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 42:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDesiredLrp(dAtA[iNdEx:])
@@ -67,3 +67,75 @@ func (request *RemoveDesiredLRPRequest) Validate() error {
 
 	return nil
 }
+
+func (request *RestartDesiredLRPRequest) Validate() error {
+	var validationError ValidationError
+
+	if request.ProcessGuid == "" {
+		validationError = validationError.Append(ErrInvalidField{"process_guid"})
+	}
+
+	if request.MaxInFlight < 0 {
+		validationError = validationError.Append(ErrInvalidField{"max_in_flight"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
+func (request *DesiredLRPRevisionsRequest) Validate() error {
+	var validationError ValidationError
+
+	if request.ProcessGuid == "" {
+		validationError = validationError.Append(ErrInvalidField{"process_guid"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
+func (request *DesiredLRPRevisionDiffRequest) Validate() error {
+	var validationError ValidationError
+
+	if request.ProcessGuid == "" {
+		validationError = validationError.Append(ErrInvalidField{"process_guid"})
+	}
+
+	if request.FromRevision < 0 {
+		validationError = validationError.Append(ErrInvalidField{"from_revision"})
+	}
+
+	if request.ToRevision < 0 {
+		validationError = validationError.Append(ErrInvalidField{"to_revision"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
+func (request *RollbackDesiredLRPRequest) Validate() error {
+	var validationError ValidationError
+
+	if request.ProcessGuid == "" {
+		validationError = validationError.Append(ErrInvalidField{"process_guid"})
+	}
+
+	if request.Revision < 0 {
+		validationError = validationError.Append(ErrInvalidField{"revision"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
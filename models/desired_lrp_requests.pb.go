@@ -505,6 +505,57 @@ func (m *RemoveDesiredLRPRequest) GetProcessGuid() string {
 	return ""
 }
 
+type RestartDesiredLRPRequest struct {
+	ProcessGuid string `protobuf:"bytes,1,opt,name=process_guid,json=processGuid,proto3" json:"process_guid"`
+	MaxInFlight int32  `protobuf:"varint,2,opt,name=max_in_flight,json=maxInFlight,proto3" json:"max_in_flight"`
+}
+
+func (m *RestartDesiredLRPRequest) Reset()      { *m = RestartDesiredLRPRequest{} }
+func (*RestartDesiredLRPRequest) ProtoMessage() {}
+func (*RestartDesiredLRPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7235cc1a84e38c85, []int{10}
+}
+func (m *RestartDesiredLRPRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestartDesiredLRPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestartDesiredLRPRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RestartDesiredLRPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestartDesiredLRPRequest.Merge(m, src)
+}
+func (m *RestartDesiredLRPRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestartDesiredLRPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestartDesiredLRPRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestartDesiredLRPRequest proto.InternalMessageInfo
+
+func (m *RestartDesiredLRPRequest) GetProcessGuid() string {
+	if m != nil {
+		return m.ProcessGuid
+	}
+	return ""
+}
+
+func (m *RestartDesiredLRPRequest) GetMaxInFlight() int32 {
+	if m != nil {
+		return m.MaxInFlight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*DesiredLRPLifecycleResponse)(nil), "models.DesiredLRPLifecycleResponse")
 	proto.RegisterType((*DesiredLRPsResponse)(nil), "models.DesiredLRPsResponse")
@@ -516,43 +567,47 @@ func init() {
 	proto.RegisterType((*DesireLRPRequest)(nil), "models.DesireLRPRequest")
 	proto.RegisterType((*UpdateDesiredLRPRequest)(nil), "models.UpdateDesiredLRPRequest")
 	proto.RegisterType((*RemoveDesiredLRPRequest)(nil), "models.RemoveDesiredLRPRequest")
+	proto.RegisterType((*RestartDesiredLRPRequest)(nil), "models.RestartDesiredLRPRequest")
 }
 
 func init() { proto.RegisterFile("desired_lrp_requests.proto", fileDescriptor_7235cc1a84e38c85) }
 
 var fileDescriptor_7235cc1a84e38c85 = []byte{
-	// 493 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x4f, 0x6b, 0x13, 0x41,
-	0x18, 0xc6, 0x77, 0x2a, 0x06, 0xfa, 0x6e, 0x0a, 0x75, 0x3c, 0x74, 0x4d, 0x65, 0x1a, 0xa7, 0x97,
-	0x5e, 0x9a, 0x4a, 0xa3, 0x5f, 0x20, 0x28, 0x45, 0x08, 0x52, 0x46, 0x7a, 0x94, 0x25, 0xd9, 0x9d,
-	0x6c, 0x17, 0x92, 0x9d, 0xed, 0x4c, 0x56, 0xe8, 0xad, 0x1f, 0xc1, 0x8f, 0x21, 0x78, 0xf6, 0x3b,
-	0x78, 0xcc, 0xb1, 0xa7, 0x62, 0x36, 0x17, 0xe9, 0xa9, 0x1f, 0x41, 0x32, 0x33, 0x75, 0x27, 0xad,
-	0x51, 0x23, 0x9e, 0x92, 0x79, 0xff, 0x3c, 0xef, 0xef, 0x7d, 0x79, 0x58, 0x68, 0xc4, 0x5c, 0xa5,
-	0x92, 0xc7, 0xe1, 0x50, 0xe6, 0xa1, 0xe4, 0x67, 0x05, 0x57, 0x63, 0xd5, 0xca, 0xa5, 0x18, 0x0b,
-	0x5c, 0x1b, 0x89, 0x98, 0x0f, 0x55, 0x63, 0x3f, 0x49, 0xc7, 0xa7, 0x45, 0xbf, 0x15, 0x89, 0xd1,
-	0x41, 0x22, 0x12, 0x71, 0xa0, 0xd3, 0xfd, 0x62, 0xa0, 0x5f, 0xfa, 0xa1, 0xff, 0x99, 0xb6, 0xc6,
-	0x23, 0x47, 0xd2, 0x86, 0x7c, 0x2e, 0xa5, 0x90, 0xe6, 0x41, 0x3b, 0xb0, 0xfd, 0xca, 0x54, 0x74,
-	0xd9, 0x71, 0x37, 0x1d, 0xf0, 0xe8, 0x3c, 0x1a, 0x72, 0xc6, 0x55, 0x2e, 0x32, 0xc5, 0xf1, 0x2e,
-	0x3c, 0xd4, 0xd5, 0x01, 0x6a, 0xa2, 0x3d, 0xff, 0x70, 0xa3, 0x65, 0x28, 0x5a, 0xaf, 0xe7, 0x41,
-	0x66, 0x72, 0xf4, 0x0c, 0x1e, 0x57, 0x1a, 0x6a, 0xa5, 0x5e, 0xfc, 0x12, 0xea, 0x0e, 0xa1, 0x0a,
-	0xd6, 0x9a, 0x0f, 0xf6, 0xfc, 0x43, 0x7c, 0x5b, 0x5b, 0xe9, 0x32, 0xdf, 0xd6, 0x75, 0x65, 0xae,
-	0xe8, 0x7b, 0xc0, 0x0b, 0x23, 0xf5, 0xa9, 0x30, 0x85, 0x5a, 0x2c, 0x46, 0xbd, 0x34, 0xd3, 0x23,
-	0xd7, 0x3b, 0x70, 0x7d, 0xb5, 0x63, 0x23, 0xcc, 0xfe, 0xe2, 0x5d, 0xd8, 0xc8, 0xa5, 0x88, 0xb8,
-	0x52, 0x61, 0x52, 0xa4, 0xb1, 0x99, 0xb8, 0xce, 0xea, 0x36, 0x78, 0x34, 0x8f, 0xd1, 0xcc, 0x95,
-	0x5f, 0x6d, 0xa1, 0x36, 0xf8, 0xce, 0x42, 0xc1, 0x9a, 0x2e, 0xfd, 0xd5, 0x3e, 0x50, 0xed, 0x43,
-	0x3f, 0x23, 0x78, 0x56, 0xa5, 0xde, 0x45, 0xa7, 0x3c, 0x2e, 0x86, 0x69, 0x96, 0xbc, 0xc9, 0x06,
-	0x62, 0xc5, 0x83, 0xf6, 0xe0, 0xa9, 0xeb, 0x22, 0xf5, 0x53, 0x2b, 0x4c, 0xe7, 0x62, 0xf6, 0xc0,
-	0xcd, 0xfb, 0x40, 0x8b, 0x53, 0xd9, 0x93, 0x0a, 0xef, 0x0e, 0x0f, 0xfd, 0x82, 0x60, 0x7f, 0x59,
-	0x5f, 0xe7, 0xfc, 0xb8, 0x3a, 0xe4, 0x6a, 0xe4, 0x21, 0x6c, 0xff, 0x86, 0xdc, 0x5e, 0xf2, 0xcf,
-	0xe0, 0xc1, 0x32, 0x70, 0x7a, 0x02, 0xa4, 0xea, 0xba, 0x03, 0x6a, 0x0c, 0xd4, 0x86, 0xba, 0x6b,
-	0x0e, 0x6b, 0xa3, 0xcd, 0xeb, 0xab, 0x9d, 0x85, 0x38, 0xf3, 0x1d, 0xb7, 0xd0, 0x23, 0xd8, 0x34,
-	0xb2, 0xda, 0x2b, 0xb7, 0x42, 0x0b, 0x2e, 0x40, 0x7f, 0xe5, 0x82, 0x0b, 0x04, 0x5b, 0x27, 0x79,
-	0xdc, 0x1b, 0x73, 0xd7, 0x7c, 0xff, 0x4e, 0x86, 0x9f, 0x43, 0xad, 0xd0, 0x7a, 0xf6, 0x78, 0xc1,
-	0x7d, 0x00, 0x33, 0x8f, 0xd9, 0x3a, 0xfa, 0x16, 0xb6, 0x18, 0x1f, 0x89, 0x0f, 0xff, 0x89, 0xa0,
-	0xf3, 0x62, 0x32, 0x25, 0xde, 0xe5, 0x94, 0x78, 0x37, 0x53, 0x82, 0x2e, 0x4a, 0x82, 0x3e, 0x95,
-	0x04, 0x7d, 0x2d, 0x09, 0x9a, 0x94, 0x04, 0x7d, 0x2b, 0x09, 0xfa, 0x5e, 0x12, 0xef, 0xa6, 0x24,
-	0xe8, 0xe3, 0x8c, 0x78, 0x93, 0x19, 0xf1, 0x2e, 0x67, 0xc4, 0xeb, 0xd7, 0xf4, 0xb7, 0xa9, 0xfd,
-	0x23, 0x00, 0x00, 0xff, 0xff, 0xca, 0x08, 0x89, 0xe8, 0x10, 0x05, 0x00, 0x00,
+	// 533 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x3f, 0x6b, 0xdb, 0x40,
+	0x18, 0xc6, 0x75, 0x29, 0x31, 0xe4, 0x55, 0x0c, 0x89, 0x3a, 0x44, 0x75, 0xca, 0xd9, 0xbd, 0x2c,
+	0x59, 0xe2, 0x94, 0xb8, 0xf9, 0x02, 0xa6, 0x6d, 0x08, 0x98, 0x12, 0xae, 0x64, 0x2c, 0x42, 0xb6,
+	0xce, 0xb2, 0xc0, 0xd2, 0x29, 0x77, 0x52, 0x49, 0xb6, 0x4c, 0x9d, 0xfb, 0x31, 0x0a, 0x9d, 0xfb,
+	0x1d, 0x3a, 0x7a, 0xcc, 0x64, 0x6a, 0x79, 0x29, 0x9e, 0xf2, 0x11, 0x8a, 0x4f, 0x97, 0x4a, 0x4e,
+	0x70, 0x5b, 0xd3, 0x4c, 0xf6, 0xbd, 0x7f, 0x9e, 0xf7, 0xf7, 0xbe, 0x3c, 0x08, 0x6a, 0x1e, 0x93,
+	0x81, 0x60, 0x9e, 0x33, 0x14, 0xb1, 0x23, 0xd8, 0x45, 0xca, 0x64, 0x22, 0x9b, 0xb1, 0xe0, 0x09,
+	0xb7, 0x2a, 0x21, 0xf7, 0xd8, 0x50, 0xd6, 0x0e, 0xfc, 0x20, 0x19, 0xa4, 0xdd, 0x66, 0x8f, 0x87,
+	0x87, 0x3e, 0xf7, 0xf9, 0xa1, 0x4a, 0x77, 0xd3, 0xbe, 0x7a, 0xa9, 0x87, 0xfa, 0x97, 0xb7, 0xd5,
+	0xb6, 0x4b, 0x92, 0x3a, 0x64, 0x32, 0x21, 0xb8, 0xc8, 0x1f, 0xa4, 0x0d, 0xbb, 0xaf, 0xf3, 0x8a,
+	0x0e, 0x3d, 0xeb, 0x04, 0x7d, 0xd6, 0xbb, 0xea, 0x0d, 0x19, 0x65, 0x32, 0xe6, 0x91, 0x64, 0xd6,
+	0x1e, 0xac, 0xab, 0x6a, 0x1b, 0x35, 0xd0, 0xbe, 0x79, 0x54, 0x6d, 0xe6, 0x14, 0xcd, 0x37, 0xf3,
+	0x20, 0xcd, 0x73, 0xe4, 0x02, 0x9e, 0x16, 0x1a, 0x72, 0xa5, 0x5e, 0xeb, 0x18, 0x36, 0x4b, 0x84,
+	0xd2, 0x5e, 0x6b, 0x3c, 0xd9, 0x37, 0x8f, 0xac, 0xbb, 0xda, 0x42, 0x97, 0x9a, 0xba, 0xae, 0x23,
+	0x62, 0x49, 0x3e, 0x80, 0xb5, 0x30, 0x52, 0x9d, 0xca, 0x22, 0x50, 0xf1, 0x78, 0xe8, 0x06, 0x91,
+	0x1a, 0xb9, 0xd1, 0x86, 0xd9, 0xb8, 0xae, 0x23, 0x54, 0xff, 0x5a, 0x7b, 0x50, 0x8d, 0x05, 0xef,
+	0x31, 0x29, 0x1d, 0x3f, 0x0d, 0xbc, 0x7c, 0xe2, 0x06, 0xdd, 0xd4, 0xc1, 0x93, 0x79, 0x8c, 0x44,
+	0x65, 0xf9, 0xd5, 0x16, 0x6a, 0x81, 0x59, 0x5a, 0xc8, 0x5e, 0x6b, 0xa0, 0x25, 0xfb, 0x40, 0xb1,
+	0x0f, 0xf9, 0x8a, 0xe0, 0x45, 0x91, 0x7a, 0xdf, 0x1b, 0x30, 0x2f, 0x1d, 0x06, 0x91, 0x7f, 0x1a,
+	0xf5, 0xf9, 0x8a, 0x07, 0x75, 0xe1, 0x79, 0xd9, 0x45, 0xf2, 0xb7, 0x96, 0x13, 0xcc, 0xc5, 0xf4,
+	0x81, 0x1b, 0x0f, 0x81, 0x16, 0xa7, 0xd2, 0x67, 0x05, 0xde, 0x3d, 0x1e, 0xf2, 0x0d, 0xc1, 0xc1,
+	0xb2, 0xbe, 0xf6, 0xd5, 0x59, 0x71, 0xc8, 0xd5, 0xc8, 0x1d, 0xd8, 0xfd, 0x03, 0xb9, 0xbe, 0xe4,
+	0xdf, 0xc1, 0xed, 0x65, 0xe0, 0xe4, 0x1c, 0x70, 0xd1, 0x75, 0x0f, 0x34, 0x37, 0x50, 0x0b, 0x36,
+	0xcb, 0xe6, 0xd0, 0x36, 0xda, 0x9a, 0x8d, 0xeb, 0x0b, 0x71, 0x6a, 0x96, 0xdc, 0x42, 0x4e, 0x60,
+	0x2b, 0x97, 0x55, 0x5e, 0xb9, 0x13, 0x5a, 0x70, 0x01, 0xfa, 0x27, 0x17, 0x5c, 0x23, 0xd8, 0x39,
+	0x8f, 0x3d, 0x37, 0x61, 0xa5, 0x82, 0xff, 0x20, 0xb3, 0x5e, 0x42, 0x25, 0x55, 0x7a, 0xfa, 0x78,
+	0xf6, 0x43, 0x80, 0x7c, 0x1e, 0xd5, 0x75, 0xe4, 0x1d, 0xec, 0x50, 0x16, 0xf2, 0x8f, 0x8f, 0x44,
+	0x40, 0x3e, 0x21, 0xb0, 0x29, 0x93, 0x89, 0x2b, 0x92, 0x47, 0xda, 0xe9, 0x18, 0xaa, 0xa1, 0x7b,
+	0xe9, 0x04, 0x91, 0xd3, 0x1f, 0x06, 0xfe, 0x20, 0x51, 0xab, 0xad, 0xb7, 0xb7, 0x67, 0xe3, 0xfa,
+	0x62, 0x82, 0x9a, 0xa1, 0x7b, 0x79, 0x1a, 0xbd, 0x55, 0x8f, 0xf6, 0xab, 0xd1, 0x04, 0x1b, 0x37,
+	0x13, 0x6c, 0xdc, 0x4e, 0x30, 0xba, 0xce, 0x30, 0xfa, 0x92, 0x61, 0xf4, 0x3d, 0xc3, 0x68, 0x94,
+	0x61, 0xf4, 0x23, 0xc3, 0xe8, 0x67, 0x86, 0x8d, 0xdb, 0x0c, 0xa3, 0xcf, 0x53, 0x6c, 0x8c, 0xa6,
+	0xd8, 0xb8, 0x99, 0x62, 0xa3, 0x5b, 0x51, 0x1f, 0xc9, 0xd6, 0xaf, 0x01, 0x00, 0x51, 0x79, 0x07,
+	0x92, 0x99, 0x05, 0x00, 0x00,
 }
 
 func (this *DesiredLRPLifecycleResponse) Equal(that interface{}) bool {
@@ -828,6 +883,33 @@ func (this *RemoveDesiredLRPRequest) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *RestartDesiredLRPRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RestartDesiredLRPRequest)
+	if !ok {
+		that2, ok := that.(RestartDesiredLRPRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProcessGuid != that1.ProcessGuid {
+		return false
+	}
+	if this.MaxInFlight != that1.MaxInFlight {
+		return false
+	}
+	return true
+}
 func (this *DesiredLRPLifecycleResponse) GoString() string {
 	if this == nil {
 		return "nil"
@@ -956,6 +1038,17 @@ func (this *RemoveDesiredLRPRequest) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *RestartDesiredLRPRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.RestartDesiredLRPRequest{")
+	s = append(s, "ProcessGuid: "+fmt.Sprintf("%#v", this.ProcessGuid)+",\n")
+	s = append(s, "MaxInFlight: "+fmt.Sprintf("%#v", this.MaxInFlight)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringDesiredLrpRequests(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -1367,6 +1460,41 @@ func (m *RemoveDesiredLRPRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *RestartDesiredLRPRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestartDesiredLRPRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestartDesiredLRPRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxInFlight != 0 {
+		i = encodeVarintDesiredLrpRequests(dAtA, i, uint64(m.MaxInFlight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ProcessGuid) > 0 {
+		i -= len(m.ProcessGuid)
+		copy(dAtA[i:], m.ProcessGuid)
+		i = encodeVarintDesiredLrpRequests(dAtA, i, uint64(len(m.ProcessGuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDesiredLrpRequests(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDesiredLrpRequests(v)
 	base := offset
@@ -1538,6 +1666,22 @@ func (m *RemoveDesiredLRPRequest) Size() (n int) {
 	return n
 }
 
+func (m *RestartDesiredLRPRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProcessGuid)
+	if l > 0 {
+		n += 1 + l + sovDesiredLrpRequests(uint64(l))
+	}
+	if m.MaxInFlight != 0 {
+		n += 1 + sovDesiredLrpRequests(uint64(m.MaxInFlight))
+	}
+	return n
+}
+
 func sovDesiredLrpRequests(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1660,6 +1804,17 @@ func (this *RemoveDesiredLRPRequest) String() string {
 	}, "")
 	return s
 }
+func (this *RestartDesiredLRPRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RestartDesiredLRPRequest{`,
+		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
+		`MaxInFlight:` + fmt.Sprintf("%v", this.MaxInFlight) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringDesiredLrpRequests(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -2720,6 +2875,107 @@ func (m *RemoveDesiredLRPRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RestartDesiredLRPRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDesiredLrpRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestartDesiredLRPRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestartDesiredLRPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInFlight", wireType)
+			}
+			m.MaxInFlight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxInFlight |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDesiredLrpRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDesiredLrpRequests(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -140,4 +140,82 @@ var _ = Describe("DesiredLRP Requests", func() {
 			})
 		})
 	})
+
+	Describe("RestartDesiredLRPRequest", func() {
+		Describe("Validate", func() {
+			var request models.RestartDesiredLRPRequest
+
+			BeforeEach(func() {
+				request = models.RestartDesiredLRPRequest{
+					ProcessGuid: "some-guid",
+					MaxInFlight: 2,
+				}
+			})
+
+			Context("when valid", func() {
+				It("returns nil", func() {
+					Expect(request.Validate()).To(BeNil())
+				})
+			})
+
+			Context("when the ProcessGuid is blank", func() {
+				BeforeEach(func() {
+					request.ProcessGuid = ""
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"process_guid"}))
+				})
+			})
+
+			Context("when the MaxInFlight is negative", func() {
+				BeforeEach(func() {
+					request.MaxInFlight = -1
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"max_in_flight"}))
+				})
+			})
+		})
+	})
+
+	Describe("RollbackDesiredLRPRequest", func() {
+		Describe("Validate", func() {
+			var request models.RollbackDesiredLRPRequest
+
+			BeforeEach(func() {
+				request = models.RollbackDesiredLRPRequest{
+					ProcessGuid: "some-guid",
+					Revision:    1,
+				}
+			})
+
+			Context("when valid", func() {
+				It("returns nil", func() {
+					Expect(request.Validate()).To(BeNil())
+				})
+			})
+
+			Context("when the ProcessGuid is blank", func() {
+				BeforeEach(func() {
+					request.ProcessGuid = ""
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"process_guid"}))
+				})
+			})
+
+			Context("when the Revision is negative", func() {
+				BeforeEach(func() {
+					request.Revision = -1
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"revision"}))
+				})
+			})
+		})
+	})
 })
@@ -0,0 +1,114 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"time"
+)
+
+// MaxDesiredLRPRevisions is the number of revisions kept in the history of
+// each DesiredLRP. Older revisions are pruned when a new one is recorded.
+const MaxDesiredLRPRevisions = 10
+
+const (
+	DesiredLRPRevisionSourceDesire   = "desire"
+	DesiredLRPRevisionSourceUpdate   = "update"
+	DesiredLRPRevisionSourceRollback = "rollback"
+	DesiredLRPRevisionSourceBackfill = "backfill"
+)
+
+// NewDesiredLRPRevision returns the revision of the DesiredLRP recorded in its
+// history.
+func NewDesiredLRPRevision(desiredLRP *DesiredLRP, createdAt time.Time, source string) *DesiredLRPRevision {
+	runInfo := desiredLRP.DesiredLRPRunInfo(createdAt)
+	schedulingInfo := desiredLRP.DesiredLRPSchedulingInfo()
+	modificationTag := schedulingInfo.ModificationTag
+	return &DesiredLRPRevision{
+		ProcessGuid:     desiredLRP.ProcessGuid,
+		Revision:        desiredLRP.Revision,
+		RunInfo:         &runInfo,
+		SchedulingInfo:  &schedulingInfo,
+		ModificationTag: &modificationTag,
+		CreatedAt:       runInfo.CreatedAt,
+		Source:          source,
+	}
+}
+
+// DesiredLRP returns the DesiredLRP as it was at this revision.
+func (r *DesiredLRPRevision) DesiredLRP() *DesiredLRP {
+	desiredLRP := NewDesiredLRP(*r.SchedulingInfo, *r.RunInfo, nil)
+	return &desiredLRP
+}
+
+// RollbackUpdate returns the update restoring the run info and resources of
+// this revision. The instances, routes and other scheduling fields of the
+// DesiredLRP are left as they are.
+func (r *DesiredLRPRevision) RollbackUpdate() *DesiredLRPUpdate {
+	runInfo := *r.RunInfo
+	resource := r.SchedulingInfo.DesiredLRPResource
+	return &DesiredLRPUpdate{
+		RunInfo:  &runInfo,
+		Resource: &resource,
+	}
+}
+
+// DiffDesiredLRPRevisions returns the top-level fields of the DesiredLRP that
+// differ between the two revisions, sorted by name, with their JSON values.
+// The revision and modification tag are left out as they always differ.
+func DiffDesiredLRPRevisions(from, to *DesiredLRPRevision) ([]*DesiredLRPRevisionChange, error) {
+	fromFields, err := desiredLRPRevisionFields(from)
+	if err != nil {
+		return nil, err
+	}
+
+	toFields, err := desiredLRPRevisionFields(to)
+	if err != nil {
+		return nil, err
+	}
+
+	names := map[string]struct{}{}
+	for name := range fromFields {
+		names[name] = struct{}{}
+	}
+	for name := range toFields {
+		names[name] = struct{}{}
+	}
+
+	sortedNames := make([]string, 0, len(names))
+	for name := range names {
+		sortedNames = append(sortedNames, name)
+	}
+	sort.Strings(sortedNames)
+
+	changes := []*DesiredLRPRevisionChange{}
+	for _, name := range sortedNames {
+		if name == "revision" || name == "modification_tag" {
+			continue
+		}
+		if bytes.Equal(fromFields[name], toFields[name]) {
+			continue
+		}
+		changes = append(changes, &DesiredLRPRevisionChange{
+			Field: name,
+			From:  string(fromFields[name]),
+			To:    string(toFields[name]),
+		})
+	}
+
+	return changes, nil
+}
+
+func desiredLRPRevisionFields(r *DesiredLRPRevision) (map[string]json.RawMessage, error) {
+	data, err := json.Marshal(r.DesiredLRP())
+	if err != nil {
+		return nil, err
+	}
+
+	fields := map[string]json.RawMessage{}
+	err = json.Unmarshal(data, &fields)
+	if err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
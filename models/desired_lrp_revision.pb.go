@@ -0,0 +1,1055 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: desired_lrp_revision.proto
+
+package models
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type DesiredLRPRevision struct {
+	ProcessGuid     string                    `protobuf:"bytes,1,opt,name=process_guid,json=processGuid,proto3" json:"process_guid"`
+	Revision        int32                     `protobuf:"varint,2,opt,name=revision,proto3" json:"revision"`
+	RunInfo         *DesiredLRPRunInfo        `protobuf:"bytes,3,opt,name=run_info,json=runInfo,proto3" json:"run_info,omitempty"`
+	SchedulingInfo  *DesiredLRPSchedulingInfo `protobuf:"bytes,4,opt,name=scheduling_info,json=schedulingInfo,proto3" json:"scheduling_info,omitempty"`
+	ModificationTag *ModificationTag          `protobuf:"bytes,5,opt,name=modification_tag,json=modificationTag,proto3" json:"modification_tag,omitempty"`
+	CreatedAt       int64                     `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	Source          string                    `protobuf:"bytes,7,opt,name=source,proto3" json:"source"`
+}
+
+func (m *DesiredLRPRevision) Reset()      { *m = DesiredLRPRevision{} }
+func (*DesiredLRPRevision) ProtoMessage() {}
+func (*DesiredLRPRevision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de8c34ec5717ceb0, []int{0}
+}
+func (m *DesiredLRPRevision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DesiredLRPRevision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DesiredLRPRevision.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DesiredLRPRevision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DesiredLRPRevision.Merge(m, src)
+}
+func (m *DesiredLRPRevision) XXX_Size() int {
+	return m.Size()
+}
+func (m *DesiredLRPRevision) XXX_DiscardUnknown() {
+	xxx_messageInfo_DesiredLRPRevision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DesiredLRPRevision proto.InternalMessageInfo
+
+func (m *DesiredLRPRevision) GetProcessGuid() string {
+	if m != nil {
+		return m.ProcessGuid
+	}
+	return ""
+}
+
+func (m *DesiredLRPRevision) GetRevision() int32 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *DesiredLRPRevision) GetRunInfo() *DesiredLRPRunInfo {
+	if m != nil {
+		return m.RunInfo
+	}
+	return nil
+}
+
+func (m *DesiredLRPRevision) GetSchedulingInfo() *DesiredLRPSchedulingInfo {
+	if m != nil {
+		return m.SchedulingInfo
+	}
+	return nil
+}
+
+func (m *DesiredLRPRevision) GetModificationTag() *ModificationTag {
+	if m != nil {
+		return m.ModificationTag
+	}
+	return nil
+}
+
+func (m *DesiredLRPRevision) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *DesiredLRPRevision) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+type DesiredLRPRevisionChange struct {
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field"`
+	From  string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To    string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (m *DesiredLRPRevisionChange) Reset()      { *m = DesiredLRPRevisionChange{} }
+func (*DesiredLRPRevisionChange) ProtoMessage() {}
+func (*DesiredLRPRevisionChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de8c34ec5717ceb0, []int{1}
+}
+func (m *DesiredLRPRevisionChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DesiredLRPRevisionChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DesiredLRPRevisionChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DesiredLRPRevisionChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DesiredLRPRevisionChange.Merge(m, src)
+}
+func (m *DesiredLRPRevisionChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *DesiredLRPRevisionChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_DesiredLRPRevisionChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DesiredLRPRevisionChange proto.InternalMessageInfo
+
+func (m *DesiredLRPRevisionChange) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *DesiredLRPRevisionChange) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *DesiredLRPRevisionChange) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*DesiredLRPRevision)(nil), "models.DesiredLRPRevision")
+	proto.RegisterType((*DesiredLRPRevisionChange)(nil), "models.DesiredLRPRevisionChange")
+}
+
+func init() { proto.RegisterFile("desired_lrp_revision.proto", fileDescriptor_de8c34ec5717ceb0) }
+
+var fileDescriptor_de8c34ec5717ceb0 = []byte{
+	// 429 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0xbd, 0x6e, 0xdb, 0x30,
+	0x14, 0x85, 0x45, 0x3b, 0x76, 0x22, 0x26, 0x70, 0x52, 0x0e, 0xad, 0xea, 0x81, 0x12, 0x3c, 0x69,
+	0x89, 0x03, 0x34, 0x79, 0x81, 0xaa, 0x05, 0x8a, 0x00, 0x2d, 0x50, 0xb0, 0xdd, 0x05, 0x59, 0xa2,
+	0x64, 0x02, 0x96, 0x68, 0xf0, 0xa7, 0x73, 0xf7, 0x2e, 0x7d, 0x8c, 0x3e, 0x4a, 0x47, 0x8f, 0x99,
+	0x84, 0x5a, 0x5e, 0x0a, 0x4d, 0x79, 0x84, 0x02, 0xa4, 0x6c, 0xc8, 0xf5, 0xc4, 0x73, 0x3f, 0xf2,
+	0x1e, 0x51, 0xe7, 0x12, 0x4e, 0x33, 0x2a, 0x99, 0xa0, 0x59, 0xbc, 0x12, 0xeb, 0x58, 0xd0, 0x6f,
+	0x4c, 0x32, 0x5e, 0xcd, 0xd7, 0x82, 0x2b, 0x8e, 0xc6, 0x25, 0xcf, 0xe8, 0x4a, 0x4e, 0x6f, 0x0b,
+	0xa6, 0x96, 0x7a, 0x31, 0x4f, 0x79, 0x79, 0x57, 0xf0, 0x82, 0xdf, 0x99, 0xed, 0x85, 0xce, 0x4d,
+	0x65, 0x0a, 0xa3, 0x6c, 0xdb, 0xf4, 0x45, 0xcf, 0xb2, 0x43, 0x2f, 0x4b, 0x9e, 0xb1, 0x9c, 0xa5,
+	0x89, 0x62, 0xbc, 0x8a, 0x55, 0x52, 0x58, 0x3e, 0xfb, 0x31, 0x84, 0xe8, 0xbd, 0x3d, 0xfd, 0x91,
+	0x7c, 0x26, 0xdd, 0xe7, 0xd1, 0x3d, 0xbc, 0x5a, 0x0b, 0x9e, 0x52, 0x29, 0xe3, 0x42, 0xb3, 0xcc,
+	0x03, 0x01, 0x08, 0xdd, 0xe8, 0xa6, 0xad, 0xfd, 0x23, 0x4e, 0x2e, 0xbb, 0xea, 0x83, 0x66, 0x19,
+	0x0a, 0xe1, 0xc5, 0xfe, 0xfe, 0xde, 0x20, 0x00, 0xe1, 0x28, 0xba, 0x6a, 0x6b, 0xff, 0xc0, 0xc8,
+	0x41, 0xa1, 0x07, 0x78, 0x21, 0x74, 0x15, 0xb3, 0x2a, 0xe7, 0xde, 0x30, 0x00, 0xe1, 0xe5, 0x9b,
+	0xd7, 0x73, 0xfb, 0xab, 0xf3, 0xde, 0x65, 0x74, 0xf5, 0x58, 0xe5, 0x9c, 0x9c, 0x0b, 0x2b, 0xd0,
+	0x23, 0xbc, 0x96, 0xe9, 0x92, 0x66, 0x7a, 0xc5, 0xaa, 0xc2, 0x36, 0x9f, 0x99, 0xe6, 0xe0, 0xb4,
+	0xf9, 0xcb, 0xe1, 0xa0, 0xf1, 0x98, 0xc8, 0xa3, 0x1a, 0x45, 0xf0, 0xe6, 0xff, 0x40, 0xbc, 0x91,
+	0xf1, 0x7a, 0xb5, 0xf7, 0xfa, 0xd4, 0xdb, 0xff, 0x9a, 0x14, 0xe4, 0xba, 0x3c, 0x06, 0xe8, 0x16,
+	0xc2, 0x54, 0xd0, 0x44, 0xd1, 0x2c, 0x4e, 0x94, 0x37, 0x0e, 0x40, 0x38, 0x8c, 0x26, 0x6d, 0xed,
+	0xf7, 0x28, 0x71, 0x3b, 0xfd, 0x56, 0xa1, 0x19, 0x1c, 0x4b, 0xae, 0x45, 0x4a, 0xbd, 0x73, 0x13,
+	0x26, 0x6c, 0x6b, 0xbf, 0x23, 0xa4, 0x5b, 0x67, 0x31, 0xf4, 0x4e, 0x87, 0xf1, 0x6e, 0x99, 0x54,
+	0x05, 0x45, 0x3e, 0x1c, 0xe5, 0x8c, 0xae, 0xf6, 0xb3, 0x70, 0xdb, 0xda, 0xb7, 0x80, 0xd8, 0x05,
+	0x21, 0x78, 0x96, 0x0b, 0x5e, 0x9a, 0xe8, 0x5d, 0x62, 0x34, 0x9a, 0xc0, 0x81, 0xb2, 0x11, 0xbb,
+	0x64, 0xa0, 0x78, 0xf4, 0xb0, 0xd9, 0x62, 0xe7, 0x69, 0x8b, 0x9d, 0xe7, 0x2d, 0x06, 0xdf, 0x1b,
+	0x0c, 0x7e, 0x35, 0x18, 0xfc, 0x6e, 0x30, 0xd8, 0x34, 0x18, 0xfc, 0x69, 0x30, 0xf8, 0xdb, 0x60,
+	0xe7, 0xb9, 0xc1, 0xe0, 0xe7, 0x0e, 0x3b, 0x9b, 0x1d, 0x76, 0x9e, 0x76, 0xd8, 0x59, 0x8c, 0xcd,
+	0x5b, 0xb9, 0xff, 0x37, 0x00, 0x50, 0x18, 0x5e, 0x98, 0xab, 0x02, 0x00, 0x00,
+}
+
+func (this *DesiredLRPRevision) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DesiredLRPRevision)
+	if !ok {
+		that2, ok := that.(DesiredLRPRevision)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProcessGuid != that1.ProcessGuid {
+		return false
+	}
+	if this.Revision != that1.Revision {
+		return false
+	}
+	if !this.RunInfo.Equal(that1.RunInfo) {
+		return false
+	}
+	if !this.SchedulingInfo.Equal(that1.SchedulingInfo) {
+		return false
+	}
+	if !this.ModificationTag.Equal(that1.ModificationTag) {
+		return false
+	}
+	if this.CreatedAt != that1.CreatedAt {
+		return false
+	}
+	if this.Source != that1.Source {
+		return false
+	}
+	return true
+}
+func (this *DesiredLRPRevisionChange) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DesiredLRPRevisionChange)
+	if !ok {
+		that2, ok := that.(DesiredLRPRevisionChange)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Field != that1.Field {
+		return false
+	}
+	if this.From != that1.From {
+		return false
+	}
+	if this.To != that1.To {
+		return false
+	}
+	return true
+}
+func (this *DesiredLRPRevision) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&models.DesiredLRPRevision{")
+	s = append(s, "ProcessGuid: "+fmt.Sprintf("%#v", this.ProcessGuid)+",\n")
+	s = append(s, "Revision: "+fmt.Sprintf("%#v", this.Revision)+",\n")
+	if this.RunInfo != nil {
+		s = append(s, "RunInfo: "+fmt.Sprintf("%#v", this.RunInfo)+",\n")
+	}
+	if this.SchedulingInfo != nil {
+		s = append(s, "SchedulingInfo: "+fmt.Sprintf("%#v", this.SchedulingInfo)+",\n")
+	}
+	if this.ModificationTag != nil {
+		s = append(s, "ModificationTag: "+fmt.Sprintf("%#v", this.ModificationTag)+",\n")
+	}
+	s = append(s, "CreatedAt: "+fmt.Sprintf("%#v", this.CreatedAt)+",\n")
+	s = append(s, "Source: "+fmt.Sprintf("%#v", this.Source)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DesiredLRPRevisionChange) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&models.DesiredLRPRevisionChange{")
+	s = append(s, "Field: "+fmt.Sprintf("%#v", this.Field)+",\n")
+	s = append(s, "From: "+fmt.Sprintf("%#v", this.From)+",\n")
+	s = append(s, "To: "+fmt.Sprintf("%#v", this.To)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringDesiredLrpRevision(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *DesiredLRPRevision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DesiredLRPRevision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DesiredLRPRevision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintDesiredLrpRevision(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintDesiredLrpRevision(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ModificationTag != nil {
+		{
+			size, err := m.ModificationTag.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDesiredLrpRevision(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.SchedulingInfo != nil {
+		{
+			size, err := m.SchedulingInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDesiredLrpRevision(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RunInfo != nil {
+		{
+			size, err := m.RunInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDesiredLrpRevision(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Revision != 0 {
+		i = encodeVarintDesiredLrpRevision(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ProcessGuid) > 0 {
+		i -= len(m.ProcessGuid)
+		copy(dAtA[i:], m.ProcessGuid)
+		i = encodeVarintDesiredLrpRevision(dAtA, i, uint64(len(m.ProcessGuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DesiredLRPRevisionChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DesiredLRPRevisionChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DesiredLRPRevisionChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintDesiredLrpRevision(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintDesiredLrpRevision(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintDesiredLrpRevision(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDesiredLrpRevision(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDesiredLrpRevision(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DesiredLRPRevision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProcessGuid)
+	if l > 0 {
+		n += 1 + l + sovDesiredLrpRevision(uint64(l))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovDesiredLrpRevision(uint64(m.Revision))
+	}
+	if m.RunInfo != nil {
+		l = m.RunInfo.Size()
+		n += 1 + l + sovDesiredLrpRevision(uint64(l))
+	}
+	if m.SchedulingInfo != nil {
+		l = m.SchedulingInfo.Size()
+		n += 1 + l + sovDesiredLrpRevision(uint64(l))
+	}
+	if m.ModificationTag != nil {
+		l = m.ModificationTag.Size()
+		n += 1 + l + sovDesiredLrpRevision(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovDesiredLrpRevision(uint64(m.CreatedAt))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovDesiredLrpRevision(uint64(l))
+	}
+	return n
+}
+
+func (m *DesiredLRPRevisionChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovDesiredLrpRevision(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovDesiredLrpRevision(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovDesiredLrpRevision(uint64(l))
+	}
+	return n
+}
+
+func sovDesiredLrpRevision(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDesiredLrpRevision(x uint64) (n int) {
+	return sovDesiredLrpRevision(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *DesiredLRPRevision) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DesiredLRPRevision{`,
+		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
+		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
+		`RunInfo:` + strings.Replace(fmt.Sprintf("%v", this.RunInfo), "DesiredLRPRunInfo", "DesiredLRPRunInfo", 1) + `,`,
+		`SchedulingInfo:` + strings.Replace(fmt.Sprintf("%v", this.SchedulingInfo), "DesiredLRPSchedulingInfo", "DesiredLRPSchedulingInfo", 1) + `,`,
+		`ModificationTag:` + strings.Replace(fmt.Sprintf("%v", this.ModificationTag), "ModificationTag", "ModificationTag", 1) + `,`,
+		`CreatedAt:` + fmt.Sprintf("%v", this.CreatedAt) + `,`,
+		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DesiredLRPRevisionChange) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DesiredLRPRevisionChange{`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`From:` + fmt.Sprintf("%v", this.From) + `,`,
+		`To:` + fmt.Sprintf("%v", this.To) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringDesiredLrpRevision(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *DesiredLRPRevision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDesiredLrpRevision
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DesiredLRPRevision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DesiredLRPRevision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRevision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDesiredLrpRevision
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDesiredLrpRevision
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRevision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRevision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrpRevision
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDesiredLrpRevision
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RunInfo == nil {
+				m.RunInfo = &DesiredLRPRunInfo{}
+			}
+			if err := m.RunInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRevision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrpRevision
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDesiredLrpRevision
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SchedulingInfo == nil {
+				m.SchedulingInfo = &DesiredLRPSchedulingInfo{}
+			}
+			if err := m.SchedulingInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModificationTag", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRevision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrpRevision
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDesiredLrpRevision
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ModificationTag == nil {
+				m.ModificationTag = &ModificationTag{}
+			}
+			if err := m.ModificationTag.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRevision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRevision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDesiredLrpRevision
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDesiredLrpRevision
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDesiredLrpRevision(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDesiredLrpRevision
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DesiredLRPRevisionChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDesiredLrpRevision
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DesiredLRPRevisionChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DesiredLRPRevisionChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRevision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDesiredLrpRevision
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDesiredLrpRevision
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRevision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDesiredLrpRevision
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDesiredLrpRevision
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRevision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDesiredLrpRevision
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDesiredLrpRevision
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDesiredLrpRevision(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDesiredLrpRevision
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDesiredLrpRevision(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDesiredLrpRevision
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDesiredLrpRevision
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDesiredLrpRevision
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDesiredLrpRevision
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDesiredLrpRevision
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDesiredLrpRevision
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDesiredLrpRevision        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDesiredLrpRevision          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDesiredLrpRevision = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,1546 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: desired_lrp_revision_requests.proto
+
+package models
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type DesiredLRPRevisionsRequest struct {
+	ProcessGuid string `protobuf:"bytes,1,opt,name=process_guid,json=processGuid,proto3" json:"process_guid"`
+}
+
+func (m *DesiredLRPRevisionsRequest) Reset()      { *m = DesiredLRPRevisionsRequest{} }
+func (*DesiredLRPRevisionsRequest) ProtoMessage() {}
+func (*DesiredLRPRevisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a40ab36dececa50a, []int{0}
+}
+func (m *DesiredLRPRevisionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DesiredLRPRevisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DesiredLRPRevisionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DesiredLRPRevisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DesiredLRPRevisionsRequest.Merge(m, src)
+}
+func (m *DesiredLRPRevisionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DesiredLRPRevisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DesiredLRPRevisionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DesiredLRPRevisionsRequest proto.InternalMessageInfo
+
+func (m *DesiredLRPRevisionsRequest) GetProcessGuid() string {
+	if m != nil {
+		return m.ProcessGuid
+	}
+	return ""
+}
+
+type DesiredLRPRevisionsResponse struct {
+	Error     *Error                `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Revisions []*DesiredLRPRevision `protobuf:"bytes,2,rep,name=revisions,proto3" json:"revisions,omitempty"`
+}
+
+func (m *DesiredLRPRevisionsResponse) Reset()      { *m = DesiredLRPRevisionsResponse{} }
+func (*DesiredLRPRevisionsResponse) ProtoMessage() {}
+func (*DesiredLRPRevisionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a40ab36dececa50a, []int{1}
+}
+func (m *DesiredLRPRevisionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DesiredLRPRevisionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DesiredLRPRevisionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DesiredLRPRevisionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DesiredLRPRevisionsResponse.Merge(m, src)
+}
+func (m *DesiredLRPRevisionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DesiredLRPRevisionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DesiredLRPRevisionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DesiredLRPRevisionsResponse proto.InternalMessageInfo
+
+func (m *DesiredLRPRevisionsResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *DesiredLRPRevisionsResponse) GetRevisions() []*DesiredLRPRevision {
+	if m != nil {
+		return m.Revisions
+	}
+	return nil
+}
+
+type DesiredLRPRevisionDiffRequest struct {
+	ProcessGuid  string `protobuf:"bytes,1,opt,name=process_guid,json=processGuid,proto3" json:"process_guid"`
+	FromRevision int32  `protobuf:"varint,2,opt,name=from_revision,json=fromRevision,proto3" json:"from_revision"`
+	ToRevision   int32  `protobuf:"varint,3,opt,name=to_revision,json=toRevision,proto3" json:"to_revision"`
+}
+
+func (m *DesiredLRPRevisionDiffRequest) Reset()      { *m = DesiredLRPRevisionDiffRequest{} }
+func (*DesiredLRPRevisionDiffRequest) ProtoMessage() {}
+func (*DesiredLRPRevisionDiffRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a40ab36dececa50a, []int{2}
+}
+func (m *DesiredLRPRevisionDiffRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DesiredLRPRevisionDiffRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DesiredLRPRevisionDiffRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DesiredLRPRevisionDiffRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DesiredLRPRevisionDiffRequest.Merge(m, src)
+}
+func (m *DesiredLRPRevisionDiffRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DesiredLRPRevisionDiffRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DesiredLRPRevisionDiffRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DesiredLRPRevisionDiffRequest proto.InternalMessageInfo
+
+func (m *DesiredLRPRevisionDiffRequest) GetProcessGuid() string {
+	if m != nil {
+		return m.ProcessGuid
+	}
+	return ""
+}
+
+func (m *DesiredLRPRevisionDiffRequest) GetFromRevision() int32 {
+	if m != nil {
+		return m.FromRevision
+	}
+	return 0
+}
+
+func (m *DesiredLRPRevisionDiffRequest) GetToRevision() int32 {
+	if m != nil {
+		return m.ToRevision
+	}
+	return 0
+}
+
+type DesiredLRPRevisionDiffResponse struct {
+	Error   *Error                      `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Changes []*DesiredLRPRevisionChange `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (m *DesiredLRPRevisionDiffResponse) Reset()      { *m = DesiredLRPRevisionDiffResponse{} }
+func (*DesiredLRPRevisionDiffResponse) ProtoMessage() {}
+func (*DesiredLRPRevisionDiffResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a40ab36dececa50a, []int{3}
+}
+func (m *DesiredLRPRevisionDiffResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DesiredLRPRevisionDiffResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DesiredLRPRevisionDiffResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DesiredLRPRevisionDiffResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DesiredLRPRevisionDiffResponse.Merge(m, src)
+}
+func (m *DesiredLRPRevisionDiffResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DesiredLRPRevisionDiffResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DesiredLRPRevisionDiffResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DesiredLRPRevisionDiffResponse proto.InternalMessageInfo
+
+func (m *DesiredLRPRevisionDiffResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *DesiredLRPRevisionDiffResponse) GetChanges() []*DesiredLRPRevisionChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+type RollbackDesiredLRPRequest struct {
+	ProcessGuid string `protobuf:"bytes,1,opt,name=process_guid,json=processGuid,proto3" json:"process_guid"`
+	Revision    int32  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision"`
+}
+
+func (m *RollbackDesiredLRPRequest) Reset()      { *m = RollbackDesiredLRPRequest{} }
+func (*RollbackDesiredLRPRequest) ProtoMessage() {}
+func (*RollbackDesiredLRPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a40ab36dececa50a, []int{4}
+}
+func (m *RollbackDesiredLRPRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollbackDesiredLRPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RollbackDesiredLRPRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RollbackDesiredLRPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackDesiredLRPRequest.Merge(m, src)
+}
+func (m *RollbackDesiredLRPRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollbackDesiredLRPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackDesiredLRPRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackDesiredLRPRequest proto.InternalMessageInfo
+
+func (m *RollbackDesiredLRPRequest) GetProcessGuid() string {
+	if m != nil {
+		return m.ProcessGuid
+	}
+	return ""
+}
+
+func (m *RollbackDesiredLRPRequest) GetRevision() int32 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*DesiredLRPRevisionsRequest)(nil), "models.DesiredLRPRevisionsRequest")
+	proto.RegisterType((*DesiredLRPRevisionsResponse)(nil), "models.DesiredLRPRevisionsResponse")
+	proto.RegisterType((*DesiredLRPRevisionDiffRequest)(nil), "models.DesiredLRPRevisionDiffRequest")
+	proto.RegisterType((*DesiredLRPRevisionDiffResponse)(nil), "models.DesiredLRPRevisionDiffResponse")
+	proto.RegisterType((*RollbackDesiredLRPRequest)(nil), "models.RollbackDesiredLRPRequest")
+}
+
+func init() {
+	proto.RegisterFile("desired_lrp_revision_requests.proto", fileDescriptor_a40ab36dececa50a)
+}
+
+var fileDescriptor_a40ab36dececa50a = []byte{
+	// 402 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x52, 0xbf, 0x0e, 0xd2, 0x40,
+	0x18, 0xef, 0x41, 0x40, 0xb9, 0x42, 0xd4, 0x4e, 0x58, 0xe3, 0xb5, 0x29, 0x4b, 0x17, 0x8b, 0x01,
+	0x63, 0x8c, 0x23, 0x62, 0x5c, 0x1c, 0xf4, 0x5e, 0xa0, 0xa1, 0xed, 0xb5, 0x34, 0x16, 0xae, 0xde,
+	0xb5, 0x0e, 0xc6, 0x41, 0xdf, 0xc0, 0xc7, 0xf0, 0x29, 0x9c, 0x1d, 0x19, 0x99, 0x1a, 0x29, 0x8b,
+	0xe9, 0xc4, 0x23, 0x18, 0xae, 0x94, 0x3f, 0x02, 0x83, 0x61, 0xbb, 0xdf, 0xf7, 0xfb, 0xd3, 0xef,
+	0xfb, 0xa5, 0xb0, 0xe7, 0x11, 0x1e, 0x32, 0xe2, 0xd9, 0x11, 0x8b, 0x6d, 0x46, 0x3e, 0x85, 0x3c,
+	0xa4, 0x73, 0x9b, 0x91, 0x8f, 0x29, 0xe1, 0x09, 0xb7, 0x62, 0x46, 0x13, 0xaa, 0x34, 0x67, 0xd4,
+	0x23, 0x11, 0x57, 0x9f, 0x04, 0x61, 0x32, 0x4d, 0x1d, 0xcb, 0xa5, 0xb3, 0x7e, 0x40, 0x03, 0xda,
+	0x17, 0xb4, 0x93, 0xfa, 0x02, 0x09, 0x20, 0x5e, 0xa5, 0x4d, 0x55, 0x2f, 0x65, 0xef, 0x38, 0x99,
+	0x30, 0x46, 0x59, 0x09, 0x8c, 0xf7, 0x50, 0x1d, 0x97, 0xd2, 0xb7, 0xf8, 0x1d, 0xde, 0x09, 0x39,
+	0x2e, 0x97, 0x50, 0x86, 0xb0, 0x1d, 0x33, 0xea, 0x12, 0xce, 0xed, 0x20, 0x0d, 0xbd, 0x2e, 0xd0,
+	0x81, 0xd9, 0x1a, 0xdd, 0x2f, 0x32, 0xed, 0x64, 0x8e, 0xe5, 0x1d, 0x7a, 0x93, 0x86, 0x9e, 0xf1,
+	0x05, 0x3e, 0xba, 0x18, 0xc9, 0x63, 0x3a, 0xe7, 0x44, 0xe9, 0xc1, 0x86, 0x58, 0x40, 0x84, 0xc9,
+	0x83, 0x8e, 0x55, 0x5e, 0x68, 0xbd, 0xde, 0x0e, 0x71, 0xc9, 0x29, 0x2f, 0x60, 0xab, 0xda, 0x9a,
+	0x77, 0x6b, 0x7a, 0xdd, 0x94, 0x07, 0x6a, 0x25, 0x3c, 0x0f, 0xc7, 0x07, 0xb1, 0xf1, 0x13, 0xc0,
+	0xc7, 0xe7, 0x8a, 0x71, 0xe8, 0xfb, 0xb7, 0x1c, 0xa5, 0x3c, 0x87, 0x1d, 0x9f, 0xd1, 0xd9, 0xbe,
+	0xcb, 0x6e, 0x4d, 0x07, 0x66, 0x63, 0xf4, 0xa0, 0xc8, 0xb4, 0x53, 0x02, 0xb7, 0xb7, 0xb0, 0xfa,
+	0xae, 0xf2, 0x14, 0xca, 0x09, 0x3d, 0xb8, 0xea, 0xc2, 0x75, 0xaf, 0xc8, 0xb4, 0xe3, 0x31, 0x86,
+	0x09, 0xad, 0x1c, 0xc6, 0x37, 0x00, 0xd1, 0xb5, 0x03, 0xfe, 0xa7, 0xc2, 0x97, 0xf0, 0x8e, 0x3b,
+	0x9d, 0xcc, 0x03, 0x52, 0x15, 0xa8, 0x5f, 0x2f, 0xf0, 0x95, 0x10, 0xe2, 0xca, 0x60, 0x7c, 0x86,
+	0x0f, 0x31, 0x8d, 0x22, 0x67, 0xe2, 0x7e, 0x38, 0x16, 0xdf, 0xd0, 0x9f, 0x09, 0xef, 0xfe, 0x53,
+	0x5d, 0xbb, 0xc8, 0xb4, 0xfd, 0x0c, 0xef, 0x5f, 0xa3, 0x67, 0x8b, 0x15, 0x92, 0x96, 0x2b, 0x24,
+	0x6d, 0x56, 0x08, 0x7c, 0xcd, 0x11, 0xf8, 0x91, 0x23, 0xf0, 0x2b, 0x47, 0x60, 0x91, 0x23, 0xf0,
+	0x3b, 0x47, 0xe0, 0x4f, 0x8e, 0xa4, 0x4d, 0x8e, 0xc0, 0xf7, 0x35, 0x92, 0x16, 0x6b, 0x24, 0x2d,
+	0xd7, 0x48, 0x72, 0x9a, 0xe2, 0x77, 0x1e, 0xfe, 0x1d, 0x00, 0xcd, 0x2d, 0x96, 0x95, 0x55, 0x03,
+	0x00, 0x00,
+}
+
+func (this *DesiredLRPRevisionsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DesiredLRPRevisionsRequest)
+	if !ok {
+		that2, ok := that.(DesiredLRPRevisionsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProcessGuid != that1.ProcessGuid {
+		return false
+	}
+	return true
+}
+func (this *DesiredLRPRevisionsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DesiredLRPRevisionsResponse)
+	if !ok {
+		that2, ok := that.(DesiredLRPRevisionsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if len(this.Revisions) != len(that1.Revisions) {
+		return false
+	}
+	for i := range this.Revisions {
+		if !this.Revisions[i].Equal(that1.Revisions[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *DesiredLRPRevisionDiffRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DesiredLRPRevisionDiffRequest)
+	if !ok {
+		that2, ok := that.(DesiredLRPRevisionDiffRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProcessGuid != that1.ProcessGuid {
+		return false
+	}
+	if this.FromRevision != that1.FromRevision {
+		return false
+	}
+	if this.ToRevision != that1.ToRevision {
+		return false
+	}
+	return true
+}
+func (this *DesiredLRPRevisionDiffResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DesiredLRPRevisionDiffResponse)
+	if !ok {
+		that2, ok := that.(DesiredLRPRevisionDiffResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if len(this.Changes) != len(that1.Changes) {
+		return false
+	}
+	for i := range this.Changes {
+		if !this.Changes[i].Equal(that1.Changes[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *RollbackDesiredLRPRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RollbackDesiredLRPRequest)
+	if !ok {
+		that2, ok := that.(RollbackDesiredLRPRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProcessGuid != that1.ProcessGuid {
+		return false
+	}
+	if this.Revision != that1.Revision {
+		return false
+	}
+	return true
+}
+func (this *DesiredLRPRevisionsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.DesiredLRPRevisionsRequest{")
+	s = append(s, "ProcessGuid: "+fmt.Sprintf("%#v", this.ProcessGuid)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DesiredLRPRevisionsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.DesiredLRPRevisionsResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.Revisions != nil {
+		s = append(s, "Revisions: "+fmt.Sprintf("%#v", this.Revisions)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DesiredLRPRevisionDiffRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&models.DesiredLRPRevisionDiffRequest{")
+	s = append(s, "ProcessGuid: "+fmt.Sprintf("%#v", this.ProcessGuid)+",\n")
+	s = append(s, "FromRevision: "+fmt.Sprintf("%#v", this.FromRevision)+",\n")
+	s = append(s, "ToRevision: "+fmt.Sprintf("%#v", this.ToRevision)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DesiredLRPRevisionDiffResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.DesiredLRPRevisionDiffResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.Changes != nil {
+		s = append(s, "Changes: "+fmt.Sprintf("%#v", this.Changes)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RollbackDesiredLRPRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.RollbackDesiredLRPRequest{")
+	s = append(s, "ProcessGuid: "+fmt.Sprintf("%#v", this.ProcessGuid)+",\n")
+	s = append(s, "Revision: "+fmt.Sprintf("%#v", this.Revision)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringDesiredLrpRevisionRequests(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *DesiredLRPRevisionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DesiredLRPRevisionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DesiredLRPRevisionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProcessGuid) > 0 {
+		i -= len(m.ProcessGuid)
+		copy(dAtA[i:], m.ProcessGuid)
+		i = encodeVarintDesiredLrpRevisionRequests(dAtA, i, uint64(len(m.ProcessGuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DesiredLRPRevisionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DesiredLRPRevisionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DesiredLRPRevisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Revisions) > 0 {
+		for iNdEx := len(m.Revisions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Revisions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDesiredLrpRevisionRequests(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDesiredLrpRevisionRequests(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DesiredLRPRevisionDiffRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DesiredLRPRevisionDiffRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DesiredLRPRevisionDiffRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToRevision != 0 {
+		i = encodeVarintDesiredLrpRevisionRequests(dAtA, i, uint64(m.ToRevision))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FromRevision != 0 {
+		i = encodeVarintDesiredLrpRevisionRequests(dAtA, i, uint64(m.FromRevision))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ProcessGuid) > 0 {
+		i -= len(m.ProcessGuid)
+		copy(dAtA[i:], m.ProcessGuid)
+		i = encodeVarintDesiredLrpRevisionRequests(dAtA, i, uint64(len(m.ProcessGuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DesiredLRPRevisionDiffResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DesiredLRPRevisionDiffResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DesiredLRPRevisionDiffResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDesiredLrpRevisionRequests(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDesiredLrpRevisionRequests(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RollbackDesiredLRPRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollbackDesiredLRPRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollbackDesiredLRPRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revision != 0 {
+		i = encodeVarintDesiredLrpRevisionRequests(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ProcessGuid) > 0 {
+		i -= len(m.ProcessGuid)
+		copy(dAtA[i:], m.ProcessGuid)
+		i = encodeVarintDesiredLrpRevisionRequests(dAtA, i, uint64(len(m.ProcessGuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDesiredLrpRevisionRequests(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDesiredLrpRevisionRequests(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DesiredLRPRevisionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProcessGuid)
+	if l > 0 {
+		n += 1 + l + sovDesiredLrpRevisionRequests(uint64(l))
+	}
+	return n
+}
+
+func (m *DesiredLRPRevisionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovDesiredLrpRevisionRequests(uint64(l))
+	}
+	if len(m.Revisions) > 0 {
+		for _, e := range m.Revisions {
+			l = e.Size()
+			n += 1 + l + sovDesiredLrpRevisionRequests(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DesiredLRPRevisionDiffRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProcessGuid)
+	if l > 0 {
+		n += 1 + l + sovDesiredLrpRevisionRequests(uint64(l))
+	}
+	if m.FromRevision != 0 {
+		n += 1 + sovDesiredLrpRevisionRequests(uint64(m.FromRevision))
+	}
+	if m.ToRevision != 0 {
+		n += 1 + sovDesiredLrpRevisionRequests(uint64(m.ToRevision))
+	}
+	return n
+}
+
+func (m *DesiredLRPRevisionDiffResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovDesiredLrpRevisionRequests(uint64(l))
+	}
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovDesiredLrpRevisionRequests(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RollbackDesiredLRPRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProcessGuid)
+	if l > 0 {
+		n += 1 + l + sovDesiredLrpRevisionRequests(uint64(l))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovDesiredLrpRevisionRequests(uint64(m.Revision))
+	}
+	return n
+}
+
+func sovDesiredLrpRevisionRequests(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDesiredLrpRevisionRequests(x uint64) (n int) {
+	return sovDesiredLrpRevisionRequests(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *DesiredLRPRevisionsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DesiredLRPRevisionsRequest{`,
+		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DesiredLRPRevisionsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRevisions := "[]*DesiredLRPRevision{"
+	for _, f := range this.Revisions {
+		repeatedStringForRevisions += strings.Replace(fmt.Sprintf("%v", f), "DesiredLRPRevision", "DesiredLRPRevision", 1) + ","
+	}
+	repeatedStringForRevisions += "}"
+	s := strings.Join([]string{`&DesiredLRPRevisionsResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`Revisions:` + repeatedStringForRevisions + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DesiredLRPRevisionDiffRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DesiredLRPRevisionDiffRequest{`,
+		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
+		`FromRevision:` + fmt.Sprintf("%v", this.FromRevision) + `,`,
+		`ToRevision:` + fmt.Sprintf("%v", this.ToRevision) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DesiredLRPRevisionDiffResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForChanges := "[]*DesiredLRPRevisionChange{"
+	for _, f := range this.Changes {
+		repeatedStringForChanges += strings.Replace(fmt.Sprintf("%v", f), "DesiredLRPRevisionChange", "DesiredLRPRevisionChange", 1) + ","
+	}
+	repeatedStringForChanges += "}"
+	s := strings.Join([]string{`&DesiredLRPRevisionDiffResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`Changes:` + repeatedStringForChanges + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RollbackDesiredLRPRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RollbackDesiredLRPRequest{`,
+		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
+		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringDesiredLrpRevisionRequests(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *DesiredLRPRevisionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDesiredLrpRevisionRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DesiredLRPRevisionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DesiredLRPRevisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRevisionRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDesiredLrpRevisionRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDesiredLrpRevisionRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDesiredLrpRevisionRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDesiredLrpRevisionRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DesiredLRPRevisionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDesiredLrpRevisionRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DesiredLRPRevisionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DesiredLRPRevisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRevisionRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrpRevisionRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDesiredLrpRevisionRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRevisionRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrpRevisionRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDesiredLrpRevisionRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revisions = append(m.Revisions, &DesiredLRPRevision{})
+			if err := m.Revisions[len(m.Revisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDesiredLrpRevisionRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDesiredLrpRevisionRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DesiredLRPRevisionDiffRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDesiredLrpRevisionRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DesiredLRPRevisionDiffRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DesiredLRPRevisionDiffRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRevisionRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDesiredLrpRevisionRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDesiredLrpRevisionRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromRevision", wireType)
+			}
+			m.FromRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRevisionRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromRevision |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToRevision", wireType)
+			}
+			m.ToRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRevisionRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToRevision |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDesiredLrpRevisionRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDesiredLrpRevisionRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DesiredLRPRevisionDiffResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDesiredLrpRevisionRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DesiredLRPRevisionDiffResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DesiredLRPRevisionDiffResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRevisionRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrpRevisionRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDesiredLrpRevisionRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRevisionRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrpRevisionRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDesiredLrpRevisionRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, &DesiredLRPRevisionChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDesiredLrpRevisionRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDesiredLrpRevisionRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RollbackDesiredLRPRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDesiredLrpRevisionRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollbackDesiredLRPRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollbackDesiredLRPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRevisionRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDesiredLrpRevisionRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDesiredLrpRevisionRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRevisionRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDesiredLrpRevisionRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDesiredLrpRevisionRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDesiredLrpRevisionRequests(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDesiredLrpRevisionRequests
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDesiredLrpRevisionRequests
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDesiredLrpRevisionRequests
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDesiredLrpRevisionRequests
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDesiredLrpRevisionRequests
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDesiredLrpRevisionRequests
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDesiredLrpRevisionRequests        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDesiredLrpRevisionRequests          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDesiredLrpRevisionRequests = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,71 @@
+package models_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DesiredLRPRevision", func() {
+	var (
+		desiredLRP *models.DesiredLRP
+		createdAt  time.Time
+		revision   *models.DesiredLRPRevision
+	)
+
+	BeforeEach(func() {
+		desiredLRP = model_helpers.NewValidDesiredLRP("some-guid")
+		desiredLRP.Revision = 2
+		createdAt = time.Unix(0, 1234)
+		revision = models.NewDesiredLRPRevision(desiredLRP, createdAt, models.DesiredLRPRevisionSourceUpdate)
+	})
+
+	Describe("NewDesiredLRPRevision", func() {
+		It("records the run info and scheduling info of the DesiredLRP", func() {
+			Expect(revision.ProcessGuid).To(Equal("some-guid"))
+			Expect(revision.Revision).To(BeEquivalentTo(2))
+			Expect(*revision.RunInfo).To(Equal(desiredLRP.DesiredLRPRunInfo(createdAt)))
+			Expect(*revision.SchedulingInfo).To(Equal(desiredLRP.DesiredLRPSchedulingInfo()))
+			Expect(revision.ModificationTag).To(Equal(desiredLRP.ModificationTag))
+			Expect(revision.CreatedAt).To(BeEquivalentTo(1234))
+			Expect(revision.Source).To(Equal(models.DesiredLRPRevisionSourceUpdate))
+		})
+	})
+
+	Describe("RollbackUpdate", func() {
+		It("restores the run info and resources of the revision", func() {
+			update := revision.RollbackUpdate()
+			Expect(update.RunInfo).To(Equal(revision.RunInfo))
+			Expect(*update.Resource).To(Equal(desiredLRP.DesiredLRPResource()))
+			Expect(update.InstancesExists()).To(BeFalse())
+			Expect(update.Routes).To(BeNil())
+			Expect(update.IsNewRevision()).To(BeTrue())
+		})
+	})
+
+	Describe("DiffDesiredLRPRevisions", func() {
+		It("returns the fields that changed, sorted by name", func() {
+			desiredLRP.MemoryMb = 4096
+			desiredLRP.StartTimeoutMs = 5000
+			desiredLRP.Revision = 3
+			desiredLRP.ModificationTag = &models.ModificationTag{Epoch: "some-epoch", Index: 7}
+			to := models.NewDesiredLRPRevision(desiredLRP, createdAt, models.DesiredLRPRevisionSourceUpdate)
+
+			changes, err := models.DiffDesiredLRPRevisions(revision, to)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changes).To(Equal([]*models.DesiredLRPRevisionChange{
+				{Field: "memory_mb", From: "1024", To: "4096"},
+				{Field: "start_timeout_ms", From: "15000", To: "5000"},
+			}))
+		})
+
+		It("returns no changes for identical revisions", func() {
+			changes, err := models.DiffDesiredLRPRevisions(revision, revision)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changes).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,68 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// MaxDesiredLRPStatusReasons is the number of distinct crash reasons and
+// placement errors reported in a DesiredLRPStatus.
+const MaxDesiredLRPStatusReasons = 5
+
+// DeriveHealth returns the health of the LRP from its instance counts and
+// placement errors:
+//
+//   - Paused if the LRP is paused.
+//   - Unplaceable if an unclaimed instance failed to be placed.
+//   - Crashing if an instance is crashed.
+//   - Degraded if fewer instances are running than desired.
+//   - Healthy otherwise.
+func (s *DesiredLRPStatus) DeriveHealth() DesiredLRPStatus_Health {
+	switch {
+	case s.Paused:
+		return DesiredLRPStatus_Paused
+	case len(s.PlacementErrors) > 0:
+		return DesiredLRPStatus_Unplaceable
+	case s.CrashedInstances > 0:
+		return DesiredLRPStatus_Crashing
+	case s.RunningInstances < s.DesiredInstances:
+		return DesiredLRPStatus_Degraded
+	default:
+		return DesiredLRPStatus_Healthy
+	}
+}
+
+// LatestReasons returns the first MaxDesiredLRPStatusReasons distinct
+// non-empty reasons, which are expected to be ordered from the latest.
+func LatestReasons(reasons []string) []string {
+	latest := []string{}
+	seen := map[string]struct{}{}
+	for _, reason := range reasons {
+		if len(latest) == MaxDesiredLRPStatusReasons {
+			break
+		}
+		if _, ok := seen[reason]; ok || reason == "" {
+			continue
+		}
+		seen[reason] = struct{}{}
+		latest = append(latest, reason)
+	}
+	return latest
+}
+
+func (h *DesiredLRPStatus_Health) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return err
+	}
+
+	if v, found := DesiredLRPStatus_Health_value[name]; found {
+		*h = DesiredLRPStatus_Health(v)
+		return nil
+	}
+	return fmt.Errorf("invalid health: %s", name)
+}
+
+func (h DesiredLRPStatus_Health) MarshalJSON() ([]byte, error) {
+	return json.Marshal(h.String())
+}
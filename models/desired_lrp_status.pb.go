@@ -0,0 +1,1301 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: desired_lrp_status.proto
+
+package models
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strconv "strconv"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type DesiredLRPStatus_Health int32
+
+const (
+	DesiredLRPStatus_Healthy     DesiredLRPStatus_Health = 0
+	DesiredLRPStatus_Degraded    DesiredLRPStatus_Health = 1
+	DesiredLRPStatus_Crashing    DesiredLRPStatus_Health = 2
+	DesiredLRPStatus_Unplaceable DesiredLRPStatus_Health = 3
+	DesiredLRPStatus_Paused      DesiredLRPStatus_Health = 4
+)
+
+var DesiredLRPStatus_Health_name = map[int32]string{
+	0: "HEALTHY",
+	1: "DEGRADED",
+	2: "CRASHING",
+	3: "UNPLACEABLE",
+	4: "PAUSED",
+}
+
+var DesiredLRPStatus_Health_value = map[string]int32{
+	"HEALTHY":     0,
+	"DEGRADED":    1,
+	"CRASHING":    2,
+	"UNPLACEABLE": 3,
+	"PAUSED":      4,
+}
+
+func (DesiredLRPStatus_Health) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d476d6b9c2f589de, []int{0, 0}
+}
+
+type DesiredLRPStatus struct {
+	ProcessGuid         string                  `protobuf:"bytes,1,opt,name=process_guid,json=processGuid,proto3" json:"process_guid"`
+	Domain              string                  `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain"`
+	DesiredInstances    int32                   `protobuf:"varint,3,opt,name=desired_instances,json=desiredInstances,proto3" json:"desired_instances"`
+	UnclaimedInstances  int32                   `protobuf:"varint,4,opt,name=unclaimed_instances,json=unclaimedInstances,proto3" json:"unclaimed_instances"`
+	ClaimedInstances    int32                   `protobuf:"varint,5,opt,name=claimed_instances,json=claimedInstances,proto3" json:"claimed_instances"`
+	RunningInstances    int32                   `protobuf:"varint,6,opt,name=running_instances,json=runningInstances,proto3" json:"running_instances"`
+	CrashedInstances    int32                   `protobuf:"varint,7,opt,name=crashed_instances,json=crashedInstances,proto3" json:"crashed_instances"`
+	EvacuatingInstances int32                   `protobuf:"varint,8,opt,name=evacuating_instances,json=evacuatingInstances,proto3" json:"evacuating_instances"`
+	SuspectInstances    int32                   `protobuf:"varint,9,opt,name=suspect_instances,json=suspectInstances,proto3" json:"suspect_instances"`
+	CrashReasons        []string                `protobuf:"bytes,10,rep,name=crash_reasons,json=crashReasons,proto3" json:"crash_reasons,omitempty"`
+	PlacementErrors     []string                `protobuf:"bytes,11,rep,name=placement_errors,json=placementErrors,proto3" json:"placement_errors,omitempty"`
+	Health              DesiredLRPStatus_Health `protobuf:"varint,12,opt,name=health,proto3,enum=models.DesiredLRPStatus_Health" json:"health"`
+	Paused              bool                    `protobuf:"varint,13,opt,name=paused,proto3" json:"paused"`
+}
+
+func (m *DesiredLRPStatus) Reset()      { *m = DesiredLRPStatus{} }
+func (*DesiredLRPStatus) ProtoMessage() {}
+func (*DesiredLRPStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d476d6b9c2f589de, []int{0}
+}
+func (m *DesiredLRPStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DesiredLRPStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DesiredLRPStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DesiredLRPStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DesiredLRPStatus.Merge(m, src)
+}
+func (m *DesiredLRPStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *DesiredLRPStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_DesiredLRPStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DesiredLRPStatus proto.InternalMessageInfo
+
+func (m *DesiredLRPStatus) GetProcessGuid() string {
+	if m != nil {
+		return m.ProcessGuid
+	}
+	return ""
+}
+
+func (m *DesiredLRPStatus) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *DesiredLRPStatus) GetDesiredInstances() int32 {
+	if m != nil {
+		return m.DesiredInstances
+	}
+	return 0
+}
+
+func (m *DesiredLRPStatus) GetUnclaimedInstances() int32 {
+	if m != nil {
+		return m.UnclaimedInstances
+	}
+	return 0
+}
+
+func (m *DesiredLRPStatus) GetClaimedInstances() int32 {
+	if m != nil {
+		return m.ClaimedInstances
+	}
+	return 0
+}
+
+func (m *DesiredLRPStatus) GetRunningInstances() int32 {
+	if m != nil {
+		return m.RunningInstances
+	}
+	return 0
+}
+
+func (m *DesiredLRPStatus) GetCrashedInstances() int32 {
+	if m != nil {
+		return m.CrashedInstances
+	}
+	return 0
+}
+
+func (m *DesiredLRPStatus) GetEvacuatingInstances() int32 {
+	if m != nil {
+		return m.EvacuatingInstances
+	}
+	return 0
+}
+
+func (m *DesiredLRPStatus) GetSuspectInstances() int32 {
+	if m != nil {
+		return m.SuspectInstances
+	}
+	return 0
+}
+
+func (m *DesiredLRPStatus) GetCrashReasons() []string {
+	if m != nil {
+		return m.CrashReasons
+	}
+	return nil
+}
+
+func (m *DesiredLRPStatus) GetPlacementErrors() []string {
+	if m != nil {
+		return m.PlacementErrors
+	}
+	return nil
+}
+
+func (m *DesiredLRPStatus) GetHealth() DesiredLRPStatus_Health {
+	if m != nil {
+		return m.Health
+	}
+	return DesiredLRPStatus_Healthy
+}
+
+func (m *DesiredLRPStatus) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+type DesiredLRPStatusResponse struct {
+	Error    *Error              `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Statuses []*DesiredLRPStatus `protobuf:"bytes,2,rep,name=statuses,proto3" json:"statuses,omitempty"`
+}
+
+func (m *DesiredLRPStatusResponse) Reset()      { *m = DesiredLRPStatusResponse{} }
+func (*DesiredLRPStatusResponse) ProtoMessage() {}
+func (*DesiredLRPStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d476d6b9c2f589de, []int{1}
+}
+func (m *DesiredLRPStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DesiredLRPStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DesiredLRPStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DesiredLRPStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DesiredLRPStatusResponse.Merge(m, src)
+}
+func (m *DesiredLRPStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DesiredLRPStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DesiredLRPStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DesiredLRPStatusResponse proto.InternalMessageInfo
+
+func (m *DesiredLRPStatusResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *DesiredLRPStatusResponse) GetStatuses() []*DesiredLRPStatus {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("models.DesiredLRPStatus_Health", DesiredLRPStatus_Health_name, DesiredLRPStatus_Health_value)
+	proto.RegisterType((*DesiredLRPStatus)(nil), "models.DesiredLRPStatus")
+	proto.RegisterType((*DesiredLRPStatusResponse)(nil), "models.DesiredLRPStatusResponse")
+}
+
+func init() { proto.RegisterFile("desired_lrp_status.proto", fileDescriptor_d476d6b9c2f589de) }
+
+var fileDescriptor_d476d6b9c2f589de = []byte{
+	// 642 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0x3f, 0x4f, 0xdb, 0x4e,
+	0x18, 0xc7, 0x73, 0x04, 0x9c, 0x70, 0x0e, 0x3f, 0xcc, 0xf1, 0xfb, 0x73, 0xf2, 0x70, 0xb1, 0x60,
+	0xc9, 0x6f, 0x68, 0x90, 0x80, 0x37, 0x90, 0x3f, 0x16, 0x41, 0x8d, 0x50, 0x74, 0x94, 0xa1, 0x53,
+	0x74, 0xb1, 0xaf, 0x8e, 0xa5, 0xc4, 0xb6, 0x7c, 0x76, 0xa5, 0x6e, 0x9d, 0x33, 0x75, 0xe8, 0x9a,
+	0xb5, 0xea, 0x4b, 0xe9, 0xc8, 0xc8, 0x14, 0x15, 0xb3, 0x54, 0x99, 0x78, 0x09, 0x15, 0x77, 0x06,
+	0x13, 0x42, 0xa7, 0xcb, 0x7d, 0xbf, 0x8f, 0x3f, 0xf9, 0x3e, 0x8f, 0x1e, 0x1b, 0x62, 0x97, 0x0b,
+	0x3f, 0xe6, 0xee, 0x70, 0x12, 0x47, 0x43, 0x91, 0xb0, 0x24, 0x15, 0xcd, 0x28, 0x0e, 0x93, 0x10,
+	0x69, 0xd3, 0xd0, 0xe5, 0x13, 0x61, 0xbe, 0xf1, 0xfc, 0x64, 0x9c, 0x8e, 0x9a, 0x4e, 0x38, 0x3d,
+	0xf2, 0x42, 0x2f, 0x3c, 0x92, 0xf6, 0x28, 0xfd, 0x20, 0x6f, 0xf2, 0x22, 0x7f, 0xa9, 0xc7, 0x4c,
+	0x9d, 0xc7, 0x71, 0x18, 0xab, 0xcb, 0xc1, 0xb7, 0x0a, 0x34, 0xba, 0xea, 0x0f, 0xfa, 0x74, 0x70,
+	0x29, 0xf1, 0xe8, 0x04, 0xd6, 0xa2, 0x38, 0x74, 0xb8, 0x10, 0x43, 0x2f, 0xf5, 0x5d, 0x0c, 0x2c,
+	0xd0, 0xd8, 0x6e, 0x1b, 0xcb, 0x45, 0x7d, 0x45, 0xa7, 0x7a, 0x7e, 0x3b, 0x4b, 0x7d, 0x17, 0x1d,
+	0x40, 0xcd, 0x0d, 0xa7, 0xcc, 0x0f, 0xf0, 0x86, 0x2c, 0x87, 0xcb, 0x45, 0x3d, 0x57, 0x68, 0x7e,
+	0xa2, 0x36, 0xdc, 0x7b, 0xec, 0xc6, 0x0f, 0x44, 0xc2, 0x02, 0x87, 0x0b, 0x5c, 0xb6, 0x40, 0x63,
+	0xab, 0xfd, 0xcf, 0x72, 0x51, 0x5f, 0x37, 0xa9, 0x91, 0x4b, 0xe7, 0x8f, 0x0a, 0xea, 0xc1, 0xfd,
+	0x34, 0x70, 0x26, 0xcc, 0x9f, 0xae, 0x50, 0x36, 0x25, 0xe5, 0xbf, 0xe5, 0xa2, 0xfe, 0x9a, 0x4d,
+	0xd1, 0x93, 0x58, 0x90, 0xda, 0x70, 0x6f, 0x9d, 0xb3, 0x55, 0xa4, 0x59, 0xa7, 0x18, 0xaf, 0x31,
+	0xe2, 0x34, 0x08, 0xfc, 0xc0, 0x7b, 0xc6, 0xd0, 0x0a, 0xc6, 0x9a, 0x49, 0x8d, 0x5c, 0x5a, 0xcd,
+	0x11, 0x33, 0x31, 0x5e, 0xc9, 0x51, 0x79, 0x96, 0xe3, 0xa5, 0x49, 0x8d, 0x5c, 0x2a, 0x18, 0x6f,
+	0xe1, 0xdf, 0xfc, 0x23, 0x73, 0x52, 0x96, 0xac, 0x46, 0xa9, 0x4a, 0x0c, 0x5e, 0x2e, 0xea, 0xaf,
+	0xfa, 0x74, 0xbf, 0x50, 0x57, 0x02, 0x89, 0x54, 0x44, 0xdc, 0x49, 0x9e, 0x91, 0xb6, 0x8b, 0x40,
+	0x6b, 0x26, 0x35, 0x72, 0xa9, 0x60, 0x1c, 0xc2, 0x1d, 0x19, 0x72, 0x18, 0x73, 0x26, 0xc2, 0x40,
+	0x60, 0x68, 0x95, 0x1b, 0xdb, 0xb4, 0x26, 0x45, 0xaa, 0x34, 0xf4, 0x3f, 0x34, 0xa2, 0x09, 0x73,
+	0xf8, 0x94, 0x07, 0xc9, 0x50, 0xae, 0xa5, 0xc0, 0xba, 0xac, 0xdb, 0x7d, 0xd2, 0x6d, 0x29, 0xa3,
+	0x0e, 0xd4, 0xc6, 0x9c, 0x4d, 0x92, 0x31, 0xae, 0x59, 0xa0, 0xf1, 0xd7, 0x71, 0xbd, 0xa9, 0xb6,
+	0xbf, 0xf9, 0x72, 0x7b, 0x9b, 0x3d, 0x59, 0xa6, 0xf6, 0x4f, 0x3d, 0x42, 0xf3, 0xf3, 0x61, 0x47,
+	0x23, 0x96, 0x0a, 0xee, 0xe2, 0x1d, 0x0b, 0x34, 0xaa, 0xaa, 0x46, 0x29, 0x34, 0x3f, 0x0f, 0xbe,
+	0x02, 0xa8, 0x29, 0x04, 0xc2, 0xb0, 0xd2, 0xb3, 0x5b, 0xfd, 0x77, 0xbd, 0xf7, 0x46, 0xc9, 0xd4,
+	0x67, 0x73, 0xab, 0xa2, 0x8c, 0x4f, 0xc8, 0x84, 0xd5, 0xae, 0x7d, 0x46, 0x5b, 0x5d, 0xbb, 0x6b,
+	0x00, 0xb3, 0x36, 0x9b, 0x5b, 0xd5, 0x2e, 0xf7, 0x62, 0xe6, 0x72, 0xf7, 0xc1, 0xeb, 0xd0, 0xd6,
+	0x65, 0xef, 0xfc, 0xe2, 0xcc, 0xd8, 0x50, 0x5e, 0xe7, 0xa1, 0x69, 0x3f, 0xf0, 0x90, 0x05, 0xf5,
+	0xab, 0x8b, 0x41, 0xbf, 0xd5, 0xb1, 0x5b, 0xed, 0xbe, 0x6d, 0x94, 0xcd, 0xdd, 0xd9, 0xdc, 0xd2,
+	0xaf, 0x02, 0xd9, 0x2d, 0x1b, 0x4d, 0x38, 0xfa, 0x17, 0x6a, 0x83, 0xd6, 0xd5, 0xa5, 0xdd, 0x35,
+	0x36, 0x4d, 0x38, 0x9b, 0x5b, 0xda, 0x40, 0xc5, 0x4a, 0x21, 0x7e, 0xd9, 0x29, 0xe5, 0x22, 0x0a,
+	0x03, 0xc1, 0xd1, 0x21, 0xdc, 0x92, 0xc3, 0x93, 0x2f, 0xaa, 0x7e, 0xbc, 0xf3, 0x38, 0x1a, 0x39,
+	0x3a, 0xaa, 0x3c, 0x74, 0x0a, 0xab, 0xea, 0xeb, 0xc1, 0x05, 0xde, 0xb0, 0xca, 0x0d, 0xfd, 0x18,
+	0xff, 0x69, 0x84, 0xf4, 0xa9, 0xb2, 0x7d, 0x7a, 0x7d, 0x4b, 0xc0, 0xcd, 0x2d, 0x29, 0xdd, 0xdf,
+	0x12, 0xf0, 0x39, 0x23, 0xe0, 0x7b, 0x46, 0xc0, 0x8f, 0x8c, 0x80, 0xeb, 0x8c, 0x80, 0x9f, 0x19,
+	0x01, 0xbf, 0x32, 0x52, 0xba, 0xcf, 0x08, 0xf8, 0x72, 0x47, 0x4a, 0xd7, 0x77, 0xa4, 0x74, 0x73,
+	0x47, 0x4a, 0x23, 0x4d, 0x7e, 0x5c, 0x4e, 0x7e, 0x0f, 0x00, 0x6b, 0x6c, 0x31, 0xf4, 0xbc, 0x04,
+	0x00, 0x00,
+}
+
+func (x DesiredLRPStatus_Health) String() string {
+	s, ok := DesiredLRPStatus_Health_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (this *DesiredLRPStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DesiredLRPStatus)
+	if !ok {
+		that2, ok := that.(DesiredLRPStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProcessGuid != that1.ProcessGuid {
+		return false
+	}
+	if this.Domain != that1.Domain {
+		return false
+	}
+	if this.DesiredInstances != that1.DesiredInstances {
+		return false
+	}
+	if this.UnclaimedInstances != that1.UnclaimedInstances {
+		return false
+	}
+	if this.ClaimedInstances != that1.ClaimedInstances {
+		return false
+	}
+	if this.RunningInstances != that1.RunningInstances {
+		return false
+	}
+	if this.CrashedInstances != that1.CrashedInstances {
+		return false
+	}
+	if this.EvacuatingInstances != that1.EvacuatingInstances {
+		return false
+	}
+	if this.SuspectInstances != that1.SuspectInstances {
+		return false
+	}
+	if len(this.CrashReasons) != len(that1.CrashReasons) {
+		return false
+	}
+	for i := range this.CrashReasons {
+		if this.CrashReasons[i] != that1.CrashReasons[i] {
+			return false
+		}
+	}
+	if len(this.PlacementErrors) != len(that1.PlacementErrors) {
+		return false
+	}
+	for i := range this.PlacementErrors {
+		if this.PlacementErrors[i] != that1.PlacementErrors[i] {
+			return false
+		}
+	}
+	if this.Health != that1.Health {
+		return false
+	}
+	if this.Paused != that1.Paused {
+		return false
+	}
+	return true
+}
+func (this *DesiredLRPStatusResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DesiredLRPStatusResponse)
+	if !ok {
+		that2, ok := that.(DesiredLRPStatusResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if len(this.Statuses) != len(that1.Statuses) {
+		return false
+	}
+	for i := range this.Statuses {
+		if !this.Statuses[i].Equal(that1.Statuses[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *DesiredLRPStatus) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 17)
+	s = append(s, "&models.DesiredLRPStatus{")
+	s = append(s, "ProcessGuid: "+fmt.Sprintf("%#v", this.ProcessGuid)+",\n")
+	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
+	s = append(s, "DesiredInstances: "+fmt.Sprintf("%#v", this.DesiredInstances)+",\n")
+	s = append(s, "UnclaimedInstances: "+fmt.Sprintf("%#v", this.UnclaimedInstances)+",\n")
+	s = append(s, "ClaimedInstances: "+fmt.Sprintf("%#v", this.ClaimedInstances)+",\n")
+	s = append(s, "RunningInstances: "+fmt.Sprintf("%#v", this.RunningInstances)+",\n")
+	s = append(s, "CrashedInstances: "+fmt.Sprintf("%#v", this.CrashedInstances)+",\n")
+	s = append(s, "EvacuatingInstances: "+fmt.Sprintf("%#v", this.EvacuatingInstances)+",\n")
+	s = append(s, "SuspectInstances: "+fmt.Sprintf("%#v", this.SuspectInstances)+",\n")
+	s = append(s, "CrashReasons: "+fmt.Sprintf("%#v", this.CrashReasons)+",\n")
+	s = append(s, "PlacementErrors: "+fmt.Sprintf("%#v", this.PlacementErrors)+",\n")
+	s = append(s, "Health: "+fmt.Sprintf("%#v", this.Health)+",\n")
+	s = append(s, "Paused: "+fmt.Sprintf("%#v", this.Paused)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DesiredLRPStatusResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.DesiredLRPStatusResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.Statuses != nil {
+		s = append(s, "Statuses: "+fmt.Sprintf("%#v", this.Statuses)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringDesiredLrpStatus(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *DesiredLRPStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DesiredLRPStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DesiredLRPStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Health != 0 {
+		i = encodeVarintDesiredLrpStatus(dAtA, i, uint64(m.Health))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.PlacementErrors) > 0 {
+		for iNdEx := len(m.PlacementErrors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PlacementErrors[iNdEx])
+			copy(dAtA[i:], m.PlacementErrors[iNdEx])
+			i = encodeVarintDesiredLrpStatus(dAtA, i, uint64(len(m.PlacementErrors[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.CrashReasons) > 0 {
+		for iNdEx := len(m.CrashReasons) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CrashReasons[iNdEx])
+			copy(dAtA[i:], m.CrashReasons[iNdEx])
+			i = encodeVarintDesiredLrpStatus(dAtA, i, uint64(len(m.CrashReasons[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.SuspectInstances != 0 {
+		i = encodeVarintDesiredLrpStatus(dAtA, i, uint64(m.SuspectInstances))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.EvacuatingInstances != 0 {
+		i = encodeVarintDesiredLrpStatus(dAtA, i, uint64(m.EvacuatingInstances))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CrashedInstances != 0 {
+		i = encodeVarintDesiredLrpStatus(dAtA, i, uint64(m.CrashedInstances))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RunningInstances != 0 {
+		i = encodeVarintDesiredLrpStatus(dAtA, i, uint64(m.RunningInstances))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ClaimedInstances != 0 {
+		i = encodeVarintDesiredLrpStatus(dAtA, i, uint64(m.ClaimedInstances))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.UnclaimedInstances != 0 {
+		i = encodeVarintDesiredLrpStatus(dAtA, i, uint64(m.UnclaimedInstances))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DesiredInstances != 0 {
+		i = encodeVarintDesiredLrpStatus(dAtA, i, uint64(m.DesiredInstances))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintDesiredLrpStatus(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProcessGuid) > 0 {
+		i -= len(m.ProcessGuid)
+		copy(dAtA[i:], m.ProcessGuid)
+		i = encodeVarintDesiredLrpStatus(dAtA, i, uint64(len(m.ProcessGuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DesiredLRPStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DesiredLRPStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DesiredLRPStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Statuses) > 0 {
+		for iNdEx := len(m.Statuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Statuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDesiredLrpStatus(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDesiredLrpStatus(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDesiredLrpStatus(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDesiredLrpStatus(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DesiredLRPStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProcessGuid)
+	if l > 0 {
+		n += 1 + l + sovDesiredLrpStatus(uint64(l))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovDesiredLrpStatus(uint64(l))
+	}
+	if m.DesiredInstances != 0 {
+		n += 1 + sovDesiredLrpStatus(uint64(m.DesiredInstances))
+	}
+	if m.UnclaimedInstances != 0 {
+		n += 1 + sovDesiredLrpStatus(uint64(m.UnclaimedInstances))
+	}
+	if m.ClaimedInstances != 0 {
+		n += 1 + sovDesiredLrpStatus(uint64(m.ClaimedInstances))
+	}
+	if m.RunningInstances != 0 {
+		n += 1 + sovDesiredLrpStatus(uint64(m.RunningInstances))
+	}
+	if m.CrashedInstances != 0 {
+		n += 1 + sovDesiredLrpStatus(uint64(m.CrashedInstances))
+	}
+	if m.EvacuatingInstances != 0 {
+		n += 1 + sovDesiredLrpStatus(uint64(m.EvacuatingInstances))
+	}
+	if m.SuspectInstances != 0 {
+		n += 1 + sovDesiredLrpStatus(uint64(m.SuspectInstances))
+	}
+	if len(m.CrashReasons) > 0 {
+		for _, s := range m.CrashReasons {
+			l = len(s)
+			n += 1 + l + sovDesiredLrpStatus(uint64(l))
+		}
+	}
+	if len(m.PlacementErrors) > 0 {
+		for _, s := range m.PlacementErrors {
+			l = len(s)
+			n += 1 + l + sovDesiredLrpStatus(uint64(l))
+		}
+	}
+	if m.Health != 0 {
+		n += 1 + sovDesiredLrpStatus(uint64(m.Health))
+	}
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func (m *DesiredLRPStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovDesiredLrpStatus(uint64(l))
+	}
+	if len(m.Statuses) > 0 {
+		for _, e := range m.Statuses {
+			l = e.Size()
+			n += 1 + l + sovDesiredLrpStatus(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovDesiredLrpStatus(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDesiredLrpStatus(x uint64) (n int) {
+	return sovDesiredLrpStatus(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *DesiredLRPStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DesiredLRPStatus{`,
+		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
+		`Domain:` + fmt.Sprintf("%v", this.Domain) + `,`,
+		`DesiredInstances:` + fmt.Sprintf("%v", this.DesiredInstances) + `,`,
+		`UnclaimedInstances:` + fmt.Sprintf("%v", this.UnclaimedInstances) + `,`,
+		`ClaimedInstances:` + fmt.Sprintf("%v", this.ClaimedInstances) + `,`,
+		`RunningInstances:` + fmt.Sprintf("%v", this.RunningInstances) + `,`,
+		`CrashedInstances:` + fmt.Sprintf("%v", this.CrashedInstances) + `,`,
+		`EvacuatingInstances:` + fmt.Sprintf("%v", this.EvacuatingInstances) + `,`,
+		`SuspectInstances:` + fmt.Sprintf("%v", this.SuspectInstances) + `,`,
+		`CrashReasons:` + fmt.Sprintf("%v", this.CrashReasons) + `,`,
+		`PlacementErrors:` + fmt.Sprintf("%v", this.PlacementErrors) + `,`,
+		`Health:` + fmt.Sprintf("%v", this.Health) + `,`,
+		`Paused:` + fmt.Sprintf("%v", this.Paused) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DesiredLRPStatusResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForStatuses := "[]*DesiredLRPStatus{"
+	for _, f := range this.Statuses {
+		repeatedStringForStatuses += strings.Replace(f.String(), "DesiredLRPStatus", "DesiredLRPStatus", 1) + ","
+	}
+	repeatedStringForStatuses += "}"
+	s := strings.Join([]string{`&DesiredLRPStatusResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`Statuses:` + repeatedStringForStatuses + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringDesiredLrpStatus(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *DesiredLRPStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDesiredLrpStatus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DesiredLRPStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DesiredLRPStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDesiredLrpStatus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDesiredLrpStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDesiredLrpStatus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDesiredLrpStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredInstances", wireType)
+			}
+			m.DesiredInstances = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DesiredInstances |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnclaimedInstances", wireType)
+			}
+			m.UnclaimedInstances = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnclaimedInstances |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedInstances", wireType)
+			}
+			m.ClaimedInstances = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimedInstances |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunningInstances", wireType)
+			}
+			m.RunningInstances = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RunningInstances |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrashedInstances", wireType)
+			}
+			m.CrashedInstances = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CrashedInstances |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvacuatingInstances", wireType)
+			}
+			m.EvacuatingInstances = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvacuatingInstances |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspectInstances", wireType)
+			}
+			m.SuspectInstances = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SuspectInstances |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrashReasons", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDesiredLrpStatus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDesiredLrpStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CrashReasons = append(m.CrashReasons, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlacementErrors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDesiredLrpStatus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDesiredLrpStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlacementErrors = append(m.PlacementErrors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+			}
+			m.Health = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Health |= DesiredLRPStatus_Health(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDesiredLrpStatus(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDesiredLrpStatus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DesiredLRPStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDesiredLrpStatus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DesiredLRPStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DesiredLRPStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrpStatus
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDesiredLrpStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrpStatus
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDesiredLrpStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Statuses = append(m.Statuses, &DesiredLRPStatus{})
+			if err := m.Statuses[len(m.Statuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDesiredLrpStatus(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDesiredLrpStatus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDesiredLrpStatus(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDesiredLrpStatus
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDesiredLrpStatus
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDesiredLrpStatus
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDesiredLrpStatus
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDesiredLrpStatus
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDesiredLrpStatus
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDesiredLrpStatus        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDesiredLrpStatus          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDesiredLrpStatus = fmt.Errorf("proto: unexpected end of group")
+)
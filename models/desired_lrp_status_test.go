@@ -0,0 +1,75 @@
+package models_test
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/bbs/models"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DesiredLRPStatus", func() {
+	Describe("DeriveHealth", func() {
+		DescribeTable("derives the health from the instance counts and placement errors",
+			func(status models.DesiredLRPStatus, expected models.DesiredLRPStatus_Health) {
+				Expect(status.DeriveHealth()).To(Equal(expected))
+			},
+			Entry("all instances running",
+				models.DesiredLRPStatus{DesiredInstances: 2, RunningInstances: 2},
+				models.DesiredLRPStatus_Healthy,
+			),
+			Entry("no instances desired",
+				models.DesiredLRPStatus{},
+				models.DesiredLRPStatus_Healthy,
+			),
+			Entry("instances starting",
+				models.DesiredLRPStatus{DesiredInstances: 2, RunningInstances: 1, ClaimedInstances: 1},
+				models.DesiredLRPStatus_Degraded,
+			),
+			Entry("an instance crashed",
+				models.DesiredLRPStatus{DesiredInstances: 2, RunningInstances: 1, CrashedInstances: 1},
+				models.DesiredLRPStatus_Crashing,
+			),
+			Entry("an instance could not be placed",
+				models.DesiredLRPStatus{DesiredInstances: 2, CrashedInstances: 1, UnclaimedInstances: 1, PlacementErrors: []string{"insufficient resources"}},
+				models.DesiredLRPStatus_Unplaceable,
+			),
+			Entry("the LRP is paused",
+				models.DesiredLRPStatus{DesiredInstances: 2, CrashedInstances: 1, Paused: true},
+				models.DesiredLRPStatus_Paused,
+			),
+		)
+	})
+
+	Describe("LatestReasons", func() {
+		It("returns the distinct non-empty reasons in order", func() {
+			Expect(models.LatestReasons([]string{"b", "", "a", "b", "c"})).To(Equal([]string{"b", "a", "c"}))
+		})
+
+		It("returns at most MaxDesiredLRPStatusReasons reasons", func() {
+			reasons := models.LatestReasons([]string{"a", "b", "c", "d", "e", "f"})
+			Expect(reasons).To(HaveLen(models.MaxDesiredLRPStatusReasons))
+			Expect(reasons[0]).To(Equal("a"))
+		})
+
+		It("returns an empty list without reasons", func() {
+			Expect(models.LatestReasons(nil)).To(BeEmpty())
+		})
+	})
+
+	Describe("DesiredLRPStatus_Health", func() {
+		DescribeTable("marshals and unmarshals between the value and the expected JSON output",
+			func(v models.DesiredLRPStatus_Health, expectedJSON string) {
+				Expect(json.Marshal(v)).To(MatchJSON(expectedJSON))
+				var testV models.DesiredLRPStatus_Health
+				Expect(json.Unmarshal([]byte(expectedJSON), &testV)).To(Succeed())
+				Expect(testV).To(Equal(v))
+			},
+			Entry("HEALTHY", models.DesiredLRPStatus_Healthy, `"HEALTHY"`),
+			Entry("DEGRADED", models.DesiredLRPStatus_Degraded, `"DEGRADED"`),
+			Entry("CRASHING", models.DesiredLRPStatus_Crashing, `"CRASHING"`),
+			Entry("UNPLACEABLE", models.DesiredLRPStatus_Unplaceable, `"UNPLACEABLE"`),
+			Entry("PAUSED", models.DesiredLRPStatus_Paused, `"PAUSED"`),
+		)
+	})
+})
@@ -265,6 +265,15 @@ var _ = Describe("DesiredLRP", func() {
 					"port": 12345,
 					"connect_timeout_ms": 100
 				}
+			},
+			{
+				"exec_check": {
+					"path": "/bin/healthcheck",
+					"args": ["-timeout", "1s"],
+					"user": "vcap",
+					"timeout_ms": 1000,
+					"interval_ms": 5000
+				}
 			}
 		],
 		"readiness_checks": [
@@ -272,6 +281,14 @@ var _ = Describe("DesiredLRP", func() {
 				"tcp_check": {
 					"port": 12345
 				}
+			},
+			{
+				"grpc_check": {
+					"port": 12346,
+					"service": "readiness",
+					"request_timeout_ms": 500,
+					"interval_ms": 2000
+				}
 			}
 		],
 		"log_source": "healthcheck_log_source"
@@ -328,6 +345,19 @@ var _ = Describe("DesiredLRP", func() {
 	],
 	"log_rate_limit": {
 	  "bytes_per_second": 2048
+	},
+	"revision": 2,
+	"rollout_strategy": {
+	  "max_surge": 2,
+	  "max_unavailable": 1
+	},
+	"restart_policy": {
+	  "immediate_restarts": 1,
+	  "max_backoff_duration_ms": 60000,
+	  "max_restarts": 10
+	},
+	"zone_spread_constraint": {
+	  "max_skew": 1
 	}
   }`
 
@@ -379,6 +409,25 @@ var _ = Describe("DesiredLRP", func() {
 			Expect(schedulingInfo).To(Equal(expectedSchedulingInfo))
 		})
 
+		It("updates the resources and the rollout strategy as a new revision", func() {
+			resource := models.NewDesiredLRPResource(1024, 2048, 100, "docker:///new-image")
+			strategy := &models.RolloutStrategy{MaxSurge: 2, MaxUnavailable: 1}
+			update := &models.DesiredLRPUpdate{
+				Resource:        &resource,
+				RolloutStrategy: strategy,
+			}
+			schedulingInfo := desiredLRP.DesiredLRPSchedulingInfo()
+
+			expectedSchedulingInfo := schedulingInfo
+			expectedSchedulingInfo.DesiredLRPResource = resource
+			expectedSchedulingInfo.RolloutStrategy = strategy
+			expectedSchedulingInfo.Revision++
+			expectedSchedulingInfo.ModificationTag.Increment()
+
+			schedulingInfo.ApplyUpdate(update)
+			Expect(schedulingInfo).To(Equal(expectedSchedulingInfo))
+		})
+
 		It("allows empty routes to be set", func() {
 			update := &models.DesiredLRPUpdate{
 				Routes: &models.Routes{},
@@ -424,6 +473,39 @@ var _ = Describe("DesiredLRP", func() {
 			Expect(schedulingInfo).To(Equal(expectedSchedulingInfo))
 		})
 
+		It("allows the LRP to be paused and resumed", func() {
+			update := &models.DesiredLRPUpdate{}
+			update.SetPaused(true)
+
+			schedulingInfo := desiredLRP.DesiredLRPSchedulingInfo()
+			schedulingInfo.ApplyUpdate(update)
+			Expect(schedulingInfo.Paused).To(BeTrue())
+			Expect(schedulingInfo.Instances).To(Equal(desiredLRP.Instances))
+			Expect(schedulingInfo.ScheduledInstances()).To(BeZero())
+
+			update.SetPaused(false)
+			schedulingInfo.ApplyUpdate(update)
+			Expect(schedulingInfo.Paused).To(BeFalse())
+			Expect(schedulingInfo.ScheduledInstances()).To(Equal(desiredLRP.Instances))
+		})
+
+		It("records the resources of the sidecars of a new run info", func() {
+			schedulingInfo := desiredLRP.DesiredLRPSchedulingInfo()
+			Expect(schedulingInfo.SidecarMemoryMb).To(BeEquivalentTo(512))
+			Expect(schedulingInfo.SidecarDiskMb).To(BeEquivalentTo(512))
+
+			runInfo := desiredLRP.DesiredLRPRunInfo(time.Now())
+			runInfo.Sidecars = []*models.Sidecar{
+				{Action: model_helpers.NewValidAction(), MemoryMb: 64, DiskMb: 32},
+				{Action: model_helpers.NewValidAction(), MemoryMb: 16},
+			}
+			update := &models.DesiredLRPUpdate{RunInfo: &runInfo}
+
+			schedulingInfo.ApplyUpdate(update)
+			Expect(schedulingInfo.SidecarMemoryMb).To(BeEquivalentTo(80))
+			Expect(schedulingInfo.SidecarDiskMb).To(BeEquivalentTo(32))
+		})
+
 		It("updates routes", func() {
 			rawMessage := json.RawMessage([]byte(`{"port": 8080,"hosts":["new-route-1","new-route-2"]}`))
 			update := &models.DesiredLRPUpdate{
@@ -922,6 +1004,76 @@ var _ = Describe("DesiredLRP", func() {
 				})
 			})
 		})
+
+		Context("V4->V3", func() {
+			It("removes the exec and gRPC checks", func() {
+				convertedLRP := desiredLRP.VersionDownTo(format.V3)
+				Expect(convertedLRP.CheckDefinition).To(Equal(&models.CheckDefinition{
+					Checks:          []*models.Check{{TcpCheck: &models.TCPCheck{Port: 12345, ConnectTimeoutMs: 100}}},
+					ReadinessChecks: []*models.Check{{TcpCheck: &models.TCPCheck{Port: 12345}}},
+					LogSource:       "healthcheck_log_source",
+				}))
+			})
+
+			It("leaves the original LRP unchanged", func() {
+				desiredLRP.VersionDownTo(format.V3)
+				Expect(desiredLRP.CheckDefinition.Checks).To(HaveLen(2))
+				Expect(desiredLRP.CheckDefinition.ReadinessChecks).To(HaveLen(2))
+			})
+
+			It("keeps the existing monitor action", func() {
+				convertedLRP := desiredLRP.VersionDownTo(format.V3)
+				Expect(convertedLRP.Monitor).To(Equal(desiredLRP.Monitor))
+			})
+
+			Context("when there is no monitor action", func() {
+				BeforeEach(func() {
+					desiredLRP.Monitor = nil
+				})
+
+				It("converts the first exec check into the monitor action", func() {
+					convertedLRP := desiredLRP.VersionDownTo(format.V3)
+					Expect(models.UnwrapAction(convertedLRP.Monitor)).To(Equal(models.Timeout(
+						&models.RunAction{
+							Path:      "/bin/healthcheck",
+							Args:      []string{"-timeout", "1s"},
+							User:      "vcap",
+							LogSource: "healthcheck_log_source",
+						},
+						time.Second,
+					)))
+				})
+			})
+
+			Context("when only exec and gRPC checks are defined", func() {
+				BeforeEach(func() {
+					desiredLRP.CheckDefinition = &models.CheckDefinition{
+						Checks:          []*models.Check{{ExecCheck: &models.ExecCheck{Path: "/bin/healthcheck", User: "vcap"}}},
+						ReadinessChecks: []*models.Check{{GrpcCheck: &models.GRPCCheck{Port: 8080}}},
+					}
+				})
+
+				It("removes the check definition", func() {
+					convertedLRP := desiredLRP.VersionDownTo(format.V3)
+					Expect(convertedLRP.CheckDefinition).To(BeNil())
+				})
+			})
+
+			Context("when a sidecar has exec checks", func() {
+				BeforeEach(func() {
+					desiredLRP.Sidecars[0].CheckDefinition = &models.CheckDefinition{
+						Checks: []*models.Check{{ExecCheck: &models.ExecCheck{Path: "/bin/healthcheck", User: "vcap"}}},
+					}
+				})
+
+				It("removes them from the sidecar only in the converted LRP", func() {
+					convertedLRP := desiredLRP.VersionDownTo(format.V3)
+					Expect(convertedLRP.Sidecars[0].CheckDefinition).To(BeNil())
+					Expect(convertedLRP.Sidecars[0].Action).To(Equal(desiredLRP.Sidecars[0].Action))
+					Expect(desiredLRP.Sidecars[0].CheckDefinition).NotTo(BeNil())
+				})
+			})
+		})
 	})
 
 	Describe("PopulateMetricsGuid", func() {
@@ -1294,6 +1446,30 @@ var _ = Describe("DesiredLRP", func() {
 				assertDesiredLRPValidationFailsWithMessage(desiredLRP, "image_username")
 			})
 		})
+
+		Context("restart policy", func() {
+			It("is valid when the restart policy is valid", func() {
+				desiredLRP.RestartPolicy = &models.RestartPolicy{ImmediateRestarts: 0, MaxBackoffDurationMs: 60000, MaxRestarts: 5}
+				Expect(desiredLRP.Validate()).To(Succeed())
+			})
+
+			It("is invalid when the restart policy is invalid", func() {
+				desiredLRP.RestartPolicy = &models.RestartPolicy{ImmediateRestarts: 1, MaxBackoffDurationMs: 1000, MaxRestarts: 5}
+				assertDesiredLRPValidationFailsWithMessage(desiredLRP, "MaxBackoffDuration")
+			})
+		})
+
+		Context("zone spread constraint", func() {
+			It("is valid when the zone spread constraint is valid", func() {
+				desiredLRP.ZoneSpreadConstraint = &models.ZoneSpreadConstraint{MaxSkew: 1}
+				Expect(desiredLRP.Validate()).To(Succeed())
+			})
+
+			It("is invalid when the zone spread constraint is invalid", func() {
+				desiredLRP.ZoneSpreadConstraint = &models.ZoneSpreadConstraint{}
+				assertDesiredLRPValidationFailsWithMessage(desiredLRP, "max_skew")
+			})
+		})
 	})
 })
 
@@ -1301,6 +1477,7 @@ var _ = Describe("DesiredLRPUpdate", func() {
 	var desiredLRPUpdate models.DesiredLRPUpdate
 
 	BeforeEach(func() {
+		desiredLRPUpdate = models.DesiredLRPUpdate{}
 		desiredLRPUpdate.SetInstances(2)
 		desiredLRPUpdate.Routes = &models.Routes{
 			"foo": &json.RawMessage{'"', 'b', 'a', 'r', '"'},
@@ -1337,6 +1514,26 @@ var _ = Describe("DesiredLRPUpdate", func() {
 			assertDesiredLRPValidationFailsWithMessage(desiredLRPUpdate, "annotation")
 		})
 
+		It("validates the run info", func() {
+			runInfo := model_helpers.NewValidDesiredLRP("some-guid").DesiredLRPRunInfo(time.Now())
+			desiredLRPUpdate.RunInfo = &runInfo
+			Expect(desiredLRPUpdate.Validate()).To(Succeed())
+
+			runInfo.Action = nil
+			assertDesiredLRPValidationFailsWithMessage(desiredLRPUpdate, "run_info")
+		})
+
+		It("validates the resource", func() {
+			resource := models.NewDesiredLRPResource(256, 1024, 0, "not-a-url")
+			desiredLRPUpdate.Resource = &resource
+			assertDesiredLRPValidationFailsWithMessage(desiredLRPUpdate, "resource")
+		})
+
+		It("validates the rollout strategy", func() {
+			desiredLRPUpdate.RolloutStrategy = &models.RolloutStrategy{}
+			assertDesiredLRPValidationFailsWithMessage(desiredLRPUpdate, "rollout_strategy")
+		})
+
 		Context("metric tags", func() {
 			It("is invalid when both static and dynamic values are provided for the same key", func() {
 				desiredLRPUpdate.MetricTags = map[string]*models.MetricTagValue{
@@ -1383,6 +1580,18 @@ var _ = Describe("DesiredLRPUpdate", func() {
 			Expect(json.Unmarshal([]byte(expectedJSON), &testV)).To(Succeed())
 			Expect(testV).To(Equal(desiredLRPUpdate))
 		})
+
+		It("marshals the paused state when it is set", func() {
+			desiredLRPUpdate.SetPaused(false)
+			data, err := json.Marshal(desiredLRPUpdate)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(data)).To(ContainSubstring(`"paused":false`))
+
+			var testV models.DesiredLRPUpdate
+			Expect(json.Unmarshal(data, &testV)).To(Succeed())
+			Expect(testV.PausedExists()).To(BeTrue())
+			Expect(testV.GetPaused()).To(BeFalse())
+		})
 	})
 
 	Describe("IsMetricTagsUpdated", func() {
@@ -1542,6 +1751,21 @@ var _ = Describe("DesiredLRPSchedulingInfo", func() {
 		Entry("invalid resource", models.NewDesiredLRPSchedulingInfo(newValidLRPKey(), annotation, instances, models.DesiredLRPResource{}, routes, tag, nil, nil), "rootfs"),
 		Entry("invalid routes", models.NewDesiredLRPSchedulingInfo(newValidLRPKey(), annotation, instances, newValidResource(), largeRoutes, tag, nil, nil), "routes"),
 	)
+
+	Describe("WithSidecarResources", func() {
+		It("includes the resources of the sidecars", func() {
+			schedulingInfo := models.NewDesiredLRPSchedulingInfo(newValidLRPKey(), annotation, instances, models.NewDesiredLRPResource(256, 1024, 100, "some-rootfs"), routes, tag, nil, nil)
+			schedulingInfo.SidecarMemoryMb = 64
+			schedulingInfo.SidecarDiskMb = 128
+
+			total := schedulingInfo.WithSidecarResources()
+			Expect(total.MemoryMb).To(BeEquivalentTo(320))
+			Expect(total.DiskMb).To(BeEquivalentTo(1152))
+			Expect(total.MaxPids).To(BeEquivalentTo(100))
+			Expect(schedulingInfo.MemoryMb).To(BeEquivalentTo(256))
+			Expect(schedulingInfo.DiskMb).To(BeEquivalentTo(1024))
+		})
+	})
 })
 
 var _ = Describe("DesiredLRPRoutingInfo", func() {
@@ -1610,6 +1834,11 @@ var _ = Describe("DesiredLRPRunInfo", func() {
 		Entry("invalid http check definition", models.NewDesiredLRPRunInfo(newValidLRPKey(), createdAt, envVars, nil, action, action, action, startTimeoutMs, privileged, cpuWeight, ports, egressRules, logSource, metricsGuid, "legacy-jim", trustedSystemCertificatesPath, []*models.VolumeMount{}, nil, nil, "", "", &models.CheckDefinition{[]*models.Check{&models.Check{HttpCheck: &models.HTTPCheck{Port: 65536}}}, "healthcheck_log_source", []*models.Check{&models.Check{HttpCheck: &models.HTTPCheck{Port: 77777}}}}, nil, []*models.Sidecar{}, logRateLimit), "port"),
 		Entry("invalid tcp check definition", models.NewDesiredLRPRunInfo(newValidLRPKey(), createdAt, envVars, nil, action, action, action, startTimeoutMs, privileged, cpuWeight, ports, egressRules, logSource, metricsGuid, "legacy-jim", trustedSystemCertificatesPath, []*models.VolumeMount{}, nil, nil, "", "", &models.CheckDefinition{[]*models.Check{&models.Check{TcpCheck: &models.TCPCheck{}}}, "healthcheck_log_source", []*models.Check{&models.Check{TcpCheck: &models.TCPCheck{}}}}, nil, []*models.Sidecar{}, logRateLimit), "port"),
 		Entry("invalid check in check definition", models.NewDesiredLRPRunInfo(newValidLRPKey(), createdAt, envVars, nil, action, action, action, startTimeoutMs, privileged, cpuWeight, ports, egressRules, logSource, metricsGuid, "legacy-jim", trustedSystemCertificatesPath, []*models.VolumeMount{}, nil, nil, "", "", &models.CheckDefinition{[]*models.Check{&models.Check{HttpCheck: &models.HTTPCheck{}, TcpCheck: &models.TCPCheck{}}}, "healthcheck_log_source", []*models.Check{&models.Check{HttpCheck: &models.HTTPCheck{}, TcpCheck: &models.TCPCheck{}}}}, nil, []*models.Sidecar{}, logRateLimit), "check"),
+		Entry("valid exec and grpc check definition", models.NewDesiredLRPRunInfo(newValidLRPKey(), createdAt, envVars, nil, action, action, action, startTimeoutMs, privileged, cpuWeight, ports, egressRules, logSource, metricsGuid, "legacy-jim", trustedSystemCertificatesPath, []*models.VolumeMount{}, nil, nil, "", "", &models.CheckDefinition{[]*models.Check{&models.Check{ExecCheck: &models.ExecCheck{Path: "/bin/healthcheck", User: "vcap"}}}, "healthcheck_log_source", []*models.Check{&models.Check{GrpcCheck: &models.GRPCCheck{Port: 8080}}}}, nil, []*models.Sidecar{}, logRateLimit), ""),
+		Entry("invalid exec check definition without path", models.NewDesiredLRPRunInfo(newValidLRPKey(), createdAt, envVars, nil, action, action, action, startTimeoutMs, privileged, cpuWeight, ports, egressRules, logSource, metricsGuid, "legacy-jim", trustedSystemCertificatesPath, []*models.VolumeMount{}, nil, nil, "", "", &models.CheckDefinition{[]*models.Check{&models.Check{ExecCheck: &models.ExecCheck{User: "vcap"}}}, "healthcheck_log_source", []*models.Check{&models.Check{ExecCheck: &models.ExecCheck{User: "vcap"}}}}, nil, []*models.Sidecar{}, logRateLimit), "path"),
+		Entry("invalid exec check definition without user", models.NewDesiredLRPRunInfo(newValidLRPKey(), createdAt, envVars, nil, action, action, action, startTimeoutMs, privileged, cpuWeight, ports, egressRules, logSource, metricsGuid, "legacy-jim", trustedSystemCertificatesPath, []*models.VolumeMount{}, nil, nil, "", "", &models.CheckDefinition{[]*models.Check{&models.Check{ExecCheck: &models.ExecCheck{Path: "/bin/healthcheck"}}}, "healthcheck_log_source", []*models.Check{&models.Check{ExecCheck: &models.ExecCheck{Path: "/bin/healthcheck"}}}}, nil, []*models.Sidecar{}, logRateLimit), "user"),
+		Entry("invalid grpc check definition", models.NewDesiredLRPRunInfo(newValidLRPKey(), createdAt, envVars, nil, action, action, action, startTimeoutMs, privileged, cpuWeight, ports, egressRules, logSource, metricsGuid, "legacy-jim", trustedSystemCertificatesPath, []*models.VolumeMount{}, nil, nil, "", "", &models.CheckDefinition{[]*models.Check{&models.Check{GrpcCheck: &models.GRPCCheck{}}}, "healthcheck_log_source", []*models.Check{&models.Check{GrpcCheck: &models.GRPCCheck{Port: 65536}}}}, nil, []*models.Sidecar{}, logRateLimit), "port"),
+		Entry("invalid check with exec and grpc checks in check definition", models.NewDesiredLRPRunInfo(newValidLRPKey(), createdAt, envVars, nil, action, action, action, startTimeoutMs, privileged, cpuWeight, ports, egressRules, logSource, metricsGuid, "legacy-jim", trustedSystemCertificatesPath, []*models.VolumeMount{}, nil, nil, "", "", &models.CheckDefinition{[]*models.Check{&models.Check{ExecCheck: &models.ExecCheck{Path: "/bin/healthcheck", User: "vcap"}, GrpcCheck: &models.GRPCCheck{Port: 8080}}}, "healthcheck_log_source", []*models.Check{&models.Check{GrpcCheck: &models.GRPCCheck{Port: 8080}}}}, nil, []*models.Sidecar{}, logRateLimit), "check"),
 		Entry("invalid legacy download user", models.NewDesiredLRPRunInfo(newValidLRPKey(), createdAt, envVars, nil, action, action, action, startTimeoutMs, privileged, cpuWeight, ports, egressRules, logSource, metricsGuid, "", trustedSystemCertificatesPath, []*models.VolumeMount{}, nil, nil, "", "", httpCheckDef, []*models.ImageLayer{{Url: "url", DestinationPath: "path", MediaType: models.MediaTypeTgz, LayerType: models.LayerTypeExclusive}}, []*models.Sidecar{}, logRateLimit), "legacy_download_user"),
 		Entry("invalid cached dependency", models.NewDesiredLRPRunInfo(newValidLRPKey(), createdAt, envVars, []*models.CachedDependency{{To: "here"}}, action, action, action, startTimeoutMs, privileged, cpuWeight, ports, egressRules, logSource, metricsGuid, "user", trustedSystemCertificatesPath, []*models.VolumeMount{}, nil, nil, "", "", httpCheckDef, nil, []*models.Sidecar{}, logRateLimit), "cached_dependency"),
 		Entry("invalid volume mount", models.NewDesiredLRPRunInfo(newValidLRPKey(), createdAt, envVars, nil, action, action, action, startTimeoutMs, privileged, cpuWeight, ports, egressRules, logSource, metricsGuid, "user", trustedSystemCertificatesPath, []*models.VolumeMount{{Mode: "lol"}}, nil, nil, "", "", httpCheckDef, nil, []*models.Sidecar{}, logRateLimit), "volume_mount"),
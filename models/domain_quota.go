@@ -0,0 +1,88 @@
+package models
+
+const (
+	QuotaLimitInstances = "max_instances"
+	QuotaLimitMemoryMB  = "max_memory_mb"
+	QuotaLimitDiskMB    = "max_disk_mb"
+	QuotaLimitTasks     = "max_tasks"
+)
+
+func (quota *DomainQuota) Validate() error {
+	var validationError ValidationError
+
+	if quota.GetDomain() == "" {
+		validationError = validationError.Append(ErrInvalidField{"domain"})
+	}
+
+	if quota.GetMaxInstances() < 0 {
+		validationError = validationError.Append(ErrInvalidField{"max_instances"})
+	}
+
+	if quota.GetMaxMemoryMb() < 0 {
+		validationError = validationError.Append(ErrInvalidField{"max_memory_mb"})
+	}
+
+	if quota.GetMaxDiskMb() < 0 {
+		validationError = validationError.Append(ErrInvalidField{"max_disk_mb"})
+	}
+
+	if quota.GetMaxTasks() < 0 {
+		validationError = validationError.Append(ErrInvalidField{"max_tasks"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
+// ExceededLimit returns the name of the first limit of the quota that
+// allocating the requested resources on top of the current usage would
+// exceed, or an empty string if they fit. A limit of 0 is unlimited. Only the
+// requested resources are checked, so a domain that is already over a lowered
+// limit can still give back resources and use the ones it is not over on.
+func (quota *DomainQuota) ExceededLimit(usage, requested *DomainUsage) string {
+	if quota == nil {
+		return ""
+	}
+
+	switch {
+	case exceeds(int64(quota.MaxInstances), int64(usage.Instances), int64(requested.Instances)):
+		return QuotaLimitInstances
+	case exceeds(quota.MaxMemoryMb, usage.MemoryMb, requested.MemoryMb):
+		return QuotaLimitMemoryMB
+	case exceeds(quota.MaxDiskMb, usage.DiskMb, requested.DiskMb):
+		return QuotaLimitDiskMB
+	case exceeds(int64(quota.MaxTasks), int64(usage.Tasks), int64(requested.Tasks)):
+		return QuotaLimitTasks
+	}
+
+	return ""
+}
+
+func exceeds(limit, used, requested int64) bool {
+	return limit > 0 && requested > 0 && used+requested > limit
+}
+
+// Sub returns the resources used on top of the other usage, which are
+// negative for the resources that are given back.
+func (u *DomainUsage) Sub(other *DomainUsage) *DomainUsage {
+	return &DomainUsage{
+		Domain:    u.Domain,
+		Instances: u.Instances - other.Instances,
+		MemoryMb:  u.MemoryMb - other.MemoryMb,
+		DiskMb:    u.DiskMb - other.DiskMb,
+		Tasks:     u.Tasks - other.Tasks,
+	}
+}
+
+// DesiredLRPUsage returns the resources that the given number of instances of
+// an LRP use against the quota of its domain.
+func DesiredLRPUsage(instances, memoryMb, diskMb int32) *DomainUsage {
+	return &DomainUsage{
+		Instances: instances,
+		MemoryMb:  int64(instances) * int64(memoryMb),
+		DiskMb:    int64(instances) * int64(diskMb),
+	}
+}
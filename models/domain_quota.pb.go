@@ -0,0 +1,921 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: domain_quota.proto
+
+package models
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type DomainQuota struct {
+	Domain       string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain"`
+	MaxInstances int32  `protobuf:"varint,2,opt,name=max_instances,json=maxInstances,proto3" json:"max_instances,omitempty"`
+	MaxMemoryMb  int64  `protobuf:"varint,3,opt,name=max_memory_mb,json=maxMemoryMb,proto3" json:"max_memory_mb,omitempty"`
+	MaxDiskMb    int64  `protobuf:"varint,4,opt,name=max_disk_mb,json=maxDiskMb,proto3" json:"max_disk_mb,omitempty"`
+	MaxTasks     int32  `protobuf:"varint,5,opt,name=max_tasks,json=maxTasks,proto3" json:"max_tasks,omitempty"`
+}
+
+func (m *DomainQuota) Reset()      { *m = DomainQuota{} }
+func (*DomainQuota) ProtoMessage() {}
+func (*DomainQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed87ae78f2652eeb, []int{0}
+}
+func (m *DomainQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DomainQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DomainQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DomainQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DomainQuota.Merge(m, src)
+}
+func (m *DomainQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *DomainQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_DomainQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DomainQuota proto.InternalMessageInfo
+
+func (m *DomainQuota) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *DomainQuota) GetMaxInstances() int32 {
+	if m != nil {
+		return m.MaxInstances
+	}
+	return 0
+}
+
+func (m *DomainQuota) GetMaxMemoryMb() int64 {
+	if m != nil {
+		return m.MaxMemoryMb
+	}
+	return 0
+}
+
+func (m *DomainQuota) GetMaxDiskMb() int64 {
+	if m != nil {
+		return m.MaxDiskMb
+	}
+	return 0
+}
+
+func (m *DomainQuota) GetMaxTasks() int32 {
+	if m != nil {
+		return m.MaxTasks
+	}
+	return 0
+}
+
+type DomainUsage struct {
+	Domain    string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain"`
+	Instances int32  `protobuf:"varint,2,opt,name=instances,proto3" json:"instances"`
+	MemoryMb  int64  `protobuf:"varint,3,opt,name=memory_mb,json=memoryMb,proto3" json:"memory_mb"`
+	DiskMb    int64  `protobuf:"varint,4,opt,name=disk_mb,json=diskMb,proto3" json:"disk_mb"`
+	Tasks     int32  `protobuf:"varint,5,opt,name=tasks,proto3" json:"tasks"`
+}
+
+func (m *DomainUsage) Reset()      { *m = DomainUsage{} }
+func (*DomainUsage) ProtoMessage() {}
+func (*DomainUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed87ae78f2652eeb, []int{1}
+}
+func (m *DomainUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DomainUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DomainUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DomainUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DomainUsage.Merge(m, src)
+}
+func (m *DomainUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *DomainUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_DomainUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DomainUsage proto.InternalMessageInfo
+
+func (m *DomainUsage) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *DomainUsage) GetInstances() int32 {
+	if m != nil {
+		return m.Instances
+	}
+	return 0
+}
+
+func (m *DomainUsage) GetMemoryMb() int64 {
+	if m != nil {
+		return m.MemoryMb
+	}
+	return 0
+}
+
+func (m *DomainUsage) GetDiskMb() int64 {
+	if m != nil {
+		return m.DiskMb
+	}
+	return 0
+}
+
+func (m *DomainUsage) GetTasks() int32 {
+	if m != nil {
+		return m.Tasks
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*DomainQuota)(nil), "models.DomainQuota")
+	proto.RegisterType((*DomainUsage)(nil), "models.DomainUsage")
+}
+
+func init() { proto.RegisterFile("domain_quota.proto", fileDescriptor_ed87ae78f2652eeb) }
+
+var fileDescriptor_ed87ae78f2652eeb = []byte{
+	// 352 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x3f, 0x4e, 0xe3, 0x40,
+	0x14, 0xc6, 0x3d, 0x9b, 0x8d, 0x37, 0x9e, 0x6c, 0x9a, 0xa9, 0xac, 0x5d, 0xe9, 0x39, 0x32, 0x14,
+	0x11, 0x88, 0xa4, 0x80, 0x13, 0x58, 0x69, 0x28, 0x52, 0x60, 0x41, 0x6d, 0x8d, 0x63, 0x63, 0xac,
+	0x30, 0x19, 0xc8, 0x38, 0x92, 0xe9, 0x38, 0x02, 0xc7, 0xe0, 0x06, 0x5c, 0x81, 0x32, 0x74, 0xa9,
+	0x2c, 0x32, 0x69, 0x90, 0xab, 0x1c, 0x01, 0x79, 0x26, 0x7f, 0x20, 0x15, 0xd5, 0x7b, 0xdf, 0xcf,
+	0x9f, 0x9f, 0xbf, 0x4f, 0xc6, 0x24, 0xe2, 0x8c, 0xa6, 0xe3, 0xe0, 0x7e, 0xca, 0x33, 0xda, 0xbd,
+	0x9b, 0xf0, 0x8c, 0x13, 0x93, 0xf1, 0x28, 0xbe, 0x15, 0xff, 0x4e, 0x92, 0x34, 0xbb, 0x99, 0x86,
+	0xdd, 0x21, 0x67, 0xbd, 0x84, 0x27, 0xbc, 0xa7, 0x1e, 0x87, 0xd3, 0x6b, 0xa5, 0x94, 0x50, 0x9b,
+	0x7e, 0xcd, 0x7d, 0x41, 0xb8, 0xd9, 0x57, 0xd7, 0x2e, 0xaa, 0x63, 0xc4, 0xc5, 0xa6, 0x3e, 0x6e,
+	0xa3, 0x36, 0xea, 0x58, 0x1e, 0x2e, 0x0b, 0x67, 0x4d, 0xfc, 0xf5, 0x24, 0x07, 0xb8, 0xc5, 0x68,
+	0x1e, 0xa4, 0x63, 0x91, 0xd1, 0xf1, 0x30, 0x16, 0xf6, 0xaf, 0x36, 0xea, 0xd4, 0xfd, 0xbf, 0x8c,
+	0xe6, 0xe7, 0x1b, 0x46, 0x5c, 0x6d, 0x62, 0x31, 0xe3, 0x93, 0x87, 0x80, 0x85, 0x76, 0xad, 0x8d,
+	0x3a, 0x35, 0xbf, 0xc9, 0x68, 0x3e, 0x50, 0x6c, 0x10, 0x12, 0xc0, 0x95, 0x0c, 0xa2, 0x54, 0x8c,
+	0x2a, 0xc7, 0x6f, 0xe5, 0xb0, 0x18, 0xcd, 0xfb, 0xa9, 0x18, 0x0d, 0x42, 0xf2, 0x1f, 0x57, 0x22,
+	0xc8, 0xa8, 0x18, 0x09, 0xbb, 0xae, 0x3e, 0xd2, 0x60, 0x34, 0xbf, 0xac, 0xb4, 0xfb, 0xb6, 0x4d,
+	0x7e, 0x25, 0x68, 0x12, 0xff, 0x28, 0xf9, 0x31, 0xb6, 0xf6, 0x52, 0x7b, 0xad, 0xb2, 0x70, 0x76,
+	0xd0, 0xdf, 0xad, 0xe4, 0x08, 0x5b, 0x7b, 0xe9, 0xb5, 0x79, 0x0b, 0xfd, 0x06, 0xdb, 0x34, 0x39,
+	0xc4, 0x7f, 0xbe, 0xb5, 0xf0, 0x9a, 0x65, 0xe1, 0x6c, 0x90, 0x6f, 0x46, 0xba, 0x8f, 0x83, 0xeb,
+	0x5f, 0xba, 0x78, 0x56, 0x59, 0x38, 0x1a, 0xf8, 0x7a, 0x78, 0x67, 0xb3, 0x05, 0x18, 0xf3, 0x05,
+	0x18, 0xab, 0x05, 0xa0, 0x47, 0x09, 0xe8, 0x59, 0x02, 0x7a, 0x95, 0x80, 0x66, 0x12, 0xd0, 0xbb,
+	0x04, 0xf4, 0x21, 0xc1, 0x58, 0x49, 0x40, 0x4f, 0x4b, 0x30, 0x66, 0x4b, 0x30, 0xe6, 0x4b, 0x30,
+	0x42, 0x53, 0xfd, 0xca, 0xd3, 0xcf, 0x01, 0x00, 0x68, 0xac, 0x62, 0x51, 0x17, 0x02, 0x00, 0x00,
+}
+
+func (this *DomainQuota) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DomainQuota)
+	if !ok {
+		that2, ok := that.(DomainQuota)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Domain != that1.Domain {
+		return false
+	}
+	if this.MaxInstances != that1.MaxInstances {
+		return false
+	}
+	if this.MaxMemoryMb != that1.MaxMemoryMb {
+		return false
+	}
+	if this.MaxDiskMb != that1.MaxDiskMb {
+		return false
+	}
+	if this.MaxTasks != that1.MaxTasks {
+		return false
+	}
+	return true
+}
+func (this *DomainUsage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DomainUsage)
+	if !ok {
+		that2, ok := that.(DomainUsage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Domain != that1.Domain {
+		return false
+	}
+	if this.Instances != that1.Instances {
+		return false
+	}
+	if this.MemoryMb != that1.MemoryMb {
+		return false
+	}
+	if this.DiskMb != that1.DiskMb {
+		return false
+	}
+	if this.Tasks != that1.Tasks {
+		return false
+	}
+	return true
+}
+func (this *DomainQuota) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&models.DomainQuota{")
+	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
+	s = append(s, "MaxInstances: "+fmt.Sprintf("%#v", this.MaxInstances)+",\n")
+	s = append(s, "MaxMemoryMb: "+fmt.Sprintf("%#v", this.MaxMemoryMb)+",\n")
+	s = append(s, "MaxDiskMb: "+fmt.Sprintf("%#v", this.MaxDiskMb)+",\n")
+	s = append(s, "MaxTasks: "+fmt.Sprintf("%#v", this.MaxTasks)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DomainUsage) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&models.DomainUsage{")
+	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
+	s = append(s, "Instances: "+fmt.Sprintf("%#v", this.Instances)+",\n")
+	s = append(s, "MemoryMb: "+fmt.Sprintf("%#v", this.MemoryMb)+",\n")
+	s = append(s, "DiskMb: "+fmt.Sprintf("%#v", this.DiskMb)+",\n")
+	s = append(s, "Tasks: "+fmt.Sprintf("%#v", this.Tasks)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringDomainQuota(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *DomainQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DomainQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DomainQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxTasks != 0 {
+		i = encodeVarintDomainQuota(dAtA, i, uint64(m.MaxTasks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxDiskMb != 0 {
+		i = encodeVarintDomainQuota(dAtA, i, uint64(m.MaxDiskMb))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxMemoryMb != 0 {
+		i = encodeVarintDomainQuota(dAtA, i, uint64(m.MaxMemoryMb))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxInstances != 0 {
+		i = encodeVarintDomainQuota(dAtA, i, uint64(m.MaxInstances))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintDomainQuota(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DomainUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DomainUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DomainUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Tasks != 0 {
+		i = encodeVarintDomainQuota(dAtA, i, uint64(m.Tasks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DiskMb != 0 {
+		i = encodeVarintDomainQuota(dAtA, i, uint64(m.DiskMb))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MemoryMb != 0 {
+		i = encodeVarintDomainQuota(dAtA, i, uint64(m.MemoryMb))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Instances != 0 {
+		i = encodeVarintDomainQuota(dAtA, i, uint64(m.Instances))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintDomainQuota(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDomainQuota(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDomainQuota(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DomainQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovDomainQuota(uint64(l))
+	}
+	if m.MaxInstances != 0 {
+		n += 1 + sovDomainQuota(uint64(m.MaxInstances))
+	}
+	if m.MaxMemoryMb != 0 {
+		n += 1 + sovDomainQuota(uint64(m.MaxMemoryMb))
+	}
+	if m.MaxDiskMb != 0 {
+		n += 1 + sovDomainQuota(uint64(m.MaxDiskMb))
+	}
+	if m.MaxTasks != 0 {
+		n += 1 + sovDomainQuota(uint64(m.MaxTasks))
+	}
+	return n
+}
+
+func (m *DomainUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovDomainQuota(uint64(l))
+	}
+	if m.Instances != 0 {
+		n += 1 + sovDomainQuota(uint64(m.Instances))
+	}
+	if m.MemoryMb != 0 {
+		n += 1 + sovDomainQuota(uint64(m.MemoryMb))
+	}
+	if m.DiskMb != 0 {
+		n += 1 + sovDomainQuota(uint64(m.DiskMb))
+	}
+	if m.Tasks != 0 {
+		n += 1 + sovDomainQuota(uint64(m.Tasks))
+	}
+	return n
+}
+
+func sovDomainQuota(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDomainQuota(x uint64) (n int) {
+	return sovDomainQuota(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *DomainQuota) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DomainQuota{`,
+		`Domain:` + fmt.Sprintf("%v", this.Domain) + `,`,
+		`MaxInstances:` + fmt.Sprintf("%v", this.MaxInstances) + `,`,
+		`MaxMemoryMb:` + fmt.Sprintf("%v", this.MaxMemoryMb) + `,`,
+		`MaxDiskMb:` + fmt.Sprintf("%v", this.MaxDiskMb) + `,`,
+		`MaxTasks:` + fmt.Sprintf("%v", this.MaxTasks) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DomainUsage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DomainUsage{`,
+		`Domain:` + fmt.Sprintf("%v", this.Domain) + `,`,
+		`Instances:` + fmt.Sprintf("%v", this.Instances) + `,`,
+		`MemoryMb:` + fmt.Sprintf("%v", this.MemoryMb) + `,`,
+		`DiskMb:` + fmt.Sprintf("%v", this.DiskMb) + `,`,
+		`Tasks:` + fmt.Sprintf("%v", this.Tasks) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringDomainQuota(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *DomainQuota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDomainQuota
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DomainQuota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DomainQuota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomainQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDomainQuota
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomainQuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInstances", wireType)
+			}
+			m.MaxInstances = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomainQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxInstances |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMemoryMb", wireType)
+			}
+			m.MaxMemoryMb = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomainQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMemoryMb |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDiskMb", wireType)
+			}
+			m.MaxDiskMb = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomainQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDiskMb |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTasks", wireType)
+			}
+			m.MaxTasks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomainQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTasks |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDomainQuota(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDomainQuota
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DomainUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDomainQuota
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DomainUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DomainUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomainQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDomainQuota
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomainQuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instances", wireType)
+			}
+			m.Instances = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomainQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Instances |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryMb", wireType)
+			}
+			m.MemoryMb = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomainQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemoryMb |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskMb", wireType)
+			}
+			m.DiskMb = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomainQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiskMb |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+			}
+			m.Tasks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomainQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tasks |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDomainQuota(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDomainQuota
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDomainQuota(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDomainQuota
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDomainQuota
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDomainQuota
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDomainQuota
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDomainQuota
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDomainQuota
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDomainQuota        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDomainQuota          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDomainQuota = fmt.Errorf("proto: unexpected end of group")
+)
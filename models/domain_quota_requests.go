@@ -0,0 +1,45 @@
+package models
+
+func (req *UpsertDomainQuotaRequest) Validate() error {
+	var validationError ValidationError
+
+	if req.Quota == nil {
+		validationError = validationError.Append(ErrInvalidField{"quota"})
+	} else if err := req.Quota.Validate(); err != nil {
+		validationError = validationError.Append(err)
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
+func (req *DeleteDomainQuotaRequest) Validate() error {
+	var validationError ValidationError
+
+	if req.Domain == "" {
+		validationError = validationError.Append(ErrInvalidField{"domain"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
+func (req *DomainUsageRequest) Validate() error {
+	var validationError ValidationError
+
+	if req.Domain == "" {
+		validationError = validationError.Append(ErrInvalidField{"domain"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package models_test
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DomainQuota", func() {
+	Describe("Validate", func() {
+		It("is valid without limits", func() {
+			quota := models.DomainQuota{Domain: "some-domain"}
+			Expect(quota.Validate()).To(Succeed())
+		})
+
+		It("requires a domain and non-negative limits", func() {
+			quota := models.DomainQuota{MaxInstances: -1, MaxMemoryMb: -1, MaxDiskMb: -1, MaxTasks: -1}
+			Expect(quota.Validate()).To(ConsistOf(
+				models.ErrInvalidField{"domain"},
+				models.ErrInvalidField{"max_instances"},
+				models.ErrInvalidField{"max_memory_mb"},
+				models.ErrInvalidField{"max_disk_mb"},
+				models.ErrInvalidField{"max_tasks"},
+			))
+		})
+	})
+
+	Describe("ExceededLimit", func() {
+		var quota *models.DomainQuota
+
+		BeforeEach(func() {
+			quota = &models.DomainQuota{
+				Domain:       "some-domain",
+				MaxInstances: 10,
+				MaxMemoryMb:  1024,
+				MaxDiskMb:    2048,
+				MaxTasks:     5,
+			}
+		})
+
+		It("returns an empty string when the requested resources fit", func() {
+			usage := &models.DomainUsage{Instances: 5, MemoryMb: 512, DiskMb: 1024, Tasks: 4}
+			requested := &models.DomainUsage{Instances: 5, MemoryMb: 512, DiskMb: 1024, Tasks: 1}
+			Expect(quota.ExceededLimit(usage, requested)).To(BeEmpty())
+		})
+
+		It("returns the limit that the requested resources exceed", func() {
+			usage := &models.DomainUsage{}
+			Expect(quota.ExceededLimit(usage, &models.DomainUsage{Instances: 11})).To(Equal(models.QuotaLimitInstances))
+			Expect(quota.ExceededLimit(usage, &models.DomainUsage{MemoryMb: 1025})).To(Equal(models.QuotaLimitMemoryMB))
+			Expect(quota.ExceededLimit(usage, &models.DomainUsage{DiskMb: 2049})).To(Equal(models.QuotaLimitDiskMB))
+			Expect(quota.ExceededLimit(usage, &models.DomainUsage{Tasks: 6})).To(Equal(models.QuotaLimitTasks))
+		})
+
+		It("treats a limit of 0 as unlimited", func() {
+			quota = &models.DomainQuota{Domain: "some-domain"}
+			requested := &models.DomainUsage{Instances: 1000, MemoryMb: 1 << 40, DiskMb: 1 << 40, Tasks: 1000}
+			Expect(quota.ExceededLimit(&models.DomainUsage{}, requested)).To(BeEmpty())
+		})
+
+		It("only checks the requested resources", func() {
+			usage := &models.DomainUsage{Instances: 20, Tasks: 5}
+			Expect(quota.ExceededLimit(usage, &models.DomainUsage{Tasks: 1})).To(Equal(models.QuotaLimitTasks))
+			Expect(quota.ExceededLimit(usage, &models.DomainUsage{})).To(BeEmpty())
+		})
+	})
+
+	Describe("UpsertDomainQuotaRequest", func() {
+		It("requires a valid quota", func() {
+			request := models.UpsertDomainQuotaRequest{}
+			Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"quota"}))
+
+			request.Quota = &models.DomainQuota{MaxTasks: -1}
+			Expect(request.Validate()).To(ConsistOf(
+				models.ErrInvalidField{"domain"},
+				models.ErrInvalidField{"max_tasks"},
+			))
+		})
+	})
+
+	Describe("DomainUsageRequest", func() {
+		It("requires a domain", func() {
+			request := models.DomainUsageRequest{}
+			Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"domain"}))
+		})
+	})
+})
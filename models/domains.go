@@ -34,3 +34,23 @@ func (request *UpsertDomainRequest) Validate() error {
 
 	return nil
 }
+
+func (request *ExpireDomainRequest) Validate() error {
+	var validationError ValidationError
+
+	if request.Domain == "" {
+		return validationError.Append(ErrInvalidField{"domain"})
+	}
+
+	return nil
+}
+
+func (request *DeleteDomainRequest) Validate() error {
+	var validationError ValidationError
+
+	if request.Domain == "" {
+		return validationError.Append(ErrInvalidField{"domain"})
+	}
+
+	return nil
+}
@@ -54,6 +54,7 @@ const (
 	Error_Unrecoverable              Error_Type = 29
 	Error_LockCollision              Error_Type = 30
 	Error_Timeout                    Error_Type = 31
+	Error_QuotaExceeded              Error_Type = 32
 )
 
 var Error_Type_name = map[int32]string{
@@ -82,6 +83,7 @@ var Error_Type_name = map[int32]string{
 	29: "Unrecoverable",
 	30: "LockCollision",
 	31: "Timeout",
+	32: "QuotaExceeded",
 }
 
 var Error_Type_value = map[string]int32{
@@ -110,6 +112,7 @@ var Error_Type_value = map[string]int32{
 	"Unrecoverable":              29,
 	"LockCollision":              30,
 	"Timeout":                    31,
+	"QuotaExceeded":              32,
 }
 
 func (Error_Type) EnumDescriptor() ([]byte, []int) {
@@ -175,44 +178,45 @@ func init() {
 func init() { proto.RegisterFile("error.proto", fileDescriptor_0579b252106fcf4a) }
 
 var fileDescriptor_0579b252106fcf4a = []byte{
-	// 585 bytes of a gzipped FileDescriptorProto
+	// 595 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0xcd, 0x4e, 0xdb, 0x4c,
-	0x14, 0xb5, 0xf9, 0x0c, 0x98, 0x09, 0x3f, 0x97, 0x21, 0x1f, 0x84, 0x40, 0x07, 0x64, 0xa9, 0x12,
-	0x9b, 0x86, 0xaa, 0xed, 0x0b, 0x34, 0x09, 0x20, 0x2a, 0x0a, 0xc8, 0x24, 0x0f, 0x30, 0xb1, 0x6f,
-	0xc2, 0x88, 0xc9, 0x4c, 0x3a, 0x1e, 0xa7, 0xa5, 0xab, 0x3e, 0x42, 0x1f, 0xa3, 0x8f, 0xd2, 0x45,
-	0x17, 0x2c, 0x59, 0xa1, 0x62, 0x36, 0x2d, 0x2b, 0x1e, 0xa1, 0xb2, 0x13, 0x10, 0x12, 0x6c, 0xac,
-	0x7b, 0xcf, 0xb9, 0xe7, 0xf8, 0x9e, 0x6b, 0x99, 0x94, 0xd0, 0x18, 0x6d, 0x6a, 0x03, 0xa3, 0xad,
-	0xa6, 0x53, 0x7d, 0x1d, 0xa3, 0x4c, 0xaa, 0xaf, 0x7a, 0xc2, 0x9e, 0xa6, 0x9d, 0x5a, 0xa4, 0xfb,
-	0xdb, 0x3d, 0xdd, 0xd3, 0xdb, 0x05, 0xdd, 0x49, 0xbb, 0x45, 0x57, 0x34, 0x45, 0x35, 0x92, 0x05,
-	0xbf, 0x26, 0xc9, 0xe4, 0x4e, 0x6e, 0x43, 0x5f, 0x13, 0xcf, 0x9e, 0x0f, 0xb0, 0xe2, 0x6e, 0xba,
-	0x5b, 0xf3, 0x6f, 0x68, 0x6d, 0xe4, 0x57, 0x2b, 0xc8, 0x5a, 0xeb, 0x7c, 0x80, 0x75, 0xff, 0xf6,
-	0x6a, 0xa3, 0x98, 0x09, 0x8b, 0x27, 0x7d, 0x49, 0xa6, 0xfb, 0x98, 0x24, 0xbc, 0x87, 0x95, 0x89,
-	0x4d, 0x77, 0x6b, 0xa6, 0x5e, 0xba, 0xbd, 0xda, 0xb8, 0x87, 0xc2, 0xfb, 0x22, 0xf8, 0xeb, 0x11,
-	0x2f, 0xd7, 0x53, 0x20, 0xb3, 0x6d, 0x75, 0xa6, 0xf4, 0x67, 0x55, 0x98, 0x82, 0x43, 0x17, 0xc9,
-	0xdc, 0xbe, 0x1a, 0x72, 0x29, 0xe2, 0x10, 0x23, 0x6d, 0x62, 0xf8, 0x8f, 0x52, 0x32, 0xff, 0x00,
-	0x7d, 0x4a, 0x31, 0xb1, 0xe0, 0xd1, 0x25, 0xb2, 0xf0, 0x80, 0x25, 0x03, 0xad, 0x12, 0x84, 0x49,
-	0x5a, 0x25, 0xcb, 0x63, 0xf0, 0x78, 0x9c, 0xf0, 0xe3, 0xe8, 0x85, 0x30, 0x45, 0x17, 0x48, 0x69,
-	0xcc, 0x7d, 0x38, 0x39, 0x3a, 0x84, 0x69, 0x5a, 0x21, 0xe5, 0x5d, 0x2e, 0x24, 0xc6, 0x2d, 0x7d,
-	0x34, 0x40, 0xb5, 0xa3, 0x86, 0x28, 0xf5, 0x00, 0xc1, 0x7f, 0x64, 0x73, 0x62, 0xb9, 0xc5, 0x96,
-	0xe1, 0x2a, 0x11, 0x56, 0x68, 0x05, 0x33, 0xb4, 0x4c, 0x20, 0xc4, 0x44, 0xa7, 0x26, 0xc2, 0x86,
-	0x56, 0x5d, 0x29, 0x22, 0x0b, 0xa5, 0x7c, 0xc3, 0x7b, 0x74, 0xe7, 0x8b, 0x48, 0x6c, 0x02, 0xb3,
-	0x8f, 0x27, 0x0f, 0xb5, 0xdd, 0xd5, 0xa9, 0x8a, 0x61, 0x2e, 0x5f, 0x23, 0xd4, 0xa9, 0x45, 0x33,
-	0xca, 0x3b, 0x4f, 0xd7, 0x49, 0xe5, 0x7d, 0x64, 0x53, 0x2e, 0x0f, 0xc2, 0xe3, 0x06, 0x57, 0x4a,
-	0xdb, 0x3a, 0x36, 0x24, 0x17, 0x7d, 0x8c, 0x61, 0xe1, 0x59, 0xf6, 0xc4, 0x72, 0x63, 0x31, 0x06,
-	0x78, 0x5e, 0x6b, 0x78, 0x72, 0x8a, 0x31, 0x2c, 0xd2, 0x35, 0xb2, 0xf2, 0x84, 0x1d, 0x25, 0x06,
-	0xfa, 0xac, 0x34, 0xc4, 0xbe, 0x1e, 0x62, 0x0c, 0x4b, 0x94, 0x91, 0xea, 0x13, 0xb6, 0xad, 0xa2,
-	0xf1, 0x5a, 0xff, 0xe7, 0x17, 0x0a, 0x53, 0xa5, 0x84, 0xea, 0x1d, 0xa9, 0xa6, 0xe8, 0x76, 0xd1,
-	0xa0, 0xb2, 0x0d, 0x94, 0x12, 0x2a, 0xf9, 0x2d, 0xf6, 0xda, 0xfb, 0xcd, 0x3d, 0x54, 0x68, 0x78,
-	0x71, 0xb5, 0x6a, 0x9e, 0xba, 0x89, 0x09, 0x1a, 0xc1, 0xa5, 0xf8, 0x8a, 0xb0, 0x46, 0x67, 0x89,
-	0xdf, 0x44, 0x1e, 0x4b, 0x1d, 0x9d, 0xc1, 0x7a, 0xfe, 0xcd, 0xdb, 0xca, 0x60, 0xa4, 0x87, 0x68,
-	0x78, 0x47, 0x22, 0xbc, 0xc8, 0xa1, 0x03, 0x1d, 0x9d, 0x35, 0xb4, 0x94, 0x22, 0xc9, 0x4d, 0x18,
-	0x2d, 0x91, 0xe9, 0x96, 0xe8, 0xa3, 0x4e, 0x2d, 0x6c, 0x04, 0x9e, 0xef, 0x82, 0x1b, 0x78, 0xfe,
-	0x04, 0x4c, 0x04, 0x9e, 0x4f, 0x80, 0x04, 0x9e, 0x5f, 0x86, 0x72, 0xe0, 0xf9, 0xcb, 0xb0, 0x1c,
-	0x78, 0xfe, 0x0a, 0xac, 0x04, 0x9e, 0xbf, 0x0a, 0xab, 0xf5, 0x77, 0x17, 0xd7, 0xcc, 0xbd, 0xbc,
-	0x66, 0xce, 0xdd, 0x35, 0x73, 0xbf, 0x65, 0xcc, 0xfd, 0x91, 0x31, 0xe7, 0x67, 0xc6, 0xdc, 0x8b,
-	0x8c, 0xb9, 0xbf, 0x33, 0xe6, 0xfe, 0xc9, 0x98, 0x73, 0x97, 0x31, 0xf7, 0xfb, 0x0d, 0x73, 0x2e,
-	0x6e, 0x98, 0x73, 0x79, 0xc3, 0x9c, 0xce, 0x54, 0xf1, 0x2f, 0xbc, 0xfd, 0x17, 0x00, 0x00, 0xff,
-	0xff, 0xf2, 0xb4, 0xa0, 0xf2, 0x51, 0x03, 0x00, 0x00,
+	0x14, 0x8d, 0xf9, 0x0c, 0x98, 0x09, 0x3f, 0x97, 0x21, 0x1f, 0x84, 0x40, 0x07, 0x64, 0xa9, 0x12,
+	0x9b, 0x86, 0xaa, 0xed, 0x0b, 0x34, 0x3f, 0x20, 0x2a, 0x0a, 0xd4, 0x24, 0x0f, 0x30, 0xb1, 0x6f,
+	0xc2, 0x88, 0xc9, 0x4c, 0x3a, 0x1e, 0xa7, 0xd0, 0x55, 0x1f, 0xa1, 0x8f, 0xd1, 0x47, 0xe9, 0x92,
+	0x25, 0x2b, 0x5a, 0xcc, 0xa6, 0x62, 0xc5, 0x03, 0x74, 0x51, 0xd9, 0x09, 0x08, 0x09, 0x36, 0xd6,
+	0xbd, 0xe7, 0xdc, 0x73, 0x7c, 0xcf, 0xb5, 0x4c, 0x8a, 0x68, 0x8c, 0x36, 0xd5, 0x81, 0xd1, 0x56,
+	0xd3, 0xa9, 0xbe, 0x8e, 0x50, 0xc6, 0x95, 0x57, 0x3d, 0x61, 0x4f, 0x92, 0x4e, 0x35, 0xd4, 0xfd,
+	0xed, 0x9e, 0xee, 0xe9, 0xed, 0x9c, 0xee, 0x24, 0xdd, 0xbc, 0xcb, 0x9b, 0xbc, 0x1a, 0xc9, 0xfc,
+	0x5f, 0x93, 0x64, 0xb2, 0x99, 0xd9, 0xd0, 0xd7, 0xc4, 0xb5, 0xe7, 0x03, 0x2c, 0x3b, 0x9b, 0xce,
+	0xd6, 0xfc, 0x1b, 0x5a, 0x1d, 0xf9, 0x55, 0x73, 0xb2, 0xda, 0x3a, 0x1f, 0x60, 0xcd, 0xbb, 0xbd,
+	0xda, 0xc8, 0x67, 0x82, 0xfc, 0x49, 0x5f, 0x92, 0xe9, 0x3e, 0xc6, 0x31, 0xef, 0x61, 0x79, 0x62,
+	0xd3, 0xd9, 0x9a, 0xa9, 0x15, 0x6f, 0xaf, 0x36, 0xee, 0xa1, 0xe0, 0xbe, 0xf0, 0xff, 0xba, 0xc4,
+	0xcd, 0xf4, 0x14, 0xc8, 0x6c, 0x5b, 0x9d, 0x2a, 0xfd, 0x45, 0xe5, 0xa6, 0x50, 0xa0, 0x8b, 0x64,
+	0x6e, 0x4f, 0x0d, 0xb9, 0x14, 0x51, 0x80, 0xa1, 0x36, 0x11, 0xfc, 0x47, 0x29, 0x99, 0x7f, 0x80,
+	0x3e, 0x27, 0x18, 0x5b, 0x70, 0xe9, 0x12, 0x59, 0x78, 0xc0, 0xe2, 0x81, 0x56, 0x31, 0xc2, 0x24,
+	0xad, 0x90, 0xe5, 0x31, 0x78, 0x34, 0x4e, 0xf8, 0x71, 0xf4, 0x42, 0x98, 0xa2, 0x0b, 0xa4, 0x38,
+	0xe6, 0x3e, 0x1c, 0x1f, 0x1e, 0xc0, 0x34, 0x2d, 0x93, 0xd2, 0x0e, 0x17, 0x12, 0xa3, 0x96, 0x3e,
+	0x1c, 0xa0, 0x6a, 0xaa, 0x21, 0x4a, 0x3d, 0x40, 0xf0, 0x1e, 0xd9, 0x1c, 0x5b, 0x6e, 0xb1, 0x65,
+	0xb8, 0x8a, 0x85, 0x15, 0x5a, 0xc1, 0x0c, 0x2d, 0x11, 0x08, 0x30, 0xd6, 0x89, 0x09, 0xb1, 0xae,
+	0x55, 0x57, 0x8a, 0xd0, 0x42, 0x31, 0xdb, 0xf0, 0x1e, 0x6d, 0x9e, 0x89, 0xd8, 0xc6, 0x30, 0xfb,
+	0x78, 0xf2, 0x40, 0xdb, 0x1d, 0x9d, 0xa8, 0x08, 0xe6, 0xb2, 0x35, 0x02, 0x9d, 0x58, 0x34, 0xa3,
+	0xbc, 0xf3, 0x74, 0x9d, 0x94, 0xdf, 0x87, 0x36, 0xe1, 0x72, 0x3f, 0x38, 0xaa, 0x73, 0xa5, 0xb4,
+	0xad, 0x61, 0x5d, 0x72, 0xd1, 0xc7, 0x08, 0x16, 0x9e, 0x65, 0x8f, 0x2d, 0x37, 0x16, 0x23, 0x80,
+	0xe7, 0xb5, 0x86, 0xc7, 0x27, 0x18, 0xc1, 0x22, 0x5d, 0x23, 0x2b, 0x4f, 0xd8, 0x51, 0x62, 0xa0,
+	0xcf, 0x4a, 0x03, 0xec, 0xeb, 0x21, 0x46, 0xb0, 0x44, 0x19, 0xa9, 0x3c, 0x61, 0xdb, 0x2a, 0x1c,
+	0xaf, 0xf5, 0x7f, 0x76, 0xa1, 0x20, 0x51, 0x4a, 0xa8, 0xde, 0xa1, 0x6a, 0x88, 0x6e, 0x17, 0x0d,
+	0x2a, 0x5b, 0x47, 0x29, 0xa1, 0x9c, 0xdd, 0x62, 0xb7, 0xbd, 0xd7, 0xd8, 0x45, 0x85, 0x86, 0xe7,
+	0x57, 0xab, 0x64, 0xa9, 0x1b, 0x18, 0xa3, 0x11, 0x5c, 0x8a, 0xaf, 0x08, 0x6b, 0x74, 0x96, 0x78,
+	0x0d, 0xe4, 0x91, 0xd4, 0xe1, 0x29, 0xac, 0x67, 0xdf, 0xbc, 0xad, 0x0c, 0x86, 0x7a, 0x88, 0x86,
+	0x77, 0x24, 0xc2, 0x8b, 0x0c, 0xda, 0xd7, 0xe1, 0x69, 0x5d, 0x4b, 0x29, 0xe2, 0xcc, 0x84, 0xd1,
+	0x22, 0x99, 0x6e, 0x89, 0x3e, 0xea, 0xc4, 0xc2, 0x46, 0xc6, 0x7f, 0x4a, 0xb4, 0xe5, 0xcd, 0xb3,
+	0x10, 0x31, 0xc2, 0x08, 0x36, 0x7d, 0xd7, 0x73, 0xc0, 0xf1, 0x5d, 0x6f, 0x02, 0x26, 0x7c, 0xd7,
+	0x23, 0x40, 0x7c, 0xd7, 0x2b, 0x41, 0xc9, 0x77, 0xbd, 0x65, 0x58, 0xf6, 0x5d, 0x6f, 0x05, 0x56,
+	0x7c, 0xd7, 0x5b, 0x85, 0xd5, 0xda, 0xbb, 0x8b, 0x6b, 0xe6, 0x5c, 0x5e, 0xb3, 0xc2, 0xdd, 0x35,
+	0x73, 0xbe, 0xa5, 0xcc, 0xf9, 0x91, 0xb2, 0xc2, 0xcf, 0x94, 0x39, 0x17, 0x29, 0x73, 0x7e, 0xa7,
+	0xcc, 0xf9, 0x93, 0xb2, 0xc2, 0x5d, 0xca, 0x9c, 0xef, 0x37, 0xac, 0x70, 0x71, 0xc3, 0x0a, 0x97,
+	0x37, 0xac, 0xd0, 0x99, 0xca, 0x7f, 0x8f, 0xb7, 0xff, 0x06, 0x00, 0x34, 0x53, 0xab, 0x59, 0x64,
+	0x03, 0x00, 0x00,
 }
 
 func (x Error_Type) String() string {
@@ -178,6 +178,13 @@ func NewRunningOnDifferentCellError(expectedCellId, actualCellId string) *Error
 	}
 }
 
+func NewQuotaExceededError(domain, limit string) *Error {
+	return &Error{
+		Type:    Error_QuotaExceeded,
+		Message: fmt.Sprintf("domain %s would exceed its %s quota", domain, limit),
+	}
+}
+
 func NewUnrecoverableError(err error) *Error {
 	return &Error{
 		Type:    Error_Unrecoverable,
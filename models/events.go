@@ -26,6 +26,8 @@ const (
 	EventTypeActualLRPRemoved = "actual_lrp_removed"
 	EventTypeActualLRPCrashed = "actual_lrp_crashed"
 
+	EventTypeActualLRPRestartsExhausted = "actual_lrp_restarts_exhausted"
+
 	EventTypeActualLRPInstanceCreated = "actual_lrp_instance_created"
 	EventTypeActualLRPInstanceChanged = "actual_lrp_instance_changed"
 	EventTypeActualLRPInstanceRemoved = "actual_lrp_instance_removed"
@@ -33,6 +35,11 @@ const (
 	EventTypeTaskCreated = "task_created"
 	EventTypeTaskChanged = "task_changed"
 	EventTypeTaskRemoved = "task_removed"
+
+	EventTypeScheduledTaskRun = "scheduled_task_run"
+
+	EventTypeDomainFresh = "domain_fresh"
+	EventTypeDomainStale = "domain_stale"
 )
 
 // Downgrade the DesiredLRPEvent payload (i.e. DesiredLRP(s)) to the given
@@ -192,6 +199,24 @@ func (event *ActualLRPCrashedEvent) Key() string {
 	return event.ActualLRPInstanceKey.InstanceGuid
 }
 
+func NewActualLRPRestartsExhaustedEvent(before, after *ActualLRP) *ActualLRPRestartsExhaustedEvent {
+	return &ActualLRPRestartsExhaustedEvent{
+		ActualLRPKey:         after.ActualLRPKey,
+		ActualLRPInstanceKey: before.ActualLRPInstanceKey,
+		CrashCount:           after.CrashCount,
+		CrashReason:          after.CrashReason,
+		Since:                after.Since,
+	}
+}
+
+func (event *ActualLRPRestartsExhaustedEvent) EventType() string {
+	return EventTypeActualLRPRestartsExhausted
+}
+
+func (event *ActualLRPRestartsExhaustedEvent) Key() string {
+	return event.ActualLRPInstanceKey.InstanceGuid
+}
+
 // Deprecated: use the ActualLRPInstance versions of this instead
 func NewActualLRPRemovedEvent(actualLRPGroup *ActualLRPGroup) *ActualLRPRemovedEvent {
 	return &ActualLRPRemovedEvent{
@@ -317,6 +342,51 @@ func (event TaskRemovedEvent) Key() string {
 	return event.Task.GetTaskGuid()
 }
 
+func NewScheduledTaskRunEvent(scheduledTaskGuid, taskGuid string, scheduledAt int64, skipReason string) *ScheduledTaskRunEvent {
+	return &ScheduledTaskRunEvent{
+		ScheduledTaskGuid: scheduledTaskGuid,
+		TaskGuid:          taskGuid,
+		ScheduledAt:       scheduledAt,
+		SkipReason:        skipReason,
+	}
+}
+
+func (event *ScheduledTaskRunEvent) EventType() string {
+	return EventTypeScheduledTaskRun
+}
+
+func (event *ScheduledTaskRunEvent) Key() string {
+	return event.GetScheduledTaskGuid()
+}
+
+func NewDomainFreshEvent(domain string) *DomainFreshEvent {
+	return &DomainFreshEvent{
+		Domain: domain,
+	}
+}
+
+func (event *DomainFreshEvent) EventType() string {
+	return EventTypeDomainFresh
+}
+
+func (event *DomainFreshEvent) Key() string {
+	return event.GetDomain()
+}
+
+func NewDomainStaleEvent(domain string) *DomainStaleEvent {
+	return &DomainStaleEvent{
+		Domain: domain,
+	}
+}
+
+func (event *DomainStaleEvent) EventType() string {
+	return EventTypeDomainStale
+}
+
+func (event *DomainStaleEvent) Key() string {
+	return event.GetDomain()
+}
+
 func (info *ActualLRPInfo) SetRoutable(routable bool) {
 	info.OptionalRoutable = &ActualLRPInfo_Routable{
 		Routable: routable,
@@ -227,8 +227,10 @@ type ActualLRPInfo struct {
 	Presence         ActualLRP_Presence `protobuf:"varint,10,opt,name=presence,proto3,enum=models.ActualLRP_Presence" json:"presence"`
 	// Types that are valid to be assigned to OptionalRoutable:
 	//	*ActualLRPInfo_Routable
-	OptionalRoutable isActualLRPInfo_OptionalRoutable `protobuf_oneof:"optional_routable"`
-	AvailabilityZone string                           `protobuf:"bytes,12,opt,name=availability_zone,json=availabilityZone,proto3" json:"availability_zone"`
+	OptionalRoutable  isActualLRPInfo_OptionalRoutable `protobuf_oneof:"optional_routable"`
+	AvailabilityZone  string                           `protobuf:"bytes,12,opt,name=availability_zone,json=availabilityZone,proto3" json:"availability_zone"`
+	NextRestartAt     int64                            `protobuf:"varint,13,opt,name=next_restart_at,json=nextRestartAt,proto3" json:"next_restart_at"`
+	RestartsExhausted bool                             `protobuf:"varint,14,opt,name=restarts_exhausted,json=restartsExhausted,proto3" json:"restarts_exhausted"`
 }
 
 func (m *ActualLRPInfo) Reset()      { *m = ActualLRPInfo{} }
@@ -346,6 +348,20 @@ func (m *ActualLRPInfo) GetAvailabilityZone() string {
 	return ""
 }
 
+func (m *ActualLRPInfo) GetNextRestartAt() int64 {
+	if m != nil {
+		return m.NextRestartAt
+	}
+	return 0
+}
+
+func (m *ActualLRPInfo) GetRestartsExhausted() bool {
+	if m != nil {
+		return m.RestartsExhausted
+	}
+	return false
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ActualLRPInfo) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -687,6 +703,67 @@ func (m *ActualLRPCrashedEvent) GetSince() int64 {
 	return 0
 }
 
+type ActualLRPRestartsExhaustedEvent struct {
+	ActualLRPKey         `protobuf:"bytes,1,opt,name=actual_lrp_key,json=actualLrpKey,proto3,embedded=actual_lrp_key" json:""`
+	ActualLRPInstanceKey `protobuf:"bytes,2,opt,name=actual_lrp_instance_key,json=actualLrpInstanceKey,proto3,embedded=actual_lrp_instance_key" json:""`
+	CrashCount           int32  `protobuf:"varint,3,opt,name=crash_count,json=crashCount,proto3" json:"crash_count"`
+	CrashReason          string `protobuf:"bytes,4,opt,name=crash_reason,json=crashReason,proto3" json:"crash_reason,omitempty"`
+	Since                int64  `protobuf:"varint,5,opt,name=since,proto3" json:"since"`
+}
+
+func (m *ActualLRPRestartsExhaustedEvent) Reset()      { *m = ActualLRPRestartsExhaustedEvent{} }
+func (*ActualLRPRestartsExhaustedEvent) ProtoMessage() {}
+func (*ActualLRPRestartsExhaustedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f22242cb04491f9, []int{11}
+}
+func (m *ActualLRPRestartsExhaustedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActualLRPRestartsExhaustedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActualLRPRestartsExhaustedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActualLRPRestartsExhaustedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActualLRPRestartsExhaustedEvent.Merge(m, src)
+}
+func (m *ActualLRPRestartsExhaustedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActualLRPRestartsExhaustedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActualLRPRestartsExhaustedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActualLRPRestartsExhaustedEvent proto.InternalMessageInfo
+
+func (m *ActualLRPRestartsExhaustedEvent) GetCrashCount() int32 {
+	if m != nil {
+		return m.CrashCount
+	}
+	return 0
+}
+
+func (m *ActualLRPRestartsExhaustedEvent) GetCrashReason() string {
+	if m != nil {
+		return m.CrashReason
+	}
+	return ""
+}
+
+func (m *ActualLRPRestartsExhaustedEvent) GetSince() int64 {
+	if m != nil {
+		return m.Since
+	}
+	return 0
+}
+
 type EventsByCellId struct {
 	CellId string `protobuf:"bytes,1,opt,name=cell_id,json=cellId,proto3" json:"cell_id"`
 }
@@ -694,7 +771,7 @@ type EventsByCellId struct {
 func (m *EventsByCellId) Reset()      { *m = EventsByCellId{} }
 func (*EventsByCellId) ProtoMessage() {}
 func (*EventsByCellId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f22242cb04491f9, []int{11}
+	return fileDescriptor_8f22242cb04491f9, []int{12}
 }
 func (m *EventsByCellId) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -737,7 +814,7 @@ type TaskCreatedEvent struct {
 func (m *TaskCreatedEvent) Reset()      { *m = TaskCreatedEvent{} }
 func (*TaskCreatedEvent) ProtoMessage() {}
 func (*TaskCreatedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f22242cb04491f9, []int{12}
+	return fileDescriptor_8f22242cb04491f9, []int{13}
 }
 func (m *TaskCreatedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -781,7 +858,7 @@ type TaskChangedEvent struct {
 func (m *TaskChangedEvent) Reset()      { *m = TaskChangedEvent{} }
 func (*TaskChangedEvent) ProtoMessage() {}
 func (*TaskChangedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f22242cb04491f9, []int{13}
+	return fileDescriptor_8f22242cb04491f9, []int{14}
 }
 func (m *TaskChangedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -831,7 +908,7 @@ type TaskRemovedEvent struct {
 func (m *TaskRemovedEvent) Reset()      { *m = TaskRemovedEvent{} }
 func (*TaskRemovedEvent) ProtoMessage() {}
 func (*TaskRemovedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f22242cb04491f9, []int{14}
+	return fileDescriptor_8f22242cb04491f9, []int{15}
 }
 func (m *TaskRemovedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -867,6 +944,159 @@ func (m *TaskRemovedEvent) GetTask() *Task {
 	return nil
 }
 
+type ScheduledTaskRunEvent struct {
+	ScheduledTaskGuid string `protobuf:"bytes,1,opt,name=scheduled_task_guid,json=scheduledTaskGuid,proto3" json:"scheduled_task_guid"`
+	TaskGuid          string `protobuf:"bytes,2,opt,name=task_guid,json=taskGuid,proto3" json:"task_guid,omitempty"`
+	ScheduledAt       int64  `protobuf:"varint,3,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at"`
+	SkipReason        string `protobuf:"bytes,4,opt,name=skip_reason,json=skipReason,proto3" json:"skip_reason,omitempty"`
+}
+
+func (m *ScheduledTaskRunEvent) Reset()      { *m = ScheduledTaskRunEvent{} }
+func (*ScheduledTaskRunEvent) ProtoMessage() {}
+func (*ScheduledTaskRunEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f22242cb04491f9, []int{16}
+}
+func (m *ScheduledTaskRunEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledTaskRunEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledTaskRunEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledTaskRunEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledTaskRunEvent.Merge(m, src)
+}
+func (m *ScheduledTaskRunEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledTaskRunEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledTaskRunEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledTaskRunEvent proto.InternalMessageInfo
+
+func (m *ScheduledTaskRunEvent) GetScheduledTaskGuid() string {
+	if m != nil {
+		return m.ScheduledTaskGuid
+	}
+	return ""
+}
+
+func (m *ScheduledTaskRunEvent) GetTaskGuid() string {
+	if m != nil {
+		return m.TaskGuid
+	}
+	return ""
+}
+
+func (m *ScheduledTaskRunEvent) GetScheduledAt() int64 {
+	if m != nil {
+		return m.ScheduledAt
+	}
+	return 0
+}
+
+func (m *ScheduledTaskRunEvent) GetSkipReason() string {
+	if m != nil {
+		return m.SkipReason
+	}
+	return ""
+}
+
+type DomainFreshEvent struct {
+	Domain string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain"`
+}
+
+func (m *DomainFreshEvent) Reset()      { *m = DomainFreshEvent{} }
+func (*DomainFreshEvent) ProtoMessage() {}
+func (*DomainFreshEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f22242cb04491f9, []int{17}
+}
+func (m *DomainFreshEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DomainFreshEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DomainFreshEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DomainFreshEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DomainFreshEvent.Merge(m, src)
+}
+func (m *DomainFreshEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *DomainFreshEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_DomainFreshEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DomainFreshEvent proto.InternalMessageInfo
+
+func (m *DomainFreshEvent) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+type DomainStaleEvent struct {
+	Domain string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain"`
+}
+
+func (m *DomainStaleEvent) Reset()      { *m = DomainStaleEvent{} }
+func (*DomainStaleEvent) ProtoMessage() {}
+func (*DomainStaleEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f22242cb04491f9, []int{18}
+}
+func (m *DomainStaleEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DomainStaleEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DomainStaleEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DomainStaleEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DomainStaleEvent.Merge(m, src)
+}
+func (m *DomainStaleEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *DomainStaleEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_DomainStaleEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DomainStaleEvent proto.InternalMessageInfo
+
+func (m *DomainStaleEvent) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ActualLRPCreatedEvent)(nil), "models.ActualLRPCreatedEvent")
 	proto.RegisterType((*ActualLRPChangedEvent)(nil), "models.ActualLRPChangedEvent")
@@ -879,74 +1109,90 @@ func init() {
 	proto.RegisterType((*DesiredLRPChangedEvent)(nil), "models.DesiredLRPChangedEvent")
 	proto.RegisterType((*DesiredLRPRemovedEvent)(nil), "models.DesiredLRPRemovedEvent")
 	proto.RegisterType((*ActualLRPCrashedEvent)(nil), "models.ActualLRPCrashedEvent")
+	proto.RegisterType((*ActualLRPRestartsExhaustedEvent)(nil), "models.ActualLRPRestartsExhaustedEvent")
 	proto.RegisterType((*EventsByCellId)(nil), "models.EventsByCellId")
 	proto.RegisterType((*TaskCreatedEvent)(nil), "models.TaskCreatedEvent")
 	proto.RegisterType((*TaskChangedEvent)(nil), "models.TaskChangedEvent")
 	proto.RegisterType((*TaskRemovedEvent)(nil), "models.TaskRemovedEvent")
+	proto.RegisterType((*ScheduledTaskRunEvent)(nil), "models.ScheduledTaskRunEvent")
+	proto.RegisterType((*DomainFreshEvent)(nil), "models.DomainFreshEvent")
+	proto.RegisterType((*DomainStaleEvent)(nil), "models.DomainStaleEvent")
 }
 
 func init() { proto.RegisterFile("events.proto", fileDescriptor_8f22242cb04491f9) }
 
 var fileDescriptor_8f22242cb04491f9 = []byte{
-	// 913 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x4f, 0x6f, 0xdb, 0x36,
-	0x14, 0x17, 0x13, 0xdb, 0x71, 0x9e, 0x3d, 0xc7, 0x61, 0x9b, 0x54, 0x08, 0x3a, 0xc9, 0x33, 0x0a,
-	0xd4, 0xd8, 0x56, 0xb7, 0x68, 0x8b, 0x1d, 0x76, 0xda, 0x9c, 0x16, 0x6b, 0xd0, 0x6e, 0x28, 0x88,
-	0xee, 0x32, 0x74, 0x10, 0x68, 0x99, 0x76, 0x84, 0xc8, 0xa2, 0x21, 0xd1, 0x01, 0xdc, 0xd3, 0x3e,
-	0xc2, 0x6e, 0xfb, 0x0a, 0xfb, 0x0c, 0xbb, 0xed, 0xd6, 0x63, 0x76, 0xeb, 0x49, 0x58, 0x9c, 0xcb,
-	0xe0, 0x53, 0x3f, 0xc2, 0x20, 0x52, 0x52, 0x29, 0xdb, 0x48, 0x57, 0x60, 0x39, 0xf4, 0x64, 0xf2,
-	0xbd, 0x1f, 0x7f, 0xef, 0x0f, 0x1f, 0x7f, 0x32, 0xd4, 0xd9, 0x29, 0x0b, 0x44, 0xd4, 0x9d, 0x84,
-	0x5c, 0x70, 0x5c, 0x19, 0xf3, 0x01, 0xf3, 0xa3, 0x83, 0x3b, 0x23, 0x4f, 0x1c, 0x4f, 0xfb, 0x5d,
-	0x97, 0x8f, 0xef, 0x8e, 0xf8, 0x88, 0xdf, 0x95, 0xee, 0xfe, 0x74, 0x28, 0x77, 0x72, 0x23, 0x57,
-	0xea, 0xd8, 0x41, 0x93, 0xba, 0x62, 0x4a, 0x7d, 0xc7, 0x0f, 0x27, 0xa9, 0x65, 0x77, 0xc0, 0x22,
-	0x2f, 0x64, 0x03, 0xcd, 0x04, 0x82, 0x46, 0x27, 0xe9, 0x7a, 0x7f, 0xcc, 0x07, 0xde, 0xd0, 0x73,
-	0xa9, 0xf0, 0x78, 0xe0, 0x08, 0x3a, 0x52, 0xf6, 0xf6, 0xcf, 0xb0, 0xf7, 0xad, 0xa4, 0x7a, 0x46,
-	0x9e, 0x1f, 0x86, 0x8c, 0x0a, 0x36, 0x78, 0x9c, 0xe4, 0x87, 0xbf, 0x01, 0x2d, 0x86, 0x33, 0x0a,
-	0xf9, 0x74, 0x62, 0xa2, 0x16, 0xea, 0xd4, 0xee, 0xef, 0x77, 0x55, 0xce, 0xdd, 0xfc, 0xe0, 0x77,
-	0x89, 0x97, 0x34, 0x14, 0xfe, 0x59, 0x38, 0x91, 0xfb, 0xaf, 0x37, 0x4c, 0xd4, 0x9e, 0xe9, 0xf4,
-	0xc7, 0x34, 0x18, 0x65, 0xf4, 0x5d, 0xa8, 0xf4, 0xd9, 0x90, 0x87, 0xec, 0x3d, 0xa4, 0x29, 0x0a,
-	0x7f, 0x09, 0x65, 0x3a, 0x14, 0x2c, 0x34, 0x37, 0x2e, 0x85, 0x2b, 0x90, 0x0c, 0xad, 0x57, 0x46,
-	0xd8, 0x98, 0x9f, 0xfe, 0xbf, 0x95, 0xbd, 0x82, 0x4f, 0x73, 0xd4, 0x51, 0x10, 0x09, 0x1a, 0xb8,
-	0xac, 0xd0, 0xc0, 0x7b, 0x00, 0xef, 0xc2, 0xa4, 0x01, 0x76, 0x57, 0x02, 0x90, 0xed, 0x9c, 0x1b,
-	0xdf, 0x86, 0xaa, 0x08, 0xa9, 0xcb, 0x1c, 0x6f, 0x20, 0xcb, 0xdc, 0xee, 0xd5, 0x17, 0xb1, 0x9d,
-	0xdb, 0xc8, 0x96, 0x5c, 0x1d, 0x0d, 0xda, 0x7f, 0x96, 0xe0, 0x13, 0x2d, 0xf8, 0x90, 0xe3, 0x1f,
-	0xe1, 0x9a, 0x56, 0x53, 0xc0, 0x84, 0xe3, 0x05, 0x43, 0x6e, 0x6e, 0xca, 0xa8, 0xe6, 0x4a, 0xd4,
-	0x1f, 0x98, 0x48, 0x8e, 0xf5, 0xea, 0xaf, 0x63, 0xdb, 0x38, 0x8b, 0x6d, 0xb4, 0x88, 0x6d, 0x83,
-	0x34, 0xf3, 0x54, 0x52, 0x3f, 0xbe, 0x07, 0x35, 0x37, 0xa4, 0xd1, 0xb1, 0xe3, 0xf2, 0x69, 0x20,
-	0xcc, 0x52, 0x0b, 0x75, 0xca, 0xbd, 0x9d, 0x45, 0x6c, 0xeb, 0x66, 0x02, 0x72, 0x73, 0x98, 0xac,
-	0xf1, 0x67, 0x50, 0x57, 0xae, 0x90, 0xd1, 0x88, 0x07, 0x66, 0x39, 0xa9, 0x83, 0x28, 0x38, 0x91,
-	0x26, 0x6c, 0x43, 0x39, 0x12, 0x54, 0x30, 0xb3, 0x22, 0x6b, 0xdc, 0x5e, 0xc4, 0xb6, 0x32, 0x10,
-	0xf5, 0x83, 0x6f, 0xc3, 0xce, 0xc4, 0xa7, 0x2e, 0x1b, 0xb3, 0x40, 0x38, 0x2c, 0x0c, 0x79, 0x68,
-	0x6e, 0x49, 0x9a, 0x46, 0x6e, 0x7e, 0x9c, 0x58, 0x25, 0x93, 0x17, 0xb8, 0xcc, 0xac, 0xb6, 0x50,
-	0x67, 0x33, 0x65, 0x4a, 0x0c, 0x44, 0xfd, 0xe0, 0x97, 0xd0, 0x5c, 0x9e, 0x7b, 0x73, 0x5b, 0xf6,
-	0xe4, 0x46, 0xd6, 0x93, 0xef, 0x35, 0xff, 0x0b, 0x3a, 0xea, 0x99, 0x49, 0x4b, 0x16, 0xb1, 0xbd,
-	0x72, 0x90, 0xec, 0x8c, 0x8b, 0x50, 0xfc, 0x08, 0xaa, 0x93, 0x90, 0x45, 0x2c, 0xc9, 0x00, 0x5a,
-	0xa8, 0xd3, 0xb8, 0x7f, 0xb0, 0xd2, 0xe9, 0xee, 0xf3, 0x14, 0xa1, 0xee, 0x32, 0xc3, 0x93, 0x7c,
-	0x85, 0x6f, 0x42, 0x95, 0xf0, 0xa9, 0xa0, 0x7d, 0x9f, 0x99, 0xb5, 0x16, 0xea, 0x54, 0x9f, 0x18,
-	0x24, 0xb7, 0xe0, 0x1e, 0xec, 0xd2, 0x53, 0xea, 0xf9, 0xb4, 0xef, 0xf9, 0x9e, 0x98, 0x39, 0xaf,
-	0x78, 0xc0, 0xcc, 0xba, 0x6c, 0xdc, 0xde, 0x22, 0xb6, 0x57, 0x9d, 0xa4, 0xa9, 0x9b, 0x7e, 0xe2,
-	0x01, 0xeb, 0x5d, 0x83, 0x5d, 0x3e, 0x49, 0x92, 0xa6, 0xbe, 0x13, 0xa6, 0xc4, 0xed, 0xbf, 0x36,
-	0xd6, 0x0d, 0xb0, 0xfe, 0x44, 0x9f, 0x40, 0x43, 0x9b, 0xa9, 0x13, 0x36, 0x4b, 0x87, 0xf8, 0xfa,
-	0x4a, 0x91, 0x4f, 0xd9, 0x6c, 0x69, 0x94, 0xea, 0xf9, 0x28, 0x3d, 0x65, 0x33, 0x4c, 0xe1, 0x86,
-	0xc6, 0xe4, 0xa5, 0xc1, 0x24, 0xa5, 0x7a, 0xce, 0x37, 0x57, 0x28, 0xb3, 0x8c, 0x56, 0xa9, 0xaf,
-	0xe7, 0xd4, 0x1a, 0x06, 0xdf, 0xc9, 0xf5, 0x44, 0xcd, 0xfc, 0xde, 0x1a, 0xc6, 0x21, 0xcf, 0xe5,
-	0xe4, 0x8b, 0x4c, 0x4e, 0x4a, 0x97, 0xa1, 0x15, 0xa6, 0xf0, 0x2e, 0xcb, 0x97, 0xbd, 0xcb, 0x75,
-	0x9a, 0x50, 0x90, 0x9e, 0x2b, 0xd4, 0x84, 0x53, 0xd8, 0x7f, 0xa4, 0xbe, 0x00, 0xcb, 0x4a, 0xfe,
-	0x00, 0x6a, 0xda, 0xb7, 0x21, 0x8d, 0x8a, 0xb3, 0xa8, 0xef, 0x0e, 0x11, 0x48, 0x61, 0x1f, 0x14,
-	0xf7, 0x37, 0x54, 0x08, 0xac, 0x0f, 0xd0, 0xe7, 0x4b, 0x1a, 0xbf, 0x2e, 0x66, 0x76, 0x21, 0x9d,
-	0xa2, 0xbe, 0xaf, 0x83, 0xae, 0xb9, 0x8d, 0xcd, 0xff, 0xdc, 0x91, 0xc2, 0x35, 0x5c, 0x6d, 0x47,
-	0xfe, 0xd8, 0x28, 0x7c, 0x53, 0x69, 0x74, 0xfc, 0x51, 0xbe, 0xa8, 0x25, 0xed, 0xdf, 0xfc, 0x70,
-	0xed, 0x2f, 0xad, 0xd7, 0x7e, 0xa9, 0xd8, 0xe5, 0xf5, 0x8a, 0xdd, 0xfe, 0x0a, 0x1a, 0xb2, 0x57,
-	0x51, 0x6f, 0x76, 0xc8, 0x7c, 0xff, 0x68, 0x80, 0x6f, 0xc1, 0x96, 0xcb, 0x7c, 0x3f, 0x69, 0x3b,
-	0x92, 0x6d, 0xaf, 0x2d, 0x62, 0x3b, 0x33, 0x91, 0x8a, 0x2b, 0x51, 0xed, 0x87, 0xd0, 0x7c, 0x41,
-	0xa3, 0x93, 0xc2, 0xe0, 0xb7, 0xa0, 0x94, 0xfc, 0x03, 0x4a, 0x9b, 0x5c, 0xcf, 0x3a, 0x92, 0xe0,
-	0x88, 0xf4, 0xb4, 0x5f, 0xa6, 0xa7, 0xf4, 0xa9, 0xbd, 0xb5, 0x34, 0xb5, 0xc5, 0x73, 0xd9, 0xbc,
-	0xb6, 0x8b, 0xf3, 0x5a, 0x04, 0x29, 0x57, 0x96, 0x53, 0x61, 0xf4, 0xde, 0x9b, 0x53, 0xef, 0xe1,
-	0xd9, 0xb9, 0x65, 0xbc, 0x39, 0xb7, 0x8c, 0xb7, 0xe7, 0x16, 0xfa, 0x65, 0x6e, 0xa1, 0xdf, 0xe7,
-	0x16, 0x7a, 0x3d, 0xb7, 0xd0, 0xd9, 0xdc, 0x42, 0x7f, 0xcf, 0x2d, 0xf4, 0xcf, 0xdc, 0x32, 0xde,
-	0xce, 0x2d, 0xf4, 0xeb, 0x85, 0x65, 0x9c, 0x5d, 0x58, 0xc6, 0x9b, 0x0b, 0xcb, 0xe8, 0x57, 0xe4,
-	0xdf, 0xb9, 0x07, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x15, 0xfc, 0xcc, 0x44, 0x5e, 0x0a, 0x00,
-	0x00,
+	// 1108 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x16, 0x6d, 0x4b, 0x91, 0x47, 0x8a, 0x2c, 0xad, 0x63, 0x9b, 0x70, 0x53, 0x52, 0x15, 0x02,
+	0x44, 0x68, 0x1b, 0x25, 0x88, 0x83, 0x1c, 0xda, 0x4b, 0x2d, 0xdb, 0x75, 0x8c, 0xa4, 0x45, 0xb0,
+	0x49, 0x2f, 0x45, 0x0a, 0x62, 0x45, 0xae, 0x24, 0xc2, 0x14, 0x57, 0x20, 0x97, 0x46, 0x94, 0x53,
+	0x1f, 0xa1, 0xb7, 0xbe, 0x42, 0x9f, 0xa1, 0xe7, 0x16, 0xc8, 0xd1, 0x45, 0x2f, 0x39, 0x11, 0xb5,
+	0x7c, 0x29, 0x78, 0xca, 0x23, 0x14, 0x5c, 0xfe, 0x98, 0x94, 0x04, 0xc7, 0x01, 0x9a, 0x43, 0x81,
+	0x9e, 0xb8, 0xfb, 0xcd, 0x37, 0x33, 0x3b, 0xb3, 0x3b, 0x33, 0x12, 0x54, 0xe9, 0x09, 0xb5, 0xb9,
+	0xdb, 0x19, 0x3b, 0x8c, 0x33, 0x54, 0x1a, 0x31, 0x83, 0x5a, 0xee, 0xf6, 0x9d, 0x81, 0xc9, 0x87,
+	0x5e, 0xaf, 0xa3, 0xb3, 0xd1, 0xdd, 0x01, 0x1b, 0xb0, 0xbb, 0x42, 0xdc, 0xf3, 0xfa, 0x62, 0x27,
+	0x36, 0x62, 0x15, 0xa9, 0x6d, 0xd7, 0x89, 0xce, 0x3d, 0x62, 0x69, 0x96, 0x33, 0x8e, 0x91, 0x86,
+	0x41, 0x5d, 0xd3, 0xa1, 0x46, 0x06, 0x02, 0x4e, 0xdc, 0xe3, 0x78, 0xbd, 0x39, 0x62, 0x86, 0xd9,
+	0x37, 0x75, 0xc2, 0x4d, 0x66, 0x6b, 0x9c, 0x0c, 0x22, 0xbc, 0xf5, 0x03, 0x6c, 0xec, 0x0a, 0x53,
+	0x4f, 0xf0, 0xd3, 0x3d, 0x87, 0x12, 0x4e, 0x8d, 0x83, 0xf0, 0x7c, 0xe8, 0x2b, 0xc8, 0xf8, 0xd0,
+	0x06, 0x0e, 0xf3, 0xc6, 0xb2, 0xd4, 0x94, 0xda, 0x95, 0xfb, 0x9b, 0x9d, 0xe8, 0xcc, 0x9d, 0x54,
+	0xf1, 0x30, 0x94, 0xe2, 0x5a, 0xc4, 0x7f, 0xe2, 0x8c, 0xc5, 0xfe, 0x8b, 0x25, 0x59, 0x6a, 0x4d,
+	0xb2, 0xe6, 0x87, 0xc4, 0x1e, 0x24, 0xe6, 0x3b, 0x50, 0xea, 0xd1, 0x3e, 0x73, 0xe8, 0x3b, 0x8c,
+	0xc6, 0x2c, 0xf4, 0x39, 0x14, 0x49, 0x9f, 0x53, 0x47, 0x5e, 0xba, 0x94, 0x1e, 0x91, 0x84, 0xeb,
+	0x6c, 0x64, 0x98, 0x8e, 0xd8, 0xc9, 0xbf, 0x1b, 0xd9, 0x2b, 0xf8, 0x38, 0x65, 0x1d, 0xd9, 0x2e,
+	0x27, 0xb6, 0x4e, 0x73, 0x09, 0xbc, 0x07, 0x70, 0xe1, 0x26, 0x76, 0xd0, 0x98, 0x73, 0x80, 0x57,
+	0x53, 0xdb, 0xe8, 0x36, 0x94, 0xb9, 0x43, 0x74, 0xaa, 0x99, 0x86, 0x08, 0x73, 0xb5, 0x5b, 0x0d,
+	0x7c, 0x35, 0xc5, 0xf0, 0x35, 0xb1, 0x3a, 0x32, 0x5a, 0xbf, 0x17, 0xe1, 0x7a, 0xc6, 0x79, 0x9f,
+	0xa1, 0xef, 0x60, 0x3d, 0x13, 0x93, 0x4d, 0xb9, 0x66, 0xda, 0x7d, 0x26, 0x2f, 0x0b, 0xaf, 0xf2,
+	0x9c, 0xd7, 0x6f, 0x29, 0x0f, 0xd5, 0xba, 0xd5, 0xd7, 0xbe, 0x5a, 0x38, 0xf5, 0x55, 0x29, 0xf0,
+	0xd5, 0x02, 0xae, 0xa7, 0x47, 0x89, 0xe5, 0xe8, 0x1e, 0x54, 0x74, 0x87, 0xb8, 0x43, 0x4d, 0x67,
+	0x9e, 0xcd, 0xe5, 0x95, 0xa6, 0xd4, 0x2e, 0x76, 0xd7, 0x02, 0x5f, 0xcd, 0xc2, 0x18, 0xc4, 0x66,
+	0x2f, 0x5c, 0xa3, 0x4f, 0xa0, 0x1a, 0x89, 0x1c, 0x4a, 0x5c, 0x66, 0xcb, 0xc5, 0x30, 0x0e, 0x1c,
+	0xd1, 0xb1, 0x80, 0x90, 0x0a, 0x45, 0x97, 0x13, 0x4e, 0xe5, 0x92, 0x88, 0x71, 0x35, 0xf0, 0xd5,
+	0x08, 0xc0, 0xd1, 0x07, 0xdd, 0x86, 0xb5, 0xb1, 0x45, 0x74, 0x3a, 0xa2, 0x36, 0xd7, 0xa8, 0xe3,
+	0x30, 0x47, 0xbe, 0x26, 0xcc, 0xd4, 0x52, 0xf8, 0x20, 0x44, 0x85, 0x25, 0xd3, 0xd6, 0xa9, 0x5c,
+	0x6e, 0x4a, 0xed, 0xe5, 0xd8, 0x52, 0x08, 0xe0, 0xe8, 0x83, 0x5e, 0x40, 0x7d, 0xf6, 0xdd, 0xcb,
+	0xab, 0x22, 0x27, 0x5b, 0x49, 0x4e, 0xbe, 0xc9, 0xc8, 0x9f, 0x93, 0x41, 0x57, 0x0e, 0x53, 0x12,
+	0xf8, 0xea, 0x9c, 0x22, 0x5e, 0x1b, 0xe5, 0xa9, 0x68, 0x1f, 0xca, 0x63, 0x87, 0xba, 0x34, 0x3c,
+	0x01, 0x34, 0xa5, 0x76, 0xed, 0xfe, 0xf6, 0x5c, 0xa6, 0x3b, 0x4f, 0x63, 0x46, 0x74, 0x97, 0x09,
+	0x1f, 0xa7, 0x2b, 0x74, 0x13, 0xca, 0x98, 0x79, 0x9c, 0xf4, 0x2c, 0x2a, 0x57, 0x9a, 0x52, 0xbb,
+	0xfc, 0xa8, 0x80, 0x53, 0x04, 0x75, 0xa1, 0x41, 0x4e, 0x88, 0x69, 0x91, 0x9e, 0x69, 0x99, 0x7c,
+	0xa2, 0xbd, 0x62, 0x36, 0x95, 0xab, 0x22, 0x71, 0x1b, 0x81, 0xaf, 0xce, 0x0b, 0x71, 0x3d, 0x0b,
+	0x7d, 0xcf, 0x6c, 0x8a, 0xbe, 0x84, 0x35, 0x9b, 0xbe, 0xe4, 0x9a, 0x43, 0x5d, 0x4e, 0x1c, 0xae,
+	0x11, 0x2e, 0x5f, 0x17, 0x09, 0x5b, 0x0f, 0x7c, 0x75, 0x56, 0x84, 0xaf, 0x87, 0x00, 0x8e, 0xf6,
+	0xbb, 0x1c, 0x1d, 0x00, 0x8a, 0x85, 0xae, 0x46, 0x5f, 0x0e, 0x89, 0xe7, 0x72, 0x6a, 0xc8, 0xb5,
+	0xf0, 0xa0, 0xdd, 0xcd, 0xc0, 0x57, 0x17, 0x48, 0x71, 0x23, 0xc1, 0x0e, 0x12, 0xa8, 0xbb, 0x0e,
+	0x0d, 0x36, 0x0e, 0x13, 0x47, 0x2c, 0xcd, 0x89, 0x83, 0x6b, 0xfd, 0xb1, 0xb4, 0xa8, 0x88, 0xb2,
+	0x6d, 0xe2, 0x11, 0xd4, 0x32, 0xef, 0xfa, 0x98, 0x4e, 0xe2, 0x42, 0xba, 0x31, 0x97, 0xe8, 0xc7,
+	0x74, 0x32, 0xf3, 0x9c, 0xab, 0xe9, 0x73, 0x7e, 0x4c, 0x27, 0x88, 0xc0, 0x56, 0xc6, 0x92, 0x19,
+	0x3b, 0x13, 0x26, 0xa3, 0x96, 0x72, 0x73, 0xce, 0x64, 0x72, 0xa2, 0x79, 0xd3, 0x37, 0x52, 0xd3,
+	0x19, 0x0e, 0xba, 0x93, 0xf6, 0xb4, 0xa8, 0xee, 0x36, 0x16, 0x58, 0xec, 0xb3, 0xb4, 0xa5, 0x7d,
+	0x96, 0xb4, 0xb4, 0x95, 0xcb, 0xd8, 0x11, 0x27, 0xd7, 0x1b, 0x8a, 0x97, 0xf5, 0x86, 0x45, 0x7d,
+	0x29, 0xd7, 0xfe, 0x3e, 0x60, 0x5f, 0x3a, 0x81, 0xcd, 0xfd, 0x68, 0x0a, 0xcd, 0x4e, 0x93, 0x1d,
+	0xa8, 0x64, 0xe6, 0x53, 0xec, 0x15, 0x25, 0x5e, 0x2f, 0x94, 0x30, 0xc4, 0xb4, 0xf7, 0xf2, 0xfb,
+	0xb3, 0x94, 0x73, 0x9c, 0x7d, 0x40, 0x9f, 0xce, 0xcc, 0x99, 0x45, 0x3e, 0x93, 0x0b, 0x69, 0xe7,
+	0x67, 0xcc, 0x22, 0xea, 0x82, 0xdb, 0x58, 0xbe, 0x72, 0x46, 0x72, 0xd7, 0xf0, 0x61, 0x33, 0xf2,
+	0xeb, 0x52, 0x6e, 0xae, 0x13, 0x77, 0xf8, 0x9f, 0xac, 0xa8, 0x99, 0xf9, 0xb3, 0xfc, 0xfe, 0xf3,
+	0x67, 0x65, 0xf1, 0xfc, 0x11, 0x53, 0xa3, 0xb8, 0x78, 0x6a, 0xb4, 0x7e, 0x5b, 0x02, 0x35, 0xf3,
+	0xd3, 0x61, 0xa6, 0x95, 0xfd, 0x9f, 0xc6, 0x2b, 0xa6, 0xf1, 0x21, 0xd4, 0x44, 0xae, 0xdc, 0xee,
+	0x64, 0x8f, 0x5a, 0xd6, 0x91, 0x81, 0x6e, 0xc1, 0x35, 0x9d, 0x5a, 0x56, 0xf8, 0x7a, 0x25, 0xf1,
+	0x7a, 0x2b, 0x81, 0xaf, 0x26, 0x10, 0x2e, 0xe9, 0x82, 0xd5, 0x7a, 0x00, 0xf5, 0xe7, 0xc4, 0x3d,
+	0xce, 0xf5, 0x8f, 0x26, 0xac, 0x84, 0x3f, 0x66, 0xe3, 0x24, 0x57, 0x93, 0x8c, 0x84, 0x3c, 0x2c,
+	0x24, 0xad, 0x17, 0xb1, 0x56, 0xb6, 0xf8, 0x6f, 0xcd, 0x14, 0x7f, 0x5e, 0x2f, 0x29, 0xfb, 0x56,
+	0xbe, 0xec, 0xf3, 0xa4, 0x48, 0x94, 0x9c, 0x29, 0x57, 0xc1, 0xef, 0x3e, 0xd3, 0x9f, 0x12, 0x6c,
+	0x3c, 0xd3, 0x87, 0xd4, 0xf0, 0x2c, 0x6a, 0x08, 0xdc, 0xb3, 0x23, 0xdd, 0x43, 0x58, 0x77, 0x13,
+	0x81, 0x16, 0x72, 0xb5, 0x81, 0x97, 0x66, 0x65, 0x2b, 0xf0, 0xd5, 0x45, 0x62, 0xdc, 0x70, 0xb3,
+	0xc6, 0x0e, 0x3d, 0xd3, 0x40, 0x1f, 0xc1, 0xea, 0x85, 0xba, 0x68, 0x09, 0xb8, 0xcc, 0x13, 0xe1,
+	0x0e, 0x54, 0x2f, 0xcc, 0x90, 0xe8, 0xe2, 0x97, 0xbb, 0xf5, 0xc0, 0x57, 0x73, 0x38, 0xae, 0xa4,
+	0xbb, 0x5d, 0x8e, 0x54, 0xa8, 0xb8, 0xc7, 0xe6, 0x38, 0x7f, 0xf3, 0x10, 0x42, 0xd1, 0xc5, 0xb7,
+	0x1e, 0x42, 0x7d, 0x9f, 0x8d, 0x88, 0x69, 0x7f, 0xed, 0x50, 0x77, 0x18, 0xc5, 0xd3, 0x82, 0x92,
+	0x21, 0xb0, 0x38, 0x04, 0x08, 0x7c, 0x35, 0x46, 0x70, 0xfc, 0xbd, 0xd0, 0x7b, 0xc6, 0x89, 0x45,
+	0xaf, 0xac, 0xd7, 0x7d, 0x70, 0x7a, 0xa6, 0x14, 0xde, 0x9c, 0x29, 0x85, 0xb7, 0x67, 0x8a, 0xf4,
+	0xe3, 0x54, 0x91, 0x7e, 0x99, 0x2a, 0xd2, 0xeb, 0xa9, 0x22, 0x9d, 0x4e, 0x15, 0xe9, 0xaf, 0xa9,
+	0x22, 0xfd, 0x3d, 0x55, 0x0a, 0x6f, 0xa7, 0x8a, 0xf4, 0xd3, 0xb9, 0x52, 0x38, 0x3d, 0x57, 0x0a,
+	0x6f, 0xce, 0x95, 0x42, 0xaf, 0x24, 0xfe, 0xdf, 0xec, 0xfc, 0x33, 0x00, 0x97, 0xec, 0x7f, 0xf1,
+	0x6f, 0x0d, 0x00, 0x00,
 }
 
 func (this *ActualLRPCreatedEvent) Equal(that interface{}) bool {
@@ -1106,6 +1352,12 @@ func (this *ActualLRPInfo) Equal(that interface{}) bool {
 	if this.AvailabilityZone != that1.AvailabilityZone {
 		return false
 	}
+	if this.NextRestartAt != that1.NextRestartAt {
+		return false
+	}
+	if this.RestartsExhausted != that1.RestartsExhausted {
+		return false
+	}
 	return true
 }
 func (this *ActualLRPInfo_Routable) Equal(that interface{}) bool {
@@ -1315,6 +1567,42 @@ func (this *ActualLRPCrashedEvent) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ActualLRPRestartsExhaustedEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ActualLRPRestartsExhaustedEvent)
+	if !ok {
+		that2, ok := that.(ActualLRPRestartsExhaustedEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ActualLRPKey.Equal(&that1.ActualLRPKey) {
+		return false
+	}
+	if !this.ActualLRPInstanceKey.Equal(&that1.ActualLRPInstanceKey) {
+		return false
+	}
+	if this.CrashCount != that1.CrashCount {
+		return false
+	}
+	if this.CrashReason != that1.CrashReason {
+		return false
+	}
+	if this.Since != that1.Since {
+		return false
+	}
+	return true
+}
 func (this *EventsByCellId) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1414,63 +1702,144 @@ func (this *TaskRemovedEvent) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *ActualLRPCreatedEvent) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *ScheduledTaskRunEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&models.ActualLRPCreatedEvent{")
-	if this.ActualLrpGroup != nil {
-		s = append(s, "ActualLrpGroup: "+fmt.Sprintf("%#v", this.ActualLrpGroup)+",\n")
+
+	that1, ok := that.(*ScheduledTaskRunEvent)
+	if !ok {
+		that2, ok := that.(ScheduledTaskRunEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ActualLRPChangedEvent) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&models.ActualLRPChangedEvent{")
-	if this.Before != nil {
-		s = append(s, "Before: "+fmt.Sprintf("%#v", this.Before)+",\n")
+	if this.ScheduledTaskGuid != that1.ScheduledTaskGuid {
+		return false
 	}
-	if this.After != nil {
-		s = append(s, "After: "+fmt.Sprintf("%#v", this.After)+",\n")
+	if this.TaskGuid != that1.TaskGuid {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ActualLRPRemovedEvent) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.ScheduledAt != that1.ScheduledAt {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&models.ActualLRPRemovedEvent{")
-	if this.ActualLrpGroup != nil {
-		s = append(s, "ActualLrpGroup: "+fmt.Sprintf("%#v", this.ActualLrpGroup)+",\n")
+	if this.SkipReason != that1.SkipReason {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *ActualLRPInstanceCreatedEvent) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 6)
-	s = append(s, "&models.ActualLRPInstanceCreatedEvent{")
-	if this.ActualLrp != nil {
-		s = append(s, "ActualLrp: "+fmt.Sprintf("%#v", this.ActualLrp)+",\n")
+func (this *DomainFreshEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s = append(s, "TraceId: "+fmt.Sprintf("%#v", this.TraceId)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ActualLRPInfo) GoString() string {
+
+	that1, ok := that.(*DomainFreshEvent)
+	if !ok {
+		that2, ok := that.(DomainFreshEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Domain != that1.Domain {
+		return false
+	}
+	return true
+}
+func (this *DomainStaleEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DomainStaleEvent)
+	if !ok {
+		that2, ok := that.(DomainStaleEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Domain != that1.Domain {
+		return false
+	}
+	return true
+}
+func (this *ActualLRPCreatedEvent) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.ActualLRPCreatedEvent{")
+	if this.ActualLrpGroup != nil {
+		s = append(s, "ActualLrpGroup: "+fmt.Sprintf("%#v", this.ActualLrpGroup)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ActualLRPChangedEvent) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.ActualLRPChangedEvent{")
+	if this.Before != nil {
+		s = append(s, "Before: "+fmt.Sprintf("%#v", this.Before)+",\n")
+	}
+	if this.After != nil {
+		s = append(s, "After: "+fmt.Sprintf("%#v", this.After)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ActualLRPRemovedEvent) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.ActualLRPRemovedEvent{")
+	if this.ActualLrpGroup != nil {
+		s = append(s, "ActualLrpGroup: "+fmt.Sprintf("%#v", this.ActualLrpGroup)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ActualLRPInstanceCreatedEvent) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.ActualLRPInstanceCreatedEvent{")
+	if this.ActualLrp != nil {
+		s = append(s, "ActualLrp: "+fmt.Sprintf("%#v", this.ActualLrp)+",\n")
+	}
+	s = append(s, "TraceId: "+fmt.Sprintf("%#v", this.TraceId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ActualLRPInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 14)
+	s := make([]string, 0, 16)
 	s = append(s, "&models.ActualLRPInfo{")
 	s = append(s, "ActualLRPNetInfo: "+strings.Replace(this.ActualLRPNetInfo.GoString(), `&`, ``, 1)+",\n")
 	s = append(s, "CrashCount: "+fmt.Sprintf("%#v", this.CrashCount)+",\n")
@@ -1484,6 +1853,8 @@ func (this *ActualLRPInfo) GoString() string {
 		s = append(s, "OptionalRoutable: "+fmt.Sprintf("%#v", this.OptionalRoutable)+",\n")
 	}
 	s = append(s, "AvailabilityZone: "+fmt.Sprintf("%#v", this.AvailabilityZone)+",\n")
+	s = append(s, "NextRestartAt: "+fmt.Sprintf("%#v", this.NextRestartAt)+",\n")
+	s = append(s, "RestartsExhausted: "+fmt.Sprintf("%#v", this.RestartsExhausted)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1582,6 +1953,20 @@ func (this *ActualLRPCrashedEvent) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *ActualLRPRestartsExhaustedEvent) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&models.ActualLRPRestartsExhaustedEvent{")
+	s = append(s, "ActualLRPKey: "+strings.Replace(this.ActualLRPKey.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "ActualLRPInstanceKey: "+strings.Replace(this.ActualLRPInstanceKey.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "CrashCount: "+fmt.Sprintf("%#v", this.CrashCount)+",\n")
+	s = append(s, "CrashReason: "+fmt.Sprintf("%#v", this.CrashReason)+",\n")
+	s = append(s, "Since: "+fmt.Sprintf("%#v", this.Since)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *EventsByCellId) GoString() string {
 	if this == nil {
 		return "nil"
@@ -1631,6 +2016,39 @@ func (this *TaskRemovedEvent) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *ScheduledTaskRunEvent) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&models.ScheduledTaskRunEvent{")
+	s = append(s, "ScheduledTaskGuid: "+fmt.Sprintf("%#v", this.ScheduledTaskGuid)+",\n")
+	s = append(s, "TaskGuid: "+fmt.Sprintf("%#v", this.TaskGuid)+",\n")
+	s = append(s, "ScheduledAt: "+fmt.Sprintf("%#v", this.ScheduledAt)+",\n")
+	s = append(s, "SkipReason: "+fmt.Sprintf("%#v", this.SkipReason)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DomainFreshEvent) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.DomainFreshEvent{")
+	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DomainStaleEvent) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.DomainStaleEvent{")
+	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringEvents(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -1818,6 +2236,21 @@ func (m *ActualLRPInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RestartsExhausted {
+		i--
+		if m.RestartsExhausted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.NextRestartAt != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NextRestartAt))
+		i--
+		dAtA[i] = 0x68
+	}
 	if len(m.AvailabilityZone) > 0 {
 		i -= len(m.AvailabilityZone)
 		copy(dAtA[i:], m.AvailabilityZone)
@@ -2224,6 +2657,66 @@ func (m *ActualLRPCrashedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ActualLRPRestartsExhaustedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActualLRPRestartsExhaustedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActualLRPRestartsExhaustedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Since != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Since))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.CrashReason) > 0 {
+		i -= len(m.CrashReason)
+		copy(dAtA[i:], m.CrashReason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CrashReason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CrashCount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CrashCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.ActualLRPInstanceKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ActualLRPKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *EventsByCellId) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2371,63 +2864,172 @@ func (m *TaskRemovedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ScheduledTaskRunEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ActualLRPCreatedEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ActualLrpGroup != nil {
-		l = m.ActualLrpGroup.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
+
+func (m *ScheduledTaskRunEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ActualLRPChangedEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ScheduledTaskRunEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Before != nil {
-		l = m.Before.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.SkipReason) > 0 {
+		i -= len(m.SkipReason)
+		copy(dAtA[i:], m.SkipReason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SkipReason)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.After != nil {
-		l = m.After.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if m.ScheduledAt != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ScheduledAt))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
-}
-
-func (m *ActualLRPRemovedEvent) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.TaskGuid) > 0 {
+		i -= len(m.TaskGuid)
+		copy(dAtA[i:], m.TaskGuid)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TaskGuid)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	if m.ActualLrpGroup != nil {
-		l = m.ActualLrpGroup.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ScheduledTaskGuid) > 0 {
+		i -= len(m.ScheduledTaskGuid)
+		copy(dAtA[i:], m.ScheduledTaskGuid)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScheduledTaskGuid)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ActualLRPInstanceCreatedEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DomainFreshEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DomainFreshEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DomainFreshEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DomainStaleEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DomainStaleEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DomainStaleEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ActualLRPCreatedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActualLrpGroup != nil {
+		l = m.ActualLrpGroup.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *ActualLRPChangedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Before != nil {
+		l = m.Before.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.After != nil {
+		l = m.After.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *ActualLRPRemovedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActualLrpGroup != nil {
+		l = m.ActualLrpGroup.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *ActualLRPInstanceCreatedEvent) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -2480,6 +3082,12 @@ func (m *ActualLRPInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if m.NextRestartAt != 0 {
+		n += 1 + sovEvents(uint64(m.NextRestartAt))
+	}
+	if m.RestartsExhausted {
+		n += 2
+	}
 	return n
 }
 
@@ -2612,6 +3220,29 @@ func (m *ActualLRPCrashedEvent) Size() (n int) {
 	return n
 }
 
+func (m *ActualLRPRestartsExhaustedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ActualLRPKey.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.ActualLRPInstanceKey.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.CrashCount != 0 {
+		n += 1 + sovEvents(uint64(m.CrashCount))
+	}
+	l = len(m.CrashReason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Since != 0 {
+		n += 1 + sovEvents(uint64(m.Since))
+	}
+	return n
+}
+
 func (m *EventsByCellId) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2668,6 +3299,56 @@ func (m *TaskRemovedEvent) Size() (n int) {
 	return n
 }
 
+func (m *ScheduledTaskRunEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScheduledTaskGuid)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.TaskGuid)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ScheduledAt != 0 {
+		n += 1 + sovEvents(uint64(m.ScheduledAt))
+	}
+	l = len(m.SkipReason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *DomainFreshEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *DomainStaleEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2731,6 +3412,8 @@ func (this *ActualLRPInfo) String() string {
 		`Presence:` + fmt.Sprintf("%v", this.Presence) + `,`,
 		`OptionalRoutable:` + fmt.Sprintf("%v", this.OptionalRoutable) + `,`,
 		`AvailabilityZone:` + fmt.Sprintf("%v", this.AvailabilityZone) + `,`,
+		`NextRestartAt:` + fmt.Sprintf("%v", this.NextRestartAt) + `,`,
+		`RestartsExhausted:` + fmt.Sprintf("%v", this.RestartsExhausted) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2818,6 +3501,20 @@ func (this *ActualLRPCrashedEvent) String() string {
 	}, "")
 	return s
 }
+func (this *ActualLRPRestartsExhaustedEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ActualLRPRestartsExhaustedEvent{`,
+		`ActualLRPKey:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ActualLRPKey), "ActualLRPKey", "ActualLRPKey", 1), `&`, ``, 1) + `,`,
+		`ActualLRPInstanceKey:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ActualLRPInstanceKey), "ActualLRPInstanceKey", "ActualLRPInstanceKey", 1), `&`, ``, 1) + `,`,
+		`CrashCount:` + fmt.Sprintf("%v", this.CrashCount) + `,`,
+		`CrashReason:` + fmt.Sprintf("%v", this.CrashReason) + `,`,
+		`Since:` + fmt.Sprintf("%v", this.Since) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *EventsByCellId) String() string {
 	if this == nil {
 		return "nil"
@@ -2859,6 +3556,39 @@ func (this *TaskRemovedEvent) String() string {
 	}, "")
 	return s
 }
+func (this *ScheduledTaskRunEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScheduledTaskRunEvent{`,
+		`ScheduledTaskGuid:` + fmt.Sprintf("%v", this.ScheduledTaskGuid) + `,`,
+		`TaskGuid:` + fmt.Sprintf("%v", this.TaskGuid) + `,`,
+		`ScheduledAt:` + fmt.Sprintf("%v", this.ScheduledAt) + `,`,
+		`SkipReason:` + fmt.Sprintf("%v", this.SkipReason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DomainFreshEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DomainFreshEvent{`,
+		`Domain:` + fmt.Sprintf("%v", this.Domain) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DomainStaleEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DomainStaleEvent{`,
+		`Domain:` + fmt.Sprintf("%v", this.Domain) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringEvents(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -3580,6 +4310,45 @@ func (m *ActualLRPInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.AvailabilityZone = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRestartAt", wireType)
+			}
+			m.NextRestartAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRestartAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartsExhausted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RestartsExhausted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -4515,7 +5284,7 @@ func (m *ActualLRPCrashedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventsByCellId) Unmarshal(dAtA []byte) error {
+func (m *ActualLRPRestartsExhaustedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4538,17 +5307,17 @@ func (m *EventsByCellId) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventsByCellId: wiretype end group for non-group")
+			return fmt.Errorf("proto: ActualLRPRestartsExhaustedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventsByCellId: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ActualLRPRestartsExhaustedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CellId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualLRPKey", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4558,35 +5327,221 @@ func (m *EventsByCellId) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CellId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.ActualLRPKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualLRPInstanceKey", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ActualLRPInstanceKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrashCount", wireType)
+			}
+			m.CrashCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CrashCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrashReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CrashReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			m.Since = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Since |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventsByCellId) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventsByCellId: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventsByCellId: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -4891,6 +5846,335 @@ func (m *TaskRemovedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ScheduledTaskRunEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledTaskRunEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledTaskRunEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTaskGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledTaskGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledAt", wireType)
+			}
+			m.ScheduledAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SkipReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DomainFreshEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DomainFreshEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DomainFreshEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DomainStaleEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DomainStaleEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DomainStaleEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
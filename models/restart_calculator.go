@@ -51,8 +51,31 @@ func NewRestartCalculator(immediateRestarts int32, maxBackoffDuration time.Durat
 	}
 }
 
+// RestartCalculator returns the calculator for the policy, or the default
+// one if there is no policy.
+func (p *RestartPolicy) RestartCalculator() RestartCalculator {
+	if p == nil {
+		return NewDefaultRestartCalculator()
+	}
+	return NewRestartCalculator(p.ImmediateRestarts, time.Duration(p.MaxBackoffDurationMs)*time.Millisecond, p.MaxRestarts)
+}
+
+func (p RestartPolicy) Validate() error {
+	return p.RestartCalculator().Validate()
+}
+
 func (r RestartCalculator) Validate() error {
 	var validationError ValidationError
+	if r.ImmediateRestarts < 0 {
+		err := fmt.Errorf("ImmediateRestarts '%d' must not be negative", r.ImmediateRestarts)
+		validationError = validationError.Append(err)
+	}
+
+	if r.MaxRestartAttempts < 0 {
+		err := fmt.Errorf("MaxRestartAttempts '%d' must not be negative", r.MaxRestartAttempts)
+		validationError = validationError.Append(err)
+	}
+
 	if r.MaxBackoffDuration < CrashBackoffMinDuration {
 		err := fmt.Errorf("MaxBackoffDuration '%s' must be larger than CrashBackoffMinDuration '%s'", r.MaxBackoffDuration, CrashBackoffMinDuration)
 		validationError = validationError.Append(err)
@@ -66,20 +89,29 @@ func (r RestartCalculator) Validate() error {
 }
 
 func (r RestartCalculator) ShouldRestart(now, crashedAt int64, crashCount int32) bool {
+	if crashCount < r.ImmediateRestarts {
+		return true
+	}
+
+	nextRestartTime, ok := r.NextRestartTime(crashedAt, crashCount)
+	return ok && nextRestartTime <= now
+}
+
+// NextRestartTime returns the time at which an instance that crashed at
+// crashedAt for the crashCount-th time is restarted, or false if it has
+// exhausted its restarts.
+func (r RestartCalculator) NextRestartTime(crashedAt int64, crashCount int32) (int64, bool) {
 	switch {
 	case crashCount < r.ImmediateRestarts:
-		return true
+		return crashedAt, true
 
 	case crashCount < r.MaxRestartAttempts:
 		backoffDuration := exponentialBackoff(crashCount-r.ImmediateRestarts, r.MaxBackoffCount)
 		if backoffDuration > r.MaxBackoffDuration {
 			backoffDuration = r.MaxBackoffDuration
 		}
-		nextRestartTime := crashedAt + backoffDuration.Nanoseconds()
-		if nextRestartTime <= now {
-			return true
-		}
+		return crashedAt + backoffDuration.Nanoseconds(), true
 	}
 
-	return false
+	return 0, false
 }
@@ -0,0 +1,447 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: restart_policy.proto
+
+package models
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type RestartPolicy struct {
+	ImmediateRestarts    int32 `protobuf:"varint,1,opt,name=immediate_restarts,json=immediateRestarts,proto3" json:"immediate_restarts"`
+	MaxBackoffDurationMs int64 `protobuf:"varint,2,opt,name=max_backoff_duration_ms,json=maxBackoffDurationMs,proto3" json:"max_backoff_duration_ms"`
+	MaxRestarts          int32 `protobuf:"varint,3,opt,name=max_restarts,json=maxRestarts,proto3" json:"max_restarts"`
+}
+
+func (m *RestartPolicy) Reset()      { *m = RestartPolicy{} }
+func (*RestartPolicy) ProtoMessage() {}
+func (*RestartPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ad66dec6121da36, []int{0}
+}
+func (m *RestartPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestartPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestartPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RestartPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestartPolicy.Merge(m, src)
+}
+func (m *RestartPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestartPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestartPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestartPolicy proto.InternalMessageInfo
+
+func (m *RestartPolicy) GetImmediateRestarts() int32 {
+	if m != nil {
+		return m.ImmediateRestarts
+	}
+	return 0
+}
+
+func (m *RestartPolicy) GetMaxBackoffDurationMs() int64 {
+	if m != nil {
+		return m.MaxBackoffDurationMs
+	}
+	return 0
+}
+
+func (m *RestartPolicy) GetMaxRestarts() int32 {
+	if m != nil {
+		return m.MaxRestarts
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*RestartPolicy)(nil), "models.RestartPolicy")
+}
+
+func init() { proto.RegisterFile("restart_policy.proto", fileDescriptor_0ad66dec6121da36) }
+
+var fileDescriptor_0ad66dec6121da36 = []byte{
+	// 271 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x29, 0x4a, 0x2d, 0x2e,
+	0x49, 0x2c, 0x2a, 0x89, 0x2f, 0xc8, 0xcf, 0xc9, 0x4c, 0xae, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
+	0x17, 0x62, 0xcb, 0xcd, 0x4f, 0x49, 0xcd, 0x29, 0x96, 0xd2, 0x4d, 0xcf, 0x2c, 0xc9, 0x28, 0x4d,
+	0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x4f, 0xcf, 0x4f, 0xcf, 0xd7, 0x07, 0x4b, 0x27, 0x95, 0xa6, 0x81,
+	0x79, 0x60, 0x0e, 0x98, 0x05, 0xd1, 0xa6, 0x74, 0x9f, 0x91, 0x8b, 0x37, 0x08, 0x62, 0x5e, 0x00,
+	0xd8, 0x38, 0x21, 0x57, 0x2e, 0xa1, 0xcc, 0xdc, 0xdc, 0xd4, 0x94, 0xcc, 0xc4, 0x92, 0xd4, 0x78,
+	0xa8, 0x55, 0xc5, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xac, 0x4e, 0x62, 0xaf, 0xee, 0xc9, 0x63, 0x91,
+	0x0d, 0x12, 0x84, 0x8b, 0x41, 0xcd, 0x2a, 0x16, 0x0a, 0xe2, 0x12, 0xcf, 0x4d, 0xac, 0x88, 0x4f,
+	0x4a, 0x4c, 0xce, 0xce, 0x4f, 0x4b, 0x8b, 0x4f, 0x29, 0x2d, 0x4a, 0x2c, 0xc9, 0xcc, 0xcf, 0x8b,
+	0xcf, 0x2d, 0x96, 0x60, 0x52, 0x60, 0xd4, 0x60, 0x76, 0x92, 0x7e, 0x75, 0x4f, 0x1e, 0x97, 0x92,
+	0x20, 0x91, 0xdc, 0xc4, 0x0a, 0x27, 0x88, 0xb8, 0x0b, 0x54, 0xd8, 0xb7, 0x58, 0xc8, 0x98, 0x8b,
+	0x07, 0xa4, 0x01, 0xee, 0x28, 0x66, 0xb0, 0xa3, 0x04, 0x5e, 0xdd, 0x93, 0x47, 0x11, 0x0f, 0xe2,
+	0xce, 0x4d, 0xac, 0x80, 0x39, 0xc4, 0xc9, 0xe4, 0xc2, 0x43, 0x39, 0x86, 0x1b, 0x0f, 0xe5, 0x18,
+	0x3e, 0x3c, 0x94, 0x63, 0x6c, 0x78, 0x24, 0xc7, 0xb8, 0xe2, 0x91, 0x1c, 0xe3, 0x89, 0x47, 0x72,
+	0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0xf8, 0xe2, 0x91, 0x1c, 0xc3, 0x87, 0x47,
+	0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x12,
+	0x1b, 0x38, 0x78, 0x8c, 0x01, 0x03, 0x00, 0xb4, 0xd1, 0x07, 0x1c, 0x6d, 0x01, 0x00, 0x00,
+}
+
+func (this *RestartPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RestartPolicy)
+	if !ok {
+		that2, ok := that.(RestartPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ImmediateRestarts != that1.ImmediateRestarts {
+		return false
+	}
+	if this.MaxBackoffDurationMs != that1.MaxBackoffDurationMs {
+		return false
+	}
+	if this.MaxRestarts != that1.MaxRestarts {
+		return false
+	}
+	return true
+}
+func (this *RestartPolicy) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&models.RestartPolicy{")
+	s = append(s, "ImmediateRestarts: "+fmt.Sprintf("%#v", this.ImmediateRestarts)+",\n")
+	s = append(s, "MaxBackoffDurationMs: "+fmt.Sprintf("%#v", this.MaxBackoffDurationMs)+",\n")
+	s = append(s, "MaxRestarts: "+fmt.Sprintf("%#v", this.MaxRestarts)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringRestartPolicy(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *RestartPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestartPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestartPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxRestarts != 0 {
+		i = encodeVarintRestartPolicy(dAtA, i, uint64(m.MaxRestarts))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxBackoffDurationMs != 0 {
+		i = encodeVarintRestartPolicy(dAtA, i, uint64(m.MaxBackoffDurationMs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ImmediateRestarts != 0 {
+		i = encodeVarintRestartPolicy(dAtA, i, uint64(m.ImmediateRestarts))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRestartPolicy(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRestartPolicy(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RestartPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ImmediateRestarts != 0 {
+		n += 1 + sovRestartPolicy(uint64(m.ImmediateRestarts))
+	}
+	if m.MaxBackoffDurationMs != 0 {
+		n += 1 + sovRestartPolicy(uint64(m.MaxBackoffDurationMs))
+	}
+	if m.MaxRestarts != 0 {
+		n += 1 + sovRestartPolicy(uint64(m.MaxRestarts))
+	}
+	return n
+}
+
+func sovRestartPolicy(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRestartPolicy(x uint64) (n int) {
+	return sovRestartPolicy(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *RestartPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RestartPolicy{`,
+		`ImmediateRestarts:` + fmt.Sprintf("%v", this.ImmediateRestarts) + `,`,
+		`MaxBackoffDurationMs:` + fmt.Sprintf("%v", this.MaxBackoffDurationMs) + `,`,
+		`MaxRestarts:` + fmt.Sprintf("%v", this.MaxRestarts) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringRestartPolicy(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *RestartPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRestartPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestartPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestartPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImmediateRestarts", wireType)
+			}
+			m.ImmediateRestarts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRestartPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ImmediateRestarts |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBackoffDurationMs", wireType)
+			}
+			m.MaxBackoffDurationMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRestartPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBackoffDurationMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRestarts", wireType)
+			}
+			m.MaxRestarts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRestartPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRestarts |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRestartPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRestartPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRestartPolicy(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRestartPolicy
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRestartPolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRestartPolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRestartPolicy
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRestartPolicy
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRestartPolicy
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRestartPolicy        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRestartPolicy          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRestartPolicy = fmt.Errorf("proto: unexpected end of group")
+)
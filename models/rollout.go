@@ -0,0 +1,141 @@
+package models
+
+// DefaultRolloutStrategy is used by LRPs without a rollout strategy. It starts
+// the replacement of one instance at a time before stopping the instance it
+// replaces.
+var DefaultRolloutStrategy = RolloutStrategy{MaxSurge: 1, MaxUnavailable: 0}
+
+func (s RolloutStrategy) Validate() error {
+	var validationError ValidationError
+
+	if s.MaxSurge < 0 {
+		validationError = validationError.Append(ErrInvalidField{"max_surge"})
+	}
+
+	if s.MaxUnavailable < 0 {
+		validationError = validationError.Append(ErrInvalidField{"max_unavailable"})
+	}
+
+	if s.MaxSurge+s.MaxUnavailable < 1 {
+		validationError = validationError.Append(ErrInvalidField{"rollout_strategy"})
+	}
+
+	return validationError.ToError()
+}
+
+// RolloutPlan is the next step of rolling the instances of an LRP over to its
+// current revision.
+type RolloutPlan struct {
+	// Surge are running instances to evacuate. They keep running until their
+	// replacement is running.
+	Surge []*ActualLRP
+	// Replace are instances to unclaim and auction again straight away.
+	Replace []*ActualLRP
+}
+
+func (p RolloutPlan) Empty() bool {
+	return len(p.Surge) == 0 && len(p.Replace) == 0
+}
+
+// PlanRollout returns the instances to replace next, index by index, so that
+// at most MaxSurge instances run alongside the ones they replace and at most
+// MaxUnavailable indices have no available instance. An instance is
+// available when it is running and routable, if it reported readiness.
+// Instances that are claimed, or running but not routable, are unavailable
+// already and are replaced straight away.
+func PlanRollout(schedulingInfo *DesiredLRPSchedulingInfo, actualLRPs []*ActualLRP) RolloutPlan {
+	strategy := DefaultRolloutStrategy
+	if schedulingInfo.RolloutStrategy != nil {
+		strategy = *schedulingInfo.RolloutStrategy
+	}
+
+	ordinary := map[int32]*ActualLRP{}
+	evacuating := map[int32]*ActualLRP{}
+	for _, lrp := range actualLRPs {
+		if lrp.Index >= schedulingInfo.ScheduledInstances() {
+			continue
+		}
+		switch lrp.Presence {
+		case ActualLRP_Ordinary:
+			ordinary[lrp.Index] = lrp
+		case ActualLRP_Evacuating:
+			evacuating[lrp.Index] = lrp
+		}
+	}
+
+	surged := int32(len(evacuating))
+	unavailable := int32(0)
+	for index := int32(0); index < schedulingInfo.ScheduledInstances(); index++ {
+		if !ordinary[index].isAvailable() && !evacuating[index].isAvailable() {
+			unavailable++
+		}
+	}
+
+	plan := RolloutPlan{}
+	for index := int32(0); index < schedulingInfo.ScheduledInstances(); index++ {
+		lrp := ordinary[index]
+		if lrp == nil || evacuating[index] != nil || lrp.Revision >= schedulingInfo.Revision {
+			continue
+		}
+		if lrp.State != ActualLRPStateClaimed && lrp.State != ActualLRPStateRunning {
+			continue
+		}
+
+		switch {
+		case !lrp.isAvailable():
+			plan.Replace = append(plan.Replace, lrp)
+		case surged < strategy.MaxSurge:
+			plan.Surge = append(plan.Surge, lrp)
+			surged++
+		case unavailable < strategy.MaxUnavailable:
+			plan.Replace = append(plan.Replace, lrp)
+			unavailable++
+		}
+	}
+
+	return plan
+}
+
+func (actual *ActualLRP) isAvailable() bool {
+	if actual == nil || actual.State != ActualLRPStateRunning {
+		return false
+	}
+	return !actual.RoutableExists() || actual.GetRoutable()
+}
+
+// PlanRollingRestart returns the instances to restart next so that at most
+// maxInFlight of the restarted instances are not running yet, and whether
+// every instance has been restarted. restartInstanceGuids holds the instance
+// guid of each index when the rolling restart was requested, and an instance
+// has been restarted once its instance guid changed.
+func PlanRollingRestart(schedulingInfo *DesiredLRPSchedulingInfo, actualLRPs []*ActualLRP, restartInstanceGuids map[int32]string, maxInFlight int32) ([]*ActualLRP, bool) {
+	ordinary := map[int32]*ActualLRP{}
+	for _, lrp := range actualLRPs {
+		if lrp.Index < schedulingInfo.ScheduledInstances() && lrp.Presence == ActualLRP_Ordinary {
+			ordinary[lrp.Index] = lrp
+		}
+	}
+
+	inFlight := int32(0)
+	pending := []*ActualLRP{}
+	for index := int32(0); index < schedulingInfo.ScheduledInstances(); index++ {
+		lrp := ordinary[index]
+		switch {
+		case lrp == nil:
+		case lrp.InstanceGuid != "" && lrp.InstanceGuid == restartInstanceGuids[index]:
+			pending = append(pending, lrp)
+		case lrp.State != ActualLRPStateRunning:
+			inFlight++
+		}
+	}
+
+	if len(pending) == 0 {
+		return nil, true
+	}
+
+	available := maxInFlight - inFlight
+	if available <= 0 {
+		return nil, false
+	}
+	return pending[:min(int(available), len(pending))], false
+}
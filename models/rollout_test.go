@@ -0,0 +1,243 @@
+package models_test
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Rollout", func() {
+	Describe("RolloutStrategy", func() {
+		DescribeTable("Validate",
+			func(strategy models.RolloutStrategy, invalidField string) {
+				err := strategy.Validate()
+				if invalidField == "" {
+					Expect(err).NotTo(HaveOccurred())
+				} else {
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring(invalidField))
+				}
+			},
+			Entry("the default strategy", models.DefaultRolloutStrategy, ""),
+			Entry("surge only", models.RolloutStrategy{MaxSurge: 2}, ""),
+			Entry("unavailable only", models.RolloutStrategy{MaxUnavailable: 1}, ""),
+			Entry("negative surge", models.RolloutStrategy{MaxSurge: -1, MaxUnavailable: 1}, "max_surge"),
+			Entry("negative unavailable", models.RolloutStrategy{MaxSurge: 1, MaxUnavailable: -1}, "max_unavailable"),
+			Entry("no surge and no unavailable", models.RolloutStrategy{}, "rollout_strategy"),
+		)
+	})
+
+	Describe("PlanRollout", func() {
+		var (
+			schedulingInfo *models.DesiredLRPSchedulingInfo
+			actualLRPs     []*models.ActualLRP
+		)
+
+		newActualLRP := func(index int32, state string, revision int32) *models.ActualLRP {
+			lrp := &models.ActualLRP{
+				ActualLRPKey: models.NewActualLRPKey("some-guid", index, "some-domain"),
+				State:        state,
+				Revision:     revision,
+				Presence:     models.ActualLRP_Ordinary,
+			}
+			if state != models.ActualLRPStateUnclaimed {
+				lrp.ActualLRPInstanceKey = models.NewActualLRPInstanceKey("instance-guid", "cell-id")
+			}
+			return lrp
+		}
+
+		indices := func(lrps []*models.ActualLRP) []int32 {
+			result := []int32{}
+			for _, lrp := range lrps {
+				result = append(result, lrp.Index)
+			}
+			return result
+		}
+
+		BeforeEach(func() {
+			schedulingInfo = &models.DesiredLRPSchedulingInfo{Instances: 3, Revision: 1}
+			actualLRPs = []*models.ActualLRP{
+				newActualLRP(0, models.ActualLRPStateRunning, 0),
+				newActualLRP(1, models.ActualLRPStateRunning, 0),
+				newActualLRP(2, models.ActualLRPStateRunning, 0),
+			}
+		})
+
+		It("surges one instance at a time by default", func() {
+			plan := models.PlanRollout(schedulingInfo, actualLRPs)
+			Expect(indices(plan.Surge)).To(Equal([]int32{0}))
+			Expect(plan.Replace).To(BeEmpty())
+		})
+
+		It("surges and replaces up to the limits of the strategy", func() {
+			schedulingInfo.RolloutStrategy = &models.RolloutStrategy{MaxSurge: 1, MaxUnavailable: 1}
+			plan := models.PlanRollout(schedulingInfo, actualLRPs)
+			Expect(indices(plan.Surge)).To(Equal([]int32{0}))
+			Expect(indices(plan.Replace)).To(Equal([]int32{1}))
+		})
+
+		Context("when a replacement is not running yet", func() {
+			BeforeEach(func() {
+				evacuating := newActualLRP(0, models.ActualLRPStateRunning, 0)
+				evacuating.Presence = models.ActualLRP_Evacuating
+				actualLRPs[0] = newActualLRP(0, models.ActualLRPStateClaimed, 1)
+				actualLRPs = append(actualLRPs, evacuating)
+			})
+
+			It("waits for it before surging the next instance", func() {
+				plan := models.PlanRollout(schedulingInfo, actualLRPs)
+				Expect(plan.Empty()).To(BeTrue())
+			})
+		})
+
+		Context("when a replacement is running", func() {
+			BeforeEach(func() {
+				actualLRPs[0] = newActualLRP(0, models.ActualLRPStateRunning, 1)
+			})
+
+			It("surges the next instance", func() {
+				plan := models.PlanRollout(schedulingInfo, actualLRPs)
+				Expect(indices(plan.Surge)).To(Equal([]int32{1}))
+			})
+
+			Context("but is not routable yet", func() {
+				BeforeEach(func() {
+					actualLRPs[0].SetRoutable(false)
+					schedulingInfo.RolloutStrategy = &models.RolloutStrategy{MaxUnavailable: 1}
+				})
+
+				It("waits for it before replacing the next instance", func() {
+					plan := models.PlanRollout(schedulingInfo, actualLRPs)
+					Expect(plan.Empty()).To(BeTrue())
+				})
+			})
+		})
+
+		It("replaces outdated instances that are not available straight away", func() {
+			actualLRPs[1] = newActualLRP(1, models.ActualLRPStateClaimed, 0)
+			actualLRPs[2] = newActualLRP(2, models.ActualLRPStateCrashed, 0)
+			plan := models.PlanRollout(schedulingInfo, actualLRPs)
+			Expect(indices(plan.Surge)).To(Equal([]int32{0}))
+			Expect(indices(plan.Replace)).To(Equal([]int32{1}))
+		})
+
+		It("ignores instances at the current revision and extra instances", func() {
+			schedulingInfo.Instances = 2
+			actualLRPs[0] = newActualLRP(0, models.ActualLRPStateRunning, 1)
+			actualLRPs[1] = newActualLRP(1, models.ActualLRPStateRunning, 1)
+			plan := models.PlanRollout(schedulingInfo, actualLRPs)
+			Expect(plan.Empty()).To(BeTrue())
+		})
+
+		It("does not roll out the instances of a paused lrp", func() {
+			schedulingInfo.Paused = true
+			Expect(models.PlanRollout(schedulingInfo, actualLRPs).Empty()).To(BeTrue())
+		})
+	})
+
+	Describe("PlanRollingRestart", func() {
+		var (
+			schedulingInfo       *models.DesiredLRPSchedulingInfo
+			actualLRPs           []*models.ActualLRP
+			restartInstanceGuids map[int32]string
+		)
+
+		newActualLRP := func(index int32, state, instanceGuid string) *models.ActualLRP {
+			return &models.ActualLRP{
+				ActualLRPKey:         models.NewActualLRPKey("some-guid", index, "some-domain"),
+				ActualLRPInstanceKey: models.NewActualLRPInstanceKey(instanceGuid, "some-cell"),
+				State:                state,
+				Presence:             models.ActualLRP_Ordinary,
+			}
+		}
+
+		indices := func(lrps []*models.ActualLRP) []int32 {
+			result := []int32{}
+			for _, lrp := range lrps {
+				result = append(result, lrp.Index)
+			}
+			return result
+		}
+
+		BeforeEach(func() {
+			schedulingInfo = &models.DesiredLRPSchedulingInfo{Instances: 4}
+			actualLRPs = []*models.ActualLRP{
+				newActualLRP(0, models.ActualLRPStateRunning, "ig-0"),
+				newActualLRP(1, models.ActualLRPStateRunning, "ig-1"),
+				newActualLRP(2, models.ActualLRPStateRunning, "ig-2"),
+				newActualLRP(3, models.ActualLRPStateRunning, "ig-3"),
+			}
+			restartInstanceGuids = map[int32]string{0: "ig-0", 1: "ig-1", 2: "ig-2", 3: "ig-3"}
+		})
+
+		It("restarts the lowest indices up to the max in flight", func() {
+			restart, done := models.PlanRollingRestart(schedulingInfo, actualLRPs, restartInstanceGuids, 2)
+			Expect(indices(restart)).To(Equal([]int32{0, 1}))
+			Expect(done).To(BeFalse())
+		})
+
+		It("waits for the restarted instances to be running", func() {
+			actualLRPs[0] = newActualLRP(0, models.ActualLRPStateClaimed, "ig-0-new")
+			actualLRPs[1] = newActualLRP(1, models.ActualLRPStateRunning, "ig-1-new")
+
+			restart, done := models.PlanRollingRestart(schedulingInfo, actualLRPs, restartInstanceGuids, 1)
+			Expect(restart).To(BeEmpty())
+			Expect(done).To(BeFalse())
+
+			restart, _ = models.PlanRollingRestart(schedulingInfo, actualLRPs, restartInstanceGuids, 2)
+			Expect(indices(restart)).To(Equal([]int32{2}))
+		})
+
+		It("counts unclaimed instances as restarting", func() {
+			actualLRPs[0] = newActualLRP(0, models.ActualLRPStateUnclaimed, "")
+
+			restart, _ := models.PlanRollingRestart(schedulingInfo, actualLRPs, restartInstanceGuids, 2)
+			Expect(indices(restart)).To(Equal([]int32{1}))
+		})
+
+		It("does not count instances that changed state but kept their instance guid as restarted", func() {
+			actualLRPs[0] = newActualLRP(0, models.ActualLRPStateClaimed, "ig-0")
+			actualLRPs[0].Since = 200
+
+			restart, done := models.PlanRollingRestart(schedulingInfo, actualLRPs, restartInstanceGuids, 1)
+			Expect(indices(restart)).To(Equal([]int32{0}))
+			Expect(done).To(BeFalse())
+		})
+
+		It("counts instances without an instance guid when the restart was requested as restarted", func() {
+			delete(restartInstanceGuids, 0)
+
+			restart, _ := models.PlanRollingRestart(schedulingInfo, actualLRPs, restartInstanceGuids, 4)
+			Expect(indices(restart)).To(Equal([]int32{1, 2, 3}))
+		})
+
+		It("is done once every instance has been restarted", func() {
+			for _, lrp := range actualLRPs {
+				lrp.InstanceGuid += "-new"
+			}
+
+			restart, done := models.PlanRollingRestart(schedulingInfo, actualLRPs, restartInstanceGuids, 2)
+			Expect(restart).To(BeEmpty())
+			Expect(done).To(BeTrue())
+		})
+
+		It("is done once the lrp is paused", func() {
+			schedulingInfo.Paused = true
+
+			restart, done := models.PlanRollingRestart(schedulingInfo, actualLRPs, restartInstanceGuids, 2)
+			Expect(restart).To(BeEmpty())
+			Expect(done).To(BeTrue())
+		})
+
+		It("ignores evacuating and extra instances", func() {
+			evacuating := newActualLRP(0, models.ActualLRPStateRunning, "ig-0")
+			evacuating.Presence = models.ActualLRP_Evacuating
+			actualLRPs[0] = newActualLRP(0, models.ActualLRPStateRunning, "ig-0-new")
+			actualLRPs = append(actualLRPs, evacuating, newActualLRP(4, models.ActualLRPStateRunning, "ig-4"))
+			restartInstanceGuids[4] = "ig-4"
+
+			restart, _ := models.PlanRollingRestart(schedulingInfo, actualLRPs, restartInstanceGuids, 4)
+			Expect(indices(restart)).To(Equal([]int32{1, 2, 3}))
+		})
+	})
+})
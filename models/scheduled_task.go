@@ -0,0 +1,108 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/lager/v3"
+)
+
+// DefaultScheduledTaskHistoryLimit is the number of runs kept for a
+// ScheduledTask that does not set a history limit.
+const DefaultScheduledTaskHistoryLimit = 10
+
+func (t *ScheduledTask) LagerData() lager.Data {
+	return lager.Data{
+		"guid":               t.Guid,
+		"domain":             t.Domain,
+		"schedule":           t.Schedule,
+		"time_zone":          t.TimeZone,
+		"concurrency_policy": t.ConcurrencyPolicy,
+	}
+}
+
+func (t *ScheduledTask) Validate() error {
+	var validationError ValidationError
+
+	if !taskGuidPattern.MatchString(t.Guid) {
+		validationError = validationError.Append(ErrInvalidField{"guid"})
+	}
+
+	if t.Domain == "" {
+		validationError = validationError.Append(ErrInvalidField{"domain"})
+	}
+
+	if location, err := t.Location(); err != nil {
+		validationError = validationError.Append(ErrInvalidField{"time_zone"})
+	} else if _, err := ParseCronSchedule(t.Schedule, location); err != nil {
+		validationError = validationError.Append(ErrInvalidField{"schedule"})
+	}
+
+	if _, found := ScheduledTask_ConcurrencyPolicy_name[int32(t.ConcurrencyPolicy)]; !found {
+		validationError = validationError.Append(ErrInvalidField{"concurrency_policy"})
+	}
+
+	if t.HistoryLimit < 0 {
+		validationError = validationError.Append(ErrInvalidField{"history_limit"})
+	}
+
+	if t.TaskDefinition == nil {
+		validationError = validationError.Append(ErrInvalidField{"task_definition"})
+	} else if defErr := t.TaskDefinition.Validate(); defErr != nil {
+		validationError = validationError.Append(defErr)
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
+// Location returns the time zone the schedule is evaluated in, defaulting to
+// UTC.
+func (t *ScheduledTask) Location() (*time.Location, error) {
+	if t.TimeZone == "" {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(t.TimeZone)
+}
+
+func (t *ScheduledTask) CronSchedule() (*CronSchedule, error) {
+	location, err := t.Location()
+	if err != nil {
+		return nil, err
+	}
+	return ParseCronSchedule(t.Schedule, location)
+}
+
+func (t *ScheduledTask) EffectiveHistoryLimit() int {
+	if t.HistoryLimit <= 0 {
+		return DefaultScheduledTaskHistoryLimit
+	}
+	return int(t.HistoryLimit)
+}
+
+// RunTaskGuid derives the guid of the Task desired for the run scheduled at
+// the given time. Deriving the guid makes desiring a run idempotent.
+func (t *ScheduledTask) RunTaskGuid(scheduledAt time.Time) string {
+	return fmt.Sprintf("%s-%d", t.Guid, scheduledAt.Unix())
+}
+
+func (p *ScheduledTask_ConcurrencyPolicy) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return err
+	}
+
+	if v, found := ScheduledTask_ConcurrencyPolicy_value[name]; found {
+		*p = ScheduledTask_ConcurrencyPolicy(v)
+		return nil
+	}
+	return fmt.Errorf("invalid concurrency policy: %s", name)
+}
+
+func (p ScheduledTask_ConcurrencyPolicy) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.String())
+}
@@ -0,0 +1,1201 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: scheduled_task.proto
+
+package models
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strconv "strconv"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ScheduledTask_ConcurrencyPolicy int32
+
+const (
+	ScheduledTask_Allow   ScheduledTask_ConcurrencyPolicy = 0
+	ScheduledTask_Forbid  ScheduledTask_ConcurrencyPolicy = 1
+	ScheduledTask_Replace ScheduledTask_ConcurrencyPolicy = 2
+)
+
+var ScheduledTask_ConcurrencyPolicy_name = map[int32]string{
+	0: "Allow",
+	1: "Forbid",
+	2: "Replace",
+}
+
+var ScheduledTask_ConcurrencyPolicy_value = map[string]int32{
+	"Allow":   0,
+	"Forbid":  1,
+	"Replace": 2,
+}
+
+func (ScheduledTask_ConcurrencyPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_493ff2cd7c97a5a3, []int{0, 0}
+}
+
+type ScheduledTask struct {
+	Guid              string                          `protobuf:"bytes,1,opt,name=guid,proto3" json:"guid"`
+	Domain            string                          `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain"`
+	Schedule          string                          `protobuf:"bytes,3,opt,name=schedule,proto3" json:"schedule"`
+	TimeZone          string                          `protobuf:"bytes,4,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	ConcurrencyPolicy ScheduledTask_ConcurrencyPolicy `protobuf:"varint,5,opt,name=concurrency_policy,json=concurrencyPolicy,proto3,enum=models.ScheduledTask_ConcurrencyPolicy" json:"concurrency_policy"`
+	HistoryLimit      int32                           `protobuf:"varint,6,opt,name=history_limit,json=historyLimit,proto3" json:"history_limit"`
+	TaskDefinition    *TaskDefinition                 `protobuf:"bytes,7,opt,name=task_definition,json=taskDefinition,proto3" json:"task_definition"`
+	CreatedAt         int64                           `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt         int64                           `protobuf:"varint,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	LastScheduledAt   int64                           `protobuf:"varint,10,opt,name=last_scheduled_at,json=lastScheduledAt,proto3" json:"last_scheduled_at"`
+	Runs              []*ScheduledTaskRun             `protobuf:"bytes,11,rep,name=runs,proto3" json:"runs,omitempty"`
+}
+
+func (m *ScheduledTask) Reset()      { *m = ScheduledTask{} }
+func (*ScheduledTask) ProtoMessage() {}
+func (*ScheduledTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_493ff2cd7c97a5a3, []int{0}
+}
+func (m *ScheduledTask) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledTask.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledTask.Merge(m, src)
+}
+func (m *ScheduledTask) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledTask.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledTask proto.InternalMessageInfo
+
+func (m *ScheduledTask) GetGuid() string {
+	if m != nil {
+		return m.Guid
+	}
+	return ""
+}
+
+func (m *ScheduledTask) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *ScheduledTask) GetSchedule() string {
+	if m != nil {
+		return m.Schedule
+	}
+	return ""
+}
+
+func (m *ScheduledTask) GetTimeZone() string {
+	if m != nil {
+		return m.TimeZone
+	}
+	return ""
+}
+
+func (m *ScheduledTask) GetConcurrencyPolicy() ScheduledTask_ConcurrencyPolicy {
+	if m != nil {
+		return m.ConcurrencyPolicy
+	}
+	return ScheduledTask_Allow
+}
+
+func (m *ScheduledTask) GetHistoryLimit() int32 {
+	if m != nil {
+		return m.HistoryLimit
+	}
+	return 0
+}
+
+func (m *ScheduledTask) GetTaskDefinition() *TaskDefinition {
+	if m != nil {
+		return m.TaskDefinition
+	}
+	return nil
+}
+
+func (m *ScheduledTask) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *ScheduledTask) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+func (m *ScheduledTask) GetLastScheduledAt() int64 {
+	if m != nil {
+		return m.LastScheduledAt
+	}
+	return 0
+}
+
+func (m *ScheduledTask) GetRuns() []*ScheduledTaskRun {
+	if m != nil {
+		return m.Runs
+	}
+	return nil
+}
+
+type ScheduledTaskRun struct {
+	TaskGuid    string `protobuf:"bytes,1,opt,name=task_guid,json=taskGuid,proto3" json:"task_guid"`
+	ScheduledAt int64  `protobuf:"varint,2,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at"`
+}
+
+func (m *ScheduledTaskRun) Reset()      { *m = ScheduledTaskRun{} }
+func (*ScheduledTaskRun) ProtoMessage() {}
+func (*ScheduledTaskRun) Descriptor() ([]byte, []int) {
+	return fileDescriptor_493ff2cd7c97a5a3, []int{1}
+}
+func (m *ScheduledTaskRun) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledTaskRun) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledTaskRun.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledTaskRun) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledTaskRun.Merge(m, src)
+}
+func (m *ScheduledTaskRun) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledTaskRun) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledTaskRun.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledTaskRun proto.InternalMessageInfo
+
+func (m *ScheduledTaskRun) GetTaskGuid() string {
+	if m != nil {
+		return m.TaskGuid
+	}
+	return ""
+}
+
+func (m *ScheduledTaskRun) GetScheduledAt() int64 {
+	if m != nil {
+		return m.ScheduledAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("models.ScheduledTask_ConcurrencyPolicy", ScheduledTask_ConcurrencyPolicy_name, ScheduledTask_ConcurrencyPolicy_value)
+	proto.RegisterType((*ScheduledTask)(nil), "models.ScheduledTask")
+	proto.RegisterType((*ScheduledTaskRun)(nil), "models.ScheduledTaskRun")
+}
+
+func init() { proto.RegisterFile("scheduled_task.proto", fileDescriptor_493ff2cd7c97a5a3) }
+
+var fileDescriptor_493ff2cd7c97a5a3 = []byte{
+	// 549 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0xc7, 0xbd, 0x6d, 0x93, 0xc6, 0x93, 0xfe, 0x49, 0xf6, 0xf7, 0xa3, 0xb2, 0x0a, 0x5a, 0x5b,
+	0xb9, 0x60, 0x21, 0x9a, 0x4a, 0x29, 0x82, 0x73, 0x0c, 0x82, 0x0b, 0x07, 0xb4, 0xf4, 0xc4, 0xc5,
+	0x72, 0xec, 0x6d, 0xb2, 0xaa, 0xe3, 0x8d, 0xec, 0xb5, 0x50, 0x38, 0xf1, 0x08, 0x3c, 0x01, 0x67,
+	0x1e, 0x85, 0x63, 0x8e, 0x3d, 0x59, 0xc4, 0xb9, 0x20, 0x9f, 0xfa, 0x08, 0xc8, 0xdb, 0x24, 0x6e,
+	0xd2, 0x5e, 0x92, 0x99, 0xef, 0x7c, 0x66, 0x34, 0x33, 0x3b, 0x86, 0xff, 0x13, 0x7f, 0xc4, 0x82,
+	0x34, 0x64, 0x81, 0x2b, 0xbd, 0xe4, 0xba, 0x3b, 0x89, 0x85, 0x14, 0xb8, 0x3e, 0x16, 0x01, 0x0b,
+	0x93, 0xd3, 0xb3, 0x21, 0x97, 0xa3, 0x74, 0xd0, 0xf5, 0xc5, 0xf8, 0x7c, 0x28, 0x86, 0xe2, 0x5c,
+	0x85, 0x07, 0xe9, 0x95, 0xf2, 0x94, 0xa3, 0xac, 0xbb, 0xb4, 0x53, 0xa8, 0x4a, 0x74, 0x7e, 0xd6,
+	0xe0, 0xf0, 0xf3, 0xaa, 0xf6, 0xa5, 0x97, 0x5c, 0xe3, 0x67, 0xb0, 0x37, 0x4c, 0x79, 0x60, 0x20,
+	0x0b, 0xd9, 0xba, 0xd3, 0x28, 0x32, 0x53, 0xf9, 0x54, 0xfd, 0xe2, 0x0e, 0xd4, 0x03, 0x31, 0xf6,
+	0x78, 0x64, 0xec, 0xa8, 0x38, 0x14, 0x99, 0xb9, 0x54, 0xe8, 0xf2, 0x1f, 0xdb, 0xd0, 0x58, 0xb5,
+	0x6b, 0xec, 0x2a, 0xea, 0xa0, 0xc8, 0xcc, 0xb5, 0x46, 0xd7, 0x16, 0x7e, 0x0a, 0xba, 0xe4, 0x63,
+	0xe6, 0x7e, 0x13, 0x11, 0x33, 0xf6, 0x4a, 0x94, 0x36, 0x4a, 0xe1, 0x8b, 0x88, 0x18, 0x8e, 0x00,
+	0xfb, 0x22, 0xf2, 0xd3, 0x38, 0x66, 0x91, 0x3f, 0x75, 0x27, 0x22, 0xe4, 0xfe, 0xd4, 0xa8, 0x59,
+	0xc8, 0x3e, 0xea, 0x3d, 0xef, 0xde, 0x8d, 0xde, 0xdd, 0xe8, 0xbd, 0xfb, 0xb6, 0xe2, 0x3f, 0x29,
+	0xdc, 0x39, 0x29, 0x32, 0xf3, 0x91, 0x32, 0xb4, 0xed, 0x6f, 0xa3, 0xf8, 0x35, 0x1c, 0x8e, 0x78,
+	0x22, 0x45, 0x3c, 0x75, 0x43, 0x3e, 0xe6, 0xd2, 0xa8, 0x5b, 0xc8, 0xae, 0x39, 0xed, 0x22, 0x33,
+	0x37, 0x03, 0xf4, 0x60, 0xe9, 0x7e, 0x2c, 0x3d, 0x7c, 0x09, 0xc7, 0xe5, 0x42, 0xdd, 0x80, 0x5d,
+	0xf1, 0x88, 0x4b, 0x2e, 0x22, 0x63, 0xdf, 0x42, 0x76, 0xb3, 0x77, 0xb2, 0x6a, 0xb2, 0xec, 0xed,
+	0xdd, 0x3a, 0xea, 0xfc, 0x57, 0x64, 0xe6, 0x76, 0x0a, 0x3d, 0x92, 0x1b, 0x10, 0x3e, 0x03, 0xf0,
+	0x63, 0xe6, 0x49, 0x16, 0xb8, 0x9e, 0x34, 0x1a, 0x16, 0xb2, 0x77, 0x9d, 0xa3, 0x22, 0x33, 0xef,
+	0xa9, 0x54, 0x5f, 0xda, 0x7d, 0x59, 0xe2, 0xe9, 0x24, 0x58, 0xe1, 0x7a, 0x85, 0x57, 0x2a, 0xd5,
+	0x97, 0x76, 0x5f, 0xe2, 0x3e, 0xb4, 0x43, 0x2f, 0x91, 0x6e, 0x75, 0x56, 0x9e, 0x34, 0x40, 0x65,
+	0x3d, 0x29, 0x32, 0xf3, 0x61, 0x90, 0x1e, 0x97, 0xd2, 0x7a, 0xdb, 0x7d, 0x89, 0x5f, 0xc2, 0x5e,
+	0x9c, 0x46, 0x89, 0xd1, 0xb4, 0x76, 0xed, 0x66, 0xcf, 0x78, 0xf4, 0x41, 0x68, 0x1a, 0x51, 0x45,
+	0x75, 0xde, 0x40, 0xfb, 0xc1, 0xe3, 0x60, 0x1d, 0x6a, 0xfd, 0x30, 0x14, 0x5f, 0x5b, 0x1a, 0x06,
+	0xa8, 0xbf, 0x17, 0xf1, 0x80, 0x07, 0x2d, 0x84, 0x9b, 0xb0, 0x4f, 0xd9, 0x24, 0xf4, 0x7c, 0xd6,
+	0xda, 0xe9, 0x24, 0xd0, 0xda, 0x2e, 0x89, 0x5f, 0x80, 0xae, 0xd6, 0x77, 0xef, 0x4e, 0x0f, 0x8b,
+	0xcc, 0xac, 0x44, 0xda, 0x28, 0xcd, 0x0f, 0xe5, 0xc1, 0x5e, 0xc0, 0xc1, 0xc6, 0x90, 0x3b, 0x6a,
+	0xc8, 0x56, 0x91, 0x99, 0x1b, 0x3a, 0x6d, 0x26, 0xd5, 0x6c, 0xce, 0xab, 0xd9, 0x9c, 0xa0, 0x9b,
+	0x39, 0xd1, 0x6e, 0xe7, 0x04, 0x7d, 0xcf, 0x09, 0xfa, 0x95, 0x13, 0xf4, 0x3b, 0x27, 0x68, 0x96,
+	0x13, 0xf4, 0x27, 0x27, 0xe8, 0x6f, 0x4e, 0xb4, 0xdb, 0x9c, 0xa0, 0x1f, 0x0b, 0xa2, 0xcd, 0x16,
+	0x44, 0xbb, 0x59, 0x10, 0x6d, 0x50, 0x57, 0x9f, 0xd4, 0xc5, 0xbf, 0x01, 0x00, 0xc3, 0xdb, 0xb8,
+	0x74, 0xad, 0x03, 0x00, 0x00,
+}
+
+func (x ScheduledTask_ConcurrencyPolicy) String() string {
+	s, ok := ScheduledTask_ConcurrencyPolicy_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (this *ScheduledTask) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScheduledTask)
+	if !ok {
+		that2, ok := that.(ScheduledTask)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Guid != that1.Guid {
+		return false
+	}
+	if this.Domain != that1.Domain {
+		return false
+	}
+	if this.Schedule != that1.Schedule {
+		return false
+	}
+	if this.TimeZone != that1.TimeZone {
+		return false
+	}
+	if this.ConcurrencyPolicy != that1.ConcurrencyPolicy {
+		return false
+	}
+	if this.HistoryLimit != that1.HistoryLimit {
+		return false
+	}
+	if !this.TaskDefinition.Equal(that1.TaskDefinition) {
+		return false
+	}
+	if this.CreatedAt != that1.CreatedAt {
+		return false
+	}
+	if this.UpdatedAt != that1.UpdatedAt {
+		return false
+	}
+	if this.LastScheduledAt != that1.LastScheduledAt {
+		return false
+	}
+	if len(this.Runs) != len(that1.Runs) {
+		return false
+	}
+	for i := range this.Runs {
+		if !this.Runs[i].Equal(that1.Runs[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ScheduledTaskRun) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScheduledTaskRun)
+	if !ok {
+		that2, ok := that.(ScheduledTaskRun)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TaskGuid != that1.TaskGuid {
+		return false
+	}
+	if this.ScheduledAt != that1.ScheduledAt {
+		return false
+	}
+	return true
+}
+func (this *ScheduledTask) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 15)
+	s = append(s, "&models.ScheduledTask{")
+	s = append(s, "Guid: "+fmt.Sprintf("%#v", this.Guid)+",\n")
+	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
+	s = append(s, "Schedule: "+fmt.Sprintf("%#v", this.Schedule)+",\n")
+	s = append(s, "TimeZone: "+fmt.Sprintf("%#v", this.TimeZone)+",\n")
+	s = append(s, "ConcurrencyPolicy: "+fmt.Sprintf("%#v", this.ConcurrencyPolicy)+",\n")
+	s = append(s, "HistoryLimit: "+fmt.Sprintf("%#v", this.HistoryLimit)+",\n")
+	if this.TaskDefinition != nil {
+		s = append(s, "TaskDefinition: "+fmt.Sprintf("%#v", this.TaskDefinition)+",\n")
+	}
+	s = append(s, "CreatedAt: "+fmt.Sprintf("%#v", this.CreatedAt)+",\n")
+	s = append(s, "UpdatedAt: "+fmt.Sprintf("%#v", this.UpdatedAt)+",\n")
+	s = append(s, "LastScheduledAt: "+fmt.Sprintf("%#v", this.LastScheduledAt)+",\n")
+	if this.Runs != nil {
+		s = append(s, "Runs: "+fmt.Sprintf("%#v", this.Runs)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ScheduledTaskRun) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.ScheduledTaskRun{")
+	s = append(s, "TaskGuid: "+fmt.Sprintf("%#v", this.TaskGuid)+",\n")
+	s = append(s, "ScheduledAt: "+fmt.Sprintf("%#v", this.ScheduledAt)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringScheduledTask(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *ScheduledTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Runs) > 0 {
+		for iNdEx := len(m.Runs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Runs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintScheduledTask(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.LastScheduledAt != 0 {
+		i = encodeVarintScheduledTask(dAtA, i, uint64(m.LastScheduledAt))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.UpdatedAt != 0 {
+		i = encodeVarintScheduledTask(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintScheduledTask(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.TaskDefinition != nil {
+		{
+			size, err := m.TaskDefinition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintScheduledTask(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.HistoryLimit != 0 {
+		i = encodeVarintScheduledTask(dAtA, i, uint64(m.HistoryLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ConcurrencyPolicy != 0 {
+		i = encodeVarintScheduledTask(dAtA, i, uint64(m.ConcurrencyPolicy))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.TimeZone) > 0 {
+		i -= len(m.TimeZone)
+		copy(dAtA[i:], m.TimeZone)
+		i = encodeVarintScheduledTask(dAtA, i, uint64(len(m.TimeZone)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Schedule) > 0 {
+		i -= len(m.Schedule)
+		copy(dAtA[i:], m.Schedule)
+		i = encodeVarintScheduledTask(dAtA, i, uint64(len(m.Schedule)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintScheduledTask(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Guid) > 0 {
+		i -= len(m.Guid)
+		copy(dAtA[i:], m.Guid)
+		i = encodeVarintScheduledTask(dAtA, i, uint64(len(m.Guid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledTaskRun) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledTaskRun) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledTaskRun) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ScheduledAt != 0 {
+		i = encodeVarintScheduledTask(dAtA, i, uint64(m.ScheduledAt))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TaskGuid) > 0 {
+		i -= len(m.TaskGuid)
+		copy(dAtA[i:], m.TaskGuid)
+		i = encodeVarintScheduledTask(dAtA, i, uint64(len(m.TaskGuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintScheduledTask(dAtA []byte, offset int, v uint64) int {
+	offset -= sovScheduledTask(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ScheduledTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Guid)
+	if l > 0 {
+		n += 1 + l + sovScheduledTask(uint64(l))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovScheduledTask(uint64(l))
+	}
+	l = len(m.Schedule)
+	if l > 0 {
+		n += 1 + l + sovScheduledTask(uint64(l))
+	}
+	l = len(m.TimeZone)
+	if l > 0 {
+		n += 1 + l + sovScheduledTask(uint64(l))
+	}
+	if m.ConcurrencyPolicy != 0 {
+		n += 1 + sovScheduledTask(uint64(m.ConcurrencyPolicy))
+	}
+	if m.HistoryLimit != 0 {
+		n += 1 + sovScheduledTask(uint64(m.HistoryLimit))
+	}
+	if m.TaskDefinition != nil {
+		l = m.TaskDefinition.Size()
+		n += 1 + l + sovScheduledTask(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovScheduledTask(uint64(m.CreatedAt))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovScheduledTask(uint64(m.UpdatedAt))
+	}
+	if m.LastScheduledAt != 0 {
+		n += 1 + sovScheduledTask(uint64(m.LastScheduledAt))
+	}
+	if len(m.Runs) > 0 {
+		for _, e := range m.Runs {
+			l = e.Size()
+			n += 1 + l + sovScheduledTask(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ScheduledTaskRun) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskGuid)
+	if l > 0 {
+		n += 1 + l + sovScheduledTask(uint64(l))
+	}
+	if m.ScheduledAt != 0 {
+		n += 1 + sovScheduledTask(uint64(m.ScheduledAt))
+	}
+	return n
+}
+
+func sovScheduledTask(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozScheduledTask(x uint64) (n int) {
+	return sovScheduledTask(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ScheduledTask) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRuns := "[]*ScheduledTaskRun{"
+	for _, f := range this.Runs {
+		repeatedStringForRuns += strings.Replace(f.String(), "ScheduledTaskRun", "ScheduledTaskRun", 1) + ","
+	}
+	repeatedStringForRuns += "}"
+	s := strings.Join([]string{`&ScheduledTask{`,
+		`Guid:` + fmt.Sprintf("%v", this.Guid) + `,`,
+		`Domain:` + fmt.Sprintf("%v", this.Domain) + `,`,
+		`Schedule:` + fmt.Sprintf("%v", this.Schedule) + `,`,
+		`TimeZone:` + fmt.Sprintf("%v", this.TimeZone) + `,`,
+		`ConcurrencyPolicy:` + fmt.Sprintf("%v", this.ConcurrencyPolicy) + `,`,
+		`HistoryLimit:` + fmt.Sprintf("%v", this.HistoryLimit) + `,`,
+		`TaskDefinition:` + strings.Replace(fmt.Sprintf("%v", this.TaskDefinition), "TaskDefinition", "TaskDefinition", 1) + `,`,
+		`CreatedAt:` + fmt.Sprintf("%v", this.CreatedAt) + `,`,
+		`UpdatedAt:` + fmt.Sprintf("%v", this.UpdatedAt) + `,`,
+		`LastScheduledAt:` + fmt.Sprintf("%v", this.LastScheduledAt) + `,`,
+		`Runs:` + repeatedStringForRuns + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScheduledTaskRun) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScheduledTaskRun{`,
+		`TaskGuid:` + fmt.Sprintf("%v", this.TaskGuid) + `,`,
+		`ScheduledAt:` + fmt.Sprintf("%v", this.ScheduledAt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringScheduledTask(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ScheduledTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduledTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScheduledTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScheduledTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScheduledTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeZone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScheduledTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeZone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcurrencyPolicy", wireType)
+			}
+			m.ConcurrencyPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConcurrencyPolicy |= ScheduledTask_ConcurrencyPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryLimit", wireType)
+			}
+			m.HistoryLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryLimit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskDefinition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScheduledTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskDefinition == nil {
+				m.TaskDefinition = &TaskDefinition{}
+			}
+			if err := m.TaskDefinition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastScheduledAt", wireType)
+			}
+			m.LastScheduledAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastScheduledAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScheduledTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Runs = append(m.Runs, &ScheduledTaskRun{})
+			if err := m.Runs[len(m.Runs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduledTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScheduledTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledTaskRun) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduledTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledTaskRun: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledTaskRun: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScheduledTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledAt", wireType)
+			}
+			m.ScheduledAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduledTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScheduledTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipScheduledTask(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowScheduledTask
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowScheduledTask
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowScheduledTask
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthScheduledTask
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupScheduledTask
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthScheduledTask
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthScheduledTask        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowScheduledTask          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupScheduledTask = fmt.Errorf("proto: unexpected end of group")
+)
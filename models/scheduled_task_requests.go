@@ -0,0 +1,65 @@
+package models
+
+func (req *ScheduledTasksRequest) Validate() error {
+	return nil
+}
+
+func (req *ScheduledTaskByGuidRequest) Validate() error {
+	var validationError ValidationError
+
+	if !taskGuidPattern.MatchString(req.Guid) {
+		validationError = validationError.Append(ErrInvalidField{"guid"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
+func (req *DesireScheduledTaskRequest) Validate() error {
+	var validationError ValidationError
+
+	if req.ScheduledTask == nil {
+		validationError = validationError.Append(ErrInvalidField{"scheduled_task"})
+	} else if err := req.ScheduledTask.Validate(); err != nil {
+		validationError = validationError.Append(err)
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
+func (req *UpdateScheduledTaskRequest) Validate() error {
+	var validationError ValidationError
+
+	if req.ScheduledTask == nil {
+		validationError = validationError.Append(ErrInvalidField{"scheduled_task"})
+	} else if err := req.ScheduledTask.Validate(); err != nil {
+		validationError = validationError.Append(err)
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
+func (req *DeleteScheduledTaskRequest) Validate() error {
+	var validationError ValidationError
+
+	if !taskGuidPattern.MatchString(req.Guid) {
+		validationError = validationError.Append(ErrInvalidField{"guid"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
@@ -0,0 +1,2082 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: scheduled_task_requests.proto
+
+package models
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ScheduledTaskLifecycleResponse struct {
+	Error *Error `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *ScheduledTaskLifecycleResponse) Reset()      { *m = ScheduledTaskLifecycleResponse{} }
+func (*ScheduledTaskLifecycleResponse) ProtoMessage() {}
+func (*ScheduledTaskLifecycleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58b4307d74d26a9f, []int{0}
+}
+func (m *ScheduledTaskLifecycleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledTaskLifecycleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledTaskLifecycleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledTaskLifecycleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledTaskLifecycleResponse.Merge(m, src)
+}
+func (m *ScheduledTaskLifecycleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledTaskLifecycleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledTaskLifecycleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledTaskLifecycleResponse proto.InternalMessageInfo
+
+func (m *ScheduledTaskLifecycleResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+type ScheduledTasksRequest struct {
+	Domain string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain"`
+}
+
+func (m *ScheduledTasksRequest) Reset()      { *m = ScheduledTasksRequest{} }
+func (*ScheduledTasksRequest) ProtoMessage() {}
+func (*ScheduledTasksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58b4307d74d26a9f, []int{1}
+}
+func (m *ScheduledTasksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledTasksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledTasksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledTasksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledTasksRequest.Merge(m, src)
+}
+func (m *ScheduledTasksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledTasksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledTasksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledTasksRequest proto.InternalMessageInfo
+
+func (m *ScheduledTasksRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+type ScheduledTasksResponse struct {
+	Error          *Error           `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	ScheduledTasks []*ScheduledTask `protobuf:"bytes,2,rep,name=scheduled_tasks,json=scheduledTasks,proto3" json:"scheduled_tasks,omitempty"`
+}
+
+func (m *ScheduledTasksResponse) Reset()      { *m = ScheduledTasksResponse{} }
+func (*ScheduledTasksResponse) ProtoMessage() {}
+func (*ScheduledTasksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58b4307d74d26a9f, []int{2}
+}
+func (m *ScheduledTasksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledTasksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledTasksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledTasksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledTasksResponse.Merge(m, src)
+}
+func (m *ScheduledTasksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledTasksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledTasksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledTasksResponse proto.InternalMessageInfo
+
+func (m *ScheduledTasksResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *ScheduledTasksResponse) GetScheduledTasks() []*ScheduledTask {
+	if m != nil {
+		return m.ScheduledTasks
+	}
+	return nil
+}
+
+type ScheduledTaskByGuidRequest struct {
+	Guid string `protobuf:"bytes,1,opt,name=guid,proto3" json:"guid"`
+}
+
+func (m *ScheduledTaskByGuidRequest) Reset()      { *m = ScheduledTaskByGuidRequest{} }
+func (*ScheduledTaskByGuidRequest) ProtoMessage() {}
+func (*ScheduledTaskByGuidRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58b4307d74d26a9f, []int{3}
+}
+func (m *ScheduledTaskByGuidRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledTaskByGuidRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledTaskByGuidRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledTaskByGuidRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledTaskByGuidRequest.Merge(m, src)
+}
+func (m *ScheduledTaskByGuidRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledTaskByGuidRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledTaskByGuidRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledTaskByGuidRequest proto.InternalMessageInfo
+
+func (m *ScheduledTaskByGuidRequest) GetGuid() string {
+	if m != nil {
+		return m.Guid
+	}
+	return ""
+}
+
+type ScheduledTaskResponse struct {
+	Error         *Error         `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	ScheduledTask *ScheduledTask `protobuf:"bytes,2,opt,name=scheduled_task,json=scheduledTask,proto3" json:"scheduled_task,omitempty"`
+}
+
+func (m *ScheduledTaskResponse) Reset()      { *m = ScheduledTaskResponse{} }
+func (*ScheduledTaskResponse) ProtoMessage() {}
+func (*ScheduledTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58b4307d74d26a9f, []int{4}
+}
+func (m *ScheduledTaskResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledTaskResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledTaskResponse.Merge(m, src)
+}
+func (m *ScheduledTaskResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledTaskResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledTaskResponse proto.InternalMessageInfo
+
+func (m *ScheduledTaskResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *ScheduledTaskResponse) GetScheduledTask() *ScheduledTask {
+	if m != nil {
+		return m.ScheduledTask
+	}
+	return nil
+}
+
+type DesireScheduledTaskRequest struct {
+	ScheduledTask *ScheduledTask `protobuf:"bytes,1,opt,name=scheduled_task,json=scheduledTask,proto3" json:"scheduled_task,omitempty"`
+}
+
+func (m *DesireScheduledTaskRequest) Reset()      { *m = DesireScheduledTaskRequest{} }
+func (*DesireScheduledTaskRequest) ProtoMessage() {}
+func (*DesireScheduledTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58b4307d74d26a9f, []int{5}
+}
+func (m *DesireScheduledTaskRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DesireScheduledTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DesireScheduledTaskRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DesireScheduledTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DesireScheduledTaskRequest.Merge(m, src)
+}
+func (m *DesireScheduledTaskRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DesireScheduledTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DesireScheduledTaskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DesireScheduledTaskRequest proto.InternalMessageInfo
+
+func (m *DesireScheduledTaskRequest) GetScheduledTask() *ScheduledTask {
+	if m != nil {
+		return m.ScheduledTask
+	}
+	return nil
+}
+
+type UpdateScheduledTaskRequest struct {
+	ScheduledTask *ScheduledTask `protobuf:"bytes,1,opt,name=scheduled_task,json=scheduledTask,proto3" json:"scheduled_task,omitempty"`
+}
+
+func (m *UpdateScheduledTaskRequest) Reset()      { *m = UpdateScheduledTaskRequest{} }
+func (*UpdateScheduledTaskRequest) ProtoMessage() {}
+func (*UpdateScheduledTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58b4307d74d26a9f, []int{6}
+}
+func (m *UpdateScheduledTaskRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateScheduledTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateScheduledTaskRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateScheduledTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateScheduledTaskRequest.Merge(m, src)
+}
+func (m *UpdateScheduledTaskRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateScheduledTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateScheduledTaskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateScheduledTaskRequest proto.InternalMessageInfo
+
+func (m *UpdateScheduledTaskRequest) GetScheduledTask() *ScheduledTask {
+	if m != nil {
+		return m.ScheduledTask
+	}
+	return nil
+}
+
+type DeleteScheduledTaskRequest struct {
+	Guid string `protobuf:"bytes,1,opt,name=guid,proto3" json:"guid"`
+}
+
+func (m *DeleteScheduledTaskRequest) Reset()      { *m = DeleteScheduledTaskRequest{} }
+func (*DeleteScheduledTaskRequest) ProtoMessage() {}
+func (*DeleteScheduledTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58b4307d74d26a9f, []int{7}
+}
+func (m *DeleteScheduledTaskRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteScheduledTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteScheduledTaskRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteScheduledTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteScheduledTaskRequest.Merge(m, src)
+}
+func (m *DeleteScheduledTaskRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteScheduledTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteScheduledTaskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteScheduledTaskRequest proto.InternalMessageInfo
+
+func (m *DeleteScheduledTaskRequest) GetGuid() string {
+	if m != nil {
+		return m.Guid
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ScheduledTaskLifecycleResponse)(nil), "models.ScheduledTaskLifecycleResponse")
+	proto.RegisterType((*ScheduledTasksRequest)(nil), "models.ScheduledTasksRequest")
+	proto.RegisterType((*ScheduledTasksResponse)(nil), "models.ScheduledTasksResponse")
+	proto.RegisterType((*ScheduledTaskByGuidRequest)(nil), "models.ScheduledTaskByGuidRequest")
+	proto.RegisterType((*ScheduledTaskResponse)(nil), "models.ScheduledTaskResponse")
+	proto.RegisterType((*DesireScheduledTaskRequest)(nil), "models.DesireScheduledTaskRequest")
+	proto.RegisterType((*UpdateScheduledTaskRequest)(nil), "models.UpdateScheduledTaskRequest")
+	proto.RegisterType((*DeleteScheduledTaskRequest)(nil), "models.DeleteScheduledTaskRequest")
+}
+
+func init() { proto.RegisterFile("scheduled_task_requests.proto", fileDescriptor_58b4307d74d26a9f) }
+
+var fileDescriptor_58b4307d74d26a9f = []byte{
+	// 379 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x92, 0x31, 0x4f, 0xfa, 0x40,
+	0x18, 0xc6, 0x7b, 0xfc, 0xff, 0x12, 0x3d, 0x02, 0x26, 0x8d, 0x18, 0xd2, 0xe8, 0x0b, 0xa9, 0x0b,
+	0x8b, 0x25, 0x41, 0x27, 0x34, 0x0e, 0x44, 0xe2, 0xe2, 0x54, 0x75, 0x71, 0x21, 0xa5, 0x3d, 0x4a,
+	0x43, 0xe1, 0xb0, 0xd7, 0x0e, 0x98, 0x98, 0xf8, 0x11, 0xfc, 0x18, 0x7e, 0x14, 0x47, 0x46, 0x26,
+	0x22, 0xc7, 0x62, 0x98, 0xf8, 0x08, 0x86, 0x6b, 0x19, 0x0e, 0x83, 0x09, 0x83, 0x4b, 0xdb, 0xf7,
+	0xbd, 0xf7, 0x79, 0xde, 0xdf, 0xd3, 0x1c, 0x3e, 0x66, 0x76, 0x87, 0x38, 0x91, 0x4f, 0x9c, 0x66,
+	0x68, 0xb1, 0x6e, 0x33, 0x20, 0x4f, 0x11, 0x61, 0x21, 0x33, 0x06, 0x01, 0x0d, 0xa9, 0x9a, 0xee,
+	0x51, 0x87, 0xf8, 0x4c, 0x3b, 0x75, 0xbd, 0xb0, 0x13, 0xb5, 0x0c, 0x9b, 0xf6, 0x2a, 0x2e, 0x75,
+	0x69, 0x45, 0x1c, 0xb7, 0xa2, 0xb6, 0xa8, 0x44, 0x21, 0xbe, 0x62, 0x99, 0x76, 0x20, 0xbb, 0x26,
+	0xdd, 0x0c, 0x09, 0x02, 0x1a, 0xc4, 0x85, 0xde, 0xc0, 0x70, 0xb7, 0x1a, 0xba, 0xb7, 0x58, 0xf7,
+	0xd6, 0x6b, 0x13, 0x7b, 0x68, 0xfb, 0xc4, 0x24, 0x6c, 0x40, 0xfb, 0x8c, 0xa8, 0x27, 0x78, 0x47,
+	0x08, 0x0a, 0xa8, 0x84, 0xca, 0x99, 0x6a, 0xd6, 0x88, 0x59, 0x8c, 0xc6, 0xb2, 0x69, 0xc6, 0x67,
+	0xfa, 0x05, 0xce, 0x4b, 0x36, 0xcc, 0x8c, 0x03, 0xa8, 0x3a, 0x4e, 0x3b, 0xb4, 0x67, 0x79, 0x7d,
+	0x21, 0xdf, 0xab, 0xe3, 0xf9, 0xa4, 0x98, 0x74, 0xcc, 0xe4, 0xad, 0xbf, 0xe0, 0xc3, 0x75, 0xf1,
+	0x16, 0xbb, 0xd5, 0x2b, 0xbc, 0x2f, 0xe7, 0x64, 0x85, 0x54, 0xe9, 0x5f, 0x39, 0x53, 0xcd, 0xaf,
+	0xc6, 0x25, 0x77, 0x33, 0xc7, 0xa4, 0x65, 0x7a, 0x0d, 0x6b, 0xd2, 0x40, 0x7d, 0x78, 0x13, 0x79,
+	0xce, 0x2a, 0xc0, 0x11, 0xfe, 0xef, 0x46, 0x9e, 0x93, 0xe0, 0xef, 0xce, 0x27, 0x45, 0x51, 0x9b,
+	0xe2, 0xa9, 0x3f, 0xaf, 0xe5, 0xde, 0x8e, 0xfc, 0x12, 0xe7, 0x64, 0xf2, 0x42, 0xaa, 0x84, 0x36,
+	0x83, 0x67, 0x25, 0x70, 0xfd, 0x11, 0x6b, 0xd7, 0x84, 0x79, 0x01, 0x59, 0x23, 0x88, 0xb9, 0x7f,
+	0x7a, 0xa3, 0xed, 0xbc, 0x1f, 0x06, 0x8e, 0x15, 0xfe, 0x85, 0x77, 0x6d, 0xc9, 0xed, 0x93, 0x0d,
+	0xde, 0xbf, 0xfe, 0xef, 0xfa, 0xf9, 0x68, 0x0a, 0xca, 0x78, 0x0a, 0xca, 0x62, 0x0a, 0xe8, 0x95,
+	0x03, 0x7a, 0xe7, 0x80, 0x3e, 0x38, 0xa0, 0x11, 0x07, 0xf4, 0xc9, 0x01, 0x7d, 0x71, 0x50, 0x16,
+	0x1c, 0xd0, 0xdb, 0x0c, 0x94, 0xd1, 0x0c, 0x94, 0xf1, 0x0c, 0x94, 0x56, 0x5a, 0xdc, 0xf5, 0xb3,
+	0xef, 0x01, 0x00, 0x66, 0x0f, 0x62, 0xb6, 0x66, 0x03, 0x00, 0x00,
+}
+
+func (this *ScheduledTaskLifecycleResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScheduledTaskLifecycleResponse)
+	if !ok {
+		that2, ok := that.(ScheduledTaskLifecycleResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	return true
+}
+func (this *ScheduledTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScheduledTasksRequest)
+	if !ok {
+		that2, ok := that.(ScheduledTasksRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Domain != that1.Domain {
+		return false
+	}
+	return true
+}
+func (this *ScheduledTasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScheduledTasksResponse)
+	if !ok {
+		that2, ok := that.(ScheduledTasksResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if len(this.ScheduledTasks) != len(that1.ScheduledTasks) {
+		return false
+	}
+	for i := range this.ScheduledTasks {
+		if !this.ScheduledTasks[i].Equal(that1.ScheduledTasks[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ScheduledTaskByGuidRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScheduledTaskByGuidRequest)
+	if !ok {
+		that2, ok := that.(ScheduledTaskByGuidRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Guid != that1.Guid {
+		return false
+	}
+	return true
+}
+func (this *ScheduledTaskResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScheduledTaskResponse)
+	if !ok {
+		that2, ok := that.(ScheduledTaskResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if !this.ScheduledTask.Equal(that1.ScheduledTask) {
+		return false
+	}
+	return true
+}
+func (this *DesireScheduledTaskRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DesireScheduledTaskRequest)
+	if !ok {
+		that2, ok := that.(DesireScheduledTaskRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ScheduledTask.Equal(that1.ScheduledTask) {
+		return false
+	}
+	return true
+}
+func (this *UpdateScheduledTaskRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpdateScheduledTaskRequest)
+	if !ok {
+		that2, ok := that.(UpdateScheduledTaskRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ScheduledTask.Equal(that1.ScheduledTask) {
+		return false
+	}
+	return true
+}
+func (this *DeleteScheduledTaskRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeleteScheduledTaskRequest)
+	if !ok {
+		that2, ok := that.(DeleteScheduledTaskRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Guid != that1.Guid {
+		return false
+	}
+	return true
+}
+func (this *ScheduledTaskLifecycleResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.ScheduledTaskLifecycleResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ScheduledTasksRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.ScheduledTasksRequest{")
+	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ScheduledTasksResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.ScheduledTasksResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.ScheduledTasks != nil {
+		s = append(s, "ScheduledTasks: "+fmt.Sprintf("%#v", this.ScheduledTasks)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ScheduledTaskByGuidRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.ScheduledTaskByGuidRequest{")
+	s = append(s, "Guid: "+fmt.Sprintf("%#v", this.Guid)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ScheduledTaskResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.ScheduledTaskResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.ScheduledTask != nil {
+		s = append(s, "ScheduledTask: "+fmt.Sprintf("%#v", this.ScheduledTask)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DesireScheduledTaskRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.DesireScheduledTaskRequest{")
+	if this.ScheduledTask != nil {
+		s = append(s, "ScheduledTask: "+fmt.Sprintf("%#v", this.ScheduledTask)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UpdateScheduledTaskRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.UpdateScheduledTaskRequest{")
+	if this.ScheduledTask != nil {
+		s = append(s, "ScheduledTask: "+fmt.Sprintf("%#v", this.ScheduledTask)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DeleteScheduledTaskRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.DeleteScheduledTaskRequest{")
+	s = append(s, "Guid: "+fmt.Sprintf("%#v", this.Guid)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringScheduledTaskRequests(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *ScheduledTaskLifecycleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledTaskLifecycleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledTaskLifecycleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintScheduledTaskRequests(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledTasksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledTasksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintScheduledTaskRequests(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledTasksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledTasksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScheduledTasks) > 0 {
+		for iNdEx := len(m.ScheduledTasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledTasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintScheduledTaskRequests(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintScheduledTaskRequests(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledTaskByGuidRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledTaskByGuidRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledTaskByGuidRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Guid) > 0 {
+		i -= len(m.Guid)
+		copy(dAtA[i:], m.Guid)
+		i = encodeVarintScheduledTaskRequests(dAtA, i, uint64(len(m.Guid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ScheduledTask != nil {
+		{
+			size, err := m.ScheduledTask.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintScheduledTaskRequests(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintScheduledTaskRequests(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DesireScheduledTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DesireScheduledTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DesireScheduledTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ScheduledTask != nil {
+		{
+			size, err := m.ScheduledTask.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintScheduledTaskRequests(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateScheduledTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateScheduledTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateScheduledTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ScheduledTask != nil {
+		{
+			size, err := m.ScheduledTask.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintScheduledTaskRequests(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteScheduledTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteScheduledTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteScheduledTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Guid) > 0 {
+		i -= len(m.Guid)
+		copy(dAtA[i:], m.Guid)
+		i = encodeVarintScheduledTaskRequests(dAtA, i, uint64(len(m.Guid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintScheduledTaskRequests(dAtA []byte, offset int, v uint64) int {
+	offset -= sovScheduledTaskRequests(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ScheduledTaskLifecycleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovScheduledTaskRequests(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduledTasksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovScheduledTaskRequests(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduledTasksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovScheduledTaskRequests(uint64(l))
+	}
+	if len(m.ScheduledTasks) > 0 {
+		for _, e := range m.ScheduledTasks {
+			l = e.Size()
+			n += 1 + l + sovScheduledTaskRequests(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ScheduledTaskByGuidRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Guid)
+	if l > 0 {
+		n += 1 + l + sovScheduledTaskRequests(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduledTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovScheduledTaskRequests(uint64(l))
+	}
+	if m.ScheduledTask != nil {
+		l = m.ScheduledTask.Size()
+		n += 1 + l + sovScheduledTaskRequests(uint64(l))
+	}
+	return n
+}
+
+func (m *DesireScheduledTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScheduledTask != nil {
+		l = m.ScheduledTask.Size()
+		n += 1 + l + sovScheduledTaskRequests(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateScheduledTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScheduledTask != nil {
+		l = m.ScheduledTask.Size()
+		n += 1 + l + sovScheduledTaskRequests(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteScheduledTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Guid)
+	if l > 0 {
+		n += 1 + l + sovScheduledTaskRequests(uint64(l))
+	}
+	return n
+}
+
+func sovScheduledTaskRequests(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozScheduledTaskRequests(x uint64) (n int) {
+	return sovScheduledTaskRequests(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ScheduledTaskLifecycleResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScheduledTaskLifecycleResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScheduledTasksRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScheduledTasksRequest{`,
+		`Domain:` + fmt.Sprintf("%v", this.Domain) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScheduledTasksResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForScheduledTasks := "[]*ScheduledTask{"
+	for _, f := range this.ScheduledTasks {
+		repeatedStringForScheduledTasks += strings.Replace(fmt.Sprintf("%v", f), "ScheduledTask", "ScheduledTask", 1) + ","
+	}
+	repeatedStringForScheduledTasks += "}"
+	s := strings.Join([]string{`&ScheduledTasksResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`ScheduledTasks:` + repeatedStringForScheduledTasks + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScheduledTaskByGuidRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScheduledTaskByGuidRequest{`,
+		`Guid:` + fmt.Sprintf("%v", this.Guid) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScheduledTaskResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScheduledTaskResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`ScheduledTask:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledTask), "ScheduledTask", "ScheduledTask", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DesireScheduledTaskRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DesireScheduledTaskRequest{`,
+		`ScheduledTask:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledTask), "ScheduledTask", "ScheduledTask", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateScheduledTaskRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateScheduledTaskRequest{`,
+		`ScheduledTask:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledTask), "ScheduledTask", "ScheduledTask", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteScheduledTaskRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteScheduledTaskRequest{`,
+		`Guid:` + fmt.Sprintf("%v", this.Guid) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringScheduledTaskRequests(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ScheduledTaskLifecycleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduledTaskRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledTaskLifecycleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledTaskLifecycleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduledTaskRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledTasksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduledTaskRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledTasksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduledTaskRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledTasksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduledTaskRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledTasksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledTasks = append(m.ScheduledTasks, &ScheduledTask{})
+			if err := m.ScheduledTasks[len(m.ScheduledTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduledTaskRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledTaskByGuidRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduledTaskRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledTaskByGuidRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledTaskByGuidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduledTaskRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduledTaskRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledTask == nil {
+				m.ScheduledTask = &ScheduledTask{}
+			}
+			if err := m.ScheduledTask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduledTaskRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DesireScheduledTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduledTaskRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DesireScheduledTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DesireScheduledTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledTask == nil {
+				m.ScheduledTask = &ScheduledTask{}
+			}
+			if err := m.ScheduledTask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduledTaskRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateScheduledTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduledTaskRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateScheduledTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateScheduledTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledTask == nil {
+				m.ScheduledTask = &ScheduledTask{}
+			}
+			if err := m.ScheduledTask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduledTaskRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteScheduledTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduledTaskRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteScheduledTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteScheduledTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduledTaskRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipScheduledTaskRequests(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowScheduledTaskRequests
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowScheduledTaskRequests
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowScheduledTaskRequests
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthScheduledTaskRequests
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupScheduledTaskRequests
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthScheduledTaskRequests
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthScheduledTaskRequests        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowScheduledTaskRequests          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupScheduledTaskRequests = fmt.Errorf("proto: unexpected end of group")
+)
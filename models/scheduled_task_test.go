@@ -0,0 +1,131 @@
+package models_test
+
+import (
+	"encoding/json"
+	"time"
+
+	"code.cloudfoundry.org/bbs/models"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ScheduledTask", func() {
+	var scheduledTask *models.ScheduledTask
+
+	BeforeEach(func() {
+		scheduledTask = &models.ScheduledTask{
+			Guid:              "some-guid",
+			Domain:            "some-domain",
+			Schedule:          "*/5 * * * *",
+			TimeZone:          "Europe/London",
+			ConcurrencyPolicy: models.ScheduledTask_Forbid,
+			TaskDefinition: &models.TaskDefinition{
+				RootFs: "some:rootfs",
+				Action: models.WrapAction(&models.RunAction{
+					Path: "ls",
+					User: "me",
+				}),
+			},
+		}
+	})
+
+	Describe("Validate", func() {
+		It("is valid", func() {
+			Expect(scheduledTask.Validate()).To(Succeed())
+		})
+
+		It("defaults the time zone to UTC", func() {
+			scheduledTask.TimeZone = ""
+			Expect(scheduledTask.Validate()).To(Succeed())
+
+			location, err := scheduledTask.Location()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(location).To(Equal(time.UTC))
+		})
+
+		DescribeTable("invalid fields",
+			func(mutate func(*models.ScheduledTask), field string) {
+				mutate(scheduledTask)
+				err := scheduledTask.Validate()
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(field))
+			},
+			Entry("guid", func(t *models.ScheduledTask) { t.Guid = "invalid/guid" }, "guid"),
+			Entry("domain", func(t *models.ScheduledTask) { t.Domain = "" }, "domain"),
+			Entry("schedule", func(t *models.ScheduledTask) { t.Schedule = "every day" }, "schedule"),
+			Entry("time_zone", func(t *models.ScheduledTask) { t.TimeZone = "Mars/Olympus_Mons" }, "time_zone"),
+			Entry("concurrency_policy", func(t *models.ScheduledTask) { t.ConcurrencyPolicy = 42 }, "concurrency_policy"),
+			Entry("history_limit", func(t *models.ScheduledTask) { t.HistoryLimit = -1 }, "history_limit"),
+			Entry("task_definition", func(t *models.ScheduledTask) { t.TaskDefinition = nil }, "task_definition"),
+			Entry("an invalid task_definition", func(t *models.ScheduledTask) { t.TaskDefinition.RootFs = "" }, "rootfs"),
+		)
+	})
+
+	Describe("EffectiveHistoryLimit", func() {
+		It("defaults when unset", func() {
+			Expect(scheduledTask.EffectiveHistoryLimit()).To(Equal(models.DefaultScheduledTaskHistoryLimit))
+		})
+
+		It("returns the configured limit", func() {
+			scheduledTask.HistoryLimit = 3
+			Expect(scheduledTask.EffectiveHistoryLimit()).To(Equal(3))
+		})
+	})
+
+	Describe("RunTaskGuid", func() {
+		It("derives the task guid from the scheduled time", func() {
+			scheduledAt := time.Unix(1700000000, 0)
+			Expect(scheduledTask.RunTaskGuid(scheduledAt)).To(Equal("some-guid-1700000000"))
+		})
+	})
+
+	Describe("ConcurrencyPolicy JSON", func() {
+		It("marshals and unmarshals as a string", func() {
+			data, err := json.Marshal(models.ScheduledTask_Replace)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(data)).To(Equal(`"Replace"`))
+
+			var policy models.ScheduledTask_ConcurrencyPolicy
+			Expect(json.Unmarshal(data, &policy)).To(Succeed())
+			Expect(policy).To(Equal(models.ScheduledTask_Replace))
+		})
+
+		It("rejects unknown policies", func() {
+			var policy models.ScheduledTask_ConcurrencyPolicy
+			Expect(json.Unmarshal([]byte(`"Sometimes"`), &policy)).To(MatchError("invalid concurrency policy: Sometimes"))
+		})
+	})
+})
+
+var _ = Describe("ScheduledTask Requests", func() {
+	Describe("DesireScheduledTaskRequest", func() {
+		It("requires a scheduled task", func() {
+			request := models.DesireScheduledTaskRequest{}
+			Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"scheduled_task"}))
+		})
+	})
+
+	Describe("UpdateScheduledTaskRequest", func() {
+		It("requires a scheduled task", func() {
+			request := models.UpdateScheduledTaskRequest{}
+			Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"scheduled_task"}))
+		})
+	})
+
+	Describe("ScheduledTaskByGuidRequest", func() {
+		It("requires a valid guid", func() {
+			request := models.ScheduledTaskByGuidRequest{}
+			Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"guid"}))
+		})
+	})
+
+	Describe("DeleteScheduledTaskRequest", func() {
+		It("requires a valid guid", func() {
+			request := models.DeleteScheduledTaskRequest{Guid: "some-guid"}
+			Expect(request.Validate()).To(Succeed())
+
+			request.Guid = ""
+			Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"guid"}))
+		})
+	})
+})
@@ -18,9 +18,26 @@ func (s Sidecar) Validate() error {
 		validationError = validationError.Append(ErrInvalidField{"disk_mb"})
 	}
 
+	if s.CheckDefinition != nil {
+		if err := s.CheckDefinition.Validate(); err != nil {
+			validationError = validationError.Append(ErrInvalidField{"check_definition"})
+			validationError = validationError.Append(err)
+		}
+	}
+
 	return validationError
 }
 
+// SidecarResources returns the memory and disk the sidecars need on top of
+// the main process of the LRP.
+func SidecarResources(sidecars []*Sidecar) (memoryMb, diskMb int32) {
+	for _, s := range sidecars {
+		memoryMb += s.GetMemoryMb()
+		diskMb += s.GetDiskMb()
+	}
+	return memoryMb, diskMb
+}
+
 func validateSidecars(sidecars []*Sidecar) ValidationError {
 	var validationError ValidationError
 
@@ -26,9 +26,10 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Sidecar struct {
-	Action   *Action `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
-	DiskMb   int32   `protobuf:"varint,2,opt,name=disk_mb,json=diskMb,proto3" json:"disk_mb"`
-	MemoryMb int32   `protobuf:"varint,3,opt,name=memory_mb,json=memoryMb,proto3" json:"memory_mb"`
+	Action          *Action          `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	DiskMb          int32            `protobuf:"varint,2,opt,name=disk_mb,json=diskMb,proto3" json:"disk_mb"`
+	MemoryMb        int32            `protobuf:"varint,3,opt,name=memory_mb,json=memoryMb,proto3" json:"memory_mb"`
+	CheckDefinition *CheckDefinition `protobuf:"bytes,4,opt,name=check_definition,json=checkDefinition,proto3" json:"check_definition,omitempty"`
 }
 
 func (m *Sidecar) Reset()      { *m = Sidecar{} }
@@ -84,6 +85,13 @@ func (m *Sidecar) GetMemoryMb() int32 {
 	return 0
 }
 
+func (m *Sidecar) GetCheckDefinition() *CheckDefinition {
+	if m != nil {
+		return m.CheckDefinition
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Sidecar)(nil), "models.Sidecar")
 }
@@ -91,22 +99,25 @@ func init() {
 func init() { proto.RegisterFile("sidecar.proto", fileDescriptor_179ad3b13e6397ec) }
 
 var fileDescriptor_179ad3b13e6397ec = []byte{
-	// 239 bytes of a gzipped FileDescriptorProto
+	// 278 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x2d, 0xce, 0x4c, 0x49,
 	0x4d, 0x4e, 0x2c, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0xcb, 0xcd, 0x4f, 0x49, 0xcd,
 	0x29, 0x96, 0xd2, 0x4d, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x4f, 0xcf,
 	0x4f, 0xcf, 0xd7, 0x07, 0x4b, 0x27, 0x95, 0xa6, 0x81, 0x79, 0x60, 0x0e, 0x98, 0x05, 0xd1, 0x26,
-	0xc5, 0x9b, 0x98, 0x5c, 0x92, 0x99, 0x9f, 0x57, 0x0c, 0xe1, 0x2a, 0x35, 0x33, 0x72, 0xb1, 0x07,
-	0x43, 0xcc, 0x15, 0x52, 0xe3, 0x62, 0x83, 0x48, 0x4a, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0xf1,
-	0xe9, 0x41, 0xac, 0xd0, 0x73, 0x04, 0x8b, 0x06, 0x41, 0x65, 0x85, 0x54, 0xb8, 0xd8, 0x53, 0x32,
-	0x8b, 0xb3, 0xe3, 0x73, 0x93, 0x24, 0x98, 0x14, 0x18, 0x35, 0x58, 0x9d, 0xb8, 0x5f, 0xdd, 0x93,
-	0x87, 0x09, 0x05, 0xb1, 0x81, 0x18, 0xbe, 0x49, 0x42, 0x5a, 0x5c, 0x9c, 0xb9, 0xa9, 0xb9, 0xf9,
-	0x45, 0x95, 0x20, 0x75, 0xcc, 0x60, 0x75, 0xbc, 0xaf, 0xee, 0xc9, 0x23, 0x04, 0x83, 0x38, 0x20,
-	0x4c, 0xdf, 0x24, 0x27, 0x93, 0x0b, 0x0f, 0xe5, 0x18, 0x6e, 0x3c, 0x94, 0x63, 0xf8, 0xf0, 0x50,
-	0x8e, 0xb1, 0xe1, 0x91, 0x1c, 0xe3, 0x8a, 0x47, 0x72, 0x8c, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78,
-	0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x8b, 0x47, 0x72, 0x0c, 0x1f, 0x1e, 0xc9, 0x31, 0x4e,
-	0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x49, 0x6c, 0x60, 0x2f,
-	0x18, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xf3, 0x28, 0x34, 0x4f, 0x19, 0x01, 0x00, 0x00,
+	0xc5, 0x9b, 0x98, 0x5c, 0x92, 0x99, 0x9f, 0x57, 0x0c, 0xe5, 0x8a, 0x25, 0x67, 0xa4, 0x26, 0x67,
+	0xc7, 0xa7, 0xa4, 0xa6, 0x65, 0xe6, 0x65, 0x82, 0x24, 0x20, 0xe2, 0x4a, 0xc7, 0x19, 0xb9, 0xd8,
+	0x83, 0x21, 0xf6, 0x09, 0xa9, 0x71, 0xb1, 0x41, 0x34, 0x49, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b,
+	0xf1, 0xe9, 0x41, 0xac, 0xd6, 0x73, 0x04, 0x8b, 0x06, 0x41, 0x65, 0x85, 0x54, 0xb8, 0xd8, 0x53,
+	0x32, 0x8b, 0xb3, 0xe3, 0x73, 0x93, 0x24, 0x98, 0x14, 0x18, 0x35, 0x58, 0x9d, 0xb8, 0x5f, 0xdd,
+	0x93, 0x87, 0x09, 0x05, 0xb1, 0x81, 0x18, 0xbe, 0x49, 0x42, 0x5a, 0x5c, 0x9c, 0xb9, 0xa9, 0xb9,
+	0xf9, 0x45, 0x95, 0x20, 0x75, 0xcc, 0x60, 0x75, 0xbc, 0xaf, 0xee, 0xc9, 0x23, 0x04, 0x83, 0x38,
+	0x20, 0x4c, 0xdf, 0x24, 0x21, 0x27, 0x2e, 0x01, 0x74, 0xf7, 0x49, 0xb0, 0x80, 0xdd, 0x20, 0x0e,
+	0x73, 0x83, 0x33, 0x48, 0xde, 0x05, 0x2e, 0x1d, 0xc4, 0x9f, 0x8c, 0x2a, 0xe0, 0x64, 0x72, 0xe1,
+	0xa1, 0x1c, 0xc3, 0x8d, 0x87, 0x72, 0x0c, 0x1f, 0x1e, 0xca, 0x31, 0x36, 0x3c, 0x92, 0x63, 0x5c,
+	0xf1, 0x48, 0x8e, 0xf1, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63,
+	0x7c, 0xf1, 0x48, 0x8e, 0xe1, 0xc3, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96,
+	0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x89, 0x0d, 0x1c, 0x0c, 0xc6, 0x80, 0x01, 0x00, 0x47, 0x99,
+	0x3d, 0xf3, 0x75, 0x01, 0x00, 0x00,
 }
 
 func (this *Sidecar) Equal(that interface{}) bool {
@@ -137,19 +148,25 @@ func (this *Sidecar) Equal(that interface{}) bool {
 	if this.MemoryMb != that1.MemoryMb {
 		return false
 	}
+	if !this.CheckDefinition.Equal(that1.CheckDefinition) {
+		return false
+	}
 	return true
 }
 func (this *Sidecar) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
+	s := make([]string, 0, 8)
 	s = append(s, "&models.Sidecar{")
 	if this.Action != nil {
 		s = append(s, "Action: "+fmt.Sprintf("%#v", this.Action)+",\n")
 	}
 	s = append(s, "DiskMb: "+fmt.Sprintf("%#v", this.DiskMb)+",\n")
 	s = append(s, "MemoryMb: "+fmt.Sprintf("%#v", this.MemoryMb)+",\n")
+	if this.CheckDefinition != nil {
+		s = append(s, "CheckDefinition: "+fmt.Sprintf("%#v", this.CheckDefinition)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -181,6 +198,18 @@ func (m *Sidecar) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CheckDefinition != nil {
+		{
+			size, err := m.CheckDefinition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSidecar(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.MemoryMb != 0 {
 		i = encodeVarintSidecar(dAtA, i, uint64(m.MemoryMb))
 		i--
@@ -233,6 +262,10 @@ func (m *Sidecar) Size() (n int) {
 	if m.MemoryMb != 0 {
 		n += 1 + sovSidecar(uint64(m.MemoryMb))
 	}
+	if m.CheckDefinition != nil {
+		l = m.CheckDefinition.Size()
+		n += 1 + l + sovSidecar(uint64(l))
+	}
 	return n
 }
 
@@ -250,6 +283,7 @@ func (this *Sidecar) String() string {
 		`Action:` + strings.Replace(fmt.Sprintf("%v", this.Action), "Action", "Action", 1) + `,`,
 		`DiskMb:` + fmt.Sprintf("%v", this.DiskMb) + `,`,
 		`MemoryMb:` + fmt.Sprintf("%v", this.MemoryMb) + `,`,
+		`CheckDefinition:` + strings.Replace(fmt.Sprintf("%v", this.CheckDefinition), "CheckDefinition", "CheckDefinition", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -365,6 +399,42 @@ func (m *Sidecar) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckDefinition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSidecar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSidecar
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSidecar
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CheckDefinition == nil {
+				m.CheckDefinition = &CheckDefinition{}
+			}
+			if err := m.CheckDefinition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSidecar(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package models_test
 
 import (
 	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -58,5 +59,36 @@ var _ = Describe("Sidecar", func() {
 			sidecar.DiskMb = -1
 			assertSidecarValidationFailsWithMessage(sidecar, "disk_mb")
 		})
+
+		It("allows a valid check definition", func() {
+			sidecar.Action = model_helpers.NewValidAction()
+			sidecar.CheckDefinition = model_helpers.NewValidHTTPCheckDefinition()
+			Expect(sidecar.Validate()).To(Succeed())
+		})
+
+		It("requires a valid check definition", func() {
+			sidecar.Action = model_helpers.NewValidAction()
+			sidecar.CheckDefinition = &models.CheckDefinition{
+				Checks: []*models.Check{{HttpCheck: &models.HTTPCheck{Port: 65536}}},
+			}
+			assertSidecarValidationFailsWithMessage(sidecar, "check_definition")
+		})
+	})
+
+	Describe("SidecarResources", func() {
+		It("sums the resources of the sidecars", func() {
+			memoryMb, diskMb := models.SidecarResources([]*models.Sidecar{
+				{MemoryMb: 64, DiskMb: 32},
+				{MemoryMb: 16},
+			})
+			Expect(memoryMb).To(BeEquivalentTo(80))
+			Expect(diskMb).To(BeEquivalentTo(32))
+		})
+
+		It("returns nothing without sidecars", func() {
+			memoryMb, diskMb := models.SidecarResources(nil)
+			Expect(memoryMb).To(BeZero())
+			Expect(diskMb).To(BeZero())
+		})
 	})
 })
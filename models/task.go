@@ -3,8 +3,11 @@ package models
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"net/url"
 	"regexp"
+	"strconv"
+	"time"
 
 	"code.cloudfoundry.org/bbs/format"
 	"code.cloudfoundry.org/lager/v3"
@@ -12,14 +15,65 @@ import (
 
 var taskGuidPattern = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
 
+const (
+	// TaskArrayIndexEnvVar is the environment variable holding the index of a
+	// Task within its task array.
+	TaskArrayIndexEnvVar = "INDEX"
+
+	MaxTaskArraySize = 1000
+)
+
 type TaskChange struct {
 	Before *Task
 	After  *Task
 }
 
 type TaskFilter struct {
-	Domain string
-	CellID string
+	Domain         string
+	CellID         string
+	IncludeResults bool
+}
+
+// TaskArrayTaskGuid returns the guid of the Task at the given index of a task
+// array.
+func TaskArrayTaskGuid(arrayGuid string, index int32) string {
+	return fmt.Sprintf("%s-%d", arrayGuid, index)
+}
+
+// TaskArrayTaskDefinition returns a copy of the TaskDefinition for the Task at
+// the given index of a task array, with the index exposed in its environment.
+func TaskArrayTaskDefinition(taskDef *TaskDefinition, index int32) *TaskDefinition {
+	newTaskDef := taskDef.Copy()
+	newTaskDef.EnvironmentVariables = make([]*EnvironmentVariable, 0, len(taskDef.EnvironmentVariables)+1)
+	newTaskDef.EnvironmentVariables = append(newTaskDef.EnvironmentVariables, taskDef.EnvironmentVariables...)
+	newTaskDef.EnvironmentVariables = append(newTaskDef.EnvironmentVariables, &EnvironmentVariable{
+		Name:  TaskArrayIndexEnvVar,
+		Value: strconv.Itoa(int(index)),
+	})
+	return newTaskDef
+}
+
+// NewTaskArrayStatus summarizes the states of the Tasks in a task array.
+func NewTaskArrayStatus(arrayGuid string, tasks []*Task) *TaskArrayStatus {
+	status := &TaskArrayStatus{ArrayGuid: arrayGuid}
+	for _, task := range tasks {
+		status.Total++
+		switch task.State {
+		case Task_Blocked:
+			status.Blocked++
+		case Task_Pending:
+			status.Pending++
+		case Task_Running:
+			status.Running++
+		case Task_Completed, Task_Resolving:
+			if task.Failed {
+				status.Failed++
+			} else {
+				status.Succeeded++
+			}
+		}
+	}
+	return status
 }
 
 func (t *Task) LagerData() lager.Data {
@@ -31,6 +85,24 @@ func (t *Task) LagerData() lager.Data {
 	}
 }
 
+// ShouldRetry reports whether the Task should be re-queued after failing with
+// the given failure class instead of being completed.
+func (t *Task) ShouldRetry(failureClass RetryPolicy_FailureClass) bool {
+	if t.TaskDefinition == nil || t.TaskDefinition.RetryPolicy == nil {
+		return false
+	}
+	return t.TaskDefinition.RetryPolicy.ShouldRetry(failureClass, len(t.Attempts)+1)
+}
+
+// IsActive reports whether the Task has not yet completed.
+func (t *Task) IsActive() bool {
+	switch t.State {
+	case Task_Blocked, Task_Pending, Task_Running:
+		return true
+	}
+	return false
+}
+
 func (task *Task) Validate() error {
 	var validationError ValidationError
 
@@ -65,6 +137,8 @@ func (t *Task) ValidateTransitionTo(to Task_State) error {
 	var valid bool
 	from := t.State
 	switch to {
+	case Task_Pending:
+		valid = from == Task_Blocked
 	case Task_Running:
 		valid = from == Task_Pending
 	case Task_Completed:
@@ -128,6 +202,14 @@ func (def *TaskDefinition) Validate() error {
 		validationError = validationError.Append(ErrInvalidField{"max_pids"})
 	}
 
+	if def.MaxRunDurationMs < 0 || def.MaxRunDurationMs > maxRunDurationMs {
+		validationError = validationError.Append(ErrInvalidField{"max_run_duration_ms"})
+	}
+
+	if def.Priority < 0 {
+		validationError = validationError.Append(ErrInvalidField{"priority"})
+	}
+
 	if len(def.Annotation) > maximumAnnotationLength {
 		validationError = validationError.Append(ErrInvalidField{"annotation"})
 	}
@@ -147,6 +229,23 @@ func (def *TaskDefinition) Validate() error {
 		validationError = validationError.Append(ErrInvalidField{"image_password"})
 	}
 
+	for _, guid := range def.DependsOn {
+		if !taskGuidPattern.MatchString(guid) {
+			validationError = validationError.Append(ErrInvalidField{"depends_on"})
+			break
+		}
+	}
+
+	if _, ok := TaskDefinition_DependencyCondition_name[int32(def.DependencyCondition)]; !ok {
+		validationError = validationError.Append(ErrInvalidField{"dependency_condition"})
+	}
+
+	if def.RetryPolicy != nil {
+		if err := def.RetryPolicy.Validate(); err != nil {
+			validationError = validationError.Append(err)
+		}
+	}
+
 	err := validateCachedDependencies(def.CachedDependencies)
 	if err != nil {
 		validationError = validationError.Append(err)
@@ -204,3 +303,120 @@ func (s *Task_State) UnmarshalJSON(data []byte) error {
 func (s Task_State) MarshalJSON() ([]byte, error) {
 	return json.Marshal(s.String())
 }
+
+func (c *TaskDefinition_DependencyCondition) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return err
+	}
+
+	if v, found := TaskDefinition_DependencyCondition_value[name]; found {
+		*c = TaskDefinition_DependencyCondition(v)
+		return nil
+	}
+	return fmt.Errorf("invalid dependency condition: %s", name)
+}
+
+func (c TaskDefinition_DependencyCondition) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.String())
+}
+
+// maxRunDurationMs is the longest run duration that can be represented as a
+// time.Duration.
+const maxRunDurationMs = int64(math.MaxInt64 / time.Millisecond)
+
+// MaxRunDuration returns how long the Task may run before convergence fails
+// it, or 0 if the Task may run indefinitely.
+func (def *TaskDefinition) MaxRunDuration() time.Duration {
+	return time.Duration(def.MaxRunDurationMs) * time.Millisecond
+}
+
+// maxRetryBackoffDoublings bounds the exponent of the retry backoff so that
+// it cannot overflow.
+const maxRetryBackoffDoublings = 30
+
+func (p *RetryPolicy) Validate() error {
+	var validationError ValidationError
+
+	if p.MaxAttempts < 0 {
+		validationError = validationError.Append(ErrInvalidField{"retry_policy.max_attempts"})
+	}
+
+	if p.BackoffMs < 0 {
+		validationError = validationError.Append(ErrInvalidField{"retry_policy.backoff_ms"})
+	}
+
+	if p.MaxBackoffMs < 0 {
+		validationError = validationError.Append(ErrInvalidField{"retry_policy.max_backoff_ms"})
+	}
+
+	for _, failureClass := range p.RetryOn {
+		if _, ok := RetryPolicy_FailureClass_name[int32(failureClass)]; !ok {
+			validationError = validationError.Append(ErrInvalidField{"retry_policy.retry_on"})
+			break
+		}
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
+// ShouldRetry reports whether a Task that has failed failedAttempts times,
+// the last time with the given failure class, should be attempted again.
+// An empty RetryOn retries any failure.
+func (p *RetryPolicy) ShouldRetry(failureClass RetryPolicy_FailureClass, failedAttempts int) bool {
+	if failedAttempts >= int(p.MaxAttempts) {
+		return false
+	}
+
+	if len(p.RetryOn) == 0 {
+		return true
+	}
+
+	for _, retryOn := range p.RetryOn {
+		if retryOn == RetryPolicy_AnyFailure || retryOn == failureClass {
+			return true
+		}
+	}
+	return false
+}
+
+// Backoff returns how long to wait before the next attempt of a Task that has
+// failed failedAttempts times. The backoff doubles with every failed attempt,
+// up to MaxBackoffMs if it is set.
+func (p *RetryPolicy) Backoff(failedAttempts int) time.Duration {
+	doublings := failedAttempts - 1
+	if doublings < 0 {
+		doublings = 0
+	}
+	if doublings > maxRetryBackoffDoublings {
+		doublings = maxRetryBackoffDoublings
+	}
+
+	backoff := time.Duration(p.BackoffMs) * time.Millisecond << uint(doublings)
+	maxBackoff := time.Duration(p.MaxBackoffMs) * time.Millisecond
+	if backoff < 0 || (maxBackoff > 0 && backoff > maxBackoff) {
+		return maxBackoff
+	}
+	return backoff
+}
+
+func (c *RetryPolicy_FailureClass) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return err
+	}
+
+	if v, found := RetryPolicy_FailureClass_value[name]; found {
+		*c = RetryPolicy_FailureClass(v)
+		return nil
+	}
+	return fmt.Errorf("invalid failure class: %s", name)
+}
+
+func (c RetryPolicy_FailureClass) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.String())
+}
@@ -27,6 +27,54 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type TaskDefinition_DependencyCondition int32
+
+const (
+	TaskDefinition_OnSuccess    TaskDefinition_DependencyCondition = 0
+	TaskDefinition_OnCompletion TaskDefinition_DependencyCondition = 1
+)
+
+var TaskDefinition_DependencyCondition_name = map[int32]string{
+	0: "OnSuccess",
+	1: "OnCompletion",
+}
+
+var TaskDefinition_DependencyCondition_value = map[string]int32{
+	"OnSuccess":    0,
+	"OnCompletion": 1,
+}
+
+func (TaskDefinition_DependencyCondition) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ce5d8dd45b4a91ff, []int{0, 0}
+}
+
+type RetryPolicy_FailureClass int32
+
+const (
+	RetryPolicy_AnyFailure  RetryPolicy_FailureClass = 0
+	RetryPolicy_CellLost    RetryPolicy_FailureClass = 1
+	RetryPolicy_NonZeroExit RetryPolicy_FailureClass = 2
+	RetryPolicy_Overdue     RetryPolicy_FailureClass = 3
+)
+
+var RetryPolicy_FailureClass_name = map[int32]string{
+	0: "AnyFailure",
+	1: "CellLost",
+	2: "NonZeroExit",
+	3: "Overdue",
+}
+
+var RetryPolicy_FailureClass_value = map[string]int32{
+	"AnyFailure":  0,
+	"CellLost":    1,
+	"NonZeroExit": 2,
+	"Overdue":     3,
+}
+
+func (RetryPolicy_FailureClass) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ce5d8dd45b4a91ff, []int{1, 0}
+}
+
 type Task_State int32
 
 const (
@@ -35,6 +83,7 @@ const (
 	Task_Running   Task_State = 2
 	Task_Completed Task_State = 3
 	Task_Resolving Task_State = 4
+	Task_Blocked   Task_State = 5
 )
 
 var Task_State_name = map[int32]string{
@@ -43,6 +92,7 @@ var Task_State_name = map[int32]string{
 	2: "Running",
 	3: "Completed",
 	4: "Resolving",
+	5: "Blocked",
 }
 
 var Task_State_value = map[string]int32{
@@ -51,40 +101,46 @@ var Task_State_value = map[string]int32{
 	"Running":   2,
 	"Completed": 3,
 	"Resolving": 4,
+	"Blocked":   5,
 }
 
 func (Task_State) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_ce5d8dd45b4a91ff, []int{1, 0}
+	return fileDescriptor_ce5d8dd45b4a91ff, []int{3, 0}
 }
 
 type TaskDefinition struct {
-	RootFs                        string                     `protobuf:"bytes,1,opt,name=root_fs,json=rootFs,proto3" json:"rootfs"`
-	EnvironmentVariables          []*EnvironmentVariable     `protobuf:"bytes,2,rep,name=environment_variables,json=environmentVariables,proto3" json:"env,omitempty"`
-	Action                        *Action                    `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
-	DiskMb                        int32                      `protobuf:"varint,4,opt,name=disk_mb,json=diskMb,proto3" json:"disk_mb"`
-	MemoryMb                      int32                      `protobuf:"varint,5,opt,name=memory_mb,json=memoryMb,proto3" json:"memory_mb"`
-	CpuWeight                     uint32                     `protobuf:"varint,6,opt,name=cpu_weight,json=cpuWeight,proto3" json:"cpu_weight"`
-	Privileged                    bool                       `protobuf:"varint,7,opt,name=privileged,proto3" json:"privileged"`
-	LogSource                     string                     `protobuf:"bytes,8,opt,name=log_source,json=logSource,proto3" json:"log_source"`
-	LogGuid                       string                     `protobuf:"bytes,9,opt,name=log_guid,json=logGuid,proto3" json:"log_guid"`
-	MetricsGuid                   string                     `protobuf:"bytes,10,opt,name=metrics_guid,json=metricsGuid,proto3" json:"metrics_guid"`
-	ResultFile                    string                     `protobuf:"bytes,11,opt,name=result_file,json=resultFile,proto3" json:"result_file"`
-	CompletionCallbackUrl         string                     `protobuf:"bytes,12,opt,name=completion_callback_url,json=completionCallbackUrl,proto3" json:"completion_callback_url,omitempty"`
-	Annotation                    string                     `protobuf:"bytes,13,opt,name=annotation,proto3" json:"annotation,omitempty"`
-	EgressRules                   []*SecurityGroupRule       `protobuf:"bytes,14,rep,name=egress_rules,json=egressRules,proto3" json:"egress_rules,omitempty"`
-	CachedDependencies            []*CachedDependency        `protobuf:"bytes,15,rep,name=cached_dependencies,json=cachedDependencies,proto3" json:"cached_dependencies,omitempty"`
-	LegacyDownloadUser            string                     `protobuf:"bytes,16,opt,name=legacy_download_user,json=legacyDownloadUser,proto3" json:"legacy_download_user,omitempty"` // Deprecated: Do not use.
-	TrustedSystemCertificatesPath string                     `protobuf:"bytes,17,opt,name=trusted_system_certificates_path,json=trustedSystemCertificatesPath,proto3" json:"trusted_system_certificates_path,omitempty"`
-	VolumeMounts                  []*VolumeMount             `protobuf:"bytes,18,rep,name=volume_mounts,json=volumeMounts,proto3" json:"volume_mounts,omitempty"`
-	Network                       *Network                   `protobuf:"bytes,19,opt,name=network,proto3" json:"network,omitempty"`
-	PlacementTags                 []string                   `protobuf:"bytes,20,rep,name=placement_tags,json=placementTags,proto3" json:"placement_tags,omitempty"`
-	MaxPids                       int32                      `protobuf:"varint,21,opt,name=max_pids,json=maxPids,proto3" json:"max_pids"`
-	CertificateProperties         *CertificateProperties     `protobuf:"bytes,22,opt,name=certificate_properties,json=certificateProperties,proto3" json:"certificate_properties,omitempty"`
-	ImageUsername                 string                     `protobuf:"bytes,23,opt,name=image_username,json=imageUsername,proto3" json:"image_username"`
-	ImagePassword                 string                     `protobuf:"bytes,24,opt,name=image_password,json=imagePassword,proto3" json:"image_password"`
-	ImageLayers                   []*ImageLayer              `protobuf:"bytes,25,rep,name=image_layers,json=imageLayers,proto3" json:"image_layers,omitempty"`
-	LogRateLimit                  *LogRateLimit              `protobuf:"bytes,26,opt,name=log_rate_limit,json=logRateLimit,proto3" json:"log_rate_limit,omitempty"`
-	MetricTags                    map[string]*MetricTagValue `protobuf:"bytes,27,rep,name=metric_tags,json=metricTags,proto3" json:"metric_tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	RootFs                        string                             `protobuf:"bytes,1,opt,name=root_fs,json=rootFs,proto3" json:"rootfs"`
+	EnvironmentVariables          []*EnvironmentVariable             `protobuf:"bytes,2,rep,name=environment_variables,json=environmentVariables,proto3" json:"env,omitempty"`
+	Action                        *Action                            `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	DiskMb                        int32                              `protobuf:"varint,4,opt,name=disk_mb,json=diskMb,proto3" json:"disk_mb"`
+	MemoryMb                      int32                              `protobuf:"varint,5,opt,name=memory_mb,json=memoryMb,proto3" json:"memory_mb"`
+	CpuWeight                     uint32                             `protobuf:"varint,6,opt,name=cpu_weight,json=cpuWeight,proto3" json:"cpu_weight"`
+	Privileged                    bool                               `protobuf:"varint,7,opt,name=privileged,proto3" json:"privileged"`
+	LogSource                     string                             `protobuf:"bytes,8,opt,name=log_source,json=logSource,proto3" json:"log_source"`
+	LogGuid                       string                             `protobuf:"bytes,9,opt,name=log_guid,json=logGuid,proto3" json:"log_guid"`
+	MetricsGuid                   string                             `protobuf:"bytes,10,opt,name=metrics_guid,json=metricsGuid,proto3" json:"metrics_guid"`
+	ResultFile                    string                             `protobuf:"bytes,11,opt,name=result_file,json=resultFile,proto3" json:"result_file"`
+	CompletionCallbackUrl         string                             `protobuf:"bytes,12,opt,name=completion_callback_url,json=completionCallbackUrl,proto3" json:"completion_callback_url,omitempty"`
+	Annotation                    string                             `protobuf:"bytes,13,opt,name=annotation,proto3" json:"annotation,omitempty"`
+	EgressRules                   []*SecurityGroupRule               `protobuf:"bytes,14,rep,name=egress_rules,json=egressRules,proto3" json:"egress_rules,omitempty"`
+	CachedDependencies            []*CachedDependency                `protobuf:"bytes,15,rep,name=cached_dependencies,json=cachedDependencies,proto3" json:"cached_dependencies,omitempty"`
+	LegacyDownloadUser            string                             `protobuf:"bytes,16,opt,name=legacy_download_user,json=legacyDownloadUser,proto3" json:"legacy_download_user,omitempty"` // Deprecated: Do not use.
+	TrustedSystemCertificatesPath string                             `protobuf:"bytes,17,opt,name=trusted_system_certificates_path,json=trustedSystemCertificatesPath,proto3" json:"trusted_system_certificates_path,omitempty"`
+	VolumeMounts                  []*VolumeMount                     `protobuf:"bytes,18,rep,name=volume_mounts,json=volumeMounts,proto3" json:"volume_mounts,omitempty"`
+	Network                       *Network                           `protobuf:"bytes,19,opt,name=network,proto3" json:"network,omitempty"`
+	PlacementTags                 []string                           `protobuf:"bytes,20,rep,name=placement_tags,json=placementTags,proto3" json:"placement_tags,omitempty"`
+	MaxPids                       int32                              `protobuf:"varint,21,opt,name=max_pids,json=maxPids,proto3" json:"max_pids"`
+	CertificateProperties         *CertificateProperties             `protobuf:"bytes,22,opt,name=certificate_properties,json=certificateProperties,proto3" json:"certificate_properties,omitempty"`
+	ImageUsername                 string                             `protobuf:"bytes,23,opt,name=image_username,json=imageUsername,proto3" json:"image_username"`
+	ImagePassword                 string                             `protobuf:"bytes,24,opt,name=image_password,json=imagePassword,proto3" json:"image_password"`
+	ImageLayers                   []*ImageLayer                      `protobuf:"bytes,25,rep,name=image_layers,json=imageLayers,proto3" json:"image_layers,omitempty"`
+	LogRateLimit                  *LogRateLimit                      `protobuf:"bytes,26,opt,name=log_rate_limit,json=logRateLimit,proto3" json:"log_rate_limit,omitempty"`
+	MetricTags                    map[string]*MetricTagValue         `protobuf:"bytes,27,rep,name=metric_tags,json=metricTags,proto3" json:"metric_tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	DependsOn                     []string                           `protobuf:"bytes,28,rep,name=depends_on,json=dependsOn,proto3" json:"depends_on,omitempty"`
+	DependencyCondition           TaskDefinition_DependencyCondition `protobuf:"varint,29,opt,name=dependency_condition,json=dependencyCondition,proto3,enum=models.TaskDefinition_DependencyCondition" json:"dependency_condition,omitempty"`
+	RetryPolicy                   *RetryPolicy                       `protobuf:"bytes,30,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	MaxRunDurationMs              int64                              `protobuf:"varint,31,opt,name=max_run_duration_ms,json=maxRunDurationMs,proto3" json:"max_run_duration_ms,omitempty"`
+	Priority                      int32                              `protobuf:"varint,32,opt,name=priority,proto3" json:"priority,omitempty"`
 }
 
 func (m *TaskDefinition) Reset()      { *m = TaskDefinition{} }
@@ -309,26 +365,199 @@ func (m *TaskDefinition) GetMetricTags() map[string]*MetricTagValue {
 	return nil
 }
 
+func (m *TaskDefinition) GetDependsOn() []string {
+	if m != nil {
+		return m.DependsOn
+	}
+	return nil
+}
+
+func (m *TaskDefinition) GetDependencyCondition() TaskDefinition_DependencyCondition {
+	if m != nil {
+		return m.DependencyCondition
+	}
+	return TaskDefinition_OnSuccess
+}
+
+func (m *TaskDefinition) GetRetryPolicy() *RetryPolicy {
+	if m != nil {
+		return m.RetryPolicy
+	}
+	return nil
+}
+
+func (m *TaskDefinition) GetMaxRunDurationMs() int64 {
+	if m != nil {
+		return m.MaxRunDurationMs
+	}
+	return 0
+}
+
+func (m *TaskDefinition) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+type RetryPolicy struct {
+	MaxAttempts  int32                      `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts"`
+	BackoffMs    int64                      `protobuf:"varint,2,opt,name=backoff_ms,json=backoffMs,proto3" json:"backoff_ms,omitempty"`
+	MaxBackoffMs int64                      `protobuf:"varint,3,opt,name=max_backoff_ms,json=maxBackoffMs,proto3" json:"max_backoff_ms,omitempty"`
+	RetryOn      []RetryPolicy_FailureClass `protobuf:"varint,4,rep,packed,name=retry_on,json=retryOn,proto3,enum=models.RetryPolicy_FailureClass" json:"retry_on,omitempty"`
+}
+
+func (m *RetryPolicy) Reset()      { *m = RetryPolicy{} }
+func (*RetryPolicy) ProtoMessage() {}
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce5d8dd45b4a91ff, []int{1}
+}
+func (m *RetryPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetryPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetryPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RetryPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryPolicy.Merge(m, src)
+}
+func (m *RetryPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetryPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryPolicy proto.InternalMessageInfo
+
+func (m *RetryPolicy) GetMaxAttempts() int32 {
+	if m != nil {
+		return m.MaxAttempts
+	}
+	return 0
+}
+
+func (m *RetryPolicy) GetBackoffMs() int64 {
+	if m != nil {
+		return m.BackoffMs
+	}
+	return 0
+}
+
+func (m *RetryPolicy) GetMaxBackoffMs() int64 {
+	if m != nil {
+		return m.MaxBackoffMs
+	}
+	return 0
+}
+
+func (m *RetryPolicy) GetRetryOn() []RetryPolicy_FailureClass {
+	if m != nil {
+		return m.RetryOn
+	}
+	return nil
+}
+
+type TaskAttempt struct {
+	CellId        string                   `protobuf:"bytes,1,opt,name=cell_id,json=cellId,proto3" json:"cell_id"`
+	FailureClass  RetryPolicy_FailureClass `protobuf:"varint,2,opt,name=failure_class,json=failureClass,proto3,enum=models.RetryPolicy_FailureClass" json:"failure_class"`
+	FailureReason string                   `protobuf:"bytes,3,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason"`
+	FailedAt      int64                    `protobuf:"varint,4,opt,name=failed_at,json=failedAt,proto3" json:"failed_at"`
+}
+
+func (m *TaskAttempt) Reset()      { *m = TaskAttempt{} }
+func (*TaskAttempt) ProtoMessage() {}
+func (*TaskAttempt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce5d8dd45b4a91ff, []int{2}
+}
+func (m *TaskAttempt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskAttempt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskAttempt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskAttempt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskAttempt.Merge(m, src)
+}
+func (m *TaskAttempt) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskAttempt) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskAttempt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskAttempt proto.InternalMessageInfo
+
+func (m *TaskAttempt) GetCellId() string {
+	if m != nil {
+		return m.CellId
+	}
+	return ""
+}
+
+func (m *TaskAttempt) GetFailureClass() RetryPolicy_FailureClass {
+	if m != nil {
+		return m.FailureClass
+	}
+	return RetryPolicy_AnyFailure
+}
+
+func (m *TaskAttempt) GetFailureReason() string {
+	if m != nil {
+		return m.FailureReason
+	}
+	return ""
+}
+
+func (m *TaskAttempt) GetFailedAt() int64 {
+	if m != nil {
+		return m.FailedAt
+	}
+	return 0
+}
+
 type Task struct {
 	*TaskDefinition  `protobuf:"bytes,1,opt,name=task_definition,json=taskDefinition,proto3,embedded=task_definition" json:""`
-	TaskGuid         string     `protobuf:"bytes,2,opt,name=task_guid,json=taskGuid,proto3" json:"task_guid"`
-	Domain           string     `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain"`
-	CreatedAt        int64      `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
-	UpdatedAt        int64      `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
-	FirstCompletedAt int64      `protobuf:"varint,6,opt,name=first_completed_at,json=firstCompletedAt,proto3" json:"first_completed_at"`
-	State            Task_State `protobuf:"varint,7,opt,name=state,proto3,enum=models.Task_State" json:"state"`
-	CellId           string     `protobuf:"bytes,8,opt,name=cell_id,json=cellId,proto3" json:"cell_id"`
-	Result           string     `protobuf:"bytes,9,opt,name=result,proto3" json:"result"`
-	Failed           bool       `protobuf:"varint,10,opt,name=failed,proto3" json:"failed"`
-	FailureReason    string     `protobuf:"bytes,11,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason"`
-	RejectionCount   int32      `protobuf:"varint,12,opt,name=rejection_count,json=rejectionCount,proto3" json:"rejection_count"`
-	RejectionReason  string     `protobuf:"bytes,13,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason"`
+	TaskGuid         string         `protobuf:"bytes,2,opt,name=task_guid,json=taskGuid,proto3" json:"task_guid"`
+	Domain           string         `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain"`
+	CreatedAt        int64          `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt        int64          `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	FirstCompletedAt int64          `protobuf:"varint,6,opt,name=first_completed_at,json=firstCompletedAt,proto3" json:"first_completed_at"`
+	State            Task_State     `protobuf:"varint,7,opt,name=state,proto3,enum=models.Task_State" json:"state"`
+	CellId           string         `protobuf:"bytes,8,opt,name=cell_id,json=cellId,proto3" json:"cell_id"`
+	Result           string         `protobuf:"bytes,9,opt,name=result,proto3" json:"result"`
+	Failed           bool           `protobuf:"varint,10,opt,name=failed,proto3" json:"failed"`
+	FailureReason    string         `protobuf:"bytes,11,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason"`
+	RejectionCount   int32          `protobuf:"varint,12,opt,name=rejection_count,json=rejectionCount,proto3" json:"rejection_count"`
+	RejectionReason  string         `protobuf:"bytes,13,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason"`
+	ArrayGuid        string         `protobuf:"bytes,14,opt,name=array_guid,json=arrayGuid,proto3" json:"array_guid,omitempty"`
+	ArrayIndex       int32          `protobuf:"varint,15,opt,name=array_index,json=arrayIndex,proto3" json:"array_index,omitempty"`
+	Attempts         []*TaskAttempt `protobuf:"bytes,16,rep,name=attempts,proto3" json:"attempts,omitempty"`
+	RetryAt          int64          `protobuf:"varint,17,opt,name=retry_at,json=retryAt,proto3" json:"retry_at,omitempty"`
 }
 
 func (m *Task) Reset()      { *m = Task{} }
 func (*Task) ProtoMessage() {}
 func (*Task) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce5d8dd45b4a91ff, []int{1}
+	return fileDescriptor_ce5d8dd45b4a91ff, []int{3}
 }
 func (m *Task) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -441,104 +670,274 @@ func (m *Task) GetRejectionReason() string {
 	return ""
 }
 
+func (m *Task) GetArrayGuid() string {
+	if m != nil {
+		return m.ArrayGuid
+	}
+	return ""
+}
+
+func (m *Task) GetArrayIndex() int32 {
+	if m != nil {
+		return m.ArrayIndex
+	}
+	return 0
+}
+
+func (m *Task) GetAttempts() []*TaskAttempt {
+	if m != nil {
+		return m.Attempts
+	}
+	return nil
+}
+
+func (m *Task) GetRetryAt() int64 {
+	if m != nil {
+		return m.RetryAt
+	}
+	return 0
+}
+
+type TaskArrayStatus struct {
+	ArrayGuid string `protobuf:"bytes,1,opt,name=array_guid,json=arrayGuid,proto3" json:"array_guid"`
+	Total     int32  `protobuf:"varint,2,opt,name=total,proto3" json:"total"`
+	Blocked   int32  `protobuf:"varint,3,opt,name=blocked,proto3" json:"blocked"`
+	Pending   int32  `protobuf:"varint,4,opt,name=pending,proto3" json:"pending"`
+	Running   int32  `protobuf:"varint,5,opt,name=running,proto3" json:"running"`
+	Succeeded int32  `protobuf:"varint,6,opt,name=succeeded,proto3" json:"succeeded"`
+	Failed    int32  `protobuf:"varint,7,opt,name=failed,proto3" json:"failed"`
+}
+
+func (m *TaskArrayStatus) Reset()      { *m = TaskArrayStatus{} }
+func (*TaskArrayStatus) ProtoMessage() {}
+func (*TaskArrayStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce5d8dd45b4a91ff, []int{4}
+}
+func (m *TaskArrayStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskArrayStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskArrayStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskArrayStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskArrayStatus.Merge(m, src)
+}
+func (m *TaskArrayStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskArrayStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskArrayStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskArrayStatus proto.InternalMessageInfo
+
+func (m *TaskArrayStatus) GetArrayGuid() string {
+	if m != nil {
+		return m.ArrayGuid
+	}
+	return ""
+}
+
+func (m *TaskArrayStatus) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *TaskArrayStatus) GetBlocked() int32 {
+	if m != nil {
+		return m.Blocked
+	}
+	return 0
+}
+
+func (m *TaskArrayStatus) GetPending() int32 {
+	if m != nil {
+		return m.Pending
+	}
+	return 0
+}
+
+func (m *TaskArrayStatus) GetRunning() int32 {
+	if m != nil {
+		return m.Running
+	}
+	return 0
+}
+
+func (m *TaskArrayStatus) GetSucceeded() int32 {
+	if m != nil {
+		return m.Succeeded
+	}
+	return 0
+}
+
+func (m *TaskArrayStatus) GetFailed() int32 {
+	if m != nil {
+		return m.Failed
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("models.TaskDefinition_DependencyCondition", TaskDefinition_DependencyCondition_name, TaskDefinition_DependencyCondition_value)
+	proto.RegisterEnum("models.RetryPolicy_FailureClass", RetryPolicy_FailureClass_name, RetryPolicy_FailureClass_value)
 	proto.RegisterEnum("models.Task_State", Task_State_name, Task_State_value)
 	proto.RegisterType((*TaskDefinition)(nil), "models.TaskDefinition")
 	proto.RegisterMapType((map[string]*MetricTagValue)(nil), "models.TaskDefinition.MetricTagsEntry")
+	proto.RegisterType((*RetryPolicy)(nil), "models.RetryPolicy")
+	proto.RegisterType((*TaskAttempt)(nil), "models.TaskAttempt")
 	proto.RegisterType((*Task)(nil), "models.Task")
+	proto.RegisterType((*TaskArrayStatus)(nil), "models.TaskArrayStatus")
 }
 
 func init() { proto.RegisterFile("task.proto", fileDescriptor_ce5d8dd45b4a91ff) }
 
 var fileDescriptor_ce5d8dd45b4a91ff = []byte{
-	// 1351 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x56, 0xcd, 0x6e, 0xdb, 0xc6,
-	0x16, 0x36, 0xed, 0x48, 0xb6, 0x46, 0x3f, 0x96, 0xc7, 0x3f, 0x61, 0x9c, 0x1b, 0x51, 0xf0, 0xbd,
-	0x37, 0x75, 0x83, 0xc4, 0x29, 0x92, 0xb4, 0x48, 0x83, 0x00, 0x85, 0x65, 0x27, 0x86, 0x01, 0xbb,
-	0x30, 0xc6, 0x71, 0xba, 0x24, 0x46, 0xe4, 0x88, 0x9e, 0x9a, 0xe4, 0x10, 0x33, 0x43, 0x39, 0xda,
-	0xf5, 0x11, 0xfa, 0x14, 0x45, 0x1f, 0xa5, 0x4b, 0x2f, 0xb3, 0x22, 0x1a, 0x67, 0x53, 0x68, 0x95,
-	0x47, 0x28, 0x66, 0x38, 0xa4, 0x24, 0xc7, 0x2b, 0x9d, 0xf3, 0x7d, 0xdf, 0x99, 0x9f, 0x33, 0x3c,
-	0xe7, 0x08, 0x00, 0x89, 0xc5, 0xc5, 0x4e, 0xc2, 0x99, 0x64, 0xb0, 0x1a, 0x31, 0x9f, 0x84, 0x62,
-	0xf3, 0x49, 0x40, 0xe5, 0x79, 0xda, 0xdf, 0xf1, 0x58, 0xf4, 0x34, 0x60, 0x01, 0x7b, 0xaa, 0xe9,
-	0x7e, 0x3a, 0xd0, 0x9e, 0x76, 0xb4, 0x95, 0x87, 0x6d, 0x36, 0xb1, 0x27, 0x29, 0x8b, 0x85, 0x71,
-	0xef, 0x93, 0x78, 0x48, 0x39, 0x8b, 0x23, 0x12, 0x4b, 0x77, 0x88, 0x39, 0xc5, 0xfd, 0x90, 0x14,
-	0xe4, 0x9a, 0x20, 0x5e, 0xca, 0xa9, 0x1c, 0xb9, 0x01, 0x67, 0x69, 0x62, 0xd0, 0xbb, 0x1e, 0xf6,
-	0xce, 0x89, 0xef, 0xfa, 0x24, 0x21, 0xb1, 0x4f, 0x62, 0x6f, 0x64, 0x08, 0x38, 0x64, 0x61, 0x1a,
-	0x11, 0x37, 0x62, 0x69, 0x2c, 0x8b, 0xed, 0x62, 0x22, 0x2f, 0x19, 0x37, 0x87, 0xde, 0xfc, 0x8f,
-	0x47, 0xb8, 0xa4, 0x03, 0xea, 0x61, 0x49, 0xdc, 0x84, 0xb3, 0x44, 0xb9, 0xe5, 0x7e, 0x2b, 0x34,
-	0xc2, 0x01, 0x71, 0x43, 0x3c, 0x22, 0xbc, 0x38, 0x42, 0xc8, 0x02, 0x97, 0x2b, 0x75, 0x48, 0x23,
-	0x5a, 0xac, 0xba, 0x12, 0x11, 0xc9, 0xa9, 0xe7, 0x4a, 0x1c, 0x98, 0xd8, 0xad, 0x3f, 0x1a, 0xa0,
-	0xf5, 0x0e, 0x8b, 0x8b, 0x7d, 0x32, 0xa0, 0x31, 0x55, 0x57, 0x84, 0xff, 0x05, 0x8b, 0x9c, 0x31,
-	0xe9, 0x0e, 0x84, 0x6d, 0x75, 0xad, 0xed, 0x5a, 0x0f, 0x8c, 0x33, 0xa7, 0xaa, 0xa0, 0x81, 0x40,
-	0xfa, 0xf7, 0xad, 0x80, 0x1e, 0x58, 0xbf, 0x35, 0x05, 0xf6, 0x7c, 0x77, 0x61, 0xbb, 0xfe, 0xec,
-	0xfe, 0x4e, 0x9e, 0xe6, 0x9d, 0x37, 0x13, 0xd1, 0x7b, 0xa3, 0xe9, 0xad, 0x8c, 0x33, 0xa7, 0x49,
-	0xe2, 0xe1, 0x63, 0x16, 0x51, 0x49, 0xa2, 0x44, 0x8e, 0xd0, 0x1a, 0xf9, 0x5a, 0x27, 0xe0, 0x43,
-	0x50, 0xcd, 0xd3, 0x6e, 0x2f, 0x74, 0xad, 0xed, 0xfa, 0xb3, 0x56, 0xb1, 0xea, 0xae, 0x46, 0x91,
-	0x61, 0xe1, 0xff, 0xc0, 0xa2, 0x4f, 0xc5, 0x85, 0x1b, 0xf5, 0xed, 0x3b, 0x5d, 0x6b, 0xbb, 0xd2,
-	0xab, 0x8f, 0x33, 0xa7, 0x80, 0x50, 0x55, 0x19, 0xc7, 0x7d, 0xf8, 0x08, 0xd4, 0x22, 0x12, 0x31,
-	0x3e, 0x52, 0xba, 0x8a, 0xd6, 0x35, 0xc7, 0x99, 0x33, 0x01, 0xd1, 0x52, 0x6e, 0x1e, 0xf7, 0xe1,
-	0x13, 0x00, 0xbc, 0x24, 0x75, 0x2f, 0x09, 0x0d, 0xce, 0xa5, 0x5d, 0xed, 0x5a, 0xdb, 0xcd, 0x5e,
-	0x6b, 0x9c, 0x39, 0x53, 0x28, 0xaa, 0x79, 0x49, 0xfa, 0x8b, 0x36, 0xe1, 0x0e, 0x00, 0x09, 0xa7,
-	0x43, 0x1a, 0x92, 0x80, 0xf8, 0xf6, 0x62, 0xd7, 0xda, 0x5e, 0xca, 0xe5, 0x13, 0x14, 0x4d, 0xd9,
-	0x6a, 0x79, 0xf5, 0x40, 0x82, 0xa5, 0xdc, 0x23, 0xf6, 0x92, 0xce, 0xb2, 0xd6, 0x4f, 0x50, 0x54,
-	0x0b, 0x59, 0x70, 0xaa, 0x4d, 0xf8, 0x0d, 0x58, 0x52, 0x44, 0x90, 0x52, 0xdf, 0xae, 0x69, 0x71,
-	0x63, 0x9c, 0x39, 0x25, 0x86, 0x16, 0x43, 0x16, 0x1c, 0xa4, 0xd4, 0x87, 0xcf, 0x41, 0x23, 0x7f,
-	0x62, 0x91, 0x8b, 0x81, 0x16, 0xb7, 0xc7, 0x99, 0x33, 0x83, 0xa3, 0xba, 0xf1, 0x74, 0xd0, 0x77,
-	0xa0, 0xce, 0x89, 0x48, 0x43, 0xe9, 0x0e, 0x68, 0x48, 0xec, 0xba, 0x8e, 0x59, 0x1e, 0x67, 0xce,
-	0x34, 0x8c, 0x40, 0xee, 0xbc, 0xa5, 0x21, 0x81, 0x3f, 0x80, 0xbb, 0x1e, 0x8b, 0x92, 0x90, 0xa8,
-	0xec, 0xbb, 0x1e, 0x0e, 0xc3, 0x3e, 0xf6, 0x2e, 0xdc, 0x94, 0x87, 0x76, 0x43, 0x45, 0xa3, 0xf5,
-	0x09, 0xbd, 0x67, 0xd8, 0x33, 0x1e, 0xc2, 0x0e, 0x00, 0x38, 0x8e, 0x99, 0xc4, 0xfa, 0x4d, 0x9b,
-	0x5a, 0x3a, 0x85, 0xc0, 0xd7, 0xa0, 0x41, 0x02, 0x4e, 0x84, 0x70, 0x79, 0xaa, 0xbe, 0xa5, 0x96,
-	0xfe, 0x96, 0xee, 0x15, 0xaf, 0x7e, 0x6a, 0xca, 0xea, 0x40, 0x55, 0x15, 0x4a, 0x43, 0x82, 0xea,
-	0xb9, 0x5c, 0xd9, 0x02, 0x1e, 0x82, 0xd5, 0x9b, 0x25, 0x46, 0x89, 0xb0, 0x97, 0xf5, 0x22, 0x76,
-	0xb1, 0xc8, 0x9e, 0x96, 0xec, 0x97, 0x45, 0x88, 0xa0, 0x37, 0x8b, 0x50, 0x22, 0xe0, 0x0b, 0xb0,
-	0x16, 0x92, 0x00, 0x7b, 0x23, 0xd7, 0x67, 0x97, 0x71, 0xc8, 0xb0, 0xef, 0xa6, 0x82, 0x70, 0xbb,
-	0xad, 0x73, 0x33, 0x6f, 0x5b, 0x08, 0xe6, 0xfc, 0xbe, 0xa1, 0xcf, 0x04, 0xe1, 0xf0, 0x00, 0x74,
-	0x25, 0x4f, 0x85, 0x24, 0xbe, 0x2b, 0x46, 0x42, 0x92, 0xc8, 0x9d, 0x2a, 0x5b, 0xe1, 0x26, 0x58,
-	0x9e, 0xdb, 0x2b, 0xfa, 0xd2, 0x0f, 0x8c, 0xee, 0x54, 0xcb, 0xf6, 0xa6, 0x54, 0x27, 0x58, 0x9e,
-	0xc3, 0x97, 0xa0, 0x39, 0xdd, 0x13, 0x84, 0x0d, 0xf5, 0x1d, 0x56, 0x8b, 0x3b, 0xbc, 0xd7, 0xe4,
-	0xb1, 0xe2, 0x50, 0x63, 0x38, 0x71, 0x04, 0xfc, 0x16, 0x2c, 0x9a, 0xce, 0x61, 0xaf, 0xea, 0x92,
-	0x59, 0x2e, 0x62, 0x7e, 0xce, 0x61, 0x54, 0xf0, 0xf0, 0xff, 0xa0, 0x95, 0x84, 0xd8, 0x23, 0xba,
-	0x7e, 0x55, 0x47, 0xb0, 0xd7, 0xba, 0x0b, 0xdb, 0x35, 0xd4, 0x2c, 0xd1, 0x77, 0x38, 0x10, 0xea,
-	0xdb, 0x8b, 0xf0, 0x07, 0x37, 0xa1, 0xbe, 0xb0, 0xd7, 0x75, 0xd1, 0xe8, 0x6f, 0xaf, 0xc0, 0xd0,
-	0x62, 0x84, 0x3f, 0x9c, 0x50, 0x5f, 0xc0, 0x77, 0x60, 0xe3, 0xf6, 0x2e, 0x65, 0x6f, 0xe8, 0x93,
-	0x3c, 0x28, 0x5f, 0x60, 0xa2, 0x3a, 0x29, 0x45, 0x68, 0xdd, 0xbb, 0x0d, 0x86, 0x3f, 0x82, 0x56,
-	0xde, 0xdd, 0x54, 0xfe, 0x63, 0x1c, 0x11, 0xfb, 0xae, 0x7e, 0x03, 0x38, 0xce, 0x9c, 0x1b, 0x0c,
-	0x6a, 0x6a, 0xff, 0xcc, 0xb8, 0x93, 0xd0, 0x04, 0x0b, 0x71, 0xc9, 0xb8, 0x6f, 0xdb, 0x37, 0x43,
-	0x0b, 0xc6, 0x84, 0x9e, 0x18, 0x17, 0x7e, 0x0f, 0x1a, 0x53, 0x3d, 0x55, 0xd8, 0xf7, 0x74, 0xfe,
-	0x61, 0x71, 0x83, 0x43, 0xc5, 0x1d, 0x29, 0x0a, 0xd5, 0x69, 0x69, 0x0b, 0xf8, 0x0a, 0xb4, 0x66,
-	0xfb, 0xae, 0xbd, 0xa9, 0xaf, 0xbe, 0x56, 0x04, 0x1e, 0xb1, 0x00, 0x61, 0x49, 0x8e, 0x14, 0x87,
-	0x1a, 0xe1, 0x94, 0x07, 0x0f, 0x40, 0x7d, 0xaa, 0x3b, 0xdb, 0xf7, 0xf5, 0x8e, 0x0f, 0x8b, 0xc0,
-	0xd9, 0x16, 0xbd, 0x73, 0xac, 0x95, 0xea, 0x7d, 0xde, 0xc4, 0x92, 0x8f, 0x10, 0x88, 0x4a, 0x60,
-	0xf3, 0x0c, 0x2c, 0xdf, 0xa0, 0x61, 0x1b, 0x2c, 0x5c, 0x90, 0x51, 0xde, 0xcd, 0x91, 0x32, 0xe1,
-	0x63, 0x50, 0x19, 0xe2, 0x30, 0x25, 0xf6, 0xbc, 0x3e, 0xe0, 0x46, 0xb1, 0x4f, 0x19, 0xf9, 0x5e,
-	0xb1, 0x28, 0x17, 0xbd, 0x9a, 0x7f, 0x69, 0x6d, 0x7d, 0xa9, 0x80, 0x3b, 0xea, 0x14, 0xf0, 0x10,
-	0x2c, 0xab, 0x71, 0xea, 0xfa, 0xe5, 0x71, 0xf4, 0xc2, 0x53, 0x8b, 0xcc, 0x1e, 0xb6, 0xb7, 0x74,
-	0x95, 0x39, 0xd6, 0x38, 0x73, 0xe6, 0x50, 0x4b, 0xce, 0x4e, 0x9a, 0x47, 0xa0, 0xa6, 0x97, 0xd2,
-	0xbd, 0x6a, 0x5e, 0x3f, 0x8e, 0xee, 0xc8, 0x25, 0x88, 0x96, 0x94, 0xa9, 0xbb, 0xd4, 0x16, 0xa8,
-	0xfa, 0x2c, 0xc2, 0x34, 0x9f, 0x05, 0x66, 0x28, 0xe5, 0x08, 0x32, 0xbf, 0xba, 0x6b, 0x73, 0x82,
-	0x55, 0x01, 0x62, 0xa9, 0x47, 0xc1, 0x82, 0xe9, 0xda, 0x25, 0x8a, 0x6a, 0xc6, 0xde, 0x95, 0x4a,
-	0x9e, 0x26, 0x7e, 0x21, 0xaf, 0x4c, 0xe4, 0x13, 0x14, 0xd5, 0x8c, 0xbd, 0x2b, 0xe1, 0x3e, 0x80,
-	0x03, 0xca, 0x85, 0x74, 0x4d, 0x73, 0xcb, 0xc3, 0xaa, 0x3a, 0x6c, 0x63, 0x9c, 0x39, 0xb7, 0xb0,
-	0xa8, 0xad, 0xb1, 0xbd, 0x02, 0xda, 0x95, 0xf0, 0x39, 0xa8, 0x08, 0x89, 0x25, 0xd1, 0x53, 0xa2,
-	0x35, 0xf9, 0xa6, 0x54, 0xd2, 0x76, 0x4e, 0x15, 0xd3, 0xab, 0x8d, 0x33, 0x27, 0x17, 0xa1, 0xfc,
-	0x47, 0x0d, 0x38, 0x8f, 0x84, 0xa1, 0x4b, 0x7d, 0x33, 0x2c, 0xf4, 0x80, 0x33, 0x10, 0xaa, 0x2a,
-	0xe3, 0x50, 0xa7, 0x28, 0x6f, 0xd2, 0x66, 0x48, 0xe4, 0x73, 0x5b, 0x23, 0xc8, 0xfc, 0x2a, 0xcd,
-	0x00, 0xd3, 0x90, 0xe4, 0xb3, 0x61, 0x29, 0xd7, 0xe4, 0x08, 0x32, 0xbf, 0xaa, 0x70, 0x94, 0x95,
-	0x72, 0xe2, 0x72, 0x82, 0x05, 0x8b, 0xcd, 0x4c, 0xd0, 0x85, 0x33, 0xcb, 0xa0, 0xa6, 0xf1, 0x91,
-	0x76, 0xe1, 0x6b, 0xb0, 0xcc, 0xc9, 0xaf, 0xc4, 0xcb, 0x07, 0x83, 0xea, 0x49, 0x7a, 0x22, 0x54,
-	0x7a, 0xab, 0xe3, 0xcc, 0xb9, 0x49, 0xa1, 0x56, 0x09, 0xec, 0x29, 0x1f, 0xfe, 0x04, 0xda, 0x13,
-	0x89, 0xd9, 0x5a, 0x4f, 0x89, 0xde, 0xda, 0x38, 0x73, 0xbe, 0xe2, 0xd0, 0x64, 0xc1, 0x7c, 0xfb,
-	0xad, 0x23, 0x50, 0xd1, 0x29, 0x84, 0x75, 0xb0, 0x78, 0x18, 0x0f, 0x71, 0x48, 0xfd, 0xf6, 0x9c,
-	0x72, 0x4e, 0x48, 0xec, 0xd3, 0x38, 0x68, 0x5b, 0xca, 0x41, 0x69, 0x1c, 0x2b, 0x67, 0x1e, 0x36,
-	0x41, 0xad, 0x7c, 0x9b, 0xf6, 0x82, 0x72, 0x11, 0x11, 0x2c, 0x1c, 0x2a, 0xf6, 0x4e, 0xef, 0xc5,
-	0xd5, 0xa7, 0x8e, 0xf5, 0xf1, 0x53, 0x67, 0xee, 0xcb, 0xa7, 0x8e, 0xf5, 0xdb, 0x75, 0xc7, 0xfa,
-	0xf3, 0xba, 0x63, 0xfd, 0x75, 0xdd, 0xb1, 0xae, 0xae, 0x3b, 0xd6, 0xdf, 0xd7, 0x1d, 0xeb, 0x9f,
-	0xeb, 0xce, 0xdc, 0x97, 0xeb, 0x8e, 0xf5, 0xfb, 0xe7, 0xce, 0xdc, 0xd5, 0xe7, 0xce, 0xdc, 0xc7,
-	0xcf, 0x9d, 0xb9, 0x7e, 0x55, 0xff, 0xb1, 0x7a, 0xfe, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe5,
-	0x48, 0xdf, 0x94, 0x75, 0x0a, 0x00, 0x00,
+	// 1862 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x57, 0x5b, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd6, 0x92, 0xe6, 0x6d, 0x78, 0x11, 0x3d, 0x92, 0xed, 0xb1, 0x1c, 0x93, 0x04, 0x9b, 0xa4,
+	0x6c, 0x1a, 0xcb, 0x85, 0x9d, 0x1a, 0x49, 0x1a, 0xa0, 0x10, 0xe5, 0x0b, 0x54, 0x58, 0xb1, 0x30,
+	0xb2, 0xdd, 0x0b, 0x50, 0x2c, 0x46, 0xbb, 0x43, 0x6a, 0xab, 0xdd, 0x1d, 0x62, 0x66, 0x96, 0x16,
+	0xdf, 0xfa, 0xda, 0xb7, 0xfc, 0x8b, 0xf6, 0xa7, 0xf4, 0xd1, 0x8f, 0x79, 0x62, 0x6b, 0xf9, 0xa5,
+	0xe0, 0x53, 0x1e, 0xfa, 0x03, 0x82, 0xb9, 0xec, 0x85, 0xb2, 0x02, 0xe4, 0x69, 0xcf, 0xf9, 0xce,
+	0x77, 0xe6, 0x72, 0xe6, 0xcc, 0x99, 0xb3, 0x00, 0x48, 0x22, 0xce, 0x76, 0x67, 0x9c, 0x49, 0x06,
+	0xab, 0x11, 0xf3, 0x69, 0x28, 0x76, 0xee, 0x4d, 0x03, 0x79, 0x9a, 0x9c, 0xec, 0x7a, 0x2c, 0xba,
+	0x3f, 0x65, 0x53, 0x76, 0x5f, 0x9b, 0x4f, 0x92, 0x89, 0xd6, 0xb4, 0xa2, 0x25, 0xe3, 0xb6, 0xd3,
+	0x26, 0x9e, 0x0c, 0x58, 0x2c, 0xac, 0x7a, 0x87, 0xc6, 0xf3, 0x80, 0xb3, 0x38, 0xa2, 0xb1, 0x74,
+	0xe7, 0x84, 0x07, 0xe4, 0x24, 0xa4, 0xa9, 0x71, 0x5b, 0x50, 0x2f, 0xe1, 0x81, 0x5c, 0xb8, 0x53,
+	0xce, 0x92, 0x99, 0x45, 0x6f, 0x79, 0xc4, 0x3b, 0xa5, 0xbe, 0xeb, 0xd3, 0x19, 0x8d, 0x7d, 0x1a,
+	0x7b, 0x0b, 0x6b, 0x80, 0x73, 0x16, 0x26, 0x11, 0x75, 0x23, 0x96, 0xc4, 0x32, 0x9d, 0x2e, 0xa6,
+	0xf2, 0x0d, 0xe3, 0x76, 0xd1, 0x3b, 0x1f, 0x79, 0x94, 0xcb, 0x60, 0x12, 0x78, 0x44, 0x52, 0x77,
+	0xc6, 0xd9, 0x4c, 0xa9, 0xd9, 0x7c, 0xd7, 0x83, 0x88, 0x4c, 0xa9, 0x1b, 0x92, 0x05, 0xe5, 0xe9,
+	0x12, 0x42, 0x36, 0x75, 0xb9, 0x62, 0x87, 0x41, 0x14, 0xa4, 0xa3, 0x5e, 0x8f, 0xa8, 0xe4, 0x81,
+	0xe7, 0x4a, 0x32, 0xb5, 0xbe, 0xc3, 0xff, 0x74, 0x40, 0xe7, 0x25, 0x11, 0x67, 0x8f, 0xe9, 0x24,
+	0x88, 0x03, 0xb5, 0x45, 0xf8, 0x0b, 0x50, 0xe3, 0x8c, 0x49, 0x77, 0x22, 0x90, 0x33, 0x70, 0x46,
+	0x8d, 0x31, 0x58, 0x2d, 0xfb, 0x55, 0x05, 0x4d, 0x04, 0xd6, 0xdf, 0xa7, 0x02, 0x7a, 0xe0, 0xc6,
+	0x95, 0x21, 0x40, 0xa5, 0x41, 0x79, 0xd4, 0x7c, 0x70, 0x67, 0xd7, 0x84, 0x79, 0xf7, 0x49, 0x4e,
+	0x7a, 0x6d, 0x39, 0xe3, 0xeb, 0xab, 0x65, 0xbf, 0x4d, 0xe3, 0xf9, 0xe7, 0x2c, 0x0a, 0x24, 0x8d,
+	0x66, 0x72, 0x81, 0xb7, 0xe9, 0x87, 0x3c, 0x01, 0x3f, 0x05, 0x55, 0x13, 0x76, 0x54, 0x1e, 0x38,
+	0xa3, 0xe6, 0x83, 0x4e, 0x3a, 0xea, 0x9e, 0x46, 0xb1, 0xb5, 0xc2, 0x8f, 0x41, 0xcd, 0x0f, 0xc4,
+	0x99, 0x1b, 0x9d, 0xa0, 0x6b, 0x03, 0x67, 0x54, 0x19, 0x37, 0x57, 0xcb, 0x7e, 0x0a, 0xe1, 0xaa,
+	0x12, 0x0e, 0x4f, 0xe0, 0x67, 0xa0, 0x11, 0xd1, 0x88, 0xf1, 0x85, 0xe2, 0x55, 0x34, 0xaf, 0xbd,
+	0x5a, 0xf6, 0x73, 0x10, 0xd7, 0x8d, 0x78, 0x78, 0x02, 0xef, 0x01, 0xe0, 0xcd, 0x12, 0xf7, 0x0d,
+	0x0d, 0xa6, 0xa7, 0x12, 0x55, 0x07, 0xce, 0xa8, 0x3d, 0xee, 0xac, 0x96, 0xfd, 0x02, 0x8a, 0x1b,
+	0xde, 0x2c, 0xf9, 0xa3, 0x16, 0xe1, 0x2e, 0x00, 0x33, 0x1e, 0xcc, 0x83, 0x90, 0x4e, 0xa9, 0x8f,
+	0x6a, 0x03, 0x67, 0x54, 0x37, 0xf4, 0x1c, 0xc5, 0x05, 0x59, 0x0d, 0xaf, 0x0e, 0x48, 0xb0, 0x84,
+	0x7b, 0x14, 0xd5, 0x75, 0x94, 0x35, 0x3f, 0x47, 0x71, 0x23, 0x64, 0xd3, 0x63, 0x2d, 0xc2, 0x5f,
+	0x82, 0xba, 0x32, 0x4c, 0x93, 0xc0, 0x47, 0x0d, 0x4d, 0x6e, 0xad, 0x96, 0xfd, 0x0c, 0xc3, 0xb5,
+	0x90, 0x4d, 0x9f, 0x25, 0x81, 0x0f, 0x1f, 0x82, 0x96, 0x39, 0x62, 0x61, 0xc8, 0x40, 0x93, 0xbb,
+	0xab, 0x65, 0x7f, 0x0d, 0xc7, 0x4d, 0xab, 0x69, 0xa7, 0xdf, 0x80, 0x26, 0xa7, 0x22, 0x09, 0xa5,
+	0x3b, 0x09, 0x42, 0x8a, 0x9a, 0xda, 0x67, 0x73, 0xb5, 0xec, 0x17, 0x61, 0x0c, 0x8c, 0xf2, 0x34,
+	0x08, 0x29, 0x7c, 0x04, 0x6e, 0x79, 0x2c, 0x9a, 0x85, 0x54, 0x45, 0xdf, 0xf5, 0x48, 0x18, 0x9e,
+	0x10, 0xef, 0xcc, 0x4d, 0x78, 0x88, 0x5a, 0xca, 0x1b, 0xdf, 0xc8, 0xcd, 0xfb, 0xd6, 0xfa, 0x8a,
+	0x87, 0xb0, 0x07, 0x00, 0x89, 0x63, 0x26, 0x89, 0x3e, 0xd3, 0xb6, 0xa6, 0x16, 0x10, 0xf8, 0x0d,
+	0x68, 0xd1, 0x29, 0xa7, 0x42, 0xb8, 0x3c, 0x51, 0xb9, 0xd4, 0xd1, 0xb9, 0x74, 0x3b, 0x3d, 0xf5,
+	0x63, 0x7b, 0xad, 0x9e, 0xa9, 0x5b, 0x85, 0x93, 0x90, 0xe2, 0xa6, 0xa1, 0x2b, 0x59, 0xc0, 0x03,
+	0xb0, 0x75, 0xf9, 0x8a, 0x05, 0x54, 0xa0, 0x4d, 0x3d, 0x08, 0x4a, 0x07, 0xd9, 0xd7, 0x94, 0xc7,
+	0xd9, 0x25, 0xc4, 0xd0, 0x5b, 0x47, 0x02, 0x2a, 0xe0, 0x17, 0x60, 0x3b, 0xa4, 0x53, 0xe2, 0x2d,
+	0x5c, 0x9f, 0xbd, 0x89, 0x43, 0x46, 0x7c, 0x37, 0x11, 0x94, 0xa3, 0xae, 0x8e, 0x4d, 0x09, 0x39,
+	0x18, 0x1a, 0xfb, 0x63, 0x6b, 0x7e, 0x25, 0x28, 0x87, 0xcf, 0xc0, 0x40, 0xf2, 0x44, 0x48, 0xea,
+	0xbb, 0x62, 0x21, 0x24, 0x8d, 0xdc, 0xc2, 0xb5, 0x15, 0xee, 0x8c, 0xc8, 0x53, 0x74, 0x5d, 0x6f,
+	0xfa, 0xae, 0xe5, 0x1d, 0x6b, 0xda, 0x7e, 0x81, 0x75, 0x44, 0xe4, 0x29, 0xfc, 0x12, 0xb4, 0x8b,
+	0x35, 0x41, 0x20, 0xa8, 0xf7, 0xb0, 0x95, 0xee, 0xe1, 0xb5, 0x36, 0x1e, 0x2a, 0x1b, 0x6e, 0xcd,
+	0x73, 0x45, 0xc0, 0x5f, 0x81, 0x9a, 0xad, 0x1c, 0x68, 0x4b, 0x5f, 0x99, 0xcd, 0xd4, 0xe7, 0x5b,
+	0x03, 0xe3, 0xd4, 0x0e, 0x3f, 0x01, 0x9d, 0x59, 0x48, 0x3c, 0xaa, 0xef, 0xaf, 0xaa, 0x08, 0x68,
+	0x7b, 0x50, 0x1e, 0x35, 0x70, 0x3b, 0x43, 0x5f, 0x92, 0xa9, 0x50, 0xb9, 0x17, 0x91, 0x73, 0x77,
+	0x16, 0xf8, 0x02, 0xdd, 0xd0, 0x97, 0x46, 0xe7, 0x5e, 0x8a, 0xe1, 0x5a, 0x44, 0xce, 0x8f, 0x02,
+	0x5f, 0xc0, 0x97, 0xe0, 0xe6, 0xd5, 0x55, 0x0a, 0xdd, 0xd4, 0x2b, 0xb9, 0x9b, 0x9d, 0x40, 0xce,
+	0x3a, 0xca, 0x48, 0xf8, 0x86, 0x77, 0x15, 0x0c, 0xbf, 0x02, 0x1d, 0x53, 0xdd, 0x54, 0xfc, 0x63,
+	0x12, 0x51, 0x74, 0x4b, 0x9f, 0x01, 0x5c, 0x2d, 0xfb, 0x97, 0x2c, 0xb8, 0xad, 0xf5, 0x57, 0x56,
+	0xcd, 0x5d, 0x67, 0x44, 0x88, 0x37, 0x8c, 0xfb, 0x08, 0x5d, 0x76, 0x4d, 0x2d, 0xd6, 0xf5, 0xc8,
+	0xaa, 0xf0, 0xb7, 0xa0, 0x55, 0xa8, 0xa9, 0x02, 0xdd, 0xd6, 0xf1, 0x87, 0xe9, 0x0e, 0x0e, 0x94,
+	0xed, 0xb9, 0x32, 0xe1, 0x66, 0x90, 0xc9, 0x02, 0x7e, 0x0d, 0x3a, 0xeb, 0x75, 0x17, 0xed, 0xe8,
+	0xad, 0x6f, 0xa7, 0x8e, 0xcf, 0xd9, 0x14, 0x13, 0x49, 0x9f, 0x2b, 0x1b, 0x6e, 0x85, 0x05, 0x0d,
+	0x3e, 0x03, 0xcd, 0x42, 0x75, 0x46, 0x77, 0xf4, 0x8c, 0x9f, 0xa6, 0x8e, 0xeb, 0x25, 0x7a, 0xf7,
+	0x50, 0x33, 0xd5, 0xf9, 0x3c, 0x89, 0x25, 0x5f, 0x60, 0x10, 0x65, 0x00, 0xbc, 0x0b, 0x80, 0xc9,
+	0x7f, 0xe1, 0xb2, 0x18, 0x7d, 0xa4, 0xcf, 0xb4, 0x61, 0x91, 0x17, 0x31, 0xfc, 0x2b, 0xd8, 0xce,
+	0x5f, 0x20, 0xd7, 0x63, 0xb1, 0xaf, 0x87, 0x44, 0x77, 0x07, 0xce, 0xa8, 0xf3, 0xe0, 0xb3, 0x9f,
+	0x98, 0x30, 0xbf, 0x2f, 0xfb, 0xa9, 0x07, 0xde, 0xf2, 0x3f, 0x04, 0xe1, 0x23, 0xd0, 0xe2, 0x54,
+	0xf2, 0x85, 0x3b, 0x63, 0x61, 0xe0, 0x2d, 0x50, 0x6f, 0xe0, 0x14, 0x33, 0x17, 0x2b, 0xdb, 0x91,
+	0x36, 0xe1, 0x26, 0xcf, 0x15, 0x78, 0x0f, 0x6c, 0xa9, 0x94, 0xe2, 0x49, 0xec, 0xfa, 0x09, 0xd7,
+	0xe5, 0xc0, 0x8d, 0x04, 0xea, 0x0f, 0x9c, 0x51, 0x19, 0x77, 0x23, 0x72, 0x8e, 0x93, 0xf8, 0xb1,
+	0x35, 0x1c, 0x0a, 0xb8, 0x03, 0xea, 0x33, 0x1e, 0x30, 0x55, 0x0d, 0xd0, 0x40, 0x65, 0x25, 0xce,
+	0xf4, 0x9d, 0x57, 0x60, 0xf3, 0x52, 0x7c, 0x60, 0x17, 0x94, 0xcf, 0xe8, 0xc2, 0x3c, 0x67, 0x58,
+	0x89, 0xf0, 0x73, 0x50, 0x99, 0x93, 0x30, 0xa1, 0xa8, 0xa4, 0x17, 0x78, 0x33, 0x5d, 0x60, 0xe6,
+	0xf9, 0x5a, 0x59, 0xb1, 0x21, 0x7d, 0x5d, 0xfa, 0xd2, 0x19, 0x3e, 0x02, 0x5b, 0x57, 0x44, 0x01,
+	0xb6, 0x41, 0xe3, 0x45, 0x7c, 0x9c, 0x78, 0x1e, 0x15, 0xa2, 0xbb, 0x01, 0xbb, 0xa0, 0xf5, 0x22,
+	0xde, 0xcf, 0xaa, 0x5f, 0xd7, 0x19, 0x7e, 0x57, 0x02, 0xcd, 0xc2, 0xb6, 0x75, 0x8d, 0x26, 0xe7,
+	0x2e, 0x91, 0xfa, 0xe9, 0x33, 0x6f, 0x6c, 0xc5, 0xd6, 0xe8, 0x02, 0x8e, 0x9b, 0x11, 0x39, 0xdf,
+	0xb3, 0x8a, 0x3a, 0x54, 0x55, 0x44, 0xd9, 0x64, 0xa2, 0xa2, 0x52, 0xd2, 0x51, 0x69, 0x58, 0xe4,
+	0x50, 0xc0, 0x8f, 0x41, 0x47, 0xf9, 0x16, 0x28, 0x65, 0x4d, 0x51, 0x23, 0x8e, 0x33, 0xd6, 0xef,
+	0x40, 0xdd, 0x9c, 0x0d, 0x8b, 0xd1, 0xb5, 0x41, 0x79, 0xd4, 0x79, 0x30, 0xb8, 0xe2, 0x5c, 0x76,
+	0x9f, 0x92, 0x20, 0x4c, 0x38, 0xdd, 0x0f, 0x89, 0x10, 0xb8, 0xa6, 0x3d, 0x5e, 0xc4, 0xc3, 0x3f,
+	0x80, 0x56, 0xd1, 0x00, 0x3b, 0x00, 0xec, 0xc5, 0x0b, 0x0b, 0x75, 0x37, 0x60, 0x0b, 0xd4, 0xf7,
+	0x69, 0x18, 0x3e, 0x67, 0x42, 0x76, 0x1d, 0xb8, 0x09, 0x9a, 0xdf, 0xb2, 0xf8, 0x2f, 0x94, 0xb3,
+	0x27, 0xe7, 0x81, 0xec, 0x96, 0x60, 0x13, 0xd4, 0x5e, 0xcc, 0x29, 0xf7, 0x13, 0xda, 0x2d, 0x0f,
+	0xff, 0xef, 0x80, 0xa6, 0x4a, 0x30, 0xbb, 0x3d, 0xf5, 0x7e, 0x7b, 0x34, 0x0c, 0xdd, 0xc0, 0xb7,
+	0x1d, 0x87, 0x7e, 0xbf, 0x2d, 0x84, 0xab, 0x4a, 0x38, 0xf0, 0xe1, 0x9f, 0x40, 0x7b, 0x62, 0xa6,
+	0x73, 0x3d, 0xb5, 0x04, 0x1d, 0x86, 0x9f, 0xb1, 0x07, 0xd3, 0x6f, 0xac, 0xb9, 0xe2, 0xd6, 0xa4,
+	0xb8, 0x97, 0xaf, 0x40, 0x27, 0x35, 0x73, 0x4a, 0x84, 0xed, 0x37, 0x6c, 0xa5, 0x58, 0xb7, 0xe0,
+	0x74, 0x20, 0xac, 0x55, 0xd5, 0x54, 0x28, 0x80, 0xfa, 0x2e, 0x91, 0xba, 0xf9, 0x28, 0x9b, 0xa6,
+	0x22, 0x03, 0x71, 0xdd, 0x88, 0x7b, 0x72, 0xf8, 0x8f, 0x1a, 0xb8, 0xa6, 0xb6, 0x0d, 0x0f, 0xc0,
+	0xa6, 0xea, 0x48, 0x5d, 0x3f, 0xbb, 0x60, 0xc8, 0x59, 0x4f, 0xc3, 0xf5, 0xeb, 0x37, 0xae, 0xbf,
+	0x5d, 0xf6, 0x9d, 0xd5, 0xb2, 0xbf, 0x81, 0x3b, 0x72, 0xcd, 0xa2, 0xe6, 0xd7, 0x43, 0xe9, 0xe7,
+	0xbe, 0xa4, 0x57, 0xad, 0xe7, 0xcf, 0x40, 0x5c, 0x57, 0xa2, 0x7e, 0xe8, 0x87, 0xa0, 0xea, 0xb3,
+	0x88, 0x04, 0xe9, 0xf6, 0x74, 0x5f, 0x67, 0x10, 0x6c, 0xbf, 0xba, 0xf1, 0xe1, 0x94, 0xc8, 0xe2,
+	0x86, 0x4c, 0xe3, 0x93, 0xa1, 0xb8, 0x61, 0xe5, 0x3d, 0xa9, 0xe8, 0xc9, 0xcc, 0x4f, 0xe9, 0x95,
+	0x9c, 0x9e, 0xa3, 0xb8, 0x61, 0xe5, 0x3d, 0x09, 0x1f, 0x03, 0x38, 0x09, 0xb8, 0x90, 0xae, 0xed,
+	0x0f, 0x8c, 0x5b, 0x55, 0xbb, 0xdd, 0x5c, 0x2d, 0xfb, 0x57, 0x58, 0x71, 0x57, 0x63, 0xfb, 0x29,
+	0xb4, 0x27, 0xe1, 0x43, 0x50, 0x11, 0x92, 0x48, 0xaa, 0x1b, 0xad, 0xce, 0x03, 0x58, 0x0c, 0xda,
+	0xee, 0xb1, 0xb2, 0x8c, 0x1b, 0xab, 0x65, 0xdf, 0x90, 0xb0, 0xf9, 0x14, 0x73, 0xac, 0xfe, 0xd3,
+	0x39, 0x36, 0x04, 0x55, 0xd3, 0xe7, 0xa0, 0x46, 0x1e, 0x22, 0x83, 0x60, 0xfb, 0x55, 0x1c, 0x73,
+	0xa4, 0xba, 0xbd, 0xaa, 0x1b, 0x8e, 0x41, 0xb0, 0xfd, 0x5e, 0x91, 0x51, 0xcd, 0x9f, 0x9b, 0x51,
+	0xdf, 0x80, 0x4d, 0x4e, 0xff, 0x46, 0x3d, 0xd3, 0x5b, 0xa9, 0x67, 0x5d, 0x37, 0x55, 0x95, 0xf1,
+	0xd6, 0x6a, 0xd9, 0xbf, 0x6c, 0xc2, 0x9d, 0x0c, 0xd8, 0x57, 0x3a, 0xfc, 0x3d, 0xe8, 0xe6, 0x14,
+	0x3b, 0xb5, 0x6e, 0xb4, 0xc6, 0xdb, 0xab, 0x65, 0xff, 0x03, 0x1b, 0xce, 0x07, 0xb4, 0xd3, 0xdf,
+	0x05, 0x80, 0x70, 0x4e, 0x16, 0x26, 0xa3, 0x3a, 0xba, 0x62, 0x36, 0x34, 0xa2, 0x73, 0xa8, 0x0f,
+	0x9a, 0xc6, 0x1c, 0xc4, 0x3e, 0x3d, 0x47, 0x9b, 0xba, 0xf6, 0x1a, 0x8f, 0x03, 0x85, 0xc0, 0xfb,
+	0xa0, 0x9e, 0x95, 0xb6, 0xee, 0x7a, 0xdb, 0x52, 0xb8, 0xf2, 0x38, 0x23, 0xc1, 0xdb, 0x69, 0x55,
+	0x22, 0x52, 0x77, 0x47, 0x65, 0x5b, 0x73, 0xf6, 0xe4, 0xf0, 0xcf, 0xa0, 0xa2, 0x8f, 0x53, 0x55,
+	0x8f, 0x83, 0x78, 0x4e, 0xc2, 0xc0, 0xef, 0x6e, 0x28, 0xe5, 0x88, 0xc6, 0x7e, 0x10, 0x4f, 0xbb,
+	0x8e, 0x52, 0x70, 0x12, 0xc7, 0x4a, 0x29, 0xa9, 0x5a, 0x9c, 0xe5, 0x49, 0xb7, 0xac, 0x54, 0x4c,
+	0x05, 0x0b, 0xe7, 0xca, 0x7a, 0x4d, 0x51, 0xc7, 0x21, 0xf3, 0xce, 0xa8, 0xdf, 0xad, 0x0c, 0xff,
+	0x59, 0x02, 0x9b, 0x7a, 0x3d, 0x6a, 0xe5, 0x6a, 0x92, 0x44, 0xa8, 0x64, 0x2e, 0x6c, 0xdd, 0xc9,
+	0xbb, 0xf2, 0x1c, 0x5d, 0x0f, 0x45, 0x45, 0x32, 0x49, 0x42, 0x7d, 0xed, 0x2a, 0x26, 0xe5, 0x34,
+	0x80, 0xcd, 0x07, 0x7e, 0x02, 0x6a, 0x27, 0x66, 0x42, 0x54, 0xce, 0x7f, 0x4b, 0x2c, 0x84, 0x53,
+	0x41, 0xd1, 0x66, 0x66, 0x3f, 0xc5, 0xbf, 0x17, 0x0b, 0xe1, 0x54, 0x50, 0x34, 0x6e, 0x76, 0x8a,
+	0x2a, 0x39, 0xcd, 0x42, 0x38, 0x15, 0xe0, 0xaf, 0x41, 0x43, 0xa8, 0xd7, 0x88, 0xfa, 0xd4, 0x47,
+	0xd5, 0xfc, 0x2f, 0x27, 0x03, 0x71, 0x2e, 0x16, 0x52, 0xb9, 0xa6, 0x99, 0x57, 0xa4, 0xf2, 0xf8,
+	0x8b, 0xb7, 0xef, 0x7a, 0xce, 0xf7, 0xef, 0x7a, 0x1b, 0x3f, 0xbc, 0xeb, 0x39, 0x7f, 0xbf, 0xe8,
+	0x39, 0xff, 0xba, 0xe8, 0x39, 0xff, 0xbe, 0xe8, 0x39, 0x6f, 0x2f, 0x7a, 0xce, 0x7f, 0x2f, 0x7a,
+	0xce, 0xff, 0x2e, 0x7a, 0x1b, 0x3f, 0x5c, 0xf4, 0x9c, 0xef, 0xde, 0xf7, 0x36, 0xde, 0xbe, 0xef,
+	0x6d, 0x7c, 0xff, 0xbe, 0xb7, 0x71, 0x52, 0xd5, 0xbf, 0x97, 0x0f, 0x7f, 0x1c, 0x00, 0x1f, 0xb2,
+	0x9e, 0xb0, 0x7b, 0x0f, 0x00, 0x00,
 }
 
+func (x TaskDefinition_DependencyCondition) String() string {
+	s, ok := TaskDefinition_DependencyCondition_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x RetryPolicy_FailureClass) String() string {
+	s, ok := RetryPolicy_FailureClass_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
 func (x Task_State) String() string {
 	s, ok := Task_State_name[int32(x)]
 	if ok {
@@ -681,16 +1080,36 @@ func (this *TaskDefinition) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.DependsOn) != len(that1.DependsOn) {
+		return false
+	}
+	for i := range this.DependsOn {
+		if this.DependsOn[i] != that1.DependsOn[i] {
+			return false
+		}
+	}
+	if this.DependencyCondition != that1.DependencyCondition {
+		return false
+	}
+	if !this.RetryPolicy.Equal(that1.RetryPolicy) {
+		return false
+	}
+	if this.MaxRunDurationMs != that1.MaxRunDurationMs {
+		return false
+	}
+	if this.Priority != that1.Priority {
+		return false
+	}
 	return true
 }
-func (this *Task) Equal(that interface{}) bool {
+func (this *RetryPolicy) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Task)
+	that1, ok := that.(*RetryPolicy)
 	if !ok {
-		that2, ok := that.(Task)
+		that2, ok := that.(RetryPolicy)
 		if ok {
 			that1 = &that2
 		} else {
@@ -702,32 +1121,103 @@ func (this *Task) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.TaskDefinition.Equal(that1.TaskDefinition) {
-		return false
-	}
-	if this.TaskGuid != that1.TaskGuid {
-		return false
-	}
-	if this.Domain != that1.Domain {
+	if this.MaxAttempts != that1.MaxAttempts {
 		return false
 	}
-	if this.CreatedAt != that1.CreatedAt {
+	if this.BackoffMs != that1.BackoffMs {
 		return false
 	}
-	if this.UpdatedAt != that1.UpdatedAt {
+	if this.MaxBackoffMs != that1.MaxBackoffMs {
 		return false
 	}
-	if this.FirstCompletedAt != that1.FirstCompletedAt {
+	if len(this.RetryOn) != len(that1.RetryOn) {
 		return false
 	}
-	if this.State != that1.State {
-		return false
+	for i := range this.RetryOn {
+		if this.RetryOn[i] != that1.RetryOn[i] {
+			return false
+		}
 	}
-	if this.CellId != that1.CellId {
-		return false
+	return true
+}
+func (this *TaskAttempt) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if this.Result != that1.Result {
-		return false
+
+	that1, ok := that.(*TaskAttempt)
+	if !ok {
+		that2, ok := that.(TaskAttempt)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CellId != that1.CellId {
+		return false
+	}
+	if this.FailureClass != that1.FailureClass {
+		return false
+	}
+	if this.FailureReason != that1.FailureReason {
+		return false
+	}
+	if this.FailedAt != that1.FailedAt {
+		return false
+	}
+	return true
+}
+func (this *Task) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Task)
+	if !ok {
+		that2, ok := that.(Task)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.TaskDefinition.Equal(that1.TaskDefinition) {
+		return false
+	}
+	if this.TaskGuid != that1.TaskGuid {
+		return false
+	}
+	if this.Domain != that1.Domain {
+		return false
+	}
+	if this.CreatedAt != that1.CreatedAt {
+		return false
+	}
+	if this.UpdatedAt != that1.UpdatedAt {
+		return false
+	}
+	if this.FirstCompletedAt != that1.FirstCompletedAt {
+		return false
+	}
+	if this.State != that1.State {
+		return false
+	}
+	if this.CellId != that1.CellId {
+		return false
+	}
+	if this.Result != that1.Result {
+		return false
 	}
 	if this.Failed != that1.Failed {
 		return false
@@ -741,13 +1231,72 @@ func (this *Task) Equal(that interface{}) bool {
 	if this.RejectionReason != that1.RejectionReason {
 		return false
 	}
+	if this.ArrayGuid != that1.ArrayGuid {
+		return false
+	}
+	if this.ArrayIndex != that1.ArrayIndex {
+		return false
+	}
+	if len(this.Attempts) != len(that1.Attempts) {
+		return false
+	}
+	for i := range this.Attempts {
+		if !this.Attempts[i].Equal(that1.Attempts[i]) {
+			return false
+		}
+	}
+	if this.RetryAt != that1.RetryAt {
+		return false
+	}
+	return true
+}
+func (this *TaskArrayStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TaskArrayStatus)
+	if !ok {
+		that2, ok := that.(TaskArrayStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ArrayGuid != that1.ArrayGuid {
+		return false
+	}
+	if this.Total != that1.Total {
+		return false
+	}
+	if this.Blocked != that1.Blocked {
+		return false
+	}
+	if this.Pending != that1.Pending {
+		return false
+	}
+	if this.Running != that1.Running {
+		return false
+	}
+	if this.Succeeded != that1.Succeeded {
+		return false
+	}
+	if this.Failed != that1.Failed {
+		return false
+	}
 	return true
 }
 func (this *TaskDefinition) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 31)
+	s := make([]string, 0, 36)
 	s = append(s, "&models.TaskDefinition{")
 	s = append(s, "RootFs: "+fmt.Sprintf("%#v", this.RootFs)+",\n")
 	if this.EnvironmentVariables != nil {
@@ -806,6 +1355,39 @@ func (this *TaskDefinition) GoString() string {
 	if this.MetricTags != nil {
 		s = append(s, "MetricTags: "+mapStringForMetricTags+",\n")
 	}
+	s = append(s, "DependsOn: "+fmt.Sprintf("%#v", this.DependsOn)+",\n")
+	s = append(s, "DependencyCondition: "+fmt.Sprintf("%#v", this.DependencyCondition)+",\n")
+	if this.RetryPolicy != nil {
+		s = append(s, "RetryPolicy: "+fmt.Sprintf("%#v", this.RetryPolicy)+",\n")
+	}
+	s = append(s, "MaxRunDurationMs: "+fmt.Sprintf("%#v", this.MaxRunDurationMs)+",\n")
+	s = append(s, "Priority: "+fmt.Sprintf("%#v", this.Priority)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RetryPolicy) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&models.RetryPolicy{")
+	s = append(s, "MaxAttempts: "+fmt.Sprintf("%#v", this.MaxAttempts)+",\n")
+	s = append(s, "BackoffMs: "+fmt.Sprintf("%#v", this.BackoffMs)+",\n")
+	s = append(s, "MaxBackoffMs: "+fmt.Sprintf("%#v", this.MaxBackoffMs)+",\n")
+	s = append(s, "RetryOn: "+fmt.Sprintf("%#v", this.RetryOn)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TaskAttempt) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&models.TaskAttempt{")
+	s = append(s, "CellId: "+fmt.Sprintf("%#v", this.CellId)+",\n")
+	s = append(s, "FailureClass: "+fmt.Sprintf("%#v", this.FailureClass)+",\n")
+	s = append(s, "FailureReason: "+fmt.Sprintf("%#v", this.FailureReason)+",\n")
+	s = append(s, "FailedAt: "+fmt.Sprintf("%#v", this.FailedAt)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -813,7 +1395,7 @@ func (this *Task) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 17)
+	s := make([]string, 0, 21)
 	s = append(s, "&models.Task{")
 	if this.TaskDefinition != nil {
 		s = append(s, "TaskDefinition: "+fmt.Sprintf("%#v", this.TaskDefinition)+",\n")
@@ -830,6 +1412,28 @@ func (this *Task) GoString() string {
 	s = append(s, "FailureReason: "+fmt.Sprintf("%#v", this.FailureReason)+",\n")
 	s = append(s, "RejectionCount: "+fmt.Sprintf("%#v", this.RejectionCount)+",\n")
 	s = append(s, "RejectionReason: "+fmt.Sprintf("%#v", this.RejectionReason)+",\n")
+	s = append(s, "ArrayGuid: "+fmt.Sprintf("%#v", this.ArrayGuid)+",\n")
+	s = append(s, "ArrayIndex: "+fmt.Sprintf("%#v", this.ArrayIndex)+",\n")
+	if this.Attempts != nil {
+		s = append(s, "Attempts: "+fmt.Sprintf("%#v", this.Attempts)+",\n")
+	}
+	s = append(s, "RetryAt: "+fmt.Sprintf("%#v", this.RetryAt)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TaskArrayStatus) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&models.TaskArrayStatus{")
+	s = append(s, "ArrayGuid: "+fmt.Sprintf("%#v", this.ArrayGuid)+",\n")
+	s = append(s, "Total: "+fmt.Sprintf("%#v", this.Total)+",\n")
+	s = append(s, "Blocked: "+fmt.Sprintf("%#v", this.Blocked)+",\n")
+	s = append(s, "Pending: "+fmt.Sprintf("%#v", this.Pending)+",\n")
+	s = append(s, "Running: "+fmt.Sprintf("%#v", this.Running)+",\n")
+	s = append(s, "Succeeded: "+fmt.Sprintf("%#v", this.Succeeded)+",\n")
+	s = append(s, "Failed: "+fmt.Sprintf("%#v", this.Failed)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -861,6 +1465,52 @@ func (m *TaskDefinition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Priority != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.MaxRunDurationMs != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.MaxRunDurationMs))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.RetryPolicy != nil {
+		{
+			size, err := m.RetryPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTask(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
+	}
+	if m.DependencyCondition != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.DependencyCondition))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
+	}
+	if len(m.DependsOn) > 0 {
+		for iNdEx := len(m.DependsOn) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DependsOn[iNdEx])
+			copy(dAtA[i:], m.DependsOn[iNdEx])
+			i = encodeVarintTask(dAtA, i, uint64(len(m.DependsOn[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
 	if len(m.MetricTags) > 0 {
 		for k := range m.MetricTags {
 			v := m.MetricTags[k]
@@ -1148,6 +1798,109 @@ func (m *TaskDefinition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RetryPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RetryOn) > 0 {
+		dAtA8 := make([]byte, len(m.RetryOn)*10)
+		var j7 int
+		for _, num := range m.RetryOn {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintTask(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MaxBackoffMs != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.MaxBackoffMs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BackoffMs != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.BackoffMs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxAttempts != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.MaxAttempts))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskAttempt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskAttempt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskAttempt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FailedAt != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.FailedAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.FailureReason) > 0 {
+		i -= len(m.FailureReason)
+		copy(dAtA[i:], m.FailureReason)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.FailureReason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.FailureClass != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.FailureClass))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CellId) > 0 {
+		i -= len(m.CellId)
+		copy(dAtA[i:], m.CellId)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.CellId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Task) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1168,6 +1921,41 @@ func (m *Task) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RetryAt != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.RetryAt))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.Attempts) > 0 {
+		for iNdEx := len(m.Attempts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attempts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTask(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.ArrayIndex != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.ArrayIndex))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.ArrayGuid) > 0 {
+		i -= len(m.ArrayGuid)
+		copy(dAtA[i:], m.ArrayGuid)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.ArrayGuid)))
+		i--
+		dAtA[i] = 0x72
+	}
 	if len(m.RejectionReason) > 0 {
 		i -= len(m.RejectionReason)
 		copy(dAtA[i:], m.RejectionReason)
@@ -1260,10 +2048,70 @@ func (m *Task) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTask(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTask(v)
-	base := offset
-	for v >= 1<<7 {
+func (m *TaskArrayStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskArrayStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskArrayStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Failed != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.Failed))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Succeeded != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.Succeeded))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Running != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.Running))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Pending != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.Pending))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Blocked != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.Blocked))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Total != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ArrayGuid) > 0 {
+		i -= len(m.ArrayGuid)
+		copy(dAtA[i:], m.ArrayGuid)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.ArrayGuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTask(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTask(v)
+	base := offset
+	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
 		v >>= 7
 		offset++
@@ -1401,6 +2249,73 @@ func (m *TaskDefinition) Size() (n int) {
 			n += mapEntrySize + 2 + sovTask(uint64(mapEntrySize))
 		}
 	}
+	if len(m.DependsOn) > 0 {
+		for _, s := range m.DependsOn {
+			l = len(s)
+			n += 2 + l + sovTask(uint64(l))
+		}
+	}
+	if m.DependencyCondition != 0 {
+		n += 2 + sovTask(uint64(m.DependencyCondition))
+	}
+	if m.RetryPolicy != nil {
+		l = m.RetryPolicy.Size()
+		n += 2 + l + sovTask(uint64(l))
+	}
+	if m.MaxRunDurationMs != 0 {
+		n += 2 + sovTask(uint64(m.MaxRunDurationMs))
+	}
+	if m.Priority != 0 {
+		n += 2 + sovTask(uint64(m.Priority))
+	}
+	return n
+}
+
+func (m *RetryPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxAttempts != 0 {
+		n += 1 + sovTask(uint64(m.MaxAttempts))
+	}
+	if m.BackoffMs != 0 {
+		n += 1 + sovTask(uint64(m.BackoffMs))
+	}
+	if m.MaxBackoffMs != 0 {
+		n += 1 + sovTask(uint64(m.MaxBackoffMs))
+	}
+	if len(m.RetryOn) > 0 {
+		l = 0
+		for _, e := range m.RetryOn {
+			l += sovTask(uint64(e))
+		}
+		n += 1 + sovTask(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *TaskAttempt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CellId)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	if m.FailureClass != 0 {
+		n += 1 + sovTask(uint64(m.FailureClass))
+	}
+	l = len(m.FailureReason)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	if m.FailedAt != 0 {
+		n += 1 + sovTask(uint64(m.FailedAt))
+	}
 	return n
 }
 
@@ -1456,6 +2371,53 @@ func (m *Task) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTask(uint64(l))
 	}
+	l = len(m.ArrayGuid)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	if m.ArrayIndex != 0 {
+		n += 1 + sovTask(uint64(m.ArrayIndex))
+	}
+	if len(m.Attempts) > 0 {
+		for _, e := range m.Attempts {
+			l = e.Size()
+			n += 2 + l + sovTask(uint64(l))
+		}
+	}
+	if m.RetryAt != 0 {
+		n += 2 + sovTask(uint64(m.RetryAt))
+	}
+	return n
+}
+
+func (m *TaskArrayStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ArrayGuid)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	if m.Total != 0 {
+		n += 1 + sovTask(uint64(m.Total))
+	}
+	if m.Blocked != 0 {
+		n += 1 + sovTask(uint64(m.Blocked))
+	}
+	if m.Pending != 0 {
+		n += 1 + sovTask(uint64(m.Pending))
+	}
+	if m.Running != 0 {
+		n += 1 + sovTask(uint64(m.Running))
+	}
+	if m.Succeeded != 0 {
+		n += 1 + sovTask(uint64(m.Succeeded))
+	}
+	if m.Failed != 0 {
+		n += 1 + sovTask(uint64(m.Failed))
+	}
 	return n
 }
 
@@ -1532,6 +2494,37 @@ func (this *TaskDefinition) String() string {
 		`ImageLayers:` + repeatedStringForImageLayers + `,`,
 		`LogRateLimit:` + strings.Replace(fmt.Sprintf("%v", this.LogRateLimit), "LogRateLimit", "LogRateLimit", 1) + `,`,
 		`MetricTags:` + mapStringForMetricTags + `,`,
+		`DependsOn:` + fmt.Sprintf("%v", this.DependsOn) + `,`,
+		`DependencyCondition:` + fmt.Sprintf("%v", this.DependencyCondition) + `,`,
+		`RetryPolicy:` + strings.Replace(this.RetryPolicy.String(), "RetryPolicy", "RetryPolicy", 1) + `,`,
+		`MaxRunDurationMs:` + fmt.Sprintf("%v", this.MaxRunDurationMs) + `,`,
+		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RetryPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RetryPolicy{`,
+		`MaxAttempts:` + fmt.Sprintf("%v", this.MaxAttempts) + `,`,
+		`BackoffMs:` + fmt.Sprintf("%v", this.BackoffMs) + `,`,
+		`MaxBackoffMs:` + fmt.Sprintf("%v", this.MaxBackoffMs) + `,`,
+		`RetryOn:` + fmt.Sprintf("%v", this.RetryOn) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TaskAttempt) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TaskAttempt{`,
+		`CellId:` + fmt.Sprintf("%v", this.CellId) + `,`,
+		`FailureClass:` + fmt.Sprintf("%v", this.FailureClass) + `,`,
+		`FailureReason:` + fmt.Sprintf("%v", this.FailureReason) + `,`,
+		`FailedAt:` + fmt.Sprintf("%v", this.FailedAt) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1540,6 +2533,11 @@ func (this *Task) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForAttempts := "[]*TaskAttempt{"
+	for _, f := range this.Attempts {
+		repeatedStringForAttempts += strings.Replace(f.String(), "TaskAttempt", "TaskAttempt", 1) + ","
+	}
+	repeatedStringForAttempts += "}"
 	s := strings.Join([]string{`&Task{`,
 		`TaskDefinition:` + strings.Replace(this.TaskDefinition.String(), "TaskDefinition", "TaskDefinition", 1) + `,`,
 		`TaskGuid:` + fmt.Sprintf("%v", this.TaskGuid) + `,`,
@@ -1554,6 +2552,26 @@ func (this *Task) String() string {
 		`FailureReason:` + fmt.Sprintf("%v", this.FailureReason) + `,`,
 		`RejectionCount:` + fmt.Sprintf("%v", this.RejectionCount) + `,`,
 		`RejectionReason:` + fmt.Sprintf("%v", this.RejectionReason) + `,`,
+		`ArrayGuid:` + fmt.Sprintf("%v", this.ArrayGuid) + `,`,
+		`ArrayIndex:` + fmt.Sprintf("%v", this.ArrayIndex) + `,`,
+		`Attempts:` + repeatedStringForAttempts + `,`,
+		`RetryAt:` + fmt.Sprintf("%v", this.RetryAt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TaskArrayStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TaskArrayStatus{`,
+		`ArrayGuid:` + fmt.Sprintf("%v", this.ArrayGuid) + `,`,
+		`Total:` + fmt.Sprintf("%v", this.Total) + `,`,
+		`Blocked:` + fmt.Sprintf("%v", this.Blocked) + `,`,
+		`Pending:` + fmt.Sprintf("%v", this.Pending) + `,`,
+		`Running:` + fmt.Sprintf("%v", this.Running) + `,`,
+		`Succeeded:` + fmt.Sprintf("%v", this.Succeeded) + `,`,
+		`Failed:` + fmt.Sprintf("%v", this.Failed) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2518,61 +3536,11 @@ func (m *TaskDefinition) Unmarshal(dAtA []byte) error {
 			}
 			m.MetricTags[mapkey] = mapvalue
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTask(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTask
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Task) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTask
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Task: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Task: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskDefinition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DependsOn", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -2582,33 +3550,29 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTask
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TaskDefinition == nil {
-				m.TaskDefinition = &TaskDefinition{}
-			}
-			if err := m.TaskDefinition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DependsOn = append(m.DependsOn, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskGuid", wireType)
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependencyCondition", wireType)
 			}
-			var stringLen uint64
+			m.DependencyCondition = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -2618,29 +3582,16 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DependencyCondition |= TaskDefinition_DependencyCondition(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTask
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTask
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TaskGuid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 30:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -2650,29 +3601,33 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTask
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Domain = string(dAtA[iNdEx:postIndex])
+			if m.RetryPolicy == nil {
+				m.RetryPolicy = &RetryPolicy{}
+			}
+			if err := m.RetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 31:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRunDurationMs", wireType)
 			}
-			m.CreatedAt = 0
+			m.MaxRunDurationMs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -2682,16 +3637,16 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreatedAt |= int64(b&0x7F) << shift
+				m.MaxRunDurationMs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 32:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
 			}
-			m.UpdatedAt = 0
+			m.Priority = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -2701,35 +3656,686 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpdatedAt |= int64(b&0x7F) << shift
+				m.Priority |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FirstCompletedAt", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.FirstCompletedAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTask
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetryPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetryPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetryPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAttempts", wireType)
+			}
+			m.MaxAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAttempts |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackoffMs", wireType)
+			}
+			m.BackoffMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BackoffMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBackoffMs", wireType)
+			}
+			m.MaxBackoffMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBackoffMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType == 0 {
+				var v RetryPolicy_FailureClass
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTask
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= RetryPolicy_FailureClass(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RetryOn = append(m.RetryOn, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTask
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTask
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTask
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.RetryOn) == 0 {
+					m.RetryOn = make([]RetryPolicy_FailureClass, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v RetryPolicy_FailureClass
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTask
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= RetryPolicy_FailureClass(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RetryOn = append(m.RetryOn, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryOn", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskAttempt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskAttempt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskAttempt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureClass", wireType)
+			}
+			m.FailureClass = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailureClass |= RetryPolicy_FailureClass(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailureReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedAt", wireType)
+			}
+			m.FailedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Task) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Task: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Task: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskDefinition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskDefinition == nil {
+				m.TaskDefinition = &TaskDefinition{}
+			}
+			if err := m.TaskDefinition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstCompletedAt", wireType)
+			}
+			m.FirstCompletedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstCompletedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= Task_State(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FirstCompletedAt |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			m.Failed = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailureReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectionCount", wireType)
 			}
-			m.State = 0
+			m.RejectionCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -2739,14 +4345,14 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= Task_State(b&0x7F) << shift
+				m.RejectionCount |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CellId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectionReason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2774,11 +4380,11 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CellId = string(dAtA[iNdEx:postIndex])
+			m.RejectionReason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArrayGuid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2806,13 +4412,13 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Result = string(dAtA[iNdEx:postIndex])
+			m.ArrayGuid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 15:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArrayIndex", wireType)
 			}
-			var v int
+			m.ArrayIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -2822,17 +4428,16 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ArrayIndex |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Failed = bool(v != 0)
-		case 11:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailureReason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -2842,29 +4447,31 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTask
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FailureReason = string(dAtA[iNdEx:postIndex])
+			m.Attempts = append(m.Attempts, &TaskAttempt{})
+			if err := m.Attempts[len(m.Attempts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 12:
+		case 17:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RejectionCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryAt", wireType)
 			}
-			m.RejectionCount = 0
+			m.RetryAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -2874,14 +4481,64 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RejectionCount |= int32(b&0x7F) << shift
+				m.RetryAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskArrayStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskArrayStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskArrayStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RejectionReason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArrayGuid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2909,8 +4566,122 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RejectionReason = string(dAtA[iNdEx:postIndex])
+			m.ArrayGuid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocked", wireType)
+			}
+			m.Blocked = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocked |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			m.Pending = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pending |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Running", wireType)
+			}
+			m.Running = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Running |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
+			}
+			m.Succeeded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Succeeded |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			m.Failed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failed |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(dAtA[iNdEx:])
@@ -0,0 +1,58 @@
+package models
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// DefaultArchivedTasksPageSize is the number of archived tasks returned
+	// by a request that does not set a page size.
+	DefaultArchivedTasksPageSize = 100
+	// MaxArchivedTasksPageSize is the largest page of archived tasks that can
+	// be requested.
+	MaxArchivedTasksPageSize = 1000
+)
+
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+type TaskArchiveFilter struct {
+	Domain          string
+	CellID          string
+	FailedOnly      bool
+	CompletedAfter  int64
+	CompletedBefore int64
+	PageSize        int
+	PageToken       string
+}
+
+// ArchivedTaskPageToken returns the token of the page of archived tasks that
+// follows the given task. Archived tasks are listed from the most recently
+// archived.
+func ArchivedTaskPageToken(task *ArchivedTask) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d:%s", task.ArchivedAt, task.TaskGuid)))
+}
+
+// ParseArchivedTaskPageToken returns the archive time and guid of the last
+// archived task of the page that precedes the page with the given token.
+func ParseArchivedTaskPageToken(token string) (int64, string, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, "", ErrInvalidPageToken
+	}
+
+	archivedAt, taskGuid, ok := strings.Cut(string(data), ":")
+	if !ok || !taskGuidPattern.MatchString(taskGuid) {
+		return 0, "", ErrInvalidPageToken
+	}
+
+	archivedAtNanos, err := strconv.ParseInt(archivedAt, 10, 64)
+	if err != nil {
+		return 0, "", ErrInvalidPageToken
+	}
+
+	return archivedAtNanos, taskGuid, nil
+}
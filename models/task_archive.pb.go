@@ -0,0 +1,848 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: task_archive.proto
+
+package models
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ArchivedTask struct {
+	TaskGuid       string     `protobuf:"bytes,1,opt,name=task_guid,json=taskGuid,proto3" json:"task_guid"`
+	Domain         string     `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain"`
+	State          Task_State `protobuf:"varint,3,opt,name=state,proto3,enum=models.Task_State" json:"state"`
+	CellId         string     `protobuf:"bytes,4,opt,name=cell_id,json=cellId,proto3" json:"cell_id"`
+	Failed         bool       `protobuf:"varint,5,opt,name=failed,proto3" json:"failed"`
+	FailureReason  string     `protobuf:"bytes,6,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason"`
+	RejectionCount int32      `protobuf:"varint,7,opt,name=rejection_count,json=rejectionCount,proto3" json:"rejection_count"`
+	CreatedAt      int64      `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	StartedAt      int64      `protobuf:"varint,9,opt,name=started_at,json=startedAt,proto3" json:"started_at"`
+	CompletedAt    int64      `protobuf:"varint,10,opt,name=completed_at,json=completedAt,proto3" json:"completed_at"`
+	ArchivedAt     int64      `protobuf:"varint,11,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at"`
+}
+
+func (m *ArchivedTask) Reset()      { *m = ArchivedTask{} }
+func (*ArchivedTask) ProtoMessage() {}
+func (*ArchivedTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b263b1050eb528b8, []int{0}
+}
+func (m *ArchivedTask) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchivedTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchivedTask.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArchivedTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchivedTask.Merge(m, src)
+}
+func (m *ArchivedTask) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchivedTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchivedTask.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchivedTask proto.InternalMessageInfo
+
+func (m *ArchivedTask) GetTaskGuid() string {
+	if m != nil {
+		return m.TaskGuid
+	}
+	return ""
+}
+
+func (m *ArchivedTask) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *ArchivedTask) GetState() Task_State {
+	if m != nil {
+		return m.State
+	}
+	return Task_Invalid
+}
+
+func (m *ArchivedTask) GetCellId() string {
+	if m != nil {
+		return m.CellId
+	}
+	return ""
+}
+
+func (m *ArchivedTask) GetFailed() bool {
+	if m != nil {
+		return m.Failed
+	}
+	return false
+}
+
+func (m *ArchivedTask) GetFailureReason() string {
+	if m != nil {
+		return m.FailureReason
+	}
+	return ""
+}
+
+func (m *ArchivedTask) GetRejectionCount() int32 {
+	if m != nil {
+		return m.RejectionCount
+	}
+	return 0
+}
+
+func (m *ArchivedTask) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *ArchivedTask) GetStartedAt() int64 {
+	if m != nil {
+		return m.StartedAt
+	}
+	return 0
+}
+
+func (m *ArchivedTask) GetCompletedAt() int64 {
+	if m != nil {
+		return m.CompletedAt
+	}
+	return 0
+}
+
+func (m *ArchivedTask) GetArchivedAt() int64 {
+	if m != nil {
+		return m.ArchivedAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ArchivedTask)(nil), "models.ArchivedTask")
+}
+
+func init() { proto.RegisterFile("task_archive.proto", fileDescriptor_b263b1050eb528b8) }
+
+var fileDescriptor_b263b1050eb528b8 = []byte{
+	// 439 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0xb1, 0x6e, 0xdb, 0x30,
+	0x10, 0x86, 0xc5, 0x26, 0x56, 0x2c, 0x3a, 0x71, 0x0a, 0x76, 0x11, 0x32, 0x90, 0x42, 0xd0, 0x41,
+	0x28, 0x10, 0xa5, 0xa8, 0xbb, 0x14, 0xe8, 0x22, 0x75, 0x28, 0xba, 0xb2, 0xdd, 0x05, 0x5a, 0x62,
+	0x1c, 0x35, 0xb2, 0x19, 0x48, 0x54, 0xe7, 0x3e, 0x42, 0xf7, 0xbe, 0x40, 0x1f, 0xa5, 0xa3, 0xc7,
+	0x4c, 0x44, 0x2d, 0x2f, 0x05, 0xa7, 0x3c, 0x42, 0x21, 0x92, 0x89, 0x53, 0x4f, 0xf7, 0xff, 0xdf,
+	0xdd, 0x7f, 0xc7, 0x81, 0x10, 0x49, 0xd6, 0xde, 0xe4, 0xac, 0x29, 0xae, 0xab, 0x6f, 0x3c, 0xb9,
+	0x6d, 0x84, 0x14, 0xc8, 0x5f, 0x8a, 0x92, 0xd7, 0xed, 0xd9, 0xc5, 0xa2, 0x92, 0xd7, 0xdd, 0x3c,
+	0x29, 0xc4, 0xf2, 0x72, 0x21, 0x16, 0xe2, 0xd2, 0xb4, 0xe7, 0xdd, 0x95, 0x71, 0xc6, 0x18, 0x65,
+	0x63, 0x67, 0x70, 0x58, 0x65, 0xf5, 0xf9, 0xcf, 0x43, 0x78, 0x9c, 0xda, 0xa5, 0xe5, 0x17, 0xd6,
+	0xde, 0xa0, 0x57, 0x30, 0x30, 0x97, 0x16, 0x5d, 0x55, 0x86, 0x20, 0x02, 0x71, 0x90, 0x9d, 0x68,
+	0x45, 0x76, 0x90, 0x8e, 0x07, 0xf9, 0xb1, 0xab, 0x4a, 0x74, 0x0e, 0xfd, 0x52, 0x2c, 0x59, 0xb5,
+	0x0a, 0x9f, 0x99, 0x41, 0xa8, 0x15, 0x71, 0x84, 0xba, 0x8a, 0x66, 0x70, 0xd4, 0x4a, 0x26, 0x79,
+	0x78, 0x10, 0x81, 0x78, 0xfa, 0x06, 0x25, 0xf6, 0xcd, 0xc9, 0x70, 0x2c, 0xf9, 0x3c, 0x74, 0xb2,
+	0x40, 0x2b, 0x62, 0x87, 0xa8, 0x2d, 0xe8, 0x25, 0x3c, 0x2a, 0x78, 0x5d, 0xe7, 0x55, 0x19, 0x1e,
+	0x9a, 0xcd, 0x13, 0xad, 0xc8, 0x03, 0xa2, 0xfe, 0x20, 0x3e, 0x99, 0xf3, 0x57, 0xac, 0xaa, 0x79,
+	0x19, 0x8e, 0x22, 0x10, 0x8f, 0xed, 0x79, 0x4b, 0xa8, 0xab, 0xe8, 0x1d, 0x9c, 0x0e, 0xaa, 0x6b,
+	0x78, 0xde, 0x70, 0xd6, 0x8a, 0x55, 0xe8, 0x9b, 0x85, 0x48, 0x2b, 0xb2, 0xd7, 0xa1, 0x27, 0xce,
+	0x53, 0x63, 0xd1, 0x7b, 0x78, 0xda, 0xf0, 0xaf, 0xbc, 0x90, 0x95, 0x58, 0xe5, 0x85, 0xe8, 0x56,
+	0x32, 0x3c, 0x8a, 0x40, 0x3c, 0xca, 0x5e, 0x68, 0x45, 0xf6, 0x5b, 0x74, 0xfa, 0x08, 0x3e, 0x0c,
+	0x1e, 0x5d, 0x40, 0x58, 0x34, 0x9c, 0x49, 0x5e, 0xe6, 0x4c, 0x86, 0xe3, 0x08, 0xc4, 0x07, 0xd9,
+	0x54, 0x2b, 0xf2, 0x84, 0xd2, 0xc0, 0xe9, 0xd4, 0x8c, 0xb7, 0x92, 0x35, 0x6e, 0x3c, 0xd8, 0x8d,
+	0xef, 0x28, 0x0d, 0x9c, 0x4e, 0x25, 0x9a, 0xc1, 0xe3, 0x42, 0x2c, 0x6f, 0x6b, 0xee, 0x02, 0xd0,
+	0x04, 0x9e, 0x6b, 0x45, 0xfe, 0xe3, 0x74, 0xf2, 0xe8, 0x52, 0x89, 0x5e, 0xc3, 0x89, 0xfb, 0x3f,
+	0x26, 0x33, 0x31, 0x99, 0x53, 0xad, 0xc8, 0x53, 0x4c, 0xe1, 0x83, 0x49, 0x65, 0xf6, 0x76, 0xbd,
+	0xc1, 0xde, 0xdd, 0x06, 0x7b, 0xf7, 0x1b, 0x0c, 0xbe, 0xf7, 0x18, 0xfc, 0xea, 0x31, 0xf8, 0xdd,
+	0x63, 0xb0, 0xee, 0x31, 0xf8, 0xd3, 0x63, 0xf0, 0xb7, 0xc7, 0xde, 0x7d, 0x8f, 0xc1, 0x8f, 0x2d,
+	0xf6, 0xd6, 0x5b, 0xec, 0xdd, 0x6d, 0xb1, 0x37, 0xf7, 0xcd, 0xd7, 0x9a, 0xfd, 0x1b, 0x00, 0xbb,
+	0x72, 0x9c, 0xd4, 0xb3, 0x02, 0x00, 0x00,
+}
+
+func (this *ArchivedTask) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ArchivedTask)
+	if !ok {
+		that2, ok := that.(ArchivedTask)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TaskGuid != that1.TaskGuid {
+		return false
+	}
+	if this.Domain != that1.Domain {
+		return false
+	}
+	if this.State != that1.State {
+		return false
+	}
+	if this.CellId != that1.CellId {
+		return false
+	}
+	if this.Failed != that1.Failed {
+		return false
+	}
+	if this.FailureReason != that1.FailureReason {
+		return false
+	}
+	if this.RejectionCount != that1.RejectionCount {
+		return false
+	}
+	if this.CreatedAt != that1.CreatedAt {
+		return false
+	}
+	if this.StartedAt != that1.StartedAt {
+		return false
+	}
+	if this.CompletedAt != that1.CompletedAt {
+		return false
+	}
+	if this.ArchivedAt != that1.ArchivedAt {
+		return false
+	}
+	return true
+}
+func (this *ArchivedTask) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 15)
+	s = append(s, "&models.ArchivedTask{")
+	s = append(s, "TaskGuid: "+fmt.Sprintf("%#v", this.TaskGuid)+",\n")
+	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
+	s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
+	s = append(s, "CellId: "+fmt.Sprintf("%#v", this.CellId)+",\n")
+	s = append(s, "Failed: "+fmt.Sprintf("%#v", this.Failed)+",\n")
+	s = append(s, "FailureReason: "+fmt.Sprintf("%#v", this.FailureReason)+",\n")
+	s = append(s, "RejectionCount: "+fmt.Sprintf("%#v", this.RejectionCount)+",\n")
+	s = append(s, "CreatedAt: "+fmt.Sprintf("%#v", this.CreatedAt)+",\n")
+	s = append(s, "StartedAt: "+fmt.Sprintf("%#v", this.StartedAt)+",\n")
+	s = append(s, "CompletedAt: "+fmt.Sprintf("%#v", this.CompletedAt)+",\n")
+	s = append(s, "ArchivedAt: "+fmt.Sprintf("%#v", this.ArchivedAt)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringTaskArchive(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *ArchivedTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchivedTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchivedTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ArchivedAt != 0 {
+		i = encodeVarintTaskArchive(dAtA, i, uint64(m.ArchivedAt))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.CompletedAt != 0 {
+		i = encodeVarintTaskArchive(dAtA, i, uint64(m.CompletedAt))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.StartedAt != 0 {
+		i = encodeVarintTaskArchive(dAtA, i, uint64(m.StartedAt))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintTaskArchive(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.RejectionCount != 0 {
+		i = encodeVarintTaskArchive(dAtA, i, uint64(m.RejectionCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.FailureReason) > 0 {
+		i -= len(m.FailureReason)
+		copy(dAtA[i:], m.FailureReason)
+		i = encodeVarintTaskArchive(dAtA, i, uint64(len(m.FailureReason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Failed {
+		i--
+		if m.Failed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.CellId) > 0 {
+		i -= len(m.CellId)
+		copy(dAtA[i:], m.CellId)
+		i = encodeVarintTaskArchive(dAtA, i, uint64(len(m.CellId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.State != 0 {
+		i = encodeVarintTaskArchive(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintTaskArchive(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TaskGuid) > 0 {
+		i -= len(m.TaskGuid)
+		copy(dAtA[i:], m.TaskGuid)
+		i = encodeVarintTaskArchive(dAtA, i, uint64(len(m.TaskGuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTaskArchive(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTaskArchive(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ArchivedTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskGuid)
+	if l > 0 {
+		n += 1 + l + sovTaskArchive(uint64(l))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovTaskArchive(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovTaskArchive(uint64(m.State))
+	}
+	l = len(m.CellId)
+	if l > 0 {
+		n += 1 + l + sovTaskArchive(uint64(l))
+	}
+	if m.Failed {
+		n += 2
+	}
+	l = len(m.FailureReason)
+	if l > 0 {
+		n += 1 + l + sovTaskArchive(uint64(l))
+	}
+	if m.RejectionCount != 0 {
+		n += 1 + sovTaskArchive(uint64(m.RejectionCount))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovTaskArchive(uint64(m.CreatedAt))
+	}
+	if m.StartedAt != 0 {
+		n += 1 + sovTaskArchive(uint64(m.StartedAt))
+	}
+	if m.CompletedAt != 0 {
+		n += 1 + sovTaskArchive(uint64(m.CompletedAt))
+	}
+	if m.ArchivedAt != 0 {
+		n += 1 + sovTaskArchive(uint64(m.ArchivedAt))
+	}
+	return n
+}
+
+func sovTaskArchive(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTaskArchive(x uint64) (n int) {
+	return sovTaskArchive(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ArchivedTask) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ArchivedTask{`,
+		`TaskGuid:` + fmt.Sprintf("%v", this.TaskGuid) + `,`,
+		`Domain:` + fmt.Sprintf("%v", this.Domain) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`CellId:` + fmt.Sprintf("%v", this.CellId) + `,`,
+		`Failed:` + fmt.Sprintf("%v", this.Failed) + `,`,
+		`FailureReason:` + fmt.Sprintf("%v", this.FailureReason) + `,`,
+		`RejectionCount:` + fmt.Sprintf("%v", this.RejectionCount) + `,`,
+		`CreatedAt:` + fmt.Sprintf("%v", this.CreatedAt) + `,`,
+		`StartedAt:` + fmt.Sprintf("%v", this.StartedAt) + `,`,
+		`CompletedAt:` + fmt.Sprintf("%v", this.CompletedAt) + `,`,
+		`ArchivedAt:` + fmt.Sprintf("%v", this.ArchivedAt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringTaskArchive(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ArchivedTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchivedTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchivedTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= Task_State(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Failed = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailureReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectionCount", wireType)
+			}
+			m.RejectionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RejectionCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			m.StartedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedAt", wireType)
+			}
+			m.CompletedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedAt", wireType)
+			}
+			m.ArchivedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ArchivedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTaskArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTaskArchive(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTaskArchive
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTaskArchive
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTaskArchive
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTaskArchive
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTaskArchive
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTaskArchive
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTaskArchive        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTaskArchive          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTaskArchive = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,43 @@
+package models
+
+func (req *ArchivedTasksRequest) Validate() error {
+	var validationError ValidationError
+
+	if req.CompletedAfter < 0 {
+		validationError = validationError.Append(ErrInvalidField{"completed_after"})
+	}
+
+	if req.CompletedBefore < 0 {
+		validationError = validationError.Append(ErrInvalidField{"completed_before"})
+	}
+
+	if req.PageSize < 0 || req.PageSize > MaxArchivedTasksPageSize {
+		validationError = validationError.Append(ErrInvalidField{"page_size"})
+	}
+
+	if req.PageToken != "" {
+		if _, _, err := ParseArchivedTaskPageToken(req.PageToken); err != nil {
+			validationError = validationError.Append(ErrInvalidField{"page_token"})
+		}
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
+func (req *ArchivedTaskByGuidRequest) Validate() error {
+	var validationError ValidationError
+
+	if !taskGuidPattern.MatchString(req.TaskGuid) {
+		validationError = validationError.Append(ErrInvalidField{"task_guid"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
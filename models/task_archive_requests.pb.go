@@ -0,0 +1,1538 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: task_archive_requests.proto
+
+package models
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ArchivedTasksRequest struct {
+	Domain          string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain"`
+	CellId          string `protobuf:"bytes,2,opt,name=cell_id,json=cellId,proto3" json:"cell_id"`
+	FailedOnly      bool   `protobuf:"varint,3,opt,name=failed_only,json=failedOnly,proto3" json:"failed_only,omitempty"`
+	CompletedAfter  int64  `protobuf:"varint,4,opt,name=completed_after,json=completedAfter,proto3" json:"completed_after,omitempty"`
+	CompletedBefore int64  `protobuf:"varint,5,opt,name=completed_before,json=completedBefore,proto3" json:"completed_before,omitempty"`
+	PageSize        int32  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken       string `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (m *ArchivedTasksRequest) Reset()      { *m = ArchivedTasksRequest{} }
+func (*ArchivedTasksRequest) ProtoMessage() {}
+func (*ArchivedTasksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf62334c4dc63b6d, []int{0}
+}
+func (m *ArchivedTasksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchivedTasksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchivedTasksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArchivedTasksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchivedTasksRequest.Merge(m, src)
+}
+func (m *ArchivedTasksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchivedTasksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchivedTasksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchivedTasksRequest proto.InternalMessageInfo
+
+func (m *ArchivedTasksRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *ArchivedTasksRequest) GetCellId() string {
+	if m != nil {
+		return m.CellId
+	}
+	return ""
+}
+
+func (m *ArchivedTasksRequest) GetFailedOnly() bool {
+	if m != nil {
+		return m.FailedOnly
+	}
+	return false
+}
+
+func (m *ArchivedTasksRequest) GetCompletedAfter() int64 {
+	if m != nil {
+		return m.CompletedAfter
+	}
+	return 0
+}
+
+func (m *ArchivedTasksRequest) GetCompletedBefore() int64 {
+	if m != nil {
+		return m.CompletedBefore
+	}
+	return 0
+}
+
+func (m *ArchivedTasksRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ArchivedTasksRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type ArchivedTasksResponse struct {
+	Error         *Error          `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	ArchivedTasks []*ArchivedTask `protobuf:"bytes,2,rep,name=archived_tasks,json=archivedTasks,proto3" json:"archived_tasks,omitempty"`
+	NextPageToken string          `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (m *ArchivedTasksResponse) Reset()      { *m = ArchivedTasksResponse{} }
+func (*ArchivedTasksResponse) ProtoMessage() {}
+func (*ArchivedTasksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf62334c4dc63b6d, []int{1}
+}
+func (m *ArchivedTasksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchivedTasksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchivedTasksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArchivedTasksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchivedTasksResponse.Merge(m, src)
+}
+func (m *ArchivedTasksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchivedTasksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchivedTasksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchivedTasksResponse proto.InternalMessageInfo
+
+func (m *ArchivedTasksResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *ArchivedTasksResponse) GetArchivedTasks() []*ArchivedTask {
+	if m != nil {
+		return m.ArchivedTasks
+	}
+	return nil
+}
+
+func (m *ArchivedTasksResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+type ArchivedTaskByGuidRequest struct {
+	TaskGuid string `protobuf:"bytes,1,opt,name=task_guid,json=taskGuid,proto3" json:"task_guid"`
+}
+
+func (m *ArchivedTaskByGuidRequest) Reset()      { *m = ArchivedTaskByGuidRequest{} }
+func (*ArchivedTaskByGuidRequest) ProtoMessage() {}
+func (*ArchivedTaskByGuidRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf62334c4dc63b6d, []int{2}
+}
+func (m *ArchivedTaskByGuidRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchivedTaskByGuidRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchivedTaskByGuidRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArchivedTaskByGuidRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchivedTaskByGuidRequest.Merge(m, src)
+}
+func (m *ArchivedTaskByGuidRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchivedTaskByGuidRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchivedTaskByGuidRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchivedTaskByGuidRequest proto.InternalMessageInfo
+
+func (m *ArchivedTaskByGuidRequest) GetTaskGuid() string {
+	if m != nil {
+		return m.TaskGuid
+	}
+	return ""
+}
+
+type ArchivedTaskResponse struct {
+	Error        *Error        `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	ArchivedTask *ArchivedTask `protobuf:"bytes,2,opt,name=archived_task,json=archivedTask,proto3" json:"archived_task,omitempty"`
+}
+
+func (m *ArchivedTaskResponse) Reset()      { *m = ArchivedTaskResponse{} }
+func (*ArchivedTaskResponse) ProtoMessage() {}
+func (*ArchivedTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf62334c4dc63b6d, []int{3}
+}
+func (m *ArchivedTaskResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchivedTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchivedTaskResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArchivedTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchivedTaskResponse.Merge(m, src)
+}
+func (m *ArchivedTaskResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchivedTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchivedTaskResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchivedTaskResponse proto.InternalMessageInfo
+
+func (m *ArchivedTaskResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *ArchivedTaskResponse) GetArchivedTask() *ArchivedTask {
+	if m != nil {
+		return m.ArchivedTask
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ArchivedTasksRequest)(nil), "models.ArchivedTasksRequest")
+	proto.RegisterType((*ArchivedTasksResponse)(nil), "models.ArchivedTasksResponse")
+	proto.RegisterType((*ArchivedTaskByGuidRequest)(nil), "models.ArchivedTaskByGuidRequest")
+	proto.RegisterType((*ArchivedTaskResponse)(nil), "models.ArchivedTaskResponse")
+}
+
+func init() { proto.RegisterFile("task_archive_requests.proto", fileDescriptor_bf62334c4dc63b6d) }
+
+var fileDescriptor_bf62334c4dc63b6d = []byte{
+	// 487 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0xbd, 0x09, 0x49, 0x93, 0x31, 0x6e, 0xd1, 0xaa, 0x48, 0xa6, 0x15, 0x1b, 0x2b, 0x20,
+	0x30, 0x48, 0xa4, 0x52, 0xe1, 0x82, 0x38, 0x35, 0x12, 0xaa, 0x38, 0x81, 0x96, 0xde, 0x2d, 0x27,
+	0xbb, 0x71, 0xad, 0x38, 0xde, 0xe0, 0xb5, 0x2b, 0xd2, 0x13, 0x8f, 0x00, 0x8f, 0xc0, 0x8d, 0x47,
+	0xe1, 0x98, 0x63, 0x4f, 0x11, 0x71, 0x2e, 0x28, 0xa7, 0x3e, 0x02, 0xda, 0xb5, 0xd3, 0xb8, 0x48,
+	0x1c, 0x38, 0xed, 0xcc, 0x37, 0xb3, 0xb3, 0x33, 0xf3, 0x2f, 0x1c, 0xa6, 0xbe, 0x1c, 0x7b, 0x7e,
+	0x32, 0x3c, 0x0f, 0x2f, 0xb8, 0x97, 0xf0, 0x4f, 0x19, 0x97, 0xa9, 0xec, 0x4d, 0x13, 0x91, 0x0a,
+	0xdc, 0x9c, 0x08, 0xc6, 0x23, 0x79, 0xf0, 0x22, 0x08, 0xd3, 0xf3, 0x6c, 0xd0, 0x1b, 0x8a, 0xc9,
+	0x51, 0x20, 0x02, 0x71, 0xa4, 0xc3, 0x83, 0x6c, 0xa4, 0x3d, 0xed, 0x68, 0xab, 0xb8, 0x76, 0x80,
+	0xab, 0x35, 0x4b, 0x66, 0xf2, 0x24, 0x11, 0x49, 0xe1, 0x74, 0xbf, 0xd5, 0x60, 0xff, 0xa4, 0x08,
+	0xb3, 0x33, 0x5f, 0x8e, 0x25, 0x2d, 0xde, 0xc5, 0x5d, 0x68, 0x32, 0x31, 0xf1, 0xc3, 0xd8, 0x46,
+	0x0e, 0x72, 0xdb, 0x7d, 0x58, 0x2f, 0x3a, 0x25, 0xa1, 0xe5, 0x89, 0x1f, 0xc3, 0xce, 0x90, 0x47,
+	0x91, 0x17, 0x32, 0xbb, 0xa6, 0x93, 0xcc, 0xf5, 0xa2, 0xb3, 0x41, 0xb4, 0xa9, 0x8c, 0x77, 0x0c,
+	0x77, 0xc0, 0x1c, 0xf9, 0x61, 0xc4, 0x99, 0x27, 0xe2, 0x68, 0x66, 0xd7, 0x1d, 0xe4, 0xb6, 0x28,
+	0x14, 0xe8, 0x7d, 0x1c, 0xcd, 0xf0, 0x53, 0xd8, 0x1b, 0x8a, 0xc9, 0x34, 0xe2, 0x29, 0x67, 0x9e,
+	0x3f, 0x4a, 0x79, 0x62, 0xdf, 0x71, 0x90, 0x5b, 0xa7, 0xbb, 0x37, 0xf8, 0x44, 0x51, 0xfc, 0x0c,
+	0xee, 0x6d, 0x13, 0x07, 0x7c, 0x24, 0x12, 0x6e, 0x37, 0x74, 0xe6, 0xb6, 0x40, 0x5f, 0x63, 0x7c,
+	0x08, 0xed, 0xa9, 0x1f, 0x70, 0x4f, 0x86, 0x97, 0xdc, 0x6e, 0x3a, 0xc8, 0x6d, 0xd0, 0x96, 0x02,
+	0x1f, 0xc3, 0x4b, 0x8e, 0x1f, 0x02, 0xe8, 0x60, 0x2a, 0xc6, 0x3c, 0xb6, 0x77, 0x54, 0xeb, 0x54,
+	0xa7, 0x9f, 0x29, 0xd0, 0xfd, 0x8e, 0xe0, 0xfe, 0x5f, 0x3b, 0x91, 0x53, 0x11, 0x4b, 0x8e, 0x1f,
+	0x41, 0x43, 0x2f, 0x4f, 0xef, 0xc4, 0x3c, 0xb6, 0x7a, 0x85, 0x2a, 0xbd, 0xb7, 0x0a, 0xd2, 0x22,
+	0x86, 0xdf, 0xc0, 0x6e, 0xb9, 0x70, 0xe6, 0xa9, 0xf5, 0x4b, 0xbb, 0xe6, 0xd4, 0x5d, 0xf3, 0x78,
+	0x7f, 0x93, 0x5d, 0xad, 0x4d, 0x2d, 0xbf, 0xfa, 0x12, 0x7e, 0x02, 0x7b, 0x31, 0xff, 0x9c, 0x7a,
+	0x95, 0xfe, 0xea, 0xba, 0x3f, 0x4b, 0xe1, 0x0f, 0x37, 0x3d, 0x9e, 0xc2, 0x83, 0x6a, 0x99, 0xfe,
+	0xec, 0x34, 0x0b, 0xd9, 0x46, 0xbb, 0xe7, 0xd0, 0xd6, 0xba, 0x07, 0x59, 0xc8, 0x4a, 0xf9, 0xac,
+	0xf5, 0xa2, 0xb3, 0x85, 0xb4, 0xa5, 0x4c, 0x75, 0xa5, 0x7b, 0x71, 0x5b, 0xff, 0xff, 0x1b, 0xf5,
+	0x35, 0x58, 0xb7, 0x46, 0xd5, 0xdf, 0xe0, 0x5f, 0x93, 0xde, 0xad, 0x4e, 0xda, 0x7f, 0x35, 0x5f,
+	0x12, 0xe3, 0x6a, 0x49, 0x8c, 0xeb, 0x25, 0x41, 0x5f, 0x72, 0x82, 0x7e, 0xe4, 0x04, 0xfd, 0xcc,
+	0x09, 0x9a, 0xe7, 0x04, 0xfd, 0xca, 0x09, 0xfa, 0x9d, 0x13, 0xe3, 0x3a, 0x27, 0xe8, 0xeb, 0x8a,
+	0x18, 0xf3, 0x15, 0x31, 0xae, 0x56, 0xc4, 0x18, 0x34, 0xf5, 0xaf, 0x7d, 0xf9, 0x67, 0x00, 0xd1,
+	0xbe, 0xf6, 0xef, 0x2c, 0x03, 0x00, 0x00,
+}
+
+func (this *ArchivedTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ArchivedTasksRequest)
+	if !ok {
+		that2, ok := that.(ArchivedTasksRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Domain != that1.Domain {
+		return false
+	}
+	if this.CellId != that1.CellId {
+		return false
+	}
+	if this.FailedOnly != that1.FailedOnly {
+		return false
+	}
+	if this.CompletedAfter != that1.CompletedAfter {
+		return false
+	}
+	if this.CompletedBefore != that1.CompletedBefore {
+		return false
+	}
+	if this.PageSize != that1.PageSize {
+		return false
+	}
+	if this.PageToken != that1.PageToken {
+		return false
+	}
+	return true
+}
+func (this *ArchivedTasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ArchivedTasksResponse)
+	if !ok {
+		that2, ok := that.(ArchivedTasksResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if len(this.ArchivedTasks) != len(that1.ArchivedTasks) {
+		return false
+	}
+	for i := range this.ArchivedTasks {
+		if !this.ArchivedTasks[i].Equal(that1.ArchivedTasks[i]) {
+			return false
+		}
+	}
+	if this.NextPageToken != that1.NextPageToken {
+		return false
+	}
+	return true
+}
+func (this *ArchivedTaskByGuidRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ArchivedTaskByGuidRequest)
+	if !ok {
+		that2, ok := that.(ArchivedTaskByGuidRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TaskGuid != that1.TaskGuid {
+		return false
+	}
+	return true
+}
+func (this *ArchivedTaskResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ArchivedTaskResponse)
+	if !ok {
+		that2, ok := that.(ArchivedTaskResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if !this.ArchivedTask.Equal(that1.ArchivedTask) {
+		return false
+	}
+	return true
+}
+func (this *ArchivedTasksRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&models.ArchivedTasksRequest{")
+	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
+	s = append(s, "CellId: "+fmt.Sprintf("%#v", this.CellId)+",\n")
+	s = append(s, "FailedOnly: "+fmt.Sprintf("%#v", this.FailedOnly)+",\n")
+	s = append(s, "CompletedAfter: "+fmt.Sprintf("%#v", this.CompletedAfter)+",\n")
+	s = append(s, "CompletedBefore: "+fmt.Sprintf("%#v", this.CompletedBefore)+",\n")
+	s = append(s, "PageSize: "+fmt.Sprintf("%#v", this.PageSize)+",\n")
+	s = append(s, "PageToken: "+fmt.Sprintf("%#v", this.PageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ArchivedTasksResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&models.ArchivedTasksResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.ArchivedTasks != nil {
+		s = append(s, "ArchivedTasks: "+fmt.Sprintf("%#v", this.ArchivedTasks)+",\n")
+	}
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ArchivedTaskByGuidRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.ArchivedTaskByGuidRequest{")
+	s = append(s, "TaskGuid: "+fmt.Sprintf("%#v", this.TaskGuid)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ArchivedTaskResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.ArchivedTaskResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.ArchivedTask != nil {
+		s = append(s, "ArchivedTask: "+fmt.Sprintf("%#v", this.ArchivedTask)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringTaskArchiveRequests(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *ArchivedTasksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchivedTasksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchivedTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PageToken) > 0 {
+		i -= len(m.PageToken)
+		copy(dAtA[i:], m.PageToken)
+		i = encodeVarintTaskArchiveRequests(dAtA, i, uint64(len(m.PageToken)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintTaskArchiveRequests(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CompletedBefore != 0 {
+		i = encodeVarintTaskArchiveRequests(dAtA, i, uint64(m.CompletedBefore))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CompletedAfter != 0 {
+		i = encodeVarintTaskArchiveRequests(dAtA, i, uint64(m.CompletedAfter))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FailedOnly {
+		i--
+		if m.FailedOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CellId) > 0 {
+		i -= len(m.CellId)
+		copy(dAtA[i:], m.CellId)
+		i = encodeVarintTaskArchiveRequests(dAtA, i, uint64(len(m.CellId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintTaskArchiveRequests(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArchivedTasksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchivedTasksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchivedTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintTaskArchiveRequests(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ArchivedTasks) > 0 {
+		for iNdEx := len(m.ArchivedTasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ArchivedTasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTaskArchiveRequests(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTaskArchiveRequests(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArchivedTaskByGuidRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchivedTaskByGuidRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchivedTaskByGuidRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TaskGuid) > 0 {
+		i -= len(m.TaskGuid)
+		copy(dAtA[i:], m.TaskGuid)
+		i = encodeVarintTaskArchiveRequests(dAtA, i, uint64(len(m.TaskGuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArchivedTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchivedTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchivedTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ArchivedTask != nil {
+		{
+			size, err := m.ArchivedTask.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTaskArchiveRequests(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTaskArchiveRequests(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTaskArchiveRequests(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTaskArchiveRequests(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ArchivedTasksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovTaskArchiveRequests(uint64(l))
+	}
+	l = len(m.CellId)
+	if l > 0 {
+		n += 1 + l + sovTaskArchiveRequests(uint64(l))
+	}
+	if m.FailedOnly {
+		n += 2
+	}
+	if m.CompletedAfter != 0 {
+		n += 1 + sovTaskArchiveRequests(uint64(m.CompletedAfter))
+	}
+	if m.CompletedBefore != 0 {
+		n += 1 + sovTaskArchiveRequests(uint64(m.CompletedBefore))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovTaskArchiveRequests(uint64(m.PageSize))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovTaskArchiveRequests(uint64(l))
+	}
+	return n
+}
+
+func (m *ArchivedTasksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovTaskArchiveRequests(uint64(l))
+	}
+	if len(m.ArchivedTasks) > 0 {
+		for _, e := range m.ArchivedTasks {
+			l = e.Size()
+			n += 1 + l + sovTaskArchiveRequests(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovTaskArchiveRequests(uint64(l))
+	}
+	return n
+}
+
+func (m *ArchivedTaskByGuidRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskGuid)
+	if l > 0 {
+		n += 1 + l + sovTaskArchiveRequests(uint64(l))
+	}
+	return n
+}
+
+func (m *ArchivedTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovTaskArchiveRequests(uint64(l))
+	}
+	if m.ArchivedTask != nil {
+		l = m.ArchivedTask.Size()
+		n += 1 + l + sovTaskArchiveRequests(uint64(l))
+	}
+	return n
+}
+
+func sovTaskArchiveRequests(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTaskArchiveRequests(x uint64) (n int) {
+	return sovTaskArchiveRequests(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ArchivedTasksRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ArchivedTasksRequest{`,
+		`Domain:` + fmt.Sprintf("%v", this.Domain) + `,`,
+		`CellId:` + fmt.Sprintf("%v", this.CellId) + `,`,
+		`FailedOnly:` + fmt.Sprintf("%v", this.FailedOnly) + `,`,
+		`CompletedAfter:` + fmt.Sprintf("%v", this.CompletedAfter) + `,`,
+		`CompletedBefore:` + fmt.Sprintf("%v", this.CompletedBefore) + `,`,
+		`PageSize:` + fmt.Sprintf("%v", this.PageSize) + `,`,
+		`PageToken:` + fmt.Sprintf("%v", this.PageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ArchivedTasksResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForArchivedTasks := "[]*ArchivedTask{"
+	for _, f := range this.ArchivedTasks {
+		repeatedStringForArchivedTasks += strings.Replace(fmt.Sprintf("%v", f), "ArchivedTask", "ArchivedTask", 1) + ","
+	}
+	repeatedStringForArchivedTasks += "}"
+	s := strings.Join([]string{`&ArchivedTasksResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`ArchivedTasks:` + repeatedStringForArchivedTasks + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ArchivedTaskByGuidRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ArchivedTaskByGuidRequest{`,
+		`TaskGuid:` + fmt.Sprintf("%v", this.TaskGuid) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ArchivedTaskResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ArchivedTaskResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`ArchivedTask:` + strings.Replace(fmt.Sprintf("%v", this.ArchivedTask), "ArchivedTask", "ArchivedTask", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringTaskArchiveRequests(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ArchivedTasksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskArchiveRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchivedTasksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchivedTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskArchiveRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskArchiveRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskArchiveRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskArchiveRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskArchiveRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskArchiveRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskArchiveRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FailedOnly = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedAfter", wireType)
+			}
+			m.CompletedAfter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskArchiveRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletedAfter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedBefore", wireType)
+			}
+			m.CompletedBefore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskArchiveRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletedBefore |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskArchiveRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskArchiveRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskArchiveRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskArchiveRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskArchiveRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTaskArchiveRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArchivedTasksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskArchiveRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchivedTasksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchivedTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskArchiveRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskArchiveRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskArchiveRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedTasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskArchiveRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskArchiveRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskArchiveRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArchivedTasks = append(m.ArchivedTasks, &ArchivedTask{})
+			if err := m.ArchivedTasks[len(m.ArchivedTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskArchiveRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskArchiveRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskArchiveRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskArchiveRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTaskArchiveRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArchivedTaskByGuidRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskArchiveRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchivedTaskByGuidRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchivedTaskByGuidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskArchiveRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskArchiveRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskArchiveRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskArchiveRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTaskArchiveRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArchivedTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskArchiveRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchivedTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchivedTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskArchiveRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskArchiveRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskArchiveRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedTask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskArchiveRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskArchiveRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskArchiveRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ArchivedTask == nil {
+				m.ArchivedTask = &ArchivedTask{}
+			}
+			if err := m.ArchivedTask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskArchiveRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTaskArchiveRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTaskArchiveRequests(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTaskArchiveRequests
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTaskArchiveRequests
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTaskArchiveRequests
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTaskArchiveRequests
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTaskArchiveRequests
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTaskArchiveRequests
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTaskArchiveRequests        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTaskArchiveRequests          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTaskArchiveRequests = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,74 @@
+package models_test
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TaskArchive", func() {
+	Describe("ArchivedTaskPageToken", func() {
+		It("can be parsed back into the archive time and guid of the task", func() {
+			token := models.ArchivedTaskPageToken(&models.ArchivedTask{TaskGuid: "some-guid", ArchivedAt: 1393371971000000000})
+
+			archivedAt, taskGuid, err := models.ParseArchivedTaskPageToken(token)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(archivedAt).To(BeEquivalentTo(1393371971000000000))
+			Expect(taskGuid).To(Equal("some-guid"))
+		})
+
+		It("rejects tokens that it did not generate", func() {
+			_, _, err := models.ParseArchivedTaskPageToken("garbage")
+			Expect(err).To(Equal(models.ErrInvalidPageToken))
+
+			_, _, err = models.ParseArchivedTaskPageToken("not base64!")
+			Expect(err).To(Equal(models.ErrInvalidPageToken))
+		})
+	})
+
+	Describe("ArchivedTasksRequest", func() {
+		It("is valid without filters", func() {
+			request := models.ArchivedTasksRequest{}
+			Expect(request.Validate()).To(Succeed())
+		})
+
+		It("requires non-negative completion times", func() {
+			request := models.ArchivedTasksRequest{CompletedAfter: -1, CompletedBefore: -1}
+			Expect(request.Validate()).To(ConsistOf(
+				models.ErrInvalidField{"completed_after"},
+				models.ErrInvalidField{"completed_before"},
+			))
+		})
+
+		It("limits the page size", func() {
+			request := models.ArchivedTasksRequest{PageSize: models.MaxArchivedTasksPageSize}
+			Expect(request.Validate()).To(Succeed())
+
+			request.PageSize = models.MaxArchivedTasksPageSize + 1
+			Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"page_size"}))
+
+			request.PageSize = -1
+			Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"page_size"}))
+		})
+
+		It("requires a valid page token", func() {
+			request := models.ArchivedTasksRequest{
+				PageToken: models.ArchivedTaskPageToken(&models.ArchivedTask{TaskGuid: "some-guid", ArchivedAt: 1}),
+			}
+			Expect(request.Validate()).To(Succeed())
+
+			request.PageToken = "garbage"
+			Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"page_token"}))
+		})
+	})
+
+	Describe("ArchivedTaskByGuidRequest", func() {
+		It("requires a valid task guid", func() {
+			request := models.ArchivedTaskByGuidRequest{TaskGuid: "some-guid"}
+			Expect(request.Validate()).To(Succeed())
+
+			request.TaskGuid = ""
+			Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"task_guid"}))
+		})
+	})
+})
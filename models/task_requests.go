@@ -1,5 +1,7 @@
 package models
 
+import "strings"
+
 func (req *DesireTaskRequest) Validate() error {
 	var validationError ValidationError
 
@@ -15,6 +17,68 @@ func (req *DesireTaskRequest) Validate() error {
 		validationError = validationError.Append(ErrInvalidField{"task_definition"})
 	} else if defErr := req.TaskDefinition.Validate(); defErr != nil {
 		validationError = validationError.Append(defErr)
+	} else {
+		for _, guid := range req.TaskDefinition.DependsOn {
+			if guid == req.TaskGuid {
+				validationError = validationError.Append(ErrInvalidField{"depends_on"})
+				break
+			}
+		}
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
+func (req *DesireTaskArrayRequest) Validate() error {
+	var validationError ValidationError
+
+	if !taskGuidPattern.MatchString(req.ArrayGuid) {
+		validationError = validationError.Append(ErrInvalidField{"array_guid"})
+	}
+
+	if req.Domain == "" {
+		validationError = validationError.Append(ErrInvalidField{"domain"})
+	}
+
+	if req.Count < 1 || req.Count > MaxTaskArraySize {
+		validationError = validationError.Append(ErrInvalidField{"count"})
+	}
+
+	if req.TaskDefinition == nil {
+		validationError = validationError.Append(ErrInvalidField{"task_definition"})
+	} else if defErr := req.TaskDefinition.Validate(); defErr != nil {
+		validationError = validationError.Append(defErr)
+	} else {
+		for _, env := range req.TaskDefinition.EnvironmentVariables {
+			if env.Name == TaskArrayIndexEnvVar {
+				validationError = validationError.Append(ErrInvalidField{"environment_variables"})
+				break
+			}
+		}
+		for _, guid := range req.TaskDefinition.DependsOn {
+			if strings.HasPrefix(guid, req.ArrayGuid+"-") {
+				validationError = validationError.Append(ErrInvalidField{"depends_on"})
+				break
+			}
+		}
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
+func (req *TaskArrayGuidRequest) Validate() error {
+	var validationError ValidationError
+
+	if !taskGuidPattern.MatchString(req.ArrayGuid) {
+		validationError = validationError.Append(ErrInvalidField{"array_guid"})
 	}
 
 	if !validationError.Empty() {
@@ -110,6 +174,20 @@ func (request *TaskByGuidRequest) Validate() error {
 	return nil
 }
 
+func (request *TaskResultRequest) Validate() error {
+	var validationError ValidationError
+
+	if !taskGuidPattern.MatchString(request.TaskGuid) {
+		validationError = validationError.Append(ErrInvalidField{"task_guid"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
 func (request *TaskGuidRequest) Validate() error {
 	var validationError ValidationError
 
@@ -36,6 +36,44 @@ var _ = Describe("Task requests", func() {
 		})
 	})
 
+	Describe("TaskResultRequest", func() {
+		Describe("Validate", func() {
+			var request models.TaskResultRequest
+
+			BeforeEach(func() {
+				request = models.TaskResultRequest{
+					TaskGuid: "something",
+				}
+			})
+
+			Context("when valid", func() {
+				It("returns nil", func() {
+					Expect(request.Validate()).To(BeNil())
+				})
+			})
+
+			Context("when the TaskGuid is blank", func() {
+				BeforeEach(func() {
+					request.TaskGuid = ""
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"task_guid"}))
+				})
+			})
+
+			Context("when the TaskGuid is invalid", func() {
+				BeforeEach(func() {
+					request.TaskGuid = "invalid/guid"
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"task_guid"}))
+				})
+			})
+		})
+	})
+
 	Describe("DesireTaskRequest", func() {
 		Describe("Validate", func() {
 			var request models.DesireTaskRequest
@@ -93,6 +131,119 @@ var _ = Describe("Task requests", func() {
 					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"rootfs"}))
 				})
 			})
+
+			Context("when the TaskDefinition depends on the task itself", func() {
+				BeforeEach(func() {
+					request.TaskDefinition.DependsOn = []string{"other-guid", "t-guid"}
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"depends_on"}))
+				})
+			})
+		})
+	})
+
+	Describe("DesireTaskArrayRequest", func() {
+		Describe("Validate", func() {
+			var request models.DesireTaskArrayRequest
+
+			BeforeEach(func() {
+				request = models.DesireTaskArrayRequest{
+					ArrayGuid:      "array-guid",
+					Domain:         "domain",
+					Count:          3,
+					TaskDefinition: model_helpers.NewValidTaskDefinition(),
+				}
+			})
+
+			Context("when valid", func() {
+				It("returns nil", func() {
+					Expect(request.Validate()).To(BeNil())
+				})
+			})
+
+			Context("when the ArrayGuid is invalid", func() {
+				BeforeEach(func() {
+					request.ArrayGuid = "array/guid"
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"array_guid"}))
+				})
+			})
+
+			Context("when the domain is blank", func() {
+				BeforeEach(func() {
+					request.Domain = ""
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"domain"}))
+				})
+			})
+
+			Context("when the count is not positive", func() {
+				BeforeEach(func() {
+					request.Count = 0
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"count"}))
+				})
+			})
+
+			Context("when the count is above the maximum", func() {
+				BeforeEach(func() {
+					request.Count = models.MaxTaskArraySize + 1
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"count"}))
+				})
+			})
+
+			Context("when the TaskDefinition is nil", func() {
+				BeforeEach(func() {
+					request.TaskDefinition = nil
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"task_definition"}))
+				})
+			})
+
+			Context("when the TaskDefinition already sets INDEX", func() {
+				BeforeEach(func() {
+					request.TaskDefinition.EnvironmentVariables = []*models.EnvironmentVariable{{Name: "INDEX", Value: "7"}}
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"environment_variables"}))
+				})
+			})
+
+			Context("when the TaskDefinition depends on a task of the same array", func() {
+				BeforeEach(func() {
+					request.TaskDefinition.DependsOn = []string{"array-guid-0"}
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"depends_on"}))
+				})
+			})
+		})
+	})
+
+	Describe("TaskArrayGuidRequest", func() {
+		Describe("Validate", func() {
+			It("requires a valid array guid", func() {
+				request := models.TaskArrayGuidRequest{ArrayGuid: "array-guid"}
+				Expect(request.Validate()).To(BeNil())
+
+				request.ArrayGuid = ""
+				Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"array_guid"}))
+			})
 		})
 	})
 
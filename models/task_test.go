@@ -99,6 +99,24 @@ var _ = Describe("Task", func() {
 			}
 		],
 		"legacy_download_user": "some-user",
+		"depends_on": ["some-other-guid"],
+		"dependency_condition": "OnCompletion",
+		"retry_policy": {
+			"max_attempts": 3,
+			"backoff_ms": 1000,
+			"max_backoff_ms": 60000,
+			"retry_on": ["CellLost", "NonZeroExit"]
+		},
+		"priority": 10,
+		"attempts": [
+			{
+				"cell_id": "some-other-cell",
+				"failure_class": "CellLost",
+				"failure_reason": "cell disappeared before completion",
+				"failed_at": 1393371971000000005
+			}
+		],
+		"retry_at": 1393371971000000006,
 		"metric_tags": {
 		  "source_id": {
 			  "static": "some-guid"
@@ -440,6 +458,127 @@ var _ = Describe("Task", func() {
 					},
 				},
 			},
+			{
+				"depends_on",
+				&models.Task{
+					Domain:   "some-domain",
+					TaskGuid: "task-guid",
+					TaskDefinition: &models.TaskDefinition{
+						RootFs: "some:rootfs",
+						Action: models.WrapAction(&models.RunAction{
+							Path: "ls",
+							User: "me",
+						}),
+						DependsOn: []string{"invalid/guid"},
+					},
+				},
+			},
+			{
+				"dependency_condition",
+				&models.Task{
+					Domain:   "some-domain",
+					TaskGuid: "task-guid",
+					TaskDefinition: &models.TaskDefinition{
+						RootFs: "some:rootfs",
+						Action: models.WrapAction(&models.RunAction{
+							Path: "ls",
+							User: "me",
+						}),
+						DependsOn:           []string{"other-task-guid"},
+						DependencyCondition: models.TaskDefinition_DependencyCondition(42),
+					},
+				},
+			},
+			{
+				"max_run_duration_ms",
+				&models.Task{
+					Domain:   "some-domain",
+					TaskGuid: "task-guid",
+					TaskDefinition: &models.TaskDefinition{
+						RootFs: "some:rootfs",
+						Action: models.WrapAction(&models.RunAction{
+							Path: "ls",
+							User: "me",
+						}),
+						MaxRunDurationMs: -1,
+					},
+				},
+			},
+			{
+				"priority",
+				&models.Task{
+					Domain:   "some-domain",
+					TaskGuid: "task-guid",
+					TaskDefinition: &models.TaskDefinition{
+						RootFs: "some:rootfs",
+						Action: models.WrapAction(&models.RunAction{
+							Path: "ls",
+							User: "me",
+						}),
+						Priority: -1,
+					},
+				},
+			},
+			{
+				"retry_policy.max_attempts",
+				&models.Task{
+					Domain:   "some-domain",
+					TaskGuid: "task-guid",
+					TaskDefinition: &models.TaskDefinition{
+						RootFs: "some:rootfs",
+						Action: models.WrapAction(&models.RunAction{
+							Path: "ls",
+							User: "me",
+						}),
+						RetryPolicy: &models.RetryPolicy{MaxAttempts: -1},
+					},
+				},
+			},
+			{
+				"retry_policy.backoff_ms",
+				&models.Task{
+					Domain:   "some-domain",
+					TaskGuid: "task-guid",
+					TaskDefinition: &models.TaskDefinition{
+						RootFs: "some:rootfs",
+						Action: models.WrapAction(&models.RunAction{
+							Path: "ls",
+							User: "me",
+						}),
+						RetryPolicy: &models.RetryPolicy{MaxAttempts: 2, BackoffMs: -1},
+					},
+				},
+			},
+			{
+				"retry_policy.max_backoff_ms",
+				&models.Task{
+					Domain:   "some-domain",
+					TaskGuid: "task-guid",
+					TaskDefinition: &models.TaskDefinition{
+						RootFs: "some:rootfs",
+						Action: models.WrapAction(&models.RunAction{
+							Path: "ls",
+							User: "me",
+						}),
+						RetryPolicy: &models.RetryPolicy{MaxAttempts: 2, MaxBackoffMs: -1},
+					},
+				},
+			},
+			{
+				"retry_policy.retry_on",
+				&models.Task{
+					Domain:   "some-domain",
+					TaskGuid: "task-guid",
+					TaskDefinition: &models.TaskDefinition{
+						RootFs: "some:rootfs",
+						Action: models.WrapAction(&models.RunAction{
+							Path: "ls",
+							User: "me",
+						}),
+						RetryPolicy: &models.RetryPolicy{MaxAttempts: 2, RetryOn: []models.RetryPolicy_FailureClass{42}},
+					},
+				},
+			},
 			{
 				"legacy_download_user",
 				&models.Task{
@@ -659,7 +798,172 @@ var _ = Describe("Task", func() {
 				Entry("running", models.Task_Running, `"Running"`),
 				Entry("completed", models.Task_Completed, `"Completed"`),
 				Entry("resolving", models.Task_Resolving, `"Resolving"`),
+				Entry("blocked", models.Task_Blocked, `"Blocked"`),
 			)
 		})
 	})
+
+	Describe("Task arrays", func() {
+		Describe("TaskArrayTaskDefinition", func() {
+			It("copies the definition and adds the INDEX environment variable", func() {
+				taskDef := &models.TaskDefinition{
+					RootFs:               "some:rootfs",
+					EnvironmentVariables: []*models.EnvironmentVariable{{Name: "FOO", Value: "bar"}},
+				}
+
+				indexed := models.TaskArrayTaskDefinition(taskDef, 4)
+				Expect(indexed.RootFs).To(Equal("some:rootfs"))
+				Expect(indexed.EnvironmentVariables).To(Equal([]*models.EnvironmentVariable{
+					{Name: "FOO", Value: "bar"},
+					{Name: "INDEX", Value: "4"},
+				}))
+				Expect(taskDef.EnvironmentVariables).To(HaveLen(1))
+			})
+		})
+
+		Describe("TaskArrayTaskGuid", func() {
+			It("appends the index to the array guid", func() {
+				Expect(models.TaskArrayTaskGuid("array-guid", 12)).To(Equal("array-guid-12"))
+			})
+		})
+
+		Describe("NewTaskArrayStatus", func() {
+			It("counts the tasks in each state", func() {
+				tasks := []*models.Task{
+					{State: models.Task_Blocked},
+					{State: models.Task_Pending},
+					{State: models.Task_Running},
+					{State: models.Task_Running},
+					{State: models.Task_Completed},
+					{State: models.Task_Completed, Failed: true},
+					{State: models.Task_Resolving, Failed: true},
+				}
+
+				Expect(models.NewTaskArrayStatus("array-guid", tasks)).To(Equal(&models.TaskArrayStatus{
+					ArrayGuid: "array-guid",
+					Total:     7,
+					Blocked:   1,
+					Pending:   1,
+					Running:   2,
+					Succeeded: 1,
+					Failed:    2,
+				}))
+			})
+		})
+	})
+
+	Describe("ValidateTransitionTo", func() {
+		It("allows blocked tasks to become pending", func() {
+			task := models.Task{State: models.Task_Blocked}
+			Expect(task.ValidateTransitionTo(models.Task_Pending)).To(Succeed())
+		})
+
+		It("does not allow blocked tasks to start running", func() {
+			task := models.Task{State: models.Task_Blocked}
+			Expect(task.ValidateTransitionTo(models.Task_Running)).To(HaveOccurred())
+		})
+
+		It("does not allow running tasks to become pending", func() {
+			task := models.Task{State: models.Task_Running}
+			Expect(task.ValidateTransitionTo(models.Task_Pending)).To(HaveOccurred())
+		})
+	})
+
+	Describe("DependencyCondition", func() {
+		Describe("MarshalJSON", func() {
+			DescribeTable("marshals and unmarshals between the value and the expected JSON output",
+				func(v models.TaskDefinition_DependencyCondition, expectedJSON string) {
+					Expect(json.Marshal(v)).To(MatchJSON(expectedJSON))
+					var testV models.TaskDefinition_DependencyCondition
+					Expect(json.Unmarshal([]byte(expectedJSON), &testV)).To(Succeed())
+					Expect(testV).To(Equal(v))
+				},
+				Entry("on success", models.TaskDefinition_OnSuccess, `"OnSuccess"`),
+				Entry("on completion", models.TaskDefinition_OnCompletion, `"OnCompletion"`),
+			)
+		})
+	})
+
+	Describe("RetryPolicy", func() {
+		var policy *models.RetryPolicy
+
+		BeforeEach(func() {
+			policy = &models.RetryPolicy{
+				MaxAttempts:  3,
+				BackoffMs:    1000,
+				MaxBackoffMs: 3000,
+			}
+		})
+
+		Describe("ShouldRetry", func() {
+			It("retries any failure when no failure classes are given", func() {
+				Expect(policy.ShouldRetry(models.RetryPolicy_CellLost, 1)).To(BeTrue())
+				Expect(policy.ShouldRetry(models.RetryPolicy_NonZeroExit, 1)).To(BeTrue())
+			})
+
+			It("stops retrying once the maximum number of attempts have failed", func() {
+				Expect(policy.ShouldRetry(models.RetryPolicy_CellLost, 2)).To(BeTrue())
+				Expect(policy.ShouldRetry(models.RetryPolicy_CellLost, 3)).To(BeFalse())
+			})
+
+			It("only retries the given failure classes", func() {
+				policy.RetryOn = []models.RetryPolicy_FailureClass{models.RetryPolicy_CellLost}
+				Expect(policy.ShouldRetry(models.RetryPolicy_CellLost, 1)).To(BeTrue())
+				Expect(policy.ShouldRetry(models.RetryPolicy_NonZeroExit, 1)).To(BeFalse())
+			})
+
+			It("retries every failure class when AnyFailure is given", func() {
+				policy.RetryOn = []models.RetryPolicy_FailureClass{models.RetryPolicy_AnyFailure}
+				Expect(policy.ShouldRetry(models.RetryPolicy_NonZeroExit, 1)).To(BeTrue())
+			})
+		})
+
+		Describe("Backoff", func() {
+			It("doubles the backoff after every failed attempt up to the maximum", func() {
+				Expect(policy.Backoff(1)).To(Equal(1 * time.Second))
+				Expect(policy.Backoff(2)).To(Equal(2 * time.Second))
+				Expect(policy.Backoff(3)).To(Equal(3 * time.Second))
+			})
+
+			It("does not overflow", func() {
+				policy.MaxBackoffMs = 0
+				Expect(policy.Backoff(1000)).To(BeNumerically(">", 0))
+			})
+		})
+
+		Describe("Task.ShouldRetry", func() {
+			It("counts the task's previous attempts", func() {
+				task := models.Task{TaskDefinition: &models.TaskDefinition{RetryPolicy: policy}}
+				Expect(task.ShouldRetry(models.RetryPolicy_CellLost)).To(BeTrue())
+
+				task.Attempts = []*models.TaskAttempt{{}, {}}
+				Expect(task.ShouldRetry(models.RetryPolicy_CellLost)).To(BeFalse())
+			})
+
+			It("does not retry tasks without a retry policy", func() {
+				task := models.Task{TaskDefinition: &models.TaskDefinition{}}
+				Expect(task.ShouldRetry(models.RetryPolicy_CellLost)).To(BeFalse())
+			})
+		})
+
+		Describe("FailureClass MarshalJSON", func() {
+			DescribeTable("marshals and unmarshals between the value and the expected JSON output",
+				func(v models.RetryPolicy_FailureClass, expectedJSON string) {
+					Expect(json.Marshal(v)).To(MatchJSON(expectedJSON))
+					var testV models.RetryPolicy_FailureClass
+					Expect(json.Unmarshal([]byte(expectedJSON), &testV)).To(Succeed())
+					Expect(testV).To(Equal(v))
+				},
+				Entry("any failure", models.RetryPolicy_AnyFailure, `"AnyFailure"`),
+				Entry("cell lost", models.RetryPolicy_CellLost, `"CellLost"`),
+				Entry("non-zero exit", models.RetryPolicy_NonZeroExit, `"NonZeroExit"`),
+				Entry("overdue", models.RetryPolicy_Overdue, `"Overdue"`),
+			)
+
+			It("rejects unknown failure classes", func() {
+				var failureClass models.RetryPolicy_FailureClass
+				Expect(json.Unmarshal([]byte(`"Sometimes"`), &failureClass)).To(MatchError("invalid failure class: Sometimes"))
+			})
+		})
+	})
 })
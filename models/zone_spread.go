@@ -0,0 +1,11 @@
+package models
+
+func (c ZoneSpreadConstraint) Validate() error {
+	var validationError ValidationError
+
+	if c.MaxSkew < 1 {
+		validationError = validationError.Append(ErrInvalidField{"max_skew"})
+	}
+
+	return validationError.ToError()
+}
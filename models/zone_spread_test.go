@@ -0,0 +1,26 @@
+package models_test
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ZoneSpread", func() {
+	Describe("ZoneSpreadConstraint", func() {
+		DescribeTable("Validate",
+			func(constraint models.ZoneSpreadConstraint, invalidField string) {
+				err := constraint.Validate()
+				if invalidField == "" {
+					Expect(err).NotTo(HaveOccurred())
+				} else {
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring(invalidField))
+				}
+			},
+			Entry("a positive max skew", models.ZoneSpreadConstraint{MaxSkew: 1}, ""),
+			Entry("no max skew", models.ZoneSpreadConstraint{}, "max_skew"),
+			Entry("a negative max skew", models.ZoneSpreadConstraint{MaxSkew: -1}, "max_skew"),
+		)
+	})
+})
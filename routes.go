@@ -7,11 +7,22 @@ const (
 	PingRoute_r0 = "Ping"
 
 	// Domains
-	DomainsRoute_r0      = "Domains"
+	DomainsRoute_r1      = "Domains"
 	UpsertDomainRoute_r0 = "UpsertDomain"
+	ExpireDomainRoute_r0 = "ExpireDomain"
+	DeleteDomainRoute_r0 = "DeleteDomain"
+	// Deprecated: use DomainsRoute_r1 instead
+	DomainsRoute_r0 = "Domains_r0"
+
+	// Domain Quotas
+	UpsertDomainQuotaRoute_r0 = "UpsertDomainQuota"
+	DeleteDomainQuotaRoute_r0 = "DeleteDomainQuota"
+	DomainQuotasRoute_r0      = "DomainQuotas"
+	DomainUsageRoute_r0       = "DomainUsage"
 
 	// Actual LRPs
-	ActualLRPsRoute_r0 = "ActualLRPs"
+	ActualLRPsRoute_r0       = "ActualLRPs"
+	ActualLRPCrashesRoute_r0 = "ActualLRPCrashes"
 	// Deprecated: use the ActualLRPInstances API instead
 	ActualLRPGroupsRoute_r0 = "ActualLRPGroups"
 	// Deprecated: use the ActualLRPInstances API instead
@@ -23,11 +34,12 @@ const (
 	ClaimActualLRPRoute_r0 = "ClaimActualLRP"
 	StartActualLRPRoute_r1 = "StartActualLRP"
 	// Deprecated: use StartActaulLRPRoute_r1 instead
-	StartActualLRPRoute_r0  = "StartActualLRP_r0"
-	CrashActualLRPRoute_r0  = "CrashActualLRP"
-	FailActualLRPRoute_r0   = "FailActualLRP"
-	RemoveActualLRPRoute_r0 = "RemoveActualLRP"
-	RetireActualLRPRoute_r0 = "RetireActualLRP"
+	StartActualLRPRoute_r0   = "StartActualLRP_r0"
+	CrashActualLRPRoute_r0   = "CrashActualLRP"
+	FailActualLRPRoute_r0    = "FailActualLRP"
+	RemoveActualLRPRoute_r0  = "RemoveActualLRP"
+	RetireActualLRPRoute_r0  = "RetireActualLRP"
+	RestartActualLRPRoute_r0 = "RestartActualLRP"
 
 	// Evacuation
 	RemoveEvacuatingActualLRPRoute_r0 = "RemoveEvacuatingActualLRP"
@@ -39,24 +51,36 @@ const (
 	EvacuateRunningActualLRPRoute_r0 = "EvacuateRunningActualLRP_r0"
 
 	// Desired LRPs
-	DesiredLRPsRoute_r3                      = "DesiredLRPs"
+	DesiredLRPsRoute_r4                      = "DesiredLRPs"
 	DesiredLRPSchedulingInfosRoute_r0        = "DesiredLRPSchedulingInfos"
 	DesiredLRPSchedulingInfoByProcessGuid_r0 = "DesiredLRPSchedulingInfoByProcessGuid"
 	DesiredLRPRoutingInfosRoute_r0           = "DesiredLRPRoutingInfos"
-	DesiredLRPByProcessGuidRoute_r3          = "DesiredLRPByProcessGuid"
+	DesiredLRPByProcessGuidRoute_r4          = "DesiredLRPByProcessGuid"
+	DesiredLRPStatusRoute_r0                 = "DesiredLRPStatus"
+	// Deprecated: use DesiredLRPsRoute_r4 instead
+	DesiredLRPsRoute_r3 = "DesiredLRPs_r3"
+	// Deprecated: use DesiredLRPByProcessGuidRoute_r4 instead
+	DesiredLRPByProcessGuidRoute_r3 = "DesiredLRPByProcessGuid_r3"
 	// Deprecated: use DsiredLRPByProcessGuidRoute_r3 instead
 	DesiredLRPsRoute_r2 = "DesiredLRPs_r2"
 	// Deprecated: use DsiredLRPByProcessGuidRoute_r3 instead
 	DesiredLRPByProcessGuidRoute_r2 = "DesiredLRPByProcessGuid_r2"
 
 	// Desire LRP Lifecycle
-	DesireDesiredLRPRoute_r2 = "DesireDesiredLRP"
-	UpdateDesiredLRPRoute_r0 = "UpdateDesireLRP"
-	RemoveDesiredLRPRoute_r0 = "RemoveDesiredLRP"
+	DesireDesiredLRPRoute_r2  = "DesireDesiredLRP"
+	UpdateDesiredLRPRoute_r0  = "UpdateDesireLRP"
+	RemoveDesiredLRPRoute_r0  = "RemoveDesiredLRP"
+	RestartDesiredLRPRoute_r0 = "RestartDesiredLRP"
+
+	// DesiredLRP Revisions
+	DesiredLRPRevisionsRoute_r0    = "DesiredLRPRevisions"
+	DesiredLRPRevisionDiffRoute_r0 = "DesiredLRPRevisionDiff"
+	RollbackDesiredLRPRoute_r0     = "RollbackDesiredLRP"
 
 	// Tasks
-	TasksRoute_r3      = "Tasks"
+	TasksRoute_r4      = "Tasks"
 	TaskByGuidRoute_r3 = "TaskByGuid"
+	TaskResultRoute_r0 = "TaskResult"
 	DesireTaskRoute_r2 = "DesireTask"
 	StartTaskRoute_r0  = "StartTask"
 	CancelTaskRoute_r0 = "CancelTask"
@@ -66,16 +90,36 @@ const (
 	CompleteTaskRoute_r0  = "CompleteTask"
 	ResolvingTaskRoute_r0 = "ResolvingTask"
 	DeleteTaskRoute_r0    = "DeleteTask"
+	// Deprecated: use TasksRoute_r4 instead
+	TasksRoute_r3 = "Tasks_r3"
 	// Deprecated: use TaskRoute_r3 instead
 	TasksRoute_r2 = "Tasks_r2"
 	// Deprecated: use TaskByGuid_r3 instead
 	TaskByGuidRoute_r2 = "TaskByGuid_r2"
 
+	// Task Arrays
+	DesireTaskArrayRoute_r0 = "DesireTaskArray"
+	TaskArrayStatusRoute_r0 = "TaskArrayStatus"
+	CancelTaskArrayRoute_r0 = "CancelTaskArray"
+
+	// Task Archive
+	ArchivedTasksRoute_r0      = "ArchivedTasks"
+	ArchivedTaskByGuidRoute_r0 = "ArchivedTaskByGuid"
+
+	// Scheduled Tasks
+	ScheduledTasksRoute_r0      = "ScheduledTasks"
+	ScheduledTaskByGuidRoute_r0 = "ScheduledTaskByGuid"
+	DesireScheduledTaskRoute_r0 = "DesireScheduledTask"
+	UpdateScheduledTaskRoute_r0 = "UpdateScheduledTask"
+	DeleteScheduledTaskRoute_r0 = "DeleteScheduledTask"
+
 	// Event Streaming
 	//Deprecated: use LRPInstanceEventStreamRoute_1 instead
 	LRPGroupEventStreamRoute_r1    = "EventStream"
 	TaskEventStreamRoute_r1        = "TaskEventStream"
-	LRPInstanceEventStreamRoute_r1 = "LRPInstanceEventStream"
+	LRPInstanceEventStreamRoute_r2 = "LRPInstanceEventStream"
+	// Deprecated: use LRPInstanceEventStreamRoute_r2 instead
+	LRPInstanceEventStreamRoute_r1 = "LRPInstanceEventStream_r1"
 	//Deprecated: use LRPInstanceEventStreamRoute_1 instead
 	EventStreamRoute_r0 = "EventStream_r0"
 	// Deprecated: use TaskEventStreamRoute_r1 instead
@@ -84,7 +128,12 @@ const (
 	LrpInstanceEventStreamRoute_r0 = "LrpInstanceEventStream_r0"
 
 	// Cell Presence
-	CellsRoute_r0 = "Cells"
+	CellsRoute_r0           = "Cells"
+	CordonCellRoute_r0      = "CordonCell"
+	UncordonCellRoute_r0    = "UncordonCell"
+	DrainCellRoute_r0       = "DrainCell"
+	CellDrainStatusRoute_r0 = "CellDrainStatus"
+	CapacityReportRoute_r0  = "CapacityReport"
 )
 
 var Routes = rata.Routes{
@@ -92,11 +141,21 @@ var Routes = rata.Routes{
 	{Path: "/v1/ping", Method: "POST", Name: PingRoute_r0},
 
 	// Domains
-	{Path: "/v1/domains/list", Method: "POST", Name: DomainsRoute_r0},
+	{Path: "/v1/domains/list.r1", Method: "POST", Name: DomainsRoute_r1},
 	{Path: "/v1/domains/upsert", Method: "POST", Name: UpsertDomainRoute_r0},
+	{Path: "/v1/domains/expire", Method: "POST", Name: ExpireDomainRoute_r0},
+	{Path: "/v1/domains/delete", Method: "POST", Name: DeleteDomainRoute_r0},
+	{Path: "/v1/domains/list", Method: "POST", Name: DomainsRoute_r0}, // DEPRECATED
+
+	// Domain Quotas
+	{Path: "/v1/domain_quotas/upsert", Method: "POST", Name: UpsertDomainQuotaRoute_r0},
+	{Path: "/v1/domain_quotas/delete", Method: "POST", Name: DeleteDomainQuotaRoute_r0},
+	{Path: "/v1/domain_quotas/list", Method: "POST", Name: DomainQuotasRoute_r0},
+	{Path: "/v1/domain_quotas/usage", Method: "POST", Name: DomainUsageRoute_r0},
 
 	// Actual LRPs
 	{Path: "/v1/actual_lrps/list", Method: "POST", Name: ActualLRPsRoute_r0},
+	{Path: "/v1/actual_lrps/crashes/list", Method: "POST", Name: ActualLRPCrashesRoute_r0},
 	{Path: "/v1/actual_lrp_groups/list", Method: "POST", Name: ActualLRPGroupsRoute_r0},                                              // DEPRECATED
 	{Path: "/v1/actual_lrp_groups/list_by_process_guid", Method: "POST", Name: ActualLRPGroupsByProcessGuidRoute_r0},                 // DEPRECATED
 	{Path: "/v1/actual_lrp_groups/get_by_process_guid_and_index", Method: "POST", Name: ActualLRPGroupByProcessGuidAndIndexRoute_r0}, // DEPRECATED
@@ -109,6 +168,7 @@ var Routes = rata.Routes{
 	{Path: "/v1/actual_lrps/fail", Method: "POST", Name: FailActualLRPRoute_r0},
 	{Path: "/v1/actual_lrps/remove", Method: "POST", Name: RemoveActualLRPRoute_r0},
 	{Path: "/v1/actual_lrps/retire", Method: "POST", Name: RetireActualLRPRoute_r0},
+	{Path: "/v1/actual_lrps/restart", Method: "POST", Name: RestartActualLRPRoute_r0},
 
 	// Evacuation
 	{Path: "/v1/actual_lrps/remove_evacuating", Method: "POST", Name: RemoveEvacuatingActualLRPRoute_r0},
@@ -123,8 +183,11 @@ var Routes = rata.Routes{
 	{Path: "/v1/desired_lrp_scheduling_infos/get_by_process_guid", Method: "POST", Name: DesiredLRPSchedulingInfoByProcessGuid_r0},
 	{Path: "/v1/desired_lrp_routing_infos/list", Method: "POST", Name: DesiredLRPRoutingInfosRoute_r0},
 
-	{Path: "/v1/desired_lrps/list.r3", Method: "POST", Name: DesiredLRPsRoute_r3},
-	{Path: "/v1/desired_lrps/get_by_process_guid.r3", Method: "POST", Name: DesiredLRPByProcessGuidRoute_r3},
+	{Path: "/v1/desired_lrps/list.r4", Method: "POST", Name: DesiredLRPsRoute_r4},
+	{Path: "/v1/desired_lrps/get_by_process_guid.r4", Method: "POST", Name: DesiredLRPByProcessGuidRoute_r4},
+	{Path: "/v1/desired_lrps/status", Method: "POST", Name: DesiredLRPStatusRoute_r0},
+	{Path: "/v1/desired_lrps/list.r3", Method: "POST", Name: DesiredLRPsRoute_r3},                            // DEPRECATED
+	{Path: "/v1/desired_lrps/get_by_process_guid.r3", Method: "POST", Name: DesiredLRPByProcessGuidRoute_r3}, // DEPRECATED
 	{Path: "/v1/desired_lrps/list.r2", Method: "POST", Name: DesiredLRPsRoute_r2},                            // DEPRECATED
 	{Path: "/v1/desired_lrps/get_by_process_guid.r2", Method: "POST", Name: DesiredLRPByProcessGuidRoute_r2}, // DEPRECATED
 
@@ -132,10 +195,18 @@ var Routes = rata.Routes{
 	{Path: "/v1/desired_lrp/desire.r2", Method: "POST", Name: DesireDesiredLRPRoute_r2},
 	{Path: "/v1/desired_lrp/update", Method: "POST", Name: UpdateDesiredLRPRoute_r0},
 	{Path: "/v1/desired_lrp/remove", Method: "POST", Name: RemoveDesiredLRPRoute_r0},
+	{Path: "/v1/desired_lrp/restart", Method: "POST", Name: RestartDesiredLRPRoute_r0},
+
+	// DesiredLRP Revisions
+	{Path: "/v1/desired_lrp/revisions/list", Method: "POST", Name: DesiredLRPRevisionsRoute_r0},
+	{Path: "/v1/desired_lrp/revisions/diff", Method: "POST", Name: DesiredLRPRevisionDiffRoute_r0},
+	{Path: "/v1/desired_lrp/rollback", Method: "POST", Name: RollbackDesiredLRPRoute_r0},
 
 	// Tasks
-	{Path: "/v1/tasks/list.r3", Method: "POST", Name: TasksRoute_r3},
+	{Path: "/v1/tasks/list.r4", Method: "POST", Name: TasksRoute_r4},
 	{Path: "/v1/tasks/get_by_task_guid.r3", Method: "POST", Name: TaskByGuidRoute_r3},
+	{Path: "/v1/tasks/get_result", Method: "POST", Name: TaskResultRoute_r0},
+	{Path: "/v1/tasks/list.r3", Method: "POST", Name: TasksRoute_r3},                  // DEPRECATED
 	{Path: "/v1/tasks/list.r2", Method: "POST", Name: TasksRoute_r2},                  // DEPRECATED
 	{Path: "/v1/tasks/get_by_task_guid.r2", Method: "POST", Name: TaskByGuidRoute_r2}, // DEPRECATED
 
@@ -149,14 +220,36 @@ var Routes = rata.Routes{
 	{Path: "/v1/tasks/resolving", Method: "POST", Name: ResolvingTaskRoute_r0},
 	{Path: "/v1/tasks/delete", Method: "POST", Name: DeleteTaskRoute_r0},
 
+	// Task Arrays
+	{Path: "/v1/task_arrays/desire", Method: "POST", Name: DesireTaskArrayRoute_r0},
+	{Path: "/v1/task_arrays/status", Method: "POST", Name: TaskArrayStatusRoute_r0},
+	{Path: "/v1/task_arrays/cancel", Method: "POST", Name: CancelTaskArrayRoute_r0},
+
+	// Task Archive
+	{Path: "/v1/task_archive/list", Method: "POST", Name: ArchivedTasksRoute_r0},
+	{Path: "/v1/task_archive/get_by_task_guid", Method: "POST", Name: ArchivedTaskByGuidRoute_r0},
+
+	// Scheduled Tasks
+	{Path: "/v1/scheduled_tasks/list", Method: "POST", Name: ScheduledTasksRoute_r0},
+	{Path: "/v1/scheduled_tasks/get_by_guid", Method: "POST", Name: ScheduledTaskByGuidRoute_r0},
+	{Path: "/v1/scheduled_tasks/desire", Method: "POST", Name: DesireScheduledTaskRoute_r0},
+	{Path: "/v1/scheduled_tasks/update", Method: "POST", Name: UpdateScheduledTaskRoute_r0},
+	{Path: "/v1/scheduled_tasks/delete", Method: "POST", Name: DeleteScheduledTaskRoute_r0},
+
 	// Event Streaming
 	{Path: "/v1/events.r1", Method: "GET", Name: LRPGroupEventStreamRoute_r1}, // DEPRECATED
 	{Path: "/v1/events/tasks.r1", Method: "POST", Name: TaskEventStreamRoute_r1},
-	{Path: "/v1/events/lrp_instances.r1", Method: "POST", Name: LRPInstanceEventStreamRoute_r1},
-	{Path: "/v1/events", Method: "GET", Name: EventStreamRoute_r0},                           // DEPRECATED
-	{Path: "/v1/events/tasks", Method: "POST", Name: TaskEventStreamRoute_r0},                // DEPRECATED
-	{Path: "/v1/events/lrp_instances", Method: "POST", Name: LrpInstanceEventStreamRoute_r0}, // DEPRECATED
+	{Path: "/v1/events/lrp_instances.r2", Method: "POST", Name: LRPInstanceEventStreamRoute_r2},
+	{Path: "/v1/events/lrp_instances.r1", Method: "POST", Name: LRPInstanceEventStreamRoute_r1}, // DEPRECATED
+	{Path: "/v1/events", Method: "GET", Name: EventStreamRoute_r0},                              // DEPRECATED
+	{Path: "/v1/events/tasks", Method: "POST", Name: TaskEventStreamRoute_r0},                   // DEPRECATED
+	{Path: "/v1/events/lrp_instances", Method: "POST", Name: LrpInstanceEventStreamRoute_r0},    // DEPRECATED
 
 	// Cells
 	{Path: "/v1/cells/list.r1", Method: "POST", Name: CellsRoute_r0},
+	{Path: "/v1/cells/cordon", Method: "POST", Name: CordonCellRoute_r0},
+	{Path: "/v1/cells/uncordon", Method: "POST", Name: UncordonCellRoute_r0},
+	{Path: "/v1/cells/drain", Method: "POST", Name: DrainCellRoute_r0},
+	{Path: "/v1/cells/drain_status", Method: "POST", Name: CellDrainStatusRoute_r0},
+	{Path: "/v1/cells/capacity", Method: "POST", Name: CapacityReportRoute_r0},
 }
@@ -0,0 +1,115 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fake_controllers
+
+import (
+	"context"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/scheduler"
+	lager "code.cloudfoundry.org/lager/v3"
+)
+
+type FakeScheduledTaskController struct {
+	RunScheduledTasksStub        func(context.Context, lager.Logger) error
+	runScheduledTasksMutex       sync.RWMutex
+	runScheduledTasksArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	runScheduledTasksReturns struct {
+		result1 error
+	}
+	runScheduledTasksReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeScheduledTaskController) RunScheduledTasks(arg1 context.Context, arg2 lager.Logger) error {
+	fake.runScheduledTasksMutex.Lock()
+	ret, specificReturn := fake.runScheduledTasksReturnsOnCall[len(fake.runScheduledTasksArgsForCall)]
+	fake.runScheduledTasksArgsForCall = append(fake.runScheduledTasksArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.RunScheduledTasksStub
+	fakeReturns := fake.runScheduledTasksReturns
+	fake.recordInvocation("RunScheduledTasks", []interface{}{arg1, arg2})
+	fake.runScheduledTasksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeScheduledTaskController) RunScheduledTasksCallCount() int {
+	fake.runScheduledTasksMutex.RLock()
+	defer fake.runScheduledTasksMutex.RUnlock()
+	return len(fake.runScheduledTasksArgsForCall)
+}
+
+func (fake *FakeScheduledTaskController) RunScheduledTasksCalls(stub func(context.Context, lager.Logger) error) {
+	fake.runScheduledTasksMutex.Lock()
+	defer fake.runScheduledTasksMutex.Unlock()
+	fake.RunScheduledTasksStub = stub
+}
+
+func (fake *FakeScheduledTaskController) RunScheduledTasksArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.runScheduledTasksMutex.RLock()
+	defer fake.runScheduledTasksMutex.RUnlock()
+	argsForCall := fake.runScheduledTasksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeScheduledTaskController) RunScheduledTasksReturns(result1 error) {
+	fake.runScheduledTasksMutex.Lock()
+	defer fake.runScheduledTasksMutex.Unlock()
+	fake.RunScheduledTasksStub = nil
+	fake.runScheduledTasksReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeScheduledTaskController) RunScheduledTasksReturnsOnCall(i int, result1 error) {
+	fake.runScheduledTasksMutex.Lock()
+	defer fake.runScheduledTasksMutex.Unlock()
+	fake.RunScheduledTasksStub = nil
+	if fake.runScheduledTasksReturnsOnCall == nil {
+		fake.runScheduledTasksReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.runScheduledTasksReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeScheduledTaskController) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.runScheduledTasksMutex.RLock()
+	defer fake.runScheduledTasksMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeScheduledTaskController) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ scheduler.ScheduledTaskController = new(FakeScheduledTaskController)
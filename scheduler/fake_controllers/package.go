@@ -0,0 +1 @@
+package fake_controllers // import "code.cloudfoundry.org/bbs/scheduler/fake_controllers"
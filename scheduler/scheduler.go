@@ -0,0 +1,75 @@
+package scheduler
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+//go:generate counterfeiter -generate
+
+//counterfeiter:generate -o fake_controllers/fake_scheduled_task_controller.go . ScheduledTaskController
+type ScheduledTaskController interface {
+	RunScheduledTasks(ctx context.Context, logger lager.Logger) error
+}
+
+// DefaultInterval is used when no scheduled task interval is configured.
+// Cron schedules have minute granularity, so runs start at most this late.
+const DefaultInterval = 15 * time.Second
+
+// Scheduler periodically desires the Tasks of ScheduledTasks that are due. It
+// must only run on the BBS holding the lock.
+type Scheduler struct {
+	logger                  lager.Logger
+	clock                   clock.Clock
+	scheduledTaskController ScheduledTaskController
+	interval                time.Duration
+}
+
+func New(
+	logger lager.Logger,
+	clock clock.Clock,
+	scheduledTaskController ScheduledTaskController,
+	interval time.Duration,
+) *Scheduler {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+
+	return &Scheduler{
+		logger:                  logger,
+		clock:                   clock,
+		scheduledTaskController: scheduledTaskController,
+		interval:                interval,
+	}
+}
+
+func (s *Scheduler) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	logger := s.logger.Session("scheduler-process")
+	logger.Info("started")
+
+	timer := s.clock.NewTimer(s.interval)
+	defer func() {
+		logger.Info("done")
+		timer.Stop()
+	}()
+
+	close(ready)
+
+	for {
+		select {
+		case <-signals:
+			return nil
+
+		case <-timer.C():
+			err := s.scheduledTaskController.RunScheduledTasks(context.Background(), logger)
+			if err != nil {
+				logger.Error("failed-to-run-scheduled-tasks", err)
+			}
+			timer.Reset(s.interval)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package scheduler_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	"github.com/tedsuo/ifrit"
+	ginkgomon "github.com/tedsuo/ifrit/ginkgomon_v2"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+
+	"code.cloudfoundry.org/bbs/scheduler"
+	"code.cloudfoundry.org/bbs/scheduler/fake_controllers"
+)
+
+const aBit = 100 * time.Millisecond
+
+var _ = Describe("SchedulerProcess", func() {
+	var (
+		fakeScheduledTaskController *fake_controllers.FakeScheduledTaskController
+		logger                      *lagertest.TestLogger
+		fakeClock                   *fakeclock.FakeClock
+		interval                    time.Duration
+
+		process ifrit.Process
+	)
+
+	BeforeEach(func() {
+		fakeScheduledTaskController = new(fake_controllers.FakeScheduledTaskController)
+		logger = lagertest.NewTestLogger("test")
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+		interval = 10 * time.Second
+	})
+
+	JustBeforeEach(func() {
+		process = ifrit.Invoke(scheduler.New(logger, fakeClock, fakeScheduledTaskController, interval))
+	})
+
+	AfterEach(func() {
+		ginkgomon.Interrupt(process)
+		Eventually(process.Wait()).Should(Receive())
+	})
+
+	It("runs the scheduled tasks every interval", func() {
+		Consistently(fakeScheduledTaskController.RunScheduledTasksCallCount).Should(Equal(0))
+
+		fakeClock.WaitForWatcherAndIncrement(interval + aBit)
+		Eventually(fakeScheduledTaskController.RunScheduledTasksCallCount).Should(Equal(1))
+
+		fakeClock.WaitForWatcherAndIncrement(interval + aBit)
+		Eventually(fakeScheduledTaskController.RunScheduledTasksCallCount).Should(Equal(2))
+	})
+
+	Context("when running the scheduled tasks fails", func() {
+		BeforeEach(func() {
+			fakeScheduledTaskController.RunScheduledTasksReturns(errors.New("boom"))
+		})
+
+		It("logs the error and keeps running", func() {
+			fakeClock.WaitForWatcherAndIncrement(interval + aBit)
+			Eventually(logger).Should(gbytes.Say("failed-to-run-scheduled-tasks"))
+
+			fakeClock.WaitForWatcherAndIncrement(interval + aBit)
+			Eventually(fakeScheduledTaskController.RunScheduledTasksCallCount).Should(Equal(2))
+		})
+	})
+
+	Context("when the interval is not set", func() {
+		BeforeEach(func() {
+			interval = 0
+		})
+
+		It("uses the default interval", func() {
+			fakeClock.WaitForWatcherAndIncrement(scheduler.DefaultInterval - aBit)
+			Consistently(fakeScheduledTaskController.RunScheduledTasksCallCount).Should(Equal(0))
+
+			fakeClock.Increment(2 * aBit)
+			Eventually(fakeScheduledTaskController.RunScheduledTasksCallCount).Should(Equal(1))
+		})
+	})
+})
@@ -0,0 +1,202 @@
+package taskworkpool
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/bbs/models"
+	cfhttp "code.cloudfoundry.org/cfhttp/v2"
+	"code.cloudfoundry.org/clock"
+)
+
+const (
+	// SignatureHeader carries the hex encoded HMAC-SHA256 of the signed
+	// payload, prefixed with the algorithm name, e.g. "sha256=<hex>".
+	SignatureHeader = "X-Bbs-Signature"
+	// TimestampHeader carries the unix time, in seconds, at which the
+	// callback was signed. Receivers should reject stale timestamps to
+	// prevent replays.
+	TimestampHeader = "X-Bbs-Timestamp"
+
+	signatureAlgorithm = "sha256"
+
+	maxCallbackRedirects = 10
+)
+
+var ErrCallbackHostNotAllowed = errors.New("completion callback host is not allowed")
+
+// CallbackConfig controls how task completion callbacks are authenticated and
+// which hosts they may be sent to.
+type CallbackConfig struct {
+	// SigningSecret is the shared secret used to sign callback bodies. When it
+	// is empty callbacks are not signed.
+	SigningSecret string
+	// AllowedHosts restricts the hosts that callbacks may be sent to. Entries
+	// are matched case-insensitively against the callback URL hostname, and an
+	// entry of the form "*.example.com" matches any subdomain of example.com.
+	// When it is empty callbacks may be sent to any host.
+	AllowedHosts []string
+	// DomainCredentials holds optional credentials presented with callbacks
+	// for tasks in the given domain.
+	DomainCredentials map[string]DomainCredentials
+}
+
+type DomainCredentials struct {
+	BearerToken    string `json:"bearer_token,omitempty"`
+	ClientCertFile string `json:"client_cert_file,omitempty"`
+	ClientKeyFile  string `json:"client_key_file,omitempty"`
+}
+
+// ValidateCallbackURL returns ErrCallbackHostNotAllowed if the host of the
+// given callback URL does not match the configured allowlist.
+func (c CallbackConfig) ValidateCallbackURL(callbackURL string) error {
+	if callbackURL == "" || len(c.AllowedHosts) == 0 {
+		return nil
+	}
+
+	u, err := url.Parse(callbackURL)
+	if err != nil {
+		return err
+	}
+
+	host := strings.ToLower(u.Hostname())
+	for _, allowed := range c.AllowedHosts {
+		allowed = strings.ToLower(allowed)
+		if strings.HasPrefix(allowed, "*.") {
+			if strings.HasSuffix(host, allowed[1:]) {
+				return nil
+			}
+			continue
+		}
+		if host == allowed {
+			return nil
+		}
+	}
+
+	return ErrCallbackHostNotAllowed
+}
+
+// CallbackClient builds and sends task completion callbacks, signing them and
+// attaching any credentials configured for the task's domain.
+type CallbackClient struct {
+	httpClient    *http.Client
+	domainClients map[string]*http.Client
+	config        CallbackConfig
+	clock         clock.Clock
+}
+
+// NewCallbackClient returns a CallbackClient sending callbacks through copies
+// of the given HTTP clients that only follow redirects to allowed hosts.
+func NewCallbackClient(httpClient *http.Client, domainClients map[string]*http.Client, config CallbackConfig, clock clock.Clock) *CallbackClient {
+	checkedDomainClients := make(map[string]*http.Client, len(domainClients))
+	for domain, client := range domainClients {
+		checkedDomainClients[domain] = withRedirectCheck(client, config)
+	}
+
+	return &CallbackClient{
+		httpClient:    withRedirectCheck(httpClient, config),
+		domainClients: checkedDomainClients,
+		config:        config,
+		clock:         clock,
+	}
+}
+
+// CheckRedirect validates every redirect of a callback request against the
+// allowlist, so that an allowed host cannot forward the callback and its
+// credentials to a host that is not.
+func (c CallbackConfig) CheckRedirect(request *http.Request, via []*http.Request) error {
+	if len(via) >= maxCallbackRedirects {
+		return fmt.Errorf("stopped after %d redirects", maxCallbackRedirects)
+	}
+	return c.ValidateCallbackURL(request.URL.String())
+}
+
+func withRedirectCheck(client *http.Client, config CallbackConfig) *http.Client {
+	checked := *client
+	checked.CheckRedirect = config.CheckRedirect
+	return &checked
+}
+
+// NewDomainClients builds an HTTP client for every domain configured with a
+// client certificate. Each client extends the given TLS configuration with the
+// domain's certificate.
+func NewDomainClients(config CallbackConfig, tlsConfig *tls.Config, requestTimeout time.Duration) (map[string]*http.Client, error) {
+	clients := map[string]*http.Client{}
+	for domain, creds := range config.DomainCredentials {
+		if creds.ClientCertFile == "" && creds.ClientKeyFile == "" {
+			continue
+		}
+
+		cert, err := tls.LoadX509KeyPair(creds.ClientCertFile, creds.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate for domain %q: %w", domain, err)
+		}
+
+		var domainTLSConfig *tls.Config
+		if tlsConfig != nil {
+			domainTLSConfig = tlsConfig.Clone()
+		} else {
+			domainTLSConfig = &tls.Config{}
+		}
+		domainTLSConfig.Certificates = []tls.Certificate{cert}
+
+		clients[domain] = cfhttp.NewClient(
+			cfhttp.WithTLSConfig(domainTLSConfig),
+			cfhttp.WithRequestTimeout(requestTimeout),
+		)
+	}
+	return clients, nil
+}
+
+func (c *CallbackClient) ValidateCallbackURL(callbackURL string) error {
+	return c.config.ValidateCallbackURL(callbackURL)
+}
+
+// NewRequest builds a signed callback request carrying the given body.
+func (c *CallbackClient) NewRequest(task *models.Task, body []byte) (*http.Request, error) {
+	request, err := http.NewRequest("POST", task.CompletionCallbackUrl, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+
+	if c.config.SigningSecret != "" {
+		timestamp := strconv.FormatInt(c.clock.Now().Unix(), 10)
+		request.Header.Set(TimestampHeader, timestamp)
+		request.Header.Set(SignatureHeader, signatureAlgorithm+"="+Sign(c.config.SigningSecret, timestamp, body))
+	}
+
+	if creds, ok := c.config.DomainCredentials[task.Domain]; ok && creds.BearerToken != "" {
+		request.Header.Set("Authorization", "Bearer "+creds.BearerToken)
+	}
+
+	return request, nil
+}
+
+func (c *CallbackClient) Do(task *models.Task, request *http.Request) (*http.Response, error) {
+	if client, ok := c.domainClients[task.Domain]; ok {
+		return client.Do(request)
+	}
+	return c.httpClient.Do(request)
+}
+
+// Sign computes the hex encoded HMAC-SHA256 of "<timestamp>.<body>" using the
+// given secret. Receivers can recompute it to verify a callback.
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -1,7 +1,6 @@
 package taskworkpool
 
 import (
-	"bytes"
 	"context"
 	"crypto/tls"
 	"encoding/json"
@@ -14,6 +13,7 @@ import (
 	"code.cloudfoundry.org/bbs/events"
 	"code.cloudfoundry.org/bbs/models"
 	cfhttp "code.cloudfoundry.org/cfhttp/v2"
+	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/lager/v3"
 	"code.cloudfoundry.org/workpool"
 )
@@ -24,10 +24,11 @@ const MAX_CB_RETRIES = 3
 
 //counterfeiter:generate . TaskCompletionClient
 
-type CompletedTaskHandler func(logger lager.Logger, httpClient *http.Client, taskDB db.TaskDB, taskHub events.Hub, task *models.Task)
+type CompletedTaskHandler func(logger lager.Logger, callbackClient *CallbackClient, taskDB db.TaskDB, taskHub events.Hub, task *models.Task)
 
 type TaskCompletionClient interface {
 	Submit(taskDB db.TaskDB, taskHub events.Hub, task *models.Task)
+	ValidateCallbackURL(callbackURL string) error
 }
 
 type TaskCompletionWorkPool struct {
@@ -35,10 +36,18 @@ type TaskCompletionWorkPool struct {
 	maxWorkers       int
 	callbackHandler  CompletedTaskHandler
 	callbackWorkPool *workpool.WorkPool
-	httpClient       *http.Client
+	callbackClient   *CallbackClient
 }
 
-func New(logger lager.Logger, maxWorkers int, cbHandler CompletedTaskHandler, tlsConfig *tls.Config, requestTimeout time.Duration) *TaskCompletionWorkPool {
+func New(
+	logger lager.Logger,
+	maxWorkers int,
+	cbHandler CompletedTaskHandler,
+	tlsConfig *tls.Config,
+	requestTimeout time.Duration,
+	callbackConfig CallbackConfig,
+	clock clock.Clock,
+) (*TaskCompletionWorkPool, error) {
 	if cbHandler == nil {
 		panic("callbackHandler cannot be nil")
 	}
@@ -48,12 +57,17 @@ func New(logger lager.Logger, maxWorkers int, cbHandler CompletedTaskHandler, tl
 		cfhttp.WithRequestTimeout(requestTimeout),
 	)
 
+	domainClients, err := NewDomainClients(callbackConfig, tlsConfig, requestTimeout)
+	if err != nil {
+		return nil, err
+	}
+
 	return &TaskCompletionWorkPool{
 		logger:          logger.Session("task-completion-workpool"),
 		maxWorkers:      maxWorkers,
 		callbackHandler: cbHandler,
-		httpClient:      httpClient,
-	}
+		callbackClient:  NewCallbackClient(httpClient, domainClients, callbackConfig, clock),
+	}, nil
 }
 
 func (twp *TaskCompletionWorkPool) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
@@ -82,11 +96,15 @@ func (twp *TaskCompletionWorkPool) Submit(taskDB db.TaskDB, taskHub events.Hub,
 	}
 	logger := twp.logger
 	twp.callbackWorkPool.Submit(func() {
-		twp.callbackHandler(logger, twp.httpClient, taskDB, taskHub, task)
+		twp.callbackHandler(logger, twp.callbackClient, taskDB, taskHub, task)
 	})
 }
 
-func HandleCompletedTask(logger lager.Logger, httpClient *http.Client, taskDB db.TaskDB, taskHub events.Hub, task *models.Task) {
+func (twp *TaskCompletionWorkPool) ValidateCallbackURL(callbackURL string) error {
+	return twp.callbackClient.ValidateCallbackURL(callbackURL)
+}
+
+func HandleCompletedTask(logger lager.Logger, callbackClient *CallbackClient, taskDB db.TaskDB, taskHub events.Hub, task *models.Task) {
 	logger = logger.Session("handle-completed-task", lager.Data{"task_guid": task.TaskGuid})
 
 	if task.CompletionCallbackUrl != "" {
@@ -97,6 +115,15 @@ func HandleCompletedTask(logger lager.Logger, httpClient *http.Client, taskDB db
 		}
 		go taskHub.Emit(models.NewTaskChangedEvent(before, after))
 
+		err := callbackClient.ValidateCallbackURL(task.CompletionCallbackUrl)
+		if err != nil {
+			// the callback will never be allowed, so resolve the task without
+			// it rather than leaving it for convergence to resubmit forever
+			logger.Error("callback-url-not-allowed", err, lager.Data{"callback_url": task.CompletionCallbackUrl})
+			deleteTask(logger, taskDB, taskHub, task.TaskGuid)
+			return
+		}
+
 		logger = logger.WithData(lager.Data{"callback_url": task.CompletionCallbackUrl})
 
 		json, err := json.Marshal(&models.TaskCallbackResponse{
@@ -116,14 +143,13 @@ func HandleCompletedTask(logger lager.Logger, httpClient *http.Client, taskDB db
 
 		retriableErrRegexp := regexp.MustCompile("Client.Timeout|use of closed network connection")
 		for i := 0; i < MAX_CB_RETRIES; i++ {
-			request, err := http.NewRequest("POST", task.CompletionCallbackUrl, bytes.NewReader(json))
+			request, err := callbackClient.NewRequest(task, json)
 			if err != nil {
 				logger.Error("building-request-failed", err)
 				return
 			}
 
-			request.Header.Set("Content-Type", "application/json")
-			response, err := httpClient.Do(request)
+			response, err := callbackClient.Do(task, request)
 			if err != nil {
 				if retriableErrRegexp.MatchString(err.Error()) {
 					continue
@@ -135,11 +161,7 @@ func HandleCompletedTask(logger lager.Logger, httpClient *http.Client, taskDB db
 
 			statusCode = response.StatusCode
 			if shouldResolve(statusCode) {
-				deletedTask, modelErr := taskDB.DeleteTask(context.Background(), logger, task.TaskGuid)
-				if modelErr != nil {
-					logger.Error("delete-task-failed", modelErr)
-				}
-				go taskHub.Emit(models.NewTaskRemovedEvent(deletedTask))
+				deleteTask(logger, taskDB, taskHub, task.TaskGuid)
 				return
 			}
 		}
@@ -148,6 +170,14 @@ func HandleCompletedTask(logger lager.Logger, httpClient *http.Client, taskDB db
 	}
 }
 
+func deleteTask(logger lager.Logger, taskDB db.TaskDB, taskHub events.Hub, taskGuid string) {
+	deletedTask, modelErr := taskDB.DeleteTask(context.Background(), logger, taskGuid)
+	if modelErr != nil {
+		logger.Error("delete-task-failed", modelErr)
+	}
+	go taskHub.Emit(models.NewTaskRemovedEvent(deletedTask))
+}
+
 func shouldResolve(status int) bool {
 	switch status {
 	case http.StatusServiceUnavailable, http.StatusGatewayTimeout:
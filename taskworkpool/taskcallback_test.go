@@ -6,6 +6,7 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"strings"
 	"time"
 
 	"code.cloudfoundry.org/bbs/db/dbfakes"
@@ -14,6 +15,7 @@ import (
 	"code.cloudfoundry.org/bbs/models/test/model_helpers"
 	"code.cloudfoundry.org/bbs/taskworkpool"
 	cfhttp "code.cloudfoundry.org/cfhttp/v2"
+	"code.cloudfoundry.org/clock/fakeclock"
 	"code.cloudfoundry.org/lager/v3"
 	"code.cloudfoundry.org/lager/v3/lagertest"
 	"github.com/tedsuo/ifrit"
@@ -48,18 +50,24 @@ var _ = Describe("TaskWorker", func() {
 			callbackURL   string
 			taskDB        *dbfakes.FakeTaskDB
 			statusCodes   chan int
+			redirects     chan string
 			task          *models.Task
 			before, after models.Task
 			taskHub       *eventfakes.FakeHub
 
-			httpClient *http.Client
+			httpClient     *http.Client
+			callbackConfig taskworkpool.CallbackConfig
+			fakeClock      *fakeclock.FakeClock
 		)
 
 		BeforeEach(func() {
 			httpClient = cfhttp.NewClient(
 				cfhttp.WithRequestTimeout(timeout),
 			)
+			callbackConfig = taskworkpool.CallbackConfig{}
+			fakeClock = fakeclock.NewFakeClock(time.Unix(1700000000, 0))
 			statusCodes = make(chan int)
+			redirects = make(chan string, 1)
 			taskHub = &eventfakes.FakeHub{}
 
 			fakeServer.RouteToHandler("POST", "/the-callback/url", func(w http.ResponseWriter, req *http.Request) {
@@ -84,7 +92,8 @@ var _ = Describe("TaskWorker", func() {
 			close(ready)
 			task = model_helpers.NewValidTask("the-task-guid")
 			task.CompletionCallbackUrl = callbackURL
-			taskworkpool.HandleCompletedTask(logger, httpClient, taskDB, taskHub, task)
+			callbackClient := taskworkpool.NewCallbackClient(httpClient, nil, callbackConfig, fakeClock)
+			taskworkpool.HandleCompletedTask(logger, callbackClient, taskDB, taskHub, task)
 			return nil
 		}
 
@@ -318,6 +327,91 @@ var _ = Describe("TaskWorker", func() {
 			})
 		})
 
+		Context("when a signing secret is configured", func() {
+			var headers chan http.Header
+
+			BeforeEach(func() {
+				callbackConfig.SigningSecret = "shared-secret"
+				headers = make(chan http.Header, 1)
+				fakeServer.RouteToHandler("POST", "/the-callback/url", func(w http.ResponseWriter, req *http.Request) {
+					body, err := io.ReadAll(req.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(req.Header.Get(taskworkpool.SignatureHeader)).To(Equal("sha256=" + taskworkpool.Sign("shared-secret", req.Header.Get(taskworkpool.TimestampHeader), body)))
+					headers <- req.Header
+					w.WriteHeader(http.StatusOK)
+				})
+			})
+
+			It("signs the callback body with a timestamp", func() {
+				var header http.Header
+				Eventually(headers).Should(Receive(&header))
+				Expect(header.Get(taskworkpool.TimestampHeader)).To(Equal("1700000000"))
+				Expect(header.Get("Authorization")).To(BeEmpty())
+			})
+
+			Context("when the task domain has a bearer token", func() {
+				BeforeEach(func() {
+					callbackConfig.DomainCredentials = map[string]taskworkpool.DomainCredentials{
+						"some-domain": {BearerToken: "some-token"},
+					}
+				})
+
+				It("sends the bearer token", func() {
+					var header http.Header
+					Eventually(headers).Should(Receive(&header))
+					Expect(header.Get("Authorization")).To(Equal("Bearer some-token"))
+				})
+			})
+		})
+
+		Context("when the callback host is not in the allowlist", func() {
+			BeforeEach(func() {
+				callbackConfig.AllowedHosts = []string{"*.example.com"}
+			})
+
+			It("resolves and deletes the task", func() {
+				Eventually(taskDB.ResolvingTaskCallCount).Should(Equal(1))
+				Eventually(taskDB.DeleteTaskCallCount).Should(Equal(1))
+				_, _, deletedGuid := taskDB.DeleteTaskArgsForCall(0)
+				Expect(deletedGuid).To(Equal("the-task-guid"))
+			})
+
+			It("does not make a request to the task's callback URL", func() {
+				Consistently(fakeServer.ReceivedRequests, 0.25).Should(BeEmpty())
+				Eventually(logger.TestSink.LogMessages).Should(ContainElement("test.handle-completed-task.callback-url-not-allowed"))
+			})
+		})
+
+		Context("when the callback host redirects", func() {
+			var otherServer *ghttp.Server
+
+			BeforeEach(func() {
+				otherServer = ghttp.NewServer()
+				otherServer.RouteToHandler("POST", "/elsewhere", ghttp.RespondWith(http.StatusOK, nil))
+				fakeServer.RouteToHandler("POST", "/the-callback/url", func(w http.ResponseWriter, req *http.Request) {
+					http.Redirect(w, req, <-redirects, http.StatusTemporaryRedirect)
+				})
+				callbackConfig.AllowedHosts = []string{"127.0.0.1"}
+			})
+
+			AfterEach(func() {
+				otherServer.Close()
+			})
+
+			It("follows redirects to allowed hosts", func() {
+				redirects <- otherServer.URL() + "/elsewhere"
+				Eventually(otherServer.ReceivedRequests).Should(HaveLen(1))
+				Eventually(taskDB.DeleteTaskCallCount).Should(Equal(1))
+			})
+
+			It("does not follow redirects to other hosts", func() {
+				redirects <- strings.Replace(otherServer.URL(), "127.0.0.1", "localhost", 1) + "/elsewhere"
+				Eventually(logger.TestSink.LogMessages).Should(ContainElement("test.handle-completed-task.doing-request-failed"))
+				Expect(otherServer.ReceivedRequests()).To(BeEmpty())
+				Expect(taskDB.DeleteTaskCallCount()).To(Equal(0))
+			})
+		})
+
 		Context("when the task doesn't have a completion callback URL", func() {
 			BeforeEach(func() {
 				callbackURL = ""
@@ -329,3 +423,43 @@ var _ = Describe("TaskWorker", func() {
 		})
 	})
 })
+
+var _ = Describe("CallbackConfig", func() {
+	Describe("ValidateCallbackURL", func() {
+		var config taskworkpool.CallbackConfig
+
+		BeforeEach(func() {
+			config = taskworkpool.CallbackConfig{
+				AllowedHosts: []string{"callbacks.internal", "*.example.com"},
+			}
+		})
+
+		It("allows hosts in the allowlist", func() {
+			Expect(config.ValidateCallbackURL("https://callbacks.internal:8443/done")).To(Succeed())
+			Expect(config.ValidateCallbackURL("http://CALLBACKS.internal/done")).To(Succeed())
+		})
+
+		It("allows subdomains matching a wildcard entry", func() {
+			Expect(config.ValidateCallbackURL("http://cc.example.com/done")).To(Succeed())
+		})
+
+		It("rejects other hosts", func() {
+			Expect(config.ValidateCallbackURL("http://169.254.169.254/latest")).To(MatchError(taskworkpool.ErrCallbackHostNotAllowed))
+			Expect(config.ValidateCallbackURL("http://example.com.evil.org/done")).To(MatchError(taskworkpool.ErrCallbackHostNotAllowed))
+		})
+
+		It("allows an empty callback URL", func() {
+			Expect(config.ValidateCallbackURL("")).To(Succeed())
+		})
+
+		Context("when the allowlist is empty", func() {
+			BeforeEach(func() {
+				config.AllowedHosts = nil
+			})
+
+			It("allows any host", func() {
+				Expect(config.ValidateCallbackURL("http://anywhere.test/done")).To(Succeed())
+			})
+		})
+	})
+})
@@ -18,6 +18,17 @@ type FakeTaskCompletionClient struct {
 		arg2 events.Hub
 		arg3 *models.Task
 	}
+	ValidateCallbackURLStub        func(string) error
+	validateCallbackURLMutex       sync.RWMutex
+	validateCallbackURLArgsForCall []struct {
+		arg1 string
+	}
+	validateCallbackURLReturns struct {
+		result1 error
+	}
+	validateCallbackURLReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -56,11 +67,74 @@ func (fake *FakeTaskCompletionClient) SubmitArgsForCall(i int) (db.TaskDB, event
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
+func (fake *FakeTaskCompletionClient) ValidateCallbackURL(arg1 string) error {
+	fake.validateCallbackURLMutex.Lock()
+	ret, specificReturn := fake.validateCallbackURLReturnsOnCall[len(fake.validateCallbackURLArgsForCall)]
+	fake.validateCallbackURLArgsForCall = append(fake.validateCallbackURLArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateCallbackURLStub
+	fakeReturns := fake.validateCallbackURLReturns
+	fake.recordInvocation("ValidateCallbackURL", []interface{}{arg1})
+	fake.validateCallbackURLMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeTaskCompletionClient) ValidateCallbackURLCallCount() int {
+	fake.validateCallbackURLMutex.RLock()
+	defer fake.validateCallbackURLMutex.RUnlock()
+	return len(fake.validateCallbackURLArgsForCall)
+}
+
+func (fake *FakeTaskCompletionClient) ValidateCallbackURLCalls(stub func(string) error) {
+	fake.validateCallbackURLMutex.Lock()
+	defer fake.validateCallbackURLMutex.Unlock()
+	fake.ValidateCallbackURLStub = stub
+}
+
+func (fake *FakeTaskCompletionClient) ValidateCallbackURLArgsForCall(i int) string {
+	fake.validateCallbackURLMutex.RLock()
+	defer fake.validateCallbackURLMutex.RUnlock()
+	argsForCall := fake.validateCallbackURLArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTaskCompletionClient) ValidateCallbackURLReturns(result1 error) {
+	fake.validateCallbackURLMutex.Lock()
+	defer fake.validateCallbackURLMutex.Unlock()
+	fake.ValidateCallbackURLStub = nil
+	fake.validateCallbackURLReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTaskCompletionClient) ValidateCallbackURLReturnsOnCall(i int, result1 error) {
+	fake.validateCallbackURLMutex.Lock()
+	defer fake.validateCallbackURLMutex.Unlock()
+	fake.ValidateCallbackURLStub = nil
+	if fake.validateCallbackURLReturnsOnCall == nil {
+		fake.validateCallbackURLReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateCallbackURLReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTaskCompletionClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.submitMutex.RLock()
 	defer fake.submitMutex.RUnlock()
+	fake.validateCallbackURLMutex.RLock()
+	defer fake.validateCallbackURLMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value